@@ -0,0 +1,119 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package advisorydb defines the interface for looking up security advisories
+// in the OSV format, allowing plugins to match packages against the OSV.dev
+// API or a locally mirrored copy of its data.
+package advisorydb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// DB is a database of OSV advisories.
+type DB interface {
+	// Get returns the advisories affecting the package or commit described by q.
+	Get(ctx context.Context, q *Query) ([]*Vulnerability, error)
+	// GetBatch runs multiple queries at once. The i-th result contains the
+	// advisories for qs[i].
+	GetBatch(ctx context.Context, qs []*Query) ([][]*Vulnerability, error)
+	// DataSource describes where the advisories come from. Plugins using the DB
+	// should return it from their DataSources method.
+	DataSource() *plugin.DataSource
+}
+
+// ErrInvalidQuery is returned for queries that don't have exactly one of PURL
+// and Commit set.
+var ErrInvalidQuery = errors.New("exactly one of PURL and Commit must be set")
+
+// Query describes a package or source commit to look up advisories for.
+// Exactly one of PURL and Commit must be set.
+type Query struct {
+	// PURL of the package. If the PURL has no version, all advisories for the
+	// package are returned.
+	PURL *purl.PackageURL
+	// Commit is the full hash of a source commit.
+	Commit string
+}
+
+// Vulnerability is an advisory in the OSV format, see
+// https://ossf.github.io/osv-schema/. Only the fields needed for matching and
+// reporting are included.
+type Vulnerability struct {
+	ID         string      `json:"id"`
+	Modified   time.Time   `json:"modified"`
+	Published  time.Time   `json:"published"`
+	Withdrawn  time.Time   `json:"withdrawn"`
+	Aliases    []string    `json:"aliases,omitempty"`
+	Summary    string      `json:"summary,omitempty"`
+	Details    string      `json:"details,omitempty"`
+	Severity   []Severity  `json:"severity,omitempty"`
+	Affected   []Affected  `json:"affected,omitempty"`
+	References []Reference `json:"references,omitempty"`
+}
+
+// Severity is a severity score of a Vulnerability, e.g. a CVSS vector.
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Affected describes the affected versions of a package.
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+// Package identifies a package in an OSV ecosystem.
+type Package struct {
+	// Ecosystem, e.g. "PyPI" or "Debian:12".
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	PURL      string `json:"purl,omitempty"`
+}
+
+// Range types.
+const (
+	RangeSemVer    = "SEMVER"
+	RangeEcosystem = "ECOSYSTEM"
+	RangeGit       = "GIT"
+)
+
+// Range is a range of affected versions or commits.
+type Range struct {
+	Type string `json:"type"`
+	// Repo is the URL of the repository for GIT ranges.
+	Repo   string  `json:"repo,omitempty"`
+	Events []Event `json:"events"`
+}
+
+// Event marks the start or end of an affected range. Exactly one field is set.
+type Event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// Reference is a link to more information about a Vulnerability.
+type Reference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
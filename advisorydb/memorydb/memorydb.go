@@ -0,0 +1,334 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memorydb implements an advisory database that keeps all advisories
+// in memory. It's used for offline bundles and as a fake in tests.
+package memorydb
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/semantic"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"golang.org/x/mod/semver"
+)
+
+// ecosystems maps PURL types to OSV ecosystems.
+var ecosystems = map[string]string{
+	purl.TypeApk:      "Alpine",
+	purl.TypeCargo:    "crates.io",
+	purl.TypeComposer: "Packagist",
+	purl.TypeCran:     "CRAN",
+	purl.TypeDebian:   "Debian",
+	purl.TypeGem:      "RubyGems",
+	purl.TypeGolang:   "Go",
+	purl.TypeHackage:  "Hackage",
+	purl.TypeHex:      "Hex",
+	purl.TypeMaven:    "Maven",
+	purl.TypeNPM:      "npm",
+	purl.TypeNuget:    "NuGet",
+	purl.TypePub:      "Pub",
+	purl.TypePyPi:     "PyPI",
+	purl.TypeSwift:    "SwiftURL",
+}
+
+// pypiSeparatorsRe matches runs of characters that PEP 503 treats as equal.
+var pypiSeparatorsRe = regexp.MustCompile(`[-_.]+`)
+
+// DB is an in-memory advisory database.
+type DB struct {
+	ds *plugin.DataSource
+	// Advisories by package key, see packageKey.
+	byPackage map[string][]*advisorydb.Vulnerability
+	// Advisories with GIT ranges.
+	withCommits []*advisorydb.Vulnerability
+}
+
+// New returns a DB containing vulns. Withdrawn advisories are ignored.
+// ds describes where the advisories come from.
+func New(ds *plugin.DataSource, vulns []*advisorydb.Vulnerability) *DB {
+	db := &DB{
+		ds:        ds,
+		byPackage: make(map[string][]*advisorydb.Vulnerability),
+	}
+	for _, v := range vulns {
+		if !v.Withdrawn.IsZero() {
+			continue
+		}
+		var keys []string
+		hasCommits := false
+		for _, a := range v.Affected {
+			if k := packageKey(a.Package.Ecosystem, a.Package.Name); k != "" && !slices.Contains(keys, k) {
+				keys = append(keys, k)
+			}
+			for _, r := range a.Ranges {
+				hasCommits = hasCommits || r.Type == advisorydb.RangeGit
+			}
+		}
+		for _, k := range keys {
+			db.byPackage[k] = append(db.byPackage[k], v)
+		}
+		if hasCommits {
+			db.withCommits = append(db.withCommits, v)
+		}
+	}
+	return db
+}
+
+// Get returns the advisories affecting the package or commit described by q.
+//
+// Package versions are matched against the affected versions listed in the
+// advisories and against SEMVER and ECOSYSTEM ranges. ECOSYSTEM ranges of
+// ecosystems whose versions can't be compared by the semantic package are
+// only matched through the affected versions. A commit only matches if it's listed as the introducing or
+// last affected commit of a GIT range, as evaluating ranges needs the
+// repository history.
+func (db *DB) Get(ctx context.Context, q *advisorydb.Query) ([]*advisorydb.Vulnerability, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch {
+	case q.PURL != nil && q.Commit == "":
+		return db.getByPURL(q.PURL), nil
+	case q.PURL == nil && q.Commit != "":
+		return db.getByCommit(q.Commit), nil
+	default:
+		return nil, advisorydb.ErrInvalidQuery
+	}
+}
+
+// GetBatch runs the queries one by one.
+func (db *DB) GetBatch(ctx context.Context, qs []*advisorydb.Query) ([][]*advisorydb.Vulnerability, error) {
+	results := make([][]*advisorydb.Vulnerability, 0, len(qs))
+	for _, q := range qs {
+		vulns, err := db.Get(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, vulns)
+	}
+	return results, nil
+}
+
+// DataSource describes where the advisories come from.
+func (db *DB) DataSource() *plugin.DataSource { return db.ds }
+
+func (db *DB) getByPURL(p *purl.PackageURL) []*advisorydb.Vulnerability {
+	ecosystem, ok := ecosystems[p.Type]
+	if !ok {
+		return nil
+	}
+	name := packageName(p)
+	key := packageKey(ecosystem, name)
+	var result []*advisorydb.Vulnerability
+	for _, v := range db.byPackage[key] {
+		for _, a := range v.Affected {
+			if packageKey(a.Package.Ecosystem, a.Package.Name) == key && affectsVersion(&a, p.Version) {
+				result = append(result, v)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func (db *DB) getByCommit(commit string) []*advisorydb.Vulnerability {
+	var result []*advisorydb.Vulnerability
+	for _, v := range db.withCommits {
+		if affectsCommit(v, commit) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// packageName returns the name of the package described by p in its OSV
+// ecosystem.
+func packageName(p *purl.PackageURL) string {
+	if p.Namespace == "" {
+		return p.Name
+	}
+	switch p.Type {
+	case purl.TypeMaven:
+		return p.Namespace + ":" + p.Name
+	case purl.TypeDebian, purl.TypeApk:
+		// The namespace is the distribution, not part of the name.
+		return p.Name
+	default:
+		return p.Namespace + "/" + p.Name
+	}
+}
+
+// packageKey returns the index key of a package. The release of OS
+// ecosystems, e.g. "12" in "Debian:12", is ignored.
+func packageKey(ecosystem, name string) string {
+	ecosystem, _, _ = strings.Cut(ecosystem, ":")
+	if ecosystem == "" || name == "" {
+		return ""
+	}
+	if ecosystem == "PyPI" {
+		name = pypiSeparatorsRe.ReplaceAllString(strings.ToLower(name), "-")
+	}
+	return ecosystem + "/" + name
+}
+
+// affectsVersion returns true if version is affected according to a. All
+// versions are considered affected if version is empty.
+func affectsVersion(a *advisorydb.Affected, version string) bool {
+	if version == "" {
+		return true
+	}
+	if slices.Contains(a.Versions, version) || slices.Contains(a.Versions, strings.TrimPrefix(version, "v")) {
+		return true
+	}
+	for _, r := range a.Ranges {
+		if r.Type == advisorydb.RangeSemVer && inSemVerRange(r.Events, version) {
+			return true
+		}
+		if r.Type == advisorydb.RangeEcosystem && inEcosystemRange(a.Package.Ecosystem, r.Events, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// inSemVerRange evaluates the events of a SEMVER range for version.
+func inSemVerRange(events []advisorydb.Event, version string) bool {
+	v := canonicalSemVer(version)
+	if !semver.IsValid(v) {
+		return false
+	}
+	// Events need to be processed in version order, with "0" being the lowest.
+	sorted := slices.Clone(events)
+	slices.SortStableFunc(sorted, func(a, b advisorydb.Event) int {
+		return semver.Compare(eventVersion(a), eventVersion(b))
+	})
+	affected := false
+	for _, e := range sorted {
+		switch {
+		case e.Introduced == "0":
+			affected = true
+		case e.Introduced != "":
+			if semver.Compare(v, canonicalSemVer(e.Introduced)) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if semver.Compare(v, canonicalSemVer(e.Fixed)) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if semver.Compare(v, canonicalSemVer(e.LastAffected)) > 0 {
+				affected = false
+			}
+		}
+	}
+	return affected
+}
+
+// inEcosystemRange evaluates the events of an ECOSYSTEM range for version,
+// comparing versions by the rules of ecosystem.
+func inEcosystemRange(ecosystem string, events []advisorydb.Event, version string) bool {
+	if !semantic.Supported(ecosystem) {
+		return false
+	}
+	compare := func(a, b string) int {
+		// "0" is the lowest version in every ecosystem.
+		switch {
+		case a == "0" && b == "0":
+			return 0
+		case a == "0":
+			return -1
+		case b == "0":
+			return 1
+		}
+		c, _ := semantic.Compare(ecosystem, a, b)
+		return c
+	}
+	sorted := slices.Clone(events)
+	slices.SortStableFunc(sorted, func(a, b advisorydb.Event) int {
+		return compare(rawEventVersion(a), rawEventVersion(b))
+	})
+	affected := false
+	for _, e := range sorted {
+		switch {
+		case e.Introduced != "":
+			if compare(version, e.Introduced) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if compare(version, e.Fixed) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if compare(version, e.LastAffected) > 0 {
+				affected = false
+			}
+		case e.Limit != "":
+			if compare(version, e.Limit) >= 0 {
+				affected = false
+			}
+		}
+	}
+	return affected
+}
+
+// rawEventVersion returns the version of e as written in the advisory.
+func rawEventVersion(e advisorydb.Event) string {
+	for _, v := range []string{e.Introduced, e.Fixed, e.LastAffected, e.Limit} {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// eventVersion returns the version of e in the format used by the semver
+// package. Introduced "0" becomes "v0", the lowest version.
+func eventVersion(e advisorydb.Event) string {
+	for _, v := range []string{e.Introduced, e.Fixed, e.LastAffected, e.Limit} {
+		if v != "" {
+			return canonicalSemVer(v)
+		}
+	}
+	return ""
+}
+
+// canonicalSemVer returns version in the format used by the semver package.
+func canonicalSemVer(version string) string {
+	return "v" + strings.TrimPrefix(version, "v")
+}
+
+// affectsCommit returns true if commit is listed as affected by v.
+func affectsCommit(v *advisorydb.Vulnerability, commit string) bool {
+	for _, a := range v.Affected {
+		for _, r := range a.Ranges {
+			if r.Type != advisorydb.RangeGit {
+				continue
+			}
+			for _, e := range r.Events {
+				if e.Introduced == commit || e.LastAffected == commit {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+var _ advisorydb.DB = &DB{}
@@ -0,0 +1,239 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memorydb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/memorydb"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+var (
+	log4shell = &advisorydb.Vulnerability{
+		ID:      "GHSA-jfh8-c2jp-5v3q",
+		Aliases: []string{"CVE-2021-44228"},
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "Maven", Name: "org.apache.logging.log4j:log4j-core"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "2.0-beta9"}, {Fixed: "2.15.0"}},
+			}},
+			Versions: []string{"2.0-beta9", "2.14.0", "2.14.1"},
+		}},
+	}
+	npmVuln = &advisorydb.Vulnerability{
+		ID: "GHSA-p6mc-m468-83gw",
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "npm", Name: "lodash"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeSemVer,
+				Events: []advisorydb.Event{{Fixed: "4.17.19"}, {Introduced: "0"}, {Introduced: "5.0.0"}, {LastAffected: "5.0.2"}},
+			}},
+		}},
+	}
+	pypiVuln = &advisorydb.Vulnerability{
+		ID: "PYSEC-2021-1",
+		Affected: []advisorydb.Affected{{
+			Package:  advisorydb.Package{Ecosystem: "PyPI", Name: "Flask_Cors"},
+			Versions: []string{"3.0.8"},
+		}},
+	}
+	debianVuln = &advisorydb.Vulnerability{
+		ID: "DSA-5000-1",
+		Affected: []advisorydb.Affected{{
+			Package:  advisorydb.Package{Ecosystem: "Debian:12", Name: "openssl"},
+			Versions: []string{"3.0.9-1"},
+		}},
+	}
+	alpineVuln = &advisorydb.Vulnerability{
+		ID: "ALPINE-CVE-2024-1",
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "Alpine:v3.20", Name: "git"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "0"}, {Fixed: "2.45.3-r0"}},
+			}},
+		}},
+	}
+	cranVuln = &advisorydb.Vulnerability{
+		ID: "RSEC-2024-1",
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "CRAN", Name: "readxl"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "0"}, {Fixed: "1.4.0"}},
+			}},
+		}},
+	}
+	gitVuln = &advisorydb.Vulnerability{
+		ID: "OSV-2023-1",
+		Affected: []advisorydb.Affected{{
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeGit,
+				Repo:   "https://github.com/madler/zlib",
+				Events: []advisorydb.Event{{Introduced: "aaaa"}, {Fixed: "bbbb"}},
+			}},
+		}},
+	}
+	withdrawn = &advisorydb.Vulnerability{
+		ID:        "GHSA-withdrawn",
+		Withdrawn: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Affected: []advisorydb.Affected{{
+			Package:  advisorydb.Package{Ecosystem: "npm", Name: "lodash"},
+			Versions: []string{"4.17.15"},
+		}},
+	}
+)
+
+func TestGet(t *testing.T) {
+	db := memorydb.New(&plugin.DataSource{Name: "test"}, []*advisorydb.Vulnerability{
+		log4shell, npmVuln, pypiVuln, debianVuln, alpineVuln, cranVuln, gitVuln, withdrawn,
+	})
+
+	tests := []struct {
+		name    string
+		query   *advisorydb.Query
+		want    []*advisorydb.Vulnerability
+		wantErr error
+	}{
+		{
+			name:  "affected version in versions list",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeMaven, Namespace: "org.apache.logging.log4j", Name: "log4j-core", Version: "2.14.1"}},
+			want:  []*advisorydb.Vulnerability{log4shell},
+		},
+		{
+			name:  "ecosystem range without listed version",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeMaven, Namespace: "org.apache.logging.log4j", Name: "log4j-core", Version: "2.15.0"}},
+			want:  nil,
+		},
+		{
+			name:  "version in ecosystem range",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeMaven, Namespace: "org.apache.logging.log4j", Name: "log4j-core", Version: "2.12.1"}},
+			want:  []*advisorydb.Vulnerability{log4shell},
+		},
+		{
+			name:  "version before ecosystem range",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeMaven, Namespace: "org.apache.logging.log4j", Name: "log4j-core", Version: "2.0-beta8"}},
+			want:  nil,
+		},
+		{
+			name:  "OS package in ecosystem range",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeApk, Namespace: "alpine", Name: "git", Version: "2.45.2-r0"}},
+			want:  []*advisorydb.Vulnerability{alpineVuln},
+		},
+		{
+			name:  "OS package fixed in ecosystem range",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeApk, Namespace: "alpine", Name: "git", Version: "2.45.3-r0"}},
+			want:  nil,
+		},
+		{
+			name:  "ecosystem range of unsupported ecosystem",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeCran, Name: "readxl", Version: "1.3.1"}},
+			want:  nil,
+		},
+		{
+			name:  "version in semver range",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "4.17.15"}},
+			want:  []*advisorydb.Vulnerability{npmVuln},
+		},
+		{
+			name:  "fixed version",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "4.17.19"}},
+			want:  nil,
+		},
+		{
+			name:  "last affected version",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "5.0.2"}},
+			want:  []*advisorydb.Vulnerability{npmVuln},
+		},
+		{
+			name:  "after last affected version",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "5.0.3"}},
+			want:  nil,
+		},
+		{
+			name:  "no version",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash"}},
+			want:  []*advisorydb.Vulnerability{npmVuln},
+		},
+		{
+			name:  "normalized PyPI name",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypePyPi, Name: "flask-cors", Version: "3.0.8"}},
+			want:  []*advisorydb.Vulnerability{pypiVuln},
+		},
+		{
+			name:  "OS package",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeDebian, Namespace: "debian", Name: "openssl", Version: "3.0.9-1"}},
+			want:  []*advisorydb.Vulnerability{debianVuln},
+		},
+		{
+			name:  "unsupported PURL type",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeGeneric, Name: "lodash", Version: "4.17.15"}},
+			want:  nil,
+		},
+		{
+			name:  "introducing commit",
+			query: &advisorydb.Query{Commit: "aaaa"},
+			want:  []*advisorydb.Vulnerability{gitVuln},
+		},
+		{
+			name:  "fixing commit",
+			query: &advisorydb.Query{Commit: "bbbb"},
+			want:  nil,
+		},
+		{
+			name:    "empty query",
+			query:   &advisorydb.Query{},
+			wantErr: advisorydb.ErrInvalidQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := db.Get(context.Background(), tt.query)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Get(%+v) error: got %v, want %v", tt.query, err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Get(%+v) (-want +got):\n%s", tt.query, diff)
+			}
+		})
+	}
+}
+
+func TestGetBatch(t *testing.T) {
+	db := memorydb.New(&plugin.DataSource{Name: "test"}, []*advisorydb.Vulnerability{log4shell, npmVuln})
+	qs := []*advisorydb.Query{
+		{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "4.17.15"}},
+		{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "left-pad", Version: "1.0.0"}},
+		{Commit: "aaaa"},
+	}
+	want := [][]*advisorydb.Vulnerability{{npmVuln}, nil, nil}
+
+	got, err := db.GetBatch(context.Background(), qs)
+	if err != nil {
+		t.Fatalf("GetBatch(): %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetBatch() (-want +got):\n%s", diff)
+	}
+}
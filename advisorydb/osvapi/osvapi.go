@@ -0,0 +1,260 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package osvapi implements an advisory database backed by the OSV.dev API.
+package osvapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// DefaultURL is the URL of the OSV.dev API.
+	DefaultURL = "https://api.osv.dev"
+
+	// dataSourceName is the name of the data source in scan provenance.
+	dataSourceName = "osv.dev"
+	// defaultTimeout is used if no HTTP client is configured.
+	defaultTimeout = 30 * time.Second
+	// maxBatchSize is the maximum number of queries in a querybatch request.
+	maxBatchSize = 1000
+)
+
+// Config is the configuration for the OSV.dev API client.
+type Config struct {
+	// URL of the API.
+	URL string
+	// HTTPC is the client used for requests. A client with a default timeout
+	// is used if nil.
+	HTTPC *http.Client
+}
+
+// DefaultConfig returns the default configuration for the OSV.dev API client.
+func DefaultConfig() Config {
+	return Config{
+		URL:   DefaultURL,
+		HTTPC: nil,
+	}
+}
+
+// DB queries advisories from the OSV.dev API.
+type DB struct {
+	url   string
+	httpc *http.Client
+}
+
+// New returns an OSV.dev API client.
+//
+// For most use cases, initialize with:
+// ```
+// db := New(DefaultConfig())
+// ```
+func New(cfg Config) *DB {
+	httpc := cfg.HTTPC
+	if httpc == nil {
+		httpc = &http.Client{Timeout: defaultTimeout}
+	}
+	return &DB{url: strings.TrimSuffix(cfg.URL, "/"), httpc: httpc}
+}
+
+// query is a query in the format of the API.
+type query struct {
+	Commit    string       `json:"commit,omitempty"`
+	Package   *packageInfo `json:"package,omitempty"`
+	PageToken string       `json:"page_token,omitempty"`
+}
+
+type packageInfo struct {
+	PURL string `json:"purl"`
+}
+
+type queryResponse struct {
+	Vulns         []*advisorydb.Vulnerability `json:"vulns"`
+	NextPageToken string                      `json:"next_page_token"`
+}
+
+type batchRequest struct {
+	Queries []*query `json:"queries"`
+}
+
+// batchResponse only contains the IDs and modification times of the
+// advisories, which have to be fetched individually.
+type batchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID string `json:"id"`
+		} `json:"vulns"`
+		NextPageToken string `json:"next_page_token"`
+	} `json:"results"`
+}
+
+// Get returns the advisories affecting the package or commit described by q.
+func (db *DB) Get(ctx context.Context, q *advisorydb.Query) ([]*advisorydb.Vulnerability, error) {
+	req, err := toAPIQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	var vulns []*advisorydb.Vulnerability
+	for {
+		var res queryResponse
+		if err := db.post(ctx, "/v1/query", req, &res); err != nil {
+			return nil, err
+		}
+		vulns = append(vulns, res.Vulns...)
+		if res.NextPageToken == "" {
+			return vulns, nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
+// GetBatch runs the queries using the querybatch endpoint and fetches the
+// advisories found. Each advisory is fetched once even if it affects multiple
+// packages.
+func (db *DB) GetBatch(ctx context.Context, qs []*advisorydb.Query) ([][]*advisorydb.Vulnerability, error) {
+	ids := make([][]string, 0, len(qs))
+	for start := 0; start < len(qs); start += maxBatchSize {
+		batch := qs[start:min(start+maxBatchSize, len(qs))]
+		req := &batchRequest{Queries: make([]*query, 0, len(batch))}
+		for _, q := range batch {
+			apiQuery, err := toAPIQuery(q)
+			if err != nil {
+				return nil, err
+			}
+			req.Queries = append(req.Queries, apiQuery)
+		}
+		var res batchResponse
+		if err := db.post(ctx, "/v1/querybatch", req, &res); err != nil {
+			return nil, err
+		}
+		if len(res.Results) != len(batch) {
+			return nil, fmt.Errorf("querybatch returned %d results for %d queries", len(res.Results), len(batch))
+		}
+		for i, r := range res.Results {
+			var resultIDs []string
+			if r.NextPageToken != "" {
+				// Rare for packages with lots of advisories: Get handles the paging.
+				vulns, err := db.Get(ctx, batch[i])
+				if err != nil {
+					return nil, err
+				}
+				for _, v := range vulns {
+					resultIDs = append(resultIDs, v.ID)
+				}
+			} else {
+				for _, v := range r.Vulns {
+					resultIDs = append(resultIDs, v.ID)
+				}
+			}
+			ids = append(ids, resultIDs)
+		}
+	}
+
+	cache := make(map[string]*advisorydb.Vulnerability)
+	results := make([][]*advisorydb.Vulnerability, 0, len(ids))
+	for _, resultIDs := range ids {
+		var vulns []*advisorydb.Vulnerability
+		for _, id := range resultIDs {
+			v, ok := cache[id]
+			if !ok {
+				var err error
+				if v, err = db.getVuln(ctx, id); err != nil {
+					return nil, err
+				}
+				cache[id] = v
+			}
+			vulns = append(vulns, v)
+		}
+		results = append(results, vulns)
+	}
+	return results, nil
+}
+
+// DataSource describes the OSV.dev API. There's no version as the data is
+// live.
+func (db *DB) DataSource() *plugin.DataSource {
+	return &plugin.DataSource{Name: dataSourceName, Location: db.url}
+}
+
+func (db *DB) getVuln(ctx context.Context, id string) (*advisorydb.Vulnerability, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, db.url+"/v1/vulns/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	var v advisorydb.Vulnerability
+	if err := db.do(req, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (db *DB) post(ctx context.Context, path string, body, res any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, db.url+path, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return db.do(req, res)
+}
+
+func (db *DB) do(req *http.Request, res any) error {
+	resp, err := db.httpc.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", req.Method, req.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: unexpected HTTP status %d", req.Method, req.URL, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
+		return fmt.Errorf("%s %s: decoding response: %w", req.Method, req.URL, err)
+	}
+	return nil
+}
+
+// toAPIQuery converts q into the format of the API.
+func toAPIQuery(q *advisorydb.Query) (*query, error) {
+	switch {
+	case q.PURL != nil && q.Commit == "":
+		// Qualifiers like the architecture aren't supported by the API.
+		p := purl.PackageURL{
+			Type:      q.PURL.Type,
+			Namespace: q.PURL.Namespace,
+			Name:      q.PURL.Name,
+			Version:   q.PURL.Version,
+		}
+		return &query{Package: &packageInfo{PURL: p.String()}}, nil
+	case q.PURL == nil && q.Commit != "":
+		return &query{Commit: q.Commit}, nil
+	default:
+		return nil, advisorydb.ErrInvalidQuery
+	}
+}
+
+var _ advisorydb.DB = &DB{}
@@ -0,0 +1,208 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/osvapi"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+var (
+	vulnA = &advisorydb.Vulnerability{ID: "GHSA-a", Summary: "first"}
+	vulnB = &advisorydb.Vulnerability{ID: "GHSA-b", Summary: "second"}
+	vulnC = &advisorydb.Vulnerability{ID: "GHSA-c", Summary: "third"}
+)
+
+// fakeAPI serves results for the PURL "pkg:npm/lodash@4.17.15" and the
+// commit "aaaa". Results for lodash are split into two pages.
+type fakeAPI struct {
+	t *testing.T
+	// Number of requests by path.
+	requests map[string]int
+}
+
+type apiQuery struct {
+	Commit  string `json:"commit"`
+	Package struct {
+		PURL string `json:"purl"`
+	} `json:"package"`
+	PageToken string `json:"page_token"`
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests[r.URL.Path]++
+	switch {
+	case r.URL.Path == "/v1/query":
+		var q apiQuery
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			f.t.Errorf("decoding query: %v", err)
+		}
+		writeJSON(w, f.queryResponse(q))
+	case r.URL.Path == "/v1/querybatch":
+		var req struct {
+			Queries []apiQuery `json:"queries"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			f.t.Errorf("decoding batch query: %v", err)
+		}
+		var results []map[string]any
+		for _, q := range req.Queries {
+			res := f.queryResponse(q)
+			var ids []map[string]string
+			for _, v := range res["vulns"].([]*advisorydb.Vulnerability) {
+				ids = append(ids, map[string]string{"id": v.ID})
+			}
+			results = append(results, map[string]any{"vulns": ids, "next_page_token": res["next_page_token"]})
+		}
+		writeJSON(w, map[string]any{"results": results})
+	case strings.HasPrefix(r.URL.Path, "/v1/vulns/"):
+		for _, v := range []*advisorydb.Vulnerability{vulnA, vulnB, vulnC} {
+			if r.URL.Path == "/v1/vulns/"+v.ID {
+				writeJSON(w, v)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeAPI) queryResponse(q apiQuery) map[string]any {
+	switch {
+	case q.Package.PURL == "pkg:npm/lodash@4.17.15" && q.PageToken == "":
+		return map[string]any{"vulns": []*advisorydb.Vulnerability{vulnA}, "next_page_token": "page2"}
+	case q.Package.PURL == "pkg:npm/lodash@4.17.15" && q.PageToken == "page2":
+		return map[string]any{"vulns": []*advisorydb.Vulnerability{vulnB}}
+	case q.Commit == "aaaa":
+		return map[string]any{"vulns": []*advisorydb.Vulnerability{vulnA, vulnC}}
+	}
+	return map[string]any{"vulns": []*advisorydb.Vulnerability{}}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func newDB(t *testing.T) (*osvapi.DB, *fakeAPI) {
+	t.Helper()
+	api := &fakeAPI{t: t, requests: make(map[string]int)}
+	s := httptest.NewServer(api)
+	t.Cleanup(s.Close)
+	return osvapi.New(osvapi.Config{URL: s.URL, HTTPC: s.Client()}), api
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   *advisorydb.Query
+		want    []*advisorydb.Vulnerability
+		wantErr bool
+	}{
+		{
+			name: "package with multiple pages",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{
+				Type:       purl.TypeNPM,
+				Name:       "lodash",
+				Version:    "4.17.15",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{"arch": "x64"}),
+			}},
+			want: []*advisorydb.Vulnerability{vulnA, vulnB},
+		},
+		{
+			name:  "commit",
+			query: &advisorydb.Query{Commit: "aaaa"},
+			want:  []*advisorydb.Vulnerability{vulnA, vulnC},
+		},
+		{
+			name:  "not affected",
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "left-pad", Version: "1.0.0"}},
+			want:  nil,
+		},
+		{
+			name:    "invalid query",
+			query:   &advisorydb.Query{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, _ := newDB(t)
+			got, err := db.Get(context.Background(), tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get(%+v) error: %v, want error: %t", tt.query, err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Get(%+v) (-want +got):\n%s", tt.query, diff)
+			}
+		})
+	}
+}
+
+func TestGetBatch(t *testing.T) {
+	db, api := newDB(t)
+	qs := []*advisorydb.Query{
+		{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "4.17.15"}},
+		{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "left-pad", Version: "1.0.0"}},
+		{Commit: "aaaa"},
+	}
+	want := [][]*advisorydb.Vulnerability{{vulnA, vulnB}, nil, {vulnA, vulnC}}
+
+	got, err := db.GetBatch(context.Background(), qs)
+	if err != nil {
+		t.Fatalf("GetBatch(): %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetBatch() (-want +got):\n%s", diff)
+	}
+	// Each advisory is only fetched once.
+	for _, v := range []*advisorydb.Vulnerability{vulnA, vulnB, vulnC} {
+		if n := api.requests["/v1/vulns/"+v.ID]; n != 1 {
+			t.Errorf("GetBatch() fetched %s %d times, want 1", v.ID, n)
+		}
+	}
+}
+
+func TestGetServerError(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+	db := osvapi.New(osvapi.Config{URL: s.URL, HTTPC: s.Client()})
+
+	if _, err := db.Get(context.Background(), &advisorydb.Query{Commit: "aaaa"}); err == nil {
+		t.Errorf("Get() with server error succeeded, want error")
+	}
+}
+
+func TestDataSource(t *testing.T) {
+	db := osvapi.New(osvapi.DefaultConfig())
+	want := &plugin.DataSource{Name: "osv.dev", Location: osvapi.DefaultURL}
+	if diff := cmp.Diff(want, db.DataSource()); diff != "" {
+		t.Errorf("DataSource() (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import (
+	"regexp"
+	"strings"
+)
+
+// alpineRe matches apk versions, e.g. "1.2.3b_rc1_p2-r4".
+var alpineRe = regexp.MustCompile(`^(\d+(?:\.\d+)*)([a-z]?)((?:_[a-z]+\d*)*)(?:-r(\d+))?$`)
+
+// alpineSuffixRe matches a single suffix of an apk version, e.g. "_rc1".
+var alpineSuffixRe = regexp.MustCompile(`_([a-z]+)(\d*)`)
+
+// alpineSuffixes are the suffixes of apk versions in their sort order. Pre
+// release suffixes sort before the release, which has no suffix.
+var alpineSuffixes = map[string]int{
+	"alpha": -4,
+	"beta":  -3,
+	"pre":   -2,
+	"rc":    -1,
+	"":      0,
+	"cvs":   1,
+	"svn":   2,
+	"git":   3,
+	"hg":    4,
+	"p":     5,
+}
+
+type alpineVersion struct {
+	numbers  []string
+	letter   string
+	suffixes [][2]string
+	revision string
+}
+
+func parseAlpine(v string) (*alpineVersion, bool) {
+	m := alpineRe.FindStringSubmatch(v)
+	if m == nil {
+		return nil, false
+	}
+	av := &alpineVersion{numbers: strings.Split(m[1], "."), letter: m[2], revision: m[4]}
+	for _, s := range alpineSuffixRe.FindAllStringSubmatch(m[3], -1) {
+		if _, ok := alpineSuffixes[s[1]]; !ok {
+			return nil, false
+		}
+		av.suffixes = append(av.suffixes, [2]string{s[1], s[2]})
+	}
+	return av, true
+}
+
+// compareAlpine compares versions like apk does. Versions that don't follow
+// the apk format are compared like Debian versions.
+func compareAlpine(a, b string) int {
+	av, aOK := parseAlpine(a)
+	bv, bOK := parseAlpine(b)
+	if !aOK || !bOK {
+		return compareDebianPart(a, b)
+	}
+
+	for i := 0; i < len(av.numbers) || i < len(bv.numbers); i++ {
+		if i >= len(av.numbers) {
+			return -1
+		}
+		if i >= len(bv.numbers) {
+			return 1
+		}
+		// Components after the first one with leading zeros are compared as
+		// decimal fractions.
+		if i > 0 && (strings.HasPrefix(av.numbers[i], "0") || strings.HasPrefix(bv.numbers[i], "0")) {
+			if c := strings.Compare(strings.TrimRight(av.numbers[i], "0"), strings.TrimRight(bv.numbers[i], "0")); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := compareInts(av.numbers[i], bv.numbers[i]); c != 0 {
+			return c
+		}
+	}
+
+	if c := strings.Compare(av.letter, bv.letter); c != 0 {
+		return c
+	}
+
+	for i := 0; i < len(av.suffixes) || i < len(bv.suffixes); i++ {
+		var as, bs [2]string
+		if i < len(av.suffixes) {
+			as = av.suffixes[i]
+		}
+		if i < len(bv.suffixes) {
+			bs = bv.suffixes[i]
+		}
+		if c := sign(alpineSuffixes[as[0]] - alpineSuffixes[bs[0]]); c != 0 {
+			return c
+		}
+		if c := compareInts(as[1], bs[1]); c != 0 {
+			return c
+		}
+	}
+
+	return compareInts(av.revision, bv.revision)
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import "strings"
+
+// compareDebian compares versions like dpkg does, see
+// https://www.debian.org/doc/debian-policy/ch-controlfields.html#version.
+func compareDebian(a, b string) int {
+	aEpoch, aUpstream, aRevision := parseDebian(a)
+	bEpoch, bUpstream, bRevision := parseDebian(b)
+	if c := compareInts(aEpoch, bEpoch); c != 0 {
+		return c
+	}
+	if c := compareDebianPart(aUpstream, bUpstream); c != 0 {
+		return c
+	}
+	return compareDebianPart(aRevision, bRevision)
+}
+
+// parseDebian splits a version into its epoch, upstream version and Debian
+// revision.
+func parseDebian(v string) (epoch, upstream, revision string) {
+	if e, rest, ok := strings.Cut(v, ":"); ok && isNumeric(e) {
+		epoch, v = e, rest
+	}
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		return epoch, v[:i], v[i+1:]
+	}
+	return epoch, v, ""
+}
+
+// compareDebianPart compares upstream versions or revisions. Non-digit parts
+// are compared character by character, with letters sorting before other
+// characters and "~" before everything, even the end of the part. Digit parts
+// are compared numerically.
+func compareDebianPart(a, b string) int {
+	for a != "" || b != "" {
+		aLen, bLen := nonDigitPrefixLen(a), nonDigitPrefixLen(b)
+		if c := compareDebianNonDigits(a[:aLen], b[:bLen]); c != 0 {
+			return c
+		}
+		a, b = a[aLen:], b[bLen:]
+
+		aLen, bLen = digitPrefixLen(a), digitPrefixLen(b)
+		if c := compareInts(a[:aLen], b[:bLen]); c != 0 {
+			return c
+		}
+		a, b = a[aLen:], b[bLen:]
+	}
+	return 0
+}
+
+func compareDebianNonDigits(a, b string) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		if c := sign(debianOrder(a, i) - debianOrder(b, i)); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// debianOrder returns the sort weight of s[i].
+func debianOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case c == '~':
+		return -1
+	case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z':
+		return int(c)
+	default:
+		return int(c) + 256
+	}
+}
+
+func nonDigitPrefixLen(s string) int {
+	i := 0
+	for i < len(s) && !isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+func digitPrefixLen(s string) int {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import "strings"
+
+// mavenQualifiers are the well-known Maven qualifiers in their sort order.
+// Other qualifiers sort after them in lexical order.
+var mavenQualifiers = map[string]int{
+	"alpha":     0,
+	"beta":      1,
+	"milestone": 2,
+	"rc":        3,
+	"snapshot":  4,
+	"":          5,
+	"sp":        6,
+}
+
+// mavenAliases maps alternative spellings to the well-known qualifiers.
+var mavenAliases = map[string]string{
+	"a":       "alpha",
+	"b":       "beta",
+	"m":       "milestone",
+	"cr":      "rc",
+	"ga":      "",
+	"final":   "",
+	"release": "",
+}
+
+// parseMaven splits a Maven version into its numeric and qualifier items,
+// e.g. "1.0-RC1" into "1", "0", "rc" and "1". Trailing items that are
+// equivalent to a release are dropped, so that 1.0 == 1 == 1-final.
+func parseMaven(v string) []string {
+	var items []string
+	for _, token := range strings.FieldsFunc(strings.ToLower(v), func(r rune) bool { return r == '.' || r == '-' || r == '_' }) {
+		parts := splitDigits(token)
+		for i, p := range parts {
+			if alias, ok := mavenAliases[p]; ok && (len(p) > 1 || i+1 < len(parts)) {
+				// The single-letter aliases only apply when followed by a number,
+				// e.g. "1.0-a1".
+				p = alias
+			}
+			items = append(items, p)
+		}
+	}
+	for len(items) > 0 {
+		last := items[len(items)-1]
+		if last != "" && strings.Trim(last, "0") != "" {
+			break
+		}
+		items = items[:len(items)-1]
+	}
+	return items
+}
+
+// compareMaven compares versions similar to Maven's ComparableVersion.
+// Numeric items sort after qualifiers, e.g. 1.0.1 > 1.0-sp.
+func compareMaven(a, b string) int {
+	ai, bi := parseMaven(a), parseMaven(b)
+	for i := 0; i < len(ai) || i < len(bi); i++ {
+		var x, y string
+		if i < len(ai) {
+			x = ai[i]
+		}
+		if i < len(bi) {
+			y = bi[i]
+		}
+		if c := compareMavenItems(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareMavenItems(x, y string) int {
+	xNum, yNum := isNumeric(x), isNumeric(y)
+	switch {
+	case xNum && yNum:
+		return compareInts(x, y)
+	case xNum:
+		// A missing item is equivalent to 0 when compared to a number.
+		if y == "" {
+			return compareInts(x, "0")
+		}
+		return 1
+	case yNum:
+		if x == "" {
+			return compareInts("0", y)
+		}
+		return -1
+	}
+	xRank, xKnown := mavenQualifiers[x]
+	yRank, yKnown := mavenQualifiers[y]
+	switch {
+	case xKnown && yKnown:
+		return sign(xRank - yRank)
+	case xKnown:
+		return -1
+	case yKnown:
+		return 1
+	}
+	return strings.Compare(x, y)
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import (
+	"regexp"
+	"strings"
+)
+
+// pypiRe matches PEP 440 versions, see
+// https://packaging.python.org/en/latest/specifications/version-specifiers/.
+var pypiRe = regexp.MustCompile(`^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|b|c|rc|alpha|beta|pre|preview)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?(dev)[-_.]?(\d*))?` +
+	`(?:\+[a-z0-9]+(?:[-_.][a-z0-9]+)*)?$`)
+
+// pypiPreReleases are the pre-release phases in their sort order.
+var pypiPreReleases = map[string]int{
+	"a": 0, "alpha": 0,
+	"b": 1, "beta": 1,
+	"c": 2, "rc": 2, "pre": 2, "preview": 2,
+}
+
+// pypiVersion is a parsed PEP 440 version. The pre, post and dev fields are
+// sort keys: missing parts sort as noted on the fields.
+type pypiVersion struct {
+	epoch   string
+	release []string
+	// hasPre is false for versions without a pre-release, which sort after
+	// all pre-releases unless the version is a dev release.
+	hasPre    bool
+	prePhase  int
+	preNumber string
+	// hasPost is false for versions without a post-release, which sort before
+	// all post-releases.
+	hasPost    bool
+	postNumber string
+	// hasDev is false for versions without a dev release, which sort after
+	// all dev releases.
+	hasDev    bool
+	devNumber string
+}
+
+func parsePyPI(v string) (*pypiVersion, bool) {
+	m := pypiRe.FindStringSubmatch(strings.ToLower(strings.TrimSpace(v)))
+	if m == nil {
+		return nil, false
+	}
+	pv := &pypiVersion{epoch: m[1], release: strings.Split(m[2], ".")}
+	// Trailing zeros don't change the release, e.g. 1.0 == 1.0.0.
+	for len(pv.release) > 1 && strings.Trim(pv.release[len(pv.release)-1], "0") == "" {
+		pv.release = pv.release[:len(pv.release)-1]
+	}
+	if m[3] != "" {
+		pv.hasPre, pv.prePhase, pv.preNumber = true, pypiPreReleases[m[3]], m[4]
+	}
+	switch {
+	case m[5] != "":
+		pv.hasPost, pv.postNumber = true, m[5]
+	case m[6] != "":
+		pv.hasPost, pv.postNumber = true, m[7]
+	}
+	if m[8] != "" {
+		pv.hasDev, pv.devNumber = true, m[9]
+	}
+	return pv, true
+}
+
+// comparePyPI compares PEP 440 versions. Local version labels are ignored.
+// Versions that don't follow PEP 440 sort before all valid versions and are
+// compared as strings among each other.
+func comparePyPI(a, b string) int {
+	av, aOK := parsePyPI(a)
+	bv, bOK := parsePyPI(b)
+	switch {
+	case !aOK && !bOK:
+		return strings.Compare(a, b)
+	case !aOK:
+		return -1
+	case !bOK:
+		return 1
+	}
+
+	if c := compareInts(av.epoch, bv.epoch); c != 0 {
+		return c
+	}
+	for i := 0; i < len(av.release) || i < len(bv.release); i++ {
+		var ar, br string
+		if i < len(av.release) {
+			ar = av.release[i]
+		}
+		if i < len(bv.release) {
+			br = bv.release[i]
+		}
+		if c := compareInts(ar, br); c != 0 {
+			return c
+		}
+	}
+	if c := av.preKey() - bv.preKey(); c != 0 {
+		return sign(c)
+	}
+	if av.hasPre {
+		if c := compareInts(av.preNumber, bv.preNumber); c != 0 {
+			return c
+		}
+	}
+	if c := compareOptional(av.hasPost, av.postNumber, bv.hasPost, bv.postNumber, -1); c != 0 {
+		return c
+	}
+	return compareOptional(av.hasDev, av.devNumber, bv.hasDev, bv.devNumber, 1)
+}
+
+// preKey returns the sort key of the pre-release phase. A dev release of the
+// final version, e.g. 1.0.dev1, sorts before all of its pre-releases.
+func (v *pypiVersion) preKey() int {
+	switch {
+	case v.hasPre:
+		return v.prePhase
+	case !v.hasPost && v.hasDev:
+		return -1
+	default:
+		return len(pypiPreReleases)
+	}
+}
+
+// compareOptional compares optional numbered parts of two versions. missing
+// is the result when only b has the part.
+func compareOptional(aHas bool, a string, bHas bool, b string, missing int) int {
+	switch {
+	case aHas && bHas:
+		return compareInts(a, b)
+	case bHas:
+		return missing
+	case aHas:
+		return -missing
+	}
+	return 0
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import "strings"
+
+// parseRubyGems splits a version into its segments like Gem::Version, e.g.
+// "1.0.a2" into "1", "0", "a" and "2". Trailing zeros of the release part
+// are dropped, so that 1.0 == 1.
+func parseRubyGems(v string) []string {
+	var segments []string
+	for _, s := range strings.Split(strings.TrimSpace(v), ".") {
+		segments = append(segments, splitDigits(s)...)
+	}
+	release := len(segments)
+	for i, s := range segments {
+		if !isNumeric(s) {
+			release = i
+			break
+		}
+	}
+	end := release
+	for end > 1 && strings.Trim(segments[end-1], "0") == "" {
+		end--
+	}
+	return append(segments[:end:end], segments[release:]...)
+}
+
+// compareRubyGems compares versions like Gem::Version. Segments with letters
+// mark pre-releases and sort before numbers.
+func compareRubyGems(a, b string) int {
+	as, bs := parseRubyGems(a), parseRubyGems(b)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		x, y := "0", "0"
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		xNum, yNum := isNumeric(x), isNumeric(y)
+		var c int
+		switch {
+		case xNum && yNum:
+			c = compareInts(x, y)
+		case xNum:
+			c = 1
+		case yNum:
+			c = -1
+		default:
+			c = strings.Compare(x, y)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package semantic compares package versions according to the versioning
+// rules of their OSV ecosystem, which is needed to evaluate the ECOSYSTEM
+// ranges of advisories.
+package semantic
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnsupportedEcosystem is returned for ecosystems whose versions can't be
+// compared.
+var ErrUnsupportedEcosystem = errors.New("unsupported ecosystem")
+
+// comparators compare two versions of an ecosystem, returning -1, 0 or +1.
+var comparators = map[string]func(a, b string) int{
+	"Alpine":    compareAlpine,
+	"Debian":    compareDebian,
+	"Maven":     compareMaven,
+	"PyPI":      comparePyPI,
+	"RubyGems":  compareRubyGems,
+	"crates.io": compareSemVer,
+	"Go":        compareSemVer,
+	"Hex":       compareSemVer,
+	"npm":       compareSemVer,
+	"NuGet":     compareSemVer,
+	"Pub":       compareSemVer,
+	"SwiftURL":  compareSemVer,
+}
+
+// Supported returns true if versions of ecosystem can be compared. The
+// release of OS ecosystems, e.g. "12" in "Debian:12", is ignored.
+func Supported(ecosystem string) bool {
+	_, ok := comparators[baseEcosystem(ecosystem)]
+	return ok
+}
+
+// Compare returns -1 if a is lower than b, +1 if a is higher than b and 0 if
+// they're equal according to the rules of ecosystem.
+func Compare(ecosystem, a, b string) (int, error) {
+	cmp, ok := comparators[baseEcosystem(ecosystem)]
+	if !ok {
+		return 0, ErrUnsupportedEcosystem
+	}
+	return cmp(a, b), nil
+}
+
+func baseEcosystem(ecosystem string) string {
+	base, _, _ := strings.Cut(ecosystem, ":")
+	return base
+}
+
+// compareInts compares two strings of decimal digits of any length by their
+// numeric value. Empty strings are 0.
+func compareInts(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return sign(len(a) - len(b))
+	}
+	return strings.Compare(a, b)
+}
+
+func sign(i int) int {
+	switch {
+	case i < 0:
+		return -1
+	case i > 0:
+		return 1
+	}
+	return 0
+}
+
+func isDigit(c byte) bool { return '0' <= c && c <= '9' }
+
+// isNumeric returns true if s is a non-empty string of decimal digits.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitDigits splits s into alternating runs of digits and non-digits, e.g.
+// "1a22" into "1", "a" and "22".
+func splitDigits(s string) []string {
+	var parts []string
+	start := 0
+	for i := 1; i <= len(s); i++ {
+		if i == len(s) || isDigit(s[i]) != isDigit(s[i-1]) {
+			parts = append(parts, s[start:i])
+			start = i
+		}
+	}
+	return parts
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/osv-scalibr/advisorydb/semantic"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		ecosystem string
+		a         string
+		b         string
+		want      int
+	}{
+		// Debian.
+		{ecosystem: "Debian:12", a: "2.36-9+deb12u4", b: "2.36-9+deb12u4", want: 0},
+		{ecosystem: "Debian:12", a: "2.36-9+deb12u4", b: "2.36-9+deb12u7", want: -1},
+		{ecosystem: "Debian", a: "1:1.0-1", b: "2.0-1", want: 1},
+		{ecosystem: "Debian", a: "1.0~rc1-1", b: "1.0-1", want: -1},
+		{ecosystem: "Debian", a: "1.0-1", b: "1.0+b1-1", want: -1},
+		{ecosystem: "Debian", a: "1.10", b: "1.9", want: 1},
+		{ecosystem: "Debian", a: "3.0.13-1~deb12u1", b: "3.0.13-1", want: -1},
+		{ecosystem: "Debian", a: "1.0a", b: "1.0+", want: -1},
+		// Alpine.
+		{ecosystem: "Alpine:v3.20", a: "1.2.5-r0", b: "1.2.5-r1", want: -1},
+		{ecosystem: "Alpine", a: "2.45.2-r0", b: "2.45.10-r0", want: -1},
+		{ecosystem: "Alpine", a: "1.0_rc1-r0", b: "1.0-r0", want: -1},
+		{ecosystem: "Alpine", a: "1.0_p1-r0", b: "1.0-r0", want: 1},
+		{ecosystem: "Alpine", a: "1.0a-r0", b: "1.0-r0", want: 1},
+		{ecosystem: "Alpine", a: "1.0_alpha1", b: "1.0_beta", want: -1},
+		{ecosystem: "Alpine", a: "1.2", b: "1.2.0", want: -1},
+		{ecosystem: "Alpine", a: "3.0.13-r1", b: "3.0.13-r1", want: 0},
+		// PyPI.
+		{ecosystem: "PyPI", a: "2.28.1", b: "2.31.0", want: -1},
+		{ecosystem: "PyPI", a: "1.0", b: "1.0.0", want: 0},
+		{ecosystem: "PyPI", a: "1!0.1", b: "2.0", want: 1},
+		{ecosystem: "PyPI", a: "1.0.dev1", b: "1.0a1", want: -1},
+		{ecosystem: "PyPI", a: "1.0a1", b: "1.0b1", want: -1},
+		{ecosystem: "PyPI", a: "1.0rc1", b: "1.0", want: -1},
+		{ecosystem: "PyPI", a: "1.0", b: "1.0.post1", want: -1},
+		{ecosystem: "PyPI", a: "1.0.post1.dev1", b: "1.0.post1", want: -1},
+		{ecosystem: "PyPI", a: "1.0-1", b: "1.0.post1", want: 0},
+		{ecosystem: "PyPI", a: "1.0+local.1", b: "1.0", want: 0},
+		{ecosystem: "PyPI", a: "not a version", b: "0.1", want: -1},
+		// Maven.
+		{ecosystem: "Maven", a: "1.0", b: "1", want: 0},
+		{ecosystem: "Maven", a: "1.0-SNAPSHOT", b: "1.0", want: -1},
+		{ecosystem: "Maven", a: "1.0-alpha1", b: "1.0-beta1", want: -1},
+		{ecosystem: "Maven", a: "1.0-a1", b: "1.0-alpha1", want: 0},
+		{ecosystem: "Maven", a: "1.0-RC1", b: "1.0-cr1", want: 0},
+		{ecosystem: "Maven", a: "1.0-final", b: "1.0", want: 0},
+		{ecosystem: "Maven", a: "1.0-sp1", b: "1.0", want: 1},
+		{ecosystem: "Maven", a: "1.0.1", b: "1.0-sp1", want: 1},
+		{ecosystem: "Maven", a: "2.17.0", b: "2.9.1", want: 1},
+		// RubyGems.
+		{ecosystem: "RubyGems", a: "1.0", b: "1", want: 0},
+		{ecosystem: "RubyGems", a: "1.0.a1", b: "1.0", want: -1},
+		{ecosystem: "RubyGems", a: "1.0.rc1", b: "1.0.beta2", want: 1},
+		{ecosystem: "RubyGems", a: "7.0.8.1", b: "7.0.8", want: 1},
+		// SemVer based ecosystems.
+		{ecosystem: "npm", a: "4.17.15", b: "4.17.21", want: -1},
+		{ecosystem: "npm", a: "1.0.0-alpha", b: "1.0.0-alpha.1", want: -1},
+		{ecosystem: "npm", a: "1.0.0-alpha.1", b: "1.0.0-alpha.beta", want: -1},
+		{ecosystem: "npm", a: "1.0.0-rc.1", b: "1.0.0", want: -1},
+		{ecosystem: "npm", a: "1.0.0+build.1", b: "1.0.0", want: 0},
+		{ecosystem: "Go", a: "v0.17.0", b: "0.9.0", want: 1},
+		{ecosystem: "crates.io", a: "0.10.0", b: "0.10", want: 0},
+		{ecosystem: "NuGet", a: "13.0.1", b: "13.0.1.1", want: -1},
+	}
+	for _, tc := range tests {
+		got, err := semantic.Compare(tc.ecosystem, tc.a, tc.b)
+		if err != nil {
+			t.Errorf("Compare(%q, %q, %q): %v", tc.ecosystem, tc.a, tc.b, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Compare(%q, %q, %q) = %d, want %d", tc.ecosystem, tc.a, tc.b, got, tc.want)
+		}
+		// Comparisons must be antisymmetric.
+		if got, _ := semantic.Compare(tc.ecosystem, tc.b, tc.a); got != -tc.want {
+			t.Errorf("Compare(%q, %q, %q) = %d, want %d", tc.ecosystem, tc.b, tc.a, got, -tc.want)
+		}
+	}
+}
+
+func TestCompare_UnsupportedEcosystem(t *testing.T) {
+	if _, err := semantic.Compare("CRAN", "1.0", "1.1"); !errors.Is(err, semantic.ErrUnsupportedEcosystem) {
+		t.Errorf("Compare(CRAN) error: got %v, want %v", err, semantic.ErrUnsupportedEcosystem)
+	}
+	if semantic.Supported("CRAN") {
+		t.Error("Supported(CRAN) = true, want false")
+	}
+	if !semantic.Supported("Debian:12") {
+		t.Error("Supported(Debian:12) = false, want true")
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import "strings"
+
+// compareSemVer compares versions by the rules of https://semver.org, which
+// most language ecosystems follow. To also handle versions that aren't
+// strictly SemVer, a leading "v" is ignored, the release may have any number
+// of components with missing ones being 0, and non-numeric components are
+// compared as strings.
+func compareSemVer(a, b string) int {
+	aRelease, aPre := splitSemVer(a)
+	bRelease, bPre := splitSemVer(b)
+	for i := 0; i < len(aRelease) || i < len(bRelease); i++ {
+		x, y := "0", "0"
+		if i < len(aRelease) {
+			x = aRelease[i]
+		}
+		if i < len(bRelease) {
+			y = bRelease[i]
+		}
+		if c := compareSemVerIdentifiers(x, y); c != 0 {
+			return c
+		}
+	}
+
+	// A version without pre-release sorts after its pre-releases.
+	switch {
+	case aPre == nil && bPre == nil:
+		return 0
+	case aPre == nil:
+		return 1
+	case bPre == nil:
+		return -1
+	}
+	for i := 0; i < len(aPre) && i < len(bPre); i++ {
+		if c := compareSemVerIdentifiers(aPre[i], bPre[i]); c != 0 {
+			return c
+		}
+	}
+	return sign(len(aPre) - len(bPre))
+}
+
+// splitSemVer returns the release components and pre-release identifiers of
+// v. Build metadata is ignored.
+func splitSemVer(v string) (release, pre []string) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	v, _, _ = strings.Cut(v, "+")
+	v, p, hasPre := strings.Cut(v, "-")
+	if hasPre {
+		pre = strings.Split(p, ".")
+	}
+	return strings.Split(v, "."), pre
+}
+
+// compareSemVerIdentifiers compares numeric identifiers numerically and sorts
+// them before non-numeric ones, which are compared as strings.
+func compareSemVerIdentifiers(x, y string) int {
+	xNum, yNum := isNumeric(x), isNumeric(y)
+	switch {
+	case xNum && yNum:
+		return compareInts(x, y)
+	case xNum:
+		return -1
+	case yNum:
+		return 1
+	}
+	return strings.Compare(x, y)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlitedb loads an advisory database from a SQLite export of OSV
+// advisories. A single export file is easier to copy to air-gapped hosts than
+// a mirror of per-ecosystem bundles. The export contains the table
+//
+//	CREATE TABLE vulnerabilities (id TEXT PRIMARY KEY, json TEXT NOT NULL)
+//
+// with one advisory in the OSV JSON format per row.
+package sqlitedb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/memorydb"
+	"github.com/google/osv-scalibr/plugin"
+
+	// SQLite driver needed for reading the export.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// dataSourceName is the name of the data source in scan provenance. It's the
+// same as for the API since exports are snapshots of the same data.
+const dataSourceName = "osv.dev"
+
+// Load reads the advisories from the SQLite export at p into memory.
+func Load(p string) (*memorydb.DB, error) {
+	// Opening a missing file would create an empty database.
+	if _, err := os.Stat(p); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite3", "file:"+(&url.URL{Path: p}).EscapedPath()+"?mode=ro")
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT id, json FROM vulnerabilities")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", p, err)
+	}
+	defer rows.Close()
+
+	var vulns []*advisorydb.Vulnerability
+	var modified time.Time
+	for rows.Next() {
+		var id, content string
+		if err := rows.Scan(&id, &content); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", p, err)
+		}
+		var v advisorydb.Vulnerability
+		if err := json.Unmarshal([]byte(content), &v); err != nil {
+			return nil, fmt.Errorf("%s: %w", id, err)
+		}
+		if v.Modified.After(modified) {
+			modified = v.Modified
+		}
+		vulns = append(vulns, &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", p, err)
+	}
+
+	// The most recent modification is used as the version of the snapshot.
+	ds := &plugin.DataSource{Name: dataSourceName, Location: p}
+	if !modified.IsZero() {
+		ds.Version = modified.UTC().Format(time.RFC3339)
+	}
+	return memorydb.New(ds, vulns), nil
+}
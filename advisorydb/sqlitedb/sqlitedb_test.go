@@ -0,0 +1,146 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlitedb_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/sqlitedb"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+var (
+	npmVuln = &advisorydb.Vulnerability{
+		ID:       "GHSA-p6mc-m468-83gw",
+		Modified: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "npm", Name: "lodash"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeSemVer,
+				Events: []advisorydb.Event{{Introduced: "0"}, {Fixed: "4.17.19"}},
+			}},
+		}},
+	}
+	debianVuln = &advisorydb.Vulnerability{
+		ID:       "DSA-5000-1",
+		Modified: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "Debian:12", Name: "openssl"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "0"}, {Fixed: "3.0.15-1~deb12u1"}},
+			}},
+		}},
+	}
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	export := filepath.Join(dir, "osv.db")
+	writeExport(t, export, npmVuln, debianVuln)
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("export"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		path      string
+		query     *advisorydb.Query
+		want      []*advisorydb.Vulnerability
+		wantDS    *plugin.DataSource
+		wantError bool
+	}{
+		{
+			name:   "semver range",
+			path:   export,
+			query:  &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "4.17.15"}},
+			want:   []*advisorydb.Vulnerability{npmVuln},
+			wantDS: &plugin.DataSource{Name: "osv.dev", Location: export, Version: "2024-03-01T10:00:00Z"},
+		},
+		{
+			name:   "ecosystem range",
+			path:   export,
+			query:  &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeDebian, Namespace: "debian", Name: "openssl", Version: "3.0.13-1~deb12u1"}},
+			want:   []*advisorydb.Vulnerability{debianVuln},
+			wantDS: &plugin.DataSource{Name: "osv.dev", Location: export, Version: "2024-03-01T10:00:00Z"},
+		},
+		{
+			name:      "missing export",
+			path:      filepath.Join(dir, "missing.db"),
+			wantError: true,
+		},
+		{
+			name:      "not a SQLite file",
+			path:      filepath.Join(dir, "README"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := sqlitedb.Load(tt.path)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Load(%s) error: %v, want error: %t", tt.path, err, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if diff := cmp.Diff(tt.wantDS, db.DataSource()); diff != "" {
+				t.Errorf("Load(%s).DataSource() (-want +got):\n%s", tt.path, diff)
+			}
+			got, err := db.Get(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("Get(%+v): %v", tt.query, err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Get(%+v) (-want +got):\n%s", tt.query, diff)
+			}
+		})
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "missing.db")); !os.IsNotExist(err) {
+		t.Errorf("Load() of a missing export created it: %v", err)
+	}
+}
+
+func writeExport(t *testing.T, path string, vulns ...*advisorydb.Vulnerability) {
+	t.Helper()
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("CREATE TABLE vulnerabilities (id TEXT PRIMARY KEY, json TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vulns {
+		content, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec("INSERT INTO vulnerabilities (id, json) VALUES (?, ?)", v.ID, string(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
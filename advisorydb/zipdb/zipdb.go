@@ -0,0 +1,126 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zipdb loads an advisory database from offline bundles of OSV
+// advisories, e.g. the per-ecosystem all.zip files published at
+// https://osv-vulnerabilities.storage.googleapis.com, for scanning in
+// environments without access to the OSV.dev API.
+package zipdb
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/memorydb"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// dataSourceName is the name of the data source in scan provenance. It's the
+// same as for the API since bundles are snapshots of the same data.
+const dataSourceName = "osv.dev"
+
+// Load reads the advisories from the zip bundle at p into memory. If p is a
+// directory, all zip files below it are loaded, e.g. a mirror of several
+// ecosystems with the layout <ecosystem>/all.zip.
+//
+// Only bundles for the ecosystems that are scanned should be mirrored as all
+// advisories are kept in memory.
+func Load(p string) (*memorydb.DB, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	var bundles []string
+	if info.IsDir() {
+		err := filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.EqualFold(filepath.Ext(path), ".zip") {
+				bundles = append(bundles, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		bundles = []string{p}
+	}
+
+	var vulns []*advisorydb.Vulnerability
+	var modified time.Time
+	for _, b := range bundles {
+		bundleVulns, err := loadBundle(b)
+		if err != nil {
+			return nil, fmt.Errorf("loading %s: %w", b, err)
+		}
+		for _, v := range bundleVulns {
+			if v.Modified.After(modified) {
+				modified = v.Modified
+			}
+		}
+		vulns = append(vulns, bundleVulns...)
+	}
+
+	// The most recent modification is used as the version of the snapshot.
+	ds := &plugin.DataSource{Name: dataSourceName, Location: p}
+	if !modified.IsZero() {
+		ds.Version = modified.UTC().Format(time.RFC3339)
+	}
+	return memorydb.New(ds, vulns), nil
+}
+
+// loadBundle reads the advisories from the JSON files in the zip file at p.
+func loadBundle(p string) ([]*advisorydb.Vulnerability, error) {
+	r, err := zip.OpenReader(p)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var vulns []*advisorydb.Vulnerability
+	for _, f := range r.File {
+		if f.FileInfo().IsDir() || path.Ext(f.Name) != ".json" {
+			continue
+		}
+		v, err := readVuln(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+		vulns = append(vulns, v)
+	}
+	return vulns, nil
+}
+
+func readVuln(f *zip.File) (*advisorydb.Vulnerability, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	var v advisorydb.Vulnerability
+	if err := json.NewDecoder(rc).Decode(&v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
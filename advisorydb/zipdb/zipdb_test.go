@@ -0,0 +1,144 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zipdb_test
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/zipdb"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+var (
+	npmVuln = &advisorydb.Vulnerability{
+		ID:       "GHSA-p6mc-m468-83gw",
+		Modified: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+		Affected: []advisorydb.Affected{{
+			Package:  advisorydb.Package{Ecosystem: "npm", Name: "lodash"},
+			Versions: []string{"4.17.15"},
+		}},
+	}
+	pypiVuln = &advisorydb.Vulnerability{
+		ID:       "PYSEC-2021-1",
+		Modified: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		Affected: []advisorydb.Affected{{
+			Package:  advisorydb.Package{Ecosystem: "PyPI", Name: "flask-cors"},
+			Versions: []string{"3.0.8"},
+		}},
+	}
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeBundle(t, filepath.Join(dir, "npm", "all.zip"), npmVuln)
+	writeBundle(t, filepath.Join(dir, "PyPI", "all.zip"), pypiVuln)
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("mirror"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		path      string
+		query     *advisorydb.Query
+		want      []*advisorydb.Vulnerability
+		wantDS    *plugin.DataSource
+		wantError bool
+	}{
+		{
+			name:   "directory",
+			path:   dir,
+			query:  &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypePyPi, Name: "flask-cors", Version: "3.0.8"}},
+			want:   []*advisorydb.Vulnerability{pypiVuln},
+			wantDS: &plugin.DataSource{Name: "osv.dev", Location: dir, Version: "2024-03-01T10:00:00Z"},
+		},
+		{
+			name:  "single bundle",
+			path:  filepath.Join(dir, "npm", "all.zip"),
+			query: &advisorydb.Query{PURL: &purl.PackageURL{Type: purl.TypeNPM, Name: "lodash", Version: "4.17.15"}},
+			want:  []*advisorydb.Vulnerability{npmVuln},
+			wantDS: &plugin.DataSource{
+				Name:     "osv.dev",
+				Location: filepath.Join(dir, "npm", "all.zip"),
+				Version:  "2024-02-01T10:00:00Z",
+			},
+		},
+		{
+			name:      "missing bundle",
+			path:      filepath.Join(dir, "Go", "all.zip"),
+			wantError: true,
+		},
+		{
+			name:      "not a zip file",
+			path:      filepath.Join(dir, "README"),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, err := zipdb.Load(tt.path)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Load(%s) error: %v, want error: %t", tt.path, err, tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+			if diff := cmp.Diff(tt.wantDS, db.DataSource()); diff != "" {
+				t.Errorf("Load(%s).DataSource() (-want +got):\n%s", tt.path, diff)
+			}
+			got, err := db.Get(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("Get(%+v): %v", tt.query, err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Get(%+v) (-want +got):\n%s", tt.query, diff)
+			}
+		})
+	}
+}
+
+func writeBundle(t *testing.T, path string, vulns ...*advisorydb.Vulnerability) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for _, v := range vulns {
+		fw, err := w.Create(v.ID + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.NewEncoder(fw).Encode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aggregate summarizes the scan results of a fleet of machines into
+// fleet-level statistics, e.g. for dashboards that would otherwise re-parse
+// every full scan result.
+package aggregate
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// UnknownOS is reported for scans without OS packages to identify the OS by.
+const UnknownOS = "unknown"
+
+// Config for aggregating scan results.
+type Config struct {
+	// Number of vulnerable packages to report. If 0, all are reported.
+	TopPackages int
+}
+
+// DefaultConfig returns the default configuration for aggregating scan
+// results.
+func DefaultConfig() Config {
+	return Config{TopPackages: 20}
+}
+
+// FleetStats are aggregate statistics of the scan results of a fleet.
+type FleetStats struct {
+	// Number of aggregated scans.
+	Scans int `json:"scans"`
+	// Number of scans that failed.
+	FailedScans int `json:"failed_scans"`
+	// Number of scans with at least one vulnerability.
+	VulnerableScans int `json:"vulnerable_scans"`
+	// The packages affected by vulnerabilities on the most scans, most
+	// affected first.
+	TopVulnerablePackages []*VulnerablePackage `json:"top_vulnerable_packages,omitempty"`
+	// Number of secrets found per secret type, e.g. "gcpsak.GCPSAK".
+	SecretTypes []*Count `json:"secret_types,omitempty"`
+	// Number of packages found per ecosystem, e.g. "PyPI" or "Debian".
+	Ecosystems []*Count `json:"ecosystems,omitempty"`
+	// Breakdown of the scans by the OS of the scanned machine.
+	OS []*OSStats `json:"os,omitempty"`
+}
+
+// Count is the number of occurrences of a value, e.g. of an ecosystem.
+type Count struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// VulnerablePackage is a package version affected by vulnerabilities.
+type VulnerablePackage struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Ecosystem string `json:"ecosystem"`
+	// Number of scans the vulnerable package was found on.
+	Scans int `json:"scans"`
+	// IDs of the vulnerabilities affecting the package, e.g. "CVE-2024-1234".
+	Advisories []string `json:"advisories,omitempty"`
+}
+
+// OSStats are the statistics of the scans of machines running the same OS.
+type OSStats struct {
+	// The OS ID and version ID, e.g. "debian 12", or UnknownOS.
+	OS              string `json:"os"`
+	Scans           int    `json:"scans"`
+	VulnerableScans int    `json:"vulnerable_scans"`
+	Packages        int    `json:"packages"`
+	Secrets         int    `json:"secrets"`
+}
+
+// Aggregate computes the fleet statistics of the scan results. Each result is
+// expected to be the scan of a different machine.
+func Aggregate(results []*scalibr.ScanResult, cfg Config) *FleetStats {
+	s := &FleetStats{}
+	vulnPkgs := map[packageKey]*VulnerablePackage{}
+	secretTypes := map[string]int{}
+	ecosystems := map[string]int{}
+	osStats := map[string]*OSStats{}
+
+	for _, r := range results {
+		s.Scans++
+		if r.Status != nil && r.Status.Status == plugin.ScanStatusFailed {
+			s.FailedScans++
+		}
+
+		os := osName(r.Inventories)
+		st, ok := osStats[os]
+		if !ok {
+			st = &OSStats{OS: os}
+			osStats[os] = st
+		}
+		st.Scans++
+		st.Packages += len(r.Inventories)
+		st.Secrets += len(r.Secrets)
+
+		for _, i := range r.Inventories {
+			if eco := baseEcosystem(ecosystem(i)); eco != "" {
+				ecosystems[eco]++
+			}
+		}
+		for _, sec := range r.Secrets {
+			secretTypes[fmt.Sprintf("%T", sec.Secret)]++
+		}
+
+		// Count each package once per scan even if several findings affect it.
+		seen := map[packageKey]bool{}
+		vulnerable := false
+		for _, f := range r.Findings {
+			if !isVuln(f) {
+				continue
+			}
+			vulnerable = true
+			if f.Target == nil || f.Target.Inventory == nil {
+				continue
+			}
+			i := f.Target.Inventory
+			k := packageKey{name: i.Name, version: i.Version, ecosystem: ecosystem(i)}
+			p, ok := vulnPkgs[k]
+			if !ok {
+				p = &VulnerablePackage{Name: k.name, Version: k.version, Ecosystem: k.ecosystem}
+				vulnPkgs[k] = p
+			}
+			if !seen[k] {
+				p.Scans++
+				seen[k] = true
+			}
+			if id := advisoryID(f.Adv); id != "" && !slices.Contains(p.Advisories, id) {
+				p.Advisories = append(p.Advisories, id)
+			}
+		}
+		if vulnerable {
+			s.VulnerableScans++
+			st.VulnerableScans++
+		}
+	}
+
+	for _, p := range vulnPkgs {
+		slices.Sort(p.Advisories)
+		s.TopVulnerablePackages = append(s.TopVulnerablePackages, p)
+	}
+	slices.SortFunc(s.TopVulnerablePackages, func(a, b *VulnerablePackage) int {
+		return cmp.Or(
+			cmp.Compare(b.Scans, a.Scans),
+			cmp.Compare(len(b.Advisories), len(a.Advisories)),
+			cmp.Compare(a.Ecosystem, b.Ecosystem),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Version, b.Version),
+		)
+	})
+	if cfg.TopPackages > 0 && len(s.TopVulnerablePackages) > cfg.TopPackages {
+		s.TopVulnerablePackages = s.TopVulnerablePackages[:cfg.TopPackages]
+	}
+	s.SecretTypes = counts(secretTypes)
+	s.Ecosystems = counts(ecosystems)
+	for _, st := range osStats {
+		s.OS = append(s.OS, st)
+	}
+	slices.SortFunc(s.OS, func(a, b *OSStats) int {
+		return cmp.Or(cmp.Compare(b.Scans, a.Scans), cmp.Compare(a.OS, b.OS))
+	})
+	return s
+}
+
+// packageKey identifies a package version across scans.
+type packageKey struct {
+	name, version, ecosystem string
+}
+
+func isVuln(f *detector.Finding) bool {
+	return f.Adv != nil && f.Adv.Type == detector.TypeVulnerability
+}
+
+func advisoryID(a *detector.Advisory) string {
+	if a == nil || a.ID == nil {
+		return ""
+	}
+	return a.ID.Reference
+}
+
+// ecosystem returns the ecosystem of the inventory, or an empty string if
+// it's not known, e.g. for results read back from a proto without the
+// extractors.
+func ecosystem(i *extractor.Inventory) string {
+	if i.Extractor == nil {
+		return ""
+	}
+	return i.Ecosystem()
+}
+
+// baseEcosystem strips the release from an ecosystem, e.g. "Debian:12"
+// becomes "Debian".
+func baseEcosystem(eco string) string {
+	base, _, _ := strings.Cut(eco, ":")
+	return base
+}
+
+// osName returns the OS of a scanned machine from the OSID and OSVersionID
+// metadata fields of its OS packages, e.g. "debian 12". If packages disagree,
+// e.g. because of packages from container images, the most common OS wins.
+func osName(invs []*extractor.Inventory) string {
+	names := map[string]int{}
+	for _, i := range invs {
+		id := metadataString(i.Metadata, "OSID")
+		if id == "" {
+			continue
+		}
+		if version := metadataString(i.Metadata, "OSVersionID"); version != "" {
+			id += " " + version
+		}
+		names[id]++
+	}
+	if len(names) == 0 {
+		return UnknownOS
+	}
+	return counts(names)[0].Name
+}
+
+// metadataString returns the value of the named string field of a metadata
+// struct, or an empty string if there's no such field.
+func metadataString(metadata any, field string) string {
+	v := reflect.ValueOf(metadata)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.FieldByName(field)
+	if !f.IsValid() || f.Kind() != reflect.String {
+		return ""
+	}
+	return f.String()
+}
+
+// counts returns the counts sorted from most to least common.
+func counts(m map[string]int) []*Count {
+	var res []*Count
+	for name, c := range m {
+		res = append(res, &Count{Name: name, Count: c})
+	}
+	slices.SortFunc(res, func(a, b *Count) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+	return res
+}
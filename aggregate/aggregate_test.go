@@ -0,0 +1,188 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/aggregate"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/veles/secrets/tunnel"
+)
+
+func debPkg(name, version, osVersion string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Metadata:  &dpkg.Metadata{PackageName: name, OSID: "debian", OSVersionID: osVersion},
+		Extractor: dpkg.Extractor{},
+	}
+}
+
+func pyPkg(name, version string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Metadata:  &requirements.Metadata{},
+		Extractor: requirements.Extractor{},
+	}
+}
+
+func vuln(id string, i *extractor.Inventory) *detector.Finding {
+	return &detector.Finding{
+		Adv: &detector.Advisory{
+			ID:   &detector.AdvisoryID{Publisher: "CVE", Reference: id},
+			Type: detector.TypeVulnerability,
+		},
+		Target: &detector.TargetDetails{Inventory: i},
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	openssl := debPkg("openssl", "3.0.11", "12")
+	curl := debPkg("curl", "7.88.1", "12")
+	oldOpenssl := debPkg("openssl", "1.1.1n", "11")
+	requests := pyPkg("requests", "2.25.0")
+	ngrok := &secrets.Secret{Secret: tunnel.NgrokAuthtoken{Token: "token"}}
+	frp := &secrets.Secret{Secret: tunnel.FRPToken{Token: "token"}}
+
+	tests := []struct {
+		desc    string
+		results []*scalibr.ScanResult
+		cfg     aggregate.Config
+		want    *aggregate.FleetStats
+	}{
+		{
+			desc: "no results",
+			cfg:  aggregate.DefaultConfig(),
+			want: &aggregate.FleetStats{},
+		},
+		{
+			desc: "fleet",
+			cfg:  aggregate.DefaultConfig(),
+			results: []*scalibr.ScanResult{
+				{
+					Status:      &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+					Inventories: []*extractor.Inventory{openssl, curl, requests},
+					Findings: []*detector.Finding{
+						vuln("CVE-2024-2", openssl),
+						vuln("CVE-2024-1", openssl),
+						vuln("CVE-2024-3", requests),
+					},
+					Secrets: []*secrets.Secret{ngrok, frp},
+				},
+				{
+					Status:      &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+					Inventories: []*extractor.Inventory{openssl, curl},
+					Findings:    []*detector.Finding{vuln("CVE-2024-1", openssl)},
+					Secrets:     []*secrets.Secret{ngrok},
+				},
+				{
+					Status:      &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+					Inventories: []*extractor.Inventory{oldOpenssl},
+				},
+				{
+					Status: &plugin.ScanStatus{Status: plugin.ScanStatusFailed},
+				},
+			},
+			want: &aggregate.FleetStats{
+				Scans:           4,
+				FailedScans:     1,
+				VulnerableScans: 2,
+				TopVulnerablePackages: []*aggregate.VulnerablePackage{
+					{Name: "openssl", Version: "3.0.11", Ecosystem: "Debian:12", Scans: 2, Advisories: []string{"CVE-2024-1", "CVE-2024-2"}},
+					{Name: "requests", Version: "2.25.0", Ecosystem: "PyPI", Scans: 1, Advisories: []string{"CVE-2024-3"}},
+				},
+				SecretTypes: []*aggregate.Count{
+					{Name: "tunnel.NgrokAuthtoken", Count: 2},
+					{Name: "tunnel.FRPToken", Count: 1},
+				},
+				Ecosystems: []*aggregate.Count{
+					{Name: "Debian", Count: 5},
+					{Name: "PyPI", Count: 1},
+				},
+				OS: []*aggregate.OSStats{
+					{OS: "debian 12", Scans: 2, VulnerableScans: 2, Packages: 5, Secrets: 3},
+					{OS: "debian 11", Scans: 1, Packages: 1},
+					{OS: aggregate.UnknownOS, Scans: 1},
+				},
+			},
+		},
+		{
+			desc: "top packages limit",
+			cfg:  aggregate.Config{TopPackages: 1},
+			results: []*scalibr.ScanResult{
+				{
+					Inventories: []*extractor.Inventory{curl, requests},
+					Findings: []*detector.Finding{
+						vuln("CVE-2024-4", curl),
+						vuln("CVE-2024-3", requests),
+						vuln("CVE-2024-5", requests),
+					},
+				},
+			},
+			want: &aggregate.FleetStats{
+				Scans:           1,
+				VulnerableScans: 1,
+				TopVulnerablePackages: []*aggregate.VulnerablePackage{
+					{Name: "requests", Version: "2.25.0", Ecosystem: "PyPI", Scans: 1, Advisories: []string{"CVE-2024-3", "CVE-2024-5"}},
+				},
+				Ecosystems: []*aggregate.Count{
+					{Name: "Debian", Count: 1},
+					{Name: "PyPI", Count: 1},
+				},
+				OS: []*aggregate.OSStats{
+					{OS: "debian 12", Scans: 1, VulnerableScans: 1, Packages: 2},
+				},
+			},
+		},
+		{
+			desc: "inventory without extractor",
+			cfg:  aggregate.DefaultConfig(),
+			results: []*scalibr.ScanResult{
+				{
+					Inventories: []*extractor.Inventory{{Name: "foo", Version: "1.0"}},
+					Findings:    []*detector.Finding{vuln("CVE-2024-6", &extractor.Inventory{Name: "foo", Version: "1.0"})},
+				},
+			},
+			want: &aggregate.FleetStats{
+				Scans:           1,
+				VulnerableScans: 1,
+				TopVulnerablePackages: []*aggregate.VulnerablePackage{
+					{Name: "foo", Version: "1.0", Scans: 1, Advisories: []string{"CVE-2024-6"}},
+				},
+				OS: []*aggregate.OSStats{
+					{OS: aggregate.UnknownOS, Scans: 1, VulnerableScans: 1, Packages: 1},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := aggregate.Aggregate(tc.results, tc.cfg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Aggregate() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
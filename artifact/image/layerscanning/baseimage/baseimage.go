@@ -0,0 +1,147 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package baseimage enriches the packages found in a container image with the known base image
+// they originate from. The chain IDs of the image's layers are compared against a catalog of base
+// images, e.g. Docker Official Images, Chainguard Images or Distroless, which tells apart the
+// packages of the base image from the ones added by the application layers.
+package baseimage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// Entry is a known base image of the catalog.
+type Entry struct {
+	// Name of the image, e.g. "gcr.io/distroless/static-debian12:nonroot".
+	Name string `json:"name"`
+	// Publisher of the image, e.g. "Docker Official Images", "Chainguard" or "Distroless".
+	Publisher string `json:"publisher,omitempty"`
+	// ChainIDs of the top layer of each known version of the image. The chain ID identifies the
+	// layer together with all layers below it, so it identifies the whole image.
+	ChainIDs []string `json:"chain_ids"`
+}
+
+// catalogFile is the JSON format of a catalog file.
+type catalogFile struct {
+	Images []Entry `json:"images"`
+}
+
+// Catalog is a collection of known base images indexed by chain ID.
+type Catalog struct {
+	byChainID map[string]*Entry
+}
+
+// NewCatalog returns a Catalog of the given base images. Chain IDs are accepted with or without
+// the "sha256:" prefix.
+func NewCatalog(entries []Entry) (*Catalog, error) {
+	c := &Catalog{byChainID: make(map[string]*Entry)}
+	for i := range entries {
+		e := &entries[i]
+		if e.Name == "" {
+			return nil, errors.New("base image without name")
+		}
+		if len(e.ChainIDs) == 0 {
+			return nil, fmt.Errorf("base image %q has no chain IDs", e.Name)
+		}
+		for _, id := range e.ChainIDs {
+			id = normalizeChainID(id)
+			if other, ok := c.byChainID[id]; ok && other.Name != e.Name {
+				return nil, fmt.Errorf("chain ID %s belongs to both %q and %q", id, other.Name, e.Name)
+			}
+			c.byChainID[id] = e
+		}
+	}
+	return c, nil
+}
+
+// LoadCatalog reads a Catalog from a JSON file of the form
+//
+//	{"images": [{"name": "...", "publisher": "...", "chain_ids": ["sha256:..."]}]}
+func LoadCatalog(path string) (*Catalog, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f catalogFile
+	if err := json.Unmarshal(content, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse base image catalog %s: %w", path, err)
+	}
+	c, err := NewCatalog(f.Images)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base image catalog %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Len returns the number of chain IDs in the catalog.
+func (c *Catalog) Len() int {
+	return len(c.byChainID)
+}
+
+// Match returns the base image that an image with the given layer chain IDs was built on and the
+// index of the base image's top layer. If several base images match, e.g. a Distroless image and
+// the Distroless image it was built on, the one with the most layers is returned. Returns nil and
+// -1 if no base image matches.
+func (c *Catalog) Match(chainIDs []string) (*Entry, int) {
+	for i := len(chainIDs) - 1; i >= 0; i-- {
+		if chainIDs[i] == "" {
+			continue
+		}
+		if e, ok := c.byChainID[normalizeChainID(chainIDs[i])]; ok {
+			// Empty layers on top of the base image's top layer share its chain ID, so the base
+			// image ends at the lowest of them.
+			for i > 0 && chainIDs[i-1] == chainIDs[i] {
+				i--
+			}
+			return e, i
+		}
+	}
+	return nil, -1
+}
+
+// Enrich annotates the layer details of the inventory found in an image with the given layer chain
+// IDs with the base image the image was built on, if it's in the catalog. Packages of the base
+// image's layers are marked as in the base image and all other packages as added by the
+// application layers. The inventory is left unchanged and nil is returned if no base image
+// matches.
+func (c *Catalog) Enrich(inventory []*extractor.Inventory, chainIDs []string) *Entry {
+	e, top := c.Match(chainIDs)
+	if e == nil {
+		return nil
+	}
+	for _, inv := range inventory {
+		ld := inv.LayerDetails
+		if ld == nil {
+			continue
+		}
+		ld.InBaseImage = ld.Index <= top
+		if ld.InBaseImage {
+			ld.BaseImage = e.Name
+		} else {
+			ld.BaseImage = ""
+		}
+	}
+	return e
+}
+
+func normalizeChainID(id string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(id)), "sha256:")
+}
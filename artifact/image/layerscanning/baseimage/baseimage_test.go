@@ -0,0 +1,199 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseimage_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/artifact/image/layerscanning/baseimage"
+	"github.com/google/osv-scalibr/extractor"
+)
+
+var (
+	static = strings.Repeat("1", 64)
+	base   = strings.Repeat("3", 64)
+	alpine = strings.Repeat("4", 64)
+	app1   = strings.Repeat("a", 64)
+	app2   = strings.Repeat("b", 64)
+)
+
+func loadCatalog(t *testing.T) *baseimage.Catalog {
+	t.Helper()
+	c, err := baseimage.LoadCatalog(filepath.Join("testdata", "catalog.json"))
+	if err != nil {
+		t.Fatalf("LoadCatalog(): %v", err)
+	}
+	return c
+}
+
+func TestNewCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []baseimage.Entry
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			entries: []baseimage.Entry{
+				{Name: "alpine:3.20", ChainIDs: []string{"sha256:" + alpine}},
+				{Name: "gcr.io/distroless/static-debian12", ChainIDs: []string{static, base}},
+			},
+			wantLen: 3,
+		},
+		{
+			name:    "missing_name",
+			entries: []baseimage.Entry{{ChainIDs: []string{alpine}}},
+			wantErr: true,
+		},
+		{
+			name:    "missing_chain_ids",
+			entries: []baseimage.Entry{{Name: "alpine:3.20"}},
+			wantErr: true,
+		},
+		{
+			name: "chain_id_of_two_images",
+			entries: []baseimage.Entry{
+				{Name: "alpine:3.20", ChainIDs: []string{alpine}},
+				{Name: "alpine:latest", ChainIDs: []string{"sha256:" + alpine}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := baseimage.NewCatalog(tc.entries)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewCatalog() error: %v, want error: %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if got := c.Len(); got != tc.wantLen {
+				t.Errorf("NewCatalog().Len() = %d, want %d", got, tc.wantLen)
+			}
+		})
+	}
+}
+
+func TestLoadCatalog(t *testing.T) {
+	if got := loadCatalog(t).Len(); got != 4 {
+		t.Errorf("LoadCatalog().Len() = %d, want 4", got)
+	}
+
+	dir := t.TempDir()
+	for _, p := range []string{filepath.Join(dir, "missing.json"), filepath.Join("testdata", "..", "baseimage.go")} {
+		if _, err := baseimage.LoadCatalog(p); err == nil {
+			t.Errorf("LoadCatalog(%s): got nil error, want error", p)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		chainIDs  []string
+		wantImage string
+		wantIndex int
+	}{
+		{
+			name:      "application_on_alpine",
+			chainIDs:  []string{alpine, app1, app2},
+			wantImage: "alpine:3.20",
+			wantIndex: 0,
+		},
+		{
+			name:      "most_specific_base_image",
+			chainIDs:  []string{static, strings.Repeat("2", 64), base, app1},
+			wantImage: "gcr.io/distroless/base-debian12",
+			wantIndex: 2,
+		},
+		{
+			name:      "empty_layers_on_top_of_base_image",
+			chainIDs:  []string{"", alpine, alpine, alpine, app1},
+			wantImage: "alpine:3.20",
+			wantIndex: 1,
+		},
+		{
+			name:      "unknown_base_image",
+			chainIDs:  []string{app1, app2},
+			wantIndex: -1,
+		},
+	}
+
+	c := loadCatalog(t)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, index := c.Match(tc.chainIDs)
+			var gotImage string
+			if e != nil {
+				gotImage = e.Name
+			}
+			if gotImage != tc.wantImage || index != tc.wantIndex {
+				t.Errorf("Match(%v) = %q, %d, want %q, %d", tc.chainIDs, gotImage, index, tc.wantImage, tc.wantIndex)
+			}
+		})
+	}
+}
+
+func TestEnrich(t *testing.T) {
+	tests := []struct {
+		name      string
+		chainIDs  []string
+		inventory []*extractor.Inventory
+		want      []*extractor.Inventory
+	}{
+		{
+			name:     "base_and_application_packages",
+			chainIDs: []string{static, strings.Repeat("2", 64), base, app1},
+			inventory: []*extractor.Inventory{
+				{Name: "tzdata", LayerDetails: &extractor.LayerDetails{Index: 0, ChainID: static}},
+				{Name: "libc6", LayerDetails: &extractor.LayerDetails{Index: 2, ChainID: base}},
+				{Name: "app", LayerDetails: &extractor.LayerDetails{Index: 3, ChainID: app1, InBaseImage: true}},
+				{Name: "untraced"},
+			},
+			want: []*extractor.Inventory{
+				{Name: "tzdata", LayerDetails: &extractor.LayerDetails{Index: 0, ChainID: static, InBaseImage: true, BaseImage: "gcr.io/distroless/base-debian12"}},
+				{Name: "libc6", LayerDetails: &extractor.LayerDetails{Index: 2, ChainID: base, InBaseImage: true, BaseImage: "gcr.io/distroless/base-debian12"}},
+				{Name: "app", LayerDetails: &extractor.LayerDetails{Index: 3, ChainID: app1}},
+				{Name: "untraced"},
+			},
+		},
+		{
+			name:     "unknown_base_image_is_left_unchanged",
+			chainIDs: []string{app1, app2},
+			inventory: []*extractor.Inventory{
+				{Name: "musl", LayerDetails: &extractor.LayerDetails{Index: 0, ChainID: app1, InBaseImage: true}},
+			},
+			want: []*extractor.Inventory{
+				{Name: "musl", LayerDetails: &extractor.LayerDetails{Index: 0, ChainID: app1, InBaseImage: true}},
+			},
+		},
+	}
+
+	c := loadCatalog(t)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Enrich(tc.inventory, tc.chainIDs)
+			if diff := cmp.Diff(tc.want, tc.inventory); diff != "" {
+				t.Errorf("Enrich(%v) unexpected diff (-want +got):\n%s", tc.chainIDs, diff)
+			}
+		})
+	}
+}
@@ -100,9 +100,16 @@ func FromRemoteName(imageName string, config *Config, imageOptions ...remote.Opt
 	return FromV1Image(v1Image, config)
 }
 
-// FromTarball creates an Image from a tarball file that stores a container image.
+// FromTarball creates an Image from a tarball file that stores a container image, either in the
+// format written by `docker save` or as an OCI image layout.
 func FromTarball(tarPath string, config *Config) (*Image, error) {
-	// TODO b/381251067: Look into supporting OCI images.
+	isOCILayout, err := isOCILayoutTarball(tarPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tarball with path %q: %w", tarPath, err)
+	}
+	if isOCILayout {
+		return fromOCILayoutTarball(tarPath, config)
+	}
 	v1Image, err := tarball.ImageFromPath(tarPath, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load image from tarball with path %q: %w", tarPath, err)
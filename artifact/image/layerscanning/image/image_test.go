@@ -15,16 +15,23 @@
 package image
 
 import (
+	"archive/tar"
 	"errors"
 	"fmt"
 	"io"
 	"io/fs"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/google/osv-scalibr/artifact/image"
 )
@@ -456,6 +463,64 @@ func TestFromTarball(t *testing.T) {
 	}
 }
 
+// Testing plan:
+//  1. Convert basic.tar to an OCI image layout whose index references a multi-platform image list
+//     with an attestation. Make sure that the image of the list is loaded with the same chain
+//     layers as the docker tarball.
+//  2. Make sure that a tarball with neither an OCI layout nor a docker manifest returns an error.
+func TestFromTarball_OCILayout(t *testing.T) {
+	dockerImage, err := tarball.ImageFromPath(filepath.Join(testdataDir, "basic.tar"), nil)
+	if err != nil {
+		t.Fatalf("tarball.ImageFromPath(): %v", err)
+	}
+	attestation, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatalf("random.Image(): %v", err)
+	}
+	imageList := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{Add: attestation, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "unknown", Architecture: "unknown"}}},
+		mutate.IndexAddendum{Add: dockerImage, Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}}},
+	)
+	index := mutate.AppendManifests(empty.Index, mutate.IndexAddendum{Add: imageList})
+	tarPath := writeOCILayoutTarball(t, index)
+
+	gotImage, err := FromTarball(tarPath, DefaultConfig())
+	if err != nil {
+		t.Fatalf("FromTarball(%v) returned unexpected error: %v", tarPath, err)
+	}
+	defer gotImage.CleanUp()
+
+	chainLayers, err := gotImage.ChainLayers()
+	if err != nil {
+		t.Fatalf("ChainLayers() returned error: %v", err)
+	}
+	wantChainLayerEntries := []chainLayerEntries{
+		{
+			filepathContentPairs: []filepathContentPair{
+				{filepath: "sample.txt", content: "sample text file\n"},
+			},
+		},
+		{
+			filepathContentPairs: []filepathContentPair{
+				{filepath: "larger-sample.txt", content: strings.Repeat("sample text file\n", 400)},
+				{filepath: "sample.txt", content: "sample text file\n"},
+			},
+		},
+	}
+	if len(chainLayers) != len(wantChainLayerEntries) {
+		t.Fatalf("ChainLayers() returned incorrect number of chain layers: got %d chain layers, want %d chain layers", len(chainLayers), len(wantChainLayerEntries))
+	}
+	for i, chainLayer := range chainLayers {
+		compareChainLayerEntries(t, chainLayer, wantChainLayerEntries[i], nil)
+	}
+
+	notAnImage := filepath.Join(t.TempDir(), "not-an-image.tar")
+	writeTarball(t, notAnImage, map[string]string{"foo.txt": "foo"})
+	if _, err := FromTarball(notAnImage, DefaultConfig()); err == nil {
+		t.Errorf("FromTarball(%v) returned nil error, want error", notAnImage)
+	}
+}
+
 // Testing plan:
 //  1. Use a fake v1.Image that has no config file. Make sure that Load() returns an error.
 //  2. Use a fake v1.Image that returns an error when calling Layers(). Make sure that Load() returns
@@ -541,3 +606,59 @@ func compareChainLayerEntries(t *testing.T, gotChainLayer image.ChainLayer, want
 		}()
 	}
 }
+
+// writeOCILayoutTarball writes index as an OCI image layout into a tarball and returns its path.
+func writeOCILayoutTarball(t *testing.T, index v1.ImageIndex) string {
+	t.Helper()
+	layoutDir := t.TempDir()
+	if _, err := layout.Write(layoutDir, index); err != nil {
+		t.Fatalf("layout.Write(): %v", err)
+	}
+
+	files := map[string]string{}
+	err := filepath.WalkDir(layoutDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(layoutDir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("filepath.WalkDir(%v): %v", layoutDir, err)
+	}
+
+	tarPath := filepath.Join(t.TempDir(), "oci-layout.tar")
+	writeTarball(t, tarPath, files)
+	return tarPath
+}
+
+// writeTarball writes a tarball with the given files to tarPath.
+func writeTarball(t *testing.T, tarPath string, files map[string]string) {
+	t.Helper()
+	f, err := os.Create(tarPath)
+	if err != nil {
+		t.Fatalf("os.Create(%v): %v", tarPath, err)
+	}
+	defer f.Close()
+
+	tw := tar.NewWriter(f)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("WriteHeader(%v): %v", name, err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("Write(%v): %v", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("tar.Writer.Close(): %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/layout"
+)
+
+const (
+	// ociLayoutFile marks the root of an OCI image layout.
+	ociLayoutFile = "oci-layout"
+	// dockerManifestFile is the manifest of an image tarball written by `docker save`.
+	dockerManifestFile = "manifest.json"
+)
+
+// isOCILayoutTarball returns whether the tarball at tarPath stores an OCI image layout, e.g. one
+// written by `skopeo copy` or `buildah push` to an oci-archive. Newer Docker versions write both
+// formats into the same tarball, in which case the `docker save` format is used.
+func isOCILayoutTarball(tarPath string) (bool, error) {
+	f, err := os.Open(tarPath)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	isOCILayout := false
+	tarReader := tar.NewReader(f)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return false, fmt.Errorf("could not read tar: %w", err)
+		}
+		switch path.Clean(filepath.ToSlash(header.Name)) {
+		case dockerManifestFile:
+			return false, nil
+		case ociLayoutFile:
+			isOCILayout = true
+		}
+	}
+	return isOCILayout, nil
+}
+
+// fromOCILayoutTarball creates an Image from a tarball that stores an OCI image layout. The layout
+// is unpacked into a temporary directory that is removed once the layers have been read.
+func fromOCILayoutTarball(tarPath string, config *Config) (*Image, error) {
+	layoutDir, err := os.MkdirTemp("", "osv-scalibr-oci-layout-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(layoutDir)
+
+	if err := unpackOCILayout(tarPath, layoutDir); err != nil {
+		return nil, fmt.Errorf("failed to unpack OCI layout from tarball with path %q: %w", tarPath, err)
+	}
+	index, err := layout.ImageIndexFromPath(layoutDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OCI layout from tarball with path %q: %w", tarPath, err)
+	}
+	v1Image, err := imageFromIndex(index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load image from OCI layout tarball with path %q: %w", tarPath, err)
+	}
+	// FromV1Image reads all layers before returning, so the layout isn't needed afterwards.
+	return FromV1Image(v1Image, config)
+}
+
+// unpackOCILayout unpacks the index and blobs of the OCI image layout in the tarball at tarPath
+// into dir.
+func unpackOCILayout(tarPath string, dir string) error {
+	f, err := os.Open(tarPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	tarReader := tar.NewReader(f)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not read tar: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(filepath.ToSlash(header.Name))
+		if !filepath.IsLocal(name) {
+			continue
+		}
+		if name != ociLayoutFile && name != "index.json" && !strings.HasPrefix(name, "blobs/") {
+			continue
+		}
+		if err := unpackFile(tarReader, filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			return err
+		}
+	}
+}
+
+func unpackFile(r io.Reader, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), dirPermission); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filePermission)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// imageFromIndex returns the first image of the index, descending into nested indexes such as
+// multi-platform image lists.
+func imageFromIndex(index v1.ImageIndex) (v1.Image, error) {
+	manifest, err := index.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+	for _, desc := range manifest.Manifests {
+		// Skip attestations, e.g. the provenance that buildx stores next to the images of a list.
+		if desc.Platform != nil && desc.Platform.OS == "unknown" {
+			continue
+		}
+		switch {
+		case desc.MediaType.IsImage():
+			return index.Image(desc.Digest)
+		case desc.MediaType.IsIndex():
+			child, err := index.ImageIndex(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+			return imageFromIndex(child)
+		}
+	}
+	return nil, errors.New("no image found in the OCI image index")
+}
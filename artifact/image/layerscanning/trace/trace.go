@@ -25,6 +25,7 @@ import (
 
 	scalibrImage "github.com/google/osv-scalibr/artifact/image"
 	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/opencontainers/go-digest"
 )
 
 // locationAndIndex is a struct to represent a location and the index of the layer it was found in.
@@ -55,17 +56,17 @@ func PopulateLayerDetails(ctx context.Context, inventory []*extractor.Inventory,
 	chainLayerDetailsList := []*extractor.LayerDetails{}
 
 	// Create list of layer details struct to be referenced by inventory.
+	chainIDs := ChainIDs(chainLayers)
 	for i, chainLayer := range chainLayers {
 		var diffID string
-		if chainLayer.Layer().IsEmpty() {
-			diffID = ""
-		} else {
+		if !chainLayer.Layer().IsEmpty() {
 			diffID = chainLayer.Layer().DiffID().Encoded()
 		}
 
 		chainLayerDetailsList = append(chainLayerDetailsList, &extractor.LayerDetails{
 			Index:       i,
 			DiffID:      diffID,
+			ChainID:     chainIDs[i],
 			Command:     chainLayer.Layer().Command(),
 			InBaseImage: false,
 		})
@@ -154,6 +155,34 @@ func PopulateLayerDetails(ctx context.Context, inventory []*extractor.Inventory,
 	}
 }
 
+// ChainIDs returns the encoded chain IDs of the given chain layers. Empty layers share the chain
+// ID of the layers below them, so empty layers at the bottom of the image have no chain ID.
+func ChainIDs(chainLayers []scalibrImage.ChainLayer) []string {
+	chainIDs := make([]string, 0, len(chainLayers))
+	var chainID digest.Digest
+	for _, chainLayer := range chainLayers {
+		if !chainLayer.Layer().IsEmpty() {
+			chainID = nextChainID(chainID, chainLayer.Layer().DiffID())
+		}
+
+		var encodedChainID string
+		if chainID != "" {
+			encodedChainID = chainID.Encoded()
+		}
+		chainIDs = append(chainIDs, encodedChainID)
+	}
+	return chainIDs
+}
+
+// nextChainID returns the chain ID of the layer with the given diffID on top of the layers with
+// the chain ID parent, or diffID itself for the first layer.
+func nextChainID(parent digest.Digest, diffID digest.Digest) digest.Digest {
+	if parent == "" {
+		return diffID
+	}
+	return digest.FromString(parent.String() + " " + diffID.String())
+}
+
 // areInventoriesEqual checks if two inventories are equal. It does this by comparing the PURLs and
 // the locations of the inventories.
 func areInventoriesEqual(inv1 *extractor.Inventory, inv2 *extractor.Inventory) bool {
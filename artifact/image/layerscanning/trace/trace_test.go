@@ -122,6 +122,11 @@ func TestPopulateLayerDetails(t *testing.T) {
 		},
 	})
 
+	chainID1 := digest1
+	chainID2 := digest.FromString(chainID1.String() + " " + digest2.String())
+	chainID3 := digest.FromString(chainID2.String() + " " + digest3.String())
+	chainID4 := digest.FromString(chainID3.String() + " " + digest4.String())
+
 	tests := []struct {
 		name          string
 		inventory     []*extractor.Inventory
@@ -161,6 +166,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       0,
 						DiffID:      "diff-id-1",
+						ChainID:     chainID1.Encoded(),
 						Command:     "command-1",
 						InBaseImage: false,
 					},
@@ -172,6 +178,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       0,
 						DiffID:      "diff-id-1",
+						ChainID:     chainID1.Encoded(),
 						Command:     "command-1",
 						InBaseImage: false,
 					},
@@ -200,6 +207,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       0,
 						DiffID:      "diff-id-1",
+						ChainID:     chainID1.Encoded(),
 						Command:     "command-1",
 						InBaseImage: false,
 					},
@@ -234,6 +242,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       0,
 						DiffID:      "diff-id-1",
+						ChainID:     chainID1.Encoded(),
 						Command:     "command-1",
 						InBaseImage: false,
 					},
@@ -245,6 +254,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       2,
 						DiffID:      "diff-id-3",
+						ChainID:     chainID3.Encoded(),
 						Command:     "command-3",
 						InBaseImage: false,
 					},
@@ -285,6 +295,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       0,
 						DiffID:      "diff-id-1",
+						ChainID:     chainID1.Encoded(),
 						Command:     "command-1",
 						InBaseImage: false,
 					},
@@ -296,6 +307,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       3,
 						DiffID:      "diff-id-4",
+						ChainID:     chainID4.Encoded(),
 						Command:     "command-4",
 						InBaseImage: false,
 					},
@@ -307,6 +319,7 @@ func TestPopulateLayerDetails(t *testing.T) {
 					LayerDetails: &extractor.LayerDetails{
 						Index:       2,
 						DiffID:      "diff-id-3",
+						ChainID:     chainID3.Encoded(),
 						Command:     "command-3",
 						InBaseImage: false,
 					},
@@ -0,0 +1,218 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package baseline compares the scan result of a host with the scan result of
+// the golden image it was deployed from and reports the drift, i.e. what the
+// host has that the image doesn't. This verifies that immutable
+// infrastructure wasn't modified after its deployment.
+package baseline
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+)
+
+// Change describes how a package of the host differs from the baseline.
+type Change string
+
+// Change values.
+const (
+	// Added packages aren't in the baseline.
+	Added Change = "added"
+	// Changed packages are in the baseline at the same location, but with a
+	// different version.
+	Changed Change = "changed"
+)
+
+// Config for comparing scan results.
+type Config struct {
+	// Path prefixes relative to the scan root, e.g. "var/lib/app", of the
+	// locations that are expected to change on the host and whose packages,
+	// files and secrets are ignored.
+	IgnorePaths []string
+}
+
+// DefaultConfig returns the default configuration for comparing scan
+// results, which ignores nothing.
+func DefaultConfig() Config {
+	return Config{}
+}
+
+// Drift is what a host has that its baseline doesn't.
+type Drift struct {
+	// Packages of the host that aren't in the baseline or have a different
+	// version, sorted by location, name and version.
+	Packages []*PackageDrift
+	// Files of the host with packages or secrets that have no packages or
+	// secrets in the baseline, sorted. Scan results don't record the other
+	// files of the filesystem.
+	Files []string
+	// Secrets found on the host but not in the baseline, sorted by location.
+	Secrets []*secrets.Secret
+}
+
+// PackageDrift is a package of the host that differs from the baseline.
+type PackageDrift struct {
+	Change Change
+	// The package found on the host.
+	Inventory *extractor.Inventory
+	// The versions of the package at the same location in the baseline. Only
+	// set for Changed packages.
+	BaselineVersions []string
+}
+
+// Empty returns whether the host matches the baseline.
+func (d *Drift) Empty() bool {
+	return len(d.Packages) == 0 && len(d.Files) == 0 && len(d.Secrets) == 0
+}
+
+// Compare returns the drift of the host scan result from the scan result of
+// its baseline, e.g. of the golden image the host was deployed from.
+//
+// Packages are matched by extractor, name, version and location. Packages
+// found in a different location than in the baseline, e.g. a copy of a
+// library, are reported as added.
+func Compare(base, host *scalibr.ScanResult, cfg Config) *Drift {
+	// Versions of the baseline packages by extractor, name and location.
+	baseVersions := map[packageKey][]string{}
+	baseFiles := map[string]bool{}
+	for _, i := range base.Inventories {
+		for _, loc := range locations(i) {
+			k := packageKey{extractor: extractorName(i), name: i.Name, location: loc}
+			baseVersions[k] = append(baseVersions[k], i.Version)
+			baseFiles[loc] = true
+		}
+	}
+	for _, s := range base.Secrets {
+		baseFiles[s.Location] = true
+	}
+
+	d := &Drift{}
+	files := map[string]bool{}
+	for _, i := range host.Inventories {
+		if p := packageDrift(i, baseVersions, cfg); p != nil {
+			d.Packages = append(d.Packages, p)
+		}
+		for _, loc := range i.Locations {
+			if !cfg.ignored(loc) && !baseFiles[loc] {
+				files[loc] = true
+			}
+		}
+	}
+
+	for _, s := range host.Secrets {
+		if cfg.ignored(s.Location) {
+			continue
+		}
+		if !slices.ContainsFunc(base.Secrets, func(b *secrets.Secret) bool {
+			return b.Location == s.Location && reflect.DeepEqual(b.Secret, s.Secret)
+		}) {
+			d.Secrets = append(d.Secrets, s)
+		}
+		if !baseFiles[s.Location] {
+			files[s.Location] = true
+		}
+	}
+
+	for f := range files {
+		d.Files = append(d.Files, f)
+	}
+	slices.Sort(d.Files)
+	slices.SortStableFunc(d.Packages, func(a, b *PackageDrift) int {
+		return cmp.Or(
+			cmp.Compare(firstLocation(a.Inventory), firstLocation(b.Inventory)),
+			cmp.Compare(a.Inventory.Name, b.Inventory.Name),
+			cmp.Compare(a.Inventory.Version, b.Inventory.Version),
+		)
+	})
+	slices.SortStableFunc(d.Secrets, func(a, b *secrets.Secret) int {
+		return cmp.Or(
+			cmp.Compare(a.Location, b.Location),
+			cmp.Compare(fmt.Sprintf("%T", a.Secret), fmt.Sprintf("%T", b.Secret)),
+		)
+	})
+	return d
+}
+
+// packageDrift returns how the host package i differs from the baseline, or
+// nil if it's in the baseline at one of its locations.
+func packageDrift(i *extractor.Inventory, baseVersions map[packageKey][]string, cfg Config) *PackageDrift {
+	var changedFrom []string
+	considered := false
+	for _, loc := range locations(i) {
+		if cfg.ignored(loc) {
+			continue
+		}
+		considered = true
+		versions := baseVersions[packageKey{extractor: extractorName(i), name: i.Name, location: loc}]
+		if slices.Contains(versions, i.Version) {
+			return nil
+		}
+		changedFrom = append(changedFrom, versions...)
+	}
+	switch {
+	case !considered:
+		return nil
+	case len(changedFrom) > 0:
+		return &PackageDrift{Change: Changed, Inventory: i, BaselineVersions: changedFrom}
+	default:
+		return &PackageDrift{Change: Added, Inventory: i}
+	}
+}
+
+// locations returns the locations of the inventory. Packages without a
+// location, e.g. those of standalone extractors, are matched by extractor and
+// name only.
+func locations(i *extractor.Inventory) []string {
+	if len(i.Locations) == 0 {
+		return []string{""}
+	}
+	return i.Locations
+}
+
+// packageKey identifies a package at a location, regardless of its version.
+type packageKey struct {
+	extractor, name, location string
+}
+
+// extractorName returns the name of the extractor of the inventory, or an
+// empty string if it's not known, e.g. for results read back from a proto
+// without the extractors.
+func extractorName(i *extractor.Inventory) string {
+	if i.Extractor == nil {
+		return ""
+	}
+	return i.Extractor.Name()
+}
+
+func firstLocation(i *extractor.Inventory) string {
+	return locations(i)[0]
+}
+
+func (c Config) ignored(location string) bool {
+	for _, p := range c.IgnorePaths {
+		p = strings.TrimSuffix(p, "/")
+		if location == p || strings.HasPrefix(location, p+"/") {
+			return true
+		}
+	}
+	return false
+}
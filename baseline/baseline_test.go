@@ -0,0 +1,123 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseline_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/baseline"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/veles/secrets/tunnel"
+)
+
+func debPkg(name, version string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Locations: []string{"var/lib/dpkg/status"},
+		Extractor: dpkg.Extractor{},
+	}
+}
+
+func pyPkg(name, version, location string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Locations: []string{location},
+		Extractor: wheelegg.Extractor{},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	openssl := debPkg("openssl", "3.0.11")
+	curl := debPkg("curl", "7.88.1")
+	newCurl := debPkg("curl", "8.5.0")
+	netcat := debPkg("netcat-openbsd", "1.219")
+	requests := pyPkg("requests", "2.31.0", "usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA")
+	requestsCopy := pyPkg("requests", "2.31.0", "home/user/.local/lib/python3.11/site-packages/requests-2.31.0.dist-info/METADATA")
+	appPkg := pyPkg("flask", "3.0.0", "var/lib/app/venv/lib/python3.11/site-packages/flask-3.0.0.dist-info/METADATA")
+	imageToken := &secrets.Secret{Secret: tunnel.NgrokAuthtoken{Token: "image"}, Location: "etc/ngrok.yml"}
+	hostToken := &secrets.Secret{Secret: tunnel.NgrokAuthtoken{Token: "host"}, Location: "etc/ngrok.yml"}
+	frpToken := &secrets.Secret{Secret: tunnel.FRPToken{Token: "token"}, Location: "root/frpc.toml"}
+
+	base := &scalibr.ScanResult{
+		Inventories: []*extractor.Inventory{openssl, curl, requests},
+		Secrets:     []*secrets.Secret{imageToken},
+	}
+
+	tests := []struct {
+		desc string
+		host *scalibr.ScanResult
+		cfg  baseline.Config
+		want *baseline.Drift
+	}{
+		{
+			desc: "host matches baseline",
+			host: &scalibr.ScanResult{
+				Inventories: []*extractor.Inventory{curl, openssl, requests},
+				Secrets:     []*secrets.Secret{imageToken},
+			},
+			cfg:  baseline.DefaultConfig(),
+			want: &baseline.Drift{},
+		},
+		{
+			desc: "drift",
+			host: &scalibr.ScanResult{
+				Inventories: []*extractor.Inventory{openssl, newCurl, netcat, requests, requestsCopy},
+				Secrets:     []*secrets.Secret{hostToken, frpToken},
+			},
+			cfg: baseline.DefaultConfig(),
+			want: &baseline.Drift{
+				Packages: []*baseline.PackageDrift{
+					{Change: baseline.Added, Inventory: requestsCopy},
+					{Change: baseline.Changed, Inventory: newCurl, BaselineVersions: []string{"7.88.1"}},
+					{Change: baseline.Added, Inventory: netcat},
+				},
+				Files: []string{
+					"home/user/.local/lib/python3.11/site-packages/requests-2.31.0.dist-info/METADATA",
+					"root/frpc.toml",
+				},
+				Secrets: []*secrets.Secret{hostToken, frpToken},
+			},
+		},
+		{
+			desc: "ignored paths",
+			host: &scalibr.ScanResult{
+				Inventories: []*extractor.Inventory{openssl, curl, requests, appPkg},
+				Secrets:     []*secrets.Secret{imageToken, frpToken},
+			},
+			cfg:  baseline.Config{IgnorePaths: []string{"var/lib/app/", "root"}},
+			want: &baseline.Drift{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := baseline.Compare(base, tc.host, tc.cfg)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(extractor.Inventory{}, "Extractor")); diff != "" {
+				t.Errorf("Compare() diff (-want +got):\n%s", diff)
+			}
+			if want := tc.want.Empty(); got.Empty() != want {
+				t.Errorf("Compare().Empty() = %t, want %t", got.Empty(), want)
+			}
+		})
+	}
+}
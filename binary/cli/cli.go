@@ -16,12 +16,19 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
 	"os"
+	"path"
+	"path/filepath"
 	"regexp"
+	"runtime"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gobwas/glob"
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -29,7 +36,9 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	scalibr "github.com/google/osv-scalibr"
 	scalibrimage "github.com/google/osv-scalibr/artifact/image"
+	"github.com/google/osv-scalibr/artifact/image/layerscanning/baseimage"
 	"github.com/google/osv-scalibr/binary/cdx"
+	"github.com/google/osv-scalibr/binary/jsonresult"
 	"github.com/google/osv-scalibr/binary/platform"
 	"github.com/google/osv-scalibr/binary/proto"
 	"github.com/google/osv-scalibr/binary/spdx"
@@ -37,14 +46,33 @@ import (
 	"github.com/google/osv-scalibr/detector"
 	"github.com/google/osv-scalibr/detector/govulncheck/binary"
 	dl "github.com/google/osv-scalibr/detector/list"
+	"github.com/google/osv-scalibr/detector/localization"
+	"github.com/google/osv-scalibr/detector/vulnmatch/osvoffline"
+	"github.com/google/osv-scalibr/discovery"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/cache"
 	el "github.com/google/osv-scalibr/extractor/filesystem/list"
+	"github.com/google/osv-scalibr/extractor/filesystem/throttle"
 	"github.com/google/osv-scalibr/extractor/standalone"
 	sl "github.com/google/osv-scalibr/extractor/standalone/list"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/ospackages"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/regosversion"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/regpatchlevel"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/securityproducts"
 	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/fs/sshfs"
+	"github.com/google/osv-scalibr/fs/vss"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/plugin/budget"
+	"github.com/google/osv-scalibr/summary"
+	"github.com/google/osv-scalibr/veles"
+	secretslist "github.com/google/osv-scalibr/veles/secrets/list"
 	"github.com/spdx/tools-golang/spdx/v2/common"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"golang.org/x/text/language"
 )
 
 // Array is a type to be passed to flag.Var that supports arrays passed as repeated flags,
@@ -126,8 +154,11 @@ type Flags struct {
 	SkipDirRegex          string
 	SkipDirGlob           string
 	RemoteImage           string
+	Image                 string
+	BaseImageCatalog      string
 	ImagePlatform         string
 	GovulncheckDBPath     string
+	OSVDBPath             string
 	SPDXDocumentName      string
 	SPDXDocumentNamespace string
 	SPDXCreators          string
@@ -139,29 +170,133 @@ type Flags struct {
 	FilterByCapabilities  bool
 	StoreAbsolutePath     bool
 	WindowsAllDrives      bool
+	WindowsVSS            bool
+	WindowsOfflineReg     bool
+	ReportCoverage        bool
+	DetectLicenses        bool
+	ScanSecrets           bool
+	ValidateSecrets       bool
+	ExtractorRetries      int
+	QuarantineBundleDir   string
+	ExtractionCache       string
+	PinnedPluginVersions  []string
+	Labels                []string
+	Locale                string
+	MessageCatalog        string
+	MaxIOPS               int
+	MaxReadBytesPerSecond int64
+	CPUShare              float64
+	PluginTimeout         time.Duration
+	PluginMaxFiles        int
+	PluginMaxBytes        int64
+	Discover              bool
+	DiscoverDryRun        bool
+	SSHHost               string
+	SSHIdentity           string
+	SSHKnownHosts         string
+	SSHMaxConcurrency     int
+	SummaryFile           string
+	SeverityThreshold     string
+	DetailedExitCodes     bool
+
+	// The JSON Lines output that the results are streamed to while scanning,
+	// set up by GetScanConfig.
+	lineFile   *os.File
+	lineWriter *jsonresult.LineWriter
+	// The binproto output that the results are streamed to while scanning,
+	// set up by GetScanConfig.
+	protoWriter *proto.StreamWriter
+	// Counts the results streamed to the outputs for the scan summary, set up
+	// by GetScanConfig.
+	collector *summary.Collector
+	// The filesystems of --windows-vss whose shadow copies are deleted by Close.
+	vssFS []*vss.FS
 }
 
 var supportedOutputFormats = []string{
-	"textproto", "binproto", "spdx23-tag-value", "spdx23-json", "spdx23-yaml", "cdx-json", "cdx-xml",
+	"textproto", "binproto", "spdx23-tag-value", "spdx23-json", "spdx23-yaml", "cdx-json", "cdx-xml", "json", "jsonl",
 }
 
 // ValidateFlags validates the passed command line flags.
 func ValidateFlags(flags *Flags) error {
-	if len(flags.ResultFile) == 0 && len(flags.Output) == 0 {
+	if len(flags.ResultFile) == 0 && len(flags.Output) == 0 && !flags.DiscoverDryRun {
 		return errors.New("either --result or --o needs to be set")
 	}
 	if flags.Root != "" && flags.WindowsAllDrives {
 		return errors.New("--root and --windows-all-drives cannot be used together")
 	}
-	if flags.ImagePlatform != "" && len(flags.RemoteImage) == 0 {
-		return errors.New("--image-platform cannot be used without --remote-image")
+	if flags.Image != "" && (flags.RemoteImage != "" || flags.Root != "" || flags.Discover || flags.SSHHost != "" || flags.WindowsAllDrives) {
+		return errors.New("--image cannot be used together with --remote-image, --root, --discover, --ssh-host or --windows-all-drives")
+	}
+	if flags.Discover && flags.RemoteImage != "" {
+		return errors.New("--discover and --remote-image cannot be used together")
+	}
+	if flags.Discover && flags.WindowsAllDrives {
+		return errors.New("--discover and --windows-all-drives cannot be used together")
+	}
+	if flags.DiscoverDryRun && !flags.Discover {
+		return errors.New("--discover-dry-run cannot be used without --discover")
+	}
+	if flags.SSHHost != "" && (flags.RemoteImage != "" || flags.Discover || flags.WindowsAllDrives) {
+		return errors.New("--ssh-host cannot be used together with --remote-image, --discover or --windows-all-drives")
+	}
+	if flags.WindowsVSS && (flags.RemoteImage != "" || flags.Discover || flags.SSHHost != "") {
+		return errors.New("--windows-vss cannot be used together with --remote-image, --discover or --ssh-host")
+	}
+	if flags.WindowsVSS && runtime.GOOS != "windows" {
+		return errors.New("--windows-vss is only supported on Windows")
+	}
+	if flags.WindowsOfflineReg && flags.Root == "" {
+		return errors.New("--windows-offline-registry requires --root to be set to the Windows image")
+	}
+	if flags.WindowsOfflineReg && flags.WindowsVSS {
+		return errors.New("--windows-offline-registry and --windows-vss cannot be used together")
+	}
+	if flags.ValidateSecrets && !flags.ScanSecrets {
+		return errors.New("--validate-secrets cannot be used without --secrets")
+	}
+	if flags.SSHHost == "" && (flags.SSHIdentity != "" || flags.SSHKnownHosts != "") {
+		return errors.New("--ssh-identity and --ssh-known-hosts cannot be used without --ssh-host")
+	}
+	if flags.SSHHost != "" {
+		if _, _, err := parseSSHHost(flags.SSHHost); err != nil {
+			return fmt.Errorf("--ssh-host: %w", err)
+		}
+	}
+	if flags.SSHMaxConcurrency < 0 {
+		return errors.New("--ssh-max-concurrency must not be negative")
+	}
+	if flags.OSVDBPath != "" {
+		if _, err := os.Stat(flags.OSVDBPath); err != nil {
+			return fmt.Errorf("--osv-db: %w", err)
+		}
+	}
+	if flags.BaseImageCatalog != "" && flags.Image == "" {
+		return errors.New("--base-image-catalog cannot be used without --image")
+	}
+	if err := validateLocale(flags.Locale, flags.MessageCatalog); err != nil {
+		return err
+	}
+	if flags.ImagePlatform != "" && len(flags.RemoteImage) == 0 && len(flags.Image) == 0 {
+		return errors.New("--image-platform cannot be used without --remote-image or --image")
 	}
 	if err := validateResultPath(flags.ResultFile); err != nil {
 		return fmt.Errorf("--result %w", err)
 	}
+	if err := validateResultPath(flags.SummaryFile); err != nil {
+		return fmt.Errorf("--summary %w", err)
+	}
+	if flags.SeverityThreshold != "" {
+		if _, err := summary.ParseSeverity(flags.SeverityThreshold); err != nil {
+			return fmt.Errorf("--severity-threshold: %w", err)
+		}
+	}
 	if err := validateOutput(flags.Output); err != nil {
 		return fmt.Errorf("--o %w", err)
 	}
+	if _, ok := flags.jsonlOutput(); ok && (len(flags.Output) > 1 || flags.ResultFile != "") {
+		return errors.New("--o jsonl cannot be used together with other outputs since the results are streamed to it instead of being kept in memory")
+	}
 	if err := validateImagePlatform(flags.ImagePlatform); err != nil {
 		return fmt.Errorf("--image-platform %w", err)
 	}
@@ -182,6 +317,27 @@ func ValidateFlags(flags *Flags) error {
 	if err := validateGlob(flags.SkipDirGlob); err != nil {
 		return fmt.Errorf("--skip-dir-glob: %w", err)
 	}
+	if flags.ExtractorRetries < 0 {
+		return errors.New("--extractor-retries must not be negative")
+	}
+	if flags.MaxIOPS < 0 {
+		return errors.New("--max-iops must not be negative")
+	}
+	if flags.MaxReadBytesPerSecond < 0 {
+		return errors.New("--max-read-bytes-per-sec must not be negative")
+	}
+	if flags.CPUShare < 0 || flags.CPUShare > 1 {
+		return errors.New("--cpu-share must be between 0 and 1")
+	}
+	if flags.PluginTimeout < 0 || flags.PluginMaxFiles < 0 || flags.PluginMaxBytes < 0 {
+		return errors.New("--plugin-timeout, --plugin-max-files and --plugin-max-bytes must not be negative")
+	}
+	if _, err := parsePinnedPluginVersions(flags.PinnedPluginVersions); err != nil {
+		return fmt.Errorf("--pinned-plugin-versions: %w", err)
+	}
+	if _, err := parseLabels(flags.Labels); err != nil {
+		return fmt.Errorf("--labels: %w", err)
+	}
 	if err := validateDetectorDependency(flags.DetectorsToRun, flags.ExtractorsToRun, flags.ExplicitExtractors); err != nil {
 		return err
 	}
@@ -236,6 +392,22 @@ func validateSPDXCreators(creators string) error {
 	return nil
 }
 
+func validateLocale(locale, catalog string) error {
+	if locale == "" {
+		if catalog != "" {
+			return errors.New("--message-catalog cannot be used without --locale")
+		}
+		return nil
+	}
+	if catalog == "" {
+		return errors.New("--locale cannot be used without --message-catalog")
+	}
+	if _, err := language.Parse(locale); err != nil {
+		return fmt.Errorf("--locale %q is not a valid BCP 47 language tag: %w", locale, err)
+	}
+	return nil
+}
+
 func validateMultiStringArg(arg []string) error {
 	if len(arg) == 0 {
 		return nil
@@ -266,6 +438,50 @@ func validateGlob(arg string) error {
 	return err
 }
 
+// parsePinnedPluginVersions parses a list of name=version pairs into a map
+// from plugin name to pinned version.
+func parsePinnedPluginVersions(pins []string) (map[string]int, error) {
+	if len(pins) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]int, len(pins))
+	for _, pin := range pins {
+		name, version, ok := strings.Cut(pin, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid pin %q, should follow a format like python/wheelegg=0", pin)
+		}
+		v, err := strconv.Atoi(version)
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("invalid version in pin %q", pin)
+		}
+		if _, ok := result[name]; ok {
+			return nil, fmt.Errorf("plugin %s is pinned more than once", name)
+		}
+		result[name] = v
+	}
+	return result, nil
+}
+
+// parseLabels parses a list of key=value pairs into a map of scan result
+// labels.
+func parseLabels(labels []string) (map[string]string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string, len(labels))
+	for _, l := range labels {
+		key, value, ok := strings.Cut(l, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid label %q, should follow a format like tenant=acme", l)
+		}
+		if _, ok := result[key]; ok {
+			return nil, fmt.Errorf("label %s is set more than once", key)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
 func validateDetectorDependency(detectors []string, extractors []string, requireExtractors bool) error {
 	f := &Flags{
 		ExtractorsToRun: extractors,
@@ -331,6 +547,49 @@ func (f *Flags) GetScanConfig() (*scalibr.ScanConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	pinnedVersions, err := parsePinnedPluginVersions(f.PinnedPluginVersions)
+	if err != nil {
+		return nil, err
+	}
+	labels, err := parseLabels(f.Labels)
+	if err != nil {
+		return nil, err
+	}
+	var baseImageCatalog *baseimage.Catalog
+	if f.BaseImageCatalog != "" {
+		if baseImageCatalog, err = baseimage.LoadCatalog(f.BaseImageCatalog); err != nil {
+			return nil, err
+		}
+	}
+	var messageCatalog *localization.Catalog
+	if f.MessageCatalog != "" {
+		if messageCatalog, err = localization.LoadCatalog(f.MessageCatalog); err != nil {
+			return nil, err
+		}
+	}
+	var extractionCache cache.Cache
+	if f.ExtractionCache != "" {
+		if extractionCache, err = cache.Open(f.ExtractionCache); err != nil {
+			return nil, err
+		}
+	}
+	var resultSink scalibr.ResultSink
+	if path, ok := f.jsonlOutput(); ok {
+		if f.lineFile, err = os.Create(path); err != nil {
+			return nil, err
+		}
+		f.lineWriter = jsonresult.NewLineWriter(f.lineFile)
+		resultSink = f.lineWriter
+	} else if path, format, ok := f.binprotoStreamOutput(); ok {
+		if f.protoWriter, err = createProtoStream(path, format); err != nil {
+			return nil, err
+		}
+		resultSink = f.protoWriter
+	}
+	if resultSink != nil {
+		f.collector = summary.NewCollector(f.summaryConfig(), resultSink)
+		resultSink = f.collector
+	}
 
 	return &scalibr.ScanConfig{
 		ScanRoots:            scanRoots,
@@ -343,9 +602,79 @@ func (f *Flags) GetScanConfig() (*scalibr.ScanConfig, error) {
 		SkipDirRegex:         skipDirRegex,
 		SkipDirGlob:          skipDirGlob,
 		StoreAbsolutePath:    f.StoreAbsolutePath,
+		ReportCoverage:       f.ReportCoverage,
+		DetectLicenses:       f.DetectLicenses,
+		SecretDetectors:      f.secretDetectors(),
+		SecretValidation:     f.secretValidation(),
+		ExtractorRetries:     f.ExtractorRetries,
+		QuarantineBundleDir:  f.QuarantineBundleDir,
+		PinnedPluginVersions: pinnedVersions,
+		Throttle:             f.throttle(),
+		PluginBudgets:        f.pluginBudgets(),
+		Labels:               labels,
+		Cache:                extractionCache,
+		ResultSink:           resultSink,
+		BaseImageCatalog:     baseImageCatalog,
+		Locale:               f.Locale,
+		MessageCatalog:       messageCatalog,
 	}, nil
 }
 
+// secretDetectors returns the Veles detectors to scan the files with, or nil
+// if secret scanning isn't enabled.
+func (f *Flags) secretDetectors() []veles.Detector {
+	if !f.ScanSecrets {
+		return nil
+	}
+	return secretslist.Detectors()
+}
+
+// secretValidation returns the engine that validates the secrets found, or
+// nil if they aren't validated.
+func (f *Flags) secretValidation() *veles.ValidationEngine {
+	if !f.ValidateSecrets {
+		return nil
+	}
+	return secretslist.NewValidationEngine(nil)
+}
+
+// jsonlOutput returns the path of the JSON Lines output, if any.
+func (f *Flags) jsonlOutput() (string, bool) {
+	for _, item := range f.Output {
+		if oFormat, oPath, _ := strings.Cut(item, "="); oFormat == "jsonl" {
+			return oPath, true
+		}
+	}
+	return "", false
+}
+
+// throttle returns the throttler configured by the flags, or nil if the scan
+// isn't throttled.
+func (f *Flags) throttle() *throttle.Throttler {
+	if f.MaxIOPS == 0 && f.MaxReadBytesPerSecond == 0 && f.CPUShare == 0 {
+		return nil
+	}
+	return throttle.New(throttle.Config{
+		MaxIOPS:           f.MaxIOPS,
+		MaxBytesPerSecond: f.MaxReadBytesPerSecond,
+		CPUShare:          f.CPUShare,
+	})
+}
+
+// pluginBudgets returns the plugin budgets configured by the flags, or nil if
+// the plugins aren't limited.
+func (f *Flags) pluginBudgets() *budget.Budgets {
+	b := budget.Budget{
+		WallTime: f.PluginTimeout,
+		MaxFiles: f.PluginMaxFiles,
+		MaxBytes: f.PluginMaxBytes,
+	}
+	if b.IsZero() {
+		return nil
+	}
+	return &budget.Budgets{Default: b}
+}
+
 // GetSPDXConfig creates an SPDXConfig struct based on the CLI flags.
 func (f *Flags) GetSPDXConfig() converter.SPDXConfig {
 	creators := []common.Creator{}
@@ -380,12 +709,18 @@ func (f *Flags) GetCDXConfig() converter.CDXConfig {
 func (f *Flags) WriteScanResults(result *scalibr.ScanResult) error {
 	if len(f.ResultFile) > 0 {
 		log.Infof("Writing scan results to %s", f.ResultFile)
-		resultProto, err := proto.ScanResultToProto(result)
-		if err != nil {
-			return err
-		}
-		if err := proto.Write(f.ResultFile, resultProto); err != nil {
-			return err
+		if isBinproto(f.ResultFile) {
+			if err := f.writeProtoStream(result, f.ResultFile, ""); err != nil {
+				return err
+			}
+		} else {
+			resultProto, err := proto.ScanResultToProto(result)
+			if err != nil {
+				return err
+			}
+			if err := proto.Write(f.ResultFile, resultProto); err != nil {
+				return err
+			}
 		}
 	}
 	if len(f.Output) > 0 {
@@ -394,7 +729,11 @@ func (f *Flags) WriteScanResults(result *scalibr.ScanResult) error {
 			oFormat := o[0]
 			oPath := o[1]
 			log.Infof("Writing scan results to %s", oPath)
-			if strings.Contains(oFormat, "proto") {
+			if oFormat == "binproto" {
+				if err := f.writeProtoStream(result, oPath, oFormat); err != nil {
+					return err
+				}
+			} else if strings.Contains(oFormat, "proto") {
 				resultProto, err := proto.ScanResultToProto(result)
 				if err != nil {
 					return err
@@ -412,12 +751,104 @@ func (f *Flags) WriteScanResults(result *scalibr.ScanResult) error {
 				if err := cdx.Write(doc, oPath, oFormat); err != nil {
 					return err
 				}
+			} else if oFormat == "json" {
+				if err := jsonresult.Write(result, oPath); err != nil {
+					return err
+				}
+			} else if oFormat == "jsonl" {
+				if err := f.writeLines(result, oPath); err != nil {
+					return err
+				}
 			}
 		}
 	}
 	return nil
 }
 
+// summaryConfig returns the config for summarizing the scan result.
+func (f *Flags) summaryConfig() summary.Config {
+	cfg := summary.DefaultConfig()
+	if sev, err := summary.ParseSeverity(f.SeverityThreshold); err == nil {
+		cfg.SeverityThreshold = sev
+	}
+	return cfg
+}
+
+// Summarize returns the summary of the scan result, including the results
+// that were streamed to the outputs during the scan.
+func (f *Flags) Summarize(result *scalibr.ScanResult) *summary.Summary {
+	if f.collector != nil {
+		return f.collector.Summarize(result)
+	}
+	return summary.Summarize(result, f.summaryConfig())
+}
+
+// WriteSummary writes the scan summary to the --summary file, if set.
+func (f *Flags) WriteSummary(s *summary.Summary) error {
+	if f.SummaryFile == "" {
+		return nil
+	}
+	log.Infof("Writing scan summary to %s", f.SummaryFile)
+	return proto.Write(f.SummaryFile, proto.ScanSummaryToProto(s))
+}
+
+// writeLines finishes the JSON Lines output that the results were streamed to
+// during the scan, or writes result to path if they weren't streamed.
+func (f *Flags) writeLines(result *scalibr.ScanResult, path string) error {
+	if f.lineWriter == nil {
+		return jsonresult.WriteLines(result, path)
+	}
+	err := f.lineWriter.Finish(result)
+	if cerr := f.lineFile.Close(); err == nil {
+		err = cerr
+	}
+	f.lineFile, f.lineWriter = nil, nil
+	return err
+}
+
+// binprotoStreamOutput returns the path and format of the binproto output if
+// it's the only output, in which case the results are streamed to it during
+// the scan. The format is empty if the output is set with --result.
+func (f *Flags) binprotoStreamOutput() (path string, format string, ok bool) {
+	if f.ResultFile != "" {
+		return f.ResultFile, "", len(f.Output) == 0 && isBinproto(f.ResultFile)
+	}
+	if len(f.Output) != 1 {
+		return "", "", false
+	}
+	oFormat, oPath, _ := strings.Cut(f.Output[0], "=")
+	return oPath, oFormat, oFormat == "binproto"
+}
+
+// writeProtoStream finishes the binproto output that the results were
+// streamed to during the scan, or writes result to path item by item if they
+// weren't streamed.
+func (f *Flags) writeProtoStream(result *scalibr.ScanResult, path string, format string) error {
+	w := f.protoWriter
+	f.protoWriter = nil
+	if w == nil {
+		var err error
+		if w, err = createProtoStream(path, format); err != nil {
+			return err
+		}
+	}
+	return w.Finish(result)
+}
+
+// createProtoStream creates the binproto output at path, with its format
+// derived from the extension if format is empty.
+func createProtoStream(path string, format string) (*proto.StreamWriter, error) {
+	if format == "" {
+		return proto.CreateStream(path)
+	}
+	return proto.CreateStreamWithFormat(path, format)
+}
+
+// isBinproto returns whether path is a .binproto or .binproto.gz file.
+func isBinproto(path string) bool {
+	return strings.HasSuffix(path, ".binproto") || strings.HasSuffix(path, ".binproto.gz")
+}
+
 // TODO(b/279413691): Allow commas in argument names.
 func (f *Flags) extractorsToRun() ([]filesystem.Extractor, []standalone.Extractor, error) {
 	if len(f.ExtractorsToRun) == 0 {
@@ -445,20 +876,53 @@ func (f *Flags) extractorsToRun() ([]filesystem.Extractor, []standalone.Extracto
 		}
 	}
 
+	if f.WindowsOfflineReg {
+		standaloneExtractors = withOfflineRegistry(standaloneExtractors)
+	}
+
 	return fsExtractors, standaloneExtractors, nil
 }
 
+// withOfflineRegistry replaces the registry-based Windows extractors with ones that read the
+// registry hives of the Windows image found at the scan root.
+func withOfflineRegistry(exs []standalone.Extractor) []standalone.Extractor {
+	result := make([]standalone.Extractor, 0, len(exs))
+	for _, ex := range exs {
+		switch ex.Name() {
+		case ospackages.Name:
+			ex = ospackages.New(ospackages.OfflineConfiguration())
+		case regosversion.Name:
+			ex = regosversion.New(regosversion.OfflineConfiguration())
+		case regpatchlevel.Name:
+			ex = regpatchlevel.New(regpatchlevel.OfflineConfiguration())
+		case securityproducts.Name:
+			ex = securityproducts.New(securityproducts.OfflineConfiguration())
+		}
+		result = append(result, ex)
+	}
+	return result
+}
+
 func (f *Flags) detectorsToRun() ([]detector.Detector, error) {
-	if len(f.DetectorsToRun) == 0 {
+	names := multiStringToList(f.DetectorsToRun)
+	// Setting an OSV database snapshot enables matching against it.
+	if f.OSVDBPath != "" {
+		names = append(names, osvoffline.Name)
+	}
+	if len(names) == 0 {
 		return []detector.Detector{}, nil
 	}
-	dets, err := dl.DetectorsFromNames(multiStringToList(f.DetectorsToRun))
+	dets, err := dl.DetectorsFromNames(names)
 	if err != nil {
 		return []detector.Detector{}, err
 	}
-	for _, d := range dets {
-		if d.Name() == binary.Name {
+	for i, d := range dets {
+		switch d.Name() {
+		case binary.Name:
 			d.(*binary.Detector).OfflineVulnDBPath = f.GovulncheckDBPath
+		case osvoffline.Name:
+			// The detector caches the loaded snapshot, so each config gets its own.
+			dets[i] = &osvoffline.Detector{DBPath: f.OSVDBPath}
 		}
 	}
 	return dets, nil
@@ -473,6 +937,11 @@ func multiStringToList(arg []string) []string {
 }
 
 func (f *Flags) scanRoots() ([]*scalibrfs.ScanRoot, error) {
+	if f.Image != "" {
+		// The scan root is set to the image's filesystem by ScanImage.
+		return nil, nil
+	}
+
 	if f.RemoteImage != "" {
 		imageOptions, err := f.scanRemoteImageOptions()
 		if err != nil {
@@ -486,23 +955,160 @@ func (f *Flags) scanRoots() ([]*scalibrfs.ScanRoot, error) {
 		return []*scalibrfs.ScanRoot{{FS: fs, Path: ""}}, nil
 	}
 
-	if len(f.Root) != 0 {
-		return scalibrfs.RealFSScanRoots(f.Root), nil
+	if f.SSHHost != "" {
+		cfg, err := f.sshConfig()
+		if err != nil {
+			return nil, err
+		}
+		fs, err := sshfs.New(cfg)
+		if err != nil {
+			return nil, err
+		}
+		// The connection stays open until the scanner exits.
+		return []*scalibrfs.ScanRoot{fs.ScanRoot()}, nil
+	}
+
+	if f.Discover {
+		plan, err := f.DiscoveryPlan()
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("Discovered scan targets:\n%s", plan)
+		return plan.ScanRoots(), nil
 	}
 
-	// Compute the default scan roots.
-	var scanRoots []*scalibrfs.ScanRoot
 	var scanRootPaths []string
-	var err error
-	if scanRootPaths, err = platform.DefaultScanRoots(f.WindowsAllDrives); err != nil {
-		return nil, err
+	if len(f.Root) != 0 {
+		scanRootPaths = []string{f.Root}
+	} else {
+		// Compute the default scan roots.
+		var err error
+		if scanRootPaths, err = platform.DefaultScanRoots(f.WindowsAllDrives); err != nil {
+			return nil, err
+		}
 	}
+	var scanRoots []*scalibrfs.ScanRoot
 	for _, r := range scanRootPaths {
-		scanRoots = append(scanRoots, &scalibrfs.ScanRoot{FS: scalibrfs.DirFS(r), Path: r})
+		if !f.WindowsVSS {
+			scanRoots = append(scanRoots, scalibrfs.RealFSScanRoot(r))
+			continue
+		}
+		fs, err := vss.New(vss.DefaultConfig(r))
+		if err != nil {
+			return nil, err
+		}
+		f.vssFS = append(f.vssFS, fs)
+		scanRoots = append(scanRoots, fs.ScanRoot())
 	}
 	return scanRoots, nil
 }
 
+// Close releases the resources that were set up for the scan by
+// GetScanConfig, i.e. deletes the shadow copies created for --windows-vss.
+func (f *Flags) Close() error {
+	var errs []error
+	for _, fs := range f.vssFS {
+		if err := fs.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	f.vssFS = nil
+	return errors.Join(errs...)
+}
+
+// parseSSHHost splits a user@host[:port] argument into the user and the
+// address of the SSH server.
+func parseSSHHost(arg string) (string, string, error) {
+	user, addr, ok := strings.Cut(arg, "@")
+	if !ok || user == "" || addr == "" {
+		return "", "", fmt.Errorf("invalid host %q, should follow a format like user@host or user@host:port", arg)
+	}
+	return user, addr, nil
+}
+
+// sshConfig returns the config of the remote filesystem to scan over SSH.
+// Keys are read from --ssh-identity or, if it's not set, from the SSH agent.
+func (f *Flags) sshConfig() (sshfs.Config, error) {
+	user, addr, err := parseSSHHost(f.SSHHost)
+	if err != nil {
+		return sshfs.Config{}, err
+	}
+	auth, err := f.sshAuthMethod()
+	if err != nil {
+		return sshfs.Config{}, err
+	}
+	knownHosts := f.SSHKnownHosts
+	if knownHosts == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return sshfs.Config{}, err
+		}
+		knownHosts = filepath.Join(home, ".ssh", "known_hosts")
+	}
+	hostKeyCallback, err := knownhosts.New(knownHosts)
+	if err != nil {
+		return sshfs.Config{}, fmt.Errorf("failed to read known hosts: %w", err)
+	}
+
+	cfg := sshfs.DefaultConfig()
+	cfg.Address = addr
+	cfg.ClientConfig = &ssh.ClientConfig{
+		User:            user,
+		Auth:            []ssh.AuthMethod{auth},
+		HostKeyCallback: hostKeyCallback,
+	}
+	if f.Root != "" {
+		cfg.Root = f.Root
+	}
+	if f.SSHMaxConcurrency > 0 {
+		cfg.MaxConcurrentOperations = f.SSHMaxConcurrency
+	}
+	return cfg, nil
+}
+
+func (f *Flags) sshAuthMethod() (ssh.AuthMethod, error) {
+	if f.SSHIdentity != "" {
+		key, err := os.ReadFile(f.SSHIdentity)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", f.SSHIdentity, err)
+		}
+		return ssh.PublicKeys(signer), nil
+	}
+	sock := os.Getenv("SSH_AUTH_SOCK")
+	if sock == "" {
+		return nil, errors.New("no SSH key: set --ssh-identity or run an SSH agent")
+	}
+	conn, err := net.Dial("unix", sock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the SSH agent: %w", err)
+	}
+	return ssh.PublicKeysCallback(agent.NewClient(conn).Signers), nil
+}
+
+// DiscoveryPlan returns the scan targets discovered on the host. If --root is
+// set, it's used as the mount point of the host's filesystem.
+func (f *Flags) DiscoveryPlan() (*discovery.Plan, error) {
+	cfg := discovery.DefaultConfig()
+	if f.Root != "" {
+		cfg.Root = f.Root
+	}
+	return discovery.Discover(context.Background(), cfg, f.capabilities())
+}
+
+// ScanImage runs the scan on the container image set with --image. Its layers are pulled from the
+// registry and the found inventory is annotated with the layer that added it.
+func (f *Flags) ScanImage(ctx context.Context, cfg *scalibr.ScanConfig) (*scalibr.ScanResult, error) {
+	imageOptions, err := f.scanRemoteImageOptions()
+	if err != nil {
+		return nil, err
+	}
+	return scalibr.New().ScanRemoteImage(ctx, f.Image, cfg, *imageOptions...)
+}
+
 func (f *Flags) scanRemoteImageOptions() (*[]remote.Option, error) {
 	imageOptions := []remote.Option{
 		remote.WithAuthFromKeychain(authn.DefaultKeychain),
@@ -521,7 +1127,16 @@ func (f *Flags) scanRemoteImageOptions() (*[]remote.Option, error) {
 
 // All capabilities are enabled when running SCALIBR as a binary.
 func (f *Flags) capabilities() *plugin.Capabilities {
-	if f.RemoteImage != "" {
+	if f.SSHHost != "" {
+		// We're scanning a remote Linux host through SFTP.
+		return &plugin.Capabilities{
+			OS:            plugin.OSLinux,
+			Network:       true,
+			DirectFS:      false,
+			RunningSystem: false,
+		}
+	}
+	if f.RemoteImage != "" || f.Image != "" {
 		// We're scanning a Linux container image whose filesystem is mounted to the host's disk.
 		return &plugin.Capabilities{
 			OS:            plugin.OSLinux,
@@ -566,6 +1181,9 @@ func filterByCapabilities(
 }
 
 func (f *Flags) dirsToSkip(scanRoots []*scalibrfs.ScanRoot) []string {
+	if f.SSHHost != "" {
+		return f.remoteDirsToSkip()
+	}
 	paths, err := platform.DefaultIgnoredDirectories()
 	if err != nil {
 		log.Warnf("Failed to get default ignored directories: %v", err)
@@ -590,6 +1208,25 @@ func (f *Flags) dirsToSkip(scanRoots []*scalibrfs.ScanRoot) []string {
 	return result
 }
 
+// remoteDirsToSkip returns the directories to skip on --ssh-host relative to
+// the remote scan root, since the remote filesystem is walked as a virtual
+// filesystem.
+func (f *Flags) remoteDirsToSkip() []string {
+	root := "/"
+	if f.Root != "" {
+		root = path.Clean(f.Root)
+	}
+	prefix := strings.TrimSuffix(root, "/") + "/"
+	paths := append([]string{"/dev", "/proc", "/sys"}, multiStringToList(f.DirsToSkip)...)
+	result := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if rel, ok := strings.CutPrefix(path.Clean(p), prefix); ok {
+			result = append(result, rel)
+		}
+	}
+	return result
+}
+
 func keys(m map[string][]string) []string {
 	ret := make([]string, 0, len(m))
 	for k := range m {
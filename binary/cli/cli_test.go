@@ -26,6 +26,7 @@ import (
 	scalibr "github.com/google/osv-scalibr"
 	"github.com/google/osv-scalibr/binary/cli"
 	"github.com/google/osv-scalibr/detector/govulncheck/binary"
+	"github.com/google/osv-scalibr/detector/vulnmatch/osvoffline"
 	"github.com/google/osv-scalibr/plugin"
 )
 
@@ -52,6 +53,29 @@ func TestValidateFlags(t *testing.T) {
 			desc:    "Either output flag missing",
 			flags:   &cli.Flags{Root: "/"},
 			wantErr: cmpopts.AnyError,
+		}, {
+			desc: "Summary and severity threshold",
+			flags: &cli.Flags{
+				ResultFile:        "result.textproto",
+				SummaryFile:       "summary.binproto",
+				SeverityThreshold: "Medium",
+				DetailedExitCodes: true,
+			},
+			wantErr: nil,
+		}, {
+			desc: "Invalid summary extension",
+			flags: &cli.Flags{
+				ResultFile:  "result.textproto",
+				SummaryFile: "summary.json",
+			},
+			wantErr: cmpopts.AnyError,
+		}, {
+			desc: "Invalid severity threshold",
+			flags: &cli.Flags{
+				ResultFile:        "result.textproto",
+				SeverityThreshold: "severe",
+			},
+			wantErr: cmpopts.AnyError,
 		}, {
 			desc: "Result flag present",
 			flags: &cli.Flags{
@@ -80,6 +104,14 @@ func TestValidateFlags(t *testing.T) {
 				Output: []string{"invalid"},
 			},
 			wantErr: cmpopts.AnyError,
+		}, {
+			desc: "JSON Lines output with other outputs",
+			flags: &cli.Flags{
+				Root:       "/",
+				ResultFile: "result.textproto",
+				Output:     []string{"jsonl=result.jsonl"},
+			},
+			wantErr: cmpopts.AnyError,
 		}, {
 			desc: "Unknown output format",
 			flags: &cli.Flags{
@@ -193,6 +225,295 @@ func TestValidateFlags(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			desc: "Image Platform with Image",
+			flags: &cli.Flags{
+				Image:         "registry.example.com/app:1.2",
+				ImagePlatform: "linux/amd64",
+				ResultFile:    "result.textproto",
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "Base image catalog without Image",
+			flags: &cli.Flags{
+				BaseImageCatalog: "catalog.json",
+				ResultFile:       "result.textproto",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Locale without message catalog",
+			flags: &cli.Flags{
+				Locale:     "de",
+				ResultFile: "result.textproto",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Message catalog without locale",
+			flags: &cli.Flags{
+				MessageCatalog: "catalog.json",
+				ResultFile:     "result.textproto",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Invalid locale",
+			flags: &cli.Flags{
+				Locale:         "not a locale",
+				MessageCatalog: "catalog.json",
+				ResultFile:     "result.textproto",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Locale with message catalog",
+			flags: &cli.Flags{
+				Locale:         "pt-BR",
+				MessageCatalog: "catalog.json",
+				ResultFile:     "result.textproto",
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "Image with Remote Image",
+			flags: &cli.Flags{
+				Image:       "registry.example.com/app:1.2",
+				RemoteImage: "docker",
+				ResultFile:  "result.textproto",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Image with root",
+			flags: &cli.Flags{
+				Image:      "registry.example.com/app:1.2",
+				Root:       "/",
+				ResultFile: "result.textproto",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Valid pinned plugin versions",
+			flags: &cli.Flags{
+				ResultFile:           "result.textproto",
+				PinnedPluginVersions: []string{"python/wheelegg=0", "cis=1"},
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "Pinned plugin version without version",
+			flags: &cli.Flags{
+				ResultFile:           "result.textproto",
+				PinnedPluginVersions: []string{"python/wheelegg"},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Pinned plugin version not a number",
+			flags: &cli.Flags{
+				ResultFile:           "result.textproto",
+				PinnedPluginVersions: []string{"python/wheelegg=latest"},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Plugin pinned twice",
+			flags: &cli.Flags{
+				ResultFile:           "result.textproto",
+				PinnedPluginVersions: []string{"cis=0", "cis=1"},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Valid labels",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				Labels:     []string{"tenant=acme", "environment=prod", "owner="},
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "Label without value",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				Labels:     []string{"tenant"},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Label without key",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				Labels:     []string{"=acme"},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Label set twice",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				Labels:     []string{"tenant=a", "tenant=b"},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Negative extractor retries",
+			flags: &cli.Flags{
+				ResultFile:       "result.textproto",
+				ExtractorRetries: -1,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Negative max IOPS",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				MaxIOPS:    -1,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "CPU share above 1",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				CPUShare:   1.5,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Discovery with remote image",
+			flags: &cli.Flags{
+				ResultFile:  "result.textproto",
+				Discover:    true,
+				RemoteImage: "docker",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Discovery dry run without discovery",
+			flags: &cli.Flags{
+				ResultFile:     "result.textproto",
+				DiscoverDryRun: true,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Discovery dry run without output",
+			flags: &cli.Flags{
+				Root:           "/",
+				Discover:       true,
+				DiscoverDryRun: true,
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "Valid SSH host",
+			flags: &cli.Flags{
+				ResultFile:        "result.textproto",
+				Root:              "/srv",
+				SSHHost:           "scanner@db1.example.com:2222",
+				SSHIdentity:       "/home/user/.ssh/id_ed25519",
+				SSHMaxConcurrency: 4,
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "SSH host without user",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				SSHHost:    "db1.example.com",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "SSH host with remote image",
+			flags: &cli.Flags{
+				ResultFile:  "result.textproto",
+				SSHHost:     "scanner@db1.example.com",
+				RemoteImage: "docker",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "SSH identity without SSH host",
+			flags: &cli.Flags{
+				ResultFile:  "result.textproto",
+				SSHIdentity: "/home/user/.ssh/id_ed25519",
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Negative SSH concurrency",
+			flags: &cli.Flags{
+				ResultFile:        "result.textproto",
+				SSHHost:           "scanner@db1.example.com",
+				SSHMaxConcurrency: -1,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Windows VSS with SSH host",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				SSHHost:    "scanner@db1.example.com",
+				WindowsVSS: true,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Windows offline registry without root",
+			flags: &cli.Flags{
+				ResultFile:        "result.textproto",
+				WindowsOfflineReg: true,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Windows offline registry with VSS",
+			flags: &cli.Flags{
+				ResultFile:        "result.textproto",
+				Root:              "/mnt/winvm",
+				WindowsOfflineReg: true,
+				WindowsVSS:        true,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Windows offline registry",
+			flags: &cli.Flags{
+				ResultFile:        "result.textproto",
+				Root:              "/mnt/winvm",
+				WindowsOfflineReg: true,
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "Secret validation without secret scanning",
+			flags: &cli.Flags{
+				ResultFile:      "result.textproto",
+				ValidateSecrets: true,
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc: "Secret scanning and validation",
+			flags: &cli.Flags{
+				ResultFile:      "result.textproto",
+				ScanSecrets:     true,
+				ValidateSecrets: true,
+			},
+			wantErr: nil,
+		},
+		{
+			desc: "Missing OSV database snapshot",
+			flags: &cli.Flags{
+				ResultFile: "result.textproto",
+				OSVDBPath:  "/does/not/exist/osv.db",
+			},
+			wantErr: cmpopts.AnyError,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := cli.ValidateFlags(tc.flags)
@@ -423,6 +744,74 @@ func TestGetScanConfig_CreatePlugins(t *testing.T) {
 	}
 }
 
+func TestGetScanConfig_PinnedPluginVersions(t *testing.T) {
+	flags := &cli.Flags{
+		ExtractorsToRun:      []string{"python/wheelegg"},
+		PinnedPluginVersions: []string{"python/wheelegg=0", "cis=1"},
+	}
+	cfg, err := flags.GetScanConfig()
+	if err != nil {
+		t.Fatalf("%v.GetScanConfig(): %v", flags, err)
+	}
+	want := map[string]int{"python/wheelegg": 0, "cis": 1}
+	if diff := cmp.Diff(want, cfg.PinnedPluginVersions); diff != "" {
+		t.Errorf("%v.GetScanConfig() PinnedPluginVersions got diff (-want +got):\n%s", flags, diff)
+	}
+}
+
+func TestGetScanConfig_Labels(t *testing.T) {
+	flags := &cli.Flags{
+		ExtractorsToRun: []string{"python/wheelegg"},
+		Labels:          []string{"tenant=acme", "cost-center=1234"},
+	}
+	cfg, err := flags.GetScanConfig()
+	if err != nil {
+		t.Fatalf("%v.GetScanConfig(): %v", flags, err)
+	}
+	want := map[string]string{"tenant": "acme", "cost-center": "1234"}
+	if diff := cmp.Diff(want, cfg.Labels); diff != "" {
+		t.Errorf("%v.GetScanConfig() Labels got diff (-want +got):\n%s", flags, diff)
+	}
+}
+
+func TestGetScanConfig_Secrets(t *testing.T) {
+	for _, tc := range []struct {
+		desc           string
+		flags          *cli.Flags
+		wantDetectors  bool
+		wantValidation bool
+	}{
+		{
+			desc:  "Secrets not scanned",
+			flags: &cli.Flags{ExtractorsToRun: []string{"python/wheelegg"}},
+		},
+		{
+			desc:          "Secrets scanned",
+			flags:         &cli.Flags{ExtractorsToRun: []string{"python/wheelegg"}, ScanSecrets: true},
+			wantDetectors: true,
+		},
+		{
+			desc:           "Secrets scanned and validated",
+			flags:          &cli.Flags{ExtractorsToRun: []string{"python/wheelegg"}, ScanSecrets: true, ValidateSecrets: true},
+			wantDetectors:  true,
+			wantValidation: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := tc.flags.GetScanConfig()
+			if err != nil {
+				t.Fatalf("%v.GetScanConfig(): %v", tc.flags, err)
+			}
+			if got := len(cfg.SecretDetectors) > 0; got != tc.wantDetectors {
+				t.Errorf("%v.GetScanConfig(): got %d secret detectors, want detectors: %v", tc.flags, len(cfg.SecretDetectors), tc.wantDetectors)
+			}
+			if got := cfg.SecretValidation != nil; got != tc.wantValidation {
+				t.Errorf("%v.GetScanConfig(): secret validation enabled: %v, want %v", tc.flags, got, tc.wantValidation)
+			}
+		})
+	}
+}
+
 func TestGetScanConfig_GovulncheckParams(t *testing.T) {
 	dbPath := "path/to/db"
 	flags := &cli.Flags{
@@ -444,6 +833,169 @@ func TestGetScanConfig_GovulncheckParams(t *testing.T) {
 	}
 }
 
+func TestGetScanConfig_WindowsOfflineRegistry(t *testing.T) {
+	flags := &cli.Flags{
+		ExtractorsToRun:   []string{"windows/ospackages", "windows/regosversion"},
+		WindowsOfflineReg: true,
+	}
+
+	cfg, err := flags.GetScanConfig()
+	if err != nil {
+		t.Fatalf("%v.GetScanConfig(): %v", flags, err)
+	}
+	got := map[string]bool{}
+	for _, e := range cfg.StandaloneExtractors {
+		got[e.Name()] = e.Requirements().RunningSystem
+	}
+	want := map[string]bool{
+		"windows/ospackages":   false,
+		"windows/regosversion": false,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("%v.GetScanConfig() standalone extractors needing a running system (-want +got):\n%s", flags, diff)
+	}
+}
+
+func TestGetScanConfig_Image(t *testing.T) {
+	flags := &cli.Flags{
+		Image:           "registry.example.com/app:1.2",
+		ExtractorsToRun: []string{"python/wheelegg"},
+	}
+
+	cfg, err := flags.GetScanConfig()
+	if err != nil {
+		t.Fatalf("%v.GetScanConfig(): %v", flags, err)
+	}
+	if len(cfg.ScanRoots) != 0 {
+		t.Errorf("%v.GetScanConfig() got scan roots %v, want none", flags, cfg.ScanRoots)
+	}
+	want := &plugin.Capabilities{OS: plugin.OSLinux, Network: true, DirectFS: true, RunningSystem: false}
+	if diff := cmp.Diff(want, cfg.Capabilities); diff != "" {
+		t.Errorf("%v.GetScanConfig() capabilities (-want +got):\n%s", flags, diff)
+	}
+}
+
+func TestGetScanConfig_BaseImageCatalog(t *testing.T) {
+	catalogPath := filepath.Join(t.TempDir(), "catalog.json")
+	catalog := `{"images": [{"name": "alpine:3.20", "chain_ids": ["sha256:` + strings.Repeat("4", 64) + `"]}]}`
+	if err := os.WriteFile(catalogPath, []byte(catalog), 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", catalogPath, err)
+	}
+
+	flags := &cli.Flags{
+		Image:            "registry.example.com/app:1.2",
+		BaseImageCatalog: catalogPath,
+	}
+	cfg, err := flags.GetScanConfig()
+	if err != nil {
+		t.Fatalf("%v.GetScanConfig(): %v", flags, err)
+	}
+	if cfg.BaseImageCatalog == nil || cfg.BaseImageCatalog.Len() != 1 {
+		t.Errorf("%v.GetScanConfig() got base image catalog %v, want catalog with 1 chain ID", flags, cfg.BaseImageCatalog)
+	}
+
+	flags.BaseImageCatalog = filepath.Join(t.TempDir(), "missing.json")
+	if _, err := flags.GetScanConfig(); err == nil {
+		t.Errorf("%v.GetScanConfig() with missing base image catalog: got nil error, want error", flags)
+	}
+}
+
+func TestGetScanConfig_MessageCatalog(t *testing.T) {
+	catalogPath := filepath.Join(t.TempDir(), "catalog.json")
+	catalog := `{"locales": {"de": {"etc-shadow-weakcredentials": {"title": "Schwache Passwörter"}}}}`
+	if err := os.WriteFile(catalogPath, []byte(catalog), 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", catalogPath, err)
+	}
+
+	flags := &cli.Flags{
+		Locale:         "de",
+		MessageCatalog: catalogPath,
+	}
+	cfg, err := flags.GetScanConfig()
+	if err != nil {
+		t.Fatalf("%v.GetScanConfig(): %v", flags, err)
+	}
+	if cfg.Locale != "de" || cfg.MessageCatalog == nil {
+		t.Errorf("%v.GetScanConfig() got locale %q and catalog %v, want locale \"de\" and a catalog", flags, cfg.Locale, cfg.MessageCatalog)
+	}
+
+	flags.MessageCatalog = filepath.Join(t.TempDir(), "missing.json")
+	if _, err := flags.GetScanConfig(); err == nil {
+		t.Errorf("%v.GetScanConfig() with missing message catalog: got nil error, want error", flags)
+	}
+}
+
+func TestGetScanConfig_OSVDBParams(t *testing.T) {
+	dbPath := "path/to/osv.db"
+	for _, detectors := range [][]string{nil, {osvoffline.Name}, {"vulnmatch", "cve"}} {
+		flags := &cli.Flags{
+			DetectorsToRun: detectors,
+			OSVDBPath:      dbPath,
+		}
+		cfg, err := flags.GetScanConfig()
+		if err != nil {
+			t.Fatalf("%v.GetScanConfig(): %v", flags, err)
+		}
+		var got []string
+		for _, d := range cfg.Detectors {
+			if d, ok := d.(*osvoffline.Detector); ok {
+				got = append(got, d.DBPath)
+			}
+		}
+		if diff := cmp.Diff([]string{dbPath}, got); diff != "" {
+			t.Errorf("%v.GetScanConfig() OSV detector DB paths (-want +got):\n%s", flags, diff)
+		}
+	}
+}
+
+func TestGetScanConfig_BinprotoStream(t *testing.T) {
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		desc       string
+		flags      *cli.Flags
+		wantStream bool
+	}{
+		{
+			desc:       "binproto result file",
+			flags:      &cli.Flags{ResultFile: filepath.Join(dir, "result.binproto.gz")},
+			wantStream: true,
+		},
+		{
+			desc:       "binproto output",
+			flags:      &cli.Flags{Output: []string{"binproto=" + filepath.Join(dir, "result.out")}},
+			wantStream: true,
+		},
+		{
+			desc:  "textproto result file",
+			flags: &cli.Flags{ResultFile: filepath.Join(dir, "result.textproto")},
+		},
+		{
+			desc: "binproto with other outputs",
+			flags: &cli.Flags{
+				ResultFile: filepath.Join(dir, "result2.binproto"),
+				Output:     []string{"json=" + filepath.Join(dir, "result.json")},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg, err := tc.flags.GetScanConfig()
+			if err != nil {
+				t.Fatalf("%v.GetScanConfig(): %v", tc.flags, err)
+			}
+			if got := cfg.ResultSink != nil; got != tc.wantStream {
+				t.Errorf("%v.GetScanConfig() streams results: %t, want %t", tc.flags, got, tc.wantStream)
+			}
+			result := &scalibr.ScanResult{
+				Version: "1.2.3",
+				Status:  &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+			}
+			if err := tc.flags.WriteScanResults(result); err != nil {
+				t.Fatalf("%v.WriteScanResults(): %v", tc.flags, err)
+			}
+		})
+	}
+}
+
 func TestWriteScanResults(t *testing.T) {
 	testDirPath := t.TempDir()
 	result := &scalibr.ScanResult{
@@ -488,6 +1040,38 @@ func TestWriteScanResults(t *testing.T) {
 			wantFilename:      "result.cyclonedx.json",
 			wantContentPrefix: "{\n  \"$schema\": \"http://cyclonedx.org/schema/bom-1.6.schema.json\"",
 		},
+		{
+			desc: "Create versioned JSON",
+			flags: &cli.Flags{
+				Output: []string{"json=" + filepath.Join(testDirPath, "result.json")},
+			},
+			wantFilename:      "result.json",
+			wantContentPrefix: "{\n  \"schema_version\": \"1.1.0\"",
+		},
+		{
+			desc: "Create JSON Lines",
+			flags: &cli.Flags{
+				Output: []string{"jsonl=" + filepath.Join(testDirPath, "result.jsonl")},
+			},
+			wantFilename:      "result.jsonl",
+			wantContentPrefix: "{\"type\":\"summary\"",
+		},
+		{
+			desc: "Create binproto using --result flag",
+			flags: &cli.Flags{
+				ResultFile: filepath.Join(testDirPath, "result.binproto"),
+			},
+			wantFilename:      "result.binproto",
+			wantContentPrefix: "\x0a\x051.2.3",
+		},
+		{
+			desc: "Create binproto using --output flag",
+			flags: &cli.Flags{
+				Output: []string{"binproto=" + filepath.Join(testDirPath, "result2.binproto")},
+			},
+			wantFilename:      "result2.binproto",
+			wantContentPrefix: "\x0a\x051.2.3",
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			if err := tc.flags.WriteScanResults(result); err != nil {
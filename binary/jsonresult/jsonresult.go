@@ -0,0 +1,404 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonresult defines a stable, versioned JSON format for SCALIBR scan
+// results that is independent of the result proto.
+//
+// The JSON encoding of the result proto follows proto field names, which
+// change whenever the proto is refactored. This format is intended for
+// scripting consumers, e.g. jq pipelines, and only changes as described in
+// docs/json_output.md:
+//
+//   - Fields are only added in minor versions of the schema.
+//   - Fields are only renamed, removed or change their type in major versions.
+package jsonresult
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/converter"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/inventory"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// SchemaVersion is the semantic version of the JSON format written by this
+// package.
+const SchemaVersion = "1.1.0"
+
+// Result is a SCALIBR scan result.
+type Result struct {
+	SchemaVersion  string     `json:"schema_version"`
+	ScannerVersion string     `json:"scanner_version"`
+	StartTime      time.Time  `json:"start_time"`
+	EndTime        time.Time  `json:"end_time"`
+	Status         *Status    `json:"status"`
+	Plugins        []*Plugin  `json:"plugins"`
+	Packages       []*Package `json:"packages"`
+	Findings       []*Finding `json:"findings"`
+	Secrets        []*Secret  `json:"secrets"`
+	// Files that made an extractor panic.
+	QuarantinedFiles []*QuarantinedFile `json:"quarantined_files"`
+	// Labels the scan was tagged with. Added in 1.1.0.
+	Labels map[string]string `json:"labels"`
+}
+
+// Status of a scan or plugin run.
+type Status struct {
+	// "SUCCEEDED", "PARTIALLY_SUCCEEDED", "FAILED" or "UNSPECIFIED".
+	Status        string `json:"status"`
+	FailureReason string `json:"failure_reason,omitempty"`
+}
+
+// Plugin is an extractor or detector that ran during the scan.
+type Plugin struct {
+	Name    string  `json:"name"`
+	Version int     `json:"version"`
+	Status  *Status `json:"status"`
+}
+
+// Package is a software package found by an extractor.
+type Package struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// The package URL, empty if the extractor doesn't create one.
+	PURL      string   `json:"purl"`
+	Ecosystem string   `json:"ecosystem"`
+	Extractor string   `json:"extractor"`
+	Locations []string `json:"locations"`
+	// e.g. "TRANSITIONAL", "INSIDE_OS_PACKAGE" or "INSIDE_CACHE_DIR".
+	Annotations []string `json:"annotations"`
+	// SPDX license expressions, only set if license detection is enabled.
+	Licenses []string `json:"licenses,omitempty"`
+	// The direct dependencies of packages found in lockfiles that record them.
+	// The PURL of a dependency is only set if the package it resolves to is
+	// part of the result, and not in JSON lines output.
+	Dependencies []*PackageRef `json:"dependencies,omitempty"`
+	// Whether the package is a direct dependency of the project whose lockfile
+	// it was found in.
+	DirectDependency bool `json:"direct_dependency,omitempty"`
+	// The extractor specific metadata. The fields of the metadata follow the
+	// Go types of the extractors and are not covered by the schema version.
+	Metadata any `json:"metadata,omitempty"`
+}
+
+// PackageRef identifies a package, e.g. the one a finding is about.
+type PackageRef struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	PURL    string `json:"purl"`
+}
+
+// Finding is a security finding reported by a detector.
+type Finding struct {
+	// The publisher and reference of the advisory, e.g. "CVE" and
+	// "CVE-2024-1234".
+	Publisher string `json:"publisher"`
+	Reference string `json:"reference"`
+	// "VULNERABILITY", "CIS_FINDING" or "UNKNOWN".
+	Type           string    `json:"type"`
+	Title          string    `json:"title"`
+	Description    string    `json:"description"`
+	Recommendation string    `json:"recommendation"`
+	Severity       *Severity `json:"severity"`
+	// The key of the texts in message catalogs and the locale they were
+	// translated to, if any.
+	MessageKey string `json:"message_key,omitempty"`
+	Locale     string `json:"locale,omitempty"`
+	// The affected package, if the finding is about one.
+	Package *PackageRef `json:"package,omitempty"`
+	// Affected files not related to a package.
+	Locations []string `json:"locations"`
+	Extra     string   `json:"extra,omitempty"`
+	Detectors []string `json:"detectors"`
+}
+
+// Severity of a finding.
+type Severity struct {
+	// "MINIMAL", "LOW", "MEDIUM", "HIGH", "CRITICAL" or "UNSPECIFIED".
+	Level  string `json:"level"`
+	CVSSV2 *CVSS  `json:"cvss_v2,omitempty"`
+	CVSSV3 *CVSS  `json:"cvss_v3,omitempty"`
+}
+
+// CVSS scores of a finding.
+type CVSS struct {
+	BaseScore          float32 `json:"base_score"`
+	TemporalScore      float32 `json:"temporal_score"`
+	EnvironmentalScore float32 `json:"environmental_score"`
+}
+
+// Secret is a secret found in the scanned files.
+type Secret struct {
+	// Go type of the secret, e.g. "gcpsak.GCPSAK".
+	Type     string `json:"type"`
+	Location string `json:"location"`
+	// "LOW", "MEDIUM", "HIGH" or "UNSPECIFIED".
+	Confidence string `json:"confidence"`
+	// e.g. "VALIDATION_VALID" or "VALIDATION_INVALID". Empty if the secret
+	// wasn't validated.
+	Validation string `json:"validation,omitempty"`
+	// The fields of the secret. Like package metadata, these follow the Go
+	// types of the secrets and are not covered by the schema version.
+	Fields any `json:"fields"`
+}
+
+// QuarantinedFile is a file that made an extractor panic.
+type QuarantinedFile struct {
+	Path             string `json:"path"`
+	Extractor        string `json:"extractor"`
+	ExtractorVersion int    `json:"extractor_version"`
+	Attempts         int    `json:"attempts"`
+	Panic            string `json:"panic"`
+}
+
+// FromScanResult converts a scan result into the JSON format.
+func FromScanResult(r *scalibr.ScanResult) *Result {
+	res := &Result{
+		SchemaVersion:    SchemaVersion,
+		ScannerVersion:   r.Version,
+		StartTime:        r.StartTime.UTC(),
+		EndTime:          r.EndTime.UTC(),
+		Status:           statusFromScanStatus(r.Status),
+		Plugins:          []*Plugin{},
+		Packages:         []*Package{},
+		Findings:         []*Finding{},
+		Secrets:          []*Secret{},
+		QuarantinedFiles: []*QuarantinedFile{},
+		Labels:           map[string]string{},
+	}
+	for k, v := range r.Labels {
+		res.Labels[k] = v
+	}
+	for _, s := range r.PluginStatus {
+		res.Plugins = append(res.Plugins, &Plugin{
+			Name:    s.Name,
+			Version: s.Version,
+			Status:  statusFromScanStatus(s.Status),
+		})
+	}
+	for _, i := range r.Inventories {
+		res.Packages = append(res.Packages, packageFromInventory(i))
+	}
+	g := inventory.NewDependencyGraph(r.Inventories)
+	for idx, i := range r.Inventories {
+		for j, d := range i.Dependencies {
+			dep := g.Resolve(i, d)
+			if dep == nil || dep.Extractor == nil {
+				continue
+			}
+			if purl := converter.ToPURL(dep); purl != nil {
+				res.Packages[idx].Dependencies[j].PURL = purl.String()
+			}
+		}
+	}
+	for _, f := range r.Findings {
+		res.Findings = append(res.Findings, findingFromDetector(f))
+	}
+	for _, s := range r.Secrets {
+		res.Secrets = append(res.Secrets, secretFromScan(s))
+	}
+	if r.Quarantine != nil {
+		for _, f := range r.Quarantine.Files {
+			res.QuarantinedFiles = append(res.QuarantinedFiles, &QuarantinedFile{
+				Path:             f.Path,
+				Extractor:        f.Extractor,
+				ExtractorVersion: f.ExtractorVersion,
+				Attempts:         f.Attempts,
+				Panic:            f.Panic,
+			})
+		}
+	}
+	return res
+}
+
+// Write writes the scan result r as indented JSON to path.
+func Write(r *scalibr.ScanResult, path string) error {
+	b, err := json.MarshalIndent(FromScanResult(r), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal scan result: %w", err)
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+func statusFromScanStatus(s *plugin.ScanStatus) *Status {
+	if s == nil {
+		return &Status{Status: "UNSPECIFIED"}
+	}
+	var status string
+	switch s.Status {
+	case plugin.ScanStatusSucceeded:
+		status = "SUCCEEDED"
+	case plugin.ScanStatusPartiallySucceeded:
+		status = "PARTIALLY_SUCCEEDED"
+	case plugin.ScanStatusFailed:
+		status = "FAILED"
+	default:
+		status = "UNSPECIFIED"
+	}
+	return &Status{Status: status, FailureReason: s.FailureReason}
+}
+
+func packageFromInventory(i *extractor.Inventory) *Package {
+	p := &Package{
+		Name:        i.Name,
+		Version:     i.Version,
+		Locations:   i.Locations,
+		Annotations: []string{},
+		Licenses:    i.Licenses,
+		Metadata:    i.Metadata,
+
+		DirectDependency: i.DirectDependency,
+	}
+	if p.Locations == nil {
+		p.Locations = []string{}
+	}
+	for _, d := range i.Dependencies {
+		p.Dependencies = append(p.Dependencies, &PackageRef{Name: d.Name, Version: d.Version})
+	}
+	if i.Extractor != nil {
+		p.Extractor = i.Extractor.Name()
+		p.Ecosystem = i.Ecosystem()
+		if purl := converter.ToPURL(i); purl != nil {
+			p.PURL = purl.String()
+		}
+	}
+	for _, a := range i.Annotations {
+		p.Annotations = append(p.Annotations, annotationString(a))
+	}
+	return p
+}
+
+func annotationString(a extractor.Annotation) string {
+	switch a {
+	case extractor.Transitional:
+		return "TRANSITIONAL"
+	case extractor.InsideOSPackage:
+		return "INSIDE_OS_PACKAGE"
+	case extractor.InsideCacheDir:
+		return "INSIDE_CACHE_DIR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func findingFromDetector(f *detector.Finding) *Finding {
+	res := &Finding{
+		Type:      "UNKNOWN",
+		Severity:  &Severity{Level: "UNSPECIFIED"},
+		Locations: []string{},
+		Extra:     f.Extra,
+		Detectors: f.Detectors,
+	}
+	if res.Detectors == nil {
+		res.Detectors = []string{}
+	}
+	if a := f.Adv; a != nil {
+		if a.ID != nil {
+			res.Publisher = a.ID.Publisher
+			res.Reference = a.ID.Reference
+		}
+		res.Type = findingTypeString(a.Type)
+		res.Title = a.Title
+		res.Description = a.Description
+		res.Recommendation = a.Recommendation
+		res.MessageKey = string(a.MessageKey)
+		res.Locale = a.Locale
+		if a.Sev != nil {
+			res.Severity = &Severity{
+				Level:  severityString(a.Sev.Severity),
+				CVSSV2: cvss(a.Sev.CVSSV2),
+				CVSSV3: cvss(a.Sev.CVSSV3),
+			}
+		}
+	}
+	if t := f.Target; t != nil {
+		if t.Location != nil {
+			res.Locations = t.Location
+		}
+		if i := t.Inventory; i != nil {
+			p := packageFromInventory(i)
+			res.Package = &PackageRef{Name: p.Name, Version: p.Version, PURL: p.PURL}
+		}
+	}
+	return res
+}
+
+func secretFromScan(s *secrets.Secret) *Secret {
+	return &Secret{
+		Type:       fmt.Sprintf("%T", s.Secret),
+		Location:   s.Location,
+		Confidence: confidenceString(s.Confidence),
+		Validation: string(s.Validation),
+		Fields:     s.Secret,
+	}
+}
+
+func findingTypeString(t detector.TypeEnum) string {
+	switch t {
+	case detector.TypeVulnerability:
+		return "VULNERABILITY"
+	case detector.TypeCISFinding:
+		return "CIS_FINDING"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func severityString(s detector.SeverityEnum) string {
+	switch s {
+	case detector.SeverityMinimal:
+		return "MINIMAL"
+	case detector.SeverityLow:
+		return "LOW"
+	case detector.SeverityMedium:
+		return "MEDIUM"
+	case detector.SeverityHigh:
+		return "HIGH"
+	case detector.SeverityCritical:
+		return "CRITICAL"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func cvss(c *detector.CVSS) *CVSS {
+	if c == nil {
+		return nil
+	}
+	return &CVSS{
+		BaseScore:          c.BaseScore,
+		TemporalScore:      c.TemporalScore,
+		EnvironmentalScore: c.EnvironmentalScore,
+	}
+}
+
+func confidenceString(c secrets.Confidence) string {
+	switch c {
+	case secrets.ConfidenceLow:
+		return "LOW"
+	case secrets.ConfidenceMedium:
+		return "MEDIUM"
+	case secrets.ConfidenceHigh:
+		return "HIGH"
+	default:
+		return "UNSPECIFIED"
+	}
+}
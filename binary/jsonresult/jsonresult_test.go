@@ -0,0 +1,195 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonresult_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/binary/jsonresult"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagelockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/quarantine"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/testing/fakeextractor"
+	"github.com/google/osv-scalibr/veles/secrets/rollbar"
+)
+
+// goldenPath is the output of the current schema version. Outputs of older
+// versions are kept in testdata/ to check that they can still be read.
+var goldenPath = filepath.Join("testdata", "v"+jsonresult.SchemaVersion+".json")
+
+func scanResult() *scalibr.ScanResult {
+	ex := fakeextractor.New("python/wheelegg", 1, nil, nil)
+	pkg := &extractor.Inventory{
+		Name:        "requests",
+		Version:     "2.25.0",
+		Locations:   []string{"usr/lib/python3/dist-packages/requests-2.25.0.dist-info/METADATA"},
+		Extractor:   ex,
+		Annotations: []extractor.Annotation{extractor.InsideOSPackage},
+	}
+	return &scalibr.ScanResult{
+		Version:   "0.1.0",
+		StartTime: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2024, 5, 1, 12, 0, 30, 0, time.UTC),
+		Status:    &plugin.ScanStatus{Status: plugin.ScanStatusPartiallySucceeded, FailureReason: "extractor python/wheelegg: 1 file failed"},
+		PluginStatus: []*plugin.Status{
+			{Name: "python/wheelegg", Version: 1, Status: &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded}},
+			{Name: "cve/cve-2023-38408", Version: 0, Status: &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded}},
+		},
+		Inventories: []*extractor.Inventory{pkg},
+		Findings: []*detector.Finding{{
+			Adv: &detector.Advisory{
+				ID:             &detector.AdvisoryID{Publisher: "CVE", Reference: "CVE-2023-32681"},
+				Type:           detector.TypeVulnerability,
+				Title:          "Requests leaks Proxy-Authorization headers",
+				Description:    "Requests before 2.31.0 leaks Proxy-Authorization headers to destination servers.",
+				Recommendation: "Upgrade requests to 2.31.0 or later.",
+				Sev: &detector.Severity{
+					Severity: detector.SeverityMedium,
+					CVSSV3:   &detector.CVSS{BaseScore: 6.1},
+				},
+			},
+			Target:    &detector.TargetDetails{Inventory: pkg},
+			Detectors: []string{"cve/cve-2023-32681"},
+		}},
+		Secrets: []*secrets.Secret{{
+			Secret:     rollbar.AccessToken{Token: "0123456789abcdef0123456789abcdef"},
+			Location:   "srv/app/.env",
+			Confidence: secrets.ConfidenceHigh,
+		}},
+		Quarantine: &quarantine.Report{Files: []*quarantine.File{{
+			Path:             "usr/lib/python3/dist-packages/broken.egg-info/PKG-INFO",
+			Extractor:        "python/wheelegg",
+			ExtractorVersion: 1,
+			Attempts:         2,
+			Panic:            "runtime error: index out of range [3] with length 3",
+			Stack:            "goroutine 1 [running]:",
+		}}},
+		Labels: map[string]string{"tenant": "acme", "environment": "prod"},
+	}
+}
+
+func TestWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "result.json")
+	if err := jsonresult.Write(scanResult(), path); err != nil {
+		t.Fatalf("Write(): %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%s): %v", path, err)
+	}
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%s): %v", goldenPath, err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("Write() output differs from %s (-want +got):\n%s\n"+
+			"Changes to the output require a new schema version, see docs/json_output.md.", goldenPath, diff)
+	}
+}
+
+func TestFromScanResult_EmptyResult(t *testing.T) {
+	got := jsonresult.FromScanResult(&scalibr.ScanResult{})
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("json.Marshal(): %v", err)
+	}
+	// Lists are always present so consumers can iterate over them without
+	// null checks.
+	for _, key := range []string{`"plugins":[]`, `"packages":[]`, `"findings":[]`, `"secrets":[]`, `"quarantined_files":[]`, `"labels":{}`} {
+		if !bytes.Contains(b, []byte(key)) {
+			t.Errorf("FromScanResult(empty) = %s, want it to contain %s", b, key)
+		}
+	}
+}
+
+func TestFromScanResult_Dependencies(t *testing.T) {
+	hasFlag := &extractor.Inventory{
+		Name:      "has-flag",
+		Version:   "3.0.0",
+		Locations: []string{"app/package-lock.json"},
+		Extractor: packagelockjson.Extractor{},
+	}
+	chalk := &extractor.Inventory{
+		Name:      "chalk",
+		Version:   "2.4.2",
+		Locations: []string{"app/package-lock.json"},
+		Extractor: packagelockjson.Extractor{},
+		Dependencies: []extractor.Dependency{
+			{Name: "has-flag", Version: "3.0.0"},
+			{Name: "supports-color", Version: "5.5.0"},
+		},
+		DirectDependency: true,
+	}
+
+	got := jsonresult.FromScanResult(&scalibr.ScanResult{Inventories: []*extractor.Inventory{chalk, hasFlag}})
+
+	want := []*jsonresult.PackageRef{
+		{Name: "has-flag", Version: "3.0.0", PURL: "pkg:npm/has-flag@3.0.0"},
+		// Not part of the result.
+		{Name: "supports-color", Version: "5.5.0"},
+	}
+	if diff := cmp.Diff(want, got.Packages[0].Dependencies); diff != "" {
+		t.Errorf("FromScanResult() dependencies diff (-want +got):\n%s", diff)
+	}
+	if !got.Packages[0].DirectDependency {
+		t.Errorf("FromScanResult() direct_dependency = false, want true")
+	}
+	if got.Packages[1].Dependencies != nil || got.Packages[1].DirectDependency {
+		t.Errorf("FromScanResult() set dependency info on %s, want none", got.Packages[1].Name)
+	}
+}
+
+// TestCompatibility checks that the outputs of all schema versions with the
+// same major version can be read into the current types, i.e. that no fields
+// were renamed or removed.
+func TestCompatibility(t *testing.T) {
+	major, _, _ := strings.Cut(jsonresult.SchemaVersion, ".")
+	files, err := filepath.Glob(filepath.Join("testdata", "v"+major+".*.json"))
+	if err != nil {
+		t.Fatalf("filepath.Glob(): %v", err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("no outputs of schema version %s.x in testdata/", major)
+	}
+	for _, f := range files {
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			b, err := os.ReadFile(f)
+			if err != nil {
+				t.Fatalf("os.ReadFile(%s): %v", f, err)
+			}
+			dec := json.NewDecoder(bytes.NewReader(b))
+			dec.DisallowUnknownFields()
+			var r jsonresult.Result
+			if err := dec.Decode(&r); err != nil {
+				t.Fatalf("decoding %s: %v", f, err)
+			}
+			want := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(f), "v"), ".json")
+			if r.SchemaVersion != want {
+				t.Errorf("%s has schema_version %q, want %q", f, r.SchemaVersion, want)
+			}
+		})
+	}
+}
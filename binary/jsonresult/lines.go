@@ -0,0 +1,125 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonresult
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+)
+
+// Types of the lines of the JSON Lines format.
+const (
+	LinePackage = "package"
+	LineFinding = "finding"
+	LineSecret  = "secret"
+	LineSummary = "summary"
+)
+
+// Line is a line of the JSON Lines format. Each package, finding and secret
+// is written on its own line, followed by a last line with the summary of the
+// scan.
+type Line struct {
+	// LinePackage, LineFinding, LineSecret or LineSummary.
+	Type    string   `json:"type"`
+	Package *Package `json:"package,omitempty"`
+	Finding *Finding `json:"finding,omitempty"`
+	Secret  *Secret  `json:"secret,omitempty"`
+	// The rest of the scan result, with empty packages, findings and secrets.
+	Summary *Result `json:"summary,omitempty"`
+}
+
+// LineWriter writes scan results in the JSON Lines format. It implements
+// scalibr.ResultSink so that the packages of large scans can be written while
+// the scan runs instead of being held in memory.
+type LineWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewLineWriter returns a LineWriter that writes to w. Finish needs to be
+// called after the scan to write the summary line and flush the output.
+func NewLineWriter(w io.Writer) *LineWriter {
+	bw := bufio.NewWriter(w)
+	return &LineWriter{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// AddInventory writes a package line.
+func (w *LineWriter) AddInventory(i *extractor.Inventory) error {
+	return w.enc.Encode(&Line{Type: LinePackage, Package: packageFromInventory(i)})
+}
+
+// AddFinding writes a finding line.
+func (w *LineWriter) AddFinding(f *detector.Finding) error {
+	return w.enc.Encode(&Line{Type: LineFinding, Finding: findingFromDetector(f)})
+}
+
+// AddSecret writes a secret line.
+func (w *LineWriter) AddSecret(s *secrets.Secret) error {
+	return w.enc.Encode(&Line{Type: LineSecret, Secret: secretFromScan(s)})
+}
+
+// Finish writes the packages, findings and secrets that are still in r, i.e.
+// those that weren't passed to the writer during the scan, followed by the
+// summary line of r, and flushes the output.
+func (w *LineWriter) Finish(r *scalibr.ScanResult) error {
+	for _, i := range r.Inventories {
+		if err := w.AddInventory(i); err != nil {
+			return err
+		}
+	}
+	for _, f := range r.Findings {
+		if err := w.AddFinding(f); err != nil {
+			return err
+		}
+	}
+	for _, s := range r.Secrets {
+		if err := w.AddSecret(s); err != nil {
+			return err
+		}
+	}
+	summary := *r
+	summary.Inventories, summary.Findings, summary.Secrets = nil, nil, nil
+	if err := w.enc.Encode(&Line{Type: LineSummary, Summary: FromScanResult(&summary)}); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// WriteLines writes the scan result r in the JSON Lines format to path.
+func WriteLines(r *scalibr.ScanResult, path string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if err := NewLineWriter(f).Finish(r); err != nil {
+		return fmt.Errorf("failed to write scan result: %w", err)
+	}
+	return nil
+}
+
+var _ scalibr.ResultSink = &LineWriter{}
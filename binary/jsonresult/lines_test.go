@@ -0,0 +1,108 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonresult_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/binary/jsonresult"
+)
+
+func readLines(t *testing.T, b []byte) []*jsonresult.Line {
+	t.Helper()
+	var lines []*jsonresult.Line
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		var l jsonresult.Line
+		if err := json.Unmarshal(s.Bytes(), &l); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", s.Bytes(), err)
+		}
+		lines = append(lines, &l)
+	}
+	return lines
+}
+
+func lineTypes(lines []*jsonresult.Line) []string {
+	var types []string
+	for _, l := range lines {
+		types = append(types, l.Type)
+	}
+	return types
+}
+
+func TestLineWriter(t *testing.T) {
+	r := scanResult()
+	var buf bytes.Buffer
+	w := jsonresult.NewLineWriter(&buf)
+	// The package is streamed during the scan and isn't part of the result
+	// anymore when the scan finishes.
+	if err := w.AddInventory(r.Inventories[0]); err != nil {
+		t.Fatalf("AddInventory(): %v", err)
+	}
+	r.Inventories = nil
+	if err := w.Finish(r); err != nil {
+		t.Fatalf("Finish(): %v", err)
+	}
+
+	lines := readLines(t, buf.Bytes())
+	wantTypes := []string{jsonresult.LinePackage, jsonresult.LineFinding, jsonresult.LineSecret, jsonresult.LineSummary}
+	if diff := cmp.Diff(wantTypes, lineTypes(lines)); diff != "" {
+		t.Fatalf("LineWriter wrote unexpected lines (-want +got):\n%s", diff)
+	}
+
+	want := jsonresult.FromScanResult(scanResult())
+	if diff := cmp.Diff(want.Packages[0], lines[0].Package); diff != "" {
+		t.Errorf("LineWriter wrote unexpected package (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want.Findings[0], lines[1].Finding); diff != "" {
+		t.Errorf("LineWriter wrote unexpected finding (-want +got):\n%s", diff)
+	}
+	// Secret fields are decoded into a map, so only compare the metadata.
+	if got := lines[2].Secret; got.Type != want.Secrets[0].Type || got.Location != want.Secrets[0].Location {
+		t.Errorf("LineWriter wrote secret %s at %s, want %s at %s", got.Type, got.Location, want.Secrets[0].Type, want.Secrets[0].Location)
+	}
+	summary := lines[3].Summary
+	if len(summary.Packages)+len(summary.Findings)+len(summary.Secrets) != 0 {
+		t.Errorf("LineWriter wrote summary with %d packages, %d findings and %d secrets, want none",
+			len(summary.Packages), len(summary.Findings), len(summary.Secrets))
+	}
+	if diff := cmp.Diff(want.Status, summary.Status); diff != "" {
+		t.Errorf("LineWriter wrote unexpected summary status (-want +got):\n%s", diff)
+	}
+	if summary.SchemaVersion != jsonresult.SchemaVersion {
+		t.Errorf("LineWriter wrote summary with schema version %q, want %q", summary.SchemaVersion, jsonresult.SchemaVersion)
+	}
+}
+
+func TestWriteLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "result.jsonl")
+	if err := jsonresult.WriteLines(scanResult(), path); err != nil {
+		t.Fatalf("WriteLines(): %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%s): %v", path, err)
+	}
+	wantTypes := []string{jsonresult.LinePackage, jsonresult.LineFinding, jsonresult.LineSecret, jsonresult.LineSummary}
+	if diff := cmp.Diff(wantTypes, lineTypes(readLines(t, b))); diff != "" {
+		t.Errorf("WriteLines() wrote unexpected lines (-want +got):\n%s", diff)
+	}
+}
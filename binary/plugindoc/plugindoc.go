@@ -0,0 +1,69 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The plugindoc command prints machine-readable JSON documentation of all
+// plugins registered in SCALIBR. With -baseline it instead prints the plugins
+// that were added, removed or changed compared to a previously generated doc.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugindoc"
+)
+
+func main() {
+	output := flag.String("o", "", "The path of the output JSON file. Prints to stdout if empty.")
+	baseline := flag.String("baseline", "", "The path of a previously generated doc to diff the registered plugins against")
+	flag.Parse()
+
+	if err := run(*output, *baseline); err != nil {
+		log.Errorf("plugindoc: %v", err)
+		os.Exit(1)
+	}
+}
+
+func run(output, baseline string) error {
+	w := io.Writer(os.Stdout)
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	doc := plugindoc.Generate(plugindoc.DefaultConfig())
+	if baseline == "" {
+		return doc.Write(w)
+	}
+
+	f, err := os.Open(baseline)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	old, err := plugindoc.Read(f)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(plugindoc.Diff(old, doc))
+}
@@ -16,47 +16,91 @@
 package proto
 
 import (
+	"cmp"
 	"compress/gzip"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/google/osv-scalibr/converter"
 	"github.com/google/osv-scalibr/detector"
 	"github.com/google/osv-scalibr/log"
+	"golang.org/x/exp/maps"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 
 	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/aggregate"
 	"github.com/google/osv-scalibr/extractor"
 	ctrdfs "github.com/google/osv-scalibr/extractor/filesystem/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/ecsagent"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/kubelet"
+	"github.com/google/osv-scalibr/extractor/filesystem/coverage"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/bazel/bzlmodlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/depsjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/golang/gobinary"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/appserver"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/archive"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/javalockfile"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/javaruntime"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/electron"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/perllocal"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/composerinstalled"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/drupal"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/joomla"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/magento"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/pear"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/activedirectory"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/appbundle"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/appsupervisor"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/dbserver"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/edgeproxy"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/iacsecrets"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/kerberos"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/logpipeline"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/privatecloud"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/registryconfig"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/storagecluster"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendoredcode"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendorlib"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/android"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/cos"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/flatpak"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/kernel/module"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/macapps"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/origin"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/pacman"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/portage"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/qnap"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/snap"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/synology"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/wsl"
 	"github.com/google/osv-scalibr/extractor/filesystem/osv"
+	"github.com/google/osv-scalibr/extractor/filesystem/quarantine"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/spdx"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
 	ctrdruntime "github.com/google/osv-scalibr/extractor/standalone/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/standalone/linux/kernelmodules"
 	winmetadata "github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/inventory"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/summary"
+	"github.com/google/osv-scalibr/veles"
 
 	spb "github.com/google/osv-scalibr/binary/proto/scan_result_go_proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -162,22 +206,32 @@ func ScanResultToProto(r *scalibr.ScanResult) (*spb.ScanResult, error) {
 
 	inventories := make([]*spb.Inventory, 0, len(r.Inventories))
 	for _, i := range r.Inventories {
-		p, err := inventoryToProto(i)
+		p, err := InventoryToProto(i)
 		if err != nil {
 			return nil, err
 		}
 		inventories = append(inventories, p)
 	}
+	resolveDependencyInstanceIDs(r.Inventories, inventories)
 
 	findings := make([]*spb.Finding, 0, len(r.Findings))
 	for _, f := range r.Findings {
-		p, err := findingToProto(f)
+		p, err := FindingToProto(f)
 		if err != nil {
 			return nil, err
 		}
 		findings = append(findings, p)
 	}
 
+	secrets := make([]*spb.Secret, 0, len(r.Secrets))
+	for _, s := range r.Secrets {
+		p, err := SecretToProto(s)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, p)
+	}
+
 	return &spb.ScanResult{
 		Version:      r.Version,
 		StartTime:    timestamppb.New(r.StartTime),
@@ -186,9 +240,139 @@ func ScanResultToProto(r *scalibr.ScanResult) (*spb.ScanResult, error) {
 		PluginStatus: pluginStatus,
 		Inventories:  inventories,
 		Findings:     findings,
+		Coverage:     coverageReportToProto(r.Coverage),
+		Quarantine:   quarantineReportToProto(r.Quarantine),
+		Provenance:   provenanceToProto(r.Provenance),
+		Secrets:      secrets,
+		Labels:       r.Labels,
 	}, nil
 }
 
+// FleetStatsToProto converts a FleetStats go struct into the equivalent proto.
+func FleetStatsToProto(s *aggregate.FleetStats) *spb.FleetStats {
+	pkgs := make([]*spb.FleetStats_VulnerablePackage, 0, len(s.TopVulnerablePackages))
+	for _, p := range s.TopVulnerablePackages {
+		pkgs = append(pkgs, &spb.FleetStats_VulnerablePackage{
+			Name:       p.Name,
+			Version:    p.Version,
+			Ecosystem:  p.Ecosystem,
+			Scans:      int32(p.Scans),
+			Advisories: p.Advisories,
+		})
+	}
+	os := make([]*spb.FleetStats_OSStats, 0, len(s.OS))
+	for _, o := range s.OS {
+		os = append(os, &spb.FleetStats_OSStats{
+			Os:              o.OS,
+			Scans:           int32(o.Scans),
+			VulnerableScans: int32(o.VulnerableScans),
+			Packages:        int32(o.Packages),
+			Secrets:         int32(o.Secrets),
+		})
+	}
+	return &spb.FleetStats{
+		Scans:                 int32(s.Scans),
+		FailedScans:           int32(s.FailedScans),
+		VulnerableScans:       int32(s.VulnerableScans),
+		TopVulnerablePackages: pkgs,
+		SecretTypes:           countsToProto(s.SecretTypes),
+		Ecosystems:            countsToProto(s.Ecosystems),
+		Os:                    os,
+	}
+}
+
+// ScanSummaryToProto converts a scan Summary go struct into the equivalent
+// proto.
+func ScanSummaryToProto(s *summary.Summary) *spb.ScanSummary {
+	sevs := maps.Keys(s.Findings)
+	slices.Sort(sevs)
+	slices.Reverse(sevs)
+	findings := make([]*spb.ScanSummary_SeverityCount, 0, len(sevs))
+	for _, sev := range sevs {
+		findings = append(findings, &spb.ScanSummary_SeverityCount{
+			Severity: severityEnumToProto(sev),
+			Count:    int32(s.Findings[sev]),
+		})
+	}
+	validations := maps.Keys(s.Secrets)
+	slices.SortFunc(validations, func(a, b veles.ValidationStatus) int {
+		return cmp.Compare(secretValidationToProto(a), secretValidationToProto(b))
+	})
+	secretCounts := make([]*spb.ScanSummary_ValidationCount, 0, len(validations))
+	for _, v := range validations {
+		secretCounts = append(secretCounts, &spb.ScanSummary_ValidationCount{
+			Validation: secretValidationToProto(v),
+			Count:      int32(s.Secrets[v]),
+		})
+	}
+	var status *spb.ScanStatus
+	if s.Status != nil {
+		status = scanStatusToProto(s.Status)
+	}
+	return &spb.ScanSummary{
+		Status:                 status,
+		Duration:               durationpb.New(s.Duration),
+		Packages:               int32(s.Packages),
+		Findings:               findings,
+		FindingsAboveThreshold: int32(s.FindingsAboveThreshold),
+		SeverityThreshold:      severityEnumToProto(s.SeverityThreshold),
+		Secrets:                secretCounts,
+		FailedPlugins:          s.FailedPlugins,
+		ExitCode:               spb.ScanSummary_ExitCodeEnum(s.ExitCode),
+	}
+}
+
+func countsToProto(counts []*aggregate.Count) []*spb.FleetStats_Count {
+	res := make([]*spb.FleetStats_Count, 0, len(counts))
+	for _, c := range counts {
+		res = append(res, &spb.FleetStats_Count{Name: c.Name, Count: int32(c.Count)})
+	}
+	return res
+}
+
+// SecretToProto converts a secret found by a scan into the equivalent proto.
+func SecretToProto(s *secrets.Secret) (*spb.Secret, error) {
+	fields, err := json.Marshal(s.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal secret of type %T: %w", s.Secret, err)
+	}
+	return &spb.Secret{
+		Type:       fmt.Sprintf("%T", s.Secret),
+		FieldsJson: string(fields),
+		Location:   s.Location,
+		Confidence: secretConfidenceToProto(s.Confidence),
+		Validation: secretValidationToProto(s.Validation),
+	}, nil
+}
+
+func secretConfidenceToProto(c secrets.Confidence) spb.Secret_ConfidenceEnum {
+	switch c {
+	case secrets.ConfidenceLow:
+		return spb.Secret_LOW
+	case secrets.ConfidenceMedium:
+		return spb.Secret_MEDIUM
+	case secrets.ConfidenceHigh:
+		return spb.Secret_HIGH
+	default:
+		return spb.Secret_UNSPECIFIED
+	}
+}
+
+func secretValidationToProto(v veles.ValidationStatus) spb.Secret_ValidationEnum {
+	switch v {
+	case veles.ValidationUnsupported:
+		return spb.Secret_VALIDATION_UNSUPPORTED
+	case veles.ValidationFailed:
+		return spb.Secret_VALIDATION_FAILED
+	case veles.ValidationInvalid:
+		return spb.Secret_VALIDATION_INVALID
+	case veles.ValidationValid:
+		return spb.Secret_VALIDATION_VALID
+	default:
+		return spb.Secret_VALIDATION_UNSPECIFIED
+	}
+}
+
 func scanStatusToProto(s *plugin.ScanStatus) *spb.ScanStatus {
 	var e spb.ScanStatus_ScanStatusEnum
 	switch s.Status {
@@ -206,39 +390,141 @@ func scanStatusToProto(s *plugin.ScanStatus) *spb.ScanStatus {
 
 func pluginStatusToProto(s *plugin.Status) *spb.PluginStatus {
 	return &spb.PluginStatus{
-		Name:    s.Name,
-		Version: int32(s.Version),
-		Status:  scanStatusToProto(s.Status),
+		Name:           s.Name,
+		Version:        int32(s.Version),
+		Status:         scanStatusToProto(s.Status),
+		BudgetExceeded: s.BudgetExceeded,
 	}
 }
 
-func inventoryToProto(i *extractor.Inventory) (*spb.Inventory, error) {
+func provenanceToProto(p *scalibr.Provenance) *spb.Provenance {
+	if p == nil {
+		return nil
+	}
+	plugins := make([]*spb.PluginVersion, 0, len(p.Plugins))
+	for _, pv := range p.Plugins {
+		plugins = append(plugins, &spb.PluginVersion{Name: pv.Name, Version: int32(pv.Version)})
+	}
+	dataSources := make([]*spb.DataSource, 0, len(p.DataSources))
+	for _, ds := range p.DataSources {
+		dataSources = append(dataSources, &spb.DataSource{
+			Name:     ds.Name,
+			Location: ds.Location,
+			Version:  ds.Version,
+		})
+	}
+	return &spb.Provenance{
+		ScalibrVersion: p.ScalibrVersion,
+		Plugins:        plugins,
+		ConfigDigest:   p.ConfigDigest,
+		DataSources:    dataSources,
+	}
+}
+
+func coverageReportToProto(r *coverage.Report) *spb.CoverageReport {
+	if r == nil {
+		return nil
+	}
+	files := make([]*spb.UnparsedFile, 0, len(r.UnparsedFiles))
+	for _, f := range r.UnparsedFiles {
+		files = append(files, &spb.UnparsedFile{
+			Path:      f.Path,
+			Ecosystem: f.Ecosystem,
+			Reason:    unparsedReasonToProto(f.Reason),
+			Error:     f.Error,
+		})
+	}
+	return &spb.CoverageReport{UnparsedFiles: files}
+}
+
+func quarantineReportToProto(r *quarantine.Report) *spb.QuarantineReport {
+	if r == nil {
+		return nil
+	}
+	files := make([]*spb.QuarantinedFile, 0, len(r.Files))
+	for _, f := range r.Files {
+		files = append(files, &spb.QuarantinedFile{
+			Path:             f.Path,
+			Extractor:        f.Extractor,
+			ExtractorVersion: int32(f.ExtractorVersion),
+			Attempts:         int32(f.Attempts),
+			Panic:            f.Panic,
+			Stack:            f.Stack,
+		})
+	}
+	return &spb.QuarantineReport{Files: files}
+}
+
+func unparsedReasonToProto(r coverage.Reason) spb.UnparsedFile_ReasonEnum {
+	switch r {
+	case coverage.ReasonNoExtractor:
+		return spb.UnparsedFile_NO_EXTRACTOR
+	case coverage.ReasonExtractionError:
+		return spb.UnparsedFile_EXTRACTION_ERROR
+	case coverage.ReasonBudgetExceeded:
+		return spb.UnparsedFile_BUDGET_EXCEEDED
+	default:
+		return spb.UnparsedFile_UNSPECIFIED
+	}
+}
+
+// InventoryToProto converts an Inventory go struct into the equivalent proto.
+func InventoryToProto(i *extractor.Inventory) (*spb.Inventory, error) {
 	if i == nil {
 		return nil, nil
 	}
 	p := converter.ToPURL(i)
 	inventoryProto := &spb.Inventory{
-		Name:         i.Name,
-		Version:      i.Version,
-		SourceCode:   sourceCodeIdentifierToProto(i.SourceCode),
-		Purl:         purlToProto(p),
-		Ecosystem:    i.Ecosystem(),
-		Locations:    i.Locations,
-		Extractor:    i.Extractor.Name(),
-		Annotations:  annotationsToProto(i.Annotations),
-		LayerDetails: layerDetailsToProto(i.LayerDetails),
+		Name:             i.Name,
+		Version:          i.Version,
+		SourceCode:       sourceCodeIdentifierToProto(i.SourceCode),
+		Purl:             purlToProto(p),
+		InstanceId:       converter.ToInstanceID(i),
+		Ecosystem:        i.Ecosystem(),
+		Locations:        i.Locations,
+		Extractor:        i.Extractor.Name(),
+		Annotations:      annotationsToProto(i.Annotations),
+		LayerDetails:     layerDetailsToProto(i.LayerDetails),
+		Licenses:         i.Licenses,
+		Dependencies:     dependenciesToProto(i.Dependencies),
+		DirectDependency: i.DirectDependency,
 	}
 	setProtoMetadata(i.Metadata, inventoryProto)
 	return inventoryProto, nil
 }
 
+func dependenciesToProto(deps []extractor.Dependency) []*spb.Dependency {
+	if len(deps) == 0 {
+		return nil
+	}
+	ps := make([]*spb.Dependency, 0, len(deps))
+	for _, d := range deps {
+		ps = append(ps, &spb.Dependency{Name: d.Name, Version: d.Version})
+	}
+	return ps
+}
+
+// resolveDependencyInstanceIDs sets the instance IDs of the packages the
+// dependencies of the inventory protos resolve to.
+func resolveDependencyInstanceIDs(inv []*extractor.Inventory, ps []*spb.Inventory) {
+	g := inventory.NewDependencyGraph(inv)
+	for idx, i := range inv {
+		for j, d := range i.Dependencies {
+			if dep := g.Resolve(i, d); dep != nil {
+				ps[idx].Dependencies[j].InstanceId = converter.ToInstanceID(dep)
+			}
+		}
+	}
+}
+
 func setProtoMetadata(meta any, i *spb.Inventory) {
 	switch m := meta.(type) {
 	case *wheelegg.PythonPackageMetadata:
 		i.Metadata = &spb.Inventory_PythonMetadata{
 			PythonMetadata: &spb.PythonPackageMetadata{
-				Author:      m.Author,
-				AuthorEmail: m.AuthorEmail,
+				Author:        m.Author,
+				AuthorEmail:   m.AuthorEmail,
+				PythonVersion: m.PythonVersion,
 			},
 		}
 	case *packagejson.JavascriptPackageJSONMetadata:
@@ -249,6 +535,14 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				Maintainers:  personsToProto(m.Maintainers),
 			},
 		}
+	case *electron.Metadata:
+		i.Metadata = &spb.Inventory_ElectronAppMetadata{
+			ElectronAppMetadata: &spb.ElectronAppMetadata{
+				AppName:         m.AppName,
+				AppVersion:      m.AppVersion,
+				ChromiumVersion: m.ChromiumVersion,
+			},
+		}
 	case *depsjson.Metadata:
 		i.Metadata = &spb.Inventory_DepsjsonMetadata{
 			DepsjsonMetadata: &spb.DEPSJSONMetadata{
@@ -282,6 +576,7 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				OsVersionId:       m.OSVersionID,
 				Maintainer:        m.Maintainer,
 				Architecture:      m.Architecture,
+				Origin:            packageOriginToProto(m.Origin),
 			},
 		}
 	case *snap.Metadata:
@@ -310,6 +605,7 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				Vendor:       m.Vendor,
 				Architecture: m.Architecture,
 				License:      m.License,
+				Origin:       packageOriginToProto(m.Origin),
 			},
 		}
 	case *cos.Metadata:
@@ -370,6 +666,13 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				UpdateUrl:                m.KSUpdateURL,
 			},
 		}
+	case *android.Metadata:
+		i.Metadata = &spb.Inventory_AndroidMetadata{
+			AndroidMetadata: &spb.AndroidMetadata{
+				App:       androidAppToProto(m.App),
+				Partition: androidPartitionToProto(m.Partition),
+			},
+		}
 	case *module.Metadata:
 		i.Metadata = &spb.Inventory_ModuleMetadata{
 			ModuleMetadata: &spb.ModuleMetadata{
@@ -433,6 +736,24 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				Sha1:       m.SHA1,
 			},
 		}
+	case *appserver.Metadata:
+		i.Metadata = &spb.Inventory_JavaAppServerMetadata{
+			JavaAppServerMetadata: &spb.JavaAppServerMetadata{
+				Server:      m.Server,
+				ArchiveType: m.ArchiveType,
+				Libraries:   m.Libraries,
+			},
+		}
+	case *javaruntime.Metadata:
+		i.Metadata = &spb.Inventory_JavaRuntimeMetadata{
+			JavaRuntimeMetadata: &spb.JavaRuntimeMetadata{
+				Vendor:         m.Vendor,
+				RuntimeVersion: m.RuntimeVersion,
+				ImageType:      m.ImageType,
+				CryptoPolicy:   m.CryptoPolicy,
+				TrustStore:     javaTrustStoreToProto(m.TrustStore),
+			},
+		}
 	case *javalockfile.Metadata:
 		i.Metadata = &spb.Inventory_JavaLockfileMetadata{
 			JavaLockfileMetadata: &spb.JavaLockfileMetadata{
@@ -463,6 +784,697 @@ func setProtoMetadata(meta any, i *spb.Inventory) {
 				FullVersion: m.FullVersion,
 			},
 		}
+	case *winmetadata.SecurityProduct:
+		i.Metadata = &spb.Inventory_WindowsSecurityProductMetadata{
+			WindowsSecurityProductMetadata: &spb.WindowsSecurityProductMetadata{
+				Kind:       m.Kind,
+				Enabled:    m.Enabled,
+				Exclusions: defenderExclusionsToProto(m.Exclusions),
+			},
+		}
+	case *logpipeline.Metadata:
+		i.Metadata = &spb.Inventory_LogPipelineMetadata{
+			LogPipelineMetadata: &spb.LogPipelineMetadata{
+				Product: m.Product,
+				Outputs: logPipelineOutputsToProto(m.Outputs),
+			},
+		}
+	case *storagecluster.Metadata:
+		i.Metadata = &spb.Inventory_StorageClusterMetadata{
+			StorageClusterMetadata: &spb.StorageClusterMetadata{
+				Component:   m.Component,
+				ClusterId:   m.ClusterID,
+				Credentials: storageClusterCredentialsToProto(m.Credentials),
+			},
+		}
+	case *appbundle.Metadata:
+		i.Metadata = &spb.Inventory_AppBundleMetadata{
+			AppBundleMetadata: &spb.AppBundleMetadata{
+				Format:    m.Format,
+				Component: m.Component,
+				Bundle:    m.Bundle,
+			},
+		}
+	case *vendorlib.Metadata:
+		i.Metadata = &spb.Inventory_VendorLibraryMetadata{
+			VendorLibraryMetadata: &spb.VendorLibraryMetadata{
+				Soname:        m.SONAME,
+				FileVersion:   m.FileVersion,
+				VersionSource: m.VersionSource,
+				VendorDir:     m.VendorDir,
+			},
+		}
+	case *bzlmodlock.Metadata:
+		i.Metadata = &spb.Inventory_BazelModuleMetadata{
+			BazelModuleMetadata: &spb.BazelModuleMetadata{
+				Kind:     m.Kind,
+				RepoName: m.RepoName,
+				Registry: m.Registry,
+			},
+		}
+	case *iacsecrets.Metadata:
+		i.Metadata = &spb.Inventory_IacSecretsMetadata{
+			IacSecretsMetadata: &spb.IaCSecretsMetadata{
+				Tool:          m.Tool,
+				Secrets:       iacSecretsToProto(m.Secrets),
+				DecryptionKey: m.DecryptionKey,
+			},
+		}
+	case *dbserver.Metadata:
+		i.Metadata = &spb.Inventory_DatabaseServerMetadata{
+			DatabaseServerMetadata: &spb.DatabaseServerMetadata{
+				Server:        m.Server,
+				DataDirectory: m.DataDirectory,
+				Product:       m.Product,
+			},
+		}
+	case *registryconfig.Metadata:
+		i.Metadata = &spb.Inventory_RegistryConfigMetadata{
+			RegistryConfigMetadata: &spb.RegistryConfigMetadata{
+				Tool:              m.Tool,
+				Registries:        packageRegistriesToProto(m.Registries),
+				InsecureHosts:     m.InsecureHosts,
+				TlsVerifyDisabled: m.TLSVerifyDisabled,
+				GoSumDb:           m.GoSumDB,
+				GoNoSumDb:         m.GoNoSumDB,
+			},
+		}
+	case *edgeproxy.Metadata:
+		i.Metadata = &spb.Inventory_EdgeProxyMetadata{
+			EdgeProxyMetadata: &spb.EdgeProxyMetadata{
+				Proxy:       m.Proxy,
+				Routes:      edgeProxyRoutesToProto(m.Routes),
+				Credentials: edgeProxyCredentialsToProto(m.Credentials),
+			},
+		}
+	case *activedirectory.Metadata:
+		i.Metadata = &spb.Inventory_ActiveDirectoryMetadata{
+			ActiveDirectoryMetadata: &spb.ActiveDirectoryMetadata{
+				Artifact:    activeDirectoryArtifactToProto(m.Artifact),
+				Live:        m.Live,
+				SystemHive:  m.SystemHive,
+				Credentials: activeDirectoryCredentialsToProto(m.Credentials),
+			},
+		}
+	case *kerberos.Metadata:
+		i.Metadata = &spb.Inventory_KerberosMetadata{
+			KerberosMetadata: &spb.KerberosMetadata{
+				Kind:         string(m.Kind),
+				DefaultRealm: m.DefaultRealm,
+				Realms:       kerberosRealmsToProto(m.Realms),
+				Principals:   kerberosPrincipalsToProto(m.Principals),
+				Domains:      sssdDomainsToProto(m.Domains),
+				Keytabs:      m.Keytabs,
+			},
+		}
+	case *appsupervisor.Metadata:
+		i.Metadata = &spb.Inventory_AppSupervisorMetadata{
+			AppSupervisorMetadata: &spb.AppSupervisorMetadata{
+				Supervisor:       m.Supervisor,
+				Command:          m.Command,
+				WorkingDirectory: m.WorkingDirectory,
+				Runtime:          m.Runtime,
+				RuntimePath:      m.RuntimePath,
+				RuntimeVersion:   m.RuntimeVersion,
+				User:             m.User,
+				Listen:           m.Listen,
+				Instances:        int32(m.Instances),
+				Status:           m.Status,
+			},
+		}
+	case *wsl.Metadata:
+		i.Metadata = &spb.Inventory_WslDistributionMetadata{
+			WslDistributionMetadata: &spb.WSLDistributionMetadata{
+				Distribution:    m.Distribution,
+				WslVersion:      int32(m.WSLVersion),
+				OsId:            m.OSID,
+				OsVersionId:     m.OSVersionID,
+				OsName:          m.OSName,
+				DiskSizeBytes:   m.DiskSizeBytes,
+				PackagesScanned: m.PackagesScanned,
+			},
+		}
+	case *kernelmodules.Metadata:
+		i.Metadata = &spb.Inventory_KernelModuleMetadata{
+			KernelModuleMetadata: kernelModuleMetadataToProto(m),
+		}
+	case *ecsagent.Metadata:
+		i.Metadata = &spb.Inventory_EcsTaskContainerMetadata{
+			EcsTaskContainerMetadata: ecsTaskContainerMetadataToProto(m),
+		}
+	case *kubelet.Metadata:
+		i.Metadata = &spb.Inventory_KubeletPodMetadata{
+			KubeletPodMetadata: kubeletPodMetadataToProto(m),
+		}
+	case *privatecloud.Metadata:
+		i.Metadata = &spb.Inventory_PrivateCloudMetadata{
+			PrivateCloudMetadata: &spb.PrivateCloudMetadata{
+				Platform:    m.Platform,
+				Component:   m.Component,
+				Credentials: privateCloudCredentialsToProto(m.Credentials),
+			},
+		}
+	case *drupal.Metadata:
+		i.Metadata = &spb.Inventory_CmsExtensionMetadata{
+			CmsExtensionMetadata: &spb.CMSExtensionMetadata{
+				Cms:               "drupal",
+				Type:              m.Type,
+				Element:           m.MachineName,
+				DisplayName:       m.DisplayName,
+				CmsVersion:        m.DrupalVersion,
+				CoreCompatibility: m.CoreCompatibility,
+			},
+		}
+	case *pear.Metadata:
+		i.Metadata = &spb.Inventory_PearPackageMetadata{
+			PearPackageMetadata: &spb.PEARPackageMetadata{
+				Channel:           m.Channel,
+				ProvidesExtension: m.ProvidesExtension,
+				ReleaseDate:       m.ReleaseDate,
+			},
+		}
+	case *composerinstalled.Metadata:
+		i.Metadata = &spb.Inventory_ComposerPackageMetadata{
+			ComposerPackageMetadata: &spb.ComposerPackageMetadata{
+				DepGroups:      m.DepGroupVals,
+				Type:           m.Type,
+				InstallPath:    m.InstallPath,
+				PathRepository: m.PathRepository,
+			},
+		}
+	case *perllocal.Metadata:
+		i.Metadata = &spb.Inventory_PerlModuleMetadata{
+			PerlModuleMetadata: &spb.PerlModuleMetadata{
+				InstallDir:  m.InstallDir,
+				InstallDate: m.InstallDate,
+			},
+		}
+	case *synology.Metadata:
+		i.Metadata = &spb.Inventory_SynologyPackageMetadata{
+			SynologyPackageMetadata: &spb.SynologyPackageMetadata{
+				DisplayName:  m.DisplayName,
+				Maintainer:   m.Maintainer,
+				Arch:         m.Arch,
+				OsMinVersion: m.OSMinVersion,
+			},
+		}
+	case *qnap.Metadata:
+		i.Metadata = &spb.Inventory_QnapPackageMetadata{
+			QnapPackageMetadata: &spb.QNAPPackageMetadata{
+				DisplayName: m.DisplayName,
+				Author:      m.Author,
+				Enabled:     m.Enabled,
+				InstallPath: m.InstallPath,
+				Date:        m.Date,
+				Build:       m.Build,
+			},
+		}
+	case *gobinary.Metadata:
+		i.Metadata = &spb.Inventory_GoBinaryMetadata{
+			GoBinaryMetadata: &spb.GoBinaryMetadata{
+				MainModule:  m.MainModule,
+				BuildFlags:  m.BuildFlags,
+				TrimPath:    m.TrimPath,
+				Vcs:         m.VCS,
+				VcsRevision: m.VCSRevision,
+				VcsTime:     m.VCSTime,
+				VcsModified: m.VCSModified,
+			},
+		}
+	case *vendoredcode.Metadata:
+		i.Metadata = &spb.Inventory_VendoredCodeMetadata{
+			VendoredCodeMetadata: &spb.VendoredCodeMetadata{
+				VendorRoot:   m.VendorRoot,
+				IdentifiedBy: m.IdentifiedBy,
+				Manifest:     m.Manifest,
+				Ecosystem:    m.Ecosystem,
+				Digest:       m.Digest,
+			},
+		}
+	case *joomla.Metadata:
+		i.Metadata = &spb.Inventory_CmsExtensionMetadata{
+			CmsExtensionMetadata: &spb.CMSExtensionMetadata{
+				Cms:         "joomla",
+				Type:        m.Type,
+				Element:     m.Element,
+				DisplayName: m.DisplayName,
+				Author:      m.Author,
+				Client:      m.Client,
+				Group:       m.Group,
+			},
+		}
+	case *magento.Metadata:
+		element := m.ModuleName
+		if m.Type == "theme" {
+			element = m.ThemePath
+		}
+		i.Metadata = &spb.Inventory_CmsExtensionMetadata{
+			CmsExtensionMetadata: &spb.CMSExtensionMetadata{
+				Cms:         "magento",
+				Type:        m.Type,
+				Element:     element,
+				DisplayName: m.DisplayName,
+				CmsVersion:  m.SetupVersion,
+				Parent:      m.Parent,
+			},
+		}
+	}
+}
+
+func ecsTaskContainerMetadataToProto(m *ecsagent.Metadata) *spb.ECSTaskContainerMetadata {
+	p := &spb.ECSTaskContainerMetadata{
+		Cluster:              m.Cluster,
+		ContainerInstanceArn: m.ContainerInstanceARN,
+		TaskArn:              m.TaskARN,
+		TaskFamily:           m.TaskFamily,
+		TaskVersion:          m.TaskVersion,
+		TaskStatus:           m.TaskStatus,
+		ContainerName:        m.ContainerName,
+		DockerId:             m.DockerID,
+		ImageName:            m.ImageName,
+		ImageDigest:          m.ImageDigest,
+	}
+	for _, s := range m.Secrets {
+		p.Secrets = append(p.Secrets, &spb.ECSSecret{
+			Name:          s.Name,
+			ValueFrom:     s.ValueFrom,
+			Provider:      s.Provider,
+			Type:          s.Type,
+			ContainerPath: s.ContainerPath,
+		})
+	}
+	for _, mount := range m.Mounts {
+		p.Mounts = append(p.Mounts, &spb.ECSMount{
+			Volume:        mount.Volume,
+			SourcePath:    mount.SourcePath,
+			ContainerPath: mount.ContainerPath,
+			ReadOnly:      mount.ReadOnly,
+		})
+	}
+	return p
+}
+
+func kubeletPodMetadataToProto(m *kubelet.Metadata) *spb.KubeletPodMetadata {
+	p := &spb.KubeletPodMetadata{
+		Uid:           m.UID,
+		NamespaceName: m.Namespace,
+		Name:          m.Name,
+	}
+	for _, c := range m.Containers {
+		p.Containers = append(p.Containers, &spb.KubeletContainer{
+			Name: c.Name,
+			Ids:  c.IDs,
+		})
+	}
+	for _, v := range m.SecretVolumes {
+		p.SecretVolumes = append(p.SecretVolumes, &spb.KubeletVolume{
+			Name:  v.Name,
+			Type:  v.Type,
+			Path:  v.Path,
+			Files: v.Files,
+		})
+	}
+	return p
+}
+
+func privateCloudCredentialsToProto(creds []*privatecloud.Credential) []*spb.PrivateCloudCredential {
+	var result []*spb.PrivateCloudCredential
+	for _, c := range creds {
+		result = append(result, &spb.PrivateCloudCredential{
+			Type:      privateCloudCredentialTypeToProto(c.Type),
+			Key:       c.Key,
+			Principal: c.Principal,
+			Secret:    c.Secret,
+			Host:      c.Host,
+			Encrypted: c.Encrypted,
+			Admin:     c.Admin,
+		})
+	}
+	return result
+}
+
+func privateCloudCredentialTypeToProto(t privatecloud.CredentialType) spb.PrivateCloudCredential_TypeEnum {
+	switch t {
+	case privatecloud.CredentialDatabase:
+		return spb.PrivateCloudCredential_DATABASE
+	case privatecloud.CredentialMessageQueue:
+		return spb.PrivateCloudCredential_MESSAGE_QUEUE
+	case privatecloud.CredentialServicePassword:
+		return spb.PrivateCloudCredential_SERVICE_PASSWORD
+	case privatecloud.CredentialAdminToken:
+		return spb.PrivateCloudCredential_ADMIN_TOKEN
+	case privatecloud.CredentialSharedSecret:
+		return spb.PrivateCloudCredential_SHARED_SECRET
+	case privatecloud.CredentialAPIToken:
+		return spb.PrivateCloudCredential_API_TOKEN
+	case privatecloud.CredentialPassword:
+		return spb.PrivateCloudCredential_PASSWORD
+	case privatecloud.CredentialPrivateKey:
+		return spb.PrivateCloudCredential_PRIVATE_KEY
+	case privatecloud.CredentialEncryptionKey:
+		return spb.PrivateCloudCredential_ENCRYPTION_KEY
+	default:
+		return spb.PrivateCloudCredential_UNSPECIFIED
+	}
+}
+
+func kernelModuleMetadataToProto(m *kernelmodules.Metadata) *spb.KernelModuleMetadata {
+	if m.DKMS != nil {
+		return &spb.KernelModuleMetadata{
+			Kind: &spb.KernelModuleMetadata_Dkms{
+				Dkms: &spb.DKMSPackage{
+					Kernels:               m.DKMS.Kernels,
+					Modules:               m.DKMS.Modules,
+					BuiltForRunningKernel: m.DKMS.BuiltForRunningKernel,
+				},
+			},
+		}
+	}
+	if m.Module == nil {
+		return &spb.KernelModuleMetadata{}
+	}
+	var taints []string
+	for _, t := range m.Module.Taints {
+		taints = append(taints, string(t))
+	}
+	return &spb.KernelModuleMetadata{
+		Kind: &spb.KernelModuleMetadata_Module{
+			Module: &spb.LoadedKernelModule{
+				SrcVersion:    m.Module.SrcVersion,
+				SizeBytes:     m.Module.SizeBytes,
+				RefCount:      int32(m.Module.RefCount),
+				UsedBy:        m.Module.UsedBy,
+				State:         m.Module.State,
+				Taints:        taints,
+				DkmsPackage:   m.Module.DKMSPackage,
+				KernelRelease: m.Module.KernelRelease,
+			},
+		},
+	}
+}
+
+func logPipelineOutputsToProto(outputs []*logpipeline.Output) []*spb.LogPipelineOutput {
+	var result []*spb.LogPipelineOutput
+	for _, o := range outputs {
+		var creds []*spb.LogPipelineCredential
+		for _, c := range o.Credentials {
+			creds = append(creds, &spb.LogPipelineCredential{Key: c.Key, Value: c.Value})
+		}
+		result = append(result, &spb.LogPipelineOutput{
+			Type:         o.Type,
+			Destinations: o.Destinations,
+			Credentials:  creds,
+		})
+	}
+	return result
+}
+
+func storageClusterCredentialsToProto(creds []*storagecluster.Credential) []*spb.StorageClusterCredential {
+	var result []*spb.StorageClusterCredential
+	for _, c := range creds {
+		result = append(result, &spb.StorageClusterCredential{
+			Type:      storageClusterCredentialTypeToProto(c.Type),
+			Principal: c.Principal,
+			Secret:    c.Secret,
+			Admin:     c.Admin,
+		})
+	}
+	return result
+}
+
+func storageClusterCredentialTypeToProto(t storagecluster.CredentialType) spb.StorageClusterCredential_TypeEnum {
+	switch t {
+	case storagecluster.CredentialCephKey:
+		return spb.StorageClusterCredential_CEPH_KEY
+	case storagecluster.CredentialTLSPrivateKey:
+		return spb.StorageClusterCredential_TLS_PRIVATE_KEY
+	case storagecluster.CredentialS3:
+		return spb.StorageClusterCredential_S3
+	default:
+		return spb.StorageClusterCredential_UNSPECIFIED
+	}
+}
+
+func iacSecretsToProto(secrets []*iacsecrets.Secret) []*spb.IaCSecret {
+	var result []*spb.IaCSecret
+	for _, s := range secrets {
+		result = append(result, &spb.IaCSecret{
+			Type:        iacSecretTypeToProto(s.Type),
+			Key:         s.Key,
+			Value:       s.Value,
+			Decryptable: s.Decryptable,
+		})
+	}
+	return result
+}
+
+func iacSecretTypeToProto(t iacsecrets.SecretType) spb.IaCSecret_TypeEnum {
+	switch t {
+	case iacsecrets.SecretEYAML:
+		return spb.IaCSecret_EYAML
+	case iacsecrets.SecretEncryptedDataBag:
+		return spb.IaCSecret_ENCRYPTED_DATA_BAG
+	case iacsecrets.SecretPlaintextPassword:
+		return spb.IaCSecret_PLAINTEXT_PASSWORD
+	default:
+		return spb.IaCSecret_UNSPECIFIED
+	}
+}
+
+func activeDirectoryArtifactToProto(a activedirectory.Artifact) spb.ActiveDirectoryMetadata_ArtifactEnum {
+	switch a {
+	case activedirectory.ArtifactNTDS:
+		return spb.ActiveDirectoryMetadata_NTDS_DATABASE
+	case activedirectory.ArtifactGPP:
+		return spb.ActiveDirectoryMetadata_GPP_PASSWORD
+	case activedirectory.ArtifactLogonScript:
+		return spb.ActiveDirectoryMetadata_LOGON_SCRIPT
+	default:
+		return spb.ActiveDirectoryMetadata_UNSPECIFIED
+	}
+}
+
+func activeDirectoryCredentialsToProto(creds []*activedirectory.Credential) []*spb.ActiveDirectoryCredential {
+	var result []*spb.ActiveDirectoryCredential
+	for _, c := range creds {
+		result = append(result, &spb.ActiveDirectoryCredential{
+			Context:   c.Context,
+			Username:  c.Username,
+			Password:  c.Password,
+			Cpassword: c.CPassword,
+			Line:      int32(c.Line),
+		})
+	}
+	return result
+}
+
+func kerberosRealmsToProto(realms []*kerberos.Realm) []*spb.KerberosRealm {
+	var result []*spb.KerberosRealm
+	for _, r := range realms {
+		result = append(result, &spb.KerberosRealm{
+			Name:        r.Name,
+			Kdcs:        r.KDCs,
+			AdminServer: r.AdminServer,
+		})
+	}
+	return result
+}
+
+func kerberosPrincipalsToProto(principals []*kerberos.Principal) []*spb.KerberosPrincipal {
+	var result []*spb.KerberosPrincipal
+	for _, p := range principals {
+		result = append(result, &spb.KerberosPrincipal{
+			Name:     p.Name,
+			Realm:    p.Realm,
+			Kvno:     p.KVNO,
+			EncTypes: p.EncTypes,
+		})
+	}
+	return result
+}
+
+func sssdDomainsToProto(domains []*kerberos.Domain) []*spb.SSSDDomain {
+	var result []*spb.SSSDDomain
+	for _, d := range domains {
+		result = append(result, &spb.SSSDDomain{
+			Name:         d.Name,
+			IdProvider:   d.IDProvider,
+			AuthProvider: d.AuthProvider,
+			Realm:        d.Realm,
+			Servers:      d.Servers,
+			Keytab:       d.Keytab,
+		})
+	}
+	return result
+}
+
+func packageRegistriesToProto(registries []*registryconfig.Registry) []*spb.PackageRegistry {
+	var result []*spb.PackageRegistry
+	for _, r := range registries {
+		result = append(result, &spb.PackageRegistry{
+			Url:   r.URL,
+			Role:  packageRegistryRoleToProto(r.Role),
+			Scope: r.Scope,
+		})
+	}
+	return result
+}
+
+func packageRegistryRoleToProto(r registryconfig.Role) spb.PackageRegistry_RoleEnum {
+	switch r {
+	case registryconfig.RoleIndex:
+		return spb.PackageRegistry_INDEX
+	case registryconfig.RoleExtraIndex:
+		return spb.PackageRegistry_EXTRA_INDEX
+	case registryconfig.RoleScope:
+		return spb.PackageRegistry_SCOPE
+	case registryconfig.RoleProxy:
+		return spb.PackageRegistry_PROXY
+	default:
+		return spb.PackageRegistry_UNSPECIFIED
+	}
+}
+
+func javaTrustStoreToProto(ts *javaruntime.TrustStore) *spb.JavaTrustStore {
+	if ts == nil {
+		return nil
+	}
+	result := &spb.JavaTrustStore{
+		Path:   ts.Path,
+		Format: ts.Format,
+	}
+	for _, c := range ts.Certificates {
+		result.Certificates = append(result.Certificates, &spb.JavaTrustedCertificate{
+			Alias:    c.Alias,
+			Subject:  c.Subject,
+			NotAfter: timestamppb.New(c.NotAfter),
+			Expired:  c.Expired,
+			Added:    c.Added,
+		})
+	}
+	return result
+}
+
+func androidAppToProto(app *android.App) *spb.AndroidApp {
+	if app == nil {
+		return nil
+	}
+	result := &spb.AndroidApp{
+		PackageName:         app.PackageName,
+		VersionCode:         app.VersionCode,
+		CodePath:            app.CodePath,
+		Installer:           app.Installer,
+		System:              app.System,
+		UpdatedSystemApp:    app.UpdatedSystemApp,
+		SigningCertificates: app.SigningCertificates,
+	}
+	if !app.FirstInstallTime.IsZero() {
+		result.FirstInstallTime = timestamppb.New(app.FirstInstallTime)
+	}
+	if !app.LastUpdateTime.IsZero() {
+		result.LastUpdateTime = timestamppb.New(app.LastUpdateTime)
+	}
+	return result
+}
+
+func androidPartitionToProto(p *android.Partition) *spb.AndroidPartition {
+	if p == nil {
+		return nil
+	}
+	return &spb.AndroidPartition{
+		Name:          p.Name,
+		Release:       p.Release,
+		SdkVersion:    p.SDKVersion,
+		SecurityPatch: p.SecurityPatch,
+		Fingerprint:   p.Fingerprint,
+		BuildId:       p.BuildID,
+	}
+}
+
+func edgeProxyRoutesToProto(routes []*edgeproxy.Route) []*spb.EdgeProxyRoute {
+	var result []*spb.EdgeProxyRoute
+	for _, r := range routes {
+		result = append(result, &spb.EdgeProxyRoute{
+			Name:      r.Name,
+			Listen:    r.Listen,
+			Match:     r.Match,
+			Upstreams: r.Upstreams,
+			Tls:       r.TLS,
+		})
+	}
+	return result
+}
+
+func edgeProxyCredentialsToProto(creds []*edgeproxy.Credential) []*spb.EdgeProxyCredential {
+	var result []*spb.EdgeProxyCredential
+	for _, c := range creds {
+		result = append(result, &spb.EdgeProxyCredential{
+			Type:      edgeProxyCredentialTypeToProto(c.Type),
+			Context:   c.Context,
+			Principal: c.Principal,
+			Secret:    c.Secret,
+		})
+	}
+	return result
+}
+
+func edgeProxyCredentialTypeToProto(t edgeproxy.CredentialType) spb.EdgeProxyCredential_TypeEnum {
+	switch t {
+	case edgeproxy.CredentialPassword:
+		return spb.EdgeProxyCredential_PASSWORD
+	case edgeproxy.CredentialPasswordHash:
+		return spb.EdgeProxyCredential_PASSWORD_HASH
+	case edgeproxy.CredentialTLSPrivateKey:
+		return spb.EdgeProxyCredential_TLS_PRIVATE_KEY
+	case edgeproxy.CredentialAuthHeader:
+		return spb.EdgeProxyCredential_AUTH_HEADER
+	default:
+		return spb.EdgeProxyCredential_UNSPECIFIED
+	}
+}
+
+func packageOriginToProto(o *origin.Origin) *spb.PackageOrigin {
+	if o == nil {
+		return nil
+	}
+	var e spb.PackageOrigin_OriginEnum
+	switch o.Class {
+	case origin.Distro:
+		e = spb.PackageOrigin_DISTRO
+	case origin.ThirdParty:
+		e = spb.PackageOrigin_THIRD_PARTY
+	case origin.Local:
+		e = spb.PackageOrigin_LOCAL
+	default:
+		e = spb.PackageOrigin_UNKNOWN
+	}
+	return &spb.PackageOrigin{Origin: e, Repository: o.Repository}
+}
+
+func defenderExclusionsToProto(exclusions []*winmetadata.DefenderExclusion) []*spb.DefenderExclusion {
+	var result []*spb.DefenderExclusion
+	for _, e := range exclusions {
+		result = append(result, &spb.DefenderExclusion{
+			Type:       defenderExclusionTypeToProto(e.Type),
+			Value:      e.Value,
+			FromPolicy: e.FromPolicy,
+		})
+	}
+	return result
+}
+
+func defenderExclusionTypeToProto(t winmetadata.DefenderExclusionType) spb.DefenderExclusion_TypeEnum {
+	switch t {
+	case winmetadata.ExclusionPath:
+		return spb.DefenderExclusion_PATH
+	case winmetadata.ExclusionExtension:
+		return spb.DefenderExclusion_EXTENSION
+	case winmetadata.ExclusionProcess:
+		return spb.DefenderExclusion_PROCESS
+	case winmetadata.ExclusionIPAddress:
+		return spb.DefenderExclusion_IP_ADDRESS
+	default:
+		return spb.DefenderExclusion_UNSPECIFIED
 	}
 }
 
@@ -522,8 +1534,10 @@ func layerDetailsToProto(ld *extractor.LayerDetails) *spb.LayerDetails {
 	return &spb.LayerDetails{
 		Index:       int32(ld.Index),
 		DiffId:      ld.DiffID,
+		ChainId:     ld.ChainID,
 		Command:     ld.Command,
 		InBaseImage: ld.InBaseImage,
+		BaseImage:   ld.BaseImage,
 	}
 }
 
@@ -551,13 +1565,14 @@ var ErrAdvisoryMissing = fmt.Errorf("Advisory missing in finding")
 // ErrAdvisoryIDMissing will be returned if the Advisory ID is not set on a finding.
 var ErrAdvisoryIDMissing = fmt.Errorf("Advisory ID missing in finding")
 
-func findingToProto(f *detector.Finding) (*spb.Finding, error) {
+// FindingToProto converts a Finding go struct into the equivalent proto.
+func FindingToProto(f *detector.Finding) (*spb.Finding, error) {
 	if f.Adv == nil {
 		return nil, ErrAdvisoryMissing
 	}
 	var target *spb.TargetDetails
 	if f.Target != nil {
-		i, err := inventoryToProto(f.Target.Inventory)
+		i, err := InventoryToProto(f.Target.Inventory)
 		if err != nil {
 			return nil, err
 		}
@@ -580,6 +1595,8 @@ func findingToProto(f *detector.Finding) (*spb.Finding, error) {
 			Description:    f.Adv.Description,
 			Recommendation: f.Adv.Recommendation,
 			Sev:            severityToProto(f.Adv.Sev),
+			MessageKey:     string(f.Adv.MessageKey),
+			Locale:         f.Adv.Locale,
 		},
 		Target: target,
 		Extra:  f.Extra,
@@ -598,21 +1615,7 @@ func typeEnumToProto(e detector.TypeEnum) spb.Advisory_TypeEnum {
 }
 
 func severityToProto(s *detector.Severity) *spb.Severity {
-	r := &spb.Severity{}
-	switch s.Severity {
-	case detector.SeverityMinimal:
-		r.Severity = spb.Severity_MINIMAL
-	case detector.SeverityLow:
-		r.Severity = spb.Severity_LOW
-	case detector.SeverityMedium:
-		r.Severity = spb.Severity_MEDIUM
-	case detector.SeverityHigh:
-		r.Severity = spb.Severity_HIGH
-	case detector.SeverityCritical:
-		r.Severity = spb.Severity_CRITICAL
-	default:
-		r.Severity = spb.Severity_UNSPECIFIED
-	}
+	r := &spb.Severity{Severity: severityEnumToProto(s.Severity)}
 	if s.CVSSV2 != nil {
 		r.CvssV2 = cvssToProto(s.CVSSV2)
 	}
@@ -622,6 +1625,23 @@ func severityToProto(s *detector.Severity) *spb.Severity {
 	return r
 }
 
+func severityEnumToProto(s detector.SeverityEnum) spb.Severity_SeverityEnum {
+	switch s {
+	case detector.SeverityMinimal:
+		return spb.Severity_MINIMAL
+	case detector.SeverityLow:
+		return spb.Severity_LOW
+	case detector.SeverityMedium:
+		return spb.Severity_MEDIUM
+	case detector.SeverityHigh:
+		return spb.Severity_HIGH
+	case detector.SeverityCritical:
+		return spb.Severity_CRITICAL
+	default:
+		return spb.Severity_UNSPECIFIED
+	}
+}
+
 func cvssToProto(c *detector.CVSS) *spb.CVSS {
 	return &spb.CVSS{
 		BaseScore:          c.BaseScore,
@@ -25,27 +25,39 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/aggregate"
 	"github.com/google/osv-scalibr/binary/proto"
+	"github.com/google/osv-scalibr/converter"
 	"github.com/google/osv-scalibr/detector"
 	"github.com/google/osv-scalibr/extractor"
 	ctrdfs "github.com/google/osv-scalibr/extractor/filesystem/containers/containerd"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/depsjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/golang/gobinary"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/composerinstalled"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/requirements"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargolock"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendoredcode"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/origin"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/pacman"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/portage"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
 	ctrdruntime "github.com/google/osv-scalibr/extractor/standalone/containers/containerd"
 	winmetadata "github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/dismpatch"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/summary"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/tunnel"
 	"google.golang.org/protobuf/testing/protocmp"
 
 	spb "github.com/google/osv-scalibr/binary/proto/scan_result_go_proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -168,6 +180,7 @@ func TestScanResultToProto(t *testing.T) {
 			OSVersionCodename: "jammy",
 			Maintainer:        "maintainer",
 			Architecture:      "amd64",
+			Origin:            &origin.Origin{Class: origin.Distro, Repository: "Debian"},
 		},
 		Locations: []string{"/file1"},
 		Extractor: dpkg.New(dpkg.DefaultConfig()),
@@ -227,6 +240,65 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: &packagejson.Extractor{},
 	}
 
+	cargoBitflags := &extractor.Inventory{
+		Name:      "bitflags",
+		Version:   "1.3.2",
+		Locations: []string{"/Cargo.lock"},
+		Extractor: cargolock.Extractor{},
+	}
+	goBinaryToolchain := &extractor.Inventory{
+		Name:      "go",
+		Version:   "1.22.12",
+		Locations: []string{"/usr/local/bin/app"},
+		Extractor: gobinary.New(gobinary.DefaultConfig()),
+		Metadata: &gobinary.Metadata{
+			MainModule:  "example.com/app",
+			BuildFlags:  []string{"-buildmode=exe", "-trimpath=true"},
+			TrimPath:    true,
+			VCS:         "git",
+			VCSRevision: "78a9af159e593ae083a757e7af2607a975b6255c",
+			VCSTime:     "2024-05-01T10:00:00Z",
+			VCSModified: true,
+		},
+	}
+	vendoredLodash := &extractor.Inventory{
+		Name:      "lodash",
+		Version:   "4.17.21",
+		Locations: []string{"web/node_modules/lodash"},
+		Extractor: vendoredcode.New(vendoredcode.DefaultConfig()),
+		Metadata: &vendoredcode.Metadata{
+			VendorRoot:   "web/node_modules",
+			IdentifiedBy: vendoredcode.IdentifiedByManifest,
+			Manifest:     "web/node_modules/lodash/package.json",
+			Ecosystem:    "npm",
+			Digest:       "sha256:a1d8baa5467ac0302d3d62b6e6bc220fad4b6dce136ebcb0433b70047732f527",
+		},
+	}
+	composerPHPUnit := &extractor.Inventory{
+		Name:       "phpunit/phpunit",
+		Version:    "10.5.20",
+		Locations:  []string{"vendor/composer/installed.json"},
+		Extractor:  composerinstalled.New(composerinstalled.DefaultConfig()),
+		SourceCode: &extractor.SourceCodeIdentifier{Commit: "547d314dc24ec1e177720d45c6263fb226cc2ae3"},
+		Metadata: &composerinstalled.Metadata{
+			DepGroupVals: []string{"dev"},
+			Type:         "library",
+			InstallPath:  "../phpunit/phpunit",
+		},
+	}
+	cargoNix := &extractor.Inventory{
+		Name:      "nix",
+		Version:   "0.26.4",
+		Locations: []string{"/Cargo.lock"},
+		Extractor: cargolock.Extractor{},
+		Dependencies: []extractor.Dependency{
+			{Name: "bitflags", Version: "1.3.2"},
+			// Not part of the scan result.
+			{Name: "libc", Version: "0.2.150"},
+		},
+		DirectDependency: true,
+	}
+
 	purlDotnetDepsJSONInventory := &extractor.Inventory{
 		Name:    "software",
 		Version: "1.0.0",
@@ -240,8 +312,9 @@ func TestScanResultToProto(t *testing.T) {
 	}
 
 	purlDotnetDepsJSONInventoryProto := &spb.Inventory{
-		Name:    "software",
-		Version: "1.0.0",
+		InstanceId: converter.ToInstanceID(purlDotnetDepsJSONInventory),
+		Name:       "software",
+		Version:    "1.0.0",
 		Purl: &spb.Purl{
 			Purl:    "pkg:nuget/software@1.0.0",
 			Type:    purl.TypeNuget,
@@ -271,8 +344,9 @@ func TestScanResultToProto(t *testing.T) {
 	}
 
 	purlDPKGInventoryProto := &spb.Inventory{
-		Name:    "software",
-		Version: "1.0.0",
+		InstanceId: converter.ToInstanceID(purlDPKGInventory),
+		Name:       "software",
+		Version:    "1.0.0",
 		Purl: &spb.Purl{
 			Purl:      "pkg:deb/debian/software@1.0.0?arch=amd64&distro=jammy",
 			Type:      purl.TypeDebian,
@@ -293,14 +367,19 @@ func TestScanResultToProto(t *testing.T) {
 				OsVersionCodename: "jammy",
 				Maintainer:        "maintainer",
 				Architecture:      "amd64",
+				Origin: &spb.PackageOrigin{
+					Origin:     spb.PackageOrigin_DISTRO,
+					Repository: "Debian",
+				},
 			},
 		},
 		Locations: []string{"/file1"},
 		Extractor: "os/dpkg",
 	}
 	purlDPKGAnnotationInventoryProto := &spb.Inventory{
-		Name:    "software",
-		Version: "1.0.0",
+		InstanceId: converter.ToInstanceID(purlDPKGAnnotationInventory),
+		Name:       "software",
+		Version:    "1.0.0",
 		Purl: &spb.Purl{
 			Purl:      "pkg:deb/debian/software@1.0.0?arch=amd64&distro=jammy",
 			Type:      purl.TypeDebian,
@@ -328,8 +407,9 @@ func TestScanResultToProto(t *testing.T) {
 		Annotations: []spb.Inventory_AnnotationEnum{spb.Inventory_TRANSITIONAL},
 	}
 	purlPythonInventoryProto := &spb.Inventory{
-		Name:    "software",
-		Version: "1.0.0",
+		InstanceId: converter.ToInstanceID(purlPythonInventory),
+		Name:       "software",
+		Version:    "1.0.0",
 		Purl: &spb.Purl{
 			Purl:    "pkg:pypi/software@1.0.0",
 			Type:    purl.TypePyPi,
@@ -347,8 +427,9 @@ func TestScanResultToProto(t *testing.T) {
 		},
 	}
 	pythonRequirementsInventoryProto := &spb.Inventory{
-		Name:    "foo",
-		Version: "1.0",
+		InstanceId: converter.ToInstanceID(pythonRequirementsInventory),
+		Name:       "foo",
+		Version:    "1.0",
 		Purl: &spb.Purl{
 			Purl:    "pkg:pypi/foo@1.0",
 			Type:    purl.TypePyPi,
@@ -366,8 +447,9 @@ func TestScanResultToProto(t *testing.T) {
 		},
 	}
 	purlJavascriptInventoryProto := &spb.Inventory{
-		Name:    "software",
-		Version: "1.0.0",
+		InstanceId: converter.ToInstanceID(purlJavascriptInventory),
+		Name:       "software",
+		Version:    "1.0.0",
 		Purl: &spb.Purl{
 			Purl:    "pkg:npm/software@1.0.0",
 			Type:    purl.TypeNPM,
@@ -400,9 +482,10 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: &cdx.Extractor{},
 	}
 	cdxInventoryProto := &spb.Inventory{
-		Name:      "openssl",
-		Version:   "1.1.1",
-		Ecosystem: "generic",
+		InstanceId: converter.ToInstanceID(cdxInventory),
+		Name:       "openssl",
+		Version:    "1.1.1",
+		Ecosystem:  "generic",
 		Purl: &spb.Purl{
 			Purl:    "pkg:generic/openssl@1.1.1",
 			Type:    purl.TypeGeneric,
@@ -441,8 +524,9 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: rpm.New(rpm.DefaultConfig()),
 	}
 	purlRPMInventoryProto := &spb.Inventory{
-		Name:    "openssh-clients",
-		Version: "5.3p1",
+		InstanceId: converter.ToInstanceID(purlRPMInventory),
+		Name:       "openssh-clients",
+		Version:    "5.3p1",
 		Purl: &spb.Purl{
 			Purl:      "pkg:rpm/rhel/openssh-clients@5.3p1?arch=x86_64&distro=rhel-8.9&epoch=2&sourcerpm=openssh-5.3p1-124.el6_10.src.rpm",
 			Type:      purl.TypeRPM,
@@ -487,8 +571,9 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: pacman.New(pacman.DefaultConfig()),
 	}
 	purlPACMANInventoryProto := &spb.Inventory{
-		Name:    "zstd",
-		Version: "1.5.6-1",
+		InstanceId: converter.ToInstanceID(purlPACMANInventory),
+		Name:       "zstd",
+		Version:    "1.5.6-1",
 		Purl: &spb.Purl{
 			Purl:      "pkg:pacman/arch/zstd@1.5.6-1?distro=20241201.0.284684",
 			Type:      purl.TypePacman,
@@ -524,8 +609,9 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: portage.New(portage.DefaultConfig()),
 	}
 	purlPORTAGEInventoryProto := &spb.Inventory{
-		Name:    "Capture-Tiny",
-		Version: "0.480.0-r1",
+		InstanceId: converter.ToInstanceID(purlPORTAGEInventory),
+		Name:       "Capture-Tiny",
+		Version:    "0.480.0-r1",
 		Purl: &spb.Purl{
 			Purl:      "pkg:portage/gentoo/Capture-Tiny@0.480.0-r1?distro=2.17",
 			Type:      purl.TypePortage,
@@ -567,9 +653,10 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: &ctrdfs.Extractor{},
 	}
 	containerdInventoryProto := &spb.Inventory{
-		Name:      "gcr.io/google-samples/hello-app:1.0",
-		Version:   "sha256:b1455e1c4fcc5ea1023c9e3b584cd84b64eb920e332feff690a2829696e379e7",
-		Ecosystem: "",
+		InstanceId: converter.ToInstanceID(containerdInventory),
+		Name:       "gcr.io/google-samples/hello-app:1.0",
+		Version:    "sha256:b1455e1c4fcc5ea1023c9e3b584cd84b64eb920e332feff690a2829696e379e7",
+		Ecosystem:  "",
 		Metadata: &spb.Inventory_ContainerdContainerMetadata{
 			ContainerdContainerMetadata: &spb.ContainerdContainerMetadata{
 				NamespaceName: "default",
@@ -603,9 +690,10 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: &ctrdruntime.Extractor{},
 	}
 	containerdRuntimeInventoryProto := &spb.Inventory{
-		Name:      "gcr.io/google-samples/hello-app:1.0",
-		Version:   "sha256:b1455e1c4fcc5ea1023c9e3b584cd84b64eb920e332feff690a2829696e379e7",
-		Ecosystem: "",
+		InstanceId: converter.ToInstanceID(containerdRuntimeInventory),
+		Name:       "gcr.io/google-samples/hello-app:1.0",
+		Version:    "sha256:b1455e1c4fcc5ea1023c9e3b584cd84b64eb920e332feff690a2829696e379e7",
+		Ecosystem:  "",
 		Metadata: &spb.Inventory_ContainerdRuntimeContainerMetadata{
 			ContainerdRuntimeContainerMetadata: &spb.ContainerdRuntimeContainerMetadata{
 				NamespaceName: "default",
@@ -621,8 +709,9 @@ func TestScanResultToProto(t *testing.T) {
 		Extractor: "containers/containerd-runtime",
 	}
 	windowsInventoryProto := &spb.Inventory{
-		Name:    "windows_server_2019",
-		Version: "10.0.17763.3406",
+		InstanceId: converter.ToInstanceID(windowsInventory),
+		Name:       "windows_server_2019",
+		Version:    "10.0.17763.3406",
 		Metadata: &spb.Inventory_WindowsOsVersionMetadata{
 			WindowsOsVersionMetadata: &spb.WindowsOSVersion{
 				Product:     "windows_server_2019",
@@ -657,11 +746,13 @@ func TestScanResultToProto(t *testing.T) {
 			DiffID:      "hash1",
 			Command:     "command1",
 			InBaseImage: true,
+			BaseImage:   "alpine:3.20",
 		},
 	}
 	purlPythonInventoryWithLayerDetailsProto := &spb.Inventory{
-		Name:    "software",
-		Version: "1.0.0",
+		InstanceId: converter.ToInstanceID(purlPythonInventoryWithLayerDetails),
+		Name:       "software",
+		Version:    "1.0.0",
 		Purl: &spb.Purl{
 			Purl:    "pkg:pypi/software@1.0.0",
 			Type:    purl.TypePyPi,
@@ -682,6 +773,7 @@ func TestScanResultToProto(t *testing.T) {
 			DiffId:      "hash1",
 			Command:     "command1",
 			InBaseImage: true,
+			BaseImage:   "alpine:3.20",
 		},
 	}
 
@@ -1157,6 +1249,214 @@ func TestScanResultToProto(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "Scan with dependencies",
+			res: &scalibr.ScanResult{
+				Version:     "1.0.0",
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Status:      success,
+				Inventories: []*extractor.Inventory{cargoNix, cargoBitflags},
+			},
+			want: &spb.ScanResult{
+				Version:      "1.0.0",
+				StartTime:    timestamppb.New(startTime),
+				EndTime:      timestamppb.New(endTime),
+				Status:       successProto,
+				PluginStatus: []*spb.PluginStatus{},
+				Inventories: []*spb.Inventory{
+					{
+						InstanceId: converter.ToInstanceID(cargoNix),
+						Name:       "nix",
+						Version:    "0.26.4",
+						Purl: &spb.Purl{
+							Purl:    "pkg:cargo/nix@0.26.4",
+							Type:    purl.TypeCargo,
+							Name:    "nix",
+							Version: "0.26.4",
+						},
+						Ecosystem: "crates.io",
+						Locations: []string{"/Cargo.lock"},
+						Extractor: "rust/Cargolock",
+						Dependencies: []*spb.Dependency{
+							{Name: "bitflags", Version: "1.3.2", InstanceId: converter.ToInstanceID(cargoBitflags)},
+							{Name: "libc", Version: "0.2.150"},
+						},
+						DirectDependency: true,
+					},
+					{
+						InstanceId: converter.ToInstanceID(cargoBitflags),
+						Name:       "bitflags",
+						Version:    "1.3.2",
+						Purl: &spb.Purl{
+							Purl:    "pkg:cargo/bitflags@1.3.2",
+							Type:    purl.TypeCargo,
+							Name:    "bitflags",
+							Version: "1.3.2",
+						},
+						Ecosystem: "crates.io",
+						Locations: []string{"/Cargo.lock"},
+						Extractor: "rust/Cargolock",
+					},
+				},
+				Findings: []*spb.Finding{},
+				Secrets:  []*spb.Secret{},
+			},
+		},
+		{
+			desc: "Scan with Go binary build settings",
+			res: &scalibr.ScanResult{
+				Version:     "1.0.0",
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Status:      success,
+				Inventories: []*extractor.Inventory{goBinaryToolchain},
+			},
+			want: &spb.ScanResult{
+				Version:      "1.0.0",
+				StartTime:    timestamppb.New(startTime),
+				EndTime:      timestamppb.New(endTime),
+				Status:       successProto,
+				PluginStatus: []*spb.PluginStatus{},
+				Inventories: []*spb.Inventory{
+					{
+						InstanceId: converter.ToInstanceID(goBinaryToolchain),
+						Name:       "go",
+						Version:    "1.22.12",
+						Purl: &spb.Purl{
+							Purl:    "pkg:golang/go@1.22.12",
+							Type:    purl.TypeGolang,
+							Name:    "go",
+							Version: "1.22.12",
+						},
+						Ecosystem: "Go",
+						Locations: []string{"/usr/local/bin/app"},
+						Extractor: "go/binary",
+						Metadata: &spb.Inventory_GoBinaryMetadata{
+							GoBinaryMetadata: &spb.GoBinaryMetadata{
+								MainModule:  "example.com/app",
+								BuildFlags:  []string{"-buildmode=exe", "-trimpath=true"},
+								TrimPath:    true,
+								Vcs:         "git",
+								VcsRevision: "78a9af159e593ae083a757e7af2607a975b6255c",
+								VcsTime:     "2024-05-01T10:00:00Z",
+								VcsModified: true,
+							},
+						},
+					},
+				},
+				Findings: []*spb.Finding{},
+				Secrets:  []*spb.Secret{},
+			},
+		},
+		{
+			desc: "Scan with vendored code",
+			res: &scalibr.ScanResult{
+				Version:     "1.0.0",
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Status:      success,
+				Inventories: []*extractor.Inventory{vendoredLodash},
+			},
+			want: &spb.ScanResult{
+				Version:      "1.0.0",
+				StartTime:    timestamppb.New(startTime),
+				EndTime:      timestamppb.New(endTime),
+				Status:       successProto,
+				PluginStatus: []*spb.PluginStatus{},
+				Inventories: []*spb.Inventory{
+					{
+						InstanceId: converter.ToInstanceID(vendoredLodash),
+						Name:       "lodash",
+						Version:    "4.17.21",
+						Purl: &spb.Purl{
+							Purl:    "pkg:npm/lodash@4.17.21",
+							Type:    purl.TypeNPM,
+							Name:    "lodash",
+							Version: "4.17.21",
+						},
+						Ecosystem: "npm",
+						Locations: []string{"web/node_modules/lodash"},
+						Extractor: "misc/vendoredcode",
+						Metadata: &spb.Inventory_VendoredCodeMetadata{
+							VendoredCodeMetadata: &spb.VendoredCodeMetadata{
+								VendorRoot:   "web/node_modules",
+								IdentifiedBy: "manifest",
+								Manifest:     "web/node_modules/lodash/package.json",
+								Ecosystem:    "npm",
+								Digest:       "sha256:a1d8baa5467ac0302d3d62b6e6bc220fad4b6dce136ebcb0433b70047732f527",
+							},
+						},
+					},
+				},
+				Findings: []*spb.Finding{},
+				Secrets:  []*spb.Secret{},
+			},
+		},
+		{
+			desc: "Scan with Composer package",
+			res: &scalibr.ScanResult{
+				Version:     "1.0.0",
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Status:      success,
+				Inventories: []*extractor.Inventory{composerPHPUnit},
+			},
+			want: &spb.ScanResult{
+				Version:      "1.0.0",
+				StartTime:    timestamppb.New(startTime),
+				EndTime:      timestamppb.New(endTime),
+				Status:       successProto,
+				PluginStatus: []*spb.PluginStatus{},
+				Inventories: []*spb.Inventory{
+					{
+						InstanceId: converter.ToInstanceID(composerPHPUnit),
+						Name:       "phpunit/phpunit",
+						Version:    "10.5.20",
+						SourceCode: &spb.SourceCodeIdentifier{Commit: "547d314dc24ec1e177720d45c6263fb226cc2ae3"},
+						Purl: &spb.Purl{
+							Purl:    "pkg:composer/phpunit%2Fphpunit@10.5.20",
+							Type:    purl.TypeComposer,
+							Name:    "phpunit/phpunit",
+							Version: "10.5.20",
+						},
+						Ecosystem: "Packagist",
+						Locations: []string{"vendor/composer/installed.json"},
+						Extractor: "php/composerinstalled",
+						Metadata: &spb.Inventory_ComposerPackageMetadata{
+							ComposerPackageMetadata: &spb.ComposerPackageMetadata{
+								DepGroups:   []string{"dev"},
+								Type:        "library",
+								InstallPath: "../phpunit/phpunit",
+							},
+						},
+					},
+				},
+				Findings: []*spb.Finding{},
+				Secrets:  []*spb.Secret{},
+			},
+		},
+		{
+			desc: "Scan with labels",
+			res: &scalibr.ScanResult{
+				Version:   "1.0.0",
+				StartTime: startTime,
+				EndTime:   endTime,
+				Status:    success,
+				Labels:    map[string]string{"tenant": "acme", "environment": "prod"},
+			},
+			want: &spb.ScanResult{
+				Version:      "1.0.0",
+				StartTime:    timestamppb.New(startTime),
+				EndTime:      timestamppb.New(endTime),
+				Status:       successProto,
+				PluginStatus: []*spb.PluginStatus{},
+				Inventories:  []*spb.Inventory{},
+				Findings:     []*spb.Finding{},
+				Secrets:      []*spb.Secret{},
+				Labels:       map[string]string{"tenant": "acme", "environment": "prod"},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1175,3 +1475,107 @@ func TestScanResultToProto(t *testing.T) {
 		})
 	}
 }
+
+func TestFleetStatsToProto(t *testing.T) {
+	stats := &aggregate.FleetStats{
+		Scans:           3,
+		FailedScans:     1,
+		VulnerableScans: 1,
+		TopVulnerablePackages: []*aggregate.VulnerablePackage{
+			{Name: "openssl", Version: "3.0.11", Ecosystem: "Debian:12", Scans: 1, Advisories: []string{"CVE-2024-1"}},
+		},
+		SecretTypes: []*aggregate.Count{{Name: "tunnel.NgrokAuthtoken", Count: 2}},
+		Ecosystems:  []*aggregate.Count{{Name: "Debian", Count: 10}},
+		OS: []*aggregate.OSStats{
+			{OS: "debian 12", Scans: 2, VulnerableScans: 1, Packages: 10, Secrets: 2},
+			{OS: aggregate.UnknownOS, Scans: 1},
+		},
+	}
+	want := &spb.FleetStats{
+		Scans:           3,
+		FailedScans:     1,
+		VulnerableScans: 1,
+		TopVulnerablePackages: []*spb.FleetStats_VulnerablePackage{
+			{Name: "openssl", Version: "3.0.11", Ecosystem: "Debian:12", Scans: 1, Advisories: []string{"CVE-2024-1"}},
+		},
+		SecretTypes: []*spb.FleetStats_Count{{Name: "tunnel.NgrokAuthtoken", Count: 2}},
+		Ecosystems:  []*spb.FleetStats_Count{{Name: "Debian", Count: 10}},
+		Os: []*spb.FleetStats_OSStats{
+			{Os: "debian 12", Scans: 2, VulnerableScans: 1, Packages: 10, Secrets: 2},
+			{Os: aggregate.UnknownOS, Scans: 1},
+		},
+	}
+
+	got := proto.FleetStatsToProto(stats)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("proto.FleetStatsToProto(%v) returned unexpected diff (-want +got):\n%s", stats, diff)
+	}
+}
+
+func TestScanSummaryToProto(t *testing.T) {
+	s := &summary.Summary{
+		Status:   &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+		Duration: 90 * time.Second,
+		Packages: 12,
+		Findings: map[detector.SeverityEnum]int{
+			detector.SeverityLow:         2,
+			detector.SeverityUnspecified: 1,
+			detector.SeverityCritical:    1,
+		},
+		FindingsAboveThreshold: 1,
+		SeverityThreshold:      detector.SeverityHigh,
+		Secrets: map[veles.ValidationStatus]int{
+			veles.ValidationValid:       1,
+			veles.ValidationUnspecified: 3,
+		},
+		FailedPlugins: []string{"os/dpkg"},
+		ExitCode:      summary.ExitFindingsAboveThreshold,
+	}
+	want := &spb.ScanSummary{
+		Status:   &spb.ScanStatus{Status: spb.ScanStatus_SUCCEEDED},
+		Duration: durationpb.New(90 * time.Second),
+		Packages: 12,
+		Findings: []*spb.ScanSummary_SeverityCount{
+			{Severity: spb.Severity_CRITICAL, Count: 1},
+			{Severity: spb.Severity_LOW, Count: 2},
+			{Severity: spb.Severity_UNSPECIFIED, Count: 1},
+		},
+		FindingsAboveThreshold: 1,
+		SeverityThreshold:      spb.Severity_HIGH,
+		Secrets: []*spb.ScanSummary_ValidationCount{
+			{Validation: spb.Secret_VALIDATION_UNSPECIFIED, Count: 3},
+			{Validation: spb.Secret_VALIDATION_VALID, Count: 1},
+		},
+		FailedPlugins: []string{"os/dpkg"},
+		ExitCode:      spb.ScanSummary_FINDINGS_ABOVE_THRESHOLD,
+	}
+
+	got := proto.ScanSummaryToProto(s)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("proto.ScanSummaryToProto(%v) returned unexpected diff (-want +got):\n%s", s, diff)
+	}
+}
+
+func TestSecretToProto_Validation(t *testing.T) {
+	s := &secrets.Secret{
+		Secret:     tunnel.NgrokAuthtoken{Token: "token"},
+		Location:   "/home/user/.config/ngrok/ngrok.yml",
+		Confidence: secrets.ConfidenceHigh,
+		Validation: veles.ValidationInvalid,
+	}
+	want := &spb.Secret{
+		Type:       "tunnel.NgrokAuthtoken",
+		FieldsJson: `{"Token":"token","Targets":null}`,
+		Location:   "/home/user/.config/ngrok/ngrok.yml",
+		Confidence: spb.Secret_HIGH,
+		Validation: spb.Secret_VALIDATION_INVALID,
+	}
+
+	got, err := proto.SecretToProto(s)
+	if err != nil {
+		t.Fatalf("proto.SecretToProto(%v): %v", s, err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("proto.SecretToProto(%v) returned unexpected diff (-want +got):\n%s", s, diff)
+	}
+}
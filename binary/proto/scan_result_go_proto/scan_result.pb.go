@@ -24,6 +24,7 @@ package scan_result_go_proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -88,6 +89,168 @@ func (ScanStatus_ScanStatusEnum) EnumDescriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type Secret_ConfidenceEnum int32
+
+const (
+	Secret_UNSPECIFIED Secret_ConfidenceEnum = 0
+	Secret_LOW         Secret_ConfidenceEnum = 1
+	Secret_MEDIUM      Secret_ConfidenceEnum = 2
+	Secret_HIGH        Secret_ConfidenceEnum = 3
+)
+
+// Enum value maps for Secret_ConfidenceEnum.
+var (
+	Secret_ConfidenceEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "LOW",
+		2: "MEDIUM",
+		3: "HIGH",
+	}
+	Secret_ConfidenceEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"LOW":         1,
+		"MEDIUM":      2,
+		"HIGH":        3,
+	}
+)
+
+func (x Secret_ConfidenceEnum) Enum() *Secret_ConfidenceEnum {
+	p := new(Secret_ConfidenceEnum)
+	*p = x
+	return p
+}
+
+func (x Secret_ConfidenceEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Secret_ConfidenceEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[1].Descriptor()
+}
+
+func (Secret_ConfidenceEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[1]
+}
+
+func (x Secret_ConfidenceEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Secret_ConfidenceEnum.Descriptor instead.
+func (Secret_ConfidenceEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type Secret_ValidationEnum int32
+
+const (
+	// The secret wasn't validated.
+	Secret_VALIDATION_UNSPECIFIED Secret_ValidationEnum = 0
+	// There's no validator for the secret type.
+	Secret_VALIDATION_UNSUPPORTED Secret_ValidationEnum = 1
+	// The validation couldn't be completed, e.g. because of a network error.
+	Secret_VALIDATION_FAILED  Secret_ValidationEnum = 2
+	Secret_VALIDATION_INVALID Secret_ValidationEnum = 3
+	Secret_VALIDATION_VALID   Secret_ValidationEnum = 4
+)
+
+// Enum value maps for Secret_ValidationEnum.
+var (
+	Secret_ValidationEnum_name = map[int32]string{
+		0: "VALIDATION_UNSPECIFIED",
+		1: "VALIDATION_UNSUPPORTED",
+		2: "VALIDATION_FAILED",
+		3: "VALIDATION_INVALID",
+		4: "VALIDATION_VALID",
+	}
+	Secret_ValidationEnum_value = map[string]int32{
+		"VALIDATION_UNSPECIFIED": 0,
+		"VALIDATION_UNSUPPORTED": 1,
+		"VALIDATION_FAILED":      2,
+		"VALIDATION_INVALID":     3,
+		"VALIDATION_VALID":       4,
+	}
+)
+
+func (x Secret_ValidationEnum) Enum() *Secret_ValidationEnum {
+	p := new(Secret_ValidationEnum)
+	*p = x
+	return p
+}
+
+func (x Secret_ValidationEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Secret_ValidationEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[2].Descriptor()
+}
+
+func (Secret_ValidationEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[2]
+}
+
+func (x Secret_ValidationEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Secret_ValidationEnum.Descriptor instead.
+func (Secret_ValidationEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{6, 1}
+}
+
+type UnparsedFile_ReasonEnum int32
+
+const (
+	UnparsedFile_UNSPECIFIED      UnparsedFile_ReasonEnum = 0
+	UnparsedFile_NO_EXTRACTOR     UnparsedFile_ReasonEnum = 1
+	UnparsedFile_EXTRACTION_ERROR UnparsedFile_ReasonEnum = 2
+	UnparsedFile_BUDGET_EXCEEDED  UnparsedFile_ReasonEnum = 3
+)
+
+// Enum value maps for UnparsedFile_ReasonEnum.
+var (
+	UnparsedFile_ReasonEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "NO_EXTRACTOR",
+		2: "EXTRACTION_ERROR",
+		3: "BUDGET_EXCEEDED",
+	}
+	UnparsedFile_ReasonEnum_value = map[string]int32{
+		"UNSPECIFIED":      0,
+		"NO_EXTRACTOR":     1,
+		"EXTRACTION_ERROR": 2,
+		"BUDGET_EXCEEDED":  3,
+	}
+)
+
+func (x UnparsedFile_ReasonEnum) Enum() *UnparsedFile_ReasonEnum {
+	p := new(UnparsedFile_ReasonEnum)
+	*p = x
+	return p
+}
+
+func (x UnparsedFile_ReasonEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnparsedFile_ReasonEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[3].Descriptor()
+}
+
+func (UnparsedFile_ReasonEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[3]
+}
+
+func (x UnparsedFile_ReasonEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnparsedFile_ReasonEnum.Descriptor instead.
+func (UnparsedFile_ReasonEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{10, 0}
+}
+
 type Inventory_AnnotationEnum int32
 
 const (
@@ -124,11 +287,11 @@ func (x Inventory_AnnotationEnum) String() string {
 }
 
 func (Inventory_AnnotationEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_scan_result_proto_enumTypes[1].Descriptor()
+	return file_proto_scan_result_proto_enumTypes[4].Descriptor()
 }
 
 func (Inventory_AnnotationEnum) Type() protoreflect.EnumType {
-	return &file_proto_scan_result_proto_enumTypes[1]
+	return &file_proto_scan_result_proto_enumTypes[4]
 }
 
 func (x Inventory_AnnotationEnum) Number() protoreflect.EnumNumber {
@@ -137,7 +300,7 @@ func (x Inventory_AnnotationEnum) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Inventory_AnnotationEnum.Descriptor instead.
 func (Inventory_AnnotationEnum) EnumDescriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{3, 0}
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type Advisory_TypeEnum int32
@@ -173,11 +336,11 @@ func (x Advisory_TypeEnum) String() string {
 }
 
 func (Advisory_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_scan_result_proto_enumTypes[2].Descriptor()
+	return file_proto_scan_result_proto_enumTypes[5].Descriptor()
 }
 
 func (Advisory_TypeEnum) Type() protoreflect.EnumType {
-	return &file_proto_scan_result_proto_enumTypes[2]
+	return &file_proto_scan_result_proto_enumTypes[5]
 }
 
 func (x Advisory_TypeEnum) Number() protoreflect.EnumNumber {
@@ -186,7 +349,7 @@ func (x Advisory_TypeEnum) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Advisory_TypeEnum.Descriptor instead.
 func (Advisory_TypeEnum) EnumDescriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{9, 0}
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{18, 0}
 }
 
 type Severity_SeverityEnum int32
@@ -231,11 +394,11 @@ func (x Severity_SeverityEnum) String() string {
 }
 
 func (Severity_SeverityEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_scan_result_proto_enumTypes[3].Descriptor()
+	return file_proto_scan_result_proto_enumTypes[6].Descriptor()
 }
 
 func (Severity_SeverityEnum) Type() protoreflect.EnumType {
-	return &file_proto_scan_result_proto_enumTypes[3]
+	return &file_proto_scan_result_proto_enumTypes[6]
 }
 
 func (x Severity_SeverityEnum) Number() protoreflect.EnumNumber {
@@ -244,686 +407,932 @@ func (x Severity_SeverityEnum) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity_SeverityEnum.Descriptor instead.
 func (Severity_SeverityEnum) EnumDescriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{11, 0}
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{20, 0}
 }
 
-// The software inventory and security findings that a scan run found.
-type ScanResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type PackageOrigin_OriginEnum int32
 
-	Version   string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// Status of the overall scan.
-	Status *ScanStatus `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	// Status and versions of the inventory+vuln plugins that ran.
-	PluginStatus []*PluginStatus `protobuf:"bytes,5,rep,name=plugin_status,json=pluginStatus,proto3" json:"plugin_status,omitempty"`
-	Inventories  []*Inventory    `protobuf:"bytes,6,rep,name=inventories,proto3" json:"inventories,omitempty"`
-	Findings     []*Finding      `protobuf:"bytes,7,rep,name=findings,proto3" json:"findings,omitempty"`
-}
+const (
+	PackageOrigin_UNKNOWN PackageOrigin_OriginEnum = 0
+	// An official repository of the distribution.
+	PackageOrigin_DISTRO PackageOrigin_OriginEnum = 1
+	// A repository added to the package manager's sources.
+	PackageOrigin_THIRD_PARTY PackageOrigin_OriginEnum = 2
+	// Not available from any configured repository, e.g. installed from a
+	// local package file.
+	PackageOrigin_LOCAL PackageOrigin_OriginEnum = 3
+)
 
-func (x *ScanResult) Reset() {
-	*x = ScanResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for PackageOrigin_OriginEnum.
+var (
+	PackageOrigin_OriginEnum_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "DISTRO",
+		2: "THIRD_PARTY",
+		3: "LOCAL",
 	}
-}
+	PackageOrigin_OriginEnum_value = map[string]int32{
+		"UNKNOWN":     0,
+		"DISTRO":      1,
+		"THIRD_PARTY": 2,
+		"LOCAL":       3,
+	}
+)
 
-func (x *ScanResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PackageOrigin_OriginEnum) Enum() *PackageOrigin_OriginEnum {
+	p := new(PackageOrigin_OriginEnum)
+	*p = x
+	return p
 }
 
-func (*ScanResult) ProtoMessage() {}
-
-func (x *ScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x PackageOrigin_OriginEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use ScanResult.ProtoReflect.Descriptor instead.
-func (*ScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{0}
+func (PackageOrigin_OriginEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[7].Descriptor()
 }
 
-func (x *ScanResult) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+func (PackageOrigin_OriginEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[7]
 }
 
-func (x *ScanResult) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+func (x PackageOrigin_OriginEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ScanResult) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+// Deprecated: Use PackageOrigin_OriginEnum.Descriptor instead.
+func (PackageOrigin_OriginEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{29, 0}
 }
 
-func (x *ScanResult) GetStatus() *ScanStatus {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
+type StorageClusterCredential_TypeEnum int32
 
-func (x *ScanResult) GetPluginStatus() []*PluginStatus {
-	if x != nil {
-		return x.PluginStatus
-	}
-	return nil
-}
+const (
+	StorageClusterCredential_UNSPECIFIED     StorageClusterCredential_TypeEnum = 0
+	StorageClusterCredential_CEPH_KEY        StorageClusterCredential_TypeEnum = 1
+	StorageClusterCredential_TLS_PRIVATE_KEY StorageClusterCredential_TypeEnum = 2
+	StorageClusterCredential_S3              StorageClusterCredential_TypeEnum = 3
+)
 
-func (x *ScanResult) GetInventories() []*Inventory {
-	if x != nil {
-		return x.Inventories
+// Enum value maps for StorageClusterCredential_TypeEnum.
+var (
+	StorageClusterCredential_TypeEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "CEPH_KEY",
+		2: "TLS_PRIVATE_KEY",
+		3: "S3",
 	}
-	return nil
+	StorageClusterCredential_TypeEnum_value = map[string]int32{
+		"UNSPECIFIED":     0,
+		"CEPH_KEY":        1,
+		"TLS_PRIVATE_KEY": 2,
+		"S3":              3,
+	}
+)
+
+func (x StorageClusterCredential_TypeEnum) Enum() *StorageClusterCredential_TypeEnum {
+	p := new(StorageClusterCredential_TypeEnum)
+	*p = x
+	return p
 }
 
-func (x *ScanResult) GetFindings() []*Finding {
-	if x != nil {
-		return x.Findings
-	}
-	return nil
+func (x StorageClusterCredential_TypeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type ScanStatus struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (StorageClusterCredential_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[8].Descriptor()
+}
 
-	Status        ScanStatus_ScanStatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=scalibr.ScanStatus_ScanStatusEnum" json:"status,omitempty"`
-	FailureReason string                    `protobuf:"bytes,2,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+func (StorageClusterCredential_TypeEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[8]
 }
 
-func (x *ScanStatus) Reset() {
-	*x = ScanStatus{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x StorageClusterCredential_TypeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ScanStatus) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use StorageClusterCredential_TypeEnum.Descriptor instead.
+func (StorageClusterCredential_TypeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{59, 0}
 }
 
-func (*ScanStatus) ProtoMessage() {}
+type IaCSecret_TypeEnum int32
 
-func (x *ScanStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	IaCSecret_UNSPECIFIED        IaCSecret_TypeEnum = 0
+	IaCSecret_EYAML              IaCSecret_TypeEnum = 1
+	IaCSecret_ENCRYPTED_DATA_BAG IaCSecret_TypeEnum = 2
+	IaCSecret_PLAINTEXT_PASSWORD IaCSecret_TypeEnum = 3
+)
+
+// Enum value maps for IaCSecret_TypeEnum.
+var (
+	IaCSecret_TypeEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "EYAML",
+		2: "ENCRYPTED_DATA_BAG",
+		3: "PLAINTEXT_PASSWORD",
 	}
-	return mi.MessageOf(x)
-}
+	IaCSecret_TypeEnum_value = map[string]int32{
+		"UNSPECIFIED":        0,
+		"EYAML":              1,
+		"ENCRYPTED_DATA_BAG": 2,
+		"PLAINTEXT_PASSWORD": 3,
+	}
+)
 
-// Deprecated: Use ScanStatus.ProtoReflect.Descriptor instead.
-func (*ScanStatus) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{1}
+func (x IaCSecret_TypeEnum) Enum() *IaCSecret_TypeEnum {
+	p := new(IaCSecret_TypeEnum)
+	*p = x
+	return p
 }
 
-func (x *ScanStatus) GetStatus() ScanStatus_ScanStatusEnum {
-	if x != nil {
-		return x.Status
-	}
-	return ScanStatus_UNSPECIFIED
+func (x IaCSecret_TypeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ScanStatus) GetFailureReason() string {
-	if x != nil {
-		return x.FailureReason
-	}
-	return ""
+func (IaCSecret_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[9].Descriptor()
 }
 
-type PluginStatus struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name    string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version int32       `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	Status  *ScanStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+func (IaCSecret_TypeEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[9]
 }
 
-func (x *PluginStatus) Reset() {
-	*x = PluginStatus{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x IaCSecret_TypeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PluginStatus) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use IaCSecret_TypeEnum.Descriptor instead.
+func (IaCSecret_TypeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{64, 0}
 }
 
-func (*PluginStatus) ProtoMessage() {}
+type PackageRegistry_RoleEnum int32
 
-func (x *PluginStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	PackageRegistry_UNSPECIFIED PackageRegistry_RoleEnum = 0
+	PackageRegistry_INDEX       PackageRegistry_RoleEnum = 1
+	PackageRegistry_EXTRA_INDEX PackageRegistry_RoleEnum = 2
+	PackageRegistry_SCOPE       PackageRegistry_RoleEnum = 3
+	PackageRegistry_PROXY       PackageRegistry_RoleEnum = 4
+)
+
+// Enum value maps for PackageRegistry_RoleEnum.
+var (
+	PackageRegistry_RoleEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "INDEX",
+		2: "EXTRA_INDEX",
+		3: "SCOPE",
+		4: "PROXY",
 	}
-	return mi.MessageOf(x)
-}
+	PackageRegistry_RoleEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"INDEX":       1,
+		"EXTRA_INDEX": 2,
+		"SCOPE":       3,
+		"PROXY":       4,
+	}
+)
 
-// Deprecated: Use PluginStatus.ProtoReflect.Descriptor instead.
-func (*PluginStatus) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{2}
+func (x PackageRegistry_RoleEnum) Enum() *PackageRegistry_RoleEnum {
+	p := new(PackageRegistry_RoleEnum)
+	*p = x
+	return p
 }
 
-func (x *PluginStatus) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x PackageRegistry_RoleEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *PluginStatus) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
+func (PackageRegistry_RoleEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[10].Descriptor()
 }
 
-func (x *PluginStatus) GetStatus() *ScanStatus {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+func (PackageRegistry_RoleEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[10]
 }
 
-// A software package or library found by an extractor.
-// PURL or CPE needs to be set, maybe both.
-type Inventory struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x PackageRegistry_RoleEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	// Human-readable name of the software, to be used for things like logging.
-	// For vuln matching, use the name from metadata.
-	Name string `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
-	// Version of the package.
-	Version string `protobuf:"bytes,12,opt,name=version,proto3" json:"version,omitempty"`
-	// Source code level package identifiers.
-	SourceCode *SourceCodeIdentifier `protobuf:"bytes,26,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
-	// Package URL of the software.
-	Purl *Purl `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
-	// Ecosystem - For software packages this corresponds to an OSV ecosystem
-	// value, e.g. PyPI.
-	Ecosystem string `protobuf:"bytes,27,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
-	// Paths or source of files related to the package.
-	Locations []string `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
-	// The name of the Extractor that found this software. Set by the
-	// core library.
-	Extractor string `protobuf:"bytes,10,opt,name=extractor,proto3" json:"extractor,omitempty"`
-	// The additional data found in the package.
-	//
-	// Types that are assignable to Metadata:
-	//
-	//	*Inventory_PythonMetadata
-	//	*Inventory_JavascriptMetadata
-	//	*Inventory_ApkMetadata
-	//	*Inventory_DpkgMetadata
-	//	*Inventory_RpmMetadata
-	//	*Inventory_CosMetadata
-	//	*Inventory_DepsjsonMetadata
-	//	*Inventory_SpdxMetadata
-	//	*Inventory_JavaArchiveMetadata
-	//	*Inventory_JavaLockfileMetadata
-	//	*Inventory_PacmanMetadata
-	//	*Inventory_ModuleMetadata
-	//	*Inventory_PortageMetadata
-	//	*Inventory_OsvMetadata
-	//	*Inventory_PythonRequirementsMetadata
-	//	*Inventory_ContainerdContainerMetadata
-	//	*Inventory_SnapMetadata
-	//	*Inventory_FlatpakMetadata
-	//	*Inventory_MacAppsMetadata
-	//	*Inventory_ContainerdRuntimeContainerMetadata
-	//	*Inventory_CdxMetadata
-	//	*Inventory_WindowsOsVersionMetadata
-	Metadata    isInventory_Metadata       `protobuf_oneof:"metadata"`
-	Annotations []Inventory_AnnotationEnum `protobuf:"varint,28,rep,packed,name=annotations,proto3,enum=scalibr.Inventory_AnnotationEnum" json:"annotations,omitempty"`
-	// Details about the layer a package was found in. This should be set only for
-	// container image scanning.
-	LayerDetails *LayerDetails `protobuf:"bytes,35,opt,name=layer_details,json=layerDetails,proto3" json:"layer_details,omitempty"`
+// Deprecated: Use PackageRegistry_RoleEnum.Descriptor instead.
+func (PackageRegistry_RoleEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{67, 0}
 }
 
-func (x *Inventory) Reset() {
-	*x = Inventory{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type EdgeProxyCredential_TypeEnum int32
+
+const (
+	EdgeProxyCredential_UNSPECIFIED     EdgeProxyCredential_TypeEnum = 0
+	EdgeProxyCredential_PASSWORD        EdgeProxyCredential_TypeEnum = 1
+	EdgeProxyCredential_PASSWORD_HASH   EdgeProxyCredential_TypeEnum = 2
+	EdgeProxyCredential_TLS_PRIVATE_KEY EdgeProxyCredential_TypeEnum = 3
+	EdgeProxyCredential_AUTH_HEADER     EdgeProxyCredential_TypeEnum = 4
+)
+
+// Enum value maps for EdgeProxyCredential_TypeEnum.
+var (
+	EdgeProxyCredential_TypeEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "PASSWORD",
+		2: "PASSWORD_HASH",
+		3: "TLS_PRIVATE_KEY",
+		4: "AUTH_HEADER",
+	}
+	EdgeProxyCredential_TypeEnum_value = map[string]int32{
+		"UNSPECIFIED":     0,
+		"PASSWORD":        1,
+		"PASSWORD_HASH":   2,
+		"TLS_PRIVATE_KEY": 3,
+		"AUTH_HEADER":     4,
 	}
+)
+
+func (x EdgeProxyCredential_TypeEnum) Enum() *EdgeProxyCredential_TypeEnum {
+	p := new(EdgeProxyCredential_TypeEnum)
+	*p = x
+	return p
 }
 
-func (x *Inventory) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x EdgeProxyCredential_TypeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Inventory) ProtoMessage() {}
+func (EdgeProxyCredential_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[11].Descriptor()
+}
 
-func (x *Inventory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (EdgeProxyCredential_TypeEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[11]
 }
 
-// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
-func (*Inventory) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{3}
+func (x EdgeProxyCredential_TypeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Inventory) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use EdgeProxyCredential_TypeEnum.Descriptor instead.
+func (EdgeProxyCredential_TypeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{70, 0}
 }
 
-func (x *Inventory) GetVersion() string {
-	if x != nil {
-		return x.Version
+type ActiveDirectoryMetadata_ArtifactEnum int32
+
+const (
+	ActiveDirectoryMetadata_UNSPECIFIED   ActiveDirectoryMetadata_ArtifactEnum = 0
+	ActiveDirectoryMetadata_NTDS_DATABASE ActiveDirectoryMetadata_ArtifactEnum = 1
+	ActiveDirectoryMetadata_GPP_PASSWORD  ActiveDirectoryMetadata_ArtifactEnum = 2
+	ActiveDirectoryMetadata_LOGON_SCRIPT  ActiveDirectoryMetadata_ArtifactEnum = 3
+)
+
+// Enum value maps for ActiveDirectoryMetadata_ArtifactEnum.
+var (
+	ActiveDirectoryMetadata_ArtifactEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "NTDS_DATABASE",
+		2: "GPP_PASSWORD",
+		3: "LOGON_SCRIPT",
 	}
-	return ""
+	ActiveDirectoryMetadata_ArtifactEnum_value = map[string]int32{
+		"UNSPECIFIED":   0,
+		"NTDS_DATABASE": 1,
+		"GPP_PASSWORD":  2,
+		"LOGON_SCRIPT":  3,
+	}
+)
+
+func (x ActiveDirectoryMetadata_ArtifactEnum) Enum() *ActiveDirectoryMetadata_ArtifactEnum {
+	p := new(ActiveDirectoryMetadata_ArtifactEnum)
+	*p = x
+	return p
 }
 
-func (x *Inventory) GetSourceCode() *SourceCodeIdentifier {
-	if x != nil {
-		return x.SourceCode
-	}
-	return nil
+func (x ActiveDirectoryMetadata_ArtifactEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Inventory) GetPurl() *Purl {
-	if x != nil {
-		return x.Purl
-	}
-	return nil
+func (ActiveDirectoryMetadata_ArtifactEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[12].Descriptor()
 }
 
-func (x *Inventory) GetEcosystem() string {
-	if x != nil {
-		return x.Ecosystem
-	}
-	return ""
+func (ActiveDirectoryMetadata_ArtifactEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[12]
 }
 
-func (x *Inventory) GetLocations() []string {
-	if x != nil {
-		return x.Locations
-	}
-	return nil
+func (x ActiveDirectoryMetadata_ArtifactEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Inventory) GetExtractor() string {
-	if x != nil {
-		return x.Extractor
-	}
-	return ""
+// Deprecated: Use ActiveDirectoryMetadata_ArtifactEnum.Descriptor instead.
+func (ActiveDirectoryMetadata_ArtifactEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{71, 0}
 }
 
-func (m *Inventory) GetMetadata() isInventory_Metadata {
-	if m != nil {
-		return m.Metadata
+type PrivateCloudCredential_TypeEnum int32
+
+const (
+	PrivateCloudCredential_UNSPECIFIED      PrivateCloudCredential_TypeEnum = 0
+	PrivateCloudCredential_DATABASE         PrivateCloudCredential_TypeEnum = 1
+	PrivateCloudCredential_MESSAGE_QUEUE    PrivateCloudCredential_TypeEnum = 2
+	PrivateCloudCredential_SERVICE_PASSWORD PrivateCloudCredential_TypeEnum = 3
+	PrivateCloudCredential_ADMIN_TOKEN      PrivateCloudCredential_TypeEnum = 4
+	PrivateCloudCredential_SHARED_SECRET    PrivateCloudCredential_TypeEnum = 5
+	PrivateCloudCredential_API_TOKEN        PrivateCloudCredential_TypeEnum = 6
+	PrivateCloudCredential_PASSWORD         PrivateCloudCredential_TypeEnum = 7
+	PrivateCloudCredential_PRIVATE_KEY      PrivateCloudCredential_TypeEnum = 8
+	PrivateCloudCredential_ENCRYPTION_KEY   PrivateCloudCredential_TypeEnum = 9
+)
+
+// Enum value maps for PrivateCloudCredential_TypeEnum.
+var (
+	PrivateCloudCredential_TypeEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "DATABASE",
+		2: "MESSAGE_QUEUE",
+		3: "SERVICE_PASSWORD",
+		4: "ADMIN_TOKEN",
+		5: "SHARED_SECRET",
+		6: "API_TOKEN",
+		7: "PASSWORD",
+		8: "PRIVATE_KEY",
+		9: "ENCRYPTION_KEY",
+	}
+	PrivateCloudCredential_TypeEnum_value = map[string]int32{
+		"UNSPECIFIED":      0,
+		"DATABASE":         1,
+		"MESSAGE_QUEUE":    2,
+		"SERVICE_PASSWORD": 3,
+		"ADMIN_TOKEN":      4,
+		"SHARED_SECRET":    5,
+		"API_TOKEN":        6,
+		"PASSWORD":         7,
+		"PRIVATE_KEY":      8,
+		"ENCRYPTION_KEY":   9,
 	}
-	return nil
+)
+
+func (x PrivateCloudCredential_TypeEnum) Enum() *PrivateCloudCredential_TypeEnum {
+	p := new(PrivateCloudCredential_TypeEnum)
+	*p = x
+	return p
 }
 
-func (x *Inventory) GetPythonMetadata() *PythonPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_PythonMetadata); ok {
-		return x.PythonMetadata
-	}
-	return nil
+func (x PrivateCloudCredential_TypeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Inventory) GetJavascriptMetadata() *JavascriptPackageJSONMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_JavascriptMetadata); ok {
-		return x.JavascriptMetadata
-	}
-	return nil
+func (PrivateCloudCredential_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[13].Descriptor()
 }
 
-func (x *Inventory) GetApkMetadata() *APKPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_ApkMetadata); ok {
-		return x.ApkMetadata
-	}
-	return nil
+func (PrivateCloudCredential_TypeEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[13]
 }
 
-func (x *Inventory) GetDpkgMetadata() *DPKGPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_DpkgMetadata); ok {
-		return x.DpkgMetadata
-	}
-	return nil
+func (x PrivateCloudCredential_TypeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Inventory) GetRpmMetadata() *RPMPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_RpmMetadata); ok {
-		return x.RpmMetadata
-	}
-	return nil
+// Deprecated: Use PrivateCloudCredential_TypeEnum.Descriptor instead.
+func (PrivateCloudCredential_TypeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{89, 0}
 }
 
-func (x *Inventory) GetCosMetadata() *COSPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_CosMetadata); ok {
-		return x.CosMetadata
+type DefenderExclusion_TypeEnum int32
+
+const (
+	DefenderExclusion_UNSPECIFIED DefenderExclusion_TypeEnum = 0
+	DefenderExclusion_PATH        DefenderExclusion_TypeEnum = 1
+	DefenderExclusion_EXTENSION   DefenderExclusion_TypeEnum = 2
+	DefenderExclusion_PROCESS     DefenderExclusion_TypeEnum = 3
+	DefenderExclusion_IP_ADDRESS  DefenderExclusion_TypeEnum = 4
+)
+
+// Enum value maps for DefenderExclusion_TypeEnum.
+var (
+	DefenderExclusion_TypeEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "PATH",
+		2: "EXTENSION",
+		3: "PROCESS",
+		4: "IP_ADDRESS",
 	}
-	return nil
+	DefenderExclusion_TypeEnum_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"PATH":        1,
+		"EXTENSION":   2,
+		"PROCESS":     3,
+		"IP_ADDRESS":  4,
+	}
+)
+
+func (x DefenderExclusion_TypeEnum) Enum() *DefenderExclusion_TypeEnum {
+	p := new(DefenderExclusion_TypeEnum)
+	*p = x
+	return p
 }
 
-func (x *Inventory) GetDepsjsonMetadata() *DEPSJSONMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_DepsjsonMetadata); ok {
-		return x.DepsjsonMetadata
-	}
-	return nil
+func (x DefenderExclusion_TypeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Inventory) GetSpdxMetadata() *SPDXPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_SpdxMetadata); ok {
-		return x.SpdxMetadata
-	}
-	return nil
+func (DefenderExclusion_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[14].Descriptor()
 }
 
-func (x *Inventory) GetJavaArchiveMetadata() *JavaArchiveMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_JavaArchiveMetadata); ok {
-		return x.JavaArchiveMetadata
-	}
-	return nil
+func (DefenderExclusion_TypeEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[14]
 }
 
-func (x *Inventory) GetJavaLockfileMetadata() *JavaLockfileMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_JavaLockfileMetadata); ok {
-		return x.JavaLockfileMetadata
-	}
-	return nil
+func (x DefenderExclusion_TypeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Inventory) GetPacmanMetadata() *PACMANPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_PacmanMetadata); ok {
-		return x.PacmanMetadata
+// Deprecated: Use DefenderExclusion_TypeEnum.Descriptor instead.
+func (DefenderExclusion_TypeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{98, 0}
+}
+
+type ScanSummary_ExitCodeEnum int32
+
+const (
+	// The scan succeeded and found no findings or secrets.
+	ScanSummary_CLEAN ScanSummary_ExitCodeEnum = 0
+	// The scan failed or couldn't be run.
+	ScanSummary_FATAL ScanSummary_ExitCodeEnum = 1
+	// The scan completed but some plugins failed.
+	ScanSummary_PARTIAL_FAILURE ScanSummary_ExitCodeEnum = 2
+	// The scan found findings or secrets, but none at or above the severity
+	// threshold.
+	ScanSummary_FINDINGS_BELOW_THRESHOLD ScanSummary_ExitCodeEnum = 3
+	// The scan found findings at or above the severity threshold, or secrets
+	// that were validated as valid.
+	ScanSummary_FINDINGS_ABOVE_THRESHOLD ScanSummary_ExitCodeEnum = 4
+)
+
+// Enum value maps for ScanSummary_ExitCodeEnum.
+var (
+	ScanSummary_ExitCodeEnum_name = map[int32]string{
+		0: "CLEAN",
+		1: "FATAL",
+		2: "PARTIAL_FAILURE",
+		3: "FINDINGS_BELOW_THRESHOLD",
+		4: "FINDINGS_ABOVE_THRESHOLD",
+	}
+	ScanSummary_ExitCodeEnum_value = map[string]int32{
+		"CLEAN":                    0,
+		"FATAL":                    1,
+		"PARTIAL_FAILURE":          2,
+		"FINDINGS_BELOW_THRESHOLD": 3,
+		"FINDINGS_ABOVE_THRESHOLD": 4,
 	}
-	return nil
+)
+
+func (x ScanSummary_ExitCodeEnum) Enum() *ScanSummary_ExitCodeEnum {
+	p := new(ScanSummary_ExitCodeEnum)
+	*p = x
+	return p
 }
 
-func (x *Inventory) GetModuleMetadata() *ModuleMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_ModuleMetadata); ok {
-		return x.ModuleMetadata
+func (x ScanSummary_ExitCodeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanSummary_ExitCodeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[15].Descriptor()
+}
+
+func (ScanSummary_ExitCodeEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[15]
+}
+
+func (x ScanSummary_ExitCodeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanSummary_ExitCodeEnum.Descriptor instead.
+func (ScanSummary_ExitCodeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{102, 0}
+}
+
+type PluginDescription_TypeEnum int32
+
+const (
+	PluginDescription_UNSPECIFIED          PluginDescription_TypeEnum = 0
+	PluginDescription_FILESYSTEM_EXTRACTOR PluginDescription_TypeEnum = 1
+	PluginDescription_STANDALONE_EXTRACTOR PluginDescription_TypeEnum = 2
+	PluginDescription_DETECTOR             PluginDescription_TypeEnum = 3
+)
+
+// Enum value maps for PluginDescription_TypeEnum.
+var (
+	PluginDescription_TypeEnum_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "FILESYSTEM_EXTRACTOR",
+		2: "STANDALONE_EXTRACTOR",
+		3: "DETECTOR",
 	}
-	return nil
+	PluginDescription_TypeEnum_value = map[string]int32{
+		"UNSPECIFIED":          0,
+		"FILESYSTEM_EXTRACTOR": 1,
+		"STANDALONE_EXTRACTOR": 2,
+		"DETECTOR":             3,
+	}
+)
+
+func (x PluginDescription_TypeEnum) Enum() *PluginDescription_TypeEnum {
+	p := new(PluginDescription_TypeEnum)
+	*p = x
+	return p
 }
 
-func (x *Inventory) GetPortageMetadata() *PortagePackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_PortageMetadata); ok {
-		return x.PortageMetadata
+func (x PluginDescription_TypeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PluginDescription_TypeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_scan_result_proto_enumTypes[16].Descriptor()
+}
+
+func (PluginDescription_TypeEnum) Type() protoreflect.EnumType {
+	return &file_proto_scan_result_proto_enumTypes[16]
+}
+
+func (x PluginDescription_TypeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PluginDescription_TypeEnum.Descriptor instead.
+func (PluginDescription_TypeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{105, 0}
+}
+
+// The software inventory and security findings that a scan run found.
+type ScanResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version   string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Status of the overall scan.
+	Status *ScanStatus `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Status and versions of the inventory+vuln plugins that ran.
+	PluginStatus []*PluginStatus `protobuf:"bytes,5,rep,name=plugin_status,json=pluginStatus,proto3" json:"plugin_status,omitempty"`
+	Inventories  []*Inventory    `protobuf:"bytes,6,rep,name=inventories,proto3" json:"inventories,omitempty"`
+	Findings     []*Finding      `protobuf:"bytes,7,rep,name=findings,proto3" json:"findings,omitempty"`
+	// Known manifests and lockfiles that couldn't be parsed. Only set if
+	// coverage reporting was enabled.
+	Coverage *CoverageReport `protobuf:"bytes,8,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	// The components and inputs that produced this result.
+	Provenance *Provenance `protobuf:"bytes,9,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	// Secrets found in the scanned files. Only set if secret scanning was
+	// enabled.
+	Secrets []*Secret `protobuf:"bytes,10,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Files that made an extractor panic. Only set if any files were
+	// quarantined.
+	Quarantine *QuarantineReport `protobuf:"bytes,11,opt,name=quarantine,proto3" json:"quarantine,omitempty"`
+	// Labels the scan was tagged with, e.g. the tenant or environment of the
+	// scanned system.
+	Labels map[string]string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ScanResult) Reset() {
+	*x = ScanResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Inventory) GetOsvMetadata() *OSVPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_OsvMetadata); ok {
-		return x.OsvMetadata
+func (x *ScanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanResult) ProtoMessage() {}
+
+func (x *ScanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Inventory) GetPythonRequirementsMetadata() *PythonRequirementsMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_PythonRequirementsMetadata); ok {
-		return x.PythonRequirementsMetadata
+// Deprecated: Use ScanResult.ProtoReflect.Descriptor instead.
+func (*ScanResult) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScanResult) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *Inventory) GetContainerdContainerMetadata() *ContainerdContainerMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_ContainerdContainerMetadata); ok {
-		return x.ContainerdContainerMetadata
+func (x *ScanResult) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *Inventory) GetSnapMetadata() *SNAPPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_SnapMetadata); ok {
-		return x.SnapMetadata
+func (x *ScanResult) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *Inventory) GetFlatpakMetadata() *FlatpakPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_FlatpakMetadata); ok {
-		return x.FlatpakMetadata
+func (x *ScanResult) GetStatus() *ScanStatus {
+	if x != nil {
+		return x.Status
 	}
 	return nil
 }
 
-func (x *Inventory) GetMacAppsMetadata() *MacAppsMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_MacAppsMetadata); ok {
-		return x.MacAppsMetadata
+func (x *ScanResult) GetPluginStatus() []*PluginStatus {
+	if x != nil {
+		return x.PluginStatus
 	}
 	return nil
 }
 
-func (x *Inventory) GetContainerdRuntimeContainerMetadata() *ContainerdRuntimeContainerMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_ContainerdRuntimeContainerMetadata); ok {
-		return x.ContainerdRuntimeContainerMetadata
+func (x *ScanResult) GetInventories() []*Inventory {
+	if x != nil {
+		return x.Inventories
 	}
 	return nil
 }
 
-func (x *Inventory) GetCdxMetadata() *CDXPackageMetadata {
-	if x, ok := x.GetMetadata().(*Inventory_CdxMetadata); ok {
-		return x.CdxMetadata
+func (x *ScanResult) GetFindings() []*Finding {
+	if x != nil {
+		return x.Findings
 	}
 	return nil
 }
 
-func (x *Inventory) GetWindowsOsVersionMetadata() *WindowsOSVersion {
-	if x, ok := x.GetMetadata().(*Inventory_WindowsOsVersionMetadata); ok {
-		return x.WindowsOsVersionMetadata
+func (x *ScanResult) GetCoverage() *CoverageReport {
+	if x != nil {
+		return x.Coverage
 	}
 	return nil
 }
 
-func (x *Inventory) GetAnnotations() []Inventory_AnnotationEnum {
+func (x *ScanResult) GetProvenance() *Provenance {
 	if x != nil {
-		return x.Annotations
+		return x.Provenance
 	}
 	return nil
 }
 
-func (x *Inventory) GetLayerDetails() *LayerDetails {
+func (x *ScanResult) GetSecrets() []*Secret {
 	if x != nil {
-		return x.LayerDetails
+		return x.Secrets
 	}
 	return nil
 }
 
-type isInventory_Metadata interface {
-	isInventory_Metadata()
+func (x *ScanResult) GetQuarantine() *QuarantineReport {
+	if x != nil {
+		return x.Quarantine
+	}
+	return nil
 }
 
-type Inventory_PythonMetadata struct {
-	PythonMetadata *PythonPackageMetadata `protobuf:"bytes,5,opt,name=python_metadata,json=pythonMetadata,proto3,oneof"`
+func (x *ScanResult) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
 }
 
-type Inventory_JavascriptMetadata struct {
-	JavascriptMetadata *JavascriptPackageJSONMetadata `protobuf:"bytes,6,opt,name=javascript_metadata,json=javascriptMetadata,proto3,oneof"`
-}
+type ScanStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type Inventory_ApkMetadata struct {
-	ApkMetadata *APKPackageMetadata `protobuf:"bytes,7,opt,name=apk_metadata,json=apkMetadata,proto3,oneof"`
+	Status        ScanStatus_ScanStatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=scalibr.ScanStatus_ScanStatusEnum" json:"status,omitempty"`
+	FailureReason string                    `protobuf:"bytes,2,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 }
 
-type Inventory_DpkgMetadata struct {
-	DpkgMetadata *DPKGPackageMetadata `protobuf:"bytes,8,opt,name=dpkg_metadata,json=dpkgMetadata,proto3,oneof"`
+func (x *ScanStatus) Reset() {
+	*x = ScanStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Inventory_RpmMetadata struct {
-	RpmMetadata *RPMPackageMetadata `protobuf:"bytes,9,opt,name=rpm_metadata,json=rpmMetadata,proto3,oneof"`
+func (x *ScanStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Inventory_CosMetadata struct {
-	CosMetadata *COSPackageMetadata `protobuf:"bytes,13,opt,name=cos_metadata,json=cosMetadata,proto3,oneof"`
-}
+func (*ScanStatus) ProtoMessage() {}
 
-type Inventory_DepsjsonMetadata struct {
-	DepsjsonMetadata *DEPSJSONMetadata `protobuf:"bytes,40,opt,name=depsjson_metadata,json=depsjsonMetadata,proto3,oneof"`
+func (x *ScanStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Inventory_SpdxMetadata struct {
-	SpdxMetadata *SPDXPackageMetadata `protobuf:"bytes,14,opt,name=spdx_metadata,json=spdxMetadata,proto3,oneof"`
+// Deprecated: Use ScanStatus.ProtoReflect.Descriptor instead.
+func (*ScanStatus) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{1}
 }
 
-type Inventory_JavaArchiveMetadata struct {
-	JavaArchiveMetadata *JavaArchiveMetadata `protobuf:"bytes,15,opt,name=java_archive_metadata,json=javaArchiveMetadata,proto3,oneof"`
+func (x *ScanStatus) GetStatus() ScanStatus_ScanStatusEnum {
+	if x != nil {
+		return x.Status
+	}
+	return ScanStatus_UNSPECIFIED
 }
 
-type Inventory_JavaLockfileMetadata struct {
-	JavaLockfileMetadata *JavaLockfileMetadata `protobuf:"bytes,31,opt,name=java_lockfile_metadata,json=javaLockfileMetadata,proto3,oneof"`
+func (x *ScanStatus) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
 }
 
-type Inventory_PacmanMetadata struct {
-	PacmanMetadata *PACMANPackageMetadata `protobuf:"bytes,36,opt,name=pacman_metadata,json=pacmanMetadata,proto3,oneof"`
-}
+type PluginStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type Inventory_ModuleMetadata struct {
-	ModuleMetadata *ModuleMetadata `protobuf:"bytes,38,opt,name=module_metadata,json=moduleMetadata,proto3,oneof"`
+	Name    string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version int32       `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Status  *ScanStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// The budget limit that cut the plugin off ("wall_time", "files" or
+	// "bytes"), or empty if the plugin ran within its budget.
+	BudgetExceeded string `protobuf:"bytes,4,opt,name=budget_exceeded,json=budgetExceeded,proto3" json:"budget_exceeded,omitempty"`
 }
 
-type Inventory_PortageMetadata struct {
-	PortageMetadata *PortagePackageMetadata `protobuf:"bytes,41,opt,name=portage_metadata,json=portageMetadata,proto3,oneof"`
+func (x *PluginStatus) Reset() {
+	*x = PluginStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type Inventory_OsvMetadata struct {
-	OsvMetadata *OSVPackageMetadata `protobuf:"bytes,16,opt,name=osv_metadata,json=osvMetadata,proto3,oneof"`
+func (x *PluginStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Inventory_PythonRequirementsMetadata struct {
-	PythonRequirementsMetadata *PythonRequirementsMetadata `protobuf:"bytes,21,opt,name=python_requirements_metadata,json=pythonRequirementsMetadata,proto3,oneof"`
-}
+func (*PluginStatus) ProtoMessage() {}
 
-type Inventory_ContainerdContainerMetadata struct {
-	ContainerdContainerMetadata *ContainerdContainerMetadata `protobuf:"bytes,22,opt,name=containerd_container_metadata,json=containerdContainerMetadata,proto3,oneof"`
-}
-
-type Inventory_SnapMetadata struct {
-	SnapMetadata *SNAPPackageMetadata `protobuf:"bytes,23,opt,name=snap_metadata,json=snapMetadata,proto3,oneof"`
+func (x *PluginStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Inventory_FlatpakMetadata struct {
-	FlatpakMetadata *FlatpakPackageMetadata `protobuf:"bytes,24,opt,name=flatpak_metadata,json=flatpakMetadata,proto3,oneof"`
+// Deprecated: Use PluginStatus.ProtoReflect.Descriptor instead.
+func (*PluginStatus) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{2}
 }
 
-type Inventory_MacAppsMetadata struct {
-	MacAppsMetadata *MacAppsMetadata `protobuf:"bytes,34,opt,name=mac_apps_metadata,json=macAppsMetadata,proto3,oneof"`
+func (x *PluginStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-type Inventory_ContainerdRuntimeContainerMetadata struct {
-	ContainerdRuntimeContainerMetadata *ContainerdRuntimeContainerMetadata `protobuf:"bytes,25,opt,name=containerd_runtime_container_metadata,json=containerdRuntimeContainerMetadata,proto3,oneof"`
+func (x *PluginStatus) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-type Inventory_CdxMetadata struct {
-	CdxMetadata *CDXPackageMetadata `protobuf:"bytes,30,opt,name=cdx_metadata,json=cdxMetadata,proto3,oneof"`
+func (x *PluginStatus) GetStatus() *ScanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
 }
 
-type Inventory_WindowsOsVersionMetadata struct {
-	WindowsOsVersionMetadata *WindowsOSVersion `protobuf:"bytes,33,opt,name=windows_os_version_metadata,json=windowsOsVersionMetadata,proto3,oneof"`
+func (x *PluginStatus) GetBudgetExceeded() string {
+	if x != nil {
+		return x.BudgetExceeded
+	}
+	return ""
 }
 
-func (*Inventory_PythonMetadata) isInventory_Metadata() {}
-
-func (*Inventory_JavascriptMetadata) isInventory_Metadata() {}
-
-func (*Inventory_ApkMetadata) isInventory_Metadata() {}
-
-func (*Inventory_DpkgMetadata) isInventory_Metadata() {}
-
-func (*Inventory_RpmMetadata) isInventory_Metadata() {}
-
-func (*Inventory_CosMetadata) isInventory_Metadata() {}
-
-func (*Inventory_DepsjsonMetadata) isInventory_Metadata() {}
-
-func (*Inventory_SpdxMetadata) isInventory_Metadata() {}
-
-func (*Inventory_JavaArchiveMetadata) isInventory_Metadata() {}
-
-func (*Inventory_JavaLockfileMetadata) isInventory_Metadata() {}
-
-func (*Inventory_PacmanMetadata) isInventory_Metadata() {}
-
-func (*Inventory_ModuleMetadata) isInventory_Metadata() {}
+// The components and inputs that produced a scan result.
+type Provenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*Inventory_PortageMetadata) isInventory_Metadata() {}
+	// Version of the SCALIBR module that ran the scan.
+	ScalibrVersion string `protobuf:"bytes,1,opt,name=scalibr_version,json=scalibrVersion,proto3" json:"scalibr_version,omitempty"`
+	// Names and versions of all enabled plugins.
+	Plugins []*PluginVersion `protobuf:"bytes,2,rep,name=plugins,proto3" json:"plugins,omitempty"`
+	// Hex-encoded SHA-256 digest of the scan configuration.
+	ConfigDigest string `protobuf:"bytes,3,opt,name=config_digest,json=configDigest,proto3" json:"config_digest,omitempty"`
+	// Versioned data (e.g. advisory databases) used by the plugins.
+	DataSources []*DataSource `protobuf:"bytes,4,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
+}
 
-func (*Inventory_OsvMetadata) isInventory_Metadata() {}
+func (x *Provenance) Reset() {
+	*x = Provenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func (*Inventory_PythonRequirementsMetadata) isInventory_Metadata() {}
+func (x *Provenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*Inventory_ContainerdContainerMetadata) isInventory_Metadata() {}
+func (*Provenance) ProtoMessage() {}
 
-func (*Inventory_SnapMetadata) isInventory_Metadata() {}
+func (x *Provenance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*Inventory_FlatpakMetadata) isInventory_Metadata() {}
+// Deprecated: Use Provenance.ProtoReflect.Descriptor instead.
+func (*Provenance) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{3}
+}
 
-func (*Inventory_MacAppsMetadata) isInventory_Metadata() {}
+func (x *Provenance) GetScalibrVersion() string {
+	if x != nil {
+		return x.ScalibrVersion
+	}
+	return ""
+}
 
-func (*Inventory_ContainerdRuntimeContainerMetadata) isInventory_Metadata() {}
+func (x *Provenance) GetPlugins() []*PluginVersion {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
 
-func (*Inventory_CdxMetadata) isInventory_Metadata() {}
+func (x *Provenance) GetConfigDigest() string {
+	if x != nil {
+		return x.ConfigDigest
+	}
+	return ""
+}
 
-func (*Inventory_WindowsOsVersionMetadata) isInventory_Metadata() {}
+func (x *Provenance) GetDataSources() []*DataSource {
+	if x != nil {
+		return x.DataSources
+	}
+	return nil
+}
 
-// Additional identifiers for source code software packages (e.g. NPM).
-type SourceCodeIdentifier struct {
+type PluginVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Repo   string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *SourceCodeIdentifier) Reset() {
-	*x = SourceCodeIdentifier{}
+func (x *PluginVersion) Reset() {
+	*x = PluginVersion{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -931,13 +1340,13 @@ func (x *SourceCodeIdentifier) Reset() {
 	}
 }
 
-func (x *SourceCodeIdentifier) String() string {
+func (x *PluginVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SourceCodeIdentifier) ProtoMessage() {}
+func (*PluginVersion) ProtoMessage() {}
 
-func (x *SourceCodeIdentifier) ProtoReflect() protoreflect.Message {
+func (x *PluginVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,39 +1358,40 @@ func (x *SourceCodeIdentifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SourceCodeIdentifier.ProtoReflect.Descriptor instead.
-func (*SourceCodeIdentifier) Descriptor() ([]byte, []int) {
+// Deprecated: Use PluginVersion.ProtoReflect.Descriptor instead.
+func (*PluginVersion) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SourceCodeIdentifier) GetRepo() string {
+func (x *PluginVersion) GetName() string {
 	if x != nil {
-		return x.Repo
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SourceCodeIdentifier) GetCommit() string {
+func (x *PluginVersion) GetVersion() int32 {
 	if x != nil {
-		return x.Commit
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-// Details about the layer a package was found in.
-type LayerDetails struct {
+type DataSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Index       int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	DiffId      string `protobuf:"bytes,2,opt,name=diff_id,json=diffId,proto3" json:"diff_id,omitempty"`
-	Command     string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
-	InBaseImage bool   `protobuf:"varint,4,opt,name=in_base_image,json=inBaseImage,proto3" json:"in_base_image,omitempty"`
+	// Name of the dataset, e.g. "govulncheck-db".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Where the data was loaded from, e.g. a file path or URL.
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// Version, snapshot timestamp or digest of the data.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *LayerDetails) Reset() {
-	*x = LayerDetails{}
+func (x *DataSource) Reset() {
+	*x = DataSource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,13 +1399,13 @@ func (x *LayerDetails) Reset() {
 	}
 }
 
-func (x *LayerDetails) String() string {
+func (x *DataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LayerDetails) ProtoMessage() {}
+func (*DataSource) ProtoMessage() {}
 
-func (x *LayerDetails) ProtoReflect() protoreflect.Message {
+func (x *DataSource) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,63 +1417,52 @@ func (x *LayerDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LayerDetails.ProtoReflect.Descriptor instead.
-func (*LayerDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
+func (*DataSource) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *LayerDetails) GetIndex() int32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *LayerDetails) GetDiffId() string {
+func (x *DataSource) GetName() string {
 	if x != nil {
-		return x.DiffId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *LayerDetails) GetCommand() string {
+func (x *DataSource) GetLocation() string {
 	if x != nil {
-		return x.Command
+		return x.Location
 	}
 	return ""
 }
 
-func (x *LayerDetails) GetInBaseImage() bool {
+func (x *DataSource) GetVersion() string {
 	if x != nil {
-		return x.InBaseImage
+		return x.Version
 	}
-	return false
+	return ""
 }
 
-// Package URL, see https://github.com/package-url/purl-spec
-type Purl struct {
+// A secret found by a Veles detector.
+type Secret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// String representation.
-	Purl string `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
-	// Package type, e.g. "maven, npm, pypi".
-	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	// Package name.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// Package version.
-	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	// Name prefix such as a Maven groupid, or Docker image owner.
-	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// Extra qualifying data for a package such as an OS, architecture, etc.
-	Qualifiers []*Qualifier `protobuf:"bytes,6,rep,name=qualifiers,proto3" json:"qualifiers,omitempty"`
-	// Extra subpath within a package, relative to the package root.
-	Subpath string `protobuf:"bytes,7,opt,name=subpath,proto3" json:"subpath,omitempty"`
+	// Go type of the secret, e.g. "appsecret.Key".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The fields of the secret encoded as JSON.
+	FieldsJson string `protobuf:"bytes,2,opt,name=fields_json,json=fieldsJson,proto3" json:"fields_json,omitempty"`
+	// Path of the file the secret was found in.
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// How likely it is that this is a real secret.
+	Confidence Secret_ConfidenceEnum `protobuf:"varint,4,opt,name=confidence,proto3,enum=scalibr.Secret_ConfidenceEnum" json:"confidence,omitempty"`
+	// Result of validating the secret against the service it belongs to.
+	Validation Secret_ValidationEnum `protobuf:"varint,5,opt,name=validation,proto3,enum=scalibr.Secret_ValidationEnum" json:"validation,omitempty"`
 }
 
-func (x *Purl) Reset() {
-	*x = Purl{}
+func (x *Secret) Reset() {
+	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1071,13 +1470,13 @@ func (x *Purl) Reset() {
 	}
 }
 
-func (x *Purl) String() string {
+func (x *Secret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Purl) ProtoMessage() {}
+func (*Secret) ProtoMessage() {}
 
-func (x *Purl) ProtoReflect() protoreflect.Message {
+func (x *Secret) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1089,71 +1488,59 @@ func (x *Purl) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Purl.ProtoReflect.Descriptor instead.
-func (*Purl) Descriptor() ([]byte, []int) {
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Purl) GetPurl() string {
-	if x != nil {
-		return x.Purl
-	}
-	return ""
-}
-
-func (x *Purl) GetType() string {
+func (x *Secret) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *Purl) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Purl) GetVersion() string {
+func (x *Secret) GetFieldsJson() string {
 	if x != nil {
-		return x.Version
+		return x.FieldsJson
 	}
 	return ""
 }
 
-func (x *Purl) GetNamespace() string {
+func (x *Secret) GetLocation() string {
 	if x != nil {
-		return x.Namespace
+		return x.Location
 	}
 	return ""
 }
 
-func (x *Purl) GetQualifiers() []*Qualifier {
+func (x *Secret) GetConfidence() Secret_ConfidenceEnum {
 	if x != nil {
-		return x.Qualifiers
+		return x.Confidence
 	}
-	return nil
+	return Secret_UNSPECIFIED
 }
 
-func (x *Purl) GetSubpath() string {
+func (x *Secret) GetValidation() Secret_ValidationEnum {
 	if x != nil {
-		return x.Subpath
+		return x.Validation
 	}
-	return ""
+	return Secret_VALIDATION_UNSPECIFIED
 }
 
-type Qualifier struct {
+// Files that made an extractor panic on every attempt and were quarantined.
+// The panics are recovered so that the scan continues, and the files are
+// listed so that they can be investigated.
+type QuarantineReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Files []*QuarantinedFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (x *Qualifier) Reset() {
-	*x = Qualifier{}
+func (x *QuarantineReport) Reset() {
+	*x = QuarantineReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1161,13 +1548,13 @@ func (x *Qualifier) Reset() {
 	}
 }
 
-func (x *Qualifier) String() string {
+func (x *QuarantineReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Qualifier) ProtoMessage() {}
+func (*QuarantineReport) ProtoMessage() {}
 
-func (x *Qualifier) ProtoReflect() protoreflect.Message {
+func (x *QuarantineReport) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1179,45 +1566,37 @@ func (x *Qualifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Qualifier.ProtoReflect.Descriptor instead.
-func (*Qualifier) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuarantineReport.ProtoReflect.Descriptor instead.
+func (*QuarantineReport) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Qualifier) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *Qualifier) GetValue() string {
+func (x *QuarantineReport) GetFiles() []*QuarantinedFile {
 	if x != nil {
-		return x.Value
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-// A security finding found by a detector. It could describe things like a CVE
-// or a CIS non-compliance.
-type Finding struct {
+type QuarantinedFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Info specific to the finding. Should always be the same for the same type
-	// of finding.
-	Adv *Advisory `protobuf:"bytes,1,opt,name=adv,proto3" json:"adv,omitempty"`
-	// Instance-specific info such as location of the vulnerable files.
-	Target *TargetDetails `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	// Additional free-text info.
-	Extra string `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
-	// The name of the Detectors that found this finding. Set by the core library.
-	Detectors []string `protobuf:"bytes,4,rep,name=detectors,proto3" json:"detectors,omitempty"`
+	// Path of the file, relative to the scan root.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Name and version of the extractor that panicked.
+	Extractor        string `protobuf:"bytes,2,opt,name=extractor,proto3" json:"extractor,omitempty"`
+	ExtractorVersion int32  `protobuf:"varint,3,opt,name=extractor_version,json=extractorVersion,proto3" json:"extractor_version,omitempty"`
+	// Number of times the extractor was run on the file, including retries.
+	Attempts int32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The value passed to panic and the stack trace of the last attempt.
+	Panic string `protobuf:"bytes,5,opt,name=panic,proto3" json:"panic,omitempty"`
+	Stack string `protobuf:"bytes,6,opt,name=stack,proto3" json:"stack,omitempty"`
 }
 
-func (x *Finding) Reset() {
-	*x = Finding{}
+func (x *QuarantinedFile) Reset() {
+	*x = QuarantinedFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1225,13 +1604,13 @@ func (x *Finding) Reset() {
 	}
 }
 
-func (x *Finding) String() string {
+func (x *QuarantinedFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Finding) ProtoMessage() {}
+func (*QuarantinedFile) ProtoMessage() {}
 
-func (x *Finding) ProtoReflect() protoreflect.Message {
+func (x *QuarantinedFile) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,56 +1622,63 @@ func (x *Finding) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Finding.ProtoReflect.Descriptor instead.
-func (*Finding) Descriptor() ([]byte, []int) {
+// Deprecated: Use QuarantinedFile.ProtoReflect.Descriptor instead.
+func (*QuarantinedFile) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Finding) GetAdv() *Advisory {
+func (x *QuarantinedFile) GetPath() string {
 	if x != nil {
-		return x.Adv
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *Finding) GetTarget() *TargetDetails {
+func (x *QuarantinedFile) GetExtractor() string {
 	if x != nil {
-		return x.Target
+		return x.Extractor
 	}
-	return nil
+	return ""
 }
 
-func (x *Finding) GetExtra() string {
+func (x *QuarantinedFile) GetExtractorVersion() int32 {
 	if x != nil {
-		return x.Extra
+		return x.ExtractorVersion
+	}
+	return 0
+}
+
+func (x *QuarantinedFile) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QuarantinedFile) GetPanic() string {
+	if x != nil {
+		return x.Panic
 	}
 	return ""
 }
 
-func (x *Finding) GetDetectors() []string {
+func (x *QuarantinedFile) GetStack() string {
 	if x != nil {
-		return x.Detectors
+		return x.Stack
 	}
-	return nil
+	return ""
 }
 
-type Advisory struct {
+type CoverageReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A unique ID for the finding.
-	Id          *AdvisoryId       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type        Advisory_TypeEnum `protobuf:"varint,2,opt,name=type,proto3,enum=scalibr.Advisory_TypeEnum" json:"type,omitempty"`
-	Title       string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// Remediation instructions, e.g. "update to latest version".
-	Recommendation string    `protobuf:"bytes,5,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
-	Sev            *Severity `protobuf:"bytes,6,opt,name=sev,proto3" json:"sev,omitempty"`
+	UnparsedFiles []*UnparsedFile `protobuf:"bytes,1,rep,name=unparsed_files,json=unparsedFiles,proto3" json:"unparsed_files,omitempty"`
 }
 
-func (x *Advisory) Reset() {
-	*x = Advisory{}
+func (x *CoverageReport) Reset() {
+	*x = CoverageReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1300,13 +1686,13 @@ func (x *Advisory) Reset() {
 	}
 }
 
-func (x *Advisory) String() string {
+func (x *CoverageReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Advisory) ProtoMessage() {}
+func (*CoverageReport) ProtoMessage() {}
 
-func (x *Advisory) ProtoReflect() protoreflect.Message {
+func (x *CoverageReport) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1318,65 +1704,34 @@ func (x *Advisory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Advisory.ProtoReflect.Descriptor instead.
-func (*Advisory) Descriptor() ([]byte, []int) {
+// Deprecated: Use CoverageReport.ProtoReflect.Descriptor instead.
+func (*CoverageReport) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Advisory) GetId() *AdvisoryId {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-func (x *Advisory) GetType() Advisory_TypeEnum {
-	if x != nil {
-		return x.Type
-	}
-	return Advisory_UNKNOWN
-}
-
-func (x *Advisory) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Advisory) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Advisory) GetRecommendation() string {
+func (x *CoverageReport) GetUnparsedFiles() []*UnparsedFile {
 	if x != nil {
-		return x.Recommendation
-	}
-	return ""
-}
-
-func (x *Advisory) GetSev() *Severity {
-	if x != nil {
-		return x.Sev
+		return x.UnparsedFiles
 	}
 	return nil
 }
 
-// A unique identifier per advisory.
-type AdvisoryId struct {
+type UnparsedFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"` // e.g. "CVE".
-	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"` // e.g. "CVE-2023-1234".
+	// Path of the file, relative to the scan root.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Ecosystem the file belongs to, e.g. "npm" or "PyPI".
+	Ecosystem string                  `protobuf:"bytes,2,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	Reason    UnparsedFile_ReasonEnum `protobuf:"varint,3,opt,name=reason,proto3,enum=scalibr.UnparsedFile_ReasonEnum" json:"reason,omitempty"`
+	// The extraction errors if the reason is EXTRACTION_ERROR.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *AdvisoryId) Reset() {
-	*x = AdvisoryId{}
+func (x *UnparsedFile) Reset() {
+	*x = UnparsedFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1384,13 +1739,13 @@ func (x *AdvisoryId) Reset() {
 	}
 }
 
-func (x *AdvisoryId) String() string {
+func (x *UnparsedFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdvisoryId) ProtoMessage() {}
+func (*UnparsedFile) ProtoMessage() {}
 
-func (x *AdvisoryId) ProtoReflect() protoreflect.Message {
+func (x *UnparsedFile) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1402,39 +1757,142 @@ func (x *AdvisoryId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdvisoryId.ProtoReflect.Descriptor instead.
-func (*AdvisoryId) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnparsedFile.ProtoReflect.Descriptor instead.
+func (*UnparsedFile) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *AdvisoryId) GetPublisher() string {
+func (x *UnparsedFile) GetPath() string {
 	if x != nil {
-		return x.Publisher
+		return x.Path
 	}
 	return ""
 }
 
-func (x *AdvisoryId) GetReference() string {
+func (x *UnparsedFile) GetEcosystem() string {
 	if x != nil {
-		return x.Reference
+		return x.Ecosystem
 	}
 	return ""
 }
 
-type Severity struct {
+func (x *UnparsedFile) GetReason() UnparsedFile_ReasonEnum {
+	if x != nil {
+		return x.Reason
+	}
+	return UnparsedFile_UNSPECIFIED
+}
+
+func (x *UnparsedFile) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// A software package or library found by an extractor.
+// PURL or CPE needs to be set, maybe both.
+type Inventory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required severity enum. Can be used for e.g. prioritizing filed bugs.
-	Severity Severity_SeverityEnum `protobuf:"varint,1,opt,name=severity,proto3,enum=scalibr.Severity_SeverityEnum" json:"severity,omitempty"`
-	// Optional CVSS scores, only set for vulns with CVEs.
-	CvssV2 *CVSS `protobuf:"bytes,2,opt,name=cvss_v2,json=cvssV2,proto3" json:"cvss_v2,omitempty"`
-	CvssV3 *CVSS `protobuf:"bytes,3,opt,name=cvss_v3,json=cvssV3,proto3" json:"cvss_v3,omitempty"`
+	// Human-readable name of the software, to be used for things like logging.
+	// For vuln matching, use the name from metadata.
+	Name string `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	// Version of the package.
+	Version string `protobuf:"bytes,12,opt,name=version,proto3" json:"version,omitempty"`
+	// Source code level package identifiers.
+	SourceCode *SourceCodeIdentifier `protobuf:"bytes,26,opt,name=source_code,json=sourceCode,proto3" json:"source_code,omitempty"`
+	// Package URL of the software.
+	Purl *Purl `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	// Stable identifier of this package instance, a UUID derived from the
+	// PURL, locations, source code identifiers and layer. The same package
+	// found at the same place has the same ID across scans and hosts that use
+	// the same scan root, since locations are relative to the scan root.
+	InstanceId string `protobuf:"bytes,55,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// Ecosystem - For software packages this corresponds to an OSV ecosystem
+	// value, e.g. PyPI.
+	Ecosystem string `protobuf:"bytes,27,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	// Paths or source of files related to the package.
+	Locations []string `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty"`
+	// The name of the Extractor that found this software. Set by the
+	// core library.
+	Extractor string `protobuf:"bytes,10,opt,name=extractor,proto3" json:"extractor,omitempty"`
+	// The additional data found in the package.
+	//
+	// Types that are assignable to Metadata:
+	//
+	//	*Inventory_PythonMetadata
+	//	*Inventory_JavascriptMetadata
+	//	*Inventory_ElectronAppMetadata
+	//	*Inventory_ApkMetadata
+	//	*Inventory_DpkgMetadata
+	//	*Inventory_RpmMetadata
+	//	*Inventory_CosMetadata
+	//	*Inventory_DepsjsonMetadata
+	//	*Inventory_SpdxMetadata
+	//	*Inventory_JavaArchiveMetadata
+	//	*Inventory_JavaLockfileMetadata
+	//	*Inventory_JavaAppServerMetadata
+	//	*Inventory_JavaRuntimeMetadata
+	//	*Inventory_PacmanMetadata
+	//	*Inventory_ModuleMetadata
+	//	*Inventory_PortageMetadata
+	//	*Inventory_OsvMetadata
+	//	*Inventory_PythonRequirementsMetadata
+	//	*Inventory_ContainerdContainerMetadata
+	//	*Inventory_SnapMetadata
+	//	*Inventory_FlatpakMetadata
+	//	*Inventory_MacAppsMetadata
+	//	*Inventory_ContainerdRuntimeContainerMetadata
+	//	*Inventory_CdxMetadata
+	//	*Inventory_WindowsOsVersionMetadata
+	//	*Inventory_WindowsSecurityProductMetadata
+	//	*Inventory_LogPipelineMetadata
+	//	*Inventory_StorageClusterMetadata
+	//	*Inventory_AppBundleMetadata
+	//	*Inventory_IacSecretsMetadata
+	//	*Inventory_DatabaseServerMetadata
+	//	*Inventory_RegistryConfigMetadata
+	//	*Inventory_EdgeProxyMetadata
+	//	*Inventory_ActiveDirectoryMetadata
+	//	*Inventory_AndroidMetadata
+	//	*Inventory_AppSupervisorMetadata
+	//	*Inventory_KernelModuleMetadata
+	//	*Inventory_WslDistributionMetadata
+	//	*Inventory_EcsTaskContainerMetadata
+	//	*Inventory_KubeletPodMetadata
+	//	*Inventory_PrivateCloudMetadata
+	//	*Inventory_CmsExtensionMetadata
+	//	*Inventory_VendorLibraryMetadata
+	//	*Inventory_BazelModuleMetadata
+	//	*Inventory_KerberosMetadata
+	//	*Inventory_PearPackageMetadata
+	//	*Inventory_PerlModuleMetadata
+	//	*Inventory_SynologyPackageMetadata
+	//	*Inventory_QnapPackageMetadata
+	//	*Inventory_GoBinaryMetadata
+	//	*Inventory_VendoredCodeMetadata
+	//	*Inventory_ComposerPackageMetadata
+	Metadata    isInventory_Metadata       `protobuf_oneof:"metadata"`
+	Annotations []Inventory_AnnotationEnum `protobuf:"varint,28,rep,packed,name=annotations,proto3,enum=scalibr.Inventory_AnnotationEnum" json:"annotations,omitempty"`
+	// Details about the layer a package was found in. This should be set only for
+	// container image scanning.
+	LayerDetails *LayerDetails `protobuf:"bytes,35,opt,name=layer_details,json=layerDetails,proto3" json:"layer_details,omitempty"`
+	// The licenses of the package as SPDX license expressions. Only set if
+	// license detection is enabled.
+	Licenses []string `protobuf:"bytes,70,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	// The direct dependencies of the package. Only set by extractors of
+	// lockfiles that record them.
+	Dependencies []*Dependency `protobuf:"bytes,71,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Whether the package is a direct dependency of the project whose lockfile
+	// it was found in.
+	DirectDependency bool `protobuf:"varint,72,opt,name=direct_dependency,json=directDependency,proto3" json:"direct_dependency,omitempty"`
 }
 
-func (x *Severity) Reset() {
-	*x = Severity{}
+func (x *Inventory) Reset() {
+	*x = Inventory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_scan_result_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1442,13 +1900,13 @@ func (x *Severity) Reset() {
 	}
 }
 
-func (x *Severity) String() string {
+func (x *Inventory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Severity) ProtoMessage() {}
+func (*Inventory) ProtoMessage() {}
 
-func (x *Severity) ProtoReflect() protoreflect.Message {
+func (x *Inventory) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_scan_result_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1460,806 +1918,819 @@ func (x *Severity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Severity.ProtoReflect.Descriptor instead.
-func (*Severity) Descriptor() ([]byte, []int) {
+// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
+func (*Inventory) Descriptor() ([]byte, []int) {
 	return file_proto_scan_result_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Severity) GetSeverity() Severity_SeverityEnum {
+func (x *Inventory) GetName() string {
 	if x != nil {
-		return x.Severity
+		return x.Name
 	}
-	return Severity_UNSPECIFIED
+	return ""
 }
 
-func (x *Severity) GetCvssV2() *CVSS {
+func (x *Inventory) GetVersion() string {
 	if x != nil {
-		return x.CvssV2
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *Severity) GetCvssV3() *CVSS {
+func (x *Inventory) GetSourceCode() *SourceCodeIdentifier {
 	if x != nil {
-		return x.CvssV3
+		return x.SourceCode
 	}
 	return nil
 }
 
-type CVSS struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BaseScore          float32 `protobuf:"fixed32,1,opt,name=base_score,json=baseScore,proto3" json:"base_score,omitempty"`
-	TemporalScore      float32 `protobuf:"fixed32,2,opt,name=temporal_score,json=temporalScore,proto3" json:"temporal_score,omitempty"`
-	EnvironmentalScore float32 `protobuf:"fixed32,3,opt,name=environmental_score,json=environmentalScore,proto3" json:"environmental_score,omitempty"`
-}
-
-func (x *CVSS) Reset() {
-	*x = CVSS{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Inventory) GetPurl() *Purl {
+	if x != nil {
+		return x.Purl
 	}
+	return nil
 }
 
-func (x *CVSS) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CVSS) ProtoMessage() {}
-
-func (x *CVSS) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Inventory) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CVSS.ProtoReflect.Descriptor instead.
-func (*CVSS) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{12}
+	return ""
 }
 
-func (x *CVSS) GetBaseScore() float32 {
+func (x *Inventory) GetEcosystem() string {
 	if x != nil {
-		return x.BaseScore
+		return x.Ecosystem
 	}
-	return 0
+	return ""
 }
 
-func (x *CVSS) GetTemporalScore() float32 {
+func (x *Inventory) GetLocations() []string {
 	if x != nil {
-		return x.TemporalScore
+		return x.Locations
 	}
-	return 0
+	return nil
 }
 
-func (x *CVSS) GetEnvironmentalScore() float32 {
+func (x *Inventory) GetExtractor() string {
 	if x != nil {
-		return x.EnvironmentalScore
+		return x.Extractor
 	}
-	return 0
+	return ""
 }
 
-type TargetDetails struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *Inventory) GetMetadata() isInventory_Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
 
-	// The software affected by the finding.
-	Inventory *Inventory `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
-	// Location of vulnerable files not related to the inventory,
-	// e.g. config files with misconfigurations.
-	Location []string `protobuf:"bytes,3,rep,name=location,proto3" json:"location,omitempty"`
+func (x *Inventory) GetPythonMetadata() *PythonPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_PythonMetadata); ok {
+		return x.PythonMetadata
+	}
+	return nil
 }
 
-func (x *TargetDetails) Reset() {
-	*x = TargetDetails{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Inventory) GetJavascriptMetadata() *JavascriptPackageJSONMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_JavascriptMetadata); ok {
+		return x.JavascriptMetadata
 	}
+	return nil
 }
 
-func (x *TargetDetails) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Inventory) GetElectronAppMetadata() *ElectronAppMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_ElectronAppMetadata); ok {
+		return x.ElectronAppMetadata
+	}
+	return nil
 }
 
-func (*TargetDetails) ProtoMessage() {}
+func (x *Inventory) GetApkMetadata() *APKPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_ApkMetadata); ok {
+		return x.ApkMetadata
+	}
+	return nil
+}
 
-func (x *TargetDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Inventory) GetDpkgMetadata() *DPKGPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_DpkgMetadata); ok {
+		return x.DpkgMetadata
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TargetDetails.ProtoReflect.Descriptor instead.
-func (*TargetDetails) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{13}
+func (x *Inventory) GetRpmMetadata() *RPMPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_RpmMetadata); ok {
+		return x.RpmMetadata
+	}
+	return nil
 }
 
-func (x *TargetDetails) GetInventory() *Inventory {
-	if x != nil {
-		return x.Inventory
+func (x *Inventory) GetCosMetadata() *COSPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_CosMetadata); ok {
+		return x.CosMetadata
 	}
 	return nil
 }
 
-func (x *TargetDetails) GetLocation() []string {
-	if x != nil {
-		return x.Location
+func (x *Inventory) GetDepsjsonMetadata() *DEPSJSONMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_DepsjsonMetadata); ok {
+		return x.DepsjsonMetadata
 	}
 	return nil
 }
 
-// The additional data found in python packages.
-type PythonPackageMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Inventory) GetSpdxMetadata() *SPDXPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_SpdxMetadata); ok {
+		return x.SpdxMetadata
+	}
+	return nil
+}
 
-	Author      string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	AuthorEmail string `protobuf:"bytes,2,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+func (x *Inventory) GetJavaArchiveMetadata() *JavaArchiveMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_JavaArchiveMetadata); ok {
+		return x.JavaArchiveMetadata
+	}
+	return nil
 }
 
-func (x *PythonPackageMetadata) Reset() {
-	*x = PythonPackageMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Inventory) GetJavaLockfileMetadata() *JavaLockfileMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_JavaLockfileMetadata); ok {
+		return x.JavaLockfileMetadata
 	}
+	return nil
 }
 
-func (x *PythonPackageMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Inventory) GetJavaAppServerMetadata() *JavaAppServerMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_JavaAppServerMetadata); ok {
+		return x.JavaAppServerMetadata
+	}
+	return nil
 }
 
-func (*PythonPackageMetadata) ProtoMessage() {}
+func (x *Inventory) GetJavaRuntimeMetadata() *JavaRuntimeMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_JavaRuntimeMetadata); ok {
+		return x.JavaRuntimeMetadata
+	}
+	return nil
+}
 
-func (x *PythonPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Inventory) GetPacmanMetadata() *PACMANPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_PacmanMetadata); ok {
+		return x.PacmanMetadata
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use PythonPackageMetadata.ProtoReflect.Descriptor instead.
-func (*PythonPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{14}
+func (x *Inventory) GetModuleMetadata() *ModuleMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_ModuleMetadata); ok {
+		return x.ModuleMetadata
+	}
+	return nil
 }
 
-func (x *PythonPackageMetadata) GetAuthor() string {
-	if x != nil {
-		return x.Author
+func (x *Inventory) GetPortageMetadata() *PortagePackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_PortageMetadata); ok {
+		return x.PortageMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *PythonPackageMetadata) GetAuthorEmail() string {
-	if x != nil {
-		return x.AuthorEmail
+func (x *Inventory) GetOsvMetadata() *OSVPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_OsvMetadata); ok {
+		return x.OsvMetadata
 	}
-	return ""
+	return nil
 }
 
-// The additional data found in npm packages.
-type JavascriptPackageJSONMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Inventory) GetPythonRequirementsMetadata() *PythonRequirementsMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_PythonRequirementsMetadata); ok {
+		return x.PythonRequirementsMetadata
+	}
+	return nil
+}
 
-	Author       string   `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
-	Maintainers  []string `protobuf:"bytes,2,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
-	Contributors []string `protobuf:"bytes,3,rep,name=contributors,proto3" json:"contributors,omitempty"`
+func (x *Inventory) GetContainerdContainerMetadata() *ContainerdContainerMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_ContainerdContainerMetadata); ok {
+		return x.ContainerdContainerMetadata
+	}
+	return nil
 }
 
-func (x *JavascriptPackageJSONMetadata) Reset() {
-	*x = JavascriptPackageJSONMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Inventory) GetSnapMetadata() *SNAPPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_SnapMetadata); ok {
+		return x.SnapMetadata
 	}
+	return nil
 }
 
-func (x *JavascriptPackageJSONMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Inventory) GetFlatpakMetadata() *FlatpakPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_FlatpakMetadata); ok {
+		return x.FlatpakMetadata
+	}
+	return nil
 }
 
-func (*JavascriptPackageJSONMetadata) ProtoMessage() {}
+func (x *Inventory) GetMacAppsMetadata() *MacAppsMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_MacAppsMetadata); ok {
+		return x.MacAppsMetadata
+	}
+	return nil
+}
 
-func (x *JavascriptPackageJSONMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Inventory) GetContainerdRuntimeContainerMetadata() *ContainerdRuntimeContainerMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_ContainerdRuntimeContainerMetadata); ok {
+		return x.ContainerdRuntimeContainerMetadata
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use JavascriptPackageJSONMetadata.ProtoReflect.Descriptor instead.
-func (*JavascriptPackageJSONMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{15}
+func (x *Inventory) GetCdxMetadata() *CDXPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_CdxMetadata); ok {
+		return x.CdxMetadata
+	}
+	return nil
 }
 
-func (x *JavascriptPackageJSONMetadata) GetAuthor() string {
-	if x != nil {
-		return x.Author
+func (x *Inventory) GetWindowsOsVersionMetadata() *WindowsOSVersion {
+	if x, ok := x.GetMetadata().(*Inventory_WindowsOsVersionMetadata); ok {
+		return x.WindowsOsVersionMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *JavascriptPackageJSONMetadata) GetMaintainers() []string {
-	if x != nil {
-		return x.Maintainers
+func (x *Inventory) GetWindowsSecurityProductMetadata() *WindowsSecurityProductMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_WindowsSecurityProductMetadata); ok {
+		return x.WindowsSecurityProductMetadata
 	}
 	return nil
 }
 
-func (x *JavascriptPackageJSONMetadata) GetContributors() []string {
-	if x != nil {
-		return x.Contributors
+func (x *Inventory) GetLogPipelineMetadata() *LogPipelineMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_LogPipelineMetadata); ok {
+		return x.LogPipelineMetadata
 	}
 	return nil
 }
 
-// The additional data found in APK packages.
-type APKPackageMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Inventory) GetStorageClusterMetadata() *StorageClusterMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_StorageClusterMetadata); ok {
+		return x.StorageClusterMetadata
+	}
+	return nil
+}
 
-	PackageName  string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	OriginName   string `protobuf:"bytes,2,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
-	OsId         string `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionId  string `protobuf:"bytes,4,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
-	Maintainer   string `protobuf:"bytes,5,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
-	Architecture string `protobuf:"bytes,6,opt,name=architecture,proto3" json:"architecture,omitempty"`
-	License      string `protobuf:"bytes,7,opt,name=license,proto3" json:"license,omitempty"`
+func (x *Inventory) GetAppBundleMetadata() *AppBundleMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_AppBundleMetadata); ok {
+		return x.AppBundleMetadata
+	}
+	return nil
 }
 
-func (x *APKPackageMetadata) Reset() {
-	*x = APKPackageMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Inventory) GetIacSecretsMetadata() *IaCSecretsMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_IacSecretsMetadata); ok {
+		return x.IacSecretsMetadata
 	}
+	return nil
 }
 
-func (x *APKPackageMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Inventory) GetDatabaseServerMetadata() *DatabaseServerMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_DatabaseServerMetadata); ok {
+		return x.DatabaseServerMetadata
+	}
+	return nil
 }
 
-func (*APKPackageMetadata) ProtoMessage() {}
+func (x *Inventory) GetRegistryConfigMetadata() *RegistryConfigMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_RegistryConfigMetadata); ok {
+		return x.RegistryConfigMetadata
+	}
+	return nil
+}
 
-func (x *APKPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Inventory) GetEdgeProxyMetadata() *EdgeProxyMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_EdgeProxyMetadata); ok {
+		return x.EdgeProxyMetadata
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use APKPackageMetadata.ProtoReflect.Descriptor instead.
-func (*APKPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{16}
+func (x *Inventory) GetActiveDirectoryMetadata() *ActiveDirectoryMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_ActiveDirectoryMetadata); ok {
+		return x.ActiveDirectoryMetadata
+	}
+	return nil
 }
 
-func (x *APKPackageMetadata) GetPackageName() string {
-	if x != nil {
-		return x.PackageName
+func (x *Inventory) GetAndroidMetadata() *AndroidMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_AndroidMetadata); ok {
+		return x.AndroidMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *APKPackageMetadata) GetOriginName() string {
-	if x != nil {
-		return x.OriginName
+func (x *Inventory) GetAppSupervisorMetadata() *AppSupervisorMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_AppSupervisorMetadata); ok {
+		return x.AppSupervisorMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *APKPackageMetadata) GetOsId() string {
-	if x != nil {
-		return x.OsId
+func (x *Inventory) GetKernelModuleMetadata() *KernelModuleMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_KernelModuleMetadata); ok {
+		return x.KernelModuleMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *APKPackageMetadata) GetOsVersionId() string {
-	if x != nil {
-		return x.OsVersionId
+func (x *Inventory) GetWslDistributionMetadata() *WSLDistributionMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_WslDistributionMetadata); ok {
+		return x.WslDistributionMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *APKPackageMetadata) GetMaintainer() string {
-	if x != nil {
-		return x.Maintainer
+func (x *Inventory) GetEcsTaskContainerMetadata() *ECSTaskContainerMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_EcsTaskContainerMetadata); ok {
+		return x.EcsTaskContainerMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *APKPackageMetadata) GetArchitecture() string {
-	if x != nil {
-		return x.Architecture
+func (x *Inventory) GetKubeletPodMetadata() *KubeletPodMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_KubeletPodMetadata); ok {
+		return x.KubeletPodMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *APKPackageMetadata) GetLicense() string {
-	if x != nil {
-		return x.License
+func (x *Inventory) GetPrivateCloudMetadata() *PrivateCloudMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_PrivateCloudMetadata); ok {
+		return x.PrivateCloudMetadata
 	}
-	return ""
+	return nil
 }
 
-// The additional data found in DPKG packages.
-// Next ID: 11
-type DPKGPackageMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PackageName       string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	SourceName        string `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
-	SourceVersion     string `protobuf:"bytes,3,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"`
-	PackageVersion    string `protobuf:"bytes,4,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
-	OsId              string `protobuf:"bytes,5,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionCodename string `protobuf:"bytes,6,opt,name=os_version_codename,json=osVersionCodename,proto3" json:"os_version_codename,omitempty"`
-	OsVersionId       string `protobuf:"bytes,7,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
-	Maintainer        string `protobuf:"bytes,8,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
-	Architecture      string `protobuf:"bytes,9,opt,name=architecture,proto3" json:"architecture,omitempty"`
-	Status            string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+func (x *Inventory) GetCmsExtensionMetadata() *CMSExtensionMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_CmsExtensionMetadata); ok {
+		return x.CmsExtensionMetadata
+	}
+	return nil
 }
 
-func (x *DPKGPackageMetadata) Reset() {
-	*x = DPKGPackageMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Inventory) GetVendorLibraryMetadata() *VendorLibraryMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_VendorLibraryMetadata); ok {
+		return x.VendorLibraryMetadata
 	}
+	return nil
 }
 
-func (x *DPKGPackageMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Inventory) GetBazelModuleMetadata() *BazelModuleMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_BazelModuleMetadata); ok {
+		return x.BazelModuleMetadata
+	}
+	return nil
 }
 
-func (*DPKGPackageMetadata) ProtoMessage() {}
+func (x *Inventory) GetKerberosMetadata() *KerberosMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_KerberosMetadata); ok {
+		return x.KerberosMetadata
+	}
+	return nil
+}
 
-func (x *DPKGPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Inventory) GetPearPackageMetadata() *PEARPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_PearPackageMetadata); ok {
+		return x.PearPackageMetadata
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DPKGPackageMetadata.ProtoReflect.Descriptor instead.
-func (*DPKGPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{17}
+func (x *Inventory) GetPerlModuleMetadata() *PerlModuleMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_PerlModuleMetadata); ok {
+		return x.PerlModuleMetadata
+	}
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetPackageName() string {
-	if x != nil {
-		return x.PackageName
+func (x *Inventory) GetSynologyPackageMetadata() *SynologyPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_SynologyPackageMetadata); ok {
+		return x.SynologyPackageMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetSourceName() string {
-	if x != nil {
-		return x.SourceName
+func (x *Inventory) GetQnapPackageMetadata() *QNAPPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_QnapPackageMetadata); ok {
+		return x.QnapPackageMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetSourceVersion() string {
-	if x != nil {
-		return x.SourceVersion
+func (x *Inventory) GetGoBinaryMetadata() *GoBinaryMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_GoBinaryMetadata); ok {
+		return x.GoBinaryMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetPackageVersion() string {
-	if x != nil {
-		return x.PackageVersion
+func (x *Inventory) GetVendoredCodeMetadata() *VendoredCodeMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_VendoredCodeMetadata); ok {
+		return x.VendoredCodeMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetOsId() string {
-	if x != nil {
-		return x.OsId
+func (x *Inventory) GetComposerPackageMetadata() *ComposerPackageMetadata {
+	if x, ok := x.GetMetadata().(*Inventory_ComposerPackageMetadata); ok {
+		return x.ComposerPackageMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetOsVersionCodename() string {
+func (x *Inventory) GetAnnotations() []Inventory_AnnotationEnum {
 	if x != nil {
-		return x.OsVersionCodename
+		return x.Annotations
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetOsVersionId() string {
+func (x *Inventory) GetLayerDetails() *LayerDetails {
 	if x != nil {
-		return x.OsVersionId
+		return x.LayerDetails
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetMaintainer() string {
+func (x *Inventory) GetLicenses() []string {
 	if x != nil {
-		return x.Maintainer
+		return x.Licenses
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetArchitecture() string {
+func (x *Inventory) GetDependencies() []*Dependency {
 	if x != nil {
-		return x.Architecture
+		return x.Dependencies
 	}
-	return ""
+	return nil
 }
 
-func (x *DPKGPackageMetadata) GetStatus() string {
+func (x *Inventory) GetDirectDependency() bool {
 	if x != nil {
-		return x.Status
+		return x.DirectDependency
 	}
-	return ""
+	return false
 }
 
-// The additional data found in RPM packages.
-type RPMPackageMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PackageName  string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	SourceRpm    string `protobuf:"bytes,2,opt,name=source_rpm,json=sourceRpm,proto3" json:"source_rpm,omitempty"`
-	Epoch        int32  `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	OsId         string `protobuf:"bytes,4,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionId  string `protobuf:"bytes,5,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
-	OsBuildId    string `protobuf:"bytes,6,opt,name=os_build_id,json=osBuildId,proto3" json:"os_build_id,omitempty"`
-	OsName       string `protobuf:"bytes,7,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
-	Vendor       string `protobuf:"bytes,8,opt,name=vendor,proto3" json:"vendor,omitempty"`
-	Architecture string `protobuf:"bytes,9,opt,name=architecture,proto3" json:"architecture,omitempty"`
-	License      string `protobuf:"bytes,10,opt,name=license,proto3" json:"license,omitempty"`
+type isInventory_Metadata interface {
+	isInventory_Metadata()
 }
 
-func (x *RPMPackageMetadata) Reset() {
-	*x = RPMPackageMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Inventory_PythonMetadata struct {
+	PythonMetadata *PythonPackageMetadata `protobuf:"bytes,5,opt,name=python_metadata,json=pythonMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Inventory_JavascriptMetadata struct {
+	JavascriptMetadata *JavascriptPackageJSONMetadata `protobuf:"bytes,6,opt,name=javascript_metadata,json=javascriptMetadata,proto3,oneof"`
 }
 
-func (*RPMPackageMetadata) ProtoMessage() {}
-
-func (x *RPMPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type Inventory_ElectronAppMetadata struct {
+	ElectronAppMetadata *ElectronAppMetadata `protobuf:"bytes,44,opt,name=electron_app_metadata,json=electronAppMetadata,proto3,oneof"`
 }
 
-// Deprecated: Use RPMPackageMetadata.ProtoReflect.Descriptor instead.
-func (*RPMPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{18}
+type Inventory_ApkMetadata struct {
+	ApkMetadata *APKPackageMetadata `protobuf:"bytes,7,opt,name=apk_metadata,json=apkMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetPackageName() string {
-	if x != nil {
-		return x.PackageName
-	}
-	return ""
+type Inventory_DpkgMetadata struct {
+	DpkgMetadata *DPKGPackageMetadata `protobuf:"bytes,8,opt,name=dpkg_metadata,json=dpkgMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetSourceRpm() string {
-	if x != nil {
-		return x.SourceRpm
-	}
-	return ""
+type Inventory_RpmMetadata struct {
+	RpmMetadata *RPMPackageMetadata `protobuf:"bytes,9,opt,name=rpm_metadata,json=rpmMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetEpoch() int32 {
-	if x != nil {
-		return x.Epoch
-	}
-	return 0
+type Inventory_CosMetadata struct {
+	CosMetadata *COSPackageMetadata `protobuf:"bytes,13,opt,name=cos_metadata,json=cosMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetOsId() string {
-	if x != nil {
-		return x.OsId
-	}
-	return ""
+type Inventory_DepsjsonMetadata struct {
+	DepsjsonMetadata *DEPSJSONMetadata `protobuf:"bytes,40,opt,name=depsjson_metadata,json=depsjsonMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetOsVersionId() string {
-	if x != nil {
-		return x.OsVersionId
-	}
-	return ""
+type Inventory_SpdxMetadata struct {
+	SpdxMetadata *SPDXPackageMetadata `protobuf:"bytes,14,opt,name=spdx_metadata,json=spdxMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetOsBuildId() string {
-	if x != nil {
-		return x.OsBuildId
-	}
-	return ""
+type Inventory_JavaArchiveMetadata struct {
+	JavaArchiveMetadata *JavaArchiveMetadata `protobuf:"bytes,15,opt,name=java_archive_metadata,json=javaArchiveMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetOsName() string {
-	if x != nil {
-		return x.OsName
-	}
-	return ""
+type Inventory_JavaLockfileMetadata struct {
+	JavaLockfileMetadata *JavaLockfileMetadata `protobuf:"bytes,31,opt,name=java_lockfile_metadata,json=javaLockfileMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetVendor() string {
-	if x != nil {
-		return x.Vendor
-	}
-	return ""
+type Inventory_JavaAppServerMetadata struct {
+	JavaAppServerMetadata *JavaAppServerMetadata `protobuf:"bytes,45,opt,name=java_app_server_metadata,json=javaAppServerMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetArchitecture() string {
-	if x != nil {
-		return x.Architecture
-	}
-	return ""
+type Inventory_JavaRuntimeMetadata struct {
+	JavaRuntimeMetadata *JavaRuntimeMetadata `protobuf:"bytes,52,opt,name=java_runtime_metadata,json=javaRuntimeMetadata,proto3,oneof"`
 }
 
-func (x *RPMPackageMetadata) GetLicense() string {
-	if x != nil {
-		return x.License
-	}
-	return ""
+type Inventory_PacmanMetadata struct {
+	PacmanMetadata *PACMANPackageMetadata `protobuf:"bytes,36,opt,name=pacman_metadata,json=pacmanMetadata,proto3,oneof"`
 }
 
-// The additional data found in COS packages.
-type COSPackageMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Inventory_ModuleMetadata struct {
+	ModuleMetadata *ModuleMetadata `protobuf:"bytes,38,opt,name=module_metadata,json=moduleMetadata,proto3,oneof"`
+}
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version     string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Category    string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	OsVersion   string `protobuf:"bytes,4,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
-	OsVersionId string `protobuf:"bytes,5,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+type Inventory_PortageMetadata struct {
+	PortageMetadata *PortagePackageMetadata `protobuf:"bytes,41,opt,name=portage_metadata,json=portageMetadata,proto3,oneof"`
 }
 
-func (x *COSPackageMetadata) Reset() {
-	*x = COSPackageMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Inventory_OsvMetadata struct {
+	OsvMetadata *OSVPackageMetadata `protobuf:"bytes,16,opt,name=osv_metadata,json=osvMetadata,proto3,oneof"`
 }
 
-func (x *COSPackageMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Inventory_PythonRequirementsMetadata struct {
+	PythonRequirementsMetadata *PythonRequirementsMetadata `protobuf:"bytes,21,opt,name=python_requirements_metadata,json=pythonRequirementsMetadata,proto3,oneof"`
 }
 
-func (*COSPackageMetadata) ProtoMessage() {}
+type Inventory_ContainerdContainerMetadata struct {
+	ContainerdContainerMetadata *ContainerdContainerMetadata `protobuf:"bytes,22,opt,name=containerd_container_metadata,json=containerdContainerMetadata,proto3,oneof"`
+}
 
-func (x *COSPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type Inventory_SnapMetadata struct {
+	SnapMetadata *SNAPPackageMetadata `protobuf:"bytes,23,opt,name=snap_metadata,json=snapMetadata,proto3,oneof"`
 }
 
-// Deprecated: Use COSPackageMetadata.ProtoReflect.Descriptor instead.
-func (*COSPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{19}
+type Inventory_FlatpakMetadata struct {
+	FlatpakMetadata *FlatpakPackageMetadata `protobuf:"bytes,24,opt,name=flatpak_metadata,json=flatpakMetadata,proto3,oneof"`
 }
 
-func (x *COSPackageMetadata) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type Inventory_MacAppsMetadata struct {
+	MacAppsMetadata *MacAppsMetadata `protobuf:"bytes,34,opt,name=mac_apps_metadata,json=macAppsMetadata,proto3,oneof"`
 }
 
-func (x *COSPackageMetadata) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+type Inventory_ContainerdRuntimeContainerMetadata struct {
+	ContainerdRuntimeContainerMetadata *ContainerdRuntimeContainerMetadata `protobuf:"bytes,25,opt,name=containerd_runtime_container_metadata,json=containerdRuntimeContainerMetadata,proto3,oneof"`
 }
 
-func (x *COSPackageMetadata) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+type Inventory_CdxMetadata struct {
+	CdxMetadata *CDXPackageMetadata `protobuf:"bytes,30,opt,name=cdx_metadata,json=cdxMetadata,proto3,oneof"`
 }
 
-func (x *COSPackageMetadata) GetOsVersion() string {
-	if x != nil {
-		return x.OsVersion
-	}
-	return ""
+type Inventory_WindowsOsVersionMetadata struct {
+	WindowsOsVersionMetadata *WindowsOSVersion `protobuf:"bytes,33,opt,name=windows_os_version_metadata,json=windowsOsVersionMetadata,proto3,oneof"`
 }
 
-func (x *COSPackageMetadata) GetOsVersionId() string {
-	if x != nil {
-		return x.OsVersionId
-	}
-	return ""
+type Inventory_WindowsSecurityProductMetadata struct {
+	WindowsSecurityProductMetadata *WindowsSecurityProductMetadata `protobuf:"bytes,42,opt,name=windows_security_product_metadata,json=windowsSecurityProductMetadata,proto3,oneof"`
 }
 
-// The additional data found in PACMAN packages.
-type PACMANPackageMetadata struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Inventory_LogPipelineMetadata struct {
+	LogPipelineMetadata *LogPipelineMetadata `protobuf:"bytes,43,opt,name=log_pipeline_metadata,json=logPipelineMetadata,proto3,oneof"`
+}
 
-	PackageName         string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	PackageVersion      string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
-	OsId                string `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionId         string `protobuf:"bytes,4,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
-	PackageDescription  string `protobuf:"bytes,5,opt,name=package_description,json=packageDescription,proto3" json:"package_description,omitempty"`
-	PackageDependencies string `protobuf:"bytes,6,opt,name=package_dependencies,json=packageDependencies,proto3" json:"package_dependencies,omitempty"`
+type Inventory_StorageClusterMetadata struct {
+	StorageClusterMetadata *StorageClusterMetadata `protobuf:"bytes,46,opt,name=storage_cluster_metadata,json=storageClusterMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) Reset() {
-	*x = PACMANPackageMetadata{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type Inventory_AppBundleMetadata struct {
+	AppBundleMetadata *AppBundleMetadata `protobuf:"bytes,47,opt,name=app_bundle_metadata,json=appBundleMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Inventory_IacSecretsMetadata struct {
+	IacSecretsMetadata *IaCSecretsMetadata `protobuf:"bytes,48,opt,name=iac_secrets_metadata,json=iacSecretsMetadata,proto3,oneof"`
 }
 
-func (*PACMANPackageMetadata) ProtoMessage() {}
+type Inventory_DatabaseServerMetadata struct {
+	DatabaseServerMetadata *DatabaseServerMetadata `protobuf:"bytes,49,opt,name=database_server_metadata,json=databaseServerMetadata,proto3,oneof"`
+}
 
-func (x *PACMANPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type Inventory_RegistryConfigMetadata struct {
+	RegistryConfigMetadata *RegistryConfigMetadata `protobuf:"bytes,50,opt,name=registry_config_metadata,json=registryConfigMetadata,proto3,oneof"`
 }
 
-// Deprecated: Use PACMANPackageMetadata.ProtoReflect.Descriptor instead.
-func (*PACMANPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{20}
+type Inventory_EdgeProxyMetadata struct {
+	EdgeProxyMetadata *EdgeProxyMetadata `protobuf:"bytes,51,opt,name=edge_proxy_metadata,json=edgeProxyMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) GetPackageName() string {
-	if x != nil {
-		return x.PackageName
-	}
-	return ""
+type Inventory_ActiveDirectoryMetadata struct {
+	ActiveDirectoryMetadata *ActiveDirectoryMetadata `protobuf:"bytes,53,opt,name=active_directory_metadata,json=activeDirectoryMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) GetPackageVersion() string {
-	if x != nil {
-		return x.PackageVersion
-	}
-	return ""
+type Inventory_AndroidMetadata struct {
+	AndroidMetadata *AndroidMetadata `protobuf:"bytes,54,opt,name=android_metadata,json=androidMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) GetOsId() string {
-	if x != nil {
-		return x.OsId
-	}
-	return ""
+type Inventory_AppSupervisorMetadata struct {
+	AppSupervisorMetadata *AppSupervisorMetadata `protobuf:"bytes,56,opt,name=app_supervisor_metadata,json=appSupervisorMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) GetOsVersionId() string {
-	if x != nil {
-		return x.OsVersionId
-	}
-	return ""
+type Inventory_KernelModuleMetadata struct {
+	KernelModuleMetadata *KernelModuleMetadata `protobuf:"bytes,57,opt,name=kernel_module_metadata,json=kernelModuleMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) GetPackageDescription() string {
-	if x != nil {
-		return x.PackageDescription
-	}
-	return ""
+type Inventory_WslDistributionMetadata struct {
+	WslDistributionMetadata *WSLDistributionMetadata `protobuf:"bytes,58,opt,name=wsl_distribution_metadata,json=wslDistributionMetadata,proto3,oneof"`
 }
 
-func (x *PACMANPackageMetadata) GetPackageDependencies() string {
-	if x != nil {
-		return x.PackageDependencies
-	}
-	return ""
+type Inventory_EcsTaskContainerMetadata struct {
+	EcsTaskContainerMetadata *ECSTaskContainerMetadata `protobuf:"bytes,59,opt,name=ecs_task_container_metadata,json=ecsTaskContainerMetadata,proto3,oneof"`
 }
 
-// The additional data found in .NET deps json packages.
-type DEPSJSONMetadata struct {
+type Inventory_KubeletPodMetadata struct {
+	KubeletPodMetadata *KubeletPodMetadata `protobuf:"bytes,60,opt,name=kubelet_pod_metadata,json=kubeletPodMetadata,proto3,oneof"`
+}
+
+type Inventory_PrivateCloudMetadata struct {
+	PrivateCloudMetadata *PrivateCloudMetadata `protobuf:"bytes,61,opt,name=private_cloud_metadata,json=privateCloudMetadata,proto3,oneof"`
+}
+
+type Inventory_CmsExtensionMetadata struct {
+	CmsExtensionMetadata *CMSExtensionMetadata `protobuf:"bytes,62,opt,name=cms_extension_metadata,json=cmsExtensionMetadata,proto3,oneof"`
+}
+
+type Inventory_VendorLibraryMetadata struct {
+	VendorLibraryMetadata *VendorLibraryMetadata `protobuf:"bytes,63,opt,name=vendor_library_metadata,json=vendorLibraryMetadata,proto3,oneof"`
+}
+
+type Inventory_BazelModuleMetadata struct {
+	BazelModuleMetadata *BazelModuleMetadata `protobuf:"bytes,64,opt,name=bazel_module_metadata,json=bazelModuleMetadata,proto3,oneof"`
+}
+
+type Inventory_KerberosMetadata struct {
+	KerberosMetadata *KerberosMetadata `protobuf:"bytes,65,opt,name=kerberos_metadata,json=kerberosMetadata,proto3,oneof"`
+}
+
+type Inventory_PearPackageMetadata struct {
+	PearPackageMetadata *PEARPackageMetadata `protobuf:"bytes,66,opt,name=pear_package_metadata,json=pearPackageMetadata,proto3,oneof"`
+}
+
+type Inventory_PerlModuleMetadata struct {
+	PerlModuleMetadata *PerlModuleMetadata `protobuf:"bytes,67,opt,name=perl_module_metadata,json=perlModuleMetadata,proto3,oneof"`
+}
+
+type Inventory_SynologyPackageMetadata struct {
+	SynologyPackageMetadata *SynologyPackageMetadata `protobuf:"bytes,68,opt,name=synology_package_metadata,json=synologyPackageMetadata,proto3,oneof"`
+}
+
+type Inventory_QnapPackageMetadata struct {
+	QnapPackageMetadata *QNAPPackageMetadata `protobuf:"bytes,69,opt,name=qnap_package_metadata,json=qnapPackageMetadata,proto3,oneof"`
+}
+
+type Inventory_GoBinaryMetadata struct {
+	GoBinaryMetadata *GoBinaryMetadata `protobuf:"bytes,73,opt,name=go_binary_metadata,json=goBinaryMetadata,proto3,oneof"`
+}
+
+type Inventory_VendoredCodeMetadata struct {
+	VendoredCodeMetadata *VendoredCodeMetadata `protobuf:"bytes,74,opt,name=vendored_code_metadata,json=vendoredCodeMetadata,proto3,oneof"`
+}
+
+type Inventory_ComposerPackageMetadata struct {
+	ComposerPackageMetadata *ComposerPackageMetadata `protobuf:"bytes,75,opt,name=composer_package_metadata,json=composerPackageMetadata,proto3,oneof"`
+}
+
+func (*Inventory_PythonMetadata) isInventory_Metadata() {}
+
+func (*Inventory_JavascriptMetadata) isInventory_Metadata() {}
+
+func (*Inventory_ElectronAppMetadata) isInventory_Metadata() {}
+
+func (*Inventory_ApkMetadata) isInventory_Metadata() {}
+
+func (*Inventory_DpkgMetadata) isInventory_Metadata() {}
+
+func (*Inventory_RpmMetadata) isInventory_Metadata() {}
+
+func (*Inventory_CosMetadata) isInventory_Metadata() {}
+
+func (*Inventory_DepsjsonMetadata) isInventory_Metadata() {}
+
+func (*Inventory_SpdxMetadata) isInventory_Metadata() {}
+
+func (*Inventory_JavaArchiveMetadata) isInventory_Metadata() {}
+
+func (*Inventory_JavaLockfileMetadata) isInventory_Metadata() {}
+
+func (*Inventory_JavaAppServerMetadata) isInventory_Metadata() {}
+
+func (*Inventory_JavaRuntimeMetadata) isInventory_Metadata() {}
+
+func (*Inventory_PacmanMetadata) isInventory_Metadata() {}
+
+func (*Inventory_ModuleMetadata) isInventory_Metadata() {}
+
+func (*Inventory_PortageMetadata) isInventory_Metadata() {}
+
+func (*Inventory_OsvMetadata) isInventory_Metadata() {}
+
+func (*Inventory_PythonRequirementsMetadata) isInventory_Metadata() {}
+
+func (*Inventory_ContainerdContainerMetadata) isInventory_Metadata() {}
+
+func (*Inventory_SnapMetadata) isInventory_Metadata() {}
+
+func (*Inventory_FlatpakMetadata) isInventory_Metadata() {}
+
+func (*Inventory_MacAppsMetadata) isInventory_Metadata() {}
+
+func (*Inventory_ContainerdRuntimeContainerMetadata) isInventory_Metadata() {}
+
+func (*Inventory_CdxMetadata) isInventory_Metadata() {}
+
+func (*Inventory_WindowsOsVersionMetadata) isInventory_Metadata() {}
+
+func (*Inventory_WindowsSecurityProductMetadata) isInventory_Metadata() {}
+
+func (*Inventory_LogPipelineMetadata) isInventory_Metadata() {}
+
+func (*Inventory_StorageClusterMetadata) isInventory_Metadata() {}
+
+func (*Inventory_AppBundleMetadata) isInventory_Metadata() {}
+
+func (*Inventory_IacSecretsMetadata) isInventory_Metadata() {}
+
+func (*Inventory_DatabaseServerMetadata) isInventory_Metadata() {}
+
+func (*Inventory_RegistryConfigMetadata) isInventory_Metadata() {}
+
+func (*Inventory_EdgeProxyMetadata) isInventory_Metadata() {}
+
+func (*Inventory_ActiveDirectoryMetadata) isInventory_Metadata() {}
+
+func (*Inventory_AndroidMetadata) isInventory_Metadata() {}
+
+func (*Inventory_AppSupervisorMetadata) isInventory_Metadata() {}
+
+func (*Inventory_KernelModuleMetadata) isInventory_Metadata() {}
+
+func (*Inventory_WslDistributionMetadata) isInventory_Metadata() {}
+
+func (*Inventory_EcsTaskContainerMetadata) isInventory_Metadata() {}
+
+func (*Inventory_KubeletPodMetadata) isInventory_Metadata() {}
+
+func (*Inventory_PrivateCloudMetadata) isInventory_Metadata() {}
+
+func (*Inventory_CmsExtensionMetadata) isInventory_Metadata() {}
+
+func (*Inventory_VendorLibraryMetadata) isInventory_Metadata() {}
+
+func (*Inventory_BazelModuleMetadata) isInventory_Metadata() {}
+
+func (*Inventory_KerberosMetadata) isInventory_Metadata() {}
+
+func (*Inventory_PearPackageMetadata) isInventory_Metadata() {}
+
+func (*Inventory_PerlModuleMetadata) isInventory_Metadata() {}
+
+func (*Inventory_SynologyPackageMetadata) isInventory_Metadata() {}
+
+func (*Inventory_QnapPackageMetadata) isInventory_Metadata() {}
+
+func (*Inventory_GoBinaryMetadata) isInventory_Metadata() {}
+
+func (*Inventory_VendoredCodeMetadata) isInventory_Metadata() {}
+
+func (*Inventory_ComposerPackageMetadata) isInventory_Metadata() {}
+
+// A dependency of a package found in a lockfile.
+type Dependency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageName    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	PackageVersion string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
-	Type           string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// The instance_id of the Inventory the dependency resolved to. Empty if the
+	// package isn't part of the scan result, and in streamed scan results.
+	InstanceId string `protobuf:"bytes,3,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
 }
 
-func (x *DEPSJSONMetadata) Reset() {
-	*x = DEPSJSONMetadata{}
+func (x *Dependency) Reset() {
+	*x = Dependency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[21]
+		mi := &file_proto_scan_result_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DEPSJSONMetadata) String() string {
+func (x *Dependency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DEPSJSONMetadata) ProtoMessage() {}
+func (*Dependency) ProtoMessage() {}
 
-func (x *DEPSJSONMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[21]
+func (x *Dependency) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,65 +2741,59 @@ func (x *DEPSJSONMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DEPSJSONMetadata.ProtoReflect.Descriptor instead.
-func (*DEPSJSONMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use Dependency.ProtoReflect.Descriptor instead.
+func (*Dependency) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DEPSJSONMetadata) GetPackageName() string {
+func (x *Dependency) GetName() string {
 	if x != nil {
-		return x.PackageName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DEPSJSONMetadata) GetPackageVersion() string {
+func (x *Dependency) GetVersion() string {
 	if x != nil {
-		return x.PackageVersion
+		return x.Version
 	}
 	return ""
 }
 
-func (x *DEPSJSONMetadata) GetType() string {
+func (x *Dependency) GetInstanceId() string {
 	if x != nil {
-		return x.Type
+		return x.InstanceId
 	}
 	return ""
 }
 
-// The additional data found in SNAP packages.
-type SNAPPackageMetadata struct {
+// Additional identifiers for source code software packages (e.g. NPM).
+type SourceCodeIdentifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name              string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version           string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Grade             string   `protobuf:"bytes,3,opt,name=grade,proto3" json:"grade,omitempty"`
-	Type              string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Architectures     []string `protobuf:"bytes,5,rep,name=architectures,proto3" json:"architectures,omitempty"`
-	OsId              string   `protobuf:"bytes,6,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionCodename string   `protobuf:"bytes,7,opt,name=os_version_codename,json=osVersionCodename,proto3" json:"os_version_codename,omitempty"`
-	OsVersionId       string   `protobuf:"bytes,8,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	Repo   string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
 }
 
-func (x *SNAPPackageMetadata) Reset() {
-	*x = SNAPPackageMetadata{}
+func (x *SourceCodeIdentifier) Reset() {
+	*x = SourceCodeIdentifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[22]
+		mi := &file_proto_scan_result_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SNAPPackageMetadata) String() string {
+func (x *SourceCodeIdentifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SNAPPackageMetadata) ProtoMessage() {}
+func (*SourceCodeIdentifier) ProtoMessage() {}
 
-func (x *SNAPPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[22]
+func (x *SourceCodeIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2339,96 +2804,58 @@ func (x *SNAPPackageMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SNAPPackageMetadata.ProtoReflect.Descriptor instead.
-func (*SNAPPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *SNAPPackageMetadata) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *SNAPPackageMetadata) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *SNAPPackageMetadata) GetGrade() string {
-	if x != nil {
-		return x.Grade
-	}
-	return ""
-}
-
-func (x *SNAPPackageMetadata) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *SNAPPackageMetadata) GetArchitectures() []string {
-	if x != nil {
-		return x.Architectures
-	}
-	return nil
-}
-
-func (x *SNAPPackageMetadata) GetOsId() string {
-	if x != nil {
-		return x.OsId
-	}
-	return ""
+// Deprecated: Use SourceCodeIdentifier.ProtoReflect.Descriptor instead.
+func (*SourceCodeIdentifier) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SNAPPackageMetadata) GetOsVersionCodename() string {
+func (x *SourceCodeIdentifier) GetRepo() string {
 	if x != nil {
-		return x.OsVersionCodename
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *SNAPPackageMetadata) GetOsVersionId() string {
+func (x *SourceCodeIdentifier) GetCommit() string {
 	if x != nil {
-		return x.OsVersionId
+		return x.Commit
 	}
 	return ""
 }
 
-// The additional data found in portage packages.
-type PortagePackageMetadata struct {
+// Details about the layer a package was found in.
+type LayerDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageName    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	PackageVersion string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
-	OsId           string `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionId    string `protobuf:"bytes,4,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	Index       int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	DiffId      string `protobuf:"bytes,2,opt,name=diff_id,json=diffId,proto3" json:"diff_id,omitempty"`
+	Command     string `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	InBaseImage bool   `protobuf:"varint,4,opt,name=in_base_image,json=inBaseImage,proto3" json:"in_base_image,omitempty"`
+	ChainId     string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Name of the known base image the layer belongs to, if the image was
+	// matched against a base image catalog.
+	BaseImage string `protobuf:"bytes,6,opt,name=base_image,json=baseImage,proto3" json:"base_image,omitempty"`
 }
 
-func (x *PortagePackageMetadata) Reset() {
-	*x = PortagePackageMetadata{}
+func (x *LayerDetails) Reset() {
+	*x = LayerDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[23]
+		mi := &file_proto_scan_result_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PortagePackageMetadata) String() string {
+func (x *LayerDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortagePackageMetadata) ProtoMessage() {}
+func (*LayerDetails) ProtoMessage() {}
 
-func (x *PortagePackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[23]
+func (x *LayerDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2439,73 +2866,92 @@ func (x *PortagePackageMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortagePackageMetadata.ProtoReflect.Descriptor instead.
-func (*PortagePackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use LayerDetails.ProtoReflect.Descriptor instead.
+func (*LayerDetails) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PortagePackageMetadata) GetPackageName() string {
+func (x *LayerDetails) GetIndex() int32 {
 	if x != nil {
-		return x.PackageName
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LayerDetails) GetDiffId() string {
+	if x != nil {
+		return x.DiffId
 	}
 	return ""
 }
 
-func (x *PortagePackageMetadata) GetPackageVersion() string {
+func (x *LayerDetails) GetCommand() string {
 	if x != nil {
-		return x.PackageVersion
+		return x.Command
 	}
 	return ""
 }
 
-func (x *PortagePackageMetadata) GetOsId() string {
+func (x *LayerDetails) GetInBaseImage() bool {
 	if x != nil {
-		return x.OsId
+		return x.InBaseImage
+	}
+	return false
+}
+
+func (x *LayerDetails) GetChainId() string {
+	if x != nil {
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *PortagePackageMetadata) GetOsVersionId() string {
+func (x *LayerDetails) GetBaseImage() string {
 	if x != nil {
-		return x.OsVersionId
+		return x.BaseImage
 	}
 	return ""
 }
 
-// The additional data found in Flatpak packages.
-type FlatpakPackageMetadata struct {
+// Package URL, see https://github.com/package-url/purl-spec
+type Purl struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageName    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	PackageId      string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	PackageVersion string `protobuf:"bytes,3,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
-	ReleaseDate    string `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	OsName         string `protobuf:"bytes,5,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
-	OsId           string `protobuf:"bytes,6,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionId    string `protobuf:"bytes,7,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
-	OsBuildId      string `protobuf:"bytes,8,opt,name=os_build_id,json=osBuildId,proto3" json:"os_build_id,omitempty"`
-	Developer      string `protobuf:"bytes,9,opt,name=developer,proto3" json:"developer,omitempty"`
+	// String representation.
+	Purl string `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	// Package type, e.g. "maven, npm, pypi".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Package name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Package version.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// Name prefix such as a Maven groupid, or Docker image owner.
+	Namespace string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Extra qualifying data for a package such as an OS, architecture, etc.
+	Qualifiers []*Qualifier `protobuf:"bytes,6,rep,name=qualifiers,proto3" json:"qualifiers,omitempty"`
+	// Extra subpath within a package, relative to the package root.
+	Subpath string `protobuf:"bytes,7,opt,name=subpath,proto3" json:"subpath,omitempty"`
 }
 
-func (x *FlatpakPackageMetadata) Reset() {
-	*x = FlatpakPackageMetadata{}
+func (x *Purl) Reset() {
+	*x = Purl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[24]
+		mi := &file_proto_scan_result_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FlatpakPackageMetadata) String() string {
+func (x *Purl) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlatpakPackageMetadata) ProtoMessage() {}
+func (*Purl) ProtoMessage() {}
 
-func (x *FlatpakPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[24]
+func (x *Purl) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,107 +2962,86 @@ func (x *FlatpakPackageMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlatpakPackageMetadata.ProtoReflect.Descriptor instead.
-func (*FlatpakPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *FlatpakPackageMetadata) GetPackageName() string {
-	if x != nil {
-		return x.PackageName
-	}
-	return ""
-}
-
-func (x *FlatpakPackageMetadata) GetPackageId() string {
-	if x != nil {
-		return x.PackageId
-	}
-	return ""
+// Deprecated: Use Purl.ProtoReflect.Descriptor instead.
+func (*Purl) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FlatpakPackageMetadata) GetPackageVersion() string {
+func (x *Purl) GetPurl() string {
 	if x != nil {
-		return x.PackageVersion
+		return x.Purl
 	}
 	return ""
 }
 
-func (x *FlatpakPackageMetadata) GetReleaseDate() string {
+func (x *Purl) GetType() string {
 	if x != nil {
-		return x.ReleaseDate
+		return x.Type
 	}
 	return ""
 }
 
-func (x *FlatpakPackageMetadata) GetOsName() string {
+func (x *Purl) GetName() string {
 	if x != nil {
-		return x.OsName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *FlatpakPackageMetadata) GetOsId() string {
+func (x *Purl) GetVersion() string {
 	if x != nil {
-		return x.OsId
+		return x.Version
 	}
 	return ""
 }
 
-func (x *FlatpakPackageMetadata) GetOsVersionId() string {
+func (x *Purl) GetNamespace() string {
 	if x != nil {
-		return x.OsVersionId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *FlatpakPackageMetadata) GetOsBuildId() string {
+func (x *Purl) GetQualifiers() []*Qualifier {
 	if x != nil {
-		return x.OsBuildId
+		return x.Qualifiers
 	}
-	return ""
+	return nil
 }
 
-func (x *FlatpakPackageMetadata) GetDeveloper() string {
+func (x *Purl) GetSubpath() string {
 	if x != nil {
-		return x.Developer
+		return x.Subpath
 	}
 	return ""
 }
 
-// The additional data found in MODULE packages.
-type ModuleMetadata struct {
+type Qualifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageName                    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
-	PackageVersion                 string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
-	PackageVermagic                string `protobuf:"bytes,3,opt,name=package_vermagic,json=packageVermagic,proto3" json:"package_vermagic,omitempty"`
-	PackageSourceVersionIdentifier string `protobuf:"bytes,4,opt,name=package_source_version_identifier,json=packageSourceVersionIdentifier,proto3" json:"package_source_version_identifier,omitempty"`
-	OsId                           string `protobuf:"bytes,5,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
-	OsVersionCodename              string `protobuf:"bytes,6,opt,name=os_version_codename,json=osVersionCodename,proto3" json:"os_version_codename,omitempty"`
-	OsVersionId                    string `protobuf:"bytes,7,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
-	PackageAuthor                  string `protobuf:"bytes,8,opt,name=package_author,json=packageAuthor,proto3" json:"package_author,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ModuleMetadata) Reset() {
-	*x = ModuleMetadata{}
+func (x *Qualifier) Reset() {
+	*x = Qualifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[25]
+		mi := &file_proto_scan_result_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ModuleMetadata) String() string {
+func (x *Qualifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModuleMetadata) ProtoMessage() {}
+func (*Qualifier) ProtoMessage() {}
 
-func (x *ModuleMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[25]
+func (x *Qualifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2627,102 +3052,141 @@ func (x *ModuleMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModuleMetadata.ProtoReflect.Descriptor instead.
-func (*ModuleMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Qualifier.ProtoReflect.Descriptor instead.
+func (*Qualifier) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ModuleMetadata) GetPackageName() string {
+func (x *Qualifier) GetKey() string {
 	if x != nil {
-		return x.PackageName
+		return x.Key
 	}
 	return ""
 }
 
-func (x *ModuleMetadata) GetPackageVersion() string {
+func (x *Qualifier) GetValue() string {
 	if x != nil {
-		return x.PackageVersion
+		return x.Value
 	}
 	return ""
 }
 
-func (x *ModuleMetadata) GetPackageVermagic() string {
-	if x != nil {
-		return x.PackageVermagic
+// A security finding found by a detector. It could describe things like a CVE
+// or a CIS non-compliance.
+type Finding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Info specific to the finding. Should always be the same for the same type
+	// of finding.
+	Adv *Advisory `protobuf:"bytes,1,opt,name=adv,proto3" json:"adv,omitempty"`
+	// Instance-specific info such as location of the vulnerable files.
+	Target *TargetDetails `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Additional free-text info.
+	Extra string `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+	// The name of the Detectors that found this finding. Set by the core library.
+	Detectors []string `protobuf:"bytes,4,rep,name=detectors,proto3" json:"detectors,omitempty"`
+}
+
+func (x *Finding) Reset() {
+	*x = Finding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ModuleMetadata) GetPackageSourceVersionIdentifier() string {
-	if x != nil {
-		return x.PackageSourceVersionIdentifier
+func (x *Finding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Finding) ProtoMessage() {}
+
+func (x *Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ModuleMetadata) GetOsId() string {
+// Deprecated: Use Finding.ProtoReflect.Descriptor instead.
+func (*Finding) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Finding) GetAdv() *Advisory {
 	if x != nil {
-		return x.OsId
+		return x.Adv
 	}
-	return ""
+	return nil
 }
 
-func (x *ModuleMetadata) GetOsVersionCodename() string {
+func (x *Finding) GetTarget() *TargetDetails {
 	if x != nil {
-		return x.OsVersionCodename
+		return x.Target
 	}
-	return ""
+	return nil
 }
 
-func (x *ModuleMetadata) GetOsVersionId() string {
+func (x *Finding) GetExtra() string {
 	if x != nil {
-		return x.OsVersionId
+		return x.Extra
 	}
 	return ""
 }
 
-func (x *ModuleMetadata) GetPackageAuthor() string {
+func (x *Finding) GetDetectors() []string {
 	if x != nil {
-		return x.PackageAuthor
+		return x.Detectors
 	}
-	return ""
+	return nil
 }
 
-// The additional data found in Mac Applications.
-type MacAppsMetadata struct {
+type Advisory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BundleDisplayName        string `protobuf:"bytes,1,opt,name=bundle_display_name,json=bundleDisplayName,proto3" json:"bundle_display_name,omitempty"`
-	BundleIdentifier         string `protobuf:"bytes,2,opt,name=bundle_identifier,json=bundleIdentifier,proto3" json:"bundle_identifier,omitempty"`
-	BundleShortVersionString string `protobuf:"bytes,3,opt,name=bundle_short_version_string,json=bundleShortVersionString,proto3" json:"bundle_short_version_string,omitempty"`
-	BundleExecutable         string `protobuf:"bytes,4,opt,name=bundle_executable,json=bundleExecutable,proto3" json:"bundle_executable,omitempty"`
-	BundleName               string `protobuf:"bytes,5,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
-	BundlePackageType        string `protobuf:"bytes,6,opt,name=bundle_package_type,json=bundlePackageType,proto3" json:"bundle_package_type,omitempty"`
-	BundleSignature          string `protobuf:"bytes,7,opt,name=bundle_signature,json=bundleSignature,proto3" json:"bundle_signature,omitempty"`
-	BundleVersion            string `protobuf:"bytes,8,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`
-	ProductId                string `protobuf:"bytes,9,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	UpdateUrl                string `protobuf:"bytes,10,opt,name=update_url,json=updateUrl,proto3" json:"update_url,omitempty"`
+	// A unique ID for the finding.
+	Id          *AdvisoryId       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type        Advisory_TypeEnum `protobuf:"varint,2,opt,name=type,proto3,enum=scalibr.Advisory_TypeEnum" json:"type,omitempty"`
+	Title       string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string            `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Remediation instructions, e.g. "update to latest version".
+	Recommendation string    `protobuf:"bytes,5,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	Sev            *Severity `protobuf:"bytes,6,opt,name=sev,proto3" json:"sev,omitempty"`
+	// Key of the title, description and recommendation in message catalogs,
+	// set for advisories with fixed texts, e.g. "cve-2024-2912".
+	MessageKey string `protobuf:"bytes,7,opt,name=message_key,json=messageKey,proto3" json:"message_key,omitempty"`
+	// Locale the title, description and recommendation were translated to,
+	// e.g. "de". Empty for the English defaults.
+	Locale string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *MacAppsMetadata) Reset() {
-	*x = MacAppsMetadata{}
+func (x *Advisory) Reset() {
+	*x = Advisory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[26]
+		mi := &file_proto_scan_result_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MacAppsMetadata) String() string {
+func (x *Advisory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MacAppsMetadata) ProtoMessage() {}
+func (*Advisory) ProtoMessage() {}
 
-func (x *MacAppsMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[26]
+func (x *Advisory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,108 +3197,94 @@ func (x *MacAppsMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MacAppsMetadata.ProtoReflect.Descriptor instead.
-func (*MacAppsMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *MacAppsMetadata) GetBundleDisplayName() string {
-	if x != nil {
-		return x.BundleDisplayName
-	}
-	return ""
-}
-
-func (x *MacAppsMetadata) GetBundleIdentifier() string {
-	if x != nil {
-		return x.BundleIdentifier
-	}
-	return ""
+// Deprecated: Use Advisory.ProtoReflect.Descriptor instead.
+func (*Advisory) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MacAppsMetadata) GetBundleShortVersionString() string {
+func (x *Advisory) GetId() *AdvisoryId {
 	if x != nil {
-		return x.BundleShortVersionString
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *MacAppsMetadata) GetBundleExecutable() string {
+func (x *Advisory) GetType() Advisory_TypeEnum {
 	if x != nil {
-		return x.BundleExecutable
+		return x.Type
 	}
-	return ""
+	return Advisory_UNKNOWN
 }
 
-func (x *MacAppsMetadata) GetBundleName() string {
+func (x *Advisory) GetTitle() string {
 	if x != nil {
-		return x.BundleName
+		return x.Title
 	}
 	return ""
 }
 
-func (x *MacAppsMetadata) GetBundlePackageType() string {
+func (x *Advisory) GetDescription() string {
 	if x != nil {
-		return x.BundlePackageType
+		return x.Description
 	}
 	return ""
 }
 
-func (x *MacAppsMetadata) GetBundleSignature() string {
+func (x *Advisory) GetRecommendation() string {
 	if x != nil {
-		return x.BundleSignature
+		return x.Recommendation
 	}
 	return ""
 }
 
-func (x *MacAppsMetadata) GetBundleVersion() string {
+func (x *Advisory) GetSev() *Severity {
 	if x != nil {
-		return x.BundleVersion
+		return x.Sev
 	}
-	return ""
+	return nil
 }
 
-func (x *MacAppsMetadata) GetProductId() string {
+func (x *Advisory) GetMessageKey() string {
 	if x != nil {
-		return x.ProductId
+		return x.MessageKey
 	}
 	return ""
 }
 
-func (x *MacAppsMetadata) GetUpdateUrl() string {
+func (x *Advisory) GetLocale() string {
 	if x != nil {
-		return x.UpdateUrl
+		return x.Locale
 	}
 	return ""
 }
 
-// The additional data for packages extracted from SPDX files.
-type SPDXPackageMetadata struct {
+// A unique identifier per advisory.
+type AdvisoryId struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Purl *Purl    `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
-	Cpes []string `protobuf:"bytes,2,rep,name=cpes,proto3" json:"cpes,omitempty"`
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"` // e.g. "CVE".
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"` // e.g. "CVE-2023-1234".
 }
 
-func (x *SPDXPackageMetadata) Reset() {
-	*x = SPDXPackageMetadata{}
+func (x *AdvisoryId) Reset() {
+	*x = AdvisoryId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[27]
+		mi := &file_proto_scan_result_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SPDXPackageMetadata) String() string {
+func (x *AdvisoryId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SPDXPackageMetadata) ProtoMessage() {}
+func (*AdvisoryId) ProtoMessage() {}
 
-func (x *SPDXPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[27]
+func (x *AdvisoryId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2845,52 +3295,54 @@ func (x *SPDXPackageMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SPDXPackageMetadata.ProtoReflect.Descriptor instead.
-func (*SPDXPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AdvisoryId.ProtoReflect.Descriptor instead.
+func (*AdvisoryId) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SPDXPackageMetadata) GetPurl() *Purl {
+func (x *AdvisoryId) GetPublisher() string {
 	if x != nil {
-		return x.Purl
+		return x.Publisher
 	}
-	return nil
+	return ""
 }
 
-func (x *SPDXPackageMetadata) GetCpes() []string {
+func (x *AdvisoryId) GetReference() string {
 	if x != nil {
-		return x.Cpes
+		return x.Reference
 	}
-	return nil
+	return ""
 }
 
-// The additional data for packages extracted from CDX files.
-type CDXPackageMetadata struct {
+type Severity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Purl *Purl    `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
-	Cpes []string `protobuf:"bytes,2,rep,name=cpes,proto3" json:"cpes,omitempty"`
+	// Required severity enum. Can be used for e.g. prioritizing filed bugs.
+	Severity Severity_SeverityEnum `protobuf:"varint,1,opt,name=severity,proto3,enum=scalibr.Severity_SeverityEnum" json:"severity,omitempty"`
+	// Optional CVSS scores, only set for vulns with CVEs.
+	CvssV2 *CVSS `protobuf:"bytes,2,opt,name=cvss_v2,json=cvssV2,proto3" json:"cvss_v2,omitempty"`
+	CvssV3 *CVSS `protobuf:"bytes,3,opt,name=cvss_v3,json=cvssV3,proto3" json:"cvss_v3,omitempty"`
 }
 
-func (x *CDXPackageMetadata) Reset() {
-	*x = CDXPackageMetadata{}
+func (x *Severity) Reset() {
+	*x = Severity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[28]
+		mi := &file_proto_scan_result_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CDXPackageMetadata) String() string {
+func (x *Severity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CDXPackageMetadata) ProtoMessage() {}
+func (*Severity) ProtoMessage() {}
 
-func (x *CDXPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[28]
+func (x *Severity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2901,53 +3353,59 @@ func (x *CDXPackageMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CDXPackageMetadata.ProtoReflect.Descriptor instead.
-func (*CDXPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use Severity.ProtoReflect.Descriptor instead.
+func (*Severity) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CDXPackageMetadata) GetPurl() *Purl {
+func (x *Severity) GetSeverity() Severity_SeverityEnum {
 	if x != nil {
-		return x.Purl
+		return x.Severity
+	}
+	return Severity_UNSPECIFIED
+}
+
+func (x *Severity) GetCvssV2() *CVSS {
+	if x != nil {
+		return x.CvssV2
 	}
 	return nil
 }
 
-func (x *CDXPackageMetadata) GetCpes() []string {
+func (x *Severity) GetCvssV3() *CVSS {
 	if x != nil {
-		return x.Cpes
+		return x.CvssV3
 	}
 	return nil
 }
 
-// The additional data found in Java JAR packages.
-type JavaArchiveMetadata struct {
+type CVSS struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArtifactId string `protobuf:"bytes,2,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	GroupId    string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Sha1       string `protobuf:"bytes,4,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	BaseScore          float32 `protobuf:"fixed32,1,opt,name=base_score,json=baseScore,proto3" json:"base_score,omitempty"`
+	TemporalScore      float32 `protobuf:"fixed32,2,opt,name=temporal_score,json=temporalScore,proto3" json:"temporal_score,omitempty"`
+	EnvironmentalScore float32 `protobuf:"fixed32,3,opt,name=environmental_score,json=environmentalScore,proto3" json:"environmental_score,omitempty"`
 }
 
-func (x *JavaArchiveMetadata) Reset() {
-	*x = JavaArchiveMetadata{}
+func (x *CVSS) Reset() {
+	*x = CVSS{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[29]
+		mi := &file_proto_scan_result_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JavaArchiveMetadata) String() string {
+func (x *CVSS) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JavaArchiveMetadata) ProtoMessage() {}
+func (*CVSS) ProtoMessage() {}
 
-func (x *JavaArchiveMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[29]
+func (x *CVSS) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2958,60 +3416,61 @@ func (x *JavaArchiveMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JavaArchiveMetadata.ProtoReflect.Descriptor instead.
-func (*JavaArchiveMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CVSS.ProtoReflect.Descriptor instead.
+func (*CVSS) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *JavaArchiveMetadata) GetArtifactId() string {
+func (x *CVSS) GetBaseScore() float32 {
 	if x != nil {
-		return x.ArtifactId
+		return x.BaseScore
 	}
-	return ""
+	return 0
 }
 
-func (x *JavaArchiveMetadata) GetGroupId() string {
+func (x *CVSS) GetTemporalScore() float32 {
 	if x != nil {
-		return x.GroupId
+		return x.TemporalScore
 	}
-	return ""
+	return 0
 }
 
-func (x *JavaArchiveMetadata) GetSha1() string {
+func (x *CVSS) GetEnvironmentalScore() float32 {
 	if x != nil {
-		return x.Sha1
+		return x.EnvironmentalScore
 	}
-	return ""
+	return 0
 }
 
-// The additional data found in Java lockfiles.
-type JavaLockfileMetadata struct {
+type TargetDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArtifactId   string   `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	GroupId      string   `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	DepGroupVals []string `protobuf:"bytes,3,rep,name=dep_group_vals,json=depGroupVals,proto3" json:"dep_group_vals,omitempty"`
+	// The software affected by the finding.
+	Inventory *Inventory `protobuf:"bytes,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	// Location of vulnerable files not related to the inventory,
+	// e.g. config files with misconfigurations.
+	Location []string `protobuf:"bytes,3,rep,name=location,proto3" json:"location,omitempty"`
 }
 
-func (x *JavaLockfileMetadata) Reset() {
-	*x = JavaLockfileMetadata{}
+func (x *TargetDetails) Reset() {
+	*x = TargetDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[30]
+		mi := &file_proto_scan_result_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JavaLockfileMetadata) String() string {
+func (x *TargetDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JavaLockfileMetadata) ProtoMessage() {}
+func (*TargetDetails) ProtoMessage() {}
 
-func (x *JavaLockfileMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[30]
+func (x *TargetDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3022,61 +3481,54 @@ func (x *JavaLockfileMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JavaLockfileMetadata.ProtoReflect.Descriptor instead.
-func (*JavaLockfileMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *JavaLockfileMetadata) GetArtifactId() string {
-	if x != nil {
-		return x.ArtifactId
-	}
-	return ""
+// Deprecated: Use TargetDetails.ProtoReflect.Descriptor instead.
+func (*TargetDetails) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *JavaLockfileMetadata) GetGroupId() string {
+func (x *TargetDetails) GetInventory() *Inventory {
 	if x != nil {
-		return x.GroupId
+		return x.Inventory
 	}
-	return ""
+	return nil
 }
 
-func (x *JavaLockfileMetadata) GetDepGroupVals() []string {
+func (x *TargetDetails) GetLocation() []string {
 	if x != nil {
-		return x.DepGroupVals
+		return x.Location
 	}
 	return nil
 }
 
-// The additional data for packages extracted by an OSV extractor wrapper.
-type OSVPackageMetadata struct {
+// The additional data found in python packages.
+type PythonPackageMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PurlType  string `protobuf:"bytes,1,opt,name=purl_type,json=purlType,proto3" json:"purl_type,omitempty"`
-	Commit    string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
-	Ecosystem string `protobuf:"bytes,3,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
-	CompareAs string `protobuf:"bytes,4,opt,name=compare_as,json=compareAs,proto3" json:"compare_as,omitempty"`
+	Author      string `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorEmail string `protobuf:"bytes,2,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	// The Python version the package is installed for, e.g. "2.7".
+	PythonVersion string `protobuf:"bytes,3,opt,name=python_version,json=pythonVersion,proto3" json:"python_version,omitempty"`
 }
 
-func (x *OSVPackageMetadata) Reset() {
-	*x = OSVPackageMetadata{}
+func (x *PythonPackageMetadata) Reset() {
+	*x = PythonPackageMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[31]
+		mi := &file_proto_scan_result_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OSVPackageMetadata) String() string {
+func (x *PythonPackageMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OSVPackageMetadata) ProtoMessage() {}
+func (*PythonPackageMetadata) ProtoMessage() {}
 
-func (x *OSVPackageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[31]
+func (x *PythonPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3087,65 +3539,60 @@ func (x *OSVPackageMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OSVPackageMetadata.ProtoReflect.Descriptor instead.
-func (*OSVPackageMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *OSVPackageMetadata) GetPurlType() string {
-	if x != nil {
-		return x.PurlType
-	}
-	return ""
+// Deprecated: Use PythonPackageMetadata.ProtoReflect.Descriptor instead.
+func (*PythonPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *OSVPackageMetadata) GetCommit() string {
+func (x *PythonPackageMetadata) GetAuthor() string {
 	if x != nil {
-		return x.Commit
+		return x.Author
 	}
 	return ""
 }
 
-func (x *OSVPackageMetadata) GetEcosystem() string {
+func (x *PythonPackageMetadata) GetAuthorEmail() string {
 	if x != nil {
-		return x.Ecosystem
+		return x.AuthorEmail
 	}
 	return ""
 }
 
-func (x *OSVPackageMetadata) GetCompareAs() string {
+func (x *PythonPackageMetadata) GetPythonVersion() string {
 	if x != nil {
-		return x.CompareAs
+		return x.PythonVersion
 	}
 	return ""
 }
 
-type PythonRequirementsMetadata struct {
+// The additional data found in npm packages.
+type JavascriptPackageJSONMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HashCheckingModeValues []string `protobuf:"bytes,1,rep,name=hash_checking_mode_values,json=hashCheckingModeValues,proto3" json:"hash_checking_mode_values,omitempty"`
-	VersionComparator      string   `protobuf:"bytes,2,opt,name=version_comparator,json=versionComparator,proto3" json:"version_comparator,omitempty"`
+	Author       string   `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Maintainers  []string `protobuf:"bytes,2,rep,name=maintainers,proto3" json:"maintainers,omitempty"`
+	Contributors []string `protobuf:"bytes,3,rep,name=contributors,proto3" json:"contributors,omitempty"`
 }
 
-func (x *PythonRequirementsMetadata) Reset() {
-	*x = PythonRequirementsMetadata{}
+func (x *JavascriptPackageJSONMetadata) Reset() {
+	*x = JavascriptPackageJSONMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[32]
+		mi := &file_proto_scan_result_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PythonRequirementsMetadata) String() string {
+func (x *JavascriptPackageJSONMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PythonRequirementsMetadata) ProtoMessage() {}
+func (*JavascriptPackageJSONMetadata) ProtoMessage() {}
 
-func (x *PythonRequirementsMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[32]
+func (x *JavascriptPackageJSONMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,60 +3603,61 @@ func (x *PythonRequirementsMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PythonRequirementsMetadata.ProtoReflect.Descriptor instead.
-func (*PythonRequirementsMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use JavascriptPackageJSONMetadata.ProtoReflect.Descriptor instead.
+func (*JavascriptPackageJSONMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PythonRequirementsMetadata) GetHashCheckingModeValues() []string {
+func (x *JavascriptPackageJSONMetadata) GetAuthor() string {
 	if x != nil {
-		return x.HashCheckingModeValues
+		return x.Author
+	}
+	return ""
+}
+
+func (x *JavascriptPackageJSONMetadata) GetMaintainers() []string {
+	if x != nil {
+		return x.Maintainers
 	}
 	return nil
 }
 
-func (x *PythonRequirementsMetadata) GetVersionComparator() string {
+func (x *JavascriptPackageJSONMetadata) GetContributors() []string {
 	if x != nil {
-		return x.VersionComparator
+		return x.Contributors
 	}
-	return ""
+	return nil
 }
 
-type ContainerdContainerMetadata struct {
+// The Electron app an npm package or the Electron runtime was found in.
+type ElectronAppMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NamespaceName string `protobuf:"bytes,1,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	ImageName     string `protobuf:"bytes,2,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
-	ImageDigest   string `protobuf:"bytes,3,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
-	Runtime       string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	Pid           int32  `protobuf:"varint,5,opt,name=pid,proto3" json:"pid,omitempty"`
-	Snapshotter   string `protobuf:"bytes,6,opt,name=snapshotter,proto3" json:"snapshotter,omitempty"`
-	SnapshotKey   string `protobuf:"bytes,7,opt,name=snapshot_key,json=snapshotKey,proto3" json:"snapshot_key,omitempty"`
-	LowerDir      string `protobuf:"bytes,8,opt,name=lower_dir,json=lowerDir,proto3" json:"lower_dir,omitempty"`
-	UpperDir      string `protobuf:"bytes,9,opt,name=upper_dir,json=upperDir,proto3" json:"upper_dir,omitempty"`
-	WorkDir       string `protobuf:"bytes,10,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
-	Id            string `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	AppName    string `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// Only set for the Electron runtime.
+	ChromiumVersion string `protobuf:"bytes,3,opt,name=chromium_version,json=chromiumVersion,proto3" json:"chromium_version,omitempty"`
 }
 
-func (x *ContainerdContainerMetadata) Reset() {
-	*x = ContainerdContainerMetadata{}
+func (x *ElectronAppMetadata) Reset() {
+	*x = ElectronAppMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[33]
+		mi := &file_proto_scan_result_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerdContainerMetadata) String() string {
+func (x *ElectronAppMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerdContainerMetadata) ProtoMessage() {}
+func (*ElectronAppMetadata) ProtoMessage() {}
 
-func (x *ContainerdContainerMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[33]
+func (x *ElectronAppMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3220,119 +3668,165 @@ func (x *ContainerdContainerMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerdContainerMetadata.ProtoReflect.Descriptor instead.
-func (*ContainerdContainerMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ElectronAppMetadata.ProtoReflect.Descriptor instead.
+func (*ElectronAppMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ContainerdContainerMetadata) GetNamespaceName() string {
+func (x *ElectronAppMetadata) GetAppName() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.AppName
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetImageName() string {
+func (x *ElectronAppMetadata) GetAppVersion() string {
 	if x != nil {
-		return x.ImageName
+		return x.AppVersion
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetImageDigest() string {
+func (x *ElectronAppMetadata) GetChromiumVersion() string {
 	if x != nil {
-		return x.ImageDigest
+		return x.ChromiumVersion
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetRuntime() string {
-	if x != nil {
-		return x.Runtime
-	}
-	return ""
-}
+// The additional data found in APK packages.
+type APKPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ContainerdContainerMetadata) GetPid() int32 {
-	if x != nil {
-		return x.Pid
-	}
-	return 0
+	PackageName  string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	OriginName   string `protobuf:"bytes,2,opt,name=origin_name,json=originName,proto3" json:"origin_name,omitempty"`
+	OsId         string `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionId  string `protobuf:"bytes,4,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	Maintainer   string `protobuf:"bytes,5,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	Architecture string `protobuf:"bytes,6,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	License      string `protobuf:"bytes,7,opt,name=license,proto3" json:"license,omitempty"`
 }
 
-func (x *ContainerdContainerMetadata) GetSnapshotter() string {
-	if x != nil {
-		return x.Snapshotter
+func (x *APKPackageMetadata) Reset() {
+	*x = APKPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APKPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APKPackageMetadata) ProtoMessage() {}
+
+func (x *APKPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APKPackageMetadata.ProtoReflect.Descriptor instead.
+func (*APKPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *APKPackageMetadata) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetSnapshotKey() string {
+func (x *APKPackageMetadata) GetOriginName() string {
 	if x != nil {
-		return x.SnapshotKey
+		return x.OriginName
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetLowerDir() string {
+func (x *APKPackageMetadata) GetOsId() string {
 	if x != nil {
-		return x.LowerDir
+		return x.OsId
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetUpperDir() string {
+func (x *APKPackageMetadata) GetOsVersionId() string {
 	if x != nil {
-		return x.UpperDir
+		return x.OsVersionId
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetWorkDir() string {
+func (x *APKPackageMetadata) GetMaintainer() string {
 	if x != nil {
-		return x.WorkDir
+		return x.Maintainer
 	}
 	return ""
 }
 
-func (x *ContainerdContainerMetadata) GetId() string {
+func (x *APKPackageMetadata) GetArchitecture() string {
 	if x != nil {
-		return x.Id
+		return x.Architecture
 	}
 	return ""
 }
 
-type ContainerdRuntimeContainerMetadata struct {
+func (x *APKPackageMetadata) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+// The additional data found in DPKG packages.
+// Next ID: 11
+type DPKGPackageMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NamespaceName string `protobuf:"bytes,1,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
-	ImageName     string `protobuf:"bytes,2,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
-	ImageDigest   string `protobuf:"bytes,3,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
-	Runtime       string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	Id            string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	Pid           int32  `protobuf:"varint,6,opt,name=pid,proto3" json:"pid,omitempty"`
-	RootfsPath    string `protobuf:"bytes,7,opt,name=rootfs_path,json=rootfsPath,proto3" json:"rootfs_path,omitempty"`
+	PackageName       string         `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	SourceName        string         `protobuf:"bytes,2,opt,name=source_name,json=sourceName,proto3" json:"source_name,omitempty"`
+	SourceVersion     string         `protobuf:"bytes,3,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"`
+	PackageVersion    string         `protobuf:"bytes,4,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	OsId              string         `protobuf:"bytes,5,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionCodename string         `protobuf:"bytes,6,opt,name=os_version_codename,json=osVersionCodename,proto3" json:"os_version_codename,omitempty"`
+	OsVersionId       string         `protobuf:"bytes,7,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	Maintainer        string         `protobuf:"bytes,8,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	Architecture      string         `protobuf:"bytes,9,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	Status            string         `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Origin            *PackageOrigin `protobuf:"bytes,11,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
-func (x *ContainerdRuntimeContainerMetadata) Reset() {
-	*x = ContainerdRuntimeContainerMetadata{}
+func (x *DPKGPackageMetadata) Reset() {
+	*x = DPKGPackageMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[34]
+		mi := &file_proto_scan_result_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContainerdRuntimeContainerMetadata) String() string {
+func (x *DPKGPackageMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerdRuntimeContainerMetadata) ProtoMessage() {}
+func (*DPKGPackageMetadata) ProtoMessage() {}
 
-func (x *ContainerdRuntimeContainerMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[34]
+func (x *DPKGPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3343,86 +3837,124 @@ func (x *ContainerdRuntimeContainerMetadata) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerdRuntimeContainerMetadata.ProtoReflect.Descriptor instead.
-func (*ContainerdRuntimeContainerMetadata) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DPKGPackageMetadata.ProtoReflect.Descriptor instead.
+func (*DPKGPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ContainerdRuntimeContainerMetadata) GetNamespaceName() string {
+func (x *DPKGPackageMetadata) GetPackageName() string {
 	if x != nil {
-		return x.NamespaceName
+		return x.PackageName
 	}
 	return ""
 }
 
-func (x *ContainerdRuntimeContainerMetadata) GetImageName() string {
+func (x *DPKGPackageMetadata) GetSourceName() string {
 	if x != nil {
-		return x.ImageName
+		return x.SourceName
 	}
 	return ""
 }
 
-func (x *ContainerdRuntimeContainerMetadata) GetImageDigest() string {
+func (x *DPKGPackageMetadata) GetSourceVersion() string {
 	if x != nil {
-		return x.ImageDigest
+		return x.SourceVersion
 	}
 	return ""
 }
 
-func (x *ContainerdRuntimeContainerMetadata) GetRuntime() string {
+func (x *DPKGPackageMetadata) GetPackageVersion() string {
 	if x != nil {
-		return x.Runtime
+		return x.PackageVersion
 	}
 	return ""
 }
 
-func (x *ContainerdRuntimeContainerMetadata) GetId() string {
+func (x *DPKGPackageMetadata) GetOsId() string {
 	if x != nil {
-		return x.Id
+		return x.OsId
 	}
 	return ""
 }
 
-func (x *ContainerdRuntimeContainerMetadata) GetPid() int32 {
+func (x *DPKGPackageMetadata) GetOsVersionCodename() string {
 	if x != nil {
-		return x.Pid
+		return x.OsVersionCodename
 	}
-	return 0
+	return ""
 }
 
-func (x *ContainerdRuntimeContainerMetadata) GetRootfsPath() string {
+func (x *DPKGPackageMetadata) GetOsVersionId() string {
 	if x != nil {
-		return x.RootfsPath
+		return x.OsVersionId
 	}
 	return ""
 }
 
-type WindowsOSVersion struct {
+func (x *DPKGPackageMetadata) GetMaintainer() string {
+	if x != nil {
+		return x.Maintainer
+	}
+	return ""
+}
+
+func (x *DPKGPackageMetadata) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *DPKGPackageMetadata) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DPKGPackageMetadata) GetOrigin() *PackageOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+// The additional data found in RPM packages.
+type RPMPackageMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Product     string `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
-	FullVersion string `protobuf:"bytes,2,opt,name=full_version,json=fullVersion,proto3" json:"full_version,omitempty"`
+	PackageName  string         `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	SourceRpm    string         `protobuf:"bytes,2,opt,name=source_rpm,json=sourceRpm,proto3" json:"source_rpm,omitempty"`
+	Epoch        int32          `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	OsId         string         `protobuf:"bytes,4,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionId  string         `protobuf:"bytes,5,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	OsBuildId    string         `protobuf:"bytes,6,opt,name=os_build_id,json=osBuildId,proto3" json:"os_build_id,omitempty"`
+	OsName       string         `protobuf:"bytes,7,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
+	Vendor       string         `protobuf:"bytes,8,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	Architecture string         `protobuf:"bytes,9,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	License      string         `protobuf:"bytes,10,opt,name=license,proto3" json:"license,omitempty"`
+	Origin       *PackageOrigin `protobuf:"bytes,11,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
-func (x *WindowsOSVersion) Reset() {
-	*x = WindowsOSVersion{}
+func (x *RPMPackageMetadata) Reset() {
+	*x = RPMPackageMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_scan_result_proto_msgTypes[35]
+		mi := &file_proto_scan_result_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WindowsOSVersion) String() string {
+func (x *RPMPackageMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowsOSVersion) ProtoMessage() {}
+func (*RPMPackageMetadata) ProtoMessage() {}
 
-func (x *WindowsOSVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_scan_result_proto_msgTypes[35]
+func (x *RPMPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3433,713 +3965,9181 @@ func (x *WindowsOSVersion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowsOSVersion.ProtoReflect.Descriptor instead.
-func (*WindowsOSVersion) Descriptor() ([]byte, []int) {
-	return file_proto_scan_result_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use RPMPackageMetadata.ProtoReflect.Descriptor instead.
+func (*RPMPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *WindowsOSVersion) GetProduct() string {
+func (x *RPMPackageMetadata) GetPackageName() string {
 	if x != nil {
-		return x.Product
+		return x.PackageName
 	}
 	return ""
 }
 
-func (x *WindowsOSVersion) GetFullVersion() string {
+func (x *RPMPackageMetadata) GetSourceRpm() string {
 	if x != nil {
-		return x.FullVersion
+		return x.SourceRpm
 	}
 	return ""
 }
 
-var File_proto_scan_result_proto protoreflect.FileDescriptor
+func (x *RPMPackageMetadata) GetEpoch() int32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
 
-var file_proto_scan_result_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xe5, 0x02, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e,
-	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a,
-	0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x0a,
-	0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x73, 0x63, 0x61,
-	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
-	0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
-	0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x55, 0x0a,
-	0x0e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12,
-	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x17, 0x0a, 0x13, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x03, 0x22, 0x69, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xcc, 0x11, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x70,
-	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x63, 0x61, 0x6c,
-	0x69, 0x62, 0x72, 0x2e, 0x50, 0x75, 0x72, 0x6c, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x79, 0x74, 0x68,
-	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x79, 0x74, 0x68,
-	0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x59, 0x0a, 0x13, 0x6a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4a, 0x53, 0x4f, 0x4e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x6a, 0x61, 0x76, 0x61,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40,
-	0x0a, 0x0c, 0x61, 0x70, 0x6b, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41,
-	0x50, 0x4b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x70, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x43, 0x0a, 0x0d, 0x64, 0x70, 0x6b, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
-	0x72, 0x2e, 0x44, 0x50, 0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x70, 0x6b, 0x67, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x72, 0x70, 0x6d, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63,
-	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x52, 0x50, 0x4d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x70, 0x6d, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x5f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x4f, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f,
-	0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x11, 0x64, 0x65, 0x70,
-	0x73, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x28,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44,
-	0x45, 0x50, 0x53, 0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
-	0x00, 0x52, 0x10, 0x64, 0x65, 0x70, 0x73, 0x6a, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x70, 0x64, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61,
-	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x50, 0x44, 0x58, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x70, 0x64, 0x78,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x15, 0x6a, 0x61, 0x76, 0x61,
-	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
-	0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x6a, 0x61, 0x76, 0x61, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x16,
-	0x6a, 0x61, 0x76, 0x61, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x4c, 0x6f, 0x63, 0x6b, 0x66,
-	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x14, 0x6a,
-	0x61, 0x76, 0x61, 0x4c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x41, 0x43, 0x4d, 0x41, 0x4e, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e,
-	0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42,
-	0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
-	0x72, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x48, 0x00, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x4c, 0x0a, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x0f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x40, 0x0a, 0x0c, 0x6f, 0x73, 0x76, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
-	0x2e, 0x4f, 0x53, 0x56, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x73, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x67, 0x0a, 0x1c, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x2e, 0x50, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x1a, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6a, 0x0a, 0x1d, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x16, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x4e, 0x41, 0x50, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c,
-	0x73, 0x6e, 0x61, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x10,
-	0x66, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
-	0x2e, 0x46, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x74, 0x70,
-	0x61, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x11, 0x6d, 0x61,
-	0x63, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
-	0x4d, 0x61, 0x63, 0x41, 0x70, 0x70, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
-	0x00, 0x52, 0x0f, 0x6d, 0x61, 0x63, 0x41, 0x70, 0x70, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x80, 0x01, 0x0a, 0x25, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x64, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
-	0x00, 0x52, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x52, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x64, 0x78, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63,
-	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x44, 0x58, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x64, 0x78, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5a, 0x0a, 0x1b, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x73, 0x5f, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x4f, 0x53,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x18, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x73, 0x4f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0b, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0c, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x22, 0x60, 0x0a, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x52, 0x41, 0x4e, 0x53,
-	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x53,
-	0x49, 0x44, 0x45, 0x5f, 0x4f, 0x53, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x10, 0x02,
-	0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45,
-	0x5f, 0x44, 0x49, 0x52, 0x10, 0x03, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x42,
-	0x0a, 0x14, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x22, 0x7b, 0x0a, 0x0c, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x66, 0x66,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x66, 0x66, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69,
-	0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x22,
-	0xc8, 0x01, 0x0a, 0x04, 0x50, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
-	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x0a,
-	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x22, 0x33, 0x0a, 0x09, 0x51, 0x75,
-	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x92, 0x01, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x03, 0x61,
-	0x64, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
-	0x62, 0x72, 0x2e, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x52, 0x03, 0x61, 0x64, 0x76,
-	0x12, 0x2e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x08, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72,
-	0x79, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79,
-	0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41,
-	0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
-	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x65, 0x76, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x65,
-	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x03, 0x73, 0x65, 0x76, 0x22, 0x3b, 0x0a, 0x08, 0x54,
-	0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42,
-	0x49, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x49, 0x53, 0x5f, 0x46,
-	0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x0a, 0x41, 0x64, 0x76, 0x69,
-	0x73, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x3a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x65, 0x76, 0x65,
-	0x72, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x75,
-	0x6d, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x63,
-	0x76, 0x73, 0x73, 0x5f, 0x76, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x56, 0x53, 0x53, 0x52, 0x06, 0x63, 0x76, 0x73,
-	0x73, 0x56, 0x32, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x76, 0x73, 0x73, 0x5f, 0x76, 0x33, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43,
-	0x56, 0x53, 0x53, 0x52, 0x06, 0x63, 0x76, 0x73, 0x73, 0x56, 0x33, 0x22, 0x59, 0x0a, 0x0c, 0x53,
-	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x03, 0x12, 0x08,
-	0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54,
-	0x49, 0x43, 0x41, 0x4c, 0x10, 0x05, 0x22, 0x7d, 0x0a, 0x04, 0x43, 0x56, 0x53, 0x53, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x12, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
-	0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x5d, 0x0a, 0x0d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
-	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x61, 0x6c,
-	0x69, 0x62, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x69,
-	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x15, 0x50, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x7d, 0x0a, 0x1d, 0x4a, 0x61, 0x76, 0x61,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4a, 0x53, 0x4f,
-	0x4e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x12, 0x41, 0x50, 0x4b, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
-	0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
-	0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61,
-	0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x22, 0xee, 0x02, 0x0a, 0x13, 0x44, 0x50,
-	0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x73,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x22,
-	0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb4, 0x02, 0x0a, 0x12, 0x52,
-	0x50, 0x4d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72,
-	0x70, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x70, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x22,
-	0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x73, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
-	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e,
-	0x64, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
-	0x65, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x43, 0x4f, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4d, 0x41, 0x4e,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6f,
-	0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64,
-	0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x10, 0x44, 0x45, 0x50, 0x53,
-	0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xfc, 0x01, 0x0a,
-	0x13, 0x53, 0x4e, 0x41, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72,
-	0x65, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x73, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x16,
-	0x50, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x16,
-	0x46, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a,
-	0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73,
-	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x73, 0x5f, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f,
-	0x70, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x72, 0x22, 0xe2, 0x02, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76,
-	0x65, 0x72, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x12, 0x49,
-	0x0a, 0x21, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x2e,
-	0x0a, 0x13, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x73, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22,
-	0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0xbb, 0x03, 0x0a, 0x0f, 0x4d, 0x61,
-	0x63, 0x41, 0x70, 0x70, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a,
-	0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
-	0x11, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x18, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x4c, 0x0a, 0x13, 0x53, 0x50, 0x44, 0x58, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
-	0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
-	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x75, 0x72, 0x6c, 0x52, 0x04, 0x70, 0x75, 0x72,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x70, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x43, 0x44, 0x58, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x04, 0x70,
-	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x63, 0x61, 0x6c,
-	0x69, 0x62, 0x72, 0x2e, 0x50, 0x75, 0x72, 0x6c, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x12,
-	0x0a, 0x04, 0x63, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x70,
-	0x65, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x4a, 0x61, 0x76, 0x61, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x74,
-	0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61, 0x31, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x68, 0x61, 0x31, 0x22, 0x78, 0x0a, 0x14, 0x4a, 0x61, 0x76,
-	0x61, 0x4c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x24, 0x0a,
-	0x0e, 0x64, 0x65, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56,
-	0x61, 0x6c, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x4f, 0x53, 0x56, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75,
-	0x72, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x75, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41, 0x73, 0x22, 0x86, 0x01, 0x0a,
-	0x1a, 0x50, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x19, 0x68,
-	0x61, 0x73, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16,
-	0x68, 0x61, 0x73, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xdc, 0x02, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
-	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x69, 0x72, 0x12, 0x1b, 0x0a, 0x09,
-	0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x70, 0x70, 0x65, 0x72, 0x44, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x6f, 0x72,
-	0x6b, 0x44, 0x69, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x22, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69,
-	0x67, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x50, 0x61, 0x74,
-	0x68, 0x22, 0x4f, 0x0a, 0x10, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x4f, 0x53, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x42, 0x3f, 0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
-	0x72, 0x2f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
-	0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *RPMPackageMetadata) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
 }
 
-var (
-	file_proto_scan_result_proto_rawDescOnce sync.Once
-	file_proto_scan_result_proto_rawDescData = file_proto_scan_result_proto_rawDesc
-)
+func (x *RPMPackageMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
 
-func file_proto_scan_result_proto_rawDescGZIP() []byte {
-	file_proto_scan_result_proto_rawDescOnce.Do(func() {
-		file_proto_scan_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_scan_result_proto_rawDescData)
-	})
-	return file_proto_scan_result_proto_rawDescData
+func (x *RPMPackageMetadata) GetOsBuildId() string {
+	if x != nil {
+		return x.OsBuildId
+	}
+	return ""
 }
 
-var file_proto_scan_result_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_scan_result_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
-var file_proto_scan_result_proto_goTypes = []interface{}{
-	(ScanStatus_ScanStatusEnum)(0),             // 0: scalibr.ScanStatus.ScanStatusEnum
-	(Inventory_AnnotationEnum)(0),              // 1: scalibr.Inventory.AnnotationEnum
-	(Advisory_TypeEnum)(0),                     // 2: scalibr.Advisory.TypeEnum
-	(Severity_SeverityEnum)(0),                 // 3: scalibr.Severity.SeverityEnum
-	(*ScanResult)(nil),                         // 4: scalibr.ScanResult
-	(*ScanStatus)(nil),                         // 5: scalibr.ScanStatus
-	(*PluginStatus)(nil),                       // 6: scalibr.PluginStatus
-	(*Inventory)(nil),                          // 7: scalibr.Inventory
-	(*SourceCodeIdentifier)(nil),               // 8: scalibr.SourceCodeIdentifier
-	(*LayerDetails)(nil),                       // 9: scalibr.LayerDetails
-	(*Purl)(nil),                               // 10: scalibr.Purl
-	(*Qualifier)(nil),                          // 11: scalibr.Qualifier
-	(*Finding)(nil),                            // 12: scalibr.Finding
-	(*Advisory)(nil),                           // 13: scalibr.Advisory
-	(*AdvisoryId)(nil),                         // 14: scalibr.AdvisoryId
-	(*Severity)(nil),                           // 15: scalibr.Severity
-	(*CVSS)(nil),                               // 16: scalibr.CVSS
-	(*TargetDetails)(nil),                      // 17: scalibr.TargetDetails
-	(*PythonPackageMetadata)(nil),              // 18: scalibr.PythonPackageMetadata
-	(*JavascriptPackageJSONMetadata)(nil),      // 19: scalibr.JavascriptPackageJSONMetadata
-	(*APKPackageMetadata)(nil),                 // 20: scalibr.APKPackageMetadata
-	(*DPKGPackageMetadata)(nil),                // 21: scalibr.DPKGPackageMetadata
-	(*RPMPackageMetadata)(nil),                 // 22: scalibr.RPMPackageMetadata
-	(*COSPackageMetadata)(nil),                 // 23: scalibr.COSPackageMetadata
-	(*PACMANPackageMetadata)(nil),              // 24: scalibr.PACMANPackageMetadata
-	(*DEPSJSONMetadata)(nil),                   // 25: scalibr.DEPSJSONMetadata
-	(*SNAPPackageMetadata)(nil),                // 26: scalibr.SNAPPackageMetadata
-	(*PortagePackageMetadata)(nil),             // 27: scalibr.PortagePackageMetadata
-	(*FlatpakPackageMetadata)(nil),             // 28: scalibr.FlatpakPackageMetadata
-	(*ModuleMetadata)(nil),                     // 29: scalibr.ModuleMetadata
-	(*MacAppsMetadata)(nil),                    // 30: scalibr.MacAppsMetadata
-	(*SPDXPackageMetadata)(nil),                // 31: scalibr.SPDXPackageMetadata
-	(*CDXPackageMetadata)(nil),                 // 32: scalibr.CDXPackageMetadata
-	(*JavaArchiveMetadata)(nil),                // 33: scalibr.JavaArchiveMetadata
-	(*JavaLockfileMetadata)(nil),               // 34: scalibr.JavaLockfileMetadata
-	(*OSVPackageMetadata)(nil),                 // 35: scalibr.OSVPackageMetadata
-	(*PythonRequirementsMetadata)(nil),         // 36: scalibr.PythonRequirementsMetadata
-	(*ContainerdContainerMetadata)(nil),        // 37: scalibr.ContainerdContainerMetadata
-	(*ContainerdRuntimeContainerMetadata)(nil), // 38: scalibr.ContainerdRuntimeContainerMetadata
-	(*WindowsOSVersion)(nil),                   // 39: scalibr.WindowsOSVersion
-	(*timestamppb.Timestamp)(nil),              // 40: google.protobuf.Timestamp
+func (x *RPMPackageMetadata) GetOsName() string {
+	if x != nil {
+		return x.OsName
+	}
+	return ""
 }
-var file_proto_scan_result_proto_depIdxs = []int32{
-	40, // 0: scalibr.ScanResult.start_time:type_name -> google.protobuf.Timestamp
-	40, // 1: scalibr.ScanResult.end_time:type_name -> google.protobuf.Timestamp
-	5,  // 2: scalibr.ScanResult.status:type_name -> scalibr.ScanStatus
-	6,  // 3: scalibr.ScanResult.plugin_status:type_name -> scalibr.PluginStatus
-	7,  // 4: scalibr.ScanResult.inventories:type_name -> scalibr.Inventory
-	12, // 5: scalibr.ScanResult.findings:type_name -> scalibr.Finding
-	0,  // 6: scalibr.ScanStatus.status:type_name -> scalibr.ScanStatus.ScanStatusEnum
-	5,  // 7: scalibr.PluginStatus.status:type_name -> scalibr.ScanStatus
-	8,  // 8: scalibr.Inventory.source_code:type_name -> scalibr.SourceCodeIdentifier
-	10, // 9: scalibr.Inventory.purl:type_name -> scalibr.Purl
-	18, // 10: scalibr.Inventory.python_metadata:type_name -> scalibr.PythonPackageMetadata
-	19, // 11: scalibr.Inventory.javascript_metadata:type_name -> scalibr.JavascriptPackageJSONMetadata
-	20, // 12: scalibr.Inventory.apk_metadata:type_name -> scalibr.APKPackageMetadata
-	21, // 13: scalibr.Inventory.dpkg_metadata:type_name -> scalibr.DPKGPackageMetadata
-	22, // 14: scalibr.Inventory.rpm_metadata:type_name -> scalibr.RPMPackageMetadata
-	23, // 15: scalibr.Inventory.cos_metadata:type_name -> scalibr.COSPackageMetadata
-	25, // 16: scalibr.Inventory.depsjson_metadata:type_name -> scalibr.DEPSJSONMetadata
-	31, // 17: scalibr.Inventory.spdx_metadata:type_name -> scalibr.SPDXPackageMetadata
-	33, // 18: scalibr.Inventory.java_archive_metadata:type_name -> scalibr.JavaArchiveMetadata
-	34, // 19: scalibr.Inventory.java_lockfile_metadata:type_name -> scalibr.JavaLockfileMetadata
-	24, // 20: scalibr.Inventory.pacman_metadata:type_name -> scalibr.PACMANPackageMetadata
-	29, // 21: scalibr.Inventory.module_metadata:type_name -> scalibr.ModuleMetadata
-	27, // 22: scalibr.Inventory.portage_metadata:type_name -> scalibr.PortagePackageMetadata
-	35, // 23: scalibr.Inventory.osv_metadata:type_name -> scalibr.OSVPackageMetadata
-	36, // 24: scalibr.Inventory.python_requirements_metadata:type_name -> scalibr.PythonRequirementsMetadata
-	37, // 25: scalibr.Inventory.containerd_container_metadata:type_name -> scalibr.ContainerdContainerMetadata
-	26, // 26: scalibr.Inventory.snap_metadata:type_name -> scalibr.SNAPPackageMetadata
-	28, // 27: scalibr.Inventory.flatpak_metadata:type_name -> scalibr.FlatpakPackageMetadata
-	30, // 28: scalibr.Inventory.mac_apps_metadata:type_name -> scalibr.MacAppsMetadata
-	38, // 29: scalibr.Inventory.containerd_runtime_container_metadata:type_name -> scalibr.ContainerdRuntimeContainerMetadata
-	32, // 30: scalibr.Inventory.cdx_metadata:type_name -> scalibr.CDXPackageMetadata
-	39, // 31: scalibr.Inventory.windows_os_version_metadata:type_name -> scalibr.WindowsOSVersion
-	1,  // 32: scalibr.Inventory.annotations:type_name -> scalibr.Inventory.AnnotationEnum
-	9,  // 33: scalibr.Inventory.layer_details:type_name -> scalibr.LayerDetails
-	11, // 34: scalibr.Purl.qualifiers:type_name -> scalibr.Qualifier
-	13, // 35: scalibr.Finding.adv:type_name -> scalibr.Advisory
-	17, // 36: scalibr.Finding.target:type_name -> scalibr.TargetDetails
-	14, // 37: scalibr.Advisory.id:type_name -> scalibr.AdvisoryId
-	2,  // 38: scalibr.Advisory.type:type_name -> scalibr.Advisory.TypeEnum
-	15, // 39: scalibr.Advisory.sev:type_name -> scalibr.Severity
-	3,  // 40: scalibr.Severity.severity:type_name -> scalibr.Severity.SeverityEnum
-	16, // 41: scalibr.Severity.cvss_v2:type_name -> scalibr.CVSS
-	16, // 42: scalibr.Severity.cvss_v3:type_name -> scalibr.CVSS
-	7,  // 43: scalibr.TargetDetails.inventory:type_name -> scalibr.Inventory
-	10, // 44: scalibr.SPDXPackageMetadata.purl:type_name -> scalibr.Purl
-	10, // 45: scalibr.CDXPackageMetadata.purl:type_name -> scalibr.Purl
-	46, // [46:46] is the sub-list for method output_type
-	46, // [46:46] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+
+func (x *RPMPackageMetadata) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
 }
 
-func init() { file_proto_scan_result_proto_init() }
-func file_proto_scan_result_proto_init() {
-	if File_proto_scan_result_proto != nil {
-		return
+func (x *RPMPackageMetadata) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_scan_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScanResult); i {
+	return ""
+}
+
+func (x *RPMPackageMetadata) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *RPMPackageMetadata) GetOrigin() *PackageOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+// Where an OS package was installed from. Only set if the package manager's
+// repository metadata is present.
+type PackageOrigin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin PackageOrigin_OriginEnum `protobuf:"varint,1,opt,name=origin,proto3,enum=scalibr.PackageOrigin_OriginEnum" json:"origin,omitempty"`
+	// The repository the package was installed from, e.g. "Debian" or the ID
+	// of a yum repo.
+	Repository string `protobuf:"bytes,2,opt,name=repository,proto3" json:"repository,omitempty"`
+}
+
+func (x *PackageOrigin) Reset() {
+	*x = PackageOrigin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageOrigin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageOrigin) ProtoMessage() {}
+
+func (x *PackageOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageOrigin.ProtoReflect.Descriptor instead.
+func (*PackageOrigin) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PackageOrigin) GetOrigin() PackageOrigin_OriginEnum {
+	if x != nil {
+		return x.Origin
+	}
+	return PackageOrigin_UNKNOWN
+}
+
+func (x *PackageOrigin) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+// The additional data found in COS packages.
+type COSPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version     string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Category    string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	OsVersion   string `protobuf:"bytes,4,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
+	OsVersionId string `protobuf:"bytes,5,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+}
+
+func (x *COSPackageMetadata) Reset() {
+	*x = COSPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *COSPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*COSPackageMetadata) ProtoMessage() {}
+
+func (x *COSPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use COSPackageMetadata.ProtoReflect.Descriptor instead.
+func (*COSPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *COSPackageMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *COSPackageMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *COSPackageMetadata) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *COSPackageMetadata) GetOsVersion() string {
+	if x != nil {
+		return x.OsVersion
+	}
+	return ""
+}
+
+func (x *COSPackageMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
+
+// The additional data found in PACMAN packages.
+type PACMANPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageName         string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	PackageVersion      string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	OsId                string `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionId         string `protobuf:"bytes,4,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	PackageDescription  string `protobuf:"bytes,5,opt,name=package_description,json=packageDescription,proto3" json:"package_description,omitempty"`
+	PackageDependencies string `protobuf:"bytes,6,opt,name=package_dependencies,json=packageDependencies,proto3" json:"package_dependencies,omitempty"`
+}
+
+func (x *PACMANPackageMetadata) Reset() {
+	*x = PACMANPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PACMANPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PACMANPackageMetadata) ProtoMessage() {}
+
+func (x *PACMANPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PACMANPackageMetadata.ProtoReflect.Descriptor instead.
+func (*PACMANPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PACMANPackageMetadata) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *PACMANPackageMetadata) GetPackageVersion() string {
+	if x != nil {
+		return x.PackageVersion
+	}
+	return ""
+}
+
+func (x *PACMANPackageMetadata) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *PACMANPackageMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
+
+func (x *PACMANPackageMetadata) GetPackageDescription() string {
+	if x != nil {
+		return x.PackageDescription
+	}
+	return ""
+}
+
+func (x *PACMANPackageMetadata) GetPackageDependencies() string {
+	if x != nil {
+		return x.PackageDependencies
+	}
+	return ""
+}
+
+// The additional data found in .NET deps json packages.
+type DEPSJSONMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageName    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	PackageVersion string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	Type           string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *DEPSJSONMetadata) Reset() {
+	*x = DEPSJSONMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DEPSJSONMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DEPSJSONMetadata) ProtoMessage() {}
+
+func (x *DEPSJSONMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DEPSJSONMetadata.ProtoReflect.Descriptor instead.
+func (*DEPSJSONMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DEPSJSONMetadata) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *DEPSJSONMetadata) GetPackageVersion() string {
+	if x != nil {
+		return x.PackageVersion
+	}
+	return ""
+}
+
+func (x *DEPSJSONMetadata) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// The additional data found in SNAP packages.
+type SNAPPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version           string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Grade             string   `protobuf:"bytes,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	Type              string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Architectures     []string `protobuf:"bytes,5,rep,name=architectures,proto3" json:"architectures,omitempty"`
+	OsId              string   `protobuf:"bytes,6,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionCodename string   `protobuf:"bytes,7,opt,name=os_version_codename,json=osVersionCodename,proto3" json:"os_version_codename,omitempty"`
+	OsVersionId       string   `protobuf:"bytes,8,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+}
+
+func (x *SNAPPackageMetadata) Reset() {
+	*x = SNAPPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SNAPPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SNAPPackageMetadata) ProtoMessage() {}
+
+func (x *SNAPPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SNAPPackageMetadata.ProtoReflect.Descriptor instead.
+func (*SNAPPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SNAPPackageMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SNAPPackageMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SNAPPackageMetadata) GetGrade() string {
+	if x != nil {
+		return x.Grade
+	}
+	return ""
+}
+
+func (x *SNAPPackageMetadata) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SNAPPackageMetadata) GetArchitectures() []string {
+	if x != nil {
+		return x.Architectures
+	}
+	return nil
+}
+
+func (x *SNAPPackageMetadata) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *SNAPPackageMetadata) GetOsVersionCodename() string {
+	if x != nil {
+		return x.OsVersionCodename
+	}
+	return ""
+}
+
+func (x *SNAPPackageMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
+
+// The additional data found in portage packages.
+type PortagePackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageName    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	PackageVersion string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	OsId           string `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionId    string `protobuf:"bytes,4,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+}
+
+func (x *PortagePackageMetadata) Reset() {
+	*x = PortagePackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortagePackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortagePackageMetadata) ProtoMessage() {}
+
+func (x *PortagePackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortagePackageMetadata.ProtoReflect.Descriptor instead.
+func (*PortagePackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PortagePackageMetadata) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *PortagePackageMetadata) GetPackageVersion() string {
+	if x != nil {
+		return x.PackageVersion
+	}
+	return ""
+}
+
+func (x *PortagePackageMetadata) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *PortagePackageMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
+
+// The additional data found in Flatpak packages.
+type FlatpakPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageName    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	PackageId      string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	PackageVersion string `protobuf:"bytes,3,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	ReleaseDate    string `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	OsName         string `protobuf:"bytes,5,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
+	OsId           string `protobuf:"bytes,6,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionId    string `protobuf:"bytes,7,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	OsBuildId      string `protobuf:"bytes,8,opt,name=os_build_id,json=osBuildId,proto3" json:"os_build_id,omitempty"`
+	Developer      string `protobuf:"bytes,9,opt,name=developer,proto3" json:"developer,omitempty"`
+}
+
+func (x *FlatpakPackageMetadata) Reset() {
+	*x = FlatpakPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlatpakPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlatpakPackageMetadata) ProtoMessage() {}
+
+func (x *FlatpakPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlatpakPackageMetadata.ProtoReflect.Descriptor instead.
+func (*FlatpakPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FlatpakPackageMetadata) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetPackageVersion() string {
+	if x != nil {
+		return x.PackageVersion
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetOsName() string {
+	if x != nil {
+		return x.OsName
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetOsBuildId() string {
+	if x != nil {
+		return x.OsBuildId
+	}
+	return ""
+}
+
+func (x *FlatpakPackageMetadata) GetDeveloper() string {
+	if x != nil {
+		return x.Developer
+	}
+	return ""
+}
+
+// The additional data found in MODULE packages.
+type ModuleMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageName                    string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	PackageVersion                 string `protobuf:"bytes,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	PackageVermagic                string `protobuf:"bytes,3,opt,name=package_vermagic,json=packageVermagic,proto3" json:"package_vermagic,omitempty"`
+	PackageSourceVersionIdentifier string `protobuf:"bytes,4,opt,name=package_source_version_identifier,json=packageSourceVersionIdentifier,proto3" json:"package_source_version_identifier,omitempty"`
+	OsId                           string `protobuf:"bytes,5,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionCodename              string `protobuf:"bytes,6,opt,name=os_version_codename,json=osVersionCodename,proto3" json:"os_version_codename,omitempty"`
+	OsVersionId                    string `protobuf:"bytes,7,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	PackageAuthor                  string `protobuf:"bytes,8,opt,name=package_author,json=packageAuthor,proto3" json:"package_author,omitempty"`
+}
+
+func (x *ModuleMetadata) Reset() {
+	*x = ModuleMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleMetadata) ProtoMessage() {}
+
+func (x *ModuleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleMetadata.ProtoReflect.Descriptor instead.
+func (*ModuleMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ModuleMetadata) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *ModuleMetadata) GetPackageVersion() string {
+	if x != nil {
+		return x.PackageVersion
+	}
+	return ""
+}
+
+func (x *ModuleMetadata) GetPackageVermagic() string {
+	if x != nil {
+		return x.PackageVermagic
+	}
+	return ""
+}
+
+func (x *ModuleMetadata) GetPackageSourceVersionIdentifier() string {
+	if x != nil {
+		return x.PackageSourceVersionIdentifier
+	}
+	return ""
+}
+
+func (x *ModuleMetadata) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *ModuleMetadata) GetOsVersionCodename() string {
+	if x != nil {
+		return x.OsVersionCodename
+	}
+	return ""
+}
+
+func (x *ModuleMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
+
+func (x *ModuleMetadata) GetPackageAuthor() string {
+	if x != nil {
+		return x.PackageAuthor
+	}
+	return ""
+}
+
+// The additional data found in Mac Applications.
+type MacAppsMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleDisplayName        string `protobuf:"bytes,1,opt,name=bundle_display_name,json=bundleDisplayName,proto3" json:"bundle_display_name,omitempty"`
+	BundleIdentifier         string `protobuf:"bytes,2,opt,name=bundle_identifier,json=bundleIdentifier,proto3" json:"bundle_identifier,omitempty"`
+	BundleShortVersionString string `protobuf:"bytes,3,opt,name=bundle_short_version_string,json=bundleShortVersionString,proto3" json:"bundle_short_version_string,omitempty"`
+	BundleExecutable         string `protobuf:"bytes,4,opt,name=bundle_executable,json=bundleExecutable,proto3" json:"bundle_executable,omitempty"`
+	BundleName               string `protobuf:"bytes,5,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
+	BundlePackageType        string `protobuf:"bytes,6,opt,name=bundle_package_type,json=bundlePackageType,proto3" json:"bundle_package_type,omitempty"`
+	BundleSignature          string `protobuf:"bytes,7,opt,name=bundle_signature,json=bundleSignature,proto3" json:"bundle_signature,omitempty"`
+	BundleVersion            string `protobuf:"bytes,8,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`
+	ProductId                string `protobuf:"bytes,9,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UpdateUrl                string `protobuf:"bytes,10,opt,name=update_url,json=updateUrl,proto3" json:"update_url,omitempty"`
+}
+
+func (x *MacAppsMetadata) Reset() {
+	*x = MacAppsMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MacAppsMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MacAppsMetadata) ProtoMessage() {}
+
+func (x *MacAppsMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MacAppsMetadata.ProtoReflect.Descriptor instead.
+func (*MacAppsMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MacAppsMetadata) GetBundleDisplayName() string {
+	if x != nil {
+		return x.BundleDisplayName
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetBundleIdentifier() string {
+	if x != nil {
+		return x.BundleIdentifier
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetBundleShortVersionString() string {
+	if x != nil {
+		return x.BundleShortVersionString
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetBundleExecutable() string {
+	if x != nil {
+		return x.BundleExecutable
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetBundleName() string {
+	if x != nil {
+		return x.BundleName
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetBundlePackageType() string {
+	if x != nil {
+		return x.BundlePackageType
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetBundleSignature() string {
+	if x != nil {
+		return x.BundleSignature
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetBundleVersion() string {
+	if x != nil {
+		return x.BundleVersion
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *MacAppsMetadata) GetUpdateUrl() string {
+	if x != nil {
+		return x.UpdateUrl
+	}
+	return ""
+}
+
+// An Android app or partition build. Exactly one of the fields is set.
+type AndroidMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App       *AndroidApp       `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Partition *AndroidPartition `protobuf:"bytes,2,opt,name=partition,proto3" json:"partition,omitempty"`
+}
+
+func (x *AndroidMetadata) Reset() {
+	*x = AndroidMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AndroidMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AndroidMetadata) ProtoMessage() {}
+
+func (x *AndroidMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AndroidMetadata.ProtoReflect.Descriptor instead.
+func (*AndroidMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AndroidMetadata) GetApp() *AndroidApp {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+func (x *AndroidMetadata) GetPartition() *AndroidPartition {
+	if x != nil {
+		return x.Partition
+	}
+	return nil
+}
+
+// An app listed in the package manager's packages.xml.
+type AndroidApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageName      string `protobuf:"bytes,1,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	VersionCode      int64  `protobuf:"varint,2,opt,name=version_code,json=versionCode,proto3" json:"version_code,omitempty"`
+	CodePath         string `protobuf:"bytes,3,opt,name=code_path,json=codePath,proto3" json:"code_path,omitempty"`
+	Installer        string `protobuf:"bytes,4,opt,name=installer,proto3" json:"installer,omitempty"`
+	System           bool   `protobuf:"varint,5,opt,name=system,proto3" json:"system,omitempty"`
+	UpdatedSystemApp bool   `protobuf:"varint,6,opt,name=updated_system_app,json=updatedSystemApp,proto3" json:"updated_system_app,omitempty"`
+	// Hex encoded SHA-256 digests of the signing certificates.
+	SigningCertificates []string               `protobuf:"bytes,7,rep,name=signing_certificates,json=signingCertificates,proto3" json:"signing_certificates,omitempty"`
+	FirstInstallTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_install_time,json=firstInstallTime,proto3" json:"first_install_time,omitempty"`
+	LastUpdateTime      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_update_time,json=lastUpdateTime,proto3" json:"last_update_time,omitempty"`
+}
+
+func (x *AndroidApp) Reset() {
+	*x = AndroidApp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AndroidApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AndroidApp) ProtoMessage() {}
+
+func (x *AndroidApp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AndroidApp.ProtoReflect.Descriptor instead.
+func (*AndroidApp) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AndroidApp) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *AndroidApp) GetVersionCode() int64 {
+	if x != nil {
+		return x.VersionCode
+	}
+	return 0
+}
+
+func (x *AndroidApp) GetCodePath() string {
+	if x != nil {
+		return x.CodePath
+	}
+	return ""
+}
+
+func (x *AndroidApp) GetInstaller() string {
+	if x != nil {
+		return x.Installer
+	}
+	return ""
+}
+
+func (x *AndroidApp) GetSystem() bool {
+	if x != nil {
+		return x.System
+	}
+	return false
+}
+
+func (x *AndroidApp) GetUpdatedSystemApp() bool {
+	if x != nil {
+		return x.UpdatedSystemApp
+	}
+	return false
+}
+
+func (x *AndroidApp) GetSigningCertificates() []string {
+	if x != nil {
+		return x.SigningCertificates
+	}
+	return nil
+}
+
+func (x *AndroidApp) GetFirstInstallTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstInstallTime
+	}
+	return nil
+}
+
+func (x *AndroidApp) GetLastUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdateTime
+	}
+	return nil
+}
+
+// The build of an Android partition read from its build.prop.
+type AndroidPartition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Release       string `protobuf:"bytes,2,opt,name=release,proto3" json:"release,omitempty"`
+	SdkVersion    string `protobuf:"bytes,3,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty"`
+	SecurityPatch string `protobuf:"bytes,4,opt,name=security_patch,json=securityPatch,proto3" json:"security_patch,omitempty"`
+	Fingerprint   string `protobuf:"bytes,5,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	BuildId       string `protobuf:"bytes,6,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (x *AndroidPartition) Reset() {
+	*x = AndroidPartition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AndroidPartition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AndroidPartition) ProtoMessage() {}
+
+func (x *AndroidPartition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AndroidPartition.ProtoReflect.Descriptor instead.
+func (*AndroidPartition) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AndroidPartition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AndroidPartition) GetRelease() string {
+	if x != nil {
+		return x.Release
+	}
+	return ""
+}
+
+func (x *AndroidPartition) GetSdkVersion() string {
+	if x != nil {
+		return x.SdkVersion
+	}
+	return ""
+}
+
+func (x *AndroidPartition) GetSecurityPatch() string {
+	if x != nil {
+		return x.SecurityPatch
+	}
+	return ""
+}
+
+func (x *AndroidPartition) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *AndroidPartition) GetBuildId() string {
+	if x != nil {
+		return x.BuildId
+	}
+	return ""
+}
+
+// The additional data for packages extracted from SPDX files.
+type SPDXPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purl *Purl    `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	Cpes []string `protobuf:"bytes,2,rep,name=cpes,proto3" json:"cpes,omitempty"`
+}
+
+func (x *SPDXPackageMetadata) Reset() {
+	*x = SPDXPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SPDXPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPDXPackageMetadata) ProtoMessage() {}
+
+func (x *SPDXPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPDXPackageMetadata.ProtoReflect.Descriptor instead.
+func (*SPDXPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SPDXPackageMetadata) GetPurl() *Purl {
+	if x != nil {
+		return x.Purl
+	}
+	return nil
+}
+
+func (x *SPDXPackageMetadata) GetCpes() []string {
+	if x != nil {
+		return x.Cpes
+	}
+	return nil
+}
+
+// The additional data for packages extracted from CDX files.
+type CDXPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purl *Purl    `protobuf:"bytes,1,opt,name=purl,proto3" json:"purl,omitempty"`
+	Cpes []string `protobuf:"bytes,2,rep,name=cpes,proto3" json:"cpes,omitempty"`
+}
+
+func (x *CDXPackageMetadata) Reset() {
+	*x = CDXPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CDXPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CDXPackageMetadata) ProtoMessage() {}
+
+func (x *CDXPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CDXPackageMetadata.ProtoReflect.Descriptor instead.
+func (*CDXPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CDXPackageMetadata) GetPurl() *Purl {
+	if x != nil {
+		return x.Purl
+	}
+	return nil
+}
+
+func (x *CDXPackageMetadata) GetCpes() []string {
+	if x != nil {
+		return x.Cpes
+	}
+	return nil
+}
+
+// The additional data found in Java JAR packages.
+type JavaArchiveMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtifactId string `protobuf:"bytes,2,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	GroupId    string `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Sha1       string `protobuf:"bytes,4,opt,name=sha1,proto3" json:"sha1,omitempty"`
+}
+
+func (x *JavaArchiveMetadata) Reset() {
+	*x = JavaArchiveMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaArchiveMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaArchiveMetadata) ProtoMessage() {}
+
+func (x *JavaArchiveMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaArchiveMetadata.ProtoReflect.Descriptor instead.
+func (*JavaArchiveMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *JavaArchiveMetadata) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *JavaArchiveMetadata) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *JavaArchiveMetadata) GetSha1() string {
+	if x != nil {
+		return x.Sha1
+	}
+	return ""
+}
+
+// The additional data found for Java application servers and the
+// applications deployed to them.
+type JavaAppServerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. "tomcat", "wildfly", "jboss-eap" or "weblogic".
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// "war" or "ear" for deployed applications.
+	ArchiveType string `protobuf:"bytes,2,opt,name=archive_type,json=archiveType,proto3" json:"archive_type,omitempty"`
+	// Libraries bundled into a deployed application.
+	Libraries []string `protobuf:"bytes,3,rep,name=libraries,proto3" json:"libraries,omitempty"`
+}
+
+func (x *JavaAppServerMetadata) Reset() {
+	*x = JavaAppServerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaAppServerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaAppServerMetadata) ProtoMessage() {}
+
+func (x *JavaAppServerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaAppServerMetadata.ProtoReflect.Descriptor instead.
+func (*JavaAppServerMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *JavaAppServerMetadata) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *JavaAppServerMetadata) GetArchiveType() string {
+	if x != nil {
+		return x.ArchiveType
+	}
+	return ""
+}
+
+func (x *JavaAppServerMetadata) GetLibraries() []string {
+	if x != nil {
+		return x.Libraries
+	}
+	return nil
+}
+
+// The additional data found for Java runtime installations.
+type JavaRuntimeMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. "Oracle Corporation" or "Eclipse Adoptium".
+	Vendor string `protobuf:"bytes,1,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// The full runtime version, e.g. "17.0.9+11-LTS-201".
+	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	// "JDK" or "JRE".
+	ImageType string `protobuf:"bytes,3,opt,name=image_type,json=imageType,proto3" json:"image_type,omitempty"`
+	// "unlimited" or "limited".
+	CryptoPolicy string          `protobuf:"bytes,4,opt,name=crypto_policy,json=cryptoPolicy,proto3" json:"crypto_policy,omitempty"`
+	TrustStore   *JavaTrustStore `protobuf:"bytes,5,opt,name=trust_store,json=trustStore,proto3" json:"trust_store,omitempty"`
+}
+
+func (x *JavaRuntimeMetadata) Reset() {
+	*x = JavaRuntimeMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaRuntimeMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaRuntimeMetadata) ProtoMessage() {}
+
+func (x *JavaRuntimeMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaRuntimeMetadata.ProtoReflect.Descriptor instead.
+func (*JavaRuntimeMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *JavaRuntimeMetadata) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *JavaRuntimeMetadata) GetRuntimeVersion() string {
+	if x != nil {
+		return x.RuntimeVersion
+	}
+	return ""
+}
+
+func (x *JavaRuntimeMetadata) GetImageType() string {
+	if x != nil {
+		return x.ImageType
+	}
+	return ""
+}
+
+func (x *JavaRuntimeMetadata) GetCryptoPolicy() string {
+	if x != nil {
+		return x.CryptoPolicy
+	}
+	return ""
+}
+
+func (x *JavaRuntimeMetadata) GetTrustStore() *JavaTrustStore {
+	if x != nil {
+		return x.TrustStore
+	}
+	return nil
+}
+
+// The cacerts trust store of a Java installation.
+type JavaTrustStore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// "jks" or "pkcs12".
+	Format       string                    `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Certificates []*JavaTrustedCertificate `protobuf:"bytes,3,rep,name=certificates,proto3" json:"certificates,omitempty"`
+}
+
+func (x *JavaTrustStore) Reset() {
+	*x = JavaTrustStore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaTrustStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaTrustStore) ProtoMessage() {}
+
+func (x *JavaTrustStore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaTrustStore.ProtoReflect.Descriptor instead.
+func (*JavaTrustStore) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *JavaTrustStore) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JavaTrustStore) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *JavaTrustStore) GetCertificates() []*JavaTrustedCertificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+type JavaTrustedCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alias    string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Subject  string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	NotAfter *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	Expired  bool                   `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	// Whether the certificate was added to the trust store shipped with the
+	// runtime.
+	Added bool `protobuf:"varint,5,opt,name=added,proto3" json:"added,omitempty"`
+}
+
+func (x *JavaTrustedCertificate) Reset() {
+	*x = JavaTrustedCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaTrustedCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaTrustedCertificate) ProtoMessage() {}
+
+func (x *JavaTrustedCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaTrustedCertificate.ProtoReflect.Descriptor instead.
+func (*JavaTrustedCertificate) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *JavaTrustedCertificate) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *JavaTrustedCertificate) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *JavaTrustedCertificate) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *JavaTrustedCertificate) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *JavaTrustedCertificate) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
+// The additional data found in Java lockfiles.
+type JavaLockfileMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtifactId   string   `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	GroupId      string   `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	DepGroupVals []string `protobuf:"bytes,3,rep,name=dep_group_vals,json=depGroupVals,proto3" json:"dep_group_vals,omitempty"`
+}
+
+func (x *JavaLockfileMetadata) Reset() {
+	*x = JavaLockfileMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JavaLockfileMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JavaLockfileMetadata) ProtoMessage() {}
+
+func (x *JavaLockfileMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JavaLockfileMetadata.ProtoReflect.Descriptor instead.
+func (*JavaLockfileMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *JavaLockfileMetadata) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *JavaLockfileMetadata) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *JavaLockfileMetadata) GetDepGroupVals() []string {
+	if x != nil {
+		return x.DepGroupVals
+	}
+	return nil
+}
+
+// The additional data for packages extracted by an OSV extractor wrapper.
+type OSVPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PurlType  string `protobuf:"bytes,1,opt,name=purl_type,json=purlType,proto3" json:"purl_type,omitempty"`
+	Commit    string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Ecosystem string `protobuf:"bytes,3,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	CompareAs string `protobuf:"bytes,4,opt,name=compare_as,json=compareAs,proto3" json:"compare_as,omitempty"`
+}
+
+func (x *OSVPackageMetadata) Reset() {
+	*x = OSVPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OSVPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OSVPackageMetadata) ProtoMessage() {}
+
+func (x *OSVPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OSVPackageMetadata.ProtoReflect.Descriptor instead.
+func (*OSVPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *OSVPackageMetadata) GetPurlType() string {
+	if x != nil {
+		return x.PurlType
+	}
+	return ""
+}
+
+func (x *OSVPackageMetadata) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *OSVPackageMetadata) GetEcosystem() string {
+	if x != nil {
+		return x.Ecosystem
+	}
+	return ""
+}
+
+func (x *OSVPackageMetadata) GetCompareAs() string {
+	if x != nil {
+		return x.CompareAs
+	}
+	return ""
+}
+
+type PythonRequirementsMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HashCheckingModeValues []string `protobuf:"bytes,1,rep,name=hash_checking_mode_values,json=hashCheckingModeValues,proto3" json:"hash_checking_mode_values,omitempty"`
+	VersionComparator      string   `protobuf:"bytes,2,opt,name=version_comparator,json=versionComparator,proto3" json:"version_comparator,omitempty"`
+}
+
+func (x *PythonRequirementsMetadata) Reset() {
+	*x = PythonRequirementsMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PythonRequirementsMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PythonRequirementsMetadata) ProtoMessage() {}
+
+func (x *PythonRequirementsMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PythonRequirementsMetadata.ProtoReflect.Descriptor instead.
+func (*PythonRequirementsMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PythonRequirementsMetadata) GetHashCheckingModeValues() []string {
+	if x != nil {
+		return x.HashCheckingModeValues
+	}
+	return nil
+}
+
+func (x *PythonRequirementsMetadata) GetVersionComparator() string {
+	if x != nil {
+		return x.VersionComparator
+	}
+	return ""
+}
+
+type ContainerdContainerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamespaceName string `protobuf:"bytes,1,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	ImageName     string `protobuf:"bytes,2,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	ImageDigest   string `protobuf:"bytes,3,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+	Runtime       string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Pid           int32  `protobuf:"varint,5,opt,name=pid,proto3" json:"pid,omitempty"`
+	Snapshotter   string `protobuf:"bytes,6,opt,name=snapshotter,proto3" json:"snapshotter,omitempty"`
+	SnapshotKey   string `protobuf:"bytes,7,opt,name=snapshot_key,json=snapshotKey,proto3" json:"snapshot_key,omitempty"`
+	LowerDir      string `protobuf:"bytes,8,opt,name=lower_dir,json=lowerDir,proto3" json:"lower_dir,omitempty"`
+	UpperDir      string `protobuf:"bytes,9,opt,name=upper_dir,json=upperDir,proto3" json:"upper_dir,omitempty"`
+	WorkDir       string `protobuf:"bytes,10,opt,name=work_dir,json=workDir,proto3" json:"work_dir,omitempty"`
+	Id            string `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ContainerdContainerMetadata) Reset() {
+	*x = ContainerdContainerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerdContainerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerdContainerMetadata) ProtoMessage() {}
+
+func (x *ContainerdContainerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerdContainerMetadata.ProtoReflect.Descriptor instead.
+func (*ContainerdContainerMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ContainerdContainerMetadata) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ContainerdContainerMetadata) GetSnapshotter() string {
+	if x != nil {
+		return x.Snapshotter
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetSnapshotKey() string {
+	if x != nil {
+		return x.SnapshotKey
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetLowerDir() string {
+	if x != nil {
+		return x.LowerDir
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetUpperDir() string {
+	if x != nil {
+		return x.UpperDir
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetWorkDir() string {
+	if x != nil {
+		return x.WorkDir
+	}
+	return ""
+}
+
+func (x *ContainerdContainerMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ContainerdRuntimeContainerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamespaceName string `protobuf:"bytes,1,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	ImageName     string `protobuf:"bytes,2,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	ImageDigest   string `protobuf:"bytes,3,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+	Runtime       string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Id            string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Pid           int32  `protobuf:"varint,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	RootfsPath    string `protobuf:"bytes,7,opt,name=rootfs_path,json=rootfsPath,proto3" json:"rootfs_path,omitempty"`
+}
+
+func (x *ContainerdRuntimeContainerMetadata) Reset() {
+	*x = ContainerdRuntimeContainerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerdRuntimeContainerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerdRuntimeContainerMetadata) ProtoMessage() {}
+
+func (x *ContainerdRuntimeContainerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerdRuntimeContainerMetadata.ProtoReflect.Descriptor instead.
+func (*ContainerdRuntimeContainerMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ContainerdRuntimeContainerMetadata) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *ContainerdRuntimeContainerMetadata) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ContainerdRuntimeContainerMetadata) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
+func (x *ContainerdRuntimeContainerMetadata) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *ContainerdRuntimeContainerMetadata) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerdRuntimeContainerMetadata) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ContainerdRuntimeContainerMetadata) GetRootfsPath() string {
+	if x != nil {
+		return x.RootfsPath
+	}
+	return ""
+}
+
+type WindowsOSVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product     string `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	FullVersion string `protobuf:"bytes,2,opt,name=full_version,json=fullVersion,proto3" json:"full_version,omitempty"`
+}
+
+func (x *WindowsOSVersion) Reset() {
+	*x = WindowsOSVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindowsOSVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsOSVersion) ProtoMessage() {}
+
+func (x *WindowsOSVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsOSVersion.ProtoReflect.Descriptor instead.
+func (*WindowsOSVersion) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WindowsOSVersion) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *WindowsOSVersion) GetFullVersion() string {
+	if x != nil {
+		return x.FullVersion
+	}
+	return ""
+}
+
+// A security product (e.g. an antivirus) registered on a Windows system.
+type WindowsSecurityProductMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kind of the product, e.g. "antivirus", "firewall" or "antispyware".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Whether the product reports its real-time protection as enabled.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Scan exclusions. Only populated for Microsoft Defender.
+	Exclusions []*DefenderExclusion `protobuf:"bytes,3,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+}
+
+func (x *WindowsSecurityProductMetadata) Reset() {
+	*x = WindowsSecurityProductMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindowsSecurityProductMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsSecurityProductMetadata) ProtoMessage() {}
+
+func (x *WindowsSecurityProductMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsSecurityProductMetadata.ProtoReflect.Descriptor instead.
+func (*WindowsSecurityProductMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WindowsSecurityProductMetadata) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WindowsSecurityProductMetadata) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WindowsSecurityProductMetadata) GetExclusions() []*DefenderExclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+// The outputs configured in a log shipper config, e.g. a Logstash pipeline.
+type LogPipelineMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The log shipper, e.g. "splunk", "logstash", "fluentd" or "fluent-bit".
+	Product string               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Outputs []*LogPipelineOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *LogPipelineMetadata) Reset() {
+	*x = LogPipelineMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogPipelineMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogPipelineMetadata) ProtoMessage() {}
+
+func (x *LogPipelineMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogPipelineMetadata.ProtoReflect.Descriptor instead.
+func (*LogPipelineMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *LogPipelineMetadata) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *LogPipelineMetadata) GetOutputs() []*LogPipelineOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type LogPipelineOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the output plugin or stanza, e.g. "elasticsearch".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Hosts or URLs the logs are sent to.
+	Destinations []string `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Credentials for the destinations stored in the config in plaintext.
+	Credentials []*LogPipelineCredential `protobuf:"bytes,3,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *LogPipelineOutput) Reset() {
+	*x = LogPipelineOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogPipelineOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogPipelineOutput) ProtoMessage() {}
+
+func (x *LogPipelineOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogPipelineOutput.ProtoReflect.Descriptor instead.
+func (*LogPipelineOutput) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *LogPipelineOutput) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LogPipelineOutput) GetDestinations() []string {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *LogPipelineOutput) GetCredentials() []*LogPipelineCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type LogPipelineCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the setting the secret is stored in, e.g. "password".
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *LogPipelineCredential) Reset() {
+	*x = LogPipelineCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogPipelineCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogPipelineCredential) ProtoMessage() {}
+
+func (x *LogPipelineCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogPipelineCredential.ProtoReflect.Descriptor instead.
+func (*LogPipelineCredential) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LogPipelineCredential) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LogPipelineCredential) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type StorageClusterMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The storage system, e.g. "ceph", "glusterfs" or "minio".
+	Component string `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	// Identifier of the cluster, e.g. the Ceph fsid.
+	ClusterId   string                      `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Credentials []*StorageClusterCredential `protobuf:"bytes,3,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *StorageClusterMetadata) Reset() {
+	*x = StorageClusterMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageClusterMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageClusterMetadata) ProtoMessage() {}
+
+func (x *StorageClusterMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageClusterMetadata.ProtoReflect.Descriptor instead.
+func (*StorageClusterMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *StorageClusterMetadata) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *StorageClusterMetadata) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *StorageClusterMetadata) GetCredentials() []*StorageClusterCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type StorageClusterCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type StorageClusterCredential_TypeEnum `protobuf:"varint,1,opt,name=type,proto3,enum=scalibr.StorageClusterCredential_TypeEnum" json:"type,omitempty"`
+	// The entity or user the credential belongs to, e.g. "client.admin".
+	Principal string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	// Empty for TLS private keys, which are reported by location.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether the credential grants full access to the cluster.
+	Admin bool `protobuf:"varint,4,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (x *StorageClusterCredential) Reset() {
+	*x = StorageClusterCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageClusterCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageClusterCredential) ProtoMessage() {}
+
+func (x *StorageClusterCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageClusterCredential.ProtoReflect.Descriptor instead.
+func (*StorageClusterCredential) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *StorageClusterCredential) GetType() StorageClusterCredential_TypeEnum {
+	if x != nil {
+		return x.Type
+	}
+	return StorageClusterCredential_UNSPECIFIED
+}
+
+func (x *StorageClusterCredential) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *StorageClusterCredential) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *StorageClusterCredential) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+type AppBundleMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Format of the bundle file: "snap", "flatpak" or "appimage".
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// What the package is within the bundle: "application", "runtime",
+	// "package" or "library".
+	Component string `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	// Name of the application of the bundle. Empty for the application itself.
+	Bundle string `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+}
+
+func (x *AppBundleMetadata) Reset() {
+	*x = AppBundleMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppBundleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppBundleMetadata) ProtoMessage() {}
+
+func (x *AppBundleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppBundleMetadata.ProtoReflect.Descriptor instead.
+func (*AppBundleMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AppBundleMetadata) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *AppBundleMetadata) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *AppBundleMetadata) GetBundle() string {
+	if x != nil {
+		return x.Bundle
+	}
+	return ""
+}
+
+// A shared library installed with a vendor application, or a component
+// identified in it.
+type VendorLibraryMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The DT_SONAME of the library, e.g. "libcrypto.so.1.1".
+	Soname string `protobuf:"bytes,1,opt,name=soname,proto3" json:"soname,omitempty"`
+	// The version in the file name of the library.
+	FileVersion string `protobuf:"bytes,2,opt,name=file_version,json=fileVersion,proto3" json:"file_version,omitempty"`
+	// Where the version comes from: "banner", "symbols" or "filename".
+	VersionSource string `protobuf:"bytes,3,opt,name=version_source,json=versionSource,proto3" json:"version_source,omitempty"`
+	// Directory of the vendor application, e.g. "opt/acme".
+	VendorDir string `protobuf:"bytes,4,opt,name=vendor_dir,json=vendorDir,proto3" json:"vendor_dir,omitempty"`
+}
+
+func (x *VendorLibraryMetadata) Reset() {
+	*x = VendorLibraryMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VendorLibraryMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorLibraryMetadata) ProtoMessage() {}
+
+func (x *VendorLibraryMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorLibraryMetadata.ProtoReflect.Descriptor instead.
+func (*VendorLibraryMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *VendorLibraryMetadata) GetSoname() string {
+	if x != nil {
+		return x.Soname
+	}
+	return ""
+}
+
+func (x *VendorLibraryMetadata) GetFileVersion() string {
+	if x != nil {
+		return x.FileVersion
+	}
+	return ""
+}
+
+func (x *VendorLibraryMetadata) GetVersionSource() string {
+	if x != nil {
+		return x.VersionSource
+	}
+	return ""
+}
+
+func (x *VendorLibraryMetadata) GetVendorDir() string {
+	if x != nil {
+		return x.VendorDir
+	}
+	return ""
+}
+
+// A dependency pinned in a MODULE.bazel.lock file.
+type BazelModuleMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "bazel_module" or "go_module".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Name of the Bazel repository that the dependency is fetched into.
+	RepoName string `protobuf:"bytes,2,opt,name=repo_name,json=repoName,proto3" json:"repo_name,omitempty"`
+	// URL of the registry that a Bazel module was resolved from.
+	Registry string `protobuf:"bytes,3,opt,name=registry,proto3" json:"registry,omitempty"`
+}
+
+func (x *BazelModuleMetadata) Reset() {
+	*x = BazelModuleMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BazelModuleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BazelModuleMetadata) ProtoMessage() {}
+
+func (x *BazelModuleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BazelModuleMetadata.ProtoReflect.Descriptor instead.
+func (*BazelModuleMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BazelModuleMetadata) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BazelModuleMetadata) GetRepoName() string {
+	if x != nil {
+		return x.RepoName
+	}
+	return ""
+}
+
+func (x *BazelModuleMetadata) GetRegistry() string {
+	if x != nil {
+		return x.Registry
+	}
+	return ""
+}
+
+type IaCSecretsMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The configuration management tool: "puppet", "chef" or "ansible".
+	Tool    string       `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	Secrets []*IaCSecret `protobuf:"bytes,2,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Path of a co-located key that decrypts the secrets, e.g. the eyaml
+	// PKCS#7 private key.
+	DecryptionKey string `protobuf:"bytes,3,opt,name=decryption_key,json=decryptionKey,proto3" json:"decryption_key,omitempty"`
+}
+
+func (x *IaCSecretsMetadata) Reset() {
+	*x = IaCSecretsMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IaCSecretsMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IaCSecretsMetadata) ProtoMessage() {}
+
+func (x *IaCSecretsMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IaCSecretsMetadata.ProtoReflect.Descriptor instead.
+func (*IaCSecretsMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *IaCSecretsMetadata) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *IaCSecretsMetadata) GetSecrets() []*IaCSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *IaCSecretsMetadata) GetDecryptionKey() string {
+	if x != nil {
+		return x.DecryptionKey
+	}
+	return ""
+}
+
+type IaCSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type IaCSecret_TypeEnum `protobuf:"varint,1,opt,name=type,proto3,enum=scalibr.IaCSecret_TypeEnum" json:"type,omitempty"`
+	// Key path of the value, e.g. "profile::db::password".
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Empty for encrypted secrets.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Whether the decryption key decrypts the secret.
+	Decryptable bool `protobuf:"varint,4,opt,name=decryptable,proto3" json:"decryptable,omitempty"`
+}
+
+func (x *IaCSecret) Reset() {
+	*x = IaCSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IaCSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IaCSecret) ProtoMessage() {}
+
+func (x *IaCSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IaCSecret.ProtoReflect.Descriptor instead.
+func (*IaCSecret) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *IaCSecret) GetType() IaCSecret_TypeEnum {
+	if x != nil {
+		return x.Type
+	}
+	return IaCSecret_UNSPECIFIED
+}
+
+func (x *IaCSecret) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *IaCSecret) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *IaCSecret) GetDecryptable() bool {
+	if x != nil {
+		return x.Decryptable
+	}
+	return false
+}
+
+type DatabaseServerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The database server: "postgresql", "mysql", "mariadb" or "mssql".
+	Server string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// The data directory the version was read from, if any.
+	DataDirectory string `protobuf:"bytes,2,opt,name=data_directory,json=dataDirectory,proto3" json:"data_directory,omitempty"`
+	// The full product name, only set for SQL Server.
+	Product string `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+}
+
+func (x *DatabaseServerMetadata) Reset() {
+	*x = DatabaseServerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabaseServerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseServerMetadata) ProtoMessage() {}
+
+func (x *DatabaseServerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseServerMetadata.ProtoReflect.Descriptor instead.
+func (*DatabaseServerMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DatabaseServerMetadata) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *DatabaseServerMetadata) GetDataDirectory() string {
+	if x != nil {
+		return x.DataDirectory
+	}
+	return ""
+}
+
+func (x *DatabaseServerMetadata) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+type RegistryConfigMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The package manager: "pip", "npm" or "go".
+	Tool       string             `protobuf:"bytes,1,opt,name=tool,proto3" json:"tool,omitempty"`
+	Registries []*PackageRegistry `protobuf:"bytes,2,rep,name=registries,proto3" json:"registries,omitempty"`
+	// Hosts for which TLS certificate verification or HTTPS is disabled.
+	InsecureHosts []string `protobuf:"bytes,3,rep,name=insecure_hosts,json=insecureHosts,proto3" json:"insecure_hosts,omitempty"`
+	// Whether TLS certificate verification is disabled for all registries.
+	TlsVerifyDisabled bool `protobuf:"varint,4,opt,name=tls_verify_disabled,json=tlsVerifyDisabled,proto3" json:"tls_verify_disabled,omitempty"`
+	// The GOSUMDB setting, if configured.
+	GoSumDb string `protobuf:"bytes,5,opt,name=go_sum_db,json=goSumDb,proto3" json:"go_sum_db,omitempty"`
+	// The module patterns of GONOSUMDB.
+	GoNoSumDb []string `protobuf:"bytes,6,rep,name=go_no_sum_db,json=goNoSumDb,proto3" json:"go_no_sum_db,omitempty"`
+}
+
+func (x *RegistryConfigMetadata) Reset() {
+	*x = RegistryConfigMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistryConfigMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryConfigMetadata) ProtoMessage() {}
+
+func (x *RegistryConfigMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistryConfigMetadata.ProtoReflect.Descriptor instead.
+func (*RegistryConfigMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RegistryConfigMetadata) GetTool() string {
+	if x != nil {
+		return x.Tool
+	}
+	return ""
+}
+
+func (x *RegistryConfigMetadata) GetRegistries() []*PackageRegistry {
+	if x != nil {
+		return x.Registries
+	}
+	return nil
+}
+
+func (x *RegistryConfigMetadata) GetInsecureHosts() []string {
+	if x != nil {
+		return x.InsecureHosts
+	}
+	return nil
+}
+
+func (x *RegistryConfigMetadata) GetTlsVerifyDisabled() bool {
+	if x != nil {
+		return x.TlsVerifyDisabled
+	}
+	return false
+}
+
+func (x *RegistryConfigMetadata) GetGoSumDb() string {
+	if x != nil {
+		return x.GoSumDb
+	}
+	return ""
+}
+
+func (x *RegistryConfigMetadata) GetGoNoSumDb() []string {
+	if x != nil {
+		return x.GoNoSumDb
+	}
+	return nil
+}
+
+type PackageRegistry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url  string                   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Role PackageRegistry_RoleEnum `protobuf:"varint,2,opt,name=role,proto3,enum=scalibr.PackageRegistry_RoleEnum" json:"role,omitempty"`
+	// The npm scope the registry is used for, e.g. "@corp".
+	Scope string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *PackageRegistry) Reset() {
+	*x = PackageRegistry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageRegistry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageRegistry) ProtoMessage() {}
+
+func (x *PackageRegistry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageRegistry.ProtoReflect.Descriptor instead.
+func (*PackageRegistry) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PackageRegistry) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PackageRegistry) GetRole() PackageRegistry_RoleEnum {
+	if x != nil {
+		return x.Role
+	}
+	return PackageRegistry_UNSPECIFIED
+}
+
+func (x *PackageRegistry) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type EdgeProxyMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The proxy the configuration belongs to: "haproxy", "envoy" or "traefik".
+	Proxy       string                 `protobuf:"bytes,1,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Routes      []*EdgeProxyRoute      `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+	Credentials []*EdgeProxyCredential `protobuf:"bytes,3,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *EdgeProxyMetadata) Reset() {
+	*x = EdgeProxyMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeProxyMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeProxyMetadata) ProtoMessage() {}
+
+func (x *EdgeProxyMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeProxyMetadata.ProtoReflect.Descriptor instead.
+func (*EdgeProxyMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *EdgeProxyMetadata) GetProxy() string {
+	if x != nil {
+		return x.Proxy
+	}
+	return ""
+}
+
+func (x *EdgeProxyMetadata) GetRoutes() []*EdgeProxyRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *EdgeProxyMetadata) GetCredentials() []*EdgeProxyCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type EdgeProxyRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The addresses or entry points the route listens on.
+	Listen []string `protobuf:"bytes,2,rep,name=listen,proto3" json:"listen,omitempty"`
+	// The host or rule the route matches on.
+	Match     string   `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	Upstreams []string `protobuf:"bytes,4,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
+	Tls       bool     `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *EdgeProxyRoute) Reset() {
+	*x = EdgeProxyRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeProxyRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeProxyRoute) ProtoMessage() {}
+
+func (x *EdgeProxyRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeProxyRoute.ProtoReflect.Descriptor instead.
+func (*EdgeProxyRoute) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *EdgeProxyRoute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EdgeProxyRoute) GetListen() []string {
+	if x != nil {
+		return x.Listen
+	}
+	return nil
+}
+
+func (x *EdgeProxyRoute) GetMatch() string {
+	if x != nil {
+		return x.Match
+	}
+	return ""
+}
+
+func (x *EdgeProxyRoute) GetUpstreams() []string {
+	if x != nil {
+		return x.Upstreams
+	}
+	return nil
+}
+
+func (x *EdgeProxyRoute) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+type EdgeProxyCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type EdgeProxyCredential_TypeEnum `protobuf:"varint,1,opt,name=type,proto3,enum=scalibr.EdgeProxyCredential_TypeEnum" json:"type,omitempty"`
+	// The section or resource the credential was found in.
+	Context string `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// The user name the credential belongs to, if any.
+	Principal string `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Secret    string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *EdgeProxyCredential) Reset() {
+	*x = EdgeProxyCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EdgeProxyCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EdgeProxyCredential) ProtoMessage() {}
+
+func (x *EdgeProxyCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EdgeProxyCredential.ProtoReflect.Descriptor instead.
+func (*EdgeProxyCredential) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *EdgeProxyCredential) GetType() EdgeProxyCredential_TypeEnum {
+	if x != nil {
+		return x.Type
+	}
+	return EdgeProxyCredential_UNSPECIFIED
+}
+
+func (x *EdgeProxyCredential) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *EdgeProxyCredential) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *EdgeProxyCredential) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ActiveDirectoryMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artifact ActiveDirectoryMetadata_ArtifactEnum `protobuf:"varint,1,opt,name=artifact,proto3,enum=scalibr.ActiveDirectoryMetadata_ArtifactEnum" json:"artifact,omitempty"`
+	// Whether the NTDS database is the live database under Windows/NTDS.
+	Live bool `protobuf:"varint,2,opt,name=live,proto3" json:"live,omitempty"`
+	// Path of a SYSTEM hive stored next to an NTDS database copy.
+	SystemHive  string                       `protobuf:"bytes,3,opt,name=system_hive,json=systemHive,proto3" json:"system_hive,omitempty"`
+	Credentials []*ActiveDirectoryCredential `protobuf:"bytes,4,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *ActiveDirectoryMetadata) Reset() {
+	*x = ActiveDirectoryMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveDirectoryMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveDirectoryMetadata) ProtoMessage() {}
+
+func (x *ActiveDirectoryMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveDirectoryMetadata.ProtoReflect.Descriptor instead.
+func (*ActiveDirectoryMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ActiveDirectoryMetadata) GetArtifact() ActiveDirectoryMetadata_ArtifactEnum {
+	if x != nil {
+		return x.Artifact
+	}
+	return ActiveDirectoryMetadata_UNSPECIFIED
+}
+
+func (x *ActiveDirectoryMetadata) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *ActiveDirectoryMetadata) GetSystemHive() string {
+	if x != nil {
+		return x.SystemHive
+	}
+	return ""
+}
+
+func (x *ActiveDirectoryMetadata) GetCredentials() []*ActiveDirectoryCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// Kerberos and SSSD configuration of a Linux host.
+type KerberosMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "krb5-config", "keytab" or "sssd-config".
+	Kind         string           `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	DefaultRealm string           `protobuf:"bytes,2,opt,name=default_realm,json=defaultRealm,proto3" json:"default_realm,omitempty"`
+	Realms       []*KerberosRealm `protobuf:"bytes,3,rep,name=realms,proto3" json:"realms,omitempty"`
+	// Principals with keys in a keytab. Key material isn't extracted.
+	Principals []*KerberosPrincipal `protobuf:"bytes,4,rep,name=principals,proto3" json:"principals,omitempty"`
+	Domains    []*SSSDDomain        `protobuf:"bytes,5,rep,name=domains,proto3" json:"domains,omitempty"`
+	// Paths of the keytabs the config refers to.
+	Keytabs []string `protobuf:"bytes,6,rep,name=keytabs,proto3" json:"keytabs,omitempty"`
+}
+
+func (x *KerberosMetadata) Reset() {
+	*x = KerberosMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KerberosMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KerberosMetadata) ProtoMessage() {}
+
+func (x *KerberosMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KerberosMetadata.ProtoReflect.Descriptor instead.
+func (*KerberosMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *KerberosMetadata) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *KerberosMetadata) GetDefaultRealm() string {
+	if x != nil {
+		return x.DefaultRealm
+	}
+	return ""
+}
+
+func (x *KerberosMetadata) GetRealms() []*KerberosRealm {
+	if x != nil {
+		return x.Realms
+	}
+	return nil
+}
+
+func (x *KerberosMetadata) GetPrincipals() []*KerberosPrincipal {
+	if x != nil {
+		return x.Principals
+	}
+	return nil
+}
+
+func (x *KerberosMetadata) GetDomains() []*SSSDDomain {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *KerberosMetadata) GetKeytabs() []string {
+	if x != nil {
+		return x.Keytabs
+	}
+	return nil
+}
+
+type KerberosRealm struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kdcs        []string `protobuf:"bytes,2,rep,name=kdcs,proto3" json:"kdcs,omitempty"`
+	AdminServer string   `protobuf:"bytes,3,opt,name=admin_server,json=adminServer,proto3" json:"admin_server,omitempty"`
+}
+
+func (x *KerberosRealm) Reset() {
+	*x = KerberosRealm{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KerberosRealm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KerberosRealm) ProtoMessage() {}
+
+func (x *KerberosRealm) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KerberosRealm.ProtoReflect.Descriptor instead.
+func (*KerberosRealm) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *KerberosRealm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KerberosRealm) GetKdcs() []string {
+	if x != nil {
+		return x.Kdcs
+	}
+	return nil
+}
+
+func (x *KerberosRealm) GetAdminServer() string {
+	if x != nil {
+		return x.AdminServer
+	}
+	return ""
+}
+
+type KerberosPrincipal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The principal name without the realm, e.g. HTTP/web.example.com.
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Realm    string   `protobuf:"bytes,2,opt,name=realm,proto3" json:"realm,omitempty"`
+	Kvno     uint32   `protobuf:"varint,3,opt,name=kvno,proto3" json:"kvno,omitempty"`
+	EncTypes []string `protobuf:"bytes,4,rep,name=enc_types,json=encTypes,proto3" json:"enc_types,omitempty"`
+}
+
+func (x *KerberosPrincipal) Reset() {
+	*x = KerberosPrincipal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KerberosPrincipal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KerberosPrincipal) ProtoMessage() {}
+
+func (x *KerberosPrincipal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KerberosPrincipal.ProtoReflect.Descriptor instead.
+func (*KerberosPrincipal) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *KerberosPrincipal) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KerberosPrincipal) GetRealm() string {
+	if x != nil {
+		return x.Realm
+	}
+	return ""
+}
+
+func (x *KerberosPrincipal) GetKvno() uint32 {
+	if x != nil {
+		return x.Kvno
+	}
+	return 0
+}
+
+func (x *KerberosPrincipal) GetEncTypes() []string {
+	if x != nil {
+		return x.EncTypes
+	}
+	return nil
+}
+
+type SSSDDomain struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IdProvider   string   `protobuf:"bytes,2,opt,name=id_provider,json=idProvider,proto3" json:"id_provider,omitempty"`
+	AuthProvider string   `protobuf:"bytes,3,opt,name=auth_provider,json=authProvider,proto3" json:"auth_provider,omitempty"`
+	Realm        string   `protobuf:"bytes,4,opt,name=realm,proto3" json:"realm,omitempty"`
+	Servers      []string `protobuf:"bytes,5,rep,name=servers,proto3" json:"servers,omitempty"`
+	Keytab       string   `protobuf:"bytes,6,opt,name=keytab,proto3" json:"keytab,omitempty"`
+}
+
+func (x *SSSDDomain) Reset() {
+	*x = SSSDDomain{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSSDDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSSDDomain) ProtoMessage() {}
+
+func (x *SSSDDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSSDDomain.ProtoReflect.Descriptor instead.
+func (*SSSDDomain) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SSSDDomain) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SSSDDomain) GetIdProvider() string {
+	if x != nil {
+		return x.IdProvider
+	}
+	return ""
+}
+
+func (x *SSSDDomain) GetAuthProvider() string {
+	if x != nil {
+		return x.AuthProvider
+	}
+	return ""
+}
+
+func (x *SSSDDomain) GetRealm() string {
+	if x != nil {
+		return x.Realm
+	}
+	return ""
+}
+
+func (x *SSSDDomain) GetServers() []string {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *SSSDDomain) GetKeytab() string {
+	if x != nil {
+		return x.Keytab
+	}
+	return ""
+}
+
+type ActiveDirectoryCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The GPP item or script command the credential was found in.
+	Context  string `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// The encrypted GPP cpassword value.
+	Cpassword string `protobuf:"bytes,4,opt,name=cpassword,proto3" json:"cpassword,omitempty"`
+	// The line of the script the credential was found on.
+	Line int32 `protobuf:"varint,5,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *ActiveDirectoryCredential) Reset() {
+	*x = ActiveDirectoryCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveDirectoryCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveDirectoryCredential) ProtoMessage() {}
+
+func (x *ActiveDirectoryCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveDirectoryCredential.ProtoReflect.Descriptor instead.
+func (*ActiveDirectoryCredential) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ActiveDirectoryCredential) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *ActiveDirectoryCredential) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ActiveDirectoryCredential) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ActiveDirectoryCredential) GetCpassword() string {
+	if x != nil {
+		return x.Cpassword
+	}
+	return ""
+}
+
+func (x *ActiveDirectoryCredential) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+// An application served by an app server or kept running by a process
+// manager.
+type AppSupervisorMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The process manager or app server: "pm2", "gunicorn", "supervisor" or
+	// "passenger".
+	Supervisor string `protobuf:"bytes,1,opt,name=supervisor,proto3" json:"supervisor,omitempty"`
+	// The command, script or entry point that starts the app.
+	Command          string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	WorkingDirectory string `protobuf:"bytes,3,opt,name=working_directory,json=workingDirectory,proto3" json:"working_directory,omitempty"`
+	// The runtime of the app: "node", "python" or "ruby".
+	Runtime        string `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	RuntimePath    string `protobuf:"bytes,5,opt,name=runtime_path,json=runtimePath,proto3" json:"runtime_path,omitempty"`
+	RuntimeVersion string `protobuf:"bytes,6,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	User           string `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
+	// Addresses or ports the app listens on.
+	Listen []string `protobuf:"bytes,8,rep,name=listen,proto3" json:"listen,omitempty"`
+	// Number of processes or workers, 0 if not configured.
+	Instances int32 `protobuf:"varint,9,opt,name=instances,proto3" json:"instances,omitempty"`
+	// The state recorded by the supervisor, e.g. "online" for pm2.
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AppSupervisorMetadata) Reset() {
+	*x = AppSupervisorMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppSupervisorMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppSupervisorMetadata) ProtoMessage() {}
+
+func (x *AppSupervisorMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppSupervisorMetadata.ProtoReflect.Descriptor instead.
+func (*AppSupervisorMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AppSupervisorMetadata) GetSupervisor() string {
+	if x != nil {
+		return x.Supervisor
+	}
+	return ""
+}
+
+func (x *AppSupervisorMetadata) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *AppSupervisorMetadata) GetWorkingDirectory() string {
+	if x != nil {
+		return x.WorkingDirectory
+	}
+	return ""
+}
+
+func (x *AppSupervisorMetadata) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *AppSupervisorMetadata) GetRuntimePath() string {
+	if x != nil {
+		return x.RuntimePath
+	}
+	return ""
+}
+
+func (x *AppSupervisorMetadata) GetRuntimeVersion() string {
+	if x != nil {
+		return x.RuntimeVersion
+	}
+	return ""
+}
+
+func (x *AppSupervisorMetadata) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AppSupervisorMetadata) GetListen() []string {
+	if x != nil {
+		return x.Listen
+	}
+	return nil
+}
+
+func (x *AppSupervisorMetadata) GetInstances() int32 {
+	if x != nil {
+		return x.Instances
+	}
+	return 0
+}
+
+func (x *AppSupervisorMetadata) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// A kernel module loaded into the running Linux kernel or a DKMS package.
+type KernelModuleMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//
+	//	*KernelModuleMetadata_Module
+	//	*KernelModuleMetadata_Dkms
+	Kind isKernelModuleMetadata_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *KernelModuleMetadata) Reset() {
+	*x = KernelModuleMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KernelModuleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KernelModuleMetadata) ProtoMessage() {}
+
+func (x *KernelModuleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KernelModuleMetadata.ProtoReflect.Descriptor instead.
+func (*KernelModuleMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{78}
+}
+
+func (m *KernelModuleMetadata) GetKind() isKernelModuleMetadata_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *KernelModuleMetadata) GetModule() *LoadedKernelModule {
+	if x, ok := x.GetKind().(*KernelModuleMetadata_Module); ok {
+		return x.Module
+	}
+	return nil
+}
+
+func (x *KernelModuleMetadata) GetDkms() *DKMSPackage {
+	if x, ok := x.GetKind().(*KernelModuleMetadata_Dkms); ok {
+		return x.Dkms
+	}
+	return nil
+}
+
+type isKernelModuleMetadata_Kind interface {
+	isKernelModuleMetadata_Kind()
+}
+
+type KernelModuleMetadata_Module struct {
+	Module *LoadedKernelModule `protobuf:"bytes,1,opt,name=module,proto3,oneof"`
+}
+
+type KernelModuleMetadata_Dkms struct {
+	Dkms *DKMSPackage `protobuf:"bytes,2,opt,name=dkms,proto3,oneof"`
+}
+
+func (*KernelModuleMetadata_Module) isKernelModuleMetadata_Kind() {}
+
+func (*KernelModuleMetadata_Dkms) isKernelModuleMetadata_Kind() {}
+
+type LoadedKernelModule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcVersion string `protobuf:"bytes,1,opt,name=src_version,json=srcVersion,proto3" json:"src_version,omitempty"`
+	SizeBytes  int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	RefCount   int32  `protobuf:"varint,3,opt,name=ref_count,json=refCount,proto3" json:"ref_count,omitempty"`
+	// Modules that depend on this module.
+	UsedBy []string `protobuf:"bytes,4,rep,name=used_by,json=usedBy,proto3" json:"used_by,omitempty"`
+	// "Live", "Loading" or "Unloading".
+	State string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	// Reasons the module taints the kernel, e.g. "out-of-tree" or "unsigned".
+	Taints []string `protobuf:"bytes,6,rep,name=taints,proto3" json:"taints,omitempty"`
+	// "<name>/<version>" of the DKMS package the module was built from.
+	DkmsPackage   string `protobuf:"bytes,7,opt,name=dkms_package,json=dkmsPackage,proto3" json:"dkms_package,omitempty"`
+	KernelRelease string `protobuf:"bytes,8,opt,name=kernel_release,json=kernelRelease,proto3" json:"kernel_release,omitempty"`
+}
+
+func (x *LoadedKernelModule) Reset() {
+	*x = LoadedKernelModule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadedKernelModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadedKernelModule) ProtoMessage() {}
+
+func (x *LoadedKernelModule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadedKernelModule.ProtoReflect.Descriptor instead.
+func (*LoadedKernelModule) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LoadedKernelModule) GetSrcVersion() string {
+	if x != nil {
+		return x.SrcVersion
+	}
+	return ""
+}
+
+func (x *LoadedKernelModule) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *LoadedKernelModule) GetRefCount() int32 {
+	if x != nil {
+		return x.RefCount
+	}
+	return 0
+}
+
+func (x *LoadedKernelModule) GetUsedBy() []string {
+	if x != nil {
+		return x.UsedBy
+	}
+	return nil
+}
+
+func (x *LoadedKernelModule) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *LoadedKernelModule) GetTaints() []string {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *LoadedKernelModule) GetDkmsPackage() string {
+	if x != nil {
+		return x.DkmsPackage
+	}
+	return ""
+}
+
+func (x *LoadedKernelModule) GetKernelRelease() string {
+	if x != nil {
+		return x.KernelRelease
+	}
+	return ""
+}
+
+type DKMSPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kernel releases the package is built for.
+	Kernels []string `protobuf:"bytes,1,rep,name=kernels,proto3" json:"kernels,omitempty"`
+	// Names of the kernel modules built from the package.
+	Modules               []string `protobuf:"bytes,2,rep,name=modules,proto3" json:"modules,omitempty"`
+	BuiltForRunningKernel bool     `protobuf:"varint,3,opt,name=built_for_running_kernel,json=builtForRunningKernel,proto3" json:"built_for_running_kernel,omitempty"`
+}
+
+func (x *DKMSPackage) Reset() {
+	*x = DKMSPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DKMSPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKMSPackage) ProtoMessage() {}
+
+func (x *DKMSPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DKMSPackage.ProtoReflect.Descriptor instead.
+func (*DKMSPackage) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DKMSPackage) GetKernels() []string {
+	if x != nil {
+		return x.Kernels
+	}
+	return nil
+}
+
+func (x *DKMSPackage) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *DKMSPackage) GetBuiltForRunningKernel() bool {
+	if x != nil {
+		return x.BuiltForRunningKernel
+	}
+	return false
+}
+
+// A Windows Subsystem for Linux distribution.
+type WSLDistributionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Distribution string `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution,omitempty"`
+	// 1 for root filesystem directories, 2 for ext4.vhdx disk images.
+	WslVersion    int32  `protobuf:"varint,2,opt,name=wsl_version,json=wslVersion,proto3" json:"wsl_version,omitempty"`
+	OsId          string `protobuf:"bytes,3,opt,name=os_id,json=osId,proto3" json:"os_id,omitempty"`
+	OsVersionId   string `protobuf:"bytes,4,opt,name=os_version_id,json=osVersionId,proto3" json:"os_version_id,omitempty"`
+	OsName        string `protobuf:"bytes,5,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
+	DiskSizeBytes int64  `protobuf:"varint,6,opt,name=disk_size_bytes,json=diskSizeBytes,proto3" json:"disk_size_bytes,omitempty"`
+	// Whether the packages of the distribution were extracted.
+	PackagesScanned bool `protobuf:"varint,7,opt,name=packages_scanned,json=packagesScanned,proto3" json:"packages_scanned,omitempty"`
+}
+
+func (x *WSLDistributionMetadata) Reset() {
+	*x = WSLDistributionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WSLDistributionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WSLDistributionMetadata) ProtoMessage() {}
+
+func (x *WSLDistributionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WSLDistributionMetadata.ProtoReflect.Descriptor instead.
+func (*WSLDistributionMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *WSLDistributionMetadata) GetDistribution() string {
+	if x != nil {
+		return x.Distribution
+	}
+	return ""
+}
+
+func (x *WSLDistributionMetadata) GetWslVersion() int32 {
+	if x != nil {
+		return x.WslVersion
+	}
+	return 0
+}
+
+func (x *WSLDistributionMetadata) GetOsId() string {
+	if x != nil {
+		return x.OsId
+	}
+	return ""
+}
+
+func (x *WSLDistributionMetadata) GetOsVersionId() string {
+	if x != nil {
+		return x.OsVersionId
+	}
+	return ""
+}
+
+func (x *WSLDistributionMetadata) GetOsName() string {
+	if x != nil {
+		return x.OsName
+	}
+	return ""
+}
+
+func (x *WSLDistributionMetadata) GetDiskSizeBytes() int64 {
+	if x != nil {
+		return x.DiskSizeBytes
+	}
+	return 0
+}
+
+func (x *WSLDistributionMetadata) GetPackagesScanned() bool {
+	if x != nil {
+		return x.PackagesScanned
+	}
+	return false
+}
+
+// A container of a task on an Amazon ECS container instance.
+type ECSTaskContainerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster              string       `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	ContainerInstanceArn string       `protobuf:"bytes,2,opt,name=container_instance_arn,json=containerInstanceArn,proto3" json:"container_instance_arn,omitempty"`
+	TaskArn              string       `protobuf:"bytes,3,opt,name=task_arn,json=taskArn,proto3" json:"task_arn,omitempty"`
+	TaskFamily           string       `protobuf:"bytes,4,opt,name=task_family,json=taskFamily,proto3" json:"task_family,omitempty"`
+	TaskVersion          string       `protobuf:"bytes,5,opt,name=task_version,json=taskVersion,proto3" json:"task_version,omitempty"`
+	TaskStatus           string       `protobuf:"bytes,6,opt,name=task_status,json=taskStatus,proto3" json:"task_status,omitempty"`
+	ContainerName        string       `protobuf:"bytes,7,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	DockerId             string       `protobuf:"bytes,8,opt,name=docker_id,json=dockerId,proto3" json:"docker_id,omitempty"`
+	ImageName            string       `protobuf:"bytes,9,opt,name=image_name,json=imageName,proto3" json:"image_name,omitempty"`
+	ImageDigest          string       `protobuf:"bytes,10,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+	Secrets              []*ECSSecret `protobuf:"bytes,11,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Mounts               []*ECSMount  `protobuf:"bytes,12,rep,name=mounts,proto3" json:"mounts,omitempty"`
+}
+
+func (x *ECSTaskContainerMetadata) Reset() {
+	*x = ECSTaskContainerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ECSTaskContainerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECSTaskContainerMetadata) ProtoMessage() {}
+
+func (x *ECSTaskContainerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECSTaskContainerMetadata.ProtoReflect.Descriptor instead.
+func (*ECSTaskContainerMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ECSTaskContainerMetadata) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetContainerInstanceArn() string {
+	if x != nil {
+		return x.ContainerInstanceArn
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetTaskArn() string {
+	if x != nil {
+		return x.TaskArn
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetTaskFamily() string {
+	if x != nil {
+		return x.TaskFamily
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetTaskVersion() string {
+	if x != nil {
+		return x.TaskVersion
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetTaskStatus() string {
+	if x != nil {
+		return x.TaskStatus
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetDockerId() string {
+	if x != nil {
+		return x.DockerId
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
+func (x *ECSTaskContainerMetadata) GetSecrets() []*ECSSecret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ECSTaskContainerMetadata) GetMounts() []*ECSMount {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+// A secret from SSM Parameter Store or Secrets Manager injected into an ECS
+// container.
+type ECSSecret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueFrom     string `protobuf:"bytes,2,opt,name=value_from,json=valueFrom,proto3" json:"value_from,omitempty"`
+	Provider      string `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Type          string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	ContainerPath string `protobuf:"bytes,5,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
+}
+
+func (x *ECSSecret) Reset() {
+	*x = ECSSecret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ECSSecret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECSSecret) ProtoMessage() {}
+
+func (x *ECSSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECSSecret.ProtoReflect.Descriptor instead.
+func (*ECSSecret) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ECSSecret) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ECSSecret) GetValueFrom() string {
+	if x != nil {
+		return x.ValueFrom
+	}
+	return ""
+}
+
+func (x *ECSSecret) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ECSSecret) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ECSSecret) GetContainerPath() string {
+	if x != nil {
+		return x.ContainerPath
+	}
+	return ""
+}
+
+// A volume mounted into an ECS container.
+type ECSMount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volume        string `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SourcePath    string `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	ContainerPath string `protobuf:"bytes,3,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
+	ReadOnly      bool   `protobuf:"varint,4,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *ECSMount) Reset() {
+	*x = ECSMount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ECSMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECSMount) ProtoMessage() {}
+
+func (x *ECSMount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECSMount.ProtoReflect.Descriptor instead.
+func (*ECSMount) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ECSMount) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *ECSMount) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *ECSMount) GetContainerPath() string {
+	if x != nil {
+		return x.ContainerPath
+	}
+	return ""
+}
+
+func (x *ECSMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+// A pod running on a Kubernetes node.
+type KubeletPodMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid           string              `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	NamespaceName string              `protobuf:"bytes,2,opt,name=namespace_name,json=namespaceName,proto3" json:"namespace_name,omitempty"`
+	Name          string              `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Containers    []*KubeletContainer `protobuf:"bytes,4,rep,name=containers,proto3" json:"containers,omitempty"`
+	SecretVolumes []*KubeletVolume    `protobuf:"bytes,5,rep,name=secret_volumes,json=secretVolumes,proto3" json:"secret_volumes,omitempty"`
+}
+
+func (x *KubeletPodMetadata) Reset() {
+	*x = KubeletPodMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubeletPodMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubeletPodMetadata) ProtoMessage() {}
+
+func (x *KubeletPodMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubeletPodMetadata.ProtoReflect.Descriptor instead.
+func (*KubeletPodMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *KubeletPodMetadata) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *KubeletPodMetadata) GetNamespaceName() string {
+	if x != nil {
+		return x.NamespaceName
+	}
+	return ""
+}
+
+func (x *KubeletPodMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KubeletPodMetadata) GetContainers() []*KubeletContainer {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *KubeletPodMetadata) GetSecretVolumes() []*KubeletVolume {
+	if x != nil {
+		return x.SecretVolumes
+	}
+	return nil
+}
+
+type KubeletContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The container runtime's IDs of the container's instances.
+	Ids []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *KubeletContainer) Reset() {
+	*x = KubeletContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubeletContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubeletContainer) ProtoMessage() {}
+
+func (x *KubeletContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubeletContainer.ProtoReflect.Descriptor instead.
+func (*KubeletContainer) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *KubeletContainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KubeletContainer) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// A volume with secrets mounted into a pod.
+type KubeletVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Path  string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Files []string `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *KubeletVolume) Reset() {
+	*x = KubeletVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubeletVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubeletVolume) ProtoMessage() {}
+
+func (x *KubeletVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubeletVolume.ProtoReflect.Descriptor instead.
+func (*KubeletVolume) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *KubeletVolume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KubeletVolume) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KubeletVolume) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *KubeletVolume) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+// A control plane component of an OpenStack, CloudStack or Proxmox VE cloud.
+type PrivateCloudMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The cloud platform: "openstack", "cloudstack" or "proxmox".
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// The component of the platform, e.g. "nova" or "pve-manager".
+	Component   string                    `protobuf:"bytes,2,opt,name=component,proto3" json:"component,omitempty"`
+	Credentials []*PrivateCloudCredential `protobuf:"bytes,3,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *PrivateCloudMetadata) Reset() {
+	*x = PrivateCloudMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivateCloudMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateCloudMetadata) ProtoMessage() {}
+
+func (x *PrivateCloudMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateCloudMetadata.ProtoReflect.Descriptor instead.
+func (*PrivateCloudMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PrivateCloudMetadata) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *PrivateCloudMetadata) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *PrivateCloudMetadata) GetCredentials() []*PrivateCloudCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+type PrivateCloudCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type PrivateCloudCredential_TypeEnum `protobuf:"varint,1,opt,name=type,proto3,enum=scalibr.PrivateCloudCredential_TypeEnum" json:"type,omitempty"`
+	// The config key of the credential, e.g. "database.connection".
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The user the credential belongs to, e.g. "nova" or "root@pam!ci".
+	Principal string `protobuf:"bytes,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	// Empty for private keys, which are reported by location.
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The host the credential is used for, e.g. the database host.
+	Host string `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	// Whether the secret is stored encrypted, e.g. CloudStack's ENC() values.
+	Encrypted bool `protobuf:"varint,6,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	// Whether the credential grants administrative access to the cloud.
+	Admin bool `protobuf:"varint,7,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (x *PrivateCloudCredential) Reset() {
+	*x = PrivateCloudCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivateCloudCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateCloudCredential) ProtoMessage() {}
+
+func (x *PrivateCloudCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateCloudCredential.ProtoReflect.Descriptor instead.
+func (*PrivateCloudCredential) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PrivateCloudCredential) GetType() PrivateCloudCredential_TypeEnum {
+	if x != nil {
+		return x.Type
+	}
+	return PrivateCloudCredential_UNSPECIFIED
+}
+
+func (x *PrivateCloudCredential) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *PrivateCloudCredential) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *PrivateCloudCredential) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *PrivateCloudCredential) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *PrivateCloudCredential) GetEncrypted() bool {
+	if x != nil {
+		return x.Encrypted
+	}
+	return false
+}
+
+func (x *PrivateCloudCredential) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+// Core or an extension of a Drupal, Joomla or Magento site.
+type CMSExtensionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The CMS: "drupal", "joomla" or "magento".
+	Cms string `protobuf:"bytes,1,opt,name=cms,proto3" json:"cms,omitempty"`
+	// The kind of the extension, e.g. "core", "module", "theme" or "plugin".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The identifier of the extension in the CMS, e.g. the Drupal machine name,
+	// the Joomla element or the Magento module name or theme path.
+	Element     string `protobuf:"bytes,3,opt,name=element,proto3" json:"element,omitempty"`
+	DisplayName string `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Author      string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// The version as declared by the CMS if it differs from the package
+	// version, e.g. the drupal.org release or the Magento setup_version.
+	CmsVersion string `protobuf:"bytes,6,opt,name=cms_version,json=cmsVersion,proto3" json:"cms_version,omitempty"`
+	// The core versions a Drupal project is compatible with.
+	CoreCompatibility string `protobuf:"bytes,7,opt,name=core_compatibility,json=coreCompatibility,proto3" json:"core_compatibility,omitempty"`
+	// The Joomla application a module or template is installed for.
+	Client string `protobuf:"bytes,8,opt,name=client,proto3" json:"client,omitempty"`
+	// The Joomla plugin group.
+	Group string `protobuf:"bytes,9,opt,name=group,proto3" json:"group,omitempty"`
+	// The theme a Magento theme inherits from.
+	Parent string `protobuf:"bytes,10,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *CMSExtensionMetadata) Reset() {
+	*x = CMSExtensionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CMSExtensionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CMSExtensionMetadata) ProtoMessage() {}
+
+func (x *CMSExtensionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CMSExtensionMetadata.ProtoReflect.Descriptor instead.
+func (*CMSExtensionMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CMSExtensionMetadata) GetCms() string {
+	if x != nil {
+		return x.Cms
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetCmsVersion() string {
+	if x != nil {
+		return x.CmsVersion
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetCoreCompatibility() string {
+	if x != nil {
+		return x.CoreCompatibility
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *CMSExtensionMetadata) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+// A package installed with the PEAR or PECL installer.
+type PEARPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The channel of the package, e.g. "pear.php.net" or "pecl.php.net".
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// The PHP extension built from a PECL package.
+	ProvidesExtension string `protobuf:"bytes,2,opt,name=provides_extension,json=providesExtension,proto3" json:"provides_extension,omitempty"`
+	ReleaseDate       string `protobuf:"bytes,3,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+}
+
+func (x *PEARPackageMetadata) Reset() {
+	*x = PEARPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PEARPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PEARPackageMetadata) ProtoMessage() {}
+
+func (x *PEARPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PEARPackageMetadata.ProtoReflect.Descriptor instead.
+func (*PEARPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PEARPackageMetadata) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PEARPackageMetadata) GetProvidesExtension() string {
+	if x != nil {
+		return x.ProvidesExtension
+	}
+	return ""
+}
+
+func (x *PEARPackageMetadata) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+// A package installed by Composer, from vendor/composer/installed.json.
+type ComposerPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "dev" for packages that are only installed as require-dev dependencies.
+	DepGroups []string `protobuf:"bytes,1,rep,name=dep_groups,json=depGroups,proto3" json:"dep_groups,omitempty"`
+	// The Composer package type, e.g. "library".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// The install directory relative to vendor/composer.
+	InstallPath string `protobuf:"bytes,3,opt,name=install_path,json=installPath,proto3" json:"install_path,omitempty"`
+	// The directory of a package installed from a path repository.
+	PathRepository string `protobuf:"bytes,4,opt,name=path_repository,json=pathRepository,proto3" json:"path_repository,omitempty"`
+}
+
+func (x *ComposerPackageMetadata) Reset() {
+	*x = ComposerPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComposerPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComposerPackageMetadata) ProtoMessage() {}
+
+func (x *ComposerPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComposerPackageMetadata.ProtoReflect.Descriptor instead.
+func (*ComposerPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ComposerPackageMetadata) GetDepGroups() []string {
+	if x != nil {
+		return x.DepGroups
+	}
+	return nil
+}
+
+func (x *ComposerPackageMetadata) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ComposerPackageMetadata) GetInstallPath() string {
+	if x != nil {
+		return x.InstallPath
+	}
+	return ""
+}
+
+func (x *ComposerPackageMetadata) GetPathRepository() string {
+	if x != nil {
+		return x.PathRepository
+	}
+	return ""
+}
+
+// A Perl module installed from CPAN, as logged in perllocal.pod.
+type PerlModuleMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstallDir  string `protobuf:"bytes,1,opt,name=install_dir,json=installDir,proto3" json:"install_dir,omitempty"`
+	InstallDate string `protobuf:"bytes,2,opt,name=install_date,json=installDate,proto3" json:"install_date,omitempty"`
+}
+
+func (x *PerlModuleMetadata) Reset() {
+	*x = PerlModuleMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerlModuleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerlModuleMetadata) ProtoMessage() {}
+
+func (x *PerlModuleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerlModuleMetadata.ProtoReflect.Descriptor instead.
+func (*PerlModuleMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PerlModuleMetadata) GetInstallDir() string {
+	if x != nil {
+		return x.InstallDir
+	}
+	return ""
+}
+
+func (x *PerlModuleMetadata) GetInstallDate() string {
+	if x != nil {
+		return x.InstallDate
+	}
+	return ""
+}
+
+// A package installed from the Synology Package Center.
+type SynologyPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Maintainer  string `protobuf:"bytes,2,opt,name=maintainer,proto3" json:"maintainer,omitempty"`
+	// The CPU architectures the package was built for.
+	Arch string `protobuf:"bytes,3,opt,name=arch,proto3" json:"arch,omitempty"`
+	// The minimum DSM version the package runs on.
+	OsMinVersion string `protobuf:"bytes,4,opt,name=os_min_version,json=osMinVersion,proto3" json:"os_min_version,omitempty"`
+}
+
+func (x *SynologyPackageMetadata) Reset() {
+	*x = SynologyPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SynologyPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynologyPackageMetadata) ProtoMessage() {}
+
+func (x *SynologyPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynologyPackageMetadata.ProtoReflect.Descriptor instead.
+func (*SynologyPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SynologyPackageMetadata) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *SynologyPackageMetadata) GetMaintainer() string {
+	if x != nil {
+		return x.Maintainer
+	}
+	return ""
+}
+
+func (x *SynologyPackageMetadata) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *SynologyPackageMetadata) GetOsMinVersion() string {
+	if x != nil {
+		return x.OsMinVersion
+	}
+	return ""
+}
+
+// A QPKG package installed on a QNAP NAS.
+type QNAPPackageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Author      string `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Enabled     bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	InstallPath string `protobuf:"bytes,4,opt,name=install_path,json=installPath,proto3" json:"install_path,omitempty"`
+	Date        string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Build       string `protobuf:"bytes,6,opt,name=build,proto3" json:"build,omitempty"`
+}
+
+func (x *QNAPPackageMetadata) Reset() {
+	*x = QNAPPackageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QNAPPackageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QNAPPackageMetadata) ProtoMessage() {}
+
+func (x *QNAPPackageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QNAPPackageMetadata.ProtoReflect.Descriptor instead.
+func (*QNAPPackageMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *QNAPPackageMetadata) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *QNAPPackageMetadata) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *QNAPPackageMetadata) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *QNAPPackageMetadata) GetInstallPath() string {
+	if x != nil {
+		return x.InstallPath
+	}
+	return ""
+}
+
+func (x *QNAPPackageMetadata) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *QNAPPackageMetadata) GetBuild() string {
+	if x != nil {
+		return x.Build
+	}
+	return ""
+}
+
+// The build settings embedded in the Go binary a package was compiled into.
+type GoBinaryMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Module path of the binary's main package.
+	MainModule string `protobuf:"bytes,1,opt,name=main_module,json=mainModule,proto3" json:"main_module,omitempty"`
+	// The go build flags, e.g. "-tags=netgo" or "-trimpath=true".
+	BuildFlags []string `protobuf:"bytes,2,rep,name=build_flags,json=buildFlags,proto3" json:"build_flags,omitempty"`
+	TrimPath   bool     `protobuf:"varint,3,opt,name=trim_path,json=trimPath,proto3" json:"trim_path,omitempty"`
+	// The version control system, revision and revision time (RFC 3339) the
+	// binary was built from.
+	Vcs         string `protobuf:"bytes,4,opt,name=vcs,proto3" json:"vcs,omitempty"`
+	VcsRevision string `protobuf:"bytes,5,opt,name=vcs_revision,json=vcsRevision,proto3" json:"vcs_revision,omitempty"`
+	VcsTime     string `protobuf:"bytes,6,opt,name=vcs_time,json=vcsTime,proto3" json:"vcs_time,omitempty"`
+	// Whether the checkout had uncommitted changes.
+	VcsModified bool `protobuf:"varint,7,opt,name=vcs_modified,json=vcsModified,proto3" json:"vcs_modified,omitempty"`
+}
+
+func (x *GoBinaryMetadata) Reset() {
+	*x = GoBinaryMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoBinaryMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoBinaryMetadata) ProtoMessage() {}
+
+func (x *GoBinaryMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoBinaryMetadata.ProtoReflect.Descriptor instead.
+func (*GoBinaryMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GoBinaryMetadata) GetMainModule() string {
+	if x != nil {
+		return x.MainModule
+	}
+	return ""
+}
+
+func (x *GoBinaryMetadata) GetBuildFlags() []string {
+	if x != nil {
+		return x.BuildFlags
+	}
+	return nil
+}
+
+func (x *GoBinaryMetadata) GetTrimPath() bool {
+	if x != nil {
+		return x.TrimPath
+	}
+	return false
+}
+
+func (x *GoBinaryMetadata) GetVcs() string {
+	if x != nil {
+		return x.Vcs
+	}
+	return ""
+}
+
+func (x *GoBinaryMetadata) GetVcsRevision() string {
+	if x != nil {
+		return x.VcsRevision
+	}
+	return ""
+}
+
+func (x *GoBinaryMetadata) GetVcsTime() string {
+	if x != nil {
+		return x.VcsTime
+	}
+	return ""
+}
+
+func (x *GoBinaryMetadata) GetVcsModified() bool {
+	if x != nil {
+		return x.VcsModified
+	}
+	return false
+}
+
+// A dependency vendored into a source tree.
+type VendoredCodeMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The vendoring directory, e.g. "third_party".
+	VendorRoot string `protobuf:"bytes,1,opt,name=vendor_root,json=vendorRoot,proto3" json:"vendor_root,omitempty"`
+	// How the component was identified: "manifest", "hash", or empty if it
+	// couldn't be identified.
+	IdentifiedBy string `protobuf:"bytes,2,opt,name=identified_by,json=identifiedBy,proto3" json:"identified_by,omitempty"`
+	// The manifest the component was identified from.
+	Manifest string `protobuf:"bytes,3,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// The OSV ecosystem of the component, if any.
+	Ecosystem string `protobuf:"bytes,4,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	// The content digest of the component directory, e.g. "sha256:9f86d0...".
+	Digest string `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (x *VendoredCodeMetadata) Reset() {
+	*x = VendoredCodeMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VendoredCodeMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendoredCodeMetadata) ProtoMessage() {}
+
+func (x *VendoredCodeMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendoredCodeMetadata.ProtoReflect.Descriptor instead.
+func (*VendoredCodeMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *VendoredCodeMetadata) GetVendorRoot() string {
+	if x != nil {
+		return x.VendorRoot
+	}
+	return ""
+}
+
+func (x *VendoredCodeMetadata) GetIdentifiedBy() string {
+	if x != nil {
+		return x.IdentifiedBy
+	}
+	return ""
+}
+
+func (x *VendoredCodeMetadata) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *VendoredCodeMetadata) GetEcosystem() string {
+	if x != nil {
+		return x.Ecosystem
+	}
+	return ""
+}
+
+func (x *VendoredCodeMetadata) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+type DefenderExclusion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DefenderExclusion_TypeEnum `protobuf:"varint,1,opt,name=type,proto3,enum=scalibr.DefenderExclusion_TypeEnum" json:"type,omitempty"`
+	// The excluded path, extension, process or IP address as configured.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Whether the exclusion is configured through group policy.
+	FromPolicy bool `protobuf:"varint,3,opt,name=from_policy,json=fromPolicy,proto3" json:"from_policy,omitempty"`
+}
+
+func (x *DefenderExclusion) Reset() {
+	*x = DefenderExclusion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DefenderExclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefenderExclusion) ProtoMessage() {}
+
+func (x *DefenderExclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefenderExclusion.ProtoReflect.Descriptor instead.
+func (*DefenderExclusion) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DefenderExclusion) GetType() DefenderExclusion_TypeEnum {
+	if x != nil {
+		return x.Type
+	}
+	return DefenderExclusion_UNSPECIFIED
+}
+
+func (x *DefenderExclusion) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DefenderExclusion) GetFromPolicy() bool {
+	if x != nil {
+		return x.FromPolicy
+	}
+	return false
+}
+
+// Aggregate statistics of the scan results of a fleet of machines.
+type FleetStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scans       int32 `protobuf:"varint,1,opt,name=scans,proto3" json:"scans,omitempty"`
+	FailedScans int32 `protobuf:"varint,2,opt,name=failed_scans,json=failedScans,proto3" json:"failed_scans,omitempty"`
+	// Number of scans with at least one vulnerability.
+	VulnerableScans int32 `protobuf:"varint,3,opt,name=vulnerable_scans,json=vulnerableScans,proto3" json:"vulnerable_scans,omitempty"`
+	// The packages affected by vulnerabilities on the most scans.
+	TopVulnerablePackages []*FleetStats_VulnerablePackage `protobuf:"bytes,4,rep,name=top_vulnerable_packages,json=topVulnerablePackages,proto3" json:"top_vulnerable_packages,omitempty"`
+	// Number of secrets found per secret type.
+	SecretTypes []*FleetStats_Count `protobuf:"bytes,5,rep,name=secret_types,json=secretTypes,proto3" json:"secret_types,omitempty"`
+	// Number of packages found per ecosystem.
+	Ecosystems []*FleetStats_Count `protobuf:"bytes,6,rep,name=ecosystems,proto3" json:"ecosystems,omitempty"`
+	// Breakdown of the scans by the OS of the scanned machine.
+	Os []*FleetStats_OSStats `protobuf:"bytes,7,rep,name=os,proto3" json:"os,omitempty"`
+}
+
+func (x *FleetStats) Reset() {
+	*x = FleetStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetStats) ProtoMessage() {}
+
+func (x *FleetStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetStats.ProtoReflect.Descriptor instead.
+func (*FleetStats) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *FleetStats) GetScans() int32 {
+	if x != nil {
+		return x.Scans
+	}
+	return 0
+}
+
+func (x *FleetStats) GetFailedScans() int32 {
+	if x != nil {
+		return x.FailedScans
+	}
+	return 0
+}
+
+func (x *FleetStats) GetVulnerableScans() int32 {
+	if x != nil {
+		return x.VulnerableScans
+	}
+	return 0
+}
+
+func (x *FleetStats) GetTopVulnerablePackages() []*FleetStats_VulnerablePackage {
+	if x != nil {
+		return x.TopVulnerablePackages
+	}
+	return nil
+}
+
+func (x *FleetStats) GetSecretTypes() []*FleetStats_Count {
+	if x != nil {
+		return x.SecretTypes
+	}
+	return nil
+}
+
+func (x *FleetStats) GetEcosystems() []*FleetStats_Count {
+	if x != nil {
+		return x.Ecosystems
+	}
+	return nil
+}
+
+func (x *FleetStats) GetOs() []*FleetStats_OSStats {
+	if x != nil {
+		return x.Os
+	}
+	return nil
+}
+
+type RunScanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The directory to scan, as for the --root flag. Defaults to the root of the
+	// host's filesystem.
+	Root string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// The extractors and detectors to run, as for the --extractors and
+	// --detectors flags. Default to "default".
+	Extractors []string `protobuf:"bytes,2,rep,name=extractors,proto3" json:"extractors,omitempty"`
+	Detectors  []string `protobuf:"bytes,3,rep,name=detectors,proto3" json:"detectors,omitempty"`
+	// Individual files to extract instead of walking the root.
+	FilesToExtract []string `protobuf:"bytes,4,rep,name=files_to_extract,json=filesToExtract,proto3" json:"files_to_extract,omitempty"`
+	DirsToSkip     []string `protobuf:"bytes,5,rep,name=dirs_to_skip,json=dirsToSkip,proto3" json:"dirs_to_skip,omitempty"`
+	SkipDirRegex   string   `protobuf:"bytes,6,opt,name=skip_dir_regex,json=skipDirRegex,proto3" json:"skip_dir_regex,omitempty"`
+	// Labels to tag the scan result with.
+	Labels map[string]string `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The maximum number of packages, findings and secrets per chunk. Defaults
+	// to 1000.
+	ChunkSize int32 `protobuf:"varint,8,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// Findings with this or a higher severity are counted as above the
+	// threshold in the scan summary. Defaults to HIGH.
+	SeverityThreshold Severity_SeverityEnum `protobuf:"varint,9,opt,name=severity_threshold,json=severityThreshold,proto3,enum=scalibr.Severity_SeverityEnum" json:"severity_threshold,omitempty"`
+}
+
+func (x *RunScanRequest) Reset() {
+	*x = RunScanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunScanRequest) ProtoMessage() {}
+
+func (x *RunScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunScanRequest.ProtoReflect.Descriptor instead.
+func (*RunScanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RunScanRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *RunScanRequest) GetExtractors() []string {
+	if x != nil {
+		return x.Extractors
+	}
+	return nil
+}
+
+func (x *RunScanRequest) GetDetectors() []string {
+	if x != nil {
+		return x.Detectors
+	}
+	return nil
+}
+
+func (x *RunScanRequest) GetFilesToExtract() []string {
+	if x != nil {
+		return x.FilesToExtract
+	}
+	return nil
+}
+
+func (x *RunScanRequest) GetDirsToSkip() []string {
+	if x != nil {
+		return x.DirsToSkip
+	}
+	return nil
+}
+
+func (x *RunScanRequest) GetSkipDirRegex() string {
+	if x != nil {
+		return x.SkipDirRegex
+	}
+	return ""
+}
+
+func (x *RunScanRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RunScanRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *RunScanRequest) GetSeverityThreshold() Severity_SeverityEnum {
+	if x != nil {
+		return x.SeverityThreshold
+	}
+	return Severity_UNSPECIFIED
+}
+
+// A part of a streamed scan result.
+type ScanResultChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inventories []*Inventory `protobuf:"bytes,1,rep,name=inventories,proto3" json:"inventories,omitempty"`
+	Findings    []*Finding   `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
+	Secrets     []*Secret    `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Set in the last chunk: the scan result without its packages, findings and
+	// secrets.
+	Summary *ScanResult `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Set in the last chunk: the counts of the scan result.
+	ScanSummary *ScanSummary `protobuf:"bytes,5,opt,name=scan_summary,json=scanSummary,proto3" json:"scan_summary,omitempty"`
+}
+
+func (x *ScanResultChunk) Reset() {
+	*x = ScanResultChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanResultChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanResultChunk) ProtoMessage() {}
+
+func (x *ScanResultChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanResultChunk.ProtoReflect.Descriptor instead.
+func (*ScanResultChunk) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ScanResultChunk) GetInventories() []*Inventory {
+	if x != nil {
+		return x.Inventories
+	}
+	return nil
+}
+
+func (x *ScanResultChunk) GetFindings() []*Finding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ScanResultChunk) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ScanResultChunk) GetSummary() *ScanResult {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ScanResultChunk) GetScanSummary() *ScanSummary {
+	if x != nil {
+		return x.ScanSummary
+	}
+	return nil
+}
+
+// The compact outcome of a scan, for CI and orchestration systems that act on
+// a scan without parsing the full result.
+type ScanSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status of the overall scan.
+	Status   *ScanStatus          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Number of packages found.
+	Packages int32 `protobuf:"varint,3,opt,name=packages,proto3" json:"packages,omitempty"`
+	// Number of findings per severity, most severe first.
+	Findings []*ScanSummary_SeverityCount `protobuf:"bytes,4,rep,name=findings,proto3" json:"findings,omitempty"`
+	// Number of findings at or above severity_threshold.
+	FindingsAboveThreshold int32                 `protobuf:"varint,5,opt,name=findings_above_threshold,json=findingsAboveThreshold,proto3" json:"findings_above_threshold,omitempty"`
+	SeverityThreshold      Severity_SeverityEnum `protobuf:"varint,6,opt,name=severity_threshold,json=severityThreshold,proto3,enum=scalibr.Severity_SeverityEnum" json:"severity_threshold,omitempty"`
+	// Number of secrets per validation status.
+	Secrets []*ScanSummary_ValidationCount `protobuf:"bytes,7,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	// Names of the plugins that failed.
+	FailedPlugins []string `protobuf:"bytes,8,rep,name=failed_plugins,json=failedPlugins,proto3" json:"failed_plugins,omitempty"`
+	// The exit code of the SCALIBR binary for this outcome. The enum values are
+	// the exit codes.
+	ExitCode ScanSummary_ExitCodeEnum `protobuf:"varint,9,opt,name=exit_code,json=exitCode,proto3,enum=scalibr.ScanSummary_ExitCodeEnum" json:"exit_code,omitempty"`
+}
+
+func (x *ScanSummary) Reset() {
+	*x = ScanSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanSummary) ProtoMessage() {}
+
+func (x *ScanSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanSummary.ProtoReflect.Descriptor instead.
+func (*ScanSummary) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ScanSummary) GetStatus() *ScanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ScanSummary) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ScanSummary) GetPackages() int32 {
+	if x != nil {
+		return x.Packages
+	}
+	return 0
+}
+
+func (x *ScanSummary) GetFindings() []*ScanSummary_SeverityCount {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ScanSummary) GetFindingsAboveThreshold() int32 {
+	if x != nil {
+		return x.FindingsAboveThreshold
+	}
+	return 0
+}
+
+func (x *ScanSummary) GetSeverityThreshold() Severity_SeverityEnum {
+	if x != nil {
+		return x.SeverityThreshold
+	}
+	return Severity_UNSPECIFIED
+}
+
+func (x *ScanSummary) GetSecrets() []*ScanSummary_ValidationCount {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *ScanSummary) GetFailedPlugins() []string {
+	if x != nil {
+		return x.FailedPlugins
+	}
+	return nil
+}
+
+func (x *ScanSummary) GetExitCode() ScanSummary_ExitCodeEnum {
+	if x != nil {
+		return x.ExitCode
+	}
+	return ScanSummary_CLEAN
+}
+
+type ListPluginsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPluginsRequest) Reset() {
+	*x = ListPluginsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPluginsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginsRequest) ProtoMessage() {}
+
+func (x *ListPluginsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginsRequest.ProtoReflect.Descriptor instead.
+func (*ListPluginsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{103}
+}
+
+type ListPluginsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugins []*PluginDescription `protobuf:"bytes,1,rep,name=plugins,proto3" json:"plugins,omitempty"`
+}
+
+func (x *ListPluginsResponse) Reset() {
+	*x = ListPluginsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPluginsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginsResponse) ProtoMessage() {}
+
+func (x *ListPluginsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginsResponse.ProtoReflect.Descriptor instead.
+func (*ListPluginsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListPluginsResponse) GetPlugins() []*PluginDescription {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+type PluginDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version int32                      `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Type    PluginDescription_TypeEnum `protobuf:"varint,3,opt,name=type,proto3,enum=scalibr.PluginDescription_TypeEnum" json:"type,omitempty"`
+}
+
+func (x *PluginDescription) Reset() {
+	*x = PluginDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginDescription) ProtoMessage() {}
+
+func (x *PluginDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginDescription.ProtoReflect.Descriptor instead.
+func (*PluginDescription) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *PluginDescription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PluginDescription) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PluginDescription) GetType() PluginDescription_TypeEnum {
+	if x != nil {
+		return x.Type
+	}
+	return PluginDescription_UNSPECIFIED
+}
+
+type FleetStats_Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FleetStats_Count) Reset() {
+	*x = FleetStats_Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetStats_Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetStats_Count) ProtoMessage() {}
+
+func (x *FleetStats_Count) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetStats_Count.ProtoReflect.Descriptor instead.
+func (*FleetStats_Count) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{99, 0}
+}
+
+func (x *FleetStats_Count) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FleetStats_Count) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FleetStats_VulnerablePackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Ecosystem string `protobuf:"bytes,3,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	// Number of scans the vulnerable package was found on.
+	Scans      int32    `protobuf:"varint,4,opt,name=scans,proto3" json:"scans,omitempty"`
+	Advisories []string `protobuf:"bytes,5,rep,name=advisories,proto3" json:"advisories,omitempty"`
+}
+
+func (x *FleetStats_VulnerablePackage) Reset() {
+	*x = FleetStats_VulnerablePackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetStats_VulnerablePackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetStats_VulnerablePackage) ProtoMessage() {}
+
+func (x *FleetStats_VulnerablePackage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetStats_VulnerablePackage.ProtoReflect.Descriptor instead.
+func (*FleetStats_VulnerablePackage) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{99, 1}
+}
+
+func (x *FleetStats_VulnerablePackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FleetStats_VulnerablePackage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *FleetStats_VulnerablePackage) GetEcosystem() string {
+	if x != nil {
+		return x.Ecosystem
+	}
+	return ""
+}
+
+func (x *FleetStats_VulnerablePackage) GetScans() int32 {
+	if x != nil {
+		return x.Scans
+	}
+	return 0
+}
+
+func (x *FleetStats_VulnerablePackage) GetAdvisories() []string {
+	if x != nil {
+		return x.Advisories
+	}
+	return nil
+}
+
+type FleetStats_OSStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Os              string `protobuf:"bytes,1,opt,name=os,proto3" json:"os,omitempty"`
+	Scans           int32  `protobuf:"varint,2,opt,name=scans,proto3" json:"scans,omitempty"`
+	VulnerableScans int32  `protobuf:"varint,3,opt,name=vulnerable_scans,json=vulnerableScans,proto3" json:"vulnerable_scans,omitempty"`
+	Packages        int32  `protobuf:"varint,4,opt,name=packages,proto3" json:"packages,omitempty"`
+	Secrets         int32  `protobuf:"varint,5,opt,name=secrets,proto3" json:"secrets,omitempty"`
+}
+
+func (x *FleetStats_OSStats) Reset() {
+	*x = FleetStats_OSStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetStats_OSStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetStats_OSStats) ProtoMessage() {}
+
+func (x *FleetStats_OSStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetStats_OSStats.ProtoReflect.Descriptor instead.
+func (*FleetStats_OSStats) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{99, 2}
+}
+
+func (x *FleetStats_OSStats) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *FleetStats_OSStats) GetScans() int32 {
+	if x != nil {
+		return x.Scans
+	}
+	return 0
+}
+
+func (x *FleetStats_OSStats) GetVulnerableScans() int32 {
+	if x != nil {
+		return x.VulnerableScans
+	}
+	return 0
+}
+
+func (x *FleetStats_OSStats) GetPackages() int32 {
+	if x != nil {
+		return x.Packages
+	}
+	return 0
+}
+
+func (x *FleetStats_OSStats) GetSecrets() int32 {
+	if x != nil {
+		return x.Secrets
+	}
+	return 0
+}
+
+type ScanSummary_SeverityCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Severity Severity_SeverityEnum `protobuf:"varint,1,opt,name=severity,proto3,enum=scalibr.Severity_SeverityEnum" json:"severity,omitempty"`
+	Count    int32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ScanSummary_SeverityCount) Reset() {
+	*x = ScanSummary_SeverityCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanSummary_SeverityCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanSummary_SeverityCount) ProtoMessage() {}
+
+func (x *ScanSummary_SeverityCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanSummary_SeverityCount.ProtoReflect.Descriptor instead.
+func (*ScanSummary_SeverityCount) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{102, 0}
+}
+
+func (x *ScanSummary_SeverityCount) GetSeverity() Severity_SeverityEnum {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_UNSPECIFIED
+}
+
+func (x *ScanSummary_SeverityCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ScanSummary_ValidationCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Validation Secret_ValidationEnum `protobuf:"varint,1,opt,name=validation,proto3,enum=scalibr.Secret_ValidationEnum" json:"validation,omitempty"`
+	Count      int32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ScanSummary_ValidationCount) Reset() {
+	*x = ScanSummary_ValidationCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_scan_result_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanSummary_ValidationCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanSummary_ValidationCount) ProtoMessage() {}
+
+func (x *ScanSummary_ValidationCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_scan_result_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanSummary_ValidationCount.ProtoReflect.Descriptor instead.
+func (*ScanSummary_ValidationCount) Descriptor() ([]byte, []int) {
+	return file_proto_scan_result_proto_rawDescGZIP(), []int{102, 1}
+}
+
+func (x *ScanSummary_ValidationCount) GetValidation() Secret_ValidationEnum {
+	if x != nil {
+		return x.Validation
+	}
+	return Secret_VALIDATION_UNSPECIFIED
+}
+
+func (x *ScanSummary_ValidationCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_proto_scan_result_proto protoreflect.FileDescriptor
+
+var file_proto_scan_result_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x05, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a,
+	0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x33, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x51,
+	0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x0a, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xc6, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e,
+	0x75, 0x6d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x55, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59,
+	0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x65,
+	0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x22, 0xc4, 0x01,
+	0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0c,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x0a, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x03, 0x0a, 0x06,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f,
+	0x57, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x03, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1a, 0x0a, 0x16,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x04, 0x22, 0x42, 0x0a, 0x10, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2e, 0x0a,
+	0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xb8, 0x01,
+	0x0a, 0x0f, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x61, 0x6e, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x6e,
+	0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x22, 0x4e, 0x0a, 0x0e, 0x43, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x0e, 0x75, 0x6e,
+	0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x55, 0x6e, 0x70,
+	0x61, 0x72, 0x73, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0d, 0x75, 0x6e, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0c, 0x55, 0x6e, 0x70,
+	0x61, 0x72, 0x73, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x38, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x0a, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f,
+	0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x22, 0xae, 0x27, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x75, 0x72, 0x6c,
+	0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x37, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x63, 0x6f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x49, 0x0a, 0x0f, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x79,
+	0x74, 0x68, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x59, 0x0a, 0x13,
+	0x6a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x12, 0x6a, 0x61, 0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x15, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x72, 0x6f, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x6f, 0x6e,
+	0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x61,
+	0x70, 0x6b, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41, 0x50, 0x4b, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00,
+	0x52, 0x0b, 0x61, 0x70, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a,
+	0x0d, 0x64, 0x70, 0x6b, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44,
+	0x50, 0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x70, 0x6b, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x72, 0x70, 0x6d, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x52, 0x50, 0x4d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x70, 0x6d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x6f, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x4f, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x11, 0x64, 0x65, 0x70, 0x73, 0x6a, 0x73,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44, 0x45, 0x50, 0x53,
+	0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10,
+	0x64, 0x65, 0x70, 0x73, 0x6a, 0x73, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x43, 0x0a, 0x0d, 0x73, 0x70, 0x64, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x53, 0x50, 0x44, 0x58, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x70, 0x64, 0x78, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x15, 0x6a, 0x61, 0x76, 0x61, 0x5f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4a,
+	0x61, 0x76, 0x61, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x6a, 0x61, 0x76, 0x61, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x16, 0x6a, 0x61, 0x76,
+	0x61, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x4c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x14, 0x6a, 0x61, 0x76, 0x61,
+	0x4c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x59, 0x0a, 0x18, 0x6a, 0x61, 0x76, 0x61, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76,
+	0x61, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x15, 0x6a, 0x61, 0x76, 0x61, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x15, 0x6a,
+	0x61, 0x76, 0x61, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x6a, 0x61, 0x76, 0x61,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x49, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6d, 0x61, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x50, 0x41, 0x43, 0x4d, 0x41, 0x4e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6d,
+	0x61, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a, 0x0f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c,
+	0x0a, 0x10, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0c,
+	0x6f, 0x73, 0x76, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4f, 0x53, 0x56,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x0b, 0x6f, 0x73, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x67,
+	0x0a, 0x1c, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50,
+	0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x1a, 0x70, 0x79, 0x74,
+	0x68, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6a, 0x0a, 0x1d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x4e, 0x41, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x10, 0x66, 0x6c, 0x61, 0x74,
+	0x70, 0x61, 0x6b, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x46, 0x6c, 0x61,
+	0x74, 0x70, 0x61, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x66, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x46, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x5f, 0x61, 0x70,
+	0x70, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4d, 0x61, 0x63, 0x41,
+	0x70, 0x70, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0f, 0x6d,
+	0x61, 0x63, 0x41, 0x70, 0x70, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x80,
+	0x01, 0x0a, 0x25, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x5f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x22, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x40, 0x0a, 0x0c, 0x63, 0x64, 0x78, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x43, 0x44, 0x58, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x64, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x5a, 0x0a, 0x1b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x5f, 0x6f,
+	0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x18, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x4f, 0x73,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x74, 0x0a, 0x21, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x53, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x1e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x15, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x69, 0x70,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x2b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4c,
+	0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5b, 0x0a, 0x18, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x16,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x13, 0x61, 0x70, 0x70, 0x5f, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x2f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41, 0x70,
+	0x70, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x11, 0x61, 0x70, 0x70, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x14, 0x69, 0x61, 0x63, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x30, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x49, 0x61, 0x43,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x12, 0x69, 0x61, 0x63, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5b, 0x0a, 0x18, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x16, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x5b, 0x0a, 0x18, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x4c, 0x0a, 0x13, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x11, 0x65, 0x64, 0x67, 0x65,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a,
+	0x19, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x17, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x45, 0x0a,
+	0x10, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x0f, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x41, 0x70, 0x70, 0x53, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x15, 0x61, 0x70, 0x70, 0x53, 0x75, 0x70, 0x65,
+	0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x55,
+	0x0a, 0x16, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x14, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x19, 0x77, 0x73, 0x6c, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x57, 0x53, 0x4c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x17, 0x77, 0x73,
+	0x6c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x62, 0x0a, 0x1b, 0x65, 0x63, 0x73, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x45, 0x43, 0x53, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x18, 0x65, 0x63, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x14, 0x6b, 0x75, 0x62,
+	0x65, 0x6c, 0x65, 0x74, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x50, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x6b, 0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x50,
+	0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x16, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x14, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x55, 0x0a, 0x16, 0x63, 0x6d, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x3e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x4d, 0x53, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x14, 0x63, 0x6d, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x58, 0x0a, 0x17, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x2e, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x15, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x52, 0x0a, 0x15, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x40, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x42, 0x61, 0x7a, 0x65,
+	0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x13, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x11, 0x6b, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x6f, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x41, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4b, 0x65, 0x72, 0x62,
+	0x65, 0x72, 0x6f, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x10,
+	0x6b, 0x65, 0x72, 0x62, 0x65, 0x72, 0x6f, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x52, 0x0a, 0x15, 0x70, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x42, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x45, 0x41, 0x52, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x13, 0x70, 0x65, 0x61, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x6c, 0x5f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x43, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x65, 0x72,
+	0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x12, 0x70, 0x65, 0x72, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x19, 0x73, 0x79, 0x6e, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x44, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x17, 0x73, 0x79,
+	0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x52, 0x0a, 0x15, 0x71, 0x6e, 0x61, 0x70, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x45,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x51,
+	0x4e, 0x41, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x13, 0x71, 0x6e, 0x61, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x12, 0x67, 0x6f, 0x5f,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x49, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x47, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x10, 0x67, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x16, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x4a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x56,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x14, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x65, 0x64, 0x43,
+	0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5e, 0x0a, 0x19, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x43, 0x0a, 0x0b, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x75, 0x6d, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x3a, 0x0a, 0x0d, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0c,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x46, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x48, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x60,
+	0x0a, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x4f, 0x53,
+	0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e,
+	0x53, 0x49, 0x44, 0x45, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x10, 0x03,
+	0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x5b, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x14, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x0c, 0x4c, 0x61,
+	0x79, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x69, 0x66, 0x66, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x42, 0x61,
+	0x73, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x22, 0xc8, 0x01, 0x0a, 0x04, 0x50, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x32,
+	0x0a, 0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x51, 0x75, 0x61,
+	0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0a, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x70, 0x61, 0x74, 0x68, 0x22, 0x33, 0x0a, 0x09,
+	0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x92, 0x01, 0x0a, 0x07, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a,
+	0x03, 0x61, 0x64, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x52, 0x03, 0x61,
+	0x64, 0x76, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x08, 0x41, 0x64, 0x76, 0x69, 0x73,
+	0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x2e, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e,
+	0x75, 0x6d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x65, 0x76, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x03, 0x73, 0x65, 0x76, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x3b, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e,
+	0x75, 0x6d, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x49, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x22, 0x48, 0x0a, 0x0a, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xf1, 0x01,
+	0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x76, 0x73, 0x73, 0x5f, 0x76,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x43, 0x56, 0x53, 0x53, 0x52, 0x06, 0x63, 0x76, 0x73, 0x73, 0x56, 0x32, 0x12, 0x26,
+	0x0a, 0x07, 0x63, 0x76, 0x73, 0x73, 0x5f, 0x76, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x43, 0x56, 0x53, 0x53, 0x52, 0x06,
+	0x63, 0x76, 0x73, 0x73, 0x56, 0x33, 0x22, 0x59, 0x0a, 0x0c, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x49, 0x4e, 0x49, 0x4d,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x0a, 0x0a,
+	0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47,
+	0x48, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10,
+	0x05, 0x22, 0x7d, 0x0a, 0x04, 0x43, 0x56, 0x53, 0x53, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x62,
+	0x61, 0x73, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x2f, 0x0a, 0x13, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x5d, 0x0a, 0x0d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x30, 0x0a, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x79, 0x0a, 0x15, 0x50, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x79, 0x74,
+	0x68, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x1d, 0x4a, 0x61,
+	0x76, 0x61, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4a,
+	0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x7c, 0x0a, 0x13, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x72, 0x6f, 0x6e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x12, 0x41, 0x50, 0x4b, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x03, 0x0a, 0x13, 0x44, 0x50,
+	0x4b, 0x47, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x73,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xe4, 0x02, 0x0a, 0x12, 0x52,
+	0x50, 0x4d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72,
+	0x70, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x70, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x73, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x22, 0xad, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x2e, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x41,
+	0x0a, 0x0a, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x49, 0x53,
+	0x54, 0x52, 0x4f, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x59, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10,
+	0x03, 0x22, 0xa1, 0x01, 0x0a, 0x12, 0x43, 0x4f, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4d, 0x41, 0x4e,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6f,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x10, 0x44, 0x45, 0x50, 0x53,
+	0x4a, 0x53, 0x4f, 0x4e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xfc, 0x01, 0x0a,
+	0x13, 0x53, 0x4e, 0x41, 0x50, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x16,
+	0x50, 0x6f, 0x72, 0x74, 0x61, 0x67, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x16,
+	0x46, 0x6c, 0x61, 0x74, 0x70, 0x61, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x13, 0x0a,
+	0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x73, 0x5f, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x72, 0x22, 0xe2, 0x02, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x12, 0x49,
+	0x0a, 0x21, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x73, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x22, 0xbb, 0x03, 0x0a, 0x0f, 0x4d, 0x61,
+	0x63, 0x41, 0x70, 0x70, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a,
+	0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x18, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x71, 0x0a, 0x0f, 0x41, 0x6e, 0x64, 0x72, 0x6f,
+	0x69, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x03, 0x61, 0x70,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x41, 0x70, 0x70, 0x52, 0x03, 0x61, 0x70,
+	0x70, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x41,
+	0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x03, 0x0a, 0x0a, 0x41,
+	0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x41, 0x70, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x61, 0x70, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x70, 0x70,
+	0x12, 0x31, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x10, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x64, 0x6b, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x64, 0x6b,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x53,
+	0x50, 0x44, 0x58, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x21, 0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x75, 0x72, 0x6c, 0x52,
+	0x04, 0x70, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x70, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x43, 0x44, 0x58,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x21, 0x0a, 0x04, 0x70, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x75, 0x72, 0x6c, 0x52, 0x04, 0x70, 0x75,
+	0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x70, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x4a, 0x61, 0x76, 0x61, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x68, 0x61,
+	0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x68, 0x61, 0x31, 0x22, 0x70, 0x0a,
+	0x15, 0x4a, 0x61, 0x76, 0x61, 0x41, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0xd4, 0x01, 0x0a, 0x13, 0x4a, 0x61, 0x76, 0x61, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x0b,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x0a, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x4a, 0x61, 0x76, 0x61, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x43, 0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4a, 0x61, 0x76, 0x61, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x16, 0x4a,
+	0x61, 0x76, 0x61, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x22, 0x78,
+	0x0a, 0x14, 0x4a, 0x61, 0x76, 0x61, 0x4c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x76, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x4f, 0x53, 0x56,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x72, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x41,
+	0x73, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x50, 0x79, 0x74, 0x68, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x39, 0x0a, 0x19, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x16, 0x68, 0x61, 0x73, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xdc, 0x02, 0x0a, 0x1b, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x74, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x69,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x70, 0x65, 0x72, 0x44, 0x69, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x69, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x22, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x64, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x74, 0x66, 0x73, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74,
+	0x66, 0x73, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4f, 0x0a, 0x10, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x1e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x2e, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x11,
+	0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x50, 0x69, 0x70, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x3f, 0x0a, 0x15, 0x4c,
+	0x6f, 0x67, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9a, 0x01, 0x0a,
+	0x16, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x18, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63,
+	0x69, 0x70, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x22, 0x46, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x45, 0x50, 0x48, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x5f, 0x4b, 0x45, 0x59,
+	0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x53, 0x33, 0x10, 0x03, 0x22, 0x61, 0x0a, 0x11, 0x41, 0x70,
+	0x70, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x98, 0x01,
+	0x0a, 0x15, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x44, 0x69, 0x72, 0x22, 0x62, 0x0a, 0x13, 0x42, 0x61, 0x7a, 0x65,
+	0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x22, 0x7d, 0x0a, 0x12,
+	0x49, 0x61, 0x43, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x49, 0x61, 0x43, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0xde, 0x01, 0x0a, 0x09,
+	0x49, 0x61, 0x43, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x49, 0x61, 0x43, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0x56, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x45, 0x44, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x42,
+	0x41, 0x47, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4c, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x58,
+	0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x03, 0x22, 0x71, 0x0a, 0x16,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x22,
+	0xfa, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x38,
+	0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x74, 0x6c, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x6c,
+	0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x09, 0x67, 0x6f, 0x5f, 0x73, 0x75, 0x6d, 0x5f, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x6f, 0x53, 0x75, 0x6d, 0x44, 0x62, 0x12, 0x1f, 0x0a, 0x0c, 0x67,
+	0x6f, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x6d, 0x5f, 0x64, 0x62, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x6f, 0x4e, 0x6f, 0x53, 0x75, 0x6d, 0x44, 0x62, 0x22, 0xbf, 0x01, 0x0a,
+	0x0f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x45,
+	0x6e, 0x75, 0x6d, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22,
+	0x4d, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x58, 0x54, 0x52, 0x41,
+	0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x43, 0x4f, 0x50,
+	0x45, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x10, 0x04, 0x22, 0x9a,
+	0x01, 0x0a, 0x11, 0x45, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x0e,
+	0x45, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x6c, 0x73,
+	0x22, 0x84, 0x02, 0x0a, 0x13, 0x45, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x2e, 0x45, 0x64, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x62, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10,
+	0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45,
+	0x5f, 0x4b, 0x45, 0x59, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x48,
+	0x45, 0x41, 0x44, 0x45, 0x52, 0x10, 0x04, 0x22, 0xb7, 0x02, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x45, 0x6e, 0x75, 0x6d, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69,
+	0x76, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x68, 0x69, 0x76,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x48,
+	0x69, 0x76, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0x56, 0x0a, 0x0c, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x54,
+	0x44, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x47, 0x50, 0x50, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12,
+	0x10, 0x0a, 0x0c, 0x4c, 0x4f, 0x47, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x10,
+	0x03, 0x22, 0x80, 0x02, 0x0a, 0x10, 0x4b, 0x65, 0x72, 0x62, 0x65, 0x72, 0x6f, 0x73, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12,
+	0x2e, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4b, 0x65, 0x72, 0x62, 0x65, 0x72,
+	0x6f, 0x73, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x52, 0x06, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x73, 0x12,
+	0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4b, 0x65,
+	0x72, 0x62, 0x65, 0x72, 0x6f, 0x73, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x53, 0x53, 0x44, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65,
+	0x79, 0x74, 0x61, 0x62, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x74, 0x61, 0x62, 0x73, 0x22, 0x5a, 0x0a, 0x0d, 0x4b, 0x65, 0x72, 0x62, 0x65, 0x72, 0x6f, 0x73,
+	0x52, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x64, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x64, 0x63, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x22, 0x6e, 0x0a, 0x11, 0x4b, 0x65, 0x72, 0x62, 0x65, 0x72, 0x6f, 0x73, 0x50, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x76, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6b,
+	0x76, 0x6e, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x22, 0xae, 0x01, 0x0a, 0x0a, 0x53, 0x53, 0x53, 0x44, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74,
+	0x68, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61,
+	0x6c, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x65, 0x79,
+	0x74, 0x61, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x74, 0x61,
+	0x62, 0x22, 0x9f, 0x01, 0x0a, 0x19, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0xc6, 0x02, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x53, 0x75, 0x70, 0x65, 0x72,
+	0x76, 0x69, 0x73, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x81, 0x01, 0x0a,
+	0x14, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x04,
+	0x64, 0x6b, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x63, 0x61,
+	0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44, 0x4b, 0x4d, 0x53, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x04, 0x64, 0x6b, 0x6d, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x22, 0x82, 0x02, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4b, 0x65, 0x72, 0x6e, 0x65,
+	0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x72,
+	0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x66, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x6b, 0x6d, 0x73, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x6b, 0x6d, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x7a, 0x0a, 0x0b, 0x44, 0x4b, 0x4d, 0x53, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x62, 0x75, 0x69, 0x6c,
+	0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
+	0x72, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x62, 0x75, 0x69, 0x6c,
+	0x74, 0x46, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x72, 0x6e, 0x65,
+	0x6c, 0x22, 0x83, 0x02, 0x0a, 0x17, 0x57, 0x53, 0x4c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x73, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x77, 0x73, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x6f, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6f, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6f,
+	0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64,
+	0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73,
+	0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xc9, 0x03, 0x0a, 0x18, 0x45, 0x43, 0x53, 0x54,
+	0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x34,
+	0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x41, 0x72, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x72, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x45, 0x43, 0x53, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x45, 0x43, 0x53, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x45, 0x43, 0x53, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x22, 0x87, 0x01, 0x0a, 0x08,
+	0x45, 0x43, 0x53, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xdb, 0x01, 0x0a, 0x12, 0x4b, 0x75, 0x62, 0x65, 0x6c, 0x65,
+	0x74, 0x50, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x10, 0x4b, 0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x61, 0x0a,
+	0x0d, 0x4b, 0x75, 0x62, 0x65, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0x93, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0xa1, 0x03, 0x0a, 0x16, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22,
+	0xb8, 0x01, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f,
+	0x52, 0x44, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x5f, 0x54, 0x4f,
+	0x4b, 0x45, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f,
+	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x50, 0x49, 0x5f,
+	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57,
+	0x4f, 0x52, 0x44, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45,
+	0x5f, 0x4b, 0x45, 0x59, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x43, 0x52, 0x59, 0x50,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x09, 0x22, 0xa7, 0x02, 0x0a, 0x14, 0x43,
+	0x4d, 0x53, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6d, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6d, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x50, 0x45, 0x41, 0x52, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x58, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x22, 0x96, 0x01,
+	0x0a, 0x17, 0x53, 0x79, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x73, 0x4d, 0x69, 0x6e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x51, 0x4e, 0x41, 0x50, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x22, 0xe4, 0x01, 0x0a, 0x10, 0x47, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x6d, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x72, 0x69, 0x6d,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x76, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x63, 0x73, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x63,
+	0x73, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x63, 0x73,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x63, 0x73,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x63, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x76, 0x63, 0x73, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x14, 0x56, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x6f, 0x6f,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x66,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75,
+	0x6d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x51,
+	0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50,
+	0x41, 0x54, 0x48, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49,
+	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x10,
+	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10,
+	0x04, 0x22, 0xd3, 0x05, 0x0a, 0x0a, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x53, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x75, 0x6c,
+	0x6e, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x63, 0x61, 0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x17, 0x74, 0x6f, 0x70, 0x5f, 0x76, 0x75, 0x6c, 0x6e,
+	0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x15, 0x74, 0x6f,
+	0x70, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x2e, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x0a, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x02,
+	0x6f, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x4f, 0x53,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x02, 0x6f, 0x73, 0x1a, 0x31, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x95, 0x01, 0x0a,
+	0x11, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x63, 0x6f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x61, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x1a, 0x90, 0x01, 0x0a, 0x07, 0x4f, 0x53, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x61, 0x6e,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0xba, 0x03, 0x0a, 0x0e, 0x52, 0x75, 0x6e, 0x53,
+	0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x6f, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69,
+	0x72, 0x73, 0x54, 0x6f, 0x53, 0x6b, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x64, 0x69, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x44, 0x69, 0x72, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x3b,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72,
+	0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x11, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x88, 0x02, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x34, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
+	0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22,
+	0xc0, 0x06, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x3e, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x38, 0x0a, 0x18, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x61, 0x62, 0x6f, 0x76,
+	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x16, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x41, 0x62, 0x6f, 0x76, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x4d, 0x0a, 0x12, 0x73, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x11, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12,
+	0x3e, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61,
+	0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x1a,
+	0x61, 0x0a, 0x0d, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x3a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x45, 0x6e,
+	0x75, 0x6d, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0x67, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x62, 0x72, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x0c, 0x45,
+	0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x09, 0x0a, 0x05, 0x43,
+	0x4c, 0x45, 0x41, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x53, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f,
+	0x4c, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x46, 0x49, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x53,
+	0x5f, 0x41, 0x42, 0x4f, 0x56, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44,
+	0x10, 0x04, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62,
+	0x72, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x22, 0x5d, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x45, 0x58,
+	0x54, 0x52, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41,
+	0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x4f,
+	0x52, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10,
+	0x03, 0x32, 0x97, 0x01, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x40, 0x0a,
+	0x07, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x17, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x62, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x4a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x1b,
+	0x2e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x63,
+	0x61, 0x6c, 0x69, 0x62, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f, 0x50, 0x01, 0x5a,
+	0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x62, 0x72, 0x2f, 0x62, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_scan_result_proto_rawDescOnce sync.Once
+	file_proto_scan_result_proto_rawDescData = file_proto_scan_result_proto_rawDesc
+)
+
+func file_proto_scan_result_proto_rawDescGZIP() []byte {
+	file_proto_scan_result_proto_rawDescOnce.Do(func() {
+		file_proto_scan_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_scan_result_proto_rawDescData)
+	})
+	return file_proto_scan_result_proto_rawDescData
+}
+
+var file_proto_scan_result_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_proto_scan_result_proto_msgTypes = make([]protoimpl.MessageInfo, 113)
+var file_proto_scan_result_proto_goTypes = []interface{}{
+	(ScanStatus_ScanStatusEnum)(0),             // 0: scalibr.ScanStatus.ScanStatusEnum
+	(Secret_ConfidenceEnum)(0),                 // 1: scalibr.Secret.ConfidenceEnum
+	(Secret_ValidationEnum)(0),                 // 2: scalibr.Secret.ValidationEnum
+	(UnparsedFile_ReasonEnum)(0),               // 3: scalibr.UnparsedFile.ReasonEnum
+	(Inventory_AnnotationEnum)(0),              // 4: scalibr.Inventory.AnnotationEnum
+	(Advisory_TypeEnum)(0),                     // 5: scalibr.Advisory.TypeEnum
+	(Severity_SeverityEnum)(0),                 // 6: scalibr.Severity.SeverityEnum
+	(PackageOrigin_OriginEnum)(0),              // 7: scalibr.PackageOrigin.OriginEnum
+	(StorageClusterCredential_TypeEnum)(0),     // 8: scalibr.StorageClusterCredential.TypeEnum
+	(IaCSecret_TypeEnum)(0),                    // 9: scalibr.IaCSecret.TypeEnum
+	(PackageRegistry_RoleEnum)(0),              // 10: scalibr.PackageRegistry.RoleEnum
+	(EdgeProxyCredential_TypeEnum)(0),          // 11: scalibr.EdgeProxyCredential.TypeEnum
+	(ActiveDirectoryMetadata_ArtifactEnum)(0),  // 12: scalibr.ActiveDirectoryMetadata.ArtifactEnum
+	(PrivateCloudCredential_TypeEnum)(0),       // 13: scalibr.PrivateCloudCredential.TypeEnum
+	(DefenderExclusion_TypeEnum)(0),            // 14: scalibr.DefenderExclusion.TypeEnum
+	(ScanSummary_ExitCodeEnum)(0),              // 15: scalibr.ScanSummary.ExitCodeEnum
+	(PluginDescription_TypeEnum)(0),            // 16: scalibr.PluginDescription.TypeEnum
+	(*ScanResult)(nil),                         // 17: scalibr.ScanResult
+	(*ScanStatus)(nil),                         // 18: scalibr.ScanStatus
+	(*PluginStatus)(nil),                       // 19: scalibr.PluginStatus
+	(*Provenance)(nil),                         // 20: scalibr.Provenance
+	(*PluginVersion)(nil),                      // 21: scalibr.PluginVersion
+	(*DataSource)(nil),                         // 22: scalibr.DataSource
+	(*Secret)(nil),                             // 23: scalibr.Secret
+	(*QuarantineReport)(nil),                   // 24: scalibr.QuarantineReport
+	(*QuarantinedFile)(nil),                    // 25: scalibr.QuarantinedFile
+	(*CoverageReport)(nil),                     // 26: scalibr.CoverageReport
+	(*UnparsedFile)(nil),                       // 27: scalibr.UnparsedFile
+	(*Inventory)(nil),                          // 28: scalibr.Inventory
+	(*Dependency)(nil),                         // 29: scalibr.Dependency
+	(*SourceCodeIdentifier)(nil),               // 30: scalibr.SourceCodeIdentifier
+	(*LayerDetails)(nil),                       // 31: scalibr.LayerDetails
+	(*Purl)(nil),                               // 32: scalibr.Purl
+	(*Qualifier)(nil),                          // 33: scalibr.Qualifier
+	(*Finding)(nil),                            // 34: scalibr.Finding
+	(*Advisory)(nil),                           // 35: scalibr.Advisory
+	(*AdvisoryId)(nil),                         // 36: scalibr.AdvisoryId
+	(*Severity)(nil),                           // 37: scalibr.Severity
+	(*CVSS)(nil),                               // 38: scalibr.CVSS
+	(*TargetDetails)(nil),                      // 39: scalibr.TargetDetails
+	(*PythonPackageMetadata)(nil),              // 40: scalibr.PythonPackageMetadata
+	(*JavascriptPackageJSONMetadata)(nil),      // 41: scalibr.JavascriptPackageJSONMetadata
+	(*ElectronAppMetadata)(nil),                // 42: scalibr.ElectronAppMetadata
+	(*APKPackageMetadata)(nil),                 // 43: scalibr.APKPackageMetadata
+	(*DPKGPackageMetadata)(nil),                // 44: scalibr.DPKGPackageMetadata
+	(*RPMPackageMetadata)(nil),                 // 45: scalibr.RPMPackageMetadata
+	(*PackageOrigin)(nil),                      // 46: scalibr.PackageOrigin
+	(*COSPackageMetadata)(nil),                 // 47: scalibr.COSPackageMetadata
+	(*PACMANPackageMetadata)(nil),              // 48: scalibr.PACMANPackageMetadata
+	(*DEPSJSONMetadata)(nil),                   // 49: scalibr.DEPSJSONMetadata
+	(*SNAPPackageMetadata)(nil),                // 50: scalibr.SNAPPackageMetadata
+	(*PortagePackageMetadata)(nil),             // 51: scalibr.PortagePackageMetadata
+	(*FlatpakPackageMetadata)(nil),             // 52: scalibr.FlatpakPackageMetadata
+	(*ModuleMetadata)(nil),                     // 53: scalibr.ModuleMetadata
+	(*MacAppsMetadata)(nil),                    // 54: scalibr.MacAppsMetadata
+	(*AndroidMetadata)(nil),                    // 55: scalibr.AndroidMetadata
+	(*AndroidApp)(nil),                         // 56: scalibr.AndroidApp
+	(*AndroidPartition)(nil),                   // 57: scalibr.AndroidPartition
+	(*SPDXPackageMetadata)(nil),                // 58: scalibr.SPDXPackageMetadata
+	(*CDXPackageMetadata)(nil),                 // 59: scalibr.CDXPackageMetadata
+	(*JavaArchiveMetadata)(nil),                // 60: scalibr.JavaArchiveMetadata
+	(*JavaAppServerMetadata)(nil),              // 61: scalibr.JavaAppServerMetadata
+	(*JavaRuntimeMetadata)(nil),                // 62: scalibr.JavaRuntimeMetadata
+	(*JavaTrustStore)(nil),                     // 63: scalibr.JavaTrustStore
+	(*JavaTrustedCertificate)(nil),             // 64: scalibr.JavaTrustedCertificate
+	(*JavaLockfileMetadata)(nil),               // 65: scalibr.JavaLockfileMetadata
+	(*OSVPackageMetadata)(nil),                 // 66: scalibr.OSVPackageMetadata
+	(*PythonRequirementsMetadata)(nil),         // 67: scalibr.PythonRequirementsMetadata
+	(*ContainerdContainerMetadata)(nil),        // 68: scalibr.ContainerdContainerMetadata
+	(*ContainerdRuntimeContainerMetadata)(nil), // 69: scalibr.ContainerdRuntimeContainerMetadata
+	(*WindowsOSVersion)(nil),                   // 70: scalibr.WindowsOSVersion
+	(*WindowsSecurityProductMetadata)(nil),     // 71: scalibr.WindowsSecurityProductMetadata
+	(*LogPipelineMetadata)(nil),                // 72: scalibr.LogPipelineMetadata
+	(*LogPipelineOutput)(nil),                  // 73: scalibr.LogPipelineOutput
+	(*LogPipelineCredential)(nil),              // 74: scalibr.LogPipelineCredential
+	(*StorageClusterMetadata)(nil),             // 75: scalibr.StorageClusterMetadata
+	(*StorageClusterCredential)(nil),           // 76: scalibr.StorageClusterCredential
+	(*AppBundleMetadata)(nil),                  // 77: scalibr.AppBundleMetadata
+	(*VendorLibraryMetadata)(nil),              // 78: scalibr.VendorLibraryMetadata
+	(*BazelModuleMetadata)(nil),                // 79: scalibr.BazelModuleMetadata
+	(*IaCSecretsMetadata)(nil),                 // 80: scalibr.IaCSecretsMetadata
+	(*IaCSecret)(nil),                          // 81: scalibr.IaCSecret
+	(*DatabaseServerMetadata)(nil),             // 82: scalibr.DatabaseServerMetadata
+	(*RegistryConfigMetadata)(nil),             // 83: scalibr.RegistryConfigMetadata
+	(*PackageRegistry)(nil),                    // 84: scalibr.PackageRegistry
+	(*EdgeProxyMetadata)(nil),                  // 85: scalibr.EdgeProxyMetadata
+	(*EdgeProxyRoute)(nil),                     // 86: scalibr.EdgeProxyRoute
+	(*EdgeProxyCredential)(nil),                // 87: scalibr.EdgeProxyCredential
+	(*ActiveDirectoryMetadata)(nil),            // 88: scalibr.ActiveDirectoryMetadata
+	(*KerberosMetadata)(nil),                   // 89: scalibr.KerberosMetadata
+	(*KerberosRealm)(nil),                      // 90: scalibr.KerberosRealm
+	(*KerberosPrincipal)(nil),                  // 91: scalibr.KerberosPrincipal
+	(*SSSDDomain)(nil),                         // 92: scalibr.SSSDDomain
+	(*ActiveDirectoryCredential)(nil),          // 93: scalibr.ActiveDirectoryCredential
+	(*AppSupervisorMetadata)(nil),              // 94: scalibr.AppSupervisorMetadata
+	(*KernelModuleMetadata)(nil),               // 95: scalibr.KernelModuleMetadata
+	(*LoadedKernelModule)(nil),                 // 96: scalibr.LoadedKernelModule
+	(*DKMSPackage)(nil),                        // 97: scalibr.DKMSPackage
+	(*WSLDistributionMetadata)(nil),            // 98: scalibr.WSLDistributionMetadata
+	(*ECSTaskContainerMetadata)(nil),           // 99: scalibr.ECSTaskContainerMetadata
+	(*ECSSecret)(nil),                          // 100: scalibr.ECSSecret
+	(*ECSMount)(nil),                           // 101: scalibr.ECSMount
+	(*KubeletPodMetadata)(nil),                 // 102: scalibr.KubeletPodMetadata
+	(*KubeletContainer)(nil),                   // 103: scalibr.KubeletContainer
+	(*KubeletVolume)(nil),                      // 104: scalibr.KubeletVolume
+	(*PrivateCloudMetadata)(nil),               // 105: scalibr.PrivateCloudMetadata
+	(*PrivateCloudCredential)(nil),             // 106: scalibr.PrivateCloudCredential
+	(*CMSExtensionMetadata)(nil),               // 107: scalibr.CMSExtensionMetadata
+	(*PEARPackageMetadata)(nil),                // 108: scalibr.PEARPackageMetadata
+	(*ComposerPackageMetadata)(nil),            // 109: scalibr.ComposerPackageMetadata
+	(*PerlModuleMetadata)(nil),                 // 110: scalibr.PerlModuleMetadata
+	(*SynologyPackageMetadata)(nil),            // 111: scalibr.SynologyPackageMetadata
+	(*QNAPPackageMetadata)(nil),                // 112: scalibr.QNAPPackageMetadata
+	(*GoBinaryMetadata)(nil),                   // 113: scalibr.GoBinaryMetadata
+	(*VendoredCodeMetadata)(nil),               // 114: scalibr.VendoredCodeMetadata
+	(*DefenderExclusion)(nil),                  // 115: scalibr.DefenderExclusion
+	(*FleetStats)(nil),                         // 116: scalibr.FleetStats
+	(*RunScanRequest)(nil),                     // 117: scalibr.RunScanRequest
+	(*ScanResultChunk)(nil),                    // 118: scalibr.ScanResultChunk
+	(*ScanSummary)(nil),                        // 119: scalibr.ScanSummary
+	(*ListPluginsRequest)(nil),                 // 120: scalibr.ListPluginsRequest
+	(*ListPluginsResponse)(nil),                // 121: scalibr.ListPluginsResponse
+	(*PluginDescription)(nil),                  // 122: scalibr.PluginDescription
+	nil,                                        // 123: scalibr.ScanResult.LabelsEntry
+	(*FleetStats_Count)(nil),                   // 124: scalibr.FleetStats.Count
+	(*FleetStats_VulnerablePackage)(nil),       // 125: scalibr.FleetStats.VulnerablePackage
+	(*FleetStats_OSStats)(nil),                 // 126: scalibr.FleetStats.OSStats
+	nil,                                        // 127: scalibr.RunScanRequest.LabelsEntry
+	(*ScanSummary_SeverityCount)(nil),          // 128: scalibr.ScanSummary.SeverityCount
+	(*ScanSummary_ValidationCount)(nil),        // 129: scalibr.ScanSummary.ValidationCount
+	(*timestamppb.Timestamp)(nil),              // 130: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                // 131: google.protobuf.Duration
+}
+var file_proto_scan_result_proto_depIdxs = []int32{
+	130, // 0: scalibr.ScanResult.start_time:type_name -> google.protobuf.Timestamp
+	130, // 1: scalibr.ScanResult.end_time:type_name -> google.protobuf.Timestamp
+	18,  // 2: scalibr.ScanResult.status:type_name -> scalibr.ScanStatus
+	19,  // 3: scalibr.ScanResult.plugin_status:type_name -> scalibr.PluginStatus
+	28,  // 4: scalibr.ScanResult.inventories:type_name -> scalibr.Inventory
+	34,  // 5: scalibr.ScanResult.findings:type_name -> scalibr.Finding
+	26,  // 6: scalibr.ScanResult.coverage:type_name -> scalibr.CoverageReport
+	20,  // 7: scalibr.ScanResult.provenance:type_name -> scalibr.Provenance
+	23,  // 8: scalibr.ScanResult.secrets:type_name -> scalibr.Secret
+	24,  // 9: scalibr.ScanResult.quarantine:type_name -> scalibr.QuarantineReport
+	123, // 10: scalibr.ScanResult.labels:type_name -> scalibr.ScanResult.LabelsEntry
+	0,   // 11: scalibr.ScanStatus.status:type_name -> scalibr.ScanStatus.ScanStatusEnum
+	18,  // 12: scalibr.PluginStatus.status:type_name -> scalibr.ScanStatus
+	21,  // 13: scalibr.Provenance.plugins:type_name -> scalibr.PluginVersion
+	22,  // 14: scalibr.Provenance.data_sources:type_name -> scalibr.DataSource
+	1,   // 15: scalibr.Secret.confidence:type_name -> scalibr.Secret.ConfidenceEnum
+	2,   // 16: scalibr.Secret.validation:type_name -> scalibr.Secret.ValidationEnum
+	25,  // 17: scalibr.QuarantineReport.files:type_name -> scalibr.QuarantinedFile
+	27,  // 18: scalibr.CoverageReport.unparsed_files:type_name -> scalibr.UnparsedFile
+	3,   // 19: scalibr.UnparsedFile.reason:type_name -> scalibr.UnparsedFile.ReasonEnum
+	30,  // 20: scalibr.Inventory.source_code:type_name -> scalibr.SourceCodeIdentifier
+	32,  // 21: scalibr.Inventory.purl:type_name -> scalibr.Purl
+	40,  // 22: scalibr.Inventory.python_metadata:type_name -> scalibr.PythonPackageMetadata
+	41,  // 23: scalibr.Inventory.javascript_metadata:type_name -> scalibr.JavascriptPackageJSONMetadata
+	42,  // 24: scalibr.Inventory.electron_app_metadata:type_name -> scalibr.ElectronAppMetadata
+	43,  // 25: scalibr.Inventory.apk_metadata:type_name -> scalibr.APKPackageMetadata
+	44,  // 26: scalibr.Inventory.dpkg_metadata:type_name -> scalibr.DPKGPackageMetadata
+	45,  // 27: scalibr.Inventory.rpm_metadata:type_name -> scalibr.RPMPackageMetadata
+	47,  // 28: scalibr.Inventory.cos_metadata:type_name -> scalibr.COSPackageMetadata
+	49,  // 29: scalibr.Inventory.depsjson_metadata:type_name -> scalibr.DEPSJSONMetadata
+	58,  // 30: scalibr.Inventory.spdx_metadata:type_name -> scalibr.SPDXPackageMetadata
+	60,  // 31: scalibr.Inventory.java_archive_metadata:type_name -> scalibr.JavaArchiveMetadata
+	65,  // 32: scalibr.Inventory.java_lockfile_metadata:type_name -> scalibr.JavaLockfileMetadata
+	61,  // 33: scalibr.Inventory.java_app_server_metadata:type_name -> scalibr.JavaAppServerMetadata
+	62,  // 34: scalibr.Inventory.java_runtime_metadata:type_name -> scalibr.JavaRuntimeMetadata
+	48,  // 35: scalibr.Inventory.pacman_metadata:type_name -> scalibr.PACMANPackageMetadata
+	53,  // 36: scalibr.Inventory.module_metadata:type_name -> scalibr.ModuleMetadata
+	51,  // 37: scalibr.Inventory.portage_metadata:type_name -> scalibr.PortagePackageMetadata
+	66,  // 38: scalibr.Inventory.osv_metadata:type_name -> scalibr.OSVPackageMetadata
+	67,  // 39: scalibr.Inventory.python_requirements_metadata:type_name -> scalibr.PythonRequirementsMetadata
+	68,  // 40: scalibr.Inventory.containerd_container_metadata:type_name -> scalibr.ContainerdContainerMetadata
+	50,  // 41: scalibr.Inventory.snap_metadata:type_name -> scalibr.SNAPPackageMetadata
+	52,  // 42: scalibr.Inventory.flatpak_metadata:type_name -> scalibr.FlatpakPackageMetadata
+	54,  // 43: scalibr.Inventory.mac_apps_metadata:type_name -> scalibr.MacAppsMetadata
+	69,  // 44: scalibr.Inventory.containerd_runtime_container_metadata:type_name -> scalibr.ContainerdRuntimeContainerMetadata
+	59,  // 45: scalibr.Inventory.cdx_metadata:type_name -> scalibr.CDXPackageMetadata
+	70,  // 46: scalibr.Inventory.windows_os_version_metadata:type_name -> scalibr.WindowsOSVersion
+	71,  // 47: scalibr.Inventory.windows_security_product_metadata:type_name -> scalibr.WindowsSecurityProductMetadata
+	72,  // 48: scalibr.Inventory.log_pipeline_metadata:type_name -> scalibr.LogPipelineMetadata
+	75,  // 49: scalibr.Inventory.storage_cluster_metadata:type_name -> scalibr.StorageClusterMetadata
+	77,  // 50: scalibr.Inventory.app_bundle_metadata:type_name -> scalibr.AppBundleMetadata
+	80,  // 51: scalibr.Inventory.iac_secrets_metadata:type_name -> scalibr.IaCSecretsMetadata
+	82,  // 52: scalibr.Inventory.database_server_metadata:type_name -> scalibr.DatabaseServerMetadata
+	83,  // 53: scalibr.Inventory.registry_config_metadata:type_name -> scalibr.RegistryConfigMetadata
+	85,  // 54: scalibr.Inventory.edge_proxy_metadata:type_name -> scalibr.EdgeProxyMetadata
+	88,  // 55: scalibr.Inventory.active_directory_metadata:type_name -> scalibr.ActiveDirectoryMetadata
+	55,  // 56: scalibr.Inventory.android_metadata:type_name -> scalibr.AndroidMetadata
+	94,  // 57: scalibr.Inventory.app_supervisor_metadata:type_name -> scalibr.AppSupervisorMetadata
+	95,  // 58: scalibr.Inventory.kernel_module_metadata:type_name -> scalibr.KernelModuleMetadata
+	98,  // 59: scalibr.Inventory.wsl_distribution_metadata:type_name -> scalibr.WSLDistributionMetadata
+	99,  // 60: scalibr.Inventory.ecs_task_container_metadata:type_name -> scalibr.ECSTaskContainerMetadata
+	102, // 61: scalibr.Inventory.kubelet_pod_metadata:type_name -> scalibr.KubeletPodMetadata
+	105, // 62: scalibr.Inventory.private_cloud_metadata:type_name -> scalibr.PrivateCloudMetadata
+	107, // 63: scalibr.Inventory.cms_extension_metadata:type_name -> scalibr.CMSExtensionMetadata
+	78,  // 64: scalibr.Inventory.vendor_library_metadata:type_name -> scalibr.VendorLibraryMetadata
+	79,  // 65: scalibr.Inventory.bazel_module_metadata:type_name -> scalibr.BazelModuleMetadata
+	89,  // 66: scalibr.Inventory.kerberos_metadata:type_name -> scalibr.KerberosMetadata
+	108, // 67: scalibr.Inventory.pear_package_metadata:type_name -> scalibr.PEARPackageMetadata
+	110, // 68: scalibr.Inventory.perl_module_metadata:type_name -> scalibr.PerlModuleMetadata
+	111, // 69: scalibr.Inventory.synology_package_metadata:type_name -> scalibr.SynologyPackageMetadata
+	112, // 70: scalibr.Inventory.qnap_package_metadata:type_name -> scalibr.QNAPPackageMetadata
+	113, // 71: scalibr.Inventory.go_binary_metadata:type_name -> scalibr.GoBinaryMetadata
+	114, // 72: scalibr.Inventory.vendored_code_metadata:type_name -> scalibr.VendoredCodeMetadata
+	109, // 73: scalibr.Inventory.composer_package_metadata:type_name -> scalibr.ComposerPackageMetadata
+	4,   // 74: scalibr.Inventory.annotations:type_name -> scalibr.Inventory.AnnotationEnum
+	31,  // 75: scalibr.Inventory.layer_details:type_name -> scalibr.LayerDetails
+	29,  // 76: scalibr.Inventory.dependencies:type_name -> scalibr.Dependency
+	33,  // 77: scalibr.Purl.qualifiers:type_name -> scalibr.Qualifier
+	35,  // 78: scalibr.Finding.adv:type_name -> scalibr.Advisory
+	39,  // 79: scalibr.Finding.target:type_name -> scalibr.TargetDetails
+	36,  // 80: scalibr.Advisory.id:type_name -> scalibr.AdvisoryId
+	5,   // 81: scalibr.Advisory.type:type_name -> scalibr.Advisory.TypeEnum
+	37,  // 82: scalibr.Advisory.sev:type_name -> scalibr.Severity
+	6,   // 83: scalibr.Severity.severity:type_name -> scalibr.Severity.SeverityEnum
+	38,  // 84: scalibr.Severity.cvss_v2:type_name -> scalibr.CVSS
+	38,  // 85: scalibr.Severity.cvss_v3:type_name -> scalibr.CVSS
+	28,  // 86: scalibr.TargetDetails.inventory:type_name -> scalibr.Inventory
+	46,  // 87: scalibr.DPKGPackageMetadata.origin:type_name -> scalibr.PackageOrigin
+	46,  // 88: scalibr.RPMPackageMetadata.origin:type_name -> scalibr.PackageOrigin
+	7,   // 89: scalibr.PackageOrigin.origin:type_name -> scalibr.PackageOrigin.OriginEnum
+	56,  // 90: scalibr.AndroidMetadata.app:type_name -> scalibr.AndroidApp
+	57,  // 91: scalibr.AndroidMetadata.partition:type_name -> scalibr.AndroidPartition
+	130, // 92: scalibr.AndroidApp.first_install_time:type_name -> google.protobuf.Timestamp
+	130, // 93: scalibr.AndroidApp.last_update_time:type_name -> google.protobuf.Timestamp
+	32,  // 94: scalibr.SPDXPackageMetadata.purl:type_name -> scalibr.Purl
+	32,  // 95: scalibr.CDXPackageMetadata.purl:type_name -> scalibr.Purl
+	63,  // 96: scalibr.JavaRuntimeMetadata.trust_store:type_name -> scalibr.JavaTrustStore
+	64,  // 97: scalibr.JavaTrustStore.certificates:type_name -> scalibr.JavaTrustedCertificate
+	130, // 98: scalibr.JavaTrustedCertificate.not_after:type_name -> google.protobuf.Timestamp
+	115, // 99: scalibr.WindowsSecurityProductMetadata.exclusions:type_name -> scalibr.DefenderExclusion
+	73,  // 100: scalibr.LogPipelineMetadata.outputs:type_name -> scalibr.LogPipelineOutput
+	74,  // 101: scalibr.LogPipelineOutput.credentials:type_name -> scalibr.LogPipelineCredential
+	76,  // 102: scalibr.StorageClusterMetadata.credentials:type_name -> scalibr.StorageClusterCredential
+	8,   // 103: scalibr.StorageClusterCredential.type:type_name -> scalibr.StorageClusterCredential.TypeEnum
+	81,  // 104: scalibr.IaCSecretsMetadata.secrets:type_name -> scalibr.IaCSecret
+	9,   // 105: scalibr.IaCSecret.type:type_name -> scalibr.IaCSecret.TypeEnum
+	84,  // 106: scalibr.RegistryConfigMetadata.registries:type_name -> scalibr.PackageRegistry
+	10,  // 107: scalibr.PackageRegistry.role:type_name -> scalibr.PackageRegistry.RoleEnum
+	86,  // 108: scalibr.EdgeProxyMetadata.routes:type_name -> scalibr.EdgeProxyRoute
+	87,  // 109: scalibr.EdgeProxyMetadata.credentials:type_name -> scalibr.EdgeProxyCredential
+	11,  // 110: scalibr.EdgeProxyCredential.type:type_name -> scalibr.EdgeProxyCredential.TypeEnum
+	12,  // 111: scalibr.ActiveDirectoryMetadata.artifact:type_name -> scalibr.ActiveDirectoryMetadata.ArtifactEnum
+	93,  // 112: scalibr.ActiveDirectoryMetadata.credentials:type_name -> scalibr.ActiveDirectoryCredential
+	90,  // 113: scalibr.KerberosMetadata.realms:type_name -> scalibr.KerberosRealm
+	91,  // 114: scalibr.KerberosMetadata.principals:type_name -> scalibr.KerberosPrincipal
+	92,  // 115: scalibr.KerberosMetadata.domains:type_name -> scalibr.SSSDDomain
+	96,  // 116: scalibr.KernelModuleMetadata.module:type_name -> scalibr.LoadedKernelModule
+	97,  // 117: scalibr.KernelModuleMetadata.dkms:type_name -> scalibr.DKMSPackage
+	100, // 118: scalibr.ECSTaskContainerMetadata.secrets:type_name -> scalibr.ECSSecret
+	101, // 119: scalibr.ECSTaskContainerMetadata.mounts:type_name -> scalibr.ECSMount
+	103, // 120: scalibr.KubeletPodMetadata.containers:type_name -> scalibr.KubeletContainer
+	104, // 121: scalibr.KubeletPodMetadata.secret_volumes:type_name -> scalibr.KubeletVolume
+	106, // 122: scalibr.PrivateCloudMetadata.credentials:type_name -> scalibr.PrivateCloudCredential
+	13,  // 123: scalibr.PrivateCloudCredential.type:type_name -> scalibr.PrivateCloudCredential.TypeEnum
+	14,  // 124: scalibr.DefenderExclusion.type:type_name -> scalibr.DefenderExclusion.TypeEnum
+	125, // 125: scalibr.FleetStats.top_vulnerable_packages:type_name -> scalibr.FleetStats.VulnerablePackage
+	124, // 126: scalibr.FleetStats.secret_types:type_name -> scalibr.FleetStats.Count
+	124, // 127: scalibr.FleetStats.ecosystems:type_name -> scalibr.FleetStats.Count
+	126, // 128: scalibr.FleetStats.os:type_name -> scalibr.FleetStats.OSStats
+	127, // 129: scalibr.RunScanRequest.labels:type_name -> scalibr.RunScanRequest.LabelsEntry
+	6,   // 130: scalibr.RunScanRequest.severity_threshold:type_name -> scalibr.Severity.SeverityEnum
+	28,  // 131: scalibr.ScanResultChunk.inventories:type_name -> scalibr.Inventory
+	34,  // 132: scalibr.ScanResultChunk.findings:type_name -> scalibr.Finding
+	23,  // 133: scalibr.ScanResultChunk.secrets:type_name -> scalibr.Secret
+	17,  // 134: scalibr.ScanResultChunk.summary:type_name -> scalibr.ScanResult
+	119, // 135: scalibr.ScanResultChunk.scan_summary:type_name -> scalibr.ScanSummary
+	18,  // 136: scalibr.ScanSummary.status:type_name -> scalibr.ScanStatus
+	131, // 137: scalibr.ScanSummary.duration:type_name -> google.protobuf.Duration
+	128, // 138: scalibr.ScanSummary.findings:type_name -> scalibr.ScanSummary.SeverityCount
+	6,   // 139: scalibr.ScanSummary.severity_threshold:type_name -> scalibr.Severity.SeverityEnum
+	129, // 140: scalibr.ScanSummary.secrets:type_name -> scalibr.ScanSummary.ValidationCount
+	15,  // 141: scalibr.ScanSummary.exit_code:type_name -> scalibr.ScanSummary.ExitCodeEnum
+	122, // 142: scalibr.ListPluginsResponse.plugins:type_name -> scalibr.PluginDescription
+	16,  // 143: scalibr.PluginDescription.type:type_name -> scalibr.PluginDescription.TypeEnum
+	6,   // 144: scalibr.ScanSummary.SeverityCount.severity:type_name -> scalibr.Severity.SeverityEnum
+	2,   // 145: scalibr.ScanSummary.ValidationCount.validation:type_name -> scalibr.Secret.ValidationEnum
+	117, // 146: scalibr.Scanner.RunScan:input_type -> scalibr.RunScanRequest
+	120, // 147: scalibr.Scanner.ListPlugins:input_type -> scalibr.ListPluginsRequest
+	118, // 148: scalibr.Scanner.RunScan:output_type -> scalibr.ScanResultChunk
+	121, // 149: scalibr.Scanner.ListPlugins:output_type -> scalibr.ListPluginsResponse
+	148, // [148:150] is the sub-list for method output_type
+	146, // [146:148] is the sub-list for method input_type
+	146, // [146:146] is the sub-list for extension type_name
+	146, // [146:146] is the sub-list for extension extendee
+	0,   // [0:146] is the sub-list for field type_name
+}
+
+func init() { file_proto_scan_result_proto_init() }
+func file_proto_scan_result_proto_init() {
+	if File_proto_scan_result_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_scan_result_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Provenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuarantineReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuarantinedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoverageReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnparsedFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Inventory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceCodeIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayerDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Purl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Qualifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Finding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advisory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdvisoryId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Severity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CVSS); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PythonPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JavascriptPackageJSONMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectronAppMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APKPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DPKGPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RPMPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*COSPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PACMANPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DEPSJSONMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SNAPPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortagePackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlatpakPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacAppsMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AndroidMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AndroidApp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AndroidPartition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SPDXPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CDXPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JavaArchiveMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JavaAppServerMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JavaRuntimeMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JavaTrustStore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JavaTrustedCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JavaLockfileMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OSVPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PythonRequirementsMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerdContainerMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerdRuntimeContainerMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindowsOSVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindowsSecurityProductMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogPipelineMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogPipelineOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogPipelineCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4150,8 +13150,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScanStatus); i {
+		file_proto_scan_result_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageClusterMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4162,8 +13162,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PluginStatus); i {
+		file_proto_scan_result_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageClusterCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4174,8 +13174,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Inventory); i {
+		file_proto_scan_result_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppBundleMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4186,8 +13186,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SourceCodeIdentifier); i {
+		file_proto_scan_result_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VendorLibraryMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4198,8 +13198,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LayerDetails); i {
+		file_proto_scan_result_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BazelModuleMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4210,8 +13210,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Purl); i {
+		file_proto_scan_result_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IaCSecretsMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4222,8 +13222,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Qualifier); i {
+		file_proto_scan_result_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IaCSecret); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4234,8 +13234,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Finding); i {
+		file_proto_scan_result_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabaseServerMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4246,8 +13246,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Advisory); i {
+		file_proto_scan_result_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistryConfigMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4258,8 +13258,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdvisoryId); i {
+		file_proto_scan_result_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageRegistry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4270,8 +13270,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Severity); i {
+		file_proto_scan_result_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgeProxyMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4282,8 +13282,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CVSS); i {
+		file_proto_scan_result_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgeProxyRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4294,8 +13294,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TargetDetails); i {
+		file_proto_scan_result_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EdgeProxyCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4306,8 +13306,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PythonPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveDirectoryMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4318,8 +13318,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JavascriptPackageJSONMetadata); i {
+		file_proto_scan_result_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KerberosMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4330,8 +13330,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APKPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KerberosRealm); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4342,8 +13342,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DPKGPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KerberosPrincipal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4354,8 +13354,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RPMPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSSDDomain); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4366,8 +13366,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*COSPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveDirectoryCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4378,8 +13378,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PACMANPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppSupervisorMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4390,8 +13390,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DEPSJSONMetadata); i {
+		file_proto_scan_result_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KernelModuleMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4402,8 +13402,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SNAPPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadedKernelModule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4414,8 +13414,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortagePackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DKMSPackage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4426,8 +13426,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlatpakPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WSLDistributionMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4438,8 +13438,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModuleMetadata); i {
+		file_proto_scan_result_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ECSTaskContainerMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4450,8 +13450,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MacAppsMetadata); i {
+		file_proto_scan_result_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ECSSecret); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4462,8 +13462,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SPDXPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ECSMount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4474,8 +13474,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CDXPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubeletPodMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4486,8 +13486,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JavaArchiveMetadata); i {
+		file_proto_scan_result_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubeletContainer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4498,8 +13498,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JavaLockfileMetadata); i {
+		file_proto_scan_result_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubeletVolume); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4510,8 +13510,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OSVPackageMetadata); i {
+		file_proto_scan_result_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateCloudMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4522,8 +13522,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PythonRequirementsMetadata); i {
+		file_proto_scan_result_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateCloudCredential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4534,8 +13534,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerdContainerMetadata); i {
+		file_proto_scan_result_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CMSExtensionMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4546,8 +13546,8 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerdRuntimeContainerMetadata); i {
+		file_proto_scan_result_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PEARPackageMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4558,8 +13558,224 @@ func file_proto_scan_result_proto_init() {
 				return nil
 			}
 		}
-		file_proto_scan_result_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WindowsOSVersion); i {
+		file_proto_scan_result_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComposerPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerlModuleMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SynologyPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QNAPPackageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoBinaryMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VendoredCodeMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefenderExclusion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunScanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanResultChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPluginsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPluginsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetStats_Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetStats_VulnerablePackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetStats_OSStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanSummary_SeverityCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_scan_result_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanSummary_ValidationCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4571,9 +13787,10 @@ func file_proto_scan_result_proto_init() {
 			}
 		}
 	}
-	file_proto_scan_result_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_proto_scan_result_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*Inventory_PythonMetadata)(nil),
 		(*Inventory_JavascriptMetadata)(nil),
+		(*Inventory_ElectronAppMetadata)(nil),
 		(*Inventory_ApkMetadata)(nil),
 		(*Inventory_DpkgMetadata)(nil),
 		(*Inventory_RpmMetadata)(nil),
@@ -4582,6 +13799,8 @@ func file_proto_scan_result_proto_init() {
 		(*Inventory_SpdxMetadata)(nil),
 		(*Inventory_JavaArchiveMetadata)(nil),
 		(*Inventory_JavaLockfileMetadata)(nil),
+		(*Inventory_JavaAppServerMetadata)(nil),
+		(*Inventory_JavaRuntimeMetadata)(nil),
 		(*Inventory_PacmanMetadata)(nil),
 		(*Inventory_ModuleMetadata)(nil),
 		(*Inventory_PortageMetadata)(nil),
@@ -4594,16 +13813,47 @@ func file_proto_scan_result_proto_init() {
 		(*Inventory_ContainerdRuntimeContainerMetadata)(nil),
 		(*Inventory_CdxMetadata)(nil),
 		(*Inventory_WindowsOsVersionMetadata)(nil),
+		(*Inventory_WindowsSecurityProductMetadata)(nil),
+		(*Inventory_LogPipelineMetadata)(nil),
+		(*Inventory_StorageClusterMetadata)(nil),
+		(*Inventory_AppBundleMetadata)(nil),
+		(*Inventory_IacSecretsMetadata)(nil),
+		(*Inventory_DatabaseServerMetadata)(nil),
+		(*Inventory_RegistryConfigMetadata)(nil),
+		(*Inventory_EdgeProxyMetadata)(nil),
+		(*Inventory_ActiveDirectoryMetadata)(nil),
+		(*Inventory_AndroidMetadata)(nil),
+		(*Inventory_AppSupervisorMetadata)(nil),
+		(*Inventory_KernelModuleMetadata)(nil),
+		(*Inventory_WslDistributionMetadata)(nil),
+		(*Inventory_EcsTaskContainerMetadata)(nil),
+		(*Inventory_KubeletPodMetadata)(nil),
+		(*Inventory_PrivateCloudMetadata)(nil),
+		(*Inventory_CmsExtensionMetadata)(nil),
+		(*Inventory_VendorLibraryMetadata)(nil),
+		(*Inventory_BazelModuleMetadata)(nil),
+		(*Inventory_KerberosMetadata)(nil),
+		(*Inventory_PearPackageMetadata)(nil),
+		(*Inventory_PerlModuleMetadata)(nil),
+		(*Inventory_SynologyPackageMetadata)(nil),
+		(*Inventory_QnapPackageMetadata)(nil),
+		(*Inventory_GoBinaryMetadata)(nil),
+		(*Inventory_VendoredCodeMetadata)(nil),
+		(*Inventory_ComposerPackageMetadata)(nil),
+	}
+	file_proto_scan_result_proto_msgTypes[78].OneofWrappers = []interface{}{
+		(*KernelModuleMetadata_Module)(nil),
+		(*KernelModuleMetadata_Dkms)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_scan_result_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   36,
+			NumEnums:      17,
+			NumMessages:   113,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_proto_scan_result_proto_goTypes,
 		DependencyIndexes: file_proto_scan_result_proto_depIdxs,
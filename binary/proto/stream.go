@@ -0,0 +1,381 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	spb "github.com/google/osv-scalibr/binary/proto/scan_result_go_proto"
+)
+
+// maxStreamItemSize is the size limit of a single package, finding or secret
+// read by a StreamReader, to fail on corrupted files instead of allocating
+// arbitrary amounts of memory.
+const maxStreamItemSize = 1 << 30
+
+var scanResultFields = (&spb.ScanResult{}).ProtoReflect().Descriptor().Fields()
+
+var (
+	inventoriesField = scanResultFields.ByName("inventories").Number()
+	findingsField    = scanResultFields.ByName("findings").Number()
+	secretsField     = scanResultFields.ByName("secrets").Number()
+)
+
+// StreamWriter writes a ScanResult proto in the binproto format one package,
+// finding and secret at a time. It implements scalibr.ResultSink so that the
+// packages of large scans can be written while the scan runs instead of being
+// held in memory.
+//
+// Each item is written as a separate ScanResult message that only contains
+// that item, followed by the rest of the scan result. Since protobuf parsers
+// merge concatenated messages, the output is a regular binproto ScanResult
+// that can be read with proto.Unmarshal or item by item with a StreamReader.
+type StreamWriter struct {
+	w *bufio.Writer
+	// Closed in order by Finish after flushing w.
+	closers []io.Closer
+}
+
+// NewStreamWriter returns a StreamWriter that writes to w. Finish needs to be
+// called after the scan to write the summary of the scan and flush the output.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: bufio.NewWriter(w)}
+}
+
+// CreateStream creates a .binproto file for a StreamWriter. If the file name
+// additionally has the .gz suffix, the output is zipped. Finish closes the file.
+func CreateStream(filePath string) (*StreamWriter, error) {
+	ft, err := typeForPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !ft.isBinProto {
+		return nil, fmt.Errorf("invalid filename %q: only .binproto files can be streamed", filePath)
+	}
+	return createStream(filePath, ft.isGZipped)
+}
+
+// CreateStreamWithFormat creates a file for a StreamWriter regardless of its
+// extension. Only the "binproto" format can be streamed. Finish closes the file.
+func CreateStreamWithFormat(filePath string, format string) (*StreamWriter, error) {
+	if format != "binproto" {
+		return nil, fmt.Errorf("format %q can't be streamed", format)
+	}
+	return createStream(filePath, false)
+}
+
+func createStream(filePath string, gzipped bool) (*StreamWriter, error) {
+	f, err := os.Create(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !gzipped {
+		w := NewStreamWriter(f)
+		w.closers = []io.Closer{f}
+		return w, nil
+	}
+	gz := gzip.NewWriter(f)
+	w := NewStreamWriter(gz)
+	w.closers = []io.Closer{gz, f}
+	return w, nil
+}
+
+// AddInventory writes a package.
+func (w *StreamWriter) AddInventory(i *extractor.Inventory) error {
+	p, err := InventoryToProto(i)
+	if err != nil {
+		return err
+	}
+	return w.write(&spb.ScanResult{Inventories: []*spb.Inventory{p}})
+}
+
+// AddFinding writes a finding.
+func (w *StreamWriter) AddFinding(f *detector.Finding) error {
+	p, err := FindingToProto(f)
+	if err != nil {
+		return err
+	}
+	return w.write(&spb.ScanResult{Findings: []*spb.Finding{p}})
+}
+
+// AddSecret writes a secret.
+func (w *StreamWriter) AddSecret(s *secrets.Secret) error {
+	p, err := SecretToProto(s)
+	if err != nil {
+		return err
+	}
+	return w.write(&spb.ScanResult{Secrets: []*spb.Secret{p}})
+}
+
+func (w *StreamWriter) write(m *spb.ScanResult) error {
+	p, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = w.w.Write(p)
+	return err
+}
+
+// Finish writes the packages, findings and secrets that are still in r, i.e.
+// those that weren't passed to the writer during the scan, followed by the
+// rest of r, flushes the output and closes the file created by CreateStream.
+func (w *StreamWriter) Finish(r *scalibr.ScanResult) error {
+	err := w.finish(r)
+	for _, c := range w.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	w.closers = nil
+	return err
+}
+
+func (w *StreamWriter) finish(r *scalibr.ScanResult) error {
+	for _, i := range r.Inventories {
+		if err := w.AddInventory(i); err != nil {
+			return err
+		}
+	}
+	for _, f := range r.Findings {
+		if err := w.AddFinding(f); err != nil {
+			return err
+		}
+	}
+	for _, s := range r.Secrets {
+		if err := w.AddSecret(s); err != nil {
+			return err
+		}
+	}
+	summary := *r
+	summary.Inventories, summary.Findings, summary.Secrets = nil, nil, nil
+	p, err := ScanResultToProto(&summary)
+	if err != nil {
+		return err
+	}
+	if err := w.write(p); err != nil {
+		return err
+	}
+	return w.w.Flush()
+}
+
+// WriteStream writes the scan result r to a .binproto file with a
+// StreamWriter, without converting the whole result to a proto in memory.
+func WriteStream(filePath string, r *scalibr.ScanResult) error {
+	w, err := CreateStream(filePath)
+	if err != nil {
+		return err
+	}
+	if err := w.Finish(r); err != nil {
+		return fmt.Errorf("failed to write scan result: %w", err)
+	}
+	return nil
+}
+
+var _ scalibr.ResultSink = &StreamWriter{}
+
+// StreamReader reads a binproto ScanResult one package, finding and secret at
+// a time, so that large results can be processed without holding them in
+// memory. It reads both the output of a StreamWriter and of Write.
+type StreamReader struct {
+	r      *bufio.Reader
+	closer io.Closer
+	// The encoded fields of the ScanResult other than the packages, findings
+	// and secrets.
+	rest    []byte
+	summary *spb.ScanResult
+}
+
+// NewStreamReader returns a StreamReader that reads from r.
+func NewStreamReader(r io.Reader) *StreamReader {
+	return &StreamReader{r: bufio.NewReader(r)}
+}
+
+// OpenStream opens a .binproto or .binproto.gz file for a StreamReader. The
+// reader needs to be closed after use.
+func OpenStream(filePath string) (*StreamReader, error) {
+	ft, err := typeForPath(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !ft.isBinProto {
+		return nil, fmt.Errorf("invalid filename %q: only .binproto files can be streamed", filePath)
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !ft.isGZipped {
+		r := NewStreamReader(f)
+		r.closer = f
+		return r, nil
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r := NewStreamReader(gz)
+	r.closer = f
+	return r, nil
+}
+
+// Close closes the file opened by OpenStream.
+func (r *StreamReader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	err := r.closer.Close()
+	r.closer = nil
+	return err
+}
+
+// Next returns the next package, finding or secret of the scan result as an
+// *spb.Inventory, *spb.Finding or *spb.Secret. It returns io.EOF once all
+// of them have been read.
+func (r *StreamReader) Next() (proto.Message, error) {
+	for {
+		num, typ, err := r.readTag()
+		if err != nil {
+			return nil, err
+		}
+		var m proto.Message
+		switch {
+		case typ != protowire.BytesType:
+		case num == inventoriesField:
+			m = &spb.Inventory{}
+		case num == findingsField:
+			m = &spb.Finding{}
+		case num == secretsField:
+			m = &spb.Secret{}
+		}
+		if m == nil {
+			if err := r.skipField(num, typ); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		b, err := r.readBytes()
+		if err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(b, m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+}
+
+// Summary returns the scan result without its packages, findings and secrets.
+// It's only available after Next returned io.EOF.
+func (r *StreamReader) Summary() (*spb.ScanResult, error) {
+	if r.summary == nil {
+		return nil, errors.New("the scan result hasn't been read completely")
+	}
+	return r.summary, nil
+}
+
+// readTag reads the field number and wire type of the next field. It returns
+// io.EOF and parses the summary at the end of the input.
+func (r *StreamReader) readTag() (protowire.Number, protowire.Type, error) {
+	tag, err := binary.ReadUvarint(r.r)
+	if errors.Is(err, io.EOF) {
+		if r.summary == nil {
+			summary := &spb.ScanResult{}
+			if err := proto.Unmarshal(r.rest, summary); err != nil {
+				return 0, 0, err
+			}
+			r.summary, r.rest = summary, nil
+		}
+		return 0, 0, io.EOF
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	num, typ := protowire.DecodeTag(tag)
+	if num < protowire.MinValidNumber {
+		return 0, 0, fmt.Errorf("invalid field number %d", num)
+	}
+	return num, typ, nil
+}
+
+// skipField keeps a field of the summary.
+func (r *StreamReader) skipField(num protowire.Number, typ protowire.Type) error {
+	r.rest = protowire.AppendTag(r.rest, num, typ)
+	switch typ {
+	case protowire.VarintType:
+		v, err := binary.ReadUvarint(r.r)
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		r.rest = protowire.AppendVarint(r.rest, v)
+	case protowire.Fixed32Type, protowire.Fixed64Type:
+		n := 4
+		if typ == protowire.Fixed64Type {
+			n = 8
+		}
+		b := make([]byte, n)
+		if _, err := io.ReadFull(r.r, b); err != nil {
+			return unexpectedEOF(err)
+		}
+		r.rest = append(r.rest, b...)
+	case protowire.BytesType:
+		b, err := r.readBytes()
+		if err != nil {
+			return err
+		}
+		r.rest = protowire.AppendBytes(r.rest, b)
+	default:
+		return fmt.Errorf("unsupported wire type %d of field %d", typ, num)
+	}
+	return nil
+}
+
+// readBytes reads a length-delimited field value.
+func (r *StreamReader) readBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if n > maxStreamItemSize {
+		return nil, fmt.Errorf("field of %d bytes exceeds the limit of %d bytes", n, maxStreamItemSize)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	return b, nil
+}
+
+// unexpectedEOF turns io.EOF in the middle of a field into io.ErrUnexpectedEOF
+// so that it isn't mistaken for the end of the input.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/binary/proto"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/plugin"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	spb "github.com/google/osv-scalibr/binary/proto/scan_result_go_proto"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+type testSecret struct {
+	Key string
+}
+
+func streamScanResult() *scalibr.ScanResult {
+	endTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	inv := &extractor.Inventory{
+		Name:    "software",
+		Version: "1.0.0",
+		Metadata: &dpkg.Metadata{
+			PackageName:    "software",
+			PackageVersion: "1.0.0",
+			OSID:           "debian",
+		},
+		Locations: []string{"/file1"},
+		Extractor: dpkg.New(dpkg.DefaultConfig()),
+	}
+	return &scalibr.ScanResult{
+		Version:   "1.0.0",
+		StartTime: endTime.Add(-10 * time.Second),
+		EndTime:   endTime,
+		Status:    &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+		PluginStatus: []*plugin.Status{{
+			Name:    "os/dpkg",
+			Version: 1,
+			Status:  &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded},
+		}},
+		Inventories: []*extractor.Inventory{inv, {
+			Name:      "other",
+			Version:   "2.0.0",
+			Locations: []string{"/file2"},
+			Extractor: dpkg.New(dpkg.DefaultConfig()),
+			Metadata:  &dpkg.Metadata{PackageName: "other", PackageVersion: "2.0.0", OSID: "debian"},
+		}},
+		Findings: []*detector.Finding{{
+			Adv: &detector.Advisory{
+				ID:    &detector.AdvisoryID{Publisher: "CVE", Reference: "CVE-1234"},
+				Type:  detector.TypeVulnerability,
+				Title: "Title",
+				Sev:   &detector.Severity{Severity: detector.SeverityHigh},
+			},
+			Target: &detector.TargetDetails{Location: []string{"/file1"}, Inventory: inv},
+		}},
+		Secrets: []*secrets.Secret{{
+			Secret:     testSecret{Key: "secret"},
+			Location:   "/file3",
+			Confidence: secrets.ConfidenceHigh,
+		}},
+		Labels: map[string]string{"env": "prod"},
+	}
+}
+
+// streamItems reads all items and the summary of a stream.
+func streamItems(t *testing.T, r *proto.StreamReader) (*spb.ScanResult, error) {
+	t.Helper()
+	result := &spb.ScanResult{}
+	for {
+		m, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch m := m.(type) {
+		case *spb.Inventory:
+			result.Inventories = append(result.Inventories, m)
+		case *spb.Finding:
+			result.Findings = append(result.Findings, m)
+		case *spb.Secret:
+			result.Secrets = append(result.Secrets, m)
+		default:
+			t.Fatalf("Next() returned unexpected message %T", m)
+		}
+	}
+	summary, err := r.Summary()
+	if err != nil {
+		return nil, err
+	}
+	gproto.Merge(result, summary)
+	return result, nil
+}
+
+func TestStreamWriter(t *testing.T) {
+	r := streamScanResult()
+	want, err := proto.ScanResultToProto(streamScanResult())
+	if err != nil {
+		t.Fatalf("ScanResultToProto(): %v", err)
+	}
+
+	var buf bytes.Buffer
+	w := proto.NewStreamWriter(&buf)
+	// The first package is streamed during the scan and isn't part of the
+	// result anymore when the scan finishes.
+	if err := w.AddInventory(r.Inventories[0]); err != nil {
+		t.Fatalf("AddInventory(): %v", err)
+	}
+	r.Inventories = r.Inventories[1:]
+	if err := w.Finish(r); err != nil {
+		t.Fatalf("Finish(): %v", err)
+	}
+
+	// The stream is a regular binproto ScanResult.
+	got := &spb.ScanResult{}
+	if err := gproto.Unmarshal(buf.Bytes(), got); err != nil {
+		t.Fatalf("proto.Unmarshal(): %v", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("StreamWriter wrote unexpected result (-want +got):\n%s", diff)
+	}
+}
+
+func TestStreamReader(t *testing.T) {
+	want, err := proto.ScanResultToProto(streamScanResult())
+	if err != nil {
+		t.Fatalf("ScanResultToProto(): %v", err)
+	}
+	full, err := gproto.Marshal(want)
+	if err != nil {
+		t.Fatalf("proto.Marshal(): %v", err)
+	}
+	var streamed bytes.Buffer
+	if err := proto.NewStreamWriter(&streamed).Finish(streamScanResult()); err != nil {
+		t.Fatalf("Finish(): %v", err)
+	}
+
+	testCases := []struct {
+		desc    string
+		input   []byte
+		want    *spb.ScanResult
+		wantErr error
+	}{
+		{
+			desc:  "streamed result",
+			input: streamed.Bytes(),
+			want:  want,
+		},
+		{
+			desc:  "result written in one piece",
+			input: full,
+			want:  want,
+		},
+		{
+			desc:  "empty result",
+			input: nil,
+			want:  &spb.ScanResult{},
+		},
+		{
+			desc:    "truncated result",
+			input:   streamed.Bytes()[:streamed.Len()-3],
+			wantErr: io.ErrUnexpectedEOF,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := streamItems(t, proto.NewStreamReader(bytes.NewReader(tc.input)))
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("StreamReader returned error %v, want %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("StreamReader returned unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStreamReader_SummaryBeforeEOF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := proto.NewStreamWriter(&buf).Finish(streamScanResult()); err != nil {
+		t.Fatalf("Finish(): %v", err)
+	}
+	r := proto.NewStreamReader(&buf)
+	if _, err := r.Next(); err != nil {
+		t.Fatalf("Next(): %v", err)
+	}
+	if _, err := r.Summary(); err == nil {
+		t.Error("Summary() before the end of the stream succeeded, want error")
+	}
+}
+
+func TestWriteStream(t *testing.T) {
+	want, err := proto.ScanResultToProto(streamScanResult())
+	if err != nil {
+		t.Fatalf("ScanResultToProto(): %v", err)
+	}
+	for _, name := range []string{"result.binproto", "result.binproto.gz"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			if err := proto.WriteStream(path, streamScanResult()); err != nil {
+				t.Fatalf("WriteStream(%s): %v", path, err)
+			}
+			r, err := proto.OpenStream(path)
+			if err != nil {
+				t.Fatalf("OpenStream(%s): %v", path, err)
+			}
+			defer r.Close()
+			got, err := streamItems(t, r)
+			if err != nil {
+				t.Fatalf("StreamReader: %v", err)
+			}
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("WriteStream(%s) wrote unexpected result (-want +got):\n%s", path, diff)
+			}
+		})
+	}
+}
+
+func TestCreateStream_InvalidFilename(t *testing.T) {
+	for _, name := range []string{"result.textproto", "result.textproto.gz", "result"} {
+		if _, err := proto.CreateStream(filepath.Join(t.TempDir(), name)); err == nil {
+			t.Errorf("CreateStream(%s) succeeded, want error", name)
+		}
+	}
+	if _, err := proto.CreateStreamWithFormat(filepath.Join(t.TempDir(), "result"), "textproto"); err == nil {
+		t.Error("CreateStreamWithFormat(textproto) succeeded, want error")
+	}
+}
@@ -34,7 +34,7 @@ func parseFlags() *cli.Flags {
 	root := flag.String("root", "", `The root dir used by detectors and by file walking during extraction (e.g.: "/", "c:\" or ".")`)
 	resultFile := flag.String("result", "", "The path of the output scan result file")
 	var output cli.Array
-	flag.Var(&output, "o", "The path of the scanner outputs in various formats, e.g. -o textproto=result.textproto -o spdx23-json=result.spdx.json -o cdx-json=result.cyclonedx.json")
+	flag.Var(&output, "o", "The path of the scanner outputs in various formats, e.g. -o textproto=result.textproto -o spdx23-json=result.spdx.json -o cdx-json=result.cyclonedx.json -o json=result.json")
 	extractorsToRun := cli.NewStringListFlag([]string{"default"})
 	flag.Var(&extractorsToRun, "extractors", "Comma-separated list of extractor plugins to run")
 	detectorsToRun := cli.NewStringListFlag([]string{"default"})
@@ -44,8 +44,11 @@ func parseFlags() *cli.Flags {
 	skipDirRegex := flag.String("skip-dir-regex", "", "If the regex matches a directory, it will be skipped. The regex is matched against the absolute file path.")
 	skipDirGlob := flag.String("skip-dir-glob", "", "If the glob matches a directory, it will be skipped. The glob is matched against the absolute file path.")
 	remoteImage := flag.String("remote-image", "", "The remote image to scan. If specified, SCALIBR pulls and scans this image instead of the local filesystem.")
+	image := flag.String("image", "", "The container image to scan, e.g. registry.example.com/app:1.2. If specified, SCALIBR pulls the image's layers from the registry without a docker daemon and reports which layer each software inventory was added in. Registry credentials are read from the docker config.")
+	baseImageCatalog := flag.String("base-image-catalog", "", "Path to a JSON catalog of known base images, e.g. Docker Official, Chainguard or Distroless images, and the chain IDs of their layers. If set, the packages of an --image scan are annotated with the catalog base image the image was built on instead of guessing the base image from the image history.")
 	imagePlatform := flag.String("image-platform", "", "The platform of the remote image to scan. If not specified, the platform of the client is used. Format is os/arch (e.g. linux/arm64)")
 	govulncheckDBPath := flag.String("govulncheck-db", "", "Path to the offline DB for the govulncheck detectors to use. Leave empty to run the detectors in online mode.")
+	osvDBPath := flag.String("osv-db", "", "Path to an offline OSV database snapshot to match the extracted packages against: a zip bundle of advisories, a directory of zip bundles or a SQLite export. Setting it enables the vulnmatch/osvoffline detector.")
 	spdxDocumentName := flag.String("spdx-document-name", "", "The 'name' field for the output SPDX document")
 	spdxDocumentNamespace := flag.String("spdx-document-namespace", "", "The 'documentNamespace' field for the output SPDX document")
 	spdxCreators := flag.String("spdx-creators", "", "The 'creators' field for the output SPDX document. Format is --spdx-creators=creatortype1:creator1,creatortype2:creator2")
@@ -56,6 +59,37 @@ func parseFlags() *cli.Flags {
 	explicitExtractors := flag.Bool("explicit-extractors", false, "If set, the program will exit with an error if not all extractors required by enabled detectors are explicitly enabled.")
 	filterByCapabilities := flag.Bool("filter-by-capabilities", true, "If set, plugins whose requirements (network access, OS, etc.) aren't satisfied by the scanning environment will be silently disabled instead of throwing a validation error.")
 	windowsAllDrives := flag.Bool("windows-all-drives", false, "Scan all drives on Windows")
+	windowsVSS := flag.Bool("windows-vss", false, "If set, files on Windows that are locked by running processes, e.g. registry hives or NTDS.dit, are read from a Volume Shadow Copy snapshot. The snapshot is created when the first locked file is found and deleted after the scan. Requires admin rights.")
+	windowsOfflineReg := flag.Bool("windows-offline-registry", false, "If set, the Windows registry extractors read the SOFTWARE, SYSTEM and user registry hives of the Windows image mounted at --root instead of the registry of the running system, e.g. to scan the disk snapshot of a Windows VM from a Linux host.")
+	reportCoverage := flag.Bool("report-coverage", false, "If set, known manifests and lockfiles that none of the enabled extractors could parse are listed in the scan result.")
+	detectLicenses := flag.Bool("detect-licenses", false, "If set, the licenses of the packages found are detected from their metadata and license files and reported as SPDX license expressions.")
+	scanSecrets := flag.Bool("secrets", false, "If set, the files visited by the scan are also scanned for secrets such as API tokens and private keys with all Veles detectors. Secrets are reported in the scan result.")
+	validateSecrets := flag.Bool("validate-secrets", false, "If set together with --secrets, the secrets found are validated by authenticating against the public APIs of the services they belong to. Validation requests are rate limited per service.")
+	extractorRetries := flag.Int("extractor-retries", 0, "Number of times a filesystem extractor that panicked on a file is run on it again before the file is quarantined.")
+	quarantineBundleDir := flag.String("quarantine-bundle-dir", "", "If set, a reproduction bundle with the path, extractor and stack trace is written to this directory for each file that made an extractor panic.")
+	extractionCache := flag.String("extraction-cache", "", "If set, the results of filesystem extractors are cached in this file and extractors are only run on files that changed since the previous scan that used it.")
+	maxIOPS := flag.Int("max-iops", 0, "Maximum number of filesystem operations per second of the scan. 0 means no limit.")
+	maxReadBytesPerSec := flag.Int64("max-read-bytes-per-sec", 0, "Maximum number of bytes per second the scan reads from files. 0 means no limit.")
+	cpuShare := flag.Float64("cpu-share", 0, "Share of a CPU between 0 and 1 that extractors may use, e.g. 0.25 to sleep 3 times as long as each extraction took. 0 means no limit.")
+	pluginTimeout := flag.Duration("plugin-timeout", 0, "Total time each extractor and detector may run for, e.g. 5m. Plugins that exceed it are cut off and reported in the scan result. 0 means no limit.")
+	pluginMaxFiles := flag.Int("plugin-max-files", 0, "Maximum number of files each extractor and detector may open. 0 means no limit.")
+	pluginMaxBytes := flag.Int64("plugin-max-bytes", 0, "Maximum number of bytes each extractor and detector may read from files. 0 means no limit.")
+	discover := flag.Bool("discover", false, "If set, the scan roots are discovered on the running host instead of scanning the whole filesystem: container runtime roots, home directories, web roots, database data directories and mounted volumes. --root sets where the host's filesystem is mounted.")
+	discoverDryRun := flag.Bool("discover-dry-run", false, "If set together with --discover, the discovered scan targets are printed without running a scan.")
+	sshHost := flag.String("ssh-host", "", "A remote host to scan over SSH/SFTP instead of the local filesystem, in the format user@host or user@host:port. --root sets the directory to scan on the remote host.")
+	sshIdentity := flag.String("ssh-identity", "", "Path of the private key to authenticate to --ssh-host with. If not set, the keys of the SSH agent are used.")
+	sshKnownHosts := flag.String("ssh-known-hosts", "", "Path of the known_hosts file to verify the host key of --ssh-host with. Defaults to ~/.ssh/known_hosts.")
+	sshMaxConcurrency := flag.Int("ssh-max-concurrency", 0, "Maximum number of concurrent filesystem operations on --ssh-host. 0 means the default of 16.")
+	var pinnedPluginVersions cli.StringListFlag
+	flag.Var(&pinnedPluginVersions, "pinned-plugin-versions", "Comma-separated list of plugin=version pairs, e.g. python/wheelegg=0. The scan fails if any of these plugins isn't enabled or has a different version.")
+	var labels cli.StringListFlag
+	flag.Var(&labels, "labels", "Comma-separated list of key=value pairs to tag the scan result with, e.g. tenant=acme,environment=prod. The labels are written unchanged into all output formats.")
+
+	locale := flag.String("locale", "", "BCP 47 language tag to report the titles, descriptions and recommendations of findings in, e.g. de or pt-BR. Requires --message-catalog. Findings without a translation keep their English texts.")
+	messageCatalog := flag.String("message-catalog", "", "Path to a JSON catalog of the translated advisory texts of the detectors, keyed by locale and message key.")
+	summaryFile := flag.String("summary", "", "The path of the scan summary proto: the counts of packages, findings by severity and secrets by validation status, the failed plugins and the scan duration. The format is derived from the extension, as for --result.")
+	severityThreshold := flag.String("severity-threshold", "high", "Findings with this or a higher severity are counted as above the threshold in the scan summary. One of any, minimal, low, medium, high or critical.")
+	detailedExitCodes := flag.Bool("detailed-exit-codes", false, "If set, the exit code tells apart clean scans (0), fatal errors (1), partial failures (2), findings below the severity threshold (3) and findings above it (4). Otherwise, only failed scans exit with a non-zero code.")
 
 	flag.Parse()
 	filesToExtract := flag.Args()
@@ -71,8 +105,11 @@ func parseFlags() *cli.Flags {
 		SkipDirRegex:          *skipDirRegex,
 		SkipDirGlob:           *skipDirGlob,
 		RemoteImage:           *remoteImage,
+		Image:                 *image,
+		BaseImageCatalog:      *baseImageCatalog,
 		ImagePlatform:         *imagePlatform,
 		GovulncheckDBPath:     *govulncheckDBPath,
+		OSVDBPath:             *osvDBPath,
 		SPDXDocumentName:      *spdxDocumentName,
 		SPDXDocumentNamespace: *spdxDocumentNamespace,
 		SPDXCreators:          *spdxCreators,
@@ -83,6 +120,34 @@ func parseFlags() *cli.Flags {
 		ExplicitExtractors:    *explicitExtractors,
 		FilterByCapabilities:  *filterByCapabilities,
 		WindowsAllDrives:      *windowsAllDrives,
+		WindowsVSS:            *windowsVSS,
+		WindowsOfflineReg:     *windowsOfflineReg,
+		ReportCoverage:        *reportCoverage,
+		DetectLicenses:        *detectLicenses,
+		ScanSecrets:           *scanSecrets,
+		ValidateSecrets:       *validateSecrets,
+		ExtractorRetries:      *extractorRetries,
+		QuarantineBundleDir:   *quarantineBundleDir,
+		ExtractionCache:       *extractionCache,
+		PinnedPluginVersions:  pinnedPluginVersions.GetSlice(),
+		Labels:                labels.GetSlice(),
+		Locale:                *locale,
+		MessageCatalog:        *messageCatalog,
+		MaxIOPS:               *maxIOPS,
+		MaxReadBytesPerSecond: *maxReadBytesPerSec,
+		CPUShare:              *cpuShare,
+		PluginTimeout:         *pluginTimeout,
+		PluginMaxFiles:        *pluginMaxFiles,
+		PluginMaxBytes:        *pluginMaxBytes,
+		Discover:              *discover,
+		DiscoverDryRun:        *discoverDryRun,
+		SSHHost:               *sshHost,
+		SSHIdentity:           *sshIdentity,
+		SSHKnownHosts:         *sshKnownHosts,
+		SSHMaxConcurrency:     *sshMaxConcurrency,
+		SummaryFile:           *summaryFile,
+		SeverityThreshold:     *severityThreshold,
+		DetailedExitCodes:     *detailedExitCodes,
 	}
 	if err := cli.ValidateFlags(flags); err != nil {
 		log.Errorf("Error parsing CLI args: %v", err)
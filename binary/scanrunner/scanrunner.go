@@ -22,6 +22,7 @@ import (
 	"github.com/google/osv-scalibr/binary/cli"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/summary"
 )
 
 // RunScan executes the scan with the given CLI flags
@@ -31,6 +32,16 @@ func RunScan(flags *cli.Flags) int {
 		log.SetLogger(&log.DefaultLogger{Verbose: true})
 	}
 
+	if flags.DiscoverDryRun {
+		plan, err := flags.DiscoveryPlan()
+		if err != nil {
+			log.Errorf("%v.DiscoveryPlan(): %v", flags, err)
+			return 1
+		}
+		log.Infof("Discovered scan targets:\n%s", plan)
+		return 0
+	}
+
 	cfg, err := flags.GetScanConfig()
 	if err != nil {
 		log.Errorf("%v.GetScanConfig(): %v", flags, err)
@@ -45,20 +56,57 @@ func RunScan(flags *cli.Flags) int {
 	if len(cfg.FilesToExtract) > 0 {
 		log.Infof("Files to extract: %s", cfg.FilesToExtract)
 	}
-	result := scalibr.New().Scan(context.Background(), cfg)
+	var result *scalibr.ScanResult
+	if flags.Image != "" {
+		log.Infof("Scanning container image %s", flags.Image)
+		if result, err = flags.ScanImage(context.Background(), cfg); err != nil {
+			log.Errorf("%v.ScanImage(): %v", flags, err)
+			return 1
+		}
+	} else {
+		result = scalibr.New().Scan(context.Background(), cfg)
+	}
+	if cfg.Cache != nil {
+		if err := cfg.Cache.Close(); err != nil {
+			log.Warnf("Failed to save the extraction cache: %v", err)
+		}
+	}
+	if err := flags.Close(); err != nil {
+		log.Warnf("Failed to clean up after the scan: %v", err)
+	}
 
 	log.Infof("Scan status: %v", result.Status)
-	log.Infof("Found %d software inventories, %d security findings", len(result.Inventories), len(result.Findings))
+	s := flags.Summarize(result)
+	log.Infof(
+		"Found %d software inventories, %d security findings (%d at or above the severity threshold)",
+		s.Packages, countFindings(s), s.FindingsAboveThreshold,
+	)
 
 	if err := flags.WriteScanResults(result); err != nil {
 		log.Errorf("Error writing scan results: %v", err)
-		return 1
+		return int(summary.ExitFatal)
+	}
+	if err := flags.WriteSummary(s); err != nil {
+		log.Errorf("Error writing scan summary: %v", err)
+		return int(summary.ExitFatal)
 	}
 
 	if result.Status.Status != plugin.ScanStatusSucceeded {
 		log.Errorf("Scan wasn't successful: %s", result.Status.FailureReason)
-		return 1
 	}
+	if flags.DetailedExitCodes {
+		return int(s.ExitCode)
+	}
+	if result.Status.Status != plugin.ScanStatusSucceeded {
+		return int(summary.ExitFatal)
+	}
+	return int(summary.ExitClean)
+}
 
-	return 0
+func countFindings(s *summary.Summary) int {
+	n := 0
+	for _, c := range s.Findings {
+		n += c
+	}
+	return n
 }
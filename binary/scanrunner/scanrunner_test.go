@@ -24,7 +24,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/osv-scalibr/binary/cli"
 	"github.com/google/osv-scalibr/binary/scanrunner"
+	"github.com/google/osv-scalibr/summary"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	spb "github.com/google/osv-scalibr/binary/proto/scan_result_go_proto"
 )
@@ -158,3 +160,38 @@ func TestRunScan(t *testing.T) {
 		})
 	}
 }
+
+func TestRunScan_Summary(t *testing.T) {
+	dir := createExtractorTestFiles(t)
+	summaryFile := filepath.Join(dir, "summary.textproto")
+	flags := &cli.Flags{
+		Root:              dir,
+		ResultFile:        filepath.Join(dir, "result.textproto"),
+		ExtractorsToRun:   []string{"python/wheelegg"},
+		SummaryFile:       summaryFile,
+		SeverityThreshold: "critical",
+		DetailedExitCodes: true,
+	}
+
+	if gotExit := scanrunner.RunScan(flags); gotExit != int(summary.ExitClean) {
+		t.Errorf("scanrunner.RunScan(%v) returned exit code %d, want %d", flags, gotExit, summary.ExitClean)
+	}
+
+	output, err := os.ReadFile(summaryFile)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%v): %v", summaryFile, err)
+	}
+	got := &spb.ScanSummary{}
+	if err = prototext.Unmarshal(output, got); err != nil {
+		t.Fatalf("prototext.Unmarshal(%v): %v", summaryFile, err)
+	}
+	want := &spb.ScanSummary{
+		Status:            &spb.ScanStatus{Status: spb.ScanStatus_SUCCEEDED},
+		Packages:          1,
+		SeverityThreshold: spb.Severity_CRITICAL,
+		ExitCode:          spb.ScanSummary_CLEAN,
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform(), protocmp.IgnoreFields(&spb.ScanSummary{}, "duration")); diff != "" {
+		t.Errorf("scanrunner.RunScan(%v) wrote unexpected summary (-want +got):\n%s", flags, diff)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scanservice implements the SCALIBR gRPC scan service, which runs
+// scans on the host it runs on for remote clients such as fleet orchestrators.
+package scanservice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/binary/cli"
+	"github.com/google/osv-scalibr/binary/proto"
+	"github.com/google/osv-scalibr/detector"
+	dl "github.com/google/osv-scalibr/detector/list"
+	"github.com/google/osv-scalibr/extractor"
+	el "github.com/google/osv-scalibr/extractor/filesystem/list"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	sl "github.com/google/osv-scalibr/extractor/standalone/list"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/summary"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+
+	spb "github.com/google/osv-scalibr/binary/proto/scan_result_go_proto"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const defaultChunkSize = 1000
+
+// Service implements the Scanner gRPC service.
+type Service struct {
+	spb.UnimplementedScannerServer
+}
+
+// New returns a Scanner service.
+func New() *Service {
+	return &Service{}
+}
+
+// Register registers the Scanner service and a gRPC health service that
+// reports it as serving on s.
+func Register(s *grpc.Server, svc *Service) {
+	spb.RegisterScannerServer(s, svc)
+	h := health.NewServer()
+	h.SetServingStatus(spb.Scanner_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, h)
+}
+
+// RunScan runs a scan with the plugins of the request and streams its result.
+func (s *Service) RunScan(req *spb.RunScanRequest, stream spb.Scanner_RunScanServer) error {
+	cfg, err := scanConfig(req)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid scan config: %v", err)
+	}
+	chunkSize := int(req.GetChunkSize())
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	sender := &chunkSender{stream: stream, chunkSize: chunkSize}
+	collector := summary.NewCollector(summaryConfig(req), sender)
+	cfg.ResultSink = collector
+
+	log.Infof("Running scan with %d extractors and %d detectors",
+		len(cfg.FilesystemExtractors)+len(cfg.StandaloneExtractors), len(cfg.Detectors))
+	result := scalibr.New().Scan(stream.Context(), cfg)
+	if err := stream.Context().Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	return sender.finish(result, collector.Summarize(result))
+}
+
+// scanConfig creates the config of the scan requested by req in the same way
+// as from the flags of the SCALIBR binary.
+func scanConfig(req *spb.RunScanRequest) (*scalibr.ScanConfig, error) {
+	flags := &cli.Flags{
+		Root:                 req.GetRoot(),
+		ExtractorsToRun:      req.GetExtractors(),
+		DetectorsToRun:       req.GetDetectors(),
+		FilesToExtract:       req.GetFilesToExtract(),
+		DirsToSkip:           req.GetDirsToSkip(),
+		SkipDirRegex:         req.GetSkipDirRegex(),
+		FilterByCapabilities: true,
+	}
+	if len(flags.ExtractorsToRun) == 0 {
+		flags.ExtractorsToRun = []string{"default"}
+	}
+	if len(flags.DetectorsToRun) == 0 {
+		flags.DetectorsToRun = []string{"default"}
+	}
+	cfg, err := flags.GetScanConfig()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetLabels()) > 0 {
+		cfg.Labels = req.GetLabels()
+	}
+	return cfg, nil
+}
+
+// summaryConfig returns the config for summarizing the scan requested by req.
+func summaryConfig(req *spb.RunScanRequest) summary.Config {
+	cfg := summary.DefaultConfig()
+	if t := req.GetSeverityThreshold(); t != spb.Severity_UNSPECIFIED {
+		// The proto enum values match the detector ones.
+		cfg.SeverityThreshold = detector.SeverityEnum(t)
+	}
+	return cfg
+}
+
+// chunkSender streams the packages, findings and secrets of a scan in chunks.
+// It implements scalibr.ResultSink.
+type chunkSender struct {
+	stream    spb.Scanner_RunScanServer
+	chunkSize int
+	chunk     *spb.ScanResultChunk
+	size      int
+}
+
+func (c *chunkSender) AddInventory(i *extractor.Inventory) error {
+	p, err := proto.InventoryToProto(i)
+	if err != nil {
+		return err
+	}
+	chunk := c.current()
+	chunk.Inventories = append(chunk.Inventories, p)
+	return c.added()
+}
+
+func (c *chunkSender) AddFinding(f *detector.Finding) error {
+	p, err := proto.FindingToProto(f)
+	if err != nil {
+		return err
+	}
+	chunk := c.current()
+	chunk.Findings = append(chunk.Findings, p)
+	return c.added()
+}
+
+func (c *chunkSender) AddSecret(s *secrets.Secret) error {
+	p, err := proto.SecretToProto(s)
+	if err != nil {
+		return err
+	}
+	chunk := c.current()
+	chunk.Secrets = append(chunk.Secrets, p)
+	return c.added()
+}
+
+func (c *chunkSender) current() *spb.ScanResultChunk {
+	if c.chunk == nil {
+		c.chunk = &spb.ScanResultChunk{}
+	}
+	return c.chunk
+}
+
+// added sends the current chunk once it's full.
+func (c *chunkSender) added() error {
+	c.size++
+	if c.size < c.chunkSize {
+		return nil
+	}
+	return c.flush()
+}
+
+func (c *chunkSender) flush() error {
+	if c.chunk == nil {
+		return nil
+	}
+	chunk := c.chunk
+	c.chunk, c.size = nil, 0
+	if err := c.stream.Send(chunk); err != nil {
+		return fmt.Errorf("failed to send scan result chunk: %w", err)
+	}
+	return nil
+}
+
+// finish sends the packages, findings and secrets that are still in r
+// followed by a last chunk with the rest of r and its summary s.
+func (c *chunkSender) finish(r *scalibr.ScanResult, s *summary.Summary) error {
+	if err := func() error {
+		for _, i := range r.Inventories {
+			if err := c.AddInventory(i); err != nil {
+				return err
+			}
+		}
+		for _, f := range r.Findings {
+			if err := c.AddFinding(f); err != nil {
+				return err
+			}
+		}
+		for _, s := range r.Secrets {
+			if err := c.AddSecret(s); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return status.Errorf(codes.Internal, "failed to convert scan result: %v", err)
+	}
+	rest := *r
+	rest.Inventories, rest.Findings, rest.Secrets = nil, nil, nil
+	p, err := proto.ScanResultToProto(&rest)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to convert scan result: %v", err)
+	}
+	chunk := c.current()
+	chunk.Summary = p
+	chunk.ScanSummary = proto.ScanSummaryToProto(s)
+	return c.flush()
+}
+
+var _ scalibr.ResultSink = &chunkSender{}
+
+// ListPlugins lists the extractors and detectors built into the service.
+func (s *Service) ListPlugins(ctx context.Context, req *spb.ListPluginsRequest) (*spb.ListPluginsResponse, error) {
+	var plugins []*spb.PluginDescription
+	for _, e := range el.All {
+		plugins = append(plugins, pluginDescription(e.Name(), e.Version(), spb.PluginDescription_FILESYSTEM_EXTRACTOR))
+	}
+	for _, e := range sl.All {
+		plugins = append(plugins, pluginDescription(e.Name(), e.Version(), spb.PluginDescription_STANDALONE_EXTRACTOR))
+	}
+	for _, d := range dl.All {
+		plugins = append(plugins, pluginDescription(d.Name(), d.Version(), spb.PluginDescription_DETECTOR))
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		if plugins[i].GetType() != plugins[j].GetType() {
+			return plugins[i].GetType() < plugins[j].GetType()
+		}
+		return plugins[i].GetName() < plugins[j].GetName()
+	})
+	return &spb.ListPluginsResponse{Plugins: plugins}, nil
+}
+
+func pluginDescription(name string, version int, typ spb.PluginDescription_TypeEnum) *spb.PluginDescription {
+	return &spb.PluginDescription{Name: name, Version: int32(version), Type: typ}
+}
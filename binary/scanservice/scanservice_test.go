@@ -0,0 +1,187 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanservice_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/binary/scanservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	spb "github.com/google/osv-scalibr/binary/proto/scan_result_go_proto"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// newClient serves the scan service in memory and returns a connection to it.
+func newClient(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	scanservice.Register(s, scanservice.New())
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.DialContext(): %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("os.MkdirAll(%s): %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", path, err)
+	}
+}
+
+func TestRunScan(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "site-packages/foo-1.0.dist-info/METADATA"), "Name: foo\nVersion: 1.0\n")
+	writeFile(t, filepath.Join(root, "site-packages/bar-2.0.dist-info/METADATA"), "Name: bar\nVersion: 2.0\n")
+
+	client := spb.NewScannerClient(newClient(t))
+	stream, err := client.RunScan(context.Background(), &spb.RunScanRequest{
+		Root:       root,
+		Extractors: []string{"python/wheelegg"},
+		Labels:     map[string]string{"tenant": "acme"},
+		ChunkSize:  1,
+	})
+	if err != nil {
+		t.Fatalf("RunScan(): %v", err)
+	}
+
+	var chunks []*spb.ScanResultChunk
+	for {
+		c, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv(): %v", err)
+		}
+		chunks = append(chunks, c)
+	}
+	if len(chunks) == 0 {
+		t.Fatal("RunScan() sent no chunks")
+	}
+
+	var names []string
+	for i, c := range chunks {
+		if n := len(c.GetInventories()) + len(c.GetFindings()) + len(c.GetSecrets()); n > 1 {
+			t.Errorf("RunScan() chunk %d has %d items, want at most 1", i, n)
+		}
+		if last := i == len(chunks)-1; (c.GetSummary() != nil) != last {
+			t.Errorf("RunScan() chunk %d has summary %v, want summary only in the last chunk", i, c.GetSummary())
+		}
+		for _, inv := range c.GetInventories() {
+			names = append(names, inv.GetName())
+		}
+	}
+	slices.Sort(names)
+	if diff := cmp.Diff([]string{"bar", "foo"}, names); diff != "" {
+		t.Errorf("RunScan() returned unexpected packages (-want +got):\n%s", diff)
+	}
+
+	summary := chunks[len(chunks)-1].GetSummary()
+	if got := summary.GetStatus().GetStatus(); got != spb.ScanStatus_SUCCEEDED {
+		t.Errorf("RunScan() returned status %v, want %v", got, spb.ScanStatus_SUCCEEDED)
+	}
+	if got := summary.GetLabels()["tenant"]; got != "acme" {
+		t.Errorf("RunScan() returned label tenant=%q, want acme", got)
+	}
+	if n := len(summary.GetInventories()); n != 0 {
+		t.Errorf("RunScan() returned %d packages in the summary, want 0", n)
+	}
+
+	scanSummary := chunks[len(chunks)-1].GetScanSummary()
+	if got := scanSummary.GetPackages(); got != 2 {
+		t.Errorf("RunScan() returned scan summary with %d packages, want 2", got)
+	}
+	if got := scanSummary.GetSeverityThreshold(); got != spb.Severity_HIGH {
+		t.Errorf("RunScan() returned scan summary with threshold %v, want %v", got, spb.Severity_HIGH)
+	}
+	if got := scanSummary.GetExitCode(); got != spb.ScanSummary_CLEAN {
+		t.Errorf("RunScan() returned scan summary with exit code %v, want %v", got, spb.ScanSummary_CLEAN)
+	}
+}
+
+func TestRunScan_InvalidConfig(t *testing.T) {
+	client := spb.NewScannerClient(newClient(t))
+	for _, req := range []*spb.RunScanRequest{
+		{Root: t.TempDir(), Extractors: []string{"unknown"}},
+		{Root: t.TempDir(), SkipDirRegex: "["},
+	} {
+		stream, err := client.RunScan(context.Background(), req)
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if got := status.Code(err); got != codes.InvalidArgument {
+			t.Errorf("RunScan(%v) returned error %v, want code %v", req, err, codes.InvalidArgument)
+		}
+	}
+}
+
+func TestListPlugins(t *testing.T) {
+	client := spb.NewScannerClient(newClient(t))
+	resp, err := client.ListPlugins(context.Background(), &spb.ListPluginsRequest{})
+	if err != nil {
+		t.Fatalf("ListPlugins(): %v", err)
+	}
+	want := map[string]spb.PluginDescription_TypeEnum{
+		"python/wheelegg":                        spb.PluginDescription_FILESYSTEM_EXTRACTOR,
+		"cis/generic_linux/etcpasswdpermissions": spb.PluginDescription_DETECTOR,
+	}
+	for _, p := range resp.GetPlugins() {
+		if typ, ok := want[p.GetName()]; ok {
+			if p.GetType() != typ {
+				t.Errorf("ListPlugins() returned %s with type %v, want %v", p.GetName(), p.GetType(), typ)
+			}
+			delete(want, p.GetName())
+		}
+	}
+	for name := range want {
+		t.Errorf("ListPlugins() didn't return %s", name)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	client := healthpb.NewHealthClient(newClient(t))
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: spb.Scanner_ServiceDesc.ServiceName})
+	if err != nil {
+		t.Fatalf("Check(): %v", err)
+	}
+	if got := resp.GetStatus(); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("Check() returned status %v, want %v", got, healthpb.HealthCheckResponse_SERVING)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The server command serves the SCALIBR gRPC scan service, which lets remote
+// clients such as fleet orchestrators run scans on the host it runs on.
+package main
+
+import (
+	"errors"
+	"flag"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/google/osv-scalibr/binary/scanservice"
+	"github.com/google/osv-scalibr/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "The address to serve the scan service on")
+	tlsCert := flag.String("tls-cert", "", "Path of the PEM certificate to serve the scan service with over TLS. Requires --tls-key.")
+	tlsKey := flag.String("tls-key", "", "Path of the PEM private key of --tls-cert")
+	verbose := flag.Bool("verbose", false, "Enable this to print debug logs")
+	flag.Parse()
+
+	if *verbose {
+		log.SetLogger(&log.DefaultLogger{Verbose: true})
+	}
+	if err := run(*addr, *tlsCert, *tlsKey); err != nil {
+		log.Errorf("server: %v", err)
+		os.Exit(1)
+	}
+}
+
+func run(addr, tlsCert, tlsKey string) error {
+	var opts []grpc.ServerOption
+	if (tlsCert == "") != (tlsKey == "") {
+		return errors.New("--tls-cert and --tls-key need to be set together")
+	}
+	if tlsCert != "" {
+		creds, err := credentials.NewServerTLSFromFile(tlsCert, tlsKey)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else {
+		log.Warnf("Serving without TLS, the scan service should only be reachable from trusted hosts")
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s := grpc.NewServer(opts...)
+	scanservice.Register(s, scanservice.New())
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		log.Infof("Shutting down the scan service")
+		s.GracefulStop()
+	}()
+
+	log.Infof("Serving the scan service on %s", lis.Addr())
+	return s.Serve(lis)
+}
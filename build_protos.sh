@@ -17,7 +17,7 @@
 rm -rf binary/proto/*_go_proto
 
 # Compile protos.
-protoc -I=binary --go_out=binary/proto binary/proto/*.proto
+protoc -I=binary --go_out=binary/proto --go-grpc_out=binary/proto binary/proto/*.proto
 
 # Clean up.
 mv binary/proto/github.com/google/scalibr/binary/proto/* binary/proto/
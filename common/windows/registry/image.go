@@ -0,0 +1,352 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"www.velocidex.com/golang/regparser"
+)
+
+const (
+	// hivesDir is the location of the system hives relative to the root of a Windows image.
+	hivesDir = `Windows\System32\config`
+	// userHiveName is the name of the per-user hive stored in each profile directory.
+	userHiveName = "NTUSER.DAT"
+	// regProfileList lists the user profiles of the machine in the SOFTWARE hive.
+	regProfileList = `SOFTWARE\Microsoft\Windows NT\CurrentVersion\ProfileList`
+)
+
+var (
+	errUnsupportedImageHive = errors.New("hive not supported on offline images")
+	errNotReaderAt          = errors.New("hive file does not implement io.ReaderAt")
+)
+
+// systemHives are the HKLM hives that can be loaded from an image.
+var systemHives = []string{"SAM", "SECURITY", "SOFTWARE", "SYSTEM"}
+
+// ImageOpener is an opener for the registry of an offline Windows image, e.g. the mounted disk of
+// a virtual machine. Hives are loaded from their default location on the image filesystem.
+type ImageOpener struct {
+	FS scalibrfs.FS
+}
+
+// NewImageOpener creates a new ImageOpener, allowing to open the registry of the Windows image
+// rooted at the given filesystem.
+func NewImageOpener(fsys scalibrfs.FS) *ImageOpener {
+	return &ImageOpener{fsys}
+}
+
+// Open the registry of the image. Hives are loaded lazily on first access.
+func (o *ImageOpener) Open() (Registry, error) {
+	if o.FS == nil {
+		return nil, errors.New("no filesystem provided for the image")
+	}
+
+	r := &ImageRegistry{fs: o.FS, hives: make(map[string]Registry)}
+	r.openHive = r.openHiveFile
+	return r, nil
+}
+
+// ImageRegistry provides access to the HKLM and HKU hives of an offline Windows image.
+// HKLM keys are resolved from the SAM, SECURITY, SOFTWARE and SYSTEM hives, and the HKU root lists
+// the SIDs of the users that have a profile hive on the image.
+type ImageRegistry struct {
+	fs    scalibrfs.FS
+	hives map[string]Registry
+	// userHives maps the SIDs of the users to the path of their hive. Populated on first access.
+	userHives map[string]string
+	// openHive opens the hive file at the given path. Replaced in tests.
+	openHive func(path string) (Registry, error)
+}
+
+// OpenKey open the requested registry key.
+func (o *ImageRegistry) OpenKey(hive string, path string) (Key, error) {
+	switch hive {
+	case "HKLM":
+		return o.openSystemKey(path)
+	case "HKU":
+		return o.openUserKey(path)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedImageHive, hive)
+	}
+}
+
+// Close closes all the hives that were opened.
+func (o *ImageRegistry) Close() error {
+	var errs []error
+	for _, h := range o.hives {
+		errs = append(errs, h.Close())
+	}
+	o.hives = make(map[string]Registry)
+	return errors.Join(errs...)
+}
+
+func (o *ImageRegistry) openSystemKey(keyPath string) (Key, error) {
+	name, rest, _ := strings.Cut(keyPath, `\`)
+	i := slices.IndexFunc(systemHives, func(h string) bool { return strings.EqualFold(h, name) })
+	if i < 0 {
+		return nil, fmt.Errorf("%w: HKLM\\%s", errUnsupportedImageHive, name)
+	}
+	name = systemHives[i]
+
+	reg, err := o.hive(hivesDir + `\` + name)
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "SYSTEM" {
+		if rest, err = resolveCurrentControlSet(reg, rest); err != nil {
+			return nil, err
+		}
+	}
+
+	return reg.OpenKey("HKLM", rest)
+}
+
+func (o *ImageRegistry) openUserKey(keyPath string) (Key, error) {
+	if err := o.loadUserHives(); err != nil {
+		return nil, err
+	}
+
+	if keyPath == "" {
+		sids := make([]string, 0, len(o.userHives))
+		for sid := range o.userHives {
+			sids = append(sids, sid)
+		}
+		slices.Sort(sids)
+		return &imageUsersKey{sids: sids}, nil
+	}
+
+	sid, rest, _ := strings.Cut(keyPath, `\`)
+	hivePath, ok := o.userHives[sid]
+	if !ok {
+		return nil, fmt.Errorf("%w: no hive for user %s", errFailedToOpenKey, sid)
+	}
+
+	reg, err := o.hive(hivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return reg.OpenKey("HKU", rest)
+}
+
+// loadUserHives lists the profiles from the SOFTWARE hive and keeps those whose hive exists on the
+// image.
+func (o *ImageRegistry) loadUserHives() error {
+	if o.userHives != nil {
+		return nil
+	}
+
+	key, err := o.openSystemKey(regProfileList)
+	if err != nil {
+		return err
+	}
+	defer key.Close()
+
+	sids, err := key.SubkeyNames()
+	if err != nil {
+		return err
+	}
+
+	o.userHives = make(map[string]string)
+	for _, sid := range sids {
+		profile, err := o.openSystemKey(regProfileList + `\` + sid)
+		if err != nil {
+			continue
+		}
+
+		imagePath, err := profile.ValueString("ProfileImagePath")
+		profile.Close()
+		if err != nil {
+			continue
+		}
+
+		hivePath := stripDrive(imagePath) + `\` + userHiveName
+		if _, err := o.resolvePath(hivePath); err == nil {
+			o.userHives[sid] = hivePath
+		}
+	}
+
+	return nil
+}
+
+// hive returns the opened hive at the given Windows path of the image, opening it if needed.
+func (o *ImageRegistry) hive(winPath string) (Registry, error) {
+	p, err := o.resolvePath(winPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if reg, ok := o.hives[p]; ok {
+		return reg, nil
+	}
+
+	reg, err := o.openHive(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open hive %q: %w", p, err)
+	}
+
+	o.hives[p] = reg
+	return reg, nil
+}
+
+func (o *ImageRegistry) openHiveFile(p string) (Registry, error) {
+	f, err := o.fs.Open(p)
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := f.(io.ReaderAt)
+	if !ok {
+		f.Close()
+		return nil, errNotReaderAt
+	}
+
+	reg, err := regparser.NewRegistry(r)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &OfflineRegistry{reg, f}, nil
+}
+
+// resolvePath converts a Windows path relative to the root of the image into a path of the image
+// filesystem. Windows paths are case-insensitive, so each component is matched against the
+// directory entries when there is no exact match.
+func (o *ImageRegistry) resolvePath(winPath string) (string, error) {
+	resolved := "."
+	for _, component := range strings.Split(winPath, `\`) {
+		if component == "" {
+			continue
+		}
+
+		next := path.Join(resolved, component)
+		if _, err := fs.Stat(o.fs, next); err == nil {
+			resolved = next
+			continue
+		}
+
+		entries, err := o.fs.ReadDir(resolved)
+		if err != nil {
+			return "", err
+		}
+
+		i := slices.IndexFunc(entries, func(e fs.DirEntry) bool { return strings.EqualFold(e.Name(), component) })
+		if i < 0 {
+			return "", fmt.Errorf("%s: %w", path.Join(resolved, component), fs.ErrNotExist)
+		}
+		resolved = path.Join(resolved, entries[i].Name())
+	}
+
+	return resolved, nil
+}
+
+// resolveCurrentControlSet replaces the CurrentControlSet link, which only exists on a running
+// system, with the control set it points to.
+func resolveCurrentControlSet(reg Registry, keyPath string) (string, error) {
+	name, rest, _ := strings.Cut(keyPath, `\`)
+	if !strings.EqualFold(name, "CurrentControlSet") {
+		return keyPath, nil
+	}
+
+	key, err := reg.OpenKey("HKLM", "Select")
+	if err != nil {
+		return "", err
+	}
+	defer key.Close()
+
+	current, err := key.ValueBytes("Current")
+	if err != nil {
+		return "", err
+	}
+
+	if len(current) == 0 {
+		return "", fmt.Errorf("%w: empty Select\\Current value", errFailedToOpenKey)
+	}
+
+	controlSet := fmt.Sprintf("ControlSet%03d", current[0])
+	if rest == "" {
+		return controlSet, nil
+	}
+
+	return controlSet + `\` + rest, nil
+}
+
+// stripDrive removes the drive from a profile path, e.g. "C:\Users\foo" or
+// "%SystemDrive%\Users\foo" become "Users\foo".
+func stripDrive(p string) string {
+	first, rest, found := strings.Cut(p, `\`)
+	if !found {
+		return p
+	}
+
+	if strings.HasSuffix(first, ":") || strings.EqualFold(first, "%SystemDrive%") {
+		return rest
+	}
+
+	return p
+}
+
+// imageUsersKey is a virtual key of HKU on an offline image. The root key has the SIDs of the
+// users that have a hive on the image as subkeys.
+type imageUsersKey struct {
+	name string
+	sids []string
+}
+
+// Name returns the name of the key.
+func (k *imageUsersKey) Name() string { return k.name }
+
+// Close closes the key.
+// For the virtual key, this is a no-op.
+func (k *imageUsersKey) Close() error { return nil }
+
+// ClassName returns the class name of the key.
+func (k *imageUsersKey) ClassName() ([]byte, error) { return nil, errFailedToReadClassName }
+
+// Subkeys returns the subkeys of the key.
+func (k *imageUsersKey) Subkeys() ([]Key, error) {
+	var subkeys []Key
+	for _, sid := range k.sids {
+		subkeys = append(subkeys, &imageUsersKey{name: sid})
+	}
+
+	return subkeys, nil
+}
+
+// SubkeyNames returns the names of the subkeys of the key.
+func (k *imageUsersKey) SubkeyNames() ([]string, error) { return slices.Clone(k.sids), nil }
+
+// Value returns the value with the given name.
+func (k *imageUsersKey) Value(name string) (Value, error) { return nil, errFailedToFindValue }
+
+// ValueBytes directly returns the content (as bytes) of the named value.
+func (k *imageUsersKey) ValueBytes(name string) ([]byte, error) { return nil, errFailedToFindValue }
+
+// ValueString directly returns the content (as string) of the named value.
+func (k *imageUsersKey) ValueString(name string) (string, error) { return "", errFailedToFindValue }
+
+// Values returns the different values contained in the key.
+func (k *imageUsersKey) Values() ([]Value, error) { return nil, nil }
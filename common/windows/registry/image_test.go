@@ -0,0 +1,256 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeHive is an in-memory hive whose keys are indexed by path.
+type fakeHive struct {
+	keys   map[string]*fakeKey
+	closed bool
+}
+
+func (h *fakeHive) OpenKey(_ string, path string) (Key, error) {
+	if k, ok := h.keys[path]; ok {
+		return k, nil
+	}
+	return nil, errFailedToOpenKey
+}
+
+func (h *fakeHive) Close() error {
+	h.closed = true
+	return nil
+}
+
+type fakeKey struct {
+	name    string
+	subkeys []string
+	values  map[string]string
+}
+
+func (k *fakeKey) Name() string                   { return k.name }
+func (k *fakeKey) Close() error                   { return nil }
+func (k *fakeKey) ClassName() ([]byte, error)     { return nil, errFailedToReadClassName }
+func (k *fakeKey) Subkeys() ([]Key, error)        { return nil, nil }
+func (k *fakeKey) SubkeyNames() ([]string, error) { return k.subkeys, nil }
+func (k *fakeKey) Values() ([]Value, error)       { return nil, nil }
+func (k *fakeKey) Value(string) (Value, error)    { return nil, errFailedToFindValue }
+
+func (k *fakeKey) ValueBytes(name string) ([]byte, error) {
+	v, err := k.ValueString(name)
+	return []byte(v), err
+}
+
+func (k *fakeKey) ValueString(name string) (string, error) {
+	if v, ok := k.values[name]; ok {
+		return v, nil
+	}
+	return "", errFailedToFindValue
+}
+
+func newTestImage(t *testing.T) (Registry, map[string]*fakeHive) {
+	t.Helper()
+
+	image := fstest.MapFS{
+		"Windows/System32/config/software": {Data: []byte("hive")},
+		"Windows/System32/config/SYSTEM":   {Data: []byte("hive")},
+		"Users/alice/NTUSER.DAT":           {Data: []byte("hive")},
+		"Users/Bob/ntuser.dat":             {Data: []byte("hive")},
+	}
+
+	hives := map[string]*fakeHive{
+		"Windows/System32/config/software": {keys: map[string]*fakeKey{
+			`Microsoft\Windows NT\CurrentVersion`: {name: "CurrentVersion", values: map[string]string{"ProductName": "Windows 10 Pro"}},
+			`Microsoft\Windows NT\CurrentVersion\ProfileList`: {
+				name:    "ProfileList",
+				subkeys: []string{"S-1-5-18", "S-1-5-21-1000", "S-1-5-21-1001", "S-1-5-21-1002"},
+			},
+			`Microsoft\Windows NT\CurrentVersion\ProfileList\S-1-5-18`: {
+				values: map[string]string{"ProfileImagePath": `%systemroot%\system32\config\systemprofile`},
+			},
+			`Microsoft\Windows NT\CurrentVersion\ProfileList\S-1-5-21-1000`: {
+				values: map[string]string{"ProfileImagePath": `C:\Users\alice`},
+			},
+			`Microsoft\Windows NT\CurrentVersion\ProfileList\S-1-5-21-1001`: {
+				values: map[string]string{"ProfileImagePath": `%SystemDrive%\Users\bob`},
+			},
+			// Profile whose hive is not on the image.
+			`Microsoft\Windows NT\CurrentVersion\ProfileList\S-1-5-21-1002`: {
+				values: map[string]string{"ProfileImagePath": `C:\Users\carol`},
+			},
+		}},
+		"Windows/System32/config/SYSTEM": {keys: map[string]*fakeKey{
+			"Select":                             {name: "Select", values: map[string]string{"Current": "\x02"}},
+			`ControlSet002\Control\ComputerName`: {name: "ComputerName"},
+		}},
+		"Users/alice/NTUSER.DAT": {keys: map[string]*fakeKey{
+			`Software\Microsoft`: {name: "Microsoft"},
+		}},
+		"Users/Bob/ntuser.dat": {keys: map[string]*fakeKey{
+			`Software\Microsoft`: {name: "Microsoft", values: map[string]string{"Owner": "bob"}},
+		}},
+	}
+
+	reg, err := NewImageOpener(image).Open()
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+
+	reg.(*ImageRegistry).openHive = func(path string) (Registry, error) {
+		if h, ok := hives[path]; ok {
+			return h, nil
+		}
+		return nil, errors.New("not a hive")
+	}
+
+	return reg, hives
+}
+
+func TestImageRegistryOpenKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		hive     string
+		path     string
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "software_key",
+			hive:     "HKLM",
+			path:     `SOFTWARE\Microsoft\Windows NT\CurrentVersion`,
+			wantName: "CurrentVersion",
+		},
+		{
+			name:     "hive_name_is_case_insensitive",
+			hive:     "HKLM",
+			path:     `Software\Microsoft\Windows NT\CurrentVersion`,
+			wantName: "CurrentVersion",
+		},
+		{
+			name:     "current_control_set_is_resolved",
+			hive:     "HKLM",
+			path:     `SYSTEM\CurrentControlSet\Control\ComputerName`,
+			wantName: "ComputerName",
+		},
+		{
+			name:     "user_key",
+			hive:     "HKU",
+			path:     `S-1-5-21-1000\Software\Microsoft`,
+			wantName: "Microsoft",
+		},
+		{
+			name:     "user_hive_with_lowercase_path",
+			hive:     "HKU",
+			path:     `S-1-5-21-1001\Software\Microsoft`,
+			wantName: "Microsoft",
+		},
+		{
+			name:    "user_without_hive",
+			hive:    "HKU",
+			path:    `S-1-5-21-1002\Software\Microsoft`,
+			wantErr: true,
+		},
+		{
+			name:    "missing_system_hive",
+			hive:    "HKLM",
+			path:    `SAM\SAM\Domains`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown_system_hive",
+			hive:    "HKLM",
+			path:    `HARDWARE\DESCRIPTION`,
+			wantErr: true,
+		},
+		{
+			name:    "current_user_is_not_supported",
+			hive:    "HKCU",
+			path:    `Software\Microsoft`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reg, _ := newTestImage(t)
+			defer reg.Close()
+
+			key, err := reg.OpenKey(tc.hive, tc.path)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("OpenKey(%q, %q) error: got %v, want error: %v", tc.hive, tc.path, err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			if got := key.Name(); got != tc.wantName {
+				t.Errorf("OpenKey(%q, %q).Name() = %q, want %q", tc.hive, tc.path, got, tc.wantName)
+			}
+		})
+	}
+}
+
+func TestImageRegistryUsers(t *testing.T) {
+	reg, _ := newTestImage(t)
+	defer reg.Close()
+
+	key, err := reg.OpenKey("HKU", "")
+	if err != nil {
+		t.Fatalf("OpenKey(HKU, \"\"): %v", err)
+	}
+
+	got, err := key.SubkeyNames()
+	if err != nil {
+		t.Fatalf("SubkeyNames(): %v", err)
+	}
+
+	want := []string{"S-1-5-21-1000", "S-1-5-21-1001"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SubkeyNames() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestImageRegistryClose(t *testing.T) {
+	reg, hives := newTestImage(t)
+
+	if _, err := reg.OpenKey("HKU", `S-1-5-21-1000\Software\Microsoft`); err != nil {
+		t.Fatalf("OpenKey(): %v", err)
+	}
+
+	if err := reg.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+
+	for _, p := range []string{"Windows/System32/config/software", "Users/alice/NTUSER.DAT"} {
+		if !hives[p].closed {
+			t.Errorf("Close() did not close hive %q", p)
+		}
+	}
+	if hives["Windows/System32/config/SYSTEM"].closed {
+		t.Errorf("Close() closed hive that was never opened")
+	}
+}
+
+func TestImageOpenerWithoutFS(t *testing.T) {
+	if _, err := NewImageOpener(nil).Open(); err == nil {
+		t.Errorf("Open() with nil filesystem returned nil error")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package registry
+
+import "errors"
+
+// LiveOpener is an opener for the live registry.
+type LiveOpener struct{}
+
+// NewLiveOpener creates a new LiveOpener, allowing to open the live registry.
+func NewLiveOpener() *LiveOpener {
+	return &LiveOpener{}
+}
+
+// Open the live registry. The live registry is only available on Windows.
+func (o *LiveOpener) Open() (Registry, error) {
+	return nil, errors.New("live registry is only supported on Windows")
+}
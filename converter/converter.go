@@ -19,6 +19,8 @@ package converter
 import (
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/CycloneDX/cyclonedx-go"
@@ -40,11 +42,18 @@ const (
 	SPDXRefPrefix = "SPDXRef-"
 	// SPDXDocumentID is the string identifier used to refer to the SPDX document.
 	SPDXDocumentID = "SPDXRef-Document"
+	// LabelPrefix is the prefix of the SPDX annotations and CDX properties that
+	// store the labels of the scan result, e.g. "scalibr:label:tenant".
+	LabelPrefix = "scalibr:label:"
 )
 
 // spdx_id must only contain letters, numbers, "." and "-"
 var spdxIDInvalidCharRe = regexp.MustCompile(`[^a-zA-Z0-9.-]`)
 
+// licenseRefRe matches the IDs of licenses that aren't on the SPDX License
+// List in license expressions.
+var licenseRefRe = regexp.MustCompile(`LicenseRef-[a-zA-Z0-9.-]+`)
+
 // ToPURL converts a SCALIBR inventory structure into a package URL.
 func ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return i.Extractor.ToPURL(i)
@@ -82,6 +91,8 @@ func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
 		Relationship: "DESCRIBES",
 	})
 
+	var otherLicenses []*v2_3.OtherLicense
+	licenseRefs := map[string]bool{}
 	for _, i := range r.Inventories {
 		p := ToPURL(i)
 		if p == nil {
@@ -101,6 +112,18 @@ func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
 		} else if l := len(i.Locations); l > 1 {
 			pSourceInfo += fmt.Sprintf(" from %d locations, including %s and %s", l, i.Locations[0], i.Locations[1])
 		}
+		licenses := licenseExpression(i.Licenses)
+		for _, ref := range licenseRefRe.FindAllString(licenses, -1) {
+			if licenseRefs[ref] {
+				continue
+			}
+			licenseRefs[ref] = true
+			otherLicenses = append(otherLicenses, &v2_3.OtherLicense{
+				LicenseIdentifier: ref,
+				ExtractedText:     NoAssertion,
+				LicenseName:       strings.TrimPrefix(ref, "LicenseRef-"),
+			})
+		}
 
 		packages = append(packages, &v2_3.Package{
 			PackageName:           pName,
@@ -113,6 +136,7 @@ func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
 			PackageDownloadLocation:   NoAssertion,
 			IsFilesAnalyzedTagPresent: false,
 			PackageSourceInfo:         pSourceInfo,
+			PackageLicenseDeclared:    licenses,
 			PackageExternalReferences: []*v2_3.PackageExternalReference{
 				{
 					Category: "PACKAGE-MANAGER",
@@ -148,6 +172,20 @@ func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
 		},
 	}
 	creators = append(creators, c.Creators...)
+	created := time.Now().UTC().Format("2006-01-02T15:04:05Z")
+	var annotations []*v2_3.Annotation
+	for _, k := range sortedLabelKeys(r.Labels) {
+		annotations = append(annotations, &v2_3.Annotation{
+			Annotator: common.Annotator{
+				AnnotatorType: "Tool",
+				Annotator:     "SCALIBR",
+			},
+			AnnotationDate:           created,
+			AnnotationType:           "OTHER",
+			AnnotationSPDXIdentifier: toDocElementID(SPDXDocumentID),
+			AnnotationComment:        LabelPrefix + k + "=" + r.Labels[k],
+		})
+	}
 	return &v2_3.Document{
 		SPDXVersion:       "SPDX-2.3",
 		DataLicense:       "CC0-1.0",
@@ -156,13 +194,31 @@ func ToSPDX23(r *scalibr.ScanResult, c SPDXConfig) *v2_3.Document {
 		DocumentNamespace: namespace,
 		CreationInfo: &v2_3.CreationInfo{
 			Creators: creators,
-			Created:  time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+			Created:  created,
 		},
 		Packages:      packages,
+		OtherLicenses: otherLicenses,
 		Relationships: relationships,
+		Annotations:   annotations,
 	}
 }
 
+// licenseExpression combines the licenses of a package into a single SPDX
+// license expression. Returns an empty string if the licenses are unknown.
+func licenseExpression(licenses []string) string {
+	if len(licenses) == 1 {
+		return licenses[0]
+	}
+	parts := make([]string, 0, len(licenses))
+	for _, l := range licenses {
+		if strings.Contains(l, " ") {
+			l = "(" + l + ")"
+		}
+		parts = append(parts, l)
+	}
+	return strings.Join(parts, " AND ")
+}
+
 func replaceSPDXIDInvalidChars(id string) string {
 	return spdxIDInvalidCharRe.ReplaceAllString(id, "-")
 }
@@ -219,6 +275,16 @@ func ToCDX(r *scalibr.ScanResult, c CDXConfig) *cyclonedx.BOM {
 		}
 		bom.Metadata.Authors = &authors
 	}
+	if len(r.Labels) > 0 {
+		props := make([]cyclonedx.Property, 0, len(r.Labels))
+		for _, k := range sortedLabelKeys(r.Labels) {
+			props = append(props, cyclonedx.Property{
+				Name:  LabelPrefix + k,
+				Value: r.Labels[k],
+			})
+		}
+		bom.Metadata.Properties = &props
+	}
 
 	comps := make([]cyclonedx.Component, 0, len(r.Inventories))
 	for _, i := range r.Inventories {
@@ -234,6 +300,9 @@ func ToCDX(r *scalibr.ScanResult, c CDXConfig) *cyclonedx.BOM {
 		if cpes := extractCPEs(i); len(cpes) > 0 {
 			pkg.CPE = cpes[0]
 		}
+		if licenses := licenseExpression((*i).Licenses); licenses != "" {
+			pkg.Licenses = &cyclonedx.Licenses{{Expression: licenses}}
+		}
 		if len((*i).Locations) > 0 {
 			occ := make([]cyclonedx.EvidenceOccurrence, 0, len(((*i).Locations)))
 			for _, loc := range (*i).Locations {
@@ -252,6 +321,17 @@ func ToCDX(r *scalibr.ScanResult, c CDXConfig) *cyclonedx.BOM {
 	return bom
 }
 
+// sortedLabelKeys returns the keys of the labels in sorted order so that the
+// output is deterministic.
+func sortedLabelKeys(labels map[string]string) []string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
 func extractCPEs(i *extractor.Inventory) []string {
 	// Only the two SBOM inventory types support storing CPEs.
 	if m, ok := i.Metadata.(*spdxe.Metadata); ok {
@@ -25,6 +25,7 @@ import (
 	"github.com/google/osv-scalibr/converter"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	cdxe "github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
 	"github.com/google/osv-scalibr/purl"
 	"github.com/google/uuid"
 	"github.com/spdx/tools-golang/spdx/v2/common"
@@ -625,6 +626,82 @@ func TestToCDX(t *testing.T) {
 	}
 }
 
+func TestToSPDX23_Labels(t *testing.T) {
+	r := &scalibr.ScanResult{Labels: map[string]string{"tenant": "acme", "environment": "prod"}}
+	got := converter.ToSPDX23(r, converter.SPDXConfig{})
+	want := []*v2_3.Annotation{
+		{
+			Annotator:                common.Annotator{AnnotatorType: "Tool", Annotator: "SCALIBR"},
+			AnnotationDate:           got.CreationInfo.Created,
+			AnnotationType:           "OTHER",
+			AnnotationSPDXIdentifier: common.DocElementID{ElementRefID: "SPDXRef-Document"},
+			AnnotationComment:        "scalibr:label:environment=prod",
+		},
+		{
+			Annotator:                common.Annotator{AnnotatorType: "Tool", Annotator: "SCALIBR"},
+			AnnotationDate:           got.CreationInfo.Created,
+			AnnotationType:           "OTHER",
+			AnnotationSPDXIdentifier: common.DocElementID{ElementRefID: "SPDXRef-Document"},
+			AnnotationComment:        "scalibr:label:tenant=acme",
+		},
+	}
+	if diff := cmp.Diff(want, got.Annotations); diff != "" {
+		t.Errorf("converter.ToSPDX23(%v).Annotations: unexpected diff (-want +got):\n%s", r, diff)
+	}
+}
+
+func TestToSPDX23_Licenses(t *testing.T) {
+	r := &scalibr.ScanResult{
+		Inventories: []*extractor.Inventory{{
+			Name:      "software",
+			Version:   "1.2.3",
+			Extractor: wheelegg.New(wheelegg.DefaultConfig()),
+			Licenses:  []string{"MIT OR Apache-2.0", "LicenseRef-Public-Domain"},
+		}},
+	}
+	got := converter.ToSPDX23(r, converter.SPDXConfig{})
+	wantDeclared := "(MIT OR Apache-2.0) AND LicenseRef-Public-Domain"
+	if gotDeclared := got.Packages[1].PackageLicenseDeclared; gotDeclared != wantDeclared {
+		t.Errorf("converter.ToSPDX23(%v).Packages[1].PackageLicenseDeclared: got %q, want %q", r, gotDeclared, wantDeclared)
+	}
+	wantOther := []*v2_3.OtherLicense{{
+		LicenseIdentifier: "LicenseRef-Public-Domain",
+		ExtractedText:     converter.NoAssertion,
+		LicenseName:       "Public-Domain",
+	}}
+	if diff := cmp.Diff(wantOther, got.OtherLicenses); diff != "" {
+		t.Errorf("converter.ToSPDX23(%v).OtherLicenses: unexpected diff (-want +got):\n%s", r, diff)
+	}
+}
+
+func TestToCDX_Licenses(t *testing.T) {
+	r := &scalibr.ScanResult{
+		Inventories: []*extractor.Inventory{{
+			Name:      "software",
+			Version:   "1.2.3",
+			Extractor: wheelegg.New(wheelegg.DefaultConfig()),
+			Licenses:  []string{"MIT"},
+		}},
+	}
+	got := converter.ToCDX(r, converter.CDXConfig{})
+	want := &cyclonedx.Licenses{{Expression: "MIT"}}
+	if diff := cmp.Diff(want, (*got.Components)[0].Licenses); diff != "" {
+		t.Errorf("converter.ToCDX(%v).Components[0].Licenses: unexpected diff (-want +got):\n%s", r, diff)
+	}
+}
+
+func TestToCDX_Labels(t *testing.T) {
+	r := &scalibr.ScanResult{Labels: map[string]string{"tenant": "acme", "environment": "prod"}}
+	got := converter.ToCDX(r, converter.CDXConfig{})
+	want := ptr([]cyclonedx.Property{
+		{Name: "scalibr:label:environment", Value: "prod"},
+		{Name: "scalibr:label:tenant", Value: "acme"},
+	})
+	if diff := cmp.Diff(want, got.Metadata.Properties); diff != "" {
+		t.Errorf("converter.ToCDX(%v).Metadata.Properties: unexpected diff (-want +got):\n%s", r, diff)
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	pipEx := wheelegg.New(wheelegg.DefaultConfig())
 	tests := []struct {
@@ -663,3 +740,112 @@ func TestToPURL(t *testing.T) {
 		})
 	}
 }
+
+func TestToInstanceID(t *testing.T) {
+	pipEx := wheelegg.New(wheelegg.DefaultConfig())
+	cdxEx := cdxe.Extractor{}
+	// IDs must stay the same across releases, so the expected values are
+	// hard-coded instead of computed.
+	baseID := "357bb245-6874-5b38-8b95-be5194dc62ab"
+
+	tests := []struct {
+		desc      string
+		inventory *extractor.Inventory
+		want      string
+	}{
+		{
+			desc: "Location relative to the scan root",
+			inventory: &extractor.Inventory{
+				Name:      "software",
+				Version:   "1.0.0",
+				Locations: []string{"usr/lib/python3/dist-packages/software-1.0.0.dist-info/METADATA"},
+				Extractor: pipEx,
+			},
+			want: baseID,
+		},
+		{
+			desc: "Absolute location with redundant elements",
+			inventory: &extractor.Inventory{
+				Name:      "software",
+				Version:   "1.0.0",
+				Locations: []string{"/usr/lib/python3/./dist-packages/software-1.0.0.dist-info/METADATA"},
+				Extractor: pipEx,
+			},
+			want: baseID,
+		},
+		{
+			desc: "Windows separators and duplicate locations",
+			inventory: &extractor.Inventory{
+				Name:    "software",
+				Version: "1.0.0",
+				Locations: []string{
+					`usr\lib\python3\dist-packages\software-1.0.0.dist-info\METADATA`,
+					"usr/lib/python3/dist-packages/software-1.0.0.dist-info/METADATA",
+				},
+				Extractor: pipEx,
+			},
+			want: baseID,
+		},
+		{
+			desc: "Metadata is ignored",
+			inventory: &extractor.Inventory{
+				Name:      "software",
+				Version:   "1.0.0",
+				Locations: []string{"usr/lib/python3/dist-packages/software-1.0.0.dist-info/METADATA"},
+				Extractor: pipEx,
+				Metadata:  &wheelegg.PythonPackageMetadata{Author: "someone"},
+			},
+			want: baseID,
+		},
+		{
+			desc: "Different version",
+			inventory: &extractor.Inventory{
+				Name:      "software",
+				Version:   "1.0.1",
+				Locations: []string{"usr/lib/python3/dist-packages/software-1.0.0.dist-info/METADATA"},
+				Extractor: pipEx,
+			},
+			want: "dab8b377-753f-5b97-be76-8751e75e7219",
+		},
+		{
+			desc: "Different location",
+			inventory: &extractor.Inventory{
+				Name:      "software",
+				Version:   "1.0.0",
+				Locations: []string{"opt/venv/lib/python3/site-packages/software-1.0.0.dist-info/METADATA"},
+				Extractor: pipEx,
+			},
+			want: "53735320-eb16-5405-a3f7-dcd2fd0d6527",
+		},
+		{
+			desc: "Different layer",
+			inventory: &extractor.Inventory{
+				Name:         "software",
+				Version:      "1.0.0",
+				Locations:    []string{"usr/lib/python3/dist-packages/software-1.0.0.dist-info/METADATA"},
+				Extractor:    pipEx,
+				LayerDetails: &extractor.LayerDetails{DiffID: "sha256:1234"},
+			},
+			want: "9fe6ebbe-3aa7-55a2-9fe8-c820c1635cfd",
+		},
+		{
+			desc: "No PURL",
+			inventory: &extractor.Inventory{
+				Name:      "software",
+				Version:   "1.0.0",
+				Locations: []string{"usr/lib/python3/dist-packages/software-1.0.0.dist-info/METADATA"},
+				Extractor: cdxEx,
+				Metadata:  &cdxe.Metadata{},
+			},
+			want: "33713146-0f9e-5bc6-952b-ba71aa0b6d5e",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := converter.ToInstanceID(tc.inventory); got != tc.want {
+				t.Errorf("converter.ToInstanceID(%v) = %q, want %q", tc.inventory, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/uuid"
+)
+
+// instanceIDNamespace is the UUID namespace of package instance IDs. Changing
+// it changes the ID of every package.
+var instanceIDNamespace = uuid.MustParse("5004927b-28cc-4f9a-b1ba-8cb819e2b430")
+
+// ToInstanceID returns a stable identifier of a package instance: the same
+// package found at the same place gets the same ID in every scan and on every
+// host, so downstream systems can track it over time.
+//
+// The ID is a name-based (version 5) UUID of
+//   - the PURL of the package, or the extractor, name and version if it has
+//     no PURL,
+//   - the locations of the package, with separators and "." and ".."
+//     elements normalized and a leading slash removed,
+//   - the source code repo and commit, and
+//   - the layer the package was found in for container images.
+//
+// Other metadata isn't included since it often contains values that change
+// without the package changing, such as install times.
+//
+// Locations are hashed as they're reported, which is relative to the scan
+// root unless ScanConfig.StoreAbsolutePath is set. IDs are thus only stable
+// across scans with the same scan root, e.g. "/" for hosts, and IDs of scans
+// that store absolute paths only match the ones of scans that don't if the
+// scan root is "/".
+func ToInstanceID(i *extractor.Inventory) string {
+	var fields []string
+	if p := ToPURL(i); p != nil {
+		fields = append(fields, "purl", p.String())
+	} else {
+		fields = append(fields, "extractor", i.Extractor.Name(), "name", i.Name, "version", i.Version)
+	}
+
+	locations := make([]string, 0, len(i.Locations))
+	for _, l := range i.Locations {
+		locations = append(locations, normalizeLocation(l))
+	}
+	slices.Sort(locations)
+	locations = slices.Compact(locations)
+	for _, l := range locations {
+		fields = append(fields, "location", l)
+	}
+
+	if i.SourceCode != nil {
+		fields = append(fields, "repo", i.SourceCode.Repo, "commit", i.SourceCode.Commit)
+	}
+	if i.LayerDetails != nil {
+		fields = append(fields, "layer", i.LayerDetails.DiffID)
+	}
+
+	// Field values can contain any character, so they're length-prefixed to
+	// keep the encoding unambiguous.
+	var b strings.Builder
+	for _, f := range fields {
+		b.WriteString(strconv.Itoa(len(f)))
+		b.WriteByte(':')
+		b.WriteString(f)
+	}
+	return uuid.NewSHA1(instanceIDNamespace, []byte(b.String())).String()
+}
+
+// normalizeLocation returns l with forward slashes, without redundant path
+// elements and without a leading slash, so that the same location reported
+// as an absolute path or with Windows separators matches.
+func normalizeLocation(l string) string {
+	l = strings.ReplaceAll(l, `\`, "/")
+	return strings.TrimPrefix(path.Clean("/"+l), "/")
+}
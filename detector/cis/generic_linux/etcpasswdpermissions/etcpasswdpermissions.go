@@ -101,6 +101,7 @@ func (Detector) ScanFS(ctx context.Context, fs fs.FS, ix *inventoryindex.Invento
 				Publisher: "CIS",
 				Reference: "etc-passwd-permissions",
 			},
+			MessageKey:     "etc-passwd-permissions",
 			Type:           detector.TypeCISFinding,
 			Title:          title,
 			Description:    description,
@@ -67,6 +67,7 @@ func TestScan(t *testing.T) {
 			Publisher: "CIS",
 			Reference: "etc-passwd-permissions",
 		},
+		MessageKey:     "etc-passwd-permissions",
 		Type:           detector.TypeCISFinding,
 		Title:          wantTitle,
 		Description:    wantDesc,
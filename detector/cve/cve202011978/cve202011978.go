@@ -203,6 +203,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 				Publisher: "SCALIBR",
 				Reference: "CVE-2020-11978",
 			},
+			MessageKey:     "cve-2020-11978",
 			Type:           detector.TypeVulnerability,
 			Title:          "CVE-2020-11978",
 			Description:    "CVE-2020-11978",
@@ -169,6 +169,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 				Publisher: "SCALIBR",
 				Reference: "CVE-2020-16846",
 			},
+			MessageKey:     "cve-2020-16846",
 			Type:           detector.TypeVulnerability,
 			Title:          "CVE-2020-16846",
 			Description:    "CVE-2020-16846",
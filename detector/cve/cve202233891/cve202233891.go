@@ -141,6 +141,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 				Publisher: "SCALIBR",
 				Reference: "CVE-2022-33891",
 			},
+			MessageKey:     "cve-2022-33891",
 			Type:           detector.TypeVulnerability,
 			Title:          "CVE-2022-33891",
 			Description:    "CVE-2022-33891",
@@ -122,6 +122,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 				Publisher: "SCALIBR",
 				Reference: "CVE-2023-38408",
 			},
+			MessageKey:     "cve-2023-38408",
 			Type:           detector.TypeVulnerability,
 			Title:          "CVE-2023-38408",
 			Description:    "CVE-2023-38408",
@@ -94,6 +94,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 				Publisher: "SCALIBR",
 				Reference: "CVE-2023-6019",
 			},
+			MessageKey:     "cve-2023-6019",
 			Type:           detector.TypeVulnerability,
 			Title:          "CVE-2023-6019",
 			Description:    "CVE-2023-6019: Ray Dashboard Remote Code Execution",
@@ -221,6 +221,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 				Publisher: "SCALIBR",
 				Reference: "CVE-2024-2912",
 			},
+			MessageKey:     "cve-2024-2912",
 			Type:           detector.TypeVulnerability,
 			Title:          "CVE-2024-2912",
 			Description:    "CVE-2024-2912",
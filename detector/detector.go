@@ -25,6 +25,7 @@ import (
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/inventoryindex"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/plugin/budget"
 	"github.com/google/osv-scalibr/stats"
 )
 
@@ -72,8 +73,19 @@ type Advisory struct {
 	// higher.".
 	Recommendation string
 	Sev            *Severity
+	// Key of the Title, Description and Recommendation in message catalogs,
+	// e.g. "cve-2024-2912". Set by detectors whose advisories have fixed
+	// texts. The text fields hold the English defaults unless Locale is set.
+	MessageKey MessageKey
+	// Locale the Title, Description and Recommendation were translated to,
+	// e.g. "de" or "pt-BR". Empty for the English defaults.
+	Locale string
 }
 
+// MessageKey identifies the texts of an advisory in message catalogs. See the
+// localization package for translating advisories.
+type MessageKey string
+
 // TypeEnum describes what kind of security finding this is.
 // For now the only type is "Vulnerability".
 type TypeEnum int
@@ -135,20 +147,35 @@ type TargetDetails struct {
 // Run runs the specified detectors and returns their findings,
 // as well as info about whether the plugin runs completed successfully.
 func Run(ctx context.Context, c stats.Collector, detectors []Detector, scanRoot *scalibrfs.ScanRoot, index *inventoryindex.InventoryIndex) ([]*Finding, []*plugin.Status, error) {
+	return RunWithBudgets(ctx, c, detectors, scanRoot, index, nil)
+}
+
+// RunWithBudgets is like Run but cuts off detectors that exceed their budget
+// in budgets. If budgets is nil, the detectors aren't limited.
+func RunWithBudgets(ctx context.Context, c stats.Collector, detectors []Detector, scanRoot *scalibrfs.ScanRoot, index *inventoryindex.InventoryIndex, budgets *budget.Budgets) ([]*Finding, []*plugin.Status, error) {
 	findings := []*Finding{}
 	status := []*plugin.Status{}
 	for _, d := range detectors {
 		if ctx.Err() != nil {
 			return nil, nil, ctx.Err()
 		}
+		usage := budgets.NewUsage(d.Name())
+		root := scanRoot
+		if usage != nil && scanRoot != nil {
+			root = &scalibrfs.ScanRoot{FS: usage.FS(scanRoot.FS), Path: scanRoot.Path}
+		}
 		start := time.Now()
-		results, err := d.Scan(ctx, scanRoot, index)
+		results, err := budget.Run(ctx, usage, func(ctx context.Context) ([]*Finding, error) {
+			return d.Scan(ctx, root, index)
+		})
 		c.AfterDetectorRun(d.Name(), time.Since(start), err)
 		for _, f := range results {
 			f.Detectors = []string{d.Name()}
 		}
 		findings = append(findings, results...)
-		status = append(status, plugin.StatusFromErr(d, false, err))
+		s := plugin.StatusFromErr(d, false, err)
+		s.BudgetExceeded = string(usage.Exceeded())
+		status = append(status, s)
 	}
 	if err := validateAdvisories(findings); err != nil {
 		return []*Finding{}, status, err
@@ -21,7 +21,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/google/osv-scalibr/detector"
@@ -37,6 +39,9 @@ import (
 const (
 	// Name is the unique name of this detector.
 	Name = "govulncheck/binary"
+
+	vulnDBName      = "govulncheck-db"
+	onlineVulnDBURL = "https://vuln.go.dev"
 )
 
 // Detector is a SCALIBR Detector that uses govulncheck to scan for vulns on Go binaries found
@@ -56,6 +61,29 @@ func (d Detector) Requirements() *plugin.Capabilities {
 	return &plugin.Capabilities{Network: d.OfflineVulnDBPath == "", DirectFS: true}
 }
 
+// DataSources returns the Go vulnerability database used by the detector. For
+// offline databases the version is the modification time from the DB index.
+func (d Detector) DataSources() []*plugin.DataSource {
+	if d.OfflineVulnDBPath == "" {
+		return []*plugin.DataSource{{Name: vulnDBName, Location: onlineVulnDBURL}}
+	}
+	ds := &plugin.DataSource{Name: vulnDBName, Location: d.OfflineVulnDBPath}
+	content, err := os.ReadFile(filepath.Join(d.OfflineVulnDBPath, "index", "db.json"))
+	if err != nil {
+		log.Warnf("govulncheck: can't read DB index: %v", err)
+		return []*plugin.DataSource{ds}
+	}
+	var index struct {
+		Modified string `json:"modified"`
+	}
+	if err := json.Unmarshal(content, &index); err != nil {
+		log.Warnf("govulncheck: can't parse DB index: %v", err)
+		return []*plugin.DataSource{ds}
+	}
+	ds.Version = index.Modified
+	return []*plugin.DataSource{ds}
+}
+
 // RequiredExtractors returns the go binary extractor.
 func (Detector) RequiredExtractors() []string {
 	return []string{gobinary.Name}
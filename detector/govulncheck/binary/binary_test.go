@@ -30,6 +30,7 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem/language/golang/gobinary"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
 )
 
 const binaryName = "semaphore-demo-go"
@@ -127,3 +128,40 @@ func setupInventoryIndex(names []string) *inventoryindex.InventoryIndex {
 	ix, _ := inventoryindex.New(invs)
 	return ix
 }
+
+func TestDataSources(t *testing.T) {
+	testCases := []struct {
+		desc string
+		det  binary.Detector
+		want []*plugin.DataSource
+	}{
+		{
+			desc: "online_DB",
+			det:  binary.Detector{},
+			want: []*plugin.DataSource{{Name: "govulncheck-db", Location: "https://vuln.go.dev"}},
+		},
+		{
+			desc: "offline_DB",
+			det:  binary.Detector{OfflineVulnDBPath: "testdata/vulndb"},
+			want: []*plugin.DataSource{{
+				Name:     "govulncheck-db",
+				Location: "testdata/vulndb",
+				Version:  "2024-01-04T18:39:51Z",
+			}},
+		},
+		{
+			desc: "offline_DB_without_index",
+			det:  binary.Detector{OfflineVulnDBPath: "testdata/nonexistent"},
+			want: []*plugin.DataSource{{Name: "govulncheck-db", Location: "testdata/nonexistent"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := tc.det.DataSources()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DataSources() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
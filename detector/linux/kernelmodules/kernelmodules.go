@@ -0,0 +1,136 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kernelmodules implements a detector for loaded kernel modules that
+// are unsigned or otherwise taint the running Linux kernel, e.g. proprietary
+// vendor drivers built out of tree by DKMS.
+package kernelmodules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone/linux/kernelmodules"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Detector is a SCALIBR Detector for unsigned and tainting kernel modules.
+type Detector struct{}
+
+// Name of the detector.
+func (Detector) Name() string { return "linux/taintedkernelmodules" }
+
+// Version of the detector.
+func (Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (Detector) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{OS: plugin.OSLinux, RunningSystem: true}
+}
+
+// RequiredExtractors returns the extractor that lists the loaded kernel modules.
+func (Detector) RequiredExtractors() []string { return []string{kernelmodules.Name} }
+
+// Scan checks the loaded kernel modules found by the kernelmodules extractor.
+func (d Detector) Scan(ctx context.Context, _ *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	var unsigned, tainting []string
+	for _, i := range ix.GetAllOfType(purl.TypeGeneric) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		m, ok := i.Metadata.(*kernelmodules.Metadata)
+		if !ok || m.Module == nil || len(m.Module.Taints) == 0 {
+			continue
+		}
+		if slices.Contains(m.Module.Taints, kernelmodules.TaintUnsigned) {
+			unsigned = append(unsigned, describe(i, m.Module))
+		} else {
+			tainting = append(tainting, describe(i, m.Module))
+		}
+	}
+
+	var findings []*detector.Finding
+	if len(unsigned) > 0 {
+		findings = append(findings, finding(
+			"unsigned-kernel-modules",
+			"Unsigned kernel modules loaded",
+			"Kernel modules without a valid signature are loaded into the running kernel. "+
+				"The kernel doesn't enforce module signatures, so an attacker with root "+
+				"privileges can load a malicious module, e.g. a rootkit, in the same way.",
+			"Sign the modules built by DKMS with a machine owner key (MOK) enrolled in "+
+				"shim, and enable module signature enforcement with Secure Boot or the "+
+				"module.sig_enforce=1 kernel parameter. Unload and remove modules that "+
+				"aren't needed.",
+			detector.SeverityMedium,
+			unsigned,
+		))
+	}
+	if len(tainting) > 0 {
+		findings = append(findings, finding(
+			"tainting-kernel-modules",
+			"Kernel modules that taint the kernel loaded",
+			"Proprietary, out-of-tree, staging or force-loaded kernel modules are loaded "+
+				"into the running kernel. These modules don't go through the review of the "+
+				"kernel's maintainers and often lag behind kernel security fixes.",
+			"Check that the modules come from a trusted vendor and are kept up to date, "+
+				"and unload and remove modules that aren't needed.",
+			detector.SeverityLow,
+			tainting,
+		))
+	}
+	return findings, nil
+}
+
+// describe returns a line for the Extra field of a finding, e.g.
+// "nvidia 545.29.06 (DKMS nvidia/545.29.06): proprietary, out-of-tree, unsigned".
+func describe(i *extractor.Inventory, m *kernelmodules.Module) string {
+	s := i.Name
+	if i.Version != "" {
+		s += " " + i.Version
+	}
+	if m.DKMSPackage != "" {
+		s += fmt.Sprintf(" (DKMS %s)", m.DKMSPackage)
+	}
+	var taints []string
+	for _, t := range m.Taints {
+		taints = append(taints, string(t))
+	}
+	return s + ": " + strings.Join(taints, ", ")
+}
+
+func finding(ref, title, description, recommendation string, sev detector.SeverityEnum, extra []string) *detector.Finding {
+	slices.Sort(extra)
+	return &detector.Finding{
+		Adv: &detector.Advisory{
+			ID: &detector.AdvisoryID{
+				Publisher: "SCALIBR",
+				Reference: ref,
+			},
+			MessageKey:     detector.MessageKey(ref),
+			Type:           detector.TypeVulnerability,
+			Title:          title,
+			Description:    description,
+			Recommendation: recommendation,
+			Sev:            &detector.Severity{Severity: sev},
+		},
+		Extra: strings.Join(extra, "\n"),
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelmodules_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector/linux/kernelmodules"
+	"github.com/google/osv-scalibr/extractor"
+	kmextractor "github.com/google/osv-scalibr/extractor/standalone/linux/kernelmodules"
+	"github.com/google/osv-scalibr/inventoryindex"
+)
+
+func TestScan(t *testing.T) {
+	ex := kmextractor.Extractor{}
+	tests := []struct {
+		name string
+		inv  []*extractor.Inventory
+		// Extra of the findings by advisory reference.
+		want map[string]string
+	}{
+		{
+			name: "unsigned and tainting modules",
+			inv: []*extractor.Inventory{
+				{
+					Name:    "nvidia",
+					Version: "545.29.06",
+					Metadata: &kmextractor.Metadata{Module: &kmextractor.Module{
+						Taints:      []kmextractor.Taint{kmextractor.TaintProprietary, kmextractor.TaintOutOfTree, kmextractor.TaintUnsigned},
+						DKMSPackage: "nvidia/545.29.06",
+					}},
+					Extractor: ex,
+				},
+				{
+					Name: "vboxdrv",
+					Metadata: &kmextractor.Metadata{Module: &kmextractor.Module{
+						Taints: []kmextractor.Taint{kmextractor.TaintOutOfTree, kmextractor.TaintUnsigned},
+					}},
+					Extractor: ex,
+				},
+				{
+					Name:    "zfs",
+					Version: "2.2.2-0ubuntu9",
+					Metadata: &kmextractor.Metadata{Module: &kmextractor.Module{
+						Taints: []kmextractor.Taint{kmextractor.TaintProprietary, kmextractor.TaintOutOfTree},
+					}},
+					Extractor: ex,
+				},
+			},
+			want: map[string]string{
+				"unsigned-kernel-modules": "nvidia 545.29.06 (DKMS nvidia/545.29.06): proprietary, out-of-tree, unsigned\n" +
+					"vboxdrv: out-of-tree, unsigned",
+				"tainting-kernel-modules": "zfs 2.2.2-0ubuntu9: proprietary, out-of-tree",
+			},
+		},
+		{
+			name: "in-tree modules and DKMS packages",
+			inv: []*extractor.Inventory{
+				{
+					Name:      "ext4",
+					Metadata:  &kmextractor.Metadata{Module: &kmextractor.Module{State: "Live"}},
+					Extractor: ex,
+				},
+				{
+					Name:    "nvidia",
+					Version: "545.29.06",
+					Metadata: &kmextractor.Metadata{DKMS: &kmextractor.DKMSPackage{
+						Modules: []string{"nvidia"},
+					}},
+					Extractor: ex,
+				},
+			},
+			want: map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ix, err := inventoryindex.New(tc.inv)
+			if err != nil {
+				t.Fatalf("inventoryindex.New(): %v", err)
+			}
+			findings, err := kernelmodules.Detector{}.Scan(context.Background(), nil, ix)
+			if err != nil {
+				t.Fatalf("Scan(): %v", err)
+			}
+			got := map[string]string{}
+			for _, f := range findings {
+				got[f.Adv.ID.Reference] = f.Extra
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Scan() unexpected findings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
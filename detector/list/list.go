@@ -25,9 +25,15 @@ import (
 	"github.com/google/osv-scalibr/detector/cis/generic_linux/etcpasswdpermissions"
 	"github.com/google/osv-scalibr/detector/cve/cve202338408"
 	"github.com/google/osv-scalibr/detector/govulncheck/binary"
+	"github.com/google/osv-scalibr/detector/linux/kernelmodules"
+	"github.com/google/osv-scalibr/detector/supplychain/registryconfig"
+	"github.com/google/osv-scalibr/detector/supplychain/vendoredcode"
+	"github.com/google/osv-scalibr/detector/vulnmatch/osvoffline"
+	"github.com/google/osv-scalibr/detector/weakcredentials/embeddedhttpd"
 	"github.com/google/osv-scalibr/detector/weakcredentials/etcshadow"
 	"github.com/google/osv-scalibr/detector/weakcredentials/filebrowser"
 	"github.com/google/osv-scalibr/detector/weakcredentials/winlocal"
+	"github.com/google/osv-scalibr/detector/windows/defenderexclusions"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 )
@@ -43,11 +49,29 @@ var Govulncheck []detector.Detector = []detector.Detector{&binary.Detector{}}
 
 // Weakcreds detectors for weak credentials.
 var Weakcreds []detector.Detector = []detector.Detector{
+	&embeddedhttpd.Detector{},
 	&etcshadow.Detector{},
 	&filebrowser.Detector{},
 	&winlocal.Detector{},
 }
 
+// Supplychain detectors for package manager configs that weaken the integrity
+// of installed packages and for vendored code of unknown origin.
+var Supplychain []detector.Detector = []detector.Detector{
+	&registryconfig.Detector{},
+	&vendoredcode.Detector{},
+}
+
+// Windows detectors for insecure system configurations.
+var Windows []detector.Detector = []detector.Detector{&defenderexclusions.Detector{}}
+
+// Linux detectors for insecure system configurations.
+var Linux []detector.Detector = []detector.Detector{&kernelmodules.Detector{}}
+
+// Vulnmatch detectors that match the extracted packages against vulnerability
+// databases.
+var Vulnmatch []detector.Detector = []detector.Detector{&osvoffline.Detector{}}
+
 // Default detectors that are recommended to be enabled.
 var Default []detector.Detector = []detector.Detector{}
 
@@ -57,6 +81,10 @@ var All []detector.Detector = slices.Concat(
 	CVE,
 	Govulncheck,
 	Weakcreds,
+	Supplychain,
+	Windows,
+	Linux,
+	Vulnmatch,
 )
 
 var detectorNames = map[string][]detector.Detector{
@@ -64,6 +92,10 @@ var detectorNames = map[string][]detector.Detector{
 	"cve":         CVE,
 	"govulncheck": Govulncheck,
 	"weakcreds":   Weakcreds,
+	"supplychain": Supplychain,
+	"windows":     Windows,
+	"linux":       Linux,
+	"vulnmatch":   Vulnmatch,
 	"default":     Default,
 	"all":         All,
 }
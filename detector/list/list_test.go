@@ -75,6 +75,7 @@ func TestDetectorsFromNames(t *testing.T) {
 			desc:  "Find weak credentials detectors",
 			names: []string{"weakcreds"},
 			wantDets: []string{
+				"weakcredentials/embeddedhttpd",
 				"weakcredentials/etcshadow",
 				"weakcredentials/filebrowser",
 				"weakcredentials/winlocal",
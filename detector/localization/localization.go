@@ -0,0 +1,143 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localization translates the titles, descriptions and
+// recommendations of the advisories reported by detectors using message
+// catalogs. Detectors with fixed advisory texts set a MessageKey on their
+// advisories and write the English texts, which catalogs map to other
+// locales so that findings can be rendered in the local language without
+// parsing their strings.
+package localization
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/osv-scalibr/detector"
+	"golang.org/x/text/language"
+)
+
+// Messages are the translated texts of an advisory. Empty fields keep the
+// English default.
+type Messages struct {
+	Title          string `json:"title,omitempty"`
+	Description    string `json:"description,omitempty"`
+	Recommendation string `json:"recommendation,omitempty"`
+}
+
+// catalogFile is the JSON format of a catalog file.
+type catalogFile struct {
+	Locales map[string]map[detector.MessageKey]Messages `json:"locales"`
+}
+
+// Catalog holds the translated advisory texts of one or more locales.
+type Catalog struct {
+	byLocale map[language.Tag]map[detector.MessageKey]Messages
+}
+
+// NewCatalog returns a Catalog of the messages of the given locales, which
+// are BCP 47 language tags, e.g. "de" or "pt-BR".
+func NewCatalog(locales map[string]map[detector.MessageKey]Messages) (*Catalog, error) {
+	c := &Catalog{byLocale: make(map[language.Tag]map[detector.MessageKey]Messages)}
+	for l, messages := range locales {
+		tag, err := language.Parse(l)
+		if err != nil {
+			return nil, fmt.Errorf("invalid locale %q: %w", l, err)
+		}
+		if _, ok := c.byLocale[tag]; ok {
+			return nil, fmt.Errorf("locale %q is listed twice", tag)
+		}
+		for key := range messages {
+			if key == "" {
+				return nil, fmt.Errorf("locale %q has messages without a key", tag)
+			}
+		}
+		c.byLocale[tag] = messages
+	}
+	return c, nil
+}
+
+// LoadCatalog reads a Catalog from a JSON file of the form
+//
+//	{"locales": {"de": {"<message key>": {"title": "...", "description": "...", "recommendation": "..."}}}}
+func LoadCatalog(path string) (*Catalog, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f catalogFile
+	if err := json.Unmarshal(content, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse message catalog %s: %w", path, err)
+	}
+	if len(f.Locales) == 0 {
+		return nil, fmt.Errorf("message catalog %s has no locales", path)
+	}
+	c, err := NewCatalog(f.Locales)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message catalog %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Lookup returns the messages of the advisory with the given key in the
+// locale and the locale they were found in. Locales fall back to their
+// parents, e.g. "de-CH" to "de", if the catalog has no messages for them.
+func (c *Catalog) Lookup(locale language.Tag, key detector.MessageKey) (Messages, language.Tag, bool) {
+	for tag := locale; ; tag = tag.Parent() {
+		if m, ok := c.byLocale[tag][key]; ok {
+			return m, tag, true
+		}
+		if tag.IsRoot() {
+			return Messages{}, language.Und, false
+		}
+	}
+}
+
+// Localize translates the advisories of the findings to the locale. Each
+// translated finding gets a copy of its advisory with the translated texts
+// and the locale set, since findings can share advisories. Advisories
+// without a message key or a translation keep their English texts.
+func (c *Catalog) Localize(findings []*detector.Finding, locale string) error {
+	if locale == "" {
+		return errors.New("no locale specified")
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return fmt.Errorf("invalid locale %q: %w", locale, err)
+	}
+	for _, f := range findings {
+		if f.Adv == nil || f.Adv.MessageKey == "" {
+			continue
+		}
+		m, found, ok := c.Lookup(tag, f.Adv.MessageKey)
+		if !ok {
+			continue
+		}
+		adv := *f.Adv
+		if m.Title != "" {
+			adv.Title = m.Title
+		}
+		if m.Description != "" {
+			adv.Description = m.Description
+		}
+		if m.Recommendation != "" {
+			adv.Recommendation = m.Recommendation
+		}
+		adv.Locale = found.String()
+		f.Adv = &adv
+	}
+	return nil
+}
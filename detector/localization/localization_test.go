@@ -0,0 +1,192 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localization_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/localization"
+)
+
+func shadowAdvisory() *detector.Advisory {
+	return &detector.Advisory{
+		ID:             &detector.AdvisoryID{Publisher: "SCALIBR", Reference: "etc-shadow-weakcredentials"},
+		MessageKey:     "etc-shadow-weakcredentials",
+		Type:           detector.TypeVulnerability,
+		Title:          "Ensure all users have strong passwords configured",
+		Description:    "The /etc/shadow file contains user account password hashes.",
+		Recommendation: "Reset the passwords of the reported users.",
+		Sev:            &detector.Severity{Severity: detector.SeverityCritical},
+	}
+}
+
+func kernelModulesAdvisory() *detector.Advisory {
+	return &detector.Advisory{
+		ID:             &detector.AdvisoryID{Publisher: "SCALIBR", Reference: "unsigned-kernel-modules"},
+		MessageKey:     "unsigned-kernel-modules",
+		Type:           detector.TypeVulnerability,
+		Title:          "Unsigned kernel modules loaded",
+		Description:    "Kernel modules without a valid signature are loaded.",
+		Recommendation: "Remove the modules.",
+		Sev:            &detector.Severity{Severity: detector.SeverityMedium},
+	}
+}
+
+func osvAdvisory() *detector.Advisory {
+	return &detector.Advisory{
+		ID:    &detector.AdvisoryID{Publisher: "OSV", Reference: "GHSA-xxxx-yyyy-zzzz"},
+		Type:  detector.TypeVulnerability,
+		Title: "Prototype pollution in foo",
+		Sev:   &detector.Severity{Severity: detector.SeverityHigh},
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	c, err := localization.LoadCatalog(filepath.Join("testdata", "catalog.json"))
+	if err != nil {
+		t.Fatalf("LoadCatalog(): %v", err)
+	}
+
+	germanShadow := shadowAdvisory()
+	germanShadow.Title = "Sicherstellen, dass alle Benutzer starke Passwörter haben"
+	germanShadow.Description = "Die Datei /etc/shadow enthält die Passwort-Hashes der Benutzerkonten. " +
+		"Diese Passwörter müssen stark und schwer zu erraten sein."
+	germanShadow.Recommendation = "Setzen Sie die Passwörter der gemeldeten Benutzer mit dem folgenden Befehl zurück:\n" +
+		"# sudo passwd BENUTZER"
+	germanShadow.Locale = "de"
+	germanKernelModules := kernelModulesAdvisory()
+	germanKernelModules.Title = "Unsignierte Kernelmodule geladen"
+	germanKernelModules.Locale = "de"
+	brazilianShadow := shadowAdvisory()
+	brazilianShadow.Title = "Garantir que todos os usuários tenham senhas fortes"
+	brazilianShadow.Locale = "pt-BR"
+
+	tests := []struct {
+		name   string
+		locale string
+		want   []*detector.Advisory
+	}{
+		{
+			name:   "translated",
+			locale: "de",
+			want:   []*detector.Advisory{germanShadow, germanKernelModules, osvAdvisory()},
+		},
+		{
+			name:   "parent locale",
+			locale: "de-CH",
+			want:   []*detector.Advisory{germanShadow, germanKernelModules, osvAdvisory()},
+		},
+		{
+			name:   "partial translation",
+			locale: "pt_BR",
+			want:   []*detector.Advisory{brazilianShadow, kernelModulesAdvisory(), osvAdvisory()},
+		},
+		{
+			name:   "locale not in catalog",
+			locale: "fr",
+			want:   []*detector.Advisory{shadowAdvisory(), kernelModulesAdvisory(), osvAdvisory()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := []*detector.Finding{{Adv: shadowAdvisory()}, {Adv: kernelModulesAdvisory()}, {Adv: osvAdvisory()}}
+			if err := c.Localize(findings, tt.locale); err != nil {
+				t.Fatalf("Localize(%q): %v", tt.locale, err)
+			}
+			var got []*detector.Advisory
+			for _, f := range findings {
+				got = append(got, f.Adv)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Localize(%q) unexpected advisories (-want +got):\n%s", tt.locale, diff)
+			}
+		})
+	}
+}
+
+func TestLocalize_SharedAdvisory(t *testing.T) {
+	c, err := localization.NewCatalog(map[string]map[detector.MessageKey]localization.Messages{
+		"de": {"unsigned-kernel-modules": {Title: "Unsignierte Kernelmodule geladen"}},
+	})
+	if err != nil {
+		t.Fatalf("NewCatalog(): %v", err)
+	}
+	adv := kernelModulesAdvisory()
+	findings := []*detector.Finding{{Adv: adv}, {Adv: adv}}
+	if err := c.Localize(findings, "de"); err != nil {
+		t.Fatalf("Localize(): %v", err)
+	}
+	if diff := cmp.Diff(kernelModulesAdvisory(), adv); diff != "" {
+		t.Errorf("Localize() modified the shared advisory (-want +got):\n%s", diff)
+	}
+	for _, f := range findings {
+		if f.Adv.Title != "Unsignierte Kernelmodule geladen" {
+			t.Errorf("Localize() title = %q, want the German title", f.Adv.Title)
+		}
+	}
+}
+
+func TestLocalize_InvalidLocale(t *testing.T) {
+	c, err := localization.NewCatalog(nil)
+	if err != nil {
+		t.Fatalf("NewCatalog(): %v", err)
+	}
+	for _, locale := range []string{"", "not a locale"} {
+		if err := c.Localize(nil, locale); err == nil {
+			t.Errorf("Localize(%q) succeeded, want error", locale)
+		}
+	}
+}
+
+func TestNewCatalog_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		locales map[string]map[detector.MessageKey]localization.Messages
+	}{
+		{
+			name:    "invalid locale",
+			locales: map[string]map[detector.MessageKey]localization.Messages{"not a locale": {}},
+		},
+		{
+			name: "duplicate locale",
+			locales: map[string]map[detector.MessageKey]localization.Messages{
+				"pt-BR": {"a": {Title: "a"}},
+				"pt_BR": {"b": {Title: "b"}},
+			},
+		},
+		{
+			name:    "empty key",
+			locales: map[string]map[detector.MessageKey]localization.Messages{"de": {"": {Title: "a"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := localization.NewCatalog(tt.locales); err == nil {
+				t.Errorf("NewCatalog(%v) succeeded, want error", tt.locales)
+			}
+		})
+	}
+}
+
+func TestLoadCatalog_Invalid(t *testing.T) {
+	if _, err := localization.LoadCatalog(filepath.Join("testdata", "nonexistent.json")); err == nil {
+		t.Error("LoadCatalog() of a missing file succeeded, want error")
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registryconfig implements a detector for package manager configs that
+// weaken the integrity of installed packages, e.g. registries reached over plain
+// HTTP, pip configs that are prone to dependency confusion or Go module
+// downloads without checksum verification.
+package registryconfig
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/registryconfig"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Detector is a SCALIBR Detector for insecure package registry configs.
+type Detector struct{}
+
+// Name of the detector.
+func (Detector) Name() string { return "supplychain/registryconfig" }
+
+// Version of the detector.
+func (Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (Detector) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// RequiredExtractors returns the extractor that reads the package manager configs.
+func (Detector) RequiredExtractors() []string { return []string{registryconfig.Name} }
+
+// Scan checks the registry configs found by the registryconfig extractor.
+func (d Detector) Scan(ctx context.Context, _ *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	var insecure, confusion, goproxy []string
+	for _, i := range ix.GetAllOfType(purl.TypeGeneric) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		m, ok := i.Metadata.(*registryconfig.Metadata)
+		if !ok {
+			continue
+		}
+		loc := ""
+		if len(i.Locations) > 0 {
+			loc = i.Locations[0]
+		}
+		for _, f := range InsecureTransport(m) {
+			insecure = append(insecure, loc+": "+f)
+		}
+		for _, f := range DependencyConfusion(m) {
+			confusion = append(confusion, loc+": "+f)
+		}
+		for _, f := range GoProxyMisconfiguration(m) {
+			goproxy = append(goproxy, loc+": "+f)
+		}
+	}
+
+	var findings []*detector.Finding
+	if len(insecure) > 0 {
+		findings = append(findings, finding(
+			"package-registry-insecure-transport",
+			"Package registries accessed without transport security",
+			"Packages are downloaded over plain HTTP or without verifying the registry's TLS "+
+				"certificate. An attacker on the network path can replace the downloaded packages "+
+				"with malicious ones.",
+			"Use https:// URLs for all registries and remove trusted-host, strict-ssl=false and "+
+				"GOINSECURE settings. If the registry uses a private certificate authority, "+
+				"configure it as trusted instead, e.g. with pip's cert or npm's cafile setting.",
+			detector.SeverityHigh,
+			insecure,
+		))
+	}
+	if len(confusion) > 0 {
+		findings = append(findings, finding(
+			"package-registry-dependency-confusion",
+			"Package registry config prone to dependency confusion",
+			"pip is configured with additional indexes through extra-index-url. pip installs the "+
+				"highest version of a package found on any of the configured indexes, so an "+
+				"attacker can publish a package with the name of an internal package and a higher "+
+				"version on the public index to have it installed instead.",
+			"Configure a single index-url that serves both internal and public packages, e.g. a "+
+				"repository manager that prefers internal packages, or pin the hashes of all "+
+				"requirements with --require-hashes.",
+			detector.SeverityMedium,
+			confusion,
+		))
+	}
+	if len(goproxy) > 0 {
+		findings = append(findings, finding(
+			"go-module-proxy-misconfiguration",
+			"Go module downloads bypass the proxy or checksum database",
+			"The go command is configured to fetch modules directly from their origin or to skip "+
+				"verifying module checksums against the checksum database. Modules that were "+
+				"tampered with at their origin or in transit are then not detected.",
+			"Remove GOSUMDB=off and broad GONOSUMDB patterns, and list only private module "+
+				"paths in GOPRIVATE. Set GOPROXY to a module proxy, e.g. "+
+				"\"https://proxy.golang.org,direct\".",
+			detector.SeverityMedium,
+			goproxy,
+		))
+	}
+	return findings, nil
+}
+
+func finding(ref, title, description, recommendation string, sev detector.SeverityEnum, extra []string) *detector.Finding {
+	slices.Sort(extra)
+	return &detector.Finding{
+		Adv: &detector.Advisory{
+			ID: &detector.AdvisoryID{
+				Publisher: "SCALIBR",
+				Reference: ref,
+			},
+			MessageKey:     detector.MessageKey(ref),
+			Type:           detector.TypeVulnerability,
+			Title:          title,
+			Description:    description,
+			Recommendation: recommendation,
+			Sev:            &detector.Severity{Severity: sev},
+		},
+		Extra: strings.Join(extra, "\n"),
+	}
+}
+
+// InsecureTransport returns the settings of the config that download packages
+// over plain HTTP or without verifying TLS certificates.
+func InsecureTransport(m *registryconfig.Metadata) []string {
+	var res []string
+	for _, r := range m.Registries {
+		if isPlainHTTP(r.URL) {
+			res = append(res, fmt.Sprintf("%s %s %q uses plain HTTP", m.Tool, r.Role, r.URL))
+		}
+	}
+	for _, h := range m.InsecureHosts {
+		res = append(res, fmt.Sprintf("%s insecure host %q", m.Tool, h))
+	}
+	if m.TLSVerifyDisabled {
+		res = append(res, m.Tool+" TLS certificate verification disabled")
+	}
+	return res
+}
+
+// DependencyConfusion returns the registries of the config that make package
+// resolution prone to dependency confusion.
+func DependencyConfusion(m *registryconfig.Metadata) []string {
+	var res []string
+	for _, r := range m.Registries {
+		if r.Role == registryconfig.RoleExtraIndex {
+			res = append(res, fmt.Sprintf("%s %s %q", m.Tool, r.Role, r.URL))
+		}
+	}
+	return res
+}
+
+// GoProxyMisconfiguration returns the Go module settings of the config that
+// bypass the module proxy or the checksum database.
+func GoProxyMisconfiguration(m *registryconfig.Metadata) []string {
+	if m.Tool != registryconfig.ToolGo {
+		return nil
+	}
+	var res []string
+	var proxies []string
+	for _, r := range m.Registries {
+		if r.Role == registryconfig.RoleProxy {
+			proxies = append(proxies, r.URL)
+		}
+	}
+	if len(proxies) > 0 {
+		switch proxies[0] {
+		case "direct":
+			res = append(res, "GOPROXY fetches modules directly from their origin")
+		case "off":
+			res = append(res, "GOPROXY disables module downloads")
+		}
+	}
+	if m.GoSumDB == "off" {
+		res = append(res, "GOSUMDB disables checksum verification")
+	}
+	for _, p := range m.GoNoSumDB {
+		// A wildcard first path element matches modules on any host.
+		if host, _, _ := strings.Cut(p, "/"); host == "*" {
+			res = append(res, fmt.Sprintf("GONOSUMDB pattern %q skips checksum verification for public modules", p))
+		}
+	}
+	return res
+}
+
+// isPlainHTTP returns whether the URL points to a non-loopback host over HTTP.
+func isPlainHTTP(u string) bool {
+	parsed, err := url.Parse(u)
+	if err != nil || !strings.EqualFold(parsed.Scheme, "http") {
+		return false
+	}
+	host := parsed.Hostname()
+	if host == "localhost" {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return false
+	}
+	return true
+}
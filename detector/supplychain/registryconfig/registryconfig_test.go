@@ -0,0 +1,146 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryconfig_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector/supplychain/registryconfig"
+	"github.com/google/osv-scalibr/extractor"
+	regextractor "github.com/google/osv-scalibr/extractor/filesystem/misc/registryconfig"
+	"github.com/google/osv-scalibr/inventoryindex"
+)
+
+func TestScan(t *testing.T) {
+	ex := regextractor.Extractor{}
+	tests := []struct {
+		name string
+		inv  []*extractor.Inventory
+		// Extra of the findings by advisory reference.
+		want map[string]string
+	}{
+		{
+			name: "insecure pip and npm configs",
+			inv: []*extractor.Inventory{
+				{
+					Name:      "pip",
+					Locations: []string{"etc/pip.conf"},
+					Metadata: &regextractor.Metadata{
+						Tool: "pip",
+						Registries: []*regextractor.Registry{
+							{URL: "https://pypi.corp.example/simple", Role: regextractor.RoleIndex},
+							{URL: "http://10.0.0.5:8080/simple", Role: regextractor.RoleExtraIndex},
+						},
+						InsecureHosts: []string{"10.0.0.5"},
+					},
+					Extractor: ex,
+				},
+				{
+					Name:      "npm",
+					Locations: []string{"root/.npmrc"},
+					Metadata: &regextractor.Metadata{
+						Tool: "npm",
+						Registries: []*regextractor.Registry{
+							{URL: "https://npm.corp.example/", Role: regextractor.RoleScope, Scope: "@corp"},
+						},
+						TLSVerifyDisabled: true,
+					},
+					Extractor: ex,
+				},
+			},
+			want: map[string]string{
+				"package-registry-insecure-transport": "etc/pip.conf: pip extra-index \"http://10.0.0.5:8080/simple\" uses plain HTTP\n" +
+					"etc/pip.conf: pip insecure host \"10.0.0.5\"\n" +
+					"root/.npmrc: npm TLS certificate verification disabled",
+				"package-registry-dependency-confusion": "etc/pip.conf: pip extra-index \"http://10.0.0.5:8080/simple\"",
+			},
+		},
+		{
+			name: "go env",
+			inv: []*extractor.Inventory{{
+				Name:      "go",
+				Locations: []string{"root/.config/go/env"},
+				Metadata: &regextractor.Metadata{
+					Tool: "go",
+					Registries: []*regextractor.Registry{
+						{URL: "direct", Role: regextractor.RoleProxy},
+						{URL: "https://proxy.golang.org", Role: regextractor.RoleProxy},
+					},
+					GoSumDB:   "off",
+					GoNoSumDB: []string{"*.corp.example", "*"},
+				},
+				Extractor: ex,
+			}},
+			want: map[string]string{
+				"go-module-proxy-misconfiguration": "root/.config/go/env: GONOSUMDB pattern \"*\" skips checksum verification for public modules\n" +
+					"root/.config/go/env: GOPROXY fetches modules directly from their origin\n" +
+					"root/.config/go/env: GOSUMDB disables checksum verification",
+			},
+		},
+		{
+			name: "secure configs",
+			inv: []*extractor.Inventory{
+				{
+					Name:      "npm",
+					Locations: []string{"root/.npmrc"},
+					Metadata: &regextractor.Metadata{
+						Tool: "npm",
+						Registries: []*regextractor.Registry{
+							{URL: "https://npm.corp.example/", Role: regextractor.RoleIndex},
+							{URL: "http://localhost:4873/", Role: regextractor.RoleScope, Scope: "@local"},
+						},
+					},
+					Extractor: ex,
+				},
+				{
+					Name:      "go",
+					Locations: []string{"root/.config/go/env"},
+					Metadata: &regextractor.Metadata{
+						Tool: "go",
+						Registries: []*regextractor.Registry{
+							{URL: "https://proxy.golang.org", Role: regextractor.RoleProxy},
+							{URL: "direct", Role: regextractor.RoleProxy},
+						},
+						GoNoSumDB: []string{"*.corp.example"},
+					},
+					Extractor: ex,
+				},
+			},
+			want: map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ix, err := inventoryindex.New(tc.inv)
+			if err != nil {
+				t.Fatalf("inventoryindex.New(): %v", err)
+			}
+			findings, err := registryconfig.Detector{}.Scan(context.Background(), nil, ix)
+			if err != nil {
+				t.Fatalf("Scan(): %v", err)
+			}
+			got := map[string]string{}
+			for _, f := range findings {
+				got[f.Adv.ID.Reference] = f.Extra
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Scan() unexpected findings (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
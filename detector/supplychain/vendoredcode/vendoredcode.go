@@ -0,0 +1,93 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vendoredcode implements a detector for vendored dependencies whose
+// origin and version can't be determined, which makes them invisible to
+// vulnerability matching.
+package vendoredcode
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendoredcode"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// Detector is a SCALIBR Detector for unidentified vendored components.
+type Detector struct{}
+
+// Name of the detector.
+func (Detector) Name() string { return "supplychain/vendoredcode" }
+
+// Version of the detector.
+func (Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (Detector) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// RequiredExtractors returns the extractor that finds the vendored components.
+func (Detector) RequiredExtractors() []string { return []string{vendoredcode.Name} }
+
+// Scan reports the vendored components that the vendoredcode extractor
+// couldn't identify, together with their content digest.
+func (d Detector) Scan(ctx context.Context, _ *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	var unidentified []string
+	// Unidentified components don't have an ecosystem and use generic PURLs.
+	for _, i := range ix.GetAllOfType(purl.TypeGeneric) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		m, ok := i.Metadata.(*vendoredcode.Metadata)
+		if !ok || m.IdentifiedBy != "" || len(i.Locations) == 0 {
+			continue
+		}
+		line := i.Locations[0]
+		if m.Digest != "" {
+			line += " (" + m.Digest + ")"
+		}
+		unidentified = append(unidentified, line)
+	}
+	if len(unidentified) == 0 {
+		return nil, nil
+	}
+
+	slices.Sort(unidentified)
+	ref := "unidentified-vendored-component"
+	return []*detector.Finding{{
+		Adv: &detector.Advisory{
+			ID: &detector.AdvisoryID{
+				Publisher: "SCALIBR",
+				Reference: ref,
+			},
+			MessageKey: detector.MessageKey(ref),
+			Type:       detector.TypeVulnerability,
+			Title:      "Unidentified vendored component",
+			Description: "The source tree contains vendored dependencies that can't be identified from " +
+				"a package manifest or their content digest. Their origin and version are unknown, " +
+				"so known vulnerabilities in them aren't reported and they're likely not kept up to date.",
+			Recommendation: "Replace the vendored copies with dependencies managed by a package manager, " +
+				"or record their origin and version next to them, e.g. in a README.chromium with " +
+				"Name: and Version: fields. Components that were modified locally should also be " +
+				"documented as such.",
+			Sev: &detector.Severity{Severity: detector.SeverityLow},
+		},
+		Extra: strings.Join(unidentified, "\n"),
+	}}, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendoredcode_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/supplychain/vendoredcode"
+	"github.com/google/osv-scalibr/extractor"
+	vcextractor "github.com/google/osv-scalibr/extractor/filesystem/misc/vendoredcode"
+	"github.com/google/osv-scalibr/inventoryindex"
+)
+
+func TestScan(t *testing.T) {
+	ex := vcextractor.Extractor{}
+	tests := []struct {
+		name string
+		inv  []*extractor.Inventory
+		// Extra of the findings by advisory reference.
+		want map[string]string
+	}{
+		{
+			name: "unidentified components",
+			inv: []*extractor.Inventory{
+				{
+					Name:      "mystery",
+					Locations: []string{"third_party/mystery"},
+					Metadata:  &vcextractor.Metadata{VendorRoot: "third_party", Digest: "sha256:0123"},
+					Extractor: ex,
+				},
+				{
+					Name:      "huge",
+					Locations: []string{"third_party/huge"},
+					Metadata:  &vcextractor.Metadata{VendorRoot: "third_party"},
+					Extractor: ex,
+				},
+				{
+					Name:      "zlib",
+					Version:   "1.3.1",
+					Locations: []string{"third_party/zlib"},
+					Metadata: &vcextractor.Metadata{
+						VendorRoot:   "third_party",
+						IdentifiedBy: vcextractor.IdentifiedByManifest,
+						Manifest:     "third_party/zlib/README.chromium",
+						Digest:       "sha256:4567",
+					},
+					Extractor: ex,
+				},
+				{
+					Name:      "known",
+					Version:   "2.0",
+					Locations: []string{"third_party/known"},
+					Metadata: &vcextractor.Metadata{
+						VendorRoot:   "third_party",
+						IdentifiedBy: vcextractor.IdentifiedByHash,
+						Digest:       "sha256:89ab",
+					},
+					Extractor: ex,
+				},
+			},
+			want: map[string]string{
+				"unidentified-vendored-component": "third_party/huge\nthird_party/mystery (sha256:0123)",
+			},
+		},
+		{
+			name: "identified npm package",
+			inv: []*extractor.Inventory{{
+				Name:      "lodash",
+				Version:   "4.17.21",
+				Locations: []string{"web/node_modules/lodash"},
+				Metadata: &vcextractor.Metadata{
+					VendorRoot:   "web/node_modules",
+					IdentifiedBy: vcextractor.IdentifiedByManifest,
+					Manifest:     "web/node_modules/lodash/package.json",
+					Ecosystem:    "npm",
+				},
+				Extractor: ex,
+			}},
+			want: map[string]string{},
+		},
+		{
+			name: "no inventory",
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ix, err := inventoryindex.New(tt.inv)
+			if err != nil {
+				t.Fatalf("inventoryindex.New(): %v", err)
+			}
+			findings, err := vendoredcode.Detector{}.Scan(context.Background(), nil, ix)
+			if err != nil {
+				t.Fatalf("Scan(): %v", err)
+			}
+			got := map[string]string{}
+			for _, f := range findings {
+				if f.Adv.Sev.Severity != detector.SeverityLow {
+					t.Errorf("Scan(): finding %s has severity %v, want %v", f.Adv.ID.Reference, f.Adv.Sev.Severity, detector.SeverityLow)
+				}
+				got[f.Adv.ID.Reference] = f.Extra
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Scan() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package osvoffline implements a detector that matches the extracted
+// packages against a local snapshot of the OSV database, so that
+// vulnerabilities can be found on hosts without access to the OSV.dev API.
+package osvoffline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/advisorydb/sqlitedb"
+	"github.com/google/osv-scalibr/advisorydb/zipdb"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/package-url/packageurl-go"
+)
+
+const (
+	// Name of the detector.
+	Name = "vulnmatch/osvoffline"
+
+	recommendation = "Upgrade the package to a version that isn't affected by the vulnerability, or remove it if it's not needed."
+)
+
+// Detector matches the extracted packages against the advisories of an
+// offline OSV database snapshot.
+type Detector struct {
+	// DBPath is the path of the snapshot: a zip bundle of advisories, a
+	// directory of zip bundles, e.g. a mirror of several ecosystems' all.zip
+	// files, or a SQLite export. The snapshot is loaded into memory on first
+	// use.
+	DBPath string
+
+	once sync.Once
+	db   advisorydb.DB
+	err  error
+}
+
+// Name of the detector.
+func (*Detector) Name() string { return Name }
+
+// Version of the detector.
+func (*Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (*Detector) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// RequiredExtractors returns an empty list as the detector matches the
+// packages of all extractors.
+func (*Detector) RequiredExtractors() []string { return []string{} }
+
+// DataSources returns the snapshot the packages are matched against. Its
+// version is the time of the most recent advisory modification.
+func (d *Detector) DataSources() []*plugin.DataSource {
+	db, err := d.load()
+	if err != nil {
+		return []*plugin.DataSource{{Name: "osv.dev", Location: d.DBPath}}
+	}
+	return []*plugin.DataSource{db.DataSource()}
+}
+
+// load reads the snapshot at DBPath once.
+func (d *Detector) load() (advisorydb.DB, error) {
+	d.once.Do(func() {
+		if d.DBPath == "" {
+			d.err = errors.New("no OSV database snapshot set")
+			return
+		}
+		info, err := os.Stat(d.DBPath)
+		if err != nil {
+			d.err = err
+			return
+		}
+		if info.IsDir() || strings.EqualFold(filepath.Ext(d.DBPath), ".zip") {
+			d.db, d.err = zipdb.Load(d.DBPath)
+		} else {
+			d.db, d.err = sqlitedb.Load(d.DBPath)
+		}
+	})
+	return d.db, d.err
+}
+
+// Scan matches the versions of all packages in the inventory against the
+// snapshot and returns a finding for each affected package and advisory.
+func (d *Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	db, err := d.load()
+	if err != nil {
+		return nil, fmt.Errorf("loading OSV database snapshot %q: %w", d.DBPath, err)
+	}
+
+	var invs []*extractor.Inventory
+	var queries []*advisorydb.Query
+	for _, inv := range ix.GetAll() {
+		if inv.Extractor == nil {
+			continue
+		}
+		p := queryPURL(inv.Extractor.ToPURL(inv))
+		// Packages without version would match all of their advisories.
+		if p == nil || p.Version == "" {
+			continue
+		}
+		invs = append(invs, inv)
+		queries = append(queries, &advisorydb.Query{PURL: p})
+	}
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	results, err := db.GetBatch(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []*detector.Finding
+	for i, vulns := range results {
+		for _, v := range dedupAliases(vulns) {
+			findings = append(findings, &detector.Finding{
+				Adv:    advisory(v),
+				Target: &detector.TargetDetails{Inventory: invs[i]},
+				Extra:  extra(v, queries[i].PURL),
+			})
+		}
+	}
+	return findings, nil
+}
+
+// queryPURL returns the PURL to look up advisories for. Debian and Alpine
+// advisories are published for source packages, so binary packages are looked
+// up by the name and version of their source package.
+func queryPURL(p *purl.PackageURL) *purl.PackageURL {
+	if p == nil {
+		return nil
+	}
+	q := packageurl.Qualifiers(p.Qualifiers).Map()
+	switch p.Type {
+	case purl.TypeDebian:
+		if q[purl.Source] == "" {
+			return p
+		}
+		version := p.Version
+		if q[purl.SourceVersion] != "" {
+			version = q[purl.SourceVersion]
+		}
+		return &purl.PackageURL{Type: p.Type, Namespace: p.Namespace, Name: q[purl.Source], Version: version}
+	case purl.TypeApk:
+		if q[purl.Origin] == "" {
+			return p
+		}
+		return &purl.PackageURL{Type: p.Type, Namespace: p.Namespace, Name: q[purl.Origin], Version: p.Version}
+	}
+	return p
+}
+
+// dedupAliases drops advisories that are aliases of an advisory reported
+// earlier, e.g. a PYSEC advisory for the same vulnerability as a GHSA one.
+func dedupAliases(vulns []*advisorydb.Vulnerability) []*advisorydb.Vulnerability {
+	var result []*advisorydb.Vulnerability
+	seen := map[string]bool{}
+	for _, v := range vulns {
+		if seen[v.ID] || slices.ContainsFunc(v.Aliases, func(a string) bool { return seen[a] }) {
+			continue
+		}
+		result = append(result, v)
+		seen[v.ID] = true
+		for _, a := range v.Aliases {
+			seen[a] = true
+		}
+	}
+	return result
+}
+
+// advisory returns the Advisory for v. It only depends on v so that all
+// findings of the same advisory are identical.
+func advisory(v *advisorydb.Vulnerability) *detector.Advisory {
+	title := v.Summary
+	if title == "" {
+		title = v.ID
+	}
+	description := v.Details
+	if len(v.Aliases) > 0 {
+		description = strings.TrimSpace(description + "\n\nAliases: " + strings.Join(v.Aliases, ", "))
+	}
+	return &detector.Advisory{
+		ID:             &detector.AdvisoryID{Publisher: "OSV", Reference: v.ID},
+		Type:           detector.TypeVulnerability,
+		Title:          title,
+		Description:    description,
+		Recommendation: recommendation,
+		Sev:            &detector.Severity{Severity: detector.SeverityUnspecified},
+	}
+}
+
+// extra lists the versions that fix the advisory for the matched package.
+func extra(v *advisorydb.Vulnerability, p *purl.PackageURL) string {
+	fixed := map[string]bool{}
+	for _, a := range v.Affected {
+		if !strings.EqualFold(a.Package.Name, p.Name) && !strings.HasSuffix(a.Package.Name, "/"+p.Name) && !strings.HasSuffix(a.Package.Name, ":"+p.Name) {
+			continue
+		}
+		for _, r := range a.Ranges {
+			for _, e := range r.Events {
+				if e.Fixed != "" {
+					fixed[e.Fixed] = true
+				}
+			}
+		}
+	}
+	if len(fixed) == 0 {
+		return fmt.Sprintf("%s %s is affected, no fixed version is known", p.Name, p.Version)
+	}
+	versions := make([]string, 0, len(fixed))
+	for f := range fixed {
+		versions = append(versions, f)
+	}
+	sort.Strings(versions)
+	return fmt.Sprintf("%s %s is affected, fixed in %s", p.Name, p.Version, strings.Join(versions, ", "))
+}
@@ -0,0 +1,263 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvoffline_test
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/advisorydb"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/vulnmatch/osvoffline"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+var (
+	glibcVuln = &advisorydb.Vulnerability{
+		ID:       "DSA-5678-1",
+		Modified: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Summary:  "glibc security update",
+		Aliases:  []string{"CVE-2024-2961"},
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "Debian:12", Name: "glibc"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "0"}, {Fixed: "2.36-9+deb12u7"}},
+			}},
+		}},
+	}
+	gitVuln = &advisorydb.Vulnerability{
+		ID:       "ALPINE-CVE-2024-32002",
+		Modified: time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC),
+		Details:  "Recursive clones can execute code.",
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "Alpine:v3.20", Name: "git"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "0"}, {Fixed: "2.45.1-r0"}},
+			}},
+		}},
+	}
+	requestsGHSA = &advisorydb.Vulnerability{
+		ID:       "GHSA-j8r2-6x86-q33q",
+		Modified: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+		Summary:  "Unintended leak of Proxy-Authorization header in requests",
+		Aliases:  []string{"CVE-2023-32681", "PYSEC-2023-74"},
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "PyPI", Name: "requests"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "2.3.0"}, {Fixed: "2.31.0"}},
+			}},
+		}},
+	}
+	requestsPYSEC = &advisorydb.Vulnerability{
+		ID:       "PYSEC-2023-74",
+		Modified: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+		Aliases:  []string{"CVE-2023-32681", "GHSA-j8r2-6x86-q33q"},
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "PyPI", Name: "requests"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeEcosystem,
+				Events: []advisorydb.Event{{Introduced: "2.3.0"}, {Fixed: "2.31.0"}},
+			}},
+		}},
+	}
+	lodashVuln = &advisorydb.Vulnerability{
+		ID:       "GHSA-p6mc-m468-83gw",
+		Modified: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		Affected: []advisorydb.Affected{{
+			Package: advisorydb.Package{Ecosystem: "npm", Name: "lodash"},
+			Ranges: []advisorydb.Range{{
+				Type:   advisorydb.RangeSemVer,
+				Events: []advisorydb.Event{{Introduced: "0"}, {Fixed: "4.17.19"}},
+			}},
+		}},
+	}
+)
+
+var (
+	libc6 = &extractor.Inventory{
+		Name:    "libc6",
+		Version: "2.36-9+deb12u4",
+		Metadata: &dpkg.Metadata{
+			PackageName:       "libc6",
+			SourceName:        "glibc",
+			PackageVersion:    "2.36-9+deb12u4",
+			OSID:              "debian",
+			OSVersionCodename: "bookworm",
+			OSVersionID:       "12",
+			Architecture:      "amd64",
+		},
+		Extractor: dpkg.New(dpkg.DefaultConfig()),
+	}
+	git = &extractor.Inventory{
+		Name:    "git",
+		Version: "2.45.0-r0",
+		Metadata: &apk.Metadata{
+			PackageName: "git",
+			OriginName:  "git",
+			OSID:        "alpine",
+			OSVersionID: "3.20.0",
+		},
+		Extractor: apk.New(apk.DefaultConfig()),
+	}
+	gitDaemon = &extractor.Inventory{
+		Name:    "git-daemon",
+		Version: "2.45.1-r0",
+		Metadata: &apk.Metadata{
+			PackageName: "git-daemon",
+			OriginName:  "git",
+			OSID:        "alpine",
+			OSVersionID: "3.20.0",
+		},
+		Extractor: apk.New(apk.DefaultConfig()),
+	}
+	requests = &extractor.Inventory{
+		Name:      "requests",
+		Version:   "2.28.1",
+		Extractor: wheelegg.New(wheelegg.DefaultConfig()),
+	}
+	lodash = &extractor.Inventory{
+		Name:      "lodash",
+		Version:   "4.17.21",
+		Extractor: packagejson.New(packagejson.DefaultConfig()),
+	}
+	lodashNoVersion = &extractor.Inventory{
+		Name:      "lodash",
+		Extractor: packagejson.New(packagejson.DefaultConfig()),
+	}
+)
+
+func TestScan(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "all.zip")
+	writeBundle(t, dbPath, glibcVuln, gitVuln, requestsGHSA, requestsPYSEC, lodashVuln)
+	d := &osvoffline.Detector{DBPath: dbPath}
+
+	ix, err := inventoryindex.New([]*extractor.Inventory{libc6, git, gitDaemon, requests, lodash, lodashNoVersion})
+	if err != nil {
+		t.Fatalf("inventoryindex.New(): %v", err)
+	}
+	got, err := d.Scan(context.Background(), nil, ix)
+	if err != nil {
+		t.Fatalf("Scan(): %v", err)
+	}
+
+	recommendation := "Upgrade the package to a version that isn't affected by the vulnerability, or remove it if it's not needed."
+	want := []*detector.Finding{
+		{
+			Adv: &detector.Advisory{
+				ID:             &detector.AdvisoryID{Publisher: "OSV", Reference: "DSA-5678-1"},
+				Type:           detector.TypeVulnerability,
+				Title:          "glibc security update",
+				Description:    "Aliases: CVE-2024-2961",
+				Recommendation: recommendation,
+				Sev:            &detector.Severity{Severity: detector.SeverityUnspecified},
+			},
+			Target: &detector.TargetDetails{Inventory: libc6},
+			Extra:  "glibc 2.36-9+deb12u4 is affected, fixed in 2.36-9+deb12u7",
+		},
+		{
+			Adv: &detector.Advisory{
+				ID:             &detector.AdvisoryID{Publisher: "OSV", Reference: "ALPINE-CVE-2024-32002"},
+				Type:           detector.TypeVulnerability,
+				Title:          "ALPINE-CVE-2024-32002",
+				Description:    "Recursive clones can execute code.",
+				Recommendation: recommendation,
+				Sev:            &detector.Severity{Severity: detector.SeverityUnspecified},
+			},
+			Target: &detector.TargetDetails{Inventory: git},
+			Extra:  "git 2.45.0-r0 is affected, fixed in 2.45.1-r0",
+		},
+		{
+			Adv: &detector.Advisory{
+				ID:             &detector.AdvisoryID{Publisher: "OSV", Reference: "GHSA-j8r2-6x86-q33q"},
+				Type:           detector.TypeVulnerability,
+				Title:          "Unintended leak of Proxy-Authorization header in requests",
+				Description:    "Aliases: CVE-2023-32681, PYSEC-2023-74",
+				Recommendation: recommendation,
+				Sev:            &detector.Severity{Severity: detector.SeverityUnspecified},
+			},
+			Target: &detector.TargetDetails{Inventory: requests},
+			Extra:  "requests 2.28.1 is affected, fixed in 2.31.0",
+		},
+	}
+	sortFindings := cmpopts.SortSlices(func(a, b *detector.Finding) bool { return a.Adv.ID.Reference < b.Adv.ID.Reference })
+	if diff := cmp.Diff(want, got, sortFindings, cmpopts.IgnoreFields(extractor.Inventory{}, "Extractor")); diff != "" {
+		t.Errorf("Scan() unexpected findings (-want +got):\n%s", diff)
+	}
+
+	wantDS := []*plugin.DataSource{{Name: "osv.dev", Location: dbPath, Version: "2024-06-01T10:00:00Z"}}
+	if diff := cmp.Diff(wantDS, d.DataSources()); diff != "" {
+		t.Errorf("DataSources() (-want +got):\n%s", diff)
+	}
+}
+
+func TestScan_InvalidSnapshot(t *testing.T) {
+	notADB := filepath.Join(t.TempDir(), "osv.db")
+	if err := os.WriteFile(notADB, []byte("not a database"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ix, err := inventoryindex.New([]*extractor.Inventory{lodash})
+	if err != nil {
+		t.Fatalf("inventoryindex.New(): %v", err)
+	}
+
+	for _, path := range []string{"", filepath.Join(t.TempDir(), "missing.zip"), notADB} {
+		d := &osvoffline.Detector{DBPath: path}
+		if _, err := d.Scan(context.Background(), nil, ix); err == nil {
+			t.Errorf("Scan() with snapshot %q: got nil error, want error", path)
+		}
+		wantDS := []*plugin.DataSource{{Name: "osv.dev", Location: path}}
+		if diff := cmp.Diff(wantDS, d.DataSources()); diff != "" {
+			t.Errorf("DataSources() with snapshot %q (-want +got):\n%s", path, diff)
+		}
+	}
+}
+
+func writeBundle(t *testing.T, path string, vulns ...*advisorydb.Vulnerability) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	for _, v := range vulns {
+		fw, err := w.Create(v.ID + ".json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.NewEncoder(fw).Encode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package embeddedhttpd implements a detector for default credentials in the
+// configs of embedded web servers found in appliance firmware, e.g. the admin
+// interfaces of printers, routers and cameras.
+//
+// Only credential files that store passwords in plain text or as unsalted
+// digests can be checked: lighttpd's plain and htdigest user files, BusyBox
+// httpd.conf and GoAhead's user databases. The crypt hashes of mini_httpd's
+// .htpasswd files aren't cracked.
+package embeddedhttpd
+
+import (
+	"bufio"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/embeddedhttpd"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// maxCredentialFileBytes is the maximum number of bytes read from a
+// credential file.
+const maxCredentialFileBytes = 1 << 20
+
+// goAheadRealm is the realm GoAhead 3 and later hash passwords with unless
+// it's changed at build time.
+const goAheadRealm = "example.com"
+
+// defaultPasswords are the passwords appliances commonly ship with. Passwords
+// that are the same as the user name are reported too.
+var defaultPasswords = []string{
+	"", "admin", "password", "1234", "12345", "123456", "root", "default",
+	"user", "guest", "support", "pass", "system",
+}
+
+// credentialFile is a file a server stores its users in.
+type credentialFile struct {
+	// Path relative to the root of the firmware.
+	path  string
+	parse func(r io.Reader) []credential
+}
+
+// credentialFiles are the credential files of each server.
+var credentialFiles = map[string][]credentialFile{
+	embeddedhttpd.ServerLighttpd: {
+		{path: "etc/lighttpd/lighttpd.user", parse: parsePlainUsers},
+		{path: "etc/lighttpd.user", parse: parsePlainUsers},
+		{path: "etc/lighttpd/lighttpd.htdigest", parse: parseHTDigest},
+		{path: "etc/lighttpd.htdigest", parse: parseHTDigest},
+	},
+	embeddedhttpd.ServerBusyBox: {
+		{path: "etc/httpd.conf", parse: parseBusyBoxConf},
+	},
+	embeddedhttpd.ServerGoAhead: {
+		{path: "etc/goahead/auth.txt", parse: parseGoAheadAuth},
+		{path: "etc/auth.txt", parse: parseGoAheadAuth},
+		{path: "etc/goahead/umconfig.txt", parse: parseGoAheadUMConfig},
+		{path: "etc/umconfig.txt", parse: parseGoAheadUMConfig},
+	},
+}
+
+// credential is a user from a credential file.
+type credential struct {
+	user string
+	// matches returns whether the user's password is pass.
+	matches func(pass string) bool
+}
+
+// hasDefaultPassword returns whether the credential uses one of the default
+// passwords or the user name as password.
+func (c credential) hasDefaultPassword() bool {
+	return c.matches(c.user) || slices.ContainsFunc(defaultPasswords, c.matches)
+}
+
+func plain(user, password string) credential {
+	return credential{user: user, matches: func(pass string) bool { return pass == password }}
+}
+
+// digest returns a credential whose password is stored as the hex encoded MD5
+// of "user:realm:password", like HTTP digest authentication's HA1.
+func digest(user, realm, hash string) credential {
+	hash = strings.ToLower(hash)
+	return credential{user: user, matches: func(pass string) bool {
+		sum := md5.Sum([]byte(user + ":" + realm + ":" + pass))
+		return hex.EncodeToString(sum[:]) == hash
+	}}
+}
+
+// Detector is a SCALIBR Detector for default credentials of embedded web
+// servers.
+type Detector struct{}
+
+// Name of the detector.
+func (Detector) Name() string { return "weakcredentials/embeddedhttpd" }
+
+// Version of the detector.
+func (Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (Detector) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// RequiredExtractors returns the extractor that finds the embedded web servers.
+func (Detector) RequiredExtractors() []string { return []string{embeddedhttpd.Name} }
+
+// Scan checks the credential files of the embedded web servers found by the
+// embeddedhttpd extractor.
+func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	var problems []string
+	var locations []string
+	checked := map[string]bool{}
+	for _, i := range ix.GetAllOfType(purl.TypeGeneric) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		m, ok := i.Metadata.(*embeddedhttpd.Metadata)
+		if !ok {
+			continue
+		}
+		for _, f := range credentialFiles[m.Server] {
+			p := path.Join(filepath.ToSlash(m.FirmwareRoot), f.path)
+			if checked[p] {
+				continue
+			}
+			checked[p] = true
+			users, err := defaultCredentialUsers(scanRoot.FS, p, f.parse)
+			if err != nil {
+				return nil, err
+			}
+			if len(users) == 0 {
+				continue
+			}
+			locations = append(locations, p)
+			for _, u := range users {
+				// Report only the user name to avoid leaking the password.
+				problems = append(problems, fmt.Sprintf("%s (%s): %s", p, m.Server, u))
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return nil, nil
+	}
+
+	slices.Sort(locations)
+	slices.Sort(problems)
+	return []*detector.Finding{{
+		Adv: &detector.Advisory{
+			ID: &detector.AdvisoryID{
+				Publisher: "SCALIBR",
+				Reference: "embedded-httpd-default-credentials",
+			},
+			MessageKey: "embedded-httpd-default-credentials",
+			Type:       detector.TypeVulnerability,
+			Title:      "Embedded web server with default credentials",
+			Description: "The admin interface of an appliance is served by an embedded web server " +
+				"whose users have default or guessable passwords. Anyone who can reach the " +
+				"interface can log in and take over the device.",
+			Recommendation: "Set strong passwords for the reported users through the device's admin " +
+				"interface, or update the firmware if the credentials can't be changed.",
+			Sev: &detector.Severity{Severity: detector.SeverityCritical},
+		},
+		Target: &detector.TargetDetails{Location: locations},
+		Extra:  "The following users have default passwords:\n" + strings.Join(problems, "\n"),
+	}}, nil
+}
+
+// defaultCredentialUsers returns the users of the credential file at p that
+// have a default password.
+func defaultCredentialUsers(fsys scalibrfs.FS, p string, parse func(io.Reader) []credential) ([]string, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var users []string
+	for _, c := range parse(io.LimitReader(f, maxCredentialFileBytes)) {
+		if c.hasDefaultPassword() {
+			users = append(users, c.user)
+		}
+	}
+	return users, nil
+}
+
+// lines returns the non-empty lines of r that aren't comments.
+func lines(r io.Reader) []string {
+	var res []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		res = append(res, line)
+	}
+	return res
+}
+
+// parsePlainUsers parses the user file of lighttpd's plain auth backend:
+// "user:password" lines.
+func parsePlainUsers(r io.Reader) []credential {
+	var res []credential
+	for _, l := range lines(r) {
+		if user, pass, ok := strings.Cut(l, ":"); ok {
+			res = append(res, plain(user, pass))
+		}
+	}
+	return res
+}
+
+// parseHTDigest parses the user file of lighttpd's htdigest auth backend:
+// "user:realm:md5(user:realm:password)" lines.
+func parseHTDigest(r io.Reader) []credential {
+	var res []credential
+	for _, l := range lines(r) {
+		parts := strings.Split(l, ":")
+		if len(parts) != 3 || len(parts[2]) != 32 {
+			continue
+		}
+		res = append(res, digest(parts[0], parts[1], parts[2]))
+	}
+	return res
+}
+
+// parseBusyBoxConf parses the protected paths of BusyBox httpd.conf:
+// "/path:user:password" lines. Passwords starting with "$" are crypt hashes
+// and skipped.
+func parseBusyBoxConf(r io.Reader) []credential {
+	var res []credential
+	for _, l := range lines(r) {
+		if !strings.HasPrefix(l, "/") {
+			continue
+		}
+		parts := strings.SplitN(l, ":", 3)
+		if len(parts) != 3 || strings.HasPrefix(parts[2], "$") {
+			continue
+		}
+		res = append(res, plain(parts[1], parts[2]))
+	}
+	return res
+}
+
+// parseGoAheadAuth parses the auth.txt of GoAhead 3 and later:
+// "user name=admin password=<hash> roles=..." lines. The passwords are the
+// MD5 of "user:realm:password".
+func parseGoAheadAuth(r io.Reader) []credential {
+	var res []credential
+	for _, l := range lines(r) {
+		fields := strings.Fields(l)
+		if len(fields) == 0 || fields[0] != "user" {
+			continue
+		}
+		var user, pass string
+		for _, f := range fields[1:] {
+			k, v, _ := strings.Cut(f, "=")
+			switch k {
+			case "name":
+				user = v
+			case "password":
+				pass = v
+			}
+		}
+		if user == "" || len(pass) != 32 {
+			continue
+		}
+		res = append(res, digest(user, goAheadRealm, pass))
+	}
+	return res
+}
+
+// parseGoAheadUMConfig parses the user management database of GoAhead 2,
+// which stores the rows of its users table as plain text "key=value" lines.
+func parseGoAheadUMConfig(r io.Reader) []credential {
+	var res []credential
+	var table, user, pass string
+	inRow := false
+	flush := func() {
+		if inRow && table == "users" && user != "" {
+			res = append(res, plain(user, pass))
+		}
+		user, pass, inRow = "", "", false
+	}
+	for _, l := range lines(r) {
+		k, v, _ := strings.Cut(l, "=")
+		switch k {
+		case "TABLE":
+			flush()
+			table = v
+		case "ROW":
+			flush()
+			inRow = true
+		case "name":
+			user = v
+		case "password":
+			pass = v
+		}
+	}
+	flush()
+	return res
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedhttpd_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector/weakcredentials/embeddedhttpd"
+	"github.com/google/osv-scalibr/extractor"
+	httpdextractor "github.com/google/osv-scalibr/extractor/filesystem/misc/embeddedhttpd"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+)
+
+func server(name, root string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Locations: []string{root + "/usr/sbin/" + name},
+		Metadata: &httpdextractor.Metadata{
+			Server:       name,
+			FirmwareRoot: root,
+		},
+		Extractor: httpdextractor.Extractor{},
+	}
+}
+
+func TestScan(t *testing.T) {
+	fsys := fstest.MapFS{
+		"printer/etc/lighttpd/lighttpd.user": {Data: []byte("# users\nadmin:admin\nservice:9x!Kq2vLp\n")},
+		"printer/etc/lighttpd.htdigest":      {Data: []byte("admin:lighttpd:3e1e656e53410dd8dccb44d74d79ca5d\n")},
+		"printer/etc/httpd.conf":             {Data: []byte("A:*\n/cgi-bin:support:support\n/adm:root:$1$salt$hash\n")},
+		"camera/etc/goahead/auth.txt": {Data: []byte(
+			"role name=administrator abilities=manage,\n" +
+				"user name=admin password=b26bbb9407b20d92c5b7dd071a108dcc roles=administrator,\n" +
+				"user name=operator password=afa1f0b0845484c97557685d7f1fa4b6 roles=user,\n")},
+		"router/etc/umconfig.txt": {Data: []byte(
+			"TABLE=groups\nROW=0\nname=Administrator\n" +
+				"TABLE=users\nROW=0\nname=admin\npassword=\ngroup=Administrator\n" +
+				"ROW=1\nname=guest\npassword=N0tDefault\ngroup=Guest\n")},
+		"secure/etc/lighttpd.user": {Data: []byte("admin:Xk29!vPq7Lm\n")},
+	}
+
+	tests := []struct {
+		name string
+		inv  []*extractor.Inventory
+		// Extra of the finding, empty if there's none.
+		want string
+	}{
+		{
+			name: "default credentials",
+			inv: []*extractor.Inventory{
+				server(httpdextractor.ServerLighttpd, "printer"),
+				server(httpdextractor.ServerBusyBox, "printer"),
+				server(httpdextractor.ServerGoAhead, "camera"),
+				server(httpdextractor.ServerGoAhead, "router"),
+			},
+			want: "The following users have default passwords:\n" +
+				"camera/etc/goahead/auth.txt (goahead): admin\n" +
+				"printer/etc/httpd.conf (busybox): support\n" +
+				"printer/etc/lighttpd.htdigest (lighttpd): admin\n" +
+				"printer/etc/lighttpd/lighttpd.user (lighttpd): admin\n" +
+				"router/etc/umconfig.txt (goahead): admin",
+		},
+		{
+			name: "strong passwords",
+			inv:  []*extractor.Inventory{server(httpdextractor.ServerLighttpd, "secure")},
+		},
+		{
+			name: "no credential files",
+			inv:  []*extractor.Inventory{server(httpdextractor.ServerMiniHTTPD, "printer")},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ix, err := inventoryindex.New(tc.inv)
+			if err != nil {
+				t.Fatalf("inventoryindex.New(): %v", err)
+			}
+			findings, err := embeddedhttpd.Detector{}.Scan(context.Background(), &scalibrfs.ScanRoot{FS: fsys}, ix)
+			if err != nil {
+				t.Fatalf("Scan(): %v", err)
+			}
+			got := ""
+			if len(findings) > 0 {
+				got = findings[0].Extra
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Scan() unexpected finding (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
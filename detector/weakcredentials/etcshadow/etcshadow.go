@@ -106,6 +106,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 				Publisher: "SCALIBR",
 				Reference: "etc-shadow-weakcredentials",
 			},
+			MessageKey:     "etc-shadow-weakcredentials",
 			Type:           detector.TypeVulnerability,
 			Title:          title,
 			Description:    description,
@@ -101,6 +101,7 @@ func TestScan(t *testing.T) {
 			Publisher: "SCALIBR",
 			Reference: "etc-shadow-weakcredentials",
 		},
+		MessageKey:     "etc-shadow-weakcredentials",
 		Type:           detector.TypeVulnerability,
 		Title:          wantTitle,
 		Description:    wantDesc,
@@ -92,6 +92,7 @@ func (d Detector) Scan(ctx context.Context, scanRoot *scalibrfs.ScanRoot, ix *in
 					Publisher: "SCALIBR",
 					Reference: "file-browser-weakcredentials",
 				},
+				MessageKey:     "file-browser-weakcredentials",
 				Type:           detector.TypeVulnerability,
 				Title:          title,
 				Description:    description,
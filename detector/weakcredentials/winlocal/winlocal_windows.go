@@ -25,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/google/osv-scalibr/detector"
@@ -41,6 +42,13 @@ var (
 	knownNTHashesFile string
 	//go:embed data/top100_lm_hashes.csv
 	knownLMHashesFile string
+
+	// The dictionaries are parsed on first use and shared by all scans and
+	// Detectors since they're never modified.
+	knownHashesOnce sync.Once
+	knownNTHashes   map[string]string
+	knownLMHashes   map[string]string
+	knownHashesErr  error
 )
 
 const (
@@ -51,10 +59,7 @@ const (
 )
 
 // Detector is a SCALIBR Detector for weak passwords detector for local accounts on Windows.
-type Detector struct {
-	knownNTHashes map[string]string
-	knownLMHashes map[string]string
-}
+type Detector struct{}
 
 // userHashInfo contains the hashes of a user. Note that both hashes represents the same password.
 type userHashInfo struct {
@@ -125,6 +130,7 @@ func (d Detector) findingForFormatLM(users []string) *detector.Finding {
 				Publisher: "GOOGLE",
 				Reference: vulnRefLMPassword,
 			},
+			MessageKey: "password-hash-lm-format",
 			Sev: &detector.Severity{
 				Severity: detector.SeverityHigh,
 			},
@@ -145,6 +151,7 @@ func (d Detector) findingForWeakPasswords(users map[string]string) *detector.Fin
 				Publisher: "GOOGLE",
 				Reference: vulnRefWeakPass,
 			},
+			MessageKey: "windows-weak-password",
 			Sev: &detector.Severity{
 				Severity: detector.SeverityCritical,
 			},
@@ -244,21 +251,21 @@ func (d Detector) loadDictionary(file string, dict map[string]string) error {
 }
 
 func (d Detector) knownHashes() (map[string]string, map[string]string, error) {
-	if d.knownNTHashes == nil {
-		d.knownNTHashes = make(map[string]string)
-		if err := d.loadDictionary(knownNTHashesFile, d.knownNTHashes); err != nil {
-			return nil, nil, err
+	knownHashesOnce.Do(func() {
+		nt := make(map[string]string)
+		if knownHashesErr = d.loadDictionary(knownNTHashesFile, nt); knownHashesErr != nil {
+			return
 		}
-	}
-
-	if d.knownLMHashes == nil {
-		d.knownLMHashes = make(map[string]string)
-		if err := d.loadDictionary(knownLMHashesFile, d.knownLMHashes); err != nil {
-			return nil, nil, err
+		lm := make(map[string]string)
+		if knownHashesErr = d.loadDictionary(knownLMHashesFile, lm); knownHashesErr != nil {
+			return
 		}
+		knownNTHashes, knownLMHashes = nt, lm
+	})
+	if knownHashesErr != nil {
+		return nil, nil, knownHashesErr
 	}
-
-	return d.knownNTHashes, d.knownLMHashes, nil
+	return knownNTHashes, knownLMHashes, nil
 }
 
 func (d Detector) hashesForUser(sam *samreg.SAMRegistry, rid string, derivedKey []byte) (*userHashInfo, error) {
@@ -0,0 +1,209 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package defenderexclusions implements a detector for overly broad Microsoft Defender scan
+// exclusions. Attackers routinely add such exclusions to hide their tooling from the antivirus.
+package defenderexclusions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/securityproducts"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+var (
+	driveRootRegexp = regexp.MustCompile(`^[a-z]:$`)
+	driveRegexp     = regexp.MustCompile(`^[a-z]:`)
+
+	// Environment variables that expand to directories containing the OS, installed software or
+	// user data.
+	broadEnvVars = map[string]bool{
+		"%systemdrive%":       true,
+		"%systemroot%":        true,
+		"%windir%":            true,
+		"%programfiles%":      true,
+		"%programfiles(x86)%": true,
+		"%programdata%":       true,
+		"%userprofile%":       true,
+		"%appdata%":           true,
+		"%localappdata%":      true,
+		"%temp%":              true,
+		"%tmp%":               true,
+		"%public%":            true,
+	}
+
+	// Drive-relative directories that contain the OS, installed software or user data.
+	broadDirs = map[string]bool{
+		`\windows`:                    true,
+		`\windows\system32`:           true,
+		`\windows\syswow64`:           true,
+		`\windows\temp`:               true,
+		`\users`:                      true,
+		`\users\public`:               true,
+		`\programdata`:                true,
+		`\program files`:              true,
+		`\program files (x86)`:        true,
+		`\program files\common files`: true,
+	}
+
+	// Per-user directories that malware is commonly dropped to.
+	broadUserDirRegexp = regexp.MustCompile(`^(?:\\users\\[^\\]+|%userprofile%)\\(?:appdata\\local\\temp|appdata\\local|appdata\\roaming|downloads|desktop)$`)
+
+	// Extensions of files that can be executed directly or through a script host.
+	executableExtensions = map[string]bool{
+		"exe": true, "dll": true, "sys": true, "scr": true, "com": true, "cpl": true,
+		"bat": true, "cmd": true, "ps1": true, "psm1": true, "vbs": true, "vbe": true,
+		"js": true, "jse": true, "wsf": true, "hta": true, "msi": true, "lnk": true,
+		"jar": true,
+	}
+
+	// Processes that can run arbitrary code (shells, script hosts and other living-off-the-land
+	// binaries) or that are commonly injected into.
+	broadProcesses = map[string]bool{
+		"powershell.exe":  true,
+		"pwsh.exe":        true,
+		"cmd.exe":         true,
+		"rundll32.exe":    true,
+		"regsvr32.exe":    true,
+		"mshta.exe":       true,
+		"wscript.exe":     true,
+		"cscript.exe":     true,
+		"msiexec.exe":     true,
+		"certutil.exe":    true,
+		"bitsadmin.exe":   true,
+		"installutil.exe": true,
+		"msbuild.exe":     true,
+		"explorer.exe":    true,
+		"svchost.exe":     true,
+	}
+
+	broadIPAddresses = map[string]bool{
+		"*":         true,
+		"0.0.0.0/0": true,
+		"::/0":      true,
+	}
+)
+
+// Detector is a SCALIBR Detector for overly broad Microsoft Defender scan exclusions.
+type Detector struct{}
+
+// Name of the detector.
+func (Detector) Name() string { return "windows/defenderexclusions" }
+
+// Version of the detector.
+func (Detector) Version() int { return 0 }
+
+// Requirements of the detector.
+func (Detector) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{OS: plugin.OSWindows}
+}
+
+// RequiredExtractors returns the extractor that reads the Defender configuration.
+func (Detector) RequiredExtractors() []string { return []string{securityproducts.Name} }
+
+// Scan checks the Defender exclusions found by the securityproducts extractor.
+func (d Detector) Scan(ctx context.Context, _ *scalibrfs.ScanRoot, ix *inventoryindex.InventoryIndex) ([]*detector.Finding, error) {
+	var broad []string
+	for _, i := range ix.GetSpecific(securityproducts.DefenderName, purl.TypeGeneric) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		m, ok := i.Metadata.(*metadata.SecurityProduct)
+		if !ok {
+			continue
+		}
+		for _, e := range m.Exclusions {
+			if !IsBroad(e) {
+				continue
+			}
+			source := "local"
+			if e.FromPolicy {
+				source = "policy"
+			}
+			broad = append(broad, fmt.Sprintf("%s exclusion %q (%s)", e.Type, e.Value, source))
+		}
+	}
+
+	if len(broad) == 0 {
+		return nil, nil
+	}
+	return []*detector.Finding{{
+		Adv: &detector.Advisory{
+			ID: &detector.AdvisoryID{
+				Publisher: "SCALIBR",
+				Reference: "windows-defender-broad-exclusion",
+			},
+			MessageKey: "windows-defender-broad-exclusion",
+			Type:       detector.TypeVulnerability,
+			Title:      "Overly broad Microsoft Defender scan exclusions",
+			Description: "Microsoft Defender is configured to skip scanning entire drives, " +
+				"system or user directories, executable file types or processes that can run " +
+				"arbitrary code. Attackers commonly add such exclusions to keep their tooling from " +
+				"being detected.",
+			Recommendation: "Review the listed exclusions and remove them unless they are " +
+				"required. Prefer exclusions for specific files or processes. Investigate " +
+				"exclusions that weren't added by an administrator.",
+			Sev: &detector.Severity{Severity: detector.SeverityHigh},
+		},
+		Extra: strings.Join(broad, "\n"),
+	}}, nil
+}
+
+// IsBroad returns whether the exclusion disables scanning for a significant part of the system.
+func IsBroad(e *metadata.DefenderExclusion) bool {
+	v := strings.ToLower(strings.TrimSpace(e.Value))
+	switch e.Type {
+	case metadata.ExclusionPath:
+		return isBroadPath(v)
+	case metadata.ExclusionExtension:
+		return executableExtensions[strings.TrimLeft(v, "*.")]
+	case metadata.ExclusionProcess:
+		base := v[strings.LastIndexAny(v, `\/`)+1:]
+		return strings.Contains(base, "*") || broadProcesses[base]
+	case metadata.ExclusionIPAddress:
+		return broadIPAddresses[v]
+	default:
+		return false
+	}
+}
+
+func isBroadPath(p string) bool {
+	p = strings.ReplaceAll(p, "/", `\`)
+	// Wildcard file patterns such as C:\*.exe exclude all files of that type below the directory.
+	if i := strings.LastIndex(p, `\`); strings.HasPrefix(p[i+1:], "*.") {
+		if executableExtensions[strings.TrimPrefix(p[i+1:], "*.")] {
+			return true
+		}
+	}
+	// Trailing wildcards exclude everything below the directory, e.g. C:\Windows\*
+	p = strings.TrimSuffix(p, `*.*`)
+	p = strings.TrimRight(p, `\*`)
+	if p == "" || driveRootRegexp.MatchString(p) || broadEnvVars[p] {
+		return true
+	}
+	rel := driveRegexp.ReplaceAllString(p, "")
+	if broadDirs[rel] {
+		return true
+	}
+	return broadUserDirRegexp.MatchString(rel)
+}
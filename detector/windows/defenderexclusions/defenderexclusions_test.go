@@ -0,0 +1,150 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defenderexclusions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/osv-scalibr/detector/windows/defenderexclusions"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/securityproducts"
+	"github.com/google/osv-scalibr/inventoryindex"
+	"github.com/google/osv-scalibr/purl"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+// genericExtractor returns generic PURLs like the securityproducts extractor does on Windows.
+type genericExtractor struct {
+	filesystem.Extractor
+}
+
+func (genericExtractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{Type: purl.TypeGeneric, Name: i.Name, Version: i.Version}
+}
+
+func TestIsBroad(t *testing.T) {
+	tests := []struct {
+		exclusionType metadata.DefenderExclusionType
+		value         string
+		want          bool
+	}{
+		{metadata.ExclusionPath, `C:\`, true},
+		{metadata.ExclusionPath, `d:`, true},
+		{metadata.ExclusionPath, `C:\*`, true},
+		{metadata.ExclusionPath, `*`, true},
+		{metadata.ExclusionPath, `C:\*.exe`, true},
+		{metadata.ExclusionPath, `C:\Windows\System32\`, true},
+		{metadata.ExclusionPath, `C:\Program Files (x86)`, true},
+		{metadata.ExclusionPath, `%TEMP%\*`, true},
+		{metadata.ExclusionPath, `C:\Users\alice\AppData\Local\Temp`, true},
+		{metadata.ExclusionPath, `%USERPROFILE%\Downloads`, true},
+		{metadata.ExclusionPath, `C:\Program Files\Vendor\App\cache`, false},
+		{metadata.ExclusionPath, `C:\Users\alice\src\downloads`, false},
+		{metadata.ExclusionPath, `D:\builds\*.obj`, false},
+		{metadata.ExclusionExtension, `.exe`, true},
+		{metadata.ExclusionExtension, `*.PS1`, true},
+		{metadata.ExclusionExtension, `log`, false},
+		{metadata.ExclusionProcess, `powershell.exe`, true},
+		{metadata.ExclusionProcess, `C:\Windows\System32\rundll32.exe`, true},
+		{metadata.ExclusionProcess, `C:\Tools\*.exe`, true},
+		{metadata.ExclusionProcess, `C:\Program Files\Vendor\agent.exe`, false},
+		{metadata.ExclusionIPAddress, `0.0.0.0/0`, true},
+		{metadata.ExclusionIPAddress, `10.0.0.5`, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.exclusionType)+":"+tc.value, func(t *testing.T) {
+			e := &metadata.DefenderExclusion{Type: tc.exclusionType, Value: tc.value}
+			if got := defenderexclusions.IsBroad(e); got != tc.want {
+				t.Errorf("IsBroad(%v) = %v, want %v", e, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	ex := genericExtractor{fe.New(securityproducts.Name, 0, nil, nil)}
+	tests := []struct {
+		name      string
+		inv       []*extractor.Inventory
+		wantExtra string
+	}{
+		{
+			name: "broad exclusions",
+			inv: []*extractor.Inventory{{
+				Name: securityproducts.DefenderName,
+				Metadata: &metadata.SecurityProduct{
+					Kind:    "antivirus",
+					Enabled: true,
+					Exclusions: []*metadata.DefenderExclusion{
+						{Type: metadata.ExclusionPath, Value: `C:\`},
+						{Type: metadata.ExclusionPath, Value: `C:\Program Files\Vendor\App\cache`},
+						{Type: metadata.ExclusionExtension, Value: "exe", FromPolicy: true},
+					},
+				},
+				Extractor: ex,
+			}},
+			wantExtra: "path exclusion \"C:\\\\\" (local)\nextension exclusion \"exe\" (policy)",
+		},
+		{
+			name: "narrow exclusions",
+			inv: []*extractor.Inventory{{
+				Name: securityproducts.DefenderName,
+				Metadata: &metadata.SecurityProduct{
+					Exclusions: []*metadata.DefenderExclusion{
+						{Type: metadata.ExclusionProcess, Value: `C:\Program Files\Vendor\agent.exe`},
+					},
+				},
+				Extractor: ex,
+			}},
+		},
+		{
+			name: "other security product",
+			inv: []*extractor.Inventory{{
+				Name:      "Example Antivirus",
+				Metadata:  &metadata.SecurityProduct{Kind: "antivirus"},
+				Extractor: ex,
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ix, err := inventoryindex.New(tc.inv)
+			if err != nil {
+				t.Fatalf("inventoryindex.New(): %v", err)
+			}
+			findings, err := defenderexclusions.Detector{}.Scan(context.Background(), nil, ix)
+			if err != nil {
+				t.Fatalf("Scan(): %v", err)
+			}
+			if tc.wantExtra == "" {
+				if len(findings) != 0 {
+					t.Errorf("Scan(): got %d findings, want none: %v", len(findings), findings)
+				}
+				return
+			}
+			if len(findings) != 1 {
+				t.Fatalf("Scan(): got %d findings, want 1", len(findings))
+			}
+			if findings[0].Extra != tc.wantExtra {
+				t.Errorf("Scan(): got Extra %q, want %q", findings[0].Extra, tc.wantExtra)
+			}
+		})
+	}
+}
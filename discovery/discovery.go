@@ -0,0 +1,188 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package discovery finds the directories of a running host that are worth
+// scanning, e.g. container runtime roots, home directories, web roots,
+// database data directories and mounted volumes, and turns them into a scan
+// plan with one scan root per target. This gives sensible coverage of a host
+// without scanning its whole filesystem or hand-curating the paths to scan.
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// Kind is the kind of a scan target.
+type Kind string
+
+// Kind values.
+const (
+	KindContainerRuntime Kind = "container-runtime"
+	KindHomeDir          Kind = "home-dir"
+	KindWebRoot          Kind = "web-root"
+	KindDatabaseData     Kind = "database-data"
+	KindMount            Kind = "mount"
+)
+
+// AllKinds are all kinds of targets, in the order they're discovered.
+var AllKinds = []Kind{KindContainerRuntime, KindHomeDir, KindWebRoot, KindDatabaseData, KindMount}
+
+// Target is a directory that's proposed to be scanned.
+type Target struct {
+	// Absolute path of the directory on the host, in slash-separated form,
+	// e.g. "/var/lib/docker".
+	Path string
+	Kind Kind
+	// Why the directory was selected, e.g. "Docker data root".
+	Reason string
+}
+
+// Config is the configuration for Discover.
+type Config struct {
+	// Root is where the host's filesystem is mounted, "/" unless the scanner
+	// runs in a container with the host mounted elsewhere.
+	Root string
+	// FS is the filesystem at Root. If nil, Root is read directly.
+	FS scalibrfs.FS
+	// Kinds of targets to discover. All kinds are discovered if empty.
+	Kinds []Kind
+}
+
+// DefaultConfig returns the default configuration for discovering the scan
+// targets of the host SCALIBR runs on.
+func DefaultConfig() Config {
+	return Config{Root: "/", Kinds: AllKinds}
+}
+
+// Plan is a set of targets to scan on a host.
+type Plan struct {
+	// Root is where the host's filesystem is mounted.
+	Root    string
+	Targets []*Target
+}
+
+// source discovers the targets of one kind in fsys.
+type source func(ctx context.Context, fsys scalibrfs.FS) ([]*Target, error)
+
+var sources = map[Kind]source{
+	KindContainerRuntime: containerRuntimeRoots,
+	KindHomeDir:          homeDirs,
+	KindWebRoot:          webRoots,
+	KindDatabaseData:     databaseDirs,
+	KindMount:            mounts,
+}
+
+// Discover returns the scan plan for the host. Discovery reads the host's
+// configuration and process information, so it requires the RunningSystem
+// capability and is only supported on Linux.
+func Discover(ctx context.Context, cfg Config, capabs *plugin.Capabilities) (*Plan, error) {
+	if capabs == nil || !capabs.RunningSystem {
+		return nil, errors.New("scan target discovery requires a running system")
+	}
+	if capabs.OS != plugin.OSLinux {
+		return nil, errors.New("scan target discovery is only supported on Linux")
+	}
+	root := cfg.Root
+	if root == "" {
+		root = "/"
+	}
+	fsys := cfg.FS
+	if fsys == nil {
+		fsys = scalibrfs.DirFS(root)
+	}
+	kinds := cfg.Kinds
+	if len(kinds) == 0 {
+		kinds = AllKinds
+	}
+
+	plan := &Plan{Root: root}
+	for _, k := range AllKinds {
+		if !slices.Contains(kinds, k) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		targets, err := sources[k](ctx, fsys)
+		if err != nil {
+			return nil, fmt.Errorf("discovering %s targets: %w", k, err)
+		}
+		for _, t := range targets {
+			if !slices.ContainsFunc(plan.Targets, func(o *Target) bool { return o.Path == t.Path }) {
+				plan.Targets = append(plan.Targets, t)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// ScanRoots returns a scan root for each target of the plan. Targets inside
+// of other targets are scanned as part of them and don't get their own root.
+func (p *Plan) ScanRoots() []*scalibrfs.ScanRoot {
+	paths := make([]string, 0, len(p.Targets))
+	for _, t := range p.Targets {
+		paths = append(paths, t.Path)
+	}
+	slices.Sort(paths)
+
+	var roots []*scalibrfs.ScanRoot
+	var kept []string
+	for _, t := range paths {
+		if slices.ContainsFunc(kept, func(k string) bool { return isUnder(t, k) }) {
+			continue
+		}
+		kept = append(kept, t)
+		abs := filepath.Join(p.Root, filepath.FromSlash(t))
+		roots = append(roots, &scalibrfs.ScanRoot{FS: scalibrfs.DirFS(abs), Path: abs})
+	}
+	return roots
+}
+
+// String returns the targets of the plan, one per line.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, t := range p.Targets {
+		fmt.Fprintf(&b, "%-17s %s (%s)\n", t.Kind, t.Path, t.Reason)
+	}
+	return b.String()
+}
+
+// isUnder returns whether p is dir or inside of dir.
+func isUnder(p, dir string) bool {
+	return p == dir || dir == "/" || strings.HasPrefix(p, dir+"/")
+}
+
+// existingDir returns the target for the absolute path p if p is a directory
+// in fsys, or nil otherwise.
+func existingDir(fsys scalibrfs.FS, p string, kind Kind, reason string) *Target {
+	p = path.Clean("/" + p)
+	if p == "/" {
+		return nil
+	}
+	info, err := fs.Stat(fsys, strings.TrimPrefix(p, "/"))
+	if err != nil || !info.IsDir() {
+		return nil
+	}
+	return &Target{Path: p, Kind: kind, Reason: reason}
+}
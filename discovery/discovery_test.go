@@ -0,0 +1,166 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/discovery"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+var linuxHost = &plugin.Capabilities{OS: plugin.OSLinux, RunningSystem: true}
+
+func dir() *fstest.MapFile { return &fstest.MapFile{Mode: fs.ModeDir | 0o755} }
+
+func hostFS() fstest.MapFS {
+	return fstest.MapFS{
+		"etc/passwd": {Data: []byte(
+			"root:x:0:0:root:/root:/bin/bash\n" +
+				"daemon:x:1:1:daemon:/usr/sbin:/usr/sbin/nologin\n" +
+				"alice:x:1000:1000:Alice:/home/alice:/bin/zsh\n" +
+				"svc:x:1001:1001::/opt/svc:/usr/sbin/nologin\n" +
+				"nobody:x:65534:65534:nobody:/nonexistent:/usr/sbin/nologin\n")},
+		"root":                   dir(),
+		"home/alice":             dir(),
+		"home/old-user":          dir(),
+		"opt/svc":                dir(),
+		"var/lib/docker":         dir(),
+		"var/lib/kubelet":        dir(),
+		"data/docker":            dir(),
+		"etc/docker/daemon.json": {Data: []byte(`{"data-root": "/data/docker"}`)},
+		"var/www/html":           dir(),
+		"srv/shop/public":        dir(),
+		"etc/nginx/sites-enabled/shop": {Data: []byte(
+			"server {\n  listen 80;\n  root /srv/shop/public;\n  location /static { root $document_root/static; }\n}\n")},
+		"etc/apache2/sites-enabled/000-default.conf": {Data: []byte(
+			"<VirtualHost *:80>\n  DocumentRoot \"/var/www/html\"\n</VirtualHost>\n")},
+		"var/lib/postgresql":                dir(),
+		"mnt/db/mysql":                      dir(),
+		"etc/mysql/mysql.conf.d/mysqld.cnf": {Data: []byte("[mysqld]\ndatadir = /mnt/db/mysql\n")},
+		"proc/self/mounts": {Data: []byte(
+			"/dev/nvme0n1p2 / ext4 rw,relatime 0 0\n" +
+				"proc /proc proc rw,nosuid 0 0\n" +
+				"/dev/nvme0n1p1 /boot/efi vfat rw 0 0\n" +
+				"/dev/nvme1n1 /mnt/db xfs rw,noatime 0 0\n" +
+				"overlay /var/lib/docker/overlay2/abc/merged overlay rw 0 0\n" +
+				"nas:/export /mnt/backup\\040share nfs4 rw 0 0\n" +
+				"/dev/loop3 /snap/core22/1380 squashfs ro 0 0\n")},
+		"mnt/backup share": dir(),
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	tests := []struct {
+		name  string
+		kinds []discovery.Kind
+		want  []*discovery.Target
+	}{
+		{
+			name: "all kinds",
+			want: []*discovery.Target{
+				{Path: "/var/lib/docker", Kind: discovery.KindContainerRuntime, Reason: "Docker data root"},
+				{Path: "/var/lib/kubelet", Kind: discovery.KindContainerRuntime, Reason: "kubelet pods and volumes"},
+				{Path: "/data/docker", Kind: discovery.KindContainerRuntime, Reason: "Docker data root from /etc/docker/daemon.json"},
+				{Path: "/root", Kind: discovery.KindHomeDir, Reason: "home directory of user root"},
+				{Path: "/home/alice", Kind: discovery.KindHomeDir, Reason: "home directory of user alice"},
+				{Path: "/home/old-user", Kind: discovery.KindHomeDir, Reason: "directory in /home"},
+				{Path: "/var/www", Kind: discovery.KindWebRoot, Reason: "web root"},
+				{Path: "/srv/shop/public", Kind: discovery.KindWebRoot, Reason: "nginx root in /etc/nginx/sites-enabled/shop"},
+				{Path: "/var/www/html", Kind: discovery.KindWebRoot, Reason: "Apache DocumentRoot in /etc/apache2/sites-enabled/000-default.conf"},
+				{Path: "/var/lib/postgresql", Kind: discovery.KindDatabaseData, Reason: "PostgreSQL data directory"},
+				{Path: "/mnt/db/mysql", Kind: discovery.KindDatabaseData, Reason: "MySQL datadir in /etc/mysql/mysql.conf.d/mysqld.cnf"},
+				{Path: "/mnt/db", Kind: discovery.KindMount, Reason: "xfs volume /dev/nvme1n1"},
+				{Path: "/mnt/backup share", Kind: discovery.KindMount, Reason: "nfs4 volume nas:/export"},
+			},
+		},
+		{
+			name:  "selected kinds",
+			kinds: []discovery.Kind{discovery.KindMount, discovery.KindContainerRuntime},
+			want: []*discovery.Target{
+				{Path: "/var/lib/docker", Kind: discovery.KindContainerRuntime, Reason: "Docker data root"},
+				{Path: "/var/lib/kubelet", Kind: discovery.KindContainerRuntime, Reason: "kubelet pods and volumes"},
+				{Path: "/data/docker", Kind: discovery.KindContainerRuntime, Reason: "Docker data root from /etc/docker/daemon.json"},
+				{Path: "/mnt/db", Kind: discovery.KindMount, Reason: "xfs volume /dev/nvme1n1"},
+				{Path: "/mnt/backup share", Kind: discovery.KindMount, Reason: "nfs4 volume nas:/export"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := discovery.Config{Root: "/host", FS: hostFS(), Kinds: tt.kinds}
+			plan, err := discovery.Discover(context.Background(), cfg, linuxHost)
+			if err != nil {
+				t.Fatalf("Discover(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, plan.Targets); diff != "" {
+				t.Errorf("Discover() returned unexpected targets (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiscover_EmptyHost(t *testing.T) {
+	plan, err := discovery.Discover(context.Background(), discovery.Config{FS: fstest.MapFS{}}, linuxHost)
+	if err != nil {
+		t.Fatalf("Discover(): %v", err)
+	}
+	if len(plan.Targets) != 0 {
+		t.Errorf("Discover() returned targets %v, want none", plan.Targets)
+	}
+}
+
+func TestDiscover_Capabilities(t *testing.T) {
+	for _, capabs := range []*plugin.Capabilities{
+		nil,
+		{OS: plugin.OSLinux, RunningSystem: false},
+		{OS: plugin.OSWindows, RunningSystem: true},
+	} {
+		if _, err := discovery.Discover(context.Background(), discovery.Config{FS: hostFS()}, capabs); err == nil {
+			t.Errorf("Discover() with capabilities %+v succeeded, want error", capabs)
+		}
+	}
+}
+
+func TestPlan_ScanRoots(t *testing.T) {
+	plan := &discovery.Plan{
+		Root: "/host",
+		Targets: []*discovery.Target{
+			{Path: "/var/www", Kind: discovery.KindWebRoot},
+			{Path: "/var/www/html", Kind: discovery.KindWebRoot},
+			{Path: "/mnt/db/mysql", Kind: discovery.KindDatabaseData},
+			{Path: "/mnt/db", Kind: discovery.KindMount},
+			{Path: "/mnt/dbx", Kind: discovery.KindMount},
+		},
+	}
+	want := []string{
+		filepath.FromSlash("/host/mnt/db"),
+		filepath.FromSlash("/host/mnt/dbx"),
+		filepath.FromSlash("/host/var/www"),
+	}
+	var got []string
+	for _, r := range plan.ScanRoots() {
+		got = append(got, r.Path)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ScanRoots() returned unexpected roots (-want +got):\n%s", diff)
+	}
+}
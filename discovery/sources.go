@@ -0,0 +1,295 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+// candidate is a well-known directory of a kind of target.
+type candidate struct {
+	path   string
+	reason string
+}
+
+var (
+	containerRuntimeDirs = []candidate{
+		{"/var/lib/docker", "Docker data root"},
+		{"/var/lib/containerd", "containerd root"},
+		{"/var/lib/containers/storage", "Podman and CRI-O storage"},
+		{"/var/lib/rancher", "k3s and RKE2 data"},
+		{"/var/lib/kubelet", "kubelet pods and volumes"},
+		{"/var/lib/lxd", "LXD storage"},
+		{"/var/snap/lxd/common/lxd", "LXD storage"},
+	}
+
+	webRootDirs = []candidate{
+		{"/var/www", "web root"},
+		{"/srv/www", "web root"},
+		{"/srv/http", "web root"},
+		{"/usr/share/nginx/html", "nginx default web root"},
+		{"/opt/lampp/htdocs", "XAMPP web root"},
+	}
+
+	databaseDataDirs = []candidate{
+		{"/var/lib/mysql", "MySQL or MariaDB data directory"},
+		{"/var/lib/postgresql", "PostgreSQL data directory"},
+		{"/var/lib/pgsql", "PostgreSQL data directory"},
+		{"/var/lib/mongodb", "MongoDB data directory"},
+		{"/var/lib/mongo", "MongoDB data directory"},
+		{"/var/lib/redis", "Redis data directory"},
+		{"/var/lib/elasticsearch", "Elasticsearch data directory"},
+		{"/var/lib/cassandra", "Cassandra data directory"},
+		{"/var/lib/influxdb", "InfluxDB data directory"},
+		{"/var/lib/couchdb", "CouchDB data directory"},
+		{"/var/lib/neo4j", "Neo4j data directory"},
+		{"/var/opt/mssql", "SQL Server data directory"},
+	}
+
+	// Web server configs and the directives that set the web root.
+	nginxConfigGlobs  = []string{"etc/nginx/nginx.conf", "etc/nginx/conf.d/*.conf", "etc/nginx/sites-enabled/*"}
+	apacheConfigGlobs = []string{"etc/apache2/sites-enabled/*", "etc/httpd/conf/httpd.conf", "etc/httpd/conf.d/*.conf"}
+	nginxRootRe       = regexp.MustCompile(`(?m)^\s*root\s+"?([^;"\s]+)"?\s*;`)
+	apacheRootRe      = regexp.MustCompile(`(?mi)^\s*DocumentRoot\s+"?([^"\s]+)"?`)
+
+	// Database configs and the directives that set the data directory.
+	mysqlConfigGlobs    = []string{"etc/my.cnf", "etc/mysql/my.cnf", "etc/mysql/*.conf.d/*.cnf", "etc/my.cnf.d/*.cnf"}
+	postgresConfigGlobs = []string{"etc/postgresql/*/*/postgresql.conf", "var/lib/pgsql/data/postgresql.conf"}
+	mysqlDataDirRe      = regexp.MustCompile(`(?m)^\s*datadir\s*=\s*"?([^"\s#]+)`)
+	postgresDataDirRe   = regexp.MustCompile(`(?m)^\s*data_directory\s*=\s*'([^']+)'`)
+
+	// Shells of accounts that can't log in.
+	noLoginShells = []string{"/usr/sbin/nologin", "/sbin/nologin", "/bin/false", "/usr/bin/false", "/bin/sync"}
+
+	// Filesystem types of mounts that hold data, as opposed to virtual,
+	// container overlay and read-only package filesystems.
+	dataFSTypes = []string{
+		"ext2", "ext3", "ext4", "xfs", "btrfs", "zfs", "f2fs", "jfs", "reiserfs",
+		"vfat", "exfat", "ntfs", "ntfs3", "fuseblk",
+		"nfs", "nfs4", "cifs", "smb3", "glusterfs", "ceph", "fuse.sshfs",
+	}
+	// Mount points that are covered by other scans or never hold data.
+	skippedMountDirs = []string{"/proc", "/sys", "/dev", "/run", "/snap", "/boot"}
+)
+
+// containerRuntimeRoots returns the data roots of container runtimes.
+func containerRuntimeRoots(ctx context.Context, fsys scalibrfs.FS) ([]*Target, error) {
+	targets := existingCandidates(fsys, containerRuntimeDirs, KindContainerRuntime)
+	// Docker's data root can be moved with "data-root" in daemon.json.
+	if b, err := fs.ReadFile(fsys, "etc/docker/daemon.json"); err == nil {
+		var cfg struct {
+			DataRoot string `json:"data-root"`
+		}
+		if json.Unmarshal(b, &cfg) == nil && path.IsAbs(cfg.DataRoot) {
+			targets = appendTarget(targets, existingDir(fsys, cfg.DataRoot, KindContainerRuntime, "Docker data root from /etc/docker/daemon.json"))
+		}
+	}
+	return targets, nil
+}
+
+// homeDirs returns the home directories of the users that can log in and the
+// directories in /home.
+func homeDirs(ctx context.Context, fsys scalibrfs.FS) ([]*Target, error) {
+	var targets []*Target
+	f, err := fsys.Open("etc/passwd")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to open /etc/passwd: %w", err)
+	}
+	if err == nil {
+		defer f.Close()
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			// name:password:uid:gid:gecos:home:shell
+			fields := strings.Split(s.Text(), ":")
+			if len(fields) < 7 || strings.HasPrefix(fields[0], "#") {
+				continue
+			}
+			name, home, shell := fields[0], fields[5], fields[6]
+			uid, err := strconv.Atoi(fields[2])
+			if err != nil || (uid != 0 && uid < 1000) || uid == 65534 {
+				// System accounts and nobody.
+				continue
+			}
+			if shell == "" || isNoLoginShell(shell) || !path.IsAbs(home) {
+				continue
+			}
+			targets = appendTarget(targets, existingDir(fsys, home, KindHomeDir, "home directory of user "+name))
+		}
+		if err := s.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read /etc/passwd: %w", err)
+		}
+	}
+
+	entries, err := fs.ReadDir(fsys, "home")
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read /home: %w", err)
+	}
+	for _, e := range entries {
+		targets = appendTarget(targets, existingDir(fsys, "/home/"+e.Name(), KindHomeDir, "directory in /home"))
+	}
+	return targets, nil
+}
+
+// webRoots returns the well-known web roots and the ones configured in nginx
+// and Apache httpd.
+func webRoots(ctx context.Context, fsys scalibrfs.FS) ([]*Target, error) {
+	targets := existingCandidates(fsys, webRootDirs, KindWebRoot)
+	targets = appendConfigured(targets, fsys, nginxConfigGlobs, nginxRootRe, KindWebRoot, "nginx root in ")
+	targets = appendConfigured(targets, fsys, apacheConfigGlobs, apacheRootRe, KindWebRoot, "Apache DocumentRoot in ")
+	return targets, nil
+}
+
+// databaseDirs returns the well-known database data directories and the ones
+// configured for MySQL and PostgreSQL.
+func databaseDirs(ctx context.Context, fsys scalibrfs.FS) ([]*Target, error) {
+	targets := existingCandidates(fsys, databaseDataDirs, KindDatabaseData)
+	targets = appendConfigured(targets, fsys, mysqlConfigGlobs, mysqlDataDirRe, KindDatabaseData, "MySQL datadir in ")
+	targets = appendConfigured(targets, fsys, postgresConfigGlobs, postgresDataDirRe, KindDatabaseData, "PostgreSQL data_directory in ")
+	return targets, nil
+}
+
+// mounts returns the mount points of the filesystems mounted on the host that
+// hold data, e.g. attached disks and network shares.
+func mounts(ctx context.Context, fsys scalibrfs.FS) ([]*Target, error) {
+	f, err := fsys.Open("proc/self/mounts")
+	if errors.Is(err, fs.ErrNotExist) {
+		f, err = fsys.Open("proc/mounts")
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the mount table: %w", err)
+	}
+	defer f.Close()
+
+	var targets []*Target
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// device mount-point fstype options dump pass
+		fields := strings.Fields(s.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		device, mountPoint, fsType := fields[0], unescapeMountPath(fields[1]), fields[2]
+		if !slices.Contains(dataFSTypes, fsType) || isSkippedMount(mountPoint) {
+			continue
+		}
+		targets = appendTarget(targets, existingDir(fsys, mountPoint, KindMount, fmt.Sprintf("%s volume %s", fsType, device)))
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the mount table: %w", err)
+	}
+	return targets, nil
+}
+
+// existingCandidates returns the targets for the candidates that exist in
+// fsys.
+func existingCandidates(fsys scalibrfs.FS, candidates []candidate, kind Kind) []*Target {
+	var targets []*Target
+	for _, c := range candidates {
+		targets = appendTarget(targets, existingDir(fsys, c.path, kind, c.reason))
+	}
+	return targets
+}
+
+// appendConfigured appends the directories set by the first submatch of re
+// in the config files matching globs.
+func appendConfigured(targets []*Target, fsys scalibrfs.FS, globs []string, re *regexp.Regexp, kind Kind, reasonPrefix string) []*Target {
+	for _, g := range globs {
+		files, err := fs.Glob(fsys, g)
+		if err != nil {
+			continue
+		}
+		for _, file := range files {
+			b, err := fs.ReadFile(fsys, file)
+			if err != nil {
+				continue
+			}
+			for _, m := range re.FindAllSubmatch(b, -1) {
+				dir := string(m[1])
+				// Skip relative paths and paths built from variables.
+				if !path.IsAbs(dir) || strings.ContainsAny(dir, "$%{") {
+					continue
+				}
+				targets = appendTarget(targets, existingDir(fsys, dir, kind, reasonPrefix+"/"+file))
+			}
+		}
+	}
+	return targets
+}
+
+// appendTarget appends t to targets unless it's nil or its path is already
+// in targets.
+func appendTarget(targets []*Target, t *Target) []*Target {
+	if t == nil {
+		return targets
+	}
+	for _, o := range targets {
+		if o.Path == t.Path {
+			return targets
+		}
+	}
+	return append(targets, t)
+}
+
+func isNoLoginShell(shell string) bool {
+	return slices.Contains(noLoginShells, shell)
+}
+
+func isSkippedMount(mountPoint string) bool {
+	if mountPoint == "/" {
+		// The root filesystem is covered by the other kinds of targets.
+		return true
+	}
+	for _, d := range skippedMountDirs {
+		if isUnder(mountPoint, d) {
+			return true
+		}
+	}
+	return false
+}
+
+// unescapeMountPath decodes the octal escapes of spaces, tabs, newlines and
+// backslashes in the mount table, e.g. "\040".
+func unescapeMountPath(p string) string {
+	if !strings.Contains(p, `\`) {
+		return p
+	}
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		if p[i] == '\\' && i+4 <= len(p) {
+			if v, err := strconv.ParseUint(p[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(p[i])
+	}
+	return b.String()
+}
@@ -40,10 +40,16 @@ type SourceCodeIdentifier struct {
 
 // LayerDetails stores details about the layer a package was found in.
 type LayerDetails struct {
-	Index       int
-	DiffID      string
+	Index  int
+	DiffID string
+	// ChainID identifies the layer together with all layers below it, see
+	// https://github.com/opencontainers/image-spec/blob/main/config.md#layer-chainid.
+	ChainID     string
 	Command     string
 	InBaseImage bool
+	// BaseImage is the name of the known base image the layer belongs to. It's only set if the
+	// image's layers were matched against a catalog of base images.
+	BaseImage string
 }
 
 // Inventory is an instance of a software package or library found by the extractor.
@@ -67,10 +73,31 @@ type Inventory struct {
 	Annotations []Annotation
 	// Details about the layer that the package was attributed to.
 	LayerDetails *LayerDetails
+	// The licenses of the package as SPDX license expressions, e.g. "MIT" or
+	// "GPL-2.0-or-later OR Artistic-1.0". Only set if license detection is
+	// enabled.
+	Licenses []string
+	// The direct dependencies of the package, set by the extractors of
+	// lockfiles that record them. The dependencies are packages found by the
+	// same extractor in the same file, see inventory.DependencyGraph.
+	Dependencies []Dependency
+	// Whether the package is a direct dependency of the project whose lockfile
+	// it was found in. Only set by extractors of lockfiles that list the
+	// dependencies of the project itself.
+	DirectDependency bool
 	// The additional data found in the package.
 	Metadata any
 }
 
+// Dependency identifies a direct dependency of a package by the name and
+// version of the package it resolved to.
+type Dependency struct {
+	Name string
+	// Empty if the lockfile doesn't say which version the dependency resolved
+	// to, e.g. for dependencies on packages from version control.
+	Version string
+}
+
 // Annotation are additional information about the inventory.
 type Annotation int64
 
@@ -0,0 +1,370 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache stores the inventories that filesystem extractors found in
+// files so that repeated scans of mostly unchanged directory trees only run
+// the extractors on the files that changed since the last scan.
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/log"
+)
+
+// formatVersion is the version of the on-disk format. Cache files with a
+// different version are discarded.
+//
+// Version 2 added the licenses and dependencies of inventories.
+const formatVersion = 2
+
+// Key identifies an extractor run on a specific version of a file.
+type Key struct {
+	// The scan root of the file. Empty for virtual scan roots.
+	Root string
+	// The path of the file, relative to Root.
+	Path string
+	// Name and version of the extractor.
+	Extractor        string
+	ExtractorVersion int
+	// Modification time and size of the file.
+	ModTime time.Time
+	Size    int64
+	// Hex-encoded SHA-256 digest of the file contents.
+	Digest string
+}
+
+// Cache stores the inventories extractors found in files.
+//
+// Results of extractors that read other files than the one they're run on
+// are only invalidated when the file itself changes.
+type Cache interface {
+	// Get returns the inventories stored for key and whether there was a
+	// matching entry. The Extractor field of the returned inventories is nil.
+	Get(key *Key) ([]*extractor.Inventory, bool)
+	// Put stores the inventories found in the file described by key,
+	// replacing any previous entry for the same file and extractor. The
+	// inventories aren't retained and can be modified once Put returns.
+	Put(key *Key, inventories []*extractor.Inventory) error
+	// Close persists the cache and releases its resources.
+	Close() error
+}
+
+// Disk is a Cache that's loaded from a JSON file when it's opened and
+// written back to it on Close.
+type Disk struct {
+	path string
+
+	mu      sync.Mutex
+	entries map[string]map[string]*entry // Extractor name to file to entry.
+	dirty   bool
+}
+
+// cacheFile is the JSON format of the cache file.
+type cacheFile struct {
+	Version int                          `json:"version"`
+	Entries map[string]map[string]*entry `json:"entries"`
+}
+
+// entry is the cached result of an extractor run on a file.
+type entry struct {
+	ExtractorVersion int                `json:"extractor_version"`
+	ModTime          time.Time          `json:"mod_time"`
+	Size             int64              `json:"size"`
+	Digest           string             `json:"digest"`
+	Inventories      []*cachedInventory `json:"inventories"`
+}
+
+// cachedInventory is the JSON format of an extractor.Inventory. The metadata
+// is stored together with the name of its type so that it can be decoded
+// into the same type again.
+type cachedInventory struct {
+	Name         string                          `json:"name"`
+	Version      string                          `json:"version"`
+	SourceCode   *extractor.SourceCodeIdentifier `json:"source_code,omitempty"`
+	Locations    []string                        `json:"locations"`
+	Annotations  []extractor.Annotation          `json:"annotations"`
+	LayerDetails *extractor.LayerDetails         `json:"layer_details,omitempty"`
+	// Slices aren't omitted when empty so that empty and nil slices are
+	// decoded as they were.
+	Licenses         []string               `json:"licenses"`
+	Dependencies     []extractor.Dependency `json:"dependencies"`
+	DirectDependency bool                   `json:"direct_dependency,omitempty"`
+	MetadataType     string                 `json:"metadata_type,omitempty"`
+	Metadata         json.RawMessage        `json:"metadata,omitempty"`
+}
+
+// Open loads the cache stored at path. If the file doesn't exist yet or
+// can't be parsed, the cache starts out empty and the file is created on
+// Close.
+func Open(path string) (*Disk, error) {
+	d := &Disk{path: path, entries: make(map[string]map[string]*entry)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return d, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache %s: %w", path, err)
+	}
+	var f cacheFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		log.Warnf("Discarding unreadable extraction cache %s: %v", path, err)
+		return d, nil
+	}
+	if f.Version != formatVersion {
+		log.Warnf("Discarding extraction cache %s with format version %d", path, f.Version)
+		return d, nil
+	}
+	if f.Entries != nil {
+		d.entries = f.Entries
+	}
+	return d, nil
+}
+
+// Get returns the inventories stored for key. Entries with metadata of a
+// type that wasn't registered in this process are treated as missing.
+func (d *Disk) Get(key *Key) ([]*extractor.Inventory, bool) {
+	d.mu.Lock()
+	e, ok := d.entries[key.Extractor][entryName(key)]
+	d.mu.Unlock()
+	if !ok || !e.matches(key) {
+		return nil, false
+	}
+	invs, err := decodeInventories(e.Inventories)
+	if err != nil {
+		return nil, false
+	}
+	return invs, true
+}
+
+// Put stores the inventories found in the file described by key. Results
+// whose metadata doesn't survive a round trip through JSON unchanged aren't
+// cached, so that a cache hit never returns different results than running
+// the extractor.
+func (d *Disk) Put(key *Key, inventories []*extractor.Inventory) error {
+	cached, err := encodeInventories(inventories)
+	if err != nil {
+		return err
+	}
+	decoded, err := decodeInventories(cached)
+	if err != nil {
+		return err
+	}
+	if !equalInventories(inventories, decoded) {
+		return fmt.Errorf("results of %s on %s can't be cached: they change when encoded as JSON", key.Extractor, key.Path)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.entries[key.Extractor] == nil {
+		d.entries[key.Extractor] = make(map[string]*entry)
+	}
+	d.entries[key.Extractor][entryName(key)] = &entry{
+		ExtractorVersion: key.ExtractorVersion,
+		ModTime:          key.ModTime.UTC(),
+		Size:             key.Size,
+		Digest:           key.Digest,
+		Inventories:      cached,
+	}
+	d.dirty = true
+	return nil
+}
+
+// Close writes the cache back to its file if it was modified. The file is
+// replaced atomically so that an interrupted write doesn't corrupt it.
+func (d *Disk) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.dirty {
+		return nil
+	}
+	data, err := json.Marshal(&cacheFile{Version: formatVersion, Entries: d.entries})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(d.path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(d.path), filepath.Base(d.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), d.path); err != nil {
+		return err
+	}
+	d.dirty = false
+	return nil
+}
+
+// entryName returns the name a file is stored under in the entries of an
+// extractor.
+func entryName(key *Key) string {
+	return filepath.ToSlash(filepath.Join(key.Root, key.Path))
+}
+
+func (e *entry) matches(key *Key) bool {
+	return e.ExtractorVersion == key.ExtractorVersion &&
+		e.ModTime.Equal(key.ModTime) &&
+		e.Size == key.Size &&
+		e.Digest == key.Digest
+}
+
+var (
+	typesMu sync.Mutex
+	types   = make(map[string]reflect.Type)
+)
+
+// RegisterMetadata registers the types of the given inventory metadata so
+// that cached inventories with metadata of these types can be decoded. The
+// types of the metadata passed to Put are registered automatically.
+func RegisterMetadata(samples ...any) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	for _, s := range samples {
+		if s == nil {
+			continue
+		}
+		t := reflect.TypeOf(s)
+		types[typeName(t)] = t
+	}
+}
+
+func lookupType(name string) (reflect.Type, bool) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	t, ok := types[name]
+	return t, ok
+}
+
+// typeName returns a name for t that's unique across packages.
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		return "*" + typeName(t.Elem())
+	}
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+	return t.PkgPath() + "." + t.Name()
+}
+
+func encodeInventories(invs []*extractor.Inventory) ([]*cachedInventory, error) {
+	cached := make([]*cachedInventory, 0, len(invs))
+	for _, inv := range invs {
+		c := &cachedInventory{
+			Name:             inv.Name,
+			Version:          inv.Version,
+			SourceCode:       inv.SourceCode,
+			Locations:        inv.Locations,
+			Annotations:      inv.Annotations,
+			LayerDetails:     inv.LayerDetails,
+			Licenses:         inv.Licenses,
+			Dependencies:     inv.Dependencies,
+			DirectDependency: inv.DirectDependency,
+		}
+		if inv.Metadata != nil {
+			RegisterMetadata(inv.Metadata)
+			m, err := json.Marshal(inv.Metadata)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode metadata of %s: %w", inv.Name, err)
+			}
+			c.MetadataType = typeName(reflect.TypeOf(inv.Metadata))
+			c.Metadata = m
+		}
+		cached = append(cached, c)
+	}
+	return cached, nil
+}
+
+func decodeInventories(cached []*cachedInventory) ([]*extractor.Inventory, error) {
+	invs := make([]*extractor.Inventory, 0, len(cached))
+	for _, c := range cached {
+		inv := &extractor.Inventory{
+			Name:             c.Name,
+			Version:          c.Version,
+			SourceCode:       c.SourceCode,
+			Locations:        c.Locations,
+			Annotations:      c.Annotations,
+			LayerDetails:     c.LayerDetails,
+			Licenses:         c.Licenses,
+			Dependencies:     c.Dependencies,
+			DirectDependency: c.DirectDependency,
+		}
+		if c.MetadataType != "" {
+			m, err := decodeMetadata(c.MetadataType, c.Metadata)
+			if err != nil {
+				return nil, err
+			}
+			inv.Metadata = m
+		}
+		invs = append(invs, inv)
+	}
+	return invs, nil
+}
+
+func decodeMetadata(name string, data []byte) (any, error) {
+	t, ok := lookupType(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown metadata type %q", name)
+	}
+	// Decode into a new value of the type, or of the type pointed to for
+	// pointer types.
+	ptr := t.Kind() == reflect.Pointer
+	v := reflect.New(t)
+	if ptr {
+		v = reflect.New(t.Elem())
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v.Interface()); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata of type %q: %w", name, err)
+	}
+	if ptr {
+		return v.Interface(), nil
+	}
+	return v.Elem().Interface(), nil
+}
+
+// equalInventories returns whether got equals want when ignoring the
+// extractor, which isn't cached.
+func equalInventories(want, got []*extractor.Inventory) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		w := *want[i]
+		w.Extractor = nil
+		if !reflect.DeepEqual(&w, got[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,282 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/cache"
+	"github.com/google/osv-scalibr/extractor/filesystem/osv"
+)
+
+type testMetadata struct {
+	Arch  string
+	Files []string
+}
+
+type unexportedMetadata struct {
+	arch string
+}
+
+func testKey() *cache.Key {
+	return &cache.Key{
+		Root:             "/root",
+		Path:             "lib/package.json",
+		Extractor:        "javascript/packagejson",
+		ExtractorVersion: 1,
+		ModTime:          time.Unix(1700000000, 123),
+		Size:             42,
+		Digest:           "0123456789abcdef",
+	}
+}
+
+func testInventories() []*extractor.Inventory {
+	return []*extractor.Inventory{
+		{
+			Name:        "left-pad",
+			Version:     "1.3.0",
+			Locations:   []string{"lib/package.json"},
+			Annotations: []extractor.Annotation{extractor.Transitional},
+			Metadata:    &testMetadata{Arch: "amd64", Files: []string{"index.js"}},
+		},
+		{
+			Name:      "no-metadata",
+			Version:   "1.0.0",
+			Locations: []string{"lib/package.json"},
+		},
+	}
+}
+
+func TestGet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	c, err := cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	if err := c.Put(testKey(), testInventories()); err != nil {
+		t.Fatalf("Put(): %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+
+	c, err = cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	tests := []struct {
+		desc    string
+		modify  func(k *cache.Key)
+		wantHit bool
+	}{
+		{
+			desc:    "unchanged file",
+			modify:  func(k *cache.Key) {},
+			wantHit: true,
+		},
+		{
+			desc:   "different root",
+			modify: func(k *cache.Key) { k.Root = "/other" },
+		},
+		{
+			desc:   "different extractor",
+			modify: func(k *cache.Key) { k.Extractor = "javascript/packagelockjson" },
+		},
+		{
+			desc:   "new extractor version",
+			modify: func(k *cache.Key) { k.ExtractorVersion = 2 },
+		},
+		{
+			desc:   "modified",
+			modify: func(k *cache.Key) { k.ModTime = k.ModTime.Add(time.Second) },
+		},
+		{
+			desc:   "different size",
+			modify: func(k *cache.Key) { k.Size = 43 },
+		},
+		{
+			desc:   "different contents",
+			modify: func(k *cache.Key) { k.Digest = "fedcba9876543210" },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			key := testKey()
+			tc.modify(key)
+			got, ok := c.Get(key)
+			if ok != tc.wantHit {
+				t.Fatalf("Get(%+v) returned hit %t, want %t", key, ok, tc.wantHit)
+			}
+			if !tc.wantHit {
+				return
+			}
+			if diff := cmp.Diff(testInventories(), got); diff != "" {
+				t.Errorf("Get(%+v) returned unexpected inventories (-want +got):\n%s", key, diff)
+			}
+		})
+	}
+}
+
+func TestGet_Lockfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	c, err := cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	key := testKey()
+	key.Path = "package-lock.json"
+	want := []*extractor.Inventory{
+		{
+			Name:             "express",
+			Version:          "4.19.2",
+			Locations:        []string{"package-lock.json"},
+			Licenses:         []string{"MIT"},
+			Dependencies:     []extractor.Dependency{{Name: "accepts", Version: "1.3.8"}, {Name: "git-dep"}},
+			DirectDependency: true,
+			Metadata:         osv.DepGroupMetadata{DepGroupVals: []string{}},
+		},
+		{
+			Name:         "accepts",
+			Version:      "1.3.8",
+			Locations:    []string{"package-lock.json"},
+			Licenses:     []string{},
+			Dependencies: []extractor.Dependency{},
+			Metadata:     osv.DepGroupMetadata{DepGroupVals: []string{"dev"}},
+		},
+	}
+	if err := c.Put(key, want); err != nil {
+		t.Fatalf("Put(): %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+
+	c, err = cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	got, ok := c.Get(key)
+	if !ok {
+		t.Fatalf("Get(%+v) returned no hit", key)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Get(%+v) returned unexpected inventories (-want +got):\n%s", key, diff)
+	}
+}
+
+func TestOpen_OldFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	c, err := cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	if err := c.Put(testKey(), testInventories()); err != nil {
+		t.Fatalf("Put(): %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%q): %v", path, err)
+	}
+	// Entries written before dependencies were cached lack them.
+	data = bytes.Replace(data, []byte(`"version":2`), []byte(`"version":1`), 1)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("os.WriteFile(%q): %v", path, err)
+	}
+
+	c, err = cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	if _, ok := c.Get(testKey()); ok {
+		t.Errorf("Get() on a cache with an old format version returned a hit")
+	}
+}
+
+func TestPut_Replaces(t *testing.T) {
+	c, err := cache.Open(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatalf("cache.Open(): %v", err)
+	}
+	if err := c.Put(testKey(), testInventories()); err != nil {
+		t.Fatalf("Put(): %v", err)
+	}
+	key := testKey()
+	key.Digest = "fedcba9876543210"
+	want := []*extractor.Inventory{{Name: "right-pad", Version: "2.0.0", Locations: []string{"lib/package.json"}}}
+	if err := c.Put(key, want); err != nil {
+		t.Fatalf("Put(): %v", err)
+	}
+
+	if _, ok := c.Get(testKey()); ok {
+		t.Errorf("Get() returned a hit for the replaced entry")
+	}
+	got, ok := c.Get(key)
+	if !ok {
+		t.Fatalf("Get(%+v) returned no hit", key)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Get(%+v) returned unexpected inventories (-want +got):\n%s", key, diff)
+	}
+}
+
+func TestPut_NotEncodable(t *testing.T) {
+	c, err := cache.Open(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatalf("cache.Open(): %v", err)
+	}
+	invs := []*extractor.Inventory{{Name: "pkg", Metadata: &unexportedMetadata{arch: "amd64"}}}
+	if err := c.Put(testKey(), invs); err == nil {
+		t.Errorf("Put() with unexported metadata fields succeeded, want error")
+	}
+	if _, ok := c.Get(testKey()); ok {
+		t.Errorf("Get() returned a hit for results that couldn't be cached")
+	}
+}
+
+func TestOpen_Corrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0o600); err != nil {
+		t.Fatalf("os.WriteFile(%q): %v", path, err)
+	}
+	c, err := cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	if _, ok := c.Get(testKey()); ok {
+		t.Errorf("Get() on a corrupt cache returned a hit")
+	}
+	if err := c.Put(testKey(), testInventories()); err != nil {
+		t.Fatalf("Put(): %v", err)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+	c, err = cache.Open(path)
+	if err != nil {
+		t.Fatalf("cache.Open(%q): %v", path, err)
+	}
+	if _, ok := c.Get(testKey()); !ok {
+		t.Errorf("Get() returned no hit after the corrupt cache was rewritten")
+	}
+}
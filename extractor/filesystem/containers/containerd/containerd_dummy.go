@@ -61,6 +61,11 @@ func (e Extractor) FileRequired(_ filesystem.FileAPI) bool {
 	return false
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 // Extract not implemented.
 func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
 	return nil, fmt.Errorf("not supported")
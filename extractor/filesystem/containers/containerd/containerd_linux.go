@@ -119,6 +119,11 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	}
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 // Extract container inventory through the containerd metadb file passed as the scan input.
 func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
 	var inventory = []*extractor.Inventory{}
@@ -0,0 +1,448 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ecsagent extracts the containers of the tasks running on an Amazon
+// ECS container instance from the state of the ECS container agent. Each
+// container is reported with its image and the secrets and volumes the agent
+// provides to it.
+package ecsagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "containers/ecsagent"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 100 * units.MiB
+
+	// stateFileName is the JSON state file of agents before version 1.44.
+	stateFileName = "ecs_agent_data.json"
+	// dbFileName is the BoltDB database of newer agents.
+	dbFileName = "agent.db"
+
+	// taskStatusStopped is the status of tasks that are kept in the state
+	// until the agent cleans them up.
+	taskStatusStopped = "STOPPED"
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the ECS agent
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts the containers of ECS tasks from the ECS agent's state.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an ECS agent extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is the state file or the
+// database of the ECS agent, i.e. /var/lib/ecs/data on Linux and
+// C:\ProgramData\Amazon\ECS\data on Windows.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if fileName(path) == "" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// fileName returns the name of the agent file at p, or "" if p is not in the
+// agent's data directory.
+func fileName(p string) string {
+	p = strings.ToLower(filepath.ToSlash(p))
+	base := path.Base(p)
+	if base != stateFileName && base != dbFileName {
+		return ""
+	}
+	if dir := path.Dir(p); dir != "ecs/data" && !strings.HasSuffix(dir, "/ecs/data") {
+		return ""
+	}
+	return base
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// agentState is the part of the agent's state the extractor reports.
+type agentState struct {
+	cluster     string
+	instanceARN string
+	tasks       []*task
+	// The Docker IDs of the containers by task ARN and container name.
+	dockerIDs map[containerKey]string
+}
+
+type containerKey struct {
+	taskARN string
+	name    string
+}
+
+// task is a task in the agent's state.
+type task struct {
+	Arn         string       `json:"Arn"`
+	Family      string       `json:"Family"`
+	Version     string       `json:"Version"`
+	KnownStatus string       `json:"KnownStatus"`
+	Containers  []*container `json:"Containers"`
+	Volumes     []*volume    `json:"volumes"`
+}
+
+// container is a container of a task.
+type container struct {
+	Name        string        `json:"Name"`
+	Image       string        `json:"Image"`
+	ImageDigest string        `json:"ImageDigest"`
+	TaskARN     string        `json:"taskARN"`
+	Secrets     []*secret     `json:"secrets"`
+	MountPoints []*mountPoint `json:"mountPoints"`
+}
+
+type secret struct {
+	Name          string `json:"name"`
+	ValueFrom     string `json:"valueFrom"`
+	Provider      string `json:"provider"`
+	Type          string `json:"type"`
+	ContainerPath string `json:"containerPath"`
+}
+
+type mountPoint struct {
+	SourceVolume  string `json:"sourceVolume"`
+	ContainerPath string `json:"containerPath"`
+	ReadOnly      bool   `json:"readOnly"`
+}
+
+// volume is a volume of a task. Only the source path of bind mounts is
+// reported.
+type volume struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Host *struct {
+		SourcePath string `json:"sourcePath"`
+	} `json:"host"`
+}
+
+// dockerContainer maps a container of a task to its Docker container.
+type dockerContainer struct {
+	DockerID  string     `json:"DockerId"`
+	Container *container `json:"Container"`
+}
+
+// Extract parses the agent's state passed through the scan input and returns
+// an Inventory for each container of the tasks that haven't stopped.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var st *agentState
+	var err error
+	switch fileName(input.Path) {
+	case stateFileName:
+		st, err = parseStateFile(input.Reader)
+	case dbFileName:
+		st, err = parseDB(input.Reader)
+	default:
+		err = fmt.Errorf("unsupported file %s", input.Path)
+	}
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	inventory := []*extractor.Inventory{}
+	for _, t := range st.tasks {
+		if err := ctx.Err(); err != nil {
+			return inventory, err
+		}
+		if t.KnownStatus == taskStatusStopped {
+			continue
+		}
+		for _, c := range t.Containers {
+			inventory = append(inventory, &extractor.Inventory{
+				Name:      c.Image,
+				Version:   c.ImageDigest,
+				Locations: []string{input.Path},
+				Metadata:  st.metadata(t, c),
+			})
+		}
+	}
+	return inventory, nil
+}
+
+// metadata returns the Metadata of container c of task t.
+func (st *agentState) metadata(t *task, c *container) *Metadata {
+	m := &Metadata{
+		Cluster:              st.cluster,
+		ContainerInstanceARN: st.instanceARN,
+		TaskARN:              t.Arn,
+		TaskFamily:           t.Family,
+		TaskVersion:          t.Version,
+		TaskStatus:           t.KnownStatus,
+		ContainerName:        c.Name,
+		DockerID:             st.dockerIDs[containerKey{t.Arn, c.Name}],
+		ImageName:            c.Image,
+		ImageDigest:          c.ImageDigest,
+	}
+	for _, s := range c.Secrets {
+		m.Secrets = append(m.Secrets, &Secret{
+			Name:          s.Name,
+			ValueFrom:     s.ValueFrom,
+			Provider:      s.Provider,
+			Type:          s.Type,
+			ContainerPath: s.ContainerPath,
+		})
+	}
+	for _, mp := range c.MountPoints {
+		mount := &Mount{
+			Volume:        mp.SourceVolume,
+			ContainerPath: mp.ContainerPath,
+			ReadOnly:      mp.ReadOnly,
+		}
+		for _, v := range t.Volumes {
+			if v.Name == mp.SourceVolume && v.Host != nil {
+				mount.SourcePath = v.Host.SourcePath
+			}
+		}
+		m.Mounts = append(m.Mounts, mount)
+	}
+	return m
+}
+
+// stateFile is the JSON state file of the agent.
+type stateFile struct {
+	Data struct {
+		Cluster              string `json:"Cluster"`
+		ContainerInstanceArn string `json:"ContainerInstanceArn"`
+		TaskEngine           struct {
+			Tasks         []*task                     `json:"Tasks"`
+			IDToContainer map[string]*dockerContainer `json:"IdToContainer"`
+			IDToTask      map[string]string           `json:"IdToTask"`
+		} `json:"TaskEngine"`
+	} `json:"Data"`
+}
+
+// parseStateFile parses the JSON state file of agents before version 1.44.
+func parseStateFile(r io.Reader) (*agentState, error) {
+	var f stateFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return nil, err
+	}
+	st := &agentState{
+		cluster:     f.Data.Cluster,
+		instanceARN: f.Data.ContainerInstanceArn,
+		tasks:       f.Data.TaskEngine.Tasks,
+		dockerIDs:   map[containerKey]string{},
+	}
+	for id, dc := range f.Data.TaskEngine.IDToContainer {
+		taskARN, ok := f.Data.TaskEngine.IDToTask[id]
+		if !ok || dc.Container == nil {
+			continue
+		}
+		st.dockerIDs[containerKey{taskARN, dc.Container.Name}] = id
+	}
+	return st, nil
+}
+
+// Buckets and keys of the agent's database. All values are JSON encoded.
+var (
+	tasksBucket             = []byte("tasks")
+	containersBucket        = []byte("containers")
+	metadataBucket          = []byte("metadata")
+	clusterNameKey          = []byte("cluster-name")
+	containerInstanceARNKey = []byte("container-instance-arn")
+)
+
+// parseDB parses the BoltDB database of agents since version 1.44.
+func parseDB(r io.Reader) (*agentState, error) {
+	// The running agent holds an exclusive lock on the database, so it's read
+	// from a copy.
+	dir, err := os.MkdirTemp("", "scalibr-ecsagent")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, dbFileName)
+	if err := copyToFile(dbPath, r); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(dbPath, 0444, &bolt.Options{ReadOnly: true, Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	st := &agentState{dockerIDs: map[containerKey]string{}}
+	err = db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(metadataBucket); b != nil {
+			st.cluster = jsonString(b.Get(clusterNameKey))
+			st.instanceARN = jsonString(b.Get(containerInstanceARNKey))
+		}
+		if b := tx.Bucket(tasksBucket); b != nil {
+			if err := b.ForEach(func(_, v []byte) error {
+				var t task
+				if err := json.Unmarshal(v, &t); err != nil {
+					return err
+				}
+				st.tasks = append(st.tasks, &t)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		if b := tx.Bucket(containersBucket); b != nil {
+			return b.ForEach(func(_, v []byte) error {
+				var dc dockerContainer
+				if err := json.Unmarshal(v, &dc); err != nil {
+					return err
+				}
+				if dc.Container != nil {
+					st.dockerIDs[containerKey{dc.Container.TaskARN, dc.Container.Name}] = dc.DockerID
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Keys are task IDs, so order the tasks by ARN like in the state file.
+	sort.Slice(st.tasks, func(i, j int) bool { return st.tasks[i].Arn < st.tasks[j].Arn })
+	return st, nil
+}
+
+func copyToFile(dst string, r io.Reader) error {
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// jsonString returns the JSON string value v, or v itself if it's not a JSON
+// string.
+func jsonString(v []byte) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		return string(v)
+	}
+	return s
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL returns nil since the containers are not software packages.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL { return nil }
+
+// Ecosystem returns no ecosystem since the Inventory is not a software package.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,331 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecsagent_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/ecsagent"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	webTaskARN = "arn:aws:ecs:us-east-1:123456789012:task/prod/0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e"
+	webImage   = "123456789012.dkr.ecr.us-east-1.amazonaws.com/web:1.4.2"
+	webDigest  = "sha256:3b7e7c4e0e9d2c3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f"
+	webID      = "a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2"
+	fluentBit  = "public.ecr.aws/aws-observability/aws-for-fluent-bit:2.31.12"
+	instARN    = "arn:aws:ecs:us-east-1:123456789012:container-instance/prod/5f1c0e3a9b2d4e6f8a7b1c2d3e4f5a6b"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "state file",
+			path:             "var/lib/ecs/data/ecs_agent_data.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "database",
+			path:             "var/lib/ecs/data/agent.db",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "Windows database",
+			path:             "ProgramData/Amazon/ECS/data/agent.db",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "database outside of the data directory",
+			path:         "srv/app/agent.db",
+			wantRequired: false,
+		},
+		{
+			name:         "other agent file",
+			path:         "var/lib/ecs/data/ecs_agent_data.json.bak",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "var/lib/ecs/data/agent.db",
+			fileSizeBytes:    1000 * units.MiB,
+			maxFileSizeBytes: 100 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "var/lib/ecs/data/agent.db",
+			fileSizeBytes:    1000 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := ecsagent.New(ecsagent.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+// webApp returns the Metadata of the app container of the web task.
+func webApp(cluster, instanceARN string) *ecsagent.Metadata {
+	return &ecsagent.Metadata{
+		Cluster:              cluster,
+		ContainerInstanceARN: instanceARN,
+		TaskARN:              webTaskARN,
+		TaskFamily:           "web",
+		TaskVersion:          "12",
+		TaskStatus:           "RUNNING",
+		ContainerName:        "app",
+		DockerID:             webID,
+		ImageName:            webImage,
+		ImageDigest:          webDigest,
+		Secrets: []*ecsagent.Secret{
+			{
+				Name:      "DB_PASSWORD",
+				ValueFrom: "arn:aws:ssm:us-east-1:123456789012:parameter/prod/db-password",
+				Provider:  "ssm",
+				Type:      "ENVIRONMENT_VARIABLE",
+			},
+			{
+				Name:          "tls-key",
+				ValueFrom:     "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/tls-AbCdEf",
+				Provider:      "asm",
+				Type:          "MOUNT_POINT",
+				ContainerPath: `C:\secrets\tls.key`,
+			},
+		},
+		Mounts: []*ecsagent.Mount{
+			{Volume: "certs", SourcePath: "/etc/pki/web", ContainerPath: "/etc/ssl/private", ReadOnly: true},
+			{Volume: "scratch", ContainerPath: "/tmp/scratch"},
+		},
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "state file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/ecs/data/ecs_agent_data.json",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      webImage,
+					Version:   webDigest,
+					Locations: []string{"testdata/var/lib/ecs/data/ecs_agent_data.json"},
+					Metadata:  webApp("prod", instARN),
+				},
+				{
+					Name:      fluentBit,
+					Locations: []string{"testdata/var/lib/ecs/data/ecs_agent_data.json"},
+					Metadata: &ecsagent.Metadata{
+						Cluster:              "prod",
+						ContainerInstanceARN: instARN,
+						TaskARN:              webTaskARN,
+						TaskFamily:           "web",
+						TaskVersion:          "12",
+						TaskStatus:           "RUNNING",
+						ContainerName:        "log-router",
+						ImageName:            fluentBit,
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid state file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/var/lib/ecs/data/ecs_agent_data.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := ecsagent.New(ecsagent.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+// writeAgentDB writes an agent database with the tasks and containers of the
+// state file in testdata to root.
+func writeAgentDB(t *testing.T, root string) string {
+	t.Helper()
+	data, err := os.ReadFile("testdata/var/lib/ecs/data/ecs_agent_data.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile(): %v", err)
+	}
+	var state struct {
+		Data struct {
+			TaskEngine struct {
+				Tasks         []map[string]any
+				IDToContainer map[string]map[string]any `json:"IdToContainer"`
+			}
+		}
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("json.Unmarshal(): %v", err)
+	}
+
+	p := "var/lib/ecs/data/agent.db"
+	if err := os.MkdirAll(filepath.Join(root, filepath.Dir(p)), 0755); err != nil {
+		t.Fatalf("os.MkdirAll(): %v", err)
+	}
+	db, err := bolt.Open(filepath.Join(root, p), 0644, nil)
+	if err != nil {
+		t.Fatalf("bolt.Open(): %v", err)
+	}
+	defer db.Close()
+	put := func(tx *bolt.Tx, bucket, key string, v any) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(key), value)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		if err := put(tx, "metadata", "cluster-name", "prod"); err != nil {
+			return err
+		}
+		for _, task := range state.Data.TaskEngine.Tasks {
+			if err := put(tx, "tasks", task["Arn"].(string), task); err != nil {
+				return err
+			}
+		}
+		for id, c := range state.Data.TaskEngine.IDToContainer {
+			c["Container"].(map[string]any)["taskARN"] = webTaskARN
+			if err := put(tx, "containers", id, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("db.Update(): %v", err)
+	}
+	return p
+}
+
+func TestExtractDB(t *testing.T) {
+	root := t.TempDir()
+	p := writeAgentDB(t, root)
+	extr := ecsagent.New(ecsagent.DefaultConfig())
+
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{
+		Path:         p,
+		FakeScanRoot: root,
+	})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	got, err := extr.Extract(context.Background(), &scanInput)
+	if err != nil {
+		t.Fatalf("%s.Extract(%q): %v", extr.Name(), p, err)
+	}
+
+	want := []*extractor.Inventory{
+		{
+			Name:      webImage,
+			Version:   webDigest,
+			Locations: []string{p},
+			Metadata:  webApp("prod", ""),
+		},
+		{
+			Name:      fluentBit,
+			Locations: []string{p},
+			Metadata: &ecsagent.Metadata{
+				Cluster:       "prod",
+				TaskARN:       webTaskARN,
+				TaskFamily:    "web",
+				TaskVersion:   "12",
+				TaskStatus:    "RUNNING",
+				ContainerName: "log-router",
+				ImageName:     fluentBit,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+		t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), p, diff)
+	}
+}
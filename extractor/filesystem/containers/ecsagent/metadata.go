@@ -0,0 +1,56 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecsagent
+
+// Metadata contains metadata about a container of an ECS task.
+type Metadata struct {
+	Cluster              string
+	ContainerInstanceARN string
+	TaskARN              string
+	TaskFamily           string
+	TaskVersion          string
+	// The last status of the task known to the agent, e.g. "RUNNING".
+	TaskStatus    string
+	ContainerName string
+	DockerID      string
+	ImageName     string
+	ImageDigest   string
+	Secrets       []*Secret
+	Mounts        []*Mount
+}
+
+// Secret is a secret from SSM Parameter Store or Secrets Manager that the
+// agent injects into a container.
+type Secret struct {
+	Name string
+	// The ARN or name of the parameter or secret.
+	ValueFrom string
+	// "ssm" or "asm".
+	Provider string
+	// "ENVIRONMENT_VARIABLE" or "MOUNT_POINT".
+	Type string
+	// The path the secret is written to in the container for MOUNT_POINT
+	// secrets.
+	ContainerPath string
+}
+
+// Mount is a volume mounted into a container.
+type Mount struct {
+	Volume string
+	// The path of the volume on the host for bind mounts.
+	SourcePath    string
+	ContainerPath string
+	ReadOnly      bool
+}
@@ -0,0 +1,329 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubelet extracts the pods running on a Kubernetes node from the
+// kubelet's pod directories. Each pod is reported with the IDs of its
+// containers and the secret volumes mounted into it.
+//
+// The kubelet doesn't store the images of the pods' containers, so the
+// container IDs link the pods to the images reported by the container
+// runtime extractors, e.g. containers/containerd.
+package kubelet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "containers/kubelet"
+
+	// podMarkerFile is created by the kubelet in the directory of each pod.
+	// It's used to find the pod directories.
+	podMarkerFile = "etc-hosts"
+
+	// The log directories of the pods and the log files of their containers,
+	// named <namespace>_<name>_<uid> and <name>_<namespace>_<container>-<id>.log.
+	podLogsDir       = "var/log/pods"
+	containerLogsDir = "var/log/containers"
+
+	// secretsStoreCSIDriver is the name of the Secrets Store CSI driver.
+	secretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+}
+
+// DefaultConfig returns the default configuration for the kubelet extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats: nil,
+	}
+}
+
+// Extractor extracts pods from the kubelet's pod directories.
+type Extractor struct {
+	stats stats.Collector
+}
+
+// New returns a kubelet extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats: cfg.Stats,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is the etc-hosts file of a
+// pod directory, e.g. /var/lib/kubelet/pods/<uid>/etc-hosts.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if !isPodMarker(path) {
+		return false
+	}
+	var size int64
+	if fileinfo, err := api.Stat(); err == nil {
+		size = fileinfo.Size()
+	}
+	e.reportFileRequired(path, size, stats.FileRequiredResultOK)
+	return true
+}
+
+func isPodMarker(p string) bool {
+	p = filepath.ToSlash(p)
+	if path.Base(p) != podMarkerFile {
+		return false
+	}
+	pods := path.Dir(path.Dir(p))
+	return path.Base(pods) == "pods" && path.Base(path.Dir(pods)) == "kubelet"
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract returns an Inventory for the pod whose directory contains the file
+// passed through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	podDir := path.Dir(filepath.ToSlash(input.Path))
+	m := &Metadata{UID: path.Base(podDir)}
+	var err error
+	if m.Namespace, m.Name, err = podName(input.FS, m.UID); err == nil {
+		m.Containers, err = containers(input.FS, podDir, m.Namespace, m.Name)
+	}
+	if err == nil {
+		m.SecretVolumes, err = secretVolumes(ctx, input.FS, podDir)
+	}
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	name := m.Name
+	if name == "" {
+		name = m.UID
+	}
+	return []*extractor.Inventory{{
+		Name:      name,
+		Locations: []string{input.Path},
+		Metadata:  m,
+	}}, nil
+}
+
+// podName returns the namespace and name of the pod with the given UID from
+// the name of its log directory.
+func podName(fsys scalibrfs.FS, uid string) (string, string, error) {
+	entries, err := readDir(fsys, podLogsDir)
+	if err != nil {
+		return "", "", err
+	}
+	for _, e := range entries {
+		// Namespaces and pod names can't contain underscores.
+		parts := strings.Split(e.Name(), "_")
+		if len(parts) == 3 && parts[2] == uid {
+			return parts[0], parts[1], nil
+		}
+	}
+	return "", "", nil
+}
+
+// containers returns the containers of the pod in podDir with their IDs.
+func containers(fsys scalibrfs.FS, podDir, namespace, name string) ([]*Container, error) {
+	ids := map[string][]string{}
+	entries, err := readDir(fsys, path.Join(podDir, "containers"))
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			ids[e.Name()] = nil
+		}
+	}
+	if name != "" {
+		entries, err := readDir(fsys, containerLogsDir)
+		if err != nil {
+			return nil, err
+		}
+		prefix := name + "_" + namespace + "_"
+		for _, e := range entries {
+			rest, ok := strings.CutPrefix(e.Name(), prefix)
+			if !ok {
+				continue
+			}
+			rest, ok = strings.CutSuffix(rest, ".log")
+			i := strings.LastIndex(rest, "-")
+			if !ok || i < 0 {
+				continue
+			}
+			ids[rest[:i]] = append(ids[rest[:i]], rest[i+1:])
+		}
+	}
+
+	var result []*Container
+	for name, ids := range ids {
+		slices.Sort(ids)
+		result = append(result, &Container{Name: name, IDs: ids})
+	}
+	slices.SortFunc(result, func(a, b *Container) int { return strings.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+// secretVolumes returns the volumes with secrets of the pod in podDir.
+func secretVolumes(ctx context.Context, fsys scalibrfs.FS, podDir string) ([]*Volume, error) {
+	var volumes []*Volume
+	plugins := []struct {
+		dir string
+		typ string
+	}{
+		{"kubernetes.io~secret", VolumeTypeSecret},
+		{"kubernetes.io~projected", VolumeTypeProjected},
+		{"kubernetes.io~csi", VolumeTypeSecretsStoreCSI},
+	}
+	for _, p := range plugins {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		dir := path.Join(podDir, "volumes", p.dir)
+		entries, err := readDir(fsys, dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			v := &Volume{Name: e.Name(), Type: p.typ, Path: path.Join(dir, e.Name())}
+			if p.typ == VolumeTypeSecretsStoreCSI {
+				// CSI volumes are mounted from the mount directory next to the
+				// volume's data.
+				if !isSecretsStoreCSI(fsys, v.Path) {
+					continue
+				}
+				v.Path = path.Join(v.Path, "mount")
+			}
+			if v.Files, err = volumeFiles(fsys, v.Path); err != nil {
+				return nil, err
+			}
+			volumes = append(volumes, v)
+		}
+	}
+	return volumes, nil
+}
+
+// isSecretsStoreCSI returns true if the CSI volume in dir is provided by the
+// Secrets Store CSI driver.
+func isSecretsStoreCSI(fsys scalibrfs.FS, dir string) bool {
+	f, err := fsys.Open(path.Join(dir, "vol_data.json"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	var data struct {
+		DriverName string `json:"driverName"`
+	}
+	if err := json.NewDecoder(f).Decode(&data); err != nil {
+		return false
+	}
+	return data.DriverName == secretsStoreCSIDriver
+}
+
+// volumeFiles returns the names of the files in the volume in dir.
+func volumeFiles(fsys scalibrfs.FS, dir string) ([]string, error) {
+	entries, err := readDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		// The kubelet writes volumes atomically into timestamped directories
+		// and links the current one as ..data.
+		if strings.HasPrefix(e.Name(), "..") {
+			continue
+		}
+		files = append(files, e.Name())
+	}
+	return files, nil
+}
+
+// readDir reads the directory dir, returning no entries if it doesn't exist.
+func readDir(fsys scalibrfs.FS, dir string) ([]fs.DirEntry, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return entries, err
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL returns nil since pods are not software packages.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL { return nil }
+
+// Ecosystem returns no ecosystem since the Inventory is not a software package.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,181 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/kubelet"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+const (
+	apiPodUID      = "5c2f7e1a-8b3d-4f6e-9a0c-1d2e3f4a5b6c"
+	exporterPodUID = "0d9e8f7a-6b5c-4d3e-2f1a-0b9c8d7e6f5a"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "pod etc-hosts",
+			path:             "var/lib/kubelet/pods/" + apiPodUID + "/etc-hosts",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "pod in custom kubelet root",
+			path:             "data/kubelet/pods/" + apiPodUID + "/etc-hosts",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "file in volume",
+			path:         "var/lib/kubelet/pods/" + apiPodUID + "/volumes/kubernetes.io~secret/db-creds/password",
+			wantRequired: false,
+		},
+		{
+			name:         "etc-hosts outside of pod directory",
+			path:         "srv/pods/app/etc-hosts",
+			wantRequired: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := kubelet.New(kubelet.Config{Stats: collector})
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: 100,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	apiPod := "var/lib/kubelet/pods/" + apiPodUID
+	exporterPod := "var/lib/kubelet/pods/" + exporterPodUID
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "pod with logs and secret volumes",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         apiPod + "/etc-hosts",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "api-7d9f8b6c4-x2x7k",
+				Locations: []string{apiPod + "/etc-hosts"},
+				Metadata: &kubelet.Metadata{
+					UID:       apiPodUID,
+					Namespace: "payments",
+					Name:      "api-7d9f8b6c4-x2x7k",
+					Containers: []*kubelet.Container{
+						{
+							Name: "app",
+							IDs: []string{
+								"0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7",
+								"7f6e5d4c3b2a19080f1e2d3c4b5a69788796a5b4c3d2e1f0e1d2c3b4a5968778",
+							},
+						},
+						{
+							Name: "istio-proxy",
+							IDs:  []string{"c0ffee00c0ffee00c0ffee00c0ffee00c0ffee00c0ffee00c0ffee00c0ffee00"},
+						},
+					},
+					SecretVolumes: []*kubelet.Volume{
+						{
+							Name:  "db-creds",
+							Type:  kubelet.VolumeTypeSecret,
+							Path:  apiPod + "/volumes/kubernetes.io~secret/db-creds",
+							Files: []string{"password", "username"},
+						},
+						{
+							Name:  "kube-api-access-x7k2p",
+							Type:  kubelet.VolumeTypeProjected,
+							Path:  apiPod + "/volumes/kubernetes.io~projected/kube-api-access-x7k2p",
+							Files: []string{"ca.crt", "namespace", "token"},
+						},
+						{
+							Name:  "vault-secrets",
+							Type:  kubelet.VolumeTypeSecretsStoreCSI,
+							Path:  apiPod + "/volumes/kubernetes.io~csi/vault-secrets/mount",
+							Files: []string{"stripe-api-key"},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "pod without logs",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         exporterPod + "/etc-hosts",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      exporterPodUID,
+				Locations: []string{exporterPod + "/etc-hosts"},
+				Metadata: &kubelet.Metadata{
+					UID:        exporterPodUID,
+					Containers: []*kubelet.Container{{Name: "node-exporter"}},
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := kubelet.New(kubelet.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
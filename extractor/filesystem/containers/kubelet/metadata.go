@@ -0,0 +1,57 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubelet
+
+// Volume types reported in Metadata.
+const (
+	VolumeTypeSecret    = "secret"
+	VolumeTypeProjected = "projected"
+	// VolumeTypeSecretsStoreCSI is a volume of the Secrets Store CSI driver,
+	// which mounts secrets from external secret managers.
+	VolumeTypeSecretsStoreCSI = "secrets-store-csi"
+)
+
+// Metadata contains metadata about a pod running on a Kubernetes node.
+type Metadata struct {
+	UID string
+	// The namespace and name of the pod. Empty if the pod's log directory
+	// was not found.
+	Namespace  string
+	Name       string
+	Containers []*Container
+	// The volumes with secrets mounted into the pod.
+	SecretVolumes []*Volume
+}
+
+// Container is a container of a pod.
+type Container struct {
+	Name string
+	// The IDs of the container's instances known to the container runtime,
+	// e.g. of the current and the previous instance after a restart. They
+	// link the pod to the images reported by the container runtime
+	// extractors.
+	IDs []string
+}
+
+// Volume is a volume with secrets mounted into a pod.
+type Volume struct {
+	Name string
+	Type string
+	// The directory on the node the volume is mounted from.
+	Path string
+	// The files in the volume, e.g. "token" and "ca.crt" for a service
+	// account token.
+	Files []string
+}
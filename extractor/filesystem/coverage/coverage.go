@@ -0,0 +1,124 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package coverage reports files that look like package manifests or lockfiles
+// but weren't parsed by any of the enabled extractors. An empty scan result
+// can otherwise not be distinguished from a blind spot in SCALIBR's coverage.
+package coverage
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Reason describes why a known manifest or lockfile wasn't parsed.
+type Reason string
+
+const (
+	// ReasonNoExtractor means none of the enabled extractors claimed the file,
+	// e.g. because the extractor for its format isn't enabled or doesn't exist.
+	ReasonNoExtractor Reason = "NO_EXTRACTOR"
+	// ReasonExtractionError means all extractors that claimed the file failed
+	// to parse it, e.g. because it's corrupt or uses an unsupported version of
+	// the format.
+	ReasonExtractionError Reason = "EXTRACTION_ERROR"
+	// ReasonBudgetExceeded means the extractors that would have parsed the
+	// file were cut off before, because they exceeded their plugin budget.
+	ReasonBudgetExceeded Reason = "BUDGET_EXCEEDED"
+)
+
+// UnparsedFile is a known manifest or lockfile that SCALIBR couldn't parse.
+type UnparsedFile struct {
+	// Path of the file, relative to the scan root.
+	Path string
+	// Ecosystem the file belongs to, e.g. "npm" or "PyPI".
+	Ecosystem string
+	Reason    Reason
+	// The extraction errors if Reason is ReasonExtractionError.
+	Error string
+}
+
+// Report lists the known manifests and lockfiles that weren't parsed during
+// a scan.
+type Report struct {
+	UnparsedFiles []*UnparsedFile
+}
+
+// manifests maps file names of well-known manifests and lockfiles to their
+// ecosystem. Formats that SCALIBR doesn't support yet are listed too so that
+// they show up in the report.
+var manifests = map[string]string{
+	// Javascript
+	"package.json":        "npm",
+	"package-lock.json":   "npm",
+	"npm-shrinkwrap.json": "npm",
+	"yarn.lock":           "npm",
+	"pnpm-lock.yaml":      "npm",
+	"bun.lock":            "npm",
+	"bun.lockb":           "npm",
+	// Python
+	"requirements.txt": "PyPI",
+	"Pipfile.lock":     "PyPI",
+	"poetry.lock":      "PyPI",
+	"pdm.lock":         "PyPI",
+	"uv.lock":          "PyPI",
+	// Go
+	"go.mod": "Go",
+	// Rust
+	"Cargo.lock": "crates.io",
+	// Java
+	"pom.xml":                     "Maven",
+	"gradle.lockfile":             "Maven",
+	"buildscript-gradle.lockfile": "Maven",
+	"verification-metadata.xml":   "Maven",
+	// Ruby
+	"Gemfile.lock": "RubyGems",
+	// PHP
+	"composer.lock": "Packagist",
+	// .NET
+	"packages.lock.json": "NuGet",
+	"packages.config":    "NuGet",
+	// Dart
+	"pubspec.lock": "Pub",
+	// Elixir
+	"mix.lock": "Hex",
+	// R
+	"renv.lock": "CRAN",
+	// C/C++
+	"conan.lock": "ConanCenter",
+	// Swift
+	"Package.resolved": "SwiftURL",
+	"Podfile.lock":     "CocoaPods",
+}
+
+// manifestSuffixes maps file name suffixes of well-known manifests to their
+// ecosystem, for formats whose file names contain the project name.
+var manifestSuffixes = map[string]string{
+	".deps.json": "NuGet",
+}
+
+// Ecosystem returns the ecosystem of the manifest or lockfile at path, or ""
+// if the path doesn't look like one.
+func Ecosystem(path string) string {
+	base := filepath.Base(path)
+	if e, ok := manifests[base]; ok {
+		return e
+	}
+	for suffix, e := range manifestSuffixes {
+		if strings.HasSuffix(base, suffix) {
+			return e
+		}
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage_test
+
+import (
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/coverage"
+)
+
+func TestEcosystem(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "package-lock.json", want: "npm"},
+		{path: "app/node_modules/foo/package.json", want: "npm"},
+		{path: "srv/uv.lock", want: "PyPI"},
+		{path: "src/go.mod", want: "Go"},
+		{path: "bin/App.deps.json", want: "NuGet"},
+		{path: "ios/Podfile.lock", want: "CocoaPods"},
+		{path: "package-lock.json.bak", want: ""},
+		{path: "README.md", want: ""},
+		{path: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := coverage.Ecosystem(tt.path); got != tt.want {
+				t.Errorf("Ecosystem(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -17,21 +17,33 @@ package filesystem
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime/debug"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/gobwas/glob"
 	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/cache"
+	"github.com/google/osv-scalibr/extractor/filesystem/coverage"
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal"
+	"github.com/google/osv-scalibr/extractor/filesystem/quarantine"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/extractor/filesystem/throttle"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/plugin/budget"
 	"github.com/google/osv-scalibr/stats"
 )
 
@@ -59,6 +71,17 @@ type FileAPI interface {
 	// Stat returns the file info for the file.
 	Stat() (fs.FileInfo, error)
 	Path() string
+	// ContentType returns the type of the file identified from its header,
+	// extension and path. The header is only read once per file and shared
+	// between extractors.
+	ContentType() (filetype.Type, error)
+}
+
+// InventorySink receives the inventories of an extraction run as soon as an
+// extractor returns them, e.g. to write them out incrementally instead of
+// holding the inventories of the whole scan in memory.
+type InventorySink interface {
+	AddInventory(i *extractor.Inventory) error
 }
 
 // ScanInput describes one file to extract from.
@@ -106,12 +129,44 @@ type Config struct {
 	PrintDurationAnalysis bool
 	// Optional: If true, fail the scan if any permission errors are encountered.
 	ErrorOnFSErrors bool
+	// Optional: If set, known manifests and lockfiles that none of the
+	// extractors could parse are added to this report.
+	Coverage *coverage.Report
+	// Optional: If set, all regular files visited by the walk are also queued
+	// for secret scanning. Files the scanner wants are read once and their
+	// contents are passed to both the extractors and the scanner. The caller
+	// is responsible for closing the scanner.
+	Secrets *secrets.Scanner
+	// Optional: If set, files that made an extractor panic are added to this
+	// report. Panics are recovered and reported as extraction errors either way.
+	Quarantine *quarantine.Report
+	// Optional: Number of times an extractor that panicked is run again on the
+	// same file before the file is quarantined.
+	ExtractorRetries int
+	// Optional: If set, a reproduction bundle with the path, extractor and
+	// stack trace is written to this directory for each quarantined file.
+	QuarantineBundleDir string
+	// Optional: If set, the filesystem operations and reads of the walk,
+	// extractors and secret scanning are throttled and extractors are paced to
+	// the configured CPU share. The scan can be paused through it.
+	Throttle *throttle.Throttler
+	// Optional: If set, extractors are only run on files whose modification
+	// time, size or contents changed since their results were cached. The
+	// caller is responsible for closing the cache.
+	Cache cache.Cache
+	// Optional: If set, the inventories are passed to this sink as they're
+	// found instead of being returned by Run. The walk is aborted if the sink
+	// returns an error.
+	InventorySink InventorySink
+	// Optional: If set, each extractor is cut off once it exceeds its budget
+	// of wall time, files and bytes read.
+	Budgets *budget.Budgets
 }
 
 // Run runs the specified extractors and returns their extraction results,
 // as well as info about whether the plugin runs completed successfully.
 func Run(ctx context.Context, config *Config) ([]*extractor.Inventory, []*plugin.Status, error) {
-	if len(config.Extractors) == 0 {
+	if len(config.Extractors) == 0 && config.Secrets == nil {
 		return []*extractor.Inventory{}, []*plugin.Status{}, nil
 	}
 
@@ -161,6 +216,9 @@ func runOnScanRoot(ctx context.Context, config *Config, scanRoot *scalibrfs.Scan
 // are expected to be relative to the scan root.
 // This function is exported for TESTS ONLY.
 func InitWalkContext(ctx context.Context, config *Config, absScanRoots []*scalibrfs.ScanRoot) (*walkContext, error) {
+	if config.Cache != nil {
+		registerMetadataTypes(config.Extractors)
+	}
 	filesToExtract, err := stripAllPathPrefixes(config.FilesToExtract, absScanRoots)
 	if err != nil {
 		return nil, err
@@ -183,6 +241,15 @@ func InitWalkContext(ctx context.Context, config *Config, absScanRoots []*scalib
 		inodesVisited:     0,
 		storeAbsolutePath: config.StoreAbsolutePath,
 		errorOnFSErrors:   config.ErrorOnFSErrors,
+		coverage:          config.Coverage,
+		secrets:           config.Secrets,
+		quarantine:        config.Quarantine,
+		extractorRetries:  config.ExtractorRetries,
+		bundleDir:         config.QuarantineBundleDir,
+		throttle:          config.Throttle,
+		cache:             config.Cache,
+		sink:              config.InventorySink,
+		usage:             newUsage(config.Budgets, config.Extractors),
 
 		lastStatus: time.Now(),
 
@@ -227,13 +294,34 @@ func RunFS(ctx context.Context, config *Config, wc *walkContext) ([]*extractor.I
 
 		close(quit)
 	}
+	if err == nil {
+		err = wc.sinkErr
+	}
 
 	// On Windows, elapsed and wall time are probably the same. On Linux and Mac they are different,
 	// if Scalibr was suspended during runtime.
 	log.Infof("End status: %d dirs visited, %d inodes visited, %d Extract calls, %s elapsed, %s wall time",
 		wc.dirsVisited, wc.inodesVisited, wc.extractCalls, time.Since(start), time.Duration(time.Now().UnixNano()-start.UnixNano()))
+	if wc.cache != nil {
+		log.Infof("Extraction cache: %d hits", wc.cacheHits)
+	}
 
-	return wc.inventory, errToExtractorStatus(config.Extractors, wc.foundInv, wc.errors), err
+	status := errToExtractorStatus(config.Extractors, wc.foundInv, wc.errors)
+	for _, s := range status {
+		s.BudgetExceeded = string(wc.usage[s.Name].Exceeded())
+	}
+	return wc.inventory, status, err
+}
+
+// newUsage returns the usage trackers of the extractors that have a budget.
+func newUsage(budgets *budget.Budgets, extractors []Extractor) map[string]*budget.Usage {
+	usage := make(map[string]*budget.Usage)
+	for _, ex := range extractors {
+		if u := budgets.NewUsage(ex.Name()); u != nil {
+			usage[ex.Name()] = u
+		}
+	}
+	return usage
 }
 
 type walkContext struct {
@@ -260,6 +348,24 @@ type walkContext struct {
 	foundInv map[string]bool
 	// Whether to read symlinks.
 	readSymlinks bool
+	// Unparsed manifests and lockfiles are recorded here if non-nil.
+	coverage *coverage.Report
+	// Files are queued for secret scanning here if non-nil.
+	secrets *secrets.Scanner
+	// Files that made an extractor panic are recorded here if non-nil.
+	quarantine       *quarantine.Report
+	extractorRetries int
+	bundleDir        string
+	// Resource usage is limited by this throttler if non-nil.
+	throttle *throttle.Throttler
+	// Extraction results are looked up in and stored to this cache if non-nil.
+	cache     cache.Cache
+	cacheHits int
+	// Inventories are passed to this sink instead of being stored if non-nil.
+	sink    InventorySink
+	sinkErr error
+	// The resources used by the extractors with a budget, keyed by name.
+	usage map[string]*budget.Usage
 
 	// Data for status printing.
 	lastStatus   time.Time
@@ -298,6 +404,9 @@ func (wc *walkContext) handleFile(path string, d fs.DirEntry, fserr error) error
 	if wc.ctx.Err() != nil {
 		return wc.ctx.Err()
 	}
+	if wc.sinkErr != nil {
+		return wc.sinkErr
+	}
 	if fserr != nil {
 		if wc.errorOnFSErrors {
 			return fmt.Errorf("handleFile(%q) fserr: %w", path, fserr)
@@ -332,21 +441,118 @@ func (wc *walkContext) handleFile(path string, d fs.DirEntry, fserr error) error
 
 	wc.fileAPI.currentPath = path
 	wc.fileAPI.currentStatCalled = false
-
+	wc.fileAPI.currentTypeCalled = false
+	wc.fileAPI.currentType = filetype.Unknown
+	wc.fileAPI.currentHeader = nil
+	wc.fileAPI.currentDigestCalled = false
+	wc.fileAPI.currentContent = nil
+	wc.fileAPI.currentContentLoaded = false
+
+	if wc.secrets != nil {
+		// Read the file once for the extractors and the secret scanner.
+		wc.fileAPI.load(wc.secrets.Wants)
+	}
+
+	parsed := false
+	extracted := false
+	cutOff := false
+	var errs []error
+	start := time.Now()
 	for _, ex := range wc.extractors {
+		if wc.usage[ex.Name()].Exceeded() != "" {
+			// The extractor was cut off. It's only asked about the file for the
+			// coverage report.
+			if wc.coverage != nil && !cutOff && ex.FileRequired(wc.fileAPI) {
+				cutOff = true
+			}
+			continue
+		}
 		if ex.FileRequired(wc.fileAPI) {
-			wc.runExtractor(ex, path)
+			extracted = true
+			if err := wc.runExtractor(ex, path); err != nil {
+				if wc.usage[ex.Name()].Exceeded() != "" {
+					// The extractor was cut off while trying to parse the file.
+					cutOff = true
+				} else {
+					errs = append(errs, fmt.Errorf("%s: %w", ex.Name(), err))
+				}
+			} else {
+				parsed = true
+			}
 		}
 	}
+	if wc.throttle != nil && extracted {
+		if err := wc.throttle.Pace(wc.ctx, time.Since(start)); err != nil {
+			return err
+		}
+	}
+	if wc.coverage != nil && !parsed {
+		wc.recordUnparsed(path, errs, cutOff)
+	}
+	if wc.secrets != nil {
+		wc.queueSecretScan(path)
+	}
 	return nil
 }
 
+// queueSecretScan queues the file at path for secret scanning if its
+// contents were loaded for the scanner. Files the scanner isn't interested in
+// aren't read beyond their header.
+func (wc *walkContext) queueSecretScan(path string) {
+	if !wc.fileAPI.currentContentLoaded {
+		return
+	}
+	location := path
+	if wc.storeAbsolutePath {
+		location = filepath.Join(wc.scanRoot, path)
+	}
+	wc.secrets.Scan(path, location, wc.fileAPI.currentContent)
+}
+
+// recordUnparsed adds the file to the coverage report if it's a known
+// manifest or lockfile. errs are the errors of the extractors that tried to
+// parse it and cutOff is whether extractors that would have parsed it were
+// skipped because they exceeded their budget.
+func (wc *walkContext) recordUnparsed(path string, errs []error, cutOff bool) {
+	ecosystem := coverage.Ecosystem(path)
+	if ecosystem == "" {
+		return
+	}
+	f := &coverage.UnparsedFile{
+		Path:      path,
+		Ecosystem: ecosystem,
+		Reason:    coverage.ReasonNoExtractor,
+	}
+	if wc.storeAbsolutePath {
+		f.Path = filepath.Join(wc.scanRoot, path)
+	}
+	if len(errs) > 0 {
+		f.Reason = coverage.ReasonExtractionError
+		f.Error = errors.Join(errs...).Error()
+	} else if cutOff {
+		f.Reason = coverage.ReasonBudgetExceeded
+	}
+	wc.coverage.UnparsedFiles = append(wc.coverage.UnparsedFiles, f)
+}
+
 type lazyFileAPI struct {
 	fs                scalibrfs.FS
 	currentPath       string
 	currentFileInfo   fs.FileInfo
 	currentStatErr    error
 	currentStatCalled bool
+	currentType       filetype.Type
+	currentTypeErr    error
+	currentTypeCalled bool
+	// The leading bytes read to detect the content type.
+	currentHeader []byte
+	// The contents of the file, only loaded if the secret scanner wants them.
+	currentContent       []byte
+	currentContentLoaded bool
+	// The SHA-256 digest of the file is only computed for the cache.
+	currentDigest       string
+	currentDigestErr    error
+	currentDigestCalled bool
 }
 
 func (api *lazyFileAPI) Path() string {
@@ -359,6 +565,77 @@ func (api *lazyFileAPI) Stat() (fs.FileInfo, error) {
 	}
 	return api.currentFileInfo, api.currentStatErr
 }
+func (api *lazyFileAPI) ContentType() (filetype.Type, error) {
+	if !api.currentTypeCalled {
+		api.currentTypeCalled = true
+		api.currentHeader, api.currentTypeErr = filetype.ReadHeader(api.fs, api.currentPath)
+		if api.currentTypeErr == nil && len(api.currentHeader) > 0 {
+			// Empty files have no content type, not the one of their extension.
+			api.currentType = filetype.Detect(api.currentPath, api.currentHeader)
+		}
+	}
+	return api.currentType, api.currentTypeErr
+}
+
+// load opens the file once to detect its content type and, if want returns
+// true for its size and type, reads its contents. The contents are shared by
+// the extractors, the cache digest and the secret scanner, so the file isn't
+// read again.
+func (api *lazyFileAPI) load(want func(size int64, typ filetype.Type) bool) {
+	info, err := api.Stat()
+	if err != nil {
+		return
+	}
+	f, err := api.fs.Open(api.currentPath)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	api.currentTypeCalled = true
+	api.currentHeader, api.currentTypeErr = filetype.ReadHeaderFrom(f)
+	if api.currentTypeErr != nil {
+		return
+	}
+	if len(api.currentHeader) > 0 {
+		api.currentType = filetype.Detect(api.currentPath, api.currentHeader)
+	}
+	if !want(info.Size(), api.currentType) {
+		return
+	}
+	rest, err := io.ReadAll(f)
+	if err != nil {
+		return
+	}
+	api.currentContent = append(slices.Clip(api.currentHeader), rest...)
+	api.currentContentLoaded = true
+}
+
+// digest returns the hex-encoded SHA-256 digest of the file contents.
+func (api *lazyFileAPI) digest() (string, error) {
+	if !api.currentDigestCalled {
+		api.currentDigestCalled = true
+		if api.currentContentLoaded {
+			sum := sha256.Sum256(api.currentContent)
+			api.currentDigest = hex.EncodeToString(sum[:])
+		} else {
+			api.currentDigest, api.currentDigestErr = fileDigest(api.fs, api.currentPath)
+		}
+	}
+	return api.currentDigest, api.currentDigestErr
+}
+
+func fileDigest(fsys scalibrfs.FS, path string) (string, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
 
 func (wc *walkContext) shouldSkipDir(path string) bool {
 	if _, ok := wc.dirsToSkip[path]; ok {
@@ -373,44 +650,217 @@ func (wc *walkContext) shouldSkipDir(path string) bool {
 	return false
 }
 
-func (wc *walkContext) runExtractor(ex Extractor, path string) {
-	rc, err := wc.fs.Open(path)
-	if err != nil {
-		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("Open(%s): %v", path, err))
-		return
+// runExtractor runs ex on the file at path and returns the extraction error,
+// if any. If ex panics, it's retried up to extractorRetries times before the
+// file is quarantined.
+func (wc *walkContext) runExtractor(ex Extractor, path string) error {
+	key := wc.cacheKey(ex, path)
+	if key != nil {
+		if results, ok := wc.cache.Get(key); ok {
+			wc.cacheHits++
+			wc.addResults(ex, results)
+			return nil
+		}
 	}
-	defer rc.Close()
+	for attempt := 1; ; attempt++ {
+		p, err := wc.runExtractorOnce(ex, path, key)
+		if p == nil {
+			return err
+		}
+		if attempt <= wc.extractorRetries {
+			log.Warnf("Extractor %s panicked on %s, retrying: %v", ex.Name(), path, p.value)
+			continue
+		}
+		log.Errorf("Extractor %s panicked on %s, quarantining the file: %v", ex.Name(), path, p.value)
+		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("%s: %w", path, err))
+		wc.quarantineFile(ex, path, attempt, p)
+		return err
+	}
+}
 
-	info, err := rc.Stat()
-	if err != nil {
-		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("stat(%s): %v", path, err))
-		return
+// extractorPanic is a recovered panic of an extractor.
+type extractorPanic struct {
+	value any
+	stack []byte
+}
+
+// runExtractorOnce runs ex on the file at path once and returns the
+// recovered panic and the extraction error, if any. The results of a
+// successful run are stored in the cache under key if it's non-nil.
+func (wc *walkContext) runExtractorOnce(ex Extractor, path string, key *cache.Key) (*extractorPanic, error) {
+	usage := wc.usage[ex.Name()]
+	fsys := usage.FS(wc.fs)
+	var reader io.Reader
+	var info fs.FileInfo
+	if wc.fileAPI.currentContentLoaded && wc.fileAPI.Path() == path {
+		// The file was already read for the secret scanner.
+		r, err := usage.Contents(wc.fileAPI.currentContent)
+		if err != nil {
+			err = fmt.Errorf("Open(%s): %v", path, err)
+			addErrToMap(wc.errors, ex.Name(), err)
+			return nil, err
+		}
+		reader = r
+		info = wc.fileAPI.currentFileInfo
+	} else {
+		rc, err := fsys.Open(path)
+		if err != nil {
+			err = fmt.Errorf("Open(%s): %v", path, err)
+			addErrToMap(wc.errors, ex.Name(), err)
+			return nil, err
+		}
+		defer rc.Close()
+
+		info, err = rc.Stat()
+		if err != nil {
+			err = fmt.Errorf("stat(%s): %v", path, err)
+			addErrToMap(wc.errors, ex.Name(), err)
+			return nil, err
+		}
+		reader = rc
 	}
 
 	wc.extractCalls++
 
 	start := time.Now()
-	results, err := ex.Extract(wc.ctx, &ScanInput{
-		FS:     wc.fs,
+	input := &ScanInput{
+		FS:     fsys,
 		Path:   path,
 		Root:   wc.scanRoot,
 		Info:   info,
-		Reader: rc,
+		Reader: reader,
+	}
+	r, err := budget.Run(wc.ctx, usage, func(ctx context.Context) (*extraction, error) {
+		results, p, err := extractRecovered(ctx, ex, input)
+		return &extraction{results: results, p: p}, err
 	})
+	var results []*extractor.Inventory
+	var p *extractorPanic
+	if r != nil {
+		results, p = r.results, r.p
+	}
 	wc.stats.AfterExtractorRun(ex.Name(), time.Since(start), err)
 
-	if err != nil {
+	// Panics are only recorded as an error once the retries are used up.
+	if err != nil && p == nil {
 		addErrToMap(wc.errors, ex.Name(), fmt.Errorf("%s: %w", path, err))
 	}
 
-	if len(results) > 0 {
-		wc.foundInv[ex.Name()] = true
-		for _, r := range results {
-			r.Extractor = ex
-			if wc.storeAbsolutePath {
-				r.Locations = expandAbsolutePath(wc.scanRoot, r.Locations)
-			}
+	// Failed runs aren't cached so that their errors are reported again.
+	if key != nil && err == nil {
+		if err := wc.cache.Put(key, results); err != nil {
+			log.Warnf("Failed to cache results of %s on %s: %v", ex.Name(), path, err)
+		}
+	}
+
+	wc.addResults(ex, results)
+	return p, err
+}
+
+// addResults adds the inventories ex found to the results of the walk.
+func (wc *walkContext) addResults(ex Extractor, results []*extractor.Inventory) {
+	if len(results) == 0 {
+		return
+	}
+	wc.foundInv[ex.Name()] = true
+	for _, r := range results {
+		r.Extractor = ex
+		if wc.storeAbsolutePath {
+			r.Locations = expandAbsolutePath(wc.scanRoot, r.Locations)
+		}
+		if wc.sink == nil {
 			wc.inventory = append(wc.inventory, r)
+		} else if wc.sinkErr == nil {
+			if err := wc.sink.AddInventory(r); err != nil {
+				wc.sinkErr = fmt.Errorf("InventorySink.AddInventory(): %w", err)
+			}
+		}
+	}
+}
+
+// cacheKey returns the key the results of ex on the file at path are cached
+// under, or nil if there's no cache or the file can't be read.
+func (wc *walkContext) cacheKey(ex Extractor, path string) *cache.Key {
+	if wc.cache == nil {
+		return nil
+	}
+	info, err := wc.fileAPI.Stat()
+	if err != nil || wc.fileAPI.Path() != path {
+		return nil
+	}
+	digest, err := wc.fileAPI.digest()
+	if err != nil {
+		return nil
+	}
+	return &cache.Key{
+		Root:             wc.scanRoot,
+		Path:             path,
+		Extractor:        ex.Name(),
+		ExtractorVersion: ex.Version(),
+		ModTime:          info.ModTime(),
+		Size:             info.Size(),
+		Digest:           digest,
+	}
+}
+
+// registerMetadataTypes registers the metadata types of the extractors that
+// document them so that their cached results can be decoded before they
+// were first stored in this process.
+func registerMetadataTypes(extractors []Extractor) {
+	for _, ex := range extractors {
+		if d, ok := ex.(plugin.Documenter); ok {
+			if doc := d.Documentation(); doc != nil {
+				cache.RegisterMetadata(doc.Metadata)
+			}
+		}
+	}
+}
+
+// extraction is the result of an extractor run.
+type extraction struct {
+	results []*extractor.Inventory
+	p       *extractorPanic
+}
+
+// extractRecovered calls ex.Extract and turns a panic into an error. The
+// results of a call that panicked are discarded.
+func extractRecovered(ctx context.Context, ex Extractor, input *ScanInput) (results []*extractor.Inventory, p *extractorPanic, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			p = &extractorPanic{value: r, stack: debug.Stack()}
+			results = nil
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	results, err = ex.Extract(ctx, input)
+	return results, nil, err
+}
+
+// quarantineFile records that ex panicked on the file at path.
+func (wc *walkContext) quarantineFile(ex Extractor, path string, attempts int, p *extractorPanic) {
+	if wc.quarantine == nil && wc.bundleDir == "" {
+		return
+	}
+	f := &quarantine.File{
+		Path:             path,
+		Extractor:        ex.Name(),
+		ExtractorVersion: ex.Version(),
+		Attempts:         attempts,
+		Panic:            fmt.Sprint(p.value),
+		Stack:            string(p.stack),
+	}
+	if wc.storeAbsolutePath {
+		f.Path = filepath.Join(wc.scanRoot, path)
+	}
+	if wc.quarantine != nil {
+		wc.quarantine.Files = append(wc.quarantine.Files, f)
+	}
+	if wc.bundleDir != "" {
+		bundle, err := quarantine.WriteBundle(wc.bundleDir, f)
+		if err != nil {
+			log.Errorf("Failed to write reproduction bundle for %s: %v", path, err)
+		} else {
+			log.Infof("Wrote reproduction bundle to %s", bundle)
 		}
 	}
 }
@@ -419,6 +869,9 @@ func (wc *walkContext) runExtractor(ex Extractor, path string) {
 // currentRoot is expected to be an absolute path.
 func (wc *walkContext) UpdateScanRoot(absRoot string, fs scalibrfs.FS) error {
 	wc.scanRoot = absRoot
+	if wc.throttle != nil {
+		fs = wc.throttle.FS(wc.ctx, fs)
+	}
 	wc.fs = fs
 	wc.fileAPI.fs = fs
 	return nil
@@ -26,6 +26,7 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strings"
 	"testing"
 	"testing/fstest"
 	"time"
@@ -35,10 +36,18 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/cache"
+	"github.com/google/osv-scalibr/extractor/filesystem/coverage"
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
+	"github.com/google/osv-scalibr/extractor/filesystem/quarantine"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/extractor/filesystem/throttle"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/stats"
 	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
 )
 
 // pathsMapFS provides a hooked version of MapFS that forces slashes. Because depending on the
@@ -713,3 +722,440 @@ func TestRunFS_ReadError(t *testing.T) {
 		t.Errorf("extractor.Run(%v): unexpected status (-want +got):\n%s", ex, diff)
 	}
 }
+
+func TestRunFS_Coverage(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		"app/package-lock.json":    {Data: []byte("{}")},
+		"app/yarn.lock":            {Data: []byte("corrupt")},
+		"app/bun.lockb":            {Data: []byte("binary")},
+		"app/README.md":            {Data: []byte("readme")},
+		"app/sub/requirements.txt": {Data: []byte("foo==1.0")},
+	}}
+	errCorrupt := errors.New("corrupt lockfile")
+	ex := []filesystem.Extractor{
+		fe.New("ex1", 1, []string{"app/package-lock.json", "app/yarn.lock", "app/sub/requirements.txt"},
+			map[string]fe.NamesErr{
+				"app/package-lock.json":    {Names: []string{"left-pad"}},
+				"app/yarn.lock":            {Err: errCorrupt},
+				"app/sub/requirements.txt": {Names: []string{"foo"}},
+			}),
+		fe.New("ex2", 1, []string{"app/sub/requirements.txt"},
+			map[string]fe.NamesErr{
+				"app/sub/requirements.txt": {Err: errCorrupt},
+			}),
+	}
+	report := &coverage.Report{}
+	config := &filesystem.Config{
+		Extractors: ex,
+		ScanRoots: []*scalibrfs.ScanRoot{{
+			FS: fsys, Path: ".",
+		}},
+		Stats:    stats.NoopCollector{},
+		Coverage: report,
+	}
+	wc, err := filesystem.InitWalkContext(context.Background(), config, config.ScanRoots)
+	if err != nil {
+		t.Fatalf("filesystem.InitializeWalkContext(%v): %v", config, err)
+	}
+	if err := wc.UpdateScanRoot(".", fsys); err != nil {
+		t.Fatalf("wc.UpdateScanRoot(%v): %v", config, err)
+	}
+	if _, _, err := filesystem.RunFS(context.Background(), config, wc); err != nil {
+		t.Fatalf("extractor.Run(%v): %v", ex, err)
+	}
+
+	want := []*coverage.UnparsedFile{
+		{
+			Path:      "app/bun.lockb",
+			Ecosystem: "npm",
+			Reason:    coverage.ReasonNoExtractor,
+		},
+		{
+			Path:      "app/yarn.lock",
+			Ecosystem: "npm",
+			Reason:    coverage.ReasonExtractionError,
+			Error:     "ex1: corrupt lockfile",
+		},
+	}
+	less := func(a, b *coverage.UnparsedFile) bool { return a.Path < b.Path }
+	if diff := cmp.Diff(want, report.UnparsedFiles, cmpopts.SortSlices(less)); diff != "" {
+		t.Errorf("extractor.Run(%v): unexpected coverage report (-want +got):\n%s", ex, diff)
+	}
+}
+
+// panickingExtractor panics when extracting from panicPath.
+type panickingExtractor struct {
+	filesystem.Extractor
+	panicPath string
+	calls     int
+}
+
+func (e *panickingExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	if input.Path == e.panicPath {
+		e.calls++
+		panic("malformed file")
+	}
+	return e.Extractor.Extract(ctx, input)
+}
+
+func TestRunFS_Quarantine(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		"bad.json":  {Data: []byte("bad")},
+		"good.json": {Data: []byte("good")},
+	}}
+	panicking := &panickingExtractor{
+		Extractor: fe.New("ex1", 1, []string{"bad.json", "good.json"},
+			map[string]fe.NamesErr{"good.json": {Names: []string{"software1"}}}),
+		panicPath: "bad.json",
+	}
+	ex := []filesystem.Extractor{
+		panicking,
+		fe.New("ex2", 2, []string{"bad.json"},
+			map[string]fe.NamesErr{"bad.json": {Names: []string{"software2"}}}),
+	}
+	report := &quarantine.Report{}
+	bundleDir := t.TempDir()
+	config := &filesystem.Config{
+		Extractors: ex,
+		ScanRoots: []*scalibrfs.ScanRoot{{
+			FS: fsys, Path: ".",
+		}},
+		Stats:               stats.NoopCollector{},
+		Quarantine:          report,
+		ExtractorRetries:    2,
+		QuarantineBundleDir: bundleDir,
+	}
+	wc, err := filesystem.InitWalkContext(context.Background(), config, config.ScanRoots)
+	if err != nil {
+		t.Fatalf("filesystem.InitializeWalkContext(%v): %v", config, err)
+	}
+	if err := wc.UpdateScanRoot(".", fsys); err != nil {
+		t.Fatalf("wc.UpdateScanRoot(%v): %v", config, err)
+	}
+	gotInv, gotStatus, err := filesystem.RunFS(context.Background(), config, wc)
+	if err != nil {
+		t.Fatalf("extractor.Run(%v): %v", ex, err)
+	}
+
+	var gotNames []string
+	for _, i := range gotInv {
+		gotNames = append(gotNames, i.Name)
+	}
+	sort.Strings(gotNames)
+	if diff := cmp.Diff([]string{"software1", "software2"}, gotNames); diff != "" {
+		t.Errorf("extractor.Run(%v): unexpected inventory names (-want +got):\n%s", ex, diff)
+	}
+	wantStatus := []*plugin.Status{
+		{Name: "ex1", Version: 1, Status: &plugin.ScanStatus{
+			Status: plugin.ScanStatusPartiallySucceeded, FailureReason: "bad.json: panic: malformed file",
+		}},
+		{Name: "ex2", Version: 2, Status: &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded}},
+	}
+	sortStatus := func(a, b *plugin.Status) bool { return a.Name < b.Name }
+	if diff := cmp.Diff(wantStatus, gotStatus, cmpopts.SortSlices(sortStatus)); diff != "" {
+		t.Errorf("extractor.Run(%v): unexpected status (-want +got):\n%s", ex, diff)
+	}
+	if panicking.calls != 3 {
+		t.Errorf("extractor.Run(%v): panicking extractor called %d times, want 3", ex, panicking.calls)
+	}
+
+	want := []*quarantine.File{{
+		Path:             "bad.json",
+		Extractor:        "ex1",
+		ExtractorVersion: 1,
+		Attempts:         3,
+		Panic:            "malformed file",
+	}}
+	if diff := cmp.Diff(want, report.Files, cmpopts.IgnoreFields(quarantine.File{}, "Stack")); diff != "" {
+		t.Errorf("extractor.Run(%v): unexpected quarantine report (-want +got):\n%s", ex, diff)
+	}
+	if len(report.Files) == 1 && report.Files[0].Stack == "" {
+		t.Errorf("extractor.Run(%v): quarantined file has no stack trace", ex)
+	}
+	bundles, err := os.ReadDir(bundleDir)
+	if err != nil {
+		t.Fatalf("os.ReadDir(%q): %v", bundleDir, err)
+	}
+	if len(bundles) != 1 {
+		t.Errorf("extractor.Run(%v): got %d reproduction bundles, want 1", ex, len(bundles))
+	}
+}
+
+func TestRunFS_Throttle(t *testing.T) {
+	fsys := pathsMapFS{mapfs: fstest.MapFS{
+		"dir/file.json": {Data: []byte("{}")},
+	}}
+	ex := []filesystem.Extractor{
+		fe.New("ex1", 1, []string{"dir/file.json"},
+			map[string]fe.NamesErr{"dir/file.json": {Names: []string{"software1"}}}),
+	}
+	th := throttle.New(throttle.Config{MaxIOPS: 1000, MaxBytesPerSecond: 1000, CPUShare: 0.5})
+	config := &filesystem.Config{
+		Extractors: ex,
+		ScanRoots: []*scalibrfs.ScanRoot{{
+			FS: fsys, Path: ".",
+		}},
+		Stats:    stats.NoopCollector{},
+		Throttle: th,
+	}
+	wc, err := filesystem.InitWalkContext(context.Background(), config, config.ScanRoots)
+	if err != nil {
+		t.Fatalf("filesystem.InitializeWalkContext(%v): %v", config, err)
+	}
+	if err := wc.UpdateScanRoot(".", fsys); err != nil {
+		t.Fatalf("wc.UpdateScanRoot(%v): %v", config, err)
+	}
+
+	th.Pause()
+	type result struct {
+		inv []*extractor.Inventory
+		err error
+	}
+	done := make(chan result)
+	go func() {
+		inv, _, err := filesystem.RunFS(context.Background(), config, wc)
+		done <- result{inv, err}
+	}()
+	select {
+	case <-done:
+		t.Fatal("extractor.Run() returned while the scan was paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	th.Resume()
+	r := <-done
+	if r.err != nil {
+		t.Fatalf("extractor.Run(%v): %v", ex, r.err)
+	}
+	var gotNames []string
+	for _, i := range r.inv {
+		gotNames = append(gotNames, i.Name)
+	}
+	if diff := cmp.Diff([]string{"software1"}, gotNames); diff != "" {
+		t.Errorf("extractor.Run(%v): unexpected inventory names (-want +got):\n%s", ex, diff)
+	}
+}
+
+// elfExtractor requires files identified as ELF binaries.
+type elfExtractor struct {
+	filesystem.Extractor
+}
+
+func (e elfExtractor) FileRequired(api filesystem.FileAPI) bool {
+	t, err := api.ContentType()
+	return err == nil && t == filetype.ELF
+}
+
+// openCountingFS counts how often each file is opened.
+type openCountingFS struct {
+	pathsMapFS
+	opens map[string]int
+}
+
+func (fsys openCountingFS) Open(name string) (fs.File, error) {
+	fsys.opens[filepath.ToSlash(name)]++
+	return fsys.pathsMapFS.Open(name)
+}
+
+func TestRunFS_ContentType(t *testing.T) {
+	fsys := openCountingFS{
+		pathsMapFS: pathsMapFS{mapfs: fstest.MapFS{
+			"usr/bin/tool":   {Data: []byte("\x7fELF\x02\x01\x01\x00")},
+			"usr/bin/script": {Data: []byte("#!/bin/sh\n")},
+		}},
+		opens: map[string]int{},
+	}
+	ex := []filesystem.Extractor{
+		elfExtractor{fe.New("ex1", 1, nil, map[string]fe.NamesErr{"usr/bin/tool": {Names: []string{"software1"}}})},
+		elfExtractor{fe.New("ex2", 1, nil, map[string]fe.NamesErr{"usr/bin/tool": {Names: []string{"software2"}}})},
+	}
+	config := &filesystem.Config{
+		Extractors: ex,
+		ScanRoots: []*scalibrfs.ScanRoot{{
+			FS: fsys, Path: ".",
+		}},
+		Stats: stats.NoopCollector{},
+	}
+	wc, err := filesystem.InitWalkContext(context.Background(), config, config.ScanRoots)
+	if err != nil {
+		t.Fatalf("filesystem.InitializeWalkContext(%v): %v", config, err)
+	}
+	if err := wc.UpdateScanRoot(".", fsys); err != nil {
+		t.Fatalf("wc.UpdateScanRoot(%v): %v", config, err)
+	}
+	inv, _, err := filesystem.RunFS(context.Background(), config, wc)
+	if err != nil {
+		t.Fatalf("extractor.Run(%v): %v", ex, err)
+	}
+
+	var gotNames []string
+	for _, i := range inv {
+		gotNames = append(gotNames, i.Name)
+	}
+	if diff := cmp.Diff([]string{"software1", "software2"}, gotNames); diff != "" {
+		t.Errorf("extractor.Run(%v): unexpected inventory names (-want +got):\n%s", ex, diff)
+	}
+	// The header is read once for both extractors, then each extractor opens
+	// the file it extracts from. Directories are opened by the walk.
+	wantOpens := map[string]int{
+		".": 1, "usr": 1, "usr/bin": 1,
+		"usr/bin/tool": 3, "usr/bin/script": 1,
+	}
+	if diff := cmp.Diff(wantOpens, fsys.opens); diff != "" {
+		t.Errorf("extractor.Run(%v): unexpected file opens (-want +got):\n%s", ex, diff)
+	}
+}
+
+// readingExtractor records the contents of the files it extracts from.
+type readingExtractor struct {
+	filesystem.Extractor
+	contents map[string]string
+}
+
+func (e *readingExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	b, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	e.contents[input.Path] = string(b)
+	return e.Extractor.Extract(ctx, input)
+}
+
+type fakeSecret struct {
+	Token string
+}
+
+func TestRunFS_SecretsShareRead(t *testing.T) {
+	large := strings.Repeat("x\n", 1000) + "tok_4567cdef"
+	fsys := openCountingFS{
+		pathsMapFS: pathsMapFS{mapfs: fstest.MapFS{
+			"app.json": {Data: []byte(large)},
+			"app.env":  {Data: []byte("TOKEN=tok_0123abcd")},
+			"app.so":   {Data: []byte("\x7fELF\x02\x01\x01\x00tok_89abcdef")},
+		}},
+		opens: map[string]int{},
+	}
+	reading := &readingExtractor{
+		Extractor: fe.New("ex1", 1, []string{"app.json"}, map[string]fe.NamesErr{"app.json": {Names: []string{"software"}}}),
+		contents:  map[string]string{},
+	}
+	scanner, err := secrets.New(context.Background(), secrets.DefaultConfig([]veles.Detector{simpletoken.Detector{
+		MaxLen: 12,
+		Re:     regexp.MustCompile(`tok_[0-9a-f]{8}`),
+		FromMatch: func(b []byte) veles.Secret {
+			return fakeSecret{Token: string(b)}
+		},
+	}}))
+	if err != nil {
+		t.Fatalf("secrets.New(): %v", err)
+	}
+	config := &filesystem.Config{
+		Extractors: []filesystem.Extractor{reading},
+		ScanRoots: []*scalibrfs.ScanRoot{{
+			FS: fsys, Path: ".",
+		}},
+		Stats:   stats.NoopCollector{},
+		Secrets: scanner,
+	}
+	wc, err := filesystem.InitWalkContext(context.Background(), config, config.ScanRoots)
+	if err != nil {
+		t.Fatalf("filesystem.InitializeWalkContext(%v): %v", config, err)
+	}
+	if err := wc.UpdateScanRoot(".", fsys); err != nil {
+		t.Fatalf("wc.UpdateScanRoot(%v): %v", config, err)
+	}
+	if _, _, err := filesystem.RunFS(context.Background(), config, wc); err != nil {
+		t.Fatalf("extractor.Run(): %v", err)
+	}
+
+	var gotTokens []string
+	for _, s := range scanner.Close() {
+		gotTokens = append(gotTokens, s.Secret.(fakeSecret).Token)
+	}
+	if diff := cmp.Diff([]string{"tok_0123abcd", "tok_4567cdef"}, gotTokens); diff != "" {
+		t.Errorf("extractor.Run(): unexpected secrets (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"app.json": large}, reading.contents); diff != "" {
+		t.Errorf("extractor.Run(): unexpected contents read by the extractor (-want +got):\n%s", diff)
+	}
+	// Each file is opened once, by both the extractor and the secret scanner.
+	// Binaries are only read up to their header.
+	wantOpens := map[string]int{".": 1, "app.json": 1, "app.env": 1, "app.so": 1}
+	if diff := cmp.Diff(wantOpens, fsys.opens); diff != "" {
+		t.Errorf("extractor.Run(): unexpected file opens (-want +got):\n%s", diff)
+	}
+}
+
+// countingExtractor counts the files it extracts from.
+type countingExtractor struct {
+	filesystem.Extractor
+	calls []string
+}
+
+func (e *countingExtractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	e.calls = append(e.calls, input.Path)
+	return e.Extractor.Extract(ctx, input)
+}
+
+func TestRunFS_Cache(t *testing.T) {
+	mapfs := fstest.MapFS{
+		"a.json": {Data: []byte("a"), ModTime: time.Unix(1000, 0)},
+		"b.json": {Data: []byte("b"), ModTime: time.Unix(1000, 0)},
+	}
+	fsys := pathsMapFS{mapfs: mapfs}
+	counting := &countingExtractor{
+		Extractor: fe.New("ex1", 1, []string{"a.json", "b.json"}, map[string]fe.NamesErr{
+			"a.json": {Names: []string{"software1"}},
+			"b.json": {Names: []string{"software2"}},
+		}),
+	}
+	c, err := cache.Open(filepath.Join(t.TempDir(), "cache.json"))
+	if err != nil {
+		t.Fatalf("cache.Open(): %v", err)
+	}
+	config := &filesystem.Config{
+		Extractors: []filesystem.Extractor{counting},
+		ScanRoots: []*scalibrfs.ScanRoot{{
+			FS: fsys, Path: ".",
+		}},
+		Stats: stats.NoopCollector{},
+		Cache: c,
+	}
+
+	scan := func(desc string, wantCalls []string) {
+		t.Helper()
+		counting.calls = nil
+		wc, err := filesystem.InitWalkContext(context.Background(), config, config.ScanRoots)
+		if err != nil {
+			t.Fatalf("filesystem.InitializeWalkContext(%v): %v", config, err)
+		}
+		if err := wc.UpdateScanRoot(".", fsys); err != nil {
+			t.Fatalf("wc.UpdateScanRoot(%v): %v", config, err)
+		}
+		gotInv, _, err := filesystem.RunFS(context.Background(), config, wc)
+		if err != nil {
+			t.Fatalf("%s: extractor.Run(): %v", desc, err)
+		}
+
+		want := []*extractor.Inventory{
+			{Name: "software1", Locations: []string{"a.json"}, Extractor: counting},
+			{Name: "software2", Locations: []string{"b.json"}, Extractor: counting},
+		}
+		less := func(a, b *extractor.Inventory) bool { return a.Name < b.Name }
+		if diff := cmp.Diff(want, gotInv, cmpopts.SortSlices(less), cmp.Comparer(func(a, b filesystem.Extractor) bool { return a == b })); diff != "" {
+			t.Errorf("%s: extractor.Run(): unexpected inventory (-want +got):\n%s", desc, diff)
+		}
+		sort.Strings(counting.calls)
+		if diff := cmp.Diff(wantCalls, counting.calls); diff != "" {
+			t.Errorf("%s: extractor.Run(): unexpected Extract calls (-want +got):\n%s", desc, diff)
+		}
+	}
+
+	scan("first scan", []string{"a.json", "b.json"})
+	scan("unchanged files", nil)
+	mapfs["b.json"] = &fstest.MapFile{Data: []byte("B"), ModTime: time.Unix(1000, 0)}
+	scan("modified file", []string{"b.json"})
+	mapfs["a.json"] = &fstest.MapFile{Data: []byte("a"), ModTime: time.Unix(2000, 0)}
+	scan("touched file", []string{"a.json"})
+	scan("unchanged files again", nil)
+}
@@ -0,0 +1,305 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filetype identifies the content type of files from their leading
+// bytes, their extension and the context of their path, similar to libmagic.
+//
+// The file walker reads the header of a file at most once and shares the
+// result with all extractors through filesystem.FileAPI, so extractors don't
+// need to read headers themselves to decide whether they're interested in a
+// file.
+package filetype
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"unicode/utf8"
+)
+
+// HeaderSize is the number of leading bytes of a file needed to identify it.
+// It covers the tar header, whose magic is at offset 257.
+const HeaderSize = 512
+
+// Type is the content type of a file.
+type Type string
+
+// Type values.
+const (
+	Unknown Type = ""
+
+	// Executables and libraries.
+	ELF       Type = "elf"
+	PE        Type = "pe"
+	MachO     Type = "macho"
+	JavaClass Type = "java-class"
+	Script    Type = "script"
+
+	// Archives and packages.
+	Zip         Type = "zip"
+	JAR         Type = "jar"
+	APK         Type = "apk"
+	PythonWheel Type = "python-wheel"
+	Tar         Type = "tar"
+	Ar          Type = "ar"
+	Deb         Type = "deb"
+	RPM         Type = "rpm"
+	SquashFS    Type = "squashfs"
+
+	// Compressed streams.
+	Gzip  Type = "gzip"
+	Bzip2 Type = "bzip2"
+	XZ    Type = "xz"
+	Zstd  Type = "zstd"
+
+	// Data formats.
+	SQLite       Type = "sqlite"
+	JavaKeyStore Type = "jks"
+	PDF          Type = "pdf"
+	PNG          Type = "png"
+	JPEG         Type = "jpeg"
+	GIF          Type = "gif"
+
+	// Text formats.
+	XML  Type = "xml"
+	JSON Type = "json"
+	YAML Type = "yaml"
+	Text Type = "text"
+)
+
+// signature is a magic byte sequence at an offset.
+type signature struct {
+	offset int
+	magic  string
+	typ    Type
+}
+
+// signatures are checked in order, so longer magics come before their
+// prefixes.
+var signatures = []signature{
+	{0, "\x7fELF", ELF},
+	{0, "\xfe\xed\xfa\xce", MachO},
+	{0, "\xfe\xed\xfa\xcf", MachO},
+	{0, "\xce\xfa\xed\xfe", MachO},
+	{0, "\xcf\xfa\xed\xfe", MachO},
+	{0, "PK\x03\x04", Zip},
+	{0, "PK\x05\x06", Zip},
+	{0, "!<arch>\ndebian-binary", Deb},
+	{0, "!<arch>\n", Ar},
+	{0, "\xed\xab\xee\xdb", RPM},
+	{0, "hsqs", SquashFS},
+	{0, "\x1f\x8b", Gzip},
+	{0, "BZh", Bzip2},
+	{0, "\xfd7zXZ\x00", XZ},
+	{0, "\x28\xb5\x2f\xfd", Zstd},
+	{0, "SQLite format 3\x00", SQLite},
+	{0, "\xfe\xed\xfe\xed", JavaKeyStore},
+	{0, "%PDF-", PDF},
+	{0, "\x89PNG\r\n\x1a\n", PNG},
+	{0, "\xff\xd8\xff", JPEG},
+	{0, "GIF87a", GIF},
+	{0, "GIF89a", GIF},
+	{257, "ustar", Tar},
+	{0, "MZ", PE},
+	{0, "#!", Script},
+}
+
+// extensions are the types of files whose header is not available or not
+// recognized, by lowercase extension.
+var extensions = map[string]Type{
+	".so":     ELF,
+	".exe":    PE,
+	".dll":    PE,
+	".sys":    PE,
+	".dylib":  MachO,
+	".class":  JavaClass,
+	".sh":     Script,
+	".py":     Script,
+	".zip":    Zip,
+	".jar":    JAR,
+	".war":    JAR,
+	".ear":    JAR,
+	".jmod":   JAR,
+	".par":    JAR,
+	".sar":    JAR,
+	".jpi":    JAR,
+	".hpi":    JAR,
+	".lpkg":   JAR,
+	".nar":    JAR,
+	".apk":    APK,
+	".whl":    PythonWheel,
+	".tar":    Tar,
+	".deb":    Deb,
+	".rpm":    RPM,
+	".gz":     Gzip,
+	".tgz":    Gzip,
+	".bz2":    Bzip2,
+	".xz":     XZ,
+	".zst":    Zstd,
+	".db":     SQLite,
+	".sqlite": SQLite,
+	".jks":    JavaKeyStore,
+	".pdf":    PDF,
+	".png":    PNG,
+	".jpg":    JPEG,
+	".jpeg":   JPEG,
+	".gif":    GIF,
+	".xml":    XML,
+	".pom":    XML,
+	".json":   JSON,
+	".yaml":   YAML,
+	".yml":    YAML,
+	".txt":    Text,
+}
+
+// Detect returns the content type of the file at path p with the given
+// leading bytes, which should be the first HeaderSize bytes of the file or
+// all of it if it's shorter. If header is empty, the type is guessed from
+// the extension alone.
+func Detect(p string, header []byte) Type {
+	ext := strings.ToLower(path.Ext(p))
+	if len(header) == 0 {
+		return extensions[ext]
+	}
+	for _, s := range signatures {
+		if len(header) >= s.offset+len(s.magic) && string(header[s.offset:s.offset+len(s.magic)]) == s.magic {
+			return refine(s.typ, ext, header)
+		}
+	}
+	// Java class files and Mach-O universal binaries share their magic.
+	if bytes.HasPrefix(header, []byte("\xca\xfe\xba\xbe")) && len(header) >= 8 {
+		// Universal binaries have a small number of architectures where class
+		// files have their major version, which is at least 45.
+		if binary.BigEndian.Uint32(header[4:8]) < 45 {
+			return MachO
+		}
+		return JavaClass
+	}
+	if !isText(header) {
+		return Unknown
+	}
+	return textType(ext, header)
+}
+
+// refine narrows down the type of a file based on its extension and content,
+// e.g. to tell JARs from other zip files.
+func refine(t Type, ext string, header []byte) Type {
+	switch t {
+	case Zip:
+		if e := extensions[ext]; e == JAR || e == APK || e == PythonWheel {
+			return e
+		}
+		return zipType(header)
+	case PE:
+		// "MZ" is common at the start of text files, so check for the offset
+		// of the PE header that follows the DOS stub.
+		if len(header) < 0x40 {
+			return Unknown
+		}
+		off := binary.LittleEndian.Uint32(header[0x3c:0x40])
+		if int(off)+4 <= len(header) && string(header[off:off+4]) != "PE\x00\x00" {
+			return Unknown
+		}
+	}
+	return t
+}
+
+// zipType returns the type of a zip file from the name of its first entry.
+func zipType(header []byte) Type {
+	// The name of the first entry follows the 30 byte local file header.
+	if len(header) < 30 {
+		return Zip
+	}
+	n := int(binary.LittleEndian.Uint16(header[26:28]))
+	end := min(30+n, len(header))
+	name := string(header[30:end])
+	switch {
+	case strings.HasPrefix(name, "META-INF/"):
+		return JAR
+	case name == "AndroidManifest.xml" || name == "classes.dex":
+		return APK
+	}
+	return Zip
+}
+
+// isText returns true if header looks like the start of a text file.
+func isText(header []byte) bool {
+	if bytes.IndexByte(header, 0) >= 0 {
+		return false
+	}
+	// The header may end in the middle of a multi-byte rune.
+	for i := 0; i < len(header) && i < utf8.UTFMax; i++ {
+		if utf8.Valid(header[:len(header)-i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// textType returns the type of a text file.
+func textType(ext string, header []byte) Type {
+	trimmed := bytes.TrimLeft(bytes.TrimPrefix(header, []byte("\xef\xbb\xbf")), " \t\r\n")
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<?xml")):
+		return XML
+	case ext == "" && bytes.HasPrefix(trimmed, []byte("{")):
+		// INI files also start with "[", so only objects are recognized
+		// without an extension.
+		return JSON
+	}
+	if t := extensions[ext]; t == XML || t == JSON || t == YAML {
+		return t
+	}
+	return Text
+}
+
+// DetectFile reads the header of the file at path p in fsys and returns its
+// content type.
+func DetectFile(fsys fs.FS, p string) (Type, error) {
+	header, err := ReadHeader(fsys, p)
+	if err != nil {
+		return Unknown, err
+	}
+	if len(header) == 0 {
+		// Empty files have no content type, not the one of their extension.
+		return Unknown, nil
+	}
+	return Detect(p, header), nil
+}
+
+// ReadHeader returns the first HeaderSize bytes of the file at path p in
+// fsys, or all of it if it's shorter.
+func ReadHeader(fsys fs.FS, p string) ([]byte, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadHeaderFrom(f)
+}
+
+// ReadHeaderFrom reads the first HeaderSize bytes from r, or all of it if
+// it's shorter. The rest of r can be read afterwards.
+func ReadHeaderFrom(r io.Reader) ([]byte, error) {
+	header := make([]byte, HeaderSize)
+	n, err := io.ReadFull(r, header)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	return header[:n], nil
+}
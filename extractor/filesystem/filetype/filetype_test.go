@@ -0,0 +1,126 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filetype_test
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
+)
+
+// zipHeader returns the local file header of a zip file whose first entry
+// is name.
+func zipHeader(name string) string {
+	h := make([]byte, 30)
+	copy(h, "PK\x03\x04")
+	binary.LittleEndian.PutUint16(h[26:], uint16(len(name)))
+	return string(h) + name
+}
+
+// peHeader returns the DOS header of a PE file followed by the PE signature.
+func peHeader() string {
+	h := make([]byte, 0x44)
+	copy(h, "MZ")
+	binary.LittleEndian.PutUint32(h[0x3c:], 0x40)
+	copy(h[0x40:], "PE\x00\x00")
+	return string(h)
+}
+
+// tarHeader returns a ustar header.
+func tarHeader() string {
+	h := make([]byte, filetype.HeaderSize)
+	copy(h, "file.txt")
+	copy(h[257:], "ustar\x0000")
+	return string(h)
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		header string
+		want   filetype.Type
+	}{
+		{name: "ELF", path: "usr/bin/ls", header: "\x7fELF\x02\x01\x01", want: filetype.ELF},
+		{name: "PE", path: "app.exe", header: peHeader(), want: filetype.PE},
+		{name: "MZ_without_PE_header", path: "notes", header: "MZ" + strings.Repeat("\x01", 0x3a) + "\x10\x00\x00\x00" + strings.Repeat("\x01", 0x20), want: filetype.Unknown},
+		{name: "Mach-O", path: "app", header: "\xcf\xfa\xed\xfe\x07\x00\x00\x01", want: filetype.MachO},
+		{name: "Mach-O_universal", path: "app", header: "\xca\xfe\xba\xbe\x00\x00\x00\x02", want: filetype.MachO},
+		{name: "Java_class", path: "A.class", header: "\xca\xfe\xba\xbe\x00\x00\x00\x41", want: filetype.JavaClass},
+		{name: "script", path: "usr/bin/tool", header: "#!/bin/sh\necho hi\n", want: filetype.Script},
+		{name: "JAR_by_extension", path: "lib/a.war", header: zipHeader("WEB-INF/"), want: filetype.JAR},
+		{name: "JAR_by_manifest", path: "lib/a", header: zipHeader("META-INF/MANIFEST.MF"), want: filetype.JAR},
+		{name: "APK_by_manifest", path: "app.zip", header: zipHeader("AndroidManifest.xml"), want: filetype.APK},
+		{name: "wheel", path: "a-1.0-py3-none-any.whl", header: zipHeader("a/__init__.py"), want: filetype.PythonWheel},
+		{name: "zip", path: "a.jar.txt", header: zipHeader("README.md"), want: filetype.Zip},
+		{name: "JAR_extension_not_zip", path: "a.jar", header: "not a jar", want: filetype.Text},
+		{name: "deb", path: "a.deb", header: "!<arch>\ndebian-binary   ", want: filetype.Deb},
+		{name: "ar", path: "libc.a", header: "!<arch>\n/               ", want: filetype.Ar},
+		{name: "tar", path: "layer", header: tarHeader(), want: filetype.Tar},
+		{name: "gzip", path: "a.tar.gz", header: "\x1f\x8b\x08\x00", want: filetype.Gzip},
+		{name: "SQLite", path: "var/lib/rpm/rpmdb.sqlite", header: "SQLite format 3\x00\x10\x00", want: filetype.SQLite},
+		{name: "JKS", path: "cacerts", header: "\xfe\xed\xfe\xed\x00\x00\x00\x02", want: filetype.JavaKeyStore},
+		{name: "XML", path: "pom", header: "<?xml version=\"1.0\"?>\n<project/>", want: filetype.XML},
+		{name: "XML_with_BOM", path: "a", header: "\xef\xbb\xbf<?xml version=\"1.0\"?>", want: filetype.XML},
+		{name: "JSON_without_extension", path: "config", header: "  {\"a\": 1}", want: filetype.JSON},
+		{name: "JSON_array", path: "package-lock.json", header: "[1, 2]", want: filetype.JSON},
+		{name: "INI_not_JSON", path: "config", header: "[section]\na=1\n", want: filetype.Text},
+		{name: "YAML", path: "a.yml", header: "a: 1\n", want: filetype.YAML},
+		{name: "text", path: "README", header: "hello wörld", want: filetype.Text},
+		{name: "text_with_truncated_rune", path: "README", header: "hello w\xc3", want: filetype.Text},
+		{name: "binary", path: "data.bin", header: "\x00\x01\x02\x03", want: filetype.Unknown},
+		{name: "extension_only", path: "lib/a.JAR", header: "", want: filetype.JAR},
+		{name: "unknown_extension_only", path: "a.bin", header: "", want: filetype.Unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filetype.Detect(tt.path, []byte(tt.header)); got != tt.want {
+				t.Errorf("Detect(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"lib/app":       {Data: []byte(zipHeader("META-INF/") + strings.Repeat("x", 2*filetype.HeaderSize))},
+		"lib/empty.jar": {Data: []byte{}},
+	}
+	tests := []struct {
+		path    string
+		want    filetype.Type
+		wantErr bool
+	}{
+		{path: "lib/app", want: filetype.JAR},
+		{path: "lib/empty.jar", want: filetype.Unknown},
+		{path: "lib/missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := filetype.DetectFile(fsys, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DetectFile(%q) error: %v, want error: %v", tt.path, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DetectFile(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,492 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package squashfs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+	"time"
+)
+
+// inodeHeader is the header shared by all inode types.
+type inodeHeader struct {
+	Type        uint16
+	Permissions uint16
+	UID         uint16
+	GID         uint16
+	ModTime     uint32
+	Number      uint32
+}
+
+// inode is a parsed inode of any type.
+type inode struct {
+	mode  fs.FileMode
+	mtime uint32
+	size  int64
+
+	// Start and offset of the listing of a directory in the directory table.
+	dirBlock  uint32
+	dirOffset uint16
+
+	// Absolute offsets of the data blocks of a regular file, their sizes as
+	// stored in the inode, and the location of the tail end in a fragment.
+	blockOffsets   []int64
+	blockSizes     []uint32
+	fragment       uint32
+	fragmentOffset uint32
+}
+
+// readInode reads the inode with the given reference. The upper bits are the
+// start of its metadata block relative to the inode table and the lower 16
+// bits its offset in the uncompressed block.
+func (f *FS) readInode(ref uint64) (*inode, error) {
+	m, err := f.newMetadataReader(int64(f.sb.InodeTableStart)+int64(ref>>16), int(ref&0xffff))
+	if err != nil {
+		return nil, err
+	}
+	var hdr inodeHeader
+	if err := binary.Read(m, binary.LittleEndian, &hdr); err != nil {
+		return nil, err
+	}
+	ino := &inode{mode: permissions(hdr.Permissions), mtime: hdr.ModTime}
+
+	switch hdr.Type {
+	case typeDir:
+		var d struct {
+			BlockIndex  uint32
+			LinkCount   uint32
+			FileSize    uint16
+			BlockOffset uint16
+			Parent      uint32
+		}
+		if err := binary.Read(m, binary.LittleEndian, &d); err != nil {
+			return nil, err
+		}
+		ino.mode |= fs.ModeDir
+		ino.size, ino.dirBlock, ino.dirOffset = int64(d.FileSize), d.BlockIndex, d.BlockOffset
+	case typeExtDir:
+		var d struct {
+			LinkCount   uint32
+			FileSize    uint32
+			BlockIndex  uint32
+			Parent      uint32
+			IndexCount  uint16
+			BlockOffset uint16
+			XattrIndex  uint32
+		}
+		if err := binary.Read(m, binary.LittleEndian, &d); err != nil {
+			return nil, err
+		}
+		ino.mode |= fs.ModeDir
+		ino.size, ino.dirBlock, ino.dirOffset = int64(d.FileSize), d.BlockIndex, d.BlockOffset
+	case typeFile:
+		var d struct {
+			BlocksStart uint32
+			Fragment    uint32
+			Offset      uint32
+			FileSize    uint32
+		}
+		if err := binary.Read(m, binary.LittleEndian, &d); err != nil {
+			return nil, err
+		}
+		if err := f.readBlockList(m, ino, uint64(d.BlocksStart), uint64(d.FileSize), d.Fragment, d.Offset); err != nil {
+			return nil, err
+		}
+	case typeExtFile:
+		var d struct {
+			BlocksStart uint64
+			FileSize    uint64
+			Sparse      uint64
+			LinkCount   uint32
+			Fragment    uint32
+			Offset      uint32
+			XattrIndex  uint32
+		}
+		if err := binary.Read(m, binary.LittleEndian, &d); err != nil {
+			return nil, err
+		}
+		if err := f.readBlockList(m, ino, d.BlocksStart, d.FileSize, d.Fragment, d.Offset); err != nil {
+			return nil, err
+		}
+	case typeSymlink, typeExtSymlink:
+		var d struct {
+			LinkCount  uint32
+			TargetSize uint32
+		}
+		if err := binary.Read(m, binary.LittleEndian, &d); err != nil {
+			return nil, err
+		}
+		if d.TargetSize > maxSymlinkLen {
+			return nil, fmt.Errorf("%w: symlink target of %d bytes", errCorrupt, d.TargetSize)
+		}
+		ino.mode |= fs.ModeSymlink
+		ino.size = int64(d.TargetSize)
+	case typeBlockDev, typeExtBlock:
+		ino.mode |= fs.ModeDevice
+	case typeCharDev, typeExtChar:
+		ino.mode |= fs.ModeDevice | fs.ModeCharDevice
+	case typeFIFO, typeExtFIFO:
+		ino.mode |= fs.ModeNamedPipe
+	case typeSocket, typeExtSocket:
+		ino.mode |= fs.ModeSocket
+	default:
+		return nil, fmt.Errorf("%w: unknown inode type %d", errCorrupt, hdr.Type)
+	}
+	return ino, nil
+}
+
+// readBlockList reads the sizes of the data blocks of a regular file that
+// follow its inode.
+func (f *FS) readBlockList(m io.Reader, ino *inode, start, size uint64, fragment, fragmentOffset uint32) error {
+	bs := uint64(f.sb.BlockSize)
+	n := size / bs
+	if fragment == NoFragment && size%bs != 0 {
+		n++
+	}
+	// Each block needs an entry in the image, which bounds the number of
+	// blocks even for sparse files.
+	if n*4 > f.sb.BytesUsed {
+		return fmt.Errorf("%w: file of %d bytes", errCorrupt, size)
+	}
+	ino.size = int64(size)
+	ino.fragment, ino.fragmentOffset = fragment, fragmentOffset
+	ino.blockSizes = make([]uint32, n)
+	if err := binary.Read(m, binary.LittleEndian, ino.blockSizes); err != nil {
+		return err
+	}
+	ino.blockOffsets = make([]int64, n)
+	off := int64(start)
+	for i, s := range ino.blockSizes {
+		ino.blockOffsets[i] = off
+		off += int64(s &^ UncompressedDataBit)
+	}
+	return nil
+}
+
+// permissions converts the permission bits of an inode to a FileMode.
+func permissions(p uint16) fs.FileMode {
+	mode := fs.FileMode(p & 0o777)
+	if p&0o4000 != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if p&0o2000 != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if p&0o1000 != 0 {
+		mode |= fs.ModeSticky
+	}
+	return mode
+}
+
+// readBlock returns the contents of the i-th block of a regular file. The
+// last block is read from the fragment block if the file has a tail end.
+func (f *FS) readBlock(ino *inode, i int64) ([]byte, error) {
+	bs := int64(f.sb.BlockSize)
+	want := min(bs, ino.size-i*bs)
+	if want <= 0 {
+		return nil, io.EOF
+	}
+	if i < int64(len(ino.blockSizes)) {
+		size := ino.blockSizes[i]
+		if size == 0 {
+			// Sparse block.
+			return make([]byte, want), nil
+		}
+		data, err := f.readData(ino.blockOffsets[i], size)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(data)) != want {
+			return nil, fmt.Errorf("%w: block %d has %d bytes, want %d", errCorrupt, i, len(data), want)
+		}
+		return data, nil
+	}
+	if ino.fragment == NoFragment {
+		return nil, fmt.Errorf("%w: missing block %d", errCorrupt, i)
+	}
+	frag, err := f.readFragment(ino.fragment)
+	if err != nil {
+		return nil, err
+	}
+	start := int64(ino.fragmentOffset)
+	if start+want > int64(len(frag)) {
+		return nil, fmt.Errorf("%w: tail end out of bounds of fragment %d", errCorrupt, ino.fragment)
+	}
+	return frag[start : start+want], nil
+}
+
+// readData reads a data or fragment block with the given on-disk size.
+func (f *FS) readData(off int64, size uint32) ([]byte, error) {
+	n := size &^ UncompressedDataBit
+	if n > maxBlockSize {
+		return nil, fmt.Errorf("%w: data block of %d bytes", errCorrupt, n)
+	}
+	data := make([]byte, n)
+	if err := f.readAt(data, off); err != nil {
+		return nil, err
+	}
+	if size&UncompressedDataBit != 0 {
+		return data, nil
+	}
+	return f.decompress(data, int(f.sb.BlockSize))
+}
+
+// readFragment returns the contents of the fragment block with the given
+// index.
+func (f *FS) readFragment(index uint32) ([]byte, error) {
+	if index >= f.sb.FragmentCount {
+		return nil, fmt.Errorf("%w: fragment %d out of range", errCorrupt, index)
+	}
+	perBlock := uint32(MetadataBlockSize / fragmentEntrySize)
+	var ptr [8]byte
+	if err := f.readAt(ptr[:], int64(f.sb.FragmentTableStart)+8*int64(index/perBlock)); err != nil {
+		return nil, err
+	}
+	m, err := f.newMetadataReader(int64(binary.LittleEndian.Uint64(ptr[:])), int(index%perBlock)*fragmentEntrySize)
+	if err != nil {
+		return nil, err
+	}
+	var entry struct {
+		Start  uint64
+		Size   uint32
+		Unused uint32
+	}
+	if err := binary.Read(m, binary.LittleEndian, &entry); err != nil {
+		return nil, err
+	}
+	return f.readData(int64(entry.Start), entry.Size)
+}
+
+// rawDirEntry is an entry of a directory listing.
+type rawDirEntry struct {
+	name string
+	mode fs.FileMode
+	ref  uint64
+}
+
+// readDir returns the entries of the directory ino.
+func (f *FS) readDir(ino *inode) ([]rawDirEntry, error) {
+	// The size of a listing is stored with 3 extra bytes for "." and "..".
+	// Empty listings aren't cached as they share their position with the
+	// next listing.
+	if ino.size <= 3 {
+		return nil, nil
+	}
+	key := uint64(ino.dirBlock)<<16 | uint64(ino.dirOffset)
+	f.mu.Lock()
+	entries, ok := f.dirs[key]
+	f.mu.Unlock()
+	if ok {
+		return entries, nil
+	}
+	entries, err := f.parseDir(ino)
+	if err != nil {
+		return nil, err
+	}
+	f.mu.Lock()
+	f.dirs[key] = entries
+	f.mu.Unlock()
+	return entries, nil
+}
+
+// parseDir parses the listing of the directory ino.
+func (f *FS) parseDir(ino *inode) ([]rawDirEntry, error) {
+	remaining := ino.size - 3
+	m, err := f.newMetadataReader(int64(f.sb.DirTableStart)+int64(ino.dirBlock), int(ino.dirOffset))
+	if err != nil {
+		return nil, err
+	}
+	var entries []rawDirEntry
+	for remaining > 0 {
+		var hdr struct {
+			Count       uint32
+			Start       uint32
+			InodeNumber uint32
+		}
+		if err := binary.Read(m, binary.LittleEndian, &hdr); err != nil {
+			return nil, err
+		}
+		remaining -= 12
+		if hdr.Count >= 256 {
+			return nil, fmt.Errorf("%w: directory header with %d entries", errCorrupt, hdr.Count+1)
+		}
+		for range hdr.Count + 1 {
+			var e struct {
+				Offset      uint16
+				InodeOffset int16
+				Type        uint16
+				NameSize    uint16
+			}
+			if err := binary.Read(m, binary.LittleEndian, &e); err != nil {
+				return nil, err
+			}
+			if e.NameSize >= 256 {
+				return nil, fmt.Errorf("%w: directory entry name of %d bytes", errCorrupt, e.NameSize+1)
+			}
+			name := make([]byte, e.NameSize+1)
+			if _, err := io.ReadFull(m, name); err != nil {
+				return nil, err
+			}
+			remaining -= 8 + int64(len(name))
+			if n := string(name); n == "." || n == ".." || strings.Contains(n, "/") {
+				return nil, fmt.Errorf("%w: invalid file name %q", errCorrupt, n)
+			}
+			entries = append(entries, rawDirEntry{
+				name: string(name),
+				mode: typeMode(e.Type),
+				ref:  uint64(hdr.Start)<<16 | uint64(e.Offset),
+			})
+		}
+	}
+	if remaining < 0 {
+		return nil, fmt.Errorf("%w: directory listing exceeds its size", errCorrupt)
+	}
+	return entries, nil
+}
+
+// typeMode returns the type bits of the FileMode for an inode type.
+func typeMode(t uint16) fs.FileMode {
+	switch t {
+	case typeDir, typeExtDir:
+		return fs.ModeDir
+	case typeSymlink, typeExtSymlink:
+		return fs.ModeSymlink
+	case typeBlockDev, typeExtBlock:
+		return fs.ModeDevice
+	case typeCharDev, typeExtChar:
+		return fs.ModeDevice | fs.ModeCharDevice
+	case typeFIFO, typeExtFIFO:
+		return fs.ModeNamedPipe
+	case typeSocket, typeExtSocket:
+		return fs.ModeSocket
+	default:
+		return 0
+	}
+}
+
+// fileInfo implements fs.FileInfo for an inode.
+type fileInfo struct {
+	name string
+	ino  *inode
+}
+
+func (i *fileInfo) Name() string       { return i.name }
+func (i *fileInfo) Size() int64        { return i.ino.size }
+func (i *fileInfo) Mode() fs.FileMode  { return i.ino.mode }
+func (i *fileInfo) ModTime() time.Time { return time.Unix(int64(i.ino.mtime), 0) }
+func (i *fileInfo) IsDir() bool        { return i.ino.mode.IsDir() }
+func (i *fileInfo) Sys() any           { return nil }
+
+// file is an open file that's not a directory. Only regular files can be
+// read.
+type file struct {
+	fs   *FS
+	info *fileInfo
+	pos  int64
+	buf  []byte
+}
+
+func (f *file) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *file) Close() error               { return nil }
+
+func (f *file) Read(p []byte) (int, error) {
+	if !f.info.ino.mode.IsRegular() {
+		return 0, &fs.PathError{Op: "read", Path: f.info.name, Err: fs.ErrInvalid}
+	}
+	if len(f.buf) == 0 {
+		if f.pos >= f.info.ino.size {
+			return 0, io.EOF
+		}
+		b, err := f.fs.readBlock(f.info.ino, f.pos/int64(f.fs.sb.BlockSize))
+		if err != nil {
+			return 0, &fs.PathError{Op: "read", Path: f.info.name, Err: err}
+		}
+		f.buf = b
+	}
+	n := copy(p, f.buf)
+	f.buf = f.buf[n:]
+	f.pos += int64(n)
+	return n, nil
+}
+
+// dir is an open directory.
+type dir struct {
+	fs      *FS
+	info    *fileInfo
+	entries []fs.DirEntry
+	loaded  bool
+	offset  int
+}
+
+func (d *dir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *dir) Close() error               { return nil }
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: errors.New("is a directory")}
+}
+
+// ReadDir returns the entries of the directory sorted by name.
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !d.loaded {
+		raw, err := d.fs.readDir(d.info.ino)
+		if err != nil {
+			return nil, &fs.PathError{Op: "readdir", Path: d.info.name, Err: err}
+		}
+		for _, e := range raw {
+			d.entries = append(d.entries, &dirEntry{fs: d.fs, raw: e})
+		}
+		slices.SortFunc(d.entries, func(a, b fs.DirEntry) int { return strings.Compare(a.Name(), b.Name()) })
+		d.loaded = true
+	}
+	rest := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(rest))
+	d.offset += n
+	return rest[:n], nil
+}
+
+// dirEntry implements fs.DirEntry. The inode is only read by Info.
+type dirEntry struct {
+	fs  *FS
+	raw rawDirEntry
+}
+
+func (e *dirEntry) Name() string      { return e.raw.name }
+func (e *dirEntry) IsDir() bool       { return e.raw.mode.IsDir() }
+func (e *dirEntry) Type() fs.FileMode { return e.raw.mode.Type() }
+
+func (e *dirEntry) Info() (fs.FileInfo, error) {
+	ino, err := e.fs.readInode(e.raw.ref)
+	if err != nil {
+		return nil, err
+	}
+	return &fileInfo{name: e.raw.name, ino: ino}, nil
+}
+
+var (
+	_ fs.FS          = &FS{}
+	_ fs.ReadDirFile = &dir{}
+)
@@ -0,0 +1,305 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package squashfs implements a read-only fs.FS for SquashFS 4.0 images as
+// used by snaps and AppImages. Only gzip and zstd compressed images are
+// supported. Symlinks are reported as such but not followed.
+package squashfs
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression IDs of the superblock.
+const (
+	CompressionGzip = 1
+	CompressionLZMA = 2
+	CompressionLZO  = 3
+	CompressionXZ   = 4
+	CompressionLZ4  = 5
+	CompressionZstd = 6
+)
+
+const (
+	magic = 0x73717368
+	// MetadataBlockSize is the uncompressed size of a full metadata block.
+	MetadataBlockSize = 8192
+	// NoFragment is the fragment index of files without a tail end in a
+	// fragment block.
+	NoFragment = 0xFFFFFFFF
+	// UncompressedMetadataBit is set in the header of uncompressed metadata
+	// blocks.
+	UncompressedMetadataBit = 1 << 15
+	// UncompressedDataBit is set in the size of uncompressed data blocks.
+	UncompressedDataBit = 1 << 24
+
+	minBlockSize      = 4096
+	maxBlockSize      = 1 << 20
+	fragmentEntrySize = 16
+	maxSymlinkLen     = 4096
+)
+
+// Inode types.
+const (
+	typeDir        = 1
+	typeFile       = 2
+	typeSymlink    = 3
+	typeBlockDev   = 4
+	typeCharDev    = 5
+	typeFIFO       = 6
+	typeSocket     = 7
+	typeExtDir     = 8
+	typeExtFile    = 9
+	typeExtSymlink = 10
+	typeExtBlock   = 11
+	typeExtChar    = 12
+	typeExtFIFO    = 13
+	typeExtSocket  = 14
+)
+
+var (
+	// ErrUnsupportedCompression is returned for images that use a compression
+	// algorithm other than gzip or zstd.
+	ErrUnsupportedCompression = errors.New("unsupported squashfs compression")
+	errCorrupt                = errors.New("corrupt squashfs image")
+)
+
+// zstdDecoder is shared by all images as DecodeAll is safe for concurrent use.
+var zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+	return zstd.NewReader(nil, zstd.WithDecoderMaxMemory(4*maxBlockSize))
+})
+
+// Superblock is the header of a SquashFS image.
+type Superblock struct {
+	Magic              uint32
+	InodeCount         uint32
+	ModTime            uint32
+	BlockSize          uint32
+	FragmentCount      uint32
+	Compression        uint16
+	BlockLog           uint16
+	Flags              uint16
+	IDCount            uint16
+	VersionMajor       uint16
+	VersionMinor       uint16
+	RootInode          uint64
+	BytesUsed          uint64
+	IDTableStart       uint64
+	XattrTableStart    uint64
+	InodeTableStart    uint64
+	DirTableStart      uint64
+	FragmentTableStart uint64
+	ExportTableStart   uint64
+}
+
+// FS is a SquashFS image.
+type FS struct {
+	r    io.ReaderAt
+	sb   Superblock
+	root *inode
+
+	mu sync.Mutex
+	// Parsed directory listings by their position in the directory table, as
+	// every lookup walks the listings of all parent directories.
+	dirs map[uint64][]rawDirEntry
+}
+
+// Open reads the superblock of the SquashFS image in r.
+func Open(r io.ReaderAt) (*FS, error) {
+	f := &FS{r: r, dirs: make(map[uint64][]rawDirEntry)}
+	if err := binary.Read(io.NewSectionReader(r, 0, int64(binary.Size(f.sb))), binary.LittleEndian, &f.sb); err != nil {
+		return nil, fmt.Errorf("reading squashfs superblock: %w", err)
+	}
+	sb := &f.sb
+	if sb.Magic != magic {
+		return nil, errors.New("not a squashfs image")
+	}
+	if sb.VersionMajor != 4 || sb.VersionMinor != 0 {
+		return nil, fmt.Errorf("unsupported squashfs version %d.%d", sb.VersionMajor, sb.VersionMinor)
+	}
+	if sb.BlockSize < minBlockSize || sb.BlockSize > maxBlockSize || sb.BlockSize != 1<<sb.BlockLog {
+		return nil, fmt.Errorf("%w: invalid block size %d", errCorrupt, sb.BlockSize)
+	}
+	switch sb.Compression {
+	case CompressionGzip, CompressionZstd:
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedCompression, sb.Compression)
+	}
+	root, err := f.readInode(sb.RootInode)
+	if err != nil {
+		return nil, fmt.Errorf("reading root inode: %w", err)
+	}
+	if !root.mode.IsDir() {
+		return nil, fmt.Errorf("%w: root is not a directory", errCorrupt)
+	}
+	f.root = root
+	return f, nil
+}
+
+// Open opens the named file or directory.
+func (f *FS) Open(name string) (fs.File, error) {
+	ino, err := f.lookup(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	info := &fileInfo{name: path.Base(name), ino: ino}
+	if ino.mode.IsDir() {
+		return &dir{fs: f, info: info}, nil
+	}
+	return &file{fs: f, info: info}, nil
+}
+
+// lookup returns the inode of the named file. Symlinks aren't followed.
+func (f *FS) lookup(name string) (*inode, error) {
+	if !fs.ValidPath(name) {
+		return nil, fs.ErrInvalid
+	}
+	ino := f.root
+	if name == "." {
+		return ino, nil
+	}
+	for _, part := range strings.Split(name, "/") {
+		if !ino.mode.IsDir() {
+			return nil, fs.ErrNotExist
+		}
+		entries, err := f.readDir(ino)
+		if err != nil {
+			return nil, err
+		}
+		var ref uint64
+		found := false
+		for _, e := range entries {
+			if e.name == part {
+				ref, found = e.ref, true
+				break
+			}
+		}
+		if !found {
+			return nil, fs.ErrNotExist
+		}
+		if ino, err = f.readInode(ref); err != nil {
+			return nil, err
+		}
+	}
+	return ino, nil
+}
+
+// decompress decompresses a block that's at most limit bytes uncompressed.
+func (f *FS) decompress(src []byte, limit int) ([]byte, error) {
+	var out []byte
+	switch f.sb.Compression {
+	case CompressionGzip:
+		zr, err := zlib.NewReader(bytes.NewReader(src))
+		if err != nil {
+			return nil, err
+		}
+		if out, err = io.ReadAll(io.LimitReader(zr, int64(limit)+1)); err != nil {
+			return nil, err
+		}
+	case CompressionZstd:
+		d, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		if out, err = d.DecodeAll(src, nil); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnsupportedCompression
+	}
+	if len(out) > limit {
+		return nil, fmt.Errorf("%w: block exceeds %d bytes", errCorrupt, limit)
+	}
+	return out, nil
+}
+
+// readAt reads exactly len(b) bytes at off.
+func (f *FS) readAt(b []byte, off int64) error {
+	n, err := f.r.ReadAt(b, off)
+	if n == len(b) {
+		return nil
+	}
+	if err == nil || errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// metadataReader reads the contents of consecutive metadata blocks.
+type metadataReader struct {
+	fs   *FS
+	next int64
+	buf  []byte
+}
+
+// newMetadataReader returns a reader starting at offset in the uncompressed
+// contents of the metadata block at blockStart.
+func (f *FS) newMetadataReader(blockStart int64, offset int) (*metadataReader, error) {
+	m := &metadataReader{fs: f, next: blockStart}
+	if err := m.fill(); err != nil {
+		return nil, err
+	}
+	if offset > len(m.buf) {
+		return nil, fmt.Errorf("%w: metadata offset %d out of bounds", errCorrupt, offset)
+	}
+	m.buf = m.buf[offset:]
+	return m, nil
+}
+
+func (m *metadataReader) fill() error {
+	var hdr [2]byte
+	if err := m.fs.readAt(hdr[:], m.next); err != nil {
+		return err
+	}
+	h := binary.LittleEndian.Uint16(hdr[:])
+	size := int(h &^ UncompressedMetadataBit)
+	if size == 0 || size > MetadataBlockSize {
+		return fmt.Errorf("%w: invalid metadata block size %d", errCorrupt, size)
+	}
+	data := make([]byte, size)
+	if err := m.fs.readAt(data, m.next+2); err != nil {
+		return err
+	}
+	m.next += int64(2 + size)
+	if h&UncompressedMetadataBit == 0 {
+		var err error
+		if data, err = m.fs.decompress(data, MetadataBlockSize); err != nil {
+			return err
+		}
+	}
+	m.buf = data
+	return nil
+}
+
+func (m *metadataReader) Read(p []byte) (int, error) {
+	for len(m.buf) == 0 {
+		if err := m.fill(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, m.buf)
+	m.buf = m.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package squashfs_test
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/squashfs"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/squashfs/squashfstest"
+)
+
+func testFiles() map[string]squashfstest.File {
+	files := map[string]squashfstest.File{
+		"meta/snap.yaml":       {Data: []byte("name: hello\nversion: 2.10\n")},
+		"usr/bin/hello":        {Data: bytes.Repeat([]byte("\x7fELF binary "), 1000), Perm: 0o755},
+		"usr/lib/libfoo.so.1":  {Data: []byte(strings.Repeat("lib", 2000))},
+		"usr/share/doc/empty":  {},
+		"usr/share/empty-dir":  {Dir: true},
+		"incompressible.bin":   {Data: pseudoRandom(5000)},
+		"usr/share/doc/README": {Data: []byte("hello")},
+	}
+	// Enough entries to span several metadata blocks.
+	for i := range 300 {
+		files["many/file-"+strings.Repeat("x", i%50)+string(rune('a'+i%26))+string(rune('a'+i/26))] = squashfstest.File{Data: []byte{byte(i)}}
+	}
+	return files
+}
+
+func TestFS(t *testing.T) {
+	tests := []struct {
+		name string
+		opts squashfstest.Options
+	}{
+		{name: "gzip", opts: squashfstest.Options{}},
+		{name: "zstd", opts: squashfstest.Options{Compression: squashfs.CompressionZstd}},
+		{name: "extended inodes", opts: squashfstest.Options{ExtendedInodes: true, BlockSize: 8192}},
+	}
+
+	files := testFiles()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := squashfstest.Build(files, tt.opts)
+			fsys, err := squashfs.Open(bytes.NewReader(img))
+			if err != nil {
+				t.Fatalf("Open(): %v", err)
+			}
+			var expected []string
+			for p, f := range files {
+				if !f.Dir {
+					expected = append(expected, p)
+				}
+			}
+			if err := fstest.TestFS(fsys, expected...); err != nil {
+				t.Errorf("TestFS(): %v", err)
+			}
+			for p, f := range files {
+				if f.Dir {
+					continue
+				}
+				got, err := fs.ReadFile(fsys, p)
+				if err != nil {
+					t.Errorf("ReadFile(%s): %v", p, err)
+					continue
+				}
+				if !bytes.Equal(got, f.Data) {
+					t.Errorf("ReadFile(%s) returned %d bytes, want %d", p, len(got), len(f.Data))
+				}
+			}
+			info, err := fs.Stat(fsys, "usr/bin/hello")
+			if err != nil {
+				t.Fatalf("Stat(usr/bin/hello): %v", err)
+			}
+			if info.Mode() != 0o755 {
+				t.Errorf("Stat(usr/bin/hello).Mode() = %v, want %v", info.Mode(), fs.FileMode(0o755))
+			}
+		})
+	}
+}
+
+func TestFS_Symlink(t *testing.T) {
+	for _, ext := range []bool{false, true} {
+		img := squashfstest.Build(map[string]squashfstest.File{
+			"lib/libfoo.so.1.2": {Data: []byte("lib")},
+			"lib/libfoo.so.1":   {Symlink: "libfoo.so.1.2"},
+		}, squashfstest.Options{ExtendedInodes: ext})
+		fsys, err := squashfs.Open(bytes.NewReader(img))
+		if err != nil {
+			t.Fatalf("Open(): %v", err)
+		}
+		entries, err := fs.ReadDir(fsys, "lib")
+		if err != nil {
+			t.Fatalf("ReadDir(lib): %v", err)
+		}
+		if len(entries) != 2 || entries[0].Type() != fs.ModeSymlink || entries[1].Type() != 0 {
+			t.Errorf("ReadDir(lib) = %v, want symlink and regular file", entries)
+		}
+		info, err := entries[0].Info()
+		if err != nil {
+			t.Fatalf("Info(): %v", err)
+		}
+		if info.Mode().Type() != fs.ModeSymlink || info.Size() != int64(len("libfoo.so.1.2")) {
+			t.Errorf("Info() = %v with size %d, want symlink of %d bytes", info.Mode(), info.Size(), len("libfoo.so.1.2"))
+		}
+		if _, err := fs.ReadFile(fsys, "lib/libfoo.so.1"); err == nil {
+			t.Error("ReadFile(symlink) succeeded, want error")
+		}
+		if _, err := fsys.Open("lib/libfoo.so.1/x"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Open(lib/libfoo.so.1/x) error = %v, want %v", err, fs.ErrNotExist)
+		}
+	}
+}
+
+func TestOpen_Errors(t *testing.T) {
+	img := squashfstest.Build(map[string]squashfstest.File{"a": {Data: []byte("a")}}, squashfstest.Options{})
+	xz := squashfstest.Build(map[string]squashfstest.File{"a": {Data: []byte("a")}}, squashfstest.Options{Compression: squashfs.CompressionXZ})
+
+	tests := []struct {
+		name    string
+		img     []byte
+		wantErr error
+	}{
+		{name: "empty", img: nil},
+		{name: "not squashfs", img: bytes.Repeat([]byte("x"), 200)},
+		{name: "truncated", img: img[:100]},
+		{name: "xz", img: xz, wantErr: squashfs.ErrUnsupportedCompression},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := squashfs.Open(bytes.NewReader(tt.img))
+			if err == nil {
+				t.Fatal("Open() succeeded, want error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Open() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// pseudoRandom returns n bytes that don't compress.
+func pseudoRandom(n int) []byte {
+	b := make([]byte, n)
+	x := uint32(2463534242)
+	for i := range b {
+		x ^= x << 13
+		x ^= x >> 17
+		x ^= x << 5
+		b[i] = byte(x)
+	}
+	return b
+}
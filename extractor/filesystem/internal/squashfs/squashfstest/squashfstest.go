@@ -0,0 +1,429 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package squashfstest builds SquashFS images for tests.
+package squashfstest
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io/fs"
+	"math/bits"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/squashfs"
+	"github.com/klauspost/compress/zstd"
+)
+
+// File is a file in the image.
+type File struct {
+	// Contents of a regular file.
+	Data []byte
+	// Target of a symlink. If set, Data is ignored.
+	Symlink string
+	// Dir creates an empty directory. Directories of other files are created
+	// implicitly.
+	Dir bool
+	// Permission bits. Defaults to 0644 for files and 0755 for directories.
+	Perm fs.FileMode
+}
+
+// Options configure how the image is written.
+type Options struct {
+	// Compression ID of the superblock. Defaults to squashfs.CompressionGzip.
+	// Blocks of images with other compression types are stored uncompressed.
+	Compression uint16
+	// BlockSize of data blocks. Defaults to 4096.
+	BlockSize uint32
+	// ExtendedInodes writes the extended instead of the basic inode types.
+	ExtendedInodes bool
+}
+
+var zstdEncoder = sync.OnceValue(func() *zstd.Encoder {
+	enc, err := zstd.NewWriter(nil)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+})
+
+// node is a file or directory of the image tree.
+type node struct {
+	file     File
+	isDir    bool
+	children map[string]*node
+
+	// Location of the data of regular files.
+	blocksStart    uint64
+	blockSizes     []uint32
+	fragment       uint32
+	fragmentOffset uint32
+}
+
+type fragmentEntry struct {
+	Start  uint64
+	Size   uint32
+	Unused uint32
+}
+
+type builder struct {
+	opts      Options
+	out       bytes.Buffer
+	inodes    metaWriter
+	dirs      metaWriter
+	fragments []fragmentEntry
+	frag      []byte
+	inodeNum  uint32
+}
+
+// Build returns a SquashFS image with the given files, keyed by their
+// slash-separated path.
+func Build(files map[string]File, opts Options) []byte {
+	if opts.Compression == 0 {
+		opts.Compression = squashfs.CompressionGzip
+	}
+	if opts.BlockSize == 0 {
+		opts.BlockSize = 4096
+	}
+	b := &builder{opts: opts}
+	b.inodes.compress = b.compress
+	b.dirs.compress = b.compress
+
+	root := &node{isDir: true, children: map[string]*node{}}
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	for _, p := range paths {
+		n := root
+		parts := strings.Split(path.Clean(p), "/")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := n.children[part]
+			if !ok {
+				child = &node{isDir: true, children: map[string]*node{}}
+				n.children[part] = child
+			}
+			n = child
+		}
+		f := files[p]
+		n.children[parts[len(parts)-1]] = &node{file: f, isDir: f.Dir, children: map[string]*node{}}
+	}
+
+	// Superblock placeholder, then all data and fragment blocks.
+	b.out.Write(make([]byte, binary.Size(squashfs.Superblock{})))
+	b.writeData(root)
+	b.flushFragment()
+
+	rootRef, _, _ := b.writeInode(root)
+	sb := squashfs.Superblock{
+		Magic:            0x73717368,
+		InodeCount:       b.inodeNum,
+		BlockSize:        opts.BlockSize,
+		FragmentCount:    uint32(len(b.fragments)),
+		Compression:      opts.Compression,
+		BlockLog:         uint16(bits.TrailingZeros32(opts.BlockSize)),
+		IDCount:          1,
+		VersionMajor:     4,
+		RootInode:        rootRef,
+		XattrTableStart:  ^uint64(0),
+		ExportTableStart: ^uint64(0),
+	}
+	sb.InodeTableStart = uint64(b.out.Len())
+	b.out.Write(b.inodes.finish())
+	sb.DirTableStart = uint64(b.out.Len())
+	b.out.Write(b.dirs.finish())
+
+	// Fragment entries, followed by the table of pointers to their blocks.
+	fragEntries := metaWriter{compress: b.compress}
+	var fragPtrs []uint64
+	for i, e := range b.fragments {
+		if i%(squashfs.MetadataBlockSize/16) == 0 {
+			fragPtrs = append(fragPtrs, uint64(b.out.Len())+uint64(len(fragEntries.out)))
+		}
+		fragEntries.write(le(e))
+	}
+	b.out.Write(fragEntries.finish())
+	sb.FragmentTableStart = uint64(b.out.Len())
+	b.out.Write(le(fragPtrs))
+
+	// A single ID for root.
+	idBlock := uint64(b.out.Len())
+	ids := metaWriter{compress: b.compress}
+	ids.write(le(uint32(0)))
+	b.out.Write(ids.finish())
+	sb.IDTableStart = uint64(b.out.Len())
+	b.out.Write(le(idBlock))
+
+	sb.BytesUsed = uint64(b.out.Len())
+	img := b.out.Bytes()
+	copy(img, le(sb))
+	return img
+}
+
+// writeData writes the data blocks of all regular files below n.
+func (b *builder) writeData(n *node) {
+	for _, name := range sortedNames(n) {
+		child := n.children[name]
+		if child.isDir {
+			b.writeData(child)
+			continue
+		}
+		if child.file.Symlink != "" {
+			continue
+		}
+		data := child.file.Data
+		bs := int(b.opts.BlockSize)
+		child.blocksStart = uint64(b.out.Len())
+		child.fragment = squashfs.NoFragment
+		for len(data) >= bs {
+			child.blockSizes = append(child.blockSizes, b.writeBlock(data[:bs]))
+			data = data[bs:]
+		}
+		if len(data) == 0 {
+			continue
+		}
+		if len(b.frag)+len(data) > bs {
+			b.flushFragment()
+		}
+		child.fragment = uint32(len(b.fragments))
+		child.fragmentOffset = uint32(len(b.frag))
+		b.frag = append(b.frag, data...)
+	}
+}
+
+// writeBlock writes a data block and returns its on-disk size.
+func (b *builder) writeBlock(data []byte) uint32 {
+	out, compressed := b.compress(data)
+	b.out.Write(out)
+	size := uint32(len(out))
+	if !compressed {
+		size |= squashfs.UncompressedDataBit
+	}
+	return size
+}
+
+func (b *builder) flushFragment() {
+	if len(b.frag) == 0 {
+		return
+	}
+	start := uint64(b.out.Len())
+	b.fragments = append(b.fragments, fragmentEntry{Start: start, Size: b.writeBlock(b.frag)})
+	b.frag = nil
+}
+
+// writeInode writes the inodes of n and, for directories, its children and
+// listing. It returns the inode reference, number and basic type of n.
+func (b *builder) writeInode(n *node) (uint64, uint32, uint16) {
+	type entry struct {
+		name   string
+		ref    uint64
+		number uint32
+		typ    uint16
+	}
+	var entries []entry
+	subdirs := 0
+	if n.isDir {
+		for _, name := range sortedNames(n) {
+			ref, num, typ := b.writeInode(n.children[name])
+			entries = append(entries, entry{name: name, ref: ref, number: num, typ: typ})
+			if typ == 1 {
+				subdirs++
+			}
+		}
+	}
+
+	b.inodeNum++
+	number := b.inodeNum
+	block, offset := b.inodes.pos()
+	ref := block<<16 | uint64(offset)
+	perm := uint16(n.file.Perm.Perm())
+	ext := uint16(0)
+	if b.opts.ExtendedInodes {
+		ext = 7
+	}
+	header := func(typ uint16) {
+		if perm == 0 {
+			perm = 0o644
+			if typ == 1 {
+				perm = 0o755
+			}
+		}
+		b.inodes.write(le(struct{ Type, Perm, UID, GID uint16 }{Type: typ + ext, Perm: perm}))
+		b.inodes.write(le(struct{ ModTime, Number uint32 }{Number: number}))
+	}
+
+	switch {
+	case n.isDir:
+		dirBlock, dirOffset := b.dirs.pos()
+		var listing []byte
+		for i := 0; i < len(entries); {
+			// A header covers up to 256 entries in the same inode block.
+			j := i + 1
+			for j < len(entries) && j-i < 256 && entries[j].ref>>16 == entries[i].ref>>16 {
+				j++
+			}
+			listing = append(listing, le(struct{ Count, Start, InodeNumber uint32 }{
+				Count: uint32(j - i - 1), Start: uint32(entries[i].ref >> 16), InodeNumber: entries[i].number,
+			})...)
+			for _, e := range entries[i:j] {
+				listing = append(listing, le(struct {
+					Offset      uint16
+					InodeOffset int16
+					Type        uint16
+					NameSize    uint16
+				}{
+					Offset:      uint16(e.ref & 0xffff),
+					InodeOffset: int16(e.number - entries[i].number),
+					Type:        e.typ,
+					NameSize:    uint16(len(e.name) - 1),
+				})...)
+				listing = append(listing, e.name...)
+			}
+			i = j
+		}
+		b.dirs.write(listing)
+		header(1)
+		size := uint32(len(listing) + 3)
+		if b.opts.ExtendedInodes {
+			b.inodes.write(le(struct {
+				LinkCount, FileSize, BlockIndex, Parent uint32
+				IndexCount, BlockOffset                 uint16
+				XattrIndex                              uint32
+			}{
+				LinkCount: uint32(2 + subdirs), FileSize: size, BlockIndex: uint32(dirBlock),
+				BlockOffset: dirOffset, XattrIndex: squashfs.NoFragment,
+			}))
+		} else {
+			b.inodes.write(le(struct {
+				BlockIndex, LinkCount uint32
+				FileSize, BlockOffset uint16
+				Parent                uint32
+			}{
+				BlockIndex: uint32(dirBlock), LinkCount: uint32(2 + subdirs),
+				FileSize: uint16(size), BlockOffset: dirOffset,
+			}))
+		}
+		return ref, number, 1
+	case n.file.Symlink != "":
+		header(3)
+		b.inodes.write(le(struct{ LinkCount, TargetSize uint32 }{LinkCount: 1, TargetSize: uint32(len(n.file.Symlink))}))
+		b.inodes.write([]byte(n.file.Symlink))
+		if b.opts.ExtendedInodes {
+			b.inodes.write(le(uint32(squashfs.NoFragment)))
+		}
+		return ref, number, 3
+	default:
+		header(2)
+		size := len(n.file.Data)
+		if b.opts.ExtendedInodes {
+			b.inodes.write(le(struct {
+				BlocksStart, FileSize, Sparse           uint64
+				LinkCount, Fragment, Offset, XattrIndex uint32
+			}{
+				BlocksStart: n.blocksStart, FileSize: uint64(size), LinkCount: 1,
+				Fragment: n.fragment, Offset: n.fragmentOffset, XattrIndex: squashfs.NoFragment,
+			}))
+		} else {
+			b.inodes.write(le(struct{ BlocksStart, Fragment, Offset, FileSize uint32 }{
+				BlocksStart: uint32(n.blocksStart), Fragment: n.fragment, Offset: n.fragmentOffset, FileSize: uint32(size),
+			}))
+		}
+		b.inodes.write(le(n.blockSizes))
+		return ref, number, 2
+	}
+}
+
+// compress compresses data with the configured algorithm. The data is
+// returned as is if compression doesn't make it smaller.
+func (b *builder) compress(data []byte) ([]byte, bool) {
+	var out []byte
+	switch b.opts.Compression {
+	case squashfs.CompressionGzip:
+		var buf bytes.Buffer
+		w := zlib.NewWriter(&buf)
+		w.Write(data)
+		w.Close()
+		out = buf.Bytes()
+	case squashfs.CompressionZstd:
+		out = zstdEncoder().EncodeAll(data, nil)
+	default:
+		return data, false
+	}
+	if len(out) >= len(data) {
+		return data, false
+	}
+	return out, true
+}
+
+// metaWriter writes a sequence of metadata blocks.
+type metaWriter struct {
+	compress func([]byte) ([]byte, bool)
+	out      []byte
+	cur      []byte
+}
+
+// pos returns the start of the current block relative to the first block and
+// the offset in its uncompressed contents.
+func (m *metaWriter) pos() (uint64, uint16) {
+	return uint64(len(m.out)), uint16(len(m.cur))
+}
+
+func (m *metaWriter) write(data []byte) {
+	m.cur = append(m.cur, data...)
+	for len(m.cur) >= squashfs.MetadataBlockSize {
+		m.flush(m.cur[:squashfs.MetadataBlockSize])
+		m.cur = append([]byte(nil), m.cur[squashfs.MetadataBlockSize:]...)
+	}
+}
+
+func (m *metaWriter) flush(block []byte) {
+	data, compressed := m.compress(block)
+	hdr := uint16(len(data))
+	if !compressed {
+		hdr |= squashfs.UncompressedMetadataBit
+	}
+	m.out = append(m.out, le(hdr)...)
+	m.out = append(m.out, data...)
+}
+
+func (m *metaWriter) finish() []byte {
+	if len(m.cur) > 0 {
+		m.flush(m.cur)
+		m.cur = nil
+	}
+	return m.out
+}
+
+func sortedNames(n *node) []string {
+	names := make([]string, 0, len(n.children))
+	for name := range n.children {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// le returns the little-endian encoding of v.
+func le(v any) []byte {
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,209 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bzlmodlock extracts the Bazel modules and the Go modules pinned
+// through Bazel from MODULE.bazel.lock files.
+package bzlmodlock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// KindBazelModule is a module resolved from a Bazel registry such as the
+	// Bazel Central Registry.
+	KindBazelModule = "bazel_module"
+	// KindGoModule is a Go module fetched by a go_repository rule, usually
+	// generated by gazelle's go_deps module extension.
+	KindGoModule = "go_module"
+)
+
+// Metadata holds parsing information for a dependency pinned in a
+// MODULE.bazel.lock file.
+type Metadata struct {
+	// KindBazelModule or KindGoModule.
+	Kind string
+	// Name of the Bazel repository that the dependency is fetched into, if
+	// recorded in the lockfile.
+	RepoName string
+	// URL of the registry that a Bazel module was resolved from, if recorded
+	// in the lockfile.
+	Registry string
+}
+
+type repoSpec struct {
+	RuleClassName string         `json:"ruleClassName"`
+	Attributes    map[string]any `json:"attributes"`
+}
+
+type lockFile struct {
+	// Bazel 7.2 and later: hashes of the registry files used during module
+	// resolution, keyed by their URL.
+	RegistryFileHashes map[string]any `json:"registryFileHashes"`
+	// Bazel 7.0 and 7.1: the resolved module graph, keyed by "name@version".
+	ModuleDepGraph map[string]struct {
+		Name     string `json:"name"`
+		Version  string `json:"version"`
+		RepoName string `json:"repoName"`
+	} `json:"moduleDepGraph"`
+	// Keyed by extension ID, then by evaluation context such as "general" or
+	// "os:linux".
+	ModuleExtensions map[string]map[string]struct {
+		GeneratedRepoSpecs map[string]repoSpec `json:"generatedRepoSpecs"`
+	} `json:"moduleExtensions"`
+}
+
+// Extractor extracts dependencies from MODULE.bazel.lock files.
+type Extractor struct{}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return "bazel/bzlmodlock" }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{}
+}
+
+// FileRequired returns true if the specified file is a MODULE.bazel.lock file.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	return filepath.Base(api.Path()) == "MODULE.bazel.lock"
+}
+
+// Extract extracts packages from MODULE.bazel.lock files passed through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var f lockFile
+	if err := json.NewDecoder(input.Reader).Decode(&f); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	type mapKey struct {
+		kind    string
+		name    string
+		version string
+	}
+	packages := map[mapKey]*extractor.Inventory{}
+	add := func(name, version string, m *Metadata) {
+		if name == "" || version == "" {
+			return
+		}
+		k := mapKey{kind: m.Kind, name: name, version: version}
+		if _, ok := packages[k]; ok {
+			return
+		}
+		packages[k] = &extractor.Inventory{
+			Name:      name,
+			Version:   version,
+			Metadata:  m,
+			Locations: []string{input.Path},
+		}
+	}
+
+	for url := range f.RegistryFileHashes {
+		// The MODULE.bazel files of every version considered during resolution
+		// are recorded, but the source.json file is only fetched for the
+		// selected version.
+		registry, name, version, ok := parseRegistryURL(url)
+		if !ok {
+			continue
+		}
+		add(name, version, &Metadata{Kind: KindBazelModule, Registry: registry})
+	}
+	for key, m := range f.ModuleDepGraph {
+		if key == "<root>" {
+			continue
+		}
+		add(m.Name, m.Version, &Metadata{Kind: KindBazelModule, RepoName: m.RepoName})
+	}
+
+	for _, contexts := range f.ModuleExtensions {
+		for _, c := range contexts {
+			for repo, spec := range c.GeneratedRepoSpecs {
+				if spec.RuleClassName != "go_repository" {
+					continue
+				}
+				path := stringAttr(spec.Attributes, "importpath")
+				if r := stringAttr(spec.Attributes, "replace"); r != "" {
+					path = r
+				}
+				version := strings.TrimPrefix(stringAttr(spec.Attributes, "version"), "v")
+				add(path, version, &Metadata{Kind: KindGoModule, RepoName: repo})
+			}
+		}
+	}
+
+	pkgs := make([]*extractor.Inventory, 0, len(packages))
+	for _, p := range packages {
+		pkgs = append(pkgs, p)
+	}
+	return pkgs, nil
+}
+
+// parseRegistryURL parses the URL of the source.json file of a module in a
+// registry, e.g. "https://bcr.bazel.build/modules/rules_go/0.41.0/source.json".
+func parseRegistryURL(url string) (registry, name, version string, ok bool) {
+	registry, path, found := strings.Cut(url, "/modules/")
+	if !found {
+		return "", "", "", false
+	}
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[2] != "source.json" {
+		return "", "", "", false
+	}
+	return registry, parts[0], parts[1], true
+}
+
+func stringAttr(attrs map[string]any, key string) string {
+	s, _ := attrs[key].(string)
+	return s
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m := i.Metadata.(*Metadata)
+	if m.Kind == KindGoModule {
+		return &purl.PackageURL{
+			Type:    purl.TypeGolang,
+			Name:    i.Name,
+			Version: i.Version,
+		}
+	}
+	return &purl.PackageURL{
+		Type:    purl.TypeBazel,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns the OSV ecosystem ('Go') of Go modules. OSV doesn't track
+// Bazel modules, so no ecosystem is returned for them.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string {
+	if i.Metadata.(*Metadata).Kind == KindGoModule {
+		return "Go"
+	}
+	return ""
+}
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,193 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bzlmodlock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/bazel/bzlmodlock"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestExtractor_FileRequired(t *testing.T) {
+	tests := []struct {
+		inputPath string
+		want      bool
+	}{
+		{inputPath: "MODULE.bazel.lock", want: true},
+		{inputPath: "path/to/my/MODULE.bazel.lock", want: true},
+		{inputPath: "path/to/my/MODULE.bazel", want: false},
+		{inputPath: "path/to/my/MODULE.bazel.lock/file", want: false},
+		{inputPath: "path/to/my/module.bazel.lock.bak", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.inputPath, func(t *testing.T) {
+			e := bzlmodlock.Extractor{}
+			got := e.FileRequired(simplefileapi.New(tt.inputPath, nil))
+			if got != tt.want {
+				t.Errorf("FileRequired(%s, FileInfo) got = %v, want %v", tt.inputPath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractor_Extract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "invalid json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid.lock",
+			},
+			WantErr: extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+		{
+			Name: "registry file hashes and go_deps",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/v11.lock",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "abseil-cpp",
+					Version:   "20230802.0",
+					Locations: []string{"testdata/v11.lock"},
+					Metadata: &bzlmodlock.Metadata{
+						Kind:     bzlmodlock.KindBazelModule,
+						Registry: "https://bcr.bazel.build",
+					},
+				},
+				{
+					Name:      "gazelle",
+					Version:   "0.33.0",
+					Locations: []string{"testdata/v11.lock"},
+					Metadata: &bzlmodlock.Metadata{
+						Kind:     bzlmodlock.KindBazelModule,
+						Registry: "https://bcr.bazel.build",
+					},
+				},
+				{
+					Name:      "rules_go",
+					Version:   "0.41.0",
+					Locations: []string{"testdata/v11.lock"},
+					Metadata: &bzlmodlock.Metadata{
+						Kind:     bzlmodlock.KindBazelModule,
+						Registry: "https://bcr.bazel.build",
+					},
+				},
+				{
+					Name:      "github.com/google/uuid",
+					Version:   "1.6.0",
+					Locations: []string{"testdata/v11.lock"},
+					Metadata: &bzlmodlock.Metadata{
+						Kind:     bzlmodlock.KindGoModule,
+						RepoName: "com_github_google_uuid",
+					},
+				},
+				{
+					Name:      "github.com/example/net",
+					Version:   "0.20.0",
+					Locations: []string{"testdata/v11.lock"},
+					Metadata: &bzlmodlock.Metadata{
+						Kind:     bzlmodlock.KindGoModule,
+						RepoName: "org_golang_x_net",
+					},
+				},
+			},
+		},
+		{
+			Name: "module dep graph",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/v3.lock",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "rules_java",
+					Version:   "7.1.0",
+					Locations: []string{"testdata/v3.lock"},
+					Metadata: &bzlmodlock.Metadata{
+						Kind:     bzlmodlock.KindBazelModule,
+						RepoName: "rules_java",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := bzlmodlock.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURLAndEcosystem(t *testing.T) {
+	tests := []struct {
+		name          string
+		inv           *extractor.Inventory
+		wantPURL      *purl.PackageURL
+		wantEcosystem string
+	}{
+		{
+			name: "bazel module",
+			inv: &extractor.Inventory{
+				Name:     "rules_go",
+				Version:  "0.41.0",
+				Metadata: &bzlmodlock.Metadata{Kind: bzlmodlock.KindBazelModule},
+			},
+			wantPURL:      &purl.PackageURL{Type: purl.TypeBazel, Name: "rules_go", Version: "0.41.0"},
+			wantEcosystem: "",
+		},
+		{
+			name: "go module",
+			inv: &extractor.Inventory{
+				Name:     "github.com/google/uuid",
+				Version:  "1.6.0",
+				Metadata: &bzlmodlock.Metadata{Kind: bzlmodlock.KindGoModule},
+			},
+			wantPURL:      &purl.PackageURL{Type: purl.TypeGolang, Name: "github.com/google/uuid", Version: "1.6.0"},
+			wantEcosystem: "Go",
+		},
+	}
+	e := bzlmodlock.Extractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.wantPURL, e.ToPURL(tt.inv)); diff != "" {
+				t.Errorf("ToPURL(%v) diff (-want +got):\n%s", tt.inv, diff)
+			}
+			if got := e.Ecosystem(tt.inv); got != tt.wantEcosystem {
+				t.Errorf("Ecosystem(%v) = %q, want %q", tt.inv, got, tt.wantEcosystem)
+			}
+		})
+	}
+}
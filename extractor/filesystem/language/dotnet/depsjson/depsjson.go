@@ -101,6 +101,11 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	return true
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 func (e Extractor) reportFileRequired(path string, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
@@ -85,6 +85,12 @@ func (e Extractor) Version() int { return 0 }
 // Requirements of the extractor.
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
+// Documentation describes the files the extractor reads and the metadata it
+// attaches to inventory. Any executable file can be a Go binary.
+func (e Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{FilePatterns: []string{"**/*"}, Ecosystems: []string{"Go"}, Metadata: &Metadata{}}
+}
+
 // FileRequired returns true if the specified file is marked executable.
 func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	path := api.Path()
@@ -170,6 +176,7 @@ func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err er
 
 func (e *Extractor) extractPackagesFromBuildInfo(binfo *buildinfo.BuildInfo, filename string) ([]*extractor.Inventory, error) {
 	res := []*extractor.Inventory{}
+	md := buildMetadata(binfo)
 
 	validatedGoVers, err := validateGoVersion(binfo.GoVersion)
 	if err != nil {
@@ -179,6 +186,7 @@ func (e *Extractor) extractPackagesFromBuildInfo(binfo *buildinfo.BuildInfo, fil
 		res = append(res, &extractor.Inventory{
 			Name:      "go",
 			Version:   validatedGoVers,
+			Metadata:  md,
 			Locations: []string{filename},
 		})
 	}
@@ -194,6 +202,7 @@ func (e *Extractor) extractPackagesFromBuildInfo(binfo *buildinfo.BuildInfo, fil
 		pkg := &extractor.Inventory{
 			Name:      pkgName,
 			Version:   pkgVers,
+			Metadata:  md,
 			Locations: []string{filename},
 		}
 		res = append(res, pkg)
@@ -202,6 +211,33 @@ func (e *Extractor) extractPackagesFromBuildInfo(binfo *buildinfo.BuildInfo, fil
 	return res, nil
 }
 
+// buildMetadata returns the main module and build settings of the binary, or
+// nil if the binary has neither.
+func buildMetadata(binfo *buildinfo.BuildInfo) *Metadata {
+	if binfo.Main.Path == "" && len(binfo.Settings) == 0 {
+		return nil
+	}
+	md := &Metadata{MainModule: binfo.Main.Path}
+	for _, s := range binfo.Settings {
+		switch {
+		case strings.HasPrefix(s.Key, "-"):
+			md.BuildFlags = append(md.BuildFlags, s.Key+"="+s.Value)
+			if s.Key == "-trimpath" {
+				md.TrimPath = s.Value == "true"
+			}
+		case s.Key == "vcs":
+			md.VCS = s.Value
+		case s.Key == "vcs.revision":
+			md.VCSRevision = s.Value
+		case s.Key == "vcs.time":
+			md.VCSTime = s.Value
+		case s.Key == "vcs.modified":
+			md.VCSModified = s.Value == "true"
+		}
+	}
+	return md
+}
+
 func validateGoVersion(vers string) (string, error) {
 	if vers == "" {
 		return "", errors.New("can't validate empty Go version")
@@ -163,82 +163,95 @@ func TestExtract(t *testing.T) {
 		{
 			name:          "binary_with_module_replacement-darwin-amd64",
 			path:          "testdata/binary_with_module_replacement-darwin-amd64",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-darwin-amd64"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-darwin-amd64", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_module_replacement-darwin-arm64",
 			path:          "testdata/binary_with_module_replacement-darwin-arm64",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-darwin-arm64"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-darwin-arm64", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_module_replacement-linux-386",
 			path:          "testdata/binary_with_module_replacement-linux-386",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-linux-386"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-linux-386", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_module_replacement-linux-amd64",
 			path:          "testdata/binary_with_module_replacement-linux-amd64",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-linux-amd64"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-linux-amd64", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_module_replacement-linux-arm64",
 			path:          "testdata/binary_with_module_replacement-linux-arm64",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-linux-arm64"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-linux-arm64", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_module_replacement-windows-386",
 			path:          "testdata/binary_with_module_replacement-windows-386",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-windows-386"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-windows-386", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_module_replacement-windows-amd64",
 			path:          "testdata/binary_with_module_replacement-windows-amd64",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-windows-amd64"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-windows-amd64", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_module_replacement-windows-arm64",
 			path:          "testdata/binary_with_module_replacement-windows-arm64",
-			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-windows-arm64"),
+			wantInventory: createInventories(append(BinaryWithModuleReplacementPackages, Toolchain), "testdata/binary_with_module_replacement-windows-arm64", ModuleReplacementMetadata),
 		},
 		{
 			name:          "binary_with_modules-darwin-amd64",
 			path:          "testdata/binary_with_modules-darwin-amd64",
-			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-darwin-amd64"),
+			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-darwin-amd64", ModulesMetadata),
 		},
 		{
 			name:          "binary_with_modules-darwin-arm64",
 			path:          "testdata/binary_with_modules-darwin-arm64",
-			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-darwin-arm64"),
+			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-darwin-arm64", ModulesMetadata),
 		},
 		{
 			name:          "binary_with_modules-linux-386",
 			path:          "testdata/binary_with_modules-linux-386",
-			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-linux-386"),
+			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-linux-386", ModulesMetadata),
 		},
 		{
 			name:          "binary_with_modules-linux-amd64",
 			path:          "testdata/binary_with_modules-linux-amd64",
-			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-linux-amd64"),
+			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-linux-amd64", ModulesMetadata),
 		},
 		{
 			name:          "binary_with_modules-linux-arm64",
 			path:          "testdata/binary_with_modules-linux-arm64",
-			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-linux-arm64"),
+			wantInventory: createInventories(append(BinaryWithModulesPackages, Toolchain), "testdata/binary_with_modules-linux-arm64", ModulesMetadata),
 		},
 		{
 			name:          "binary_with_modules-windows-386",
 			path:          "testdata/binary_with_modules-windows-386",
-			wantInventory: createInventories(append(BinaryWithModulesPackagesWindows, Toolchain), "testdata/binary_with_modules-windows-386"),
+			wantInventory: createInventories(append(BinaryWithModulesPackagesWindows, Toolchain), "testdata/binary_with_modules-windows-386", ModulesMetadata),
 		},
 		{
 			name:          "binary_with_modules-windows-amd64",
 			path:          "testdata/binary_with_modules-windows-amd64",
-			wantInventory: createInventories(append(BinaryWithModulesPackagesWindows, Toolchain), "testdata/binary_with_modules-windows-amd64"),
+			wantInventory: createInventories(append(BinaryWithModulesPackagesWindows, Toolchain), "testdata/binary_with_modules-windows-amd64", ModulesMetadata),
 		},
 		{
 			name:          "binary_with_modules-windows-arm64",
 			path:          "testdata/binary_with_modules-windows-arm64",
-			wantInventory: createInventories(append(BinaryWithModulesPackagesWindows, Toolchain), "testdata/binary_with_modules-windows-arm64"),
+			wantInventory: createInventories(append(BinaryWithModulesPackagesWindows, Toolchain), "testdata/binary_with_modules-windows-arm64", ModulesMetadata),
+		},
+		{
+			name: "binary_with_vcs-linux-amd64",
+			path: "testdata/binary_with_vcs-linux-amd64",
+			wantInventory: createInventories([]*extractor.Inventory{goPackage("go", "1.22.12")}, "testdata/binary_with_vcs-linux-amd64", &gobinary.Metadata{
+				MainModule:  "example.com/binary_with_vcs",
+				BuildFlags:  []string{"-buildmode=exe", "-compiler=gc", "-tags=netgo", "-trimpath=true"},
+				TrimPath:    true,
+				VCS:         "git",
+				VCSRevision: "78a9af159e593ae083a757e7af2607a975b6255c",
+				VCSTime:     "2024-05-01T10:00:00Z",
+				VCSModified: true,
+			}),
 		},
 		{
 			name:             "dummy file that fails to parse will log an error metric, but won't fail extraction",
@@ -350,17 +363,29 @@ var (
 	}
 
 	Toolchain = goPackage("go", "1.22.0")
+
+	// ModulesMetadata and ModuleReplacementMetadata are the build settings of
+	// the binary_with_modules-* and binary_with_module_replacement-* testdata
+	// binaries.
+	ModulesMetadata = &gobinary.Metadata{
+		MainModule: "binary_with_modules",
+		BuildFlags: []string{"-buildmode=exe", "-compiler=gc"},
+	}
+	ModuleReplacementMetadata = &gobinary.Metadata{
+		MainModule: "binary_with_module_replacement",
+		BuildFlags: []string{"-buildmode=exe", "-compiler=gc"},
+	}
 )
 
 func goPackage(name, version string) *extractor.Inventory {
 	return &extractor.Inventory{Name: name, Version: version}
 }
 
-func createInventories(invs []*extractor.Inventory, location string) []*extractor.Inventory {
+func createInventories(invs []*extractor.Inventory, location string, md *gobinary.Metadata) []*extractor.Inventory {
 	res := []*extractor.Inventory{}
 	for _, i := range invs {
 		res = append(res, &extractor.Inventory{
-			Name: i.Name, Version: i.Version, Locations: []string{location},
+			Name: i.Name, Version: i.Version, Metadata: md, Locations: []string{location},
 		})
 	}
 	return res
@@ -0,0 +1,39 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gobinary
+
+// Metadata holds the build settings embedded in the Go binary that a package
+// was compiled into. It's the same for all packages found in the binary.
+type Metadata struct {
+	// Module path of the binary's main package, e.g.
+	// "github.com/google/osv-scalibr".
+	MainModule string
+	// The go build flags that were set directly or through GOFLAGS, e.g.
+	// "-tags=netgo" or "-trimpath=true".
+	BuildFlags []string
+	// Whether the binary was built with -trimpath, i.e. without the file
+	// system paths of the build machine.
+	TrimPath bool
+	// The version control system of the main module, e.g. "git". Empty if the
+	// binary wasn't built from a VCS checkout or with -buildvcs=false.
+	VCS string
+	// The revision the binary was built from, e.g. a git commit hash.
+	VCSRevision string
+	// The time of the revision in RFC 3339 format.
+	VCSTime string
+	// Whether the checkout had uncommitted changes, i.e. the binary was built
+	// from a dirty tree and doesn't match VCSRevision.
+	VCSModified bool
+}
@@ -74,9 +74,10 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		name := require.Mod.Path
 		version := strings.TrimPrefix(require.Mod.Version, "v")
 		packages[mapKey{name: name, version: version}] = &extractor.Inventory{
-			Name:      name,
-			Version:   version,
-			Locations: []string{input.Path},
+			Name:             name,
+			Version:          version,
+			Locations:        []string{input.Path},
+			DirectDependency: !require.Indirect,
 		}
 	}
 
@@ -105,9 +106,10 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 
 		for _, replacement := range replacements {
 			packages[replacement] = &extractor.Inventory{
-				Name:      replace.New.Path,
-				Version:   strings.TrimPrefix(replace.New.Version, "v"),
-				Locations: []string{input.Path},
+				Name:             replace.New.Path,
+				Version:          strings.TrimPrefix(replace.New.Version, "v"),
+				Locations:        []string{input.Path},
+				DirectDependency: packages[replacement].DirectDependency,
 			}
 		}
 	}
@@ -115,9 +117,10 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	// Add the Go stdlib as an explicit dependency.
 	if parsedLockfile.Go != nil && parsedLockfile.Go.Version != "" {
 		packages[mapKey{name: "stdlib"}] = &extractor.Inventory{
-			Name:      "stdlib",
-			Version:   parsedLockfile.Go.Version,
-			Locations: []string{input.Path},
+			Name:             "stdlib",
+			Version:          parsedLockfile.Go.Version,
+			Locations:        []string{input.Path},
+			DirectDependency: true,
 		}
 	}
 
@@ -125,7 +128,11 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	// deduplication pass.
 	dedupedPs := map[mapKey]*extractor.Inventory{}
 	for _, p := range packages {
-		dedupedPs[mapKey{name: p.Name, version: p.Version}] = p
+		k := mapKey{name: p.Name, version: p.Version}
+		if existing, ok := dedupedPs[k]; ok && existing.DirectDependency {
+			p.DirectDependency = true
+		}
+		dedupedPs[k] = p
 	}
 	return maps.Values(dedupedPs), nil
 }
@@ -272,9 +272,59 @@ func TestExtractor_Extract(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess), extracttest.IgnoreDependencies); diff != "" {
 				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
 			}
 		})
 	}
 }
+
+func TestExtract_DirectDependencies(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want map[string]bool
+	}{
+		{
+			name: "indirect packages",
+			path: "testdata/indirect-packages.mod",
+			want: map[string]bool{
+				"github.com/BurntSushi/toml":    true,
+				"gopkg.in/yaml.v2":              true,
+				"github.com/mattn/go-colorable": false,
+				"github.com/mattn/go-isatty":    false,
+				"golang.org/x/sys":              false,
+				"stdlib":                        true,
+			},
+		},
+		{
+			name: "replacement keeps the requirement direct",
+			path: "testdata/replace-one.mod",
+			want: map[string]bool{
+				"example.com/fork/net": true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extr := gomod.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: tt.path})
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			inv, err := extr.Extract(context.Background(), &scanInput)
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", tt.path, err)
+			}
+
+			got := map[string]bool{}
+			for _, i := range inv {
+				got[i.Name] = i.DirectDependency
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Extract(%s) direct dependencies diff (-want +got):\n%s", tt.path, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appserver extracts the versions of Java application servers
+// (Tomcat, JBoss/WildFly and WebLogic) and the applications deployed to them,
+// including the libraries bundled into the deployed WAR and EAR files.
+package appserver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "java/appserver"
+
+	// defaultMaxNestedArchiveBytes is the default maximum size of a web
+	// module inside an EAR file that's read into memory.
+	defaultMaxNestedArchiveBytes = 512 * units.MiB
+
+	// maxManifestBytes is the maximum number of bytes read from a MANIFEST.MF.
+	maxManifestBytes = 1 * units.MiB
+)
+
+// Supported application servers.
+const (
+	ServerTomcat   = "tomcat"
+	ServerWildFly  = "wildfly"
+	ServerJBossEAP = "jboss-eap"
+	// ServerJBoss is used for applications deployed to JBoss EAP or WildFly.
+	ServerJBoss    = "jboss"
+	ServerWebLogic = "weblogic"
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// Tomcat's RELEASE-NOTES.
+	kindTomcatReleaseNotes
+	// version.txt of JBoss EAP and WildFly.
+	kindJBossVersion
+	// inventory/registry.xml of an Oracle home with WebLogic.
+	kindWebLogicRegistry
+	// A WAR or EAR file in a deployment directory.
+	kindArchive
+	// The deployment descriptor of an exploded WAR or EAR directory.
+	kindExploded
+)
+
+var (
+	// Directory names of JBoss EAP and WildFly installations, e.g.
+	// /opt/jboss/wildfly or /opt/jboss-eap-7.4.
+	jbossDirPrefixes = []string{"jboss", "wildfly", "eap"}
+	// WebLogic keeps applications deployed through the admin console in
+	// <domain>/servers/<server>/upload/<app>/app/.
+	webLogicUploadDirRe = regexp.MustCompile(`(?:^|/)servers/[^/]+/upload/[^/]+/app$`)
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+	// MaxNestedArchiveBytes is the maximum size of a web module in an EAR file
+	// that's read into memory to list its libraries. If this limit is greater
+	// than zero, the libraries of larger modules aren't listed.
+	MaxNestedArchiveBytes int64
+}
+
+// DefaultConfig returns the default configuration for the application server
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:                 nil,
+		MaxFileSizeBytes:      0,
+		MaxNestedArchiveBytes: defaultMaxNestedArchiveBytes,
+	}
+}
+
+// Extractor extracts Java application servers and their deployments.
+type Extractor struct {
+	stats                 stats.Collector
+	maxFileSizeBytes      int64
+	maxNestedArchiveBytes int64
+}
+
+// New returns an application server extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:                 cfg.Stats,
+		maxFileSizeBytes:      cfg.MaxFileSizeBytes,
+		maxNestedArchiveBytes: cfg.MaxNestedArchiveBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is the release file of an
+// application server or an application deployed to one.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(filepath.ToSlash(path)) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// kindForPath returns the kind of file at p.
+func kindForPath(p string) fileKind {
+	base := path.Base(p)
+	dir := path.Dir(p)
+	switch {
+	case base == "RELEASE-NOTES":
+		return kindTomcatReleaseNotes
+	case base == "version.txt" && hasDirWithPrefix(strings.ToLower(dir), jbossDirPrefixes):
+		return kindJBossVersion
+	case base == "registry.xml" && path.Base(dir) == "inventory":
+		return kindWebLogicRegistry
+	}
+	if archiveType(base) != "" && deploymentServer(dir) != "" {
+		return kindArchive
+	}
+	// Exploded deployments are directories named like the archive, e.g.
+	// webapps/ROOT/WEB-INF/web.xml or deployments/app.ear/META-INF/application.xml.
+	appDir := path.Dir(dir)
+	switch {
+	case base == "web.xml" && path.Base(dir) == "WEB-INF":
+	case base == "application.xml" && path.Base(dir) == "META-INF" && archiveType(path.Base(appDir)) == archiveTypeEAR:
+	default:
+		return kindNone
+	}
+	if deploymentServer(path.Dir(appDir)) == "" {
+		return kindNone
+	}
+	return kindExploded
+}
+
+// hasDirWithPrefix returns true if any of the directories in dir start with
+// one of prefixes.
+func hasDirWithPrefix(dir string, prefixes []string) bool {
+	for _, d := range strings.Split(dir, "/") {
+		for _, p := range prefixes {
+			if strings.HasPrefix(d, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// archiveType returns the type of the application archive with the given
+// file name, or an empty string if it's neither a WAR nor an EAR.
+func archiveType(name string) string {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".war":
+		return archiveTypeWAR
+	case ".ear":
+		return archiveTypeEAR
+	}
+	return ""
+}
+
+// deploymentServer returns the server that deploys the applications in dir,
+// or an empty string if dir isn't a deployment directory.
+func deploymentServer(dir string) string {
+	switch path.Base(dir) {
+	case "webapps":
+		return ServerTomcat
+	case "deployments":
+		if path.Base(path.Dir(dir)) == "standalone" {
+			return ServerJBoss
+		}
+	case "autodeploy":
+		return ServerWebLogic
+	}
+	if webLogicUploadDirRe.MatchString(dir) {
+		return ServerWebLogic
+	}
+	return ""
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract extracts the application server or deployment from the file passed
+// through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("%s extract(%s): %w", e.Name(), input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	p := filepath.ToSlash(input.Path)
+	switch kind := kindForPath(p); kind {
+	case kindTomcatReleaseNotes, kindJBossVersion, kindWebLogicRegistry:
+		var s *server
+		var err error
+		switch kind {
+		case kindTomcatReleaseNotes:
+			s, err = parseTomcatReleaseNotes(input.Reader)
+		case kindJBossVersion:
+			s, err = parseJBossVersionFile(input.Reader)
+		default:
+			s, err = parseWebLogicRegistry(input.Reader)
+		}
+		if err != nil || s == nil {
+			return nil, err
+		}
+		return &extractor.Inventory{
+			Name:      s.name,
+			Version:   s.version,
+			Metadata:  &Metadata{Server: s.name},
+			Locations: []string{input.Path},
+		}, nil
+
+	case kindArchive:
+		zr, err := e.openArchive(input)
+		if err != nil {
+			return nil, err
+		}
+		typ := archiveType(path.Base(p))
+		d, err := inspectDeployment(zr, ".", typ, e.maxNestedArchiveBytes)
+		if err != nil {
+			return nil, err
+		}
+		return deploymentInventory(path.Base(p), deploymentServer(path.Dir(p)), typ, d, input.Path), nil
+
+	case kindExploded:
+		// The descriptor is in WEB-INF/ or META-INF/ of the application.
+		appDir := path.Dir(path.Dir(p))
+		typ := archiveType(path.Base(appDir))
+		if typ == "" {
+			// Tomcat unpacks WAR files next to the archive, which is already reported.
+			if _, err := fs.Stat(input.FS, appDir+".war"); err == nil {
+				return nil, nil
+			}
+			typ = archiveTypeWAR
+		}
+		d, err := inspectDeployment(input.FS, appDir, typ, e.maxNestedArchiveBytes)
+		if err != nil {
+			return nil, err
+		}
+		return deploymentInventory(path.Base(appDir), deploymentServer(path.Dir(appDir)), typ, d, filepath.FromSlash(appDir)), nil
+	}
+	return nil, nil
+}
+
+// openArchive opens the WAR or EAR file passed through the scan input.
+func (e Extractor) openArchive(input *filesystem.ScanInput) (*zip.Reader, error) {
+	r, ok := input.Reader.(io.ReaderAt)
+	if ok && input.Info != nil {
+		return zip.NewReader(r, input.Info.Size())
+	}
+	log.Debugf("Reader of %s does not implement ReaderAt. Fall back to read to memory.", input.Path)
+	b, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return zip.NewReader(bytes.NewReader(b), int64(len(b)))
+}
+
+// deploymentInventory returns the Inventory for an application deployed as
+// name, e.g. "app.war" or the exploded "ROOT" directory.
+func deploymentInventory(name, server, typ string, d *deployment, location string) *extractor.Inventory {
+	if ext := path.Ext(name); archiveType(name) != "" {
+		name = strings.TrimSuffix(name, ext)
+	}
+	return &extractor.Inventory{
+		Name:    name,
+		Version: d.version,
+		Metadata: &Metadata{
+			Server:      server,
+			ArchiveType: typ,
+			Libraries:   d.libraries,
+		},
+		Locations: []string{location},
+	}
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV doesn't track vulnerabilities of
+// application server distributions or custom applications.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
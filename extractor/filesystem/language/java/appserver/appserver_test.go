@@ -0,0 +1,349 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appserver_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/appserver"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "tomcat release notes",
+			path:             "opt/tomcat/RELEASE-NOTES",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "wildfly version file",
+			path:             "opt/jboss/wildfly/version.txt",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated version file",
+			path:         "opt/app/version.txt",
+			wantRequired: false,
+		},
+		{
+			name:             "weblogic registry",
+			path:             "u01/oracle/inventory/registry.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "war in tomcat webapps",
+			path:             "opt/tomcat/webapps/app.war",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "ear in jboss deployments",
+			path:             "opt/jboss/standalone/deployments/app.EAR",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "war uploaded to weblogic",
+			path:             "u01/domains/base/servers/AdminServer/upload/app/app/app.war",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "war outside of a deployment directory",
+			path:         "home/user/app.war",
+			wantRequired: false,
+		},
+		{
+			name:         "deployments dir of something else",
+			path:         "srv/deployments/app.war",
+			wantRequired: false,
+		},
+		{
+			name:             "exploded war",
+			path:             "opt/tomcat/webapps/ROOT/WEB-INF/web.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "exploded ear",
+			path:             "opt/jboss/standalone/deployments/app.ear/META-INF/application.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "web module of an exploded ear",
+			path:         "opt/jboss/standalone/deployments/app.ear/web.war/WEB-INF/web.xml",
+			wantRequired: false,
+		},
+		{
+			name:             "archive not required if size greater than maxFileSizeBytes",
+			path:             "opt/tomcat/webapps/app.war",
+			fileSizeBytes:    1000 * units.MiB,
+			maxFileSizeBytes: 100 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := appserver.New(appserver.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			path := filepath.FromSlash(tt.path)
+			isRequired := e.FileRequired(simplefileapi.New(path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputConfig extracttest.ScanInputMockConfig
+		want        []*extractor.Inventory
+		wantErr     bool
+	}{
+		{
+			name: "tomcat",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/tomcat/RELEASE-NOTES",
+				FakeScanRoot: "testdata/tomcat",
+			},
+			want: []*extractor.Inventory{{
+				Name:      "tomcat",
+				Version:   "9.0.65",
+				Metadata:  &appserver.Metadata{Server: "tomcat"},
+				Locations: []string{"opt/tomcat/RELEASE-NOTES"},
+			}},
+		},
+		{
+			name: "wildfly",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/jboss/wildfly/version.txt",
+				FakeScanRoot: "testdata/wildfly",
+			},
+			want: []*extractor.Inventory{{
+				Name:      "wildfly",
+				Version:   "26.1.1.Final",
+				Metadata:  &appserver.Metadata{Server: "wildfly"},
+				Locations: []string{"opt/jboss/wildfly/version.txt"},
+			}},
+		},
+		{
+			name: "jboss eap",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/jboss-eap-7.4/version.txt",
+				FakeScanRoot: "testdata/jboss_eap",
+			},
+			want: []*extractor.Inventory{{
+				Name:      "jboss-eap",
+				Version:   "7.4.0.GA",
+				Metadata:  &appserver.Metadata{Server: "jboss-eap"},
+				Locations: []string{"opt/jboss-eap-7.4/version.txt"},
+			}},
+		},
+		{
+			name: "weblogic",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "u01/oracle/inventory/registry.xml",
+				FakeScanRoot: "testdata/weblogic",
+			},
+			want: []*extractor.Inventory{{
+				Name:      "weblogic",
+				Version:   "14.1.1.0.0",
+				Metadata:  &appserver.Metadata{Server: "weblogic"},
+				Locations: []string{"u01/oracle/inventory/registry.xml"},
+			}},
+		},
+		{
+			name: "release notes without version",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/tomcat/RELEASE-NOTES",
+				FakeScanRoot: "testdata/no_version",
+			},
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "war in tomcat webapps",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/tomcat/webapps/shop.war",
+				FakeScanRoot: "testdata/war_in_tomcat_webapps",
+			},
+			want: []*extractor.Inventory{{
+				Name:    "shop",
+				Version: "2.3.1",
+				Metadata: &appserver.Metadata{
+					Server:      "tomcat",
+					ArchiveType: "war",
+					Libraries:   []string{"WEB-INF/lib/commons-text-1.9.jar", "WEB-INF/lib/log4j-core-2.14.1.jar"},
+				},
+				Locations: []string{"opt/tomcat/webapps/shop.war"},
+			}},
+		},
+		{
+			name: "ear in jboss deployments",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/jboss/standalone/deployments/shop.ear",
+				FakeScanRoot: "testdata/ear_in_jboss_deployments",
+			},
+			want: []*extractor.Inventory{{
+				Name: "shop",
+				Metadata: &appserver.Metadata{
+					Server:      "jboss",
+					ArchiveType: "ear",
+					Libraries: []string{
+						"lib/jackson-databind-2.13.0.jar",
+						"shop-ejb.jar",
+						"shop-web.war/WEB-INF/lib/spring-web-5.3.20.jar",
+					},
+				},
+				Locations: []string{"opt/jboss/standalone/deployments/shop.ear"},
+			}},
+		},
+		{
+			name: "exploded war",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/tomcat/webapps/ROOT/WEB-INF/web.xml",
+				FakeScanRoot: "testdata/exploded_war",
+			},
+			want: []*extractor.Inventory{{
+				Name:    "ROOT",
+				Version: "2.3.1",
+				Metadata: &appserver.Metadata{
+					Server:      "tomcat",
+					ArchiveType: "war",
+					Libraries:   []string{"WEB-INF/lib/log4j-core-2.14.1.jar"},
+				},
+				Locations: []string{"opt/tomcat/webapps/ROOT"},
+			}},
+		},
+		{
+			name: "war unpacked by tomcat is reported through the archive",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/tomcat/webapps/shop/WEB-INF/web.xml",
+				FakeScanRoot: "testdata/unpacked_war",
+			},
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "exploded ear",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/jboss/standalone/deployments/shop.ear/META-INF/application.xml",
+				FakeScanRoot: "testdata/exploded_ear",
+			},
+			want: []*extractor.Inventory{{
+				Name: "shop",
+				Metadata: &appserver.Metadata{
+					Server:      "jboss",
+					ArchiveType: "ear",
+					Libraries: []string{
+						"lib/jackson-databind-2.13.0.jar",
+						"shop-web.war/WEB-INF/lib/spring-web-5.3.20.jar",
+					},
+				},
+				Locations: []string{"opt/jboss/standalone/deployments/shop.ear"},
+			}},
+		},
+		{
+			name: "invalid archive",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/tomcat/webapps/shop.war",
+				FakeScanRoot: "testdata/invalid_archive",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := appserver.New(appserver.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.inputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := e.Extract(context.Background(), &scanInput)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Extract(%s) error: got %v, want error: %v", tt.inputConfig.Path, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Extract(%s) (-want +got):\n%s", tt.inputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := appserver.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "tomcat",
+		Version:   "9.0.65",
+		Locations: []string{"opt/tomcat/RELEASE-NOTES"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "tomcat",
+		Version: "9.0.65",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
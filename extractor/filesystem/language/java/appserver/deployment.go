@@ -0,0 +1,160 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appserver
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Archive types of deployed applications.
+const (
+	archiveTypeWAR = "war"
+	archiveTypeEAR = "ear"
+)
+
+// deployment is an application deployed to a server.
+type deployment struct {
+	version   string
+	libraries []string
+}
+
+// inspectDeployment lists the libraries bundled into the application at dir
+// in fsys and reads its version from the manifest. fsys is either the server's
+// filesystem for exploded deployments or the opened archive.
+//
+// Web applications keep their libraries in WEB-INF/lib. Enterprise
+// applications keep shared libraries in lib/ and contain their modules, which
+// can be web applications themselves, at the top level.
+func inspectDeployment(fsys fs.FS, dir, archiveType string, maxNestedBytes int64) (*deployment, error) {
+	d := &deployment{version: manifestVersion(fsys, path.Join(dir, "META-INF", "MANIFEST.MF"))}
+	if archiveType == archiveTypeWAR {
+		libs, err := jarsIn(fsys, path.Join(dir, "WEB-INF", "lib"))
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range libs {
+			d.libraries = append(d.libraries, path.Join("WEB-INF", "lib", l))
+		}
+		return d, nil
+	}
+
+	libs, err := jarsIn(fsys, path.Join(dir, "lib"))
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range libs {
+		d.libraries = append(d.libraries, path.Join("lib", l))
+	}
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		switch strings.ToLower(path.Ext(name)) {
+		case ".jar":
+			if !e.IsDir() {
+				d.libraries = append(d.libraries, name)
+			}
+		case ".war":
+			module, err := inspectModule(fsys, path.Join(dir, name), e, maxNestedBytes)
+			if err != nil {
+				return nil, fmt.Errorf("module %s: %w", name, err)
+			}
+			for _, l := range module.libraries {
+				d.libraries = append(d.libraries, path.Join(name, l))
+			}
+		}
+	}
+	slices.Sort(d.libraries)
+	return d, nil
+}
+
+// inspectModule inspects a web module of an enterprise application, which is
+// either a directory or a nested archive.
+func inspectModule(fsys fs.FS, p string, e fs.DirEntry, maxNestedBytes int64) (*deployment, error) {
+	if e.IsDir() {
+		return inspectDeployment(fsys, p, archiveTypeWAR, maxNestedBytes)
+	}
+	info, err := e.Info()
+	if err != nil {
+		return nil, err
+	}
+	if maxNestedBytes > 0 && info.Size() > maxNestedBytes {
+		return nil, fmt.Errorf("size %d exceeds the limit of %d", info.Size(), maxNestedBytes)
+	}
+	b, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return nil, err
+	}
+	return inspectDeployment(zr, ".", archiveTypeWAR, maxNestedBytes)
+}
+
+// jarsIn returns the names of the JAR files in dir, which might not exist.
+func jarsIn(fsys fs.FS, dir string) ([]string, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var jars []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.EqualFold(path.Ext(e.Name()), ".jar") {
+			jars = append(jars, e.Name())
+		}
+	}
+	return jars, nil
+}
+
+// manifestVersion returns the version of the application from its
+// MANIFEST.MF, or an empty string if it doesn't have one.
+func manifestVersion(fsys fs.FS, p string) string {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	var specVersion string
+	s := bufio.NewScanner(io.LimitReader(f, maxManifestBytes))
+	for s.Scan() {
+		key, value, ok := strings.Cut(s.Text(), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "Implementation-Version":
+			return value
+		case "Specification-Version":
+			specVersion = value
+		}
+	}
+	return specVersion
+}
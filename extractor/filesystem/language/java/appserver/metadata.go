@@ -0,0 +1,29 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appserver
+
+// Metadata holds information about an application server installation or an
+// application deployed to it.
+type Metadata struct {
+	// The application server, e.g. "tomcat", "wildfly", "jboss-eap" or
+	// "weblogic". For deployments to JBoss and WildFly, this is "jboss".
+	Server string
+	// The type of a deployed application, "war" or "ear". Empty for the server
+	// installation itself.
+	ArchiveType string
+	// Paths of the libraries bundled into a deployed application, relative to
+	// the application, e.g. "WEB-INF/lib/log4j-core-2.14.1.jar".
+	Libraries []string
+}
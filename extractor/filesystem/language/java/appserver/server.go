@@ -0,0 +1,104 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appserver
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	// The first line of Tomcat's RELEASE-NOTES is a license header, the version
+	// follows in a line like "Apache Tomcat Version 9.0.65".
+	tomcatVersionRe = regexp.MustCompile(`Apache Tomcat Version ([0-9][0-9A-Za-z.\-]*)`)
+	// version.txt of WildFly, e.g. "WildFly Full 26.1.1.Final (WildFly Core 18.1.1.Final) - 2022-06-28".
+	wildflyVersionRe = regexp.MustCompile(`^WildFly(?: Full)? ([0-9][0-9A-Za-z.\-]*)`)
+	// version.txt of JBoss EAP, e.g.
+	// "Red Hat JBoss Enterprise Application Platform - Version 7.4.0.GA".
+	jbossEAPVersionRe = regexp.MustCompile(`JBoss Enterprise Application Platform - Version ([0-9][0-9A-Za-z.\-]*)`)
+)
+
+// server is an application server installation.
+type server struct {
+	name    string
+	version string
+}
+
+// parseTomcatReleaseNotes returns the Tomcat version from its RELEASE-NOTES.
+func parseTomcatReleaseNotes(r io.Reader) (*server, error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if m := tomcatVersionRe.FindStringSubmatch(s.Text()); m != nil {
+			return &server{name: ServerTomcat, version: m[1]}, nil
+		}
+	}
+	return nil, s.Err()
+}
+
+// parseJBossVersionFile returns the WildFly or JBoss EAP version from the
+// version.txt in the server's home directory.
+func parseJBossVersionFile(r io.Reader) (*server, error) {
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if m := wildflyVersionRe.FindStringSubmatch(line); m != nil {
+			return &server{name: ServerWildFly, version: m[1]}, nil
+		}
+		if m := jbossEAPVersionRe.FindStringSubmatch(line); m != nil {
+			return &server{name: ServerJBossEAP, version: m[1]}, nil
+		}
+	}
+	return nil, s.Err()
+}
+
+// parseWebLogicRegistry returns the WebLogic version from the registry.xml in
+// the inventory of an Oracle home, which lists the installed distributions:
+//
+//	<distribution status="installed" name="WebLogic Server" version="12.2.1.4.0">
+func parseWebLogicRegistry(r io.Reader) (*server, error) {
+	d := xml.NewDecoder(r)
+	for {
+		t, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing registry.xml: %w", err)
+		}
+		se, ok := t.(xml.StartElement)
+		if !ok || se.Name.Local != "distribution" {
+			continue
+		}
+		var name, version, status string
+		for _, a := range se.Attr {
+			switch a.Name.Local {
+			case "name":
+				name = a.Value
+			case "version":
+				version = a.Value
+			case "status":
+				status = a.Value
+			}
+		}
+		if strings.Contains(name, "WebLogic Server") && version != "" && status != "removed" {
+			return &server{name: ServerWebLogic, version: version}, nil
+		}
+	}
+}
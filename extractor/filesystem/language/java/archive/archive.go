@@ -29,6 +29,7 @@ import (
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
@@ -75,6 +76,10 @@ type Config struct {
 	ExtractFromFilename bool
 	// HashJars configures if JAR files should be hashed with base64(sha1()), which can be used in deps.dev.
 	HashJars bool
+	// IdentifyByContent configures if files without a Java archive extension should be extracted
+	// if their content identifies them as JAR files, e.g. renamed or extensionless archives.
+	// This reads the header of every file that isn't skipped by its extension.
+	IdentifyByContent bool
 	// Stats is a stats collector for reporting metrics.
 	Stats stats.Collector
 }
@@ -87,6 +92,7 @@ type Extractor struct {
 	minZipBytes         int
 	extractFromFilename bool
 	hashJars            bool
+	identifyByContent   bool
 	stats               stats.Collector
 }
 
@@ -117,6 +123,7 @@ func New(cfg Config) *Extractor {
 		minZipBytes:         cfg.MinZipBytes,
 		extractFromFilename: cfg.ExtractFromFilename,
 		hashJars:            cfg.HashJars,
+		identifyByContent:   cfg.IdentifyByContent,
 		stats:               cfg.Stats,
 	}
 }
@@ -133,7 +140,8 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 // FileRequired returns true if the specified file matches java archive file patterns.
 func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	path := api.Path()
-	if !isArchive(filepath.ToSlash(path)) {
+	byExtension := isArchive(filepath.ToSlash(path))
+	if !byExtension && !e.identifyByContent {
 		return false
 	}
 
@@ -141,6 +149,15 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	if err != nil {
 		return false
 	}
+	if !byExtension {
+		// Check the size first since identifying the content reads the file.
+		if fileinfo.Size() < int64(e.minZipBytes) {
+			return false
+		}
+		if t, err := api.ContentType(); err != nil || t != filetype.JAR {
+			return false
+		}
+	}
 	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
 		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
 		return false
@@ -150,6 +167,11 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	return true
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
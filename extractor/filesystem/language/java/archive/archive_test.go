@@ -17,6 +17,7 @@ package archive_test
 import (
 	"archive/zip"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -45,12 +46,14 @@ var (
 
 func TestFileRequired(t *testing.T) {
 	tests := []struct {
-		name             string
-		path             string
-		fileSizeBytes    int64
-		maxFileSizeBytes int64
-		wantRequired     bool
-		wantResultMetric stats.FileRequiredResult
+		name              string
+		path              string
+		header            []byte
+		fileSizeBytes     int64
+		maxFileSizeBytes  int64
+		identifyByContent bool
+		wantRequired      bool
+		wantResultMetric  stats.FileRequiredResult
 	}{
 		{
 			name:         ".jar",
@@ -117,6 +120,45 @@ func TestFileRequired(t *testing.T) {
 			path:         filepath.FromSlash("some/path/a"),
 			wantRequired: false,
 		},
+		{
+			name:          "no extension ignored even if content is jar",
+			path:          filepath.FromSlash("some/path/a"),
+			header:        zipHeader("META-INF/MANIFEST.MF"),
+			fileSizeBytes: 1000,
+			wantRequired:  false,
+		},
+		{
+			name:              "no extension required if content is jar",
+			path:              filepath.FromSlash("some/path/a"),
+			header:            zipHeader("META-INF/MANIFEST.MF"),
+			fileSizeBytes:     1000,
+			identifyByContent: true,
+			wantRequired:      true,
+		},
+		{
+			name:              "other extension required if content is jar",
+			path:              filepath.FromSlash("some/path/a.bak"),
+			header:            zipHeader("META-INF/"),
+			fileSizeBytes:     1000,
+			identifyByContent: true,
+			wantRequired:      true,
+		},
+		{
+			name:              "zip without manifest not required",
+			path:              filepath.FromSlash("some/path/a"),
+			header:            zipHeader("README.md"),
+			fileSizeBytes:     1000,
+			identifyByContent: true,
+			wantRequired:      false,
+		},
+		{
+			name:              "file smaller than an empty zip not required",
+			path:              filepath.FromSlash("some/path/a"),
+			header:            zipHeader("META-INF/"),
+			fileSizeBytes:     10,
+			identifyByContent: true,
+			wantRequired:      false,
+		},
 		{
 			name:             ".jar required if size less than maxFileSizeBytes",
 			path:             filepath.FromSlash("some/path/a.jar"),
@@ -152,17 +194,18 @@ func TestFileRequired(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			collector := testcollector.New()
 			cfg := defaultConfigWith(archive.Config{
-				MaxFileSizeBytes: tt.maxFileSizeBytes,
-				Stats:            collector,
+				MaxFileSizeBytes:  tt.maxFileSizeBytes,
+				IdentifyByContent: tt.identifyByContent,
+				Stats:             collector,
 			})
 
 			var e filesystem.Extractor = archive.New(cfg)
 
-			if got := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+			if got := e.FileRequired(simplefileapi.NewWithHeader(tt.path, fakefs.FakeFileInfo{
 				FileName: filepath.Base(tt.path),
 				FileMode: fs.ModePerm,
 				FileSize: tt.fileSizeBytes,
-			})); got != tt.wantRequired {
+			}, tt.header)); got != tt.wantRequired {
 				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, got, tt.wantRequired)
 			}
 
@@ -174,6 +217,15 @@ func TestFileRequired(t *testing.T) {
 	}
 }
 
+// zipHeader returns the local file header of a zip file whose first entry
+// is name.
+func zipHeader(name string) []byte {
+	h := make([]byte, 30)
+	copy(h, "PK\x03\x04")
+	binary.LittleEndian.PutUint16(h[26:], uint16(len(name)))
+	return append(h, name...)
+}
+
 func TestExtract(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -642,6 +694,7 @@ func defaultConfigWith(cfg archive.Config) archive.Config {
 	// ignores defaults
 	newCfg.ExtractFromFilename = cfg.ExtractFromFilename
 	newCfg.HashJars = cfg.HashJars
+	newCfg.IdentifyByContent = cfg.IdentifyByContent
 	return newCfg
 }
 
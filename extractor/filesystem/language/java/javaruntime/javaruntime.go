@@ -0,0 +1,373 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package javaruntime extracts Java runtime installations (JDKs and JREs) from
+// their release files, including the cryptographic policy in effect and the
+// contents of the cacerts trust store.
+package javaruntime
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "java/runtime"
+
+	// maxTrustStoreBytes is the maximum size of a cacerts file that's read.
+	maxTrustStoreBytes = 10 * units.MiB
+	// maxPolicyJarBytes is the maximum size of a JCE policy file that's read.
+	maxPolicyJarBytes = 1 * units.MiB
+)
+
+// Cryptographic policies of the Java Cryptography Extension.
+const (
+	CryptoPolicyUnlimited = "unlimited"
+	CryptoPolicyLimited   = "limited"
+)
+
+var (
+	// Directory names that identify a Java installation, e.g. /usr/lib/jvm/,
+	// /opt/java/openjdk/ or C:\Program Files\Java\jdk-17\.
+	javaDirMarkers = []string{"java", "jdk", "jre", "jvm"}
+
+	// JAVA_VERSION of Java 8 and older, e.g. "1.8.0_391".
+	legacyVersionRe = regexp.MustCompile(`^1\.(\d+)\.\d+(?:_(\d+))?`)
+	// JAVA_VERSION of Java 9 and newer, e.g. "17.0.9".
+	versionRe = regexp.MustCompile(`^(\d+)`)
+)
+
+// The locations of files relative to the Java home directory. JDK 8 keeps
+// the runtime files in a jre/ subdirectory.
+var (
+	trustStorePaths   = []string{"lib/security/cacerts", "jre/lib/security/cacerts"}
+	securityPropPaths = []string{"conf/security/java.security", "lib/security/java.security", "jre/lib/security/java.security"}
+	policyJarPaths    = []string{"lib/security/local_policy.jar", "jre/lib/security/local_policy.jar"}
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Java runtime
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: 0,
+	}
+}
+
+// Extractor extracts Java runtime installations.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a Java runtime extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is the release file of a
+// Java installation.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if !isReleaseFile(filepath.ToSlash(path)) {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+func isReleaseFile(p string) bool {
+	if path.Base(p) != "release" {
+		return false
+	}
+	for _, d := range strings.Split(strings.ToLower(path.Dir(p)), "/") {
+		for _, m := range javaDirMarkers {
+			if strings.Contains(d, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract extracts the Java installation described by the release file passed
+// through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("%s extract(%s): %w", e.Name(), input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	props, err := parseRelease(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	version := props["JAVA_VERSION"]
+	if version == "" {
+		// Not the release file of a Java installation.
+		return nil, nil
+	}
+
+	home := path.Dir(filepath.ToSlash(input.Path))
+	m := &Metadata{
+		Vendor:         props["IMPLEMENTOR"],
+		RuntimeVersion: props["JAVA_RUNTIME_VERSION"],
+		ImageType:      props["IMAGE_TYPE"],
+		CryptoPolicy:   cryptoPolicy(input.FS, home, version),
+		TrustStore:     trustStore(input.FS, home),
+	}
+	if m.ImageType == "" {
+		m.ImageType = "JRE"
+		if _, err := fs.Stat(input.FS, path.Join(home, "bin/javac")); err == nil {
+			m.ImageType = "JDK"
+		}
+	}
+	return &extractor.Inventory{
+		Name:      "java",
+		Version:   version,
+		Metadata:  m,
+		Locations: []string{input.Path},
+	}, nil
+}
+
+// parseRelease parses the KEY="value" lines of a release file.
+func parseRelease(r io.Reader) (map[string]string, error) {
+	props := make(map[string]string)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		k, v, ok := strings.Cut(strings.TrimSpace(s.Text()), "=")
+		if !ok || strings.HasPrefix(k, "#") {
+			continue
+		}
+		props[strings.TrimSpace(k)] = strings.Trim(strings.TrimSpace(v), `"`)
+	}
+	return props, s.Err()
+}
+
+// cryptoPolicy returns the JCE policy in effect for the installation at home.
+//
+// The policy is configured through the crypto.policy security property since
+// Java 8u151. Older releases ship the policy in local_policy.jar, which had to
+// be replaced with the unlimited strength version to use strong keys.
+func cryptoPolicy(fsys fs.FS, home, version string) string {
+	for _, p := range securityPropPaths {
+		b, err := readFile(fsys, path.Join(home, p), maxTrustStoreBytes)
+		if err != nil {
+			continue
+		}
+		if v := securityProperty(b, "crypto.policy"); v != "" {
+			return v
+		}
+		break
+	}
+	for _, p := range policyJarPaths {
+		b, err := readFile(fsys, path.Join(home, p), maxPolicyJarBytes)
+		if err != nil {
+			continue
+		}
+		if policy := policyFromJar(b); policy != "" {
+			return policy
+		}
+	}
+	return defaultCryptoPolicy(version)
+}
+
+// securityProperty returns the value of key in a java.security file.
+func securityProperty(b []byte, key string) string {
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		k, v, ok := strings.Cut(strings.TrimSpace(s.Text()), "=")
+		if ok && strings.TrimSpace(k) == key {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// policyFromJar returns the policy granted by a local_policy.jar.
+func policyFromJar(b []byte) string {
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		return ""
+	}
+	f, err := zr.Open("default_local.policy")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	policy, err := io.ReadAll(io.LimitReader(f, maxPolicyJarBytes))
+	if err != nil {
+		return ""
+	}
+	if bytes.Contains(policy, []byte("CryptoAllPermission")) {
+		return CryptoPolicyUnlimited
+	}
+	return CryptoPolicyLimited
+}
+
+// defaultCryptoPolicy returns the policy used by a Java version if it isn't
+// configured. The unlimited policy is the default since Java 8u161.
+func defaultCryptoPolicy(version string) string {
+	if m := legacyVersionRe.FindStringSubmatch(version); m != nil {
+		major, _ := strconv.Atoi(m[1])
+		update, _ := strconv.Atoi(m[2])
+		if major == 8 && update >= 161 {
+			return CryptoPolicyUnlimited
+		}
+		return CryptoPolicyLimited
+	}
+	if m := versionRe.FindStringSubmatch(version); m != nil {
+		if major, _ := strconv.Atoi(m[1]); major >= 9 {
+			return CryptoPolicyUnlimited
+		}
+	}
+	return ""
+}
+
+// trustStore returns the cacerts trust store of the installation at home.
+func trustStore(fsys fs.FS, home string) *TrustStore {
+	for _, p := range trustStorePaths {
+		p = path.Join(home, p)
+		b, err := readFile(fsys, p, maxTrustStoreBytes)
+		if err != nil {
+			continue
+		}
+		format, certs, err := parseTrustStore(b, time.Now())
+		if err != nil {
+			log.Warnf("Failed to parse trust store %s: %v", p, err)
+			return nil
+		}
+		return &TrustStore{Path: p, Format: format, Certificates: certs}
+	}
+	return nil
+}
+
+func readFile(fsys fs.FS, p string, limit int64) ([]byte, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(io.LimitReader(f, limit))
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV doesn't track vulnerabilities of
+// Java runtime distributions.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
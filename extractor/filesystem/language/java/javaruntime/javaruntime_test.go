@@ -0,0 +1,262 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javaruntime_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/javaruntime"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+var (
+	future = time.Date(2999, 1, 1, 0, 0, 0, 0, time.UTC)
+	past   = time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "debian openjdk",
+			path:             "usr/lib/jvm/java-17-openjdk-amd64/release",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "oracle jdk on macos",
+			path:             "Library/Java/JavaVirtualMachines/jdk-21.jdk/Contents/Home/release",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "windows jre",
+			path:             "Program Files/Java/jre1.8.0_391/release",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "release file of another product",
+			path:         "opt/app/release",
+			wantRequired: false,
+		},
+		{
+			name:         "other file in a jdk",
+			path:         "usr/lib/jvm/java-17-openjdk-amd64/lib/security/cacerts",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "usr/lib/jvm/java-17-openjdk-amd64/release",
+			fileSizeBytes:    1000 * units.MiB,
+			maxFileSizeBytes: 100 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := javaruntime.New(javaruntime.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			path := filepath.FromSlash(tt.path)
+			isRequired := e.FileRequired(simplefileapi.New(path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "jdk 17 with pkcs12 trust store",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "usr/lib/jvm/java-17-openjdk/release",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "java",
+				Version:   "17.0.9",
+				Locations: []string{"usr/lib/jvm/java-17-openjdk/release"},
+				Metadata: &javaruntime.Metadata{
+					Vendor:         "Eclipse Adoptium",
+					RuntimeVersion: "17.0.9+9",
+					ImageType:      "JDK",
+					CryptoPolicy:   javaruntime.CryptoPolicyLimited,
+					TrustStore: &javaruntime.TrustStore{
+						Path:   "usr/lib/jvm/java-17-openjdk/lib/security/cacerts",
+						Format: javaruntime.TrustStorePKCS12,
+						Certificates: []*javaruntime.Certificate{
+							{
+								Alias:    "globalroot [jdk]",
+								Subject:  "CN=Global Root,O=Test",
+								NotAfter: future,
+							},
+							{
+								Alias:    "legacyroot [jdk]",
+								Subject:  "CN=Legacy Root,O=Test",
+								NotAfter: past,
+								Expired:  true,
+							},
+							{
+								Alias:    "corp-proxy",
+								Subject:  "CN=Corp Intercept CA,O=Test",
+								NotAfter: future,
+								Added:    true,
+							},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "jdk 8 with jks trust store and unlimited policy jar",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/jdk1.8.0_141/release",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "java",
+				Version:   "1.8.0_141",
+				Locations: []string{"opt/jdk1.8.0_141/release"},
+				Metadata: &javaruntime.Metadata{
+					ImageType:    "JDK",
+					CryptoPolicy: javaruntime.CryptoPolicyUnlimited,
+					TrustStore: &javaruntime.TrustStore{
+						Path:   "opt/jdk1.8.0_141/jre/lib/security/cacerts",
+						Format: javaruntime.TrustStoreJKS,
+						Certificates: []*javaruntime.Certificate{
+							{
+								Alias:    "globalroot [jdk]",
+								Subject:  "CN=Global Root,O=Test",
+								NotAfter: future,
+							},
+							{
+								Alias:    "mitmproxy",
+								Subject:  "CN=mitmproxy,O=Test",
+								NotAfter: past,
+								Expired:  true,
+								Added:    true,
+							},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "jre without trust store uses default policy",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/java/openjdk/release",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "java",
+				Version:   "21.0.1",
+				Locations: []string{"opt/java/openjdk/release"},
+				Metadata: &javaruntime.Metadata{
+					Vendor:         "Oracle Corporation",
+					RuntimeVersion: "21.0.1+12-LTS-29",
+					ImageType:      "JRE",
+					CryptoPolicy:   javaruntime.CryptoPolicyUnlimited,
+				},
+			}},
+		},
+		{
+			Name: "release file without java version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/java/other/release",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := javaruntime.New(javaruntime.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := javaruntime.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "java",
+		Version:   "17.0.9",
+		Locations: []string{"usr/lib/jvm/java-17-openjdk/release"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "java",
+		Version: "17.0.9",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
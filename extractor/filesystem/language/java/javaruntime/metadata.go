@@ -0,0 +1,56 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javaruntime
+
+import "time"
+
+// Metadata holds information about a Java runtime installation.
+type Metadata struct {
+	// The IMPLEMENTOR of the release file, e.g. "Oracle Corporation" or
+	// "Eclipse Adoptium".
+	Vendor string
+	// The full runtime version, e.g. "17.0.9+11-LTS-201".
+	RuntimeVersion string
+	// "JDK" or "JRE".
+	ImageType string
+	// The cryptographic policy in effect, CryptoPolicyUnlimited or
+	// CryptoPolicyLimited. Empty if it couldn't be determined.
+	CryptoPolicy string
+	// The cacerts trust store of the installation, nil if none was found.
+	TrustStore *TrustStore
+}
+
+// TrustStore is the cacerts trust store of a Java installation.
+type TrustStore struct {
+	// Path of the trust store, relative to the scan root.
+	Path string
+	// The keystore format, TrustStoreJKS or TrustStorePKCS12.
+	Format       string
+	Certificates []*Certificate
+}
+
+// Certificate is a trusted certificate entry of a trust store.
+type Certificate struct {
+	Alias    string
+	Subject  string
+	NotAfter time.Time
+	// Whether the certificate expired at the time of the scan.
+	Expired bool
+	// Whether the certificate was added to the trust store shipped with the
+	// runtime, e.g. with keytool -importcert. Only detected for trust stores
+	// whose shipped certificates carry the " [jdk]" alias suffix of OpenJDK
+	// builds.
+	Added bool
+}
@@ -0,0 +1,291 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javaruntime
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// Trust store formats.
+const (
+	TrustStoreJKS    = "jks"
+	TrustStorePKCS12 = "pkcs12"
+)
+
+// jdkAliasSuffix is appended to the aliases of the certificates shipped in
+// the cacerts of OpenJDK builds.
+const jdkAliasSuffix = " [jdk]"
+
+const (
+	jksMagic = 0xFEEDFEED
+
+	jksPrivateKeyEntry  = 1
+	jksTrustedCertEntry = 2
+)
+
+var (
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidCertBag       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidFriendlyName  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 20}
+	oidX509CertInBag = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+
+	errUnsupportedKeystore = errors.New("unsupported keystore format")
+)
+
+// rawCert is a certificate entry before it's parsed.
+type rawCert struct {
+	alias string
+	der   []byte
+}
+
+// parseTrustStore parses a JKS or PKCS12 trust store. Certificates that can't
+// be parsed are skipped.
+func parseTrustStore(b []byte, now time.Time) (format string, certs []*Certificate, err error) {
+	var raw []rawCert
+	if len(b) >= 4 && binary.BigEndian.Uint32(b) == jksMagic {
+		format = TrustStoreJKS
+		raw, err = parseJKS(bytes.NewReader(b[4:]))
+	} else {
+		format = TrustStorePKCS12
+		raw, err = parsePKCS12(b)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	hasJDKAliases := false
+	for _, r := range raw {
+		if strings.HasSuffix(r.alias, jdkAliasSuffix) {
+			hasJDKAliases = true
+			break
+		}
+	}
+	for _, r := range raw {
+		c, err := x509.ParseCertificate(r.der)
+		if err != nil {
+			continue
+		}
+		certs = append(certs, &Certificate{
+			Alias:    r.alias,
+			Subject:  c.Subject.String(),
+			NotAfter: c.NotAfter.UTC(),
+			Expired:  now.After(c.NotAfter),
+			Added:    hasJDKAliases && !strings.HasSuffix(r.alias, jdkAliasSuffix),
+		})
+	}
+	return format, certs, nil
+}
+
+// parseJKS parses the entries of a Java KeyStore following the magic number.
+// The integrity checksum at the end of the file isn't verified since that
+// requires the store password.
+func parseJKS(r io.Reader) ([]rawCert, error) {
+	var header struct {
+		Version, Count uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, fmt.Errorf("reading JKS header: %w", err)
+	}
+	if header.Version != 1 && header.Version != 2 {
+		return nil, fmt.Errorf("%w: JKS version %d", errUnsupportedKeystore, header.Version)
+	}
+
+	readCert := func() ([]byte, error) {
+		if header.Version == 2 {
+			if _, err := readJKSString(r); err != nil {
+				return nil, err
+			}
+		}
+		return readJKSBytes(r)
+	}
+
+	var result []rawCert
+	for i := uint32(0); i < header.Count; i++ {
+		var tag uint32
+		if err := binary.Read(r, binary.BigEndian, &tag); err != nil {
+			return nil, fmt.Errorf("reading JKS entry: %w", err)
+		}
+		alias, err := readJKSString(r)
+		if err != nil {
+			return nil, err
+		}
+		// Creation date in milliseconds since the epoch.
+		var created int64
+		if err := binary.Read(r, binary.BigEndian, &created); err != nil {
+			return nil, err
+		}
+		switch tag {
+		case jksTrustedCertEntry:
+			der, err := readCert()
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, rawCert{alias: alias, der: der})
+		case jksPrivateKeyEntry:
+			if _, err := readJKSBytes(r); err != nil {
+				return nil, err
+			}
+			var chainLen uint32
+			if err := binary.Read(r, binary.BigEndian, &chainLen); err != nil {
+				return nil, err
+			}
+			for j := uint32(0); j < chainLen; j++ {
+				if _, err := readCert(); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%w: JKS entry type %d", errUnsupportedKeystore, tag)
+		}
+	}
+	return result, nil
+}
+
+// readJKSString reads a string written by Java's DataOutput.writeUTF.
+func readJKSString(r io.Reader) (string, error) {
+	var n uint16
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func readJKSBytes(r io.Reader) ([]byte, error) {
+	var n uint32
+	if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return nil, err
+	}
+	// Bound allocations for corrupt files, certificates are a few KiB.
+	if n > 1<<20 {
+		return nil, fmt.Errorf("JKS entry of %d bytes is too large", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+type pfxPDU struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  asn1.RawValue `asn1:"optional"`
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue     `asn1:"tag:0,explicit"`
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue `asn1:"set"`
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+// parsePKCS12 returns the certificates of a PKCS #12 trust store. Only
+// unencrypted certificate bags are read, as used by the password-less cacerts
+// of recent JDKs.
+func parsePKCS12(b []byte) ([]rawCert, error) {
+	var pfx pfxPDU
+	if _, err := asn1.Unmarshal(b, &pfx); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUnsupportedKeystore, err)
+	}
+	if !pfx.AuthSafe.ContentType.Equal(oidData) {
+		return nil, fmt.Errorf("%w: PKCS #12 content type %v", errUnsupportedKeystore, pfx.AuthSafe.ContentType)
+	}
+	var authSafe []contentInfo
+	if err := unmarshalOctets(pfx.AuthSafe.Content, &authSafe); err != nil {
+		return nil, err
+	}
+
+	var result []rawCert
+	for _, ci := range authSafe {
+		// Encrypted content requires the store password.
+		if !ci.ContentType.Equal(oidData) {
+			continue
+		}
+		var bags []safeBag
+		if err := unmarshalOctets(ci.Content, &bags); err != nil {
+			return nil, err
+		}
+		for _, bag := range bags {
+			if !bag.ID.Equal(oidCertBag) {
+				continue
+			}
+			var cb certBag
+			if _, err := asn1.Unmarshal(bag.Value.Bytes, &cb); err != nil || !cb.ID.Equal(oidX509CertInBag) {
+				continue
+			}
+			result = append(result, rawCert{alias: friendlyName(bag.Attributes), der: cb.Data})
+		}
+	}
+	return result, nil
+}
+
+// unmarshalOctets unmarshals the DER value wrapped in the OCTET STRING of the
+// explicitly tagged content v.
+func unmarshalOctets(v asn1.RawValue, out any) error {
+	var octets []byte
+	if _, err := asn1.Unmarshal(v.Bytes, &octets); err != nil {
+		return fmt.Errorf("parsing PKCS #12 content: %w", err)
+	}
+	if _, err := asn1.Unmarshal(octets, out); err != nil {
+		return fmt.Errorf("parsing PKCS #12 content: %w", err)
+	}
+	return nil
+}
+
+// friendlyName returns the alias stored in the attributes of a safe bag.
+func friendlyName(attrs []pkcs12Attribute) string {
+	for _, a := range attrs {
+		if !a.ID.Equal(oidFriendlyName) {
+			continue
+		}
+		var bmp asn1.RawValue
+		if _, err := asn1.Unmarshal(a.Value.Bytes, &bmp); err != nil || len(bmp.Bytes)%2 != 0 {
+			return ""
+		}
+		u := make([]uint16, len(bmp.Bytes)/2)
+		for i := range u {
+			u[i] = binary.BigEndian.Uint16(bmp.Bytes[2*i:])
+		}
+		return string(utf16.Decode(u))
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package maveninstall extracts maven_install.json lockfiles written by
+// rules_jvm_external for Bazel projects.
+package maveninstall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/javalockfile"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// mavenInstallFile is the union of the v1 ("dependency_tree") and v2
+// ("artifacts") lockfile formats of rules_jvm_external.
+type mavenInstallFile struct {
+	// v2: keyed by "group:artifact", optionally followed by the packaging
+	// and classifier.
+	Artifacts map[string]struct {
+		Version string `json:"version"`
+	} `json:"artifacts"`
+	// v1: coordinates are "group:artifact[:packaging[:classifier]]:version".
+	DependencyTree *struct {
+		Dependencies []struct {
+			Coord string `json:"coord"`
+		} `json:"dependencies"`
+	} `json:"dependency_tree"`
+}
+
+// Extractor extracts Maven packages from maven_install.json files.
+type Extractor struct{}
+
+// Name of the extractor
+func (e Extractor) Name() string { return "java/maveninstall" }
+
+// Version of the extractor
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor
+func (e Extractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{}
+}
+
+// FileRequired returns true if the specified file is a rules_jvm_external
+// lockfile. Besides the default maven_install.json name, lockfiles of
+// additional maven_install repositories are usually named
+// <repo>_maven_install.json.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	base := filepath.Base(api.Path())
+	return base == "maven_install.json" || strings.HasSuffix(base, "_maven_install.json")
+}
+
+// Extract extracts packages from maven_install.json files passed through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var f mavenInstallFile
+	if err := json.NewDecoder(input.Reader).Decode(&f); err != nil {
+		return nil, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	// Classifiers such as "sources" show up as separate entries of the same
+	// package.
+	seen := map[string]bool{}
+	pkgs := []*extractor.Inventory{}
+	add := func(group, artifact, version string) {
+		if group == "" || artifact == "" || version == "" {
+			return
+		}
+		key := group + ":" + artifact + ":" + version
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		pkgs = append(pkgs, &extractor.Inventory{
+			Name:    group + ":" + artifact,
+			Version: version,
+			Metadata: &javalockfile.Metadata{
+				ArtifactID: artifact,
+				GroupID:    group,
+			},
+			Locations: []string{input.Path},
+		})
+	}
+
+	for key, a := range f.Artifacts {
+		parts := strings.Split(key, ":")
+		if len(parts) < 2 {
+			continue
+		}
+		add(parts[0], parts[1], a.Version)
+	}
+	if f.DependencyTree != nil {
+		for _, d := range f.DependencyTree.Dependencies {
+			parts := strings.Split(d.Coord, ":")
+			if len(parts) < 3 {
+				continue
+			}
+			add(parts[0], parts[1], parts[len(parts)-1])
+		}
+	}
+
+	return pkgs, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m := i.Metadata.(*javalockfile.Metadata)
+	return &purl.PackageURL{
+		Type:      purl.TypeMaven,
+		Namespace: strings.ToLower(m.GroupID),
+		Name:      strings.ToLower(m.ArtifactID),
+		Version:   i.Version,
+	}
+}
+
+// Ecosystem returns the OSV ecosystem ('Maven') of the software extracted by this extractor.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string {
+	return "Maven"
+}
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,173 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maveninstall_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/javalockfile"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/maveninstall"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestExtractor_FileRequired(t *testing.T) {
+	tests := []struct {
+		inputPath string
+		want      bool
+	}{
+		{inputPath: "maven_install.json", want: true},
+		{inputPath: "path/to/my/maven_install.json", want: true},
+		{inputPath: "path/to/my/unpinned_maven_install.json", want: true},
+		{inputPath: "path/to/my/maven_install.json/file", want: false},
+		{inputPath: "path/to/my/maven_install.json.bak", want: false},
+		{inputPath: "path/to/my/install.json", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.inputPath, func(t *testing.T) {
+			e := maveninstall.Extractor{}
+			got := e.FileRequired(simplefileapi.New(tt.inputPath, nil))
+			if got != tt.want {
+				t.Errorf("FileRequired(%s, FileInfo) got = %v, want %v", tt.inputPath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractor_Extract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "invalid json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid.json",
+			},
+			WantErr: extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+		{
+			Name: "empty",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/empty.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "v2 format",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/v2_maven_install.json",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "com.google.guava:failureaccess",
+					Version:   "1.0.1",
+					Locations: []string{"testdata/v2_maven_install.json"},
+					Metadata: &javalockfile.Metadata{
+						ArtifactID: "failureaccess",
+						GroupID:    "com.google.guava",
+					},
+				},
+				{
+					Name:      "com.google.guava:guava",
+					Version:   "32.1.2-jre",
+					Locations: []string{"testdata/v2_maven_install.json"},
+					Metadata: &javalockfile.Metadata{
+						ArtifactID: "guava",
+						GroupID:    "com.google.guava",
+					},
+				},
+				{
+					Name:      "io.netty:netty-transport-native-epoll",
+					Version:   "4.1.100.Final",
+					Locations: []string{"testdata/v2_maven_install.json"},
+					Metadata: &javalockfile.Metadata{
+						ArtifactID: "netty-transport-native-epoll",
+						GroupID:    "io.netty",
+					},
+				},
+			},
+		},
+		{
+			Name: "v1 format",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/v1_maven_install.json",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "junit:junit",
+					Version:   "4.13.2",
+					Locations: []string{"testdata/v1_maven_install.json"},
+					Metadata: &javalockfile.Metadata{
+						ArtifactID: "junit",
+						GroupID:    "junit",
+					},
+				},
+				{
+					Name:      "org.hamcrest:hamcrest-core",
+					Version:   "1.3",
+					Locations: []string{"testdata/v1_maven_install.json"},
+					Metadata: &javalockfile.Metadata{
+						ArtifactID: "hamcrest-core",
+						GroupID:    "org.hamcrest",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := maveninstall.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := maveninstall.Extractor{}
+	i := &extractor.Inventory{
+		Name:    "com.google.guava:guava",
+		Version: "32.1.2-jre",
+		Metadata: &javalockfile.Metadata{
+			ArtifactID: "guava",
+			GroupID:    "com.google.guava",
+		},
+	}
+	want := &purl.PackageURL{
+		Type:      purl.TypeMaven,
+		Namespace: "com.google.guava",
+		Name:      "guava",
+		Version:   "32.1.2-jre",
+	}
+	if diff := cmp.Diff(want, e.ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) diff (-want +got):\n%s", i, diff)
+	}
+}
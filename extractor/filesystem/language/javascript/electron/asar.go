@@ -0,0 +1,136 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electron
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+)
+
+// maxHeaderBytes is the maximum size of an asar header. Headers are a JSON
+// listing of all files in the archive and rarely exceed a few MiB.
+const maxHeaderBytes = 64 * 1024 * 1024
+
+// entry is a file, directory or symlink in an asar archive.
+//
+// The header of an archive is a JSON object of the form
+//
+//	{"files": {"package.json": {"size": 123, "offset": "0"}, "node_modules": {"files": {...}}}}
+//
+// where the offset of a file is relative to the end of the header. Files
+// with "unpacked" set are stored next to the archive in <archive>.unpacked/.
+type entry struct {
+	Files    map[string]*entry `json:"files"`
+	Size     int64             `json:"size"`
+	Offset   string            `json:"offset"`
+	Unpacked bool              `json:"unpacked"`
+	Link     string            `json:"link"`
+}
+
+// archive is an opened asar archive.
+type archive struct {
+	r io.ReaderAt
+	// size of the archive file.
+	size int64
+	root *entry
+	// dataOffset is the position of the first file's contents in the archive.
+	dataOffset int64
+}
+
+// openArchive parses the header of the asar archive in r.
+//
+// The header is stored as two nested Chromium pickles: The first one only
+// contains the size of the second one, which contains the JSON listing.
+func openArchive(r io.ReaderAt, size int64) (*archive, error) {
+	var sizePickle [8]byte
+	if _, err := r.ReadAt(sizePickle[:], 0); err != nil {
+		return nil, fmt.Errorf("reading header size: %w", err)
+	}
+	if binary.LittleEndian.Uint32(sizePickle[:4]) != 4 {
+		return nil, errors.New("not an asar archive: invalid size pickle")
+	}
+	headerSize := int64(binary.LittleEndian.Uint32(sizePickle[4:]))
+	if headerSize < 8 || headerSize > maxHeaderBytes || 8+headerSize > size {
+		return nil, fmt.Errorf("invalid header size %d", headerSize)
+	}
+
+	header := make([]byte, headerSize)
+	if _, err := r.ReadAt(header, 8); err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	jsonSize := int64(binary.LittleEndian.Uint32(header[4:8]))
+	if 8+jsonSize > headerSize {
+		return nil, fmt.Errorf("invalid header JSON size %d", jsonSize)
+	}
+	root := &entry{}
+	if err := json.Unmarshal(header[8:8+jsonSize], root); err != nil {
+		return nil, fmt.Errorf("parsing header: %w", err)
+	}
+	return &archive{r: r, size: size, root: root, dataOffset: 8 + headerSize}, nil
+}
+
+// walk calls fn for all regular files in the archive in lexical order of
+// their paths.
+func (a *archive) walk(fn func(name string, e *entry) error) error {
+	return walkEntry("", a.root, fn)
+}
+
+func walkEntry(dir string, e *entry, fn func(name string, e *entry) error) error {
+	names := make([]string, 0, len(e.Files))
+	for name := range e.Files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		child := e.Files[name]
+		if child == nil || child.Link != "" {
+			continue
+		}
+		p := path.Join(dir, name)
+		if child.Files != nil {
+			if err := walkEntry(p, child, fn); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := fn(p, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFile returns the contents of a file that's packed into the archive.
+func (a *archive) readFile(e *entry) ([]byte, error) {
+	offset, err := strconv.ParseInt(e.Offset, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offset %q: %w", e.Offset, err)
+	}
+	start := a.dataOffset + offset
+	if offset < 0 || e.Size < 0 || start+e.Size > a.size {
+		return nil, fmt.Errorf("file at offset %d with size %d is out of bounds", offset, e.Size)
+	}
+	b := make([]byte, e.Size)
+	if _, err := a.r.ReadAt(b, start); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,309 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package electron extracts the npm packages bundled into the app.asar
+// archives of Electron apps as well as the versions of Electron and Chromium
+// the apps ship with.
+package electron
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "javascript/electron"
+
+	// defaultMaxBinarySizeBytes is the default maximum size of an Electron
+	// binary the extractor will scan for the Electron and Chromium versions.
+	defaultMaxBinarySizeBytes = 1024 * units.MiB
+
+	// maxPackageJSONBytes is the maximum size of a package.json file in an
+	// archive that's parsed.
+	maxPackageJSONBytes = 10 * units.MiB
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of an asar archive that can be
+	// extracted. If this limit is greater than zero and a file is encountered
+	// that is larger than this limit, the file is ignored by returning false
+	// for `FileRequired`.
+	// Only the header and the package.json files of an archive are read so
+	// large archives are unlimited by default.
+	MaxFileSizeBytes int64
+	// MaxBinarySizeBytes is the maximum size of an Electron binary that's
+	// scanned for the Electron and Chromium versions. If this limit is greater
+	// than zero, larger binaries are skipped.
+	MaxBinarySizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Electron extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:              nil,
+		MaxFileSizeBytes:   0,
+		MaxBinarySizeBytes: defaultMaxBinarySizeBytes,
+	}
+}
+
+// Extractor extracts npm packages and the Electron runtime from Electron apps.
+type Extractor struct {
+	stats              stats.Collector
+	maxFileSizeBytes   int64
+	maxBinarySizeBytes int64
+}
+
+// New returns an Electron extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:              cfg.Stats,
+		maxFileSizeBytes:   cfg.MaxFileSizeBytes,
+		maxBinarySizeBytes: cfg.MaxBinarySizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is the app.asar archive of
+// an Electron app.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := filepath.ToSlash(api.Path())
+	if !isAppArchive(path) {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// isAppArchive returns true for the app.asar archive in the resources
+// directory of an Electron app. Other archives such as Electron's own
+// default_app.asar don't contain app code.
+func isAppArchive(p string) bool {
+	return path.Base(p) == "app.asar" && strings.EqualFold(path.Base(path.Dir(p)), "resources")
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract extracts the packages bundled into the asar archive passed through
+// the scan input and the Electron runtime of the app it belongs to.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extractFromInput(ctx, input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("%s extract(%s): %w", e.Name(), input.Path, err)
+	}
+	return inventory, nil
+}
+
+func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	r, ok := input.Reader.(io.ReaderAt)
+	var size int64
+	if input.Info != nil {
+		size = input.Info.Size()
+	}
+	if !ok || input.Info == nil {
+		log.Debugf("Reader of %s does not implement ReaderAt. Fall back to read to memory.", input.Path)
+		b, err := io.ReadAll(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(b)
+		size = int64(len(b))
+	}
+
+	a, err := openArchive(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var app packageJSON
+	var deps []*extractor.Inventory
+	err = a.walk(func(name string, entry *entry) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if name != "package.json" && !isDependencyManifest(name) {
+			return nil
+		}
+		p, err := e.readPackageJSON(input, a, name, entry)
+		if err != nil {
+			log.Debugf("%s: skipping %s in %s: %v", e.Name(), name, input.Path, err)
+			return nil
+		}
+		if name == "package.json" {
+			app = *p
+			return nil
+		}
+		if p.Name == "" || p.Version == "" {
+			return nil
+		}
+		deps = append(deps, &extractor.Inventory{
+			Name:      p.Name,
+			Version:   p.Version,
+			Locations: []string{filepath.Join(input.Path, name)},
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := []*extractor.Inventory{}
+	versions := e.findRuntimeVersions(ctx, input.FS, filepath.ToSlash(input.Path))
+	if versions.electron != "" {
+		inventory = append(inventory, &extractor.Inventory{
+			Name:    "electron",
+			Version: versions.electron,
+			Metadata: &Metadata{
+				AppName:         app.Name,
+				AppVersion:      app.Version,
+				ChromiumVersion: versions.chromium,
+			},
+			Locations: []string{input.Path},
+		})
+	}
+	for _, i := range deps {
+		i.Metadata = &Metadata{AppName: app.Name, AppVersion: app.Version}
+		inventory = append(inventory, i)
+	}
+	return inventory, nil
+}
+
+// isDependencyManifest returns true if name is the package.json of a package
+// installed into a node_modules directory, e.g. "node_modules/a/package.json"
+// or "node_modules/a/node_modules/@b/c/package.json".
+func isDependencyManifest(name string) bool {
+	parts := strings.Split(name, "/")
+	n := len(parts)
+	if n < 3 || parts[n-1] != "package.json" {
+		return false
+	}
+	if parts[n-3] == "node_modules" && !strings.HasPrefix(parts[n-2], "@") {
+		return true
+	}
+	return n >= 4 && parts[n-4] == "node_modules" && strings.HasPrefix(parts[n-3], "@")
+}
+
+type packageJSON struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// readPackageJSON parses the package.json at name in the archive. Files that
+// are excluded from packing are read from the <archive>.unpacked directory.
+func (e Extractor) readPackageJSON(input *filesystem.ScanInput, a *archive, name string, entry *entry) (*packageJSON, error) {
+	if entry.Size > maxPackageJSONBytes {
+		return nil, fmt.Errorf("size %d exceeds the limit of %d", entry.Size, maxPackageJSONBytes)
+	}
+	var b []byte
+	var err error
+	if entry.Unpacked {
+		b, err = fs.ReadFile(input.FS, path.Join(filepath.ToSlash(input.Path)+".unpacked", name))
+	} else {
+		b, err = a.readFile(entry)
+	}
+	if err != nil {
+		return nil, err
+	}
+	p := &packageJSON{}
+	if err := json.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeNPM,
+		Name:    strings.ToLower(i.Name),
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns the OSV ecosystem ('npm') of the software extracted by
+// this extractor. Electron advisories are also published for its npm package.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "npm" }
+
+var _ filesystem.Extractor = Extractor{}
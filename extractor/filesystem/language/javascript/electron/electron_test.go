@@ -0,0 +1,285 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electron_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/electron"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "linux app",
+			path:             "opt/Signal/resources/app.asar",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "macOS app",
+			path:             "Applications/Slack.app/Contents/Resources/app.asar",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "electron's default app",
+			path:         "opt/Signal/resources/default_app.asar",
+			wantRequired: false,
+		},
+		{
+			name:         "archive outside of a resources dir",
+			path:         "home/user/app.asar",
+			wantRequired: false,
+		},
+		{
+			name:         "unpacked file",
+			path:         "opt/Signal/resources/app.asar.unpacked/node_modules/a/package.json",
+			wantRequired: false,
+		},
+		{
+			name:             "archive not required if size greater than maxFileSizeBytes",
+			path:             "opt/Signal/resources/app.asar",
+			fileSizeBytes:    1000 * units.MiB,
+			maxFileSizeBytes: 100 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "large archive required by default",
+			path:             "opt/Signal/resources/app.asar",
+			fileSizeBytes:    1000 * units.MiB,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := electron.New(electron.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+// appMetadata is the metadata of packages bundled into the app archive.
+var appMetadata = &electron.Metadata{AppName: "signal-desktop", AppVersion: "7.0.0"}
+
+// appDeps are the packages extracted from the app archive at
+// opt/Signal/resources/app.asar.
+var appDeps = []*extractor.Inventory{
+	{
+		Name:      "@signalapp/ringrtc",
+		Version:   "2.39.0",
+		Metadata:  appMetadata,
+		Locations: []string{"opt/Signal/resources/app.asar/node_modules/@signalapp/ringrtc/package.json"},
+	},
+	{
+		Name:      "a",
+		Version:   "1.0.0",
+		Metadata:  appMetadata,
+		Locations: []string{"opt/Signal/resources/app.asar/node_modules/lodash/node_modules/a/package.json"},
+	},
+	{
+		Name:      "lodash",
+		Version:   "4.17.20",
+		Metadata:  appMetadata,
+		Locations: []string{"opt/Signal/resources/app.asar/node_modules/lodash/package.json"},
+	},
+	{
+		Name:      "sqlite3",
+		Version:   "5.1.6",
+		Metadata:  appMetadata,
+		Locations: []string{"opt/Signal/resources/app.asar/node_modules/sqlite3/package.json"},
+	},
+}
+
+// userAgent is the version string embedded in Electron binaries.
+const userAgent = "Chrome/120.0.6099.56 Electron/28.1.0"
+
+func TestExtract(t *testing.T) {
+	electronApp := &extractor.Inventory{
+		Name:    "electron",
+		Version: "28.1.0",
+		Metadata: &electron.Metadata{
+			AppName:         "signal-desktop",
+			AppVersion:      "7.0.0",
+			ChromiumVersion: "120.0.6099.56",
+		},
+		Locations: []string{"opt/Signal/resources/app.asar"},
+	}
+	tests := []struct {
+		name          string
+		inputConfig   extracttest.ScanInputMockConfig
+		want          []*extractor.Inventory
+		wantErr       bool
+		maxBinarySize int64
+	}{
+		{
+			name: "linux app with version file",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/Signal/resources/app.asar",
+				FakeScanRoot: "testdata/linux",
+			},
+			want: append([]*extractor.Inventory{electronApp}, appDeps...),
+		},
+		{
+			name: "versions from windows executable",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/Signal/resources/app.asar",
+				FakeScanRoot: "testdata/windows",
+			},
+			want: append([]*extractor.Inventory{electronApp}, appDeps...),
+		},
+		{
+			// The binary is read in chunks of 1 MiB plus a 128 byte overlap. The
+			// first chunk ends after "Chrome/120.0.6099.5".
+			name: "version string across chunk boundary",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/Signal/resources/app.asar",
+				FakeScanRoot: "testdata/chunkboundary",
+			},
+			want: append([]*extractor.Inventory{electronApp}, appDeps...),
+		},
+		{
+			name: "binary above size limit",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/Signal/resources/app.asar",
+				FakeScanRoot: "testdata/chunkboundary",
+			},
+			maxBinarySize: 10,
+			want:          appDeps,
+		},
+		{
+			name: "macOS app",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "Applications/Signal.app/Contents/Resources/app.asar",
+				FakeScanRoot: "testdata/macos",
+			},
+			want: []*extractor.Inventory{{
+				Name:    "electron",
+				Version: "28.1.0",
+				Metadata: &electron.Metadata{
+					AppName:    "signal-desktop",
+					AppVersion: "7.0.0",
+				},
+				Locations: []string{"Applications/Signal.app/Contents/Resources/app.asar"},
+			}},
+		},
+		{
+			name: "no Electron runtime found",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/Signal/resources/app.asar",
+				FakeScanRoot: "testdata/noruntime",
+			},
+			want: appDeps,
+		},
+		{
+			name: "not an asar archive",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/Signal/resources/app.asar",
+				FakeScanRoot: "testdata/invalid",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := electron.DefaultConfig()
+			if tt.maxBinarySize != 0 {
+				cfg.MaxBinarySizeBytes = tt.maxBinarySize
+			}
+			e := electron.New(cfg)
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.inputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := e.Extract(context.Background(), &scanInput)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Extract(%s) succeeded, want error", tt.inputConfig.Path)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", tt.inputConfig.Path, err)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Extract(%s) (-want +got):\n%s", tt.inputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := electron.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "@SignalApp/RingRTC",
+		Version:   "2.39.0",
+		Locations: []string{"opt/Signal/resources/app.asar/node_modules/@signalapp/ringrtc/package.json"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeNPM,
+		Name:    "@signalapp/ringrtc",
+		Version: "2.39.0",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electron
+
+// Metadata holds information about the Electron app a package was found in.
+type Metadata struct {
+	// Name and version of the app from the package.json at the root of its
+	// asar archive.
+	AppName    string
+	AppVersion string
+	// Version of the Chromium engine Electron is built on. Only set for the
+	// "electron" package.
+	ChromiumVersion string
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package electron
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/log"
+	"github.com/groob/plist"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+const (
+	// binaryChunkSize is the number of bytes read from an Electron binary at once.
+	binaryChunkSize = 1024 * 1024
+	// binaryChunkOverlap is the number of bytes consecutive chunks overlap so
+	// that version strings crossing chunk boundaries are still found.
+	binaryChunkOverlap = 128
+)
+
+var (
+	// Electron binaries embed their user agent, which contains the Electron
+	// and Chromium versions, e.g. "Chrome/120.0.6099.56 Electron/28.1.0".
+	electronVersionRe = regexp.MustCompile(`Electron/([0-9]+\.[0-9]+\.[0-9]+(?:-[0-9A-Za-z.]+)?)`)
+	chromiumVersionRe = regexp.MustCompile(`Chrome/([0-9]+\.[0-9]+\.[0-9]+\.[0-9]+)`)
+
+	// Files shipped next to the main executable of Electron apps that don't
+	// contain the version strings.
+	nonMainExecutables = map[string]bool{
+		"chrome-sandbox":          true,
+		"chrome_crashpad_handler": true,
+		"crashpad_handler":        true,
+	}
+)
+
+// runtimeVersions are the versions of the Electron runtime an app is bundled with.
+type runtimeVersions struct {
+	electron string
+	chromium string
+}
+
+// findRuntimeVersions determines the Electron and Chromium versions of the
+// app the asar archive at asarPath belongs to.
+//
+// Packaged apps keep the archive in <app>/resources/ on Linux and Windows
+// and in <app>.app/Contents/Resources/ on macOS. The Electron version is read
+// from the "version" file that's shipped in the app's directory or from the
+// Info.plist of the Electron framework. Both versions are also embedded in
+// the Electron binary, which is scanned if they couldn't be found otherwise.
+func (e Extractor) findRuntimeVersions(ctx context.Context, fsys scalibrfs.FS, asarPath string) runtimeVersions {
+	resourcesDir := path.Dir(asarPath)
+	appDir := path.Dir(resourcesDir)
+
+	var v runtimeVersions
+	var binaries []string
+	if path.Base(resourcesDir) == "Resources" && path.Base(appDir) == "Contents" {
+		framework := path.Join(appDir, "Frameworks", "Electron Framework.framework")
+		v.electron = frameworkVersion(fsys, path.Join(framework, "Resources", "Info.plist"))
+		binaries = []string{
+			path.Join(framework, "Electron Framework"),
+			path.Join(framework, "Versions", "A", "Electron Framework"),
+		}
+	} else {
+		v.electron = versionFileVersion(fsys, path.Join(appDir, "version"))
+		binaries = mainExecutables(fsys, appDir)
+	}
+
+	for _, b := range binaries {
+		if v.electron != "" && v.chromium != "" {
+			break
+		}
+		electron, chromium, err := e.scanBinary(ctx, fsys, b)
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				log.Debugf("electron: scanning %s for version strings failed: %v", b, err)
+			}
+			continue
+		}
+		if v.electron == "" {
+			v.electron = electron
+		}
+		if v.chromium == "" {
+			v.chromium = chromium
+		}
+	}
+	return v
+}
+
+// versionFileVersion returns the Electron version stored in the "version"
+// file that Electron ships next to its executable.
+func versionFileVersion(fsys scalibrfs.FS, p string) string {
+	b, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return ""
+	}
+	v := strings.TrimPrefix(strings.TrimSpace(string(b)), "v")
+	if !electronVersionRe.MatchString("Electron/" + v) {
+		return ""
+	}
+	return v
+}
+
+// frameworkVersion returns the version of the Electron framework from its
+// Info.plist.
+func frameworkVersion(fsys scalibrfs.FS, p string) string {
+	b, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		return ""
+	}
+	var info struct {
+		CFBundleVersion string `plist:"CFBundleVersion"`
+	}
+	if err := plist.Unmarshal(b, &info); err != nil {
+		log.Debugf("electron: parsing %s failed: %v", p, err)
+		return ""
+	}
+	return info.CFBundleVersion
+}
+
+// mainExecutables returns the paths of the files in appDir that could be the
+// app's main executable, which is a renamed Electron binary.
+func mainExecutables(fsys scalibrfs.FS, appDir string) []string {
+	entries, err := fsys.ReadDir(appDir)
+	if err != nil {
+		return nil
+	}
+	var result []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || nonMainExecutables[name] {
+			continue
+		}
+		if strings.HasSuffix(strings.ToLower(name), ".exe") {
+			result = append(result, path.Join(appDir, name))
+			continue
+		}
+		// Linux executables don't have an extension. This skips shared
+		// libraries and other resources.
+		if strings.Contains(name, ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Mode().Perm()&0111 == 0 {
+			continue
+		}
+		result = append(result, path.Join(appDir, name))
+	}
+	return result
+}
+
+// scanBinary searches the Electron binary at p for the Electron and Chromium
+// version strings.
+func (e Extractor) scanBinary(ctx context.Context, fsys scalibrfs.FS, p string) (electron, chromium string, err error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return "", "", err
+	}
+	if e.maxBinarySizeBytes > 0 && info.Size() > e.maxBinarySizeBytes {
+		log.Debugf("electron: skipping %s with size %d above the limit of %d", p, info.Size(), e.maxBinarySizeBytes)
+		return "", "", nil
+	}
+
+	buf := make([]byte, 0, binaryChunkOverlap+binaryChunkSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", "", err
+		}
+		n, err := io.ReadFull(f, buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+n]
+		eof := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !eof {
+			return "", "", err
+		}
+		// Matches that reach into the retained tail might be truncated. They're
+		// found again in the next chunk.
+		limit := len(buf) - binaryChunkOverlap
+		if eof {
+			limit = len(buf)
+		}
+		if electron == "" {
+			electron = findVersion(electronVersionRe, buf, limit)
+		}
+		if chromium == "" {
+			chromium = findVersion(chromiumVersionRe, buf, limit)
+		}
+		if eof || (electron != "" && chromium != "") {
+			return electron, chromium, nil
+		}
+		buf = buf[:copy(buf, buf[len(buf)-binaryChunkOverlap:])]
+	}
+}
+
+// findVersion returns the version captured by the first match of re in data
+// that ends at or before limit.
+func findVersion(re *regexp.Regexp, data []byte, limit int) string {
+	for _, m := range re.FindAllSubmatchIndex(data, -1) {
+		if m[1] <= limit {
+			return string(data[m[2]:m[3]])
+		}
+	}
+	return ""
+}
@@ -39,6 +39,9 @@ type npmLockDependency struct {
 	// For an aliased package, Version is like "npm:[name]@[version]"
 	Version      string                       `json:"version"`
 	Dependencies map[string]npmLockDependency `json:"dependencies,omitempty"`
+	// The dependencies of the package, resolved like node does: in the nested
+	// dependencies of the package, then of its parents.
+	Requires map[string]string `json:"requires,omitempty"`
 
 	Dev      bool `json:"dev,omitempty"`
 	Optional bool `json:"optional,omitempty"`
@@ -55,6 +58,11 @@ type npmLockPackage struct {
 	Optional    bool `json:"optional,omitempty"`
 
 	Link bool `json:"link,omitempty"`
+
+	Dependencies         map[string]string `json:"dependencies,omitempty"`
+	DevDependencies      map[string]string `json:"devDependencies,omitempty"`
+	OptionalDependencies map[string]string `json:"optionalDependencies,omitempty"`
+	PeerDependencies     map[string]string `json:"peerDependencies,omitempty"`
 }
 
 type npmLockfile struct {
@@ -66,10 +74,12 @@ type npmLockfile struct {
 }
 
 type packageDetails struct {
-	Name      string
-	Version   string
-	Commit    string
-	DepGroups []string
+	Name         string
+	Version      string
+	Commit       string
+	DepGroups    []string
+	Dependencies []extractor.Dependency
+	Direct       bool
 }
 
 type npmPackageDetailsMap map[string]packageDetails
@@ -100,6 +110,12 @@ func (pdm npmPackageDetailsMap) add(key string, details packageDetails) {
 
 	if ok {
 		details.DepGroups = mergeNpmDepsGroups(existing, details)
+		details.Direct = details.Direct || existing.Direct
+		for _, d := range existing.Dependencies {
+			if !slices.Contains(details.Dependencies, d) {
+				details.Dependencies = append(details.Dependencies, d)
+			}
+		}
 	}
 
 	pdm[key] = details
@@ -119,54 +135,85 @@ func (dep npmLockDependency) depGroups() []string {
 	return nil
 }
 
-func parseNpmLockDependencies(dependencies map[string]npmLockDependency) map[string]packageDetails {
+// resolve returns the name and version of the package the dependency
+// resolved to, the commit if it was installed from version control, and the
+// key the package is deduplicated by.
+func (dep npmLockDependency) resolve(name string) (finalName, finalVersion, commit, key string) {
+	version := dep.Version
+	finalVersion = version
+
+	// If the package is aliased, get the name and version
+	// E.g. npm:string-width@^4.2.0
+	if strings.HasPrefix(dep.Version, "npm:") {
+		i := strings.LastIndex(dep.Version, "@")
+		name = dep.Version[4:i]
+		finalVersion = dep.Version[i+1:]
+	}
+
+	// we can't resolve a version from a "file:" dependency
+	if strings.HasPrefix(dep.Version, "file:") {
+		finalVersion = ""
+	} else {
+		commit = commitextractor.TryExtractCommit(dep.Version)
+
+		// if there is a commit, we want to deduplicate based on that rather than
+		// the version (the versions must match anyway for the commits to match)
+		//
+		// we also don't actually know what the "version" is, so blank it
+		if commit != "" {
+			finalVersion = ""
+			version = commit
+		}
+	}
+
+	return name, finalVersion, commit, name + "@" + version
+}
+
+// parseNpmLockDependencies parses the dependencies of a v1 lockfile. scopes
+// are the dependency maps the required packages are looked up in, starting
+// with the innermost one.
+func parseNpmLockDependencies(dependencies map[string]npmLockDependency, scopes []map[string]npmLockDependency) map[string]packageDetails {
 	details := npmPackageDetailsMap{}
+	scopes = append([]map[string]npmLockDependency{dependencies}, scopes...)
 
 	for name, detail := range dependencies {
+		depScopes := scopes
 		if detail.Dependencies != nil {
-			nestedDeps := parseNpmLockDependencies(detail.Dependencies)
+			nestedDeps := parseNpmLockDependencies(detail.Dependencies, scopes)
 			for k, v := range nestedDeps {
 				details.add(k, v)
 			}
+			depScopes = append([]map[string]npmLockDependency{detail.Dependencies}, scopes...)
 		}
 
-		version := detail.Version
-		finalVersion := version
-		commit := ""
+		finalName, finalVersion, commit, key := detail.resolve(name)
+		details.add(key, packageDetails{
+			Name:         finalName,
+			Version:      finalVersion,
+			Commit:       commit,
+			DepGroups:    detail.depGroups(),
+			Dependencies: resolveNpmLockRequires(detail.Requires, depScopes),
+		})
+	}
 
-		// If the package is aliased, get the name and version
-		// E.g. npm:string-width@^4.2.0
-		if strings.HasPrefix(detail.Version, "npm:") {
-			i := strings.LastIndex(detail.Version, "@")
-			name = detail.Version[4:i]
-			finalVersion = detail.Version[i+1:]
-		}
+	return details
+}
 
-		// we can't resolve a version from a "file:" dependency
-		if strings.HasPrefix(detail.Version, "file:") {
-			finalVersion = ""
-		} else {
-			commit = commitextractor.TryExtractCommit(detail.Version)
-
-			// if there is a commit, we want to deduplicate based on that rather than
-			// the version (the versions must match anyway for the commits to match)
-			//
-			// we also don't actually know what the "version" is, so blank it
-			if commit != "" {
-				finalVersion = ""
-				version = commit
+func resolveNpmLockRequires(requires map[string]string, scopes []map[string]npmLockDependency) []extractor.Dependency {
+	names := maps.Keys(requires)
+	slices.Sort(names)
+
+	var deps []extractor.Dependency
+	for _, name := range names {
+		for _, scope := range scopes {
+			if dep, ok := scope[name]; ok {
+				depName, depVersion, _, _ := dep.resolve(name)
+				deps = append(deps, extractor.Dependency{Name: depName, Version: depVersion})
+				break
 			}
 		}
-
-		details.add(name+"@"+version, packageDetails{
-			Name:      name,
-			Version:   finalVersion,
-			Commit:    commit,
-			DepGroups: detail.depGroups(),
-		})
 	}
-
-	return details
+	return deps
 }
 
 func extractNpmPackageName(name string) string {
@@ -194,18 +241,82 @@ func (pkg npmLockPackage) depGroups() []string {
 	return nil
 }
 
+// name returns the name of the package installed at namePath.
+func (pkg npmLockPackage) name(namePath string) string {
+	if pkg.Name != "" {
+		return pkg.Name
+	}
+	return extractNpmPackageName(namePath)
+}
+
+// resolveNpmLockPackage returns the path of the package that a dependency on
+// name of the package at from resolves to, looking for it in the node_modules
+// directory of the package and then of its parents like node does.
+func resolveNpmLockPackage(packages map[string]npmLockPackage, from string, name string) (string, bool) {
+	dir := from
+	for {
+		p := path.Join(dir, "node_modules", name)
+		if pkg, ok := packages[p]; ok {
+			// Workspace packages are linked into node_modules.
+			if pkg.Link && pkg.Resolved != "" {
+				if _, ok := packages[pkg.Resolved]; ok {
+					return pkg.Resolved, true
+				}
+			}
+			return p, true
+		}
+		if dir == "" {
+			return "", false
+		}
+		if i := strings.LastIndex(dir, "node_modules/"); i >= 0 {
+			dir = strings.TrimSuffix(dir[:i], "/")
+		} else {
+			dir = ""
+		}
+	}
+}
+
+// npmLockPackageDependencies returns the dependencies of the package at
+// namePath. Development dependencies are only installed for the root project.
+func npmLockPackageDependencies(packages map[string]npmLockPackage, namePath string) []extractor.Dependency {
+	pkg := packages[namePath]
+	names := map[string]bool{}
+	for _, m := range []map[string]string{pkg.Dependencies, pkg.OptionalDependencies, pkg.PeerDependencies} {
+		for n := range m {
+			names[n] = true
+		}
+	}
+	if namePath == "" {
+		for n := range pkg.DevDependencies {
+			names[n] = true
+		}
+	}
+
+	sorted := maps.Keys(names)
+	slices.Sort(sorted)
+
+	var deps []extractor.Dependency
+	for _, n := range sorted {
+		p, ok := resolveNpmLockPackage(packages, namePath, n)
+		if !ok {
+			continue
+		}
+		dep := packages[p]
+		deps = append(deps, extractor.Dependency{Name: dep.name(p), Version: dep.Version})
+	}
+	return deps
+}
+
 func parseNpmLockPackages(packages map[string]npmLockPackage) map[string]packageDetails {
 	details := npmPackageDetailsMap{}
+	direct := npmLockPackageDependencies(packages, "")
 
 	for namePath, detail := range packages {
 		if namePath == "" {
 			continue
 		}
 
-		finalName := detail.Name
-		if finalName == "" {
-			finalName = extractNpmPackageName(namePath)
-		}
+		finalName := detail.name(namePath)
 
 		finalVersion := detail.Version
 
@@ -218,10 +329,12 @@ func parseNpmLockPackages(packages map[string]npmLockPackage) map[string]package
 		}
 
 		details.add(finalName+"@"+finalVersion, packageDetails{
-			Name:      finalName,
-			Version:   detail.Version,
-			Commit:    commit,
-			DepGroups: detail.depGroups(),
+			Name:         finalName,
+			Version:      detail.Version,
+			Commit:       commit,
+			DepGroups:    detail.depGroups(),
+			Dependencies: npmLockPackageDependencies(packages, namePath),
+			Direct:       slices.Contains(direct, extractor.Dependency{Name: finalName, Version: detail.Version}),
 		})
 	}
 
@@ -233,7 +346,7 @@ func parseNpmLock(lockfile npmLockfile) map[string]packageDetails {
 		return parseNpmLockPackages(lockfile.Packages)
 	}
 
-	return parseNpmLockDependencies(lockfile.Dependencies)
+	return parseNpmLockDependencies(lockfile.Dependencies, nil)
 }
 
 // Config is the configuration for the Extractor.
@@ -366,7 +479,9 @@ func (e Extractor) extractPkgLock(_ context.Context, input *filesystem.ScanInput
 			Metadata: osv.DepGroupMetadata{
 				DepGroupVals: pkg.DepGroups,
 			},
-			Locations: []string{input.Path},
+			Locations:        []string{input.Path},
+			Dependencies:     pkg.Dependencies,
+			DirectDependency: pkg.Direct,
 		}
 	}
 
@@ -211,3 +211,70 @@ func TestMetricCollector(t *testing.T) {
 		})
 	}
 }
+
+func TestExtract_Dependencies(t *testing.T) {
+	type node struct {
+		Dependencies []extractor.Dependency
+		Direct       bool
+	}
+	tests := []struct {
+		name string
+		path string
+		want map[string]node
+	}{
+		{
+			name: "v1 requires resolved through nested dependencies",
+			path: "testdata/dependencies.v1.json",
+			want: map[string]node{
+				"chalk@2.4.2": {Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "3.0.0"},
+					{Name: "supports-color", Version: "5.5.0"},
+				}},
+				"supports-color@5.5.0": {Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "3.0.0"},
+				}},
+				"has-flag@3.0.0": {},
+			},
+		},
+		{
+			name: "v2 dependencies resolved through node_modules",
+			path: "testdata/dependencies.v2.json",
+			want: map[string]node{
+				"chalk@2.4.2": {Direct: true, Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "3.0.0"},
+					{Name: "supports-color", Version: "5.5.0"},
+				}},
+				"supports-color@5.5.0": {Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "3.0.0"},
+				}},
+				"has-flag@3.0.0": {},
+				"supports-color@7.2.0": {Direct: true, Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "4.0.0"},
+				}},
+				"has-flag@4.0.0": {},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extr := packagelockjson.New(packagelockjson.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: tt.path})
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			inv, err := extr.Extract(context.Background(), &scanInput)
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", tt.path, err)
+			}
+
+			got := map[string]node{}
+			for _, i := range inv {
+				got[i.Name+"@"+i.Version] = node{Dependencies: i.Dependencies, Direct: i.DirectDependency}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Extract(%s) dependencies diff (-want +got):\n%s", tt.path, diff)
+			}
+		})
+	}
+}
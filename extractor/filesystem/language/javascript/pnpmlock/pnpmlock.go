@@ -16,12 +16,14 @@
 package pnpmlock
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -31,6 +33,7 @@ import (
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
+	"golang.org/x/exp/maps"
 	"gopkg.in/yaml.v3"
 )
 
@@ -42,20 +45,58 @@ type pnpmLockPackageResolution struct {
 }
 
 type pnpmLockPackage struct {
-	Resolution pnpmLockPackageResolution `yaml:"resolution"`
-	Name       string                    `yaml:"name"`
-	Version    string                    `yaml:"version"`
-	Dev        bool                      `yaml:"dev"`
+	Resolution           pnpmLockPackageResolution `yaml:"resolution"`
+	Name                 string                    `yaml:"name"`
+	Version              string                    `yaml:"version"`
+	Dev                  bool                      `yaml:"dev"`
+	Dependencies         map[string]string         `yaml:"dependencies,omitempty"`
+	OptionalDependencies map[string]string         `yaml:"optionalDependencies,omitempty"`
+}
+
+// pnpmLockDependency is the reference a project has to one of its
+// dependencies. Lockfiles before v6 only record the version, later ones also
+// the specifier it was resolved from.
+type pnpmLockDependency struct {
+	Specifier string `yaml:"specifier"`
+	Version   string `yaml:"version"`
+}
+
+// UnmarshalYAML is a custom unmarshalling function for handling dependencies
+// that are only recorded with their version.
+func (d *pnpmLockDependency) UnmarshalYAML(unmarshal func(any) error) error {
+	if err := unmarshal(&d.Version); err == nil {
+		return nil
+	}
+
+	type plain pnpmLockDependency
+	return unmarshal((*plain)(d))
+}
+
+// pnpmLockImporter is a project of the workspace the lockfile is for.
+type pnpmLockImporter struct {
+	Dependencies         map[string]pnpmLockDependency `yaml:"dependencies,omitempty"`
+	DevDependencies      map[string]pnpmLockDependency `yaml:"devDependencies,omitempty"`
+	OptionalDependencies map[string]pnpmLockDependency `yaml:"optionalDependencies,omitempty"`
 }
 
 type pnpmLockfile struct {
 	Version  float64                    `yaml:"lockfileVersion"`
 	Packages map[string]pnpmLockPackage `yaml:"packages,omitempty"`
+	// The dependencies of the packages, keyed by package and peer dependencies.
+	// Only used from v9 on, before that they're recorded in Packages.
+	Snapshots map[string]pnpmLockPackage  `yaml:"snapshots,omitempty"`
+	Importers map[string]pnpmLockImporter `yaml:"importers,omitempty"`
+	// The dependencies of the project, for lockfiles of projects that aren't
+	// workspaces.
+	pnpmLockImporter `yaml:",inline"`
 }
 
 type pnpmLockfileV6 struct {
-	Version  string                     `yaml:"lockfileVersion"`
-	Packages map[string]pnpmLockPackage `yaml:"packages,omitempty"`
+	Version          string                      `yaml:"lockfileVersion"`
+	Packages         map[string]pnpmLockPackage  `yaml:"packages,omitempty"`
+	Snapshots        map[string]pnpmLockPackage  `yaml:"snapshots,omitempty"`
+	Importers        map[string]pnpmLockImporter `yaml:"importers,omitempty"`
+	pnpmLockImporter `yaml:",inline"`
 }
 
 // UnmarshalYAML is a custom unmarshalling function for handling v6 lockfiles.
@@ -74,6 +115,9 @@ func (l *pnpmLockfile) UnmarshalYAML(unmarshal func(any) error) error {
 
 	l.Version = parsedVersion
 	l.Packages = lockfileV6.Packages
+	l.Snapshots = lockfileV6.Snapshots
+	l.Importers = lockfileV6.Importers
+	l.pnpmLockImporter = lockfileV6.pnpmLockImporter
 
 	return nil
 }
@@ -159,9 +203,88 @@ func parseNameAtVersion(value string) (name string, version string) {
 	return matches[1], matches[2]
 }
 
+// trimPnpmPeerSuffix removes the peer dependencies a package was resolved
+// with from its version or dependency path, e.g. "1.0.0_react@18.2.0" (before
+// v6) or "1.0.0(react@18.2.0)".
+func trimPnpmPeerSuffix(version string) string {
+	version, _, _ = strings.Cut(version, "(")
+	return version
+}
+
+// resolvePnpmDependency returns the package a dependency on name resolved to,
+// given the version or dependency path it's recorded with.
+func resolvePnpmDependency(name string, ref string, lockfileVersion float64) (extractor.Dependency, bool) {
+	ref = trimPnpmPeerSuffix(ref)
+
+	// Linked and local packages aren't in the lockfile.
+	if strings.HasPrefix(ref, "link:") || strings.HasPrefix(ref, "file:") {
+		return extractor.Dependency{}, false
+	}
+
+	// Aliased packages are recorded with their dependency path, e.g.
+	// "/string-width/4.2.3" (before v9) or "string-width@4.2.3".
+	if strings.HasPrefix(ref, "/") || (lockfileVersion >= 9.0 && strings.Contains(strings.TrimPrefix(ref, "@"), "@")) {
+		depName, depVersion, err := extractPnpmPackageNameAndVersion(ref, lockfileVersion)
+		if err != nil || depName == "" {
+			return extractor.Dependency{}, false
+		}
+		return extractor.Dependency{Name: depName, Version: depVersion}, true
+	}
+
+	version, _, _ := strings.Cut(ref, "_")
+	return extractor.Dependency{Name: name, Version: version}, true
+}
+
+func resolvePnpmDependencies(deps []map[string]string, lockfileVersion float64) []extractor.Dependency {
+	var result []extractor.Dependency
+	for _, m := range deps {
+		for name, ref := range m {
+			if dep, ok := resolvePnpmDependency(name, ref, lockfileVersion); ok && !slices.Contains(result, dep) {
+				result = append(result, dep)
+			}
+		}
+	}
+	slices.SortFunc(result, func(a, b extractor.Dependency) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Version, b.Version))
+	})
+	return result
+}
+
+// pnpmDirectDependencies returns the packages that the projects of the
+// lockfile depend on.
+func pnpmDirectDependencies(lockfile pnpmLockfile) map[extractor.Dependency]bool {
+	importers := maps.Values(lockfile.Importers)
+	importers = append(importers, lockfile.pnpmLockImporter)
+
+	direct := map[extractor.Dependency]bool{}
+	for _, importer := range importers {
+		for _, m := range []map[string]pnpmLockDependency{importer.Dependencies, importer.DevDependencies, importer.OptionalDependencies} {
+			for name, d := range m {
+				if dep, ok := resolvePnpmDependency(name, d.Version, lockfile.Version); ok {
+					direct[dep] = true
+				}
+			}
+		}
+	}
+	return direct
+}
+
+// pnpmSnapshotDependencies returns the dependencies of the packages of a v9
+// lockfile, keyed by the dependency path of the package.
+func pnpmSnapshotDependencies(lockfile pnpmLockfile) map[string][]map[string]string {
+	deps := map[string][]map[string]string{}
+	for s, snapshot := range lockfile.Snapshots {
+		key := trimPnpmPeerSuffix(strings.Trim(s, "'"))
+		deps[key] = append(deps[key], snapshot.Dependencies, snapshot.OptionalDependencies)
+	}
+	return deps
+}
+
 func parsePnpmLock(lockfile pnpmLockfile) ([]*extractor.Inventory, error) {
 	packages := make([]*extractor.Inventory, 0, len(lockfile.Packages))
 	errs := []error{}
+	direct := pnpmDirectDependencies(lockfile)
+	snapshotDeps := pnpmSnapshotDependencies(lockfile)
 
 	for s, pkg := range lockfile.Packages {
 		name, version, err := extractPnpmPackageNameAndVersion(s, lockfile.Version)
@@ -202,6 +325,11 @@ func parsePnpmLock(lockfile pnpmLockfile) ([]*extractor.Inventory, error) {
 			depGroups = append(depGroups, "dev")
 		}
 
+		deps := []map[string]string{pkg.Dependencies, pkg.OptionalDependencies}
+		if lockfile.Version >= 9.0 {
+			deps = snapshotDeps[strings.Trim(s, "'")]
+		}
+
 		packages = append(packages, &extractor.Inventory{
 			Name:    name,
 			Version: version,
@@ -211,6 +339,8 @@ func parsePnpmLock(lockfile pnpmLockfile) ([]*extractor.Inventory, error) {
 			Metadata: osv.DepGroupMetadata{
 				DepGroupVals: depGroups,
 			},
+			Dependencies:     resolvePnpmDependencies(deps, lockfile.Version),
+			DirectDependency: direct[extractor.Dependency{Name: name, Version: version}],
 		})
 	}
 
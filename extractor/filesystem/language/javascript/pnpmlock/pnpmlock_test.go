@@ -696,9 +696,77 @@ func TestExtractor_Extract(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess), extracttest.IgnoreDependencies); diff != "" {
 				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
 			}
 		})
 	}
 }
+
+func TestExtract_Dependencies(t *testing.T) {
+	type node struct {
+		Dependencies []extractor.Dependency
+		Direct       bool
+	}
+	tests := []struct {
+		name string
+		path string
+		want map[string]node
+	}{
+		{
+			name: "v6",
+			path: "testdata/dependencies.v6.yaml",
+			want: map[string]node{
+				"chalk@2.4.2": {Direct: true, Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "3.0.0"},
+					{Name: "supports-color", Version: "5.5.0"},
+				}},
+				"supports-color@5.5.0": {Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "3.0.0"},
+				}},
+				"has-flag@3.0.0": {},
+				"supports-color@7.2.0": {Direct: true, Dependencies: []extractor.Dependency{
+					{Name: "has-flag", Version: "4.0.0"},
+				}},
+				"has-flag@4.0.0": {},
+			},
+		},
+		{
+			name: "v9 with peer dependencies and aliases",
+			path: "testdata/dependencies.v9.yaml",
+			want: map[string]node{
+				"react-dom@18.2.0": {Direct: true, Dependencies: []extractor.Dependency{
+					{Name: "loose-envify", Version: "1.4.0"},
+					{Name: "react", Version: "18.2.0"},
+				}},
+				"react@18.2.0": {Dependencies: []extractor.Dependency{
+					{Name: "loose-envify", Version: "1.4.0"},
+				}},
+				"loose-envify@1.4.0": {},
+				"string-width@4.2.3": {Direct: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := pnpmlock.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: tt.path})
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			inv, err := e.Extract(context.Background(), &scanInput)
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", tt.path, err)
+			}
+
+			got := map[string]node{}
+			for _, i := range inv {
+				got[i.Name+"@"+i.Version] = node{Dependencies: i.Dependencies, Direct: i.DirectDependency}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Extract(%s) dependencies diff (-want +got):\n%s", tt.path, diff)
+			}
+		})
+	}
+}
@@ -40,6 +40,14 @@ var (
 	// Format for yarn.lock v1: `resolved "git+ssh://git@github.com:G-Rath/repo-2#hash"`
 	// Format for yarn.lock v2: `resolution: "@my-scope/my-first-package@https://github.com/my-org/my-first-package.git#commit=hash"`
 	yarnPackageResolutionRe = regexp.MustCompile(`^ {2}"?(?:resolution:|resolved)"? "([^ '"]+)"$`)
+	// Dependency section matcher regex.
+	// Format for yarn.lock v1: `dependencies:`
+	// Format for yarn.lock v2: `dependencies:`
+	yarnDependencySectionRe = regexp.MustCompile(`^ {2}"?(dependencies|optionalDependencies)"?:$`)
+	// Dependency matcher regex, for the lines of a dependency section.
+	// Format for yarn.lock v1: `"@my-scope/my-package" "^1.0.0"`
+	// Format for yarn.lock v2: `"@my-scope/my-package": "npm:^1.0.0"`
+	yarnDependencyRe = regexp.MustCompile(`^ {4}"?([^ "]+?)"?:? "?([^"]+?)"?$`)
 	// Regexes for matching commit hashes in the resolution.
 	commitMatchers = []*regexp.Regexp{
 		// ssh://...
@@ -159,6 +167,90 @@ func determineYarnPackageResolution(props []string) string {
 	return ""
 }
 
+// descriptors returns the descriptors that resolve to the package, e.g.
+// "my-package@^1.0.0" and "my-package@npm:^1.0.0".
+func (desc *packageDescription) descriptors() []string {
+	var result []string
+	for _, d := range strings.Split(strings.TrimSuffix(desc.header, ":"), ",") {
+		result = append(result, strings.Trim(strings.TrimSpace(d), "\""))
+	}
+	return result
+}
+
+// dependencies returns the descriptors of the dependencies of the package.
+func (desc *packageDescription) dependencies() []string {
+	var result []string
+	inSection := false
+	for _, s := range desc.props {
+		if yarnDependencySectionRe.MatchString(s) {
+			inSection = true
+			continue
+		}
+		if !strings.HasPrefix(s, "    ") {
+			inSection = false
+			continue
+		}
+		if !inSection {
+			continue
+		}
+		if matched := yarnDependencyRe.FindStringSubmatch(s); matched != nil {
+			result = append(result, matched[1]+"@"+matched[2])
+		}
+	}
+	return result
+}
+
+// isWorkspace returns whether the package is a workspace of the project.
+func (desc *packageDescription) isWorkspace() bool {
+	for _, d := range desc.descriptors() {
+		if strings.Contains(d, "@workspace:") {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveYarnDependencies sets the dependencies of the packages by looking up
+// the descriptors they depend on. Dependencies of workspaces are direct
+// dependencies of the project.
+func resolveYarnDependencies(groups []*packageDescription, packages []*extractor.Inventory) {
+	byDescriptor := map[string]*extractor.Inventory{}
+	for i, group := range groups {
+		for _, d := range group.descriptors() {
+			byDescriptor[d] = packages[i]
+		}
+	}
+
+	for i, group := range groups {
+		for _, d := range group.dependencies() {
+			dep, ok := byDescriptor[d]
+			if !ok {
+				// yarn v2 prefixes ranges of registry packages with the protocol.
+				name, rng := splitYarnDescriptor(d)
+				dep, ok = byDescriptor[name+"@npm:"+rng]
+			}
+			if !ok {
+				continue
+			}
+			packages[i].Dependencies = append(packages[i].Dependencies, extractor.Dependency{Name: dep.Name, Version: dep.Version})
+			if group.isWorkspace() {
+				dep.DirectDependency = true
+			}
+		}
+	}
+}
+
+func splitYarnDescriptor(descriptor string) (name string, rng string) {
+	i := strings.Index(strings.TrimPrefix(descriptor, "@"), "@")
+	if i < 0 {
+		return descriptor, ""
+	}
+	if strings.HasPrefix(descriptor, "@") {
+		i++
+	}
+	return descriptor[:i], descriptor[i+1:]
+}
+
 func parseYarnPackageGroup(desc *packageDescription) *extractor.Inventory {
 	name := extractYarnPackageName(desc.header)
 	version := determineYarnPackageVersion(desc.props)
@@ -206,6 +298,7 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 	}
 
 	packages := make([]*extractor.Inventory, 0, len(packageGroups))
+	groups := make([]*packageDescription, 0, len(packageGroups))
 
 	for _, group := range packageGroups {
 		if group.header == "__metadata:" {
@@ -215,7 +308,9 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		inv := parseYarnPackageGroup(group)
 		inv.Locations = []string{input.Path}
 		packages = append(packages, inv)
+		groups = append(groups, group)
 	}
+	resolveYarnDependencies(groups, packages)
 
 	return packages, nil
 }
@@ -15,10 +15,14 @@
 package yarnlock_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/yarnlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/testing/extracttest"
 )
 
 func TestExtractor_FileRequired(t *testing.T) {
@@ -62,3 +66,69 @@ func TestExtractor_FileRequired(t *testing.T) {
 		})
 	}
 }
+
+func TestExtract_Dependencies(t *testing.T) {
+	type node struct {
+		Dependencies []extractor.Dependency
+		Direct       bool
+	}
+	tests := []struct {
+		name string
+		path string
+		want map[string]node
+	}{
+		{
+			name: "v1",
+			path: "testdata/dependencies.v1.lock",
+			want: map[string]node{
+				"chalk@2.4.2": {Dependencies: []extractor.Dependency{
+					{Name: "@my-scope/has-flag", Version: "3.0.0"},
+					{Name: "supports-color", Version: "5.5.0"},
+				}},
+				"supports-color@5.5.0": {Dependencies: []extractor.Dependency{
+					{Name: "@my-scope/has-flag", Version: "3.0.0"},
+				}},
+				"@my-scope/has-flag@3.0.0": {},
+			},
+		},
+		{
+			name: "v2 with workspace",
+			path: "testdata/dependencies.v2.lock",
+			want: map[string]node{
+				"mine@0.0.0-use.local": {Dependencies: []extractor.Dependency{
+					{Name: "chalk", Version: "2.4.2"},
+				}},
+				"chalk@2.4.2": {Direct: true, Dependencies: []extractor.Dependency{
+					{Name: "@my-scope/has-flag", Version: "3.0.0"},
+					{Name: "supports-color", Version: "5.5.0"},
+				}},
+				"supports-color@5.5.0": {Dependencies: []extractor.Dependency{
+					{Name: "@my-scope/has-flag", Version: "3.0.0"},
+				}},
+				"@my-scope/has-flag@3.0.0": {},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := yarnlock.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: tt.path})
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			inv, err := e.Extract(context.Background(), &scanInput)
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", tt.path, err)
+			}
+
+			got := map[string]node{}
+			for _, i := range inv {
+				got[i.Name+"@"+i.Version] = node{Dependencies: i.Dependencies, Direct: i.DirectDependency}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Extract(%s) dependencies diff (-want +got):\n%s", tt.path, diff)
+			}
+		})
+	}
+}
@@ -12,18 +12,14 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build !windows
+package perllocal
 
-package regpatchlevel
-
-import (
-	"context"
-	"testing"
-)
-
-func TestScan(t *testing.T) {
-	e := Extractor{}
-	if _, err := e.Extract(context.Background(), nil); err == nil {
-		t.Errorf("Extract() returned nil error, should not be supported on non-Windows")
-	}
+// Metadata holds information about a Perl module installed from CPAN.
+type Metadata struct {
+	// The directory the module was installed into, e.g.
+	// "/usr/local/share/perl/5.18.2".
+	InstallDir string
+	// The time of the installation as recorded by the installer, e.g.
+	// "Wed Jan 10 10:00:00 2024".
+	InstallDate string
 }
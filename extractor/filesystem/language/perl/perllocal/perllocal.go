@@ -0,0 +1,235 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package perllocal extracts the Perl modules and bundles installed from CPAN
+// on legacy servers from the perllocal.pod installation log that
+// ExtUtils::MakeMaker and Module::Build append to.
+package perllocal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "perl/perllocal"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 20 * units.MiB
+)
+
+var (
+	// Each installation starts with a heading of the time and the module, e.g.
+	// =head2 Wed Jan 10 10:00:00 2024: C<Module> L<JSON::XS|JSON::XS>
+	headingRe = regexp.MustCompile(`^=head2\s+(.+?):\s+C<\w+>\s+L<([^|>]+)`)
+	// Followed by items of the installation, e.g. C<VERSION: 3.01>.
+	itemRe = regexp.MustCompile(`^C<([^:>]+):\s*(.*)>$`)
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the perllocal.pod
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts Perl modules from perllocal.pod files.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a perllocal.pod extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is a perllocal.pod file.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if filepath.Base(filepath.ToSlash(path)) != "perllocal.pod" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the perllocal.pod file passed through the scan input and
+// returns the modules installed into each directory. If a module was
+// installed several times, the latest installation is returned.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(ctx, input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	return inventory, nil
+}
+
+// installKey identifies an installation that replaces earlier ones.
+type installKey struct {
+	name string
+	dir  string
+}
+
+func (e Extractor) extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory := []*extractor.Inventory{}
+	index := make(map[installKey]int)
+	var cur *extractor.Inventory
+	var meta *Metadata
+	add := func() {
+		if cur == nil || cur.Version == "" {
+			return
+		}
+		k := installKey{name: cur.Name, dir: meta.InstallDir}
+		if i, ok := index[k]; ok {
+			inventory[i] = cur
+			return
+		}
+		index[k] = len(inventory)
+		inventory = append(inventory, cur)
+	}
+
+	s := bufio.NewScanner(input.Reader)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		line := strings.TrimSpace(s.Text())
+		if m := headingRe.FindStringSubmatch(line); m != nil {
+			add()
+			meta = &Metadata{InstallDate: m[1]}
+			cur = &extractor.Inventory{
+				Name:      strings.TrimSpace(m[2]),
+				Locations: []string{input.Path},
+				Metadata:  meta,
+			}
+			continue
+		}
+		m := itemRe.FindStringSubmatch(line)
+		if m == nil || cur == nil {
+			continue
+		}
+		switch value := strings.TrimSpace(m[2]); m[1] {
+		case "installed into":
+			meta.InstallDir = value
+		case "VERSION":
+			cur.Version = value
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	add()
+	return inventory, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeCPAN,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track CPAN modules.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
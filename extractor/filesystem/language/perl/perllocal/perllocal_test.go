@@ -0,0 +1,185 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perllocal_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/perllocal"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "perllocal.pod",
+			path:             "usr/local/lib/perl/5.14.2/perllocal.pod",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "perllocal.pod of a local::lib",
+			path:             "home/app/perl5/lib/perl5/x86_64-linux/perllocal.pod",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "other pod file",
+			path:         "usr/share/perl/5.14.2/pod/perlfunc.pod",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "usr/local/lib/perl/5.14.2/perllocal.pod",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "usr/local/lib/perl/5.14.2/perllocal.pod",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := perllocal.New(perllocal.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "modules and bundles",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/perllocal.pod",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					// The latest of the two installations.
+					Name:      "DBI",
+					Version:   "1.632",
+					Locations: []string{"testdata/perllocal.pod"},
+					Metadata: &perllocal.Metadata{
+						InstallDir:  "/usr/local/lib/perl/5.14.2",
+						InstallDate: "Mon Jan  5 11:02:19 2015",
+					},
+				},
+				{
+					Name:      "Bundle::LWP",
+					Version:   "6.00",
+					Locations: []string{"testdata/perllocal.pod"},
+					Metadata: &perllocal.Metadata{
+						InstallDir:  "/usr/local/share/perl/5.14.2",
+						InstallDate: "Tue Mar  4 09:15:02 2014",
+					},
+				},
+			},
+		},
+		{
+			Name: "module without version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/noversion.pod",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := perllocal.New(perllocal.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := perllocal.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "DBI",
+		Version:   "1.632",
+		Locations: []string{"usr/local/lib/perl/5.14.2/perllocal.pod"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeCPAN,
+		Name:    "DBI",
+		Version: "1.632",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
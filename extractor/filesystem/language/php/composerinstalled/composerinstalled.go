@@ -0,0 +1,259 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package composerinstalled extracts the packages Composer installed into a
+// vendor directory from vendor/composer/installed.json. Unlike composer.lock,
+// it lists the packages that are actually installed, e.g. without the
+// require-dev packages after `composer install --no-dev`, and it's present in
+// deployments that don't ship the lockfile.
+package composerinstalled
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "php/composerinstalled"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 30 * units.MiB
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Composer
+// installed.json extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts the packages installed by Composer.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a Composer installed.json extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is a composer/installed.json
+// in a vendor directory.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if !isInstalledJSON(filepath.ToSlash(path)) {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// isInstalledJSON returns whether p is the installed.json in the composer
+// directory of a vendor directory. The vendor directory can be renamed with
+// Composer's vendor-dir setting, so its name isn't checked.
+func isInstalledJSON(p string) bool {
+	return path.Base(p) == "installed.json" && path.Base(path.Dir(p)) == "composer"
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+type composerPackage struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Type        string `json:"type"`
+	InstallPath string `json:"install-path"`
+	Source      struct {
+		Reference string `json:"reference"`
+	} `json:"source"`
+	Dist struct {
+		Type      string `json:"type"`
+		URL       string `json:"url"`
+		Reference string `json:"reference"`
+	} `json:"dist"`
+}
+
+// installedJSON is the installed.json of Composer 2. Composer 1 writes a list
+// of packages instead.
+type installedJSON struct {
+	Packages []composerPackage `json:"packages"`
+	// The names of the packages that are only installed as require-dev
+	// dependencies.
+	DevPackageNames []string `json:"dev-package-names"`
+}
+
+// Extract extracts the packages listed in the installed.json passed through
+// the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	return inventory, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(input.Reader).Decode(&raw); err != nil {
+		return nil, err
+	}
+	var installed installedJSON
+	// Composer 1 doesn't record which packages are dev dependencies.
+	if err := json.Unmarshal(raw, &installed.Packages); err != nil {
+		if err := json.Unmarshal(raw, &installed); err != nil {
+			return nil, err
+		}
+	}
+
+	inventory := make([]*extractor.Inventory, 0, len(installed.Packages))
+	for _, p := range installed.Packages {
+		if p.Name == "" || p.Version == "" {
+			continue
+		}
+		m := &Metadata{
+			DepGroupVals: []string{},
+			Type:         p.Type,
+			InstallPath:  p.InstallPath,
+		}
+		if slices.Contains(installed.DevPackageNames, p.Name) {
+			m.DepGroupVals = []string{"dev"}
+		}
+		i := &extractor.Inventory{
+			Name:      p.Name,
+			Version:   p.Version,
+			Locations: []string{input.Path},
+			Metadata:  m,
+		}
+		if p.Dist.Type == "path" {
+			// The reference of path packages is a hash of their contents, not a
+			// commit.
+			m.PathRepository = p.Dist.URL
+		} else {
+			commit := p.Dist.Reference
+			if commit == "" {
+				commit = p.Source.Reference
+			}
+			if commit != "" {
+				i.SourceCode = &extractor.SourceCodeIdentifier{Commit: commit}
+			}
+		}
+		inventory = append(inventory, i)
+	}
+	return inventory, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeComposer,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this
+// extractor. Packages installed from path repositories are local code that
+// isn't published on Packagist, so they have no ecosystem.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string {
+	if m, ok := i.Metadata.(*Metadata); ok && m.PathRepository != "" {
+		return ""
+	}
+	return "Packagist"
+}
+
+var _ filesystem.Extractor = Extractor{}
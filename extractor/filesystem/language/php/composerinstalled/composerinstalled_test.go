@@ -0,0 +1,267 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composerinstalled_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/composerinstalled"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "installed.json",
+			path:             "var/www/app/vendor/composer/installed.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "renamed vendor directory",
+			path:             "var/www/app/libs/composer/installed.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "installed.php",
+			path:         "var/www/app/vendor/composer/installed.php",
+			wantRequired: false,
+		},
+		{
+			name:         "installed.json outside of the composer directory",
+			path:         "var/www/app/installed.json",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "vendor/composer/installed.json",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "vendor/composer/installed.json",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := composerinstalled.New(composerinstalled.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "composer 2 with dev and path packages",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/composer2/vendor/composer/installed.json",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:       "monolog/monolog",
+					Version:    "3.5.0",
+					Locations:  []string{"testdata/composer2/vendor/composer/installed.json"},
+					SourceCode: &extractor.SourceCodeIdentifier{Commit: "c915e2634718dbc8a4a15c61b0e62e7a44e14448"},
+					Metadata: &composerinstalled.Metadata{
+						DepGroupVals: []string{},
+						Type:         "library",
+						InstallPath:  "../monolog/monolog",
+					},
+				},
+				{
+					Name:       "phpunit/phpunit",
+					Version:    "10.5.20",
+					Locations:  []string{"testdata/composer2/vendor/composer/installed.json"},
+					SourceCode: &extractor.SourceCodeIdentifier{Commit: "547d314dc24ec1e177720d45c6263fb226cc2ae3"},
+					Metadata: &composerinstalled.Metadata{
+						DepGroupVals: []string{"dev"},
+						Type:         "library",
+						InstallPath:  "../phpunit/phpunit",
+					},
+				},
+				{
+					Name:      "acme/billing",
+					Version:   "dev-main",
+					Locations: []string{"testdata/composer2/vendor/composer/installed.json"},
+					Metadata: &composerinstalled.Metadata{
+						DepGroupVals:   []string{},
+						Type:           "library",
+						InstallPath:    "../acme/billing",
+						PathRepository: "../packages/billing",
+					},
+				},
+			},
+		},
+		{
+			Name: "installed with --no-dev",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/nodev/vendor/composer/installed.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:       "psr/log",
+				Version:    "3.0.0",
+				Locations:  []string{"testdata/nodev/vendor/composer/installed.json"},
+				SourceCode: &extractor.SourceCodeIdentifier{Commit: "fe5ea303b0887d5caefd3d431c3e61ad47037001"},
+				Metadata: &composerinstalled.Metadata{
+					DepGroupVals: []string{},
+					Type:         "library",
+					InstallPath:  "../psr/log",
+				},
+			}},
+		},
+		{
+			Name: "composer 1",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/composer1/vendor/composer/installed.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:       "guzzlehttp/guzzle",
+				Version:    "6.5.8",
+				Locations:  []string{"testdata/composer1/vendor/composer/installed.json"},
+				SourceCode: &extractor.SourceCodeIdentifier{Commit: "a52f0440530b54fa079ce76e8c5d196a42cad981"},
+				Metadata: &composerinstalled.Metadata{
+					DepGroupVals: []string{},
+					Type:         "library",
+				},
+			}},
+		},
+		{
+			Name: "truncated file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/vendor/composer/installed.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := composerinstalled.New(composerinstalled.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestEcosystem(t *testing.T) {
+	e := composerinstalled.Extractor{}
+	tests := []struct {
+		name string
+		m    *composerinstalled.Metadata
+		want string
+	}{
+		{
+			name: "registry package",
+			m:    &composerinstalled.Metadata{InstallPath: "../monolog/monolog"},
+			want: "Packagist",
+		},
+		{
+			name: "path repository package",
+			m:    &composerinstalled.Metadata{PathRepository: "../packages/billing"},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &extractor.Inventory{Name: "acme/billing", Metadata: tt.m}
+			if got := e.Ecosystem(i); got != tt.want {
+				t.Errorf("Ecosystem(%v) = %q, want %q", i, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := composerinstalled.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "monolog/monolog",
+		Version:   "3.5.0",
+		Locations: []string{"vendor/composer/installed.json"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeComposer,
+		Name:    "monolog/monolog",
+		Version: "3.5.0",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
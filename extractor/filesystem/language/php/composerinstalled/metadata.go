@@ -0,0 +1,36 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package composerinstalled
+
+// Metadata holds information about a package installed by Composer.
+type Metadata struct {
+	// DepGroupVals is ["dev"] for packages that are only installed as
+	// require-dev dependencies.
+	DepGroupVals []string
+	// The Composer package type, e.g. "library" or "composer-plugin".
+	Type string
+	// The directory the package is installed in, relative to vendor/composer,
+	// e.g. "../monolog/monolog".
+	InstallPath string
+	// The directory of a package installed from a path repository, as
+	// configured in the repository, e.g. "../packages/billing". Empty for
+	// packages downloaded from a registry or VCS.
+	PathRepository string
+}
+
+// DepGroups returns the dependency groups of the package.
+func (m *Metadata) DepGroups() []string {
+	return m.DepGroupVals
+}
@@ -0,0 +1,397 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drupal extracts the version of Drupal core and the contrib modules,
+// themes and install profiles of Drupal sites. Contrib projects are usually
+// downloaded from drupal.org or copied between sites rather than installed
+// through Composer, so they don't show up in composer.lock files.
+package drupal
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "php/drupal"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// core/lib/Drupal.php of Drupal 8 and later.
+	kindCore
+	// includes/bootstrap.inc of Drupal 7.
+	kindCore7
+	// The .info.yml file of a Drupal 8+ module, theme or profile.
+	kindInfoYAML
+	// The .info file of a Drupal 7 module, theme or profile.
+	kindInfo
+)
+
+var (
+	// The Drupal class defines the core version as a constant, e.g.
+	// const VERSION = '10.2.5';
+	coreVersionRe = regexp.MustCompile(`const\s+VERSION\s*=\s*'([^']+)'`)
+	// Drupal 7 defines it in bootstrap.inc, e.g. define('VERSION', '7.98');
+	core7VersionRe = regexp.MustCompile(`define\(\s*'VERSION'\s*,\s*'([^']+)'\s*\)`)
+	// Contrib versions of the core-specific scheme, e.g. 8.x-1.10 or
+	// 7.x-3.0-rc1.
+	legacyVersionRe = regexp.MustCompile(`^[0-9]+\.x-([0-9]+)\.([0-9]+)(?:-(.+))?$`)
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Drupal extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts Drupal core and contrib projects.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a Drupal extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file defines the Drupal core
+// version or describes a module, theme or profile.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// kindForPath returns the kind of the file at p.
+func kindForPath(p string) fileKind {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	dir := path.Dir(p)
+	switch {
+	case base == "Drupal.php" && (dir == "core/lib" || strings.HasSuffix(dir, "/core/lib")):
+		return kindCore
+	case base == "bootstrap.inc" && path.Base(dir) == "includes":
+		return kindCore7
+	case strings.HasSuffix(base, ".info.yml") && projectDirType(dir) != "":
+		return kindInfoYAML
+	case strings.HasSuffix(base, ".info") && projectDirType(dir) != "":
+		return kindInfo
+	}
+	return kindNone
+}
+
+// projectDirType returns the type of the projects in the modules, themes or
+// profiles directory dir is in, or "" if dir isn't in one or is part of
+// Drupal 8+ core.
+func projectDirType(dir string) string {
+	parts := strings.Split(dir, "/")
+	for i, d := range parts {
+		var typ string
+		switch d {
+		case "modules":
+			typ = "module"
+		case "themes":
+			typ = "theme"
+		case "profiles":
+			typ = "profile"
+		default:
+			continue
+		}
+		if i > 0 && parts[i-1] == "core" {
+			return ""
+		}
+		return typ
+	}
+	return ""
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// Drupal core version or the project it describes.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	switch kind := kindForPath(input.Path); kind {
+	case kindCore, kindCore7:
+		re, name := coreVersionRe, "drupal/core"
+		if kind == kindCore7 {
+			re, name = core7VersionRe, "drupal/drupal"
+		}
+		b, err := io.ReadAll(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		m := re.FindSubmatch(b)
+		if m == nil {
+			return nil, nil
+		}
+		return &extractor.Inventory{
+			Name:      name,
+			Version:   string(m[1]),
+			Locations: []string{input.Path},
+			Metadata: &Metadata{
+				Type:          "core",
+				MachineName:   "drupal",
+				DisplayName:   "Drupal",
+				DrupalVersion: string(m[1]),
+			},
+		}, nil
+	case kindInfoYAML, kindInfo:
+		parse := parseInfoYAML
+		if kind == kindInfo {
+			parse = parseInfo
+		}
+		info, err := parse(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		p := filepath.ToSlash(input.Path)
+		machineName := strings.TrimSuffix(strings.TrimSuffix(path.Base(p), ".yml"), ".info")
+		return projectInventory(info, machineName, projectDirType(path.Dir(p)), input.Path), nil
+	default:
+		return nil, fmt.Errorf("unsupported file %s", input.Path)
+	}
+}
+
+// info holds the fields of .info and .info.yml files the extractor uses. The
+// version and project fields are added by the drupal.org packaging script.
+type info struct {
+	Name                   string `yaml:"name"`
+	Type                   string `yaml:"type"`
+	Version                string `yaml:"version"`
+	Project                string `yaml:"project"`
+	Core                   string `yaml:"core"`
+	CoreVersionRequirement string `yaml:"core_version_requirement"`
+}
+
+func parseInfoYAML(r io.Reader) (*info, error) {
+	i := &info{}
+	if err := yaml.NewDecoder(r).Decode(i); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return i, nil
+}
+
+// parseInfo parses the INI-like .info files of Drupal 7, e.g.
+// version = "7.x-3.29"
+func parseInfo(r io.Reader) (*info, error) {
+	i := &info{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, ";") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		switch strings.TrimSpace(key) {
+		case "name":
+			i.Name = value
+		case "version":
+			i.Version = value
+		case "project":
+			i.Project = value
+		case "core":
+			i.Core = value
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// projectInventory returns the inventory of the project described by the info
+// file of the given module, theme or profile, or nil if the info file doesn't
+// describe a project of its own.
+func projectInventory(i *info, machineName, dirType, location string) *extractor.Inventory {
+	project := i.Project
+	switch {
+	case project == "drupal":
+		// Core modules are covered by the core version.
+		return nil
+	case project == "":
+		// Custom code and git checkouts aren't packaged by drupal.org. Only
+		// report them if they declare a version.
+		if i.Version == "" || i.Version == "VERSION" {
+			return nil
+		}
+		project = machineName
+	case project != machineName:
+		// Submodules are part of the project they're shipped with.
+		return nil
+	}
+	typ := i.Type
+	if typ == "" {
+		typ = dirType
+	}
+	compat := i.CoreVersionRequirement
+	if compat == "" {
+		compat = i.Core
+	}
+	return &extractor.Inventory{
+		Name:      "drupal/" + project,
+		Version:   composerVersion(i.Version),
+		Locations: []string{location},
+		Metadata: &Metadata{
+			Type:              typ,
+			MachineName:       machineName,
+			DisplayName:       i.Name,
+			DrupalVersion:     i.Version,
+			CoreCompatibility: compat,
+		},
+	}
+}
+
+// composerVersion returns the version drupal.org's Composer repository
+// publishes a release under, e.g. "1.10.0" for "8.x-1.10".
+func composerVersion(v string) string {
+	m := legacyVersionRe.FindStringSubmatch(v)
+	if m == nil {
+		return v
+	}
+	result := m[1] + "." + m[2] + ".0"
+	if m[3] != "" {
+		result += "-" + m[3]
+	}
+	return result
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeComposer,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this
+// extractor. drupal.org projects are published on Packagist through
+// drupal.org's Composer repository under the "drupal" vendor.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "Packagist" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,320 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drupal_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/drupal"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "core version",
+			path:             "var/www/html/web/core/lib/Drupal.php",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "core version at the root",
+			path:             "core/lib/Drupal.php",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "Drupal.php outside of core",
+			path:         "vendor/foo/lib/Drupal.php",
+			wantRequired: false,
+		},
+		{
+			name:             "drupal 7 bootstrap.inc",
+			path:             "var/www/drupal7/includes/bootstrap.inc",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "contrib module",
+			path:             "var/www/html/web/modules/contrib/pathauto/pathauto.info.yml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "contrib theme",
+			path:             "web/themes/contrib/gin/gin.info.yml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "drupal 7 module",
+			path:             "var/www/drupal7/sites/all/modules/views/views.info",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "drupal 8+ core module",
+			path:         "web/core/modules/node/node.info.yml",
+			wantRequired: false,
+		},
+		{
+			name:         "info file outside of a modules directory",
+			path:         "usr/share/doc/foo/foo.info",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "web/core/lib/Drupal.php",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "web/core/lib/Drupal.php",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := drupal.New(drupal.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "core version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/web/core/lib/Drupal.php",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "drupal/core",
+				Version:   "10.2.5",
+				Locations: []string{"testdata/web/core/lib/Drupal.php"},
+				Metadata: &drupal.Metadata{
+					Type:          "core",
+					MachineName:   "drupal",
+					DisplayName:   "Drupal",
+					DrupalVersion: "10.2.5",
+				},
+			}},
+		},
+		{
+			Name: "drupal 7 core version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/drupal7/includes/bootstrap.inc",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "drupal/drupal",
+				Version:   "7.98",
+				Locations: []string{"testdata/drupal7/includes/bootstrap.inc"},
+				Metadata: &drupal.Metadata{
+					Type:          "core",
+					MachineName:   "drupal",
+					DisplayName:   "Drupal",
+					DrupalVersion: "7.98",
+				},
+			}},
+		},
+		{
+			Name: "packaged contrib module",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/web/modules/contrib/pathauto/pathauto.info.yml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "drupal/pathauto",
+				Version:   "1.12.0",
+				Locations: []string{"testdata/web/modules/contrib/pathauto/pathauto.info.yml"},
+				Metadata: &drupal.Metadata{
+					Type:              "module",
+					MachineName:       "pathauto",
+					DisplayName:       "Pathauto",
+					DrupalVersion:     "8.x-1.12",
+					CoreCompatibility: "^9.3 || ^10",
+				},
+			}},
+		},
+		{
+			Name: "contrib theme with pre-release version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/web/themes/contrib/gin/gin.info.yml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "drupal/gin",
+				Version:   "3.0.0-rc9",
+				Locations: []string{"testdata/web/themes/contrib/gin/gin.info.yml"},
+				Metadata: &drupal.Metadata{
+					Type:              "theme",
+					MachineName:       "gin",
+					DisplayName:       "Gin",
+					DrupalVersion:     "8.x-3.0-rc9",
+					CoreCompatibility: "^9 || ^10 || ^11",
+				},
+			}},
+		},
+		{
+			Name: "submodule of a contrib project",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/web/modules/contrib/token/tests/modules/token_module_test/token_module_test.info.yml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "custom module with version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/web/modules/custom/acme_sso/acme_sso.info.yml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "drupal/acme_sso",
+				Version:   "2.1.0",
+				Locations: []string{"testdata/web/modules/custom/acme_sso/acme_sso.info.yml"},
+				Metadata: &drupal.Metadata{
+					Type:              "module",
+					MachineName:       "acme_sso",
+					DisplayName:       "ACME SSO",
+					DrupalVersion:     "2.1.0",
+					CoreCompatibility: "^10",
+				},
+			}},
+		},
+		{
+			Name: "custom module without version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/web/modules/custom/acme_blog/acme_blog.info.yml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "drupal 7 contrib module",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/drupal7/sites/all/modules/views/views.info",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "drupal/views",
+				Version:   "3.29.0",
+				Locations: []string{"testdata/drupal7/sites/all/modules/views/views.info"},
+				Metadata: &drupal.Metadata{
+					Type:              "module",
+					MachineName:       "views",
+					DisplayName:       "Views",
+					DrupalVersion:     "7.x-3.29",
+					CoreCompatibility: "7.x",
+				},
+			}},
+		},
+		{
+			Name: "drupal 7 core module",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/drupal7/modules/node/node.info",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "invalid info file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/modules/broken/broken.info.yml",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := drupal.New(drupal.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := drupal.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "drupal/pathauto",
+		Version:   "1.12.0",
+		Locations: []string{"web/modules/contrib/pathauto/pathauto.info.yml"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeComposer,
+		Name:    "drupal/pathauto",
+		Version: "1.12.0",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
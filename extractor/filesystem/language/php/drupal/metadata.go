@@ -0,0 +1,32 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drupal
+
+// Metadata holds information about a Drupal core installation or an
+// installed contrib project.
+type Metadata struct {
+	// The kind of the project: "core", "module", "theme" or "profile".
+	Type string
+	// The machine name of the project, e.g. "pathauto".
+	MachineName string
+	// The human-readable name of the project, e.g. "Pathauto".
+	DisplayName string
+	// The version as released on drupal.org, e.g. "8.x-1.10". The inventory
+	// version is the equivalent Composer version, e.g. "1.10.0".
+	DrupalVersion string
+	// The Drupal core versions the project is compatible with, e.g.
+	// "^9.3 || ^10" or "7.x".
+	CoreCompatibility string
+}
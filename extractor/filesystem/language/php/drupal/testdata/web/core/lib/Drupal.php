@@ -0,0 +1,34 @@
+<?php
+
+/**
+ * @file
+ * Contains \Drupal.
+ */
+
+use Drupal\Core\DependencyInjection\ContainerNotInitializedException;
+use Symfony\Component\DependencyInjection\ContainerInterface;
+
+/**
+ * Static Service Container wrapper.
+ */
+class Drupal {
+
+  /**
+   * The current system version.
+   */
+  const VERSION = '10.2.5';
+
+  /**
+   * Core API compatibility.
+   *
+   * This constant is set to '8.x' to provide legacy compatibility with
+   * extensions that use it to denote compatibility with Drupal 8.0.0 or above.
+   */
+  const CORE_COMPATIBILITY = '8.x';
+
+  /**
+   * Core minimum schema version.
+   */
+  const CORE_MINIMUM_SCHEMA_VERSION = 8000;
+
+}
@@ -0,0 +1,327 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package joomla extracts the version of Joomla core and the extensions
+// installed on Joomla sites from their XML manifests.
+package joomla
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "php/joomla"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+
+	// coreName is the Packagist name of Joomla core.
+	coreName = "joomla/joomla-cms"
+	// coreAuthor is the author of Joomla core and the extensions it ships
+	// with.
+	coreAuthor = "Joomla! Project"
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// The manifest of Joomla core,
+	// administrator/manifests/files/joomla.xml.
+	kindCore
+	// The manifest of an extension.
+	kindExtension
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Joomla extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts Joomla core and extensions.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a Joomla extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is in the location of the
+// Joomla core manifest or an extension manifest.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// kindForPath returns the kind of the file at p. Extension manifests are
+// stored in
+//   - administrator/components/com_<name>/<name>.xml
+//   - [administrator/]modules/mod_<name>/mod_<name>.xml
+//   - plugins/<group>/<name>/<name>.xml
+//   - [administrator/]templates/<name>/templateDetails.xml
+//   - administrator/manifests/{packages,libraries,files}/<name>.xml
+func kindForPath(p string) fileKind {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	if path.Ext(base) != ".xml" {
+		return kindNone
+	}
+	dir := path.Dir(p)
+	dirName := path.Base(dir)
+	parent := path.Dir(dir)
+	switch {
+	case base == "joomla.xml" && hasDirSuffix(dir, "administrator/manifests/files"):
+		return kindCore
+	case strings.HasPrefix(dirName, "com_") && hasDirSuffix(parent, "administrator/components"):
+		return kindExtension
+	case strings.HasPrefix(dirName, "mod_") && base == dirName+".xml" && path.Base(parent) == "modules":
+		return kindExtension
+	case base == dirName+".xml" && path.Base(path.Dir(parent)) == "plugins":
+		return kindExtension
+	case base == "templateDetails.xml" && path.Base(parent) == "templates":
+		return kindExtension
+	case hasDirSuffix(parent, "administrator/manifests") && (dirName == "packages" || dirName == "libraries" || dirName == "files"):
+		return kindExtension
+	}
+	return kindNone
+}
+
+// hasDirSuffix returns true if dir is or ends with the directory suffix.
+func hasDirSuffix(dir, suffix string) bool {
+	return dir == suffix || strings.HasSuffix(dir, "/"+suffix)
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// manifest holds the fields of extension manifests the extractor uses.
+type manifest struct {
+	XMLName     xml.Name
+	Type        string `xml:"type,attr"`
+	Client      string `xml:"client,attr"`
+	Group       string `xml:"group,attr"`
+	Name        string `xml:"name"`
+	Author      string `xml:"author"`
+	Version     string `xml:"version"`
+	Element     string `xml:"element"`
+	PackageName string `xml:"packagename"`
+	LibraryName string `xml:"libraryname"`
+}
+
+// Extract parses the manifest passed through the scan input and returns the
+// Joomla core version or the extension it describes.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	m := &manifest{}
+	if err := xml.NewDecoder(input.Reader).Decode(m); err != nil && err != io.EOF {
+		return nil, err
+	}
+	// Manifests of Joomla 1.5 extensions have an <install> root element.
+	// Components also keep other XML files like config.xml next to their
+	// manifest.
+	if (m.XMLName.Local != "extension" && m.XMLName.Local != "install") || m.Version == "" {
+		return nil, nil
+	}
+	version := strings.TrimSpace(m.Version)
+
+	if kindForPath(input.Path) == kindCore {
+		return &extractor.Inventory{
+			Name:      coreName,
+			Version:   version,
+			Locations: []string{input.Path},
+			Metadata: &Metadata{
+				Type:        "core",
+				Element:     "joomla",
+				DisplayName: strings.TrimSpace(m.Name),
+				Author:      strings.TrimSpace(m.Author),
+			},
+		}, nil
+	}
+	author := strings.TrimSpace(m.Author)
+	if author == coreAuthor {
+		// Core extensions are covered by the core version.
+		return nil, nil
+	}
+	md := &Metadata{
+		Type:        m.Type,
+		Element:     element(m, filepath.ToSlash(input.Path)),
+		DisplayName: strings.TrimSpace(m.Name),
+		Author:      author,
+	}
+	switch m.Type {
+	case "module", "template":
+		md.Client = m.Client
+		if md.Client == "" {
+			md.Client = "site"
+		}
+	case "plugin":
+		md.Group = m.Group
+	}
+	return &extractor.Inventory{
+		Name:      md.Element,
+		Version:   version,
+		Locations: []string{input.Path},
+		Metadata:  md,
+	}, nil
+}
+
+// element returns the element of the extension with the manifest m at p.
+// Unless the manifest declares it, it's the name of the extension's directory
+// or, for packages, libraries and file sets, the name of the manifest.
+func element(m *manifest, p string) string {
+	switch {
+	case m.Element != "":
+		return strings.TrimSpace(m.Element)
+	case m.PackageName != "":
+		return "pkg_" + strings.TrimSpace(m.PackageName)
+	case m.LibraryName != "":
+		return strings.TrimSpace(m.LibraryName)
+	case path.Base(path.Dir(path.Dir(p))) == "manifests":
+		return strings.TrimSuffix(path.Base(p), ".xml")
+	}
+	return path.Base(path.Dir(p))
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	if i.Name == coreName {
+		return &purl.PackageURL{
+			Type:    purl.TypeComposer,
+			Name:    i.Name,
+			Version: i.Version,
+		}
+	}
+	return &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "joomla",
+		Name:      i.Name,
+		Version:   i.Version,
+	}
+}
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this
+// extractor. Joomla core is published on Packagist, extensions aren't tracked
+// by OSV.
+func (Extractor) Ecosystem(i *extractor.Inventory) string {
+	if i.Name == coreName {
+		return "Packagist"
+	}
+	return ""
+}
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,363 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joomla_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/joomla"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "core manifest",
+			path:             "var/www/html/administrator/manifests/files/joomla.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "component manifest",
+			path:             "var/www/html/administrator/components/com_akeebabackup/akeebabackup.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "site module manifest",
+			path:             "var/www/html/modules/mod_jux_megamenu/mod_jux_megamenu.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "administrator module manifest",
+			path:             "administrator/modules/mod_latest/mod_latest.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "plugin manifest",
+			path:             "plugins/system/jch_optimize/jch_optimize.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "template manifest",
+			path:             "templates/cassiopeia/templateDetails.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "package manifest",
+			path:             "administrator/manifests/packages/pkg_regularlabs.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "library manifest",
+			path:             "administrator/manifests/libraries/regularlabs.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "module language file",
+			path:         "modules/mod_jux_megamenu/language/en-GB.xml",
+			wantRequired: false,
+		},
+		{
+			name:         "plugin form",
+			path:         "plugins/system/jch_optimize/forms/settings.xml",
+			wantRequired: false,
+		},
+		{
+			name:         "site component file",
+			path:         "components/com_akeebabackup/tmpl/backup/default.xml",
+			wantRequired: false,
+		},
+		{
+			name:         "unrelated xml",
+			path:         "etc/fonts/fonts.xml",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "administrator/manifests/files/joomla.xml",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "administrator/manifests/files/joomla.xml",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := joomla.New(joomla.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "core manifest",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/administrator/manifests/files/joomla.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "joomla/joomla-cms",
+				Version:   "4.4.3",
+				Locations: []string{"testdata/administrator/manifests/files/joomla.xml"},
+				Metadata: &joomla.Metadata{
+					Type:        "core",
+					Element:     "joomla",
+					DisplayName: "files_joomla",
+					Author:      "Joomla! Project",
+				},
+			}},
+		},
+		{
+			Name: "component",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/administrator/components/com_akeebabackup/akeebabackup.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "com_akeebabackup",
+				Version:   "9.8.4",
+				Locations: []string{"testdata/administrator/components/com_akeebabackup/akeebabackup.xml"},
+				Metadata: &joomla.Metadata{
+					Type:        "component",
+					Element:     "com_akeebabackup",
+					DisplayName: "COM_AKEEBABACKUP",
+					Author:      "Nicholas K. Dionysopoulos",
+				},
+			}},
+		},
+		{
+			Name: "component config file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/administrator/components/com_akeebabackup/config.xml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "core component",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/administrator/components/com_content/content.xml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "site module",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/modules/mod_jux_megamenu/mod_jux_megamenu.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "mod_jux_megamenu",
+				Version:   "2.0.2",
+				Locations: []string{"testdata/modules/mod_jux_megamenu/mod_jux_megamenu.xml"},
+				Metadata: &joomla.Metadata{
+					Type:        "module",
+					Element:     "mod_jux_megamenu",
+					DisplayName: "JUX Mega Menu",
+					Author:      "JoomlaUX",
+					Client:      "site",
+				},
+			}},
+		},
+		{
+			Name: "plugin",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/plugins/system/jch_optimize/jch_optimize.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "jch_optimize",
+				Version:   "8.0.6",
+				Locations: []string{"testdata/plugins/system/jch_optimize/jch_optimize.xml"},
+				Metadata: &joomla.Metadata{
+					Type:        "plugin",
+					Element:     "jch_optimize",
+					DisplayName: "PLG_SYSTEM_JCH_OPTIMIZE",
+					Author:      "Samuel Marshall",
+					Group:       "system",
+				},
+			}},
+		},
+		{
+			Name: "core template",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/templates/cassiopeia/templateDetails.xml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "administrator template with element",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/administrator/templates/atum/templateDetails.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "atum_custom",
+				Version:   "1.2.0",
+				Locations: []string{"testdata/administrator/templates/atum/templateDetails.xml"},
+				Metadata: &joomla.Metadata{
+					Type:        "template",
+					Element:     "atum_custom",
+					DisplayName: "atum_custom",
+					Author:      "Example Agency",
+					Client:      "administrator",
+				},
+			}},
+		},
+		{
+			Name: "package",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/administrator/manifests/packages/pkg_regularlabs.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "pkg_regularlabs",
+				Version:   "24.2.10022",
+				Locations: []string{"testdata/administrator/manifests/packages/pkg_regularlabs.xml"},
+				Metadata: &joomla.Metadata{
+					Type:        "package",
+					Element:     "pkg_regularlabs",
+					DisplayName: "PKG_REGULARLABS",
+					Author:      "Regular Labs (Peter van Westen)",
+				},
+			}},
+		},
+		{
+			Name: "joomla 1.5 component",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/legacy/administrator/components/com_jce/jce.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "com_jce",
+				Version:   "1.5.7.14",
+				Locations: []string{"testdata/legacy/administrator/components/com_jce/jce.xml"},
+				Metadata: &joomla.Metadata{
+					Type:        "component",
+					Element:     "com_jce",
+					DisplayName: "JCE",
+					Author:      "Ryan Demmer",
+				},
+			}},
+		},
+		{
+			Name: "invalid manifest",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/administrator/components/com_akeebabackup/broken.xml",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := joomla.New(joomla.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := joomla.Extractor{}
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want *purl.PackageURL
+	}{
+		{
+			name: "core",
+			inv:  &extractor.Inventory{Name: "joomla/joomla-cms", Version: "4.4.3"},
+			want: &purl.PackageURL{Type: purl.TypeComposer, Name: "joomla/joomla-cms", Version: "4.4.3"},
+		},
+		{
+			name: "extension",
+			inv:  &extractor.Inventory{Name: "com_akeebabackup", Version: "9.8.4"},
+			want: &purl.PackageURL{Type: purl.TypeGeneric, Namespace: "joomla", Name: "com_akeebabackup", Version: "9.8.4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := e.ToPURL(tt.inv)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", tt.inv, diff)
+			}
+		})
+	}
+}
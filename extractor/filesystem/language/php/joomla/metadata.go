@@ -0,0 +1,37 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joomla
+
+// Metadata holds information about a Joomla core installation or an
+// installed extension.
+type Metadata struct {
+	// The kind of the extension as declared in its manifest, e.g. "component",
+	// "module", "plugin", "template", "package", "library" or "file", or "core"
+	// for Joomla itself.
+	Type string
+	// The element that identifies the extension in Joomla, e.g. "com_akeeba"
+	// or "mod_articles_news".
+	Element string
+	// The name from the manifest. Often a language key, e.g.
+	// "COM_AKEEBABACKUP".
+	DisplayName string
+	Author      string
+	// The application the extension is installed for, "site" or
+	// "administrator". Only set for modules and templates.
+	Client string
+	// The plugin group, e.g. "system" or "authentication". Only set for
+	// plugins.
+	Group string
+}
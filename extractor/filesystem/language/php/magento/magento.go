@@ -0,0 +1,435 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package magento extracts the version of Magento core and the modules and
+// themes of Magento 1 and 2 stores. Modules and themes copied into app/code
+// and app/design aren't installed through Composer, so they don't show up in
+// composer.lock files.
+package magento
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "php/magento"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+
+	// magento1Name is the name Magento 1 core is reported with.
+	magento1Name = "magento"
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// The composer.json of the Magento 2 product metapackage, e.g.
+	// vendor/magento/product-community-edition/composer.json.
+	kindCore
+	// app/Mage.php of Magento 1.
+	kindCore1
+	// The module.xml of a Magento 2 module in app/code/<Vendor>/<Module>/etc.
+	kindModule
+	// The theme.xml of a Magento 2 theme in app/design/<area>/<Vendor>/<theme>.
+	kindTheme
+	// The config.xml of a Magento 1 module in
+	// app/code/{local,community}/<Vendor>/<Module>/etc.
+	kindModule1
+)
+
+var (
+	// Mage::getVersionInfo() returns the version parts in an array, e.g.
+	// 'major' => '1', 'minor' => '9', 'revision' => '4', 'patch' => '5'.
+	versionInfoRe     = regexp.MustCompile(`(?s)function\s+getVersionInfo\s*\(\s*\)\s*\{.*?\}`)
+	versionInfoPartRe = regexp.MustCompile(`'(major|minor|revision|patch)'\s*=>\s*'([0-9]+)'`)
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Magento extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts Magento core, modules and themes.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a Magento extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file defines the Magento core
+// version or declares a module or theme.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// kindForPath returns the kind of the file at p.
+func kindForPath(p string) fileKind {
+	parts := strings.Split(filepath.ToSlash(p), "/")
+	n := len(parts)
+	// at returns the i-th to last path element.
+	at := func(i int) string {
+		if i > n {
+			return ""
+		}
+		return parts[n-i]
+	}
+	switch {
+	case at(1) == "composer.json" && at(3) == "magento" && at(4) == "vendor" &&
+		(at(2) == "product-community-edition" || at(2) == "product-enterprise-edition"):
+		return kindCore
+	case at(1) == "Mage.php" && at(2) == "app":
+		return kindCore1
+	case at(1) == "module.xml" && at(2) == "etc" && at(5) == "code" && at(6) == "app":
+		return kindModule
+	case at(1) == "theme.xml" && at(5) == "design" && at(6) == "app":
+		return kindTheme
+	case at(1) == "config.xml" && at(2) == "etc" && (at(5) == "local" || at(5) == "community") &&
+		at(6) == "code" && at(7) == "app":
+		return kindModule1
+	}
+	return kindNone
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// Magento core version or the module or theme it declares.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	p := filepath.ToSlash(input.Path)
+	switch kindForPath(input.Path) {
+	case kindCore:
+		c := &composerJSON{}
+		if err := json.NewDecoder(input.Reader).Decode(c); err != nil {
+			return nil, err
+		}
+		if c.Name == "" || c.Version == "" {
+			return nil, nil
+		}
+		return &extractor.Inventory{
+			Name:      c.Name,
+			Version:   c.Version,
+			Locations: []string{input.Path},
+			Metadata:  &Metadata{Type: "core", ComposerPackage: c.Name},
+		}, nil
+	case kindCore1:
+		version, err := parseMageVersion(input.Reader)
+		if err != nil || version == "" {
+			return nil, err
+		}
+		return &extractor.Inventory{
+			Name:      magento1Name,
+			Version:   version,
+			Locations: []string{input.Path},
+			Metadata:  &Metadata{Type: "core"},
+		}, nil
+	case kindModule:
+		m := &moduleXML{}
+		if err := xml.NewDecoder(input.Reader).Decode(m); err != nil {
+			return nil, err
+		}
+		if m.Module.Name == "" {
+			return nil, nil
+		}
+		md := &Metadata{
+			Type:         "module",
+			ModuleName:   m.Module.Name,
+			SetupVersion: m.Module.SetupVersion,
+		}
+		// The module directory is two levels above etc/module.xml.
+		return componentInventory(input, path.Dir(path.Dir(p)), m.Module.Name, m.Module.SetupVersion, md)
+	case kindTheme:
+		t := &themeXML{}
+		if err := xml.NewDecoder(input.Reader).Decode(t); err != nil {
+			return nil, err
+		}
+		parts := strings.Split(p, "/")
+		themePath := strings.Join(parts[len(parts)-4:len(parts)-1], "/")
+		md := &Metadata{
+			Type:        "theme",
+			ThemePath:   themePath,
+			DisplayName: strings.TrimSpace(t.Title),
+			Parent:      strings.TrimSpace(t.Parent),
+		}
+		return componentInventory(input, path.Dir(p), themePath, "", md)
+	case kindModule1:
+		c := &configXML{}
+		if err := xml.NewDecoder(input.Reader).Decode(c); err != nil {
+			return nil, err
+		}
+		if len(c.Modules.Modules) == 0 {
+			return nil, nil
+		}
+		m := c.Modules.Modules[0]
+		version := strings.TrimSpace(m.Version)
+		return &extractor.Inventory{
+			Name:      m.XMLName.Local,
+			Version:   version,
+			Locations: []string{input.Path},
+			Metadata: &Metadata{
+				Type:         "module",
+				ModuleName:   m.XMLName.Local,
+				SetupVersion: version,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported file %s", input.Path)
+	}
+}
+
+// composerJSON holds the fields of composer.json files the extractor uses.
+type composerJSON struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// moduleXML is the etc/module.xml of a Magento 2 module, e.g.
+// <config><module name="Amasty_Shopby" setup_version="2.18.3"/></config>.
+type moduleXML struct {
+	Module struct {
+		Name         string `xml:"name,attr"`
+		SetupVersion string `xml:"setup_version,attr"`
+	} `xml:"module"`
+}
+
+// themeXML is the theme.xml of a Magento 2 theme.
+type themeXML struct {
+	Title  string `xml:"title"`
+	Parent string `xml:"parent"`
+}
+
+// configXML is the etc/config.xml of a Magento 1 module, which declares the
+// module's version in <config><modules><Vendor_Module><version>.
+type configXML struct {
+	Modules struct {
+		Modules []struct {
+			XMLName xml.Name
+			Version string `xml:"version"`
+		} `xml:",any"`
+	} `xml:"modules"`
+}
+
+// componentInventory returns the inventory of the Magento 2 module or theme in
+// dir. If the component has a composer.json, it's reported as the Composer
+// package with the version from the composer.json.
+func componentInventory(input *filesystem.ScanInput, dir, name, version string, md *Metadata) (*extractor.Inventory, error) {
+	c, err := readComposerJSON(input.FS, path.Join(dir, "composer.json"))
+	if err != nil {
+		return nil, err
+	}
+	if c != nil && c.Name != "" {
+		name = c.Name
+		md.ComposerPackage = c.Name
+		if c.Version != "" {
+			version = c.Version
+		}
+	}
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Locations: []string{input.Path},
+		Metadata:  md,
+	}, nil
+}
+
+// readComposerJSON reads the composer.json at p, or returns nil if there is
+// none.
+func readComposerJSON(fsys fs.FS, p string) (*composerJSON, error) {
+	if fsys == nil {
+		return nil, nil
+	}
+	b, err := fs.ReadFile(fsys, p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := &composerJSON{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	return c, nil
+}
+
+// parseMageVersion returns the version Mage::getVersionInfo() of Magento 1
+// returns, e.g. "1.9.4.5".
+func parseMageVersion(r io.Reader) (string, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	f := versionInfoRe.Find(b)
+	if f == nil {
+		return "", nil
+	}
+	parts := map[string]string{}
+	for _, m := range versionInfoPartRe.FindAllSubmatch(f, -1) {
+		parts[string(m[1])] = string(m[2])
+	}
+	var version []string
+	for _, k := range []string{"major", "minor", "revision", "patch"} {
+		if parts[k] == "" {
+			return "", nil
+		}
+		version = append(version, parts[k])
+	}
+	return strings.Join(version, "."), nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	if isComposerPackage(i) {
+		return &purl.PackageURL{
+			Type:    purl.TypeComposer,
+			Name:    i.Name,
+			Version: i.Version,
+		}
+	}
+	return &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "magento",
+		Name:      i.Name,
+		Version:   i.Version,
+	}
+}
+
+// Ecosystem returns the OSV Ecosystem of the software extracted by this
+// extractor. Components with a composer.json are Packagist packages, the
+// others aren't tracked by OSV.
+func (Extractor) Ecosystem(i *extractor.Inventory) string {
+	if isComposerPackage(i) {
+		return "Packagist"
+	}
+	return ""
+}
+
+func isComposerPackage(i *extractor.Inventory) bool {
+	m, ok := i.Metadata.(*Metadata)
+	return ok && m.ComposerPackage != ""
+}
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,334 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package magento_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/magento"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "magento 2 open source version",
+			path:             "var/www/magento/vendor/magento/product-community-edition/composer.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "adobe commerce version",
+			path:             "vendor/magento/product-enterprise-edition/composer.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "other magento package",
+			path:         "vendor/magento/module-catalog/composer.json",
+			wantRequired: false,
+		},
+		{
+			name:             "magento 1 version",
+			path:             "var/www/magento/app/Mage.php",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "magento 2 module",
+			path:             "var/www/magento/app/code/Amasty/Shopby/etc/module.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "magento 2 module installed through composer",
+			path:         "vendor/amasty/shopby/etc/module.xml",
+			wantRequired: false,
+		},
+		{
+			name:             "magento 2 theme",
+			path:             "app/design/frontend/Smartwave/porto/theme.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "magento 1 community module",
+			path:             "app/code/community/Mageplaza/Smtp/etc/config.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "magento 1 local module",
+			path:             "app/code/local/Acme/Shipping/etc/config.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "magento 1 core module",
+			path:         "app/code/core/Mage/Catalog/etc/config.xml",
+			wantRequired: false,
+		},
+		{
+			name:         "magento 2 module config",
+			path:         "app/code/Amasty/Shopby/etc/config.xml",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "app/Mage.php",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "app/Mage.php",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := magento.New(magento.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "magento 2 version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/vendor/magento/product-community-edition/composer.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "magento/product-community-edition",
+				Version:   "2.4.6-p3",
+				Locations: []string{"testdata/vendor/magento/product-community-edition/composer.json"},
+				Metadata: &magento.Metadata{
+					Type:            "core",
+					ComposerPackage: "magento/product-community-edition",
+				},
+			}},
+		},
+		{
+			Name: "magento 1 version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/m1/app/Mage.php",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "magento",
+				Version:   "1.9.4.5",
+				Locations: []string{"testdata/m1/app/Mage.php"},
+				Metadata: &magento.Metadata{
+					Type: "core",
+				},
+			}},
+		},
+		{
+			Name: "module with composer.json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/app/code/Amasty/Shopby/etc/module.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "amasty/shopby",
+				Version:   "2.21.5",
+				Locations: []string{"testdata/app/code/Amasty/Shopby/etc/module.xml"},
+				Metadata: &magento.Metadata{
+					Type:            "module",
+					ModuleName:      "Amasty_Shopby",
+					SetupVersion:    "2.18.3",
+					ComposerPackage: "amasty/shopby",
+				},
+			}},
+		},
+		{
+			Name: "module without composer.json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/app/code/Acme/Checkout/etc/module.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "Acme_Checkout",
+				Version:   "1.0.4",
+				Locations: []string{"testdata/app/code/Acme/Checkout/etc/module.xml"},
+				Metadata: &magento.Metadata{
+					Type:         "module",
+					ModuleName:   "Acme_Checkout",
+					SetupVersion: "1.0.4",
+				},
+			}},
+		},
+		{
+			Name: "theme with composer.json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/app/design/frontend/Smartwave/porto/theme.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "smartwave/porto",
+				Version:   "2.4.6",
+				Locations: []string{"testdata/app/design/frontend/Smartwave/porto/theme.xml"},
+				Metadata: &magento.Metadata{
+					Type:            "theme",
+					ThemePath:       "frontend/Smartwave/porto",
+					DisplayName:     "Porto",
+					Parent:          "Magento/blank",
+					ComposerPackage: "smartwave/porto",
+				},
+			}},
+		},
+		{
+			Name: "theme without composer.json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/app/design/adminhtml/Acme/backend/theme.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "adminhtml/Acme/backend",
+				Locations: []string{"testdata/app/design/adminhtml/Acme/backend/theme.xml"},
+				Metadata: &magento.Metadata{
+					Type:        "theme",
+					ThemePath:   "adminhtml/Acme/backend",
+					DisplayName: "Acme Backend",
+					Parent:      "Magento/backend",
+				},
+			}},
+		},
+		{
+			Name: "magento 1 module",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/m1/app/code/community/Mageplaza/Smtp/etc/config.xml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "Mageplaza_Smtp",
+				Version:   "1.2.1",
+				Locations: []string{"testdata/m1/app/code/community/Mageplaza/Smtp/etc/config.xml"},
+				Metadata: &magento.Metadata{
+					Type:         "module",
+					ModuleName:   "Mageplaza_Smtp",
+					SetupVersion: "1.2.1",
+				},
+			}},
+		},
+		{
+			Name: "module with invalid composer.json",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/app/code/Acme/Broken/etc/module.xml",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := magento.New(magento.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := magento.Extractor{}
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want *purl.PackageURL
+	}{
+		{
+			name: "composer package",
+			inv: &extractor.Inventory{
+				Name:     "amasty/shopby",
+				Version:  "2.21.5",
+				Metadata: &magento.Metadata{Type: "module", ComposerPackage: "amasty/shopby"},
+			},
+			want: &purl.PackageURL{Type: purl.TypeComposer, Name: "amasty/shopby", Version: "2.21.5"},
+		},
+		{
+			name: "module without composer.json",
+			inv: &extractor.Inventory{
+				Name:     "Acme_Checkout",
+				Version:  "1.0.4",
+				Metadata: &magento.Metadata{Type: "module", ModuleName: "Acme_Checkout"},
+			},
+			want: &purl.PackageURL{Type: purl.TypeGeneric, Namespace: "magento", Name: "Acme_Checkout", Version: "1.0.4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := e.ToPURL(tt.inv)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", tt.inv, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package magento
+
+// Metadata holds information about a Magento core installation or an
+// installed module or theme.
+type Metadata struct {
+	// The kind of the component: "core", "module" or "theme".
+	Type string
+	// The name of the module, e.g. "Amasty_Shopby". Only set for modules.
+	ModuleName string
+	// The area, vendor and name of the theme, e.g. "frontend/Smartwave/porto".
+	// Only set for themes.
+	ThemePath string
+	// The title of the theme. Only set for themes.
+	DisplayName string
+	// The theme the theme inherits from, e.g. "Magento/luma". Only set for
+	// themes.
+	Parent string
+	// The schema version the module declares in its module.xml (Magento 2) or
+	// config.xml (Magento 1).
+	SetupVersion string
+	// The Composer package the component is published as, e.g.
+	// "amasty/shopby". Empty for components without a composer.json.
+	ComposerPackage string
+}
@@ -0,0 +1,35 @@
+<?php
+/**
+ * Main Mage hub class
+ */
+final class Mage
+{
+    /**
+     * Gets the current Magento version string
+     *
+     * @return string
+     */
+    public static function getVersion()
+    {
+        $i = self::getVersionInfo();
+        return trim("{$i['major']}.{$i['minor']}.{$i['revision']}" . ($i['patch'] != '' ? ".{$i['patch']}" : "")
+                        . "-{$i['stability']}{$i['number']}", '.-');
+    }
+
+    /**
+     * Gets the detailed Magento version information
+     *
+     * @return array
+     */
+    public static function getVersionInfo()
+    {
+        return array(
+            'major'     => '1',
+            'minor'     => '9',
+            'revision'  => '4',
+            'patch'     => '5',
+            'stability' => '',
+            'number'    => '',
+        );
+    }
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pear
+
+// Metadata holds information about a package installed with the PEAR or PECL
+// installer.
+type Metadata struct {
+	// The channel the package was installed from, e.g. "pear.php.net" or
+	// "pecl.php.net".
+	Channel string
+	// The PHP extension built from a PECL package, e.g. "imagick".
+	ProvidesExtension string
+	// The release date of the installed version, e.g. "2017-04-11".
+	ReleaseDate string
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pear extracts the packages installed with the PEAR and PECL
+// installers of legacy PHP servers from the PEAR registry. These packages
+// predate Composer and aren't listed in composer.lock files.
+package pear
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "php/pear"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 10 * units.MiB
+
+	// registryDir is the directory of the PEAR registry in the PHP include
+	// directory, e.g. /usr/share/php/.registry.
+	registryDir = ".registry"
+	// channelDirPrefix is the prefix of the registry subdirectories of channels
+	// other than pear.php.net, e.g. .channel.pecl.php.net.
+	channelDirPrefix = ".channel."
+	// defaultChannel is the channel of the packages at the top level of the
+	// registry and of those installed by PEAR versions without channels.
+	defaultChannel = "pear.php.net"
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the PEAR extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts PEAR and PECL packages.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a PEAR extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is a package file of the
+// PEAR registry.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if _, ok := channelForPath(path); !ok {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// channelForPath returns the channel of the package registered in the file at
+// p, i.e. .registry/<package>.reg for pear.php.net packages and
+// .registry/.channel.<channel>/<package>.reg for other channels.
+func channelForPath(p string) (string, bool) {
+	p = filepath.ToSlash(p)
+	if path.Ext(p) != ".reg" {
+		return "", false
+	}
+	dir := path.Dir(p)
+	if path.Base(dir) == registryDir {
+		return defaultChannel, true
+	}
+	channelDir := path.Base(dir)
+	if !strings.HasPrefix(channelDir, channelDirPrefix) || path.Base(path.Dir(dir)) != registryDir {
+		return "", false
+	}
+	channel := strings.TrimPrefix(channelDir, channelDirPrefix)
+	// Channels of aliases like __uri have no packages of interest.
+	if channel == "" || strings.HasPrefix(channel, "__") {
+		return "", false
+	}
+	return channel, true
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the registry file passed through the scan input and returns
+// the package it describes.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	b, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	v, err := unserialize(b)
+	if err != nil {
+		return nil, err
+	}
+	reg, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("registry file is a %T, not an array", v)
+	}
+
+	// Registries of PEAR 1.4 and later hold the parsed package.xml 2.0 with the
+	// "name" and the "release" and "api" versions. Older registries hold the
+	// package.xml 1.0 fields with the "package" name and a single "version".
+	name := stringField(reg, "name")
+	if name == "" {
+		name = stringField(reg, "package")
+	}
+	version := stringField(reg, "version")
+	if versions, ok := reg["version"].(map[string]any); ok {
+		version = stringField(versions, "release")
+	}
+	if name == "" || version == "" {
+		return nil, nil
+	}
+
+	channel := stringField(reg, "channel")
+	if channel == "" {
+		channel, _ = channelForPath(input.Path)
+	}
+	releaseDate := stringField(reg, "date")
+	if releaseDate == "" {
+		releaseDate = stringField(reg, "release_date")
+	}
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Locations: []string{input.Path},
+		Metadata: &Metadata{
+			Channel:           channel,
+			ProvidesExtension: stringField(reg, "providesextension"),
+			ReleaseDate:       releaseDate,
+		},
+	}, nil
+}
+
+func stringField(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL. There is
+// no PURL type for PEAR, so generic PURLs namespaced by the channel are used.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	p := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+	if m, ok := i.Metadata.(*Metadata); ok {
+		p.Namespace = m.Channel
+	}
+	return p
+}
+
+// Ecosystem returns no ecosystem since OSV does not track PEAR and PECL
+// packages.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,229 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pear_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/pear"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "pear.php.net package",
+			path:             "usr/share/php/.registry/mail.reg",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "pecl.php.net package",
+			path:             "usr/share/php/.registry/.channel.pecl.php.net/imagick.reg",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "uri pseudo-channel",
+			path:         "usr/share/php/.registry/.channel.__uri/foo.reg",
+			wantRequired: false,
+		},
+		{
+			name:         "channel definition",
+			path:         "usr/share/php/.channels/pecl.php.net.reg",
+			wantRequired: false,
+		},
+		{
+			name:         "reg file outside of the registry",
+			path:         "etc/foo/settings.reg",
+			wantRequired: false,
+		},
+		{
+			name:         "other file in the registry",
+			path:         "usr/share/php/.registry/.lock",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "usr/share/php/.registry/mail.reg",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "usr/share/php/.registry/mail.reg",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := pear.New(pear.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "pear package",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/usr/share/php/.registry/mail.reg",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "Mail",
+				Version:   "1.4.1",
+				Locations: []string{"testdata/usr/share/php/.registry/mail.reg"},
+				Metadata: &pear.Metadata{
+					Channel:     "pear.php.net",
+					ReleaseDate: "2017-04-11",
+				},
+			}},
+		},
+		{
+			Name: "pecl extension",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/usr/share/php/.registry/.channel.pecl.php.net/imagick.reg",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "imagick",
+				Version:   "3.4.4",
+				Locations: []string{"testdata/usr/share/php/.registry/.channel.pecl.php.net/imagick.reg"},
+				Metadata: &pear.Metadata{
+					Channel:           "pecl.php.net",
+					ProvidesExtension: "imagick",
+					ReleaseDate:       "2019-05-02",
+				},
+			}},
+		},
+		{
+			Name: "registry of pear without channels",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/opt/php4/lib/php/.registry/db.reg",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "DB",
+				Version:   "1.6.2",
+				Locations: []string{"testdata/opt/php4/lib/php/.registry/db.reg"},
+				Metadata: &pear.Metadata{
+					Channel:     "pear.php.net",
+					ReleaseDate: "2004-02-13",
+				},
+			}},
+		},
+		{
+			Name: "registry file without package",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/empty/.registry/empty.reg",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "truncated registry file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/.registry/broken.reg",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := pear.New(pear.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := pear.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "imagick",
+		Version:   "3.4.4",
+		Locations: []string{"usr/share/php/.registry/.channel.pecl.php.net/imagick.reg"},
+		Metadata:  &pear.Metadata{Channel: "pecl.php.net", ProvidesExtension: "imagick"},
+	}
+	want := &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "pecl.php.net",
+		Name:      "imagick",
+		Version:   "3.4.4",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
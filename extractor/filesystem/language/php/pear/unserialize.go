@@ -0,0 +1,165 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pear
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// maxDepth is the nesting limit of the serialized values, to fail on
+// malicious files instead of exhausting the stack.
+const maxDepth = 32
+
+// unserialize decodes a value in the format of PHP's serialize() function.
+// Arrays and objects are returned as a map[string]any, strings, integers,
+// floats and booleans as their literal string and null as nil.
+func unserialize(b []byte) (any, error) {
+	d := &decoder{b: b}
+	v, err := d.value(0)
+	if err != nil {
+		return nil, fmt.Errorf("offset %d: %w", d.pos, err)
+	}
+	return v, nil
+}
+
+type decoder struct {
+	b   []byte
+	pos int
+}
+
+func (d *decoder) value(depth int) (any, error) {
+	if depth > maxDepth {
+		return nil, errors.New("values nested too deeply")
+	}
+	if d.pos+1 >= len(d.b) {
+		return nil, errors.New("unexpected end of input")
+	}
+	typ := d.b[d.pos]
+	if typ == 'N' {
+		d.pos++
+		return nil, d.expect(';')
+	}
+	d.pos++
+	if err := d.expect(':'); err != nil {
+		return nil, err
+	}
+	switch typ {
+	case 'b', 'i', 'd':
+		return d.until(';')
+	case 's':
+		return d.str()
+	case 'a':
+		return d.array(depth)
+	case 'O':
+		// Objects are serialized as their class name followed by their
+		// properties in the array format.
+		if _, err := d.str(); err != nil {
+			return nil, err
+		}
+		if err := d.expect(':'); err != nil {
+			return nil, err
+		}
+		return d.array(depth)
+	default:
+		return nil, fmt.Errorf("unsupported type %q", typ)
+	}
+}
+
+// str decodes a length-prefixed string: <len>:"<bytes>".
+func (d *decoder) str() (string, error) {
+	n, err := d.length(':')
+	if err != nil {
+		return "", err
+	}
+	if err := d.expect('"'); err != nil {
+		return "", err
+	}
+	if n > len(d.b)-d.pos {
+		return "", errors.New("string exceeds the input")
+	}
+	s := string(d.b[d.pos : d.pos+n])
+	d.pos += n
+	if err := d.expect('"'); err != nil {
+		return "", err
+	}
+	// Strings are terminated by ';' except for class names.
+	if d.pos < len(d.b) && d.b[d.pos] == ';' {
+		d.pos++
+	}
+	return s, nil
+}
+
+// array decodes <count>:{<key><value>...}.
+func (d *decoder) array(depth int) (map[string]any, error) {
+	n, err := d.length(':')
+	if err != nil {
+		return nil, err
+	}
+	if err := d.expect('{'); err != nil {
+		return nil, err
+	}
+	m := make(map[string]any)
+	for range n {
+		k, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid array key %v", k)
+		}
+		v, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+	return m, d.expect('}')
+}
+
+// length decodes a non-negative integer terminated by sep.
+func (d *decoder) length(sep byte) (int, error) {
+	s, err := d.until(sep)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid length %q", s)
+	}
+	return n, nil
+}
+
+// until returns the input up to sep and skips sep.
+func (d *decoder) until(sep byte) (string, error) {
+	for i := d.pos; i < len(d.b); i++ {
+		if d.b[i] == sep {
+			s := string(d.b[d.pos:i])
+			d.pos = i + 1
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("missing %q", sep)
+}
+
+func (d *decoder) expect(c byte) error {
+	if d.pos >= len(d.b) || d.b[d.pos] != c {
+		return fmt.Errorf("expected %q", c)
+	}
+	d.pos++
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
 
 	"github.com/BurntSushi/toml"
 	"github.com/google/osv-scalibr/extractor"
@@ -27,6 +28,7 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem/osv"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
+	"golang.org/x/exp/maps"
 )
 
 type poetryLockPackageSource struct {
@@ -39,6 +41,9 @@ type poetryLockPackage struct {
 	Version  string                  `toml:"version"`
 	Optional bool                    `toml:"optional"`
 	Source   poetryLockPackageSource `toml:"source"`
+	// The dependencies of the package, keyed by name. The values are the
+	// version constraints, which are either strings or tables.
+	Dependencies map[string]any `toml:"dependencies"`
 }
 
 type poetryLockFile struct {
@@ -99,10 +104,39 @@ func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]
 		}
 		packages = append(packages, pkgDetails)
 	}
+	resolvePoetryDependencies(parsedLockfile.Packages, packages)
 
 	return packages, nil
 }
 
+// normalizedName returns the normalized name of a Python package, see
+// https://peps.python.org/pep-0503/#normalized-names
+func normalizedName(name string) string {
+	return pypipurl.MakePackageURL(&extractor.Inventory{Name: name}).Name
+}
+
+// resolvePoetryDependencies sets the dependencies of the packages to the
+// locked packages with the names they depend on. A name can be locked at
+// several versions for different environments, in which case all of them are
+// recorded.
+func resolvePoetryDependencies(lockPackages []poetryLockPackage, packages []*extractor.Inventory) {
+	byName := map[string][]*extractor.Inventory{}
+	for _, pkg := range packages {
+		n := normalizedName(pkg.Name)
+		byName[n] = append(byName[n], pkg)
+	}
+
+	for i, lockPackage := range lockPackages {
+		names := maps.Keys(lockPackage.Dependencies)
+		slices.Sort(names)
+		for _, name := range names {
+			for _, dep := range byName[normalizedName(name)] {
+				packages[i].Dependencies = append(packages[i].Dependencies, extractor.Dependency{Name: dep.Name, Version: dep.Version})
+			}
+		}
+	}
+}
+
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return pypipurl.MakePackageURL(i)
@@ -215,9 +215,45 @@ func TestExtractor_Extract(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess), extracttest.IgnoreDependencies); diff != "" {
 				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
 			}
 		})
 	}
 }
+
+func TestExtract_Dependencies(t *testing.T) {
+	path := "testdata/dependencies.lock"
+	want := map[string][]extractor.Dependency{
+		"certifi@2024.8.30":        nil,
+		"charset-normalizer@3.4.0": nil,
+		"numpy@1.24.4":             nil,
+		"numpy@2.1.3":              nil,
+		"requests@2.32.3": {
+			{Name: "certifi", Version: "2024.8.30"},
+			{Name: "charset-normalizer", Version: "3.4.0"},
+		},
+		"pandas@2.2.3": {
+			{Name: "numpy", Version: "1.24.4"},
+			{Name: "numpy", Version: "2.1.3"},
+			{Name: "requests", Version: "2.32.3"},
+		},
+	}
+
+	extr := poetrylock.Extractor{}
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: path})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	inv, err := extr.Extract(context.Background(), &scanInput)
+	if err != nil {
+		t.Fatalf("Extract(%s): %v", path, err)
+	}
+
+	got := map[string][]extractor.Dependency{}
+	for _, i := range inv {
+		got[i.Name+"@"+i.Version] = i.Dependencies
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Extract(%s) dependencies diff (-want +got):\n%s", path, diff)
+	}
+}
@@ -18,4 +18,7 @@ package wheelegg
 type PythonPackageMetadata struct {
 	Author      string `json:"author"`
 	AuthorEmail string `json:"authorEmail"`
+	// The Python version the package is installed for, e.g. "2.7", if it's
+	// known from the path of the package.
+	PythonVersion string `json:"pythonVersion,omitempty"`
 }
@@ -24,7 +24,9 @@ import (
 	"fmt"
 	"io"
 	"net/textproto"
+	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/google/osv-scalibr/extractor"
@@ -92,6 +94,15 @@ func (e Extractor) Version() int { return 0 }
 func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
 
 var (
+	// The Python version of the site-packages directory of an installed
+	// package, e.g. lib/python2.7/site-packages or Debian's dist-packages.
+	sitePackagesRe = regexp.MustCompile(`(?:^|/)python([0-9]+\.[0-9]+)/(?:site|dist)-packages/`)
+	// The Python version tag of an egg name, e.g. foo-1.0-py2.7.egg,
+	// foo-1.0-py2.7-linux-x86_64.egg or foo-1.0-py2.7.egg-info.
+	eggPythonTagRe = regexp.MustCompile(`-py([0-9]+(?:\.[0-9]+)?)(?:-[^/]*)?\.egg(?:-info)?(?:/|$)`)
+	// The name and version of an egg file, e.g. foo_bar-1.0-py2.7.egg.
+	eggFilenameRe = regexp.MustCompile(`^([^-]+)-([^-]+)(?:-py[0-9][^-]*)?(?:-.+)?\.egg$`)
+
 	requiredFiles = []string{
 		// Metadata format
 		"EGG-INFO/PKG-INFO",
@@ -146,8 +157,14 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 // For .egg files, input.Info.Size() is required to unzip the file.
 func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) (inventory []*extractor.Inventory, err error) {
 	if strings.HasSuffix(input.Path, ".egg") {
-		// TODO(b/280417821): In case extractZip returns no inventory, we could parse the filename.
 		inventory, err = e.extractZip(ctx, input)
+		if err == nil && len(inventory) == 0 {
+			// Eggs built by old setuptools versions may lack metadata. Fall back
+			// to the name and version in the egg filename.
+			if i := inventoryFromEggFilename(input.Path); i != nil {
+				inventory = []*extractor.Inventory{i}
+			}
+		}
 	} else {
 		var i *extractor.Inventory
 		if i, err = e.extractSingleFile(input.Reader, input.Path); i != nil {
@@ -244,9 +261,39 @@ func (e Extractor) extractSingleFile(r io.Reader, path string) (*extractor.Inven
 	}
 
 	i.Locations = []string{path}
+	i.Metadata.(*PythonPackageMetadata).PythonVersion = pythonVersion(path)
 	return i, nil
 }
 
+// inventoryFromEggFilename returns the package of an egg file based on its
+// filename, or nil if the filename doesn't contain a version.
+func inventoryFromEggFilename(p string) *extractor.Inventory {
+	m := eggFilenameRe.FindStringSubmatch(path.Base(filepath.ToSlash(p)))
+	if m == nil {
+		return nil
+	}
+	return &extractor.Inventory{
+		Name:      m[1],
+		Version:   m[2],
+		Locations: []string{p},
+		Metadata:  &PythonPackageMetadata{PythonVersion: pythonVersion(p)},
+	}
+}
+
+// pythonVersion returns the Python version a package is installed for based
+// on the egg name or site-packages directory in its path, e.g. "2.7" for the
+// packages of end-of-life Python 2 installations, or "" if it's unknown.
+func pythonVersion(p string) string {
+	p = filepath.ToSlash(p)
+	if m := eggPythonTagRe.FindStringSubmatch(p); m != nil {
+		return m[1]
+	}
+	if m := sitePackagesRe.FindStringSubmatch(p); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
 func parse(r io.Reader) (*extractor.Inventory, error) {
 	rd := textproto.NewReader(bufio.NewReader(r))
 	h, err := rd.ReadMIMEHeader()
@@ -226,15 +226,37 @@ func TestExtract(t *testing.T) {
 				Version:   "1.6",
 				Locations: []string{"testdata/monotonic-1.6-py3.10.egg"},
 				Metadata: &wheelegg.PythonPackageMetadata{
-					Author:      "Ori Livneh",
-					AuthorEmail: "ori@wikimedia.org",
+					Author:        "Ori Livneh",
+					AuthorEmail:   "ori@wikimedia.org",
+					PythonVersion: "3.10",
 				},
 			}},
 		},
 		{
-			name:          ".egg without PKG-INFO",
-			path:          "testdata/monotonic_no_pkginfo-1.6-py3.10.egg",
-			wantInventory: []*extractor.Inventory{},
+			name: ".egg without PKG-INFO",
+			path: "testdata/monotonic_no_pkginfo-1.6-py3.10.egg",
+			wantInventory: []*extractor.Inventory{{
+				Name:      "monotonic_no_pkginfo",
+				Version:   "1.6",
+				Locations: []string{"testdata/monotonic_no_pkginfo-1.6-py3.10.egg"},
+				Metadata: &wheelegg.PythonPackageMetadata{
+					PythonVersion: "3.10",
+				},
+			}},
+		},
+		{
+			name: "python 2 distutils .egg-info",
+			path: "testdata/usr/lib/python2.7/dist-packages/MySQL_python-1.2.3.egg-info",
+			wantInventory: []*extractor.Inventory{{
+				Name:      "MySQL-python",
+				Version:   "1.2.3",
+				Locations: []string{"testdata/usr/lib/python2.7/dist-packages/MySQL_python-1.2.3.egg-info"},
+				Metadata: &wheelegg.PythonPackageMetadata{
+					Author:        "Andy Dustman",
+					AuthorEmail:   "adustman@users.sourceforge.net",
+					PythonVersion: "2.7",
+				},
+			}},
 		},
 	}
 
@@ -319,8 +341,9 @@ func TestExtractWithoutReadAt(t *testing.T) {
 				Version:   "1.6",
 				Locations: []string{"testdata/monotonic-1.6-py3.10.egg"},
 				Metadata: &wheelegg.PythonPackageMetadata{
-					Author:      "Ori Livneh",
-					AuthorEmail: "ori@wikimedia.org",
+					Author:        "Ori Livneh",
+					AuthorEmail:   "ori@wikimedia.org",
+					PythonVersion: "3.10",
 				},
 			},
 		},
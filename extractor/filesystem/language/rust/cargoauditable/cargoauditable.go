@@ -0,0 +1,304 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cargoauditable extracts the crates compiled into Rust binaries
+// built with cargo-auditable, which embeds the dependency tree of the binary
+// as zlib-compressed JSON in a .dep-v0 section.
+package cargoauditable
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "rust/cargoauditable"
+
+	// sectionName is the section cargo-auditable stores the dependency tree in
+	// on ELF and PE binaries.
+	sectionName = ".dep-v0"
+	// machoSectionName is the section in the __DATA segment cargo-auditable
+	// stores the dependency tree in on Mach-O binaries.
+	machoSectionName = "__dep_v0"
+	// maxJSONSize limits the size of the decompressed dependency tree so that
+	// a malicious binary can't exhaust the memory of the scanner.
+	maxJSONSize = 8 * units.MiB
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// Extractor extracts crates from the dependency tree embedded in Rust
+// binaries by cargo-auditable.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// DefaultConfig returns a default configuration for the extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: 0,
+	}
+}
+
+// New returns a cargo-auditable binary extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the files the extractor reads. Any executable file
+// can be a Rust binary.
+func (e Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{FilePatterns: []string{"**/*"}, Ecosystems: []string{"crates.io"}, Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is marked executable.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+
+	if !fileinfo.Mode().IsRegular() {
+		// Includes dirs, symlinks, sockets, pipes...
+		return false
+	}
+
+	// Either windows .exe or unix executable bit should be set.
+	if filepath.Ext(path) != ".exe" && fileinfo.Mode()&0111 == 0 {
+		return false
+	}
+
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// auditInfo is the dependency tree cargo-auditable embeds, see
+// https://github.com/rust-secure-code/cargo-auditable/blob/master/PARSING.md
+type auditInfo struct {
+	Packages []struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+		Source  string `json:"source"`
+		Kind    string `json:"kind"`
+		// Whether the package is the crate of the binary itself.
+		Root bool `json:"root"`
+	} `json:"packages"`
+}
+
+// Extract returns the crates compiled into a Rust binary.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var readerAt io.ReaderAt
+	if fileWithReaderAt, ok := input.Reader.(io.ReaderAt); ok {
+		readerAt = fileWithReaderAt
+	} else {
+		buf := bytes.NewBuffer([]byte{})
+		_, err := io.Copy(buf, input.Reader)
+		if err != nil {
+			return []*extractor.Inventory{}, err
+		}
+		readerAt = bytes.NewReader(buf.Bytes())
+	}
+
+	data, err := depSection(readerAt)
+	if err != nil {
+		// Most executables aren't Rust binaries built with cargo-auditable.
+		log.Debugf("no cargo-auditable dependency tree in %s: %v", input.Path, err)
+		e.reportFileExtracted(input.Path, input.Info, nil)
+		return []*extractor.Inventory{}, nil
+	}
+
+	inventory, err := e.extractPackages(data, input.Path)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	return inventory, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+var errNoSection = errors.New("no " + sectionName + " section")
+
+// depSection returns the contents of the section cargo-auditable stores the
+// compressed dependency tree in.
+func depSection(r io.ReaderAt) ([]byte, error) {
+	magic := make([]byte, 4)
+	if _, err := r.ReadAt(magic, 0); err != nil {
+		return nil, err
+	}
+	switch {
+	case bytes.Equal(magic, []byte("\x7fELF")):
+		f, err := elf.NewFile(r)
+		if err != nil {
+			return nil, err
+		}
+		if s := f.Section(sectionName); s != nil {
+			return s.Data()
+		}
+	case bytes.Equal(magic[:2], []byte("MZ")):
+		f, err := pe.NewFile(r)
+		if err != nil {
+			return nil, err
+		}
+		if s := f.Section(sectionName); s != nil {
+			return s.Data()
+		}
+	default:
+		f, err := macho.NewFile(r)
+		if err != nil {
+			fat, fatErr := macho.NewFatFile(r)
+			if fatErr != nil || len(fat.Arches) == 0 {
+				return nil, err
+			}
+			// All architectures of a universal binary are built from the same
+			// dependency tree.
+			f = fat.Arches[0].File
+		}
+		if s := f.Section(machoSectionName); s != nil {
+			return s.Data()
+		}
+	}
+	return nil, errNoSection
+}
+
+func (e Extractor) extractPackages(data []byte, path string) ([]*extractor.Inventory, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	var info auditInfo
+	if err := json.NewDecoder(io.LimitReader(zr, maxJSONSize)).Decode(&info); err != nil {
+		return nil, err
+	}
+
+	res := []*extractor.Inventory{}
+	for _, p := range info.Packages {
+		if p.Root || p.Name == "" || p.Version == "" {
+			continue
+		}
+		kind := p.Kind
+		if kind == "" {
+			kind = KindRuntime
+		}
+		res = append(res, &extractor.Inventory{
+			Name:      p.Name,
+			Version:   p.Version,
+			Locations: []string{path},
+			Metadata: &Metadata{
+				Source: p.Source,
+				Kind:   kind,
+			},
+		})
+	}
+	return res, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeCargo,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns the OSV ecosystem ('crates.io') of crates from crates.io.
+// Crates from git, local paths and alternative registries aren't tracked by
+// OSV.
+func (Extractor) Ecosystem(i *extractor.Inventory) string {
+	if m, ok := i.Metadata.(*Metadata); ok && m.Source != SourceCratesIO {
+		return ""
+	}
+	return "crates.io"
+}
+
+var _ filesystem.Extractor = Extractor{}
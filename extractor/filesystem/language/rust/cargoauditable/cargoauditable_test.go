@@ -0,0 +1,233 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargoauditable_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargoauditable"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		mode             fs.FileMode
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "executable",
+			path:             "usr/local/bin/service",
+			mode:             0755,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "windows exe",
+			path:             "Program Files/service/service.exe",
+			mode:             0644,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "not executable",
+			path:         "usr/local/lib/service.rlib",
+			mode:         0644,
+			wantRequired: false,
+		},
+		{
+			name:         "non regular file",
+			path:         "run/service.sock",
+			mode:         fs.ModeSocket | 0777,
+			wantRequired: false,
+		},
+		{
+			name:             "executable not required if size greater than maxFileSizeBytes",
+			path:             "usr/local/bin/service",
+			mode:             0755,
+			fileSizeBytes:    1000,
+			maxFileSizeBytes: 100,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "executable required if maxFileSizeBytes explicitly set to 0",
+			path:             "usr/local/bin/service",
+			mode:             0755,
+			fileSizeBytes:    1000,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := cargoauditable.New(cargoauditable.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1000
+			}
+
+			if got := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: tt.mode,
+				FileSize: fileSizeBytes,
+			})); got != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, got, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "binary built with cargo-auditable",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/auditable-linux",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "serde",
+					Version:   "1.0.197",
+					Locations: []string{"testdata/auditable-linux"},
+					Metadata:  &cargoauditable.Metadata{Source: cargoauditable.SourceCratesIO, Kind: cargoauditable.KindRuntime},
+				},
+				{
+					Name:      "tokio",
+					Version:   "1.36.0",
+					Locations: []string{"testdata/auditable-linux"},
+					Metadata:  &cargoauditable.Metadata{Source: cargoauditable.SourceCratesIO, Kind: cargoauditable.KindRuntime},
+				},
+				{
+					Name:      "cc",
+					Version:   "1.0.90",
+					Locations: []string{"testdata/auditable-linux"},
+					Metadata:  &cargoauditable.Metadata{Source: cargoauditable.SourceCratesIO, Kind: cargoauditable.KindBuild},
+				},
+				{
+					Name:      "internal-auth",
+					Version:   "0.1.0",
+					Locations: []string{"testdata/auditable-linux"},
+					Metadata:  &cargoauditable.Metadata{Source: cargoauditable.SourceGit, Kind: cargoauditable.KindRuntime},
+				},
+			},
+		},
+		{
+			Name: "binary without dependency tree",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/plain-linux",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "script",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/script.sh",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "corrupt dependency tree",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/corrupt-linux",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := cargoauditable.New(cargoauditable.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestEcosystem(t *testing.T) {
+	e := cargoauditable.Extractor{}
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{source: cargoauditable.SourceCratesIO, want: "crates.io"},
+		{source: cargoauditable.SourceGit, want: ""},
+		{source: cargoauditable.SourceLocal, want: ""},
+	}
+	for _, tc := range tests {
+		i := &extractor.Inventory{Name: "serde", Metadata: &cargoauditable.Metadata{Source: tc.source}}
+		if got := e.Ecosystem(i); got != tc.want {
+			t.Errorf("Ecosystem(source %q) = %q, want %q", tc.source, got, tc.want)
+		}
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := cargoauditable.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "tokio",
+		Version:   "1.36.0",
+		Locations: []string{"usr/local/bin/service"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeCargo,
+		Name:    "tokio",
+		Version: "1.36.0",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargoauditable
+
+// Sources of crates.
+const (
+	SourceCratesIO = "crates.io"
+	SourceGit      = "git"
+	SourceLocal    = "local"
+	SourceRegistry = "registry"
+)
+
+// Kinds of dependencies.
+const (
+	// KindRuntime crates are compiled into the binary.
+	KindRuntime = "runtime"
+	// KindBuild crates are only used by build scripts and procedural macros.
+	KindBuild = "build"
+)
+
+// Metadata holds where a crate embedded in a Rust binary came from.
+type Metadata struct {
+	// SourceCratesIO, SourceGit, SourceLocal, SourceRegistry or "other".
+	Source string
+	// KindRuntime or KindBuild.
+	Kind string
+}
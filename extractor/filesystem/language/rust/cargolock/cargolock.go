@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 
@@ -31,6 +32,12 @@ import (
 type cargoLockPackage struct {
 	Name    string `toml:"name"`
 	Version string `toml:"version"`
+	// Empty for the crates of the workspace itself.
+	Source string `toml:"source"`
+	// The dependencies of the crate, as "name", "name version" or
+	// "name version (source)". The version is only given if several versions
+	// of the crate are locked.
+	Dependencies []string `toml:"dependencies"`
 }
 
 type cargoLockFile struct {
@@ -76,10 +83,51 @@ func (e Extractor) Extract(_ context.Context, input *filesystem.ScanInput) ([]*e
 			Locations: []string{input.Path},
 		})
 	}
+	resolveCargoDependencies(parsedLockfile.Packages, packages)
 
 	return packages, nil
 }
 
+// resolveCargoDependencies sets the dependencies of the packages to the
+// locked crates they refer to. Dependencies of the crates of the workspace are
+// direct dependencies.
+func resolveCargoDependencies(lockPackages []cargoLockPackage, packages []*extractor.Inventory) {
+	byName := map[string][]*extractor.Inventory{}
+	for _, pkg := range packages {
+		byName[pkg.Name] = append(byName[pkg.Name], pkg)
+	}
+
+	for i, lockPackage := range lockPackages {
+		for _, d := range lockPackage.Dependencies {
+			fields := strings.Fields(d)
+			if len(fields) == 0 {
+				continue
+			}
+
+			var dep *extractor.Inventory
+			candidates := byName[fields[0]]
+			if len(fields) > 1 {
+				for _, c := range candidates {
+					if c.Version == fields[1] {
+						dep = c
+						break
+					}
+				}
+			} else if len(candidates) == 1 {
+				dep = candidates[0]
+			}
+			if dep == nil {
+				continue
+			}
+
+			packages[i].Dependencies = append(packages[i].Dependencies, extractor.Dependency{Name: dep.Name, Version: dep.Version})
+			if lockPackage.Source == "" {
+				dep.DirectDependency = true
+			}
+		}
+	}
+}
+
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return &purl.PackageURL{
@@ -170,9 +170,45 @@ func TestExtractor_Extract(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess), extracttest.IgnoreDependencies); diff != "" {
 				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
 			}
 		})
 	}
 }
+
+func TestExtract_Dependencies(t *testing.T) {
+	type node struct {
+		Dependencies []extractor.Dependency
+		Direct       bool
+	}
+	path := "testdata/dependencies.lock"
+	want := map[string]node{
+		"my-app@0.1.0": {Dependencies: []extractor.Dependency{
+			{Name: "bitflags", Version: "2.6.0"},
+			{Name: "nix", Version: "0.26.4"},
+		}},
+		"nix@0.26.4": {Direct: true, Dependencies: []extractor.Dependency{
+			{Name: "bitflags", Version: "1.3.2"},
+		}},
+		"bitflags@1.3.2": {},
+		"bitflags@2.6.0": {Direct: true},
+	}
+
+	extr := cargolock.Extractor{}
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{Path: path})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	inv, err := extr.Extract(context.Background(), &scanInput)
+	if err != nil {
+		t.Fatalf("Extract(%s): %v", path, err)
+	}
+
+	got := map[string]node{}
+	for _, i := range inv {
+		got[i.Name+"@"+i.Version] = node{Dependencies: i.Dependencies, Direct: i.DirectDependency}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Extract(%s) dependencies diff (-want +got):\n%s", path, diff)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cargovendor extracts crates vendored with `cargo vendor`.
+package cargovendor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+// checksumFileName is the file cargo writes into every vendored crate directory.
+const checksumFileName = ".cargo-checksum.json"
+
+type cargoChecksum struct {
+	// Package is the sha256 of the .crate archive. It's null for crates that
+	// don't come from a registry, e.g. git or path dependencies.
+	Package *string `json:"package"`
+}
+
+type cargoManifest struct {
+	Package struct {
+		Name    string `toml:"name"`
+		Version string `toml:"version"`
+	} `toml:"package"`
+}
+
+// Extractor extracts crates.io packages from vendored crate directories.
+type Extractor struct{}
+
+// Name of the extractor
+func (e Extractor) Name() string { return "rust/cargovendor" }
+
+// Version of the extractor
+func (e Extractor) Version() int { return 0 }
+
+// FileRequired returns true if the specified file is the checksum file of a vendored crate.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	return filepath.Base(api.Path()) == checksumFileName
+}
+
+// Requirements of the extractor
+func (e Extractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{}
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// Extract extracts the vendored crate whose .cargo-checksum.json is passed
+// through the scan input. Name and version are read from the Cargo.toml next
+// to the checksum file.
+func (e Extractor) Extract(_ context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var checksum cargoChecksum
+	if err := json.NewDecoder(input.Reader).Decode(&checksum); err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	// Paths in the scan input FS are always slash-separated.
+	manifestPath := path.Join(path.Dir(filepath.ToSlash(input.Path)), "Cargo.toml")
+	f, err := input.FS.Open(manifestPath)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not open %s: %w", manifestPath, err)
+	}
+	defer f.Close()
+
+	var manifest cargoManifest
+	if _, err := toml.NewDecoder(f).Decode(&manifest); err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", manifestPath, err)
+	}
+	if manifest.Package.Name == "" || manifest.Package.Version == "" {
+		return []*extractor.Inventory{}, nil
+	}
+
+	m := &Metadata{}
+	if checksum.Package != nil {
+		m.Checksum = *checksum.Package
+	}
+	return []*extractor.Inventory{{
+		Name:      manifest.Package.Name,
+		Version:   manifest.Package.Version,
+		Metadata:  m,
+		Locations: []string{input.Path, manifestPath},
+	}}, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeCargo,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns the OSV ecosystem ('crates.io') of the software extracted by this extractor.
+func (e Extractor) Ecosystem(_ *extractor.Inventory) string {
+	return "crates.io"
+}
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,147 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargovendor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargovendor"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/testing/extracttest"
+)
+
+func TestExtractor_FileRequired(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputPath string
+		want      bool
+	}{
+		{
+			name:      "Empty path",
+			inputPath: "",
+			want:      false,
+		},
+		{
+			name:      "vendored crate",
+			inputPath: "vendor/serde/.cargo-checksum.json",
+			want:      true,
+		},
+		{
+			name:      "unpacked registry source",
+			inputPath: "root/.cargo/registry/src/index.crates.io-6f17d22bba15001f/serde-1.0.193/.cargo-checksum.json",
+			want:      true,
+		},
+		{
+			name:      "manifest",
+			inputPath: "vendor/serde/Cargo.toml",
+			want:      false,
+		},
+		{
+			name:      "similar file name",
+			inputPath: "vendor/serde/.cargo-checksum.json.bak",
+			want:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := cargovendor.Extractor{}
+			got := e.FileRequired(simplefileapi.New(tt.inputPath, nil))
+			if got != tt.want {
+				t.Errorf("FileRequired(%s, FileInfo) got = %v, want %v", tt.inputPath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractor_Extract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "registry crate",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "vendor/serde/.cargo-checksum.json",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:    "serde",
+					Version: "1.0.193",
+					Metadata: &cargovendor.Metadata{
+						Checksum: "25dd9975e68d0cb5aa1120c288333fc98731bd1dd12f561e468ea4728c042b89",
+					},
+					Locations: []string{"vendor/serde/.cargo-checksum.json", "vendor/serde/Cargo.toml"},
+				},
+			},
+		},
+		{
+			Name: "git dependency without package checksum",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "vendor/local-git-dep/.cargo-checksum.json",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "local-git-dep",
+					Version:   "0.3.0",
+					Metadata:  &cargovendor.Metadata{},
+					Locations: []string{"vendor/local-git-dep/.cargo-checksum.json", "vendor/local-git-dep/Cargo.toml"},
+				},
+			},
+		},
+		{
+			Name: "missing manifest",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "vendor/no-manifest/.cargo-checksum.json",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not open"},
+		},
+		{
+			Name: "invalid checksum file",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "vendor/bad-checksum/.cargo-checksum.json",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := cargovendor.Extractor{}
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
@@ -12,18 +12,11 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build !windows
+package cargovendor
 
-package ospackages
-
-import (
-	"context"
-	"testing"
-)
-
-func TestScan(t *testing.T) {
-	e := Extractor{}
-	if _, err := e.Extract(context.Background(), nil); err == nil {
-		t.Errorf("Extract() returned nil error, should not be supported on non-Windows")
-	}
+// Metadata holds parsing information for a vendored crate.
+type Metadata struct {
+	// Checksum is the sha256 of the original .crate archive. Empty for crates
+	// vendored from git or path dependencies.
+	Checksum string
 }
@@ -27,6 +27,9 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem"
 
 	"github.com/google/osv-scalibr/extractor/filesystem/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/ecsagent"
+	"github.com/google/osv-scalibr/extractor/filesystem/containers/kubelet"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/bazel/bzlmodlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/cpp/conanlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/dart/pubspec"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/dotnet/depsjson"
@@ -35,15 +38,25 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem/language/erlang/mixlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/golang/gobinary"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/golang/gomod"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/appserver"
 	javaarchive "github.com/google/osv-scalibr/extractor/filesystem/language/java/archive"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/gradlelockfile"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/gradleverificationmetadataxml"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/javaruntime"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/maveninstall"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/java/pomxml"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/electron"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagejson"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagelockjson"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/pnpmlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/yarnlock"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/perl/perllocal"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/composerinstalled"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/php/composerlock"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/drupal"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/joomla"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/magento"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/php/pear"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/condameta"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/pdmlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/python/pipfilelock"
@@ -53,7 +66,25 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem/language/r/renvlock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/ruby/gemfilelock"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/ruby/gemspec"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargoauditable"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargocrate"
 	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargolock"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/rust/cargovendor"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/activedirectory"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/appbundle"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/appsupervisor"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/dbserver"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/edgeproxy"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/embeddedhttpd"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/iacsecrets"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/kerberos"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/logpipeline"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/privatecloud"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/registryconfig"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/storagecluster"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendoredcode"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendorlib"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/android"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/cos"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
@@ -63,8 +94,11 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem/os/macapps"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/pacman"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/portage"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/qnap"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/snap"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/synology"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/wsl"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/cdx"
 	"github.com/google/osv-scalibr/extractor/filesystem/sbom/spdx"
 	"github.com/google/osv-scalibr/log"
@@ -79,9 +113,12 @@ var (
 	Cpp []filesystem.Extractor = []filesystem.Extractor{conanlock.Extractor{}}
 	// Java extractors.
 	Java []filesystem.Extractor = []filesystem.Extractor{
+		appserver.New(appserver.DefaultConfig()),
 		gradlelockfile.Extractor{},
 		gradleverificationmetadataxml.Extractor{},
 		javaarchive.New(javaarchive.DefaultConfig()),
+		javaruntime.New(javaruntime.DefaultConfig()),
+		maveninstall.Extractor{},
 		pomxml.Extractor{},
 	}
 	// Javascript extractors.
@@ -90,6 +127,7 @@ var (
 		packagelockjson.New(packagelockjson.DefaultConfig()),
 		&pnpmlock.Extractor{},
 		&yarnlock.Extractor{},
+		electron.New(electron.DefaultConfig()),
 	}
 	// Python extractors.
 	Python []filesystem.Extractor = []filesystem.Extractor{
@@ -105,6 +143,8 @@ var (
 		gobinary.New(gobinary.DefaultConfig()),
 		&gomod.Extractor{},
 	}
+	// Bazel extractors.
+	Bazel []filesystem.Extractor = []filesystem.Extractor{bzlmodlock.Extractor{}}
 	// Dart extractors.
 	Dart []filesystem.Extractor = []filesystem.Extractor{pubspec.Extractor{}}
 	// Erlang extractors.
@@ -116,7 +156,12 @@ var (
 	// Ruby extractors.
 	Ruby []filesystem.Extractor = []filesystem.Extractor{gemspec.New(gemspec.DefaultConfig()), &gemfilelock.Extractor{}}
 	// Rust extractors.
-	Rust []filesystem.Extractor = []filesystem.Extractor{cargolock.Extractor{}}
+	Rust []filesystem.Extractor = []filesystem.Extractor{
+		cargolock.Extractor{},
+		cargocrate.New(cargocrate.DefaultConfig()),
+		cargovendor.Extractor{},
+		cargoauditable.New(cargoauditable.DefaultConfig()),
+	}
 	// SBOM extractors.
 	SBOM []filesystem.Extractor = []filesystem.Extractor{&cdx.Extractor{}, &spdx.Extractor{}}
 	// Dotnet (.NET) extractors.
@@ -125,9 +170,39 @@ var (
 		packageslockjson.New(packageslockjson.DefaultConfig()),
 	}
 	// PHP extractors.
-	PHP []filesystem.Extractor = []filesystem.Extractor{&composerlock.Extractor{}}
+	PHP []filesystem.Extractor = []filesystem.Extractor{
+		&composerlock.Extractor{},
+		composerinstalled.New(composerinstalled.DefaultConfig()),
+		drupal.New(drupal.DefaultConfig()),
+		joomla.New(joomla.DefaultConfig()),
+		magento.New(magento.DefaultConfig()),
+		pear.New(pear.DefaultConfig()),
+	}
+	// Perl extractors.
+	Perl []filesystem.Extractor = []filesystem.Extractor{perllocal.New(perllocal.DefaultConfig())}
 	// Containers extractors.
-	Containers []filesystem.Extractor = []filesystem.Extractor{containerd.New(containerd.DefaultConfig())}
+	Containers []filesystem.Extractor = []filesystem.Extractor{
+		containerd.New(containerd.DefaultConfig()),
+		ecsagent.New(ecsagent.DefaultConfig()),
+		kubelet.New(kubelet.DefaultConfig()),
+	}
+	// Misc extractors.
+	Misc []filesystem.Extractor = []filesystem.Extractor{
+		logpipeline.New(logpipeline.DefaultConfig()),
+		storagecluster.New(storagecluster.DefaultConfig()),
+		appbundle.New(appbundle.DefaultConfig()),
+		iacsecrets.New(iacsecrets.DefaultConfig()),
+		dbserver.New(dbserver.DefaultConfig()),
+		registryconfig.New(registryconfig.DefaultConfig()),
+		edgeproxy.New(edgeproxy.DefaultConfig()),
+		activedirectory.New(activedirectory.DefaultConfig()),
+		appsupervisor.New(appsupervisor.DefaultConfig()),
+		privatecloud.New(privatecloud.DefaultConfig()),
+		vendorlib.New(vendorlib.DefaultConfig()),
+		vendoredcode.New(vendoredcode.DefaultConfig()),
+		kerberos.New(kerberos.DefaultConfig()),
+		embeddedhttpd.New(embeddedhttpd.DefaultConfig()),
+	}
 
 	// OS extractors.
 	OS []filesystem.Extractor = []filesystem.Extractor{
@@ -141,7 +216,11 @@ var (
 		portage.New(portage.DefaultConfig()),
 		flatpak.New(flatpak.DefaultConfig()),
 		homebrew.Extractor{},
-		macapps.New(macapps.DefaultConfig())}
+		macapps.New(macapps.DefaultConfig()),
+		android.New(android.DefaultConfig()),
+		wsl.New(wsl.DefaultConfig()),
+		synology.New(synology.DefaultConfig()),
+		qnap.New(qnap.DefaultConfig())}
 
 	// Collections of extractors.
 
@@ -154,10 +233,12 @@ var (
 		Javascript,
 		Python,
 		Go,
+		Bazel,
 		Dart,
 		Erlang,
 		Elixir,
 		PHP,
+		Perl,
 		R,
 		Ruby,
 		Rust,
@@ -165,6 +246,7 @@ var (
 		SBOM,
 		OS,
 		Containers,
+		Misc,
 	)
 
 	extractorNames = map[string][]filesystem.Extractor{
@@ -174,6 +256,7 @@ var (
 		"javascript": Javascript,
 		"python":     Python,
 		"go":         Go,
+		"bazel":      Bazel,
 		"dart":       Dart,
 		"erlang":     Erlang,
 		"elixir":     Elixir,
@@ -181,11 +264,13 @@ var (
 		"ruby":       Ruby,
 		"dotnet":     Dotnet,
 		"php":        PHP,
+		"perl":       Perl,
 		"rust":       Rust,
 
 		"sbom":       SBOM,
 		"os":         OS,
 		"containers": Containers,
+		"misc":       Misc,
 
 		// Collections.
 		"default": Default,
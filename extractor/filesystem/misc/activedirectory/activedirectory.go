@@ -0,0 +1,241 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package activedirectory extracts Active Directory artifacts from Windows
+// domain controller images: NTDS.dit databases and their copies in backup
+// locations, Group Policy Preferences files with cpassword values and logon
+// scripts in SYSVOL with embedded credentials.
+package activedirectory
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/activedirectory"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// An NTDS.dit database, e.g. Windows/NTDS/ntds.dit.
+	kindNTDS
+	// A Group Policy Preferences file, e.g.
+	// Policies/{GUID}/Machine/Preferences/Groups/Groups.xml.
+	kindGPP
+	// A script in SYSVOL or NETLOGON, e.g. SYSVOL/domain/scripts/logon.bat.
+	kindScript
+)
+
+// gppFiles are the Group Policy Preferences files that can hold a cpassword.
+var gppFiles = []string{"groups.xml", "services.xml", "scheduledtasks.xml", "datasources.xml", "printers.xml", "drives.xml"}
+
+// scriptExtensions are the extensions of logon and startup scripts.
+var scriptExtensions = []string{".bat", ".cmd", ".ps1", ".vbs", ".kix"}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	// NTDS databases are always extracted since only their header is read.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Active Directory
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts Active Directory artifacts.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an Active Directory extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is an NTDS.dit database, a
+// Group Policy Preferences file or a script in SYSVOL.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	kind := kindForPath(path)
+	if kind == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if kind != kindNTDS && e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// kindForPath returns the kind of the file at p. Windows paths are case
+// insensitive so all comparisons ignore case.
+func kindForPath(p string) fileKind {
+	p = strings.ToLower(filepath.ToSlash(p))
+	base := path.Base(p)
+	dirs := strings.Split(path.Dir(p), "/")
+	switch {
+	case base == "ntds.dit":
+		return kindNTDS
+	case slices.Contains(gppFiles, base) && slices.Contains(dirs, "preferences"):
+		// Also matches GPO backups created with Backup-GPO, which store the
+		// files under DomainSysvol/GPO/.
+		return kindGPP
+	case slices.Contains(scriptExtensions, path.Ext(base)):
+		for _, d := range dirs {
+			if d == "sysvol" || d == "domainsysvol" || d == "netlogon" {
+				return kindScript
+			}
+		}
+	}
+	return kindNone
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// Active Directory artifact it holds.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	var m *Metadata
+	var err error
+	switch kindForPath(input.Path) {
+	case kindNTDS:
+		m, err = extractNTDS(input)
+	case kindGPP:
+		m, err = extractGPP(input.Reader)
+	case kindScript:
+		m, err = extractScript(input.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported file %s", input.Path)
+	}
+	if err != nil || m == nil {
+		return nil, err
+	}
+	return &extractor.Inventory{
+		Name:      string(m.Artifact),
+		Locations: []string{input.Path},
+		Metadata:  m,
+	}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: i.Name,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track Active Directory
+// artifacts.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,366 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activedirectory_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/activedirectory"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+const policyDir = "Windows/SYSVOL/domain/Policies/{31B2F340-016D-11D2-945F-00C04FB984F9}/Machine/Preferences"
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "live NTDS database",
+			path:             "Windows/NTDS/ntds.dit",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "NTDS copy in backup path",
+			path:             "backup/IFM/Active Directory/NTDS.dit",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "NTDS database ignores maxFileSizeBytes",
+			path:             "Windows/NTDS/ntds.dit",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "GPP Groups.xml",
+			path:             policyDir + "/Groups/Groups.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "GPP file in GPO backup",
+			path:             "gpo-backup/{6AC1786C-016F-11D2-945F-00C04FB984F9}/DomainSysvol/GPO/User/Preferences/Drives/Drives.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "Groups.xml outside of preferences",
+			path:         "app/config/groups.xml",
+			wantRequired: false,
+		},
+		{
+			name:             "logon script in SYSVOL",
+			path:             "Windows/SYSVOL/domain/scripts/logon.bat",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "script in NETLOGON share",
+			path:             "shares/NETLOGON/map.vbs",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "script outside of SYSVOL",
+			path:         "Users/alice/Desktop/logon.bat",
+			wantRequired: false,
+		},
+		{
+			name:         "non-script file in SYSVOL",
+			path:         "Windows/SYSVOL/domain/Policies/{31B2F340-016D-11D2-945F-00C04FB984F9}/GPT.INI",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "Windows/SYSVOL/domain/scripts/logon.bat",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "Windows/SYSVOL/domain/scripts/logon.bat",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := activedirectory.New(activedirectory.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "live NTDS database",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "Windows/NTDS/ntds.dit",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ntds-database",
+				Locations: []string{"Windows/NTDS/ntds.dit"},
+				Metadata: &activedirectory.Metadata{
+					Artifact: activedirectory.ArtifactNTDS,
+					Live:     true,
+				},
+			}},
+		},
+		{
+			Name: "IFM backup with SYSTEM hive",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "backup/IFM/Active Directory/ntds.dit",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ntds-database",
+				Locations: []string{"backup/IFM/Active Directory/ntds.dit"},
+				Metadata: &activedirectory.Metadata{
+					Artifact:   activedirectory.ArtifactNTDS,
+					SystemHive: "backup/IFM/registry/SYSTEM",
+				},
+			}},
+		},
+		{
+			Name: "NTDS copy without SYSTEM hive",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "tmp/NTDS.dit",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ntds-database",
+				Locations: []string{"tmp/NTDS.dit"},
+				Metadata: &activedirectory.Metadata{
+					Artifact: activedirectory.ArtifactNTDS,
+				},
+			}},
+		},
+		{
+			Name: "ntds.dit that is not an ESE database",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "notese/ntds.dit",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "GPP Groups.xml",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         policyDir + "/Groups/Groups.xml",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "gpp-password",
+				Locations: []string{policyDir + "/Groups/Groups.xml"},
+				Metadata: &activedirectory.Metadata{
+					Artifact: activedirectory.ArtifactGPP,
+					Credentials: []*activedirectory.Credential{{
+						Context:   `User "Administrator (built-in)"`,
+						Username:  "Administrator (built-in)",
+						Password:  "Local*P4ssword!",
+						CPassword: "j1Uyj3Vx8TY9LtLZil2uAuZkFQA/4latT76ZwgdHdhw",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "GPP ScheduledTasks.xml",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         policyDir + "/ScheduledTasks/ScheduledTasks.xml",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "gpp-password",
+				Locations: []string{policyDir + "/ScheduledTasks/ScheduledTasks.xml"},
+				Metadata: &activedirectory.Metadata{
+					Artifact: activedirectory.ArtifactGPP,
+					Credentials: []*activedirectory.Credential{{
+						Context:   `Task "Nightly backup"`,
+						Username:  `CORP\svc_backup`,
+						Password:  "Sch3dT4sk!",
+						CPassword: "3IOF5tNAVr7UVx+yMg7fR3QpGi1DQV/1hqNdkWhtU2A",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "GPP file without cpassword",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         policyDir + "/Services/Services.xml",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "batch logon script",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "Windows/SYSVOL/domain/scripts/logon.bat",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "logon-script",
+				Locations: []string{"Windows/SYSVOL/domain/scripts/logon.bat"},
+				Metadata: &activedirectory.Metadata{
+					Artifact: activedirectory.ArtifactLogonScript,
+					Credentials: []*activedirectory.Credential{
+						{
+							Context:  "net use",
+							Username: `CORP\svc_shares`,
+							Password: "Welcome2019!",
+							Line:     4,
+						},
+						{
+							Context:  "DB_PASS",
+							Password: "Sql Server 1",
+							Line:     6,
+						},
+						{
+							Context:  "net user",
+							Username: "helpdesk",
+							Password: "H3lpd3sk#1",
+							Line:     8,
+						},
+						{
+							Context:  "psexec",
+							Username: `CORP\svc_deploy`,
+							Password: "D3pl0y!",
+							Line:     9,
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "PowerShell logon script",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "Windows/SYSVOL/domain/scripts/install.ps1",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "logon-script",
+				Locations: []string{"Windows/SYSVOL/domain/scripts/install.ps1"},
+				Metadata: &activedirectory.Metadata{
+					Artifact: activedirectory.ArtifactLogonScript,
+					Credentials: []*activedirectory.Credential{
+						{
+							Context:  "ConvertTo-SecureString",
+							Password: "Inst4ll-Me",
+							Line:     2,
+						},
+						{
+							Context:  "ApiPass",
+							Password: "tok3n-value",
+							Line:     5,
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "script without credentials",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "Windows/SYSVOL/domain/scripts/printers.vbs",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := activedirectory.New(activedirectory.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := activedirectory.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "gpp-password",
+		Locations: []string{policyDir + "/Groups/Groups.xml"},
+	}
+	want := &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: "gpp-password",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
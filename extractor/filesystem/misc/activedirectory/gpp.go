@@ -0,0 +1,161 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activedirectory
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// gppKey is the AES-256 key Microsoft published in the Group Policy
+// Preferences protocol documentation (MS-GPPREF 2.2.1.1.4). Anyone can use it
+// to decrypt cpassword values.
+var gppKey = mustDecodeHex("4e9906e8fcb66cc9faf49310620ffee8f496e806cc057990209b09a433b66c1b")
+
+// gppUserAttrs are the attributes of the Properties element that hold the
+// account a cpassword belongs to, depending on the preference type.
+var gppUserAttrs = []string{"userName", "runAs", "accountName"}
+
+func mustDecodeHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// extractGPP returns the cpassword values of a Group Policy Preferences file.
+// Returns nil if the file doesn't hold any.
+func extractGPP(r io.Reader) (*Metadata, error) {
+	d := xml.NewDecoder(r)
+	// The preference item that contains the current Properties element, e.g.
+	// <User name="Administrator (built-in)">.
+	var parents []xml.StartElement
+	var creds []*Credential
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local == "Properties" {
+				if c := gppCredential(t, parents); c != nil {
+					creds = append(creds, c)
+				}
+			}
+			parents = append(parents, t)
+		case xml.EndElement:
+			if len(parents) > 0 {
+				parents = parents[:len(parents)-1]
+			}
+		}
+	}
+	if len(creds) == 0 {
+		return nil, nil
+	}
+	return &Metadata{Artifact: ArtifactGPP, Credentials: creds}, nil
+}
+
+func gppCredential(props xml.StartElement, parents []xml.StartElement) *Credential {
+	c := &Credential{}
+	for _, a := range props.Attr {
+		switch {
+		case a.Name.Local == "cpassword":
+			c.CPassword = a.Value
+		case c.Username == "" && containsFold(gppUserAttrs, a.Name.Local):
+			c.Username = a.Value
+		}
+	}
+	if c.CPassword == "" {
+		return nil
+	}
+	if len(parents) > 0 {
+		item := parents[len(parents)-1]
+		c.Context = item.Name.Local
+		if name := attr(item, "name"); name != "" {
+			c.Context += fmt.Sprintf(" %q", name)
+		}
+	}
+	// Undecryptable values are still reported since they're likely encrypted
+	// with the same key and only malformed.
+	c.Password, _ = decryptCPassword(c.CPassword)
+	return c
+}
+
+func attr(e xml.StartElement, name string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// decryptCPassword decrypts a GPP cpassword value: base64 without padding of
+// the AES-256-CBC encrypted UTF-16LE password with a zero IV.
+func decryptCPassword(cpassword string) (string, error) {
+	if n := len(cpassword) % 4; n != 0 {
+		cpassword += strings.Repeat("=", 4-n)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(cpassword)
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return "", fmt.Errorf("invalid cpassword length %d", len(ciphertext))
+	}
+	block, err := aes.NewCipher(gppKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(plaintext, ciphertext)
+
+	pad := int(plaintext[len(plaintext)-1])
+	if pad == 0 || pad > aes.BlockSize {
+		return "", errors.New("invalid cpassword padding")
+	}
+	plaintext = plaintext[:len(plaintext)-pad]
+	if len(plaintext)%2 != 0 {
+		return "", errors.New("cpassword is not UTF-16 encoded")
+	}
+	u := make([]uint16, len(plaintext)/2)
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint16(plaintext[2*i:])
+	}
+	return string(utf16.Decode(u)), nil
+}
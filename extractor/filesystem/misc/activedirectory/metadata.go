@@ -0,0 +1,58 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activedirectory
+
+// Artifact is the kind of Active Directory artifact a file holds.
+type Artifact string
+
+// Supported artifacts.
+const (
+	// ArtifactNTDS is an NTDS.dit Active Directory database, either the live
+	// database of a domain controller or a copy of it.
+	ArtifactNTDS Artifact = "ntds-database"
+	// ArtifactGPP is a Group Policy Preferences XML file with cpassword values.
+	ArtifactGPP Artifact = "gpp-password"
+	// ArtifactLogonScript is a logon or startup script with embedded
+	// credentials.
+	ArtifactLogonScript Artifact = "logon-script"
+)
+
+// Metadata holds an Active Directory artifact found on a domain controller or
+// in a backup of one.
+type Metadata struct {
+	Artifact Artifact
+	// Whether the NTDS database is the live database of a domain controller
+	// under Windows/NTDS. Copies elsewhere, e.g. created with ntdsutil IFM or
+	// shadow copies, can be cracked offline.
+	Live bool
+	// Path of a SYSTEM registry hive stored next to an NTDS database copy. The
+	// hive holds the boot key that decrypts the password hashes.
+	SystemHive  string
+	Credentials []*Credential
+}
+
+// Credential is a credential found in a GPP file or logon script.
+type Credential struct {
+	// Where the credential was found, e.g. `User "Administrator (built-in)"`
+	// for GPP files or "net use" for scripts.
+	Context  string
+	Username string
+	// The plaintext password. For GPP files this is the decrypted cpassword.
+	Password string
+	// The encrypted GPP cpassword value. Empty for scripts.
+	CPassword string
+	// The line of the script the credential was found on. Zero for GPP files.
+	Line int
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activedirectory
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+// eseSignature is the signature of Extensible Storage Engine databases, stored
+// after the header checksum.
+var eseSignature = []byte{0xef, 0xcd, 0xab, 0x89}
+
+// extractNTDS checks that the file is an ESE database and looks for a SYSTEM
+// hive stored next to it. Returns nil if the file isn't an ESE database.
+func extractNTDS(input *filesystem.ScanInput) (*Metadata, error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(input.Reader, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !bytes.Equal(header[4:], eseSignature) {
+		return nil, nil
+	}
+
+	p := filepath.ToSlash(input.Path)
+	m := &Metadata{
+		Artifact: ArtifactNTDS,
+		Live:     strings.HasSuffix(strings.ToLower(p), "windows/ntds/ntds.dit"),
+	}
+	if !m.Live {
+		m.SystemHive = findSystemHive(input.FS, path.Dir(p))
+	}
+	return m, nil
+}
+
+// findSystemHive returns the path of a SYSTEM hive in dir or, for ntdsutil IFM
+// backups, in the registry directory next to the "Active Directory" directory.
+func findSystemHive(fsys scalibrfs.FS, dir string) string {
+	if fsys == nil {
+		return ""
+	}
+	if p := findFold(fsys, dir, "system", false); p != "" {
+		return p
+	}
+	if r := findFold(fsys, path.Dir(dir), "registry", true); r != "" {
+		return findFold(fsys, r, "system", false)
+	}
+	return ""
+}
+
+// findFold returns the path of the file or directory in dir whose name matches
+// name ignoring case, or an empty string if there is none.
+func findFold(fsys scalibrfs.FS, dir, name string, isDir bool) string {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return ""
+	}
+	for _, e := range entries {
+		if strings.EqualFold(e.Name(), name) && e.IsDir() == isDir {
+			return path.Join(dir, e.Name())
+		}
+	}
+	return ""
+}
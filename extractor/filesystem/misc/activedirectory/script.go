@@ -0,0 +1,187 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package activedirectory
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	// net use Z: \\server\share <password> /user:<user>
+	netUseRe = regexp.MustCompile(`(?i)\bnet(?:\.exe)?\s+use\s+(.+)`)
+	// net user <user> <password> /add
+	netUserRe = regexp.MustCompile(`(?i)\bnet(?:\.exe)?\s+user\s+(.+)`)
+	// psexec \\host -u <user> -p <password> cmd
+	psexecRe = regexp.MustCompile(`(?i)\bpsexec(?:64)?(?:\.exe)?\s.*?\s-u\s+("[^"]*"|\S+)\s+-p\s+("[^"]*"|\S+)`)
+	// ConvertTo-SecureString "<password>" -AsPlainText
+	secureStringRe = regexp.MustCompile(`(?i)\bConvertTo-SecureString\s+(?:-String\s+)?("[^"]*"|'[^']*')\s+-AsPlainText`)
+	// set PASSWORD=<password>, $Password = "<password>" or strPwd = "<password>"
+	assignmentRe = regexp.MustCompile(`(?i)^\s*(set\s+"?|\$|)(\w*(?:passw(?:or)?d|pwd|pass))\s*=\s*(.*)`)
+)
+
+// extractScript returns the credentials embedded in a logon script. Returns nil
+// if the script doesn't hold any.
+func extractScript(r io.Reader) (*Metadata, error) {
+	var creds []*Credential
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		if c := scriptCredential(s.Text()); c != nil {
+			c.Line = line
+			creds = append(creds, c)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(creds) == 0 {
+		return nil, nil
+	}
+	return &Metadata{Artifact: ArtifactLogonScript, Credentials: creds}, nil
+}
+
+// scriptCredential returns the credential in a script line, if any.
+func scriptCredential(line string) *Credential {
+	if m := netUseRe.FindStringSubmatch(line); m != nil {
+		return netUseCredential(splitArgs(m[1]))
+	}
+	if m := netUserRe.FindStringSubmatch(line); m != nil {
+		args := splitArgs(m[1])
+		if len(args) >= 2 && !strings.HasPrefix(args[0], "/") && isLiteral(args[1]) {
+			return &Credential{Context: "net user", Username: args[0], Password: args[1]}
+		}
+		return nil
+	}
+	if m := psexecRe.FindStringSubmatch(line); m != nil {
+		if p := unquote(m[2]); isLiteral(p) {
+			return &Credential{Context: "psexec", Username: unquote(m[1]), Password: p}
+		}
+		return nil
+	}
+	if m := secureStringRe.FindStringSubmatch(line); m != nil {
+		if p := unquote(m[1]); isLiteral(p) {
+			return &Credential{Context: "ConvertTo-SecureString", Password: p}
+		}
+		return nil
+	}
+	if m := assignmentRe.FindStringSubmatch(line); m != nil {
+		isSet := strings.HasPrefix(strings.ToLower(m[1]), "set")
+		value, quoted := assignedValue(m[3], isSet)
+		// Unquoted values in PowerShell, VBScript and KiXtart are expressions,
+		// e.g. Read-Host or InputBox calls. Batch files don't quote values.
+		if (quoted || isSet) && isLiteral(value) {
+			return &Credential{Context: m[2], Password: value}
+		}
+	}
+	return nil
+}
+
+// netUseCredential returns the credential of the arguments of "net use". The
+// password is the only argument that isn't a flag, drive or share.
+func netUseCredential(args []string) *Credential {
+	c := &Credential{Context: "net use"}
+	for _, a := range args {
+		lower := strings.ToLower(a)
+		switch {
+		case strings.HasPrefix(lower, "/user:"):
+			c.Username = a[len("/user:"):]
+		case strings.HasPrefix(lower, "/u:"):
+			c.Username = a[len("/u:"):]
+		case strings.HasPrefix(a, "/"), strings.HasPrefix(a, `\\`):
+		case len(a) == 2 && a[1] == ':':
+		case c.Password == "":
+			c.Password = a
+		}
+	}
+	if !isLiteral(c.Password) {
+		return nil
+	}
+	return c
+}
+
+// assignedValue returns the value of an assignment and whether it was quoted.
+func assignedValue(s string, isSet bool) (string, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", false
+	}
+	if q := s[0]; (q == '"' || q == '\'') && !isSet {
+		if end := strings.IndexByte(s[1:], q); end >= 0 {
+			return s[1 : end+1], true
+		}
+		return "", false
+	}
+	if isSet {
+		// set "PASSWORD=value" quotes the whole assignment and values can
+		// contain spaces.
+		return strings.TrimSuffix(s, `"`), false
+	}
+	return strings.Fields(s)[0], false
+}
+
+// isLiteral returns true if a password is a literal value and not empty, a
+// prompt or a reference to a variable.
+func isLiteral(p string) bool {
+	switch {
+	case p == "", p == "*":
+		return false
+	case strings.HasPrefix(p, "$"):
+		return false
+	case strings.HasPrefix(p, "%"):
+		// Environment variable or script argument, e.g. %PASSWORD% or %1.
+		return false
+	case strings.HasPrefix(p, "!") && strings.HasSuffix(p, "!") && len(p) > 1:
+		// Delayed expansion variable.
+		return false
+	}
+	return true
+}
+
+// splitArgs splits a command line into its arguments, keeping double quoted
+// arguments together.
+func splitArgs(s string) []string {
+	var args []string
+	var cur strings.Builder
+	inArg, quoted := false, false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inArg = true
+		case (r == ' ' || r == '\t') && !quoted:
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		default:
+			cur.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	return args
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,5 @@
+$User = "CORP\svc_install"
+$Password = ConvertTo-SecureString "Inst4ll-Me" -AsPlainText -Force
+$Cred = New-Object System.Management.Automation.PSCredential($User, $Password)
+$AdminPassword = Read-Host -AsSecureString
+$ApiPass = 'tok3n-value'
@@ -0,0 +1,9 @@
+@echo off
+REM Map the department shares
+net use H: \\fs01\home\%USERNAME% /persistent:no
+net use S: \\fs01\shared Welcome2019! /user:CORP\svc_shares /persistent:no
+net use P: \\fs01\public * /user:CORP\%USERNAME%
+set "DB_PASS=Sql Server 1"
+set ADMIN_PWD=%1
+net user helpdesk H3lpd3sk#1 /add
+psexec \\%COMPUTERNAME% -u CORP\svc_deploy -p D3pl0y! -s cmd /c gpupdate /force
@@ -0,0 +1,245 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appbundle extracts the applications, runtimes and bundled
+// libraries of snap, flatpak and AppImage bundle files, e.g. in download or
+// build directories, before they're installed.
+//
+// Snaps and AppImages are SquashFS images. Only gzip and zstd compressed
+// images can be read; snaps using the default xz compression are reported as
+// an extraction error. The files of flatpak bundles are stored in an OSTree
+// static delta, so only their metadata is extracted.
+package appbundle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/appbundle"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`. Bundles are read lazily, so
+	// only their metadata and directory structure are loaded.
+	defaultMaxFileSizeBytes = 4 * units.GiB
+
+	// maxMetadataFileBytes is the maximum size of a metadata file inside a
+	// bundle, e.g. meta/snap.yaml.
+	maxMetadataFileBytes = 1 * units.MiB
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the app bundle
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts the contents of application bundle files.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an app bundle extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is a .snap, .flatpak or
+// .AppImage bundle.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if formatForPath(path) == "" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil || !fileinfo.Mode().IsRegular() {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// formatForPath returns the bundle format of the file at p based on its
+// extension. Snaps in snapd's own directories are skipped as they're
+// installed and reported by the snap extractor.
+func formatForPath(p string) string {
+	p = filepath.ToSlash(p)
+	switch strings.ToLower(path.Ext(p)) {
+	case ".snap":
+		if strings.HasPrefix(p, "var/lib/snapd/") || strings.Contains(p, "/var/lib/snapd/") {
+			return ""
+		}
+		return FormatSnap
+	case ".flatpak":
+		return FormatFlatpak
+	case ".appimage":
+		return FormatAppImage
+	}
+	return ""
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the bundle passed through the scan input and returns the
+// application and the components bundled with it.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(ctx, input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	return inventory, nil
+}
+
+func (e Extractor) extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	r, size, err := openBundle(input)
+	if err != nil {
+		return nil, err
+	}
+	switch formatForPath(input.Path) {
+	case FormatSnap:
+		return extractSnap(ctx, r, input.Path)
+	case FormatFlatpak:
+		return extractFlatpak(r, size, input.Path)
+	case FormatAppImage:
+		return extractAppImage(ctx, r, size, input.Path)
+	}
+	return nil, fmt.Errorf("unsupported file %s", input.Path)
+}
+
+// openBundle returns random access to the bundle passed through the scan
+// input and its size.
+func openBundle(input *filesystem.ScanInput) (io.ReaderAt, int64, error) {
+	r, ok := input.Reader.(io.ReaderAt)
+	if ok && input.Info != nil {
+		return r, input.Info.Size(), nil
+	}
+	log.Debugf("Reader of %s does not implement ReaderAt. Fall back to read to memory.", input.Path)
+	b, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bytes.NewReader(b), int64(len(b)), nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+// Snap and flatpak applications and runtimes have their own PURL types. The
+// other components are generic as the distro of staged packages and the
+// origin of bundled libraries are unknown.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	m := i.Metadata.(*Metadata)
+	typ := purl.TypeGeneric
+	if m.Component == ComponentApplication || m.Component == ComponentRuntime {
+		switch m.Format {
+		case FormatSnap:
+			typ = purl.TypeSnap
+		case FormatFlatpak:
+			typ = purl.TypeFlatpak
+		}
+	}
+	return &purl.PackageURL{
+		Type:    typ,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track the contents of
+// application bundles.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
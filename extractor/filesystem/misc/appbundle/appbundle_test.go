@@ -0,0 +1,281 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appbundle_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/appbundle"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "snap",
+			path:             "home/user/Downloads/hello_2.10_amd64.snap",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "installed snap",
+			path:         "var/lib/snapd/snaps/hello_42.snap",
+			wantRequired: false,
+		},
+		{
+			name:             "flatpak bundle",
+			path:             "build/org.example.App.flatpak",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "AppImage",
+			path:             "opt/Krita-5.2.2-x86_64.AppImage",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "lowercase AppImage extension",
+			path:             "home/user/app.appimage",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "flatpakref",
+			path:         "home/user/org.example.App.flatpakref",
+			wantRequired: false,
+		},
+		{
+			name:             "bundle not required if size greater than maxFileSizeBytes",
+			path:             "home/user/hello.snap",
+			fileSizeBytes:    1000 * units.MiB,
+			maxFileSizeBytes: 100 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := appbundle.New(appbundle.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			path := filepath.FromSlash(tt.path)
+			isRequired := e.FileRequired(simplefileapi.New(path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputConfig extracttest.ScanInputMockConfig
+		want        []*extractor.Inventory
+		wantErr     bool
+	}{
+		{
+			name: "snap",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/hello_2.10_amd64.snap",
+			},
+			want: []*extractor.Inventory{
+				inv("hello", "2.10", appbundle.FormatSnap, appbundle.ComponentApplication, "", "testdata/hello_2.10_amd64.snap"),
+				inv("core22", "", appbundle.FormatSnap, appbundle.ComponentRuntime, "hello", "testdata/hello_2.10_amd64.snap"),
+				inv("libidn2-0", "2.3.2-2build1", appbundle.FormatSnap, appbundle.ComponentPackage, "hello", "testdata/hello_2.10_amd64.snap/snap/manifest.yaml"),
+				inv("libssl3", "3.0.2-0ubuntu1.15", appbundle.FormatSnap, appbundle.ComponentPackage, "hello", "testdata/hello_2.10_amd64.snap/snap/manifest.yaml"),
+				inv("libidn2", "0.3.7", appbundle.FormatSnap, appbundle.ComponentLibrary, "hello", "testdata/hello_2.10_amd64.snap/usr/lib/x86_64-linux-gnu/libidn2.so.0.3.7"),
+				inv("libssl", "3", appbundle.FormatSnap, appbundle.ComponentLibrary, "hello", "testdata/hello_2.10_amd64.snap/usr/lib/x86_64-linux-gnu/libssl.so.3"),
+				inv("libstdc++", "6.0.30", appbundle.FormatSnap, appbundle.ComponentLibrary, "hello", "testdata/hello_2.10_amd64.snap/usr/lib/x86_64-linux-gnu/libstdc++.so.6.0.30"),
+			},
+		},
+		{
+			name: "base snap with per-part stage packages",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/core22.snap",
+			},
+			want: []*extractor.Inventory{
+				inv("core22", "20240111", appbundle.FormatSnap, appbundle.ComponentRuntime, "", "testdata/core22.snap"),
+				inv("zlib1g", "1:1.2.11", appbundle.FormatSnap, appbundle.ComponentPackage, "core22", "testdata/core22.snap/snap/manifest.yaml"),
+			},
+		},
+		{
+			name: "xz compressed snap",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/hello_xz.snap",
+			},
+			wantErr: true,
+		},
+		{
+			name: "snap without snap.yaml",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/no_snap_yaml.snap",
+			},
+			wantErr: true,
+		},
+		{
+			name: "AppImage",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/Krita-x86_64.AppImage",
+			},
+			want: []*extractor.Inventory{
+				inv("Krita", "5.2.2", appbundle.FormatAppImage, appbundle.ComponentApplication, "", "testdata/Krita-x86_64.AppImage"),
+				inv("libQt5Core", "5", appbundle.FormatAppImage, appbundle.ComponentLibrary, "Krita", "testdata/Krita-x86_64.AppImage/usr/lib/libQt5Core.so.5"),
+			},
+		},
+		{
+			name: "AppImage with version from AppStream",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/tool.AppImage",
+			},
+			want: []*extractor.Inventory{
+				inv("tool", "0.9", appbundle.FormatAppImage, appbundle.ComponentApplication, "", "testdata/tool.AppImage"),
+			},
+		},
+		{
+			name: "ELF that isn't an AppImage",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/not_an_appimage.AppImage",
+			},
+			wantErr: true,
+		},
+		{
+			name: "flatpak app bundle",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/org.example.App.flatpak",
+			},
+			want: []*extractor.Inventory{
+				inv("org.example.App", "1.4.2", appbundle.FormatFlatpak, appbundle.ComponentApplication, "", "testdata/org.example.App.flatpak"),
+				inv("org.freedesktop.Platform", "23.08", appbundle.FormatFlatpak, appbundle.ComponentRuntime, "org.example.App", "testdata/org.example.App.flatpak"),
+			},
+		},
+		{
+			name: "flatpak runtime bundle",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/platform.flatpak",
+			},
+			want: []*extractor.Inventory{
+				inv("org.example.Platform", "", appbundle.FormatFlatpak, appbundle.ComponentRuntime, "", "testdata/platform.flatpak"),
+			},
+		},
+		{
+			name: "not a flatpak bundle",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/flatpak_ref.flatpak",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := appbundle.New(appbundle.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.inputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := e.Extract(context.Background(), &scanInput)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Extract(%s) error: got %v, want error: %v", tt.inputConfig.Path, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Extract(%s) (-want +got):\n%s", tt.inputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want *purl.PackageURL
+	}{
+		{
+			name: "snap",
+			inv:  inv("hello", "2.10", appbundle.FormatSnap, appbundle.ComponentApplication, "", "hello.snap"),
+			want: &purl.PackageURL{Type: purl.TypeSnap, Name: "hello", Version: "2.10"},
+		},
+		{
+			name: "flatpak runtime",
+			inv:  inv("org.freedesktop.Platform", "23.08", appbundle.FormatFlatpak, appbundle.ComponentRuntime, "org.example.App", "app.flatpak"),
+			want: &purl.PackageURL{Type: purl.TypeFlatpak, Name: "org.freedesktop.Platform", Version: "23.08"},
+		},
+		{
+			name: "library",
+			inv:  inv("libssl", "3", appbundle.FormatSnap, appbundle.ComponentLibrary, "hello", "hello.snap/libssl.so.3"),
+			want: &purl.PackageURL{Type: purl.TypeGeneric, Name: "libssl", Version: "3"},
+		},
+	}
+	e := appbundle.Extractor{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := e.ToPURL(tt.inv)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", tt.inv, diff)
+			}
+		})
+	}
+}
+
+func inv(name, version, format, component, bundle, location string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Metadata:  &appbundle.Metadata{Format: format, Component: component, Bundle: bundle},
+		Locations: []string{location},
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appbundle
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/squashfs"
+)
+
+// extractAppImage extracts the application and the libraries of the type 2
+// AppImage in r, which is an ELF runtime followed by a SquashFS image.
+func extractAppImage(ctx context.Context, r io.ReaderAt, size int64, location string) ([]*extractor.Inventory, error) {
+	off, err := appImageOffset(r)
+	if err != nil {
+		return nil, err
+	}
+	if off <= 0 || off >= size {
+		return nil, fmt.Errorf("invalid AppImage payload offset %d", off)
+	}
+	fsys, err := squashfs.Open(io.NewSectionReader(r, off, size-off))
+	if err != nil {
+		return nil, err
+	}
+
+	name, version := appImageDesktopEntry(fsys)
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(location), filepath.Ext(location))
+	}
+	if version == "" {
+		version = readAppstreamVersion(fsys, "usr/share/metainfo", "usr/share/appdata")
+	}
+	inv := []*extractor.Inventory{{
+		Name:      name,
+		Version:   version,
+		Metadata:  &Metadata{Format: FormatAppImage, Component: ComponentApplication},
+		Locations: []string{location},
+	}}
+
+	libs, err := findLibraries(ctx, fsys)
+	if err != nil {
+		return nil, err
+	}
+	return append(inv, libraryInventories(libs, FormatAppImage, name, location)...), nil
+}
+
+// appImageOffset returns the offset of the SquashFS image, which directly
+// follows the section headers at the end of the ELF runtime.
+func appImageOffset(r io.ReaderAt) (int64, error) {
+	hdr := make([]byte, 64)
+	if n, err := r.ReadAt(hdr, 0); n < len(hdr) {
+		return 0, fmt.Errorf("reading ELF header: %w", err)
+	}
+	if string(hdr[:4]) != "\x7fELF" {
+		return 0, errors.New("not an ELF file")
+	}
+	// The AppImage type is stored in the padding of e_ident.
+	switch string(hdr[8:11]) {
+	case "AI\x02":
+	case "AI\x01":
+		return 0, errors.New("type 1 (ISO 9660) AppImages are not supported")
+	default:
+		return 0, errors.New("not an AppImage")
+	}
+	var bo binary.ByteOrder = binary.LittleEndian
+	if hdr[5] == 2 {
+		bo = binary.BigEndian
+	}
+	switch hdr[4] {
+	case 1:
+		shoff, entSize, num := bo.Uint32(hdr[0x20:]), bo.Uint16(hdr[0x2e:]), bo.Uint16(hdr[0x30:])
+		return int64(shoff) + int64(entSize)*int64(num), nil
+	case 2:
+		shoff, entSize, num := bo.Uint64(hdr[0x28:]), bo.Uint16(hdr[0x3a:]), bo.Uint16(hdr[0x3c:])
+		if shoff > 1<<62 {
+			return 0, fmt.Errorf("invalid section header offset %d", shoff)
+		}
+		return int64(shoff) + int64(entSize)*int64(num), nil
+	default:
+		return 0, fmt.Errorf("unknown ELF class %d", hdr[4])
+	}
+}
+
+// appImageDesktopEntry returns the name and version from the desktop entry of
+// an AppImage. The entry in the root directory is often a symlink, in which
+// case the one in usr/share/applications is used.
+func appImageDesktopEntry(fsys fs.FS) (name, version string) {
+	for _, dir := range []string{".", "usr/share/applications"} {
+		entries, err := fs.ReadDir(fsys, dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if !e.Type().IsRegular() || path.Ext(e.Name()) != ".desktop" {
+				continue
+			}
+			b, err := readFile(fsys, path.Join(dir, e.Name()))
+			if err != nil {
+				continue
+			}
+			groups, err := parseKeyFile(strings.NewReader(string(b)))
+			if err != nil {
+				continue
+			}
+			entry := groups["Desktop Entry"]
+			name = entry["X-AppImage-Name"]
+			if name == "" {
+				name = entry["Name"]
+			}
+			return name, entry["X-AppImage-Version"]
+		}
+	}
+	return "", ""
+}
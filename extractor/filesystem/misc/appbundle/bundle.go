@@ -0,0 +1,160 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appbundle
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// maxWalkEntries bounds the number of files visited in a bundle, which also
+// guards against directory cycles in corrupt images.
+const maxWalkEntries = 1_000_000
+
+// libraryRe matches versioned shared libraries, e.g. libssl.so.3.
+var libraryRe = regexp.MustCompile(`^(lib[\w+\-.]*?)\.so\.(\d+(?:\.\d+)*)$`)
+
+var errTooManyFiles = errors.New("bundle contains too many files")
+
+// library is a shared library found in a bundle.
+type library struct {
+	name    string
+	version string
+	path    string
+}
+
+// findLibraries returns the versioned shared libraries in fsys sorted by
+// name. Symlinks, e.g. from a library's soname, are skipped so each library
+// is reported once.
+func findLibraries(ctx context.Context, fsys fs.FS) ([]library, error) {
+	var libs []library
+	seen := make(map[string]bool)
+	visited := 0
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if visited++; visited > maxWalkEntries {
+			return errTooManyFiles
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		m := libraryRe.FindStringSubmatch(d.Name())
+		if m == nil {
+			return nil
+		}
+		if key := m[1] + "@" + m[2]; !seen[key] {
+			seen[key] = true
+			libs = append(libs, library{name: m[1], version: m[2], path: p})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(libs, func(a, b library) int {
+		return strings.Compare(a.name+"@"+a.version, b.name+"@"+b.version)
+	})
+	return libs, nil
+}
+
+// parseKeyFile parses a freedesktop key file such as a .desktop file or
+// flatpak metadata into a map of group name to its keys and values.
+// Localized keys (e.g. Name[de]) are kept as is.
+func parseKeyFile(r io.Reader) (map[string]map[string]string, error) {
+	groups := make(map[string]map[string]string)
+	var cur map[string]string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := line[1 : len(line)-1]
+			if groups[name] == nil {
+				groups[name] = make(map[string]string)
+			}
+			cur = groups[name]
+		case cur != nil:
+			if k, v, ok := strings.Cut(line, "="); ok {
+				cur[strings.TrimSpace(k)] = strings.TrimSpace(v)
+			}
+		}
+	}
+	return groups, s.Err()
+}
+
+// appstreamVersion returns the version of the latest release in AppStream
+// metadata, which lists releases newest first. Both single component
+// metainfo files and component collections are supported.
+func appstreamVersion(r io.Reader) (string, error) {
+	d := xml.NewDecoder(r)
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "release" {
+			continue
+		}
+		for _, a := range start.Attr {
+			if a.Name.Local == "version" {
+				return a.Value, nil
+			}
+		}
+	}
+}
+
+// readAppstreamVersion returns the release version from the first AppStream
+// file in the given directories of fsys.
+func readAppstreamVersion(fsys fs.FS, dirs ...string) string {
+	for _, dir := range dirs {
+		entries, err := fs.ReadDir(fsys, dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if !e.Type().IsRegular() || path.Ext(e.Name()) != ".xml" {
+				continue
+			}
+			f, err := fsys.Open(path.Join(dir, e.Name()))
+			if err != nil {
+				continue
+			}
+			v, err := appstreamVersion(io.LimitReader(f, maxMetadataFileBytes))
+			f.Close()
+			if err == nil && v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
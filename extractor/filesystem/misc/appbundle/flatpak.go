@@ -0,0 +1,234 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appbundle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// maxFlatpakHeaderBytes is the maximum size of the metadata at the start of a
+// flatpak bundle. It contains the app's metadata, AppStream data and GPG keys
+// but no files.
+const maxFlatpakHeaderBytes = 16 << 20
+
+// A flatpak bundle is a serialized GVariant of an OSTree static delta
+// superblock, a tuple whose first element is the a{sv} metadata dictionary.
+// flatpak adds a "flatpak" (or "xdg-app" in old versions) entry first so the
+// key doubles as the magic of the file.
+var flatpakMagics = []string{"flatpak\x00", "xdg-app\x00"}
+
+// gvariant is a serialized GVariant value with its type signature.
+type gvariant struct {
+	sig   string
+	value []byte
+}
+
+// extractFlatpak extracts the application or runtime and, for applications,
+// the runtime they use from the flatpak bundle in r.
+func extractFlatpak(r io.ReaderAt, size int64, location string) ([]*extractor.Inventory, error) {
+	meta, err := readFlatpakHeader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := meta.str("ref")
+	if err != nil {
+		return nil, err
+	}
+	// Refs have the form app/org.example.App/x86_64/stable.
+	parts := strings.Split(ref, "/")
+	if len(parts) != 4 || (parts[0] != "app" && parts[0] != "runtime") {
+		return nil, fmt.Errorf("invalid flatpak ref %q", ref)
+	}
+	name := parts[1]
+
+	version := ""
+	if appdata, ok := meta["appdata"]; ok && appdata.sig == "ay" {
+		if zr, err := gzip.NewReader(bytes.NewReader(appdata.value)); err == nil {
+			version, _ = appstreamVersion(io.LimitReader(zr, maxMetadataFileBytes))
+		}
+	}
+	component := ComponentApplication
+	if parts[0] == "runtime" {
+		component = ComponentRuntime
+	}
+	inv := []*extractor.Inventory{{
+		Name:      name,
+		Version:   version,
+		Metadata:  &Metadata{Format: FormatFlatpak, Component: component},
+		Locations: []string{location},
+	}}
+
+	// The runtime is only referenced from the keyfile of applications, e.g.
+	// runtime=org.freedesktop.Platform/x86_64/23.08. Its branch is what's
+	// usually considered its version.
+	keyFile, err := meta.str("metadata")
+	if err != nil || component != ComponentApplication {
+		return inv, nil
+	}
+	groups, err := parseKeyFile(strings.NewReader(keyFile))
+	if err != nil {
+		return inv, nil
+	}
+	if rt := strings.Split(groups["Application"]["runtime"], "/"); len(rt) == 3 && rt[0] != "" {
+		inv = append(inv, &extractor.Inventory{
+			Name:      rt[0],
+			Version:   rt[2],
+			Metadata:  &Metadata{Format: FormatFlatpak, Component: ComponentRuntime, Bundle: name},
+			Locations: []string{location},
+		})
+	}
+	return inv, nil
+}
+
+// flatpakHeader is the metadata dictionary of a flatpak bundle.
+type flatpakHeader map[string]gvariant
+
+// str returns the string value of key.
+func (h flatpakHeader) str(key string) (string, error) {
+	v, ok := h[key]
+	if !ok {
+		return "", fmt.Errorf("flatpak bundle has no %q", key)
+	}
+	if v.sig != "s" || len(v.value) == 0 || v.value[len(v.value)-1] != 0 {
+		return "", fmt.Errorf("flatpak bundle %q is not a string", key)
+	}
+	return string(v.value[:len(v.value)-1]), nil
+}
+
+// readFlatpakHeader reads the metadata dictionary of the flatpak bundle in r.
+func readFlatpakHeader(r io.ReaderAt, size int64) (flatpakHeader, error) {
+	// The end of the first element of the tuple is the last framing offset of
+	// the whole file.
+	osize := offsetSize(uint64(size))
+	if size < int64(osize) {
+		return nil, errors.New("flatpak bundle too small")
+	}
+	b := make([]byte, osize)
+	if n, err := r.ReadAt(b, size-int64(osize)); n < len(b) {
+		return nil, fmt.Errorf("reading flatpak bundle: %w", err)
+	}
+	end := readOffset(b)
+	if end > uint64(size) || end > maxFlatpakHeaderBytes {
+		return nil, fmt.Errorf("invalid flatpak metadata size %d", end)
+	}
+	b = make([]byte, end)
+	if n, err := r.ReadAt(b, 0); n < len(b) {
+		return nil, fmt.Errorf("reading flatpak bundle: %w", err)
+	}
+	isBundle := false
+	for _, m := range flatpakMagics {
+		isBundle = isBundle || bytes.HasPrefix(b, []byte(m))
+	}
+	if !isBundle {
+		return nil, errors.New("not a flatpak bundle")
+	}
+
+	entries, err := gvariantArray(b, 8)
+	if err != nil {
+		return nil, err
+	}
+	h := make(flatpakHeader, len(entries))
+	for _, e := range entries {
+		key, value, err := gvariantDictEntry(e)
+		if err != nil {
+			return nil, err
+		}
+		h[key] = value
+	}
+	return h, nil
+}
+
+// offsetSize returns the size of the framing offsets of a GVariant container
+// of the given size.
+func offsetSize(size uint64) int {
+	switch {
+	case size <= 0xff:
+		return 1
+	case size <= 0xffff:
+		return 2
+	case size <= 0xffffffff:
+		return 4
+	default:
+		return 8
+	}
+}
+
+// readOffset reads a little-endian framing offset of len(b) bytes.
+func readOffset(b []byte) uint64 {
+	var buf [8]byte
+	copy(buf[:], b)
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// gvariantArray splits a serialized array of variable-size elements with the
+// given alignment. The offsets of the ends of the elements are stored after
+// the last element.
+func gvariantArray(b []byte, align uint64) ([][]byte, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	osize := offsetSize(uint64(len(b)))
+	if len(b) < osize {
+		return nil, errors.New("invalid GVariant array")
+	}
+	tableStart := readOffset(b[len(b)-osize:])
+	if tableStart > uint64(len(b)) || (uint64(len(b))-tableStart)%uint64(osize) != 0 {
+		return nil, errors.New("invalid GVariant array framing")
+	}
+	n := (uint64(len(b)) - tableStart) / uint64(osize)
+	elems := make([][]byte, 0, n)
+	start := uint64(0)
+	for i := range n {
+		o := tableStart + i*uint64(osize)
+		end := readOffset(b[o : o+uint64(osize)])
+		start = (start + align - 1) &^ (align - 1)
+		if end < start || end > tableStart {
+			return nil, errors.New("invalid GVariant array element")
+		}
+		elems = append(elems, b[start:end])
+		start = end
+	}
+	return elems, nil
+}
+
+// gvariantDictEntry parses a serialized {sv} dictionary entry. The end of
+// the key is stored in a framing offset at the end of the entry.
+func gvariantDictEntry(b []byte) (string, gvariant, error) {
+	osize := offsetSize(uint64(len(b)))
+	if len(b) < osize {
+		return "", gvariant{}, errors.New("invalid GVariant dict entry")
+	}
+	keyEnd := readOffset(b[len(b)-osize:])
+	valueStart := (keyEnd + 7) &^ 7
+	valueEnd := uint64(len(b) - osize)
+	if keyEnd == 0 || valueStart > valueEnd || b[keyEnd-1] != 0 {
+		return "", gvariant{}, errors.New("invalid GVariant dict entry")
+	}
+	// A variant is its value followed by a NUL byte and the type signature.
+	v := b[valueStart:valueEnd]
+	i := bytes.LastIndexByte(v, 0)
+	if i < 0 {
+		return "", gvariant{}, errors.New("invalid GVariant variant")
+	}
+	return string(b[:keyEnd-1]), gvariant{sig: string(v[i+1:]), value: v[:i]}, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appbundle
+
+// Bundle formats.
+const (
+	FormatSnap     = "snap"
+	FormatFlatpak  = "flatpak"
+	FormatAppImage = "appimage"
+)
+
+// Components of a bundle.
+const (
+	// The application shipped in the bundle.
+	ComponentApplication = "application"
+	// The runtime or base the application runs on, e.g. core22 or
+	// org.freedesktop.Platform.
+	ComponentRuntime = "runtime"
+	// A distro package staged into the bundle, e.g. from a snap's
+	// stage-packages.
+	ComponentPackage = "package"
+	// A shared library found in the bundle.
+	ComponentLibrary = "library"
+)
+
+// Metadata holds parsing information for a component of an application
+// bundle file.
+type Metadata struct {
+	// Format is the format of the bundle, e.g. FormatSnap.
+	Format string
+	// Component is what the Inventory is within the bundle, e.g.
+	// ComponentLibrary.
+	Component string
+	// Bundle is the name of the application of the bundle. It's empty for the
+	// application itself.
+	Bundle string
+}
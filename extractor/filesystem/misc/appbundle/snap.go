@@ -0,0 +1,166 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appbundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/squashfs"
+	"gopkg.in/yaml.v3"
+)
+
+// snapYAML is the relevant part of meta/snap.yaml.
+type snapYAML struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Base    string `yaml:"base"`
+	Type    string `yaml:"type"`
+}
+
+// snapManifest is the relevant part of snap/manifest.yaml, which snapcraft
+// adds with build information enabled.
+type snapManifest struct {
+	PrimedStagePackages []string `yaml:"primed-stage-packages"`
+	Parts               map[string]struct {
+		StagePackages []string `yaml:"stage-packages"`
+	} `yaml:"parts"`
+}
+
+// extractSnap extracts the application, its base and the staged packages and
+// libraries of the .snap file in r.
+func extractSnap(ctx context.Context, r io.ReaderAt, location string) ([]*extractor.Inventory, error) {
+	fsys, err := squashfs.Open(r)
+	if err != nil {
+		return nil, err
+	}
+	var meta snapYAML
+	if err := readYAML(fsys, "meta/snap.yaml", &meta); err != nil {
+		return nil, err
+	}
+	if meta.Name == "" {
+		return nil, errors.New("meta/snap.yaml has no name")
+	}
+
+	app := &extractor.Inventory{
+		Name:      meta.Name,
+		Version:   meta.Version,
+		Metadata:  &Metadata{Format: FormatSnap, Component: ComponentApplication},
+		Locations: []string{location},
+	}
+	inv := []*extractor.Inventory{app}
+	switch meta.Type {
+	case "base", "os":
+		app.Metadata.(*Metadata).Component = ComponentRuntime
+	case "", "app":
+		// Apps without a base run on the "core" snap unless they're "bare".
+		base := meta.Base
+		if base == "" {
+			base = "core"
+		}
+		if base != "bare" {
+			inv = append(inv, &extractor.Inventory{
+				Name:      base,
+				Metadata:  &Metadata{Format: FormatSnap, Component: ComponentRuntime, Bundle: meta.Name},
+				Locations: []string{location},
+			})
+		}
+	}
+
+	var manifest snapManifest
+	if err := readYAML(fsys, "snap/manifest.yaml", &manifest); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, pkg := range manifest.stagePackages() {
+		name, version, _ := strings.Cut(pkg, "=")
+		name, _, _ = strings.Cut(name, ":")
+		inv = append(inv, &extractor.Inventory{
+			Name:      name,
+			Version:   version,
+			Metadata:  &Metadata{Format: FormatSnap, Component: ComponentPackage, Bundle: meta.Name},
+			Locations: []string{filepath.Join(location, "snap/manifest.yaml")},
+		})
+	}
+
+	libs, err := findLibraries(ctx, fsys)
+	if err != nil {
+		return nil, err
+	}
+	return append(inv, libraryInventories(libs, FormatSnap, meta.Name, location)...), nil
+}
+
+// stagePackages returns the sorted "name=version" entries of the packages
+// staged into the snap. Newer snapcraft versions list the packages that
+// ended up in the snap separately; older ones only list them per part.
+func (m *snapManifest) stagePackages() []string {
+	pkgs := slices.Clone(m.PrimedStagePackages)
+	if len(pkgs) == 0 {
+		for _, part := range m.Parts {
+			pkgs = append(pkgs, part.StagePackages...)
+		}
+	}
+	slices.Sort(pkgs)
+	return slices.Compact(pkgs)
+}
+
+// readYAML decodes the YAML file at p in fsys into out.
+func readYAML(fsys fs.FS, p string, out any) error {
+	b, err := readFile(fsys, p)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("parsing %s: %w", p, err)
+	}
+	return nil
+}
+
+// readFile reads the metadata file at p in fsys.
+func readFile(fsys fs.FS, p string) ([]byte, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	b, err := io.ReadAll(io.LimitReader(f, maxMetadataFileBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > maxMetadataFileBytes {
+		return nil, fmt.Errorf("%s exceeds %d bytes", p, maxMetadataFileBytes)
+	}
+	return b, nil
+}
+
+// libraryInventories returns the Inventory of each library of a bundle.
+func libraryInventories(libs []library, format, bundle, location string) []*extractor.Inventory {
+	inv := make([]*extractor.Inventory, 0, len(libs))
+	for _, l := range libs {
+		inv = append(inv, &extractor.Inventory{
+			Name:      l.name,
+			Version:   l.version,
+			Metadata:  &Metadata{Format: format, Component: ComponentLibrary, Bundle: bundle},
+			Locations: []string{filepath.Join(location, l.path)},
+		})
+	}
+	return inv
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appsupervisor extracts the applications served by Ruby, Python and
+// Node.js app servers and process managers from their configs and state
+// files: pm2 dumps, gunicorn and supervisor configs and Passenger configs.
+// The apps are reported with their working directories and runtime versions
+// to link application level inventory to the processes running it.
+package appsupervisor
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/appsupervisor"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 10 * units.MiB
+)
+
+// Supported app servers and process managers.
+const (
+	SupervisorPM2        = "pm2"
+	SupervisorGunicorn   = "gunicorn"
+	SupervisorSupervisor = "supervisor"
+	SupervisorPassenger  = "passenger"
+)
+
+// Supported runtimes.
+const (
+	RuntimeNode   = "node"
+	RuntimePython = "python"
+	RuntimeRuby   = "ruby"
+)
+
+// fileKind is the kind of a file the extractor parses.
+type fileKind int
+
+const (
+	kindUnknown fileKind = iota
+	kindPM2Dump
+	kindGunicorn
+	kindSupervisor
+	kindPassengerfile
+	kindNginx
+	kindApache
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the app server
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts the apps of app servers and process managers.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an app supervisor extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is a pm2 dump or a config
+// of one of the supported app servers.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == kindUnknown {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// kindForPath returns the kind of the file at p.
+//
+// Passenger is configured in the Nginx or Apache configs, so all of those
+// are parsed and only the server blocks and virtual hosts that enable
+// Passenger are reported.
+func kindForPath(p string) fileKind {
+	p = strings.ToLower(filepath.ToSlash(p))
+	base := path.Base(p)
+	ext := path.Ext(base)
+	dir := path.Dir(p)
+	switch {
+	case base == "dump.pm2" && path.Base(dir) == ".pm2":
+		return kindPM2Dump
+	case base == "gunicorn.conf.py" || base == "gunicorn_conf.py" || base == "gunicorn_config.py":
+		return kindGunicorn
+	case ext == ".py" && hasDirWithPrefix(dir, "gunicorn"):
+		return kindGunicorn
+	case base == "supervisord.conf" || ((ext == ".conf" || ext == ".ini") && hasDirWithPrefix(dir, "supervisor")):
+		return kindSupervisor
+	case base == "passengerfile.json":
+		return kindPassengerfile
+	case base == "nginx.conf" || ((ext == ".conf" || path.Base(dir) == "sites-enabled") && hasDirWithPrefix(dir, "nginx")):
+		return kindNginx
+	case ext == ".conf" && (hasDirWithPrefix(dir, "apache") || hasDirWithPrefix(dir, "httpd")):
+		return kindApache
+	}
+	return kindUnknown
+}
+
+// hasDirWithPrefix returns true if any of the directories in dir start with
+// prefix, e.g. "etc/supervisor/conf.d" for "supervisor".
+func hasDirWithPrefix(dir, prefix string) bool {
+	for _, d := range strings.Split(dir, "/") {
+		if strings.HasPrefix(d, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// app is an application found in a file along with its name and version.
+type app struct {
+	name    string
+	version string
+	m       *Metadata
+}
+
+// Extract parses the config or state file passed through the scan input and
+// returns an Inventory for each app found in it.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	var apps []*app
+	var err error
+	switch kindForPath(input.Path) {
+	case kindPM2Dump:
+		apps, err = parsePM2Dump(input.Reader)
+	case kindGunicorn:
+		apps, err = parseGunicorn(ctx, input.Reader, input.Path)
+	case kindSupervisor:
+		apps, err = parseSupervisor(ctx, input.Reader)
+	case kindPassengerfile:
+		apps, err = parsePassengerfile(input.Reader, input.Path)
+	case kindNginx:
+		apps, err = parseNginx(ctx, input.Reader)
+	case kindApache:
+		apps, err = parseApache(ctx, input.Reader)
+	default:
+		err = fmt.Errorf("unsupported file %s", input.Path)
+	}
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+
+	inventory := []*extractor.Inventory{}
+	for _, a := range apps {
+		inventory = append(inventory, &extractor.Inventory{
+			Name:      a.name,
+			Version:   a.version,
+			Locations: []string{input.Path},
+			Metadata:  a.m,
+		})
+	}
+	return inventory, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since the apps are deployments rather than
+// packages known to OSV.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
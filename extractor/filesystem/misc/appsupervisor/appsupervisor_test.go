@@ -0,0 +1,375 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsupervisor_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/appsupervisor"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "pm2 dump",
+			path:             "home/deploy/.pm2/dump.pm2",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "pm2 dump backup",
+			path:         "home/deploy/.pm2/dump.pm2.bak",
+			wantRequired: false,
+		},
+		{
+			name:             "gunicorn config",
+			path:             "srv/api/gunicorn.conf.py",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "gunicorn config directory",
+			path:             "etc/gunicorn.d/api.py",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated python file",
+			path:         "srv/api/settings.py",
+			wantRequired: false,
+		},
+		{
+			name:             "supervisord.conf",
+			path:             "etc/supervisord.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "supervisor program config",
+			path:             "etc/supervisord.d/api.ini",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "Passengerfile",
+			path:             "srv/shop/Passengerfile.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "nginx site",
+			path:             "etc/nginx/sites-enabled/shop",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "apache site",
+			path:             "etc/httpd/conf.d/blog.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated conf",
+			path:         "etc/resolv.conf",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "home/deploy/.pm2/dump.pm2",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "home/deploy/.pm2/dump.pm2",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := appsupervisor.New(appsupervisor.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "pm2 dump",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/home/deploy/.pm2/dump.pm2",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "web",
+					Version:   "2.4.1",
+					Locations: []string{"testdata/home/deploy/.pm2/dump.pm2"},
+					Metadata: &appsupervisor.Metadata{
+						Supervisor:       appsupervisor.SupervisorPM2,
+						Command:          "/srv/web/server.js",
+						WorkingDirectory: "/srv/web",
+						Runtime:          appsupervisor.RuntimeNode,
+						RuntimeVersion:   "18.17.0",
+						User:             "deploy",
+						Listen:           []string{"3000"},
+						Instances:        4,
+						Status:           "online",
+					},
+				},
+				{
+					Name:      "worker",
+					Locations: []string{"testdata/home/deploy/.pm2/dump.pm2"},
+					Metadata: &appsupervisor.Metadata{
+						Supervisor:       appsupervisor.SupervisorPM2,
+						Command:          "/srv/worker/main.py",
+						WorkingDirectory: "/srv/worker",
+						Runtime:          appsupervisor.RuntimePython,
+						RuntimePath:      "/home/deploy/.pyenv/versions/3.11.4/bin/python",
+						RuntimeVersion:   "3.11.4",
+						User:             "deploy",
+						Status:           "stopped",
+					},
+				},
+			},
+		},
+		{
+			Name: "invalid pm2 dump",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/home/deploy/.pm2/dump.pm2",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+		{
+			Name: "gunicorn config",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/srv/api/gunicorn.conf.py",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "api.wsgi",
+				Locations: []string{"testdata/srv/api/gunicorn.conf.py"},
+				Metadata: &appsupervisor.Metadata{
+					Supervisor:       appsupervisor.SupervisorGunicorn,
+					Command:          "api.wsgi:application",
+					WorkingDirectory: "/srv/api",
+					Runtime:          appsupervisor.RuntimePython,
+					User:             "www-data",
+					Listen:           []string{"unix:/run/api.sock", "127.0.0.1:8000"},
+					Instances:        4,
+				},
+			}},
+		},
+		{
+			Name: "supervisor programs",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/supervisor/conf.d/apps.conf",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "billing",
+					Locations: []string{"testdata/etc/supervisor/conf.d/apps.conf"},
+					Metadata: &appsupervisor.Metadata{
+						Supervisor:       appsupervisor.SupervisorSupervisor,
+						Command:          "/srv/billing/venv/bin/gunicorn billing.wsgi -b 0.0.0.0:8001 --workers 3",
+						WorkingDirectory: "/srv/billing",
+						Runtime:          appsupervisor.RuntimePython,
+						User:             "billing",
+						Listen:           []string{"0.0.0.0:8001"},
+						Instances:        1,
+					},
+				},
+				{
+					Name:      "jobs",
+					Locations: []string{"testdata/etc/supervisor/conf.d/apps.conf"},
+					Metadata: &appsupervisor.Metadata{
+						Supervisor:       appsupervisor.SupervisorSupervisor,
+						Command:          "/home/deploy/.rbenv/versions/3.2.2/bin/bundle exec sidekiq",
+						WorkingDirectory: "/srv/shop",
+						Runtime:          appsupervisor.RuntimeRuby,
+						RuntimeVersion:   "3.2.2",
+					},
+				},
+				{
+					Name:      "exporter",
+					Locations: []string{"testdata/etc/supervisor/conf.d/apps.conf"},
+					Metadata: &appsupervisor.Metadata{
+						Supervisor: appsupervisor.SupervisorSupervisor,
+						Command:    "/usr/local/bin/node_exporter --web.listen-address=:9100",
+					},
+				},
+			},
+		},
+		{
+			Name: "passenger nginx site",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/nginx/sites-enabled/shop",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "shop.example.com",
+					Locations: []string{"testdata/etc/nginx/sites-enabled/shop"},
+					Metadata: &appsupervisor.Metadata{
+						Supervisor:       appsupervisor.SupervisorPassenger,
+						WorkingDirectory: "/srv/shop",
+						Runtime:          appsupervisor.RuntimeRuby,
+						RuntimePath:      "/usr/bin/ruby3.1",
+						RuntimeVersion:   "3.1",
+						User:             "deploy",
+						Listen:           []string{"80", "[::]:80"},
+						Instances:        2,
+					},
+				},
+				{
+					Name:      "dashboard",
+					Locations: []string{"testdata/etc/nginx/sites-enabled/shop"},
+					Metadata: &appsupervisor.Metadata{
+						Supervisor:       appsupervisor.SupervisorPassenger,
+						Command:          "server.js",
+						WorkingDirectory: "/srv/dashboard",
+						Runtime:          appsupervisor.RuntimeNode,
+						RuntimePath:      "/home/deploy/.nvm/versions/node/v20.11.1/bin/node",
+						RuntimeVersion:   "20.11.1",
+						Listen:           []string{"8080"},
+					},
+				},
+			},
+		},
+		{
+			Name: "passenger apache site",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/apache2/sites-enabled/blog.conf",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "blog.example.com",
+				Locations: []string{"testdata/etc/apache2/sites-enabled/blog.conf"},
+				Metadata: &appsupervisor.Metadata{
+					Supervisor:       appsupervisor.SupervisorPassenger,
+					Command:          "passenger_wsgi.py",
+					WorkingDirectory: "/srv/blog",
+					Runtime:          appsupervisor.RuntimePython,
+					RuntimePath:      "/usr/bin/python3.10",
+					RuntimeVersion:   "3.10",
+					Listen:           []string{"*:80"},
+				},
+			}},
+		},
+		{
+			Name: "Passengerfile",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/srv/shop/Passengerfile.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "shop",
+				Locations: []string{"testdata/srv/shop/Passengerfile.json"},
+				Metadata: &appsupervisor.Metadata{
+					Supervisor:       appsupervisor.SupervisorPassenger,
+					WorkingDirectory: "testdata/srv/shop",
+					Runtime:          appsupervisor.RuntimeRuby,
+					RuntimePath:      "/opt/rubies/ruby-3.3.0/bin/ruby",
+					RuntimeVersion:   "3.3.0",
+					User:             "shop",
+					Listen:           []string{"0.0.0.0:3001"},
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := appsupervisor.New(appsupervisor.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := appsupervisor.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "web",
+		Version:   "2.4.1",
+		Locations: []string{"home/deploy/.pm2/dump.pm2"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "web",
+		Version: "2.4.1",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsupervisor
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// gunicornSettingRe matches top-level assignments in a gunicorn config,
+	// which is a Python file.
+	gunicornSettingRe = regexp.MustCompile(`^([a-z_]+)\s*=\s*(.+)$`)
+	// pyStringRe matches Python string literals.
+	pyStringRe = regexp.MustCompile(`"([^"]*)"|'([^']*)'`)
+)
+
+// parseGunicorn parses the literal settings of a gunicorn config. Settings
+// computed at runtime, e.g. workers = multiprocessing.cpu_count() * 2, are
+// ignored.
+func parseGunicorn(ctx context.Context, r io.Reader, configPath string) ([]*app, error) {
+	settings := map[string]string{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if m := gunicornSettingRe.FindStringSubmatch(s.Text()); m != nil {
+			settings[m[1]] = strings.TrimSpace(m[2])
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read gunicorn config: %w", err)
+	}
+
+	m := &Metadata{
+		Supervisor:       SupervisorGunicorn,
+		Command:          pyString(settings["wsgi_app"]),
+		WorkingDirectory: pyString(settings["chdir"]),
+		User:             pyString(settings["user"]),
+		Runtime:          RuntimePython,
+		Listen:           pyStrings(settings["bind"]),
+	}
+	if n, err := strconv.Atoi(pyStripComment(settings["workers"])); err == nil {
+		m.Instances = n
+	}
+
+	name := pyString(settings["proc_name"])
+	if name == "" && m.Command != "" {
+		name, _, _ = strings.Cut(m.Command, ":")
+	}
+	if name == "" && m.WorkingDirectory != "" {
+		name = path.Base(filepath.ToSlash(m.WorkingDirectory))
+	}
+	if name == "" {
+		name = path.Base(path.Dir(filepath.ToSlash(configPath)))
+	}
+	return []*app{{name: name, m: m}}, nil
+}
+
+// pyString returns the first string literal in a Python expression.
+func pyString(expr string) string {
+	if s := pyStrings(expr); len(s) > 0 {
+		return s[0]
+	}
+	return ""
+}
+
+// pyStrings returns the string literals in a Python expression, e.g. the
+// items of a list.
+func pyStrings(expr string) []string {
+	var result []string
+	for _, m := range pyStringRe.FindAllStringSubmatch(expr, -1) {
+		result = append(result, m[1]+m[2])
+	}
+	return result
+}
+
+// pyStripComment returns expr without a trailing comment. It must only be
+// used for expressions without string literals.
+func pyStripComment(expr string) string {
+	expr, _, _ = strings.Cut(expr, "#")
+	return strings.TrimSpace(expr)
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsupervisor
+
+// Metadata describes an application served by an app server or kept running
+// by a process manager.
+type Metadata struct {
+	// The process manager or app server the app was found in, e.g. "pm2".
+	Supervisor string
+	// The command, script or entry point that starts the app, e.g. a pm2
+	// script path, a WSGI app or a supervisor command line.
+	Command string
+	// The directory the app runs in or is deployed to.
+	WorkingDirectory string
+	// The runtime of the app: "node", "python" or "ruby". Empty if unknown.
+	Runtime string
+	// Path of the runtime's interpreter, if configured.
+	RuntimePath string
+	// Version of the runtime if it's recorded by the supervisor or part of the
+	// interpreter path, e.g. "18.17.0" for ~/.nvm/versions/node/v18.17.0.
+	RuntimeVersion string
+	// The user the app runs as.
+	User string
+	// Addresses or ports the app listens on.
+	Listen []string
+	// Number of processes or workers, 0 if not configured.
+	Instances int
+	// The state recorded by the supervisor, e.g. "online" or "stopped" for
+	// pm2. Empty if the supervisor doesn't record one.
+	Status string
+}
@@ -0,0 +1,364 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsupervisor
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// passengerAppTypes maps Passenger app types to runtimes.
+var passengerAppTypes = map[string]string{
+	"rack":   RuntimeRuby,
+	"wsgi":   RuntimePython,
+	"node":   RuntimeNode,
+	"meteor": RuntimeNode,
+}
+
+// passengerInterpreters maps runtimes to the directives that configure
+// their interpreter.
+var passengerInterpreters = map[string]string{
+	RuntimeRuby:   "passenger_ruby",
+	RuntimePython: "passenger_python",
+	RuntimeNode:   "passenger_nodejs",
+}
+
+// apacheDirectives maps the Apache directives of Passenger and the core
+// directives it depends on to their Nginx names.
+var apacheDirectives = map[string]string{
+	"passengerapproot":         "passenger_app_root",
+	"passengerapptype":         "passenger_app_type",
+	"passengerstartupfile":     "passenger_startup_file",
+	"passengerappstartcommand": "passenger_app_start_command",
+	"passengerruby":            "passenger_ruby",
+	"passengerpython":          "passenger_python",
+	"passengernodejs":          "passenger_nodejs",
+	"passengeruser":            "passenger_user",
+	"passengermininstances":    "passenger_min_instances",
+	"documentroot":             "root",
+	"servername":               "server_name",
+}
+
+// passengerScope holds the directives of an Nginx block, an Apache virtual
+// host or a Passengerfile, keyed by their Nginx names. Directives that aren't
+// set in a scope are inherited from its parent.
+type passengerScope struct {
+	parent     *passengerScope
+	directives map[string]string
+	listen     []string
+	// Whether Passenger is enabled for the scope or any of its children.
+	enabled bool
+	// Whether Passenger is explicitly disabled for an Apache virtual host.
+	disabled bool
+}
+
+func newPassengerScope(parent *passengerScope) *passengerScope {
+	return &passengerScope{parent: parent, directives: map[string]string{}}
+}
+
+// set sets a directive unless it's already set in the scope.
+func (s *passengerScope) set(key, value string) {
+	if _, ok := s.directives[key]; !ok {
+		s.directives[key] = value
+	}
+}
+
+func (s *passengerScope) get(key string) string {
+	for ; s != nil; s = s.parent {
+		if v, ok := s.directives[key]; ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// app returns the app served by Passenger in the scope.
+func (s *passengerScope) app() *app {
+	m := &Metadata{
+		Supervisor:       SupervisorPassenger,
+		WorkingDirectory: s.get("passenger_app_root"),
+		User:             s.get("passenger_user"),
+		Listen:           s.listen,
+		Command:          s.get("passenger_startup_file"),
+	}
+	if m.Command == "" {
+		m.Command = s.get("passenger_app_start_command")
+	}
+	if m.WorkingDirectory == "" {
+		// Passenger expects the document root to be the public directory of the
+		// app by default.
+		root := s.get("root")
+		if path.Base(root) == "public" {
+			root = path.Dir(root)
+		}
+		m.WorkingDirectory = root
+	}
+	if n, err := strconv.Atoi(s.get("passenger_min_instances")); err == nil {
+		m.Instances = n
+	}
+
+	m.Runtime = passengerAppTypes[s.get("passenger_app_type")]
+	if m.Runtime == "" {
+		m.Runtime = s.soleInterpreter()
+	}
+	if m.Runtime != "" {
+		m.RuntimePath = s.get(passengerInterpreters[m.Runtime])
+		m.RuntimeVersion = versionFromPath(m.RuntimePath)
+	}
+
+	name := ""
+	for _, n := range strings.Fields(s.get("server_name")) {
+		if n != "_" {
+			name = n
+			break
+		}
+	}
+	if name == "" && m.WorkingDirectory != "" {
+		name = path.Base(m.WorkingDirectory)
+	}
+	if name == "" {
+		name = SupervisorPassenger
+	}
+	return &app{name: name, m: m}
+}
+
+// soleInterpreter returns the runtime of the only interpreter configured for
+// the scope, or an empty string if none or several are configured.
+func (s *passengerScope) soleInterpreter() string {
+	runtime := ""
+	for r, key := range passengerInterpreters {
+		if s.get(key) == "" {
+			continue
+		}
+		if runtime != "" {
+			return ""
+		}
+		runtime = r
+	}
+	return runtime
+}
+
+// parseNginx returns the apps of the server blocks of an Nginx config that
+// enable Passenger, either directly or in one of their locations.
+func parseNginx(ctx context.Context, r io.Reader) ([]*app, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read nginx config: %w", err)
+	}
+
+	var apps []*app
+	scope := newPassengerScope(nil)
+	// The names of the open blocks.
+	var blocks []string
+	var args []string
+	var word strings.Builder
+	inWord := false
+	endWord := func() {
+		if inWord {
+			args = append(args, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+
+	for i := 0; i < len(data); i++ {
+		if i%4096 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+		c := data[i]
+		switch {
+		case c == '#' && !inWord:
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(data) && data[end] != c {
+				if data[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			word.Write(data[i+1 : min(end, len(data))])
+			inWord = true
+			i = end
+		case c == ';':
+			endWord()
+			nginxDirective(scope, args)
+			args = nil
+		case c == '{':
+			endWord()
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+			blocks = append(blocks, name)
+			scope = newPassengerScope(scope)
+			args = nil
+		case c == '}':
+			endWord()
+			args = nil
+			if len(blocks) == 0 {
+				return nil, fmt.Errorf("unexpected } at offset %d", i)
+			}
+			name := blocks[len(blocks)-1]
+			blocks = blocks[:len(blocks)-1]
+			closed := scope
+			scope = scope.parent
+			switch {
+			case name == "server" && closed.enabled:
+				apps = append(apps, closed.app())
+			case name == "location" && closed.enabled:
+				// Settings of Passenger enabled locations apply to the app of
+				// the server.
+				scope.enabled = true
+				for k, v := range closed.directives {
+					scope.set(k, v)
+				}
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			endWord()
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	return apps, nil
+}
+
+// nginxDirective adds a directive to scope.
+func nginxDirective(scope *passengerScope, args []string) {
+	if len(args) < 2 {
+		return
+	}
+	switch key := args[0]; key {
+	case "listen":
+		scope.listen = append(scope.listen, args[1])
+	case "server_name":
+		scope.set(key, strings.Join(args[1:], " "))
+	case "passenger_enabled":
+		if args[1] == "on" {
+			scope.enabled = true
+		}
+	default:
+		scope.set(key, args[1])
+	}
+}
+
+// parseApache returns the apps of the virtual hosts of an Apache config that
+// use Passenger.
+func parseApache(ctx context.Context, r io.Reader) ([]*app, error) {
+	var apps []*app
+	global := newPassengerScope(nil)
+	var vhost *passengerScope
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		lower := strings.ToLower(line)
+		switch {
+		case strings.HasPrefix(lower, "<virtualhost"):
+			vhost = newPassengerScope(global)
+			addrs := strings.TrimSuffix(strings.TrimSpace(line[len("<virtualhost"):]), ">")
+			vhost.listen = strings.Fields(addrs)
+			continue
+		case strings.HasPrefix(lower, "</virtualhost"):
+			if vhost != nil && vhost.enabled {
+				apps = append(apps, vhost.app())
+			}
+			vhost = nil
+			continue
+		case strings.HasPrefix(line, "<"):
+			// Directives of nested sections such as <Directory> apply to the
+			// virtual host.
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		directive := strings.ToLower(fields[0])
+		value := strings.Trim(strings.Join(fields[1:], " "), `"`)
+		if vhost != nil && directive == "passengerenabled" && strings.EqualFold(value, "off") {
+			vhost.disabled = true
+		}
+		if vhost != nil && strings.HasPrefix(directive, "passenger") {
+			// Passenger serves every virtual host once its module is loaded, a
+			// virtual host is considered to be an app if it configures
+			// Passenger and doesn't disable it.
+			vhost.enabled = !vhost.disabled
+		}
+		key, ok := apacheDirectives[directive]
+		if !ok {
+			continue
+		}
+		scope := global
+		if vhost != nil {
+			scope = vhost
+		}
+		scope.set(key, value)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read apache config: %w", err)
+	}
+	return apps, nil
+}
+
+// parsePassengerfile parses the config of a Passenger Standalone app. The
+// app root is the directory the Passengerfile.json is in.
+func parsePassengerfile(r io.Reader, configPath string) ([]*app, error) {
+	var cfg map[string]any
+	if err := json.NewDecoder(r).Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse Passengerfile.json: %w", err)
+	}
+
+	scope := newPassengerScope(nil)
+	for k, v := range cfg {
+		switch v := v.(type) {
+		case string:
+			scope.set("passenger_"+k, v)
+		case float64:
+			scope.set("passenger_"+k, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+	}
+	root := path.Dir(filepath.ToSlash(configPath))
+	if root == "." {
+		root = ""
+	}
+	scope.set("passenger_app_root", root)
+	if port := scope.get("passenger_port"); port != "" {
+		addr := scope.get("passenger_address")
+		if addr == "" {
+			addr = "0.0.0.0"
+		}
+		scope.listen = []string{addr + ":" + port}
+	}
+	return []*app{scope.app()}, nil
+}
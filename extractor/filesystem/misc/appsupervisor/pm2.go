@@ -0,0 +1,94 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsupervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// pm2Process is a process in a pm2 dump. pm2 saves the environment of each
+// managed process, which includes its pm2 settings, to ~/.pm2/dump.pm2 to
+// restore them on reboot.
+type pm2Process struct {
+	Name            string          `json:"name"`
+	Version         string          `json:"version"`
+	ExecPath        string          `json:"pm_exec_path"`
+	Cwd             string          `json:"pm_cwd"`
+	ExecInterpreter string          `json:"exec_interpreter"`
+	NodeVersion     string          `json:"node_version"`
+	Username        string          `json:"username"`
+	Status          string          `json:"status"`
+	Instances       json.RawMessage `json:"instances"`
+	Env             map[string]any  `json:"env"`
+}
+
+// pm2NoValue is used by pm2 for unknown values, e.g. the version of apps
+// without a package.json.
+const pm2NoValue = "N/A"
+
+func parsePM2Dump(r io.Reader) ([]*app, error) {
+	var procs []*pm2Process
+	if err := json.NewDecoder(r).Decode(&procs); err != nil {
+		return nil, fmt.Errorf("failed to parse pm2 dump: %w", err)
+	}
+
+	var apps []*app
+	for _, p := range procs {
+		if p == nil || p.Name == "" {
+			continue
+		}
+		m := &Metadata{
+			Supervisor:       SupervisorPM2,
+			Command:          p.ExecPath,
+			WorkingDirectory: p.Cwd,
+			User:             p.Username,
+			Status:           p.Status,
+			Instances:        pm2Instances(p.Instances),
+		}
+		interpreter := p.ExecInterpreter
+		if interpreter == "" {
+			interpreter = "node"
+		}
+		if interpreter != "none" {
+			setRuntime(m, interpreter)
+		}
+		if m.Runtime == RuntimeNode && p.NodeVersion != "" && p.NodeVersion != pm2NoValue {
+			m.RuntimeVersion = p.NodeVersion
+		}
+		if port, ok := p.Env["PORT"]; ok {
+			m.Listen = append(m.Listen, fmt.Sprint(port))
+		}
+		version := p.Version
+		if version == pm2NoValue {
+			version = ""
+		}
+		apps = append(apps, &app{name: p.Name, version: version, m: m})
+	}
+	return apps, nil
+}
+
+// pm2Instances returns the number of instances of a process, which pm2
+// stores as a number or as "max" for one instance per CPU. 0 is returned
+// for "max" and values that aren't positive numbers.
+func pm2Instances(raw json.RawMessage) int {
+	n, err := strconv.Atoi(string(raw))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsupervisor
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	// interpreterRe matches the names of interpreters, optionally with a
+	// version, e.g. "python3.11" or "ruby".
+	interpreterRe = regexp.MustCompile(`^(?:python|ruby|node|nodejs)(\d+(?:\.\d+)*)?$`)
+	// managedVersionRe matches the versions in interpreter paths of version
+	// managers: pyenv, rbenv and nodenv ("versions/3.12.1/"), nvm
+	// ("versions/node/v18.17.0/") and rvm and chruby ("rubies/ruby-3.2.2/").
+	managedVersionRe = regexp.MustCompile(`/(?:versions/(?:node/)?v?|rubies/ruby-)(\d+\.\d+(?:\.\d+)?)(?:/|$)`)
+)
+
+// runtimeTools maps the names of common app servers and tools started by
+// process managers to the runtime they run on.
+var runtimeTools = map[string]string{
+	"gunicorn":    RuntimePython,
+	"uwsgi":       RuntimePython,
+	"uvicorn":     RuntimePython,
+	"hypercorn":   RuntimePython,
+	"daphne":      RuntimePython,
+	"celery":      RuntimePython,
+	"npm":         RuntimeNode,
+	"npx":         RuntimeNode,
+	"yarn":        RuntimeNode,
+	"pm2":         RuntimeNode,
+	"pm2-runtime": RuntimeNode,
+	"bundle":      RuntimeRuby,
+	"puma":        RuntimeRuby,
+	"unicorn":     RuntimeRuby,
+	"rails":       RuntimeRuby,
+	"rackup":      RuntimeRuby,
+	"sidekiq":     RuntimeRuby,
+}
+
+// setRuntime sets the runtime of m based on the executable that starts the
+// app. Interpreters given by path are also reported as the runtime path.
+func setRuntime(m *Metadata, executable string) {
+	base := strings.ToLower(path.Base(executable))
+	switch {
+	case interpreterRe.MatchString(base):
+		if strings.Contains(executable, "/") {
+			m.RuntimePath = executable
+		}
+		switch {
+		case strings.HasPrefix(base, "python"):
+			m.Runtime = RuntimePython
+		case strings.HasPrefix(base, "ruby"):
+			m.Runtime = RuntimeRuby
+		default:
+			m.Runtime = RuntimeNode
+		}
+	case runtimeTools[base] != "":
+		m.Runtime = runtimeTools[base]
+	default:
+		return
+	}
+	if m.RuntimeVersion == "" {
+		m.RuntimeVersion = versionFromPath(executable)
+	}
+}
+
+// versionFromPath returns the runtime version in the path of an executable
+// or an empty string if it doesn't contain a full version.
+func versionFromPath(p string) string {
+	if m := interpreterRe.FindStringSubmatch(strings.ToLower(path.Base(p))); m != nil && strings.Contains(m[1], ".") {
+		return m[1]
+	}
+	if m := managedVersionRe.FindStringSubmatch(p); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// firstWord returns the first whitespace separated word of s.
+func firstWord(s string) string {
+	if fields := strings.Fields(s); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsupervisor
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// programSectionPrefix is the prefix of supervisor config sections that
+// define a program.
+const programSectionPrefix = "program:"
+
+// parseSupervisor parses the programs of a supervisor config. Every program
+// is reported, not only the ones running on one of the supported runtimes,
+// since supervisor is also used to run compiled services.
+func parseSupervisor(ctx context.Context, r io.Reader) ([]*app, error) {
+	var apps []*app
+	var current *app
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == ';' || line[0] == '#' {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = nil
+			section := strings.TrimSpace(line[1 : len(line)-1])
+			if name, ok := strings.CutPrefix(section, programSectionPrefix); ok && name != "" {
+				current = &app{name: name, m: &Metadata{Supervisor: SupervisorSupervisor}}
+				apps = append(apps, current)
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = stripINIComment(strings.TrimSpace(value))
+		switch key {
+		case "command":
+			current.m.Command = value
+		case "directory":
+			current.m.WorkingDirectory = value
+		case "user":
+			current.m.User = value
+		case "numprocs":
+			if n, err := strconv.Atoi(value); err == nil {
+				current.m.Instances = n
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read supervisor config: %w", err)
+	}
+
+	for _, a := range apps {
+		setRuntime(a.m, firstWord(a.m.Command))
+		a.m.Listen = bindArgs(a.m.Command)
+	}
+	return apps, nil
+}
+
+// stripINIComment removes an inline comment, which supervisor only
+// recognizes if the ";" is preceded by whitespace.
+func stripINIComment(value string) string {
+	for i := 1; i < len(value); i++ {
+		if value[i] == ';' && (value[i-1] == ' ' || value[i-1] == '\t') {
+			return strings.TrimSpace(value[:i])
+		}
+	}
+	return value
+}
+
+// bindArgs returns the addresses passed with the -b or --bind flags of
+// gunicorn and similar servers in a command line.
+func bindArgs(command string) []string {
+	var result []string
+	args := strings.Fields(command)
+	for i, a := range args {
+		if v, ok := strings.CutPrefix(a, "--bind="); ok {
+			result = append(result, v)
+		} else if (a == "-b" || a == "--bind") && i+1 < len(args) {
+			result = append(result, args[i+1])
+		}
+	}
+	return result
+}
@@ -0,0 +1,12 @@
+import multiprocessing
+
+# Served behind nginx.
+bind = ["unix:/run/api.sock", "127.0.0.1:8000"]
+workers = 4  # one per core
+threads = multiprocessing.cpu_count()
+chdir = "/srv/api"
+wsgi_app = "api.wsgi:application"
+user = 'www-data'
+
+def on_starting(server):
+    workers = 8
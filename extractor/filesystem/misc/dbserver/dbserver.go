@@ -0,0 +1,266 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dbserver extracts the versions of database servers (PostgreSQL,
+// MySQL, MariaDB and Microsoft SQL Server) from their installed binaries and
+// data directories. This covers servers installed from vendor tarballs, which
+// aren't reported by the OS package extractors.
+package dbserver
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/dbserver"
+)
+
+// Supported database servers.
+const (
+	ServerPostgreSQL = "postgresql"
+	ServerMySQL      = "mysql"
+	ServerMariaDB    = "mariadb"
+	ServerMSSQL      = "mssql"
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// PG_VERSION in the root of a PostgreSQL data directory.
+	kindPGVersion
+	// The PostgreSQL server binary, e.g. /usr/local/pgsql/bin/postgres.
+	kindPostgresBinary
+	// mysql_upgrade_info in a MySQL or MariaDB data directory.
+	kindMySQLUpgradeInfo
+	// docs/INFO_SRC of a MySQL binary tarball installation.
+	kindMySQLInfoSrc
+	// The error log of SQL Server, e.g. /var/opt/mssql/log/errorlog.
+	kindMSSQLErrorLog
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the database server
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats: nil,
+		// Only the beginning of the SQL Server error log is read and the
+		// PostgreSQL binary is streamed, so large files are fine.
+		MaxFileSizeBytes: 0,
+	}
+}
+
+// Extractor extracts database server versions.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a database server extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is a server binary or a
+// version file of a supported database server.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(filepath.ToSlash(path)) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// kindForPath returns the kind of file at p.
+func kindForPath(p string) fileKind {
+	base := path.Base(p)
+	dir := path.Dir(p)
+	switch base {
+	case "PG_VERSION":
+		// Every database in base/<oid>/ has its own PG_VERSION.
+		if path.Base(path.Dir(dir)) == "base" {
+			return kindNone
+		}
+		return kindPGVersion
+	case "postgres", "postgres.exe":
+		if path.Base(dir) == "bin" {
+			return kindPostgresBinary
+		}
+	case "mysql_upgrade_info":
+		return kindMySQLUpgradeInfo
+	case "INFO_SRC":
+		if path.Base(dir) == "docs" {
+			return kindMySQLInfoSrc
+		}
+	}
+	// The error log is /var/opt/mssql/log/errorlog on Linux and
+	// MSSQL16.MSSQLSERVER\MSSQL\Log\ERRORLOG on Windows.
+	lowerDir := strings.ToLower(dir)
+	if strings.ToLower(base) == "errorlog" && path.Base(lowerDir) == "log" && strings.Contains(lowerDir, "mssql") {
+		return kindMSSQLErrorLog
+	}
+	return kindNone
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract extracts the database server version from the file passed through
+// the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("%s extract(%s): %w", e.Name(), input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	p := filepath.ToSlash(input.Path)
+	var s *server
+	var err error
+	switch kindForPath(p) {
+	case kindPGVersion:
+		s, err = parsePGVersion(input.Reader)
+		if s != nil {
+			s.dataDir = path.Dir(p)
+		}
+	case kindPostgresBinary:
+		s, err = parsePostgresBinary(input.Reader)
+	case kindMySQLUpgradeInfo:
+		s, err = parseMySQLUpgradeInfo(input.Reader)
+		if s != nil {
+			s.dataDir = path.Dir(p)
+		}
+	case kindMySQLInfoSrc:
+		s, err = parseMySQLInfoSrc(input.Reader)
+	case kindMSSQLErrorLog:
+		s, err = parseMSSQLErrorLog(input.Reader)
+		if s != nil {
+			// The log directory is in the data directory.
+			s.dataDir = path.Dir(path.Dir(p))
+		}
+	}
+	if err != nil || s == nil {
+		return nil, err
+	}
+	return &extractor.Inventory{
+		Name:    s.name,
+		Version: s.version,
+		Metadata: &Metadata{
+			Server:        s.name,
+			DataDirectory: filepath.FromSlash(s.dataDir),
+			Product:       s.product,
+		},
+		Locations: []string{input.Path},
+	}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV doesn't track vulnerabilities of
+// database server distributions.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,322 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbserver_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/dbserver"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+const mssqlWindowsDir = "testdata/Program Files/Microsoft SQL Server/MSSQL15.MSSQLSERVER/MSSQL"
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "PG_VERSION of data directory",
+			path:             "var/lib/postgresql/16/main/PG_VERSION",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "PG_VERSION of a database",
+			path:         "var/lib/postgresql/16/main/base/1/PG_VERSION",
+			wantRequired: false,
+		},
+		{
+			name:             "postgres binary",
+			path:             "usr/local/pgsql/bin/postgres",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "postgres binary on windows",
+			path:             "Program Files/PostgreSQL/16/bin/postgres.exe",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "postgres file outside of bin",
+			path:         "home/postgres",
+			wantRequired: false,
+		},
+		{
+			name:             "mysql_upgrade_info",
+			path:             "var/lib/mysql/mysql_upgrade_info",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "mysql INFO_SRC",
+			path:             "opt/mysql/docs/INFO_SRC",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "INFO_SRC outside of docs",
+			path:         "opt/mysql/INFO_SRC",
+			wantRequired: false,
+		},
+		{
+			name:             "mssql errorlog on linux",
+			path:             "var/opt/mssql/log/errorlog",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "mssql errorlog on windows",
+			path:             "Program Files/Microsoft SQL Server/MSSQL16.MSSQLSERVER/MSSQL/Log/ERRORLOG",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "errorlog of something else",
+			path:         "var/log/app/errorlog",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "usr/local/pgsql/bin/postgres",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "usr/local/pgsql/bin/postgres",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := dbserver.New(dbserver.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "PG_VERSION",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/postgresql/16/main/PG_VERSION",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "postgresql",
+				Version:   "16",
+				Locations: []string{"testdata/var/lib/postgresql/16/main/PG_VERSION"},
+				Metadata: &dbserver.Metadata{
+					Server:        dbserver.ServerPostgreSQL,
+					DataDirectory: filepath.FromSlash("testdata/var/lib/postgresql/16/main"),
+				},
+			}},
+		},
+		{
+			Name: "invalid PG_VERSION",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/var/lib/postgresql/data/PG_VERSION",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "invalid PG_VERSION"},
+		},
+		{
+			Name: "postgres binary",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/usr/local/pgsql/bin/postgres",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "postgresql",
+				Version:   "16.2",
+				Locations: []string{"testdata/usr/local/pgsql/bin/postgres"},
+				Metadata:  &dbserver.Metadata{Server: dbserver.ServerPostgreSQL},
+			}},
+		},
+		{
+			Name: "postgres binary without version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/usr/local/pgsql/bin/postgres",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "mysql_upgrade_info",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/mysql/mysql_upgrade_info",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "mysql",
+				Version:   "8.0.36",
+				Locations: []string{"testdata/var/lib/mysql/mysql_upgrade_info"},
+				Metadata: &dbserver.Metadata{
+					Server:        dbserver.ServerMySQL,
+					DataDirectory: filepath.FromSlash("testdata/var/lib/mysql"),
+				},
+			}},
+		},
+		{
+			Name: "mariadb mysql_upgrade_info",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/mariadb/mysql_upgrade_info",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "mariadb",
+				Version:   "10.11.6",
+				Locations: []string{"testdata/var/lib/mariadb/mysql_upgrade_info"},
+				Metadata: &dbserver.Metadata{
+					Server:        dbserver.ServerMariaDB,
+					DataDirectory: filepath.FromSlash("testdata/var/lib/mariadb"),
+				},
+			}},
+		},
+		{
+			Name: "invalid mysql_upgrade_info",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/var/lib/mysql/mysql_upgrade_info",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "invalid mysql_upgrade_info"},
+		},
+		{
+			Name: "mysql INFO_SRC",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/opt/mysql/docs/INFO_SRC",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "mysql",
+				Version:   "8.0.36",
+				Locations: []string{"testdata/opt/mysql/docs/INFO_SRC"},
+				Metadata:  &dbserver.Metadata{Server: dbserver.ServerMySQL},
+			}},
+		},
+		{
+			Name: "mssql errorlog on linux",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/opt/mssql/log/errorlog",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "mssql",
+				Version:   "16.0.4115.5",
+				Locations: []string{"testdata/var/opt/mssql/log/errorlog"},
+				Metadata: &dbserver.Metadata{
+					Server:        dbserver.ServerMSSQL,
+					DataDirectory: filepath.FromSlash("testdata/var/opt/mssql"),
+					Product:       "Microsoft SQL Server 2022 (RTM-CU12) (KB5033663)",
+				},
+			}},
+		},
+		{
+			Name: "mssql errorlog on windows",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: mssqlWindowsDir + "/Log/ERRORLOG",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "mssql",
+				Version:   "15.0.4355.3",
+				Locations: []string{mssqlWindowsDir + "/Log/ERRORLOG"},
+				Metadata: &dbserver.Metadata{
+					Server:        dbserver.ServerMSSQL,
+					DataDirectory: filepath.FromSlash(mssqlWindowsDir),
+					Product:       "Microsoft SQL Server 2019 (RTM-CU25) (KB5033688)",
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := dbserver.New(dbserver.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := dbserver.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "postgresql",
+		Version:   "16.2",
+		Locations: []string{"usr/local/pgsql/bin/postgres"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "postgresql",
+		Version: "16.2",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbserver
+
+// Metadata holds information about a database server installation.
+type Metadata struct {
+	// The database server: "postgresql", "mysql", "mariadb" or "mssql".
+	Server string
+	// The data directory the version was read from. Empty for versions read
+	// from the installed server binaries.
+	DataDirectory string
+	// The full product name reported by the server, e.g.
+	// "Microsoft SQL Server 2022 (RTM-CU12) (KB5033663)". Only set for SQL
+	// Server.
+	Product string
+}
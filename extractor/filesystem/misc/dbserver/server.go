@@ -0,0 +1,188 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbserver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+)
+
+const (
+	// maxVersionFileBytes is the maximum number of bytes read from a version
+	// file, e.g. PG_VERSION.
+	maxVersionFileBytes = 1 * units.KiB
+	// maxErrorLogBytes is the maximum number of bytes read from the beginning
+	// of the SQL Server error log. The server logs its version first on
+	// startup.
+	maxErrorLogBytes = 64 * units.KiB
+	// binaryChunkBytes is the size of the chunks a server binary is searched
+	// in for its version string.
+	binaryChunkBytes = 1 * units.MiB
+	// maxVersionStringBytes is the maximum length of a version string
+	// searched in a server binary.
+	maxVersionStringBytes = 64
+)
+
+var (
+	pgVersionRe = regexp.MustCompile(`^\d+(?:\.\d+)?$`)
+	// The PostgreSQL server binary embeds PG_BACKEND_VERSIONSTR, e.g.
+	// "postgres (PostgreSQL) 16.2".
+	postgresBinaryRe = regexp.MustCompile(`postgres \(PostgreSQL\) (\d+(?:\.\d+)*(?:(?:beta|rc)\d+)?)`)
+	// e.g. "8.0.36" or "10.11.6-MariaDB".
+	mysqlUpgradeInfoRe = regexp.MustCompile(`^(\d+\.\d+\.\d+)(\S*)$`)
+	mysqlInfoSrcRe     = regexp.MustCompile(`(?m)^MySQL source (\d+\.\d+\.\d+)`)
+	// e.g. "Microsoft SQL Server 2022 (RTM-CU12) (KB5033663) - 16.0.4115.5 (X64)".
+	mssqlVersionRe = regexp.MustCompile(`(Microsoft SQL Server [^\r\n]*?) - (\d+\.\d+\.\d+\.\d+)`)
+)
+
+// server is a database server found on the system.
+type server struct {
+	name    string
+	version string
+	dataDir string
+	product string
+}
+
+// parsePGVersion parses the PG_VERSION file of a PostgreSQL data directory.
+// It only contains the major version of the server that created the data
+// directory, e.g. "16" or "9.6".
+func parsePGVersion(r io.Reader) (*server, error) {
+	line, err := firstLine(io.LimitReader(r, maxVersionFileBytes))
+	if err != nil {
+		return nil, err
+	}
+	if !pgVersionRe.MatchString(line) {
+		return nil, fmt.Errorf("invalid PG_VERSION %q", line)
+	}
+	return &server{name: ServerPostgreSQL, version: line}, nil
+}
+
+// parsePostgresBinary searches the PostgreSQL server binary for its version.
+func parsePostgresBinary(r io.Reader) (*server, error) {
+	v, err := findInReader(r, postgresBinaryRe)
+	if err != nil || v == "" {
+		return nil, err
+	}
+	return &server{name: ServerPostgreSQL, version: v}, nil
+}
+
+// parseMySQLUpgradeInfo parses the mysql_upgrade_info file that MySQL and
+// MariaDB write to the data directory after upgrading it to the running
+// server version.
+func parseMySQLUpgradeInfo(r io.Reader) (*server, error) {
+	b, err := io.ReadAll(io.LimitReader(r, maxVersionFileBytes))
+	if err != nil {
+		return nil, err
+	}
+	s := strings.TrimSpace(strings.TrimRight(string(b), "\x00"))
+	m := mysqlUpgradeInfoRe.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("invalid mysql_upgrade_info %q", s)
+	}
+	name := ServerMySQL
+	if strings.Contains(strings.ToLower(m[2]), "mariadb") {
+		name = ServerMariaDB
+	}
+	return &server{name: name, version: m[1]}, nil
+}
+
+// parseMySQLInfoSrc parses the docs/INFO_SRC file with the source version
+// that's shipped with MySQL binary tarballs.
+func parseMySQLInfoSrc(r io.Reader) (*server, error) {
+	b, err := io.ReadAll(io.LimitReader(r, maxVersionFileBytes))
+	if err != nil {
+		return nil, err
+	}
+	m := mysqlInfoSrcRe.FindSubmatch(b)
+	if m == nil {
+		return nil, nil
+	}
+	return &server{name: ServerMySQL, version: string(m[1])}, nil
+}
+
+// parseMSSQLErrorLog parses the version SQL Server logs on startup from the
+// beginning of its error log. The log is UTF-16 encoded.
+func parseMSSQLErrorLog(r io.Reader) (*server, error) {
+	b, err := io.ReadAll(io.LimitReader(r, maxErrorLogBytes))
+	if err != nil {
+		return nil, err
+	}
+	m := mssqlVersionRe.FindStringSubmatch(decodeText(b))
+	if m == nil {
+		return nil, nil
+	}
+	return &server{name: ServerMSSQL, version: m[2], product: strings.TrimSpace(m[1])}, nil
+}
+
+// firstLine returns the first line of r without surrounding whitespace.
+func firstLine(r io.Reader) (string, error) {
+	s := bufio.NewScanner(r)
+	if !s.Scan() {
+		return "", s.Err()
+	}
+	return strings.TrimSpace(s.Text()), nil
+}
+
+// findInReader returns the first submatch of re in r, or an empty string if
+// there's none. r is searched in chunks so that large binaries aren't read into
+// memory at once. Matches longer than maxVersionStringBytes can be missed if
+// they span two chunks.
+func findInReader(r io.Reader, re *regexp.Regexp) (string, error) {
+	chunk := make([]byte, binaryChunkBytes)
+	var buf []byte
+	for {
+		n, err := io.ReadFull(r, chunk)
+		if n > 0 {
+			buf = append(buf, chunk[:n]...)
+			if m := re.FindSubmatch(buf); m != nil {
+				return string(m[1]), nil
+			}
+			// Keep the end of the chunk in case a match starts there.
+			if len(buf) > maxVersionStringBytes {
+				buf = append(buf[:0], buf[len(buf)-maxVersionStringBytes:]...)
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+}
+
+// decodeText returns b as a string, decoding it from UTF-16LE if it starts
+// with a byte order mark or looks like UTF-16LE encoded ASCII.
+func decodeText(b []byte) string {
+	switch {
+	case len(b) >= 2 && b[0] == 0xFF && b[1] == 0xFE:
+		b = b[2:]
+	case len(b) >= 2 && b[0] != 0 && b[1] == 0:
+	default:
+		return string(b)
+	}
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		u = append(u, binary.LittleEndian.Uint16(b[i:]))
+	}
+	return string(utf16.Decode(u))
+}
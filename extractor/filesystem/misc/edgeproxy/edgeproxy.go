@@ -0,0 +1,233 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package edgeproxy extracts the routes of HAProxy, Envoy and Traefik configs
+// and the credentials stored in them: inline TLS private keys, basic auth
+// user lists and credentials for upstream servers.
+package edgeproxy
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/edgeproxy"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 10 * units.MiB
+)
+
+// Supported proxies.
+const (
+	ProxyHAProxy = "haproxy"
+	ProxyEnvoy   = "envoy"
+	ProxyTraefik = "traefik"
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the edge proxy extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts the routes and credentials of proxy configs.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an edge proxy extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is a config of one of the
+// supported proxies.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if proxyForPath(path) == "" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// proxyForPath returns the proxy the config file at p belongs to, or an empty
+// string if it isn't a supported config.
+//
+// Besides the main configs, e.g. haproxy.cfg, files with the proxy's config
+// format in its config directories are considered, e.g. the conf.d snippets
+// of HAProxy, the xDS resource files of Envoy and the dynamic configs of
+// Traefik's file provider.
+func proxyForPath(p string) string {
+	p = strings.ToLower(filepath.ToSlash(p))
+	base := path.Base(p)
+	ext := path.Ext(base)
+	dir := path.Dir(p)
+	switch {
+	case base == "haproxy.cfg" || (ext == ".cfg" && hasDirWithPrefix(dir, "haproxy")):
+		return ProxyHAProxy
+	case slices.Contains([]string{"envoy.yaml", "envoy.yml", "envoy.json"}, base):
+		return ProxyEnvoy
+	case slices.Contains([]string{"traefik.yaml", "traefik.yml", "traefik.toml"}, base):
+		return ProxyTraefik
+	case (ext == ".yaml" || ext == ".yml" || ext == ".json") && hasDirWithPrefix(dir, "envoy"):
+		return ProxyEnvoy
+	case (ext == ".yaml" || ext == ".yml" || ext == ".toml") && hasDirWithPrefix(dir, "traefik"):
+		return ProxyTraefik
+	}
+	return ""
+}
+
+// hasDirWithPrefix returns true if any of the directories in dir start with
+// prefix, e.g. "etc/haproxy/conf.d" for "haproxy".
+func hasDirWithPrefix(dir, prefix string) bool {
+	for _, d := range strings.Split(dir, "/") {
+		if strings.HasPrefix(d, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the proxy config passed through the scan input and returns
+// an Inventory describing its routes and credentials.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	proxy := proxyForPath(input.Path)
+	var m *Metadata
+	var err error
+	switch proxy {
+	case ProxyHAProxy:
+		m, err = parseHAProxy(ctx, input.Reader)
+	case ProxyEnvoy:
+		m, err = parseEnvoy(input.Reader)
+	case ProxyTraefik:
+		m, err = parseTraefik(input.Reader, path.Ext(filepath.ToSlash(input.Path)) == ".toml")
+	default:
+		err = fmt.Errorf("unsupported config file %s", input.Path)
+	}
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if len(m.Routes) == 0 && len(m.Credentials) == 0 {
+		return []*extractor.Inventory{}, nil
+	}
+	m.Proxy = proxy
+	return []*extractor.Inventory{{
+		Name:      proxy,
+		Locations: []string{input.Path},
+		Metadata:  m,
+	}}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not support proxy configs.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,184 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edgeproxy
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// parseEnvoy parses the listeners, routes and clusters of an Envoy bootstrap
+// config or xDS resource file. JSON configs are parsed as YAML.
+func parseEnvoy(r io.Reader) (*Metadata, error) {
+	var doc any
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &Metadata{}, nil
+		}
+		return nil, err
+	}
+
+	// Resources by type: from the static resources of a bootstrap config or
+	// from the resources of a file-based xDS config.
+	var listeners, clusters, routeConfigs []any
+	listeners = append(listeners, list(get(doc, "static_resources", "listeners"))...)
+	clusters = append(clusters, list(get(doc, "static_resources", "clusters"))...)
+	for _, res := range list(get(doc, "resources")) {
+		switch t := str(get(res, "@type")); {
+		case strings.HasSuffix(t, ".Listener"):
+			listeners = append(listeners, res)
+		case strings.HasSuffix(t, ".Cluster"):
+			clusters = append(clusters, res)
+		case strings.HasSuffix(t, ".RouteConfiguration"):
+			routeConfigs = append(routeConfigs, res)
+		}
+	}
+
+	endpoints := map[string][]string{}
+	for _, c := range clusters {
+		name := str(get(c, "name"))
+		for _, e := range list(get(c, "load_assignment", "endpoints")) {
+			for _, lb := range list(get(e, "lb_endpoints")) {
+				if addr := socketAddress(get(lb, "endpoint", "address")); addr != "" {
+					endpoints[name] = append(endpoints[name], addr)
+				}
+			}
+		}
+	}
+
+	m := &Metadata{}
+	for _, l := range listeners {
+		addr := socketAddress(get(l, "address"))
+		for _, fc := range list(get(l, "filter_chains")) {
+			tls := get(fc, "transport_socket") != nil
+			for _, f := range list(get(fc, "filters")) {
+				for _, vh := range list(get(f, "typed_config", "route_config", "virtual_hosts")) {
+					route := envoyRoute(vh, endpoints)
+					if addr != "" {
+						route.Listen = []string{addr}
+					}
+					route.TLS = tls
+					m.Routes = append(m.Routes, route)
+				}
+			}
+		}
+	}
+	for _, rc := range routeConfigs {
+		for _, vh := range list(get(rc, "virtual_hosts")) {
+			m.Routes = append(m.Routes, envoyRoute(vh, endpoints))
+		}
+	}
+
+	walkEnvoyCredentials(doc, "", m)
+	return m, nil
+}
+
+// envoyRoute returns the Route of a virtual host.
+func envoyRoute(vh any, endpoints map[string][]string) *Route {
+	route := &Route{Name: str(get(vh, "name"))}
+	var domains []string
+	for _, d := range list(get(vh, "domains")) {
+		domains = append(domains, str(d))
+	}
+	route.Match = strings.Join(domains, ",")
+
+	var clusters []string
+	for _, rt := range list(get(vh, "routes")) {
+		if c := str(get(rt, "route", "cluster")); c != "" {
+			clusters = append(clusters, c)
+		}
+		for _, wc := range list(get(rt, "route", "weighted_clusters", "clusters")) {
+			clusters = append(clusters, str(get(wc, "name")))
+		}
+	}
+	for _, c := range clusters {
+		if addrs, ok := endpoints[c]; ok {
+			route.Upstreams = append(route.Upstreams, addrs...)
+		} else {
+			route.Upstreams = append(route.Upstreams, c)
+		}
+	}
+	route.Upstreams = dedup(route.Upstreams)
+	return route
+}
+
+// socketAddress returns the host:port of an Envoy address.
+func socketAddress(addr any) string {
+	host := str(get(addr, "socket_address", "address"))
+	if host == "" {
+		return ""
+	}
+	port := str(get(addr, "socket_address", "port_value"))
+	if port == "" {
+		return host
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// walkEnvoyCredentials adds the inline private keys and Authorization headers
+// found anywhere in v to m. name is the name of the closest enclosing
+// resource, e.g. the cluster or SDS secret.
+func walkEnvoyCredentials(v any, name string, m *Metadata) {
+	switch t := v.(type) {
+	case map[string]any:
+		// Names of extensions, e.g. "envoy.transport_sockets.tls", don't
+		// identify the resource.
+		if n := str(t["name"]); n != "" && !strings.HasPrefix(n, "envoy.") {
+			name = n
+		}
+		if key := inlineData(t["private_key"]); key != "" {
+			m.Credentials = append(m.Credentials, &Credential{
+				Type: CredentialTLSPrivateKey, Context: name, Secret: key,
+			})
+		}
+		if strings.EqualFold(str(t["key"]), "authorization") {
+			if value := str(t["value"]); !isReference(value) {
+				m.Credentials = append(m.Credentials, &Credential{
+					Type: CredentialAuthHeader, Context: name, Secret: value,
+				})
+			}
+		}
+		// Keys are sorted to report the credentials in a deterministic order.
+		for _, k := range sortedKeys(t) {
+			if k != "private_key" {
+				walkEnvoyCredentials(t[k], name, m)
+			}
+		}
+	case []any:
+		for _, child := range t {
+			walkEnvoyCredentials(child, name, m)
+		}
+	}
+}
+
+// inlineData returns the contents of an Envoy data source that's stored in
+// the config itself. Data sources that refer to files or environment
+// variables are ignored.
+func inlineData(v any) string {
+	if s := str(get(v, "inline_string")); s != "" {
+		return strings.TrimSpace(s)
+	}
+	if b := str(get(v, "inline_bytes")); b != "" {
+		if decoded, err := base64.StdEncoding.DecodeString(b); err == nil {
+			return strings.TrimSpace(string(decoded))
+		}
+	}
+	return ""
+}
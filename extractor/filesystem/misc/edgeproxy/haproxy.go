@@ -0,0 +1,190 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edgeproxy
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+)
+
+// parseHAProxy parses the frontends, backends and user lists of an HAProxy
+// config.
+func parseHAProxy(ctx context.Context, r io.Reader) (*Metadata, error) {
+	m := &Metadata{}
+	var section, name string
+	var route *Route
+	// Backends referenced by each route and the servers of each backend.
+	backendRefs := map[*Route][]string{}
+	backends := map[string][]string{}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		args := splitHAProxyArgs(s.Text())
+		if len(args) == 0 {
+			continue
+		}
+		switch args[0] {
+		case "global", "defaults", "frontend", "backend", "listen", "userlist",
+			"peers", "resolvers", "mailers", "program", "http-errors", "cache", "ring":
+			section, name, route = args[0], "", nil
+			if len(args) > 1 {
+				name = args[1]
+			}
+			if section == "frontend" || section == "listen" {
+				route = &Route{Name: name}
+				m.Routes = append(m.Routes, route)
+				// listen sections can have the address in the header.
+				if len(args) > 2 {
+					route.Listen = append(route.Listen, args[2])
+				}
+			}
+			continue
+		}
+
+		switch {
+		case args[0] == "bind" && route != nil && len(args) > 1:
+			route.Listen = append(route.Listen, args[1])
+			for _, a := range args[2:] {
+				if a == "ssl" {
+					route.TLS = true
+				}
+			}
+		case (args[0] == "default_backend" || args[0] == "use_backend") && route != nil && len(args) > 1:
+			backendRefs[route] = append(backendRefs[route], args[1])
+		case args[0] == "server" && len(args) > 2:
+			if section == "backend" {
+				backends[name] = append(backends[name], args[2])
+			} else if route != nil {
+				route.Upstreams = append(route.Upstreams, args[2])
+			}
+		case args[0] == "user" && section == "userlist" && len(args) > 3:
+			switch args[2] {
+			case "password":
+				m.Credentials = append(m.Credentials, &Credential{
+					Type: CredentialPasswordHash, Context: name, Principal: args[1], Secret: args[3],
+				})
+			case "insecure-password":
+				if !isReference(args[3]) {
+					m.Credentials = append(m.Credentials, &Credential{
+						Type: CredentialPassword, Context: name, Principal: args[1], Secret: args[3],
+					})
+				}
+			}
+		case args[0] == "stats" && len(args) > 2 && args[1] == "auth":
+			user, password, ok := strings.Cut(args[2], ":")
+			if ok && !isReference(password) {
+				m.Credentials = append(m.Credentials, &Credential{
+					Type: CredentialPassword, Context: sectionContext(section, name), Principal: user, Secret: password,
+				})
+			}
+		case args[0] == "http-request" && len(args) > 3 && (args[1] == "set-header" || args[1] == "add-header") &&
+			strings.EqualFold(args[2], "authorization"):
+			if !isReference(args[3]) {
+				m.Credentials = append(m.Credentials, &Credential{
+					Type: CredentialAuthHeader, Context: sectionContext(section, name), Secret: args[3],
+				})
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, route := range m.Routes {
+		for _, b := range backendRefs[route] {
+			if servers, ok := backends[b]; ok {
+				route.Upstreams = append(route.Upstreams, servers...)
+			} else {
+				route.Upstreams = append(route.Upstreams, b)
+			}
+		}
+		route.Upstreams = dedup(route.Upstreams)
+	}
+	return m, nil
+}
+
+// sectionContext returns the context of credentials in an HAProxy section,
+// e.g. "frontend www".
+func sectionContext(section, name string) string {
+	if name == "" {
+		return section
+	}
+	return section + " " + name
+}
+
+// splitHAProxyArgs splits an HAProxy config line into its arguments.
+// Arguments can be quoted and comments start with an unquoted "#".
+func splitHAProxyArgs(line string) []string {
+	var args []string
+	var cur strings.Builder
+	inArg := false
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			cur.WriteByte(c)
+		case c == '"' || c == '\'':
+			quote = c
+			inArg = true
+		case c == '\\' && i+1 < len(line):
+			i++
+			cur.WriteByte(line[i])
+			inArg = true
+		case c == '#':
+			i = len(line)
+		case c == ' ' || c == '\t':
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		default:
+			cur.WriteByte(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	return args
+}
+
+// isReference returns true if v refers to a secret stored elsewhere, e.g. an
+// environment variable, instead of being the secret itself.
+func isReference(v string) bool {
+	return v == "" || strings.HasPrefix(v, "$") || strings.Contains(v, "{{")
+}
+
+// dedup removes duplicate values from s and keeps the order of the first
+// occurrences.
+func dedup(s []string) []string {
+	seen := map[string]bool{}
+	var res []string
+	for _, v := range s {
+		if !seen[v] {
+			seen[v] = true
+			res = append(res, v)
+		}
+	}
+	return res
+}
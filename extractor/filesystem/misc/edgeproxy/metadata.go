@@ -0,0 +1,71 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edgeproxy
+
+// Metadata holds the routes and credentials of a proxy config file.
+type Metadata struct {
+	// The proxy the config belongs to, e.g. "haproxy".
+	Proxy       string
+	Routes      []*Route
+	Credentials []*Credential
+}
+
+// Route is traffic the proxy accepts and forwards to upstream servers: an
+// HAProxy frontend, an Envoy virtual host or a Traefik router.
+type Route struct {
+	// Name of the frontend, virtual host or router.
+	Name string
+	// Addresses or entry points the route listens on, e.g. "*:443" or
+	// "websecure".
+	Listen []string
+	// The hosts or requests the route matches, e.g. the Traefik rule
+	// "Host(`app.example.com`)" or the domains of an Envoy virtual host.
+	Match string
+	// Upstream servers the traffic is forwarded to. Names of backends,
+	// clusters or services are listed if their servers aren't configured in
+	// the same file.
+	Upstreams []string
+	// Whether the route terminates TLS.
+	TLS bool
+}
+
+// CredentialType is the kind of a credential stored in a proxy config.
+type CredentialType string
+
+// CredentialType values.
+const (
+	// CredentialPassword is a plaintext password, e.g. an HAProxy
+	// insecure-password or stats auth.
+	CredentialPassword CredentialType = "password"
+	// CredentialPasswordHash is a password hash of a basic auth user list,
+	// which can be cracked offline.
+	CredentialPasswordHash CredentialType = "password-hash"
+	// CredentialTLSPrivateKey is a PEM encoded private key stored inline in
+	// the config.
+	CredentialTLSPrivateKey CredentialType = "tls-private-key"
+	// CredentialAuthHeader is the value of an Authorization header that the
+	// proxy adds to upstream requests.
+	CredentialAuthHeader CredentialType = "auth-header"
+)
+
+// Credential is a secret stored in a proxy config.
+type Credential struct {
+	Type CredentialType
+	// The section, cluster or middleware the credential is configured in.
+	Context string
+	// The user the credential belongs to, if any.
+	Principal string
+	Secret    string
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edgeproxy
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// parseTraefik parses the routers, services, middlewares and TLS certificates
+// of a Traefik static or dynamic config in YAML or TOML.
+func parseTraefik(r io.Reader, isTOML bool) (*Metadata, error) {
+	var doc map[string]any
+	if isTOML {
+		if _, err := toml.NewDecoder(r).Decode(&doc); err != nil {
+			return nil, err
+		}
+	} else if err := yaml.NewDecoder(r).Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	m := &Metadata{}
+	for _, protocol := range []string{"http", "tcp", "udp"} {
+		servers := traefikServers(get(doc, protocol, "services"))
+		for _, name := range sortedKeys(get(doc, protocol, "routers")) {
+			router := get(doc, protocol, "routers", name)
+			route := &Route{
+				Name:  name,
+				Match: str(get(router, "rule")),
+				TLS:   get(router, "tls") != nil,
+			}
+			for _, ep := range list(get(router, "entryPoints")) {
+				route.Listen = append(route.Listen, str(ep))
+			}
+			if svc := str(get(router, "service")); svc != "" {
+				// Services of the file provider can be referenced as name@file.
+				if addrs, ok := servers[strings.TrimSuffix(svc, "@file")]; ok {
+					route.Upstreams = addrs
+				} else {
+					route.Upstreams = []string{svc}
+				}
+			}
+			m.Routes = append(m.Routes, route)
+		}
+	}
+
+	for _, name := range sortedKeys(get(doc, "http", "middlewares")) {
+		mw := get(doc, "http", "middlewares", name)
+		for _, auth := range []string{"basicAuth", "digestAuth"} {
+			for _, u := range list(get(mw, auth, "users")) {
+				// basicAuth users are "user:hash", digestAuth users are
+				// "user:realm:hash".
+				parts := strings.Split(str(u), ":")
+				if len(parts) < 2 {
+					continue
+				}
+				m.Credentials = append(m.Credentials, &Credential{
+					Type:      CredentialPasswordHash,
+					Context:   name,
+					Principal: parts[0],
+					Secret:    parts[len(parts)-1],
+				})
+			}
+		}
+		headers := get(mw, "headers", "customRequestHeaders")
+		for _, h := range sortedKeys(headers) {
+			if !strings.EqualFold(h, "authorization") {
+				continue
+			}
+			if value := str(get(headers, h)); !isReference(value) {
+				m.Credentials = append(m.Credentials, &Credential{
+					Type: CredentialAuthHeader, Context: name, Secret: value,
+				})
+			}
+		}
+	}
+
+	// certFile and keyFile can hold the PEM contents instead of a path.
+	for _, cert := range list(get(doc, "tls", "certificates")) {
+		addTraefikKey(m, "tls.certificates", get(cert, "keyFile"))
+	}
+	for _, store := range sortedKeys(get(doc, "tls", "stores")) {
+		addTraefikKey(m, "tls.stores."+store, get(doc, "tls", "stores", store, "defaultCertificate", "keyFile"))
+	}
+	return m, nil
+}
+
+// traefikServers returns the server URLs or addresses of each load balancer
+// service.
+func traefikServers(services any) map[string][]string {
+	res := map[string][]string{}
+	for _, name := range sortedKeys(services) {
+		for _, s := range list(get(services, name, "loadBalancer", "servers")) {
+			addr := str(get(s, "url"))
+			if addr == "" {
+				addr = str(get(s, "address"))
+			}
+			if addr != "" {
+				res[name] = append(res[name], addr)
+			}
+		}
+	}
+	return res
+}
+
+// addTraefikKey adds keyFile to m if it's an inline private key.
+func addTraefikKey(m *Metadata, context string, keyFile any) {
+	key := strings.TrimSpace(str(keyFile))
+	if !strings.HasPrefix(key, "-----BEGIN") {
+		return
+	}
+	m.Credentials = append(m.Credentials, &Credential{
+		Type: CredentialTLSPrivateKey, Context: context, Secret: key,
+	})
+}
+
+// sortedKeys returns the keys of v in sorted order if it's a map.
+func sortedKeys(v any) []string {
+	t, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	keys := make([]string, 0, len(t))
+	for k := range t {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edgeproxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// get returns the value at the path of keys in nested maps of a decoded YAML,
+// JSON or TOML document, or nil if it doesn't exist. Keys are matched
+// case-insensitively since Traefik doesn't distinguish case either.
+func get(v any, keys ...string) any {
+	for _, k := range keys {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		next, ok := m[k]
+		if !ok {
+			for mk, mv := range m {
+				if strings.EqualFold(mk, k) {
+					next, ok = mv, true
+					break
+				}
+			}
+		}
+		if !ok {
+			return nil
+		}
+		v = next
+	}
+	return v
+}
+
+// list returns v as a list. TOML arrays of tables are decoded as
+// []map[string]any, which is converted.
+func list(v any) []any {
+	switch l := v.(type) {
+	case []any:
+		return l
+	case []map[string]any:
+		res := make([]any, 0, len(l))
+		for _, e := range l {
+			res = append(res, e)
+		}
+		return res
+	}
+	return nil
+}
+
+// str returns the string value of a scalar, or an empty string for maps and
+// lists.
+func str(v any) string {
+	switch s := v.(type) {
+	case nil, map[string]any, []any:
+		return ""
+	case string:
+		return s
+	default:
+		return fmt.Sprint(s)
+	}
+}
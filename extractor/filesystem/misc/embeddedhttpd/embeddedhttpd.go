@@ -0,0 +1,223 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package embeddedhttpd extracts the embedded web servers of appliance
+// firmware, e.g. the admin interfaces of printers, scanners, routers and
+// cameras: lighttpd, GoAhead, mini_httpd and the httpd applet of BusyBox. The
+// servers are identified by the version strings in their binaries.
+package embeddedhttpd
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/embeddedhttpd"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 50 * units.MiB
+)
+
+// Supported web servers.
+const (
+	ServerLighttpd  = "lighttpd"
+	ServerGoAhead   = "goahead"
+	ServerMiniHTTPD = "mini_httpd"
+	ServerBusyBox   = "busybox"
+)
+
+// binaryServers are the servers a binary can be, by its file name. A binary
+// named httpd can be any of them, e.g. a renamed GoAhead or a link to BusyBox.
+var binaryServers = map[string][]string{
+	"lighttpd":   {ServerLighttpd},
+	"goahead":    {ServerGoAhead},
+	"webs":       {ServerGoAhead},
+	"mini_httpd": {ServerMiniHTTPD},
+	"busybox":    {ServerBusyBox},
+	"httpd":      nil,
+}
+
+// binDirs are the directories the binaries are installed in, relative to the
+// root of the firmware.
+var binDirs = []string{"usr/local/sbin", "usr/local/bin", "usr/sbin", "usr/bin", "sbin", "bin"}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the embedded web server
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts embedded web servers from their binaries.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an embedded web server extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is a web server binary in a
+// bin or sbin directory.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if _, ok := binaryServers[filepath.Base(path)]; !ok {
+		return false
+	}
+	if firmwareRoot(filepath.ToSlash(path)) == "" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil || !fileinfo.Mode().IsRegular() {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// firmwareRoot returns the root of the firmware filesystem the binary at p was
+// installed in, "." if it's the scan root, or "" if p isn't in a bin
+// directory.
+func firmwareRoot(p string) string {
+	dir := path.Dir(p)
+	for _, b := range binDirs {
+		if dir == b {
+			return "."
+		}
+		if root, ok := strings.CutSuffix(dir, "/"+b); ok {
+			return root
+		}
+	}
+	return ""
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract extracts the web server from the binary passed through the scan
+// input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	m, err := findServer(input.Reader, binaryServers[filepath.Base(input.Path)])
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("%s findServer(%s): %w", e.Name(), input.Path, err)
+	}
+	if m == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{{
+		Name:    m.server,
+		Version: m.version,
+		Metadata: &Metadata{
+			Server:       m.server,
+			FirmwareRoot: filepath.FromSlash(firmwareRoot(filepath.ToSlash(input.Path))),
+		},
+		Locations: []string{input.Path},
+	}}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV doesn't track vulnerabilities of
+// embedded web servers.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,250 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedhttpd_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/embeddedhttpd"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "lighttpd in firmware root",
+			path:             "firmware/squashfs-root/usr/sbin/lighttpd",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "busybox in scan root",
+			path:             "bin/busybox",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "httpd",
+			path:             "usr/sbin/httpd",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "web server outside of bin directory",
+			path:         "usr/share/doc/lighttpd",
+			wantRequired: false,
+		},
+		{
+			name:         "other binary",
+			path:         "usr/sbin/nginx",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "usr/sbin/lighttpd",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "usr/sbin/lighttpd",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := embeddedhttpd.New(embeddedhttpd.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "lighttpd",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/printer/rootfs/usr/sbin/lighttpd",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "lighttpd",
+				Version:   "1.4.59",
+				Locations: []string{"testdata/printer/rootfs/usr/sbin/lighttpd"},
+				Metadata: &embeddedhttpd.Metadata{
+					Server:       embeddedhttpd.ServerLighttpd,
+					FirmwareRoot: filepath.FromSlash("testdata/printer/rootfs"),
+				},
+			}},
+		},
+		{
+			Name: "busybox with httpd applet",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/printer/rootfs/bin/busybox",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "busybox",
+				Version:   "1.31.1",
+				Locations: []string{"testdata/printer/rootfs/bin/busybox"},
+				Metadata: &embeddedhttpd.Metadata{
+					Server:       embeddedhttpd.ServerBusyBox,
+					FirmwareRoot: filepath.FromSlash("testdata/printer/rootfs"),
+				},
+			}},
+		},
+		{
+			Name: "busybox without httpd applet",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/host/bin/busybox",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "goahead",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/camera/bin/goahead",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "goahead",
+				Version:   "3.6.5",
+				Locations: []string{"testdata/camera/bin/goahead"},
+				Metadata: &embeddedhttpd.Metadata{
+					Server:       embeddedhttpd.ServerGoAhead,
+					FirmwareRoot: filepath.FromSlash("testdata/camera"),
+				},
+			}},
+		},
+		{
+			Name: "goahead 2 renamed to httpd",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/router/usr/sbin/httpd",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "goahead",
+				Locations: []string{"testdata/router/usr/sbin/httpd"},
+				Metadata: &embeddedhttpd.Metadata{
+					Server:       embeddedhttpd.ServerGoAhead,
+					FirmwareRoot: filepath.FromSlash("testdata/router"),
+				},
+			}},
+		},
+		{
+			Name: "mini_httpd",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/nas/usr/sbin/mini_httpd",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "mini_httpd",
+				Version:   "1.30",
+				Locations: []string{"testdata/nas/usr/sbin/mini_httpd"},
+				Metadata: &embeddedhttpd.Metadata{
+					Server:       embeddedhttpd.ServerMiniHTTPD,
+					FirmwareRoot: filepath.FromSlash("testdata/nas"),
+				},
+			}},
+		},
+		{
+			Name: "apache httpd",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/host/usr/sbin/httpd",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := embeddedhttpd.New(embeddedhttpd.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := embeddedhttpd.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "lighttpd",
+		Version:   "1.4.59",
+		Locations: []string{"usr/sbin/lighttpd"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "lighttpd",
+		Version: "1.4.59",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedhttpd
+
+// Metadata holds information about an embedded web server binary.
+type Metadata struct {
+	// The web server: "lighttpd", "goahead", "mini_httpd" or "busybox".
+	Server string
+	// The directory the firmware's root filesystem is extracted to, i.e. the
+	// parent of the bin or sbin directory the binary was found in. Default
+	// credential files are looked up relative to it.
+	FirmwareRoot string
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embeddedhttpd
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"slices"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+)
+
+const (
+	// chunkBytes is the size of the chunks a binary is searched in.
+	chunkBytes = 1 * units.MiB
+	// maxSignatureBytes is the maximum length of a signature. The end of each
+	// chunk is searched again together with the next one.
+	maxSignatureBytes = 64
+)
+
+// signature identifies a web server in a binary.
+type signature struct {
+	server string
+	// version matches the version string the server embeds, e.g. in its Server
+	// header. The first group is the version.
+	version *regexp.Regexp
+	// markers identify builds of the server without a version string.
+	markers [][]byte
+	// requires has to be in the binary as well, e.g. the config file of the
+	// httpd applet of BusyBox, which is left out of many builds.
+	requires []byte
+}
+
+var signatures = []*signature{
+	{
+		server:  ServerLighttpd,
+		version: regexp.MustCompile(`lighttpd/(\d+\.\d+\.\d+)`),
+	},
+	{
+		// GoAhead 3 and later embed "GoAhead/<version>", GoAhead 2 only its
+		// Server header "GoAhead-Webs".
+		server:  ServerGoAhead,
+		version: regexp.MustCompile(`GoAhead/(\d+\.\d+\.\d+)`),
+		markers: [][]byte{[]byte("GoAhead-Webs"), []byte("GoAhead-http")},
+	},
+	{
+		server:  ServerMiniHTTPD,
+		version: regexp.MustCompile(`mini_httpd/(\d+\.\d+[a-z]?)`),
+	},
+	{
+		server:   ServerBusyBox,
+		version:  regexp.MustCompile(`BusyBox v(\d+\.\d+\.\d+)`),
+		requires: []byte("/etc/httpd.conf"),
+	},
+}
+
+// match is a server identified in a binary.
+type match struct {
+	server  string
+	version string
+}
+
+// sigState tracks what was found of a signature so far.
+type sigState struct {
+	sig      *signature
+	version  string
+	found    bool
+	required bool
+}
+
+// findServer searches the binary r for the signatures of the given servers,
+// or of all servers if none are given, and returns the first one that
+// matched. r is searched in chunks so that large binaries aren't read into
+// memory at once.
+func findServer(r io.Reader, servers []string) (*match, error) {
+	var states []*sigState
+	for _, s := range signatures {
+		if len(servers) == 0 || slices.Contains(servers, s.server) {
+			states = append(states, &sigState{sig: s, required: s.requires == nil})
+		}
+	}
+
+	chunk := make([]byte, chunkBytes)
+	var buf []byte
+	for {
+		n, err := io.ReadFull(r, chunk)
+		if n > 0 {
+			buf = append(buf, chunk[:n]...)
+			for _, s := range states {
+				s.update(buf)
+			}
+			// Keep the end of the chunk in case a signature starts there.
+			if len(buf) > maxSignatureBytes {
+				buf = append(buf[:0], buf[len(buf)-maxSignatureBytes:]...)
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range states {
+		if s.found && s.required {
+			return &match{server: s.sig.server, version: s.version}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *sigState) update(buf []byte) {
+	if s.version == "" {
+		if m := s.sig.version.FindSubmatch(buf); m != nil {
+			s.version = string(m[1])
+			s.found = true
+		}
+	}
+	for _, m := range s.sig.markers {
+		if !s.found && bytes.Contains(buf, m) {
+			s.found = true
+		}
+	}
+	if !s.required && bytes.Contains(buf, s.sig.requires) {
+		s.required = true
+	}
+}
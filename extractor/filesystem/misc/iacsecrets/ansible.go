@@ -0,0 +1,42 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacsecrets
+
+import (
+	"bytes"
+
+	"gopkg.in/yaml.v3"
+)
+
+// parseAnsibleVars returns the secrets stored in plaintext in an Ansible
+// variables file. Values encrypted with Ansible Vault, either inline with the
+// !vault tag or by encrypting the whole file, are skipped.
+func parseAnsibleVars(data []byte) ([]*Secret, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("$ANSIBLE_VAULT;")) {
+		return nil, nil
+	}
+	doc, err := parseYAML(data)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+	var secrets []*Secret
+	walkYAML(doc, "", func(key string, n *yaml.Node) {
+		if n.Tag != "!!str" || !isSecretKey(key) || !isPlaintextValue(n.Value) {
+			return
+		}
+		secrets = append(secrets, &Secret{Type: SecretPlaintextPassword, Key: key, Value: n.Value})
+	})
+	return secrets, nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacsecrets
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+// dataBagSecretFiles are the usual locations of the Chef encrypted data bag
+// secret, relative to the repository root.
+var dataBagSecretFiles = []string{
+	"encrypted_data_bag_secret",
+	".chef/encrypted_data_bag_secret",
+	"secrets/encrypted_data_bag_secret",
+}
+
+// encryptedDataBagValue is an encrypted value of a Chef data bag item. Version
+// 1 and 2 use AES-256-CBC, version 3 uses AES-256-GCM.
+type encryptedDataBagValue struct {
+	EncryptedData string `json:"encrypted_data"`
+	IV            string `json:"iv"`
+	AuthTag       string `json:"auth_tag"`
+	Version       int    `json:"version"`
+	Cipher        string `json:"cipher"`
+}
+
+// dataBagValue is a secret value of a Chef data bag item.
+type dataBagValue struct {
+	key string
+	// Set for encrypted values.
+	encrypted *encryptedDataBagValue
+	// Set for secrets stored in plaintext.
+	plaintext string
+}
+
+// parseDataBagItem returns the encrypted values and the plaintext secrets of a
+// data bag item.
+func parseDataBagItem(data []byte) ([]*dataBagValue, error) {
+	var item map[string]json.RawMessage
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(item))
+	for k := range item {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var values []*dataBagValue
+	for _, k := range keys {
+		if k == "id" {
+			continue
+		}
+		enc := &encryptedDataBagValue{}
+		if err := json.Unmarshal(item[k], enc); err == nil && enc.EncryptedData != "" {
+			values = append(values, &dataBagValue{key: k, encrypted: enc})
+			continue
+		}
+		var v any
+		if err := json.Unmarshal(item[k], &v); err != nil {
+			return nil, err
+		}
+		walkJSON(v, k, func(key, s string) {
+			if isSecretKey(key) && isPlaintextValue(s) {
+				values = append(values, &dataBagValue{key: key, plaintext: s})
+			}
+		})
+	}
+	return values, nil
+}
+
+// walkJSON calls fn for each string value in v with the path of keys leading
+// to it, joined with dots.
+func walkJSON(v any, key string, fn func(key, s string)) {
+	switch v := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			walkJSON(v[k], joinKey(key, k), fn)
+		}
+	case []any:
+		for _, c := range v {
+			walkJSON(c, key, fn)
+		}
+	case string:
+		fn(key, v)
+	}
+}
+
+// dataBagSecrets returns the secrets of the data bag item and the path of the
+// co-located data bag secret that decrypts them, if any.
+func dataBagSecrets(fsys scalibrfs.FS, dir string, values []*dataBagValue) ([]*Secret, string) {
+	var secrets []*Secret
+	var encrypted []int
+	for _, v := range values {
+		if v.encrypted == nil {
+			secrets = append(secrets, &Secret{Type: SecretPlaintextPassword, Key: v.key, Value: v.plaintext})
+			continue
+		}
+		encrypted = append(encrypted, len(secrets))
+		secrets = append(secrets, &Secret{Type: SecretEncryptedDataBag, Key: v.key})
+	}
+	if len(encrypted) == 0 {
+		return secrets, ""
+	}
+
+	paths, contents := findKeyFiles(fsys, dir, dataBagSecretFiles)
+	for _, p := range paths {
+		decryptable := false
+		for _, i := range encrypted {
+			if dataBagDecryptable(values[i].encrypted, contents[p]) {
+				secrets[i].Decryptable = true
+				decryptable = true
+			}
+		}
+		if decryptable {
+			return secrets, p
+		}
+	}
+	return secrets, ""
+}
+
+// dataBagDecryptable returns true if the data bag secret decrypts the value.
+// Chef derives the AES key from the SHA-256 hash of the secret and wraps the
+// plaintext in a JSON object with a "json_wrapper" key.
+func dataBagDecryptable(v *encryptedDataBagValue, secret []byte) bool {
+	key := sha256.Sum256(bytes.TrimSpace(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return false
+	}
+	ciphertext, err := decodeBase64(v.EncryptedData)
+	if err != nil {
+		return false
+	}
+	iv, err := decodeBase64(v.IV)
+	if err != nil {
+		return false
+	}
+
+	var plaintext []byte
+	if v.Version >= 3 || v.Cipher == "aes-256-gcm" {
+		tag, err := decodeBase64(v.AuthTag)
+		if err != nil || len(iv) == 0 {
+			return false
+		}
+		gcm, err := cipher.NewGCMWithNonceSize(block, len(iv))
+		if err != nil {
+			return false
+		}
+		if plaintext, err = gcm.Open(nil, iv, append(ciphertext, tag...), nil); err != nil {
+			return false
+		}
+	} else {
+		if len(iv) != block.BlockSize() || len(ciphertext) == 0 || len(ciphertext)%block.BlockSize() != 0 {
+			return false
+		}
+		plaintext = make([]byte, len(ciphertext))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+		if !validPadding(plaintext, block.BlockSize()) {
+			return false
+		}
+		plaintext = plaintext[:len(plaintext)-int(plaintext[len(plaintext)-1])]
+	}
+	var wrapper map[string]json.RawMessage
+	if err := json.Unmarshal(plaintext, &wrapper); err != nil {
+		return false
+	}
+	_, ok := wrapper["json_wrapper"]
+	return ok
+}
+
+// decodeBase64 decodes base64 that may be wrapped over multiple lines.
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
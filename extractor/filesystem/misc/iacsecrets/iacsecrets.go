@@ -0,0 +1,304 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package iacsecrets extracts secrets from the configuration management files
+// of infrastructure-as-code repositories: hiera-eyaml encrypted Puppet
+// hieradata, Chef data bags and Ansible inventory variables. Encrypted secrets
+// are flagged as decryptable if the key that decrypts them is stored in the
+// same repository.
+package iacsecrets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/iacsecrets"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+
+	// maxKeyFileSizeBytes is the maximum size of a decryption key file.
+	maxKeyFileSizeBytes = 64 * units.KiB
+)
+
+// Supported configuration management tools.
+const (
+	ToolPuppet  = "puppet"
+	ToolChef    = "chef"
+	ToolAnsible = "ansible"
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// Puppet hieradata, e.g. hieradata/common.yaml or data/common.eyaml.
+	kindHieradata
+	// A Chef data bag item, e.g. data_bags/users/admin.json.
+	kindDataBag
+	// Ansible inventory variables, e.g. group_vars/all.yml or
+	// host_vars/web1/vars.yml.
+	kindAnsibleVars
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the infrastructure-as-code
+// secrets extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts secrets from configuration management files.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an infrastructure-as-code secrets extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is Puppet hieradata, a Chef
+// data bag item or an Ansible variables file.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// kindForPath returns the kind of the file at p.
+func kindForPath(p string) fileKind {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	ext := path.Ext(base)
+	dirs := strings.Split(path.Dir(p), "/")
+	parent := dirs[len(dirs)-1]
+	grandparent := ""
+	if len(dirs) > 1 {
+		grandparent = dirs[len(dirs)-2]
+	}
+	isYAML := ext == ".yaml" || ext == ".yml"
+	switch {
+	case ext == ".eyaml":
+		return kindHieradata
+	case isYAML && slices.Contains(dirs, "hieradata"):
+		return kindHieradata
+	case ext == ".json" && grandparent == "data_bags":
+		return kindDataBag
+	case (isYAML || ext == "") && !strings.HasPrefix(base, "."):
+		// Variables can be stored in group_vars/<group> or in any file in the
+		// group_vars/<group>/ directory.
+		if isAnsibleVarsDir(parent) || isAnsibleVarsDir(grandparent) {
+			return kindAnsibleVars
+		}
+	}
+	return kindNone
+}
+
+func isAnsibleVarsDir(d string) bool {
+	return d == "group_vars" || d == "host_vars"
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// secrets stored in it.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	data, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	dir := path.Dir(filepath.ToSlash(input.Path))
+
+	m := &Metadata{}
+	switch kindForPath(input.Path) {
+	case kindHieradata:
+		values, err := parseHieradata(data)
+		if err != nil {
+			return nil, err
+		}
+		m.Tool = ToolPuppet
+		m.Secrets, m.DecryptionKey = eyamlSecrets(input.FS, dir, values)
+	case kindDataBag:
+		item, err := parseDataBagItem(data)
+		if err != nil {
+			return nil, err
+		}
+		m.Tool = ToolChef
+		m.Secrets, m.DecryptionKey = dataBagSecrets(input.FS, dir, item)
+	case kindAnsibleVars:
+		secrets, err := parseAnsibleVars(data)
+		if err != nil {
+			return nil, err
+		}
+		m.Tool = ToolAnsible
+		m.Secrets = secrets
+	default:
+		return nil, fmt.Errorf("unsupported file %s", input.Path)
+	}
+	if len(m.Secrets) == 0 {
+		return nil, nil
+	}
+	return &extractor.Inventory{
+		Name:      m.Tool,
+		Locations: []string{input.Path},
+		Metadata:  m,
+	}, nil
+}
+
+// findKeyFiles returns the contents of the files at the given paths relative
+// to dir or any of its ancestors, nearest first, keyed by their path.
+func findKeyFiles(fsys scalibrfs.FS, dir string, names []string) (paths []string, contents map[string][]byte) {
+	if fsys == nil {
+		return nil, nil
+	}
+	contents = map[string][]byte{}
+	for {
+		for _, n := range names {
+			p := path.Join(dir, n)
+			info, err := fsys.Stat(p)
+			if err != nil || !info.Mode().IsRegular() || info.Size() > maxKeyFileSizeBytes {
+				continue
+			}
+			b, err := fs.ReadFile(fsys, p)
+			if err != nil {
+				continue
+			}
+			paths = append(paths, p)
+			contents[p] = b
+		}
+		if dir == "." || dir == "/" || dir == "" {
+			return paths, contents
+		}
+		dir = path.Dir(dir)
+	}
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: i.Name,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track configuration
+// management secrets.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,361 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacsecrets_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/iacsecrets"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "hieradata",
+			path:             "control-repo/hieradata/common.yaml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "nested hieradata",
+			path:             "hieradata/nodes/web1.example.com.yml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "eyaml file",
+			path:             "site/profile/data/common.eyaml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "yaml outside hieradata",
+			path:         "config/settings.yaml",
+			wantRequired: false,
+		},
+		{
+			name:             "chef data bag item",
+			path:             "chef-repo/data_bags/users/admin.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "json outside data bag",
+			path:         "chef-repo/data_bags/users.json",
+			wantRequired: false,
+		},
+		{
+			name:             "ansible group vars file",
+			path:             "inventory/group_vars/all.yml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "ansible group vars directory",
+			path:             "inventory/group_vars/db/vault.yml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "ansible host vars without extension",
+			path:             "host_vars/web1",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "hidden file in group vars",
+			path:         "group_vars/.gitkeep",
+			wantRequired: false,
+		},
+		{
+			name:         "ansible role vars",
+			path:         "roles/web/vars/main.yml",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "group_vars/all.yml",
+			fileSizeBytes:    10 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "group_vars/all.yml",
+			fileSizeBytes:    10 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := iacsecrets.New(iacsecrets.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "eyaml with co-located private key",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/puppet/hieradata/common.eyaml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "puppet",
+				Locations: []string{"testdata/puppet/hieradata/common.eyaml"},
+				Metadata: &iacsecrets.Metadata{
+					Tool: "puppet",
+					Secrets: []*iacsecrets.Secret{
+						{Type: iacsecrets.SecretEYAML, Key: "profile::db::password", Decryptable: true},
+						{Type: iacsecrets.SecretEYAML, Key: "profile::api.token", Decryptable: true},
+						{Type: iacsecrets.SecretEYAML, Key: "profile::backup::gpg_password"},
+					},
+					DecryptionKey: "testdata/puppet/keys/private_key.pkcs7.pem",
+				},
+			}},
+		},
+		{
+			Name: "eyaml value encrypted for another key",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/puppet/hieradata/nodes/web1.yaml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "puppet",
+				Locations: []string{"testdata/puppet/hieradata/nodes/web1.yaml"},
+				Metadata: &iacsecrets.Metadata{
+					Tool: "puppet",
+					Secrets: []*iacsecrets.Secret{
+						{Type: iacsecrets.SecretEYAML, Key: "nginx::ssl_key_passphrase"},
+						{Type: iacsecrets.SecretEYAML, Key: "nginx::admin_password", Decryptable: true},
+					},
+					DecryptionKey: "testdata/puppet/keys/private_key.pkcs7.pem",
+				},
+			}},
+		},
+		{
+			Name: "eyaml without private key",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/puppet-nokeys/hieradata/common.yaml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "puppet",
+				Locations: []string{"testdata/puppet-nokeys/hieradata/common.yaml"},
+				Metadata: &iacsecrets.Metadata{
+					Tool:    "puppet",
+					Secrets: []*iacsecrets.Secret{{Type: iacsecrets.SecretEYAML, Key: "profile::db::password"}},
+				},
+			}},
+		},
+		{
+			Name: "hieradata without encrypted values",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/puppet/hieradata/plain.yaml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "encrypted data bag with local secret",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/chef/data_bags/creds/db.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "chef",
+				Locations: []string{"testdata/chef/data_bags/creds/db.json"},
+				Metadata: &iacsecrets.Metadata{
+					Tool: "chef",
+					Secrets: []*iacsecrets.Secret{
+						{Type: iacsecrets.SecretEncryptedDataBag, Key: "password", Decryptable: true},
+						{Type: iacsecrets.SecretEncryptedDataBag, Key: "user", Decryptable: true},
+					},
+					DecryptionKey: "testdata/chef/.chef/encrypted_data_bag_secret",
+				},
+			}},
+		},
+		{
+			Name: "encrypted data bag with a different secret",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/chef/data_bags/creds/other.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "chef",
+				Locations: []string{"testdata/chef/data_bags/creds/other.json"},
+				Metadata: &iacsecrets.Metadata{
+					Tool:    "chef",
+					Secrets: []*iacsecrets.Secret{{Type: iacsecrets.SecretEncryptedDataBag, Key: "password"}},
+				},
+			}},
+		},
+		{
+			Name: "plaintext data bag",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/chef/data_bags/users/admin.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "chef",
+				Locations: []string{"testdata/chef/data_bags/users/admin.json"},
+				Metadata: &iacsecrets.Metadata{
+					Tool: "chef",
+					Secrets: []*iacsecrets.Secret{
+						{Type: iacsecrets.SecretPlaintextPassword, Key: "password", Value: "Adm1nP@ss"},
+						{Type: iacsecrets.SecretPlaintextPassword, Key: "ssh.key_passphrase", Value: "hunter2"},
+					},
+				},
+			}},
+		},
+		{
+			Name: "data bag without secrets",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/chef/data_bags/users/deploy.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "invalid data bag",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/data_bags/creds/broken.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+		{
+			Name: "ansible group vars",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/ansible/group_vars/all.yml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ansible",
+				Locations: []string{"testdata/ansible/group_vars/all.yml"},
+				Metadata: &iacsecrets.Metadata{
+					Tool: "ansible",
+					Secrets: []*iacsecrets.Secret{
+						{Type: iacsecrets.SecretPlaintextPassword, Key: "mysql.root_password", Value: "Sup3rS3cret!"},
+						{Type: iacsecrets.SecretPlaintextPassword, Key: "users.0.password", Value: "d3pl0y-pa55"},
+					},
+				},
+			}},
+		},
+		{
+			Name: "ansible host vars",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/ansible/host_vars/web1",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ansible",
+				Locations: []string{"testdata/ansible/host_vars/web1"},
+				Metadata: &iacsecrets.Metadata{
+					Tool:    "ansible",
+					Secrets: []*iacsecrets.Secret{{Type: iacsecrets.SecretPlaintextPassword, Key: "ansible_become_pass", Value: "w3bR00t"}},
+				},
+			}},
+		},
+		{
+			Name: "vault encrypted ansible vars",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/ansible/group_vars/db/vault.yml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "ansible vars without secrets",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/ansible/group_vars/web.yml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := iacsecrets.New(iacsecrets.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := iacsecrets.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "ansible",
+		Locations: []string{"group_vars/all.yml"},
+	}
+	want := &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: "ansible",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
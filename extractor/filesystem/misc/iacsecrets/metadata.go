@@ -0,0 +1,54 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacsecrets
+
+// Metadata holds the secrets found in a configuration management file of an
+// infrastructure-as-code repository.
+type Metadata struct {
+	// The configuration management tool the file belongs to, e.g. "puppet".
+	Tool    string
+	Secrets []*Secret
+	// Path of a decryption key stored next to the file, e.g. the eyaml PKCS#7
+	// private key or the Chef encrypted data bag secret. Empty if no key was
+	// found or it doesn't decrypt any of the secrets.
+	DecryptionKey string
+}
+
+// SecretType is the kind of a secret found in a configuration management file.
+type SecretType string
+
+// Supported secret types.
+const (
+	// SecretEYAML is a hiera-eyaml encrypted value, e.g. ENC[PKCS7,...].
+	SecretEYAML SecretType = "eyaml"
+	// SecretEncryptedDataBag is an encrypted Chef data bag item value.
+	SecretEncryptedDataBag SecretType = "encrypted-data-bag"
+	// SecretPlaintextPassword is a password, token or other secret stored
+	// without encryption.
+	SecretPlaintextPassword SecretType = "plaintext-password"
+)
+
+// Secret is a single secret value in a configuration management file.
+type Secret struct {
+	Type SecretType
+	// Key path of the value, e.g. "profile::db::password" or
+	// "mysql.root_password".
+	Key string
+	// The plaintext value. Empty for encrypted secrets.
+	Value string
+	// Whether DecryptionKey decrypts the secret, making it effectively stored
+	// in plaintext.
+	Decryptable bool
+}
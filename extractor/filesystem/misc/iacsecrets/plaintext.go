@@ -0,0 +1,110 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacsecrets
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// secretKeyWords are parts of key names that hold secrets.
+var secretKeyWords = []string{"password", "passwd", "passphrase", "secret", "token", "api_key", "apikey", "private_key"}
+
+// nonSecretKeySuffixes are suffixes of key names that refer to a secret
+// instead of holding it, e.g. "ssl_private_key_file" or "secret_name".
+var nonSecretKeySuffixes = []string{"_file", "_path", "_dir", "_name", "_id", "_url", "_length", "_policy", "_ttl"}
+
+// isSecretKey returns true if the last segment of the key path names a secret,
+// e.g. "mysql.root_password" or "deploy_token".
+func isSecretKey(key string) bool {
+	k := strings.ToLower(key[strings.LastIndexAny(key, ".:")+1:])
+	for _, s := range nonSecretKeySuffixes {
+		if strings.HasSuffix(k, s) {
+			return false
+		}
+	}
+	for _, w := range secretKeyWords {
+		if strings.Contains(k, w) {
+			return true
+		}
+	}
+	return strings.HasSuffix(k, "_pass") || strings.HasSuffix(k, "_pwd") || k == "pass" || k == "pwd"
+}
+
+// isPlaintextValue returns true if a secret value is stored in plaintext, i.e.
+// it's not empty, encrypted or a reference to another variable.
+func isPlaintextValue(v string) bool {
+	v = strings.TrimSpace(v)
+	switch {
+	case v == "":
+		return false
+	case strings.HasPrefix(v, "$ANSIBLE_VAULT;"):
+		return false
+	case strings.HasPrefix(v, "ENC["):
+		return false
+	case strings.Contains(v, "{{") || strings.Contains(v, "{%"):
+		// Jinja template, usually referencing a vaulted variable.
+		return false
+	case strings.HasPrefix(v, "%{") && strings.HasSuffix(v, "}"):
+		// Hiera interpolation.
+		return false
+	}
+	return true
+}
+
+// walkYAML calls fn for each scalar value in the YAML document with the path of
+// keys leading to it, joined with dots.
+func walkYAML(n *yaml.Node, key string, fn func(key string, n *yaml.Node)) {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		for _, c := range n.Content {
+			walkYAML(c, key, fn)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			walkYAML(n.Content[i+1], joinKey(key, n.Content[i].Value), fn)
+		}
+	case yaml.SequenceNode:
+		for i, c := range n.Content {
+			walkYAML(c, joinKey(key, strconv.Itoa(i)), fn)
+		}
+	case yaml.ScalarNode:
+		fn(key, n)
+	case yaml.AliasNode:
+		// Aliased values are reported where they're defined.
+	}
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// parseYAML parses a YAML document. Empty documents return a nil node.
+func parseYAML(data []byte) (*yaml.Node, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	n := &yaml.Node{}
+	if err := yaml.Unmarshal(data, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacsecrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"regexp"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"gopkg.in/yaml.v3"
+)
+
+// eyamlKeyFiles are the default locations of the hiera-eyaml PKCS#7 private
+// key, relative to the repository root.
+var eyamlKeyFiles = []string{
+	"keys/private_key.pkcs7.pem",
+	"eyaml/private_key.pkcs7.pem",
+}
+
+// eyamlRe matches an eyaml encrypted value, e.g. "ENC[PKCS7,MIIBeQYJ...]".
+var eyamlRe = regexp.MustCompile(`ENC\[(PKCS7|GPG),([A-Za-z0-9+/=\s]+)\]`)
+
+// eyamlValue is an encrypted value in hieradata.
+type eyamlValue struct {
+	key    string
+	method string
+	// The DER encoded PKCS#7 enveloped data. Empty for GPG values.
+	der []byte
+}
+
+// parseHieradata returns the eyaml encrypted values in the hieradata.
+func parseHieradata(data []byte) ([]*eyamlValue, error) {
+	doc, err := parseYAML(data)
+	if err != nil || doc == nil {
+		return nil, err
+	}
+	var values []*eyamlValue
+	walkYAML(doc, "", func(key string, n *yaml.Node) {
+		m := eyamlRe.FindStringSubmatch(n.Value)
+		if m == nil {
+			return
+		}
+		v := &eyamlValue{key: key, method: m[1]}
+		if v.method == "PKCS7" {
+			der, err := decodeBase64(m[2])
+			if err == nil {
+				v.der = der
+			}
+		}
+		values = append(values, v)
+	})
+	return values, nil
+}
+
+// eyamlSecrets returns the secrets for the encrypted values and the path of
+// the co-located private key that decrypts them, if any.
+func eyamlSecrets(fsys scalibrfs.FS, dir string, values []*eyamlValue) ([]*Secret, string) {
+	var secrets []*Secret
+	for _, v := range values {
+		secrets = append(secrets, &Secret{Type: SecretEYAML, Key: v.key})
+	}
+	if len(secrets) == 0 {
+		return nil, ""
+	}
+
+	paths, contents := findKeyFiles(fsys, dir, eyamlKeyFiles)
+	for _, p := range paths {
+		key := parseRSAPrivateKey(contents[p])
+		if key == nil {
+			continue
+		}
+		decryptable := false
+		for i, v := range values {
+			if v.der != nil && pkcs7Decryptable(v.der, key) {
+				secrets[i].Decryptable = true
+				decryptable = true
+			}
+		}
+		if decryptable {
+			return secrets, p
+		}
+	}
+	return secrets, ""
+}
+
+// parseRSAPrivateKey parses a PEM encoded PKCS#1 or PKCS#8 RSA private key.
+func parseRSAPrivateKey(data []byte) *rsa.PrivateKey {
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			return nil
+		}
+		data = rest
+		if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+			return key
+		}
+		if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+			if rsaKey, ok := key.(*rsa.PrivateKey); ok {
+				return rsaKey
+			}
+		}
+	}
+}
+
+// PKCS#7 enveloped data, see RFC 2315 section 10.
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type pkcs7EnvelopedData struct {
+	Version              int
+	RecipientInfos       []pkcs7RecipientInfo `asn1:"set"`
+	EncryptedContentInfo pkcs7EncryptedContentInfo
+}
+
+type pkcs7RecipientInfo struct {
+	Version                int
+	IssuerAndSerialNumber  asn1.RawValue
+	KeyEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedKey           []byte
+}
+
+type pkcs7EncryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"tag:0,optional"`
+}
+
+var (
+	oidEnvelopedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+	oidAES128CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+func isAESCBC(oid asn1.ObjectIdentifier) bool {
+	return oid.Equal(oidAES128CBC) || oid.Equal(oidAES192CBC) || oid.Equal(oidAES256CBC)
+}
+
+// pkcs7Decryptable returns true if the private key decrypts the PKCS#7
+// enveloped data. The content encryption key is decrypted with the private
+// key and, for AES encrypted content, verified by decrypting the content.
+func pkcs7Decryptable(der []byte, key *rsa.PrivateKey) bool {
+	var ci pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(der, &ci); err != nil || !ci.ContentType.Equal(oidEnvelopedData) {
+		return false
+	}
+	var ed pkcs7EnvelopedData
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &ed); err != nil {
+		return false
+	}
+	for _, ri := range ed.RecipientInfos {
+		cek, err := rsa.DecryptPKCS1v15(nil, key, ri.EncryptedKey)
+		if err != nil {
+			continue
+		}
+		eci := ed.EncryptedContentInfo
+		if !isAESCBC(eci.ContentEncryptionAlgorithm.Algorithm) {
+			// A legacy cipher, e.g. 3DES. The successful key decryption is all
+			// that's verified.
+			return true
+		}
+		var iv []byte
+		if _, err := asn1.Unmarshal(eci.ContentEncryptionAlgorithm.Parameters.FullBytes, &iv); err != nil {
+			continue
+		}
+		if cbcDecryptable(cek, iv, eci.EncryptedContent) {
+			return true
+		}
+	}
+	return false
+}
+
+// cbcDecryptable returns true if the ciphertext decrypts to a plaintext with
+// valid PKCS#7 padding using AES in CBC mode.
+func cbcDecryptable(key, iv, ciphertext []byte) bool {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return false
+	}
+	if len(iv) != block.BlockSize() || len(ciphertext) == 0 || len(ciphertext)%block.BlockSize() != 0 {
+		return false
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+	return validPadding(plaintext, block.BlockSize())
+}
+
+// validPadding returns true if b ends with valid PKCS#7 padding.
+func validPadding(b []byte, blockSize int) bool {
+	n := int(b[len(b)-1])
+	if n == 0 || n > blockSize || n > len(b) {
+		return false
+	}
+	for _, c := range b[len(b)-n:] {
+		if int(c) != n {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kerberos extracts the Kerberos and SSSD configuration of Linux
+// hosts: the realms and KDCs of krb5.conf, the service principals with keys
+// in keytabs and the identity domains of sssd.conf.
+package kerberos
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/kerberos"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Kerberos extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts Kerberos and SSSD configuration.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a Kerberos extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is a krb5.conf file, a
+// keytab or an sssd.conf file.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == "" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// kindForPath returns the kind of the file at p or an empty string if the
+// extractor doesn't handle it.
+func kindForPath(p string) Kind {
+	p = filepath.ToSlash(p)
+	dir, base := path.Split(p)
+	switch {
+	case p == "etc/krb5.conf" || strings.HasSuffix(dir, "etc/krb5.conf.d/"):
+		return KindKrb5Config
+	case base == "krb5.keytab" || path.Ext(base) == ".keytab":
+		return KindKeytab
+	case strings.HasSuffix(dir, "etc/sssd/") && base == "sssd.conf",
+		strings.HasSuffix(dir, "etc/sssd/conf.d/") && path.Ext(base) == ".conf":
+		return KindSSSDConfig
+	}
+	return ""
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// realms, principals or domains it holds.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	var m *Metadata
+	var err error
+	switch kindForPath(input.Path) {
+	case KindKrb5Config:
+		m, err = parseKrb5Config(input.Reader)
+	case KindKeytab:
+		m, err = parseKeytab(input.Reader)
+	case KindSSSDConfig:
+		m, err = parseSSSDConfig(input.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported file %s", input.Path)
+	}
+	if err != nil || m == nil {
+		return nil, err
+	}
+	return &extractor.Inventory{
+		Name:      string(m.Kind),
+		Locations: []string{input.Path},
+		Metadata:  m,
+	}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: i.Name,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track Kerberos
+// configuration.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+// addKeytab adds the keytab path of a "FILE:" or "WRFILE:" keytab name to
+// m.Keytabs. Other keytab types, e.g. MEMORY:, aren't stored in files.
+func (m *Metadata) addKeytab(name string) {
+	kt, ok := strings.CutPrefix(name, "FILE:")
+	if !ok {
+		kt, ok = strings.CutPrefix(name, "WRFILE:")
+	}
+	if !ok && !strings.HasPrefix(name, "/") {
+		return
+	}
+	if slices.Contains(m.Keytabs, kt) {
+		return
+	}
+	m.Keytabs = append(m.Keytabs, kt)
+}
+
+var _ filesystem.Extractor = Extractor{}
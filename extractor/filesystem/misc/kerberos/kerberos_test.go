@@ -0,0 +1,323 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kerberos_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/kerberos"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "krb5.conf",
+			path:             "etc/krb5.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "krb5.conf snippet",
+			path:             "etc/krb5.conf.d/crypto-policies",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "krb5.conf outside of etc",
+			path:         "usr/share/doc/krb5-libs/krb5.conf",
+			wantRequired: false,
+		},
+		{
+			name:             "system keytab",
+			path:             "etc/krb5.keytab",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "service keytab",
+			path:             "etc/httpd/conf/http.keytab",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "sssd.conf",
+			path:             "etc/sssd/sssd.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "sssd.conf snippet",
+			path:             "etc/sssd/conf.d/ad.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "other file in sssd dir",
+			path:         "etc/sssd/conf.d/README",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "etc/krb5.keytab",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "etc/krb5.keytab",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := kerberos.New(kerberos.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "krb5.conf",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "etc/krb5.conf",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "krb5-config",
+				Locations: []string{"etc/krb5.conf"},
+				Metadata: &kerberos.Metadata{
+					Kind:         kerberos.KindKrb5Config,
+					DefaultRealm: "CORP.EXAMPLE.COM",
+					Realms: []*kerberos.Realm{
+						{
+							Name:        "CORP.EXAMPLE.COM",
+							KDCs:        []string{"dc1.corp.example.com", "dc2.corp.example.com:88"},
+							AdminServer: "dc1.corp.example.com",
+						},
+						{
+							Name: "LAB.EXAMPLE.COM",
+							KDCs: []string{"kdc.lab.example.com"},
+						},
+					},
+					Keytabs: []string{"/etc/krb5.keytab", "/var/kerberos/krb5/user/%{euid}/client.keytab"},
+				},
+			}},
+		},
+		{
+			Name: "krb5.conf snippet without realms",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "etc/krb5.conf.d/crypto-policies",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "keytab",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "etc/krb5.keytab",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "keytab",
+				Locations: []string{"etc/krb5.keytab"},
+				Metadata: &kerberos.Metadata{
+					Kind: kerberos.KindKeytab,
+					Principals: []*kerberos.Principal{
+						{
+							Name:     "host/web1.corp.example.com",
+							Realm:    "CORP.EXAMPLE.COM",
+							KVNO:     3,
+							EncTypes: []string{"aes256-cts-hmac-sha1-96", "aes128-cts-hmac-sha1-96", "arcfour-hmac"},
+						},
+						{
+							Name:     "HTTP/web1.corp.example.com",
+							Realm:    "CORP.EXAMPLE.COM",
+							KVNO:     300,
+							EncTypes: []string{"aes256-cts-hmac-sha1-96"},
+						},
+						{
+							Name:     "WEB1$",
+							Realm:    "CORP.EXAMPLE.COM",
+							KVNO:     5,
+							EncTypes: []string{"enctype-99"},
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "empty keytab",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/app/empty.keytab",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "truncated keytab",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "invalid/truncated.keytab",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+		{
+			Name: "keytab version 1",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "invalid/v1.keytab",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "unsupported keytab version"},
+		},
+		{
+			Name: "not a keytab",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "invalid/notakeytab.keytab",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "not a keytab file"},
+		},
+		{
+			Name: "sssd.conf",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "etc/sssd/sssd.conf",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "sssd-config",
+				Locations: []string{"etc/sssd/sssd.conf"},
+				Metadata: &kerberos.Metadata{
+					Kind: kerberos.KindSSSDConfig,
+					Domains: []*kerberos.Domain{
+						{
+							Name:         "corp.example.com",
+							IDProvider:   "ad",
+							AuthProvider: "ad",
+							Realm:        "CORP.EXAMPLE.COM",
+							Servers:      []string{"dc1.corp.example.com", "dc2.corp.example.com"},
+							Keytab:       "/etc/krb5.keytab",
+						},
+						{
+							Name:         "lab",
+							IDProvider:   "ldap",
+							AuthProvider: "krb5",
+							Realm:        "LAB.EXAMPLE.COM",
+							Servers:      []string{"kdc.lab.example.com", "ldaps://ldap.lab.example.com"},
+							Keytab:       "/etc/sssd/lab.keytab",
+						},
+					},
+					Keytabs: []string{"/etc/krb5.keytab", "/etc/sssd/lab.keytab"},
+				},
+			}},
+		},
+		{
+			Name: "sssd.conf snippet without domains",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path:         "etc/sssd/conf.d/empty.conf",
+				FakeScanRoot: "testdata",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := kerberos.New(kerberos.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := kerberos.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "keytab",
+		Locations: []string{"etc/krb5.keytab"},
+	}
+	want := &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: "keytab",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
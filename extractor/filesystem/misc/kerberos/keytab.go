@@ -0,0 +1,169 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kerberos
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// keytabVersion is the first two bytes of the keytab format written by MIT
+// Kerberos and Heimdal since Kerberos 5 release 1.0. Version 0x0501 used the
+// byte order of the host and isn't supported.
+const keytabVersion = 0x0502
+
+var errTruncated = errors.New("truncated keytab entry")
+
+// encTypes are the names of the encryption types from RFC 3961 and its
+// successors.
+var encTypes = map[uint16]string{
+	1:  "des-cbc-crc",
+	2:  "des-cbc-md4",
+	3:  "des-cbc-md5",
+	16: "des3-cbc-sha1",
+	17: "aes128-cts-hmac-sha1-96",
+	18: "aes256-cts-hmac-sha1-96",
+	19: "aes128-cts-hmac-sha256-128",
+	20: "aes256-cts-hmac-sha384-192",
+	23: "arcfour-hmac",
+	24: "arcfour-hmac-exp",
+	25: "camellia128-cts-cmac",
+	26: "camellia256-cts-cmac",
+}
+
+// parseKeytab lists the principals of a keytab file. The keys themselves are
+// skipped. It returns nil if the keytab has no entries.
+func parseKeytab(r io.Reader) (*Metadata, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 || data[0] != 0x05 {
+		return nil, errors.New("not a keytab file")
+	}
+	if v := binary.BigEndian.Uint16(data); v != keytabVersion {
+		return nil, fmt.Errorf("unsupported keytab version %#04x", v)
+	}
+
+	m := &Metadata{Kind: KindKeytab}
+	byName := map[string]*Principal{}
+	data = data[2:]
+	for len(data) >= 4 {
+		size := int32(binary.BigEndian.Uint32(data))
+		data = data[4:]
+		if size == 0 {
+			break
+		}
+		if size < 0 {
+			// A hole left by a deleted entry.
+			if int(-size) > len(data) {
+				return nil, errTruncated
+			}
+			data = data[-size:]
+			continue
+		}
+		if int(size) > len(data) {
+			return nil, errTruncated
+		}
+		p, err := parseKeytabEntry(data[:size])
+		if err != nil {
+			return nil, err
+		}
+		data = data[size:]
+
+		key := p.Name + "@" + p.Realm
+		existing, ok := byName[key]
+		if !ok {
+			byName[key] = p
+			m.Principals = append(m.Principals, p)
+			continue
+		}
+		existing.KVNO = max(existing.KVNO, p.KVNO)
+		for _, et := range p.EncTypes {
+			if !slices.Contains(existing.EncTypes, et) {
+				existing.EncTypes = append(existing.EncTypes, et)
+			}
+		}
+	}
+	if len(m.Principals) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
+
+// parseKeytabEntry parses a single keytab entry into a principal with one
+// encryption type.
+func parseKeytabEntry(b []byte) (*Principal, error) {
+	e := &entryReader{b: b}
+	numComponents := e.uint16()
+	realm := e.string()
+	components := make([]string, 0, numComponents)
+	for range numComponents {
+		components = append(components, e.string())
+	}
+	// The name type and the timestamp.
+	e.skip(8)
+	kvno := uint32(e.uint8())
+	encType := e.uint16()
+	// The key.
+	e.skip(int(e.uint16()))
+	if e.err != nil {
+		return nil, e.err
+	}
+	// Newer keytabs store the full 32 bit key version after the key.
+	if len(e.b) >= 4 {
+		if v := e.uint32(); v != 0 {
+			kvno = v
+		}
+	}
+
+	name, ok := encTypes[encType]
+	if !ok {
+		name = fmt.Sprintf("enctype-%d", encType)
+	}
+	return &Principal{
+		Name:     strings.Join(components, "/"),
+		Realm:    realm,
+		KVNO:     kvno,
+		EncTypes: []string{name},
+	}, nil
+}
+
+// entryReader reads the big-endian fields of a keytab entry. After the first
+// error all reads return zero values.
+type entryReader struct {
+	b   []byte
+	err error
+}
+
+func (e *entryReader) next(n int) []byte {
+	if e.err != nil || n > len(e.b) {
+		e.err = errTruncated
+		return make([]byte, n)
+	}
+	b := e.b[:n]
+	e.b = e.b[n:]
+	return b
+}
+
+func (e *entryReader) skip(n int)     { e.next(n) }
+func (e *entryReader) uint8() uint8   { return e.next(1)[0] }
+func (e *entryReader) uint16() uint16 { return binary.BigEndian.Uint16(e.next(2)) }
+func (e *entryReader) uint32() uint32 { return binary.BigEndian.Uint32(e.next(4)) }
+func (e *entryReader) string() string { return string(e.next(int(e.uint16()))) }
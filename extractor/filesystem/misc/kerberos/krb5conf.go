@@ -0,0 +1,99 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kerberos
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// parseKrb5Config parses a krb5.conf file in the profile format:
+//
+//	[libdefaults]
+//	    default_realm = EXAMPLE.COM
+//	[realms]
+//	    EXAMPLE.COM = {
+//	        kdc = kdc1.example.com
+//	    }
+//
+// It returns nil if the file configures no realms or keytabs.
+func parseKrb5Config(r io.Reader) (*Metadata, error) {
+	m := &Metadata{Kind: KindKrb5Config}
+	var section string
+	// depth is the number of open braces in the current section.
+	var depth int
+	var realm *Realm
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			depth, realm = 0, nil
+			continue
+		case strings.HasPrefix(line, "}"):
+			if depth > 0 {
+				depth--
+			}
+			if depth == 0 {
+				realm = nil
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			// Directives like include and includedir.
+			continue
+		}
+		// A trailing "*" marks the value as final.
+		key = strings.TrimSuffix(strings.TrimSpace(key), "*")
+		value = strings.TrimSpace(value)
+		if value == "{" {
+			depth++
+			if section == "realms" && depth == 1 {
+				realm = &Realm{Name: key}
+				m.Realms = append(m.Realms, realm)
+			}
+			continue
+		}
+
+		switch {
+		case section == "libdefaults" && depth == 0:
+			switch key {
+			case "default_realm":
+				m.DefaultRealm = value
+			case "default_keytab_name", "default_client_keytab_name":
+				m.addKeytab(value)
+			}
+		case realm != nil && depth == 1:
+			switch key {
+			case "kdc":
+				realm.KDCs = append(realm.KDCs, value)
+			case "admin_server":
+				realm.AdminServer = value
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if m.DefaultRealm == "" && len(m.Realms) == 0 && len(m.Keytabs) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
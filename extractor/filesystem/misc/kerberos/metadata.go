@@ -0,0 +1,81 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kerberos
+
+// Kind is the kind of file an inventory was extracted from.
+type Kind string
+
+// Supported kinds.
+const (
+	// KindKrb5Config is an MIT or Heimdal krb5.conf file or a snippet in
+	// krb5.conf.d.
+	KindKrb5Config Kind = "krb5-config"
+	// KindKeytab is a keytab file with the keys of service principals.
+	KindKeytab Kind = "keytab"
+	// KindSSSDConfig is an sssd.conf file or a snippet in sssd/conf.d.
+	KindSSSDConfig Kind = "sssd-config"
+)
+
+// Metadata holds the Kerberos realms and principals a Linux host is
+// configured for.
+type Metadata struct {
+	Kind Kind
+	// The default_realm of krb5.conf.
+	DefaultRealm string
+	// The realms defined in krb5.conf.
+	Realms []*Realm
+	// The principals with keys in a keytab, e.g. host/db1.example.com.
+	Principals []*Principal
+	// The [domain/...] sections of sssd.conf.
+	Domains []*Domain
+	// Paths of the keytabs the config refers to, e.g. the
+	// default_keytab_name of krb5.conf or the krb5_keytab of an SSSD domain.
+	Keytabs []string
+}
+
+// Realm is a Kerberos realm defined in krb5.conf.
+type Realm struct {
+	Name        string
+	KDCs        []string
+	AdminServer string
+}
+
+// Principal is a principal with keys in a keytab. Key material isn't
+// extracted.
+type Principal struct {
+	// The principal name without the realm, e.g. HTTP/web.example.com. Names
+	// with more than one component are service principal names.
+	Name  string
+	Realm string
+	// The highest key version number of the principal in the keytab.
+	KVNO uint32
+	// The encryption types of the keys, e.g. aes256-cts-hmac-sha1-96.
+	EncTypes []string
+}
+
+// Domain is an identity domain configured in sssd.conf.
+type Domain struct {
+	Name string
+	// The id_provider and auth_provider, e.g. "ad", "ipa", "ldap" or "krb5".
+	IDProvider   string
+	AuthProvider string
+	// The Kerberos realm of the domain. Defaults to the upper-case ad_domain
+	// or ipa_domain like SSSD does.
+	Realm string
+	// The servers of the domain, e.g. from ad_server or ldap_uri.
+	Servers []string
+	// The keytab the domain authenticates with.
+	Keytab string
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kerberos
+
+import (
+	"bufio"
+	"io"
+	"slices"
+	"strings"
+)
+
+// parseSSSDConfig parses the [domain/NAME] sections of an sssd.conf file. It
+// returns nil if the file configures no domains.
+func parseSSSDConfig(r io.Reader) (*Metadata, error) {
+	m := &Metadata{Kind: KindSSSDConfig}
+	var domain *Domain
+	// The ad_domain or ipa_domain of the current domain, used as its realm if
+	// krb5_realm isn't set.
+	var defaultRealm string
+	finishDomain := func() {
+		if domain == nil {
+			return
+		}
+		if domain.Realm == "" {
+			domain.Realm = strings.ToUpper(defaultRealm)
+		}
+		if domain.Keytab != "" {
+			m.addKeytab(domain.Keytab)
+		}
+	}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			finishDomain()
+			domain, defaultRealm = nil, ""
+			if name, ok := strings.CutPrefix(strings.TrimSpace(line[1:len(line)-1]), "domain/"); ok {
+				domain = &Domain{Name: name}
+				m.Domains = append(m.Domains, domain)
+			}
+			continue
+		}
+		if domain == nil {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "id_provider":
+			domain.IDProvider = value
+		case "auth_provider":
+			domain.AuthProvider = value
+		case "krb5_realm":
+			domain.Realm = value
+		case "ad_domain", "ipa_domain":
+			defaultRealm = value
+		case "ad_server", "ipa_server", "krb5_server", "ldap_uri":
+			for _, server := range strings.Split(value, ",") {
+				// _srv_ enables DNS service discovery.
+				server = strings.TrimSpace(server)
+				if server != "" && server != "_srv_" && !slices.Contains(domain.Servers, server) {
+					domain.Servers = append(domain.Servers, server)
+				}
+			}
+		case "krb5_keytab", "ldap_krb5_keytab":
+			domain.Keytab = value
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	finishDomain()
+	if len(m.Domains) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logpipeline
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// fluentdSection is a <directive> of a Fluentd config with its parameters and
+// nested sections.
+type fluentdSection struct {
+	name     string
+	params   []setting
+	children []*fluentdSection
+}
+
+// Fluentd output plugins that send data to their <store> sections instead of
+// a destination of their own.
+var fluentdMultiOutputs = map[string]bool{
+	"copy":       true,
+	"roundrobin": true,
+}
+
+// parseFluentd parses the outputs of a Fluentd config, i.e. its <match>
+// directives.
+func parseFluentd(ctx context.Context, r io.Reader) ([]*Output, error) {
+	root := &fluentdSection{}
+	stack := []*fluentdSection{root}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		cur := stack[len(stack)-1]
+		switch {
+		case strings.HasPrefix(line, "</"):
+			name := strings.TrimSuffix(strings.TrimPrefix(line, "</"), ">")
+			if len(stack) == 1 || cur.name != name {
+				return nil, fmt.Errorf("unexpected closing tag %s", line)
+			}
+			stack = stack[:len(stack)-1]
+		case strings.HasPrefix(line, "<") && strings.HasSuffix(line, ">"):
+			name, _ := cutSpace(line[1 : len(line)-1])
+			sec := &fluentdSection{name: name}
+			cur.children = append(cur.children, sec)
+			stack = append(stack, sec)
+		default:
+			key, value := cutSpace(line)
+			cur.params = append(cur.params, setting{key: key, value: unquote(value)})
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("unclosed section <%s>", stack[len(stack)-1].name)
+	}
+	return fluentdOutputs(root), nil
+}
+
+// fluentdOutputs returns the outputs of all <match> directives below sec,
+// including the ones inside <label> sections.
+func fluentdOutputs(sec *fluentdSection) []*Output {
+	var outputs []*Output
+	for _, c := range sec.children {
+		switch c.name {
+		case "match":
+			outputs = append(outputs, fluentdOutput(c)...)
+		case "label":
+			outputs = append(outputs, fluentdOutputs(c)...)
+		}
+	}
+	return outputs
+}
+
+// fluentdOutput returns the outputs configured in a <match> or <store>
+// section. Destinations and credentials in nested sections such as <server>
+// or <security> are attributed to the enclosing output.
+func fluentdOutput(sec *fluentdSection) []*Output {
+	typ := ""
+	for _, p := range sec.params {
+		if p.key == "@type" || p.key == "type" {
+			typ = p.value
+		}
+	}
+	if fluentdMultiOutputs[typ] {
+		var outputs []*Output
+		for _, c := range sec.children {
+			if c.name == "store" {
+				outputs = append(outputs, fluentdOutput(c)...)
+			}
+		}
+		return outputs
+	}
+
+	out := &Output{Type: typ}
+	var collect func(*fluentdSection)
+	collect = func(s *fluentdSection) {
+		if o := newOutput(typ, s.params); o != nil {
+			out.Destinations = append(out.Destinations, o.Destinations...)
+			out.Credentials = append(out.Credentials, o.Credentials...)
+		}
+		for _, c := range s.children {
+			collect(c)
+		}
+	}
+	collect(sec)
+	if len(out.Destinations) == 0 && len(out.Credentials) == 0 {
+		return nil
+	}
+	return []*Output{out}
+}
+
+// parseFluentBit parses the [OUTPUT] sections of a Fluent Bit config in the
+// classic format.
+func parseFluentBit(ctx context.Context, r io.Reader) ([]*Output, error) {
+	var outputs []*Output
+	var inOutput bool
+	var settings []setting
+	flush := func() {
+		if !inOutput {
+			return
+		}
+		typ := ""
+		for _, s := range settings {
+			if strings.EqualFold(s.key, "name") {
+				typ = s.value
+			}
+		}
+		if out := newOutput(typ, settings); out != nil {
+			outputs = append(outputs, out)
+		}
+	}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "@") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			flush()
+			inOutput = strings.EqualFold(line, "[OUTPUT]")
+			settings = nil
+			continue
+		}
+		key, value := cutSpace(line)
+		settings = append(settings, setting{key: key, value: value})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return outputs, nil
+}
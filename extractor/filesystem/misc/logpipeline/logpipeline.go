@@ -0,0 +1,239 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logpipeline extracts the outputs configured for log shippers such as
+// Splunk forwarders, Logstash and Fluentd/Fluent Bit, including credentials
+// for the downstream systems that are stored in the configs in plaintext.
+package logpipeline
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/logpipeline"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 10 * units.MiB
+)
+
+// Supported log shippers.
+const (
+	ProductSplunk    = "splunk"
+	ProductLogstash  = "logstash"
+	ProductFluentd   = "fluentd"
+	ProductFluentBit = "fluent-bit"
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the log pipeline extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts the outputs of log pipeline configs.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a log pipeline extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is a config of one of the
+// supported log shippers.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if productForPath(path) == "" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// productForPath returns the log shipper the config file at p belongs to, or
+// an empty string if it isn't a supported config.
+//
+// Splunk forwarders keep their outputs in outputs.conf files below the
+// installation directory. Logstash pipelines and Fluentd/Fluent Bit configs
+// can have arbitrary names so any .conf file in their config directories is
+// considered.
+func productForPath(p string) string {
+	p = strings.ToLower(filepath.ToSlash(p))
+	base := path.Base(p)
+	if path.Ext(base) != ".conf" {
+		return ""
+	}
+	dir := path.Dir(p)
+	switch {
+	case base == "outputs.conf":
+		if hasDirWithPrefix(dir, "splunk") {
+			return ProductSplunk
+		}
+		return ""
+	case base == "fluent-bit.conf" || hasDirWithPrefix(dir, "fluent-bit"):
+		return ProductFluentBit
+	case base == "fluent.conf" || base == "td-agent.conf" || hasDirWithPrefix(dir, "fluent") || hasDirWithPrefix(dir, "td-agent"):
+		return ProductFluentd
+	case hasDirWithPrefix(dir, "logstash"):
+		return ProductLogstash
+	}
+	return ""
+}
+
+// hasDirWithPrefix returns true if any of the directories in dir start with
+// prefix, e.g. "opt/splunkforwarder/etc" for "splunk".
+func hasDirWithPrefix(dir, prefix string) bool {
+	for _, d := range strings.Split(dir, "/") {
+		if strings.HasPrefix(d, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the log pipeline config passed through the scan input and
+// returns an Inventory describing its outputs.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	product := productForPath(input.Path)
+	var outputs []*Output
+	var err error
+	switch product {
+	case ProductSplunk:
+		outputs, err = parseSplunk(ctx, input.Reader)
+	case ProductLogstash:
+		outputs, err = parseLogstash(ctx, input.Reader)
+	case ProductFluentd:
+		outputs, err = parseFluentd(ctx, input.Reader)
+	case ProductFluentBit:
+		outputs, err = parseFluentBit(ctx, input.Reader)
+	default:
+		err = fmt.Errorf("unsupported config file %s", input.Path)
+	}
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if len(outputs) == 0 {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{{
+		Name:      product,
+		Locations: []string{input.Path},
+		Metadata: &Metadata{
+			Product: product,
+			Outputs: outputs,
+		},
+	}}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: i.Name,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not support log pipeline configs.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,339 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logpipeline_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/logpipeline"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "splunk forwarder outputs.conf",
+			path:             "opt/splunkforwarder/etc/system/local/outputs.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "splunk app outputs.conf",
+			path:             "opt/splunk/etc/apps/forwarding/local/outputs.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "outputs.conf outside of splunk",
+			path:         "etc/myapp/outputs.conf",
+			wantRequired: false,
+		},
+		{
+			name:         "other splunk config",
+			path:         "opt/splunkforwarder/etc/system/local/inputs.conf",
+			wantRequired: false,
+		},
+		{
+			name:             "logstash pipeline",
+			path:             "etc/logstash/conf.d/10-output.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "logstash settings",
+			path:         "etc/logstash/logstash.yml",
+			wantRequired: false,
+		},
+		{
+			name:             "fluentd config",
+			path:             "etc/fluent/fluent.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "td-agent include",
+			path:             "etc/td-agent/conf.d/output.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "fluent bit config",
+			path:             "etc/fluent-bit/fluent-bit.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated .conf file",
+			path:         "etc/nginx/nginx.conf",
+			wantRequired: false,
+		},
+		{
+			name:         "directory name merely containing a product name",
+			path:         "etc/influent/app.conf",
+			wantRequired: false,
+		},
+		{
+			name:             "config not required if size greater than maxFileSizeBytes",
+			path:             "etc/logstash/conf.d/10-output.conf",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 10 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "config required if maxFileSizeBytes explicitly set to 0",
+			path:             "etc/logstash/conf.d/10-output.conf",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := logpipeline.New(logpipeline.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "splunk outputs",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/splunkforwarder/outputs.conf",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "splunk",
+					Locations: []string{"testdata/splunkforwarder/outputs.conf"},
+					Metadata: &logpipeline.Metadata{
+						Product: "splunk",
+						Outputs: []*logpipeline.Output{
+							{
+								Type:         "tcpout",
+								Destinations: []string{"idx1.example.com:9997", "idx2.example.com:9997"},
+								Credentials:  []*logpipeline.Credential{{Key: "sslPassword", Value: "forwarderpass"}},
+							},
+							{
+								// The encrypted sslPassword isn't reported.
+								Type:         "tcpout-server",
+								Destinations: []string{"idx3.example.com:9997"},
+							},
+							{
+								Type:         "httpout",
+								Destinations: []string{"https://hec.example.com:8088"},
+								Credentials: []*logpipeline.Credential{
+									{Key: "httpEventCollectorToken", Value: "8b4b2e2c-1f0a-4f5b-9a6e-0c1d2e3f4a5b"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "splunk config without destinations",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/splunkforwarder/no-outputs/outputs.conf",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "logstash pipeline",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/logstash/pipeline.conf",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "logstash",
+					Locations: []string{"testdata/logstash/pipeline.conf"},
+					Metadata: &logpipeline.Metadata{
+						Product: "logstash",
+						Outputs: []*logpipeline.Output{
+							{
+								Type:         "elasticsearch",
+								Destinations: []string{"https://es1.example.com:9200", "https://es2.example.com:9200"},
+								Credentials:  []*logpipeline.Credential{{Key: "password", Value: "changeme"}},
+							},
+							{
+								Type:         "http",
+								Destinations: []string{"https://audit.example.com/ingest"},
+								Credentials:  []*logpipeline.Credential{{Key: "Authorization", Value: "Bearer c2VjcmV0LXRva2Vu"}},
+							},
+							// The s3 output only references its secret key through an
+							// environment variable and has no destination URL.
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "logstash pipeline without outputs",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/logstash/input-only.conf",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "invalid logstash pipeline",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/logstash/invalid.conf",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+		{
+			Name: "fluentd config",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/fluentd/fluent.conf",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "fluentd",
+					Locations: []string{"testdata/fluentd/fluent.conf"},
+					Metadata: &logpipeline.Metadata{
+						Product: "fluentd",
+						Outputs: []*logpipeline.Output{
+							{
+								Type:         "elasticsearch",
+								Destinations: []string{"es.example.com:9200"},
+								Credentials:  []*logpipeline.Credential{{Key: "password", Value: "s3cr3t"}},
+							},
+							{
+								Type:         "forward",
+								Destinations: []string{"aggregator.example.com:24224"},
+								Credentials:  []*logpipeline.Credential{{Key: "shared_key", Value: "forward-shared-key"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "fluentd config with unclosed section",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/fluentd/unclosed.conf",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "unclosed section"},
+		},
+		{
+			Name: "fluent bit config",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/fluent-bit/fluent-bit.conf",
+			},
+			WantInventory: []*extractor.Inventory{
+				{
+					Name:      "fluent-bit",
+					Locations: []string{"testdata/fluent-bit/fluent-bit.conf"},
+					Metadata: &logpipeline.Metadata{
+						Product: "fluent-bit",
+						Outputs: []*logpipeline.Output{
+							{
+								Type:         "es",
+								Destinations: []string{"es.example.com:9200"},
+								Credentials:  []*logpipeline.Credential{{Key: "HTTP_Passwd", Value: "bitpass"}},
+							},
+							{
+								Type:         "splunk",
+								Destinations: []string{"hec.example.com:8088"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := logpipeline.New(logpipeline.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := logpipeline.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "logstash",
+		Locations: []string{"etc/logstash/conf.d/10-output.conf"},
+	}
+	want := &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: "logstash",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logpipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// tokenKind is the kind of a token of the Logstash config language.
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenArrow // =>
+	tokenLBrace
+	tokenRBrace
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+var errUnexpectedEOF = errors.New("unexpected end of config")
+
+// parseLogstash parses the outputs of a Logstash pipeline config, e.g.
+//
+//	output {
+//	  elasticsearch { hosts => ["https://es:9200"] password => "changeme" }
+//	}
+func parseLogstash(ctx context.Context, r io.Reader) ([]*Output, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := tokenizeLogstash(string(content))
+	if err != nil {
+		return nil, err
+	}
+	p := &logstashParser{ctx: ctx, tokens: tokens}
+	var outputs []*Output
+	for !p.done() {
+		t := p.next()
+		if t.kind != tokenWord {
+			return nil, fmt.Errorf("unexpected token %q at top level", t.value)
+		}
+		if err := p.expect(tokenLBrace); err != nil {
+			return nil, err
+		}
+		var out []*Output
+		if t.value == "output" {
+			out, err = p.parsePlugins()
+		} else {
+			// Inputs and filters don't send data anywhere.
+			err = p.skipBlock()
+		}
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, out...)
+	}
+	return outputs, nil
+}
+
+type logstashParser struct {
+	ctx    context.Context
+	tokens []token
+	pos    int
+}
+
+func (p *logstashParser) done() bool { return p.pos >= len(p.tokens) }
+
+func (p *logstashParser) next() token {
+	t := p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *logstashParser) expect(kind tokenKind) error {
+	if p.done() {
+		return errUnexpectedEOF
+	}
+	if t := p.next(); t.kind != kind {
+		return fmt.Errorf("unexpected token %q", t.value)
+	}
+	return nil
+}
+
+// skipBlock skips all tokens up to and including the closing brace of the
+// current block.
+func (p *logstashParser) skipBlock() error {
+	depth := 1
+	for !p.done() {
+		switch p.next().kind {
+		case tokenLBrace:
+			depth++
+		case tokenRBrace:
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+	return errUnexpectedEOF
+}
+
+// parsePlugins parses the plugin definitions of an output block up to and
+// including its closing brace. Plugins inside conditionals are included.
+func (p *logstashParser) parsePlugins() ([]*Output, error) {
+	var outputs []*Output
+	for !p.done() {
+		if err := p.ctx.Err(); err != nil {
+			return nil, err
+		}
+		t := p.next()
+		switch {
+		case t.kind == tokenRBrace:
+			return outputs, nil
+		case t.kind == tokenWord && (t.value == "if" || t.value == "else"):
+			// Skip the condition, e.g. `if [type] == "nginx" {`.
+			for !p.done() && p.tokens[p.pos].kind != tokenLBrace {
+				p.pos++
+			}
+			if err := p.expect(tokenLBrace); err != nil {
+				return nil, err
+			}
+			out, err := p.parsePlugins()
+			if err != nil {
+				return nil, err
+			}
+			outputs = append(outputs, out...)
+		case t.kind == tokenWord:
+			if err := p.expect(tokenLBrace); err != nil {
+				return nil, err
+			}
+			settings, err := p.parseSettings()
+			if err != nil {
+				return nil, err
+			}
+			if out := newOutput(t.value, settings); out != nil {
+				outputs = append(outputs, out)
+			}
+		default:
+			return nil, fmt.Errorf("unexpected token %q in output block", t.value)
+		}
+	}
+	return nil, errUnexpectedEOF
+}
+
+// parseSettings parses the `key => value` pairs of a plugin or hash up to and
+// including the closing brace. Arrays result in one setting per element and
+// hashes in one setting per hash entry.
+func (p *logstashParser) parseSettings() ([]setting, error) {
+	var settings []setting
+	for !p.done() {
+		t := p.next()
+		if t.kind == tokenRBrace {
+			return settings, nil
+		}
+		if t.kind != tokenWord && t.kind != tokenString {
+			return nil, fmt.Errorf("unexpected token %q in plugin settings", t.value)
+		}
+		if err := p.expect(tokenArrow); err != nil {
+			return nil, err
+		}
+		if p.done() {
+			return nil, errUnexpectedEOF
+		}
+		v := p.next()
+		switch v.kind {
+		case tokenWord, tokenString:
+			settings = append(settings, setting{key: t.value, value: v.value})
+		case tokenLBracket:
+			values, err := p.parseArray()
+			if err != nil {
+				return nil, err
+			}
+			for _, value := range values {
+				settings = append(settings, setting{key: t.value, value: value})
+			}
+		case tokenLBrace:
+			hash, err := p.parseSettings()
+			if err != nil {
+				return nil, err
+			}
+			settings = append(settings, hash...)
+		default:
+			return nil, fmt.Errorf("unexpected token %q as value of %s", v.value, t.value)
+		}
+	}
+	return nil, errUnexpectedEOF
+}
+
+// parseArray parses the elements of an array up to and including the closing
+// bracket.
+func (p *logstashParser) parseArray() ([]string, error) {
+	var values []string
+	for !p.done() {
+		t := p.next()
+		switch t.kind {
+		case tokenRBracket:
+			return values, nil
+		case tokenComma:
+		case tokenWord, tokenString:
+			values = append(values, t.value)
+		default:
+			return nil, fmt.Errorf("unexpected token %q in array", t.value)
+		}
+	}
+	return nil, errUnexpectedEOF
+}
+
+// tokenizeLogstash splits a Logstash config into tokens, skipping comments.
+func tokenizeLogstash(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '#':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(s) && s[j] != c; j++ {
+				if s[j] == '\\' && j+1 < len(s) {
+					j++
+				}
+				sb.WriteByte(s[j])
+			}
+			if j >= len(s) {
+				return nil, errors.New("unterminated string")
+			}
+			tokens = append(tokens, token{kind: tokenString, value: sb.String()})
+			i = j + 1
+		case strings.HasPrefix(s[i:], "=>"):
+			tokens = append(tokens, token{kind: tokenArrow, value: "=>"})
+			i += 2
+		case strings.IndexByte("{}[],", c) >= 0:
+			tokens = append(tokens, token{kind: punctuation[c], value: string(c)})
+			i++
+		default:
+			j := i
+			for j < len(s) && !isLogstashDelimiter(s, j) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+var punctuation = map[byte]tokenKind{
+	'{': tokenLBrace,
+	'}': tokenRBrace,
+	'[': tokenLBracket,
+	']': tokenRBracket,
+	',': tokenComma,
+}
+
+func isLogstashDelimiter(s string, i int) bool {
+	return strings.IndexByte(" \t\r\n#\"'{}[],", s[i]) >= 0 || strings.HasPrefix(s[i:], "=>")
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logpipeline
+
+// Metadata holds the outputs configured in a log pipeline config file.
+type Metadata struct {
+	// The log shipper the config belongs to, e.g. "logstash".
+	Product string
+	Outputs []*Output
+}
+
+// Output is a downstream system the log shipper sends data to.
+type Output struct {
+	// Type of the output plugin or stanza, e.g. "elasticsearch" or "tcpout".
+	Type string
+	// Hosts or URLs the data is sent to.
+	Destinations []string
+	// Credentials for the destination that are stored in plaintext in the
+	// config. Environment variable references and values encrypted by the log
+	// shipper are not included.
+	Credentials []*Credential
+}
+
+// Credential is a plaintext secret found in an output's settings.
+type Credential struct {
+	// Name of the setting the secret is stored in, e.g. "password".
+	Key   string
+	Value string
+}
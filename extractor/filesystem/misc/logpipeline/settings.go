@@ -0,0 +1,148 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logpipeline
+
+import (
+	"net"
+	"strings"
+)
+
+// setting is a key-value pair from an output's configuration.
+type setting struct {
+	key   string
+	value string
+}
+
+var (
+	// Settings that contain one or more comma-separated destination hosts or URLs.
+	destinationKeys = map[string]bool{
+		"bootstrapservers": true,
+		"brokers":          true,
+		"endpoint":         true,
+		"hosts":            true,
+		"server":           true,
+		"servers":          true,
+		"uri":              true,
+		"url":              true,
+		"urls":             true,
+	}
+	// Substrings of setting names that hold credentials.
+	credentialKeywords = []string{
+		"accesskey",
+		"apikey",
+		"authorization",
+		"cloudauth",
+		"passwd",
+		"password",
+		"seckey",
+		"secret",
+		"sharedkey",
+		"token",
+	}
+	// Suffixes of setting names that refer to a file or location of a
+	// credential instead of the credential itself.
+	credentialReferenceSuffixes = []string{"file", "path", "id"}
+)
+
+// normalizeKey lowercases key and removes separators so that e.g.
+// "HTTP_Passwd" and "http-passwd" are treated the same.
+func normalizeKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "", ".", "", "@", "").Replace(strings.ToLower(key))
+}
+
+// isCredentialKey returns true if the setting with the given name holds a
+// secret such as a password or token.
+func isCredentialKey(key string) bool {
+	k := normalizeKey(key)
+	for _, s := range credentialReferenceSuffixes {
+		if strings.HasSuffix(k, s) {
+			return false
+		}
+	}
+	for _, kw := range credentialKeywords {
+		if strings.Contains(k, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmbeddedSecret returns true if the value is a secret stored in plaintext,
+// i.e. not a reference to an environment variable or a value encrypted by
+// the log shipper.
+func isEmbeddedSecret(value string) bool {
+	switch {
+	case value == "":
+		return false
+	case strings.Contains(value, "${") || strings.Contains(value, "#{"):
+		// ${VAR} (Logstash, Fluent Bit) and "#{ENV['VAR']}" (Fluentd).
+		return false
+	case strings.HasPrefix(value, "$1$") || strings.HasPrefix(value, "$7$"):
+		// Encrypted with the Splunk secret.
+		return false
+	}
+	return true
+}
+
+// newOutput creates an Output of the given type from its settings. Returns
+// nil if the settings contain neither destinations nor credentials.
+func newOutput(typ string, settings []setting) *Output {
+	out := &Output{Type: typ}
+	var host, port string
+	for _, s := range settings {
+		k := normalizeKey(s.key)
+		switch {
+		case destinationKeys[k]:
+			for _, d := range strings.Split(s.value, ",") {
+				if d = strings.TrimSpace(d); d != "" {
+					out.Destinations = append(out.Destinations, d)
+				}
+			}
+		case k == "host":
+			host = s.value
+		case k == "port":
+			port = s.value
+		case isCredentialKey(s.key) && isEmbeddedSecret(s.value):
+			out.Credentials = append(out.Credentials, &Credential{Key: s.key, Value: s.value})
+		}
+	}
+	if host != "" {
+		if port != "" {
+			host = net.JoinHostPort(host, port)
+		}
+		out.Destinations = append(out.Destinations, host)
+	}
+	if len(out.Destinations) == 0 && len(out.Credentials) == 0 {
+		return nil
+	}
+	return out
+}
+
+// cutSpace splits s at the first run of whitespace.
+func cutSpace(s string) (before, after string) {
+	i := strings.IndexAny(s, " \t")
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], strings.TrimSpace(s[i:])
+}
+
+// unquote removes matching single or double quotes around s.
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logpipeline
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"strings"
+)
+
+// Splunk outputs.conf stanzas that configure outputs. Stanzas are named
+// "<type>", "<type>:<group>" or, for tcpout-server, "tcpout-server://<host>:<port>".
+var splunkOutputTypes = map[string]bool{
+	"httpout":         true,
+	"indexandforward": true,
+	"syslog":          true,
+	"tcpout":          true,
+	"tcpout-server":   true,
+}
+
+// parseSplunk parses the outputs of a Splunk outputs.conf file.
+func parseSplunk(ctx context.Context, r io.Reader) ([]*Output, error) {
+	var outputs []*Output
+	var typ, server string
+	var settings []setting
+	flush := func() {
+		if typ == "" {
+			return
+		}
+		if server != "" {
+			settings = append(settings, setting{key: "server", value: server})
+		}
+		if out := newOutput(typ, settings); out != nil {
+			outputs = append(outputs, out)
+		}
+	}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			flush()
+			typ, server, settings = splunkStanza(line[1:len(line)-1]), "", nil
+			if rest, ok := strings.CutPrefix(line[1:len(line)-1], "tcpout-server://"); ok {
+				server = rest
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || typ == "" {
+			continue
+		}
+		settings = append(settings, setting{key: strings.TrimSpace(key), value: strings.TrimSpace(value)})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return outputs, nil
+}
+
+// splunkStanza returns the output type of the stanza with the given name, or
+// an empty string if the stanza doesn't configure an output.
+func splunkStanza(name string) string {
+	typ, _, _ := strings.Cut(name, ":")
+	if !splunkOutputTypes[strings.ToLower(typ)] {
+		return ""
+	}
+	return typ
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privatecloud
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// cloudStackManagement is the component name of the CloudStack management
+// server.
+const cloudStackManagement = "cloudstack-management"
+
+// parseCloudStackDBProperties returns the database credentials of the cloud
+// and usage databases from the db.properties of the management server:
+//
+//	db.cloud.username=cloud
+//	db.cloud.password=ENC(kWbLqTBrnCsJzNDmnwDeqA==)
+//	db.cloud.host=localhost
+//	db.cloud.encrypt.secret=ENC(iR2MnLkiSbMdC7W1y2vbUg==)
+//
+// Values wrapped in ENC() are encrypted with the management server key.
+func parseCloudStackDBProperties(r io.Reader) ([]*Credential, error) {
+	props := map[string]string{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		props[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	var creds []*Credential
+	for _, db := range []string{"cloud", "usage"} {
+		key := "db." + db + ".password"
+		password, encrypted := unwrapEncrypted(props[key])
+		if password == "" {
+			continue
+		}
+		user := props["db."+db+".username"]
+		creds = append(creds, &Credential{
+			Type:      CredentialDatabase,
+			Key:       key,
+			Principal: user,
+			Secret:    password,
+			Host:      props["db."+db+".host"],
+			Encrypted: encrypted,
+			Admin:     user == "root",
+		})
+	}
+	if secret, encrypted := unwrapEncrypted(props["db.cloud.encrypt.secret"]); secret != "" {
+		creds = append(creds, &Credential{
+			Type:      CredentialEncryptionKey,
+			Key:       "db.cloud.encrypt.secret",
+			Secret:    secret,
+			Encrypted: encrypted,
+		})
+	}
+	return creds, nil
+}
+
+// unwrapEncrypted returns the value inside ENC(...) and whether v was wrapped.
+func unwrapEncrypted(v string) (string, bool) {
+	if strings.HasPrefix(v, "ENC(") && strings.HasSuffix(v, ")") {
+		return v[len("ENC(") : len(v)-1], true
+	}
+	return v, false
+}
+
+// cloudStackVersionFromJar returns the version from the name of the
+// management server jar, e.g. "4.18.1.0" for cloudstack-4.18.1.0.jar.
+func cloudStackVersionFromJar(name string) string {
+	version := strings.TrimSuffix(strings.TrimPrefix(name, "cloudstack-"), ".jar")
+	if version == "" || !unicode.IsDigit(rune(version[0])) {
+		return ""
+	}
+	return version
+}
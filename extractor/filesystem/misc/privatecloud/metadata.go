@@ -0,0 +1,79 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privatecloud
+
+// Metadata holds information about a control plane component of a private
+// cloud, including the service credentials stored in its configuration.
+type Metadata struct {
+	// The cloud platform, e.g. "openstack".
+	Platform string
+	// The component of the platform, e.g. "nova" or "pve-manager".
+	Component   string
+	Credentials []*Credential
+}
+
+// CredentialType is the kind of a private cloud credential.
+type CredentialType string
+
+// Supported credential types.
+const (
+	// CredentialDatabase is the user and password of a database connection,
+	// e.g. from an oslo.db connection URL or CloudStack's db.properties.
+	CredentialDatabase CredentialType = "database"
+	// CredentialMessageQueue is the user and password of a message queue
+	// connection, e.g. from an oslo.messaging RabbitMQ transport_url.
+	CredentialMessageQueue CredentialType = "message-queue"
+	// CredentialServicePassword is the Keystone password of a service user,
+	// e.g. from the [keystone_authtoken] section.
+	CredentialServicePassword CredentialType = "service-password"
+	// CredentialAdminToken is the Keystone admin_token that bypasses
+	// authentication.
+	CredentialAdminToken CredentialType = "admin-token"
+	// CredentialSharedSecret is a secret shared between services, e.g. the
+	// Neutron metadata proxy shared secret.
+	CredentialSharedSecret CredentialType = "shared-secret"
+	// CredentialAPIToken is a Proxmox VE API token.
+	CredentialAPIToken CredentialType = "api-token"
+	// CredentialPassword is a password stored on its own, e.g. the password of
+	// a Proxmox VE storage.
+	CredentialPassword CredentialType = "password"
+	// CredentialPrivateKey is a private key, e.g. the key Proxmox VE signs
+	// authentication tickets with. Reported by location.
+	CredentialPrivateKey CredentialType = "private-key"
+	// CredentialEncryptionKey is a key that decrypts other credentials, e.g.
+	// the CloudStack management server key.
+	CredentialEncryptionKey CredentialType = "encryption-key"
+)
+
+// Credential is a secret that grants access to a private cloud service.
+type Credential struct {
+	Type CredentialType
+	// The config key of the credential, e.g. "database.connection" or
+	// "db.cloud.password". Empty for credentials stored in their own file.
+	Key string
+	// The user the credential belongs to, e.g. "nova" or "root@pam!ci".
+	Principal string
+	// The secret. Empty for private keys, which are reported by location.
+	Secret string
+	// The host the credential is used for, e.g. the database or RabbitMQ
+	// host. Empty if the config doesn't contain it.
+	Host string
+	// Whether the secret is stored encrypted, e.g. CloudStack's ENC(...)
+	// values.
+	Encrypted bool
+	// Whether the credential grants administrative access to the cloud, e.g.
+	// the Keystone admin_token or a token of Proxmox VE's root@pam.
+	Admin bool
+}
@@ -0,0 +1,397 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package privatecloud extracts the control plane components of private
+// clouds (OpenStack, Apache CloudStack and Proxmox VE) from their config
+// directories together with the service credentials stored in them. These
+// credentials usually grant access to the cloud's database and message queue
+// and thus to all of its tenants.
+package privatecloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/privatecloud"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+
+	// maxSmallFileBytes is the maximum number of bytes read from files that
+	// only contain a secret or a version.
+	maxSmallFileBytes = 64 * units.KiB
+)
+
+// Supported private cloud platforms.
+const (
+	PlatformOpenStack  = "openstack"
+	PlatformCloudStack = "cloudstack"
+	PlatformProxmox    = "proxmox"
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// The config of an OpenStack service, e.g. /etc/nova/nova.conf or
+	// /etc/kolla/nova-api/nova.conf.
+	kindOpenStackService
+	// The Kolla Ansible globals, /etc/kolla/globals.yml.
+	kindKollaGlobals
+	// The OpenStack-Ansible release file, /etc/openstack-release.
+	kindOSARelease
+	// The database settings of the CloudStack management server,
+	// /etc/cloudstack/management/db.properties.
+	kindCloudStackDB
+	// The key that decrypts the values of db.properties,
+	// /etc/cloudstack/management/key.
+	kindCloudStackKey
+	// The jar of the CloudStack management server, e.g.
+	// /usr/share/cloudstack-management/lib/cloudstack-4.18.1.0.jar.
+	kindCloudStackJar
+	// The API token secrets of Proxmox VE, /etc/pve/priv/token.cfg.
+	kindPVETokens
+	// The key Proxmox VE signs authentication tickets with,
+	// /etc/pve/priv/authkey.key.
+	kindPVEAuthKey
+	// The password of a Proxmox VE storage, e.g. /etc/pve/priv/storage/nas.pw.
+	kindPVEStoragePassword
+	// The build config of pve-manager, /usr/share/perl5/PVE/pvecfg.pm.
+	kindPVECfg
+)
+
+// openStackServices are the OpenStack services whose configs are extracted.
+var openStackServices = map[string]bool{
+	"aodh":       true,
+	"barbican":   true,
+	"ceilometer": true,
+	"cinder":     true,
+	"designate":  true,
+	"glance":     true,
+	"gnocchi":    true,
+	"heat":       true,
+	"ironic":     true,
+	"keystone":   true,
+	"magnum":     true,
+	"manila":     true,
+	"neutron":    true,
+	"nova":       true,
+	"octavia":    true,
+	"placement":  true,
+	"trove":      true,
+}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the private cloud
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts private cloud control plane components and their
+// credentials.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a private cloud extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is a config, credential or
+// version file of a supported private cloud platform.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// kindForPath returns the kind of the file at p.
+func kindForPath(p string) fileKind {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	dir := path.Dir(p)
+	switch {
+	case path.Ext(base) == ".conf" && isOpenStackServiceDir(dir, strings.TrimSuffix(base, ".conf")):
+		return kindOpenStackService
+	case base == "globals.yml" && path.Base(dir) == "kolla":
+		return kindKollaGlobals
+	case base == "openstack-release" && path.Base(dir) == "etc":
+		return kindOSARelease
+	case base == "db.properties" && strings.HasSuffix(dir, "cloudstack/management"):
+		return kindCloudStackDB
+	case base == "key" && strings.HasSuffix(dir, "cloudstack/management"):
+		return kindCloudStackKey
+	case strings.HasPrefix(base, "cloudstack-") && path.Ext(base) == ".jar" && strings.HasSuffix(dir, "cloudstack-management/lib"):
+		return kindCloudStackJar
+	case base == "token.cfg" && strings.HasSuffix(dir, "pve/priv"):
+		return kindPVETokens
+	case base == "authkey.key" && strings.HasSuffix(dir, "pve/priv"):
+		return kindPVEAuthKey
+	case path.Ext(base) == ".pw" && strings.HasSuffix(dir, "pve/priv/storage"):
+		return kindPVEStoragePassword
+	case base == "pvecfg.pm" && strings.HasSuffix(dir, "perl5/PVE"):
+		return kindPVECfg
+	}
+	return kindNone
+}
+
+// isOpenStackServiceDir returns true if service is a supported OpenStack
+// service and one of the directories in dir belongs to it, e.g. "etc/nova" or
+// "etc/kolla/nova-api" for "nova".
+func isOpenStackServiceDir(dir, service string) bool {
+	if !openStackServices[service] {
+		return false
+	}
+	for _, d := range strings.Split(dir, "/") {
+		if d == service || strings.HasPrefix(d, service+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// private cloud component it describes.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	i := &extractor.Inventory{Locations: []string{input.Path}}
+	m := &Metadata{}
+	switch kind := kindForPath(input.Path); kind {
+	case kindOpenStackService:
+		creds, err := parseOpenStackConfig(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		m.Platform = PlatformOpenStack
+		m.Component = strings.TrimSuffix(path.Base(filepath.ToSlash(input.Path)), ".conf")
+		m.Credentials = creds
+	case kindKollaGlobals, kindOSARelease:
+		parse := parseKollaGlobals
+		if kind == kindOSARelease {
+			parse = parseOSARelease
+		}
+		version, err := parse(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if version == "" {
+			return nil, nil
+		}
+		m.Platform = PlatformOpenStack
+		m.Component = PlatformOpenStack
+		i.Version = version
+	case kindCloudStackDB:
+		creds, err := parseCloudStackDBProperties(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if len(creds) == 0 {
+			return nil, nil
+		}
+		m.Platform = PlatformCloudStack
+		m.Component = cloudStackManagement
+		m.Credentials = creds
+	case kindCloudStackKey:
+		key, err := readSecretFile(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			return nil, nil
+		}
+		m.Platform = PlatformCloudStack
+		m.Component = cloudStackManagement
+		m.Credentials = []*Credential{{Type: CredentialEncryptionKey, Secret: key}}
+	case kindCloudStackJar:
+		m.Platform = PlatformCloudStack
+		m.Component = cloudStackManagement
+		i.Version = cloudStackVersionFromJar(path.Base(filepath.ToSlash(input.Path)))
+	case kindPVETokens:
+		creds, err := parsePVETokens(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if len(creds) == 0 {
+			return nil, nil
+		}
+		m.Platform = PlatformProxmox
+		m.Component = pveManager
+		m.Credentials = creds
+	case kindPVEAuthKey:
+		m.Platform = PlatformProxmox
+		m.Component = pveManager
+		m.Credentials = []*Credential{{Type: CredentialPrivateKey, Admin: true}}
+	case kindPVEStoragePassword:
+		password, err := readSecretFile(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if password == "" {
+			return nil, nil
+		}
+		m.Platform = PlatformProxmox
+		m.Component = pveManager
+		m.Credentials = []*Credential{{
+			Type:      CredentialPassword,
+			Principal: strings.TrimSuffix(path.Base(filepath.ToSlash(input.Path)), ".pw"),
+			Secret:    password,
+		}}
+	case kindPVECfg:
+		version, err := parsePVECfg(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if version == "" {
+			return nil, nil
+		}
+		m.Platform = PlatformProxmox
+		m.Component = pveManager
+		i.Version = version
+	default:
+		return nil, fmt.Errorf("unsupported file %s", input.Path)
+	}
+	i.Name = m.Component
+	i.Metadata = m
+	return i, nil
+}
+
+// readSecretFile returns the trimmed contents of a file that only contains a
+// secret.
+func readSecretFile(r io.Reader) (string, error) {
+	b, err := io.ReadAll(io.LimitReader(r, maxSmallFileBytes))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track private cloud
+// deployments.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,517 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privatecloud_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/privatecloud"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "nova config",
+			path:             "etc/nova/nova.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "kolla service config",
+			path:             "etc/kolla/neutron-server/neutron.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "service config outside the service's directory",
+			path:         "home/user/nova.conf",
+			wantRequired: false,
+		},
+		{
+			name:         "config of an unknown service",
+			path:         "etc/nginx/nginx.conf",
+			wantRequired: false,
+		},
+		{
+			name:             "kolla globals",
+			path:             "etc/kolla/globals.yml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "openstack-ansible release",
+			path:             "etc/openstack-release",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "cloudstack db.properties",
+			path:             "etc/cloudstack/management/db.properties",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated db.properties",
+			path:         "opt/app/conf/db.properties",
+			wantRequired: false,
+		},
+		{
+			name:             "cloudstack key",
+			path:             "etc/cloudstack/management/key",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "cloudstack jar",
+			path:             "usr/share/cloudstack-management/lib/cloudstack-4.18.1.0.jar",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "proxmox token.cfg",
+			path:             "etc/pve/priv/token.cfg",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "proxmox authkey",
+			path:             "etc/pve/priv/authkey.key",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "proxmox storage password",
+			path:             "etc/pve/priv/storage/nas.pw",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "pvecfg.pm",
+			path:             "usr/share/perl5/PVE/pvecfg.pm",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "etc/nova/nova.conf",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "etc/nova/nova.conf",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := privatecloud.New(privatecloud.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "nova config",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/nova/nova.conf",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "nova",
+				Locations: []string{"testdata/etc/nova/nova.conf"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "openstack",
+					Component: "nova",
+					Credentials: []*privatecloud.Credential{
+						{
+							Type:      privatecloud.CredentialMessageQueue,
+							Key:       "DEFAULT.transport_url",
+							Principal: "openstack",
+							Secret:    "R4bb1t#Pass",
+							Host:      "ctrl1:5672",
+						},
+						{
+							Type:      privatecloud.CredentialDatabase,
+							Key:       "api_database.connection",
+							Principal: "nova",
+							Secret:    "N0vaAp1DB",
+							Host:      "ctrl1",
+						},
+						{
+							Type:      privatecloud.CredentialDatabase,
+							Key:       "database.connection",
+							Principal: "nova",
+							Secret:    "N0vaDBPass",
+							Host:      "ctrl1",
+						},
+						{
+							Type:      privatecloud.CredentialServicePassword,
+							Key:       "keystone_authtoken.password",
+							Principal: "nova",
+							Secret:    "N0vaK3yst0ne",
+						},
+						{
+							Type:      privatecloud.CredentialServicePassword,
+							Key:       "neutron.password",
+							Principal: "neutron",
+							Secret:    "N3utr0nPass",
+						},
+						{
+							Type:   privatecloud.CredentialSharedSecret,
+							Key:    "neutron.metadata_proxy_shared_secret",
+							Secret: "M3taS3cr3t",
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "keystone admin token and root database user",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/keystone/keystone.conf",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "keystone",
+				Locations: []string{"testdata/etc/keystone/keystone.conf"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "openstack",
+					Component: "keystone",
+					Credentials: []*privatecloud.Credential{
+						{
+							Type:   privatecloud.CredentialAdminToken,
+							Key:    "DEFAULT.admin_token",
+							Secret: "7c3e1f0a9b8d4e2f",
+							Admin:  true,
+						},
+						{
+							Type:      privatecloud.CredentialDatabase,
+							Key:       "database.connection",
+							Principal: "root",
+							Secret:    "R00tDB",
+							Host:      "db.internal:3306",
+							Admin:     true,
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "service config without credentials",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/glance/glance.conf",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "glance",
+				Locations: []string{"testdata/etc/glance/glance.conf"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "openstack",
+					Component: "glance",
+				},
+			}},
+		},
+		{
+			Name: "kolla service config",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/kolla/neutron-server/neutron.conf",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "neutron",
+				Locations: []string{"testdata/etc/kolla/neutron-server/neutron.conf"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "openstack",
+					Component: "neutron",
+					Credentials: []*privatecloud.Credential{{
+						Type:      privatecloud.CredentialMessageQueue,
+						Key:       "DEFAULT.transport_url",
+						Principal: "openstack",
+						Secret:    "kolla-rabbit",
+						Host:      "192.168.1.10:5672",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "kolla globals",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/kolla/globals.yml",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "openstack",
+				Version:   "2023.2",
+				Locations: []string{"testdata/etc/kolla/globals.yml"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "openstack",
+					Component: "openstack",
+				},
+			}},
+		},
+		{
+			Name: "kolla globals without release",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/etc/kolla/globals.yml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "openstack-ansible release",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/openstack-release",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "openstack",
+				Version:   "28.0.0",
+				Locations: []string{"testdata/etc/openstack-release"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "openstack",
+					Component: "openstack",
+				},
+			}},
+		},
+		{
+			Name: "cloudstack db.properties",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/cloudstack/management/db.properties",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "cloudstack-management",
+				Locations: []string{"testdata/etc/cloudstack/management/db.properties"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "cloudstack",
+					Component: "cloudstack-management",
+					Credentials: []*privatecloud.Credential{
+						{
+							Type:      privatecloud.CredentialDatabase,
+							Key:       "db.cloud.password",
+							Principal: "cloud",
+							Secret:    "kWbLqTBrnCsJzNDmnwDeqA==",
+							Host:      "db.cloudstack.internal",
+							Encrypted: true,
+						},
+						{
+							Type:      privatecloud.CredentialDatabase,
+							Key:       "db.usage.password",
+							Principal: "cloud",
+							Secret:    "Us4geDBPass",
+							Host:      "localhost",
+						},
+						{
+							Type:      privatecloud.CredentialEncryptionKey,
+							Key:       "db.cloud.encrypt.secret",
+							Secret:    "iR2MnLkiSbMdC7W1y2vbUg==",
+							Encrypted: true,
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "cloudstack key",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/cloudstack/management/key",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "cloudstack-management",
+				Locations: []string{"testdata/etc/cloudstack/management/key"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "cloudstack",
+					Component: "cloudstack-management",
+					Credentials: []*privatecloud.Credential{{
+						Type:   privatecloud.CredentialEncryptionKey,
+						Secret: "MgmtS3rv3rK3y",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "cloudstack jar",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/usr/share/cloudstack-management/lib/cloudstack-4.18.1.0.jar",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "cloudstack-management",
+				Version:   "4.18.1.0",
+				Locations: []string{"testdata/usr/share/cloudstack-management/lib/cloudstack-4.18.1.0.jar"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "cloudstack",
+					Component: "cloudstack-management",
+				},
+			}},
+		},
+		{
+			Name: "proxmox api tokens",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/pve/priv/token.cfg",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "pve-manager",
+				Locations: []string{"testdata/etc/pve/priv/token.cfg"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "proxmox",
+					Component: "pve-manager",
+					Credentials: []*privatecloud.Credential{
+						{
+							Type:      privatecloud.CredentialAPIToken,
+							Principal: "root@pam!automation",
+							Secret:    "5e0c8a4e-2f1b-4d5a-9c3e-7b6a1d2f3e4c",
+							Admin:     true,
+						},
+						{
+							Type:      privatecloud.CredentialAPIToken,
+							Principal: "monitor@pve!grafana",
+							Secret:    "9a7b6c5d-4e3f-4a1b-8c2d-1e0f9a8b7c6d",
+						},
+					},
+				},
+			}},
+		},
+		{
+			Name: "proxmox ticket signing key",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/pve/priv/authkey.key",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "pve-manager",
+				Locations: []string{"testdata/etc/pve/priv/authkey.key"},
+				Metadata: &privatecloud.Metadata{
+					Platform:    "proxmox",
+					Component:   "pve-manager",
+					Credentials: []*privatecloud.Credential{{Type: privatecloud.CredentialPrivateKey, Admin: true}},
+				},
+			}},
+		},
+		{
+			Name: "proxmox storage password",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/pve/priv/storage/nas.pw",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "pve-manager",
+				Locations: []string{"testdata/etc/pve/priv/storage/nas.pw"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "proxmox",
+					Component: "pve-manager",
+					Credentials: []*privatecloud.Credential{{
+						Type:      privatecloud.CredentialPassword,
+						Principal: "nas",
+						Secret:    "NasSt0rageP4ss",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "pvecfg.pm",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/usr/share/perl5/PVE/pvecfg.pm",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "pve-manager",
+				Version:   "8.1.4",
+				Locations: []string{"testdata/usr/share/perl5/PVE/pvecfg.pm"},
+				Metadata: &privatecloud.Metadata{
+					Platform:  "proxmox",
+					Component: "pve-manager",
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := privatecloud.New(privatecloud.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := privatecloud.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "pve-manager",
+		Version:   "8.1.4",
+		Locations: []string{"usr/share/perl5/PVE/pvecfg.pm"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "pve-manager",
+		Version: "8.1.4",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privatecloud
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// pveManager is the component name of Proxmox VE.
+const pveManager = "pve-manager"
+
+// pveVersionRe matches the version function of PVE/pvecfg.pm:
+//
+//	sub version {
+//	    return '8.1.4';
+//	}
+var pveVersionRe = regexp.MustCompile(`sub\s+version\s*\{\s*return\s+'([^']+)'`)
+
+// parsePVETokens returns the API tokens in token.cfg, which has one line per
+// token with its ID and secret, e.g.
+// "root@pam!automation 5e0c8a4e-2f1b-4d5a-9c3e-7b6a1d2f3e4c".
+func parsePVETokens(r io.Reader) ([]*Credential, error) {
+	var creds []*Credential
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		user, _, ok := strings.Cut(fields[0], "!")
+		if !ok {
+			continue
+		}
+		creds = append(creds, &Credential{
+			Type:      CredentialAPIToken,
+			Principal: fields[0],
+			Secret:    fields[1],
+			// Tokens of root@pam without privilege separation have all of its
+			// privileges.
+			Admin: user == "root@pam",
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
+// parsePVECfg returns the pve-manager version from PVE/pvecfg.pm.
+func parsePVECfg(r io.Reader) (string, error) {
+	b, err := io.ReadAll(io.LimitReader(r, maxSmallFileBytes))
+	if err != nil {
+		return "", err
+	}
+	m := pveVersionRe.FindSubmatch(b)
+	if m == nil {
+		return "", nil
+	}
+	return string(m[1]), nil
+}
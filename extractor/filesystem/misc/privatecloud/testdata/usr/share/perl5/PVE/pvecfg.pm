@@ -0,0 +1,22 @@
+package PVE::pvecfg;
+
+use strict;
+use warnings;
+
+sub package {
+    return 'pve-manager';
+}
+
+sub version {
+    return '8.1.4';
+}
+
+sub release {
+    return '8.1';
+}
+
+sub repoid {
+    return 'ec5affc9e41f1d79';
+}
+
+1;
@@ -0,0 +1,63 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryconfig
+
+// Role is what a configured registry is used for.
+type Role string
+
+// Role values.
+const (
+	// RoleIndex is the registry packages are installed from by default, e.g.
+	// pip's index-url or npm's registry.
+	RoleIndex Role = "index"
+	// RoleExtraIndex is an additional registry that's searched together with
+	// the default one, e.g. pip's extra-index-url.
+	RoleExtraIndex Role = "extra-index"
+	// RoleScope is the registry of an npm scope, e.g. @corp:registry.
+	RoleScope Role = "scope"
+	// RoleProxy is an entry of GOPROXY. Besides proxy URLs, the entries can
+	// be "direct" and "off".
+	RoleProxy Role = "proxy"
+)
+
+// Registry is a package registry or proxy configured for a package manager.
+type Registry struct {
+	// The URL of the registry as configured, e.g. "https://pypi.corp.example/simple".
+	URL  string
+	Role Role
+	// The npm scope the registry is used for, e.g. "@corp". Only set for
+	// RoleScope.
+	Scope string
+}
+
+// Metadata holds the registries and security relevant settings of a package
+// manager config.
+type Metadata struct {
+	// The package manager: "pip", "npm" or "go".
+	Tool       string
+	Registries []*Registry
+	// Hosts for which TLS certificate verification or HTTPS is disabled, e.g.
+	// pip's trusted-host or the module patterns of GOINSECURE.
+	InsecureHosts []string
+	// Whether TLS certificate verification is disabled for all registries,
+	// e.g. npm's strict-ssl=false.
+	TLSVerifyDisabled bool
+	// The checksum database setting of Go, e.g. "off". Only set if
+	// configured.
+	GoSumDB string
+	// Module patterns whose checksums aren't verified against the checksum
+	// database, from GONOSUMDB.
+	GoNoSumDB []string
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryconfig
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// parsePipConfig parses a pip.conf or pip.ini file. The settings can be in any
+// section, e.g. [global] or [install], and list values can continue on
+// indented lines.
+func parsePipConfig(r io.Reader) (*Metadata, error) {
+	m := &Metadata{Tool: ToolPip}
+	var key string
+	add := func(key, value string) {
+		for _, v := range strings.Fields(value) {
+			switch key {
+			case "index-url":
+				m.Registries = append(m.Registries, &Registry{URL: v, Role: RoleIndex})
+			case "extra-index-url":
+				m.Registries = append(m.Registries, &Registry{URL: v, Role: RoleExtraIndex})
+			case "trusted-host":
+				m.InsecureHosts = append(m.InsecureHosts, v)
+			}
+		}
+	}
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";") {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			key = ""
+			continue
+		}
+		// Continuation of the previous value.
+		if key != "" && (line[0] == ' ' || line[0] == '\t') {
+			add(key, trimmed)
+			continue
+		}
+		k, v, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			k, v, ok = strings.Cut(trimmed, ":")
+		}
+		if !ok {
+			key = ""
+			continue
+		}
+		key = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(k)), "_", "-")
+		add(key, v)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parseNPMRC parses an .npmrc file.
+func parseNPMRC(r io.Reader) (*Metadata, error) {
+	m := &Metadata{Tool: ToolNPM}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		k = strings.TrimSpace(k)
+		v = unquote(strings.TrimSpace(v))
+		switch {
+		case k == "registry":
+			m.Registries = append(m.Registries, &Registry{URL: v, Role: RoleIndex})
+		case strings.HasPrefix(k, "@") && strings.HasSuffix(k, ":registry"):
+			m.Registries = append(m.Registries, &Registry{URL: v, Role: RoleScope, Scope: strings.TrimSuffix(k, ":registry")})
+		case k == "strict-ssl":
+			m.TLSVerifyDisabled = v == "false"
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parseGoEnv parses the go command's env file that `go env -w` writes.
+func parseGoEnv(r io.Reader) (*Metadata, error) {
+	m := &Metadata{Tool: ToolGo}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		v = unquote(strings.TrimSpace(v))
+		switch strings.TrimSpace(k) {
+		case "GOPROXY":
+			// Entries are separated by "," or "|" depending on whether the
+			// next proxy is only tried on 404s or on any error.
+			for _, p := range strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == '|' }) {
+				m.Registries = append(m.Registries, &Registry{URL: strings.TrimSpace(p), Role: RoleProxy})
+			}
+		case "GOINSECURE":
+			m.InsecureHosts = append(m.InsecureHosts, splitList(v)...)
+		case "GOSUMDB":
+			m.GoSumDB = v
+		case "GONOSUMDB":
+			m.GoNoSumDB = append(m.GoNoSumDB, splitList(v)...)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// splitList splits a comma separated list of the go command, e.g. GOINSECURE.
+func splitList(v string) []string {
+	var res []string
+	for _, p := range strings.Split(v, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// unquote removes the quotes around a value.
+func unquote(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
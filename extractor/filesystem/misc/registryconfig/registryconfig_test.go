@@ -0,0 +1,244 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryconfig_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/registryconfig"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "global pip.conf",
+			path:             "etc/pip.conf",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "windows pip.ini",
+			path:             "Users/me/AppData/Roaming/pip/pip.ini",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "project .npmrc",
+			path:             "src/app/.npmrc",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "global npmrc",
+			path:             "usr/local/etc/npmrc",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "npmrc outside of etc",
+			path:         "usr/lib/node_modules/npm/npmrc",
+			wantRequired: false,
+		},
+		{
+			name:             "go env",
+			path:             "root/.config/go/env",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated env",
+			path:         "root/.config/app/env",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "etc/pip.conf",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "etc/pip.conf",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := registryconfig.New(registryconfig.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "pip.conf with extra index",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/pip.conf",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "pip",
+				Locations: []string{"testdata/etc/pip.conf"},
+				Metadata: &registryconfig.Metadata{
+					Tool: "pip",
+					Registries: []*registryconfig.Registry{
+						{URL: "https://pypi.corp.example/simple", Role: registryconfig.RoleIndex},
+						{URL: "http://10.0.0.5:8080/simple", Role: registryconfig.RoleExtraIndex},
+						{URL: "https://pypi.org/simple", Role: registryconfig.RoleExtraIndex},
+					},
+					InsecureHosts: []string{"10.0.0.5"},
+				},
+			}},
+		},
+		{
+			Name: "pip.conf without registries",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/root/.config/pip/pip.conf",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "npmrc with scope and strict-ssl",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/project/.npmrc",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "npm",
+				Locations: []string{"testdata/project/.npmrc"},
+				Metadata: &registryconfig.Metadata{
+					Tool: "npm",
+					Registries: []*registryconfig.Registry{
+						{URL: "http://npm.corp.example/", Role: registryconfig.RoleIndex},
+						{URL: "https://npm.corp.example/", Role: registryconfig.RoleScope, Scope: "@corp"},
+					},
+					TLSVerifyDisabled: true,
+				},
+			}},
+		},
+		{
+			Name: "npmrc without registries",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/plain/etc/npmrc",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "go env",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/root/.config/go/env",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "go",
+				Locations: []string{"testdata/root/.config/go/env"},
+				Metadata: &registryconfig.Metadata{
+					Tool: "go",
+					Registries: []*registryconfig.Registry{
+						{URL: "direct", Role: registryconfig.RoleProxy},
+						{URL: "https://proxy.golang.org", Role: registryconfig.RoleProxy},
+						{URL: "off", Role: registryconfig.RoleProxy},
+					},
+					InsecureHosts: []string{"git.corp.example"},
+					GoSumDB:       "off",
+					GoNoSumDB:     []string{"*.corp.example", "github.com/corp"},
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := registryconfig.New(registryconfig.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := registryconfig.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "npm",
+		Locations: []string{"root/.npmrc"},
+	}
+	want := &purl.PackageURL{
+		Type: purl.TypeGeneric,
+		Name: "npm",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
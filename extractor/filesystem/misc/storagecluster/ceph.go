@@ -0,0 +1,131 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecluster
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"strings"
+)
+
+// parseCephKeyring returns the keys of the entities in a Ceph keyring, which
+// is an INI file with one section per entity:
+//
+//	[client.admin]
+//	        key = AQBvaBFZAAAAABAA9VHgwCg3rWn8fMaX8KL01A==
+//	        caps mon = "allow *"
+func parseCephKeyring(r io.Reader) ([]*Credential, error) {
+	var creds []*Credential
+	var current *Credential
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = &Credential{
+				Type:      CredentialCephKey,
+				Principal: strings.TrimSpace(line[1 : len(line)-1]),
+			}
+			// client.admin has all capabilities by convention.
+			current.Admin = current.Principal == "client.admin"
+			creds = append(creds, current)
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.Join(strings.Fields(key), " ")
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch {
+		case key == "key":
+			current.Secret = value
+		case key == "caps mon" && value == "allow *":
+			// Full monitor capabilities allow granting any other capability.
+			current.Admin = true
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	var withKeys []*Credential
+	for _, c := range creds {
+		if c.Secret != "" {
+			withKeys = append(withKeys, c)
+		}
+	}
+	return withKeys, nil
+}
+
+// parseCephadmImage returns the Ceph version from the unit.image file cephadm
+// writes for each daemon, e.g. "quay.io/ceph/ceph:v18.2.1". Images referenced
+// by digest don't reveal the version.
+func parseCephadmImage(r io.Reader) (string, error) {
+	b, err := io.ReadAll(io.LimitReader(r, 4096))
+	if err != nil {
+		return "", err
+	}
+	image := strings.TrimSpace(string(b))
+	if strings.Contains(image, "@") {
+		return "", nil
+	}
+	// The tag follows the last colon after the last slash, the registry may
+	// have a port.
+	_, tag, ok := strings.Cut(path.Base(image), ":")
+	if !ok {
+		return "", nil
+	}
+	return strings.TrimPrefix(tag, "v"), nil
+}
+
+// cephFSIDFromPath returns the fsid of the cluster from the path of a cephadm
+// managed daemon, e.g.
+// var/lib/ceph/5f1a7d36-6f4e-11ee-9a3b-525400c0ffee/osd.0/unit.image.
+func cephFSIDFromPath(p string) string {
+	parts := strings.Split(p, "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == "ceph" && isUUID(parts[i+1]) {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// isUUID returns true if s has the format of a UUID.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i, c := range s {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+				return false
+			}
+		}
+	}
+	return true
+}
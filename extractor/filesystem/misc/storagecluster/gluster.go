@@ -0,0 +1,61 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecluster
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// glusterInfo is the content of glusterd.info.
+type glusterInfo struct {
+	uuid    string
+	version string
+}
+
+// parseGlusterdInfo parses the glusterd.info file of a Gluster peer:
+//
+//	UUID=2b9a3b8c-5a04-4c3f-9b4e-3f6f7f0b1e52
+//	operating-version=100000
+//
+// The operating version is the release all peers of the cluster run at,
+// encoded as X*10000+Y*100+Z for release X.Y.Z.
+func parseGlusterdInfo(r io.Reader) (*glusterInfo, error) {
+	info := &glusterInfo{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(s.Text()), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "UUID":
+			info.uuid = value
+		case "operating-version":
+			opVersion, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid operating-version %q: %w", value, err)
+			}
+			info.version = fmt.Sprintf("%d.%d.%d", opVersion/10000, opVersion/100%100, opVersion%100)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
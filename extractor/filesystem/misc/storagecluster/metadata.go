@@ -0,0 +1,52 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecluster
+
+// Metadata holds information about a storage cluster component found on a
+// storage node, including the credentials stored on it.
+type Metadata struct {
+	// The storage system, e.g. "ceph".
+	Component string
+	// Identifier of the cluster, e.g. the Ceph fsid or the UUID of a Gluster
+	// peer. Empty if the file doesn't contain it.
+	ClusterID   string
+	Credentials []*Credential
+}
+
+// CredentialType is the kind of a storage cluster credential.
+type CredentialType string
+
+// Supported credential types.
+const (
+	// CredentialCephKey is the key of a Ceph entity (user or daemon) from a keyring.
+	CredentialCephKey CredentialType = "ceph-key"
+	// CredentialTLSPrivateKey is the private key a Gluster node uses for TLS.
+	CredentialTLSPrivateKey CredentialType = "tls-private-key"
+	// CredentialS3 is an S3 access key and secret key pair of MinIO.
+	CredentialS3 CredentialType = "s3"
+)
+
+// Credential is a secret that grants access to a storage cluster.
+type Credential struct {
+	Type CredentialType
+	// The entity or user the credential belongs to, e.g. "client.admin" or the
+	// MinIO access key.
+	Principal string
+	// The secret. Empty for TLS private keys, which are reported by location.
+	Secret string
+	// Whether the credential grants full access to the cluster and its data,
+	// e.g. Ceph's client.admin or the MinIO root user.
+	Admin bool
+}
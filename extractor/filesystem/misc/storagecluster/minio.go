@@ -0,0 +1,116 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecluster
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+)
+
+// parseMinIOEnv returns the root credentials from the environment file of the
+// MinIO server's systemd unit, usually /etc/default/minio. The deprecated
+// MINIO_ACCESS_KEY and MINIO_SECRET_KEY variables are used if the current
+// ones aren't set.
+func parseMinIOEnv(r io.Reader) ([]*Credential, error) {
+	vars := make(map[string]string)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !ok {
+			continue
+		}
+		vars[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	user, password := vars["MINIO_ROOT_USER"], vars["MINIO_ROOT_PASSWORD"]
+	if password == "" {
+		user, password = vars["MINIO_ACCESS_KEY"], vars["MINIO_SECRET_KEY"]
+	}
+	if password == "" || isReference(password) {
+		return nil, nil
+	}
+	return []*Credential{{
+		Type:      CredentialS3,
+		Principal: user,
+		Secret:    password,
+		Admin:     true,
+	}}, nil
+}
+
+// mcConfig is the config.json of the MinIO client, mc.
+type mcConfig struct {
+	// Called "hosts" before mc switched to "aliases".
+	Aliases map[string]mcAlias `json:"aliases"`
+	Hosts   map[string]mcAlias `json:"hosts"`
+}
+
+type mcAlias struct {
+	URL       string `json:"url"`
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// playURL is MinIO's public playground. mc ships with an alias for it with
+// publicly known credentials.
+const playURL = "https://play.min.io"
+
+// parseMCConfig returns the credentials of the aliases configured for the
+// MinIO client. The placeholders of the default gcs and s3 aliases and the
+// playground's credentials are ignored.
+func parseMCConfig(r io.Reader) ([]*Credential, error) {
+	var cfg mcConfig
+	if err := json.NewDecoder(r).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	aliases := cfg.Aliases
+	if len(aliases) == 0 {
+		aliases = cfg.Hosts
+	}
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var creds []*Credential
+	for _, name := range names {
+		a := aliases[name]
+		if a.SecretKey == "" || isReference(a.SecretKey) || strings.HasPrefix(a.SecretKey, "YOUR-") || a.URL == playURL {
+			continue
+		}
+		creds = append(creds, &Credential{
+			Type:      CredentialS3,
+			Principal: a.AccessKey,
+			Secret:    a.SecretKey,
+		})
+	}
+	return creds, nil
+}
+
+// isReference returns true if value refers to a secret stored elsewhere, e.g.
+// an environment variable.
+func isReference(value string) bool {
+	return strings.HasPrefix(value, "$")
+}
@@ -0,0 +1,288 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storagecluster extracts the components of storage clusters (Ceph,
+// GlusterFS and MinIO) found on storage nodes together with the credentials
+// stored on them. Storage admin keys usually grant full access to all data in
+// the cluster.
+package storagecluster
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/storagecluster"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 1 * units.MiB
+)
+
+// Supported storage systems.
+const (
+	ComponentCeph      = "ceph"
+	ComponentGlusterFS = "glusterfs"
+	ComponentMinIO     = "minio"
+)
+
+// fileKind is the kind of file the extractor handles.
+type fileKind int
+
+const (
+	kindNone fileKind = iota
+	// A Ceph keyring, e.g. /etc/ceph/ceph.client.admin.keyring.
+	kindCephKeyring
+	// The container image of a cephadm managed daemon.
+	kindCephadmImage
+	// /var/lib/glusterd/glusterd.info.
+	kindGlusterdInfo
+	// The TLS private key of a Gluster node, /etc/ssl/glusterfs.key.
+	kindGlusterTLSKey
+	// The environment file of the MinIO server, /etc/default/minio.
+	kindMinIOEnv
+	// The config of the MinIO client, ~/.mc/config.json.
+	kindMCConfig
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the storage cluster
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts storage cluster components and credentials.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a storage cluster extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is a config, keyring or
+// state file of a supported storage system.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if kindForPath(path) == kindNone {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// kindForPath returns the kind of the file at p.
+func kindForPath(p string) fileKind {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	dir := path.Dir(p)
+	switch {
+	case (base == "keyring" || path.Ext(base) == ".keyring") && hasDirWithPrefix(dir, "ceph"):
+		return kindCephKeyring
+	case base == "unit.image" && hasDirWithPrefix(dir, "ceph"):
+		return kindCephadmImage
+	case base == "glusterd.info":
+		return kindGlusterdInfo
+	case base == "glusterfs.key":
+		return kindGlusterTLSKey
+	case base == "minio" && path.Base(dir) == "default":
+		return kindMinIOEnv
+	case base == "config.json" && path.Base(dir) == ".mc":
+		return kindMCConfig
+	}
+	return kindNone
+}
+
+// hasDirWithPrefix returns true if any of the directories in dir start with
+// prefix, e.g. "var/lib/ceph/osd/ceph-0" for "ceph".
+func hasDirWithPrefix(dir, prefix string) bool {
+	for _, d := range strings.Split(dir, "/") {
+		if strings.HasPrefix(d, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// storage cluster component it describes.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	if inventory == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{inventory}, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) (*extractor.Inventory, error) {
+	i := &extractor.Inventory{Locations: []string{input.Path}}
+	m := &Metadata{}
+	switch kind := kindForPath(input.Path); kind {
+	case kindCephKeyring:
+		creds, err := parseCephKeyring(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if len(creds) == 0 {
+			return nil, nil
+		}
+		m.Component = ComponentCeph
+		m.ClusterID = cephFSIDFromPath(filepath.ToSlash(input.Path))
+		m.Credentials = creds
+	case kindCephadmImage:
+		version, err := parseCephadmImage(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		m.Component = ComponentCeph
+		m.ClusterID = cephFSIDFromPath(filepath.ToSlash(input.Path))
+		i.Version = version
+	case kindGlusterdInfo:
+		info, err := parseGlusterdInfo(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		m.Component = ComponentGlusterFS
+		m.ClusterID = info.uuid
+		i.Version = info.version
+	case kindGlusterTLSKey:
+		m.Component = ComponentGlusterFS
+		m.Credentials = []*Credential{{Type: CredentialTLSPrivateKey}}
+	case kindMinIOEnv, kindMCConfig:
+		parse := parseMinIOEnv
+		if kind == kindMCConfig {
+			parse = parseMCConfig
+		}
+		creds, err := parse(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		if len(creds) == 0 {
+			return nil, nil
+		}
+		m.Component = ComponentMinIO
+		m.Credentials = creds
+	default:
+		return nil, fmt.Errorf("unsupported file %s", input.Path)
+	}
+	i.Name = m.Component
+	i.Metadata = m
+	return i, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track storage cluster
+// deployments.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,355 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagecluster_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/storagecluster"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+const fsid = "5f1a7d36-6f4e-11ee-9a3b-525400c0ffee"
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "ceph admin keyring",
+			path:             "etc/ceph/ceph.client.admin.keyring",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "ceph daemon keyring",
+			path:             "var/lib/ceph/osd/ceph-0/keyring",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "keyring of something else",
+			path:         "home/user/.local/share/keyrings/login.keyring",
+			wantRequired: false,
+		},
+		{
+			name:             "cephadm unit image",
+			path:             "var/lib/ceph/" + fsid + "/osd.0/unit.image",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "glusterd.info",
+			path:             "var/lib/glusterd/glusterd.info",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "gluster tls key",
+			path:             "etc/ssl/glusterfs.key",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "minio environment file",
+			path:             "etc/default/minio",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "mc config",
+			path:             "root/.mc/config.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated config.json",
+			path:         "root/.docker/config.json",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "etc/ceph/ceph.client.admin.keyring",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "etc/ceph/ceph.client.admin.keyring",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := storagecluster.New(storagecluster.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "ceph admin keyring",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/ceph/ceph.client.admin.keyring",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ceph",
+				Locations: []string{"testdata/etc/ceph/ceph.client.admin.keyring"},
+				Metadata: &storagecluster.Metadata{
+					Component: "ceph",
+					Credentials: []*storagecluster.Credential{{
+						Type:      storagecluster.CredentialCephKey,
+						Principal: "client.admin",
+						Secret:    "AQBvaBFZAAAAABAA9VHgwCg3rWn8fMaX8KL01A==",
+						Admin:     true,
+					}},
+				},
+			}},
+		},
+		{
+			Name: "ceph keyring with restricted caps",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/ceph/ceph.client.backup.keyring",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ceph",
+				Locations: []string{"testdata/etc/ceph/ceph.client.backup.keyring"},
+				Metadata: &storagecluster.Metadata{
+					Component: "ceph",
+					Credentials: []*storagecluster.Credential{{
+						Type:      storagecluster.CredentialCephKey,
+						Principal: "client.backup",
+						Secret:    "AQCx3hVlAAAAABAAuI9p0k7bM5mKzJ2eW1h0Qg==",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "cephadm daemon keyring",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/ceph/" + fsid + "/osd.0/keyring",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ceph",
+				Locations: []string{"testdata/var/lib/ceph/" + fsid + "/osd.0/keyring"},
+				Metadata: &storagecluster.Metadata{
+					Component: "ceph",
+					ClusterID: fsid,
+					Credentials: []*storagecluster.Credential{{
+						Type:      storagecluster.CredentialCephKey,
+						Principal: "osd.0",
+						Secret:    "AQDy3hVlAAAAABAAf1mYbDq0dK4p5Qx3j2nV8w==",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "cephadm image with tag",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/ceph/" + fsid + "/osd.0/unit.image",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ceph",
+				Version:   "18.2.1",
+				Locations: []string{"testdata/var/lib/ceph/" + fsid + "/osd.0/unit.image"},
+				Metadata: &storagecluster.Metadata{
+					Component: "ceph",
+					ClusterID: fsid,
+				},
+			}},
+		},
+		{
+			Name: "cephadm image with digest",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/ceph/" + fsid + "/mon.a/unit.image",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ceph",
+				Locations: []string{"testdata/var/lib/ceph/" + fsid + "/mon.a/unit.image"},
+				Metadata: &storagecluster.Metadata{
+					Component: "ceph",
+					ClusterID: fsid,
+				},
+			}},
+		},
+		{
+			Name: "glusterd.info",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/lib/glusterd/glusterd.info",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "glusterfs",
+				Version:   "10.0.0",
+				Locations: []string{"testdata/var/lib/glusterd/glusterd.info"},
+				Metadata: &storagecluster.Metadata{
+					Component: "glusterfs",
+					ClusterID: "2b9a3b8c-5a04-4c3f-9b4e-3f6f7f0b1e52",
+				},
+			}},
+		},
+		{
+			Name: "invalid glusterd.info",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/var/lib/glusterd/glusterd.info",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "invalid operating-version"},
+		},
+		{
+			Name: "gluster tls key",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/ssl/glusterfs.key",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "glusterfs",
+				Locations: []string{"testdata/etc/ssl/glusterfs.key"},
+				Metadata: &storagecluster.Metadata{
+					Component:   "glusterfs",
+					Credentials: []*storagecluster.Credential{{Type: storagecluster.CredentialTLSPrivateKey}},
+				},
+			}},
+		},
+		{
+			Name: "minio root credentials",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/etc/default/minio",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "minio",
+				Locations: []string{"testdata/etc/default/minio"},
+				Metadata: &storagecluster.Metadata{
+					Component: "minio",
+					Credentials: []*storagecluster.Credential{{
+						Type:      storagecluster.CredentialS3,
+						Principal: "storageadmin",
+						Secret:    "Zx8v!q2LmR7tP0wK",
+						Admin:     true,
+					}},
+				},
+			}},
+		},
+		{
+			Name: "mc config",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/root/.mc/config.json",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "minio",
+				Locations: []string{"testdata/root/.mc/config.json"},
+				Metadata: &storagecluster.Metadata{
+					Component: "minio",
+					Credentials: []*storagecluster.Credential{{
+						Type:      storagecluster.CredentialS3,
+						Principal: "backup-writer",
+						Secret:    "b4ckupS3cr3tK3yV4lu3",
+					}},
+				},
+			}},
+		},
+		{
+			Name: "invalid mc config",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/invalid/root/.mc/config.json",
+			},
+			WantInventory: []*extractor.Inventory{},
+			WantErr:       extracttest.ContainsErrStr{Str: "could not extract from"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := storagecluster.New(storagecluster.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := storagecluster.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "glusterfs",
+		Version:   "10.0.0",
+		Locations: []string{"var/lib/glusterd/glusterd.info"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "glusterfs",
+		Version: "10.0.0",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendoredcode
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ErrTooLarge is returned by Digest if the files of a component exceed the
+// size limit.
+var ErrTooLarge = errors.New("component exceeds the size limit")
+
+// Component is a known release of a dependency.
+type Component struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// The OSV ecosystem of the component, e.g. "npm". Empty for components
+	// that aren't packages of an ecosystem.
+	Ecosystem string `json:"ecosystem"`
+}
+
+// Corpus identifies vendored components by their content digest.
+type Corpus interface {
+	// Lookup returns the component with the given digest, as computed by
+	// Digest(), and whether it's known.
+	Lookup(digest string) (Component, bool)
+}
+
+// MapCorpus is a Corpus backed by a map from digest to component.
+type MapCorpus map[string]Component
+
+// Lookup returns the component with the given digest.
+func (c MapCorpus) Lookup(digest string) (Component, bool) {
+	comp, ok := c[digest]
+	return comp, ok
+}
+
+// corpusEntry is an entry of a corpus file.
+type corpusEntry struct {
+	Digest string `json:"digest"`
+	Component
+}
+
+// LoadCorpus reads a corpus from a JSON file holding a list of components
+// with their digests, e.g.
+//
+//	[{"digest": "sha256:9f86d0...", "name": "zlib", "version": "1.3.1"}]
+func LoadCorpus(path string) (MapCorpus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var entries []corpusEntry
+	if err := json.NewDecoder(f).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("invalid corpus %s: %w", path, err)
+	}
+	c := MapCorpus{}
+	for _, e := range entries {
+		if !strings.HasPrefix(e.Digest, "sha256:") || e.Name == "" {
+			return nil, fmt.Errorf("invalid corpus %s: entry %+v needs a sha256 digest and a name", path, e)
+		}
+		c[e.Digest] = e.Component
+	}
+	return c, nil
+}
+
+// Digest returns the content digest of the component in dir. It's the
+// SHA-256 of a listing of the regular files below dir sorted by path, with a
+// "<path>\x00<hex SHA-256 of the file>\n" line per file, so it only depends
+// on the paths and contents of the files and not on metadata such as
+// timestamps. Symlinks and .git directories are skipped. If maxBytes is
+// greater than zero and the files are larger in total, ErrTooLarge is
+// returned.
+func Digest(fsys fs.FS, dir string, maxBytes int64) (string, error) {
+	type file struct{ path, hash string }
+	var files []file
+	var total int64
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		total += info.Size()
+		if maxBytes > 0 && total > maxBytes {
+			return ErrTooLarge
+		}
+		h, err := hashFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		rel := strings.TrimPrefix(p, dir+"/")
+		files = append(files, file{path: rel, hash: h})
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	slices.SortFunc(files, func(a, b file) int { return strings.Compare(a.path, b.path) })
+	h := sha256.New()
+	for _, f := range files {
+		fmt.Fprintf(h, "%s\x00%s\n", f.path, f.hash)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func hashFile(fsys fs.FS, p string) (string, error) {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,264 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendoredcode
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"golang.org/x/mod/modfile"
+)
+
+// maxManifestFileBytes is the maximum size of a manifest that's parsed.
+const maxManifestFileBytes = 1 * units.MiB
+
+// OSV ecosystems of the identified components.
+const (
+	ecosystemNPM       = "npm"
+	ecosystemPyPI      = "PyPI"
+	ecosystemCrates    = "crates.io"
+	ecosystemPackagist = "Packagist"
+	ecosystemGo        = "Go"
+)
+
+// identity is the name, version and ecosystem of a component.
+type identity struct {
+	name      string
+	version   string
+	ecosystem string
+}
+
+// manifests are the package manifests that identify a component if found at
+// the top of its directory, in the order they're checked.
+var manifests = []struct {
+	file  string
+	parse func(io.Reader) (*identity, error)
+}{
+	{"package.json", parsePackageJSON},
+	{"Cargo.toml", parseCargoToml},
+	{"composer.json", parseComposerJSON},
+	{"PKG-INFO", parsePythonMetadata},
+	{"METADATA", parsePythonMetadata},
+	{"README.chromium", parseReadmeChromium},
+	{"go.mod", parseGoMod},
+}
+
+// identify returns the identity of the component in dir from the first
+// manifest that names it, together with the path of that manifest.
+// Unreadable and malformed manifests are skipped.
+func identify(fsys fs.FS, root, dir string) (*identity, string) {
+	for _, m := range manifests {
+		p := path.Join(dir, m.file)
+		if id := readManifest(fsys, p, m.parse); id != nil {
+			return id, p
+		}
+	}
+	// pip and setuptools list the packages in their _vendor directory in a
+	// requirements file instead of keeping their metadata.
+	if path.Base(root) == "_vendor" {
+		p := path.Join(root, "vendor.txt")
+		pins := readManifest(fsys, p, func(r io.Reader) (*identity, error) {
+			return parseVendorTxt(r, path.Base(dir))
+		})
+		if pins != nil {
+			return pins, p
+		}
+	}
+	return nil, ""
+}
+
+func readManifest(fsys fs.FS, p string, parse func(io.Reader) (*identity, error)) *identity {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	id, err := parse(io.LimitReader(f, maxManifestFileBytes))
+	if err != nil || id == nil || id.name == "" {
+		return nil
+	}
+	return id
+}
+
+func parsePackageJSON(r io.Reader) (*identity, error) {
+	var p struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(r).Decode(&p); err != nil {
+		return nil, err
+	}
+	return &identity{name: p.Name, version: p.Version, ecosystem: ecosystemNPM}, nil
+}
+
+func parseComposerJSON(r io.Reader) (*identity, error) {
+	var p struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	if err := json.NewDecoder(r).Decode(&p); err != nil {
+		return nil, err
+	}
+	return &identity{name: p.Name, version: p.Version, ecosystem: ecosystemPackagist}, nil
+}
+
+func parseCargoToml(r io.Reader) (*identity, error) {
+	var p struct {
+		Package struct {
+			Name string `toml:"name"`
+			// A string, or a table for versions inherited from the workspace.
+			Version any `toml:"version"`
+		} `toml:"package"`
+	}
+	if _, err := toml.NewDecoder(r).Decode(&p); err != nil {
+		return nil, err
+	}
+	version, _ := p.Package.Version.(string)
+	return &identity{name: p.Package.Name, version: version, ecosystem: ecosystemCrates}, nil
+}
+
+// parsePythonMetadata parses the headers of Python core metadata, i.e. a
+// PKG-INFO or METADATA file.
+func parsePythonMetadata(r io.Reader) (*identity, error) {
+	h, err := parseHeaders(r)
+	if err != nil {
+		return nil, err
+	}
+	// Other files named METADATA aren't Python metadata.
+	if h["metadata-version"] == "" {
+		return nil, nil
+	}
+	return &identity{name: h["name"], version: h["version"], ecosystem: ecosystemPyPI}, nil
+}
+
+// parseReadmeChromium parses the README.chromium files Chromium based
+// projects keep for each directory in third_party.
+func parseReadmeChromium(r io.Reader) (*identity, error) {
+	h, err := parseHeaders(r)
+	if err != nil {
+		return nil, err
+	}
+	version := h["version"]
+	if strings.EqualFold(version, "N/A") || strings.EqualFold(version, "unknown") {
+		version = ""
+	}
+	return &identity{name: h["name"], version: version}, nil
+}
+
+// parseHeaders returns the "Key: value" lines at the top of r by lowercase
+// key, up to the first empty line. The first value of each key is kept.
+func parseHeaders(r io.Reader) (map[string]string, error) {
+	h := map[string]string{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if strings.TrimSpace(line) == "" {
+			break
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		k = strings.ToLower(strings.TrimSpace(k))
+		if _, ok := h[k]; !ok {
+			h[k] = strings.TrimSpace(v)
+		}
+	}
+	return h, s.Err()
+}
+
+// parseGoMod returns the module path of a go.mod. The version of a module
+// isn't recorded in it.
+func parseGoMod(r io.Reader) (*identity, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return &identity{name: modfile.ModulePath(data), ecosystem: ecosystemGo}, nil
+}
+
+var pythonNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// normalizePythonName normalizes a Python package name as in PEP 503.
+func normalizePythonName(name string) string {
+	return pythonNameSeparators.ReplaceAllString(strings.ToLower(name), "-")
+}
+
+// parseVendorTxt returns the pin for the package in directory dir from the
+// "name==version" lines of a pip or setuptools vendor.txt.
+func parseVendorTxt(r io.Reader, dir string) (*identity, error) {
+	want := normalizePythonName(dir)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		name, version, ok := strings.Cut(strings.TrimSpace(line), "==")
+		if !ok {
+			continue
+		}
+		// Drop extras and environment markers, e.g. "requests[socks]==2.32.3"
+		// or "tomli==2.0.1; python_version < '3.11'".
+		name, _, _ = strings.Cut(name, "[")
+		version, _, _ = strings.Cut(version, ";")
+		name = strings.TrimSpace(name)
+		if normalizePythonName(name) == want {
+			return &identity{name: name, version: strings.TrimSpace(version), ecosystem: ecosystemPyPI}, nil
+		}
+	}
+	return nil, s.Err()
+}
+
+// parseModulesTxt returns the modules listed in a Go vendor/modules.txt. For
+// replaced modules, the replacement is returned unless it's a local
+// directory, whose version is unknown.
+func parseModulesTxt(r io.Reader) ([]*identity, error) {
+	var res []*identity
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		// "# <path> [<version>] [=> <path> [<version>]]". Lines starting with
+		// "## " hold annotations, other lines list the vendored packages.
+		line, ok := strings.CutPrefix(s.Text(), "# ")
+		if !ok {
+			continue
+		}
+		mod, replace, replaced := strings.Cut(line, "=>")
+		fields := strings.Fields(mod)
+		if len(fields) == 0 {
+			continue
+		}
+		id := &identity{name: fields[0], ecosystem: ecosystemGo}
+		if len(fields) > 1 {
+			id.version = strings.TrimPrefix(fields[1], "v")
+		}
+		if replaced {
+			fields = strings.Fields(replace)
+			switch {
+			case len(fields) == 2:
+				id.name, id.version = fields[0], strings.TrimPrefix(fields[1], "v")
+			case len(fields) == 1:
+				// Local directory replacement.
+				id.version = ""
+			}
+		}
+		res = append(res, id)
+	}
+	return res, s.Err()
+}
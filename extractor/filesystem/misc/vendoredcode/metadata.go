@@ -0,0 +1,48 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendoredcode
+
+// How the component of an Inventory was identified.
+const (
+	// IdentifiedByManifest means the name and version are read from a
+	// package manifest left in the vendored tree, e.g. a package.json or
+	// PKG-INFO, or from the vendoring tool's list of vendored modules.
+	IdentifiedByManifest = "manifest"
+	// IdentifiedByHash means the content digest of the component matched an
+	// entry of the configured Corpus.
+	IdentifiedByHash = "hash"
+)
+
+// Metadata holds information about a vendored component.
+type Metadata struct {
+	// VendorRoot is the vendoring directory the component is in, e.g.
+	// third_party or src/pip/_vendor.
+	VendorRoot string
+	// IdentifiedBy is how the component was identified, e.g.
+	// IdentifiedByManifest. Empty for unidentified components, whose name is
+	// the name of their directory.
+	IdentifiedBy string
+	// Manifest is the file the component was identified from, e.g.
+	// third_party/zlib/README.chromium. Only set for IdentifiedByManifest.
+	Manifest string
+	// Ecosystem is the OSV ecosystem of the component, e.g. "npm". Empty for
+	// unidentified components and for components that aren't packages of an
+	// ecosystem, e.g. C libraries identified from a README.chromium.
+	Ecosystem string
+	// Digest is the content digest of the component directory, e.g.
+	// "sha256:9f86d0...". See Digest() for how it's computed. Empty if the
+	// component exceeds the configured size limit.
+	Digest string
+}
@@ -0,0 +1,399 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vendoredcode extracts dependencies vendored into a source tree, i.e.
+// the components in vendor/, third_party/, Python _vendor/ and git committed
+// node_modules/ directories. Components are identified from the package
+// manifests left in their directory, or by looking up the digest of their
+// contents in a corpus of known releases. Components that can't be identified
+// are reported by their directory name.
+package vendoredcode
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/vendoredcode"
+
+	// defaultMaxComponentSizeBytes is the default maximum total size of the
+	// files of a component that are hashed for its digest.
+	defaultMaxComponentSizeBytes = 50 * units.MiB
+)
+
+// vendorRoots are the names of the directories dependencies are vendored into.
+var vendorRoots = map[string]bool{
+	"vendor":       true,
+	"third_party":  true,
+	"_vendor":      true,
+	"node_modules": true,
+}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// Corpus identifies components without a manifest by the digest of their
+	// contents. If nil, no lookup is done.
+	Corpus Corpus
+	// MaxComponentSizeBytes is the maximum total size of the files of a
+	// component that are hashed. If this limit is greater than zero and a
+	// component is larger, it's reported without a digest.
+	MaxComponentSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the vendored code
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:                 nil,
+		Corpus:                nil,
+		MaxComponentSizeBytes: defaultMaxComponentSizeBytes,
+	}
+}
+
+// Extractor extracts vendored dependencies.
+type Extractor struct {
+	stats                 stats.Collector
+	corpus                Corpus
+	maxComponentSizeBytes int64
+}
+
+// New returns a vendored code extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:                 cfg.Stats,
+		corpus:                cfg.Corpus,
+		maxComponentSizeBytes: cfg.MaxComponentSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true for the files at the top of a vendored component
+// directory and for Go vendor/modules.txt files. A component is extracted
+// once, from the first of its files by name. Components without files at the
+// top of their directory are thus not found.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if !isModulesTxt(filepath.ToSlash(path)) && !isComponentFile(filepath.ToSlash(path)) {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+func isModulesTxt(p string) bool {
+	return path.Base(p) == "modules.txt" && path.Base(path.Dir(p)) == "vendor"
+}
+
+func isComponentFile(p string) bool {
+	_, dir, ok := componentOf(p)
+	return ok && path.Dir(p) == dir
+}
+
+// componentOf returns the vendoring directory and the component directory of
+// the file at p, using the innermost vendoring directory for components
+// vendored into other components. Components are the directories right
+// below the vendoring directory, or the two below it for scoped npm packages.
+func componentOf(p string) (root, dir string, ok bool) {
+	elems := strings.Split(p, "/")
+	// Leave room for the component directory and a file in it.
+	for i := len(elems) - 3; i >= 0; i-- {
+		if !vendorRoots[elems[i]] {
+			continue
+		}
+		comp := elems[i+1]
+		switch {
+		// Package manager state, e.g. node_modules/.bin, and Python caches.
+		case strings.HasPrefix(comp, "."), comp == "__pycache__":
+			return "", "", false
+		// Go packages vendored before modules, e.g. vendor/github.com/foo/bar,
+		// where the module boundaries are unknown. Go modules are listed in
+		// vendor/modules.txt instead.
+		case elems[i] == "vendor" && strings.Contains(comp, "."):
+			return "", "", false
+		}
+		end := i + 2
+		if elems[i] == "node_modules" && strings.HasPrefix(comp, "@") {
+			end++
+		}
+		if end >= len(elems) {
+			return "", "", false
+		}
+		return strings.Join(elems[:i+1], "/"), strings.Join(elems[:end], "/"), true
+	}
+	return "", "", false
+}
+
+// Extract extracts the vendored component of the file passed through the scan
+// input, or the modules of a Go vendor/modules.txt.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("%s extract(%s): %w", e.Name(), input.Path, err)
+	}
+	return inventory, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	p := filepath.ToSlash(input.Path)
+	if isModulesTxt(p) {
+		return extractModulesTxt(input, p)
+	}
+	root, dir, ok := componentOf(p)
+	if !ok || skipRoot(input.FS, root) {
+		return []*extractor.Inventory{}, nil
+	}
+	// Crates vendored by `cargo vendor` are reported by the cargovendor
+	// extractor.
+	if exists(input.FS, path.Join(dir, ".cargo-checksum.json")) {
+		return []*extractor.Inventory{}, nil
+	}
+	if first, err := firstFile(input.FS, dir); err != nil || first != path.Base(p) {
+		return []*extractor.Inventory{}, err
+	}
+
+	m := &Metadata{VendorRoot: root}
+	digest, err := Digest(input.FS, dir, e.maxComponentSizeBytes)
+	if err != nil && !errors.Is(err, ErrTooLarge) {
+		return nil, err
+	}
+	m.Digest = digest
+
+	inv := &extractor.Inventory{
+		Name:      strings.TrimPrefix(dir, root+"/"),
+		Metadata:  m,
+		Locations: []string{dir},
+	}
+	if id, manifest := identify(input.FS, root, dir); id != nil {
+		inv.Name, inv.Version = id.name, id.version
+		m.IdentifiedBy, m.Manifest, m.Ecosystem = IdentifiedByManifest, manifest, id.ecosystem
+	} else if e.corpus != nil && digest != "" {
+		if c, ok := e.corpus.Lookup(digest); ok {
+			inv.Name, inv.Version = c.Name, c.Version
+			m.IdentifiedBy, m.Ecosystem = IdentifiedByHash, c.Ecosystem
+		}
+	}
+	return []*extractor.Inventory{inv}, nil
+}
+
+func extractModulesTxt(input *filesystem.ScanInput, p string) ([]*extractor.Inventory, error) {
+	ids, err := parseModulesTxt(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	inventory := make([]*extractor.Inventory, 0, len(ids))
+	for _, id := range ids {
+		inventory = append(inventory, &extractor.Inventory{
+			Name:    id.name,
+			Version: id.version,
+			Metadata: &Metadata{
+				VendorRoot:   path.Dir(p),
+				IdentifiedBy: IdentifiedByManifest,
+				Manifest:     p,
+				Ecosystem:    id.ecosystem,
+			},
+			Locations: []string{p},
+		})
+	}
+	return inventory, nil
+}
+
+// skipRoot returns whether the components in the vendoring directory root are
+// left to other extractors or aren't vendored.
+func skipRoot(fsys fs.FS, root string) bool {
+	switch path.Base(root) {
+	case "vendor":
+		// Go modules and Composer packages are listed by their package manager.
+		return exists(fsys, path.Join(root, "modules.txt")) ||
+			exists(fsys, path.Join(root, "composer", "installed.json"))
+	case "node_modules":
+		return !committedToGit(fsys, root)
+	}
+	return false
+}
+
+// committedToGit returns whether the node_modules directory root is part of a
+// git checkout and not excluded by a .gitignore file. node_modules directories
+// are usually installed by npm and not vendored.
+func committedToGit(fsys fs.FS, root string) bool {
+	// The project directory is the one of the outermost node_modules.
+	elems := strings.Split(root, "/")
+	project := path.Join(elems[:slices.Index(elems, "node_modules")]...)
+	if project == "" {
+		project = "."
+	}
+	for dir := project; ; dir = path.Dir(dir) {
+		if ignoresNodeModules(fsys, dir, dir == project) {
+			return false
+		}
+		if exists(fsys, path.Join(dir, ".git")) {
+			return true
+		}
+		if dir == "." {
+			return false
+		}
+	}
+}
+
+// ignoresNodeModules returns whether the .gitignore file in dir excludes the
+// node_modules directory of the project. Anchored patterns, e.g.
+// "/node_modules", only apply if dir is the project directory.
+func ignoresNodeModules(fsys fs.FS, dir string, isProject bool) bool {
+	f, err := fsys.Open(path.Join(dir, ".gitignore"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSuffix(strings.TrimSpace(s.Text()), "/")
+		switch line {
+		case "node_modules", "**/node_modules":
+			return true
+		case "/node_modules":
+			if isProject {
+				return true
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		log.Debugf("%s: reading %s: %v", Name, path.Join(dir, ".gitignore"), err)
+	}
+	return false
+}
+
+func exists(fsys fs.FS, p string) bool {
+	_, err := fs.Stat(fsys, p)
+	return err == nil
+}
+
+// firstFile returns the name of the first regular file in dir.
+func firstFile(fsys fs.FS, dir string) (string, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			return e.Name(), nil
+		}
+	}
+	return "", nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL. Generic
+// PURLs are used for components that aren't packages of a known ecosystem.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	p := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+	m, ok := i.Metadata.(*Metadata)
+	if !ok {
+		return p
+	}
+	switch m.Ecosystem {
+	case ecosystemNPM:
+		p.Type, p.Name = purl.TypeNPM, strings.ToLower(i.Name)
+	case ecosystemPyPI:
+		p.Type, p.Name = purl.TypePyPi, strings.ToLower(i.Name)
+	case ecosystemCrates:
+		p.Type = purl.TypeCargo
+	case ecosystemPackagist:
+		p.Type = purl.TypeComposer
+	case ecosystemGo:
+		p.Type = purl.TypeGolang
+	}
+	return p
+}
+
+// Ecosystem returns the OSV ecosystem of the vendored component, or no
+// ecosystem for components that couldn't be attributed to one.
+func (Extractor) Ecosystem(i *extractor.Inventory) string {
+	if m, ok := i.Metadata.(*Metadata); ok {
+		return m.Ecosystem
+	}
+	return ""
+}
+
+var _ filesystem.Extractor = Extractor{}
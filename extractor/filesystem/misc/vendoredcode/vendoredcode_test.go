@@ -0,0 +1,528 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendoredcode_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendoredcode"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "third_party component",
+			path:             "src/third_party/zlib/README.chromium",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "file below the top of a component",
+			path:         "src/third_party/zlib/contrib/minizip/zip.c",
+			wantRequired: false,
+		},
+		{
+			name:         "file directly in the vendoring directory",
+			path:         "third_party/BUILD",
+			wantRequired: false,
+		},
+		{
+			name:             "Python _vendor component",
+			path:             "pip/_vendor/requests/__init__.py",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "scoped npm package",
+			path:             "web/node_modules/@babel/core/package.json",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "component vendored into a component",
+			path:             "web/node_modules/a/node_modules/b/index.js",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "npm state",
+			path:         "web/node_modules/.bin/tsc",
+			wantRequired: false,
+		},
+		{
+			name:         "Python cache",
+			path:         "pip/_vendor/__pycache__/six.cpython-312.pyc",
+			wantRequired: false,
+		},
+		{
+			name:             "Go modules.txt",
+			path:             "src/vendor/modules.txt",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "Go import path",
+			path:         "src/vendor/github.com/foo/bar.go",
+			wantRequired: false,
+		},
+		{
+			name:         "unrelated file",
+			path:         "src/lib/zlib/zlib.h",
+			wantRequired: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := vendoredcode.New(vendoredcode.Config{Stats: collector})
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: 1024,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	root := t.TempDir()
+	for p, content := range map[string]string{
+		"third_party/zlib/README.chromium":                       "Name: zlib\nShort Name: zlib\nURL: https://zlib.net\nVersion: 1.3.1\n\nDescription:\nGeneral purpose compression library\n",
+		"third_party/zlib/zlib.h":                                "/* zlib.h */\n",
+		"third_party/known/known.c":                              "int known(void) { return 1; }\n",
+		"third_party/mystery/lib.c":                              "int mystery(void) { return 42; }\n",
+		"third_party/mystery/include/lib.h":                      "int mystery(void);\n",
+		"pip/_vendor/vendor.txt":                                 "CacheControl==0.14.0  # Make sure to update tests\nrequests==2.32.3\ntomli==2.0.1; python_version < '3.11'\n",
+		"pip/_vendor/cachecontrol/__init__.py":                   "",
+		"pip/_vendor/tomli/__init__.py":                          "",
+		"pip/_vendor/idna/core.py":                               "",
+		"web/.git/HEAD":                                          "ref: refs/heads/main\n",
+		"web/packages/app/node_modules/lodash/package.json":      `{"name": "lodash", "version": "4.17.21"}`,
+		"web/packages/app/node_modules/@babel/core/package.json": `{"name": "@babel/core", "version": "7.24.0"}`,
+		"installed/.git/HEAD":                                    "ref: refs/heads/main\n",
+		"installed/.gitignore":                                   "dist\n/node_modules/\n",
+		"installed/node_modules/left-pad/package.json":           `{"name": "left-pad", "version": "1.3.0"}`,
+		"rust/vendor/serde/Cargo.toml":                           "[package]\nname = \"serde\"\nversion = \"1.0.200\"\n",
+		"rust/vendor/serde/src/lib.rs":                           "",
+		"rust/vendor/member/Cargo.toml":                          "[package]\nname = \"member\"\nversion.workspace = true\n",
+		"rust/vendor/cargo-vendored/.cargo-checksum.json":        `{"files": {}, "package": "0123"}`,
+		"rust/vendor/cargo-vendored/Cargo.toml":                  "[package]\nname = \"cargo-vendored\"\nversion = \"1.0.0\"\n",
+		"go/vendor/modules.txt": "# github.com/google/uuid v1.6.0\n## explicit\ngithub.com/google/uuid\n" +
+			"# golang.org/x/net v0.20.0 => golang.org/x/net v0.25.0\n## explicit; go 1.18\ngolang.org/x/net/html\n" +
+			"# example.com/local v1.0.0 => ../local\n## explicit\nexample.com/local\n",
+		"go/vendor/custom/go.mod":              "module example.com/custom\n\ngo 1.22\n",
+		"php/vendor/composer/installed.json":   `{"packages": []}`,
+		"php/vendor/monolog/composer.json":     `{"name": "monolog/monolog"}`,
+		"python/_vendor/broken/PKG-INFO":       "not metadata\n",
+		"python/_vendor/packaging/PKG-INFO":    "Metadata-Version: 2.1\nName: packaging\nVersion: 24.0\n\nName: ignored\n",
+		"python/_vendor/packaging/__init__.py": "",
+		"python/_vendor/unknown/METADATA":      "Name: not python\n",
+	} {
+		writeFile(t, filepath.Join(root, p), []byte(content))
+	}
+	known, err := vendoredcode.Digest(os.DirFS(root), "third_party/known", 0)
+	if err != nil {
+		t.Fatalf("Digest(third_party/known): %v", err)
+	}
+	corpus := vendoredcode.MapCorpus{known: {Name: "known", Version: "2.0", Ecosystem: ""}}
+
+	tests := []struct {
+		name string
+		path string
+		want []*extractor.Inventory
+	}{
+		{
+			name: "README.chromium",
+			path: "third_party/zlib/README.chromium",
+			want: []*extractor.Inventory{
+				inv("zlib", "1.3.1", "third_party/zlib", &vendoredcode.Metadata{
+					VendorRoot:   "third_party",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "third_party/zlib/README.chromium",
+				}),
+			},
+		},
+		{
+			name: "not the first file of the component",
+			path: "third_party/zlib/zlib.h",
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "identified by hash",
+			path: "third_party/known/known.c",
+			want: []*extractor.Inventory{
+				inv("known", "2.0", "third_party/known", &vendoredcode.Metadata{
+					VendorRoot:   "third_party",
+					IdentifiedBy: vendoredcode.IdentifiedByHash,
+					Digest:       known,
+				}),
+			},
+		},
+		{
+			name: "unidentified",
+			path: "third_party/mystery/lib.c",
+			want: []*extractor.Inventory{
+				inv("mystery", "", "third_party/mystery", &vendoredcode.Metadata{VendorRoot: "third_party"}),
+			},
+		},
+		{
+			name: "pip vendor.txt",
+			path: "pip/_vendor/cachecontrol/__init__.py",
+			want: []*extractor.Inventory{
+				inv("CacheControl", "0.14.0", "pip/_vendor/cachecontrol", &vendoredcode.Metadata{
+					VendorRoot:   "pip/_vendor",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "pip/_vendor/vendor.txt",
+					Ecosystem:    "PyPI",
+				}),
+			},
+		},
+		{
+			name: "pip vendor.txt with environment marker",
+			path: "pip/_vendor/tomli/__init__.py",
+			want: []*extractor.Inventory{
+				inv("tomli", "2.0.1", "pip/_vendor/tomli", &vendoredcode.Metadata{
+					VendorRoot:   "pip/_vendor",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "pip/_vendor/vendor.txt",
+					Ecosystem:    "PyPI",
+				}),
+			},
+		},
+		{
+			name: "not in vendor.txt",
+			path: "pip/_vendor/idna/core.py",
+			want: []*extractor.Inventory{
+				inv("idna", "", "pip/_vendor/idna", &vendoredcode.Metadata{VendorRoot: "pip/_vendor"}),
+			},
+		},
+		{
+			name: "committed node_modules of a monorepo package",
+			path: "web/packages/app/node_modules/lodash/package.json",
+			want: []*extractor.Inventory{
+				inv("lodash", "4.17.21", "web/packages/app/node_modules/lodash", &vendoredcode.Metadata{
+					VendorRoot:   "web/packages/app/node_modules",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "web/packages/app/node_modules/lodash/package.json",
+					Ecosystem:    "npm",
+				}),
+			},
+		},
+		{
+			name: "scoped npm package",
+			path: "web/packages/app/node_modules/@babel/core/package.json",
+			want: []*extractor.Inventory{
+				inv("@babel/core", "7.24.0", "web/packages/app/node_modules/@babel/core", &vendoredcode.Metadata{
+					VendorRoot:   "web/packages/app/node_modules",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "web/packages/app/node_modules/@babel/core/package.json",
+					Ecosystem:    "npm",
+				}),
+			},
+		},
+		{
+			name: "git ignored node_modules",
+			path: "installed/node_modules/left-pad/package.json",
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "crate",
+			path: "rust/vendor/serde/Cargo.toml",
+			want: []*extractor.Inventory{
+				inv("serde", "1.0.200", "rust/vendor/serde", &vendoredcode.Metadata{
+					VendorRoot:   "rust/vendor",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "rust/vendor/serde/Cargo.toml",
+					Ecosystem:    "crates.io",
+				}),
+			},
+		},
+		{
+			name: "crate with workspace version",
+			path: "rust/vendor/member/Cargo.toml",
+			want: []*extractor.Inventory{
+				inv("member", "", "rust/vendor/member", &vendoredcode.Metadata{
+					VendorRoot:   "rust/vendor",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "rust/vendor/member/Cargo.toml",
+					Ecosystem:    "crates.io",
+				}),
+			},
+		},
+		{
+			name: "crate vendored by cargo vendor",
+			path: "rust/vendor/cargo-vendored/.cargo-checksum.json",
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "Go modules.txt",
+			path: "go/vendor/modules.txt",
+			want: []*extractor.Inventory{
+				goModule("github.com/google/uuid", "1.6.0"),
+				goModule("golang.org/x/net", "0.25.0"),
+				goModule("example.com/local", ""),
+			},
+		},
+		{
+			name: "component of a Go module vendor directory",
+			path: "go/vendor/custom/go.mod",
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "Composer vendor directory",
+			path: "php/vendor/monolog/composer.json",
+			want: []*extractor.Inventory{},
+		},
+		{
+			name: "malformed PKG-INFO",
+			path: "python/_vendor/broken/PKG-INFO",
+			want: []*extractor.Inventory{
+				inv("broken", "", "python/_vendor/broken", &vendoredcode.Metadata{VendorRoot: "python/_vendor"}),
+			},
+		},
+		{
+			name: "PKG-INFO",
+			path: "python/_vendor/packaging/PKG-INFO",
+			want: []*extractor.Inventory{
+				inv("packaging", "24.0", "python/_vendor/packaging", &vendoredcode.Metadata{
+					VendorRoot:   "python/_vendor",
+					IdentifiedBy: vendoredcode.IdentifiedByManifest,
+					Manifest:     "python/_vendor/packaging/PKG-INFO",
+					Ecosystem:    "PyPI",
+				}),
+			},
+		},
+		{
+			name: "METADATA that isn't Python metadata",
+			path: "python/_vendor/unknown/METADATA",
+			want: []*extractor.Inventory{
+				inv("unknown", "", "python/_vendor/unknown", &vendoredcode.Metadata{VendorRoot: "python/_vendor"}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			cfg := vendoredcode.DefaultConfig()
+			cfg.Stats = collector
+			cfg.Corpus = corpus
+			e := vendoredcode.New(cfg)
+
+			scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{
+				Path:         tt.path,
+				FakeScanRoot: root,
+			})
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := e.Extract(context.Background(), &scanInput)
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", tt.path, err)
+			}
+			// Digests are covered by TestDigest.
+			opts := []cmp.Option{
+				cmpopts.IgnoreFields(extractor.Inventory{}, "Extractor"),
+				cmp.Transformer("DropHashDigest", func(m *vendoredcode.Metadata) *vendoredcode.Metadata {
+					if m == nil || m.IdentifiedBy == vendoredcode.IdentifiedByHash {
+						return m
+					}
+					c := *m
+					c.Digest = ""
+					return &c
+				}),
+			}
+			if diff := cmp.Diff(tt.want, got, opts...); diff != "" {
+				t.Errorf("Extract(%s) (-want +got):\n%s", tt.path, diff)
+			}
+			for _, i := range got {
+				m := i.Metadata.(*vendoredcode.Metadata)
+				if m.Manifest != "go/vendor/modules.txt" && m.Digest == "" {
+					t.Errorf("Extract(%s): %s has no digest", tt.path, i.Name)
+				}
+			}
+
+			if got := collector.FileExtractedResult(tt.path); got != stats.FileExtractedResultSuccess {
+				t.Errorf("Extract(%s) recorded result %v, want %v", tt.path, got, stats.FileExtractedResultSuccess)
+			}
+		})
+	}
+}
+
+func TestDigest(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "lib/a.c"), []byte("a"))
+	writeFile(t, filepath.Join(root, "lib/a-b/b.c"), []byte("b"))
+	writeFile(t, filepath.Join(root, "lib/.git/HEAD"), []byte("ref: refs/heads/main\n"))
+	fsys := os.DirFS(root)
+
+	got, err := vendoredcode.Digest(fsys, "lib", 0)
+	if err != nil {
+		t.Fatalf("Digest(): %v", err)
+	}
+	// sha256("a-b/b.c\x00<sha256(b)>\na.c\x00<sha256(a)>\n")
+	want := "sha256:a1d8baa5467ac0302d3d62b6e6bc220fad4b6dce136ebcb0433b70047732f527"
+	if got != want {
+		t.Errorf("Digest(): got %q, want %q", got, want)
+	}
+
+	// The digest doesn't depend on the location of the component.
+	writeFile(t, filepath.Join(root, "copy/lib/a.c"), []byte("a"))
+	writeFile(t, filepath.Join(root, "copy/lib/a-b/b.c"), []byte("b"))
+	if got, err := vendoredcode.Digest(fsys, "copy/lib", 0); err != nil || got != want {
+		t.Errorf("Digest(copy/lib): got %q, %v, want %q", got, err, want)
+	}
+
+	if _, err := vendoredcode.Digest(fsys, "lib", 1); !errors.Is(err, vendoredcode.ErrTooLarge) {
+		t.Errorf("Digest() with limit: got error %v, want %v", err, vendoredcode.ErrTooLarge)
+	}
+}
+
+func TestLoadCorpus(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    vendoredcode.MapCorpus
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			content: `[{"digest": "sha256:0123", "name": "zlib", "version": "1.3.1"}, {"digest": "sha256:4567", "name": "left-pad", "version": "1.3.0", "ecosystem": "npm"}]`,
+			want: vendoredcode.MapCorpus{
+				"sha256:0123": {Name: "zlib", Version: "1.3.1"},
+				"sha256:4567": {Name: "left-pad", Version: "1.3.0", Ecosystem: "npm"},
+			},
+		},
+		{
+			name:    "missing digest",
+			content: `[{"name": "zlib"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "not JSON",
+			content: "zlib",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "corpus.json")
+			writeFile(t, p, []byte(tt.content))
+			got, err := vendoredcode.LoadCorpus(p)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadCorpus(): got error %v, want error %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("LoadCorpus() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := vendoredcode.Extractor{}
+	tests := []struct {
+		name string
+		inv  *extractor.Inventory
+		want *purl.PackageURL
+	}{
+		{
+			name: "npm",
+			inv:  inv("@Babel/core", "7.24.0", "node_modules/@babel/core", &vendoredcode.Metadata{Ecosystem: "npm"}),
+			want: &purl.PackageURL{Type: purl.TypeNPM, Name: "@babel/core", Version: "7.24.0"},
+		},
+		{
+			name: "Go",
+			inv:  goModule("golang.org/x/net", "0.25.0"),
+			want: &purl.PackageURL{Type: purl.TypeGolang, Name: "golang.org/x/net", Version: "0.25.0"},
+		},
+		{
+			name: "unidentified",
+			inv:  inv("mystery", "", "third_party/mystery", &vendoredcode.Metadata{VendorRoot: "third_party"}),
+			want: &purl.PackageURL{Type: purl.TypeGeneric, Name: "mystery"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := e.ToPURL(tt.inv)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", tt.inv, diff)
+			}
+		})
+	}
+}
+
+func inv(name, version, dir string, m *vendoredcode.Metadata) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:      name,
+		Version:   version,
+		Metadata:  m,
+		Locations: []string{dir},
+	}
+}
+
+func goModule(name, version string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:    name,
+		Version: version,
+		Metadata: &vendoredcode.Metadata{
+			VendorRoot:   "go/vendor",
+			IdentifiedBy: vendoredcode.IdentifiedByManifest,
+			Manifest:     "go/vendor/modules.txt",
+			Ecosystem:    "Go",
+		},
+		Locations: []string{"go/vendor/modules.txt"},
+	}
+}
+
+func writeFile(t *testing.T, path string, content []byte) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("os.MkdirAll(%s): %v", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatalf("os.WriteFile(%s): %v", path, err)
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendorlib
+
+// Sources of the version of an Inventory.
+const (
+	// The version is taken from a version string embedded in the library, e.g.
+	// the "OpenSSL 1.1.1k  25 Mar 2021" banner of libcrypto.
+	VersionSourceBanner = "banner"
+	// The version is read from exported version variables, e.g.
+	// gtk_major_version, gtk_minor_version and gtk_micro_version.
+	VersionSourceSymbols = "symbols"
+	// The version is taken from the file name or SONAME of the library, e.g.
+	// 2.3.1 for libfoo.so.2.3.1.
+	VersionSourceFileName = "filename"
+)
+
+// Metadata holds parsing information for a shared library or a component
+// embedded in it.
+type Metadata struct {
+	// SONAME is the DT_SONAME of the library, e.g. libcrypto.so.1.1.
+	SONAME string
+	// FileVersion is the version in the file name of the library, e.g.
+	// 5.15.2 for libQt5Core.so.5.15.2.
+	FileVersion string
+	// VersionSource is where the version of the Inventory comes from, e.g.
+	// VersionSourceBanner.
+	VersionSource string
+	// VendorDir is the directory of the vendor application the library is
+	// installed with, e.g. opt/acme, or the library directory itself for
+	// libraries installed directly into it, e.g. usr/local/lib.
+	VendorDir string
+}
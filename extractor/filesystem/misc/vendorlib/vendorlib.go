@@ -0,0 +1,425 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vendorlib extracts shared libraries bundled with vendor
+// applications, e.g. in /opt, that aren't tracked by a package manager.
+//
+// Libraries are identified by version strings embedded in them (e.g. the
+// OpenSSL and QtCore banners), by exported version variables (GTK and GLib)
+// and, as a fallback, by their SONAME and file name.
+package vendorlib
+
+import (
+	"bytes"
+	"context"
+	"debug/elf"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "misc/vendorlib"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 100 * units.MiB
+)
+
+// defaultDirectories are the directories vendor applications are commonly
+// installed into.
+var defaultDirectories = []string{"opt", "usr/local/lib"}
+
+var (
+	// libraryRe matches shared library file names and SONAMEs, e.g.
+	// libQt5Core.so.5.15.2 or libfoo.so.
+	libraryRe = regexp.MustCompile(`^(.+?)\.so((?:\.\d+)*)$`)
+
+	// banners are the version strings that libraries embed, keyed by the
+	// component they identify.
+	banners = []struct {
+		name string
+		re   *regexp.Regexp
+	}{
+		// e.g. "OpenSSL 1.1.1k  25 Mar 2021" or "OpenSSL 3.0.2 15 Mar 2022".
+		{name: "openssl", re: regexp.MustCompile(`OpenSSL (\d+\.\d+\.\d+[a-z]{0,2})\s`)},
+		// e.g. "This is the QtCore library version Qt 5.15.2 (x86_64-little_endian-lp64 ...)".
+		{name: "qt", re: regexp.MustCompile(`This is the QtCore library version (?:Qt )?(\d+\.\d+\.\d+)`)},
+		// e.g. " deflate 1.2.11 Copyright 1995-2017 Jean-loup Gailly and Mark Adler ".
+		{name: "zlib", re: regexp.MustCompile(`(?:deflate|inflate) (\d+\.\d+(?:\.\d+)*) Copyright`)},
+		// e.g. " libpng version 1.6.37 - April 14, 2019".
+		{name: "libpng", re: regexp.MustCompile(`libpng version (\d+\.\d+\.\d+)`)},
+		// e.g. "expat_2.2.9".
+		{name: "expat", re: regexp.MustCompile(`expat_(\d+\.\d+\.\d+)`)},
+	}
+
+	// versionSymbols are the prefixes of exported <prefix>_major_version,
+	// <prefix>_minor_version and <prefix>_micro_version variables, keyed by
+	// the component they identify.
+	versionSymbols = []struct {
+		name   string
+		prefix string
+	}{
+		{name: "gtk", prefix: "gtk"},
+		{name: "glib", prefix: "glib"},
+	}
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+	// Directories are the directories, relative to the scan root, in which
+	// shared libraries are extracted. Libraries of OS packages in directories
+	// like usr/lib are reported by the OS package extractors.
+	Directories []string
+}
+
+// DefaultConfig returns the default configuration for the vendor library
+// extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+		Directories:      defaultDirectories,
+	}
+}
+
+// Extractor extracts shared libraries installed with vendor applications.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+	directories      []string
+}
+
+// New returns a vendor library extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	dirs := make([]string, 0, len(cfg.Directories))
+	for _, d := range cfg.Directories {
+		dirs = append(dirs, strings.Trim(filepath.ToSlash(d), "/"))
+	}
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+		directories:      dirs,
+	}
+}
+
+// Config returns the configuration of the extractor.
+func (e Extractor) Config() Config {
+	return Config{
+		Stats:            e.stats,
+		MaxFileSizeBytes: e.maxFileSizeBytes,
+		Directories:      e.directories,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is a shared library in one
+// of the configured directories.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if e.vendorDir(path) == "" || !libraryRe.MatchString(filepath.Base(path)) {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil || !fileinfo.Mode().IsRegular() {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// vendorDir returns the directory of the vendor application that the file
+// at p is installed with: the first directory below the configured directory
+// containing it, or the configured directory itself for files directly in
+// it. It returns an empty string if p isn't in any configured directory.
+func (e Extractor) vendorDir(p string) string {
+	p = filepath.ToSlash(p)
+	for _, dir := range e.directories {
+		rest, ok := strings.CutPrefix(p, dir+"/")
+		if !ok {
+			continue
+		}
+		sub, _, nested := strings.Cut(rest, "/")
+		if !nested {
+			return dir
+		}
+		return dir + "/" + sub
+	}
+	return ""
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract identifies the shared library passed through the scan input.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	return inventory, nil
+}
+
+// extract returns the components identified by version strings or version
+// variables in the library. If there are none, the library itself is
+// returned with the version from its file name or SONAME. Libraries without
+// any version are skipped.
+func (e Extractor) extract(input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	r, err := readerAt(input)
+	if err != nil {
+		return nil, err
+	}
+	// Files named like libraries can also be linker scripts, e.g. libc.so.
+	magic := make([]byte, len(elf.ELFMAG))
+	if _, err := r.ReadAt(magic, 0); err != nil || string(magic) != elf.ELFMAG {
+		return nil, nil
+	}
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ELF file: %w", err)
+	}
+
+	var soname string
+	if names, err := f.DynString(elf.DT_SONAME); err == nil && len(names) > 0 {
+		soname = names[0]
+	}
+	name, fileVersion := splitLibraryName(path.Base(filepath.ToSlash(input.Path)))
+	vendorDir := e.vendorDir(input.Path)
+	newInventory := func(name, version, source string) *extractor.Inventory {
+		return &extractor.Inventory{
+			Name:    name,
+			Version: version,
+			Metadata: &Metadata{
+				SONAME:        soname,
+				FileVersion:   fileVersion,
+				VersionSource: source,
+				VendorDir:     vendorDir,
+			},
+			Locations: []string{input.Path},
+		}
+	}
+
+	var inventory []*extractor.Inventory
+	for _, c := range symbolVersions(f) {
+		inventory = append(inventory, newInventory(c.name, c.version, VersionSourceSymbols))
+	}
+	for _, c := range bannerVersions(f) {
+		inventory = append(inventory, newInventory(c.name, c.version, VersionSourceBanner))
+	}
+	if len(inventory) > 0 {
+		return inventory, nil
+	}
+
+	version := fileVersion
+	if soname != "" {
+		n, v := splitLibraryName(soname)
+		name = n
+		if version == "" {
+			version = v
+		}
+	}
+	if version == "" {
+		return nil, nil
+	}
+	return []*extractor.Inventory{newInventory(name, version, VersionSourceFileName)}, nil
+}
+
+// readerAt returns random access to the file passed through the scan input.
+func readerAt(input *filesystem.ScanInput) (io.ReaderAt, error) {
+	if r, ok := input.Reader.(io.ReaderAt); ok {
+		return r, nil
+	}
+	log.Debugf("Reader of %s does not implement ReaderAt. Fall back to read to memory.", input.Path)
+	b, err := io.ReadAll(input.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
+}
+
+// splitLibraryName splits a shared library file name or SONAME into the
+// library name and its version, e.g. libQt5Core.so.5.15.2 into libQt5Core
+// and 5.15.2.
+func splitLibraryName(name string) (string, string) {
+	m := libraryRe.FindStringSubmatch(name)
+	if m == nil {
+		return name, ""
+	}
+	return m[1], strings.TrimPrefix(m[2], ".")
+}
+
+type component struct {
+	name    string
+	version string
+}
+
+// bannerVersions returns the components identified by version strings in
+// the read-only data of f.
+func bannerVersions(f *elf.File) []component {
+	var components []component
+	for _, s := range f.Sections {
+		if s.Type != elf.SHT_PROGBITS || !strings.HasPrefix(s.Name, ".rodata") {
+			continue
+		}
+		data, err := s.Data()
+		if err != nil {
+			log.Debugf("failed to read ELF section %s: %v", s.Name, err)
+			continue
+		}
+		for _, b := range banners {
+			if m := b.re.FindSubmatch(data); m != nil {
+				components = append(components, component{name: b.name, version: string(m[1])})
+			}
+		}
+	}
+	return components
+}
+
+// symbolVersions returns the components identified by exported version
+// variables of f.
+func symbolVersions(f *elf.File) []component {
+	syms, err := f.DynamicSymbols()
+	if err != nil {
+		// No dynamic symbol table.
+		return nil
+	}
+	defined := make(map[string]elf.Symbol)
+	for _, s := range syms {
+		if s.Section != elf.SHN_UNDEF && elf.ST_TYPE(s.Info) == elf.STT_OBJECT {
+			defined[s.Name] = s
+		}
+	}
+
+	var components []component
+	for _, vs := range versionSymbols {
+		var parts []string
+		for _, part := range []string{"major", "minor", "micro"} {
+			s, ok := defined[vs.prefix+"_"+part+"_version"]
+			if !ok {
+				break
+			}
+			v, err := readUint32(f, s.Value)
+			if err != nil {
+				log.Debugf("failed to read %s: %v", s.Name, err)
+				break
+			}
+			parts = append(parts, strconv.FormatUint(uint64(v), 10))
+		}
+		if len(parts) == 3 {
+			components = append(components, component{name: vs.name, version: strings.Join(parts, ".")})
+		}
+	}
+	return components
+}
+
+// readUint32 reads the 32-bit unsigned integer at the virtual address addr
+// of f.
+func readUint32(f *elf.File, addr uint64) (uint32, error) {
+	for _, s := range f.Sections {
+		if s.Type != elf.SHT_PROGBITS || addr < s.Addr || addr+4 > s.Addr+s.Size {
+			continue
+		}
+		b := make([]byte, 4)
+		if _, err := s.ReadAt(b, int64(addr-s.Addr)); err != nil {
+			return 0, err
+		}
+		return f.ByteOrder.Uint32(b), nil
+	}
+	return 0, fmt.Errorf("address %#x is not in a data section", addr)
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL. The
+// origin of vendored libraries is unknown, so generic PURLs are used.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track vendored native
+// libraries.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -0,0 +1,254 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vendorlib_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/misc/vendorlib"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		directories      []string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "versioned library in opt",
+			path:             "opt/acme/lib/libQt5Core.so.5.15.2",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "unversioned library in opt",
+			path:             "opt/google/chrome/libEGL.so",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "library in usr/local/lib",
+			path:             "usr/local/lib/libfoo.so.2",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "OS package library",
+			path:         "usr/lib/x86_64-linux-gnu/libssl.so.3",
+			wantRequired: false,
+		},
+		{
+			name:         "not a shared library",
+			path:         "opt/acme/lib/libfoo.a",
+			wantRequired: false,
+		},
+		{
+			name:         "so in the middle of the name",
+			path:         "opt/acme/share/libfoo.so.conf",
+			wantRequired: false,
+		},
+		{
+			name:             "custom directory",
+			path:             "srv/vendor/libfoo.so.1",
+			directories:      []string{"/srv/vendor/"},
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "default directory not scanned with custom directories",
+			path:         "opt/acme/lib/libfoo.so.1",
+			directories:  []string{"srv/vendor"},
+			wantRequired: false,
+		},
+		{
+			name:             "library not required if size greater than maxFileSizeBytes",
+			path:             "opt/acme/lib/libQt5WebEngineCore.so.5",
+			fileSizeBytes:    1000 * units.MiB,
+			maxFileSizeBytes: 100 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			cfg := vendorlib.DefaultConfig()
+			cfg.Stats = collector
+			cfg.MaxFileSizeBytes = tt.maxFileSizeBytes
+			if tt.directories != nil {
+				cfg.Directories = tt.directories
+			}
+			e := vendorlib.New(cfg)
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			path := filepath.FromSlash(tt.path)
+			isRequired := e.FileRequired(simplefileapi.New(path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputConfig extracttest.ScanInputMockConfig
+		want        []*extractor.Inventory
+		wantErr     bool
+	}{
+		{
+			name: "OpenSSL banner",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/acme/lib/libcrypto.so.1.1",
+				FakeScanRoot: "testdata",
+			},
+			want: []*extractor.Inventory{
+				inv("openssl", "1.1.1k", "libcrypto.so.1.1", "1.1", vendorlib.VersionSourceBanner, "opt/acme", "opt/acme/lib/libcrypto.so.1.1"),
+			},
+		},
+		{
+			name: "QtCore banner",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/acme/lib/libQt5Core.so.5.15.2",
+				FakeScanRoot: "testdata",
+			},
+			want: []*extractor.Inventory{
+				inv("qt", "5.15.2", "libQt5Core.so.5", "5.15.2", vendorlib.VersionSourceBanner, "opt/acme", "opt/acme/lib/libQt5Core.so.5.15.2"),
+			},
+		},
+		{
+			name: "GTK version variables",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/acme/lib/libgtk-3.so.0.2404.16",
+				FakeScanRoot: "testdata",
+			},
+			want: []*extractor.Inventory{
+				inv("gtk", "3.24.20", "libgtk-3.so.0", "0.2404.16", vendorlib.VersionSourceSymbols, "opt/acme", "opt/acme/lib/libgtk-3.so.0.2404.16"),
+			},
+		},
+		{
+			name: "version from file name",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "usr/local/lib/libfoo.so.2.3.1",
+				FakeScanRoot: "testdata",
+			},
+			want: []*extractor.Inventory{
+				inv("libfoo", "2.3.1", "libfoo.so.2", "2.3.1", vendorlib.VersionSourceFileName, "usr/local/lib", "usr/local/lib/libfoo.so.2.3.1"),
+			},
+		},
+		{
+			name: "library without version",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/acme/lib/libplain.so",
+				FakeScanRoot: "testdata",
+			},
+			want: nil,
+		},
+		{
+			name: "linker script",
+			inputConfig: extracttest.ScanInputMockConfig{
+				Path:         "opt/acme/lib/libbar.so",
+				FakeScanRoot: "testdata",
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := vendorlib.New(vendorlib.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.inputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := e.Extract(context.Background(), &scanInput)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Extract(%s) error: got %v, want error: %v", tt.inputConfig.Path, err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Extract(%s) (-want +got):\n%s", tt.inputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestExtract_CorruptELF(t *testing.T) {
+	path := "opt/acme/lib/libbroken.so.1"
+	scanInput := extracttest.GenerateScanInputMock(t, extracttest.ScanInputMockConfig{
+		Path:         path,
+		FakeScanRoot: "testdata",
+	})
+	defer extracttest.CloseTestScanInput(t, scanInput)
+
+	e := vendorlib.New(vendorlib.DefaultConfig())
+	if _, err := e.Extract(context.Background(), &scanInput); err == nil {
+		t.Errorf("Extract(%s): got nil error, want error", path)
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := vendorlib.Extractor{}
+	i := inv("openssl", "1.1.1k", "libcrypto.so.1.1", "1.1", vendorlib.VersionSourceBanner, "opt/acme", "opt/acme/lib/libcrypto.so.1.1")
+	want := &purl.PackageURL{Type: purl.TypeGeneric, Name: "openssl", Version: "1.1.1k"}
+	if diff := cmp.Diff(want, e.ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
+
+func inv(name, version, soname, fileVersion, source, vendorDir, location string) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:    name,
+		Version: version,
+		Metadata: &vendorlib.Metadata{
+			SONAME:        soname,
+			FileVersion:   fileVersion,
+			VersionSource: source,
+			VendorDir:     vendorDir,
+		},
+		Locations: []string{location},
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package android
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// abxMagic is the header of Android Binary XML files. Since Android 12 the
+// package manager writes packages.xml in this format by default.
+var abxMagic = []byte{'A', 'B', 'X', 0}
+
+// Token types of Android Binary XML, matching the XmlPullParser events. The
+// token type is stored in the low 4 bits of each token byte.
+const (
+	abxStartDocument = 0
+	abxEndDocument   = 1
+	abxStartTag      = 2
+	abxEndTag        = 3
+	abxText          = 4
+	abxCDSect        = 5
+	abxEntityRef     = 6
+	abxWhitespace    = 7
+	abxProcessing    = 8
+	abxComment       = 9
+	abxDocDecl       = 10
+	abxAttribute     = 15
+)
+
+// Data types of Android Binary XML, stored in the high 4 bits of each token
+// byte.
+const (
+	abxTypeNull           = 1 << 4
+	abxTypeString         = 2 << 4
+	abxTypeStringInterned = 3 << 4
+	abxTypeBytesHex       = 4 << 4
+	abxTypeBytesBase64    = 5 << 4
+	abxTypeInt            = 6 << 4
+	abxTypeIntHex         = 7 << 4
+	abxTypeLong           = 8 << 4
+	abxTypeLongHex        = 9 << 4
+	abxTypeFloat          = 10 << 4
+	abxTypeDouble         = 11 << 4
+	abxTypeBooleanTrue    = 12 << 4
+	abxTypeBooleanFalse   = 13 << 4
+)
+
+// element is an XML element with its attributes. Attribute values of binary
+// XML files are converted to the strings the text serializer would write, so
+// both formats can be handled the same way.
+type element struct {
+	name     string
+	attrs    map[string]string
+	children []*element
+}
+
+// parseXML parses a text or binary XML document and returns its root element.
+func parseXML(r io.Reader) (*element, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(len(abxMagic))
+	if err == nil && bytes.Equal(header, abxMagic) {
+		if _, err := br.Discard(len(abxMagic)); err != nil {
+			return nil, err
+		}
+		return parseABX(br)
+	}
+	return parseTextXML(br)
+}
+
+func parseTextXML(r io.Reader) (*element, error) {
+	d := xml.NewDecoder(r)
+	root := &element{}
+	stack := []*element{root}
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			e := &element{name: t.Name.Local, attrs: map[string]string{}}
+			for _, a := range t.Attr {
+				e.attrs[a.Name.Local] = a.Value
+			}
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, e)
+			stack = append(stack, e)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+	return root, nil
+}
+
+// abxReader reads the values of an Android Binary XML document.
+type abxReader struct {
+	r        *bufio.Reader
+	interned []string
+}
+
+func parseABX(r *bufio.Reader) (*element, error) {
+	a := &abxReader{r: r}
+	root := &element{}
+	stack := []*element{root}
+	for {
+		tok, err := a.r.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return root, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		cmd, typ := tok&0x0f, tok&0xf0
+		switch cmd {
+		case abxStartDocument, abxEndDocument:
+		case abxStartTag:
+			name, err := a.readInterned()
+			if err != nil {
+				return nil, err
+			}
+			e := &element{name: name, attrs: map[string]string{}}
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, e)
+			stack = append(stack, e)
+		case abxEndTag:
+			if _, err := a.readInterned(); err != nil {
+				return nil, err
+			}
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case abxAttribute:
+			name, err := a.readInterned()
+			if err != nil {
+				return nil, err
+			}
+			value, err := a.readValue(typ)
+			if err != nil {
+				return nil, fmt.Errorf("attribute %q: %w", name, err)
+			}
+			stack[len(stack)-1].attrs[name] = value
+		case abxText, abxCDSect, abxEntityRef, abxWhitespace, abxProcessing, abxComment, abxDocDecl:
+			// Text content isn't needed, only skipped.
+			if _, err := a.readValue(typ); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown binary XML token %#x", tok)
+		}
+	}
+}
+
+// readValue reads a value of the given data type and returns it the way the
+// text XML serializer would write it.
+func (a *abxReader) readValue(typ byte) (string, error) {
+	switch typ {
+	case abxTypeNull:
+		return "", nil
+	case abxTypeBooleanTrue:
+		return "true", nil
+	case abxTypeBooleanFalse:
+		return "false", nil
+	case abxTypeString:
+		return a.readUTF()
+	case abxTypeStringInterned:
+		return a.readInterned()
+	case abxTypeBytesHex, abxTypeBytesBase64:
+		n, err := a.readUint16()
+		if err != nil {
+			return "", err
+		}
+		b := make([]byte, n)
+		if _, err := io.ReadFull(a.r, b); err != nil {
+			return "", err
+		}
+		if typ == abxTypeBytesHex {
+			return hex.EncodeToString(b), nil
+		}
+		return base64.StdEncoding.EncodeToString(b), nil
+	case abxTypeInt, abxTypeIntHex, abxTypeFloat:
+		b := make([]byte, 4)
+		if _, err := io.ReadFull(a.r, b); err != nil {
+			return "", err
+		}
+		v := binary.BigEndian.Uint32(b)
+		switch typ {
+		case abxTypeInt:
+			return strconv.FormatInt(int64(int32(v)), 10), nil
+		case abxTypeIntHex:
+			return strconv.FormatUint(uint64(v), 16), nil
+		}
+		return strconv.FormatFloat(float64(math.Float32frombits(v)), 'g', -1, 32), nil
+	case abxTypeLong, abxTypeLongHex, abxTypeDouble:
+		b := make([]byte, 8)
+		if _, err := io.ReadFull(a.r, b); err != nil {
+			return "", err
+		}
+		v := binary.BigEndian.Uint64(b)
+		switch typ {
+		case abxTypeLong:
+			return strconv.FormatInt(int64(v), 10), nil
+		case abxTypeLongHex:
+			return strconv.FormatUint(v, 16), nil
+		}
+		return strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unknown binary XML data type %#x", typ)
+	}
+}
+
+// readInterned reads a string that's either a reference to a previously read
+// string or a new string that's added to the table.
+func (a *abxReader) readInterned() (string, error) {
+	ref, err := a.readUint16()
+	if err != nil {
+		return "", err
+	}
+	if ref == 0xffff {
+		s, err := a.readUTF()
+		if err != nil {
+			return "", err
+		}
+		a.interned = append(a.interned, s)
+		return s, nil
+	}
+	if int(ref) >= len(a.interned) {
+		return "", fmt.Errorf("invalid interned string reference %d", ref)
+	}
+	return a.interned[ref], nil
+}
+
+// readUTF reads a string prefixed with its 16 bit length in bytes.
+func (a *abxReader) readUTF() (string, error) {
+	n, err := a.readUint16()
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(a.r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (a *abxReader) readUint16() (uint16, error) {
+	b := make([]byte, 2)
+	if _, err := io.ReadFull(a.r, b); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint16(b), nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package android extracts the apps installed on Android devices and the
+// builds of Android partitions from device filesystem dumps and system images.
+// Apps are read from the package manager's packages.xml, in both the text and
+// the binary XML format, and partition builds from build.prop files.
+package android
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "os/android"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`. packages.xml grows with
+	// the number of installed apps and their permissions.
+	defaultMaxFileSizeBytes = 20 * units.MiB
+
+	// packagesXMLPath is the path of packages.xml relative to the root of a
+	// device filesystem or the userdata partition.
+	packagesXMLPath = "system/packages.xml"
+)
+
+// partitions are the partitions that have a build.prop file.
+var partitions = []string{"system", "system_ext", "vendor", "product", "odm"}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the Android extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts Android apps and partition builds.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns an Android extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true if the specified file is the package manager's
+// packages.xml or the build.prop of an Android partition.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	path := api.Path()
+	if !isPackagesXML(path) && buildPropPartition(path) == "" {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(path, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+// isPackagesXML returns true for data/system/packages.xml of a device
+// filesystem and system/packages.xml of a userdata partition dump.
+func isPackagesXML(p string) bool {
+	p = filepath.ToSlash(p)
+	return p == packagesXMLPath || strings.HasSuffix(p, "/"+packagesXMLPath)
+}
+
+// buildPropPartition returns the partition of a build.prop file, e.g. "vendor"
+// for vendor/build.prop or "product" for product/etc/build.prop. Returns an
+// empty string if the file isn't a partition's build.prop.
+func buildPropPartition(p string) string {
+	p = filepath.ToSlash(p)
+	if path.Base(p) != "build.prop" {
+		return ""
+	}
+	dirs := strings.Split(path.Dir(p), "/")
+	if dirs[len(dirs)-1] == "etc" {
+		dirs = dirs[:len(dirs)-1]
+	}
+	if len(dirs) == 0 || !slices.Contains(partitions, dirs[len(dirs)-1]) {
+		return ""
+	}
+	return dirs[len(dirs)-1]
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the file passed through the scan input and returns the
+// installed apps or the partition build.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	return inventory, nil
+}
+
+func (e Extractor) extract(input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	if isPackagesXML(input.Path) {
+		root, err := parseXML(input.Reader)
+		if err != nil {
+			return nil, err
+		}
+		inventory := []*extractor.Inventory{}
+		for _, app := range parsePackages(root) {
+			inventory = append(inventory, &extractor.Inventory{
+				Name:      app.PackageName,
+				Version:   strconv.FormatInt(app.VersionCode, 10),
+				Locations: []string{input.Path},
+				Metadata:  &Metadata{App: app},
+			})
+		}
+		return inventory, nil
+	}
+
+	partition := buildPropPartition(input.Path)
+	p, err := parseBuildProp(input.Reader, partition)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return []*extractor.Inventory{}, nil
+	}
+	return []*extractor.Inventory{{
+		Name:      "android-" + partition,
+		Version:   p.Release,
+		Locations: []string{input.Path},
+		Metadata:  &Metadata{Partition: p},
+	}}, nil
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track Android apps and the
+// Android ecosystem of OSV is keyed by AOSP components, not partitions.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
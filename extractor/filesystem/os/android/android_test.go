@@ -0,0 +1,310 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package android_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/android"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+const (
+	systemCert = "90e0043b216c0cec621796ffffd7890aff60f4eb42e2041d4e6ddbc44bf0c945"
+	chromeCert = "1c58f3b5260ca9203e5b788bd2f8ca732aff4c642a870fbeed9fe76fa0d6bea3"
+	notesCert  = "671aaa2c39ed6ae9399b3801ac7f0b6f5f8dda5acf34baa427b6079603328d4f"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "packages.xml on device filesystem",
+			path:             "data/system/packages.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "packages.xml in userdata partition dump",
+			path:             "userdata/system/packages.xml",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "packages.xml backup",
+			path:         "data/system/packages-backup.xml",
+			wantRequired: false,
+		},
+		{
+			name:             "system build.prop",
+			path:             "system/build.prop",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "system-as-root build.prop",
+			path:             "system/system/build.prop",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "product build.prop",
+			path:             "product/etc/build.prop",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "build.prop outside of a partition",
+			path:         "etc/build.prop",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "data/system/packages.xml",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "data/system/packages.xml",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := android.New(android.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+// wantApps returns the apps listed in testdata/data/system/packages.xml and
+// its binary XML version.
+func wantApps(location string) []*extractor.Inventory {
+	return []*extractor.Inventory{
+		{
+			Name:      "com.android.settings",
+			Version:   "34",
+			Locations: []string{location},
+			Metadata: &android.Metadata{App: &android.App{
+				PackageName:         "com.android.settings",
+				VersionCode:         34,
+				CodePath:            "/system_ext/priv-app/SettingsGoogle",
+				System:              true,
+				SigningCertificates: []string{systemCert},
+				LastUpdateTime:      time.UnixMilli(0x18d7c0e5a10).UTC(),
+			}},
+		},
+		{
+			Name:      "com.android.chrome",
+			Version:   "623718133",
+			Locations: []string{location},
+			Metadata: &android.Metadata{App: &android.App{
+				PackageName:         "com.android.chrome",
+				VersionCode:         623718133,
+				CodePath:            "/data/app/~~Xh3rQz0YdJ9wq1Vb7Qm1Dw==/com.android.chrome-ZmFrZUNocm9tZQ==",
+				Installer:           "com.android.vending",
+				System:              true,
+				UpdatedSystemApp:    true,
+				SigningCertificates: []string{chromeCert},
+				FirstInstallTime:    time.UnixMilli(0x18d7c0e5a10).UTC(),
+				LastUpdateTime:      time.UnixMilli(0x18e0a7b1d40).UTC(),
+			}},
+		},
+		{
+			Name:      "org.example.notes",
+			Version:   "4021",
+			Locations: []string{location},
+			Metadata: &android.Metadata{App: &android.App{
+				PackageName:         "org.example.notes",
+				VersionCode:         4021,
+				CodePath:            "/data/app/~~aGVsbG8gd29ybGQ=/org.example.notes-bm90ZXM=",
+				Installer:           "com.android.vending",
+				SigningCertificates: []string{notesCert},
+				FirstInstallTime:    time.UnixMilli(0x18e12c4a700).UTC(),
+				LastUpdateTime:      time.UnixMilli(0x18e12c4a8f0).UTC(),
+			}},
+		},
+		{
+			Name:      "org.example.sideloaded",
+			Version:   "7",
+			Locations: []string{location},
+			Metadata: &android.Metadata{App: &android.App{
+				PackageName:         "org.example.sideloaded",
+				VersionCode:         7,
+				CodePath:            "/data/app/org.example.sideloaded-1",
+				SigningCertificates: []string{notesCert},
+				FirstInstallTime:    time.UnixMilli(0x16b8e2d7a00).UTC(),
+				LastUpdateTime:      time.UnixMilli(0x16b8e2d7a00).UTC(),
+			}},
+		},
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "text packages.xml",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/data/system/packages.xml",
+			},
+			WantInventory: wantApps("testdata/data/system/packages.xml"),
+		},
+		{
+			Name: "binary packages.xml",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/abx/data/system/packages.xml",
+			},
+			WantInventory: wantApps("testdata/abx/data/system/packages.xml"),
+		},
+		{
+			Name: "packages.xml without packages",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/empty/data/system/packages.xml",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+		{
+			Name: "system build.prop",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/system/build.prop",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "android-system",
+				Version:   "14",
+				Locations: []string{"testdata/system/build.prop"},
+				Metadata: &android.Metadata{Partition: &android.Partition{
+					Name:          "system",
+					Release:       "14",
+					SDKVersion:    "34",
+					SecurityPatch: "2024-02-05",
+					Fingerprint:   "google/oriole/oriole:14/UQ1A.240205.004/11269751:user/release-keys",
+					BuildID:       "UQ1A.240205.004",
+				}},
+			}},
+		},
+		{
+			Name: "vendor build.prop",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/vendor/build.prop",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "android-vendor",
+				Version:   "14",
+				Locations: []string{"testdata/vendor/build.prop"},
+				Metadata: &android.Metadata{Partition: &android.Partition{
+					Name:          "vendor",
+					Release:       "14",
+					SDKVersion:    "34",
+					SecurityPatch: "2024-01-05",
+					Fingerprint:   "google/oriole/oriole:14/UQ1A.240205.002/11224170:user/release-keys",
+					BuildID:       "UQ1A.240205.002",
+				}},
+			}},
+		},
+		{
+			Name: "build.prop without build information",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/product/etc/build.prop",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := android.New(android.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := android.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "com.android.chrome",
+		Version:   "623718133",
+		Locations: []string{"data/system/packages.xml"},
+	}
+	want := &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    "com.android.chrome",
+		Version: "623718133",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
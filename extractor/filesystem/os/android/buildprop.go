@@ -0,0 +1,75 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package android
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// parseBuildProp returns the build of the given partition from its build.prop
+// file. Returns nil if the file doesn't contain a release or fingerprint.
+func parseBuildProp(r io.Reader, partition string) (*Partition, error) {
+	// Properties specific to the partition, e.g. ro.vendor.build.fingerprint,
+	// take precedence over the generic ones.
+	props := map[string]string{}
+	partitionProps := map[string]string{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if rest, ok := strings.CutPrefix(k, "ro."+partition+"."); ok {
+			partitionProps["ro."+rest] = v
+		} else {
+			props[k] = v
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	get := func(keys ...string) string {
+		for _, m := range []map[string]string{partitionProps, props} {
+			for _, k := range keys {
+				if v, ok := m[k]; ok {
+					return v
+				}
+			}
+		}
+		return ""
+	}
+
+	p := &Partition{
+		Name:       partition,
+		Release:    get("ro.build.version.release"),
+		SDKVersion: get("ro.build.version.sdk"),
+		// The vendor partition stores its patch level in
+		// ro.vendor.build.security_patch.
+		SecurityPatch: get("ro.build.version.security_patch", "ro.build.security_patch"),
+		Fingerprint:   get("ro.build.fingerprint"),
+		BuildID:       get("ro.build.id"),
+	}
+	if p.Release == "" && p.Fingerprint == "" {
+		return nil, nil
+	}
+	return p, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package android
+
+import "time"
+
+// Metadata holds an Android app installed on a device or the build of an
+// Android partition. Exactly one of the fields is set.
+type Metadata struct {
+	App       *App
+	Partition *Partition
+}
+
+// App is an app listed in the package manager's data/system/packages.xml.
+type App struct {
+	PackageName string
+	// The versionCode of the app. packages.xml doesn't store the versionName.
+	VersionCode int64
+	// Directory of the APK, e.g. /data/app/~~abc==/com.example-xyz==.
+	CodePath string
+	// Package name of the app store or tool that installed the app, if any.
+	Installer string
+	// Whether the app is part of the system image. Updated system apps have
+	// their code in /data/app but are still system apps.
+	System bool
+	// Whether the app is a system app that was updated after the image was
+	// built.
+	UpdatedSystemApp bool
+	// SHA-256 digests of the DER encoded signing certificates, hex encoded as
+	// printed by apksigner.
+	SigningCertificates []string
+	FirstInstallTime    time.Time
+	LastUpdateTime      time.Time
+}
+
+// Partition is the build of an Android partition read from its build.prop.
+type Partition struct {
+	// The partition the build.prop belongs to, e.g. "system" or "vendor".
+	Name string
+	// The Android release, e.g. "14".
+	Release    string
+	SDKVersion string
+	// The security patch level, e.g. "2024-03-05".
+	SecurityPatch string
+	Fingerprint   string
+	BuildID       string
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package android
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// flagSystem is ApplicationInfo.FLAG_SYSTEM, set in the publicFlags of apps
+// that are part of the system image.
+const flagSystem = 1
+
+// systemCodePaths are the partitions system apps are installed on.
+var systemCodePaths = []string{"/system/", "/system_ext/", "/product/", "/vendor/", "/odm/", "/apex/"}
+
+// parsePackages returns the apps listed in a packages.xml document.
+func parsePackages(root *element) []*App {
+	var packages *element
+	for _, c := range root.children {
+		if c.name == "packages" {
+			packages = c
+		}
+	}
+	if packages == nil {
+		return nil
+	}
+
+	// Certificates are written in full the first time they're used and only
+	// referenced by their index afterwards, also across packages.
+	certs := map[string]string{}
+	collectCerts(packages, certs)
+
+	updated := map[string]bool{}
+	for _, c := range packages.children {
+		if c.name == "updated-package" {
+			updated[c.attrs["name"]] = true
+		}
+	}
+
+	var apps []*App
+	for _, c := range packages.children {
+		if c.name != "package" || c.attrs["name"] == "" {
+			continue
+		}
+		apps = append(apps, parseApp(c, certs, updated[c.attrs["name"]]))
+	}
+	return apps
+}
+
+func parseApp(e *element, certs map[string]string, updated bool) *App {
+	app := &App{
+		PackageName:      e.attrs["name"],
+		CodePath:         e.attrs["codePath"],
+		Installer:        e.attrs["installer"],
+		UpdatedSystemApp: updated,
+		FirstInstallTime: parseHexMillis(e.attrs["it"]),
+		LastUpdateTime:   parseHexMillis(e.attrs["ut"]),
+	}
+	app.VersionCode, _ = strconv.ParseInt(e.attrs["version"], 10, 64)
+
+	flags, ok := e.attrs["publicFlags"]
+	if !ok {
+		// Used before Android 6.
+		flags, ok = e.attrs["flags"]
+	}
+	if f, err := strconv.ParseInt(flags, 10, 64); ok && err == nil {
+		app.System = f&flagSystem != 0
+	} else {
+		app.System = hasSystemCodePath(app.CodePath)
+	}
+	app.System = app.System || updated
+
+	for _, c := range e.children {
+		if c.name != "sigs" {
+			continue
+		}
+		// Certificates of previous signing keys are nested in pastSigs and
+		// aren't the current signers.
+		for _, cert := range c.children {
+			if cert.name != "cert" {
+				continue
+			}
+			if d, ok := certs[cert.attrs["index"]]; ok {
+				app.SigningCertificates = append(app.SigningCertificates, d)
+			}
+		}
+	}
+	return app
+}
+
+// collectCerts adds the SHA-256 digests of all certificates defined in e and
+// its descendants to certs, keyed by their index.
+func collectCerts(e *element, certs map[string]string) {
+	if e.name == "cert" {
+		if key, ok := e.attrs["key"]; ok {
+			if der, err := hex.DecodeString(key); err == nil {
+				d := sha256.Sum256(der)
+				certs[e.attrs["index"]] = hex.EncodeToString(d[:])
+			}
+		}
+	}
+	for _, c := range e.children {
+		collectCerts(c, certs)
+	}
+}
+
+func hasSystemCodePath(p string) bool {
+	for _, s := range systemCodePaths {
+		if strings.HasPrefix(p, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseHexMillis parses a timestamp in hex encoded milliseconds since the
+// epoch. Returns the zero time if the timestamp is missing or invalid.
+func parseHexMillis(s string) time.Time {
+	ms, err := strconv.ParseInt(s, 16, 64)
+	if err != nil || ms <= 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms).UTC()
+}
@@ -20,11 +20,13 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/osrelease"
+	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
@@ -36,6 +38,14 @@ const (
 	Name = "os/apk"
 )
 
+// defaultDatabasePaths are the locations of the APK database relative to the
+// root of an installation. Hardened images and distributions with a merged
+// /usr keep it below usr/ instead of lib/.
+var defaultDatabasePaths = []string{
+	"lib/apk/db/installed",
+	"usr/lib/apk/db/installed",
+}
+
 // Config is the configuration for the Extractor.
 type Config struct {
 	// Stats is a stats collector for reporting metrics.
@@ -43,6 +53,14 @@ type Config struct {
 	// MaxFileSizeBytes is the maximum file size this extractor will unmarshal. If
 	// `FileRequired` gets a bigger file, it will return false,
 	MaxFileSizeBytes int64
+	// DatabasePaths are additional locations of the APK database relative to
+	// the root of the installation, e.g. "opt/apk/db/installed" for images
+	// that relocate it. The default locations are always checked.
+	DatabasePaths []string
+	// NestedRoots makes the extractor also look for the APK database in root
+	// filesystems nested in the scanned one, e.g. chroots in srv/chroot/foo/.
+	// The OS of each nested root is read from its own os-release file.
+	NestedRoots bool
 }
 
 // DefaultConfig returns the default configuration for the extractor.
@@ -57,6 +75,10 @@ func DefaultConfig() Config {
 type Extractor struct {
 	stats            stats.Collector
 	maxFileSizeBytes int64
+	// Database paths, longest first so that a nested root is never mistaken
+	// for a part of a longer database path.
+	databasePaths []string
+	nestedRoots   bool
 }
 
 // New returns an APK extractor.
@@ -66,9 +88,16 @@ type Extractor struct {
 // e := New(DefaultConfig())
 // ```
 func New(cfg Config) *Extractor {
+	paths := append([]string{}, defaultDatabasePaths...)
+	for _, p := range cfg.DatabasePaths {
+		paths = append(paths, strings.Trim(filepath.ToSlash(p), "/"))
+	}
+	sort.SliceStable(paths, func(i, j int) bool { return len(paths[i]) > len(paths[j]) })
 	return &Extractor{
 		stats:            cfg.Stats,
 		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+		databasePaths:    paths,
+		nestedRoots:      cfg.NestedRoots,
 	}
 }
 
@@ -84,7 +113,7 @@ func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabili
 // FileRequired returns true if the specified file matches apk status file pattern.
 func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	// Should match the status file.
-	if filepath.ToSlash(api.Path()) != "lib/apk/db/installed" {
+	if _, ok := e.installRoot(api.Path()); !ok {
 		return false
 	}
 
@@ -101,6 +130,30 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	return true
 }
 
+// installRoot returns the root of the installation that the APK database at
+// path belongs to and whether path is an APK database.
+func (e Extractor) installRoot(path string) (string, bool) {
+	path = filepath.ToSlash(path)
+	databasePaths := e.databasePaths
+	if databasePaths == nil {
+		databasePaths = defaultDatabasePaths
+	}
+	for _, db := range databasePaths {
+		if path == db {
+			return ".", true
+		}
+		if e.nestedRoots && strings.HasSuffix(path, "/"+db) {
+			return strings.TrimSuffix(path, "/"+db), true
+		}
+	}
+	return "", false
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
@@ -112,7 +165,7 @@ func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result s
 	})
 }
 
-// Extract extracts packages from lib/apk/db/installed passed through the scan input.
+// Extract extracts packages from the APK database passed through the scan input.
 func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
 	inventory, err := e.extractFromInput(ctx, input)
 	if e.stats != nil {
@@ -163,7 +216,11 @@ func parseSingleApkRecord(scanner *bufio.Scanner) (map[string]string, error) {
 }
 
 func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
-	m, err := osrelease.GetOSRelease(input.FS)
+	root, ok := e.installRoot(input.Path)
+	if !ok {
+		root = "."
+	}
+	m, err := osrelease.GetOSRelease(scalibrfs.Sub(input.FS, root))
 	if err != nil {
 		log.Errorf("osrelease.ParseOsRelease(): %v", err)
 	}
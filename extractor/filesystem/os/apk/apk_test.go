@@ -42,6 +42,8 @@ func TestFileRequired(t *testing.T) {
 		path             string
 		fileSizeBytes    int64
 		maxFileSizeBytes int64
+		databasePaths    []string
+		nestedRoots      bool
 		wantRequired     bool
 		wantResultMetric stats.FileRequiredResult
 	}{
@@ -61,6 +63,45 @@ func TestFileRequired(t *testing.T) {
 			path:         "foo/lib/apk/db/installed",
 			wantRequired: false,
 		},
+		{
+			name:             "installed file below usr",
+			path:             "usr/lib/apk/db/installed",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "custom database path",
+			path:             "opt/apk/db/installed",
+			databasePaths:    []string{"/opt/apk/db/installed"},
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "custom database path not configured",
+			path:         "opt/apk/db/installed",
+			wantRequired: false,
+		},
+		{
+			name:             "nested root",
+			path:             "srv/chroot/edge/lib/apk/db/installed",
+			nestedRoots:      true,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "nested root with custom database path",
+			path:             "srv/chroot/edge/opt/apk/db/installed",
+			databasePaths:    []string{"opt/apk/db/installed"},
+			nestedRoots:      true,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "nested root sub file",
+			path:         "srv/chroot/edge/lib/apk/db/installed/test",
+			nestedRoots:  true,
+			wantRequired: false,
+		},
 		{
 			name:             "installed file required if file size < max file size",
 			path:             "lib/apk/db/installed",
@@ -101,6 +142,8 @@ func TestFileRequired(t *testing.T) {
 			var e filesystem.Extractor = apk.New(apk.Config{
 				Stats:            collector,
 				MaxFileSizeBytes: tt.maxFileSizeBytes,
+				DatabasePaths:    tt.databasePaths,
+				NestedRoots:      tt.nestedRoots,
 			})
 
 			// Set a default file size if not specified.
@@ -275,6 +318,73 @@ func TestExtract(t *testing.T) {
 	}
 }
 
+func TestExtract_NestedRoot(t *testing.T) {
+	tests := []struct {
+		name          string
+		dbPath        string
+		wantOSVersion string
+	}{
+		{
+			name:          "scanned root",
+			dbPath:        "usr/lib/apk/db/installed",
+			wantOSVersion: "3.18.0",
+		},
+		{
+			name:          "chroot",
+			dbPath:        "srv/chroot/edge/lib/apk/db/installed",
+			wantOSVersion: "3.21.0",
+		},
+		{
+			name:          "chroot with database below usr",
+			dbPath:        "srv/chroot/edge/usr/lib/apk/db/installed",
+			wantOSVersion: "3.21.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := apk.New(apk.Config{NestedRoots: true})
+
+			d := t.TempDir()
+			createOsRelease(t, d, alpine)
+			createOsRelease(t, filepath.Join(d, "srv/chroot/edge"), "ID=alpine\nVERSION_ID=3.21.0")
+			db, err := os.ReadFile("testdata/single")
+			if err != nil {
+				t.Fatalf("os.ReadFile(): %v", err)
+			}
+			if err := os.MkdirAll(filepath.Join(d, filepath.Dir(tt.dbPath)), 0755); err != nil {
+				t.Fatalf("os.MkdirAll(): %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(d, tt.dbPath), db, 0644); err != nil {
+				t.Fatalf("os.WriteFile(): %v", err)
+			}
+			r, err := os.Open(filepath.Join(d, tt.dbPath))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			input := &filesystem.ScanInput{
+				FS:     scalibrfs.DirFS(d),
+				Path:   tt.dbPath,
+				Reader: r,
+				Root:   d,
+			}
+			got, err := e.Extract(context.Background(), input)
+			if err != nil {
+				t.Fatalf("Extract(%s): %v", tt.dbPath, err)
+			}
+
+			want := []*extractor.Inventory{
+				getInventory(tt.dbPath, "alpine-baselayout-data", "alpine-baselayout", "3.4.3-r1", "alpine", tt.wantOSVersion, "Natanael Copa <ncopa@alpinelinux.org>", "x86_64", "GPL-2.0-only", "65502ca9379dd29d1ac4b0bf0dcf03a3dd1b324a"),
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Extract(%s) (-want +got):\n%s", tt.dbPath, diff)
+			}
+		})
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	e := apk.Extractor{}
 	tests := []struct {
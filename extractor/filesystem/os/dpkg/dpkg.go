@@ -45,6 +45,10 @@ const (
 	// If Extract gets a bigger file, it will return an error.
 	defaultMaxFileSizeBytes = 100 * units.MiB
 
+	// defaultMaxAPTIndexBytes is the maximum number of decompressed bytes read
+	// from the APT package indexes to classify the origins of packages.
+	defaultMaxAPTIndexBytes = 512 * units.MiB
+
 	// defaultIncludeNotInstalled is the default value for the IncludeNotInstalled option.
 	defaultIncludeNotInstalled = false
 )
@@ -59,6 +63,11 @@ type Config struct {
 	// IncludeNotInstalled includes packages that are not installed
 	// (e.g. `deinstall`, `purge`, and those missing a status field).
 	IncludeNotInstalled bool
+	// MaxAPTIndexBytes is the maximum number of decompressed bytes read from
+	// the APT package indexes in var/lib/apt/lists. If this limit is greater
+	// than zero and the indexes are larger, the origins of the packages aren't
+	// classified.
+	MaxAPTIndexBytes int64
 }
 
 // DefaultConfig returns the default configuration for the DPKG extractor.
@@ -66,6 +75,7 @@ func DefaultConfig() Config {
 	return Config{
 		MaxFileSizeBytes:    defaultMaxFileSizeBytes,
 		IncludeNotInstalled: defaultIncludeNotInstalled,
+		MaxAPTIndexBytes:    defaultMaxAPTIndexBytes,
 	}
 }
 
@@ -74,6 +84,8 @@ type Extractor struct {
 	stats               stats.Collector
 	maxFileSizeBytes    int64
 	includeNotInstalled bool
+	maxAPTIndexBytes    int64
+	aptIndexes          *aptIndexCache
 }
 
 // New returns a DPKG extractor.
@@ -87,6 +99,8 @@ func New(cfg Config) *Extractor {
 		stats:               cfg.Stats,
 		maxFileSizeBytes:    cfg.MaxFileSizeBytes,
 		includeNotInstalled: cfg.IncludeNotInstalled,
+		maxAPTIndexBytes:    cfg.MaxAPTIndexBytes,
+		aptIndexes:          &aptIndexCache{},
 	}
 }
 
@@ -96,6 +110,7 @@ func (e Extractor) Config() Config {
 		Stats:               e.stats,
 		MaxFileSizeBytes:    e.maxFileSizeBytes,
 		IncludeNotInstalled: e.includeNotInstalled,
+		MaxAPTIndexBytes:    e.maxAPTIndexBytes,
 	}
 }
 
@@ -128,6 +143,11 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	return true
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 func fileRequired(path string) bool {
 	normalized := filepath.ToSlash(path)
 
@@ -174,6 +194,14 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 		log.Errorf("osrelease.ParseOsRelease(): %v", err)
 	}
 
+	// OPKG and distroless images don't have APT repository indexes.
+	var aptIx *aptIndex
+	if filepath.ToSlash(input.Path) == "var/lib/dpkg/status" {
+		if aptIx, err = e.aptIndexes.get(ctx, input.FS, e.maxAPTIndexBytes); err != nil {
+			log.Warnf("Failed to load APT package indexes, package origins won't be classified: %v", err)
+		}
+	}
+
 	rd := textproto.NewReader(bufio.NewReader(input.Reader))
 	pkgs := []*extractor.Inventory{}
 	for eof := false; !eof; {
@@ -248,6 +276,9 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 			Locations:   []string{input.Path},
 			Annotations: annotations,
 		}
+		if aptIx != nil {
+			i.Metadata.(*Metadata).Origin = aptIx.classify(pkgName, pkgVersion)
+		}
 		sourceName, sourceVersion, err := parseSourceNameVersion(h.Get("Source"))
 		if err != nil {
 			return pkgs, fmt.Errorf("parseSourceNameVersion(%q): %w", h.Get("Source"), err)
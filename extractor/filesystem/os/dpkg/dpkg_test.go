@@ -23,6 +23,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -30,6 +32,7 @@ import (
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/origin"
 	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	scalibrlog "github.com/google/osv-scalibr/log"
@@ -51,6 +54,7 @@ func TestNew(t *testing.T) {
 			wantCfg: dpkg.Config{
 				MaxFileSizeBytes:    100 * units.MiB,
 				IncludeNotInstalled: false,
+				MaxAPTIndexBytes:    512 * units.MiB,
 			},
 		},
 		{
@@ -58,10 +62,12 @@ func TestNew(t *testing.T) {
 			cfg: dpkg.Config{
 				MaxFileSizeBytes:    10,
 				IncludeNotInstalled: true,
+				MaxAPTIndexBytes:    20,
 			},
 			wantCfg: dpkg.Config{
 				MaxFileSizeBytes:    10,
 				IncludeNotInstalled: true,
+				MaxAPTIndexBytes:    20,
 			},
 		},
 	}
@@ -1410,6 +1416,99 @@ func TestToPURL(t *testing.T) {
 	}
 }
 
+// extractOrigins runs e on the status file in fsys and returns the origins of
+// the packages by name.
+func extractOrigins(t *testing.T, e *dpkg.Extractor, fsys scalibrfs.FS) map[string]*origin.Origin {
+	t.Helper()
+	path := "var/lib/dpkg/status"
+	r, err := fsys.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	info, err := r.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := &filesystem.ScanInput{FS: fsys, Path: path, Reader: r, Info: info}
+	got, err := e.Extract(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Extract(%s): %v", path, err)
+	}
+	origins := make(map[string]*origin.Origin)
+	for _, i := range got {
+		origins[i.Name] = i.Metadata.(*dpkg.Metadata).Origin
+	}
+	return origins
+}
+
+func TestExtract_Origin(t *testing.T) {
+	fsys := scalibrfs.DirFS("testdata/origin")
+	tests := []struct {
+		name string
+		cfg  dpkg.Config
+		want map[string]*origin.Origin
+	}{
+		{
+			name: "default",
+			cfg:  dpkg.DefaultConfig(),
+			want: map[string]*origin.Origin{
+				"bash": {Class: origin.Distro, Repository: "Debian"},
+				// The installed version is outdated but the package is still provided
+				// by the distro.
+				"libssl3":   {Class: origin.Distro, Repository: "Debian"},
+				"docker-ce": {Class: origin.ThirdParty, Repository: "Docker"},
+				"mytool":    {Class: origin.Local},
+				// The repository's Release file claims to be Debian but it's not
+				// hosted on a Debian host.
+				"agent": {Class: origin.ThirdParty, Repository: "apt.example.com"},
+			},
+		},
+		{
+			name: "package indexes too large",
+			cfg:  dpkg.Config{MaxAPTIndexBytes: 100},
+			want: map[string]*origin.Origin{
+				"bash":      nil,
+				"libssl3":   nil,
+				"docker-ce": nil,
+				"mytool":    nil,
+				"agent":     nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractOrigins(t, dpkg.New(tt.cfg), fsys)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Extract() origins (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtract_OriginIndexReloaded(t *testing.T) {
+	status := "Package: agent\nStatus: install ok installed\nVersion: 2.1.0\n\n"
+	packages := "Package: agent\nVersion: 2.1.0\n"
+	list := "var/lib/apt/lists/deb.debian.org_debian_dists_bookworm_main_binary-amd64_Packages"
+	fsys := fstest.MapFS{
+		"var/lib/dpkg/status": {Data: []byte(status)},
+		list:                  {Data: []byte(packages), ModTime: time.Unix(1, 0)},
+	}
+	e := dpkg.New(dpkg.DefaultConfig())
+	want := map[string]*origin.Origin{"agent": {Class: origin.Distro, Repository: "deb.debian.org"}}
+	if diff := cmp.Diff(want, extractOrigins(t, e, fsys)); diff != "" {
+		t.Errorf("Extract() origins (-want +got):\n%s", diff)
+	}
+
+	// The package was removed from the repository's index after an update.
+	fsys[list] = &fstest.MapFile{Data: []byte("Package: other\nVersion: 1.0\n"), ModTime: time.Unix(2, 0)}
+	want = map[string]*origin.Origin{"agent": {Class: origin.Local}}
+	if diff := cmp.Diff(want, extractOrigins(t, e, fsys)); diff != "" {
+		t.Errorf("Extract() after update origins (-want +got):\n%s", diff)
+	}
+}
+
 func TestEcosystem(t *testing.T) {
 	e := dpkg.Extractor{}
 	tests := []struct {
@@ -14,6 +14,8 @@
 
 package dpkg
 
+import "github.com/google/osv-scalibr/extractor/filesystem/os/origin"
+
 // Metadata holds parsing information for a dpkg package.
 type Metadata struct {
 	PackageName       string
@@ -26,4 +28,7 @@ type Metadata struct {
 	OSVersionID       string
 	Maintainer        string
 	Architecture      string
+	// Where the package was installed from. Only set if APT's package indexes
+	// are present.
+	Origin *origin.Origin
 }
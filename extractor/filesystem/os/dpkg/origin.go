@@ -0,0 +1,302 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dpkg
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/os/origin"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+// aptListsDir contains the package indexes of all configured APT repositories.
+const aptListsDir = "var/lib/apt/lists"
+
+// distroOrigins are the Origin values of the Release files of official distro
+// repositories. The Origin is set by whoever runs the repository, so it's only
+// a hint: repositories are only classified as distro repositories if their
+// host is an official distro host as well.
+var distroOrigins = map[string]bool{
+	"Debian":           true,
+	"Debian Backports": true,
+	"Devuan":           true,
+	"Kali":             true,
+	"Raspbian":         true,
+	"Ubuntu":           true,
+}
+
+// aptIndex maps packages to the origins of the APT repositories that
+// provide them.
+type aptIndex struct {
+	// Keyed by "<name>=<version>".
+	versions map[string]*origin.Origin
+	// Keyed by package name. Used for packages whose installed version is no
+	// longer listed in the repository's index.
+	names map[string]*origin.Origin
+}
+
+// errAPTIndexTooLarge is returned if the package indexes exceed the size
+// limit.
+var errAPTIndexTooLarge = errors.New("APT package indexes exceed the size limit")
+
+// aptIndexCache keeps the APT index of the last scanned filesystem so that
+// it's only loaded once per scan, even if several status files are
+// extracted. The index is reloaded if the files in the lists directory
+// change, e.g. in the next scan or on another layer of a container image.
+type aptIndexCache struct {
+	mu          sync.Mutex
+	fingerprint string
+	ix          *aptIndex
+	err         error
+}
+
+// get returns the APT index of fsys, loading it if it's not cached. At most
+// maxBytes of decompressed package indexes are read if maxBytes is greater
+// than zero.
+func (c *aptIndexCache) get(ctx context.Context, fsys scalibrfs.FS, maxBytes int64) (*aptIndex, error) {
+	if fsys == nil {
+		return nil, nil
+	}
+	entries, err := fs.ReadDir(fsys, aptListsDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return loadAPTIndex(ctx, fsys, entries, maxBytes)
+	}
+	fingerprint, err := listsFingerprint(entries)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if fingerprint == c.fingerprint {
+		return c.ix, c.err
+	}
+	ix, err := loadAPTIndex(ctx, fsys, entries, maxBytes)
+	if err != nil && !errors.Is(err, errAPTIndexTooLarge) {
+		// Don't cache context errors.
+		return nil, err
+	}
+	c.fingerprint, c.ix, c.err = fingerprint, ix, err
+	return ix, err
+}
+
+// listsFingerprint identifies the contents of the lists directory by the
+// names, sizes and modification times of its files.
+func listsFingerprint(entries []fs.DirEntry) (string, error) {
+	var b strings.Builder
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "%s:%d:%d\n", e.Name(), info.Size(), info.ModTime().UnixNano())
+	}
+	return b.String(), nil
+}
+
+// loadAPTIndex reads the package indexes downloaded by APT from the given
+// entries of the lists directory. Returns nil if there are none, e.g. because
+// they were removed to reduce the size of a container image, since no
+// packages can be classified in that case.
+func loadAPTIndex(ctx context.Context, fsys scalibrfs.FS, entries []fs.DirEntry, maxBytes int64) (*aptIndex, error) {
+	ix := &aptIndex{
+		versions: make(map[string]*origin.Origin),
+		names:    make(map[string]*origin.Origin),
+	}
+	remaining := &maxBytes
+	if maxBytes <= 0 {
+		remaining = nil
+	}
+	found := false
+	for _, e := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		name := e.Name()
+		if e.IsDir() || !(strings.HasSuffix(name, "_Packages") || strings.HasSuffix(name, "_Packages.gz")) {
+			continue
+		}
+		o := listOrigin(fsys, name)
+		if err := ix.addPackages(fsys, path.Join(aptListsDir, name), o, remaining); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		found = true
+	}
+	if !found {
+		return nil, nil
+	}
+	return ix, nil
+}
+
+// listOrigin determines the origin of the repository a Packages index file
+// belongs to. Repositories are distro repositories if they're hosted on an
+// official distro host and their Release file, if any, names a distro as
+// their Origin. Repositories are named after their Origin, falling back to
+// the host.
+//
+// Index file names are derived from the repository URL, e.g.
+// deb.debian.org_debian_dists_bookworm_main_binary-amd64_Packages with the
+// Release file deb.debian.org_debian_dists_bookworm_InRelease. Flat
+// repositories have no dists/ directory.
+func listOrigin(fsys scalibrfs.FS, listName string) *origin.Origin {
+	base := strings.TrimSuffix(strings.TrimSuffix(listName, ".gz"), "_Packages")
+	if i := strings.Index(base, "_dists_"); i >= 0 {
+		suite, _, _ := strings.Cut(base[i+len("_dists_"):], "_")
+		base = base[:i+len("_dists_")] + suite
+	}
+	host, _, _ := strings.Cut(base, "_")
+	o := &origin.Origin{Class: origin.ThirdParty, Repository: host}
+	if origin.IsDistroURL(host) {
+		o.Class = origin.Distro
+	}
+	for _, release := range []string{base + "_InRelease", base + "_Release"} {
+		name, err := releaseOrigin(fsys, path.Join(aptListsDir, release))
+		if err != nil || name == "" {
+			continue
+		}
+		if !distroOrigins[name] {
+			return &origin.Origin{Class: origin.ThirdParty, Repository: name}
+		}
+		// Repositories on other hosts that claim to be a distro, e.g. mirrors,
+		// keep the host as their name.
+		if o.Class == origin.Distro {
+			o.Repository = name
+		}
+		return o
+	}
+	return o
+}
+
+// releaseOrigin returns the Origin field of an APT Release or InRelease file.
+func releaseOrigin(fsys scalibrfs.FS, path string) (string, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if o, ok := strings.CutPrefix(line, "Origin:"); ok {
+			return strings.TrimSpace(o), nil
+		}
+		// The origin is part of the header before the file checksums.
+		if strings.HasPrefix(line, "MD5Sum:") || strings.HasPrefix(line, "SHA256:") {
+			break
+		}
+	}
+	return "", s.Err()
+}
+
+// addPackages adds all packages listed in the Packages index at path. If
+// remaining isn't nil, it's the number of decompressed bytes that may still be
+// read and is decremented accordingly.
+func (ix *aptIndex) addPackages(fsys scalibrfs.FS, path string, o *origin.Origin, remaining *int64) error {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	if remaining != nil {
+		r = &limitReader{r: r, remaining: remaining}
+	}
+
+	s := bufio.NewScanner(r)
+	// Some packages have very long Description or Depends fields.
+	s.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var name string
+	for s.Scan() {
+		line := s.Text()
+		if n, ok := strings.CutPrefix(line, "Package:"); ok {
+			name = strings.TrimSpace(n)
+			ix.add(ix.names, name, o)
+		} else if v, ok := strings.CutPrefix(line, "Version:"); ok && name != "" {
+			ix.add(ix.versions, name+"="+strings.TrimSpace(v), o)
+		} else if line == "" {
+			name = ""
+		}
+	}
+	return s.Err()
+}
+
+// limitReader reads from r until remaining reaches zero and fails with
+// errAPTIndexTooLarge afterwards. Unlike io.LimitReader, the limit is shared
+// between readers and exceeding it is an error.
+type limitReader struct {
+	r         io.Reader
+	remaining *int64
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if *l.remaining <= 0 {
+		// The limit may be reached at the end of a file.
+		if n, err := l.r.Read(make([]byte, 1)); n == 0 && errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		return 0, errAPTIndexTooLarge
+	}
+	if int64(len(p)) > *l.remaining {
+		p = p[:*l.remaining]
+	}
+	n, err := l.r.Read(p)
+	*l.remaining -= int64(n)
+	return n, err
+}
+
+// add records that the package with the given key is available from o.
+// Official distro repositories take precedence over third-party ones.
+func (ix *aptIndex) add(m map[string]*origin.Origin, key string, o *origin.Origin) {
+	if prev, ok := m[key]; ok && prev.Class == origin.Distro {
+		return
+	}
+	m[key] = o
+}
+
+// classify returns the origin of an installed package. Packages that aren't
+// listed in any repository index are considered to be installed locally.
+func (ix *aptIndex) classify(name, version string) *origin.Origin {
+	if o, ok := ix.versions[name+"="+version]; ok {
+		c := *o
+		return &c
+	}
+	if o, ok := ix.names[name]; ok {
+		c := *o
+		return &c
+	}
+	return &origin.Origin{Class: origin.Local}
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package origin classifies OS packages by where they were installed from,
+// e.g. the official distro repositories or a third-party repository.
+package origin
+
+import (
+	"strings"
+)
+
+// Class is the trust classification of a package's origin.
+type Class string
+
+// Origin classes.
+const (
+	// Unknown means the origin couldn't be determined, e.g. because the package
+	// manager's repository metadata was removed from the image.
+	Unknown Class = ""
+	// Distro means the package comes from an official repository of the
+	// distribution.
+	Distro Class = "distro"
+	// ThirdParty means the package comes from a repository that was added to
+	// the package manager's sources, e.g. a vendor repository.
+	ThirdParty Class = "third-party"
+	// Local means the package isn't available from any configured repository,
+	// e.g. because it was installed from a local package file.
+	Local Class = "local"
+)
+
+// Origin describes where a package was installed from.
+type Origin struct {
+	Class Class
+	// The repository the package was installed from, e.g. the Origin of an
+	// APT repository ("Debian") or a yum repo ID ("baseos"). Empty for local
+	// packages.
+	Repository string
+}
+
+// distroDomains are the domains that host official distro repositories and
+// their mirror lists.
+var distroDomains = []string{
+	"almalinux.org",
+	"amazonlinux.com",
+	"archive.ubuntu.com",
+	"centos.org",
+	"debian.org",
+	"fedoraproject.org",
+	"oracle.com",
+	"opensuse.org",
+	"ports.ubuntu.com",
+	"redhat.com",
+	"rockylinux.org",
+	"security.ubuntu.com",
+	"suse.com",
+}
+
+// IsDistroURL returns true if the repository URL points to an official distro
+// repository or mirror list.
+func IsDistroURL(url string) bool {
+	_, rest, found := strings.Cut(url, "://")
+	if !found {
+		rest = url
+	}
+	host, _, _ := strings.Cut(rest, "/")
+	host, _, _ = strings.Cut(host, ":")
+	host = strings.ToLower(host)
+	if host == "" {
+		return false
+	}
+	for _, d := range distroDomains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package origin_test
+
+import (
+	"testing"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/os/origin"
+)
+
+func TestIsDistroURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{url: "http://deb.debian.org/debian", want: true},
+		{url: "http://us.archive.ubuntu.com/ubuntu/", want: true},
+		{url: "https://mirrors.fedoraproject.org/metalink?repo=fedora-$releasever&arch=$basearch", want: true},
+		{url: "https://cdn.redhat.com:443/content/dist/rhel9/", want: true},
+		{url: "mirrorlist.centos.org/?release=7", want: true},
+		{url: "https://download.docker.com/linux/debian", want: false},
+		{url: "https://evil-debian.org/debian", want: false},
+		{url: "file:///mnt/repo", want: false},
+		{url: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if got := origin.IsDistroURL(tt.url); got != tt.want {
+				t.Errorf("IsDistroURL(%q) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
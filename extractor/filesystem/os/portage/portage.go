@@ -116,6 +116,11 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	return true
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 func fileRequired(path string) bool {
 	// Define Portage-specific file patterns here
 	normalized := filepath.ToSlash(path)
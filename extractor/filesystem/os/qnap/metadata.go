@@ -0,0 +1,30 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qnap
+
+// Metadata holds information about a QPKG package installed on a QNAP NAS.
+type Metadata struct {
+	// The name shown in the App Center, e.g. "Container Station".
+	DisplayName string
+	Author      string
+	// Whether the package is enabled, i.e. started on boot.
+	Enabled bool
+	// The directory the package is installed into, e.g.
+	// "/share/CACHEDEV1_DATA/.qpkg/container-station".
+	InstallPath string
+	// The date the package was installed, e.g. "2023-01-30".
+	Date  string
+	Build string
+}
@@ -0,0 +1,254 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package qnap extracts the QPKG packages installed on QNAP NAS devices from
+// the package registry of QTS, qpkg.conf.
+package qnap
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "os/qnap"
+
+	// defaultMaxFileSizeBytes is the default maximum file size the extractor will
+	// attempt to extract. If a file is encountered that is larger than this
+	// limit, the file is ignored by `FileRequired`.
+	defaultMaxFileSizeBytes = 10 * units.MiB
+
+	// registryFile is the name of the QTS package registry.
+	registryFile = "qpkg.conf"
+	// purlNamespace is the namespace of the generic PURLs of QPKG packages.
+	purlNamespace = "qnap"
+)
+
+// registryDirs are the directories holding the package registry: /etc/config
+// is a link to the .config directory of the system volume, which is what's
+// found when scanning a disk image.
+var registryDirs = []string{"etc/config", "mnt/HDA_ROOT/.config"}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// MaxFileSizeBytes is the maximum size of a file that can be extracted.
+	// If this limit is greater than zero and a file is encountered that is larger
+	// than this limit, the file is ignored by returning false for `FileRequired`.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the QNAP extractor.
+func DefaultConfig() Config {
+	return Config{
+		Stats:            nil,
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// Extractor extracts QNAP QPKG packages.
+type Extractor struct {
+	stats            stats.Collector
+	maxFileSizeBytes int64
+}
+
+// New returns a QNAP extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:            cfg.Stats,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// FileRequired returns true if the specified file is the QTS package registry.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	p := filepath.ToSlash(api.Path())
+	if path.Base(p) != registryFile || !isRegistryDir(path.Dir(p)) {
+		return false
+	}
+
+	fileinfo, err := api.Stat()
+	if err != nil {
+		return false
+	}
+	if e.maxFileSizeBytes > 0 && fileinfo.Size() > e.maxFileSizeBytes {
+		e.reportFileRequired(p, fileinfo.Size(), stats.FileRequiredResultSizeLimitExceeded)
+		return false
+	}
+
+	e.reportFileRequired(p, fileinfo.Size(), stats.FileRequiredResultOK)
+	return true
+}
+
+func isRegistryDir(dir string) bool {
+	for _, d := range registryDirs {
+		if dir == d || strings.HasSuffix(dir, "/"+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:          path,
+		Result:        result,
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// Extract parses the package registry passed through the scan input and
+// returns the packages it lists.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inventory, err := e.extract(ctx, input)
+	e.reportFileExtracted(input.Path, input.Info, err)
+	if err != nil {
+		return []*extractor.Inventory{}, fmt.Errorf("could not extract from %s: %w", input.Path, err)
+	}
+	return inventory, nil
+}
+
+// section is a [section] of the registry with its key = value pairs.
+type section struct {
+	name   string
+	values map[string]string
+}
+
+func (e Extractor) extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	sections, err := parseRegistry(input)
+	if err != nil {
+		return nil, err
+	}
+	inventory := []*extractor.Inventory{}
+	for _, s := range sections {
+		if err := ctx.Err(); err != nil {
+			return inventory, fmt.Errorf("%s halted due to context error: %w", e.Name(), err)
+		}
+		version := s.values["Version"]
+		if version == "" {
+			continue
+		}
+		// Sections are named after the package, Name is set for all but very
+		// old packages.
+		name := s.values["Name"]
+		if name == "" {
+			name = s.name
+		}
+		inventory = append(inventory, &extractor.Inventory{
+			Name:      name,
+			Version:   version,
+			Locations: []string{input.Path},
+			Metadata: &Metadata{
+				DisplayName: s.values["Display_Name"],
+				Author:      s.values["Author"],
+				Enabled:     strings.EqualFold(s.values["Enable"], "TRUE"),
+				InstallPath: s.values["Install_Path"],
+				Date:        s.values["Date"],
+				Build:       s.values["Build"],
+			},
+		})
+	}
+	return inventory, nil
+}
+
+// parseRegistry parses the INI-style registry into its sections.
+func parseRegistry(input *filesystem.ScanInput) ([]*section, error) {
+	var sections []*section
+	var current *section
+	s := bufio.NewScanner(input.Reader)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = &section{name: strings.TrimSpace(line[1 : len(line)-1]), values: map[string]string{}}
+			sections = append(sections, current)
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || current == nil {
+			continue
+		}
+		current.values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return sections, s.Err()
+}
+
+func (e Extractor) reportFileExtracted(path string, fileinfo fs.FileInfo, err error) {
+	if e.stats == nil {
+		return
+	}
+	var fileSizeBytes int64
+	if fileinfo != nil {
+		fileSizeBytes = fileinfo.Size()
+	}
+	e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+		Path:          path,
+		Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+		FileSizeBytes: fileSizeBytes,
+	})
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL. There is
+// no PURL type for QPKG packages, so generic PURLs are used.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: purlNamespace,
+		Name:      i.Name,
+		Version:   i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not track QPKG packages.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ filesystem.Extractor = Extractor{}
@@ -14,6 +14,8 @@
 
 package rpm
 
+import "github.com/google/osv-scalibr/extractor/filesystem/os/origin"
+
 // Metadata holds parsing information for an rpm package.
 type Metadata struct {
 	PackageName  string
@@ -26,4 +28,7 @@ type Metadata struct {
 	Vendor       string
 	Architecture string
 	License      string
+	// Where the package was installed from. Only set if the yum database or
+	// dnf history is present.
+	Origin *origin.Origin
 }
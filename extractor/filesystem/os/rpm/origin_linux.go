@@ -0,0 +1,291 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+
+package rpm
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/os/origin"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+const (
+	yumReposDir    = "etc/yum.repos.d"
+	yumDBDir       = "var/lib/yum/yumdb"
+	dnfHistoryPath = "var/lib/dnf/history.sqlite"
+)
+
+// Pseudo repos used by yum and dnf.
+const (
+	// Packages installed from a local .rpm file.
+	commandlineRepo = "@commandline"
+	// Packages installed while installing the OS.
+	anacondaRepo = "anaconda"
+)
+
+// distroRepoPrefixes are IDs of official distro repos, used if their
+// definitions are no longer present in /etc/yum.repos.d.
+var distroRepoPrefixes = []string{
+	"almalinux", "amazonlinux", "amzn", "appstream", "base", "centos", "crb",
+	"extras", "fedora", "ol7_", "ol8_", "ol9_", "powertools", "rhel-", "rocky",
+	"ubi-", "updates",
+}
+
+// dnfInstallActions are the dnf history TransactionItemActions that result in
+// a package being installed: install, downgrade, obsolete, upgrade, reinstall.
+const dnfInstallActions = "1, 2, 4, 6, 9"
+
+// repoIndex maps installed packages to the repos they were installed from.
+type repoIndex struct {
+	// Whether the repo with the given ID points to official distro mirrors.
+	repos map[string]bool
+	// Repo IDs keyed by "<name>-<version>-<release>".
+	installedFrom map[string]string
+}
+
+// loadRepoIndex reads which repo each package was installed from out of the
+// yum database and the dnf history. Returns nil if neither is present.
+// root is the scan root on the real filesystem, or empty if fsys is virtual.
+func loadRepoIndex(ctx context.Context, fsys scalibrfs.FS, root string) (*repoIndex, error) {
+	if fsys == nil {
+		return nil, nil
+	}
+	ix := &repoIndex{
+		repos:         make(map[string]bool),
+		installedFrom: make(map[string]string),
+	}
+	if err := ix.loadYumDB(ctx, fsys); err != nil {
+		return nil, err
+	}
+	if err := ix.loadDNFHistory(ctx, fsys, root); err != nil {
+		return nil, err
+	}
+	if len(ix.installedFrom) == 0 {
+		return nil, nil
+	}
+	if err := ix.loadRepos(fsys); err != nil {
+		return nil, err
+	}
+	return ix, nil
+}
+
+// loadYumDB reads the repos recorded by yum in
+// yumdb/<letter>/<pkgid>-<name>-<version>-<release>-<arch>/from_repo.
+func (ix *repoIndex) loadYumDB(ctx context.Context, fsys scalibrfs.FS) error {
+	letters, err := fs.ReadDir(fsys, yumDBDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, l := range letters {
+		pkgs, err := fs.ReadDir(fsys, path.Join(yumDBDir, l.Name()))
+		if err != nil {
+			continue
+		}
+		for _, p := range pkgs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			key, ok := yumDBKey(p.Name())
+			if !ok {
+				continue
+			}
+			repo, err := fs.ReadFile(fsys, path.Join(yumDBDir, l.Name(), p.Name(), "from_repo"))
+			if err != nil {
+				continue
+			}
+			ix.installedFrom[key] = strings.TrimSpace(string(repo))
+		}
+	}
+	return nil
+}
+
+// yumDBKey converts a yumdb directory name into a "<name>-<version>-<release>"
+// key by removing the leading package checksum and the trailing architecture.
+func yumDBKey(dir string) (string, bool) {
+	_, nevra, found := strings.Cut(dir, "-")
+	if !found {
+		return "", false
+	}
+	i := strings.LastIndex(nevra, "-")
+	if i <= 0 {
+		return "", false
+	}
+	return nevra[:i], true
+}
+
+// loadDNFHistory reads the repos recorded in dnf's transaction history. Later
+// transactions take precedence, e.g. if a package was reinstalled from a
+// different repo.
+func (ix *repoIndex) loadDNFHistory(ctx context.Context, fsys scalibrfs.FS, root string) error {
+	dbPath, cleanup, err := realPath(fsys, root, dnfHistoryPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	db, err := sql.Open("sqlite3", "file:"+dbPath+"?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	rows, err := db.QueryContext(ctx, `
+SELECT rpm.name, rpm.version, rpm.release, repo.repoid
+FROM trans_item
+JOIN rpm ON trans_item.item_id = rpm.item_id
+JOIN repo ON trans_item.repo_id = repo.id
+WHERE trans_item.action IN (`+dnfInstallActions+`)
+ORDER BY trans_item.id`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, version, release, repo string
+		if err := rows.Scan(&name, &version, &release, &repo); err != nil {
+			return err
+		}
+		ix.installedFrom[name+"-"+version+"-"+release] = repo
+	}
+	return rows.Err()
+}
+
+// realPath returns the path of the file p on the scanning host's filesystem.
+// Files on virtual filesystems are copied into a temporary directory that's
+// removed by the returned cleanup function.
+func realPath(fsys scalibrfs.FS, root, p string) (string, func(), error) {
+	if root != "" {
+		realPath := filepath.Join(root, filepath.FromSlash(p))
+		if _, err := os.Stat(realPath); err != nil {
+			return "", nil, err
+		}
+		return realPath, func() {}, nil
+	}
+	src, err := fsys.Open(p)
+	if err != nil {
+		return "", nil, err
+	}
+	defer src.Close()
+	dir, err := os.MkdirTemp("", "scalibr-rpm-origin")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+	dst, err := os.Create(filepath.Join(dir, path.Base(p)))
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	defer dst.Close()
+	if _, err := io.Copy(dst, src); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return dst.Name(), cleanup, nil
+}
+
+// loadRepos reads the repo definitions in /etc/yum.repos.d and records which
+// ones point to official distro mirrors.
+func (ix *repoIndex) loadRepos(fsys scalibrfs.FS) error {
+	entries, err := fs.ReadDir(fsys, yumReposDir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".repo" {
+			continue
+		}
+		if err := ix.parseRepoFile(fsys, path.Join(yumReposDir, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseRepoFile parses an INI-style .repo file.
+func (ix *repoIndex) parseRepoFile(fsys scalibrfs.FS, p string) error {
+	f, err := fsys.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var id string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			id = line[1 : len(line)-1]
+			ix.repos[id] = false
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || id == "" {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "baseurl", "mirrorlist", "metalink":
+			for _, url := range strings.Fields(value) {
+				if origin.IsDistroURL(url) {
+					ix.repos[id] = true
+				}
+			}
+		}
+	}
+	return s.Err()
+}
+
+// classify returns the origin of the package with the given name and
+// "<version>-<release>". Packages that neither yum nor dnf installed from a
+// repo were installed with rpm directly.
+func (ix *repoIndex) classify(name, version string) *origin.Origin {
+	repo, ok := ix.installedFrom[name+"-"+version]
+	switch {
+	case !ok || repo == commandlineRepo:
+		return &origin.Origin{Class: origin.Local}
+	case repo == anacondaRepo:
+		return &origin.Origin{Class: origin.Distro, Repository: repo}
+	}
+	if distro, ok := ix.repos[repo]; ok {
+		if distro {
+			return &origin.Origin{Class: origin.Distro, Repository: repo}
+		}
+		return &origin.Origin{Class: origin.ThirdParty, Repository: repo}
+	}
+	for _, prefix := range distroRepoPrefixes {
+		if strings.HasPrefix(repo, prefix) {
+			return &origin.Origin{Class: origin.Distro, Repository: repo}
+		}
+	}
+	return &origin.Origin{Class: origin.ThirdParty, Repository: repo}
+}
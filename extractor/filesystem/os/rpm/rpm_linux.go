@@ -132,6 +132,11 @@ func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	return true
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 func (e Extractor) reportFileRequired(path string, fileSizeBytes int64, result stats.FileRequiredResult) {
 	if e.stats == nil {
 		return
@@ -184,6 +189,11 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 		log.Errorf("osrelease.ParseOsRelease(): %v", err)
 	}
 
+	repoIx, err := loadRepoIndex(ctx, input.FS, input.Root)
+	if err != nil {
+		log.Warnf("Failed to load yum/dnf repo data, package origins won't be classified: %v", err)
+	}
+
 	pkgs := []*extractor.Inventory{}
 	for _, p := range rpmPkgs {
 		metadata := &Metadata{
@@ -205,6 +215,9 @@ func (e Extractor) extractFromInput(ctx context.Context, input *filesystem.ScanI
 			Locations: []string{input.Path},
 			Metadata:  metadata,
 		}
+		if repoIx != nil {
+			metadata.Origin = repoIx.classify(p.Name, i.Version)
+		}
 
 		pkgs = append(pkgs, i)
 	}
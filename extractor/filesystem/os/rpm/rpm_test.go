@@ -16,6 +16,7 @@ package rpm_test
 
 import (
 	"context"
+	"database/sql"
 	"io"
 	"io/fs"
 	"os"
@@ -32,6 +33,7 @@ import (
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
 	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/origin"
 	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
 	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
 	scalibrfs "github.com/google/osv-scalibr/fs"
@@ -39,6 +41,8 @@ import (
 	"github.com/google/osv-scalibr/stats"
 	"github.com/google/osv-scalibr/testing/fakefs"
 	"github.com/google/osv-scalibr/testing/testcollector"
+
+	_ "github.com/mattn/go-sqlite3"
 )
 
 func TestFileRequired(t *testing.T) {
@@ -827,6 +831,118 @@ func TestExtract_VirtualFilesystem(t *testing.T) {
 	}
 }
 
+func TestExtract_Origin(t *testing.T) {
+	// supported OSes
+	if !slices.Contains([]string{"linux"}, runtime.GOOS) {
+		t.Skipf("Test skipped, OS unsupported: %v", runtime.GOOS)
+	}
+
+	root := t.TempDir()
+	createOsRelease(t, root, fedora38)
+	dbDir := filepath.Join(root, "var/lib/rpm")
+	writeFile(t, filepath.Join(root, "etc/yum.repos.d/ubi.repo"), `[ubi-9-baseos-rpms]
+name = Red Hat Universal Base Image 9 (RPMs) - BaseOS
+baseurl = https://cdn-ubi.redhat.com/content/public/ubi/dist/ubi9/9/$basearch/baseos/os
+enabled = 1
+`)
+	writeFile(t, filepath.Join(root, "etc/yum.repos.d/docker-ce.repo"), `[docker-ce-stable]
+name=Docker CE Stable - $basearch
+baseurl=https://download.docker.com/linux/rhel/$releasever/$basearch/stable
+`)
+	// Packages installed while building the base image.
+	writeFile(t, filepath.Join(root, "var/lib/yum/yumdb/b/0123456789abcdef-basesystem-11-13.el9-noarch/from_repo"), "anaconda\n")
+	createDNFHistory(t, filepath.Join(root, "var/lib/dnf/history.sqlite"), [][]string{
+		{"alternatives", "1.20", "2.el9", "ubi-9-baseos-rpms"},
+		{"audit-libs", "3.0.7", "103.el9", "docker-ce-stable"},
+	})
+	if err := os.MkdirAll(dbDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := CopyFileToTempDir(t, "testdata/rpmdb.sqlite", dbDir); err != nil {
+		t.Fatalf("CopyFileToTempDir(): %v", err)
+	}
+
+	input := &filesystem.ScanInput{
+		FS:   scalibrfs.DirFS(root),
+		Path: "var/lib/rpm/rpmdb.sqlite",
+		Root: root,
+	}
+	got, err := rpm.New(rpm.DefaultConfig()).Extract(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Extract(): %v", err)
+	}
+
+	want := map[string]*origin.Origin{
+		"alternatives": {Class: origin.Distro, Repository: "ubi-9-baseos-rpms"},
+		"audit-libs":   {Class: origin.ThirdParty, Repository: "docker-ce-stable"},
+		"basesystem":   {Class: origin.Distro, Repository: "anaconda"},
+	}
+	found := 0
+	for _, i := range got {
+		o := i.Metadata.(*rpm.Metadata).Origin
+		wantOrigin, ok := want[i.Name]
+		if ok {
+			found++
+		} else {
+			// Packages without install records were installed with rpm directly.
+			wantOrigin = &origin.Origin{Class: origin.Local}
+		}
+		if diff := cmp.Diff(wantOrigin, o); diff != "" {
+			t.Errorf("Extract(): origin of %s (-want +got):\n%s", i.Name, diff)
+		}
+	}
+	if found != len(want) {
+		t.Errorf("Extract(): found %d of the %d packages with install records", found, len(want))
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// createDNFHistory creates a dnf history DB with an install transaction item
+// for each {name, version, release, repo} entry.
+func createDNFHistory(t *testing.T, path string, installs [][]string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("sql.Open(): %v", err)
+	}
+	defer db.Close()
+	stmts := []string{
+		"CREATE TABLE repo (id INTEGER PRIMARY KEY, repoid TEXT NOT NULL)",
+		"CREATE TABLE rpm (item_id INTEGER PRIMARY KEY, name TEXT, epoch INTEGER, version TEXT, release TEXT, arch TEXT)",
+		"CREATE TABLE trans_item (id INTEGER PRIMARY KEY, trans_id INTEGER, item_id INTEGER, repo_id INTEGER, action INTEGER)",
+	}
+	for _, s := range stmts {
+		if _, err := db.Exec(s); err != nil {
+			t.Fatalf("db.Exec(%q): %v", s, err)
+		}
+	}
+	for i, p := range installs {
+		id := i + 1
+		if _, err := db.Exec("INSERT INTO repo VALUES (?, ?)", id, p[3]); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec("INSERT INTO rpm VALUES (?, ?, 0, ?, ?, 'x86_64')", id, p[0], p[1], p[2]); err != nil {
+			t.Fatal(err)
+		}
+		// 1 is the install action.
+		if _, err := db.Exec("INSERT INTO trans_item VALUES (?, 1, ?, ?, 1)", id, id, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	// supported OSes
 	if !slices.Contains([]string{"linux"}, runtime.GOOS) {
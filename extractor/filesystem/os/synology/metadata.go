@@ -0,0 +1,28 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synology
+
+// Metadata holds information about a package installed from the Synology
+// Package Center.
+type Metadata struct {
+	// The name shown in the Package Center, e.g. "Container Manager".
+	DisplayName string
+	Maintainer  string
+	// The CPU architectures the package was built for, e.g. "x86_64 armv8" or
+	// "noarch".
+	Arch string
+	// The minimum DSM version the package runs on, e.g. "7.2-64551".
+	OSMinVersion string
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synology_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/synology"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		fileSizeBytes    int64
+		maxFileSizeBytes int64
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "package INFO file",
+			path:             "var/packages/ContainerManager/INFO",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "package INFO file on a mounted volume",
+			path:             "mnt/dsm/var/packages/ContainerManager/INFO",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "INFO file outside of the package directory",
+			path:         "var/packages/ContainerManager/target/INFO",
+			wantRequired: false,
+		},
+		{
+			name:         "INFO file in a similarly named directory",
+			path:         "myvar/packages/ContainerManager/INFO",
+			wantRequired: false,
+		},
+		{
+			name:         "other file in the package directory",
+			path:         "var/packages/ContainerManager/conf/privilege",
+			wantRequired: false,
+		},
+		{
+			name:             "file not required if size greater than maxFileSizeBytes",
+			path:             "var/packages/ContainerManager/INFO",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 1 * units.MiB,
+			wantRequired:     false,
+			wantResultMetric: stats.FileRequiredResultSizeLimitExceeded,
+		},
+		{
+			name:             "file required if maxFileSizeBytes explicitly set to 0",
+			path:             "var/packages/ContainerManager/INFO",
+			fileSizeBytes:    100 * units.MiB,
+			maxFileSizeBytes: 0,
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			e := synology.New(synology.Config{
+				Stats:            collector,
+				MaxFileSizeBytes: tt.maxFileSizeBytes,
+			})
+
+			fileSizeBytes := tt.fileSizeBytes
+			if fileSizeBytes == 0 {
+				fileSizeBytes = 1 * units.KiB
+			}
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: fileSizeBytes,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "DSM 7 package",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/packages/ContainerManager/INFO",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "ContainerManager",
+				Version:   "20.10.23-1437",
+				Locations: []string{"testdata/var/packages/ContainerManager/INFO"},
+				Metadata: &synology.Metadata{
+					DisplayName:  "Container Manager",
+					Maintainer:   "Synology Inc.",
+					Arch:         "x86_64",
+					OSMinVersion: "7.2-64551",
+				},
+			}},
+		},
+		{
+			Name: "DSM 6 package with escaped quotes",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/packages/SynologyPhotos/INFO",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "SynologyPhotos",
+				Version:   "1.1.0-0224",
+				Locations: []string{"testdata/var/packages/SynologyPhotos/INFO"},
+				Metadata: &synology.Metadata{
+					DisplayName:  `Synology "Photos"`,
+					Maintainer:   "Synology Inc.",
+					Arch:         "noarch",
+					OSMinVersion: "6.2-25556",
+				},
+			}},
+		},
+		{
+			Name: "package without version",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/var/packages/Broken/INFO",
+			},
+			WantInventory: []*extractor.Inventory{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := synology.New(synology.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := synology.Extractor{}
+	i := &extractor.Inventory{
+		Name:      "ContainerManager",
+		Version:   "20.10.23-1437",
+		Locations: []string{"var/packages/ContainerManager/INFO"},
+	}
+	want := &purl.PackageURL{
+		Type:      purl.TypeGeneric,
+		Namespace: "synology",
+		Name:      "ContainerManager",
+		Version:   "20.10.23-1437",
+	}
+	got := e.ToPURL(i)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToPURL(%v) (-want +got):\n%s", i, diff)
+	}
+}
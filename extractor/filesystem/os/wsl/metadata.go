@@ -0,0 +1,36 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wsl
+
+// Metadata describes a Windows Subsystem for Linux (WSL) distribution.
+type Metadata struct {
+	// Name of the distribution's directory, which is the package family name
+	// for distributions installed from the Microsoft Store, e.g.
+	// "CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc".
+	Distribution string
+	// WSLVersion is 1 for distributions whose root filesystem is stored as a
+	// directory and 2 for distributions stored in an ext4.vhdx disk image.
+	WSLVersion int
+	// OS of the distribution from its os-release file. Only set for WSL 1
+	// distributions.
+	OSID        string
+	OSVersionID string
+	OSName      string
+	// Size of the disk image of a WSL 2 distribution.
+	DiskSizeBytes int64
+	// Whether the packages of the distribution are extracted. The packages
+	// of WSL 2 distributions aren't since ext4 disk images can't be read.
+	PackagesScanned bool
+}
@@ -0,0 +1,357 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wsl extracts the Windows Subsystem for Linux (WSL) distributions
+// stored on a Windows filesystem and the packages installed in them.
+//
+// The root filesystems of WSL 1 distributions are directories, e.g.
+// Users/<user>/AppData/Local/Packages/<package>/LocalState/rootfs, and the
+// package databases in them are extracted by running the Linux package
+// extractors on them. WSL 2 distributions are stored in ext4.vhdx disk images
+// which are reported without their packages.
+package wsl
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/osrelease"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/pacman"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "os/wsl"
+
+	diskImageName = "ext4.vhdx"
+)
+
+var (
+	// rootFSMarkers are the lowercase path segments that end the path of the
+	// root filesystem of a WSL 1 distribution: distributions installed from
+	// the Microsoft Store and the legacy lxss installation.
+	rootFSMarkers = []string{"/localstate/rootfs/", "/appdata/local/lxss/rootfs/"}
+	// osReleasePaths are the os-release files in a root filesystem.
+	osReleasePaths = []string{"etc/os-release", "usr/lib/os-release"}
+)
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// Stats is a stats collector for reporting metrics.
+	Stats stats.Collector
+	// Extractors run on the root filesystems of WSL 1 distributions.
+	Extractors []filesystem.Extractor
+}
+
+// DefaultConfig returns the default configuration for the WSL extractor.
+func DefaultConfig() Config {
+	return Config{
+		Extractors: []filesystem.Extractor{
+			dpkg.New(dpkg.DefaultConfig()),
+			apk.New(apk.DefaultConfig()),
+			pacman.New(pacman.DefaultConfig()),
+		},
+	}
+}
+
+// Extractor extracts WSL distributions and their packages.
+type Extractor struct {
+	stats      stats.Collector
+	extractors []filesystem.Extractor
+}
+
+// New returns a WSL extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{
+		stats:      cfg.Stats,
+		extractors: cfg.Extractors,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+
+// Documentation describes the metadata the extractor attaches to inventory.
+// Packages in WSL distributions have the metadata of the extractor that
+// found them, e.g. *dpkg.Metadata.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// FileRequired returns true for WSL 2 disk images, the os-release files of
+// WSL 1 root filesystems and the files in them that one of the Linux package
+// extractors requires.
+func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
+	p := api.Path()
+	if strings.EqualFold(path.Base(p), diskImageName) {
+		e.reportFileRequired(p, stats.FileRequiredResultOK)
+		return true
+	}
+	_, inner, ok := splitRootFS(p)
+	if !ok {
+		return false
+	}
+	if e.extractorFor(&innerFileAPI{FileAPI: api, path: inner}) == nil && !isOSRelease(inner) {
+		return false
+	}
+	e.reportFileRequired(p, stats.FileRequiredResultOK)
+	return true
+}
+
+func (e Extractor) reportFileRequired(path string, result stats.FileRequiredResult) {
+	if e.stats == nil {
+		return
+	}
+	e.stats.AfterFileRequired(e.Name(), &stats.FileRequiredStats{
+		Path:   path,
+		Result: result,
+	})
+}
+
+// Extract returns the WSL distribution stored in a disk image or described
+// by an os-release file, or the packages extracted from a package database in
+// a WSL 1 distribution.
+func (e Extractor) Extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	inv, err := e.extract(ctx, input)
+	if e.stats != nil {
+		var fileSizeBytes int64
+		if input.Info != nil {
+			fileSizeBytes = input.Info.Size()
+		}
+		e.stats.AfterFileExtracted(e.Name(), &stats.FileExtractedStats{
+			Path:          input.Path,
+			Result:        filesystem.ExtractorErrorToFileExtractedResult(err),
+			FileSizeBytes: fileSizeBytes,
+		})
+	}
+	return inv, err
+}
+
+func (e Extractor) extract(ctx context.Context, input *filesystem.ScanInput) ([]*extractor.Inventory, error) {
+	if strings.EqualFold(path.Base(input.Path), diskImageName) {
+		m := &Metadata{
+			Distribution: distributionName(path.Dir(input.Path)),
+			WSLVersion:   2,
+		}
+		if input.Info != nil {
+			m.DiskSizeBytes = input.Info.Size()
+		}
+		return []*extractor.Inventory{{
+			Name:      m.Distribution,
+			Locations: []string{input.Path},
+			Metadata:  m,
+		}}, nil
+	}
+
+	rootFS, inner, ok := splitRootFS(input.Path)
+	if !ok {
+		return nil, nil
+	}
+	fsys := scalibrfs.Sub(input.FS, rootFS)
+	if isOSRelease(inner) {
+		return distribution(fsys, rootFS, inner)
+	}
+
+	ex := e.extractorFor(&innerFileAPI{path: inner, info: input.Info})
+	if ex == nil {
+		return nil, nil
+	}
+	root := input.Root
+	if root != "" {
+		root = filepath.Join(root, filepath.FromSlash(rootFS))
+	}
+	inv, err := ex.Extract(ctx, &filesystem.ScanInput{
+		FS:     fsys,
+		Path:   inner,
+		Root:   root,
+		Info:   input.Info,
+		Reader: input.Reader,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range inv {
+		for j, l := range i.Locations {
+			i.Locations[j] = path.Join(rootFS, l)
+		}
+	}
+	return inv, nil
+}
+
+// distribution returns the WSL 1 distribution whose root filesystem is at
+// rootFS. Distributions are reported for their etc/os-release file, or for
+// usr/lib/os-release if there's none.
+func distribution(fsys scalibrfs.FS, rootFS, osRelease string) ([]*extractor.Inventory, error) {
+	if osRelease != osReleasePaths[0] {
+		if _, err := fs.Stat(fsys, osReleasePaths[0]); !errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+	}
+	m := &Metadata{
+		Distribution:    distributionName(rootFS),
+		WSLVersion:      1,
+		PackagesScanned: true,
+	}
+	if r, err := osrelease.GetOSRelease(fsys); err == nil {
+		m.OSID = r["ID"]
+		m.OSVersionID = r["VERSION_ID"]
+		m.OSName = r["NAME"]
+	}
+	return []*extractor.Inventory{{
+		Name:      m.Distribution,
+		Version:   m.OSVersionID,
+		Locations: []string{rootFS},
+		Metadata:  m,
+	}}, nil
+}
+
+// extractorFor returns the first Linux package extractor that requires the
+// file in a root filesystem, or nil if there's none.
+func (e Extractor) extractorFor(api filesystem.FileAPI) filesystem.Extractor {
+	for _, ex := range e.extractors {
+		if ex.FileRequired(api) {
+			return ex
+		}
+	}
+	return nil
+}
+
+// extractorOf returns the Linux package extractor that created i based on the
+// type of its metadata, or nil if it wasn't created by one.
+func (e Extractor) extractorOf(i *extractor.Inventory) filesystem.Extractor {
+	t := reflect.TypeOf(i.Metadata)
+	for _, ex := range e.extractors {
+		if d, ok := ex.(plugin.Documenter); ok && d.Documentation() != nil && reflect.TypeOf(d.Documentation().Metadata) == t {
+			return ex
+		}
+	}
+	return nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL. WSL
+// distributions get a generic PURL and packages the PURL of the extractor
+// that found them.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	if _, ok := i.Metadata.(*Metadata); ok {
+		return &purl.PackageURL{
+			Type:    purl.TypeGeneric,
+			Name:    i.Name,
+			Version: i.Version,
+		}
+	}
+	if ex := e.extractorOf(i); ex != nil {
+		return ex.ToPURL(i)
+	}
+	return nil
+}
+
+// Ecosystem returns the OSV ecosystem of the packages in WSL distributions.
+// The distributions themselves have no ecosystem.
+func (e Extractor) Ecosystem(i *extractor.Inventory) string {
+	if ex := e.extractorOf(i); ex != nil {
+		return ex.Ecosystem(i)
+	}
+	return ""
+}
+
+// splitRootFS splits the path p of a file in the root filesystem of a WSL 1
+// distribution into the path of the root filesystem and the path of the file
+// inside of it.
+func splitRootFS(p string) (rootFS, inner string, ok bool) {
+	lower := strings.ToLower("/" + p)
+	for _, m := range rootFSMarkers {
+		i := strings.Index(lower, m)
+		if i < 0 {
+			continue
+		}
+		if m == rootFSMarkers[0] && !strings.Contains(lower[:i], "/appdata/local/packages/") {
+			continue
+		}
+		// Offsets in lower are one larger than in p.
+		end := i + len(m) - 1
+		return p[:end-1], p[end:], true
+	}
+	return "", "", false
+}
+
+// distributionName returns the name of the distribution stored in dir: the
+// package directory for distributions installed from the Microsoft Store and
+// the directory itself for distributions imported with "wsl --import".
+func distributionName(dir string) string {
+	for _, suffix := range []string{"/LocalState/rootfs", "/LocalState", "/rootfs"} {
+		if len(dir) > len(suffix) && strings.EqualFold(dir[len(dir)-len(suffix):], suffix) {
+			dir = dir[:len(dir)-len(suffix)]
+			break
+		}
+	}
+	return path.Base(dir)
+}
+
+func isOSRelease(p string) bool {
+	return p == osReleasePaths[0] || p == osReleasePaths[1]
+}
+
+// innerFileAPI is the FileAPI of a file in a root filesystem with its path
+// relative to the root filesystem.
+type innerFileAPI struct {
+	filesystem.FileAPI
+	path string
+	info fs.FileInfo
+}
+
+func (a *innerFileAPI) Path() string { return a.path }
+
+func (a *innerFileAPI) Stat() (fs.FileInfo, error) {
+	if a.FileAPI != nil {
+		return a.FileAPI.Stat()
+	}
+	if a.info == nil {
+		return nil, fs.ErrNotExist
+	}
+	return a.info, nil
+}
+
+func (a *innerFileAPI) ContentType() (filetype.Type, error) {
+	if a.FileAPI != nil {
+		return a.FileAPI.ContentType()
+	}
+	return filetype.Detect(a.path, nil), nil
+}
+
+var _ filesystem.Extractor = Extractor{}
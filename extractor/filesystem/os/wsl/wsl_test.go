@@ -0,0 +1,296 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wsl_test
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/wsl"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/testing/extracttest"
+	"github.com/google/osv-scalibr/testing/fakefs"
+	"github.com/google/osv-scalibr/testing/testcollector"
+)
+
+const (
+	ubuntuRootFS = "testdata/Users/alice/AppData/Local/Packages/CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc/LocalState/rootfs"
+	alpineRootFS = "testdata/Users/bob/AppData/Local/lxss/rootfs"
+)
+
+func TestFileRequired(t *testing.T) {
+	tests := []struct {
+		name             string
+		path             string
+		wantRequired     bool
+		wantResultMetric stats.FileRequiredResult
+	}{
+		{
+			name:             "dpkg status in store distribution",
+			path:             "Users/alice/AppData/Local/Packages/CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc/LocalState/rootfs/var/lib/dpkg/status",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "apk database in legacy lxss distribution",
+			path:             "Users/bob/AppData/Local/lxss/rootfs/lib/apk/db/installed",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "os-release",
+			path:             "Users/alice/AppData/Local/Packages/CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc/LocalState/rootfs/etc/os-release",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "paths are case insensitive",
+			path:             "users/alice/appdata/local/packages/Distro/localstate/rootfs/var/lib/dpkg/status",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:             "WSL 2 disk image",
+			path:             "Users/alice/AppData/Local/Packages/CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc/LocalState/ext4.vhdx",
+			wantRequired:     true,
+			wantResultMetric: stats.FileRequiredResultOK,
+		},
+		{
+			name:         "unrelated file in rootfs",
+			path:         "Users/alice/AppData/Local/Packages/Distro/LocalState/rootfs/etc/passwd",
+			wantRequired: false,
+		},
+		{
+			name:         "rootfs outside of the packages directory",
+			path:         "Users/alice/project/LocalState/rootfs/var/lib/dpkg/status",
+			wantRequired: false,
+		},
+		{
+			name:         "dpkg status of the host",
+			path:         "var/lib/dpkg/status",
+			wantRequired: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := testcollector.New()
+			cfg := wsl.DefaultConfig()
+			cfg.Stats = collector
+			e := wsl.New(cfg)
+
+			isRequired := e.FileRequired(simplefileapi.New(tt.path, fakefs.FakeFileInfo{
+				FileName: filepath.Base(tt.path),
+				FileMode: fs.ModePerm,
+				FileSize: 1024,
+			}))
+			if isRequired != tt.wantRequired {
+				t.Fatalf("FileRequired(%s): got %v, want %v", tt.path, isRequired, tt.wantRequired)
+			}
+
+			gotResultMetric := collector.FileRequiredResult(tt.path)
+			if gotResultMetric != tt.wantResultMetric {
+				t.Errorf("FileRequired(%s) recorded result metric %v, want result metric %v", tt.path, gotResultMetric, tt.wantResultMetric)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []extracttest.TestTableEntry{
+		{
+			Name: "dpkg packages",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: ubuntuRootFS + "/var/lib/dpkg/status",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "openssl",
+				Version:   "3.0.2-0ubuntu1.10",
+				Locations: []string{ubuntuRootFS + "/var/lib/dpkg/status"},
+				Metadata: &dpkg.Metadata{
+					PackageName:       "openssl",
+					Status:            "install ok installed",
+					PackageVersion:    "3.0.2-0ubuntu1.10",
+					OSID:              "ubuntu",
+					OSVersionCodename: "jammy",
+					OSVersionID:       "22.04",
+					Maintainer:        "Ubuntu Developers <ubuntu-devel-discuss@lists.ubuntu.com>",
+					Architecture:      "amd64",
+				},
+			}},
+		},
+		{
+			Name: "apk packages",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: alpineRootFS + "/lib/apk/db/installed",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:       "busybox",
+				Version:    "1.36.1-r15",
+				SourceCode: &extractor.SourceCodeIdentifier{Commit: "5ae1e5b6e3e1d58fbf5bb9a6a0e9bb8c2fd7e5c2"},
+				Locations:  []string{alpineRootFS + "/lib/apk/db/installed"},
+				Metadata: &apk.Metadata{
+					PackageName:  "busybox",
+					OriginName:   "busybox",
+					OSID:         "alpine",
+					OSVersionID:  "3.19.1",
+					Maintainer:   "Sören Tempel <soeren+alpine@soeren-tempel.net>",
+					Architecture: "x86_64",
+					License:      "GPL-2.0-only",
+				},
+			}},
+		},
+		{
+			Name: "WSL 1 distribution",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: ubuntuRootFS + "/etc/os-release",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc",
+				Version:   "22.04",
+				Locations: []string{ubuntuRootFS},
+				Metadata: &wsl.Metadata{
+					Distribution:    "CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc",
+					WSLVersion:      1,
+					OSID:            "ubuntu",
+					OSVersionID:     "22.04",
+					OSName:          "Ubuntu",
+					PackagesScanned: true,
+				},
+			}},
+		},
+		{
+			Name: "legacy WSL 1 distribution with usr/lib/os-release",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: alpineRootFS + "/usr/lib/os-release",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "lxss",
+				Version:   "3.19.1",
+				Locations: []string{alpineRootFS},
+				Metadata: &wsl.Metadata{
+					Distribution:    "lxss",
+					WSLVersion:      1,
+					OSID:            "alpine",
+					OSVersionID:     "3.19.1",
+					OSName:          "Alpine Linux",
+					PackagesScanned: true,
+				},
+			}},
+		},
+		{
+			Name: "WSL 2 disk image",
+			InputConfig: extracttest.ScanInputMockConfig{
+				Path: "testdata/Users/alice/AppData/Local/Docker/wsl/data/ext4.vhdx",
+			},
+			WantInventory: []*extractor.Inventory{{
+				Name:      "data",
+				Locations: []string{"testdata/Users/alice/AppData/Local/Docker/wsl/data/ext4.vhdx"},
+				Metadata: &wsl.Metadata{
+					Distribution:  "data",
+					WSLVersion:    2,
+					DiskSizeBytes: 8,
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			extr := wsl.New(wsl.DefaultConfig())
+
+			scanInput := extracttest.GenerateScanInputMock(t, tt.InputConfig)
+			defer extracttest.CloseTestScanInput(t, scanInput)
+
+			got, err := extr.Extract(context.Background(), &scanInput)
+
+			if diff := cmp.Diff(tt.WantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("%s.Extract(%q) error diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+				return
+			}
+
+			if diff := cmp.Diff(tt.WantInventory, got, cmpopts.SortSlices(extracttest.InventoryCmpLess)); diff != "" {
+				t.Errorf("%s.Extract(%q) diff (-want +got):\n%s", extr.Name(), tt.InputConfig.Path, diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := wsl.New(wsl.DefaultConfig())
+	tests := []struct {
+		name          string
+		inv           *extractor.Inventory
+		want          *purl.PackageURL
+		wantEcosystem string
+	}{
+		{
+			name: "distribution",
+			inv: &extractor.Inventory{
+				Name:     "CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc",
+				Version:  "22.04",
+				Metadata: &wsl.Metadata{WSLVersion: 1},
+			},
+			want: &purl.PackageURL{
+				Type:    purl.TypeGeneric,
+				Name:    "CanonicalGroupLimited.Ubuntu22.04LTS_79rhkp1fndgsc",
+				Version: "22.04",
+			},
+		},
+		{
+			name: "package",
+			inv: &extractor.Inventory{
+				Name:    "busybox",
+				Version: "1.36.1-r15",
+				Metadata: &apk.Metadata{
+					PackageName: "busybox",
+					OriginName:  "busybox",
+					OSID:        "alpine",
+					OSVersionID: "3.19.1",
+				},
+			},
+			want: &purl.PackageURL{
+				Type:      purl.TypeApk,
+				Namespace: "alpine",
+				Name:      "busybox",
+				Version:   "1.36.1-r15",
+				Qualifiers: purl.QualifiersFromMap(map[string]string{
+					purl.Distro: "3.19.1",
+					purl.Origin: "busybox",
+				}),
+			},
+			wantEcosystem: "Alpine:v3.19",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, e.ToPURL(tt.inv)); diff != "" {
+				t.Errorf("ToPURL(%v) (-want +got):\n%s", tt.inv, diff)
+			}
+			if got := e.Ecosystem(tt.inv); got != tt.wantEcosystem {
+				t.Errorf("Ecosystem(%v) = %q, want %q", tt.inv, got, tt.wantEcosystem)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package quarantine records files that made an extractor panic. The panic is
+// recovered so that a single malformed file doesn't abort the whole scan, and
+// the file is listed in the scan result so that it can be investigated.
+package quarantine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// File is a file that an extractor panicked on.
+type File struct {
+	// Path of the file, relative to the scan root.
+	Path string
+	// Name and version of the extractor that panicked.
+	Extractor        string
+	ExtractorVersion int
+	// Number of times the extractor was run on the file, including retries.
+	Attempts int
+	// The value passed to panic and the stack trace of the last attempt.
+	Panic string
+	Stack string
+}
+
+// Report lists the files that were quarantined during a scan.
+type Report struct {
+	Files []*File
+}
+
+// bundle is the JSON format of a reproduction bundle.
+type bundle struct {
+	Path             string `json:"path"`
+	Extractor        string `json:"extractor"`
+	ExtractorVersion int    `json:"extractor_version"`
+	Attempts         int    `json:"attempts"`
+	Panic            string `json:"panic"`
+	Stack            string `json:"stack"`
+}
+
+// WriteBundle writes a reproduction bundle for f to dir and returns its path.
+// The bundle is a JSON file named after the extractor and a hash of the file
+// path, so a file that's quarantined again overwrites its previous bundle.
+func WriteBundle(dir string, f *File) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(&bundle{
+		Path:             f.Path,
+		Extractor:        f.Extractor,
+		ExtractorVersion: f.ExtractorVersion,
+		Attempts:         f.Attempts,
+		Panic:            f.Panic,
+		Stack:            f.Stack,
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(f.Path))
+	name := fmt.Sprintf("%s-%s.json", strings.ReplaceAll(f.Extractor, "/", "_"), hex.EncodeToString(sum[:6]))
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quarantine_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor/filesystem/quarantine"
+)
+
+func TestWriteBundle(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "bundles")
+	f := &quarantine.File{
+		Path:             "app/package-lock.json",
+		Extractor:        "javascript/packagelockjson",
+		ExtractorVersion: 1,
+		Attempts:         2,
+		Panic:            "index out of range",
+		Stack:            "goroutine 1 [running]:",
+	}
+	path, err := quarantine.WriteBundle(dir, f)
+	if err != nil {
+		t.Fatalf("WriteBundle(%q, %v): %v", dir, f, err)
+	}
+	if filepath.Dir(path) != dir {
+		t.Errorf("WriteBundle(%q, %v) = %q, want a file in %q", dir, f, path, dir)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%q): %v", path, err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%q): %v", data, err)
+	}
+	want := map[string]any{
+		"path":              "app/package-lock.json",
+		"extractor":         "javascript/packagelockjson",
+		"extractor_version": float64(1),
+		"attempts":          float64(2),
+		"panic":             "index out of range",
+		"stack":             "goroutine 1 [running]:",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("WriteBundle(%q, %v) wrote unexpected bundle (-want +got):\n%s", dir, f, diff)
+	}
+
+	// Quarantining the same file again overwrites the bundle.
+	again, err := quarantine.WriteBundle(dir, f)
+	if err != nil {
+		t.Fatalf("WriteBundle(%q, %v): %v", dir, f, err)
+	}
+	if again != path {
+		t.Errorf("WriteBundle(%q, %v) = %q, want %q", dir, f, again, path)
+	}
+}
@@ -52,6 +52,11 @@ var cdxExtensions = map[string]cyclonedx.BOMFileFormat{
 	".bom.xml":  cyclonedx.BOMFileFormatXML,
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 // FileRequired returns true if the specified file is a supported cdx file.
 func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	return findExtractor(api.Path()) != nil
@@ -57,6 +57,11 @@ var extensionHandlers = map[string]extractFunc{
 	// No support for .xsl files because those are too ambiguous and could be many other things.
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 // FileRequired returns true if the specified file is a supported spdx file.
 func (e Extractor) FileRequired(api filesystem.FileAPI) bool {
 	_, isSupported := findExtractor(api.Path())
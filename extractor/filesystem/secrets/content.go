@@ -0,0 +1,102 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
+)
+
+const (
+	// sniffLen is the number of bytes at the start of a file that are used to
+	// determine its content type. It's the maximum http.DetectContentType
+	// considers.
+	sniffLen = 512
+	// minTextRatio is the share of text bytes above which content that
+	// http.DetectContentType considers binary is still scanned. This covers
+	// text files with a few stray control characters.
+	minTextRatio = 0.95
+)
+
+// binaryTypes are content types of files that can't contain plaintext
+// secrets since they're executables, archives, databases or media. They're
+// skipped without reading them. Gzip compressed files are decompressed by the
+// detection engine, compressed tarballs are skipped once their decompressed
+// content is sniffed.
+var binaryTypes = map[filetype.Type]bool{
+	filetype.ELF:          true,
+	filetype.PE:           true,
+	filetype.MachO:        true,
+	filetype.JavaClass:    true,
+	filetype.Zip:          true,
+	filetype.JAR:          true,
+	filetype.APK:          true,
+	filetype.PythonWheel:  true,
+	filetype.Tar:          true,
+	filetype.Ar:           true,
+	filetype.Deb:          true,
+	filetype.RPM:          true,
+	filetype.SquashFS:     true,
+	filetype.Bzip2:        true,
+	filetype.XZ:           true,
+	filetype.Zstd:         true,
+	filetype.SQLite:       true,
+	filetype.JavaKeyStore: true,
+	filetype.PDF:          true,
+	filetype.PNG:          true,
+	filetype.JPEG:         true,
+	filetype.GIF:          true,
+}
+
+// isText returns true if content that starts with sniff should be scanned.
+//
+// Files are first routed by the content type detected by the walk, see
+// Scanner.Wants. The decoded content is then routed by its sniffed MIME type:
+// Text is scanned, other binary formats such as audio, video and fonts are
+// skipped. Content of unknown
+// type is only scanned if it mostly consists of text, which skips
+// executables and other binary blobs.
+func isText(sniff []byte) bool {
+	if len(sniff) == 0 {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(sniff))
+	if err != nil {
+		return false
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "text/"):
+		return true
+	case mediaType == "application/octet-stream":
+		return textRatio(sniff) >= minTextRatio
+	default:
+		return false
+	}
+}
+
+// textRatio returns the share of bytes in b that are printable ASCII,
+// whitespace or part of multi-byte UTF-8 sequences.
+func textRatio(b []byte) float64 {
+	text := 0
+	for _, c := range b {
+		if (c >= 0x20 && c != 0x7f) || c == '\t' || c == '\n' || c == '\r' || c >= 0x80 {
+			text++
+		}
+	}
+	return float64(text) / float64(len(b))
+}
@@ -0,0 +1,301 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets scans the contents of the files visited by the filesystem
+// walk for secrets using Veles detectors. Files are scanned by a pool of
+// workers while the walk continues, so extractors and secret detection share
+// a single traversal of the filesystem.
+package secrets
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
+	"github.com/google/osv-scalibr/extractor/filesystem/internal/units"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/dotenv"
+	"github.com/google/osv-scalibr/veles/secrets/iacstate"
+	"github.com/google/osv-scalibr/veles/secrets/sourcemap"
+)
+
+// defaultMaxFileSizeBytes is the default maximum size of a file that's
+// scanned for secrets. Larger files are usually data or logs.
+const defaultMaxFileSizeBytes = 10 * units.MiB
+
+// Confidence is how likely it is that a Secret is a real secret.
+type Confidence int
+
+// Confidence values.
+const (
+	ConfidenceUnspecified Confidence = iota
+	// ConfidenceLow is used for values that are only considered secrets
+	// because of the name they're assigned to, e.g. DB_PASSWORD in a .env file.
+	ConfidenceLow
+	// ConfidenceMedium is used for secrets found by a detector in
+	// unstructured file contents.
+	ConfidenceMedium
+	// ConfidenceHigh is used for secrets found by a detector in the value of a
+	// structured file, e.g. a .env file.
+	ConfidenceHigh
+)
+
+// Secret is a secret found in a file.
+type Secret struct {
+	// The secret as returned by the Veles detector, e.g. appsecret.Key.
+	Secret veles.Secret
+	// Path of the file the secret was found in.
+	Location string
+	// Confidence that Secret is a real secret.
+	Confidence Confidence
+	// Result of validating Secret. Empty if the secrets weren't validated.
+	Validation veles.ValidationStatus
+}
+
+// Config is the configuration for the Scanner.
+type Config struct {
+	// Detectors to run on the files' contents.
+	Detectors []veles.Detector
+	// Workers is the number of files scanned in parallel. If 0, the number of
+	// CPUs is used.
+	Workers int
+	// MaxFileSizeBytes is the maximum size of a file that's scanned. If this
+	// limit is greater than zero, larger files are skipped.
+	MaxFileSizeBytes int64
+	// Validation validates the secrets found, e.g. by authenticating against
+	// the service they belong to. If nil, the secrets aren't validated. Use
+	// veles.NewThrottledValidationEngine to limit the calls to these services.
+	Validation *veles.ValidationEngine
+}
+
+// DefaultConfig returns the default configuration for the Scanner using the
+// given detectors.
+func DefaultConfig(detectors []veles.Detector) Config {
+	return Config{
+		Detectors:        detectors,
+		Workers:          runtime.NumCPU(),
+		MaxFileSizeBytes: defaultMaxFileSizeBytes,
+	}
+}
+
+// job is a file queued for scanning.
+type job struct {
+	path     string
+	location string
+	content  []byte
+}
+
+// Scanner scans files for secrets with a pool of workers.
+type Scanner struct {
+	engine           *veles.DetectionEngine
+	validation       *veles.ValidationEngine
+	maxFileSizeBytes int64
+	jobs             chan job
+	wg               sync.WaitGroup
+
+	mu      sync.Mutex
+	secrets []*Secret
+}
+
+// New creates a Scanner and starts its workers. The workers stop once Close
+// is called or ctx is cancelled.
+func New(ctx context.Context, cfg Config) (*Scanner, error) {
+	engine, err := veles.NewDetectionEngine(cfg.Detectors)
+	if err != nil {
+		return nil, err
+	}
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	s := &Scanner{
+		engine:           engine,
+		validation:       cfg.Validation,
+		maxFileSizeBytes: cfg.MaxFileSizeBytes,
+		jobs:             make(chan job, workers),
+		secrets:          []*Secret{},
+	}
+	s.wg.Add(workers)
+	for range workers {
+		go s.work(ctx)
+	}
+	return s, nil
+}
+
+// Wants returns true if a file of the given size and content type, as
+// reported by the walk's filesystem.FileAPI, should be read and passed to
+// Scan. Files above the size limit and files of binary formats that can't
+// contain plaintext secrets, e.g. executables, archives and images, are
+// skipped. Gzip compressed files are decompressed by the detection engine.
+func (s *Scanner) Wants(size int64, typ filetype.Type) bool {
+	if s.maxFileSizeBytes > 0 && size > s.maxFileSizeBytes {
+		return false
+	}
+	return !binaryTypes[typ]
+}
+
+// Scan queues the contents of the file at path for scanning. location is the
+// path that's reported for secrets found in the file. The file isn't read
+// again, content must not be modified afterwards.
+//
+// Scan blocks while all workers are busy.
+func (s *Scanner) Scan(path, location string, content []byte) {
+	s.jobs <- job{path: path, location: location, content: content}
+}
+
+// Close waits for all queued files to be scanned, stops the workers and
+// returns the secrets found, sorted by location. Scan must not be called
+// afterwards.
+func (s *Scanner) Close() []*Secret {
+	close(s.jobs)
+	s.wg.Wait()
+	slices.SortStableFunc(s.secrets, func(a, b *Secret) int {
+		return cmp.Compare(a.Location, b.Location)
+	})
+	return s.secrets
+}
+
+func (s *Scanner) work(ctx context.Context) {
+	defer s.wg.Done()
+	for j := range s.jobs {
+		// Keep draining the queue after cancellation so Scan doesn't block.
+		if ctx.Err() != nil {
+			continue
+		}
+		found, err := s.scanFile(ctx, j)
+		if err != nil {
+			log.Debugf("secrets: scanning %s failed: %v", j.path, err)
+			continue
+		}
+		if len(found) == 0 {
+			continue
+		}
+		s.validate(ctx, found)
+		s.mu.Lock()
+		s.secrets = append(s.secrets, found...)
+		s.mu.Unlock()
+	}
+}
+
+// scanFile runs the detectors on the decoded contents of the file of j unless
+// they're binary.
+func (s *Scanner) scanFile(ctx context.Context, j job) ([]*Secret, error) {
+	// Sniff the decoded content so that UTF-16 and gzip compressed text files
+	// are scanned as well.
+	decoded, err := veles.Decode(bytes.NewReader(j.content))
+	if err != nil {
+		return nil, err
+	}
+	sniff := make([]byte, sniffLen)
+	n, err := io.ReadFull(decoded, sniff)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	sniff = sniff[:n]
+	if !isText(sniff) {
+		return nil, nil
+	}
+	r := io.MultiReader(bytes.NewReader(sniff), decoded)
+	if dotenv.IsDotenvFile(j.path) {
+		return s.scanStructured(ctx, j, r, dotenv.Detect)
+	}
+	if iacstate.IsStateFile(j.path) {
+		return s.scanStructured(ctx, j, r, iacstate.Detect)
+	}
+	if sourcemap.IsSourceMapFile(j.path) {
+		return s.scanStructured(ctx, j, r, sourcemap.Detect)
+	}
+	found, err := s.engine.Detect(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return s.toSecrets(j, found, ConfidenceMedium), nil
+}
+
+// validate sets the validation status of the secrets if a ValidationEngine
+// is configured. Secrets whose validation returns an error are marked as
+// failed.
+func (s *Scanner) validate(ctx context.Context, found []*Secret) {
+	if s.validation == nil {
+		return
+	}
+	for _, secret := range found {
+		status, err := s.validation.Validate(ctx, secret.Secret)
+		if err != nil {
+			log.Debugf("secrets: validating %T from %s failed: %v", secret.Secret, secret.Location, err)
+		}
+		secret.Validation = status
+	}
+}
+
+// structuredDetector parses a structured file and returns the secrets in its
+// values, e.g. dotenv.Detect.
+type structuredDetector func(ctx context.Context, engine *veles.DetectionEngine, r io.Reader) ([]veles.Secret, error)
+
+// scanStructured scans a structured file, a dotenv, infrastructure state or
+// source map file, both as a whole and value by value. Secrets found in the
+// parsed values, secret state attributes and the sources embedded in source
+// maps are reported with high confidence and untyped dotenv credentials with
+// low confidence. Secrets only found in the raw
+// contents, e.g. in comments, keep medium confidence. If the file can't be
+// parsed, only the raw contents are scanned.
+func (s *Scanner) scanStructured(ctx context.Context, j job, r io.Reader, detect structuredDetector) ([]*Secret, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := s.engine.Detect(ctx, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	structured, err := detect(ctx, s.engine, bytes.NewReader(content))
+	if err != nil {
+		log.Debugf("secrets: parsing %s failed: %v", j.path, err)
+		return s.toSecrets(j, raw, ConfidenceMedium), nil
+	}
+
+	var result []*Secret
+	for _, secret := range structured {
+		confidence := ConfidenceHigh
+		if _, ok := secret.(dotenv.Credential); ok {
+			confidence = ConfidenceLow
+		}
+		result = append(result, &Secret{Secret: secret, Location: j.location, Confidence: confidence})
+	}
+	for _, secret := range raw {
+		if slices.ContainsFunc(structured, func(o veles.Secret) bool { return reflect.DeepEqual(secret, o) }) {
+			continue
+		}
+		result = append(result, &Secret{Secret: secret, Location: j.location, Confidence: ConfidenceMedium})
+	}
+	return result, nil
+}
+
+// toSecrets wraps the secrets found in the file of j with the given
+// confidence.
+func (s *Scanner) toSecrets(j job, found []veles.Secret, confidence Confidence) []*Secret {
+	result := make([]*Secret, 0, len(found))
+	for _, secret := range found {
+		result = append(result, &Secret{Secret: secret, Location: j.location, Confidence: confidence})
+	}
+	return result
+}
@@ -0,0 +1,285 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io/fs"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"unicode/utf16"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+	"github.com/google/osv-scalibr/veles/secrets/dotenv"
+	"github.com/google/osv-scalibr/veles/secrets/iacstate"
+	"github.com/google/osv-scalibr/veles/secrets/sourcemap"
+)
+
+type testToken struct {
+	Token string
+}
+
+var testDetector = simpletoken.Detector{
+	MaxLen: 12,
+	Re:     regexp.MustCompile(`tok_[0-9a-f]{8}`),
+	FromMatch: func(b []byte) veles.Secret {
+		return testToken{Token: string(b)}
+	},
+}
+
+func utf16LE(s string) []byte {
+	b := []byte{0xFF, 0xFE}
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = append(b, byte(u), byte(u>>8))
+	}
+	return b
+}
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(s)); err != nil {
+		t.Fatalf("gzip Write(): %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("gzip Close(): %v", err)
+	}
+	return buf.Bytes()
+}
+
+// scan passes the file at p in fsys to s if it wants it, like the filesystem
+// walk does.
+func scan(t *testing.T, s *secrets.Scanner, fsys fs.FS, p, location string) {
+	t.Helper()
+	content, err := fs.ReadFile(fsys, p)
+	if err != nil {
+		t.Fatalf("fs.ReadFile(%s): %v", p, err)
+	}
+	typ := filetype.Unknown
+	if len(content) > 0 {
+		typ = filetype.Detect(p, content[:min(len(content), filetype.HeaderSize)])
+	}
+	if s.Wants(int64(len(content)), typ) {
+		s.Scan(p, location, content)
+	}
+}
+
+func tarred(t *testing.T, name, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	if err := w.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(s))}); err != nil {
+		t.Fatalf("tar WriteHeader(): %v", err)
+	}
+	if _, err := w.Write([]byte(s)); err != nil {
+		t.Fatalf("tar Write(): %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("tar Close(): %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestScanner(t *testing.T) {
+	elf := "\x7fELF\x02\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x3e\x00"
+	fsys := fstest.MapFS{
+		"config.env": {Data: []byte("API_TOKEN=tok_0123abcd\nOTHER=tok_4567cdef\n")},
+		// Dotenv files are also parsed entry by entry.
+		"app/.env":    {Data: []byte("# OLD_TOKEN=tok_11111111\nAPI_TOKEN='tok_22222222'\nDB_PASSWORD=hunter22\n")},
+		"a/deep.yaml": {Data: []byte("token: tok_deadbeef")},
+		"empty.txt":   {Data: []byte{}},
+		"no-secrets":  {Data: []byte("nothing to see here")},
+		// Text with a stray control character is still scanned.
+		"log.txt": {Data: []byte("\x01started with tok_00000001")},
+		// Binary content is skipped.
+		"bin/app":  {Data: []byte(elf + strings.Repeat("\x00", 100) + "tok_00000002")},
+		"logo.dat": {Data: []byte("\x89PNG\r\n\x1a\n" + "tok_00000003")},
+		// Files are routed by their content, not their extension.
+		"logo.png": {Data: []byte("tok_00000004")},
+		// UTF-16 and gzip compressed text files are decoded.
+		"win/app.ini":     {Data: utf16LE("token=tok_00000006")},
+		"logs/app.log.gz": {Data: gzipped(t, "token=tok_00000007")},
+		// Compressed tarballs are skipped.
+		"backup.tar.gz": {Data: gzipped(t, string(tarred(t, "backup.txt", "tok_00000008")))},
+		// Files above the size limit are skipped.
+		"large.txt": {Data: []byte("tok_00000005" + strings.Repeat("x", 200))},
+		// Infrastructure state files are parsed resource by resource.
+		"infra/terraform.tfstate": {Data: []byte(`{"resources":[{"type":"random_password","name":"db","instances":[{"attributes":{"result":"hunter33"}}]}]}`)},
+		// Source maps in web roots are parsed source by source.
+		"www/app.js.map": {Data: []byte(`{"sources":["/src/a.js"],"sourcesContent":["k='tok_00000009'"]}`)},
+	}
+
+	s, err := secrets.New(context.Background(), secrets.Config{
+		Detectors:        []veles.Detector{testDetector},
+		Workers:          3,
+		MaxFileSizeBytes: 200,
+	})
+	if err != nil {
+		t.Fatalf("secrets.New(): %v", err)
+	}
+	for p := range fsys {
+		scan(t, s, fsys, p, "root/"+p)
+	}
+	got := s.Close()
+
+	want := []*secrets.Secret{
+		{Secret: testToken{Token: "tok_deadbeef"}, Location: "root/a/deep.yaml", Confidence: secrets.ConfidenceMedium},
+		{Secret: testToken{Token: "tok_22222222"}, Location: "root/app/.env", Confidence: secrets.ConfidenceHigh},
+		{
+			Secret:     dotenv.Credential{Key: "DB_PASSWORD", Value: "hunter22", Class: dotenv.ClassPassword},
+			Location:   "root/app/.env",
+			Confidence: secrets.ConfidenceLow,
+		},
+		{Secret: testToken{Token: "tok_11111111"}, Location: "root/app/.env", Confidence: secrets.ConfidenceMedium},
+		{Secret: testToken{Token: "tok_0123abcd"}, Location: "root/config.env", Confidence: secrets.ConfidenceMedium},
+		{Secret: testToken{Token: "tok_4567cdef"}, Location: "root/config.env", Confidence: secrets.ConfidenceMedium},
+		{
+			Secret:     iacstate.Attribute{Tool: iacstate.ToolTerraform, Address: "random_password.db", Attribute: "result", Value: "hunter33"},
+			Location:   "root/infra/terraform.tfstate",
+			Confidence: secrets.ConfidenceHigh,
+		},
+		{Secret: testToken{Token: "tok_00000001"}, Location: "root/log.txt", Confidence: secrets.ConfidenceMedium},
+		{Secret: testToken{Token: "tok_00000004"}, Location: "root/logo.png", Confidence: secrets.ConfidenceMedium},
+		{Secret: testToken{Token: "tok_00000007"}, Location: "root/logs/app.log.gz", Confidence: secrets.ConfidenceMedium},
+		{Secret: testToken{Token: "tok_00000006"}, Location: "root/win/app.ini", Confidence: secrets.ConfidenceMedium},
+		{Secret: testToken{Token: "tok_00000009"}, Location: "root/www/app.js.map", Confidence: secrets.ConfidenceHigh},
+		{
+			Secret:     sourcemap.PathDisclosure{Paths: []string{"/src/a.js"}, Count: 1, Severity: sourcemap.SeverityLow},
+			Location:   "root/www/app.js.map",
+			Confidence: secrets.ConfidenceHigh,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Scanner returned unexpected secrets (-want +got):\n%s", diff)
+	}
+}
+
+type testValidator struct{}
+
+func (testValidator) Validate(_ context.Context, tok testToken) (veles.ValidationStatus, error) {
+	switch tok.Token {
+	case "tok_0123abcd":
+		return veles.ValidationValid, nil
+	case "tok_4567cdef":
+		return veles.ValidationFailed, errors.New("service unavailable")
+	}
+	return veles.ValidationInvalid, nil
+}
+
+func TestScanner_Validation(t *testing.T) {
+	validation := veles.NewValidationEngine()
+	veles.AddValidator[testToken](validation, testValidator{})
+	s, err := secrets.New(context.Background(), secrets.Config{
+		Detectors:  []veles.Detector{testDetector},
+		Workers:    1,
+		Validation: validation,
+	})
+	if err != nil {
+		t.Fatalf("secrets.New(): %v", err)
+	}
+	fsys := fstest.MapFS{
+		"config.txt": {Data: []byte("tok_0123abcd tok_4567cdef tok_deadbeef")},
+		"app/.env":   {Data: []byte("DB_PASSWORD=hunter22\n")},
+	}
+	scan(t, s, fsys, "config.txt", "config.txt")
+	scan(t, s, fsys, "app/.env", "app/.env")
+	got := s.Close()
+
+	want := []*secrets.Secret{
+		{
+			Secret:     dotenv.Credential{Key: "DB_PASSWORD", Value: "hunter22", Class: dotenv.ClassPassword},
+			Location:   "app/.env",
+			Confidence: secrets.ConfidenceLow,
+			// There's no validator for dotenv credentials.
+			Validation: veles.ValidationUnsupported,
+		},
+		{Secret: testToken{Token: "tok_0123abcd"}, Location: "config.txt", Confidence: secrets.ConfidenceMedium, Validation: veles.ValidationValid},
+		{Secret: testToken{Token: "tok_4567cdef"}, Location: "config.txt", Confidence: secrets.ConfidenceMedium, Validation: veles.ValidationFailed},
+		{Secret: testToken{Token: "tok_deadbeef"}, Location: "config.txt", Confidence: secrets.ConfidenceMedium, Validation: veles.ValidationInvalid},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Scanner returned unexpected secrets (-want +got):\n%s", diff)
+	}
+}
+
+func TestScanner_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s, err := secrets.New(ctx, secrets.Config{Detectors: []veles.Detector{testDetector}, Workers: 1})
+	if err != nil {
+		t.Fatalf("secrets.New(): %v", err)
+	}
+	cancel()
+	// Scan must not block after cancellation.
+	for range 10 {
+		s.Scan("config.env", "config.env", []byte("tok_0123abcd"))
+	}
+	if got := s.Close(); len(got) != 0 {
+		t.Errorf("Scanner found secrets after cancellation: %v", got)
+	}
+}
+
+func TestScanner_Wants(t *testing.T) {
+	s, err := secrets.New(context.Background(), secrets.Config{
+		Detectors:        []veles.Detector{testDetector},
+		Workers:          1,
+		MaxFileSizeBytes: 100,
+	})
+	if err != nil {
+		t.Fatalf("secrets.New(): %v", err)
+	}
+	defer s.Close()
+
+	tests := []struct {
+		desc string
+		size int64
+		typ  filetype.Type
+		want bool
+	}{
+		{desc: "text", size: 10, typ: filetype.Text, want: true},
+		{desc: "json", size: 10, typ: filetype.JSON, want: true},
+		{desc: "script", size: 10, typ: filetype.Script, want: true},
+		// Unknown content is sniffed once it's read, e.g. UTF-16 text.
+		{desc: "unknown", size: 10, typ: filetype.Unknown, want: true},
+		{desc: "gzip", size: 10, typ: filetype.Gzip, want: true},
+		{desc: "executable", size: 10, typ: filetype.ELF, want: false},
+		{desc: "archive", size: 10, typ: filetype.JAR, want: false},
+		{desc: "image", size: 10, typ: filetype.PNG, want: false},
+		{desc: "too large", size: 101, typ: filetype.Text, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := s.Wants(tt.size, tt.typ); got != tt.want {
+				t.Errorf("Wants(%d, %q) = %v, want %v", tt.size, tt.typ, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew_NoDetectors(t *testing.T) {
+	if _, err := secrets.New(context.Background(), secrets.Config{}); err == nil {
+		t.Error("secrets.New() without detectors succeeded, want error")
+	}
+}
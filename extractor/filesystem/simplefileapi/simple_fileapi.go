@@ -19,13 +19,15 @@ import (
 	"io/fs"
 
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/filetype"
 )
 
 // SimpleFileAPI is a fake implementation of the filesystem.FileAPI interface.
 type SimpleFileAPI struct {
-	path string
-	info fs.FileInfo
-	err  error
+	path   string
+	info   fs.FileInfo
+	err    error
+	header []byte
 }
 
 // New creates a new FakeFileAPI.
@@ -36,6 +38,15 @@ func New(path string, info fs.FileInfo) *SimpleFileAPI {
 	}
 }
 
+// NewWithHeader creates a new FakeFileAPI for a file starting with header.
+func NewWithHeader(path string, info fs.FileInfo, header []byte) *SimpleFileAPI {
+	return &SimpleFileAPI{
+		path:   path,
+		info:   info,
+		header: header,
+	}
+}
+
 // Path returns the path of the file.
 func (f SimpleFileAPI) Path() string {
 	return f.path
@@ -46,4 +57,10 @@ func (f SimpleFileAPI) Stat() (fs.FileInfo, error) {
 	return f.info, f.err
 }
 
+// ContentType returns the type of the file from its header, or from its
+// extension if no header was given.
+func (f SimpleFileAPI) ContentType() (filetype.Type, error) {
+	return filetype.Detect(f.path, f.header), nil
+}
+
 var _ filesystem.FileAPI = SimpleFileAPI{}
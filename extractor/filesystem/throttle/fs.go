@@ -0,0 +1,149 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+// FS returns fsys with its operations and reads throttled by t. Waiting
+// operations fail with the error of ctx once it's done.
+func (t *Throttler) FS(ctx context.Context, fsys scalibrfs.FS) scalibrfs.FS {
+	return &throttledFS{ctx: ctx, fs: fsys, t: t}
+}
+
+type throttledFS struct {
+	ctx context.Context
+	fs  scalibrfs.FS
+	t   *Throttler
+}
+
+func (f *throttledFS) Open(name string) (fs.File, error) {
+	if err := f.t.WaitOp(f.ctx); err != nil {
+		return nil, err
+	}
+	file, err := f.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &throttledFile{ctx: f.ctx, file: file, t: f.t, fs: f.fs, name: name}, nil
+}
+
+func (f *throttledFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if err := f.t.WaitOp(f.ctx); err != nil {
+		return nil, err
+	}
+	return f.fs.ReadDir(name)
+}
+
+func (f *throttledFS) Stat(name string) (fs.FileInfo, error) {
+	if err := f.t.WaitOp(f.ctx); err != nil {
+		return nil, err
+	}
+	return f.fs.Stat(name)
+}
+
+// throttledFile is a file opened through a throttled FS. It implements
+// io.ReaderAt and io.Seeker since extractors check for them, and fails if the
+// underlying file doesn't. fs.ReadDirFile is always implemented since the walk
+// checks for it, falling back to the ReadDir method of the FS.
+type throttledFile struct {
+	ctx  context.Context
+	file fs.File
+	t    *Throttler
+
+	// Used to list directories whose files don't implement fs.ReadDirFile.
+	fs      scalibrfs.FS
+	name    string
+	entries []fs.DirEntry
+	listed  bool
+}
+
+func (f *throttledFile) Stat() (fs.FileInfo, error) { return f.file.Stat() }
+
+func (f *throttledFile) Close() error { return f.file.Close() }
+
+func (f *throttledFile) Read(p []byte) (int, error) {
+	if err := f.t.WaitOp(f.ctx); err != nil {
+		return 0, err
+	}
+	n, err := f.file.Read(p)
+	return n, f.waitBytes(n, err)
+}
+
+func (f *throttledFile) ReadAt(p []byte, off int64) (int, error) {
+	r, ok := f.file.(io.ReaderAt)
+	if !ok {
+		return 0, errors.New("ReadAt not implemented")
+	}
+	if err := f.t.WaitOp(f.ctx); err != nil {
+		return 0, err
+	}
+	n, err := r.ReadAt(p, off)
+	return n, f.waitBytes(n, err)
+}
+
+func (f *throttledFile) Seek(offset int64, whence int) (int64, error) {
+	s, ok := f.file.(io.Seeker)
+	if !ok {
+		return 0, errors.New("Seek not implemented")
+	}
+	return s.Seek(offset, whence)
+}
+
+func (f *throttledFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if d, ok := f.file.(fs.ReadDirFile); ok {
+		if err := f.t.WaitOp(f.ctx); err != nil {
+			return nil, err
+		}
+		return d.ReadDir(n)
+	}
+	if !f.listed {
+		if err := f.t.WaitOp(f.ctx); err != nil {
+			return nil, err
+		}
+		entries, err := f.fs.ReadDir(f.name)
+		if err != nil {
+			return nil, err
+		}
+		f.entries, f.listed = entries, true
+	}
+	if n <= 0 {
+		entries := f.entries
+		f.entries = nil
+		return entries, nil
+	}
+	if len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(f.entries))
+	entries := f.entries[:n]
+	f.entries = f.entries[n:]
+	return entries, nil
+}
+
+// waitBytes waits for the n bytes that were read and returns readErr, or the
+// error of the wait if the read succeeded.
+func (f *throttledFile) waitBytes(n int, readErr error) error {
+	if err := f.t.WaitBytes(f.ctx, n); err != nil && readErr == nil {
+		return err
+	}
+	return readErr
+}
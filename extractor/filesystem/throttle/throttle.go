@@ -0,0 +1,203 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package throttle limits the resources used by the filesystem walk so that
+// scans can run on live production hosts without degrading latency-sensitive
+// workloads. It limits the number of filesystem operations and the bytes read
+// per second, paces extractors to a share of a CPU and allows pausing and
+// resuming a running scan.
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// bucketWindow is how much of a second's worth of operations or bytes can be
+// used in a burst after the walk was idle.
+const bucketWindow = 100 * time.Millisecond
+
+// Config is the configuration for a Throttler. Zero values disable the
+// respective limit.
+type Config struct {
+	// MaxIOPS is the maximum number of filesystem operations per second. Opening,
+	// reading a chunk of, statting and listing a file count as an operation.
+	MaxIOPS int
+	// MaxBytesPerSecond is the maximum number of bytes read from files per
+	// second.
+	MaxBytesPerSecond int64
+	// CPUShare is the share of a CPU the extractors may use, between 0 and 1.
+	// After each extractor run the walk sleeps so that the time spent extracting
+	// doesn't exceed this share of the wall time, similar to a high nice value.
+	CPUShare float64
+}
+
+// Throttler limits the resources used by the filesystem walk. It's safe for
+// concurrent use, e.g. by the secret scanning workers. A nil Throttler
+// doesn't limit anything.
+type Throttler struct {
+	iops     *bucket
+	bytes    *bucket
+	cpuShare float64
+
+	mu sync.Mutex
+	// resumed is closed when a paused scan is resumed. Nil if the scan isn't
+	// paused.
+	resumed chan struct{}
+}
+
+// New returns a Throttler that applies the limits of cfg.
+func New(cfg Config) *Throttler {
+	t := &Throttler{}
+	if cfg.MaxIOPS > 0 {
+		t.iops = newBucket(float64(cfg.MaxIOPS))
+	}
+	if cfg.MaxBytesPerSecond > 0 {
+		t.bytes = newBucket(float64(cfg.MaxBytesPerSecond))
+	}
+	if cfg.CPUShare > 0 && cfg.CPUShare < 1 {
+		t.cpuShare = cfg.CPUShare
+	}
+	return t
+}
+
+// Pause pauses the scan. Filesystem operations block until Resume is called.
+// Operations that are already running aren't interrupted.
+func (t *Throttler) Pause() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.resumed == nil {
+		t.resumed = make(chan struct{})
+	}
+}
+
+// Resume resumes a paused scan.
+func (t *Throttler) Resume() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.resumed != nil {
+		close(t.resumed)
+		t.resumed = nil
+	}
+}
+
+// Paused returns whether the scan is paused.
+func (t *Throttler) Paused() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.resumed != nil
+}
+
+// WaitOp blocks until a filesystem operation may be performed or ctx is done.
+func (t *Throttler) WaitOp(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	if err := t.waitResumed(ctx); err != nil {
+		return err
+	}
+	return t.iops.wait(ctx, 1)
+}
+
+// WaitBytes blocks until n more bytes may be read or ctx is done. It's called
+// after the bytes were read so that the size of each read doesn't need to be
+// known upfront.
+func (t *Throttler) WaitBytes(ctx context.Context, n int) error {
+	if t == nil || n <= 0 {
+		return nil
+	}
+	return t.bytes.wait(ctx, float64(n))
+}
+
+// Pace blocks after an extractor ran for busy so that extractors don't use
+// more than the configured share of a CPU.
+func (t *Throttler) Pace(ctx context.Context, busy time.Duration) error {
+	if t == nil {
+		return nil
+	}
+	if err := t.waitResumed(ctx); err != nil {
+		return err
+	}
+	if t.cpuShare == 0 || busy <= 0 {
+		return nil
+	}
+	return sleep(ctx, time.Duration(float64(busy)*(1-t.cpuShare)/t.cpuShare))
+}
+
+func (t *Throttler) waitResumed(ctx context.Context) error {
+	t.mu.Lock()
+	resumed := t.resumed
+	t.mu.Unlock()
+	if resumed == nil {
+		return nil
+	}
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// bucket is a token bucket that refills at rate tokens per second up to a
+// capacity of bucketWindow worth of tokens. Waits for more tokens than the
+// capacity are allowed and put the bucket into debt, so large reads are
+// delayed instead of rejected.
+type bucket struct {
+	rate     float64
+	capacity float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate float64) *bucket {
+	capacity := max(rate*bucketWindow.Seconds(), 1)
+	return &bucket{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     time.Now(),
+	}
+}
+
+func (b *bucket) wait(ctx context.Context, n float64) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.tokens+now.Sub(b.last).Seconds()*b.rate, b.capacity)
+	b.last = now
+	b.tokens -= n
+	deficit := -b.tokens
+	b.mu.Unlock()
+	if deficit <= 0 {
+		return nil
+	}
+	return sleep(ctx, time.Duration(deficit/b.rate*float64(time.Second)))
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor/filesystem/throttle"
+)
+
+func TestWaitOp(t *testing.T) {
+	// 200 operations per second with a burst of 20.
+	th := throttle.New(throttle.Config{MaxIOPS: 200})
+	start := time.Now()
+	for range 60 {
+		if err := th.WaitOp(context.Background()); err != nil {
+			t.Fatalf("WaitOp(): %v", err)
+		}
+	}
+	// The 40 operations after the burst take at least 200ms.
+	if got := time.Since(start); got < 150*time.Millisecond {
+		t.Errorf("60 operations took %v, want at least 150ms", got)
+	}
+}
+
+func TestWaitOp_Unlimited(t *testing.T) {
+	for _, th := range []*throttle.Throttler{nil, throttle.New(throttle.Config{})} {
+		start := time.Now()
+		for range 10000 {
+			if err := th.WaitOp(context.Background()); err != nil {
+				t.Fatalf("WaitOp(): %v", err)
+			}
+		}
+		if got := time.Since(start); got > time.Second {
+			t.Errorf("unlimited operations took %v", got)
+		}
+	}
+}
+
+func TestWaitOp_ContextCanceled(t *testing.T) {
+	th := throttle.New(throttle.Config{MaxIOPS: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// The first operation uses up the burst.
+	_ = th.WaitOp(ctx)
+	if err := th.WaitOp(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitOp() = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestPauseResume(t *testing.T) {
+	th := throttle.New(throttle.Config{})
+	th.Pause()
+	if !th.Paused() {
+		t.Fatal("Paused() = false after Pause()")
+	}
+
+	done := make(chan error)
+	go func() { done <- th.WaitOp(context.Background()) }()
+	select {
+	case <-done:
+		t.Fatal("WaitOp() returned while paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	th.Resume()
+	if th.Paused() {
+		t.Error("Paused() = true after Resume()")
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("WaitOp(): %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("WaitOp() didn't return after Resume()")
+	}
+}
+
+func TestPace(t *testing.T) {
+	th := throttle.New(throttle.Config{CPUShare: 0.25})
+	start := time.Now()
+	if err := th.Pace(context.Background(), 50*time.Millisecond); err != nil {
+		t.Fatalf("Pace(): %v", err)
+	}
+	// With a quarter of a CPU, 50ms of work are followed by 150ms of sleep.
+	if got := time.Since(start); got < 150*time.Millisecond {
+		t.Errorf("Pace(50ms) took %v, want at least 150ms", got)
+	}
+}
+
+func TestFS(t *testing.T) {
+	content := make([]byte, 3000)
+	fsys := fstest.MapFS{
+		"dir/file": &fstest.MapFile{Data: content},
+	}
+	// 10000 bytes per second with a burst of 1000.
+	th := throttle.New(throttle.Config{MaxBytesPerSecond: 10000})
+	tfs := th.FS(context.Background(), fsys)
+
+	entries, err := tfs.ReadDir("dir")
+	if err != nil {
+		t.Fatalf("ReadDir(dir): %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "file" {
+		t.Errorf("ReadDir(dir) = %v, want [file]", entries)
+	}
+
+	start := time.Now()
+	f, err := tfs.Open("dir/file")
+	if err != nil {
+		t.Fatalf("Open(dir/file): %v", err)
+	}
+	defer f.Close()
+	got, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll(dir/file): %v", err)
+	}
+	if diff := cmp.Diff(content, got); diff != "" {
+		t.Errorf("ReadAll(dir/file) diff (-want +got):\n%s", diff)
+	}
+	// The 2000 bytes after the burst take at least 200ms.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("reading 3000 bytes took %v, want at least 150ms", elapsed)
+	}
+
+	if _, ok := f.(io.ReaderAt); !ok {
+		t.Error("opened file doesn't implement io.ReaderAt")
+	}
+}
+
+func TestFS_ReadDirFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dir/a": &fstest.MapFile{},
+		"dir/b": &fstest.MapFile{},
+	}
+	tfs := throttle.New(throttle.Config{MaxIOPS: 1000}).FS(context.Background(), fsys)
+	f, err := tfs.Open("dir")
+	if err != nil {
+		t.Fatalf("Open(dir): %v", err)
+	}
+	defer f.Close()
+	d, ok := f.(fs.ReadDirFile)
+	if !ok {
+		t.Fatal("opened directory doesn't implement fs.ReadDirFile")
+	}
+	var names []string
+	for {
+		entries, err := d.ReadDir(1)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("ReadDir(1): %v", err)
+		}
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, names); diff != "" {
+		t.Errorf("ReadDir() diff (-want +got):\n%s", diff)
+	}
+}
@@ -65,6 +65,11 @@ func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([
 	return nil, fmt.Errorf("only supported on Linux")
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 // ToPURL converts an inventory created by this extractor into a PURL.
 func (e *Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
 	return nil
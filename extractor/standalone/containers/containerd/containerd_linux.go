@@ -120,6 +120,11 @@ func (e Extractor) Requirements() *plugin.Capabilities {
 	}
 }
 
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
 // Extractor extracts containers from the containerd API.
 func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
 	var inventory = []*extractor.Inventory{}
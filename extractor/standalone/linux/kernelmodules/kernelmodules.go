@@ -0,0 +1,314 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kernelmodules extracts the kernel modules loaded into the running
+// Linux kernel from /proc/modules and /sys/module and the DKMS packages of
+// out-of-tree modules from /var/lib/dkms.
+package kernelmodules
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name is the unique name of this extractor.
+	Name = "linux/kernelmodules"
+
+	procModulesPath = "proc/modules"
+	osReleasePath   = "proc/sys/kernel/osrelease"
+	sysModulePath   = "sys/module"
+	dkmsPath        = "var/lib/dkms"
+)
+
+// taintFlags maps the per-module taint flags in /proc/modules to Taints.
+var taintFlags = map[rune]Taint{
+	'P': TaintProprietary,
+	'O': TaintOutOfTree,
+	'E': TaintUnsigned,
+	'F': TaintForced,
+	'C': TaintStaging,
+	'K': TaintLivepatch,
+}
+
+// koExtensions are the extensions of (compressed) kernel module files.
+var koExtensions = []string{".ko", ".ko.xz", ".ko.zst", ".ko.gz"}
+
+// Config is the configuration for the Extractor.
+type Config struct {
+	// IncludeInTree also reports loaded modules that don't taint the kernel
+	// and weren't built by DKMS, i.e. the signed modules shipped with the
+	// kernel.
+	IncludeInTree bool
+}
+
+// DefaultConfig returns the default configuration for the kernel modules
+// extractor.
+func DefaultConfig() Config {
+	return Config{IncludeInTree: false}
+}
+
+// Extractor extracts loaded kernel modules and DKMS packages.
+type Extractor struct {
+	includeInTree bool
+}
+
+// New returns a kernel modules extractor.
+//
+// For most use cases, initialize with:
+// ```
+// e := New(DefaultConfig())
+// ```
+func New(cfg Config) *Extractor {
+	return &Extractor{includeInTree: cfg.IncludeInTree}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+func (e Extractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{
+		OS:            plugin.OSLinux,
+		RunningSystem: true,
+	}
+}
+
+// Documentation describes the metadata the extractor attaches to inventory.
+func (Extractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{Metadata: &Metadata{}}
+}
+
+// Extract returns the out-of-tree and tainting kernel modules loaded into the
+// running kernel and the DKMS packages installed on the system.
+func (e Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+	kernel := ""
+	if b, err := fs.ReadFile(input.FS, osReleasePath); err == nil {
+		kernel = strings.TrimSpace(string(b))
+	}
+
+	dkms, err := readDKMS(ctx, input.FS, kernel)
+	if err != nil {
+		return nil, err
+	}
+	// DKMS packages by the names of the modules built from them.
+	dkmsByModule := map[string]*extractor.Inventory{}
+	for _, i := range dkms {
+		for _, m := range i.Metadata.(*Metadata).DKMS.Modules {
+			dkmsByModule[m] = i
+		}
+	}
+
+	modules, err := e.readLoadedModules(ctx, input.FS, kernel, dkmsByModule)
+	if err != nil {
+		return nil, err
+	}
+	return append(modules, dkms...), nil
+}
+
+// readLoadedModules parses /proc/modules. Lines have the format
+// "name size refcount deps state address [taints]", e.g.
+// "nvidia 56823808 1449 nvidia_modeset, Live 0xffffffffc0a00000 (POE)".
+func (e Extractor) readLoadedModules(ctx context.Context, fsys scalibrfs.FS, kernel string, dkmsByModule map[string]*extractor.Inventory) ([]*extractor.Inventory, error) {
+	f, err := fsys.Open(procModulesPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", procModulesPath, err)
+	}
+	defer f.Close()
+
+	var result []*extractor.Inventory
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		fields := strings.Fields(s.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		name := fields[0]
+		m := &Module{State: fields[4], KernelRelease: kernel}
+		m.SizeBytes, _ = strconv.ParseInt(fields[1], 10, 64)
+		m.RefCount, _ = strconv.Atoi(fields[2])
+		for _, dep := range strings.Split(fields[3], ",") {
+			if dep != "" && dep != "-" {
+				m.UsedBy = append(m.UsedBy, dep)
+			}
+		}
+		if last := fields[len(fields)-1]; len(fields) > 6 && strings.HasPrefix(last, "(") {
+			for _, c := range strings.Trim(last, "()") {
+				if t, ok := taintFlags[c]; ok {
+					m.Taints = append(m.Taints, t)
+				}
+			}
+		}
+		if i, ok := dkmsByModule[name]; ok {
+			m.DKMSPackage = i.Name + "/" + i.Version
+		}
+		if !e.includeInTree && len(m.Taints) == 0 && m.DKMSPackage == "" {
+			continue
+		}
+
+		m.SrcVersion = readSysModuleFile(fsys, name, "srcversion")
+		result = append(result, &extractor.Inventory{
+			Name:      name,
+			Version:   readSysModuleFile(fsys, name, "version"),
+			Locations: []string{procModulesPath},
+			Metadata:  &Metadata{Module: m},
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", procModulesPath, err)
+	}
+	return result, nil
+}
+
+// readSysModuleFile returns the content of a file in the sysfs directory of a
+// module, or an empty string if it doesn't exist. The version file only
+// exists for modules that declare a version.
+func readSysModuleFile(fsys scalibrfs.FS, module, file string) string {
+	b, err := fs.ReadFile(fsys, path.Join(sysModulePath, module, file))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+// readDKMS returns the DKMS packages in the DKMS tree, which has the layout
+// <name>/<version>/<kernel>/<arch>/module/<module>.ko for built modules.
+func readDKMS(ctx context.Context, fsys scalibrfs.FS, runningKernel string) ([]*extractor.Inventory, error) {
+	names, err := fs.ReadDir(fsys, dkmsPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dkmsPath, err)
+	}
+
+	var result []*extractor.Inventory
+	for _, name := range names {
+		if !name.IsDir() {
+			continue
+		}
+		versions, err := fs.ReadDir(fsys, path.Join(dkmsPath, name.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DKMS package %s: %w", name.Name(), err)
+		}
+		for _, version := range versions {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			// Besides the version directories, the package directory contains
+			// "kernel-<kernel>-<arch>" symlinks to the installed versions.
+			if !version.IsDir() {
+				continue
+			}
+			dir := path.Join(dkmsPath, name.Name(), version.Name())
+			p := readDKMSBuilds(fsys, dir)
+			p.BuiltForRunningKernel = runningKernel != "" && slices.Contains(p.Kernels, runningKernel)
+			result = append(result, &extractor.Inventory{
+				Name:      name.Name(),
+				Version:   version.Name(),
+				Locations: []string{dir},
+				Metadata:  &Metadata{DKMS: p},
+			})
+		}
+	}
+	return result, nil
+}
+
+// readDKMSBuilds returns the kernels a DKMS package version is built for and
+// the modules built from it.
+func readDKMSBuilds(fsys scalibrfs.FS, dir string) *DKMSPackage {
+	p := &DKMSPackage{}
+	kernels, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return p
+	}
+	for _, kernel := range kernels {
+		// Skips the "source" symlink to the sources in /usr/src.
+		if !kernel.IsDir() {
+			continue
+		}
+		arches, err := fs.ReadDir(fsys, path.Join(dir, kernel.Name()))
+		if err != nil {
+			continue
+		}
+		built := false
+		for _, arch := range arches {
+			files, err := fs.ReadDir(fsys, path.Join(dir, kernel.Name(), arch.Name(), "module"))
+			if err != nil {
+				continue
+			}
+			for _, f := range files {
+				if m := moduleName(f.Name()); m != "" {
+					built = true
+					if !slices.Contains(p.Modules, m) {
+						p.Modules = append(p.Modules, m)
+					}
+				}
+			}
+		}
+		if built {
+			p.Kernels = append(p.Kernels, kernel.Name())
+		}
+	}
+	slices.Sort(p.Modules)
+	return p
+}
+
+// moduleName returns the name the kernel uses for the module file f, or an
+// empty string if f isn't a kernel module. The kernel replaces dashes in
+// module names with underscores.
+func moduleName(f string) string {
+	for _, ext := range koExtensions {
+		if name, ok := strings.CutSuffix(f, ext); ok {
+			return strings.ReplaceAll(name, "-", "_")
+		}
+	}
+	return ""
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not support kernel modules.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
+
+var _ standalone.Extractor = Extractor{}
@@ -0,0 +1,178 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelmodules_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/extractor/standalone/linux/kernelmodules"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/purl"
+)
+
+func TestExtract(t *testing.T) {
+	nvidiaUVM := &extractor.Inventory{
+		Name:      "nvidia_uvm",
+		Locations: []string{"proc/modules"},
+		Metadata: &kernelmodules.Metadata{Module: &kernelmodules.Module{
+			SizeBytes:     1765376,
+			State:         "Live",
+			Taints:        []kernelmodules.Taint{kernelmodules.TaintProprietary, kernelmodules.TaintOutOfTree, kernelmodules.TaintUnsigned},
+			DKMSPackage:   "nvidia/545.29.06",
+			KernelRelease: "6.5.0-14-generic",
+		}},
+	}
+	nvidia := &extractor.Inventory{
+		Name:      "nvidia",
+		Version:   "545.29.06",
+		Locations: []string{"proc/modules"},
+		Metadata: &kernelmodules.Metadata{Module: &kernelmodules.Module{
+			SrcVersion:    "7C5A1B2E3D4F5A6B7C8D9E0",
+			SizeBytes:     56823808,
+			RefCount:      1449,
+			UsedBy:        []string{"nvidia_uvm", "nvidia_modeset"},
+			State:         "Live",
+			Taints:        []kernelmodules.Taint{kernelmodules.TaintProprietary, kernelmodules.TaintOutOfTree, kernelmodules.TaintUnsigned},
+			DKMSPackage:   "nvidia/545.29.06",
+			KernelRelease: "6.5.0-14-generic",
+		}},
+	}
+	vboxdrv := &extractor.Inventory{
+		Name:      "vboxdrv",
+		Version:   "7.0.12_Ubuntu r159484",
+		Locations: []string{"proc/modules"},
+		Metadata: &kernelmodules.Metadata{Module: &kernelmodules.Module{
+			SrcVersion:    "0A1B2C3D4E5F6A7B8C9D0E1",
+			SizeBytes:     696320,
+			RefCount:      2,
+			UsedBy:        []string{"vboxnetadp", "vboxnetflt"},
+			State:         "Live",
+			Taints:        []kernelmodules.Taint{kernelmodules.TaintOutOfTree, kernelmodules.TaintUnsigned},
+			DKMSPackage:   "virtualbox/7.0.12",
+			KernelRelease: "6.5.0-14-generic",
+		}},
+	}
+	ext4 := &extractor.Inventory{
+		Name:      "ext4",
+		Locations: []string{"proc/modules"},
+		Metadata: &kernelmodules.Metadata{Module: &kernelmodules.Module{
+			SrcVersion:    "E3B2A5C8D1F4E7A0B3C6D9F",
+			SizeBytes:     1060864,
+			RefCount:      2,
+			State:         "Live",
+			KernelRelease: "6.5.0-14-generic",
+		}},
+	}
+	mbcache := &extractor.Inventory{
+		Name:      "mbcache",
+		Locations: []string{"proc/modules"},
+		Metadata: &kernelmodules.Metadata{Module: &kernelmodules.Module{
+			SizeBytes:     16384,
+			RefCount:      1,
+			UsedBy:        []string{"ext4"},
+			State:         "Live",
+			KernelRelease: "6.5.0-14-generic",
+		}},
+	}
+	nvidiaDKMS := &extractor.Inventory{
+		Name:      "nvidia",
+		Version:   "545.29.06",
+		Locations: []string{"var/lib/dkms/nvidia/545.29.06"},
+		Metadata: &kernelmodules.Metadata{DKMS: &kernelmodules.DKMSPackage{
+			Kernels:               []string{"6.2.0-39-generic", "6.5.0-14-generic"},
+			Modules:               []string{"nvidia", "nvidia_modeset", "nvidia_uvm"},
+			BuiltForRunningKernel: true,
+		}},
+	}
+	virtualboxDKMS := &extractor.Inventory{
+		Name:      "virtualbox",
+		Version:   "7.0.12",
+		Locations: []string{"var/lib/dkms/virtualbox/7.0.12"},
+		Metadata: &kernelmodules.Metadata{DKMS: &kernelmodules.DKMSPackage{
+			Kernels: []string{"6.2.0-39-generic"},
+			Modules: []string{"vboxdrv"},
+		}},
+	}
+
+	tests := []struct {
+		name string
+		cfg  kernelmodules.Config
+		fsys scalibrfs.FS
+		want []*extractor.Inventory
+	}{
+		{
+			name: "out-of-tree modules and DKMS packages",
+			cfg:  kernelmodules.DefaultConfig(),
+			fsys: scalibrfs.DirFS("testdata/host"),
+			want: []*extractor.Inventory{nvidiaUVM, nvidia, vboxdrv, nvidiaDKMS, virtualboxDKMS},
+		},
+		{
+			name: "include in-tree modules",
+			cfg:  kernelmodules.Config{IncludeInTree: true},
+			fsys: scalibrfs.DirFS("testdata/host"),
+			want: []*extractor.Inventory{nvidiaUVM, nvidia, vboxdrv, ext4, mbcache, nvidiaDKMS, virtualboxDKMS},
+		},
+		{
+			name: "no proc or DKMS tree",
+			cfg:  kernelmodules.DefaultConfig(),
+			fsys: fstest.MapFS{},
+			want: nil,
+		},
+		{
+			name: "malformed lines are skipped",
+			cfg:  kernelmodules.DefaultConfig(),
+			fsys: fstest.MapFS{
+				"proc/modules": {Data: []byte("garbage\nzfs 6225920 6 - Live 0xffffffffc1000000 (PO)\n")},
+			},
+			want: []*extractor.Inventory{{
+				Name:      "zfs",
+				Locations: []string{"proc/modules"},
+				Metadata: &kernelmodules.Metadata{Module: &kernelmodules.Module{
+					SizeBytes: 6225920,
+					RefCount:  6,
+					State:     "Live",
+					Taints:    []kernelmodules.Taint{kernelmodules.TaintProprietary, kernelmodules.TaintOutOfTree},
+				}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := kernelmodules.New(tt.cfg)
+			got, err := e.Extract(context.Background(), &standalone.ScanInput{FS: tt.fsys, Root: "/"})
+			if err != nil {
+				t.Fatalf("Extract(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Extract() returned unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToPURL(t *testing.T) {
+	e := kernelmodules.New(kernelmodules.DefaultConfig())
+	i := &extractor.Inventory{Name: "nvidia", Version: "545.29.06"}
+	want := &purl.PackageURL{Type: purl.TypeGeneric, Name: "nvidia", Version: "545.29.06"}
+	if diff := cmp.Diff(want, e.ToPURL(i)); diff != "" {
+		t.Errorf("ToPURL(%v) returned unexpected diff (-want +got):\n%s", i, diff)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kernelmodules
+
+// Metadata describes a kernel module loaded into the running kernel or a
+// DKMS package. Exactly one of the fields is set.
+type Metadata struct {
+	Module *Module
+	DKMS   *DKMSPackage
+}
+
+// Taint is a reason a module taints the kernel.
+type Taint string
+
+// Taint values, see
+// https://docs.kernel.org/admin-guide/tainted-kernels.html.
+const (
+	// TaintProprietary is set for modules with a license other than the GPL.
+	TaintProprietary Taint = "proprietary"
+	// TaintOutOfTree is set for modules built outside of the kernel tree.
+	TaintOutOfTree Taint = "out-of-tree"
+	// TaintUnsigned is set for modules without a valid signature on kernels
+	// that support module signing.
+	TaintUnsigned Taint = "unsigned"
+	// TaintForced is set for modules that were loaded with modprobe --force.
+	TaintForced Taint = "forced"
+	// TaintStaging is set for drivers from the staging tree.
+	TaintStaging Taint = "staging"
+	// TaintLivepatch is set for live patches.
+	TaintLivepatch Taint = "livepatch"
+)
+
+// Module is a loaded kernel module.
+type Module struct {
+	// SrcVersion is a checksum of the module's sources, which identifies
+	// builds of modules that don't declare a version.
+	SrcVersion string
+	SizeBytes  int64
+	// Number of references to the module.
+	RefCount int
+	// Modules that depend on this module.
+	UsedBy []string
+	// State of the module: "Live", "Loading" or "Unloading".
+	State  string
+	Taints []Taint
+	// "<name>/<version>" of the DKMS package the module was built from, if
+	// any.
+	DKMSPackage string
+	// Release of the running kernel, e.g. "6.5.0-14-generic".
+	KernelRelease string
+}
+
+// DKMSPackage is a package of out-of-tree kernel modules that DKMS rebuilds
+// for every installed kernel.
+type DKMSPackage struct {
+	// Kernel releases the package is built for.
+	Kernels []string
+	// Names of the kernel modules built from the package.
+	Modules []string
+	// Whether the package is built for the running kernel.
+	BuiltForRunningKernel bool
+}
@@ -23,10 +23,12 @@ import (
 
 	"github.com/google/osv-scalibr/extractor/standalone"
 	"github.com/google/osv-scalibr/extractor/standalone/containers/containerd"
+	"github.com/google/osv-scalibr/extractor/standalone/linux/kernelmodules"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/dismpatch"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/ospackages"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/regosversion"
 	"github.com/google/osv-scalibr/extractor/standalone/windows/regpatchlevel"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/securityproducts"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
 )
@@ -43,6 +45,7 @@ var (
 		ospackages.New(ospackages.DefaultConfiguration()),
 		regosversion.New(regosversion.DefaultConfiguration()),
 		regpatchlevel.New(regpatchlevel.DefaultConfiguration()),
+		securityproducts.New(securityproducts.DefaultConfiguration()),
 	}
 
 	// Containers standalone extractors.
@@ -50,14 +53,21 @@ var (
 		containerd.New(containerd.DefaultConfig()),
 	}
 
+	// Linux standalone extractors.
+	Linux = []standalone.Extractor{
+		kernelmodules.New(kernelmodules.DefaultConfig()),
+	}
+
 	// Default standalone extractors.
 	Default []standalone.Extractor = slices.Concat(Windows)
 	// All standalone extractors.
-	All []standalone.Extractor = slices.Concat(Windows, WindowsExperimental, Containers)
+	All []standalone.Extractor = slices.Concat(Windows, WindowsExperimental, Containers, Linux)
 
 	extractorNames = map[string][]standalone.Extractor{
 		// Windows
 		"windows": Windows,
+		// Linux
+		"linux": Linux,
 
 		// Collections.
 		"default":    Default,
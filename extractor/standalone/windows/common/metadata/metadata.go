@@ -22,3 +22,36 @@ type OSVersion struct {
 	// FullVersion is the full version of the OS version: Major.Minor.Build.Revision.
 	FullVersion string
 }
+
+// SecurityProduct provides metadata about a security product (e.g. an
+// antivirus) registered on the system.
+type SecurityProduct struct {
+	// Kind of the product, e.g. "antivirus", "firewall" or "antispyware".
+	Kind string
+	// Whether the product reports its real-time protection as enabled.
+	Enabled bool
+	// Scan exclusions configured for the product. Only populated for
+	// Microsoft Defender.
+	Exclusions []*DefenderExclusion
+}
+
+// DefenderExclusionType is the type of a Microsoft Defender scan exclusion.
+type DefenderExclusionType string
+
+// DefenderExclusionType values.
+const (
+	ExclusionPath      DefenderExclusionType = "path"
+	ExclusionExtension DefenderExclusionType = "extension"
+	ExclusionProcess   DefenderExclusionType = "process"
+	ExclusionIPAddress DefenderExclusionType = "ip_address"
+)
+
+// DefenderExclusion is a Microsoft Defender scan exclusion.
+type DefenderExclusion struct {
+	Type DefenderExclusionType
+	// The excluded path, extension, process or IP address as configured.
+	Value string
+	// Whether the exclusion is configured through group policy rather than
+	// locally.
+	FromPolicy bool
+}
@@ -18,9 +18,16 @@ package winproducts
 import (
 	"strings"
 
+	"github.com/google/osv-scalibr/common/windows/registry"
 	"github.com/google/osv-scalibr/log"
 )
 
+const (
+	// Registry information to find the flavor of Windows.
+	regRoot = `SOFTWARE\Microsoft\Windows NT\CurrentVersion`
+	regKey  = "InstallationType"
+)
+
 var (
 	// windowsFlavorAndBuildToProductName maps a given Windows flavor and build number to a product
 	// name.
@@ -88,3 +95,20 @@ func WindowsProductFromVersion(flavor, imgVersion string) string {
 
 	return "unknownWindows"
 }
+
+// WindowsFlavorFromRegistry returns the Windows flavor (e.g. server, client) from the registry.
+// It will default to a "server" flavor if it cannot be determined.
+func WindowsFlavorFromRegistry(reg registry.Registry) string {
+	k, err := reg.OpenKey("HKLM", regRoot)
+	if err != nil {
+		return windowsFlavor("server")
+	}
+	defer k.Close()
+
+	value, err := k.ValueString(regKey)
+	if err != nil {
+		return windowsFlavor("server")
+	}
+
+	return windowsFlavor(value)
+}
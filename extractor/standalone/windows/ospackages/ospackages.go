@@ -12,8 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build windows
-
 // Package ospackages extracts installed softwares on Windows.
 package ospackages
 
@@ -43,6 +41,9 @@ const (
 type Configuration struct {
 	// Opener is the registry engine to use (offline, live or mock).
 	Opener registry.Opener
+	// Offline reads the registry hives from the scanned filesystem instead of using Opener, e.g. to
+	// scan the mounted disk image of a Windows machine from another host.
+	Offline bool
 }
 
 // DefaultConfiguration for the extractor. It uses the live registry of the running system.
@@ -52,18 +53,28 @@ func DefaultConfiguration() Configuration {
 	}
 }
 
+// OfflineConfiguration for the extractor. It reads the registry hives of the Windows image found
+// at the scan root.
+func OfflineConfiguration() Configuration {
+	return Configuration{
+		Offline: true,
+	}
+}
+
 // Name of the extractor
 const Name = "windows/ospackages"
 
 // Extractor implements the ospackages extractor.
 type Extractor struct {
-	opener registry.Opener
+	opener  registry.Opener
+	offline bool
 }
 
 // New creates a new Extractor from a given configuration.
 func New(config Configuration) *Extractor {
 	return &Extractor{
-		opener: config.Opener,
+		opener:  config.Opener,
+		offline: config.Offline,
 	}
 }
 
@@ -74,13 +85,17 @@ func (e Extractor) Name() string { return Name }
 func (e Extractor) Version() int { return 0 }
 
 // Requirements of the extractor.
+// Offline scans only need the scanned filesystem.
 func (e Extractor) Requirements() *plugin.Capabilities {
-	return &plugin.Capabilities{RunningSystem: true}
+	if e.offline {
+		return &plugin.Capabilities{}
+	}
+	return &plugin.Capabilities{OS: plugin.OSWindows, RunningSystem: true}
 }
 
 // Extract retrieves the patch level from the Windows registry.
 func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
-	reg, err := e.opener.Open()
+	reg, err := e.registryOpener(input).Open()
 	if err != nil {
 		return nil, err
 	}
@@ -105,6 +120,14 @@ func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([
 	return append(inventory, inv...), nil
 }
 
+// registryOpener returns the opener for the registry to scan.
+func (e *Extractor) registryOpener(input *standalone.ScanInput) registry.Opener {
+	if e.offline {
+		return registry.NewImageOpener(input.FS)
+	}
+	return e.opener
+}
+
 // allSoftwaresInfo builds the inventory of name/version for installed software from the given registry
 // keys. This function cannot return an error.
 func (e *Extractor) allSoftwaresInfo(reg registry.Registry, hive string, paths []string) []*extractor.Inventory {
@@ -12,17 +12,18 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build windows
-
 package ospackages
 
 import (
 	"context"
 	"testing"
+	"testing/fstest"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/osv-scalibr/common/windows/registry"
 	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/plugin"
 	"github.com/google/osv-scalibr/purl"
 	"github.com/google/osv-scalibr/testing/mockregistry"
 )
@@ -189,7 +190,7 @@ func TestExtract(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			cfg := Configuration{mockregistry.NewOpener(tc.reg)}
+			cfg := Configuration{Opener: mockregistry.NewOpener(tc.reg)}
 			e := New(cfg)
 			got, err := e.Extract(context.Background(), nil)
 			if tc.wantErr != (err != nil) {
@@ -207,6 +208,63 @@ func TestExtract(t *testing.T) {
 	}
 }
 
+func TestExtractOffline(t *testing.T) {
+	tests := []struct {
+		name    string
+		fs      fstest.MapFS
+		wantErr bool
+	}{
+		{
+			name:    "missing_software_hive",
+			fs:      fstest.MapFS{"Windows/System32/config/SYSTEM": {Data: []byte("not a hive")}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_software_hive",
+			fs:      fstest.MapFS{"Windows/System32/config/SOFTWARE": {Data: []byte("not a hive")}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := New(OfflineConfiguration())
+			_, err := e.Extract(context.Background(), &standalone.ScanInput{FS: tc.fs})
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Extract() returned an unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRequirements(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Configuration
+		want *plugin.Capabilities
+	}{
+		{
+			name: "live_registry",
+			cfg:  DefaultConfiguration(),
+			want: &plugin.Capabilities{OS: plugin.OSWindows, RunningSystem: true},
+		},
+		{
+			name: "offline_image",
+			cfg:  OfflineConfiguration(),
+			want: &plugin.Capabilities{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := New(tc.cfg).Requirements()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Requirements() returned an unexpected diff (-want +got): %v", diff)
+			}
+		})
+	}
+}
+
 func TestToPURL(t *testing.T) {
 	tests := []struct {
 		name string
@@ -12,8 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build windows
-
 // Package regosversion extracts the OS version (build, major, minor release) from the registry.
 package regosversion
 
@@ -41,6 +39,9 @@ const (
 type Configuration struct {
 	// Opener is the registry engine to use (offline, live or mock).
 	Opener registry.Opener
+	// Offline reads the registry hives from the scanned filesystem instead of using Opener, e.g. to
+	// scan the mounted disk image of a Windows machine from another host.
+	Offline bool
 }
 
 // DefaultConfiguration for the extractor. It uses the live registry of the running system.
@@ -50,15 +51,25 @@ func DefaultConfiguration() Configuration {
 	}
 }
 
+// OfflineConfiguration for the extractor. It reads the registry hives of the Windows image found
+// at the scan root.
+func OfflineConfiguration() Configuration {
+	return Configuration{
+		Offline: true,
+	}
+}
+
 // Extractor provides a metadata extractor for the patch level on Windows.
 type Extractor struct {
-	opener registry.Opener
+	opener  registry.Opener
+	offline bool
 }
 
 // New creates a new Extractor from a given configuration.
 func New(config Configuration) *Extractor {
 	return &Extractor{
-		opener: config.Opener,
+		opener:  config.Opener,
+		offline: config.Offline,
 	}
 }
 
@@ -69,13 +80,17 @@ func (e Extractor) Name() string { return Name }
 func (e Extractor) Version() int { return 0 }
 
 // Requirements of the extractor.
+// Offline scans only need the scanned filesystem.
 func (e Extractor) Requirements() *plugin.Capabilities {
-	return &plugin.Capabilities{RunningSystem: true}
+	if e.offline {
+		return &plugin.Capabilities{}
+	}
+	return &plugin.Capabilities{OS: plugin.OSWindows, RunningSystem: true}
 }
 
 // Extract the DISM patch level on Windows.
 func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
-	reg, err := e.opener.Open()
+	reg, err := e.registryOpener(input).Open()
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +133,14 @@ func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([
 	}, nil
 }
 
+// registryOpener returns the opener for the registry to scan.
+func (e *Extractor) registryOpener(input *standalone.ScanInput) registry.Opener {
+	if e.offline {
+		return registry.NewImageOpener(input.FS)
+	}
+	return e.opener
+}
+
 // windowsVersion extracts the version of Windows (major and minor, e.g. 6.3 or 10.0)
 func (e Extractor) windowsVersion(key registry.Key) (string, error) {
 	// recent version of Windows
@@ -12,8 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build windows
-
 package regosversion
 
 import (
@@ -203,7 +201,7 @@ func TestExtract(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			cfg := Configuration{mockregistry.NewOpener(tc.reg)}
+			cfg := Configuration{Opener: mockregistry.NewOpener(tc.reg)}
 			e := New(cfg)
 			got, err := e.Extract(context.Background(), nil)
 			if tc.wantErr != (err != nil) {
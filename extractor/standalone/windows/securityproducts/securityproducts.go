@@ -0,0 +1,310 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package securityproducts extracts the security products registered on the system as well as
+// the Microsoft Defender scan exclusions from the Windows registry.
+package securityproducts
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+
+	"github.com/google/osv-scalibr/common/windows/registry"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/purl"
+)
+
+const (
+	// Name of the extractor.
+	Name = "windows/securityproducts"
+	// DefenderName is the inventory name used for Microsoft Defender Antivirus.
+	DefenderName = "Microsoft Defender Antivirus"
+
+	// Registry path where third-party products register with the Windows Security Center.
+	regSecurityCenterRoot = `SOFTWARE\Microsoft\Security Center\Provider`
+	// Registry path to the Microsoft Defender configuration.
+	regDefenderRoot = `SOFTWARE\Microsoft\Windows Defender`
+	// Registry path to the Microsoft Defender group policy configuration.
+	regDefenderPolicyRoot = `SOFTWARE\Policies\Microsoft\Windows Defender`
+
+	// Bit of the Security Center product state that is set if the product is enabled.
+	productStateEnabled = 0x1000
+)
+
+var (
+	// The Security Center provider subkeys and the kind of product they contain.
+	providerKinds = []struct {
+		subkey string
+		kind   string
+	}{
+		{"Av", "antivirus"},
+		{"As", "antispyware"},
+		{"Fw", "firewall"},
+	}
+
+	// The Defender exclusion subkeys. The value names are the excluded items.
+	exclusionTypes = []struct {
+		subkey        string
+		exclusionType metadata.DefenderExclusionType
+	}{
+		{"Paths", metadata.ExclusionPath},
+		{"Extensions", metadata.ExclusionExtension},
+		{"Processes", metadata.ExclusionProcess},
+		{"IpAddresses", metadata.ExclusionIPAddress},
+	}
+
+	// Defender is installed to e.g. C:\ProgramData\Microsoft\Windows Defender\Platform\4.18.24090.11-0
+	defenderVersionRegexp = regexp.MustCompile(`\\Platform\\([0-9]+(?:\.[0-9]+)+)[^\\]*\\?$`)
+)
+
+// Configuration for the extractor.
+type Configuration struct {
+	// Opener is the registry engine to use (offline, live or mock).
+	Opener registry.Opener
+	// Offline reads the registry hives from the scanned filesystem instead of using Opener, e.g. to
+	// scan the mounted disk image of a Windows machine from another host.
+	Offline bool
+}
+
+// DefaultConfiguration for the extractor. It uses the live registry of the running system.
+func DefaultConfiguration() Configuration {
+	return Configuration{
+		Opener: registry.NewLiveOpener(),
+	}
+}
+
+// OfflineConfiguration for the extractor. It reads the registry hives of the Windows image found
+// at the scan root.
+func OfflineConfiguration() Configuration {
+	return Configuration{
+		Offline: true,
+	}
+}
+
+// Extractor implements the securityproducts extractor.
+type Extractor struct {
+	opener  registry.Opener
+	offline bool
+}
+
+// New creates a new Extractor from a given configuration.
+func New(config Configuration) *Extractor {
+	return &Extractor{
+		opener:  config.Opener,
+		offline: config.Offline,
+	}
+}
+
+// Name of the extractor.
+func (e Extractor) Name() string { return Name }
+
+// Version of the extractor.
+func (e Extractor) Version() int { return 0 }
+
+// Requirements of the extractor.
+// Offline scans only need the scanned filesystem.
+func (e Extractor) Requirements() *plugin.Capabilities {
+	if e.offline {
+		return &plugin.Capabilities{}
+	}
+	return &plugin.Capabilities{OS: plugin.OSWindows, RunningSystem: true}
+}
+
+// Extract retrieves the registered security products and Defender exclusions from the registry.
+func (e *Extractor) Extract(ctx context.Context, input *standalone.ScanInput) ([]*extractor.Inventory, error) {
+	reg, err := e.registryOpener(input).Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reg.Close()
+
+	var inventory []*extractor.Inventory
+	if defender := e.defenderInventory(reg); defender != nil {
+		inventory = append(inventory, defender)
+	}
+
+	for _, p := range providerKinds {
+		products, err := e.securityCenterProducts(reg, p.subkey, p.kind)
+		if err != nil {
+			return nil, err
+		}
+		inventory = append(inventory, products...)
+	}
+
+	return inventory, nil
+}
+
+// registryOpener returns the opener for the registry to scan.
+func (e *Extractor) registryOpener(input *standalone.ScanInput) registry.Opener {
+	if e.offline {
+		return registry.NewImageOpener(input.FS)
+	}
+	return e.opener
+}
+
+// defenderInventory returns the inventory for Microsoft Defender, or nil if it's not installed.
+func (e *Extractor) defenderInventory(reg registry.Registry) *extractor.Inventory {
+	key, err := reg.OpenKey("HKLM", regDefenderRoot)
+	if err != nil {
+		return nil
+	}
+	defer key.Close()
+
+	version := ""
+	if location, err := key.ValueString("InstallLocation"); err == nil {
+		if submatch := defenderVersionRegexp.FindStringSubmatch(location); len(submatch) > 1 {
+			version = submatch[1]
+		}
+	}
+
+	enabled := !realtimeMonitoringDisabled(reg, regDefenderRoot) &&
+		!realtimeMonitoringDisabled(reg, regDefenderPolicyRoot)
+
+	exclusions := defenderExclusions(reg, regDefenderRoot, false)
+	exclusions = append(exclusions, defenderExclusions(reg, regDefenderPolicyRoot, true)...)
+
+	return &extractor.Inventory{
+		Name:    DefenderName,
+		Version: version,
+		Metadata: &metadata.SecurityProduct{
+			Kind:       "antivirus",
+			Enabled:    enabled,
+			Exclusions: exclusions,
+		},
+	}
+}
+
+// realtimeMonitoringDisabled returns whether the DisableRealtimeMonitoring setting is set under
+// the given Defender configuration root.
+func realtimeMonitoringDisabled(reg registry.Registry, root string) bool {
+	key, err := reg.OpenKey("HKLM", root+`\Real-Time Protection`)
+	if err != nil {
+		return false
+	}
+	defer key.Close()
+
+	data, err := key.ValueBytes("DisableRealtimeMonitoring")
+	if err != nil || len(data) < 4 {
+		return false
+	}
+	return binary.LittleEndian.Uint32(data) == 1
+}
+
+// defenderExclusions returns the exclusions configured under the given Defender configuration
+// root. Missing or inaccessible keys are skipped: Local exclusions are only readable by SYSTEM on
+// recent versions of Windows.
+func defenderExclusions(reg registry.Registry, root string, fromPolicy bool) []*metadata.DefenderExclusion {
+	var exclusions []*metadata.DefenderExclusion
+	for _, t := range exclusionTypes {
+		keyPath := fmt.Sprintf(`%s\Exclusions\%s`, root, t.subkey)
+		key, err := reg.OpenKey("HKLM", keyPath)
+		if err != nil {
+			continue
+		}
+
+		values, err := key.Values()
+		key.Close()
+		if err != nil {
+			log.Warnf("Failed to read values of %q: %v", keyPath, err)
+			continue
+		}
+
+		for _, v := range values {
+			exclusions = append(exclusions, &metadata.DefenderExclusion{
+				Type:       t.exclusionType,
+				Value:      v.Name(),
+				FromPolicy: fromPolicy,
+			})
+		}
+	}
+	return exclusions
+}
+
+// securityCenterProducts returns the products registered with the Security Center under the given
+// provider subkey.
+func (e *Extractor) securityCenterProducts(reg registry.Registry, subkey, kind string) ([]*extractor.Inventory, error) {
+	providerPath := fmt.Sprintf(`%s\%s`, regSecurityCenterRoot, subkey)
+	key, err := reg.OpenKey("HKLM", providerPath)
+	if err != nil {
+		// No product of this kind is registered.
+		return nil, nil
+	}
+	defer key.Close()
+
+	productKeys, err := key.SubkeyNames()
+	if err != nil {
+		return nil, err
+	}
+
+	var inventory []*extractor.Inventory
+	for _, productKey := range productKeys {
+		product, err := e.handleProductKey(reg, providerPath, productKey, kind)
+		if err != nil {
+			return nil, err
+		}
+		if product != nil {
+			inventory = append(inventory, product)
+		}
+	}
+	return inventory, nil
+}
+
+func (e *Extractor) handleProductKey(reg registry.Registry, providerPath, keyName, kind string) (*extractor.Inventory, error) {
+	keyPath := fmt.Sprintf(`%s\%s`, providerPath, keyName)
+	key, err := reg.OpenKey("HKLM", keyPath)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+
+	displayName, err := key.ValueString("DISPLAYNAME")
+	if err != nil || displayName == "" {
+		return nil, nil
+	}
+	// Defender is reported with its configuration by defenderInventory.
+	if displayName == DefenderName || displayName == "Windows Defender" {
+		return nil, nil
+	}
+
+	enabled := false
+	if state, err := key.ValueBytes("STATE"); err == nil && len(state) >= 4 {
+		enabled = binary.LittleEndian.Uint32(state)&productStateEnabled != 0
+	}
+
+	return &extractor.Inventory{
+		Name: displayName,
+		Metadata: &metadata.SecurityProduct{
+			Kind:    kind,
+			Enabled: enabled,
+		},
+	}, nil
+}
+
+// ToPURL converts an inventory created by this extractor into a PURL.
+func (e Extractor) ToPURL(i *extractor.Inventory) *purl.PackageURL {
+	return &purl.PackageURL{
+		Type:    purl.TypeGeneric,
+		Name:    i.Name,
+		Version: i.Version,
+	}
+}
+
+// Ecosystem returns no ecosystem since OSV does not support security products.
+func (Extractor) Ecosystem(i *extractor.Inventory) string { return "" }
@@ -0,0 +1,156 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securityproducts
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/common/windows/registry"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/standalone/windows/common/metadata"
+	"github.com/google/osv-scalibr/testing/mockregistry"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name    string
+		reg     *mockregistry.MockRegistry
+		want    []*extractor.Inventory
+		wantErr bool
+	}{
+		{
+			name: "defenderWithExclusions_returnsInventory",
+			reg: &mockregistry.MockRegistry{
+				Keys: map[string]registry.Key{
+					regDefenderRoot: &mockregistry.MockKey{
+						KName: "Windows Defender",
+						KValues: []registry.Value{
+							&mockregistry.MockValue{
+								VName:       "InstallLocation",
+								VDataString: `C:\ProgramData\Microsoft\Windows Defender\Platform\4.18.24090.11-0\`,
+							},
+						},
+					},
+					regDefenderRoot + `\Exclusions\Paths`: &mockregistry.MockKey{
+						KName: "Paths",
+						KValues: []registry.Value{
+							&mockregistry.MockValue{VName: `C:\`, VData: []byte{0, 0, 0, 0}},
+						},
+					},
+					regDefenderPolicyRoot + `\Exclusions\Extensions`: &mockregistry.MockKey{
+						KName: "Extensions",
+						KValues: []registry.Value{
+							&mockregistry.MockValue{VName: ".exe", VData: []byte{0, 0, 0, 0}},
+						},
+					},
+				},
+			},
+			want: []*extractor.Inventory{
+				{
+					Name:    DefenderName,
+					Version: "4.18.24090.11",
+					Metadata: &metadata.SecurityProduct{
+						Kind:    "antivirus",
+						Enabled: true,
+						Exclusions: []*metadata.DefenderExclusion{
+							{Type: metadata.ExclusionPath, Value: `C:\`},
+							{Type: metadata.ExclusionExtension, Value: ".exe", FromPolicy: true},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "defenderRealtimeMonitoringDisabled_returnsDisabled",
+			reg: &mockregistry.MockRegistry{
+				Keys: map[string]registry.Key{
+					regDefenderRoot: &mockregistry.MockKey{KName: "Windows Defender"},
+					regDefenderPolicyRoot + `\Real-Time Protection`: &mockregistry.MockKey{
+						KName: "Real-Time Protection",
+						KValues: []registry.Value{
+							&mockregistry.MockValue{VName: "DisableRealtimeMonitoring", VData: []byte{1, 0, 0, 0}},
+						},
+					},
+				},
+			},
+			want: []*extractor.Inventory{
+				{
+					Name:     DefenderName,
+					Metadata: &metadata.SecurityProduct{Kind: "antivirus"},
+				},
+			},
+		},
+		{
+			name: "securityCenterProducts_returnsInventory",
+			reg: &mockregistry.MockRegistry{
+				Keys: map[string]registry.Key{
+					regSecurityCenterRoot + `\Av`: &mockregistry.MockKey{
+						KName: "Av",
+						KSubkeys: []registry.Key{
+							&mockregistry.MockKey{KName: "{D68DDC3A-831F-4fae-9E44-DA132C1ACF46}"},
+							&mockregistry.MockKey{KName: "{2C5A4B7E-0F3D-4E44-8D42-0F6B1C9D2E11}"},
+						},
+					},
+					regSecurityCenterRoot + `\Av\{D68DDC3A-831F-4fae-9E44-DA132C1ACF46}`: &mockregistry.MockKey{
+						KName: "{D68DDC3A-831F-4fae-9E44-DA132C1ACF46}",
+						KValues: []registry.Value{
+							&mockregistry.MockValue{VName: "DISPLAYNAME", VDataString: "Windows Defender"},
+						},
+					},
+					regSecurityCenterRoot + `\Av\{2C5A4B7E-0F3D-4E44-8D42-0F6B1C9D2E11}`: &mockregistry.MockKey{
+						KName: "{2C5A4B7E-0F3D-4E44-8D42-0F6B1C9D2E11}",
+						KValues: []registry.Value{
+							&mockregistry.MockValue{VName: "DISPLAYNAME", VDataString: "Example Antivirus"},
+							&mockregistry.MockValue{VName: "STATE", VData: []byte{0x00, 0x10, 0x06, 0x00}},
+						},
+					},
+				},
+			},
+			want: []*extractor.Inventory{
+				{
+					Name:     "Example Antivirus",
+					Metadata: &metadata.SecurityProduct{Kind: "antivirus", Enabled: true},
+				},
+			},
+		},
+		{
+			name: "noSecurityProducts_returnsEmpty",
+			reg:  &mockregistry.MockRegistry{},
+			want: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Configuration{Opener: mockregistry.NewOpener(tc.reg)}
+			e := New(cfg)
+			got, err := e.Extract(context.Background(), nil)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Extract() returned an unexpected error: %v", err)
+			}
+
+			if tc.wantErr == true {
+				return
+			}
+
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Extract() returned an unexpected diff (-want +got): %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sshfs provides a virtual filesystem that accesses a remote host over
+// SFTP. It allows scanning the host with the filesystem extractors without
+// installing SCALIBR on it.
+package sshfs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"path"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const defaultPort = "22"
+
+// Config is the configuration of the remote filesystem.
+type Config struct {
+	// Address of the SSH server, e.g. "db1.example.com:22". The port defaults
+	// to 22.
+	Address string
+	// The SSH client config with the user, the authentication methods and the
+	// host key callback. Only used by New.
+	ClientConfig *ssh.ClientConfig
+	// Directory on the remote host the filesystem is rooted at.
+	Root string
+	// Number of SFTP sessions opened over the SSH connection. Operations are
+	// distributed across the sessions round-robin.
+	Sessions int
+	// Maximum number of open, stat and readdir operations in flight at once.
+	// If 0, the number is not limited.
+	MaxConcurrentOperations int
+	// Maximum number of concurrent read requests for a single opened file.
+	MaxConcurrentRequestsPerFile int
+}
+
+// DefaultConfig returns the default configuration of the remote filesystem,
+// without the address and client config.
+func DefaultConfig() Config {
+	return Config{
+		Root:                         "/",
+		Sessions:                     2,
+		MaxConcurrentOperations:      16,
+		MaxConcurrentRequestsPerFile: 64,
+	}
+}
+
+// FS is a scalibrfs.FS that accesses the filesystem of a remote host over
+// SFTP. It's safe for concurrent use.
+type FS struct {
+	conn *ssh.Client
+	// Whether the SSH connection was opened by this FS and has to be closed
+	// together with it.
+	ownsConn bool
+	clients  []*sftp.Client
+	next     atomic.Uint32
+	// Limits the number of concurrent operations, nil if there's no limit.
+	sem  chan struct{}
+	root string
+}
+
+// New connects to the SSH server at cfg.Address and returns a filesystem
+// for it. The connection is closed by FS.Close.
+func New(cfg Config) (*FS, error) {
+	if cfg.ClientConfig == nil {
+		return nil, errors.New("no SSH client config")
+	}
+	addr := cfg.Address
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, defaultPort)
+	}
+	conn, err := ssh.Dial("tcp", addr, cfg.ClientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("ssh.Dial(%s): %w", addr, err)
+	}
+	f, err := newFS(conn, cfg)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	f.ownsConn = true
+	return f, nil
+}
+
+// NewFromClient returns a filesystem that reuses the existing SSH connection
+// conn, e.g. to scan several directories of the same host. FS.Close closes
+// the SFTP sessions but leaves the connection open.
+func NewFromClient(conn *ssh.Client, cfg Config) (*FS, error) {
+	return newFS(conn, cfg)
+}
+
+func newFS(conn *ssh.Client, cfg Config) (*FS, error) {
+	sessions := max(cfg.Sessions, 1)
+	var opts []sftp.ClientOption
+	if cfg.MaxConcurrentRequestsPerFile > 0 {
+		opts = append(opts, sftp.MaxConcurrentRequestsPerFile(cfg.MaxConcurrentRequestsPerFile))
+	}
+	f := &FS{conn: conn, root: cfg.Root}
+	if f.root == "" {
+		f.root = "/"
+	}
+	if cfg.MaxConcurrentOperations > 0 {
+		f.sem = make(chan struct{}, cfg.MaxConcurrentOperations)
+	}
+	for range sessions {
+		c, err := sftp.NewClient(conn, opts...)
+		if err != nil {
+			f.closeClients()
+			return nil, fmt.Errorf("failed to open SFTP session: %w", err)
+		}
+		f.clients = append(f.clients, c)
+	}
+	return f, nil
+}
+
+// ScanRoot returns a scan root for the remote filesystem. The scan root is
+// virtual since the files aren't on the disk of the scanning host.
+func (f *FS) ScanRoot() *scalibrfs.ScanRoot {
+	return &scalibrfs.ScanRoot{FS: f}
+}
+
+// Close closes the SFTP sessions and the SSH connection if it was opened by
+// New.
+func (f *FS) Close() error {
+	err := f.closeClients()
+	if f.ownsConn {
+		err = errors.Join(err, f.conn.Close())
+	}
+	return err
+}
+
+func (f *FS) closeClients() error {
+	var errs []error
+	for _, c := range f.clients {
+		errs = append(errs, c.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// client returns the SFTP session to use for the next operation.
+func (f *FS) client() *sftp.Client {
+	return f.clients[int(f.next.Add(1))%len(f.clients)]
+}
+
+// acquire blocks until another operation may run and returns the function
+// that ends it.
+func (f *FS) acquire() func() {
+	if f.sem == nil {
+		return func() {}
+	}
+	f.sem <- struct{}{}
+	return func() { <-f.sem }
+}
+
+// remotePath returns the path of name on the remote host.
+func (f *FS) remotePath(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return path.Join(f.root, name), nil
+}
+
+// Open opens the named file or directory.
+func (f *FS) Open(name string) (fs.File, error) {
+	p, err := f.remotePath("open", name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.acquire()()
+	c := f.client()
+	info, err := c.Stat(p)
+	if err != nil {
+		return nil, pathError("open", name, err)
+	}
+	if info.IsDir() {
+		return &dir{fsys: f, name: name, info: info}, nil
+	}
+	file, err := c.Open(p)
+	if err != nil {
+		return nil, pathError("open", name, err)
+	}
+	return &remoteFile{File: file, info: info}, nil
+}
+
+// ReadDir reads the named directory and returns its entries sorted by name.
+// Symlinks in the directory are not followed.
+func (f *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	p, err := f.remotePath("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.acquire()()
+	infos, err := f.client().ReadDir(p)
+	if err != nil {
+		return nil, pathError("readdir", name, err)
+	}
+	entries := make([]fs.DirEntry, 0, len(infos))
+	for _, info := range infos {
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	return entries, nil
+}
+
+// Stat returns the FileInfo of the named file, following symlinks.
+func (f *FS) Stat(name string) (fs.FileInfo, error) {
+	p, err := f.remotePath("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.acquire()()
+	info, err := f.client().Stat(p)
+	if err != nil {
+		return nil, pathError("stat", name, err)
+	}
+	return info, nil
+}
+
+// pathError returns err as a PathError for the path relative to the root.
+func pathError(op, name string, err error) error {
+	var pe *fs.PathError
+	if errors.As(err, &pe) {
+		err = pe.Err
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+// remoteFile is a regular file opened over SFTP. It supports random access
+// through ReadAt and Seek.
+type remoteFile struct {
+	*sftp.File
+	info fs.FileInfo
+}
+
+// Stat returns the FileInfo the file was opened with.
+func (r *remoteFile) Stat() (fs.FileInfo, error) {
+	return r.info, nil
+}
+
+// dir is an opened remote directory.
+type dir struct {
+	fsys    *FS
+	name    string
+	info    fs.FileInfo
+	entries []fs.DirEntry
+	read    bool
+	offset  int
+}
+
+func (d *dir) Stat() (fs.FileInfo, error) { return d.info, nil }
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *dir) Close() error { return nil }
+
+// ReadDir returns the next n entries of the directory, or all remaining
+// entries if n <= 0.
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if !d.read {
+		entries, err := d.fsys.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries = entries
+		d.read = true
+	}
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return remaining, nil
+	}
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(remaining))
+	d.offset += n
+	return remaining[:n], nil
+}
+
+var _ scalibrfs.FS = &FS{}
@@ -0,0 +1,288 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sshfs_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/fs/sshfs"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testUser     = "scanner"
+	testPassword = "s3cr3t"
+)
+
+// startServer starts an SSH server with an SFTP subsystem that serves the
+// local filesystem and returns its address and a client config for it.
+func startServer(t *testing.T) (string, *ssh.ClientConfig) {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey(): %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(priv)
+	if err != nil {
+		t.Fatalf("ssh.NewSignerFromKey(): %v", err)
+	}
+	serverConfig := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == testUser && string(pass) == testPassword {
+				return nil, nil
+			}
+			return nil, errors.New("access denied")
+		},
+	}
+	serverConfig.AddHostKey(signer)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(): %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveConn(conn, serverConfig)
+		}
+	}()
+
+	return l.Addr().String(), &ssh.ClientConfig{
+		User:            testUser,
+		Auth:            []ssh.AuthMethod{ssh.Password(testPassword)},
+		HostKeyCallback: ssh.FixedHostKey(signer.PublicKey()),
+	}
+}
+
+func serveConn(conn net.Conn, cfg *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, cfg)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	for newChan := range chans {
+		if newChan.ChannelType() != "session" {
+			newChan.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+		ch, chReqs, err := newChan.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			for req := range chReqs {
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if !ok {
+					continue
+				}
+				server, err := sftp.NewServer(ch, sftp.ReadOnly())
+				if err != nil {
+					ch.Close()
+					return
+				}
+				server.Serve()
+				server.Close()
+				return
+			}
+		}()
+	}
+}
+
+// writeFiles creates the files with the given contents in a temp dir and
+// returns its path.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("os.MkdirAll(%s): %v", filepath.Dir(p), err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("os.WriteFile(%s): %v", p, err)
+		}
+	}
+	return dir
+}
+
+func newFS(t *testing.T, root string) *sshfs.FS {
+	t.Helper()
+	addr, clientConfig := startServer(t)
+	cfg := sshfs.DefaultConfig()
+	cfg.Address = addr
+	cfg.ClientConfig = clientConfig
+	cfg.Root = root
+	fsys, err := sshfs.New(cfg)
+	if err != nil {
+		t.Fatalf("sshfs.New(): %v", err)
+	}
+	t.Cleanup(func() { fsys.Close() })
+	return fsys
+}
+
+func TestFS(t *testing.T) {
+	root := writeFiles(t, map[string]string{
+		"etc/os-release":    "ID=debian\n",
+		"etc/hostname":      "db1\n",
+		"var/log/app/a.log": "line 1\nline 2\n",
+	})
+	fsys := newFS(t, root)
+	if err := fstest.TestFS(fsys, "etc/os-release", "etc/hostname", "var/log/app/a.log"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReadAt(t *testing.T) {
+	root := writeFiles(t, map[string]string{"data.bin": "0123456789"})
+	fsys := newFS(t, root)
+	f, err := fsys.Open("data.bin")
+	if err != nil {
+		t.Fatalf("Open(data.bin): %v", err)
+	}
+	defer f.Close()
+	r, ok := f.(io.ReaderAt)
+	if !ok {
+		t.Fatalf("Open(data.bin) returned %T, want an io.ReaderAt", f)
+	}
+	buf := make([]byte, 3)
+	if _, err := r.ReadAt(buf, 4); err != nil {
+		t.Fatalf("ReadAt(4): %v", err)
+	}
+	if got, want := string(buf), "456"; got != want {
+		t.Errorf("ReadAt(4) = %q, want %q", got, want)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	fsys := newFS(t, writeFiles(t, map[string]string{"a": "a"}))
+	tests := []struct {
+		name    string
+		op      func() error
+		wantErr error
+	}{
+		{
+			name:    "open missing file",
+			op:      func() error { _, err := fsys.Open("missing"); return err },
+			wantErr: fs.ErrNotExist,
+		},
+		{
+			name:    "stat missing file",
+			op:      func() error { _, err := fsys.Stat("missing"); return err },
+			wantErr: fs.ErrNotExist,
+		},
+		{
+			name:    "read missing dir",
+			op:      func() error { _, err := fsys.ReadDir("missing"); return err },
+			wantErr: fs.ErrNotExist,
+		},
+		{
+			name:    "path outside of the root",
+			op:      func() error { _, err := fsys.Open("../a"); return err },
+			wantErr: fs.ErrInvalid,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.op(); !errors.Is(err, tc.wantErr) {
+				t.Errorf("got error %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewFromClient(t *testing.T) {
+	root := writeFiles(t, map[string]string{"etc/hostname": "db1\n", "srv/app/index.html": ""})
+	addr, clientConfig := startServer(t)
+	conn, err := ssh.Dial("tcp", addr, clientConfig)
+	if err != nil {
+		t.Fatalf("ssh.Dial(): %v", err)
+	}
+	defer conn.Close()
+
+	// Both filesystems share the connection and closing one doesn't affect the
+	// other.
+	cfg := sshfs.DefaultConfig()
+	cfg.Root = filepath.Join(root, "etc")
+	etc, err := sshfs.NewFromClient(conn, cfg)
+	if err != nil {
+		t.Fatalf("sshfs.NewFromClient(): %v", err)
+	}
+	cfg.Root = filepath.Join(root, "srv")
+	srv, err := sshfs.NewFromClient(conn, cfg)
+	if err != nil {
+		t.Fatalf("sshfs.NewFromClient(): %v", err)
+	}
+	defer srv.Close()
+	if _, err := etc.Stat("hostname"); err != nil {
+		t.Errorf("Stat(hostname): %v", err)
+	}
+	if err := etc.Close(); err != nil {
+		t.Errorf("Close(): %v", err)
+	}
+	if _, err := srv.Stat("app/index.html"); err != nil {
+		t.Errorf("Stat(app/index.html) after closing the other FS: %v", err)
+	}
+}
+
+func TestScan(t *testing.T) {
+	metadata := "usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA"
+	root := writeFiles(t, map[string]string{
+		metadata: "Metadata-Version: 2.1\nName: requests\nVersion: 2.31.0\n",
+	})
+	fsys := newFS(t, root)
+
+	ex := wheelegg.New(wheelegg.DefaultConfig())
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{ex},
+		ScanRoots:            []*scalibrfs.ScanRoot{fsys.ScanRoot()},
+	}
+	got := scalibr.New().Scan(context.Background(), cfg)
+	if got.Status.FailureReason != "" {
+		t.Fatalf("Scan(): %s", got.Status.FailureReason)
+	}
+	want := []*extractor.Inventory{{
+		Name:      "requests",
+		Version:   "2.31.0",
+		Locations: []string{metadata},
+		Extractor: ex,
+		Metadata:  &wheelegg.PythonPackageMetadata{},
+	}}
+	if diff := cmp.Diff(want, got.Inventories, cmpopts.IgnoreFields(extractor.Inventory{}, "Extractor")); diff != "" {
+		t.Errorf("Scan() inventories (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fs
+
+import (
+	"io/fs"
+	"path"
+)
+
+// Sub returns an FS for the subtree of fsys rooted at dir, e.g. the root
+// filesystem of a distribution stored inside of a scanned filesystem.
+func Sub(fsys FS, dir string) FS {
+	if dir == "." || dir == "" {
+		return fsys
+	}
+	return &subFS{fsys: fsys, dir: dir}
+}
+
+type subFS struct {
+	fsys FS
+	dir  string
+}
+
+// fullName returns the path of name in the parent FS.
+func (s *subFS) fullName(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return path.Join(s.dir, name), nil
+}
+
+func (s *subFS) Open(name string) (fs.File, error) {
+	full, err := s.fullName("open", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.fsys.Open(full)
+}
+
+func (s *subFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	full, err := s.fullName("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.fsys.ReadDir(full)
+}
+
+func (s *subFS) Stat(name string) (fs.FileInfo, error) {
+	full, err := s.fullName("stat", name)
+	if err != nil {
+		return nil, err
+	}
+	return s.fsys.Stat(full)
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vss provides a filesystem for scanning a running Windows system that
+// reads files that are exclusively locked by other processes, e.g. registry
+// hives, NTDS.dit or Exchange databases, from a Volume Shadow Copy snapshot of
+// their volume.
+//
+// The snapshot is only created once the first locked file is opened and is
+// deleted when the filesystem is closed. All other files and directory
+// listings are read from the live system.
+package vss
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/log"
+)
+
+// Snapshot is a shadow copy of a volume.
+type Snapshot struct {
+	// ID of the shadow copy, e.g. "{6C8A5CB1-54A0-4B09-8F57-7B5E3D6E4F3A}".
+	ID string
+	// Volume the shadow copy was created for, e.g. `C:\`.
+	Volume string
+	// Path the files of the shadow copy are accessible at, e.g.
+	// `\\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy3`.
+	Path string
+}
+
+// Snapshotter creates and deletes shadow copies of volumes.
+type Snapshotter interface {
+	Create(volume string) (*Snapshot, error)
+	Delete(s *Snapshot) error
+}
+
+// Config is the configuration of the filesystem.
+type Config struct {
+	// Root of the filesystem on the live system, e.g. `C:\`.
+	Root string
+	// Snapshotter that creates the shadow copies of the root's volume.
+	Snapshotter Snapshotter
+	// IsLocked reports whether an error returned when opening a file on the
+	// live system means that the file is locked by another process.
+	IsLocked func(err error) bool
+}
+
+// DefaultConfig returns the default configuration for scanning the given root,
+// which creates shadow copies through WMI. The Snapshotter is nil on other
+// systems than Windows.
+func DefaultConfig(root string) Config {
+	return Config{
+		Root:        root,
+		Snapshotter: defaultSnapshotter(),
+		IsLocked:    isLocked,
+	}
+}
+
+// FS reads files from the live system and falls back to a shadow copy for
+// locked files.
+type FS struct {
+	root        string
+	live        scalibrfs.FS
+	snapshotter Snapshotter
+	isLocked    func(err error) bool
+
+	mu         sync.Mutex
+	snapshot   *Snapshot
+	snapshotFS scalibrfs.FS
+	// snapshotErr is the error of creating the snapshot. The creation is not
+	// retried after it failed once.
+	snapshotErr error
+	closed      bool
+}
+
+// New returns a filesystem for the given config.
+func New(cfg Config) (*FS, error) {
+	if cfg.Snapshotter == nil {
+		return nil, errors.New("volume shadow copies are only supported on Windows")
+	}
+	if cfg.Root == "" {
+		return nil, errors.New("no root set")
+	}
+	isLocked := cfg.IsLocked
+	if isLocked == nil {
+		isLocked = func(error) bool { return false }
+	}
+	return &FS{
+		root:        cfg.Root,
+		live:        scalibrfs.DirFS(cfg.Root),
+		snapshotter: cfg.Snapshotter,
+		isLocked:    isLocked,
+	}, nil
+}
+
+// ScanRoot returns a scan root for the filesystem.
+func (f *FS) ScanRoot() *scalibrfs.ScanRoot {
+	return &scalibrfs.ScanRoot{FS: f, Path: f.root}
+}
+
+// Open opens the named file on the live system or, if it's locked, in the
+// shadow copy.
+func (f *FS) Open(name string) (fs.File, error) {
+	file, err := f.live.Open(name)
+	if err == nil || !f.isLocked(err) {
+		return file, err
+	}
+	sfs, serr := f.openSnapshot()
+	if serr != nil {
+		return nil, err
+	}
+	return sfs.Open(name)
+}
+
+// ReadDir reads the named directory on the live system.
+func (f *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	return f.live.ReadDir(name)
+}
+
+// Stat returns the FileInfo of the named file on the live system or, if it's
+// locked, in the shadow copy.
+func (f *FS) Stat(name string) (fs.FileInfo, error) {
+	info, err := f.live.Stat(name)
+	if err == nil || !f.isLocked(err) {
+		return info, err
+	}
+	sfs, serr := f.openSnapshot()
+	if serr != nil {
+		return nil, err
+	}
+	return sfs.Stat(name)
+}
+
+// Snapshot returns the shadow copy created for locked files, or nil if none
+// was needed so far.
+func (f *FS) Snapshot() *Snapshot {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.snapshot
+}
+
+// Close deletes the shadow copy if one was created. Locked files can't be
+// opened anymore afterwards.
+func (f *FS) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	if f.snapshot == nil {
+		return nil
+	}
+	err := f.snapshotter.Delete(f.snapshot)
+	f.snapshot = nil
+	f.snapshotFS = nil
+	return err
+}
+
+// openSnapshot returns the filesystem of the shadow copy rooted at the same
+// directory as the live filesystem, creating the shadow copy on first use.
+func (f *FS) openSnapshot() (scalibrfs.FS, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.closed {
+		return nil, fs.ErrClosed
+	}
+	if f.snapshotFS != nil || f.snapshotErr != nil {
+		return f.snapshotFS, f.snapshotErr
+	}
+	volume := filepath.VolumeName(f.root) + string(filepath.Separator)
+	s, err := f.snapshotter.Create(volume)
+	if err != nil {
+		log.Warnf("Failed to create a shadow copy of %s, locked files are skipped: %v", volume, err)
+		f.snapshotErr = err
+		return nil, err
+	}
+	log.Infof("Created shadow copy %s of %s for reading locked files", s.ID, volume)
+	rel := strings.TrimLeft(strings.TrimPrefix(f.root, filepath.VolumeName(f.root)), `\/`)
+	root := s.Path
+	if rel != "" {
+		root = strings.TrimRight(root, `\/`) + string(filepath.Separator) + rel
+	}
+	f.snapshot = s
+	f.snapshotFS = scalibrfs.DirFS(root)
+	return f.snapshotFS, nil
+}
@@ -12,11 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package winproducts
+//go:build !windows
 
-import "github.com/google/osv-scalibr/common/windows/registry"
+package vss
 
-// WindowsFlavorFromRegistry is a noop on Linux.
-func WindowsFlavorFromRegistry(reg registry.Registry) string {
-	return ""
-}
+func defaultSnapshotter() Snapshotter { return nil }
+
+func isLocked(err error) bool { return false }
@@ -0,0 +1,154 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vss_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/osv-scalibr/fs/vss"
+)
+
+type fakeSnapshotter struct {
+	path      string
+	createErr error
+	created   int
+	deleted   int
+}
+
+func (s *fakeSnapshotter) Create(volume string) (*vss.Snapshot, error) {
+	s.created++
+	if s.createErr != nil {
+		return nil, s.createErr
+	}
+	return &vss.Snapshot{ID: "{snapshot}", Volume: volume, Path: s.path}, nil
+}
+
+func (s *fakeSnapshotter) Delete(*vss.Snapshot) error {
+	s.deleted++
+	return nil
+}
+
+// setup returns a live root with the file "live.txt" and a snapshotter whose
+// shadow copy additionally contains "locked.txt". Files missing on the live
+// system are treated as locked.
+func setup(t *testing.T) (vss.Config, *fakeSnapshotter) {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "live.txt"), []byte("live"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	snapshot := t.TempDir()
+	snapshotRoot := filepath.Join(snapshot, root)
+	if err := os.MkdirAll(snapshotRoot, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(snapshotRoot, "locked.txt"), []byte("locked"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeSnapshotter{path: snapshot}
+	cfg := vss.Config{
+		Root:        root,
+		Snapshotter: s,
+		IsLocked:    func(err error) bool { return errors.Is(err, fs.ErrNotExist) },
+	}
+	return cfg, s
+}
+
+func readFile(t *testing.T, fsys fs.FS, name string) string {
+	t.Helper()
+	f, err := fsys.Open(name)
+	if err != nil {
+		t.Fatalf("Open(%q): %v", name, err)
+	}
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll(%q): %v", name, err)
+	}
+	return string(content)
+}
+
+func TestFS(t *testing.T) {
+	cfg, s := setup(t)
+	fsys, err := vss.New(cfg)
+	if err != nil {
+		t.Fatalf("vss.New(): %v", err)
+	}
+
+	if got := readFile(t, fsys, "live.txt"); got != "live" {
+		t.Errorf("live.txt: got %q, want %q", got, "live")
+	}
+	if s.created != 0 || fsys.Snapshot() != nil {
+		t.Errorf("shadow copy created for unlocked file")
+	}
+
+	if got := readFile(t, fsys, "locked.txt"); got != "locked" {
+		t.Errorf("locked.txt: got %q, want %q", got, "locked")
+	}
+	if _, err := fsys.Stat("locked.txt"); err != nil {
+		t.Errorf("Stat(locked.txt): %v", err)
+	}
+	if s.created != 1 {
+		t.Errorf("shadow copy created %d times, want 1", s.created)
+	}
+	if got := fsys.Snapshot(); got == nil || got.ID != "{snapshot}" {
+		t.Errorf("Snapshot() = %v, want {snapshot}", got)
+	}
+
+	if err := fsys.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+	if s.deleted != 1 {
+		t.Errorf("shadow copy deleted %d times, want 1", s.deleted)
+	}
+	if _, err := fsys.Open("locked.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Open(locked.txt) after Close: got error %v, want the live error", err)
+	}
+}
+
+func TestFS_CreateFails(t *testing.T) {
+	cfg, s := setup(t)
+	s.createErr = errors.New("access denied")
+	fsys, err := vss.New(cfg)
+	if err != nil {
+		t.Fatalf("vss.New(): %v", err)
+	}
+
+	for range 2 {
+		if _, err := fsys.Open("locked.txt"); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Open(locked.txt): got error %v, want the live error", err)
+		}
+	}
+	if s.created != 1 {
+		t.Errorf("shadow copy creation tried %d times, want 1", s.created)
+	}
+	if err := fsys.Close(); err != nil {
+		t.Errorf("Close(): %v", err)
+	}
+	if s.deleted != 0 {
+		t.Errorf("shadow copy deleted %d times, want 0", s.deleted)
+	}
+}
+
+func TestNew_NoSnapshotter(t *testing.T) {
+	if _, err := vss.New(vss.Config{Root: t.TempDir()}); err == nil {
+		t.Errorf("vss.New() without snapshotter succeeded, want error")
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows
+
+package vss
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// wmiTimeout is the maximum time creating or deleting a shadow copy may take.
+const wmiTimeout = 5 * time.Minute
+
+// createScript creates a client-accessible shadow copy of the volume in $args[0]
+// and prints its ID and device path. Requires admin rights.
+const createScript = `$r = Invoke-CimMethod -ClassName Win32_ShadowCopy -MethodName Create -Arguments @{Volume=$args[0]; Context='ClientAccessible'}
+if ($r.ReturnValue -ne 0) { [Console]::Error.WriteLine("Win32_ShadowCopy.Create returned $($r.ReturnValue)"); exit 1 }
+$s = Get-CimInstance -ClassName Win32_ShadowCopy -Filter "ID='$($r.ShadowID)'"
+Write-Output $s.ID
+Write-Output $s.DeviceObject`
+
+// deleteScript deletes the shadow copy with the ID in $args[0].
+const deleteScript = `Get-CimInstance -ClassName Win32_ShadowCopy -Filter "ID='$($args[0])'" | Remove-CimInstance`
+
+// wmiSnapshotter manages shadow copies through the Win32_ShadowCopy WMI class.
+type wmiSnapshotter struct{}
+
+func defaultSnapshotter() Snapshotter { return wmiSnapshotter{} }
+
+func (wmiSnapshotter) Create(volume string) (*Snapshot, error) {
+	out, err := powershell(createScript, volume)
+	if err != nil {
+		return nil, err
+	}
+	id, path, ok := strings.Cut(strings.TrimSpace(out), "\n")
+	if !ok {
+		return nil, fmt.Errorf("unexpected output of Win32_ShadowCopy.Create: %q", out)
+	}
+	return &Snapshot{
+		ID:     strings.TrimSpace(id),
+		Volume: volume,
+		Path:   strings.TrimSpace(path),
+	}, nil
+}
+
+func (wmiSnapshotter) Delete(s *Snapshot) error {
+	_, err := powershell(deleteScript, s.ID)
+	return err
+}
+
+func powershell(script string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), wmiTimeout)
+	defer cancel()
+	cmdArgs := append([]string{"-NoProfile", "-NonInteractive", "-Command", "& {" + script + "}"}, args...)
+	out, err := exec.CommandContext(ctx, "powershell.exe", cmdArgs...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf("powershell: %w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("powershell: %w", err)
+	}
+	return string(out), nil
+}
+
+// isLocked returns whether the file couldn't be opened because another process
+// holds it open without sharing it.
+func isLocked(err error) bool {
+	return errors.Is(err, windows.ERROR_SHARING_VIOLATION) || errors.Is(err, windows.ERROR_LOCK_VIOLATION)
+}
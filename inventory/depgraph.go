@@ -0,0 +1,134 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"slices"
+
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// DependencyGraph is the graph of the dependencies between the packages
+// found by lockfile extractors, built from the dependency edges the
+// extractors record on the Inventories.
+type DependencyGraph struct {
+	// The packages the scanned projects depend on directly. For lockfiles that
+	// don't record the direct dependencies of the project, these are the
+	// packages no other package of the lockfile depends on.
+	Roots []*extractor.Inventory
+	// The packages each package depends on directly.
+	Edges map[*extractor.Inventory][]*extractor.Inventory
+
+	// Packages by lockfile and name, to resolve dependencies with.
+	byName map[lockfileKey]map[string][]*extractor.Inventory
+}
+
+// lockfileKey identifies the packages found by an extractor in a file. The
+// dependencies of a package only refer to packages in the same lockfile.
+type lockfileKey struct {
+	extractor string
+	location  string
+}
+
+func keyOf(i *extractor.Inventory) lockfileKey {
+	k := lockfileKey{}
+	if i.Extractor != nil {
+		k.extractor = i.Extractor.Name()
+	}
+	if len(i.Locations) > 0 {
+		k.location = i.Locations[0]
+	}
+	return k
+}
+
+// NewDependencyGraph builds the dependency graph of the inventory. Packages
+// without dependency information are only part of the graph if another
+// package depends on them.
+func NewDependencyGraph(inv []*extractor.Inventory) *DependencyGraph {
+	g := &DependencyGraph{
+		Edges:  map[*extractor.Inventory][]*extractor.Inventory{},
+		byName: map[lockfileKey]map[string][]*extractor.Inventory{},
+	}
+	for _, i := range inv {
+		k := keyOf(i)
+		if g.byName[k] == nil {
+			g.byName[k] = map[string][]*extractor.Inventory{}
+		}
+		g.byName[k][i.Name] = append(g.byName[k][i.Name], i)
+	}
+
+	hasDirect := map[lockfileKey]bool{}
+	hasDependents := map[*extractor.Inventory]bool{}
+	hasDependencies := map[lockfileKey]bool{}
+	for _, i := range inv {
+		if i.DirectDependency {
+			hasDirect[keyOf(i)] = true
+		}
+		for _, d := range i.Dependencies {
+			dep := g.Resolve(i, d)
+			if dep == nil || dep == i || slices.Contains(g.Edges[i], dep) {
+				continue
+			}
+			g.Edges[i] = append(g.Edges[i], dep)
+			hasDependents[dep] = true
+			hasDependencies[keyOf(i)] = true
+		}
+	}
+
+	for _, i := range inv {
+		k := keyOf(i)
+		if i.DirectDependency || (!hasDirect[k] && hasDependencies[k] && !hasDependents[i]) {
+			g.Roots = append(g.Roots, i)
+		}
+	}
+	return g
+}
+
+// Resolve returns the package that the dependency d of the package from
+// refers to, or nil if it's not in the inventory. Dependencies without a
+// version resolve to the first package with the name.
+func (g *DependencyGraph) Resolve(from *extractor.Inventory, d extractor.Dependency) *extractor.Inventory {
+	for _, i := range g.byName[keyOf(from)][d.Name] {
+		if d.Version == "" || i.Version == d.Version {
+			return i
+		}
+	}
+	return nil
+}
+
+// Reachable returns the packages that can be reached from the given packages
+// by following dependency edges, including the packages themselves, in
+// breadth-first order. If no packages are given, the roots of the graph are
+// used.
+func (g *DependencyGraph) Reachable(from ...*extractor.Inventory) []*extractor.Inventory {
+	if len(from) == 0 {
+		from = g.Roots
+	}
+
+	var result []*extractor.Inventory
+	seen := map[*extractor.Inventory]bool{}
+	queue := append([]*extractor.Inventory{}, from...)
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+		if seen[i] {
+			continue
+		}
+		seen[i] = true
+		result = append(result, i)
+		queue = append(queue, g.Edges[i]...)
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/javascript/packagelockjson"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/poetrylock"
+	"github.com/google/osv-scalibr/inventory"
+)
+
+// npmPackage returns a package found in the package-lock.json of a project.
+func npmPackage(name, version string, direct bool, deps ...extractor.Dependency) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:             name,
+		Version:          version,
+		Locations:        []string{"app/package-lock.json"},
+		Extractor:        packagelockjson.Extractor{},
+		Dependencies:     deps,
+		DirectDependency: direct,
+	}
+}
+
+func poetryPackage(name, version string, deps ...extractor.Dependency) *extractor.Inventory {
+	return &extractor.Inventory{
+		Name:         name,
+		Version:      version,
+		Locations:    []string{"app/poetry.lock"},
+		Extractor:    poetrylock.Extractor{},
+		Dependencies: deps,
+	}
+}
+
+func names(inv []*extractor.Inventory) []string {
+	var result []string
+	for _, i := range inv {
+		result = append(result, i.Name+"@"+i.Version)
+	}
+	return result
+}
+
+func TestNewDependencyGraph(t *testing.T) {
+	chalk := npmPackage("chalk", "2.4.2", true,
+		extractor.Dependency{Name: "supports-color", Version: "5.5.0"},
+		extractor.Dependency{Name: "has-flag", Version: "3.0.0"},
+		// Not in the lockfile.
+		extractor.Dependency{Name: "escape-string-regexp", Version: "1.0.5"},
+	)
+	supportsColor5 := npmPackage("supports-color", "5.5.0", false,
+		extractor.Dependency{Name: "has-flag", Version: "3.0.0"},
+	)
+	hasFlag3 := npmPackage("has-flag", "3.0.0", false)
+	supportsColor7 := npmPackage("supports-color", "7.2.0", true,
+		extractor.Dependency{Name: "has-flag", Version: "4.0.0"},
+	)
+	hasFlag4 := npmPackage("has-flag", "4.0.0", false)
+
+	// poetry.lock doesn't record the direct dependencies of the project.
+	pandas := poetryPackage("pandas", "2.2.3", extractor.Dependency{Name: "numpy", Version: "2.1.3"})
+	numpy := poetryPackage("numpy", "2.1.3")
+	// Same name and version as the npm package, but in a different lockfile.
+	otherHasFlag := &extractor.Inventory{
+		Name:      "has-flag",
+		Version:   "3.0.0",
+		Locations: []string{"other/package-lock.json"},
+		Extractor: packagelockjson.Extractor{},
+	}
+
+	inv := []*extractor.Inventory{chalk, supportsColor5, hasFlag3, supportsColor7, hasFlag4, pandas, numpy, otherHasFlag}
+	g := inventory.NewDependencyGraph(inv)
+
+	if diff := cmp.Diff([]string{"chalk@2.4.2", "supports-color@7.2.0", "pandas@2.2.3"}, names(g.Roots)); diff != "" {
+		t.Errorf("NewDependencyGraph() roots diff (-want +got):\n%s", diff)
+	}
+
+	wantEdges := map[string][]string{
+		"chalk@2.4.2":          {"supports-color@5.5.0", "has-flag@3.0.0"},
+		"supports-color@5.5.0": {"has-flag@3.0.0"},
+		"supports-color@7.2.0": {"has-flag@4.0.0"},
+		"pandas@2.2.3":         {"numpy@2.1.3"},
+	}
+	gotEdges := map[string][]string{}
+	for from, to := range g.Edges {
+		gotEdges[from.Name+"@"+from.Version] = names(to)
+	}
+	if diff := cmp.Diff(wantEdges, gotEdges); diff != "" {
+		t.Errorf("NewDependencyGraph() edges diff (-want +got):\n%s", diff)
+	}
+
+	if got := g.Resolve(supportsColor5, extractor.Dependency{Name: "has-flag", Version: "3.0.0"}); got != hasFlag3 {
+		t.Errorf("Resolve(has-flag@3.0.0) = %v, want the package from the same lockfile", got)
+	}
+	if got := g.Resolve(chalk, extractor.Dependency{Name: "has-flag"}); got == nil || got.Name != "has-flag" {
+		t.Errorf("Resolve(has-flag) = %v, want a has-flag package", got)
+	}
+	if got := g.Resolve(chalk, extractor.Dependency{Name: "escape-string-regexp", Version: "1.0.5"}); got != nil {
+		t.Errorf("Resolve(escape-string-regexp@1.0.5) = %v, want nil", got)
+	}
+}
+
+func TestDependencyGraph_Reachable(t *testing.T) {
+	a := npmPackage("a", "1.0.0", true, extractor.Dependency{Name: "b", Version: "1.0.0"})
+	b := npmPackage("b", "1.0.0", false, extractor.Dependency{Name: "c", Version: "1.0.0"})
+	c := npmPackage("c", "1.0.0", false, extractor.Dependency{Name: "a", Version: "1.0.0"})
+	unused := npmPackage("unused", "1.0.0", false)
+	g := inventory.NewDependencyGraph([]*extractor.Inventory{a, b, c, unused})
+
+	tests := []struct {
+		name string
+		from []*extractor.Inventory
+		want []string
+	}{
+		{
+			name: "from roots",
+			want: []string{"a@1.0.0", "b@1.0.0", "c@1.0.0"},
+		},
+		{
+			name: "from package",
+			from: []*extractor.Inventory{c},
+			want: []string{"c@1.0.0", "a@1.0.0", "b@1.0.0"},
+		},
+		{
+			name: "from package without dependencies",
+			from: []*extractor.Inventory{unused},
+			want: []string{"unused@1.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := names(g.Reachable(tt.from...))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Reachable() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package license detects the licenses of the packages found by extractors and
+// stores them as SPDX license expressions in the Licenses of the inventory.
+//
+// Licenses are read from package metadata, e.g. the License of APK and RPM
+// packages, the license field of package.json files, the core metadata of
+// Python packages and the copyright files of Debian packages. The license
+// files installed with a package, e.g. LICENSE or COPYING, are matched
+// against the texts of common licenses. License files are only looked for
+// next to the metadata files of packages that are installed into a directory
+// of their own, e.g. node_modules/foo/package.json or Python .dist-info
+// directories, so that the license of a project isn't attributed to the
+// dependencies listed in its lockfiles.
+package license
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+const (
+	// defaultMaxFileSizeBytes is the default maximum size of the license and
+	// metadata files that are read.
+	defaultMaxFileSizeBytes = 1 << 20
+
+	// dpkgDocDir holds the copyright files of Debian packages in a directory
+	// per package.
+	dpkgDocDir = "usr/share/doc"
+)
+
+// licenseFilePrefixes are the lowercase prefixes of the names of license
+// files, e.g. LICENSE, LICENSE-MIT.txt or COPYING.LESSER.
+var licenseFilePrefixes = []string{"license", "licence", "copying", "copyright", "unlicense"}
+
+// codeExtensions are file extensions of source files whose name starts with a
+// license file prefix, e.g. license.py.
+var codeExtensions = []string{".go", ".py", ".pyc", ".js", ".ts", ".rb", ".java", ".class", ".c", ".h", ".json", ".yaml", ".yml", ".html", ".xml", ".sh"}
+
+// Config is the configuration for the Detector.
+type Config struct {
+	// MaxFileSizeBytes is the maximum size of a license or metadata file that
+	// is read. Larger files are skipped. If 0, no limit is applied.
+	MaxFileSizeBytes int64
+}
+
+// DefaultConfig returns the default configuration for the license Detector.
+func DefaultConfig() Config {
+	return Config{MaxFileSizeBytes: defaultMaxFileSizeBytes}
+}
+
+// Detector detects the licenses of packages.
+type Detector struct {
+	maxFileSizeBytes int64
+}
+
+// New returns a license Detector.
+//
+// For most use cases, initialize with:
+// ```
+// d := New(DefaultConfig())
+// ```
+func New(cfg Config) *Detector {
+	return &Detector{maxFileSizeBytes: cfg.MaxFileSizeBytes}
+}
+
+// Run adds the licenses found for each of the inventories to its Licenses.
+// The locations of the inventories are resolved relative to root. Files that
+// can't be read are skipped, so an error is only returned if ctx is done.
+func (d *Detector) Run(ctx context.Context, root *scalibrfs.ScanRoot, inventory []*extractor.Inventory) error {
+	for _, inv := range inventory {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for _, l := range d.licenses(root, inv) {
+			if l != "" && !slices.Contains(inv.Licenses, l) {
+				inv.Licenses = append(inv.Licenses, l)
+			}
+		}
+	}
+	return nil
+}
+
+// licenses returns the licenses of the package declared in its metadata,
+// followed by the licenses of its license files.
+func (d *Detector) licenses(root *scalibrfs.ScanRoot, inv *extractor.Inventory) []string {
+	var licenses []string
+	switch m := inv.Metadata.(type) {
+	case *apk.Metadata:
+		licenses = append(licenses, Normalize(m.License))
+	case *rpm.Metadata:
+		licenses = append(licenses, Normalize(m.License))
+	case *dpkg.Metadata:
+		licenses = append(licenses, d.dpkgLicenses(root, m.PackageName)...)
+	}
+	if len(inv.Locations) == 0 {
+		return licenses
+	}
+	loc := relativePath(root, inv.Locations[0])
+	switch base := path.Base(loc); {
+	case base == "package.json":
+		licenses = append(licenses, d.packageJSONLicenses(root, loc)...)
+	case (base == "METADATA" || base == "PKG-INFO") && isPythonMetadataDir(path.Dir(loc)):
+		licenses = append(licenses, d.pythonLicenses(root, loc)...)
+		// Core metadata 2.4 moved the license files into a subdirectory.
+		licenses = append(licenses, d.licenseFileLicenses(root, path.Join(path.Dir(loc), "licenses"))...)
+	default:
+		return licenses
+	}
+	return append(licenses, d.licenseFileLicenses(root, path.Dir(loc))...)
+}
+
+// relativePath returns the slash-separated path of loc relative to the scan
+// root, since locations are absolute if the scan stores absolute paths.
+func relativePath(root *scalibrfs.ScanRoot, loc string) string {
+	if root.Path != "" && filepath.IsAbs(loc) {
+		if rel, err := filepath.Rel(root.Path, loc); err == nil {
+			loc = rel
+		}
+	}
+	return strings.TrimPrefix(filepath.ToSlash(loc), "/")
+}
+
+func isPythonMetadataDir(dir string) bool {
+	return strings.HasSuffix(dir, ".dist-info") || strings.HasSuffix(dir, ".egg-info")
+}
+
+// readFile reads the file at p of the scan root, or returns nil if it doesn't
+// exist, can't be read or is larger than the size limit.
+func (d *Detector) readFile(root *scalibrfs.ScanRoot, p string) []byte {
+	f, err := root.FS.Open(p)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		return nil
+	}
+	if d.maxFileSizeBytes > 0 && info.Size() > d.maxFileSizeBytes {
+		return nil
+	}
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil
+	}
+	return content
+}
+
+// licenseFileLicenses returns the licenses of the license files in dir.
+func (d *Detector) licenseFileLicenses(root *scalibrfs.ScanRoot, dir string) []string {
+	entries, err := root.FS.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var licenses []string
+	for _, e := range entries {
+		if e.IsDir() || !isLicenseFile(e.Name()) {
+			continue
+		}
+		if content := d.readFile(root, path.Join(dir, e.Name())); content != nil {
+			licenses = append(licenses, Match(content)...)
+		}
+	}
+	return licenses
+}
+
+func isLicenseFile(name string) bool {
+	lower := strings.ToLower(name)
+	if slices.Contains(codeExtensions, path.Ext(lower)) {
+		return false
+	}
+	for _, p := range licenseFilePrefixes {
+		if strings.HasPrefix(lower, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// packageJSONLicenses returns the licenses of the license field of a
+// package.json file, or of the licenses list used by old npm packages.
+func (d *Detector) packageJSONLicenses(root *scalibrfs.ScanRoot, p string) []string {
+	content := d.readFile(root, p)
+	if content == nil {
+		return nil
+	}
+	var pkg struct {
+		License  json.RawMessage   `json:"license"`
+		Licenses []json.RawMessage `json:"licenses"`
+	}
+	if err := json.Unmarshal(content, &pkg); err != nil {
+		return nil
+	}
+	var licenses []string
+	for _, raw := range append([]json.RawMessage{pkg.License}, pkg.Licenses...) {
+		if len(raw) == 0 {
+			continue
+		}
+		// Either an SPDX expression or an object with a type.
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			var obj struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal(raw, &obj); err != nil {
+				continue
+			}
+			s = obj.Type
+		}
+		licenses = append(licenses, Normalize(s))
+	}
+	return licenses
+}
+
+// pythonLicenses returns the licenses declared in the core metadata of a
+// Python package: the License-Expression, or the License and the license
+// classifiers of packages built by older tools.
+func (d *Detector) pythonLicenses(root *scalibrfs.ScanRoot, p string) []string {
+	content := d.readFile(root, p)
+	if content == nil {
+		return nil
+	}
+	var licenses []string
+	s := bufio.NewScanner(bytes.NewReader(content))
+	s.Buffer(nil, len(content)+1)
+	for s.Scan() {
+		line := s.Text()
+		if line == "" {
+			// The headers end at the first empty line, followed by the
+			// description.
+			break
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(key) {
+		case "license-expression":
+			return []string{Normalize(value)}
+		case "license":
+			// Some packages put the whole license text into the header,
+			// continued on indented lines.
+			if len(value) > 100 {
+				licenses = append(licenses, Match(content)...)
+			} else {
+				licenses = append(licenses, Normalize(value))
+			}
+		case "classifier":
+			licenses = append(licenses, classifierLicense(value))
+		}
+	}
+	return licenses
+}
+
+// classifierLicense returns the license of a Trove classifier such as
+// "License :: OSI Approved :: MIT License".
+func classifierLicense(classifier string) string {
+	parts := strings.Split(classifier, "::")
+	if len(parts) < 2 || strings.TrimSpace(parts[0]) != "License" {
+		return ""
+	}
+	name := strings.TrimSpace(parts[len(parts)-1])
+	if name == "OSI Approved" {
+		return ""
+	}
+	// Drop abbreviations, e.g. of "GNU General Public License v3 (GPLv3)".
+	if i := strings.Index(name, " ("); i > 0 {
+		name = name[:i]
+	}
+	return Normalize(name)
+}
+
+// dpkgLicenses returns the licenses in the copyright file of a Debian package.
+// Copyright files in the machine-readable format list the licenses of the
+// files of the package in License fields, other copyright files are matched
+// against the license texts.
+func (d *Detector) dpkgLicenses(root *scalibrfs.ScanRoot, pkg string) []string {
+	if pkg == "" {
+		return nil
+	}
+	content := d.readFile(root, path.Join(dpkgDocDir, pkg, "copyright"))
+	if content == nil {
+		return nil
+	}
+	var licenses []string
+	s := bufio.NewScanner(bytes.NewReader(content))
+	for s.Scan() {
+		if value, ok := strings.CutPrefix(s.Text(), "License:"); ok {
+			licenses = append(licenses, Normalize(value))
+		}
+	}
+	if len(licenses) == 0 {
+		return Match(content)
+	}
+	return licenses
+}
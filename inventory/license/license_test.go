@@ -0,0 +1,266 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/apk"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/dpkg"
+	"github.com/google/osv-scalibr/extractor/filesystem/os/rpm"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/inventory/license"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		declared string
+		want     string
+	}{
+		{declared: "MIT", want: "MIT"},
+		{declared: "mit", want: "MIT"},
+		{declared: "MIT License", want: "MIT"},
+		{declared: "Apache 2.0", want: "Apache-2.0"},
+		{declared: "Apache License, Version 2.0", want: "Apache-2.0"},
+		{declared: "ASL 2.0", want: "Apache-2.0"},
+		{declared: "BSD 3-Clause", want: "BSD-3-Clause"},
+		{declared: "GPLv2+", want: "GPL-2.0-or-later"},
+		{declared: "GPL-2.0", want: "GPL-2.0-only"},
+		{declared: "GPL-2+", want: "GPL-2.0-or-later"},
+		{declared: "LGPLv2.1", want: "LGPL-2.1-only"},
+		{declared: "GNU General Public License v3 or later", want: "GPL-3.0-or-later"},
+		{declared: "GPL version 2 or any later version", want: "GPL-2.0-or-later"},
+		{declared: "GPLv2+ and LGPLv2+", want: "GPL-2.0-or-later AND LGPL-2.0-or-later"},
+		{declared: "(MIT OR Apache-2.0) AND Unicode-DFS-2016", want: "(MIT OR Apache-2.0) AND Unicode-DFS-2016"},
+		{declared: "GPL-2.0-or-later WITH Classpath-exception-2.0", want: "GPL-2.0-or-later WITH Classpath-exception-2.0"},
+		{declared: "MPL-1.1+", want: "MPL-1.1+"},
+		{declared: "Artistic or GPL-1+", want: "Artistic-1.0 OR GPL-1.0-or-later"},
+		{declared: "Public Domain", want: "LicenseRef-Public-Domain"},
+		{declared: "UNLICENSED", want: "LicenseRef-UNLICENSED"},
+		{declared: "The Unlicense", want: "Unlicense"},
+		{declared: "MIT and", want: "MIT"},
+		{declared: "UNKNOWN", want: ""},
+		{declared: "SEE LICENSE IN LICENSE.md", want: ""},
+		{declared: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.declared, func(t *testing.T) {
+			if got := license.Normalize(tt.declared); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.declared, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		file string
+		want []string
+	}{
+		{file: "MIT", want: []string{"MIT"}},
+		{file: "Apache-2.0", want: []string{"Apache-2.0"}},
+		{file: "BSD-3-Clause", want: []string{"BSD-3-Clause"}},
+		{file: "BSD-2-Clause", want: []string{"BSD-2-Clause"}},
+		{file: "ISC", want: []string{"ISC"}},
+		{file: "GPL-2.0", want: []string{"GPL-2.0-only"}},
+		{file: "LGPL-2.0", want: []string{"LGPL-2.0-only"}},
+		{file: "MPL-2.0-header", want: []string{"MPL-2.0"}},
+		{file: "dual", want: []string{"MIT", "Unlicense"}},
+		{file: "unknown", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			text, err := os.ReadFile(filepath.Join("testdata/texts", tt.file))
+			if err != nil {
+				t.Fatalf("os.ReadFile(%s): %v", tt.file, err)
+			}
+			if diff := cmp.Diff(tt.want, license.Match(text)); diff != "" {
+				t.Errorf("Match(%s) diff (-want +got):\n%s", tt.file, diff)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		desc      string
+		inventory *extractor.Inventory
+		want      []string
+	}{
+		{
+			desc: "package.json license and license file",
+			inventory: &extractor.Inventory{
+				Name:      "left-pad",
+				Locations: []string{"node_modules/left-pad/package.json"},
+			},
+			want: []string{"WTFPL", "MIT"},
+		},
+		{
+			desc: "package.json licenses list",
+			inventory: &extractor.Inventory{
+				Name:      "old-pkg",
+				Locations: []string{"node_modules/old-pkg/package.json"},
+			},
+			want: []string{"MIT", "Apache-2.0"},
+		},
+		{
+			desc: "Python license and classifier",
+			inventory: &extractor.Inventory{
+				Name:      "requests",
+				Locations: []string{"usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA"},
+			},
+			want: []string{"Apache-2.0"},
+		},
+		{
+			desc: "Python license expression",
+			inventory: &extractor.Inventory{
+				Name:      "attrs",
+				Locations: []string{"usr/lib/python3/dist-packages/attrs-23.1.0.dist-info/METADATA"},
+			},
+			want: []string{"MIT"},
+		},
+		{
+			desc: "machine-readable Debian copyright file",
+			inventory: &extractor.Inventory{
+				Name:      "libc6",
+				Locations: []string{"var/lib/dpkg/status"},
+				Metadata:  &dpkg.Metadata{PackageName: "libc6"},
+			},
+			want: []string{"LGPL-2.1-or-later", "GPL-2.0-or-later"},
+		},
+		{
+			desc: "free-form Debian copyright file",
+			inventory: &extractor.Inventory{
+				Name:      "zlib1g",
+				Locations: []string{"var/lib/dpkg/status"},
+				Metadata:  &dpkg.Metadata{PackageName: "zlib1g"},
+			},
+			want: []string{"Zlib"},
+		},
+		{
+			desc: "Debian package without copyright file",
+			inventory: &extractor.Inventory{
+				Name:      "base-files",
+				Locations: []string{"var/lib/dpkg/status"},
+				Metadata:  &dpkg.Metadata{PackageName: "base-files"},
+			},
+			want: nil,
+		},
+		{
+			desc: "APK license",
+			inventory: &extractor.Inventory{
+				Name:      "musl",
+				Locations: []string{"lib/apk/db/installed"},
+				Metadata:  &apk.Metadata{License: "MIT AND BSD-2-Clause"},
+			},
+			want: []string{"MIT AND BSD-2-Clause"},
+		},
+		{
+			desc: "RPM license",
+			inventory: &extractor.Inventory{
+				Name:      "bash",
+				Locations: []string{"var/lib/rpm/Packages"},
+				Metadata:  &rpm.Metadata{License: "GPLv3+"},
+			},
+			want: []string{"GPL-3.0-or-later"},
+		},
+		{
+			desc: "project license not attributed to lockfile dependencies",
+			inventory: &extractor.Inventory{
+				Name:      "lodash",
+				Locations: []string{"project/package-lock.json"},
+			},
+			want: nil,
+		},
+		{
+			desc: "licenses set by the extractor are kept",
+			inventory: &extractor.Inventory{
+				Name:      "left-pad",
+				Locations: []string{"node_modules/left-pad/package.json"},
+				Licenses:  []string{"MIT"},
+			},
+			want: []string{"MIT", "WTFPL"},
+		},
+	}
+
+	root := &scalibrfs.ScanRoot{FS: scalibrfs.DirFS("testdata/root")}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			d := license.New(license.DefaultConfig())
+			if err := d.Run(context.Background(), root, []*extractor.Inventory{tt.inventory}); err != nil {
+				t.Fatalf("Run(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, tt.inventory.Licenses); diff != "" {
+				t.Errorf("Run() licenses diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRun_AbsoluteLocations(t *testing.T) {
+	path, err := filepath.Abs("testdata/root")
+	if err != nil {
+		t.Fatalf("filepath.Abs(): %v", err)
+	}
+	root := &scalibrfs.ScanRoot{FS: scalibrfs.DirFS(path), Path: path}
+	inv := &extractor.Inventory{
+		Name:      "left-pad",
+		Locations: []string{filepath.Join(path, "node_modules/left-pad/package.json")},
+	}
+
+	if err := license.New(license.DefaultConfig()).Run(context.Background(), root, []*extractor.Inventory{inv}); err != nil {
+		t.Fatalf("Run(): %v", err)
+	}
+	want := []string{"WTFPL", "MIT"}
+	if diff := cmp.Diff(want, inv.Licenses); diff != "" {
+		t.Errorf("Run() licenses diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRun_SizeLimit(t *testing.T) {
+	root := &scalibrfs.ScanRoot{FS: scalibrfs.DirFS("testdata/root")}
+	inv := &extractor.Inventory{
+		Name:      "left-pad",
+		Locations: []string{"node_modules/left-pad/package.json"},
+	}
+
+	d := license.New(license.Config{MaxFileSizeBytes: 100})
+	if err := d.Run(context.Background(), root, []*extractor.Inventory{inv}); err != nil {
+		t.Fatalf("Run(): %v", err)
+	}
+	// The LICENSE file is larger than the limit.
+	want := []string{"WTFPL"}
+	if diff := cmp.Diff(want, inv.Licenses); diff != "" {
+		t.Errorf("Run() licenses diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRun_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	root := &scalibrfs.ScanRoot{FS: scalibrfs.DirFS("testdata/root")}
+	inv := &extractor.Inventory{Name: "left-pad", Locations: []string{"node_modules/left-pad/package.json"}}
+
+	if err := license.New(license.DefaultConfig()).Run(ctx, root, []*extractor.Inventory{inv}); err == nil {
+		t.Errorf("Run() with cancelled context: got nil error, want error")
+	}
+}
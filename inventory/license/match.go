@@ -0,0 +1,100 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	_ "embed" // Needed for go:embed
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	//go:embed data/licenses.json
+	licensesJSON []byte
+
+	// fingerprints are the phrases identifying the texts of the licenses,
+	// normalized with normalizeText.
+	fingerprints = func() []fingerprint {
+		var fps []fingerprint
+		if err := json.Unmarshal(licensesJSON, &fps); err != nil {
+			panic("invalid license fingerprints: " + err.Error())
+		}
+		for _, fp := range fps {
+			for i, p := range fp.Phrases {
+				fp.Phrases[i] = normalizeText(p)
+			}
+			for i, p := range fp.Excludes {
+				fp.Excludes[i] = normalizeText(p)
+			}
+		}
+		return fps
+	}()
+
+	nonWord = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// fingerprint identifies the text of a license by phrases that are all part of
+// it. Licenses that share the phrases of another license, e.g. BSD-2-Clause
+// and BSD-3-Clause, exclude the phrases that tell them apart.
+type fingerprint struct {
+	ID       string   `json:"id"`
+	Phrases  []string `json:"phrases"`
+	Excludes []string `json:"excludes"`
+}
+
+// normalizeText lowercases the text and reduces it to its words separated by
+// single spaces, so that line breaks, punctuation and comment markers don't
+// affect matching.
+func normalizeText(text string) string {
+	return strings.TrimSpace(nonWord.ReplaceAllString(strings.ToLower(text), " "))
+}
+
+// Match returns the SPDX IDs of the licenses whose texts or standard notices
+// are found in text, e.g. the contents of a LICENSE file. Texts of the GPL
+// family are reported with their -only IDs since the "or later" choice is made
+// in the notices of the licensed files, not in the license text.
+func Match(text []byte) []string {
+	norm := " " + normalizeText(string(text)) + " "
+	var ids []string
+	for _, fp := range fingerprints {
+		if slices.Contains(ids, fp.ID) || !containsAll(norm, fp.Phrases) || containsAny(norm, fp.Excludes) {
+			continue
+		}
+		ids = append(ids, fp.ID)
+	}
+	return ids
+}
+
+// containsAll returns true if the normalized text contains all of the
+// phrases as whole words.
+func containsAll(text string, phrases []string) bool {
+	for _, p := range phrases {
+		if !strings.Contains(text, " "+p+" ") {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(text string, phrases []string) bool {
+	for _, p := range phrases {
+		if strings.Contains(text, " "+p+" ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package license
+
+import (
+	"regexp"
+	"strings"
+)
+
+// spdxIDs are the SPDX license IDs that declared licenses are matched against
+// case-insensitively before looking them up in the aliases.
+var spdxIDs = []string{
+	"0BSD", "AFL-3.0", "AGPL-3.0-only", "AGPL-3.0-or-later", "Apache-1.0",
+	"Apache-1.1", "Apache-2.0", "Artistic-1.0", "Artistic-1.0-Perl",
+	"Artistic-2.0", "BSD-1-Clause", "BSD-2-Clause", "BSD-3-Clause",
+	"BSD-4-Clause", "BSL-1.0", "CC-BY-3.0", "CC-BY-4.0", "CC-BY-SA-4.0",
+	"CC0-1.0", "CDDL-1.0", "CDDL-1.1", "curl", "EPL-1.0", "EPL-2.0", "EUPL-1.2",
+	"GFDL-1.3-only", "GFDL-1.3-or-later", "GPL-1.0-only", "GPL-1.0-or-later",
+	"GPL-2.0-only", "GPL-2.0-or-later", "GPL-3.0-only", "GPL-3.0-or-later",
+	"ISC", "LGPL-2.0-only", "LGPL-2.0-or-later", "LGPL-2.1-only",
+	"LGPL-2.1-or-later", "LGPL-3.0-only", "LGPL-3.0-or-later", "Libpng",
+	"MIT", "MIT-0", "MPL-1.0", "MPL-1.1", "MPL-2.0", "MS-PL", "NCSA",
+	"OFL-1.1", "OpenSSL", "PHP-3.01", "PostgreSQL", "PSF-2.0", "Python-2.0",
+	"Ruby", "Unicode-DFS-2016", "Unlicense", "UPL-1.0", "Vim", "W3C", "WTFPL",
+	"X11", "Zlib", "ZPL-2.1",
+}
+
+// aliases maps the keys returned by aliasKey for common spellings of license
+// names, e.g. "GPLv2+" in RPM headers or "Apache 2.0" in package.json files,
+// to SPDX license IDs.
+var aliases = map[string]string{
+	"mit":           "MIT",
+	"expat":         "MIT",
+	"apache":        "Apache-2.0",
+	"apache1":       "Apache-1.0",
+	"apache11":      "Apache-1.1",
+	"apache2":       "Apache-2.0",
+	"asl2":          "Apache-2.0",
+	"asl11":         "Apache-1.1",
+	"bsd2clause":    "BSD-2-Clause",
+	"2clausebsd":    "BSD-2-Clause",
+	"simplifiedbsd": "BSD-2-Clause",
+	"freebsd":       "BSD-2-Clause",
+	"bsd3clause":    "BSD-3-Clause",
+	"3clausebsd":    "BSD-3-Clause",
+	"newbsd":        "BSD-3-Clause",
+	"modifiedbsd":   "BSD-3-Clause",
+	"revisedbsd":    "BSD-3-Clause",
+	"bsd4clause":    "BSD-4-Clause",
+	"isc":           "ISC",
+	"gpl+":          "GPL-1.0-or-later",
+	"gpl1":          "GPL-1.0-only",
+	"gpl1+":         "GPL-1.0-or-later",
+	"gpl2":          "GPL-2.0-only",
+	"gpl2+":         "GPL-2.0-or-later",
+	"gpl3":          "GPL-3.0-only",
+	"gpl3+":         "GPL-3.0-or-later",
+	"lgpl2":         "LGPL-2.0-only",
+	"lgpl2+":        "LGPL-2.0-or-later",
+	"lgpl21":        "LGPL-2.1-only",
+	"lgpl21+":       "LGPL-2.1-or-later",
+	"lgpl3":         "LGPL-3.0-only",
+	"lgpl3+":        "LGPL-3.0-or-later",
+	"agpl3":         "AGPL-3.0-only",
+	"agpl3+":        "AGPL-3.0-or-later",
+	"mpl1":          "MPL-1.0",
+	"mpl11":         "MPL-1.1",
+	"mpl2":          "MPL-2.0",
+	"epl1":          "EPL-1.0",
+	"epl2":          "EPL-2.0",
+	"cddl1":         "CDDL-1.0",
+	"unlicense":     "Unlicense",
+	"zlib":          "Zlib",
+	"bsl1":          "BSL-1.0",
+	"boost":         "BSL-1.0",
+	"cc0":           "CC0-1.0",
+	"cc01":          "CC0-1.0",
+	"cc01universal": "CC0-1.0",
+	"psf":           "PSF-2.0",
+	"psf2":          "PSF-2.0",
+	"python":        "Python-2.0",
+	"artistic":      "Artistic-1.0",
+	"artistic2":     "Artistic-2.0",
+	"wtfpl":         "WTFPL",
+	"openssl":       "OpenSSL",
+	"ofl11":         "OFL-1.1",
+	"php301":        "PHP-3.01",
+	"ruby":          "Ruby",
+	"postgresql":    "PostgreSQL",
+}
+
+// placeholders are declared licenses that don't name a license.
+var placeholders = map[string]bool{
+	"unknown": true, "none": true, "n/a": true, "na": true, "other": true,
+	"noassertion": true, "undefined": true, "-": true,
+}
+
+var (
+	spdxIDsByKey = func() map[string]string {
+		m := make(map[string]string, len(spdxIDs))
+		for _, id := range spdxIDs {
+			m[strings.ToLower(id)] = id
+		}
+		return m
+	}()
+
+	// Long license names replaced by their abbreviations in aliasKey. The order
+	// matters since e.g. "general public" is part of "lesser general public".
+	longNames = strings.NewReplacer(
+		"gnu lesser general public", "lgpl",
+		"gnu library general public", "lgpl",
+		"lesser general public", "lgpl",
+		"library general public", "lgpl",
+		"gnu affero general public", "agpl",
+		"affero general public", "agpl",
+		"gnu general public", "gpl",
+		"general public", "gpl",
+		"mozilla public", "mpl",
+		"eclipse public", "epl",
+		"apache software", "apache",
+		"boost software", "bsl",
+		"python software foundation", "psf",
+		"common development and distribution", "cddl",
+	)
+	orLater    = regexp.MustCompile(`\s*(?:-or-later|,?\s+or\s+(?:\(at your option\)\s+)?(?:any\s+)?later(?:\s+version)?)`)
+	dotZero    = regexp.MustCompile(`(\d)\.0\b`)
+	noiseWords = regexp.MustCompile(`\b(?:the|licen[cs]e[sd]?|version|only)\b|-only\b`)
+	nonKey     = regexp.MustCompile(`[^a-z0-9+]`)
+	versionV   = regexp.MustCompile(`([a-z])v(\d)`)
+	nonIDChars = regexp.MustCompile(`[^A-Za-z0-9.]+`)
+)
+
+// Normalize converts a declared license, e.g. "GPLv2+ and MIT" from an RPM
+// header or "Apache 2.0" from a package.json file, into an SPDX license
+// expression. Common spellings of licenses are mapped to their SPDX IDs, other
+// license names are turned into LicenseRef- IDs. Returns an empty string for
+// placeholders such as "UNKNOWN" and references to license files.
+func Normalize(declared string) string {
+	declared = strings.TrimSpace(declared)
+	lower := strings.ToLower(declared)
+	if placeholders[lower] || strings.HasPrefix(lower, "see license") {
+		return ""
+	}
+
+	tokens := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ", "&", " and ", "|", " or ").Replace(declared))
+	var out []string
+	var term []string
+	flush := func() {
+		if len(term) > 0 {
+			out = append(out, normalizeTerm(strings.Join(term, " "), len(out) > 0 && out[len(out)-1] == "WITH"))
+			term = nil
+		}
+	}
+	for i, t := range tokens {
+		switch op := strings.ToUpper(t); {
+		case op == "OR" && i+1 < len(tokens) && isLaterKeyword(tokens[i+1:]):
+			// "GPL version 2 or later" is a single license.
+			term = append(term, t)
+		case op == "AND" || op == "OR" || op == "WITH":
+			flush()
+			if len(out) > 0 && out[len(out)-1] != "(" {
+				out = append(out, op)
+			}
+		case t == "(" || t == ")":
+			flush()
+			out = append(out, t)
+		default:
+			term = append(term, t)
+		}
+	}
+	flush()
+	// Drop dangling operators, e.g. of "MIT and".
+	for len(out) > 0 && isOperator(out[len(out)-1]) {
+		out = out[:len(out)-1]
+	}
+	return strings.NewReplacer("( ", "(", " )", ")").Replace(strings.Join(out, " "))
+}
+
+func isLaterKeyword(tokens []string) bool {
+	for _, t := range tokens {
+		switch strings.ToLower(strings.Trim(t, "()")) {
+		case "later":
+			return true
+		case "any", "at", "your", "option":
+			continue
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+func isOperator(s string) bool {
+	return s == "AND" || s == "OR" || s == "WITH"
+}
+
+// normalizeTerm converts a single license name into an SPDX license ID.
+// License exceptions, the terms after WITH, are kept as they are.
+func normalizeTerm(term string, exception bool) string {
+	if exception {
+		return nonIDChars.ReplaceAllString(term, "-")
+	}
+	if id, ok := spdxIDsByKey[strings.ToLower(term)]; ok {
+		return id
+	}
+	key := aliasKey(term)
+	if id, ok := aliases[key]; ok {
+		return id
+	}
+	// SPDX IDs followed by the deprecated + suffix, e.g. "MPL-1.1+".
+	if id, ok := spdxIDsByKey[strings.ToLower(strings.TrimSuffix(term, "+"))]; ok {
+		return id + "+"
+	}
+	return "LicenseRef-" + strings.Trim(nonIDChars.ReplaceAllString(term, "-"), "-")
+}
+
+// aliasKey reduces a license name to a key for the aliases, e.g. "GNU General
+// Public License v2 or later" and "GPL-2.0+" both to "gpl2+".
+func aliasKey(name string) string {
+	key := strings.ToLower(name)
+	key = longNames.Replace(key)
+	key = orLater.ReplaceAllString(key, "+")
+	key = noiseWords.ReplaceAllString(key, "")
+	key = dotZero.ReplaceAllString(key, "$1")
+	key = nonKey.ReplaceAllString(key, "")
+	key = versionV.ReplaceAllString(key, "$1$2")
+	return key
+}
@@ -0,0 +1,264 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inventory reconciles the inventory found by a SCALIBR scan with
+// externally supplied SBOMs, e.g. to check vendor-provided SBOMs against what
+// is actually installed on disk, and builds the dependency graph of the
+// packages found in lockfiles.
+package inventory
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/archive"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/spdx/tools-golang/spdx"
+)
+
+// ErrUnsupportedSBOM is returned for SBOM documents of an unsupported type.
+var ErrUnsupportedSBOM = errors.New("unsupported SBOM document type")
+
+// Status describes on which side of the merge a package was found.
+type Status string
+
+// Status values.
+const (
+	// Corroborated packages are declared in the SBOM and were found by the scan.
+	Corroborated Status = "corroborated"
+	// OnlyDeclared packages are declared in the SBOM but weren't found by the
+	// scan.
+	OnlyDeclared Status = "only-declared"
+	// OnlyObserved packages were found by the scan but aren't declared in the
+	// SBOM.
+	OnlyObserved Status = "only-observed"
+)
+
+// MatchType describes how a scanned package was matched to an SBOM package.
+type MatchType string
+
+// MatchType values.
+const (
+	MatchNone MatchType = ""
+	MatchPURL MatchType = "purl"
+	MatchHash MatchType = "hash"
+)
+
+// DeclaredPackage is a package listed in an SBOM.
+type DeclaredPackage struct {
+	// The SPDX identifier or CycloneDX bom-ref of the package.
+	Ref     string
+	Name    string
+	Version string
+	PURL    *purl.PackageURL
+	// Checksums as algorithm (e.g. "SHA1") -> lowercase hex digest.
+	Hashes map[string]string
+}
+
+// MergedPackage is a package of the merged inventory.
+type MergedPackage struct {
+	Status Status
+	// How the scanned package was matched, only set for Corroborated packages.
+	MatchedBy MatchType
+	// The scanned package, nil for OnlyDeclared packages.
+	Inventory *extractor.Inventory
+	// The SBOM package, nil for OnlyObserved packages.
+	Declared *DeclaredPackage
+}
+
+// MergeResult is the result of merging scan results with an SBOM.
+type MergeResult struct {
+	// The scanned packages in the order of the input inventory, followed by the
+	// packages only declared in the SBOM in document order.
+	Packages []*MergedPackage
+}
+
+// Count returns the number of packages with the given status.
+func (r *MergeResult) Count(s Status) int {
+	n := 0
+	for _, p := range r.Packages {
+		if p.Status == s {
+			n++
+		}
+	}
+	return n
+}
+
+// MergeWithSBOM reconciles the scanned inventory inv with sbomDoc, an SPDX
+// (*spdx.Document) or CycloneDX (*cyclonedx.BOM) document.
+//
+// Packages are matched by PURL, ignoring qualifiers and subpaths as these
+// differ between tools, and otherwise by file hash. A declared package can
+// corroborate several scanned packages, e.g. the same library installed in
+// multiple locations.
+func MergeWithSBOM(inv []*extractor.Inventory, sbomDoc any) (*MergeResult, error) {
+	var declared []*DeclaredPackage
+	switch d := sbomDoc.(type) {
+	case *spdx.Document:
+		declared = fromSPDX(d)
+	case *cyclonedx.BOM:
+		declared = fromCDX(d)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedSBOM, sbomDoc)
+	}
+
+	byPURL := make(map[string][]*DeclaredPackage)
+	byHash := make(map[string][]*DeclaredPackage)
+	for _, d := range declared {
+		if d.PURL != nil {
+			k := purlKey(d.PURL)
+			byPURL[k] = append(byPURL[k], d)
+		}
+		for alg, h := range d.Hashes {
+			byHash[alg+":"+h] = append(byHash[alg+":"+h], d)
+		}
+	}
+
+	result := &MergeResult{}
+	matched := make(map[*DeclaredPackage]bool)
+	for _, i := range inv {
+		p := &MergedPackage{Status: OnlyObserved, Inventory: i}
+		if d := first(byPURL[observedPURLKey(i)]); d != nil {
+			p.Status, p.MatchedBy, p.Declared = Corroborated, MatchPURL, d
+		} else {
+			for alg, h := range observedHashes(i) {
+				if d := first(byHash[alg+":"+h]); d != nil {
+					p.Status, p.MatchedBy, p.Declared = Corroborated, MatchHash, d
+					break
+				}
+			}
+		}
+		if p.Declared != nil {
+			matched[p.Declared] = true
+		}
+		result.Packages = append(result.Packages, p)
+	}
+	for _, d := range declared {
+		if !matched[d] {
+			result.Packages = append(result.Packages, &MergedPackage{Status: OnlyDeclared, Declared: d})
+		}
+	}
+	return result, nil
+}
+
+func first(ds []*DeclaredPackage) *DeclaredPackage {
+	if len(ds) == 0 {
+		return nil
+	}
+	return ds[0]
+}
+
+// purlKey identifies a package by the PURL type, namespace, name and version.
+func purlKey(p *purl.PackageURL) string {
+	return strings.Join([]string{
+		strings.ToLower(p.Type),
+		strings.ToLower(p.Namespace),
+		strings.ToLower(p.Name),
+		p.Version,
+	}, "/")
+}
+
+func observedPURLKey(i *extractor.Inventory) string {
+	if i.Extractor == nil {
+		return ""
+	}
+	p := i.Extractor.ToPURL(i)
+	if p == nil {
+		return ""
+	}
+	return purlKey(p)
+}
+
+// observedHashes returns the file hashes known for a scanned package.
+func observedHashes(i *extractor.Inventory) map[string]string {
+	m, ok := i.Metadata.(*archive.Metadata)
+	if !ok || m.SHA1 == "" {
+		return nil
+	}
+	// The archive extractor stores base64 encoded hashes.
+	h, err := base64.StdEncoding.DecodeString(m.SHA1)
+	if err != nil {
+		return nil
+	}
+	return map[string]string{"SHA1": hex.EncodeToString(h)}
+}
+
+// hashAlgorithm normalizes SPDX ("SHA256") and CycloneDX ("SHA-256")
+// algorithm names.
+func hashAlgorithm(alg string) string {
+	return strings.ToUpper(strings.ReplaceAll(alg, "-", ""))
+}
+
+func fromSPDX(doc *spdx.Document) []*DeclaredPackage {
+	var result []*DeclaredPackage
+	for _, p := range doc.Packages {
+		d := &DeclaredPackage{
+			Ref:     "SPDXRef-" + string(p.PackageSPDXIdentifier),
+			Name:    p.PackageName,
+			Version: p.PackageVersion,
+			Hashes:  make(map[string]string),
+		}
+		for _, ref := range p.PackageExternalReferences {
+			if ref.RefType != "purl" && ref.RefType != "http://spdx.org/rdf/references/purl" {
+				continue
+			}
+			if pu, err := purl.FromString(ref.Locator); err == nil {
+				d.PURL = &pu
+				break
+			}
+		}
+		for _, c := range p.PackageChecksums {
+			d.Hashes[hashAlgorithm(string(c.Algorithm))] = strings.ToLower(c.Value)
+		}
+		result = append(result, d)
+	}
+	return result
+}
+
+func fromCDX(bom *cyclonedx.BOM) []*DeclaredPackage {
+	var result []*DeclaredPackage
+	var walk func(cs *[]cyclonedx.Component)
+	walk = func(cs *[]cyclonedx.Component) {
+		if cs == nil {
+			return
+		}
+		for _, c := range *cs {
+			d := &DeclaredPackage{
+				Ref:     c.BOMRef,
+				Name:    c.Name,
+				Version: c.Version,
+				Hashes:  make(map[string]string),
+			}
+			if c.PackageURL != "" {
+				if pu, err := purl.FromString(c.PackageURL); err == nil {
+					d.PURL = &pu
+				}
+			}
+			if c.Hashes != nil {
+				for _, h := range *c.Hashes {
+					d.Hashes[hashAlgorithm(string(h.Algorithm))] = strings.ToLower(h.Value)
+				}
+			}
+			result = append(result, d)
+			walk(c.Components)
+		}
+	}
+	walk(bom.Components)
+	return result
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/java/archive"
+	"github.com/google/osv-scalibr/extractor/filesystem/language/python/wheelegg"
+	"github.com/google/osv-scalibr/inventory"
+	"github.com/google/osv-scalibr/purl"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+const (
+	emptySHA1Hex    = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+	emptySHA1Base64 = "2jmj7l5rSw0yVb/vlWAYkK/YBwk="
+)
+
+var (
+	requests = &extractor.Inventory{
+		Name:      "requests",
+		Version:   "2.31.0",
+		Locations: []string{"usr/lib/python3/dist-packages/requests-2.31.0.dist-info/METADATA"},
+		Extractor: wheelegg.Extractor{},
+	}
+	urllib3 = &extractor.Inventory{
+		Name:      "urllib3",
+		Version:   "2.0.7",
+		Locations: []string{"usr/lib/python3/dist-packages/urllib3-2.0.7.dist-info/METADATA"},
+		Extractor: wheelegg.Extractor{},
+	}
+	// A shaded jar whose coordinates differ from the ones declared upstream.
+	shadedJar = &extractor.Inventory{
+		Name:      "guava-shaded",
+		Version:   "32.1.0",
+		Locations: []string{"app/lib/guava-shaded.jar"},
+		Extractor: archive.Extractor{},
+		Metadata: &archive.Metadata{
+			ArtifactID: "guava-shaded",
+			GroupID:    "com.example",
+			SHA1:       emptySHA1Base64,
+		},
+	}
+)
+
+type mergedPackage struct {
+	Status    inventory.Status
+	MatchedBy inventory.MatchType
+	// The location of the scanned package.
+	Location string
+	Ref      string
+}
+
+func summarize(r *inventory.MergeResult) []mergedPackage {
+	var result []mergedPackage
+	for _, p := range r.Packages {
+		m := mergedPackage{Status: p.Status, MatchedBy: p.MatchedBy}
+		if p.Inventory != nil {
+			m.Location = p.Inventory.Locations[0]
+		}
+		if p.Declared != nil {
+			m.Ref = p.Declared.Ref
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func TestMergeWithSBOM(t *testing.T) {
+	spdxDoc := &spdx.Document{
+		Packages: []*spdx.Package{
+			{
+				PackageName:           "requests",
+				PackageVersion:        "2.31.0",
+				PackageSPDXIdentifier: "Package-requests",
+				PackageExternalReferences: []*spdx.PackageExternalReference{{
+					Category: "PACKAGE-MANAGER",
+					RefType:  "purl",
+					Locator:  "pkg:pypi/requests@2.31.0?arch=any",
+				}},
+			},
+			{
+				PackageName:           "guava",
+				PackageVersion:        "32.1.0",
+				PackageSPDXIdentifier: "Package-guava",
+				PackageChecksums: []common.Checksum{{
+					Algorithm: common.SHA1,
+					Value:     "DA39A3EE5E6B4B0D3255BFEF95601890AFD80709",
+				}},
+			},
+			{
+				PackageName:           "flask",
+				PackageVersion:        "3.0.0",
+				PackageSPDXIdentifier: "Package-flask",
+				PackageExternalReferences: []*spdx.PackageExternalReference{{
+					Category: "PACKAGE-MANAGER",
+					RefType:  "purl",
+					Locator:  "pkg:pypi/flask@3.0.0",
+				}},
+			},
+		},
+	}
+	cdxBOM := &cyclonedx.BOM{
+		Components: &[]cyclonedx.Component{
+			{
+				BOMRef:     "app",
+				Name:       "app",
+				PackageURL: "pkg:generic/app@1.0",
+				Components: &[]cyclonedx.Component{
+					{
+						BOMRef:     "requests",
+						Name:       "requests",
+						Version:    "2.31.0",
+						PackageURL: "pkg:pypi/requests@2.31.0",
+					},
+					{
+						BOMRef: "guava",
+						Name:   "guava",
+						Hashes: &[]cyclonedx.Hash{{Algorithm: cyclonedx.HashAlgoSHA1, Value: emptySHA1Hex}},
+					},
+				},
+			},
+			{
+				BOMRef:     "urllib3",
+				Name:       "urllib3",
+				Version:    "1.26.18",
+				PackageURL: "pkg:pypi/urllib3@1.26.18",
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		inv  []*extractor.Inventory
+		sbom any
+		want []mergedPackage
+	}{
+		{
+			name: "spdx",
+			inv:  []*extractor.Inventory{requests, urllib3, shadedJar},
+			sbom: spdxDoc,
+			want: []mergedPackage{
+				{Status: inventory.Corroborated, MatchedBy: inventory.MatchPURL, Location: requests.Locations[0], Ref: "SPDXRef-Package-requests"},
+				{Status: inventory.OnlyObserved, Location: urllib3.Locations[0]},
+				{Status: inventory.Corroborated, MatchedBy: inventory.MatchHash, Location: shadedJar.Locations[0], Ref: "SPDXRef-Package-guava"},
+				{Status: inventory.OnlyDeclared, Ref: "SPDXRef-Package-flask"},
+			},
+		},
+		{
+			name: "cyclonedx with nested components",
+			inv:  []*extractor.Inventory{requests, urllib3, shadedJar},
+			sbom: cdxBOM,
+			want: []mergedPackage{
+				{Status: inventory.Corroborated, MatchedBy: inventory.MatchPURL, Location: requests.Locations[0], Ref: "requests"},
+				// The declared version differs.
+				{Status: inventory.OnlyObserved, Location: urllib3.Locations[0]},
+				{Status: inventory.Corroborated, MatchedBy: inventory.MatchHash, Location: shadedJar.Locations[0], Ref: "guava"},
+				{Status: inventory.OnlyDeclared, Ref: "app"},
+				{Status: inventory.OnlyDeclared, Ref: "urllib3"},
+			},
+		},
+		{
+			name: "package installed twice",
+			inv:  []*extractor.Inventory{requests, requests},
+			sbom: cdxBOM,
+			want: []mergedPackage{
+				{Status: inventory.Corroborated, MatchedBy: inventory.MatchPURL, Location: requests.Locations[0], Ref: "requests"},
+				{Status: inventory.Corroborated, MatchedBy: inventory.MatchPURL, Location: requests.Locations[0], Ref: "requests"},
+				{Status: inventory.OnlyDeclared, Ref: "app"},
+				{Status: inventory.OnlyDeclared, Ref: "guava"},
+				{Status: inventory.OnlyDeclared, Ref: "urllib3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := inventory.MergeWithSBOM(tt.inv, tt.sbom)
+			if err != nil {
+				t.Fatalf("MergeWithSBOM(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, summarize(got)); diff != "" {
+				t.Errorf("MergeWithSBOM() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeWithSBOM_DeclaredPackage(t *testing.T) {
+	got, err := inventory.MergeWithSBOM(nil, &cyclonedx.BOM{
+		Components: &[]cyclonedx.Component{{
+			BOMRef:     "guava",
+			Name:       "guava",
+			Version:    "32.1.0",
+			PackageURL: "pkg:maven/com.google.guava/guava@32.1.0",
+			Hashes:     &[]cyclonedx.Hash{{Algorithm: cyclonedx.HashAlgoSHA256, Value: "ABCD"}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("MergeWithSBOM(): %v", err)
+	}
+	want := []*inventory.MergedPackage{{
+		Status: inventory.OnlyDeclared,
+		Declared: &inventory.DeclaredPackage{
+			Ref:     "guava",
+			Name:    "guava",
+			Version: "32.1.0",
+			PURL: &purl.PackageURL{
+				Type:       purl.TypeMaven,
+				Namespace:  "com.google.guava",
+				Name:       "guava",
+				Version:    "32.1.0",
+				Qualifiers: purl.Qualifiers{},
+			},
+			Hashes: map[string]string{"SHA256": "abcd"},
+		},
+	}}
+	if diff := cmp.Diff(want, got.Packages); diff != "" {
+		t.Errorf("MergeWithSBOM() diff (-want +got):\n%s", diff)
+	}
+	if n := got.Count(inventory.OnlyDeclared); n != 1 {
+		t.Errorf("Count(OnlyDeclared) = %d, want 1", n)
+	}
+}
+
+func TestMergeWithSBOM_UnsupportedDocument(t *testing.T) {
+	_, err := inventory.MergeWithSBOM(nil, "not an sbom")
+	if !errors.Is(err, inventory.ErrUnsupportedSBOM) {
+		t.Errorf("MergeWithSBOM() error: %v, want %v", err, inventory.ErrUnsupportedSBOM)
+	}
+}
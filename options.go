@@ -0,0 +1,195 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalibr
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/binary/platform"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/cache"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/log"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/plugin/budget"
+
+	dl "github.com/google/osv-scalibr/detector/list"
+	el "github.com/google/osv-scalibr/extractor/filesystem/list"
+	sl "github.com/google/osv-scalibr/extractor/standalone/list"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+// Option configures a ScanConfig created with NewScanConfig.
+type Option func(*ScanConfig)
+
+// NewScanConfig returns a config for scanning the system SCALIBR runs on,
+// modified by the given options, e.g.
+//
+//	cfg := scalibr.NewScanConfig(scalibr.WithScanRoots("/src"), scalibr.WithOfflineMode())
+//	result := scalibr.New().Scan(ctx, cfg)
+//
+// Unless set through options, the config
+//   - enables the default extractors and detectors that can run under its
+//     capabilities,
+//   - scans the root of the system and
+//   - skips the platform's pseudo-filesystems like /proc.
+//
+// The defaults are all or nothing: once any plugin is enabled through
+// WithPlugins, no default plugins are added, and the given plugins aren't
+// filtered by the capabilities. The scan fails if one of them needs a
+// capability that isn't available. WithOfflineMode takes effect after all
+// other options, whatever their order.
+//
+// The returned config can be modified further like one created as a struct.
+func NewScanConfig(opts ...Option) *ScanConfig {
+	cfg := &ScanConfig{
+		Capabilities: &plugin.Capabilities{
+			OS:            platform.OS(),
+			Network:       true,
+			DirectFS:      true,
+			RunningSystem: true,
+		},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.offlineMode {
+		capabs := &plugin.Capabilities{}
+		if cfg.Capabilities != nil {
+			*capabs = *cfg.Capabilities
+		}
+		capabs.Network = false
+		cfg.Capabilities = capabs
+	}
+	if len(cfg.plugins()) == 0 {
+		cfg.FilesystemExtractors = el.FilterByCapabilities(el.Default, cfg.Capabilities)
+		cfg.StandaloneExtractors = sl.FilterByCapabilities(sl.Default, cfg.Capabilities)
+		cfg.Detectors = dl.FilterByCapabilities(dl.Default, cfg.Capabilities)
+	}
+	if len(cfg.ScanRoots) == 0 {
+		root, err := platform.SystemRoot()
+		if err != nil {
+			log.Warnf("Failed to get the system root: %v", err)
+		} else {
+			cfg.ScanRoots = scalibrfs.RealFSScanRoots(root)
+		}
+	}
+	if cfg.DirsToSkip == nil {
+		cfg.DirsToSkip = defaultDirsToSkip(cfg.ScanRoots)
+	}
+	return cfg
+}
+
+// defaultDirsToSkip returns the platform's default ignored directories that
+// are inside the given scan roots.
+func defaultDirsToSkip(scanRoots []*scalibrfs.ScanRoot) []string {
+	dirs, err := platform.DefaultIgnoredDirectories()
+	if err != nil {
+		log.Warnf("Failed to get default ignored directories: %v", err)
+		return nil
+	}
+	var result []string
+	for _, root := range scanRoots {
+		if root.IsVirtual() {
+			continue
+		}
+		path := root.Path
+		if !strings.HasSuffix(path, string(os.PathSeparator)) {
+			path += string(os.PathSeparator)
+		}
+		for _, d := range dirs {
+			if strings.HasPrefix(d, path) {
+				result = append(result, d)
+			}
+		}
+	}
+	return result
+}
+
+// WithPlugins enables the given filesystem extractors, standalone extractors
+// and detectors instead of the default ones.
+func WithPlugins(plugins ...plugin.Plugin) Option {
+	return func(cfg *ScanConfig) {
+		for _, p := range plugins {
+			switch p := p.(type) {
+			case filesystem.Extractor:
+				cfg.FilesystemExtractors = append(cfg.FilesystemExtractors, p)
+			case standalone.Extractor:
+				cfg.StandaloneExtractors = append(cfg.StandaloneExtractors, p)
+			case detector.Detector:
+				cfg.Detectors = append(cfg.Detectors, p)
+			default:
+				log.Warnf("Plugin %q is neither an extractor nor a detector, ignoring", p.Name())
+			}
+		}
+	}
+}
+
+// WithScanRoots scans the given directories of the real filesystem instead of
+// the system root.
+func WithScanRoots(paths ...string) Option {
+	return func(cfg *ScanConfig) {
+		for _, p := range paths {
+			cfg.ScanRoots = append(cfg.ScanRoots, scalibrfs.RealFSScanRoot(p))
+		}
+	}
+}
+
+// WithTimeout aborts the scan once it has run for the given duration.
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *ScanConfig) {
+		cfg.Timeout = timeout
+	}
+}
+
+// WithCache only runs filesystem extractors on the files that changed since
+// their results were stored in c.
+func WithCache(c cache.Cache) Option {
+	return func(cfg *ScanConfig) {
+		cfg.Cache = c
+	}
+}
+
+// WithPluginBudget cuts off each plugin once it exceeds b. Budgets of
+// individual plugins can be set in cfg.PluginBudgets.Plugins.
+func WithPluginBudget(b budget.Budget) Option {
+	return func(cfg *ScanConfig) {
+		if cfg.PluginBudgets == nil {
+			cfg.PluginBudgets = &budget.Budgets{}
+		}
+		cfg.PluginBudgets.Default = b
+	}
+}
+
+// WithOfflineMode runs the scan without network access. Default plugins that
+// need network access aren't enabled. It overrides the Network capability set
+// through WithCapabilities, even if that comes later.
+func WithOfflineMode() Option {
+	return func(cfg *ScanConfig) {
+		cfg.offlineMode = true
+	}
+}
+
+// WithCapabilities sets the capabilities of the scanning environment, e.g.
+// for scanning a filesystem that isn't the one of the running system. Network
+// access stays off if WithOfflineMode is also given.
+func WithCapabilities(capabs *plugin.Capabilities) Option {
+	return func(cfg *ScanConfig) {
+		cfg.Capabilities = capabs
+	}
+}
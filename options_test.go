@@ -0,0 +1,170 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalibr_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	scalibr "github.com/google/osv-scalibr"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/binary/platform"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/stats"
+	fd "github.com/google/osv-scalibr/testing/fakedetector"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+func TestNewScanConfig_Defaults(t *testing.T) {
+	cfg := scalibr.NewScanConfig()
+
+	wantCapabs := &plugin.Capabilities{OS: platform.OS(), Network: true, DirectFS: true, RunningSystem: true}
+	if diff := cmp.Diff(wantCapabs, cfg.Capabilities); diff != "" {
+		t.Errorf("NewScanConfig(): unexpected capabilities (-want +got):\n%s", diff)
+	}
+	if len(cfg.FilesystemExtractors) == 0 {
+		t.Error("NewScanConfig(): no filesystem extractors enabled, want the default ones")
+	}
+	if err := cfg.ValidatePluginRequirements(); err != nil {
+		t.Errorf("NewScanConfig(): ValidatePluginRequirements(): %v", err)
+	}
+	root, err := platform.SystemRoot()
+	if err != nil {
+		t.Fatalf("platform.SystemRoot(): %v", err)
+	}
+	if len(cfg.ScanRoots) != 1 || cfg.ScanRoots[0].Path != root {
+		t.Errorf("NewScanConfig(): ScanRoots = %v, want [%s]", cfg.ScanRoots, root)
+	}
+	wantSkip, err := platform.DefaultIgnoredDirectories()
+	if err != nil {
+		t.Fatalf("platform.DefaultIgnoredDirectories(): %v", err)
+	}
+	if diff := cmp.Diff(wantSkip, cfg.DirsToSkip); diff != "" {
+		t.Errorf("NewScanConfig(): unexpected DirsToSkip (-want +got):\n%s", diff)
+	}
+	if cfg.Timeout != 0 {
+		t.Errorf("NewScanConfig(): Timeout = %v, want 0", cfg.Timeout)
+	}
+}
+
+func TestNewScanConfig_Options(t *testing.T) {
+	tmp := t.TempDir()
+	ext := fe.New("python/wheelegg", 1, nil, nil)
+	det := fd.New("detector", 2, nil, nil)
+
+	cfg := scalibr.NewScanConfig(
+		scalibr.WithPlugins(ext, det),
+		scalibr.WithScanRoots(tmp),
+		scalibr.WithTimeout(time.Minute),
+		scalibr.WithOfflineMode(),
+	)
+
+	if diff := cmp.Diff([]filesystem.Extractor{ext}, cfg.FilesystemExtractors, fe.AllowUnexported); diff != "" {
+		t.Errorf("NewScanConfig(): unexpected FilesystemExtractors (-want +got):\n%s", diff)
+	}
+	if len(cfg.StandaloneExtractors) != 0 {
+		t.Errorf("NewScanConfig(): StandaloneExtractors = %v, want none", cfg.StandaloneExtractors)
+	}
+	if len(cfg.Detectors) != 1 || cfg.Detectors[0] != det {
+		t.Errorf("NewScanConfig(): Detectors = %v, want [%v]", cfg.Detectors, det)
+	}
+	if len(cfg.ScanRoots) != 1 || cfg.ScanRoots[0].Path != tmp {
+		t.Errorf("NewScanConfig(): ScanRoots = %v, want [%s]", cfg.ScanRoots, tmp)
+	}
+	if len(cfg.DirsToSkip) != 0 {
+		t.Errorf("NewScanConfig(): DirsToSkip = %v, want none outside of the system root", cfg.DirsToSkip)
+	}
+	if cfg.Timeout != time.Minute {
+		t.Errorf("NewScanConfig(): Timeout = %v, want %v", cfg.Timeout, time.Minute)
+	}
+	if cfg.Capabilities.Network {
+		t.Error("NewScanConfig(WithOfflineMode()): Capabilities.Network = true, want false")
+	}
+}
+
+func TestNewScanConfig_OfflineModeFiltersDefaults(t *testing.T) {
+	cfg := scalibr.NewScanConfig(scalibr.WithOfflineMode())
+	for _, d := range cfg.Detectors {
+		if d.Requirements().Network {
+			t.Errorf("NewScanConfig(WithOfflineMode()): enabled detector %q needs network access", d.Name())
+		}
+	}
+	for _, e := range cfg.FilesystemExtractors {
+		if e.Requirements().Network {
+			t.Errorf("NewScanConfig(WithOfflineMode()): enabled extractor %q needs network access", e.Name())
+		}
+	}
+}
+
+func TestNewScanConfig_OptionOrder(t *testing.T) {
+	capabs := &plugin.Capabilities{OS: plugin.OSLinux, Network: true}
+	cfg := scalibr.NewScanConfig(
+		scalibr.WithCapabilities(capabs),
+		scalibr.WithOfflineMode(),
+		scalibr.WithPlugins(fd.New("detector", 1, nil, nil)),
+	)
+	want := &plugin.Capabilities{OS: plugin.OSLinux, Network: false}
+	if diff := cmp.Diff(want, cfg.Capabilities); diff != "" {
+		t.Errorf("NewScanConfig(): unexpected capabilities (-want +got):\n%s", diff)
+	}
+	if !capabs.Network {
+		t.Error("WithOfflineMode() modified the capabilities passed to WithCapabilities()")
+	}
+}
+
+func TestNewScanConfig_OfflineModeBeforeCapabilities(t *testing.T) {
+	capabs := &plugin.Capabilities{OS: plugin.OSLinux, Network: true}
+	cfg := scalibr.NewScanConfig(
+		scalibr.WithOfflineMode(),
+		scalibr.WithCapabilities(capabs),
+	)
+	want := &plugin.Capabilities{OS: plugin.OSLinux, Network: false}
+	if diff := cmp.Diff(want, cfg.Capabilities); diff != "" {
+		t.Errorf("NewScanConfig(): unexpected capabilities (-want +got):\n%s", diff)
+	}
+	if !capabs.Network {
+		t.Error("WithOfflineMode() modified the capabilities passed to WithCapabilities()")
+	}
+	for _, d := range cfg.Detectors {
+		if d.Requirements().Network {
+			t.Errorf("NewScanConfig(): enabled detector %q needs network access", d.Name())
+		}
+	}
+}
+
+// slowCollector slows down the filesystem walk.
+type slowCollector struct {
+	stats.NoopCollector
+}
+
+func (slowCollector) AfterInodeVisited(path string) { time.Sleep(50 * time.Millisecond) }
+
+func TestScan_Timeout(t *testing.T) {
+	cfg := scalibr.NewScanConfig(
+		scalibr.WithPlugins(fe.New("python/wheelegg", 1, nil, nil)),
+		scalibr.WithScanRoots(t.TempDir()),
+		scalibr.WithTimeout(time.Millisecond),
+	)
+	cfg.Stats = slowCollector{}
+
+	got := scalibr.New().Scan(context.Background(), cfg).Status
+	if got.Status != plugin.ScanStatusFailed || !strings.Contains(got.FailureReason, context.DeadlineExceeded.Error()) {
+		t.Errorf("Scan() with timeout: got status %v, want a failure with %q", got, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package budget limits the resources a single plugin can use during a scan
+// so that a misbehaving extractor or detector can't stall the whole scan.
+// Each plugin has a budget of wall time, files touched and bytes read. Once
+// a plugin exhausts any of them it's cut off: it isn't run anymore for the
+// rest of the scan and its status reports the limit it exceeded.
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/osv-scalibr/log"
+)
+
+// ErrExceeded is returned when a plugin exceeds its budget.
+var ErrExceeded = errors.New("plugin budget exceeded")
+
+// Limit is a limit of a budget.
+type Limit string
+
+// Limits of a budget.
+const (
+	LimitWallTime Limit = "wall_time"
+	LimitFiles    Limit = "files"
+	LimitBytes    Limit = "bytes"
+)
+
+// Budget is the resources a plugin can use during a scan. Zero values
+// disable the respective limit.
+type Budget struct {
+	// WallTime is the total time the plugin can run for. Extractors are
+	// abandoned when their remaining time runs out, even if they don't return.
+	WallTime time.Duration
+	// MaxFiles is the number of files the plugin can open. For extractors
+	// this includes the files they're run on.
+	MaxFiles int
+	// MaxBytes is the number of bytes the plugin can read from files.
+	MaxBytes int64
+}
+
+// IsZero returns whether b doesn't limit anything.
+func (b Budget) IsZero() bool {
+	return b.WallTime <= 0 && b.MaxFiles <= 0 && b.MaxBytes <= 0
+}
+
+// Budgets are the budgets of the plugins of a scan.
+type Budgets struct {
+	// Default is the budget of the plugins that aren't listed in Plugins.
+	Default Budget
+	// Plugins are the budgets of individual plugins, keyed by plugin name.
+	Plugins map[string]Budget
+}
+
+// For returns the budget of the plugin with the given name.
+func (b *Budgets) For(name string) Budget {
+	if b == nil {
+		return Budget{}
+	}
+	if pb, ok := b.Plugins[name]; ok {
+		return pb
+	}
+	return b.Default
+}
+
+// NewUsage returns the usage tracker for the plugin with the given name, or
+// nil if the plugin's budget doesn't limit anything.
+func (b *Budgets) NewUsage(name string) *Usage {
+	budget := b.For(name)
+	if budget.IsZero() {
+		return nil
+	}
+	return &Usage{plugin: name, budget: budget}
+}
+
+// Usage tracks the resources a plugin used against its budget. It's safe for
+// concurrent use. A nil Usage doesn't limit anything.
+type Usage struct {
+	plugin string
+	budget Budget
+
+	mu       sync.Mutex
+	elapsed  time.Duration
+	files    int
+	bytes    int64
+	exceeded Limit
+}
+
+// Exceeded returns the limit the plugin exceeded, or an empty string if it's
+// still within its budget.
+func (u *Usage) Exceeded() Limit {
+	if u == nil {
+		return ""
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.exceeded
+}
+
+// Err returns the error describing the limit the plugin exceeded, or nil.
+func (u *Usage) Err() error {
+	switch u.Exceeded() {
+	case LimitWallTime:
+		return fmt.Errorf("%w: wall time of %v", ErrExceeded, u.budget.WallTime)
+	case LimitFiles:
+		return fmt.Errorf("%w: %d files", ErrExceeded, u.budget.MaxFiles)
+	case LimitBytes:
+		return fmt.Errorf("%w: %d bytes", ErrExceeded, u.budget.MaxBytes)
+	}
+	return nil
+}
+
+// exceed records that the plugin exceeded l. Only the first exceeded limit is
+// recorded.
+func (u *Usage) exceed(l Limit) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.exceeded != "" {
+		return
+	}
+	u.exceeded = l
+	log.Warnf("Plugin %s exceeded its %s budget and is cut off for the rest of the scan", u.plugin, l)
+}
+
+// addFile counts a file the plugin opened.
+func (u *Usage) addFile() error {
+	u.mu.Lock()
+	u.files++
+	over := u.budget.MaxFiles > 0 && u.files > u.budget.MaxFiles
+	u.mu.Unlock()
+	if over {
+		u.exceed(LimitFiles)
+	}
+	return u.Err()
+}
+
+// addBytes counts n bytes the plugin read.
+func (u *Usage) addBytes(n int) error {
+	u.mu.Lock()
+	u.bytes += int64(n)
+	over := u.budget.MaxBytes > 0 && u.bytes > u.budget.MaxBytes
+	u.mu.Unlock()
+	if over {
+		u.exceed(LimitBytes)
+	}
+	return u.Err()
+}
+
+// Run runs fn with the remaining wall time of the plugin. The context passed
+// to fn is canceled when the time runs out. If fn doesn't return by then,
+// it's abandoned: Run returns the budget error and the results fn returns
+// later are discarded. Run doesn't call fn if the plugin is already cut off.
+func Run[T any](ctx context.Context, u *Usage, fn func(context.Context) (T, error)) (T, error) {
+	var zero T
+	if u == nil {
+		return fn(ctx)
+	}
+	if err := u.Err(); err != nil {
+		return zero, err
+	}
+	if u.budget.WallTime <= 0 {
+		return fn(ctx)
+	}
+
+	u.mu.Lock()
+	remaining := u.budget.WallTime - u.elapsed
+	u.mu.Unlock()
+	runCtx, cancel := context.WithTimeout(ctx, remaining)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	start := time.Now()
+	go func() {
+		v, err := fn(runCtx)
+		done <- result{value: v, err: err}
+	}()
+
+	var r result
+	abandoned := false
+	select {
+	case r = <-done:
+	case <-runCtx.Done():
+		abandoned = true
+	}
+
+	u.mu.Lock()
+	u.elapsed += time.Since(start)
+	timeUp := u.elapsed >= u.budget.WallTime
+	u.mu.Unlock()
+	if ctx.Err() != nil {
+		// The scan itself was canceled.
+		if abandoned {
+			return zero, ctx.Err()
+		}
+		return r.value, r.err
+	}
+	cutOff := abandoned || (r.err != nil && errors.Is(runCtx.Err(), context.DeadlineExceeded))
+	if timeUp || cutOff {
+		u.exceed(LimitWallTime)
+	}
+	if cutOff {
+		return zero, u.Err()
+	}
+	return r.value, r.err
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package budget_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/plugin/budget"
+)
+
+func TestBudgetsFor(t *testing.T) {
+	b := &budget.Budgets{
+		Default: budget.Budget{WallTime: time.Minute},
+		Plugins: map[string]budget.Budget{"os/rpm": {MaxFiles: 10}},
+	}
+	tests := []struct {
+		desc    string
+		budgets *budget.Budgets
+		plugin  string
+		want    budget.Budget
+	}{
+		{
+			desc:    "default",
+			budgets: b,
+			plugin:  "python/wheelegg",
+			want:    budget.Budget{WallTime: time.Minute},
+		},
+		{
+			desc:    "plugin override",
+			budgets: b,
+			plugin:  "os/rpm",
+			want:    budget.Budget{MaxFiles: 10},
+		},
+		{
+			desc:   "nil budgets",
+			plugin: "os/rpm",
+			want:   budget.Budget{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.budgets.For(tc.plugin)); diff != "" {
+				t.Errorf("For(%q) unexpected budget (-want +got):\n%s", tc.plugin, diff)
+			}
+		})
+	}
+}
+
+func TestNewUsage_Unlimited(t *testing.T) {
+	b := &budget.Budgets{Plugins: map[string]budget.Budget{"os/rpm": {MaxFiles: 10}}}
+	u := b.NewUsage("python/wheelegg")
+	if u != nil {
+		t.Fatalf("NewUsage() = %v, want nil for a zero budget", u)
+	}
+	// A nil Usage doesn't limit anything.
+	got, err := budget.Run(context.Background(), u, func(context.Context) (int, error) { return 1, nil })
+	if got != 1 || err != nil {
+		t.Errorf("Run() = %d, %v, want 1, nil", got, err)
+	}
+	if l := u.Exceeded(); l != "" {
+		t.Errorf("Exceeded() = %q, want empty", l)
+	}
+}
+
+func TestRun_WallTime(t *testing.T) {
+	b := &budget.Budgets{Default: budget.Budget{WallTime: 50 * time.Millisecond}}
+	u := b.NewUsage("fake")
+
+	got, err := budget.Run(context.Background(), u, func(context.Context) (string, error) { return "fast", nil })
+	if got != "fast" || err != nil {
+		t.Fatalf("Run(fast) = %q, %v, want \"fast\", nil", got, err)
+	}
+
+	// The plugin ignores the context and is abandoned.
+	stuck := make(chan struct{})
+	defer close(stuck)
+	start := time.Now()
+	got, err = budget.Run(context.Background(), u, func(context.Context) (string, error) {
+		<-stuck
+		return "stuck", nil
+	})
+	if !errors.Is(err, budget.ErrExceeded) {
+		t.Errorf("Run(stuck) error = %v, want %v", err, budget.ErrExceeded)
+	}
+	if got != "" {
+		t.Errorf("Run(stuck) = %q, want the results to be discarded", got)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Run(stuck) took %v, want it to return once the budget is used up", elapsed)
+	}
+	if l := u.Exceeded(); l != budget.LimitWallTime {
+		t.Errorf("Exceeded() = %q, want %q", l, budget.LimitWallTime)
+	}
+
+	// Cut off plugins aren't run anymore.
+	called := false
+	_, err = budget.Run(context.Background(), u, func(context.Context) (string, error) {
+		called = true
+		return "", nil
+	})
+	if called || !errors.Is(err, budget.ErrExceeded) {
+		t.Errorf("Run() after cut off: called %v, error %v, want not called and %v", called, err, budget.ErrExceeded)
+	}
+}
+
+func TestRun_ContextCanceled(t *testing.T) {
+	b := &budget.Budgets{Default: budget.Budget{WallTime: time.Minute}}
+	u := b.NewUsage("fake")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := budget.Run(ctx, u, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want %v", err, context.Canceled)
+	}
+	if l := u.Exceeded(); l != "" {
+		t.Errorf("Exceeded() = %q, want empty when the scan was canceled", l)
+	}
+}
+
+func TestFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.txt": {Data: []byte("0123456789")},
+		"b.txt": {Data: []byte("0123456789")},
+		"c.txt": {Data: []byte("0123456789")},
+	}
+	tests := []struct {
+		desc      string
+		budget    budget.Budget
+		wantLimit budget.Limit
+	}{
+		{
+			desc:   "within budget",
+			budget: budget.Budget{MaxFiles: 3, MaxBytes: 30},
+		},
+		{
+			desc:      "too many files",
+			budget:    budget.Budget{MaxFiles: 2},
+			wantLimit: budget.LimitFiles,
+		},
+		{
+			desc:      "too many bytes",
+			budget:    budget.Budget{MaxBytes: 25},
+			wantLimit: budget.LimitBytes,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			u := (&budget.Budgets{Default: tc.budget}).NewUsage("fake")
+			bfs := u.FS(fsys)
+			var gotErr error
+			for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+				f, err := bfs.Open(name)
+				if err != nil {
+					gotErr = err
+					break
+				}
+				_, err = io.ReadAll(f)
+				f.Close()
+				if err != nil {
+					gotErr = err
+					break
+				}
+			}
+			if l := u.Exceeded(); l != tc.wantLimit {
+				t.Errorf("Exceeded() = %q, want %q", l, tc.wantLimit)
+			}
+			if wantErr := tc.wantLimit != ""; errors.Is(gotErr, budget.ErrExceeded) != wantErr {
+				t.Errorf("reading the files returned %v, want budget error: %v", gotErr, wantErr)
+			}
+		})
+	}
+}
+
+func TestContents(t *testing.T) {
+	tests := []struct {
+		desc      string
+		budget    budget.Budget
+		wantLimit budget.Limit
+	}{
+		{
+			desc:   "within budget",
+			budget: budget.Budget{MaxFiles: 3, MaxBytes: 30},
+		},
+		{
+			desc:      "too many files",
+			budget:    budget.Budget{MaxFiles: 2},
+			wantLimit: budget.LimitFiles,
+		},
+		{
+			desc:      "too many bytes",
+			budget:    budget.Budget{MaxBytes: 25},
+			wantLimit: budget.LimitBytes,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			u := (&budget.Budgets{Default: tc.budget}).NewUsage("fake")
+			var gotErr error
+			for range 3 {
+				r, err := u.Contents([]byte("0123456789"))
+				if err != nil {
+					gotErr = err
+					break
+				}
+				if _, err := io.ReadAll(r); err != nil {
+					gotErr = err
+					break
+				}
+			}
+			if l := u.Exceeded(); l != tc.wantLimit {
+				t.Errorf("Exceeded() = %q, want %q", l, tc.wantLimit)
+			}
+			if wantErr := tc.wantLimit != ""; errors.Is(gotErr, budget.ErrExceeded) != wantErr {
+				t.Errorf("reading the contents returned %v, want budget error: %v", gotErr, wantErr)
+			}
+		})
+	}
+}
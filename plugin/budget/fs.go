@@ -0,0 +1,134 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package budget
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+
+	scalibrfs "github.com/google/osv-scalibr/fs"
+)
+
+// FS returns fsys with the files opened and the bytes read through it counted
+// against the budget of the plugin. Opening and reading files fails once the
+// plugin is cut off.
+func (u *Usage) FS(fsys scalibrfs.FS) scalibrfs.FS {
+	if u == nil || fsys == nil {
+		return fsys
+	}
+	return &budgetFS{fs: fsys, u: u}
+}
+
+type budgetFS struct {
+	fs scalibrfs.FS
+	u  *Usage
+}
+
+func (f *budgetFS) Open(name string) (fs.File, error) {
+	if err := f.u.addFile(); err != nil {
+		return nil, err
+	}
+	file, err := f.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &budgetFile{file: file, u: f.u}, nil
+}
+
+func (f *budgetFS) ReadDir(name string) ([]fs.DirEntry, error) { return f.fs.ReadDir(name) }
+
+func (f *budgetFS) Stat(name string) (fs.FileInfo, error) { return f.fs.Stat(name) }
+
+// Contents counts a file the plugin reads from contents that were already
+// read from the filesystem, e.g. once for several plugins, and returns a
+// reader of them that counts the bytes read. The reader implements
+// io.ReaderAt and io.Seeker.
+func (u *Usage) Contents(b []byte) (io.Reader, error) {
+	if u == nil {
+		return bytes.NewReader(b), nil
+	}
+	if err := u.addFile(); err != nil {
+		return nil, err
+	}
+	return &budgetFile{file: bytesFile{bytes.NewReader(b)}, u: u}, nil
+}
+
+// bytesFile is an fs.File of contents that were read before.
+type bytesFile struct {
+	*bytes.Reader
+}
+
+func (bytesFile) Stat() (fs.FileInfo, error) { return nil, errors.New("Stat not implemented") }
+
+func (bytesFile) Close() error { return nil }
+
+// budgetFile is a file opened through a budget FS. It implements io.ReaderAt,
+// io.Seeker and fs.ReadDirFile since extractors check for them, and fails if
+// the underlying file doesn't.
+type budgetFile struct {
+	file fs.File
+	u    *Usage
+}
+
+func (f *budgetFile) Stat() (fs.FileInfo, error) { return f.file.Stat() }
+
+func (f *budgetFile) Close() error { return f.file.Close() }
+
+func (f *budgetFile) Read(p []byte) (int, error) {
+	if err := f.u.Err(); err != nil {
+		return 0, err
+	}
+	n, err := f.file.Read(p)
+	return n, f.addBytes(n, err)
+}
+
+func (f *budgetFile) ReadAt(p []byte, off int64) (int, error) {
+	r, ok := f.file.(io.ReaderAt)
+	if !ok {
+		return 0, errors.New("ReadAt not implemented")
+	}
+	if err := f.u.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.ReadAt(p, off)
+	return n, f.addBytes(n, err)
+}
+
+func (f *budgetFile) Seek(offset int64, whence int) (int64, error) {
+	s, ok := f.file.(io.Seeker)
+	if !ok {
+		return 0, errors.New("Seek not implemented")
+	}
+	return s.Seek(offset, whence)
+}
+
+func (f *budgetFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	d, ok := f.file.(fs.ReadDirFile)
+	if !ok {
+		return nil, errors.New("ReadDir not implemented")
+	}
+	return d.ReadDir(n)
+}
+
+// addBytes counts the n bytes that were read and returns readErr, or the
+// budget error if the read succeeded.
+func (f *budgetFile) addBytes(n int, readErr error) error {
+	if err := f.u.addBytes(n); err != nil && readErr == nil {
+		return err
+	}
+	return readErr
+}
@@ -71,6 +71,9 @@ type Status struct {
 	Name    string
 	Version int
 	Status  *ScanStatus
+	// The budget limit that cut the plugin off, e.g. "wall_time", or empty if
+	// the plugin ran within its budget. See the budget package.
+	BudgetExceeded string
 }
 
 // ScanStatus is the status of a scan run. In case the scan fails, FailureReason contains details.
@@ -90,8 +93,55 @@ const (
 	ScanStatusFailed
 )
 
+// DataSource describes a versioned dataset a plugin relies on, e.g. an advisory
+// or fingerprint database.
+type DataSource struct {
+	// Name of the dataset, e.g. "govulncheck-db".
+	Name string
+	// Where the data was loaded from, e.g. a file path or URL.
+	Location string
+	// Version, snapshot timestamp or digest of the data. Empty if the plugin
+	// uses live data that can't be pinned.
+	Version string
+}
+
 // LINT.ThenChange(/binary/proto/scan_result.proto)
 
+// DataSourceProvider is implemented by plugins that rely on versioned data.
+// The data sources are recorded in the scan result so that downstream systems
+// can tell when results were produced with different data.
+type DataSourceProvider interface {
+	DataSources() []*DataSource
+}
+
+// DataSources returns the data sources of the plugin, or nil if it doesn't use
+// any.
+func DataSources(p Plugin) []*DataSource {
+	if dp, ok := p.(DataSourceProvider); ok {
+		return dp.DataSources()
+	}
+	return nil
+}
+
+// Documentation describes the coverage of a plugin beyond what the Plugin
+// interface exposes. It's used to generate machine-readable plugin docs.
+type Documentation struct {
+	// Glob patterns of the file paths the plugin reads, e.g.
+	// "**/package-lock.json".
+	FilePatterns []string
+	// Ecosystems of the inventory or findings the plugin reports, e.g. "npm".
+	Ecosystems []string
+	// A zero value of the metadata struct the plugin attaches to its results,
+	// e.g. &Metadata{}.
+	Metadata any
+}
+
+// Documenter is implemented by plugins that describe their coverage. Fields
+// left empty are derived by the doc generator where possible.
+type Documenter interface {
+	Documentation() *Documentation
+}
+
 // ValidateRequirements checks that the specified  scanning capabilities satisfy
 // the requirements of a given plugin.
 func ValidateRequirements(p Plugin, capabs *Capabilities) error {
@@ -118,6 +168,16 @@ func ValidateRequirements(p Plugin, capabs *Capabilities) error {
 	return fmt.Errorf("plugin %s can't be enabled: %s", p.Name(), strings.Join(errs, ", "))
 }
 
+// ValidateVersion checks that the plugin has the version it's pinned to in
+// pinnedVersions, if any.
+func ValidateVersion(p Plugin, pinnedVersions map[string]int) error {
+	want, ok := pinnedVersions[p.Name()]
+	if !ok || want == p.Version() {
+		return nil
+	}
+	return fmt.Errorf("plugin %s has version %d but is pinned to version %d", p.Name(), p.Version(), want)
+}
+
 // StatusFromErr returns a successful or failed plugin scan status for a given plugin based on an error.
 func StatusFromErr(p Plugin, partial bool, err error) *Status {
 	status := &ScanStatus{}
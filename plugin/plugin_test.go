@@ -92,6 +92,39 @@ func TestValidateRequirements(t *testing.T) {
 	}
 }
 
+func TestValidateVersion(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		pinned  map[string]int
+		wantErr error
+	}{
+		{
+			desc:    "Not pinned",
+			pinned:  map[string]int{"other-plugin": 1},
+			wantErr: nil,
+		},
+		{
+			desc:    "Pinned to same version",
+			pinned:  map[string]int{"fake-plugin": 0},
+			wantErr: nil,
+		},
+		{
+			desc:    "Pinned to different version",
+			pinned:  map[string]int{"fake-plugin": 1},
+			wantErr: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := plugin.ValidateVersion(fakePlugin{}, tc.pinned)
+			if !cmp.Equal(err, tc.wantErr, cmpopts.EquateErrors()) {
+				t.Fatalf("plugin.ValidateVersion(%v) got error: %v, want: %v\n", tc.pinned, err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestString(t *testing.T) {
 	testCases := []struct {
 		desc string
@@ -0,0 +1,58 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugindoc
+
+import "reflect"
+
+// Changes lists the differences between two versions of the plugin docs.
+type Changes struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	// Plugins whose version, requirements or coverage changed.
+	Changed []string `json:"changed,omitempty"`
+}
+
+// Empty returns whether there are no differences.
+func (c *Changes) Empty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// Diff returns the plugins that were added, removed or changed in newDoc
+// compared to oldDoc. Plugins are identified by their type and name.
+func Diff(oldDoc, newDoc *Doc) *Changes {
+	type key struct{ typ, name string }
+	old := map[key]*Plugin{}
+	for _, p := range oldDoc.Plugins {
+		old[key{p.Type, p.Name}] = p
+	}
+	c := &Changes{}
+	for _, p := range newDoc.Plugins {
+		k := key{p.Type, p.Name}
+		o, ok := old[k]
+		switch {
+		case !ok:
+			c.Added = append(c.Added, p.Name)
+		case !reflect.DeepEqual(o, p):
+			c.Changed = append(c.Changed, p.Name)
+		}
+		delete(old, k)
+	}
+	for _, p := range oldDoc.Plugins {
+		if _, ok := old[key{p.Type, p.Name}]; ok {
+			c.Removed = append(c.Removed, p.Name)
+		}
+	}
+	return c
+}
@@ -0,0 +1,282 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugindoc generates machine-readable documentation of the plugins
+// registered in SCALIBR, e.g. for downstream platforms that render plugin
+// catalogs or track coverage changes between releases.
+package plugindoc
+
+import (
+	"encoding/json"
+	"io"
+	"io/fs"
+	"path"
+	"reflect"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/osv-scalibr/detector"
+	dl "github.com/google/osv-scalibr/detector/list"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/coverage"
+	fl "github.com/google/osv-scalibr/extractor/filesystem/list"
+	"github.com/google/osv-scalibr/extractor/filesystem/simplefileapi"
+	"github.com/google/osv-scalibr/extractor/standalone"
+	sl "github.com/google/osv-scalibr/extractor/standalone/list"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// Plugin types.
+const (
+	TypeFilesystemExtractor = "filesystem_extractor"
+	TypeStandaloneExtractor = "standalone_extractor"
+	TypeDetector            = "detector"
+)
+
+// Config for generating the plugin docs.
+type Config struct {
+	FilesystemExtractors []filesystem.Extractor
+	StandaloneExtractors []standalone.Extractor
+	Detectors            []detector.Detector
+	// Paths matched against the FileRequired function of filesystem extractors
+	// that don't document their file patterns.
+	ProbePaths []string
+}
+
+// DefaultConfig returns a config that documents every registered plugin.
+func DefaultConfig() Config {
+	return Config{
+		FilesystemExtractors: fl.All,
+		StandaloneExtractors: sl.All,
+		Detectors:            dl.All,
+		ProbePaths:           ProbePaths,
+	}
+}
+
+// Doc is the machine-readable documentation of a set of plugins.
+type Doc struct {
+	Plugins []*Plugin `json:"plugins"`
+}
+
+// Plugin documents a single plugin.
+type Plugin struct {
+	Name         string        `json:"name"`
+	Type         string        `json:"type"`
+	Version      int           `json:"version"`
+	Requirements *Requirements `json:"requirements"`
+	// Extractors that need to be enabled for a detector to run.
+	RequiredExtractors []string `json:"required_extractors,omitempty"`
+	// File patterns the plugin reads. If the plugin doesn't document them,
+	// these are the probe paths accepted by its FileRequired function.
+	FilePatterns []string `json:"file_patterns,omitempty"`
+	// Ecosystems of the inventory or findings the plugin reports. Empty if the
+	// ecosystem depends on the scanned data, e.g. on the distro of OS packages.
+	Ecosystems     []string `json:"ecosystems,omitempty"`
+	MetadataFields []*Field `json:"metadata_fields,omitempty"`
+}
+
+// Requirements are the scanning capabilities the plugin needs.
+type Requirements struct {
+	OS            string `json:"os"`
+	Network       bool   `json:"network"`
+	DirectFS      bool   `json:"direct_fs"`
+	RunningSystem bool   `json:"running_system"`
+}
+
+// Field is an exported field of a plugin's metadata struct.
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Generate returns the docs of the plugins in the config, sorted by type and
+// name.
+func Generate(cfg Config) *Doc {
+	doc := &Doc{}
+	for _, e := range cfg.FilesystemExtractors {
+		p := newPlugin(e, TypeFilesystemExtractor)
+		if len(p.FilePatterns) == 0 {
+			p.FilePatterns = probe(e, cfg.ProbePaths)
+		}
+		if len(p.Ecosystems) == 0 {
+			p.Ecosystems = extractorEcosystems(e, p.FilePatterns)
+		}
+		doc.Plugins = append(doc.Plugins, p)
+	}
+	for _, e := range cfg.StandaloneExtractors {
+		p := newPlugin(e, TypeStandaloneExtractor)
+		if len(p.Ecosystems) == 0 {
+			p.Ecosystems = extractorEcosystems(e, nil)
+		}
+		doc.Plugins = append(doc.Plugins, p)
+	}
+	for _, d := range cfg.Detectors {
+		p := newPlugin(d, TypeDetector)
+		p.RequiredExtractors = d.RequiredExtractors()
+		doc.Plugins = append(doc.Plugins, p)
+	}
+	sort.SliceStable(doc.Plugins, func(i, j int) bool {
+		if doc.Plugins[i].Type != doc.Plugins[j].Type {
+			return doc.Plugins[i].Type < doc.Plugins[j].Type
+		}
+		return doc.Plugins[i].Name < doc.Plugins[j].Name
+	})
+	return doc
+}
+
+// Write writes the docs as indented JSON.
+func (d *Doc) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(d)
+}
+
+// Read parses docs previously written with Write.
+func Read(r io.Reader) (*Doc, error) {
+	d := &Doc{}
+	if err := json.NewDecoder(r).Decode(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func newPlugin(p plugin.Plugin, typ string) *Plugin {
+	req := p.Requirements()
+	if req == nil {
+		req = &plugin.Capabilities{}
+	}
+	res := &Plugin{
+		Name:    p.Name(),
+		Type:    typ,
+		Version: p.Version(),
+		Requirements: &Requirements{
+			OS:            osName(req.OS),
+			Network:       req.Network,
+			DirectFS:      req.DirectFS,
+			RunningSystem: req.RunningSystem,
+		},
+	}
+	d, ok := p.(plugin.Documenter)
+	if !ok {
+		return res
+	}
+	doc := d.Documentation()
+	if doc == nil {
+		return res
+	}
+	res.FilePatterns = doc.FilePatterns
+	res.Ecosystems = doc.Ecosystems
+	res.MetadataFields = fields(doc.Metadata)
+	return res
+}
+
+func osName(os plugin.OS) string {
+	switch os {
+	case plugin.OSLinux:
+		return "linux"
+	case plugin.OSWindows:
+		return "windows"
+	case plugin.OSMac:
+		return "mac"
+	case plugin.OSUnix:
+		return "unix"
+	default:
+		return "any"
+	}
+}
+
+// probe returns the paths the extractor's FileRequired function accepts. The
+// files are presented as small regular files.
+func probe(e filesystem.Extractor, paths []string) []string {
+	var res []string
+	for _, p := range paths {
+		if fileRequired(e, p, probeFileInfo{name: path.Base(p)}) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// probeFileInfo describes a 1 KiB regular file.
+type probeFileInfo struct {
+	name string
+}
+
+func (i probeFileInfo) Name() string       { return i.name }
+func (i probeFileInfo) Size() int64        { return 1024 }
+func (i probeFileInfo) Mode() fs.FileMode  { return 0644 }
+func (i probeFileInfo) ModTime() time.Time { return time.Time{} }
+func (i probeFileInfo) IsDir() bool        { return false }
+func (i probeFileInfo) Sys() any           { return nil }
+
+func fileRequired(e filesystem.Extractor, p string, info fs.FileInfo) (required bool) {
+	defer func() {
+		if recover() != nil {
+			required = false
+		}
+	}()
+	return e.FileRequired(simplefileapi.New(p, info))
+}
+
+// extractorEcosystems returns the ecosystems of the known manifests in paths
+// and the ecosystem the extractor reports independently of the inventory.
+func extractorEcosystems(e extractor.Extractor, paths []string) []string {
+	var res []string
+	for _, p := range paths {
+		if eco := coverage.Ecosystem(p); eco != "" {
+			res = append(res, eco)
+		}
+	}
+	if eco := staticEcosystem(e); eco != "" {
+		res = append(res, eco)
+	}
+	slices.Sort(res)
+	return slices.Compact(res)
+}
+
+// staticEcosystem returns the ecosystem reported for an inventory without
+// metadata. Extractors whose ecosystem depends on the metadata return an empty
+// value or panic.
+func staticEcosystem(e extractor.Extractor) (eco string) {
+	defer func() {
+		if recover() != nil {
+			eco = ""
+		}
+	}()
+	return e.Ecosystem(&extractor.Inventory{})
+}
+
+func fields(metadata any) []*Field {
+	if metadata == nil {
+		return nil
+	}
+	t := reflect.TypeOf(metadata)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	var res []*Field
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		res = append(res, &Field{Name: f.Name, Type: f.Type.String()})
+	}
+	return res
+}
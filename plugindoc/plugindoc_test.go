@@ -0,0 +1,163 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugindoc_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/plugindoc"
+	"github.com/google/osv-scalibr/testing/fakedetector"
+	"github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+type metadata struct {
+	PackageName string
+	Sources     []string
+	internal    int
+}
+
+type documentedExtractor struct {
+	filesystem.Extractor
+}
+
+func (documentedExtractor) Requirements() *plugin.Capabilities {
+	return &plugin.Capabilities{OS: plugin.OSLinux, DirectFS: true}
+}
+
+func (documentedExtractor) Documentation() *plugin.Documentation {
+	return &plugin.Documentation{
+		FilePatterns: []string{"var/lib/foo/*.db"},
+		Ecosystems:   []string{"Foo"},
+		Metadata:     &metadata{},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	cfg := plugindoc.Config{
+		FilesystemExtractors: []filesystem.Extractor{
+			fakeextractor.New("fake/lockfile", 2, []string{"app/package-lock.json", "app/yarn.lock"}, nil),
+			documentedExtractor{fakeextractor.New("fake/documented", 1, nil, nil)},
+		},
+		Detectors: []detector.Detector{
+			fakedetector.NewWithOptions(fakedetector.WithName("fake/detector"), fakedetector.WithRequiredExtractors("fake/lockfile")),
+		},
+		ProbePaths: []string{"app/package-lock.json", "app/yarn.lock", "app/go.mod"},
+	}
+	want := &plugindoc.Doc{Plugins: []*plugindoc.Plugin{
+		{
+			Name:               "fake/detector",
+			Type:               plugindoc.TypeDetector,
+			Requirements:       &plugindoc.Requirements{OS: "any"},
+			RequiredExtractors: []string{"fake/lockfile"},
+		},
+		{
+			Name:         "fake/documented",
+			Type:         plugindoc.TypeFilesystemExtractor,
+			Version:      1,
+			Requirements: &plugindoc.Requirements{OS: "linux", DirectFS: true},
+			FilePatterns: []string{"var/lib/foo/*.db"},
+			Ecosystems:   []string{"Foo"},
+			MetadataFields: []*plugindoc.Field{
+				{Name: "PackageName", Type: "string"},
+				{Name: "Sources", Type: "[]string"},
+			},
+		},
+		{
+			Name:         "fake/lockfile",
+			Type:         plugindoc.TypeFilesystemExtractor,
+			Version:      2,
+			Requirements: &plugindoc.Requirements{OS: "any"},
+			FilePatterns: []string{"app/package-lock.json", "app/yarn.lock"},
+			Ecosystems:   []string{"FakeEcosystem", "npm"},
+		},
+	}}
+
+	got := plugindoc.Generate(cfg)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Generate() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerateDefault(t *testing.T) {
+	doc := plugindoc.Generate(plugindoc.DefaultConfig())
+	seen := map[string]bool{}
+	for _, p := range doc.Plugins {
+		key := p.Type + "/" + p.Name
+		if seen[key] {
+			t.Errorf("Generate(DefaultConfig()): duplicate plugin %s", key)
+		}
+		seen[key] = true
+		if p.Type == plugindoc.TypeFilesystemExtractor && len(p.FilePatterns) == 0 {
+			t.Errorf("Generate(DefaultConfig()): no file patterns for %s, add a sample path to plugindoc.ProbePaths", p.Name)
+		}
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	doc := &plugindoc.Doc{Plugins: []*plugindoc.Plugin{{
+		Name:           "fake/lockfile",
+		Type:           plugindoc.TypeFilesystemExtractor,
+		Requirements:   &plugindoc.Requirements{OS: "any"},
+		FilePatterns:   []string{"package-lock.json"},
+		Ecosystems:     []string{"npm"},
+		MetadataFields: []*plugindoc.Field{{Name: "PackageName", Type: "string"}},
+	}}}
+
+	var buf bytes.Buffer
+	if err := doc.Write(&buf); err != nil {
+		t.Fatalf("Write(): %v", err)
+	}
+	got, err := plugindoc.Read(&buf)
+	if err != nil {
+		t.Fatalf("Read(): %v", err)
+	}
+	if diff := cmp.Diff(doc, got); diff != "" {
+		t.Errorf("Read(Write()) returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	oldDoc := &plugindoc.Doc{Plugins: []*plugindoc.Plugin{
+		{Name: "a", Type: plugindoc.TypeDetector},
+		{Name: "b", Type: plugindoc.TypeFilesystemExtractor, FilePatterns: []string{"b.lock"}},
+		{Name: "c", Type: plugindoc.TypeFilesystemExtractor},
+	}}
+	newDoc := &plugindoc.Doc{Plugins: []*plugindoc.Plugin{
+		{Name: "a", Type: plugindoc.TypeDetector},
+		{Name: "b", Type: plugindoc.TypeFilesystemExtractor, FilePatterns: []string{"b.lock", "b2.lock"}},
+		{Name: "d", Type: plugindoc.TypeStandaloneExtractor},
+	}}
+	want := &plugindoc.Changes{
+		Added:   []string{"d"},
+		Removed: []string{"c"},
+		Changed: []string{"b"},
+	}
+
+	got := plugindoc.Diff(oldDoc, newDoc)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Diff() returned unexpected diff (-want +got):\n%s", diff)
+	}
+	if got.Empty() {
+		t.Errorf("Diff().Empty() = true, want false")
+	}
+	if c := plugindoc.Diff(oldDoc, oldDoc); !c.Empty() {
+		t.Errorf("Diff(doc, doc) = %+v, want no changes", c)
+	}
+}
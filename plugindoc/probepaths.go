@@ -0,0 +1,158 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugindoc
+
+// ProbePaths are representative paths of the files SCALIBR extracts from. The
+// file patterns of extractors that don't document them are derived by matching
+// these against their FileRequired function, so new file formats should be
+// added here.
+var ProbePaths = []string{
+	// OS packages.
+	"var/lib/dpkg/status",
+	"var/lib/dpkg/status.d/foo",
+	"var/lib/opkg/status",
+	"lib/apk/db/installed",
+	"usr/lib/apk/db/installed",
+	"var/lib/rpm/Packages",
+	"var/lib/rpm/Packages.db",
+	"var/lib/rpm/rpmdb.sqlite",
+	"usr/lib/sysimage/rpm/rpmdb.sqlite",
+	"etc/cos-package-info.json",
+	"snap/foo/123/meta/snap.yaml",
+	"lib/modules/6.1.0/kernel/drivers/foo/foo.ko",
+	"var/lib/pacman/local/foo-1.0-1/desc",
+	"var/db/pkg/app-misc/foo-1.0/PF",
+	"var/lib/flatpak/app/org.foo.Bar/x86_64/stable/1234/export/share/metainfo/org.foo.Bar.metainfo.xml",
+	"usr/local/Cellar/foo/1.0/INSTALL_RECEIPT.json",
+	"Applications/Foo.app/Contents/Info.plist",
+	"data/system/packages.xml",
+	"system/build.prop",
+	"Users/foo/AppData/Local/Packages/Foo.Ubuntu_1234/LocalState/rootfs/var/lib/dpkg/status",
+	"Users/foo/AppData/Local/Packages/Foo.Ubuntu_1234/LocalState/ext4.vhdx",
+	"var/packages/Foo/INFO",
+	"etc/config/qpkg.conf",
+	// Language packages.
+	"package.json",
+	"node_modules/foo/package.json",
+	"package-lock.json",
+	"npm-shrinkwrap.json",
+	"yarn.lock",
+	"pnpm-lock.yaml",
+	"bun.lock",
+	"opt/foo/resources/app.asar",
+	"requirements.txt",
+	"Pipfile.lock",
+	"poetry.lock",
+	"pdm.lock",
+	"uv.lock",
+	"usr/lib/python3/dist-packages/foo-1.0.dist-info/METADATA",
+	"usr/lib/python3/dist-packages/foo-1.0.egg-info/PKG-INFO",
+	"opt/conda/envs/foo/conda-meta/foo-1.0-0.json",
+	"go.mod",
+	"MODULE.bazel.lock",
+	"Cargo.lock",
+	"foo-1.0.crate",
+	"vendor/foo/.cargo-checksum.json",
+	"pom.xml",
+	"gradle.lockfile",
+	"gradle/verification-metadata.xml",
+	"maven_install.json",
+	"foo.jar",
+	"foo.war",
+	"foo.ear",
+	"opt/tomcat/RELEASE-NOTES",
+	"opt/tomcat/webapps/foo.war",
+	"opt/tomcat/webapps/foo/WEB-INF/web.xml",
+	"Gemfile.lock",
+	"specifications/foo-1.0.gemspec",
+	"composer.lock",
+	"vendor/composer/installed.json",
+	"var/www/html/core/lib/Drupal.php",
+	"var/www/html/modules/contrib/foo/foo.info.yml",
+	"var/www/html/administrator/manifests/files/joomla.xml",
+	"var/www/html/administrator/components/com_foo/foo.xml",
+	"var/www/html/app/code/Foo/Bar/etc/module.xml",
+	"var/www/html/app/design/frontend/Foo/bar/theme.xml",
+	"usr/share/php/.registry/foo.reg",
+	"usr/share/php/.registry/.channel.pecl.php.net/foo.reg",
+	"usr/local/lib/perl/5.14.2/perllocal.pod",
+	"packages.lock.json",
+	"packages.config",
+	"App.deps.json",
+	"pubspec.lock",
+	"mix.lock",
+	"renv.lock",
+	"conan.lock",
+	"Package.resolved",
+	"Podfile.lock",
+	// SBOMs.
+	"foo.spdx.json",
+	"foo.spdx",
+	"foo.cdx.json",
+	"bom.json",
+	// Containers.
+	"var/lib/containerd/io.containerd.metadata.v1.bolt/meta.db",
+	"var/lib/ecs/data/ecs_agent_data.json",
+	"var/lib/ecs/data/agent.db",
+	"var/lib/kubelet/pods/00000000-0000-0000-0000-000000000000/etc-hosts",
+	// Misc.
+	"foo.snap",
+	"foo.flatpak",
+	"foo.AppImage",
+	"opt/foo/lib/libfoo.so.1",
+	"usr/local/lib/libfoo.so.1",
+	"etc/logstash/conf.d/main.conf",
+	"etc/fluent/fluent.conf",
+	"opt/splunkforwarder/etc/system/local/outputs.conf",
+	"etc/ceph/ceph.client.admin.keyring",
+	"var/lib/glusterd/glusterd.info",
+	"etc/ssl/glusterfs.key",
+	"etc/default/minio",
+	"root/.mc/config.json",
+	"hieradata/common.yaml",
+	"data_bags/users/admin.json",
+	"group_vars/all.yml",
+	"var/lib/postgresql/16/main/PG_VERSION",
+	"usr/local/pgsql/bin/postgres",
+	"var/lib/mysql/mysql_upgrade_info",
+	"opt/mysql/docs/INFO_SRC",
+	"var/opt/mssql/log/errorlog",
+	"etc/pip.conf",
+	"root/.npmrc",
+	"root/.config/go/env",
+	"etc/haproxy/haproxy.cfg",
+	"etc/envoy/envoy.yaml",
+	"etc/traefik/traefik.yml",
+	"usr/lib/jvm/java-17-openjdk-amd64/release",
+	"Windows/NTDS/ntds.dit",
+	"Windows/SYSVOL/domain/Policies/{31B2F340-016D-11D2-945F-00C04FB984F9}/Machine/Preferences/Groups/Groups.xml",
+	"Windows/SYSVOL/domain/scripts/logon.bat",
+	"home/deploy/.pm2/dump.pm2",
+	"srv/app/gunicorn.conf.py",
+	"etc/supervisor/conf.d/app.conf",
+	"etc/nginx/sites-enabled/app",
+	"srv/app/Passengerfile.json",
+	"etc/nova/nova.conf",
+	"etc/kolla/globals.yml",
+	"etc/cloudstack/management/db.properties",
+	"etc/pve/priv/token.cfg",
+	"etc/krb5.conf",
+	"etc/krb5.keytab",
+	"etc/sssd/sssd.conf",
+	"usr/sbin/lighttpd",
+	"bin/goahead",
+	"usr/sbin/mini_httpd",
+	"bin/busybox",
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalibr
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"slices"
+
+	"github.com/google/osv-scalibr/plugin"
+)
+
+const modulePath = "github.com/google/osv-scalibr"
+
+// LINT.IfChange
+
+// Provenance records the components and inputs that produced a ScanResult so
+// that results are reproducible and downstream systems can invalidate cached
+// results when any of them change.
+type Provenance struct {
+	// Version of the SCALIBR module that ran the scan.
+	ScalibrVersion string
+	// Names and versions of all plugins that were enabled.
+	Plugins []*PluginVersion
+	// Hex-encoded SHA-256 digest of the scan configuration.
+	ConfigDigest string
+	// Versioned data (e.g. advisory databases) used by the plugins.
+	DataSources []*plugin.DataSource
+}
+
+// PluginVersion is the name and version of a plugin.
+type PluginVersion struct {
+	Name    string
+	Version int
+}
+
+// LINT.ThenChange(/binary/proto/scan_result.proto)
+
+// ScannerVersion returns the version of the SCALIBR module that's linked into
+// the running binary, or "(devel)" if it can't be determined, e.g. when
+// running from a local checkout.
+func ScannerVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	if info.Main.Path == modulePath {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != modulePath {
+			continue
+		}
+		if dep.Replace != nil {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "(devel)"
+}
+
+// ValidatePluginVersions checks that all pinned plugins are enabled and have
+// the version they're pinned to.
+func (cfg *ScanConfig) ValidatePluginVersions() error {
+	if len(cfg.PinnedPluginVersions) == 0 {
+		return nil
+	}
+	unused := make(map[string]bool)
+	for name := range cfg.PinnedPluginVersions {
+		unused[name] = true
+	}
+	var errs []error
+	for _, p := range cfg.plugins() {
+		delete(unused, p.Name())
+		if err := plugin.ValidateVersion(p, cfg.PinnedPluginVersions); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	names := make([]string, 0, len(unused))
+	for name := range unused {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		errs = append(errs, fmt.Errorf("plugin %s is pinned but not enabled", name))
+	}
+	return errors.Join(errs...)
+}
+
+// plugins returns all enabled plugins.
+func (cfg *ScanConfig) plugins() []plugin.Plugin {
+	plugins := make([]plugin.Plugin, 0, len(cfg.FilesystemExtractors)+len(cfg.StandaloneExtractors)+len(cfg.Detectors))
+	for _, p := range cfg.FilesystemExtractors {
+		plugins = append(plugins, p)
+	}
+	for _, p := range cfg.StandaloneExtractors {
+		plugins = append(plugins, p)
+	}
+	for _, p := range cfg.Detectors {
+		plugins = append(plugins, p)
+	}
+	return plugins
+}
+
+// pluginProvenance returns the provenance of the plugins of cfg, without the
+// config digest.
+func pluginProvenance(cfg *ScanConfig) *Provenance {
+	p := &Provenance{
+		ScalibrVersion: ScannerVersion(),
+		Plugins:        []*PluginVersion{},
+		DataSources:    []*plugin.DataSource{},
+	}
+	for _, pl := range cfg.plugins() {
+		p.Plugins = append(p.Plugins, &PluginVersion{Name: pl.Name(), Version: pl.Version()})
+		p.DataSources = append(p.DataSources, plugin.DataSources(pl)...)
+	}
+	slices.SortFunc(p.Plugins, func(a, b *PluginVersion) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Version, b.Version))
+	})
+	slices.SortFunc(p.DataSources, func(a, b *plugin.DataSource) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Location, b.Location))
+	})
+	return p
+}
+
+// newProvenance computes the provenance of a scan run with the given config
+// from the provenance of its plugins.
+func newProvenance(cfg *ScanConfig, plugins *Provenance) (*Provenance, error) {
+	p := &Provenance{
+		ScalibrVersion: plugins.ScalibrVersion,
+		Plugins:        slices.Clone(plugins.Plugins),
+		DataSources:    slices.Clone(plugins.DataSources),
+	}
+	digest, err := configDigest(cfg, p)
+	if err != nil {
+		return nil, err
+	}
+	p.ConfigDigest = digest
+	return p, nil
+}
+
+// configDigest returns the SHA-256 digest of the settings of cfg that affect
+// the scan results. The stats collector and print settings are left out as
+// they don't change the results. SkipDirGlob is left out as compiled globs
+// can't be serialized.
+func configDigest(cfg *ScanConfig, p *Provenance) (string, error) {
+	var scanRoots []string
+	for _, r := range cfg.ScanRoots {
+		scanRoots = append(scanRoots, r.Path)
+	}
+	skipDirRegex := ""
+	if cfg.SkipDirRegex != nil {
+		skipDirRegex = cfg.SkipDirRegex.String()
+	}
+	b, err := json.Marshal(struct {
+		ScalibrVersion    string
+		Plugins           []*PluginVersion
+		DataSources       []*plugin.DataSource
+		Capabilities      *plugin.Capabilities
+		ScanRoots         []string
+		FilesToExtract    []string
+		DirsToSkip        []string
+		SkipDirRegex      string
+		ReadSymlinks      bool
+		MaxInodes         int
+		StoreAbsolutePath bool
+		ErrorOnFSErrors   bool
+		ReportCoverage    bool
+		DetectLicenses    bool
+		ExtractorRetries  int
+	}{
+		ScalibrVersion:    p.ScalibrVersion,
+		Plugins:           p.Plugins,
+		DataSources:       p.DataSources,
+		Capabilities:      cfg.Capabilities,
+		ScanRoots:         scanRoots,
+		FilesToExtract:    cfg.FilesToExtract,
+		DirsToSkip:        cfg.DirsToSkip,
+		SkipDirRegex:      skipDirRegex,
+		ReadSymlinks:      cfg.ReadSymlinks,
+		MaxInodes:         cfg.MaxInodes,
+		StoreAbsolutePath: cfg.StoreAbsolutePath,
+		ErrorOnFSErrors:   cfg.ErrorOnFSErrors,
+		ReportCoverage:    cfg.ReportCoverage,
+		DetectLicenses:    cfg.DetectLicenses,
+		ExtractorRetries:  cfg.ExtractorRetries,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -31,6 +31,8 @@ const (
 	TypeAlpm = "alpm"
 	// TypeApk is a pkg:apk purl.
 	TypeApk = "apk"
+	// TypeBazel is a pkg:bazel purl.
+	TypeBazel = "bazel"
 	// TypeBitbucket is a pkg:bitbucket purl.
 	TypeBitbucket = "bitbucket"
 	// TypeBrew is a pkg:brew purl.
@@ -47,6 +49,8 @@ const (
 	TypeConda = "conda"
 	// COS is the pkg:cos purl
 	TypeCOS = "cos"
+	// TypeCPAN is a pkg:cpan purl.
+	TypeCPAN = "cpan"
 	// TypeCran is a pkg:cran purl.
 	TypeCran = "cran"
 	// TypeDebian is a pkg:deb purl.
@@ -158,6 +162,7 @@ func validType(t string) bool {
 	types := map[string]bool{
 		TypeAlpm:         true,
 		TypeApk:          true,
+		TypeBazel:        true,
 		TypeBitbucket:    true,
 		TypeBrew:         true,
 		TypeCargo:        true,
@@ -166,6 +171,7 @@ func validType(t string) bool {
 		TypeConan:        true,
 		TypeConda:        true,
 		TypeCOS:          true,
+		TypeCPAN:         true,
 		TypeCran:         true,
 		TypeDebian:       true,
 		TypePacman:       true,
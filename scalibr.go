@@ -27,16 +27,28 @@ import (
 	"time"
 
 	"github.com/gobwas/glob"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/osv-scalibr/artifact/image/layerscanning/baseimage"
 	"github.com/google/osv-scalibr/artifact/image/layerscanning/image"
 	"github.com/google/osv-scalibr/artifact/image/layerscanning/trace"
 	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/localization"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/cache"
+	"github.com/google/osv-scalibr/extractor/filesystem/coverage"
+	"github.com/google/osv-scalibr/extractor/filesystem/quarantine"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/extractor/filesystem/throttle"
 	"github.com/google/osv-scalibr/extractor/standalone"
+	"github.com/google/osv-scalibr/inventory/license"
 	"github.com/google/osv-scalibr/inventoryindex"
 	"github.com/google/osv-scalibr/log"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/plugin/budget"
 	"github.com/google/osv-scalibr/stats"
+	"github.com/google/osv-scalibr/veles"
 
 	el "github.com/google/osv-scalibr/extractor/filesystem/list"
 	sl "github.com/google/osv-scalibr/extractor/standalone/list"
@@ -46,6 +58,7 @@ import (
 var (
 	errNoScanRoot            = fmt.Errorf("no scan root specified")
 	errFilesWithSeveralRoots = fmt.Errorf("can't extract specific files with several scan roots")
+	errLocaleWithoutCatalog  = fmt.Errorf("a locale was specified without a message catalog")
 )
 
 // Scanner is the main entry point of the scanner.
@@ -95,6 +108,75 @@ type ScanConfig struct {
 	PrintDurationAnalysis bool
 	// Optional: If true, fail the scan if any permission errors are encountered.
 	ErrorOnFSErrors bool
+	// Optional: If true, report known manifests and lockfiles that none of the
+	// enabled extractors could parse in ScanResult.Coverage.
+	ReportCoverage bool
+	// Optional: If true, detect the licenses of the packages found from their
+	// metadata and license files and store them as SPDX license expressions in
+	// the Licenses of the inventory. The locations of the packages are resolved
+	// relative to the first scan root. Packages passed to the ResultSink while
+	// the scan is running don't have their licenses set.
+	DetectLicenses bool
+	// Optional: Plugin names mapped to the version they're pinned to. The scan
+	// fails if a pinned plugin isn't enabled or has a different version.
+	PinnedPluginVersions map[string]int
+	// Optional: Veles detectors to run on the contents of the files visited
+	// by the filesystem walk. The secrets found are stored in
+	// ScanResult.Secrets.
+	SecretDetectors []veles.Detector
+	// Optional: Number of files scanned for secrets in parallel. If 0, the
+	// number of CPUs is used.
+	SecretScanWorkers int
+	// Optional: Validates the secrets found, e.g. by authenticating against the
+	// service they belong to. If nil, the secrets aren't validated.
+	SecretValidation *veles.ValidationEngine
+	// Optional: Number of times a filesystem extractor that panicked on a file
+	// is run on it again before the file is quarantined.
+	ExtractorRetries int
+	// Optional: If set, a reproduction bundle is written to this directory for
+	// each quarantined file.
+	QuarantineBundleDir string
+	// Optional: If set, the filesystem scan is throttled to limit its impact on
+	// other workloads of the host. The scan can be paused and resumed through
+	// the throttler while it runs.
+	Throttle *throttle.Throttler
+	// Optional: Labels to tag the scan result with, e.g. the tenant,
+	// environment or owner of the scanned system. They're copied unchanged
+	// into ScanResult.Labels and all output formats.
+	Labels map[string]string
+	// Optional: If non-zero, the scan is aborted and fails once it has run for
+	// this long.
+	Timeout time.Duration
+	// Optional: If set, filesystem extractors are only run on the files that
+	// changed since their results were cached. The caller is responsible for
+	// closing the cache after the scan.
+	Cache cache.Cache
+	// Optional: If set, the packages, findings and secrets are passed to this
+	// sink instead of being stored in the ScanResult. The packages of the
+	// filesystem extractors are passed as soon as each file is extracted; if
+	// detectors are enabled, they're also kept in memory until the detectors
+	// ran. Container scans attribute packages to layers after the scan, so
+	// they pass everything at the end of the scan.
+	ResultSink ResultSink
+	// Optional: If set, plugins are cut off once they exceed their budget of
+	// wall time, files opened and bytes read, so that a misbehaving plugin
+	// can't stall the scan. The limit a plugin exceeded is reported in its
+	// status.
+	PluginBudgets *budget.Budgets
+	// Optional: Catalog of known base images. Container scans attribute the
+	// packages of the layers that match a catalog image to that base image
+	// instead of guessing the base image from the image history.
+	BaseImageCatalog *baseimage.Catalog
+	// Optional: Locale to report the titles, descriptions and recommendations
+	// of findings in, e.g. "de" or "pt-BR". Requires MessageCatalog. Findings
+	// without a translation in the catalog keep their English texts.
+	Locale string
+	// Optional: Translations of the advisories of the detectors.
+	MessageCatalog *localization.Catalog
+
+	// Set by WithOfflineMode. NewScanConfig turns off network access after
+	// all options are applied, so that later options can't turn it on again.
+	offlineMode bool
 }
 
 // EnableRequiredExtractors adds those extractors to the config that are required by enabled
@@ -129,21 +211,26 @@ func (cfg *ScanConfig) EnableRequiredExtractors() error {
 	return nil
 }
 
-// ValidatePluginRequirements checks that the scanning environment's capabilities satisfy
-// the requirements of all enabled plugin.
-func (cfg *ScanConfig) ValidatePluginRequirements() error {
-	plugins := make([]plugin.Plugin, 0, len(cfg.FilesystemExtractors)+len(cfg.StandaloneExtractors)+len(cfg.Detectors))
-	for _, p := range cfg.FilesystemExtractors {
-		plugins = append(plugins, p)
+// preparePlugins enables the extractors required by the enabled plugins,
+// validates the plugins and returns their provenance.
+func (cfg *ScanConfig) preparePlugins() (*Provenance, error) {
+	if err := cfg.EnableRequiredExtractors(); err != nil {
+		return nil, err
 	}
-	for _, p := range cfg.StandaloneExtractors {
-		plugins = append(plugins, p)
+	if err := cfg.ValidatePluginRequirements(); err != nil {
+		return nil, err
 	}
-	for _, p := range cfg.Detectors {
-		plugins = append(plugins, p)
+	if err := cfg.ValidatePluginVersions(); err != nil {
+		return nil, err
 	}
+	return pluginProvenance(cfg), nil
+}
+
+// ValidatePluginRequirements checks that the scanning environment's capabilities satisfy
+// the requirements of all enabled plugin.
+func (cfg *ScanConfig) ValidatePluginRequirements() error {
 	errs := []error{}
-	for _, p := range plugins {
+	for _, p := range cfg.plugins() {
 		if err := plugin.ValidateRequirements(p, cfg.Capabilities); err != nil {
 			errs = append(errs, err)
 		}
@@ -164,31 +251,68 @@ type ScanResult struct {
 	PluginStatus []*plugin.Status
 	Inventories  []*extractor.Inventory
 	Findings     []*detector.Finding
+	// Known manifests and lockfiles that couldn't be parsed. Only set if
+	// ScanConfig.ReportCoverage is true.
+	Coverage *coverage.Report
+	// The components and inputs that produced this result.
+	Provenance *Provenance
+	// Secrets found in the scanned files. Only set if
+	// ScanConfig.SecretDetectors is non-empty.
+	Secrets []*secrets.Secret
+	// Files that made an extractor panic. Only set if any files were
+	// quarantined.
+	Quarantine *quarantine.Report
+	// The labels from ScanConfig.Labels.
+	Labels map[string]string
 }
 
 // LINT.ThenChange(/binary/proto/scan_result.proto)
 
 // Scan executes the extraction and detection using the provided scan config.
 func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
+	return scan(ctx, config, nil)
+}
+
+// scan runs a scan with config. If plugins is non-nil, the plugins of config
+// were already prepared by a Session and plugins is their provenance, so
+// they're not set up and validated again.
+func scan(ctx context.Context, config *ScanConfig, plugins *Provenance) (sr *ScanResult) {
 	if config.Stats == nil {
 		config.Stats = stats.NoopCollector{}
 	}
 	defer func() {
 		config.Stats.AfterScan(time.Since(sr.StartTime), sr.Status)
 	}()
+	if config.ResultSink != nil {
+		// Everything that wasn't streamed yet is passed to the sink once the scan
+		// is done.
+		defer func() { flushToSink(config.ResultSink, sr) }()
+	}
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
+	}
 	sro := &newScanResultOptions{
 		StartTime:   time.Now(),
 		Inventories: []*extractor.Inventory{},
 		Findings:    []*detector.Finding{},
+		Labels:      config.Labels,
+	}
+	var err error
+	if plugins == nil {
+		plugins, err = config.preparePlugins()
 	}
-	if err := config.EnableRequiredExtractors(); err != nil {
+	if err != nil {
 		sro.Err = err
-	} else if err := config.ValidatePluginRequirements(); err != nil {
+	} else if sro.Provenance, err = newProvenance(config, plugins); err != nil {
 		sro.Err = err
 	} else if len(config.ScanRoots) == 0 {
 		sro.Err = errNoScanRoot
 	} else if len(config.FilesToExtract) > 0 && len(config.ScanRoots) > 1 {
 		sro.Err = errFilesWithSeveralRoots
+	} else if config.Locale != "" && config.MessageCatalog == nil {
+		sro.Err = errLocaleWithoutCatalog
 	}
 	if sro.Err != nil {
 		sro.EndTime = time.Now()
@@ -207,8 +331,43 @@ func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
 		StoreAbsolutePath:     config.StoreAbsolutePath,
 		PrintDurationAnalysis: config.PrintDurationAnalysis,
 		ErrorOnFSErrors:       config.ErrorOnFSErrors,
+		Quarantine:            &quarantine.Report{},
+		ExtractorRetries:      config.ExtractorRetries,
+		QuarantineBundleDir:   config.QuarantineBundleDir,
+		Throttle:              config.Throttle,
+		Cache:                 config.Cache,
+		Budgets:               config.PluginBudgets,
+	}
+	var forwarder *inventoryForwarder
+	if config.ResultSink != nil {
+		forwarder = &inventoryForwarder{sink: config.ResultSink, keep: len(config.Detectors) > 0}
+		extractorConfig.InventorySink = forwarder
+	}
+	if config.ReportCoverage {
+		sro.Coverage = &coverage.Report{UnparsedFiles: []*coverage.UnparsedFile{}}
+		extractorConfig.Coverage = sro.Coverage
+	}
+	if len(config.SecretDetectors) > 0 {
+		secretsConfig := secrets.DefaultConfig(config.SecretDetectors)
+		if config.SecretScanWorkers > 0 {
+			secretsConfig.Workers = config.SecretScanWorkers
+		}
+		secretsConfig.Validation = config.SecretValidation
+		scanner, err := secrets.New(ctx, secretsConfig)
+		if err != nil {
+			sro.Err = err
+			sro.EndTime = time.Now()
+			return newScanResult(sro)
+		}
+		extractorConfig.Secrets = scanner
 	}
 	inventories, extractorStatus, err := filesystem.Run(ctx, extractorConfig)
+	if extractorConfig.Secrets != nil {
+		sro.Secrets = extractorConfig.Secrets.Close()
+	}
+	if len(extractorConfig.Quarantine.Files) > 0 {
+		sro.Quarantine = extractorConfig.Quarantine
+	}
 	if err != nil {
 		sro.Err = err
 		sro.EndTime = time.Now()
@@ -232,20 +391,36 @@ func (Scanner) Scan(ctx context.Context, config *ScanConfig) (sr *ScanResult) {
 	sro.Inventories = append(sro.Inventories, standaloneInv...)
 	sro.ExtractorStatus = append(sro.ExtractorStatus, standaloneStatus...)
 
-	ix, err := inventoryindex.New(sro.Inventories)
+	if config.DetectLicenses {
+		err := license.New(license.DefaultConfig()).Run(ctx, &scalibrfs.ScanRoot{FS: sysroot.FS, Path: sysroot.Path}, sro.Inventories)
+		if err != nil {
+			sro.Err = err
+			sro.EndTime = time.Now()
+			return newScanResult(sro)
+		}
+	}
+
+	detectorInventories := sro.Inventories
+	if forwarder != nil {
+		detectorInventories = append(forwarder.inventories, sro.Inventories...)
+	}
+	ix, err := inventoryindex.New(detectorInventories)
 	if err != nil {
 		sro.Err = err
 		sro.EndTime = time.Now()
 		return newScanResult(sro)
 	}
 
-	findings, detectorStatus, err := detector.Run(
+	findings, detectorStatus, err := detector.RunWithBudgets(
 		ctx, config.Stats, config.Detectors, &scalibrfs.ScanRoot{FS: sysroot.FS, Path: sysroot.Path}, ix,
+		config.PluginBudgets,
 	)
 	sro.Findings = findings
 	sro.DetectorStatus = detectorStatus
 	if err != nil {
 		sro.Err = err
+	} else if config.Locale != "" {
+		sro.Err = config.MessageCatalog.Localize(findings, config.Locale)
 	}
 
 	sro.EndTime = time.Now()
@@ -269,6 +444,10 @@ func (s Scanner) ScanContainer(ctx context.Context, img *image.Image, config *Sc
 	finalChainLayer := chainLayers[len(chainLayers)-1]
 	chainfs := finalChainLayer.FS()
 
+	// The scan roots and result sink are replaced for the scan of the image,
+	// so work on a copy to leave the caller's config unchanged.
+	cfg := *config
+	config = &cfg
 	if config.ScanRoots != nil && len(config.ScanRoots) > 0 {
 		log.Warnf("expected no scan roots, but got %d scan roots, overwriting with container image scan root", len(config.ScanRoots))
 	}
@@ -279,6 +458,9 @@ func (s Scanner) ScanContainer(ctx context.Context, img *image.Image, config *Sc
 		},
 	}
 
+	// The packages can only be passed to the sink once their layers are known.
+	sink := config.ResultSink
+	config.ResultSink = nil
 	scanResult := s.Scan(ctx, config)
 	inventory := scanResult.Inventories
 	extractorConfig := &filesystem.Config{
@@ -297,9 +479,66 @@ func (s Scanner) ScanContainer(ctx context.Context, img *image.Image, config *Sc
 
 	// Populate the LayerDetails field of the inventory by tracing the layer origins.
 	trace.PopulateLayerDetails(ctx, inventory, chainLayers, extractorConfig)
+	// The chain layers are indexed like the image history, so the layers up to the base image index
+	// are the ones of the base image.
+	for _, inv := range inventory {
+		if inv.LayerDetails != nil && img.BaseImageIndex >= 0 {
+			inv.LayerDetails.InBaseImage = inv.LayerDetails.Index <= img.BaseImageIndex
+		}
+	}
+	if config.BaseImageCatalog != nil {
+		if e := config.BaseImageCatalog.Enrich(inventory, trace.ChainIDs(chainLayers)); e != nil {
+			log.Infof("Image is based on %s", e.Name)
+		}
+	}
+	if sink != nil {
+		flushToSink(sink, scanResult)
+	}
 	return scanResult, nil
 }
 
+// ScanImageTarball scans the container image stored in the tarball at tarPath, e.g. one written by
+// `docker save` or an OCI image layout archive, in the same way as ScanContainer. The layers are
+// unpacked into a temporary directory that is removed after the scan.
+func (s Scanner) ScanImageTarball(ctx context.Context, tarPath string, config *ScanConfig) (*ScanResult, error) {
+	img, err := image.FromTarball(tarPath, image.DefaultConfig())
+	if img != nil {
+		defer func() {
+			if err := img.CleanUp(); err != nil {
+				log.Warnf("failed to remove the unpacked image layers: %v", err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.ScanContainer(ctx, img, config)
+}
+
+// ScanRemoteImage pulls the container image with the given name from its registry, e.g.
+// "registry.example.com/app:1.2" or a digest reference, and scans it in the same way as
+// ScanContainer. Registry credentials are read from the docker config and its credential helpers
+// unless imageOptions set other ones. The layers are fetched one by one while they're unpacked into
+// a temporary directory that is removed after the scan, so no local docker daemon is needed.
+func (s Scanner) ScanRemoteImage(ctx context.Context, imageName string, config *ScanConfig, imageOptions ...remote.Option) (*ScanResult, error) {
+	opts := append([]remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(authn.DefaultKeychain),
+	}, imageOptions...)
+	img, err := image.FromRemoteName(imageName, image.DefaultConfig(), opts...)
+	if img != nil {
+		defer func() {
+			if err := img.CleanUp(); err != nil {
+				log.Warnf("failed to remove the unpacked image layers: %v", err)
+			}
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.ScanContainer(ctx, img, config)
+}
+
 type newScanResultOptions struct {
 	StartTime       time.Time
 	EndTime         time.Time
@@ -307,6 +546,11 @@ type newScanResultOptions struct {
 	Inventories     []*extractor.Inventory
 	DetectorStatus  []*plugin.Status
 	Findings        []*detector.Finding
+	Coverage        *coverage.Report
+	Provenance      *Provenance
+	Secrets         []*secrets.Secret
+	Quarantine      *quarantine.Report
+	Labels          map[string]string
 	Err             error
 }
 
@@ -325,6 +569,11 @@ func newScanResult(o *newScanResultOptions) *ScanResult {
 		PluginStatus: append(o.ExtractorStatus, o.DetectorStatus...),
 		Inventories:  o.Inventories,
 		Findings:     o.Findings,
+		Coverage:     o.Coverage,
+		Provenance:   o.Provenance,
+		Secrets:      o.Secrets,
+		Quarantine:   o.Quarantine,
+		Labels:       o.Labels,
 	}
 
 	// Sort results for better diffing.
@@ -350,6 +599,12 @@ func sortResults(results *ScanResult) {
 	slices.SortFunc(results.PluginStatus, cmpStatus)
 	slices.SortFunc(results.Inventories, CmpInventories)
 	slices.SortFunc(results.Findings, cmpFindings)
+	if results.Coverage != nil {
+		slices.SortFunc(results.Coverage.UnparsedFiles, cmpUnparsedFiles)
+	}
+	if results.Quarantine != nil {
+		slices.SortFunc(results.Quarantine.Files, cmpQuarantinedFiles)
+	}
 }
 
 // CmpInventories is a comparison helper fun to be used for sorting Inventory structs.
@@ -378,6 +633,17 @@ func cmpFindings(a, b *detector.Finding) int {
 	return cmpString(a.Extra, b.Extra)
 }
 
+func cmpUnparsedFiles(a, b *coverage.UnparsedFile) int {
+	return cmpString(a.Path, b.Path)
+}
+
+func cmpQuarantinedFiles(a, b *quarantine.File) int {
+	if a.Path != b.Path {
+		return cmpString(a.Path, b.Path)
+	}
+	return cmpString(a.Extractor, b.Extractor)
+}
+
 func cmpString(a, b string) int {
 	if a < b {
 		return -1
@@ -18,23 +18,37 @@ import (
 	"context"
 	"errors"
 	"io/fs"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
 	scalibr "github.com/google/osv-scalibr"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/osv-scalibr/artifact/image/layerscanning/baseimage"
 	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/detector/localization"
 	"github.com/google/osv-scalibr/extractor"
 	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/coverage"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
 	scalibrfs "github.com/google/osv-scalibr/fs"
 	"github.com/google/osv-scalibr/inventoryindex"
 	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/plugin/budget"
 	"github.com/google/osv-scalibr/purl"
 	fd "github.com/google/osv-scalibr/testing/fakedetector"
 	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
 )
 
 func TestScan(t *testing.T) {
@@ -177,7 +191,9 @@ func TestScan(t *testing.T) {
 			tc.want.StartTime = got.StartTime
 			tc.want.EndTime = got.EndTime
 
-			if diff := cmp.Diff(tc.want, got, fe.AllowUnexported); diff != "" {
+			// Provenance is tested separately in TestScan_Provenance.
+			ignoreProvenance := cmpopts.IgnoreFields(scalibr.ScanResult{}, "Provenance")
+			if diff := cmp.Diff(tc.want, got, fe.AllowUnexported, ignoreProvenance); diff != "" {
 				t.Errorf("scalibr.New().Scan(%v): unexpected diff (-want +got):\n%s", tc.cfg, diff)
 			}
 		})
@@ -360,6 +376,547 @@ func TestValidatePluginRequirements(t *testing.T) {
 	}
 }
 
+func TestValidatePluginVersions(t *testing.T) {
+	ex := fe.New("python/wheelegg", 1, nil, nil)
+	det := fd.New("detector", 2, nil, nil)
+	cases := []struct {
+		desc    string
+		pinned  map[string]int
+		wantErr error
+	}{
+		{
+			desc:    "no pinned versions",
+			wantErr: nil,
+		},
+		{
+			desc:    "versions match",
+			pinned:  map[string]int{"python/wheelegg": 1, "detector": 2},
+			wantErr: nil,
+		},
+		{
+			desc:    "version mismatch",
+			pinned:  map[string]int{"detector": 3},
+			wantErr: cmpopts.AnyError,
+		},
+		{
+			desc:    "pinned plugin not enabled",
+			pinned:  map[string]int{"python/requirements": 0},
+			wantErr: cmpopts.AnyError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &scalibr.ScanConfig{
+				FilesystemExtractors: []filesystem.Extractor{ex},
+				Detectors:            []detector.Detector{det},
+				PinnedPluginVersions: tc.pinned,
+			}
+			if err := cfg.ValidatePluginVersions(); !cmp.Equal(tc.wantErr, err, cmpopts.EquateErrors()) {
+				t.Fatalf("ValidatePluginVersions() error: %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// fakeDetWithData is a detector that reports a data source.
+type fakeDetWithData struct {
+	fakeDetNeedsFS
+	dbVersion string
+}
+
+func (fakeDetWithData) Requirements() *plugin.Capabilities { return &plugin.Capabilities{} }
+func (d fakeDetWithData) DataSources() []*plugin.DataSource {
+	return []*plugin.DataSource{{Name: "advisory-db", Location: "/db", Version: d.dbVersion}}
+}
+
+func TestScan_Provenance(t *testing.T) {
+	tmp := t.TempDir()
+	newConfig := func(dbVersion string) *scalibr.ScanConfig {
+		return &scalibr.ScanConfig{
+			FilesystemExtractors: []filesystem.Extractor{fe.New("python/wheelegg", 1, nil, nil)},
+			Detectors:            []detector.Detector{fakeDetWithData{dbVersion: dbVersion}},
+			ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		}
+	}
+
+	got := scalibr.New().Scan(context.Background(), newConfig("2024-01-01")).Provenance
+	if got == nil {
+		t.Fatal("Scan(): Provenance is nil")
+	}
+	want := &scalibr.Provenance{
+		ScalibrVersion: scalibr.ScannerVersion(),
+		Plugins: []*scalibr.PluginVersion{
+			{Name: "fake-extractor", Version: 0},
+			{Name: "python/wheelegg", Version: 1},
+		},
+		DataSources: []*plugin.DataSource{{Name: "advisory-db", Location: "/db", Version: "2024-01-01"}},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(scalibr.Provenance{}, "ConfigDigest")); diff != "" {
+		t.Errorf("Scan(): unexpected provenance (-want +got):\n%s", diff)
+	}
+	if len(got.ConfigDigest) != 64 {
+		t.Errorf("Scan(): ConfigDigest = %q, want a hex-encoded SHA-256 digest", got.ConfigDigest)
+	}
+
+	same := scalibr.New().Scan(context.Background(), newConfig("2024-01-01")).Provenance
+	if same.ConfigDigest != got.ConfigDigest {
+		t.Errorf("Scan(): ConfigDigest differs between identical configs: %q vs %q", got.ConfigDigest, same.ConfigDigest)
+	}
+	updated := scalibr.New().Scan(context.Background(), newConfig("2024-02-01")).Provenance
+	if updated.ConfigDigest == got.ConfigDigest {
+		t.Errorf("Scan(): ConfigDigest didn't change after a data source update: %q", got.ConfigDigest)
+	}
+}
+
+func TestScan_Labels(t *testing.T) {
+	tmp := t.TempDir()
+	labels := map[string]string{"tenant": "acme", "environment": "prod"}
+	cfg := &scalibr.ScanConfig{
+		ScanRoots: []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		Labels:    labels,
+	}
+	got := scalibr.New().Scan(context.Background(), cfg).Labels
+	if diff := cmp.Diff(labels, got); diff != "" {
+		t.Errorf("Scan(): unexpected labels (-want +got):\n%s", diff)
+	}
+
+	// Labels are also set on failed scans.
+	cfg.ScanRoots = nil
+	got = scalibr.New().Scan(context.Background(), cfg).Labels
+	if diff := cmp.Diff(labels, got); diff != "" {
+		t.Errorf("Scan() without scan roots: unexpected labels (-want +got):\n%s", diff)
+	}
+}
+
+func TestScan_Locale(t *testing.T) {
+	tmp := t.TempDir()
+	finding := &detector.Finding{Adv: &detector.Advisory{
+		ID:         &detector.AdvisoryID{Publisher: "SCALIBR", Reference: "weak-password"},
+		MessageKey: "weak-password",
+		Title:      "Weak password",
+	}}
+	catalog, err := localization.NewCatalog(map[string]map[detector.MessageKey]localization.Messages{
+		"de": {"weak-password": {Title: "Schwaches Passwort"}},
+	})
+	if err != nil {
+		t.Fatalf("localization.NewCatalog(): %v", err)
+	}
+	cfg := &scalibr.ScanConfig{
+		ScanRoots:      []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		Detectors:      []detector.Detector{fd.New("detector", 2, finding, nil)},
+		Locale:         "de-AT",
+		MessageCatalog: catalog,
+	}
+	sr := scalibr.New().Scan(context.Background(), cfg)
+	if sr.Status.Status != plugin.ScanStatusSucceeded {
+		t.Fatalf("Scan() status: %v", sr.Status)
+	}
+	want := &detector.Advisory{
+		ID:         &detector.AdvisoryID{Publisher: "SCALIBR", Reference: "weak-password"},
+		MessageKey: "weak-password",
+		Title:      "Schwaches Passwort",
+		Locale:     "de",
+	}
+	if len(sr.Findings) != 1 {
+		t.Fatalf("Scan() returned %d findings, want 1", len(sr.Findings))
+	}
+	if diff := cmp.Diff(want, sr.Findings[0].Adv); diff != "" {
+		t.Errorf("Scan(): unexpected advisory (-want +got):\n%s", diff)
+	}
+
+	cfg.MessageCatalog = nil
+	if sr := scalibr.New().Scan(context.Background(), cfg); sr.Status.Status != plugin.ScanStatusFailed {
+		t.Errorf("Scan() with a locale but no catalog: got status %v, want failure", sr.Status)
+	}
+}
+
+func TestScan_DetectLicenses(t *testing.T) {
+	tmp := t.TempDir()
+	dir := filepath.Join(tmp, "node_modules", "foo")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("os.MkdirAll(%s): %v", dir, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"license": "MIT"}`), 0644); err != nil {
+		t.Fatalf("os.WriteFile(): %v", err)
+	}
+	ex := fe.New("javascript/packagejson", 1, []string{"node_modules/foo/package.json"}, map[string]fe.NamesErr{
+		"node_modules/foo/package.json": {Names: []string{"foo"}},
+	})
+	cfg := &scalibr.ScanConfig{
+		ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		FilesystemExtractors: []filesystem.Extractor{ex},
+		DetectLicenses:       true,
+	}
+
+	sr := scalibr.New().Scan(context.Background(), cfg)
+	if sr.Status.Status != plugin.ScanStatusSucceeded {
+		t.Fatalf("Scan() status: %v", sr.Status)
+	}
+	if len(sr.Inventories) != 1 {
+		t.Fatalf("Scan() returned %d inventories, want 1", len(sr.Inventories))
+	}
+	if diff := cmp.Diff([]string{"MIT"}, sr.Inventories[0].Licenses); diff != "" {
+		t.Errorf("Scan(): unexpected licenses (-want +got):\n%s", diff)
+	}
+}
+
+func TestScanImageTarball(t *testing.T) {
+	// basic.tar has a layer that adds sample.txt and one that adds larger-sample.txt.
+	tarPath := filepath.Join("artifact", "image", "layerscanning", "image", "testdata", "basic.tar")
+	ex := fe.New("python/wheelegg", 1, []string{"sample.txt", "larger-sample.txt"}, map[string]fe.NamesErr{
+		"sample.txt":        {Names: []string{"sample"}},
+		"larger-sample.txt": {Names: []string{"larger-sample"}},
+	})
+	cfg := &scalibr.ScanConfig{FilesystemExtractors: []filesystem.Extractor{ex}}
+
+	got, err := scalibr.New().ScanImageTarball(context.Background(), tarPath, cfg)
+	if err != nil {
+		t.Fatalf("ScanImageTarball(%s): %v", tarPath, err)
+	}
+	want := map[string]*extractor.LayerDetails{
+		"sample": {
+			Index:   0,
+			DiffID:  "c1aaf9767066c805f7315d98f60ad7b4c638e4d6f81a72e67f79b31528ad889f",
+			ChainID: "c1aaf9767066c805f7315d98f60ad7b4c638e4d6f81a72e67f79b31528ad889f",
+			Command: "COPY sample.txt / # buildkit",
+		},
+		"larger-sample": {
+			Index:   1,
+			DiffID:  "eb9be5a47890de4137bbe15e07519a8c43e88a44fd39e173ef06e1ec4bdfc37c",
+			ChainID: "e0be4f641f58f8edd3712e5a46d52b3cfce744bb84d6ffc516b2dacc40da9710",
+			Command: "COPY larger-sample.txt / # buildkit",
+		},
+	}
+	gotDetails := map[string]*extractor.LayerDetails{}
+	for _, inv := range got.Inventories {
+		gotDetails[inv.Name] = inv.LayerDetails
+	}
+	if diff := cmp.Diff(want, gotDetails); diff != "" {
+		t.Errorf("ScanImageTarball(%s): unexpected layer details (-want +got):\n%s", tarPath, diff)
+	}
+
+	if _, err := scalibr.New().ScanImageTarball(context.Background(), filepath.Join(t.TempDir(), "missing.tar"), cfg); err == nil {
+		t.Error("ScanImageTarball() of a missing tarball: got nil error, want error")
+	}
+}
+
+func TestScanImageTarball_BaseImageCatalog(t *testing.T) {
+	tarPath := filepath.Join("artifact", "image", "layerscanning", "image", "testdata", "basic.tar")
+	ex := fe.New("python/wheelegg", 1, []string{"sample.txt", "larger-sample.txt"}, map[string]fe.NamesErr{
+		"sample.txt":        {Names: []string{"sample"}},
+		"larger-sample.txt": {Names: []string{"larger-sample"}},
+	})
+	// The first layer of basic.tar is the base image.
+	catalog, err := baseimage.NewCatalog([]baseimage.Entry{{
+		Name:     "example.com/base:1",
+		ChainIDs: []string{"sha256:c1aaf9767066c805f7315d98f60ad7b4c638e4d6f81a72e67f79b31528ad889f"},
+	}})
+	if err != nil {
+		t.Fatalf("baseimage.NewCatalog(): %v", err)
+	}
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{ex},
+		BaseImageCatalog:     catalog,
+	}
+
+	got, err := scalibr.New().ScanImageTarball(context.Background(), tarPath, cfg)
+	if err != nil {
+		t.Fatalf("ScanImageTarball(%s): %v", tarPath, err)
+	}
+	want := map[string]string{"sample": "example.com/base:1", "larger-sample": ""}
+	gotBaseImages := map[string]string{}
+	for _, inv := range got.Inventories {
+		if inv.LayerDetails.InBaseImage != (inv.LayerDetails.BaseImage != "") {
+			t.Errorf("ScanImageTarball(%s): %q has InBaseImage %t and base image %q", tarPath, inv.Name, inv.LayerDetails.InBaseImage, inv.LayerDetails.BaseImage)
+		}
+		gotBaseImages[inv.Name] = inv.LayerDetails.BaseImage
+	}
+	if diff := cmp.Diff(want, gotBaseImages); diff != "" {
+		t.Errorf("ScanImageTarball(%s): unexpected base images (-want +got):\n%s", tarPath, diff)
+	}
+}
+
+func TestScanRemoteImage(t *testing.T) {
+	// Serve basic.tar from an in-memory registry.
+	srv := httptest.NewServer(registry.New())
+	defer srv.Close()
+	tarPath := filepath.Join("artifact", "image", "layerscanning", "image", "testdata", "basic.tar")
+	img, err := tarball.ImageFromPath(tarPath, nil)
+	if err != nil {
+		t.Fatalf("tarball.ImageFromPath(%s): %v", tarPath, err)
+	}
+	imageName := strings.TrimPrefix(srv.URL, "http://") + "/app:1.2"
+	ref, err := name.NewTag(imageName)
+	if err != nil {
+		t.Fatalf("name.NewTag(%s): %v", imageName, err)
+	}
+	if err := remote.Write(ref, img); err != nil {
+		t.Fatalf("remote.Write(%s): %v", ref, err)
+	}
+
+	ex := fe.New("python/wheelegg", 1, []string{"sample.txt", "larger-sample.txt"}, map[string]fe.NamesErr{
+		"sample.txt":        {Names: []string{"sample"}},
+		"larger-sample.txt": {Names: []string{"larger-sample"}},
+	})
+	cfg := &scalibr.ScanConfig{FilesystemExtractors: []filesystem.Extractor{ex}}
+
+	got, err := scalibr.New().ScanRemoteImage(context.Background(), imageName, cfg)
+	if err != nil {
+		t.Fatalf("ScanRemoteImage(%s): %v", imageName, err)
+	}
+	want := map[string]int{"sample": 0, "larger-sample": 1}
+	gotLayers := map[string]int{}
+	for _, inv := range got.Inventories {
+		if inv.LayerDetails == nil {
+			t.Fatalf("ScanRemoteImage(%s): no layer details for %q", imageName, inv.Name)
+		}
+		gotLayers[inv.Name] = inv.LayerDetails.Index
+	}
+	if diff := cmp.Diff(want, gotLayers); diff != "" {
+		t.Errorf("ScanRemoteImage(%s): unexpected layer indexes (-want +got):\n%s", imageName, diff)
+	}
+
+	missing := strings.TrimPrefix(srv.URL, "http://") + "/missing:latest"
+	if _, err := scalibr.New().ScanRemoteImage(context.Background(), missing, cfg); err == nil {
+		t.Errorf("ScanRemoteImage(%s) of a missing image: got nil error, want error", missing)
+	}
+}
+
+type fakeSecret struct {
+	Token string
+}
+
+func TestScan_Secrets(t *testing.T) {
+	tmp := t.TempDir()
+	os.WriteFile(filepath.Join(tmp, "file.txt"), []byte("Content"), 0644)
+	os.MkdirAll(filepath.Join(tmp, "config"), 0755)
+	os.WriteFile(filepath.Join(tmp, "config", "app.env"), []byte("TOKEN=tok_0123abcd"), 0644)
+	// Files larger than the header read for their content type are read in
+	// full, binaries are skipped.
+	os.WriteFile(filepath.Join(tmp, "config", "large.txt"), []byte(strings.Repeat("x\n", 1000)+"tok_4567cdef"), 0644)
+	os.WriteFile(filepath.Join(tmp, "app.so"), []byte("\x7fELF\x02\x01\x01\x00tok_89abcdef"), 0644)
+
+	fakeExtractor := fe.New(
+		"python/wheelegg", 1, []string{"file.txt"},
+		map[string]fe.NamesErr{"file.txt": {Names: []string{"software"}}},
+	)
+	secretDetector := simpletoken.Detector{
+		MaxLen: 12,
+		Re:     regexp.MustCompile(`tok_[0-9a-f]{8}`),
+		FromMatch: func(b []byte) veles.Secret {
+			return fakeSecret{Token: string(b)}
+		},
+	}
+
+	tests := []struct {
+		desc       string
+		extractors []filesystem.Extractor
+		wantInv    int
+	}{
+		{
+			desc:       "secrets and inventory in one walk",
+			extractors: []filesystem.Extractor{fakeExtractor},
+			wantInv:    1,
+		},
+		{
+			desc:    "secrets only",
+			wantInv: 0,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &scalibr.ScanConfig{
+				FilesystemExtractors: tc.extractors,
+				SecretDetectors:      []veles.Detector{secretDetector},
+				ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+			}
+			got := scalibr.New().Scan(context.Background(), cfg)
+			if got.Status.Status != plugin.ScanStatusSucceeded {
+				t.Fatalf("Scan(): status %v: %s", got.Status.Status, got.Status.FailureReason)
+			}
+			want := []*secrets.Secret{{
+				Secret:     fakeSecret{Token: "tok_0123abcd"},
+				Location:   filepath.Join("config", "app.env"),
+				Confidence: secrets.ConfidenceMedium,
+			}, {
+				Secret:     fakeSecret{Token: "tok_4567cdef"},
+				Location:   filepath.Join("config", "large.txt"),
+				Confidence: secrets.ConfidenceMedium,
+			}}
+			if diff := cmp.Diff(want, got.Secrets); diff != "" {
+				t.Errorf("Scan(): unexpected secrets (-want +got):\n%s", diff)
+			}
+			if len(got.Inventories) != tc.wantInv {
+				t.Errorf("Scan(): got %d inventories, want %d", len(got.Inventories), tc.wantInv)
+			}
+		})
+	}
+}
+
+type fakeSink struct {
+	err         error
+	inventories []*extractor.Inventory
+	findings    []*detector.Finding
+	secrets     []*secrets.Secret
+}
+
+func (s *fakeSink) AddInventory(i *extractor.Inventory) error {
+	s.inventories = append(s.inventories, i)
+	return s.err
+}
+
+func (s *fakeSink) AddFinding(f *detector.Finding) error {
+	s.findings = append(s.findings, f)
+	return s.err
+}
+
+func (s *fakeSink) AddSecret(sec *secrets.Secret) error {
+	s.secrets = append(s.secrets, sec)
+	return s.err
+}
+
+func TestScan_ResultSink(t *testing.T) {
+	tmp := t.TempDir()
+	os.WriteFile(filepath.Join(tmp, "file.txt"), []byte("Content"), 0644)
+	os.WriteFile(filepath.Join(tmp, "app.env"), []byte("TOKEN=tok_0123abcd"), 0644)
+
+	fakeExtractor := fe.New(
+		"python/wheelegg", 1, []string{"file.txt"},
+		map[string]fe.NamesErr{"file.txt": {Names: []string{"software"}}},
+	)
+	finding := &detector.Finding{Adv: &detector.Advisory{ID: &detector.AdvisoryID{Reference: "CVE-1234"}}}
+	secretDetector := simpletoken.Detector{
+		MaxLen: 12,
+		Re:     regexp.MustCompile(`tok_[0-9a-f]{8}`),
+		FromMatch: func(b []byte) veles.Secret {
+			return fakeSecret{Token: string(b)}
+		},
+	}
+
+	tests := []struct {
+		desc       string
+		sinkErr    error
+		wantStatus plugin.ScanStatusEnum
+	}{
+		{
+			desc:       "results_are_passed_to_sink",
+			wantStatus: plugin.ScanStatusSucceeded,
+		},
+		{
+			desc:       "sink_error_fails_scan",
+			sinkErr:    errors.New("disk full"),
+			wantStatus: plugin.ScanStatusFailed,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sink := &fakeSink{err: tc.sinkErr}
+			cfg := &scalibr.ScanConfig{
+				FilesystemExtractors: []filesystem.Extractor{fakeExtractor},
+				Detectors:            []detector.Detector{fd.New("detector", 2, finding, nil)},
+				SecretDetectors:      []veles.Detector{secretDetector},
+				ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+				ResultSink:           sink,
+			}
+			got := scalibr.New().Scan(context.Background(), cfg)
+			if got.Status.Status != tc.wantStatus {
+				t.Fatalf("Scan(): status %v (%s), want %v", got.Status.Status, got.Status.FailureReason, tc.wantStatus)
+			}
+			if len(got.Inventories)+len(got.Findings)+len(got.Secrets) != 0 {
+				t.Errorf("Scan(): got %d inventories, %d findings and %d secrets in the result, want them passed to the sink",
+					len(got.Inventories), len(got.Findings), len(got.Secrets))
+			}
+			if tc.sinkErr != nil {
+				return
+			}
+			wantInv := []*extractor.Inventory{{Name: "software", Locations: []string{"file.txt"}, Extractor: fakeExtractor}}
+			if diff := cmp.Diff(wantInv, sink.inventories, fe.AllowUnexported); diff != "" {
+				t.Errorf("Scan(): unexpected inventories in sink (-want +got):\n%s", diff)
+			}
+			wantFindings := []*detector.Finding{withDetectorName(finding, "detector")}
+			if diff := cmp.Diff(wantFindings, sink.findings); diff != "" {
+				t.Errorf("Scan(): unexpected findings in sink (-want +got):\n%s", diff)
+			}
+			if len(sink.secrets) != 1 {
+				t.Errorf("Scan(): got %d secrets in sink, want 1", len(sink.secrets))
+			}
+		})
+	}
+}
+
+func TestScan_PluginBudgets(t *testing.T) {
+	tmp := t.TempDir()
+	os.WriteFile(filepath.Join(tmp, "a.txt"), []byte("Content"), 0644)
+	os.WriteFile(filepath.Join(tmp, "b.txt"), []byte("Content"), 0644)
+
+	fakeExtractor := fe.New(
+		"python/wheelegg", 1, []string{"a.txt", "b.txt"},
+		map[string]fe.NamesErr{"a.txt": {Names: []string{"a"}}, "b.txt": {Names: []string{"b"}}},
+	)
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{fakeExtractor},
+		ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		PluginBudgets:        &budget.Budgets{Default: budget.Budget{MaxFiles: 1}},
+	}
+	got := scalibr.New().Scan(context.Background(), cfg)
+
+	if len(got.Inventories) != 1 {
+		t.Errorf("Scan(): got %d inventories, want 1 from the file within the budget", len(got.Inventories))
+	}
+	if len(got.PluginStatus) != 1 {
+		t.Fatalf("Scan(): got %d plugin statuses, want 1", len(got.PluginStatus))
+	}
+	status := got.PluginStatus[0]
+	if status.BudgetExceeded != string(budget.LimitFiles) {
+		t.Errorf("Scan(): plugin status BudgetExceeded = %q, want %q", status.BudgetExceeded, budget.LimitFiles)
+	}
+	if status.Status.Status != plugin.ScanStatusPartiallySucceeded {
+		t.Errorf("Scan(): plugin status %v, want %v", status.Status.Status, plugin.ScanStatusPartiallySucceeded)
+	}
+}
+
+func TestScan_PluginBudgetsCoverage(t *testing.T) {
+	tmp := t.TempDir()
+	for _, dir := range []string{"a", "b", "c"} {
+		os.MkdirAll(filepath.Join(tmp, dir), 0755)
+		os.WriteFile(filepath.Join(tmp, dir, "requirements.txt"), []byte("foo==1.0"), 0644)
+	}
+
+	fakeExtractor := fe.New(
+		"python/requirements", 1, []string{"a/requirements.txt", "b/requirements.txt", "c/requirements.txt"},
+		map[string]fe.NamesErr{
+			"a/requirements.txt": {Names: []string{"a"}},
+			"b/requirements.txt": {Names: []string{"b"}},
+			"c/requirements.txt": {Names: []string{"c"}},
+		},
+	)
+	cfg := &scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{fakeExtractor},
+		ScanRoots:            []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tmp), Path: tmp}},
+		PluginBudgets:        &budget.Budgets{Default: budget.Budget{MaxFiles: 1}},
+		ReportCoverage:       true,
+	}
+	got := scalibr.New().Scan(context.Background(), cfg)
+
+	// The extractor is cut off while opening the second file it visits and
+	// skips the third one.
+	if len(got.Inventories) != 1 {
+		t.Errorf("Scan(): got %d inventories, want 1 from the file within the budget", len(got.Inventories))
+	}
+	if got.Coverage == nil {
+		t.Fatal("Scan(): Coverage = nil, want report")
+	}
+	if len(got.Coverage.UnparsedFiles) != 2 {
+		t.Fatalf("Scan(): got %d unparsed files, want 2: %v", len(got.Coverage.UnparsedFiles), got.Coverage.UnparsedFiles)
+	}
+	for _, f := range got.Coverage.UnparsedFiles {
+		if f.Reason != coverage.ReasonBudgetExceeded {
+			t.Errorf("Scan(): unparsed file %s has reason %q, want %q", f.Path, f.Reason, coverage.ReasonBudgetExceeded)
+		}
+	}
+}
+
 type errorFS struct {
 	err error
 }
@@ -0,0 +1,149 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalibr
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/cache"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/stats"
+)
+
+// Session runs many scans with the same plugins, e.g. in a service that
+// scans one target after another. The required extractors are enabled, the
+// plugins are validated and their data sources are resolved once when the
+// session is created instead of on every scan. The plugin instances are
+// reused, so reference data that plugins load on first use, like the known
+// password hashes of the Windows weak credentials detector, is kept for later
+// scans.
+//
+// Scans of a session can run concurrently as long as its plugins and its
+// Stats collector can. The built-in plugins keep no per-scan state, except for
+// standalone extractors that connect to a local daemon such as the containerd
+// extractor, which create their client on first use. The ResultSink and Cache
+// of the config are shared by all scans; the session serializes the calls to
+// them, so the results of concurrent scans arrive interleaved in the same
+// sink. The Throttler is shared as well and limits all scans together.
+type Session struct {
+	config *ScanConfig
+	// Provenance of the plugins, without the config digest.
+	plugins *Provenance
+}
+
+// Target is what a scan of a Session scans, together with the settings that
+// can differ between the scans of a session.
+type Target struct {
+	ScanRoots []*scalibrfs.ScanRoot
+	// Optional: Individual files to extract inventory from.
+	FilesToExtract []string
+	// Optional: Directories that the file system walk should ignore. If nil,
+	// the platform's default ignored directories below the scan roots are
+	// skipped.
+	DirsToSkip []string
+	// Optional: Labels to tag the scan result with.
+	Labels map[string]string
+}
+
+// NewSession prepares the plugins of config for repeated scans. The scan
+// roots, files, skipped directories and labels of config are replaced by the
+// Target of each scan; all other settings apply to all scans. config isn't
+// modified and can be reused.
+func NewSession(config *ScanConfig) (*Session, error) {
+	cfg := *config
+	// EnableRequiredExtractors appends to the extractor lists.
+	cfg.FilesystemExtractors = slices.Clone(config.FilesystemExtractors)
+	cfg.StandaloneExtractors = slices.Clone(config.StandaloneExtractors)
+	if cfg.Stats == nil {
+		cfg.Stats = stats.NoopCollector{}
+	}
+	if cfg.ResultSink != nil {
+		cfg.ResultSink = &syncSink{sink: cfg.ResultSink}
+	}
+	if cfg.Cache != nil {
+		cfg.Cache = &syncCache{cache: cfg.Cache}
+	}
+	plugins, err := cfg.preparePlugins()
+	if err != nil {
+		return nil, err
+	}
+	return &Session{config: &cfg, plugins: plugins}, nil
+}
+
+// Scan scans target with the plugins of the session.
+func (s *Session) Scan(ctx context.Context, target *Target) *ScanResult {
+	cfg := *s.config
+	cfg.ScanRoots = target.ScanRoots
+	cfg.FilesToExtract = target.FilesToExtract
+	cfg.DirsToSkip = target.DirsToSkip
+	if cfg.DirsToSkip == nil {
+		cfg.DirsToSkip = defaultDirsToSkip(target.ScanRoots)
+	}
+	cfg.Labels = target.Labels
+	return scan(ctx, &cfg, s.plugins)
+}
+
+// syncSink serializes the calls to a ResultSink shared by concurrent scans.
+type syncSink struct {
+	mu   sync.Mutex
+	sink ResultSink
+}
+
+func (s *syncSink) AddInventory(i *extractor.Inventory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.AddInventory(i)
+}
+
+func (s *syncSink) AddFinding(f *detector.Finding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.AddFinding(f)
+}
+
+func (s *syncSink) AddSecret(secret *secrets.Secret) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.AddSecret(secret)
+}
+
+// syncCache serializes the calls to a Cache shared by concurrent scans.
+type syncCache struct {
+	mu    sync.Mutex
+	cache cache.Cache
+}
+
+func (c *syncCache) Get(key *cache.Key) ([]*extractor.Inventory, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+func (c *syncCache) Put(key *cache.Key, inventories []*extractor.Inventory) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Put(key, inventories)
+}
+
+func (c *syncCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Close()
+}
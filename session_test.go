@@ -0,0 +1,175 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalibr_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	scalibrfs "github.com/google/osv-scalibr/fs"
+	"github.com/google/osv-scalibr/plugin"
+	fd "github.com/google/osv-scalibr/testing/fakedetector"
+	fe "github.com/google/osv-scalibr/testing/fakeextractor"
+)
+
+// countingDetWithData counts how often its data sources are resolved.
+type countingDetWithData struct {
+	fakeDetWithData
+	calls int
+}
+
+func (d *countingDetWithData) DataSources() []*plugin.DataSource {
+	d.calls++
+	return d.fakeDetWithData.DataSources()
+}
+
+func TestSession_Scan(t *testing.T) {
+	withFile := t.TempDir()
+	if err := os.WriteFile(filepath.Join(withFile, "file.txt"), []byte("Content"), 0644); err != nil {
+		t.Fatalf("os.WriteFile(): %v", err)
+	}
+	empty := t.TempDir()
+
+	det := &countingDetWithData{fakeDetWithData: fakeDetWithData{dbVersion: "2024-01-01"}}
+	s, err := scalibr.NewSession(&scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{
+			fe.New("python/wheelegg", 1, []string{"file.txt"}, map[string]fe.NamesErr{"file.txt": {Names: []string{"software"}}}),
+		},
+		Detectors: []detector.Detector{det},
+	})
+	if err != nil {
+		t.Fatalf("NewSession(): %v", err)
+	}
+
+	for _, tc := range []struct {
+		root       string
+		labels     map[string]string
+		wantInvNum int
+	}{
+		{root: withFile, labels: map[string]string{"target": "1"}, wantInvNum: 1},
+		{root: empty, labels: map[string]string{"target": "2"}, wantInvNum: 0},
+	} {
+		got := s.Scan(context.Background(), &scalibr.Target{
+			ScanRoots: []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(tc.root), Path: tc.root}},
+			Labels:    tc.labels,
+		})
+		if got.Status.Status != plugin.ScanStatusSucceeded {
+			t.Errorf("Scan(%s): status = %v, want success: %s", tc.root, got.Status.Status, got.Status.FailureReason)
+		}
+		if len(got.Inventories) != tc.wantInvNum {
+			t.Errorf("Scan(%s): got %d inventories, want %d", tc.root, len(got.Inventories), tc.wantInvNum)
+		}
+		if diff := cmp.Diff(tc.labels, got.Labels); diff != "" {
+			t.Errorf("Scan(%s): unexpected labels (-want +got):\n%s", tc.root, diff)
+		}
+		if got.Provenance == nil || len(got.Provenance.DataSources) != 1 {
+			t.Errorf("Scan(%s): Provenance = %+v, want 1 data source", tc.root, got.Provenance)
+		}
+	}
+
+	if det.calls != 1 {
+		t.Errorf("DataSources() called %d times, want 1", det.calls)
+	}
+}
+
+func TestSession_ConcurrentScansShareSink(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "file.txt"), []byte("Content"), 0644); err != nil {
+		t.Fatalf("os.WriteFile(): %v", err)
+	}
+	// fakeSink isn't safe for concurrent use, the session serializes the calls.
+	sink := &fakeSink{}
+	s, err := scalibr.NewSession(&scalibr.ScanConfig{
+		FilesystemExtractors: []filesystem.Extractor{
+			fe.New("python/wheelegg", 1, []string{"file.txt"}, map[string]fe.NamesErr{"file.txt": {Names: []string{"software"}}}),
+		},
+		ResultSink: sink,
+	})
+	if err != nil {
+		t.Fatalf("NewSession(): %v", err)
+	}
+
+	const scans = 8
+	var wg sync.WaitGroup
+	for range scans {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got := s.Scan(context.Background(), &scalibr.Target{
+				ScanRoots: []*scalibrfs.ScanRoot{{FS: scalibrfs.DirFS(root), Path: root}},
+			})
+			if got.Status.Status != plugin.ScanStatusSucceeded {
+				t.Errorf("Scan(): status = %v, want success: %s", got.Status.Status, got.Status.FailureReason)
+			}
+		}()
+	}
+	wg.Wait()
+	if len(sink.inventories) != scans {
+		t.Errorf("sink got %d inventories, want %d", len(sink.inventories), scans)
+	}
+}
+
+func TestNewSession_DoesNotModifyConfig(t *testing.T) {
+	cfg := &scalibr.ScanConfig{
+		Detectors: []detector.Detector{fd.NewWithOptions(fd.WithRequiredExtractors("python/wheelegg"))},
+	}
+	if _, err := scalibr.NewSession(cfg); err != nil {
+		t.Fatalf("NewSession(): %v", err)
+	}
+	if len(cfg.FilesystemExtractors) != 0 {
+		t.Errorf("NewSession() added %d extractors to the config, want 0", len(cfg.FilesystemExtractors))
+	}
+}
+
+func TestNewSession_InvalidPlugins(t *testing.T) {
+	for _, tc := range []struct {
+		desc string
+		cfg  *scalibr.ScanConfig
+	}{
+		{
+			desc: "unknown_required_extractor",
+			cfg: &scalibr.ScanConfig{
+				Detectors: []detector.Detector{fd.NewWithOptions(fd.WithRequiredExtractors("unknown"))},
+			},
+		},
+		{
+			desc: "unsatisfied_requirements",
+			cfg: &scalibr.ScanConfig{
+				Detectors:    []detector.Detector{fakeDetNeedsFS{}},
+				Capabilities: &plugin.Capabilities{DirectFS: false},
+			},
+		},
+		{
+			desc: "pinned_version_mismatch",
+			cfg: &scalibr.ScanConfig{
+				FilesystemExtractors: []filesystem.Extractor{fe.New("python/wheelegg", 1, nil, nil)},
+				PinnedPluginVersions: map[string]int{"python/wheelegg": 2},
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if _, err := scalibr.NewSession(tc.cfg); err == nil {
+				t.Error("NewSession(): got nil error, want error")
+			}
+		})
+	}
+}
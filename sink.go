@@ -0,0 +1,80 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scalibr
+
+import (
+	"fmt"
+
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/plugin"
+)
+
+// ResultSink receives the packages, findings and secrets of a scan as they're
+// found, e.g. to write them to a file without holding the results of a large
+// scan in memory. The methods are called from one goroutine at a time and the
+// results are passed in no particular order.
+type ResultSink interface {
+	filesystem.InventorySink
+	AddFinding(f *detector.Finding) error
+	AddSecret(s *secrets.Secret) error
+}
+
+// inventoryForwarder passes the inventories of the filesystem extractors to a
+// ResultSink. If keep is set, the inventories are also kept for the inventory
+// index of the detectors.
+type inventoryForwarder struct {
+	sink        ResultSink
+	keep        bool
+	inventories []*extractor.Inventory
+}
+
+func (f *inventoryForwarder) AddInventory(i *extractor.Inventory) error {
+	if f.keep {
+		f.inventories = append(f.inventories, i)
+	}
+	return f.sink.AddInventory(i)
+}
+
+// flushToSink passes the packages, findings and secrets of sr to sink and
+// removes them from sr. The scan fails if the sink returns an error.
+func flushToSink(sink ResultSink, sr *ScanResult) {
+	err := func() error {
+		for _, i := range sr.Inventories {
+			if err := sink.AddInventory(i); err != nil {
+				return fmt.Errorf("ResultSink.AddInventory(): %w", err)
+			}
+		}
+		for _, f := range sr.Findings {
+			if err := sink.AddFinding(f); err != nil {
+				return fmt.Errorf("ResultSink.AddFinding(): %w", err)
+			}
+		}
+		for _, s := range sr.Secrets {
+			if err := sink.AddSecret(s); err != nil {
+				return fmt.Errorf("ResultSink.AddSecret(): %w", err)
+			}
+		}
+		return nil
+	}()
+	sr.Inventories = []*extractor.Inventory{}
+	sr.Findings = []*detector.Finding{}
+	sr.Secrets = nil
+	if err != nil && sr.Status.Status != plugin.ScanStatusFailed {
+		sr.Status = &plugin.ScanStatus{Status: plugin.ScanStatusFailed, FailureReason: err.Error()}
+	}
+}
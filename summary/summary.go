@@ -0,0 +1,234 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package summary condenses a scan result into the counts that CI and
+// orchestration systems base their decisions on, and maps them to exit codes,
+// so that these systems don't have to parse the full result.
+package summary
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/veles"
+)
+
+// ExitCode is the exit code of the SCALIBR binary for a scan outcome. If
+// several apply, the highest precedence one is used: ExitFatal, then
+// ExitFindingsAboveThreshold, ExitPartialFailure, ExitFindingsBelowThreshold
+// and ExitClean.
+type ExitCode int
+
+// ExitCode values.
+const (
+	// ExitClean means the scan succeeded and found no findings or secrets.
+	ExitClean ExitCode = 0
+	// ExitFatal means the scan failed or couldn't be run.
+	ExitFatal ExitCode = 1
+	// ExitPartialFailure means the scan completed but some plugins failed.
+	ExitPartialFailure ExitCode = 2
+	// ExitFindingsBelowThreshold means the scan found findings or secrets, but
+	// none at or above the severity threshold.
+	ExitFindingsBelowThreshold ExitCode = 3
+	// ExitFindingsAboveThreshold means the scan found findings at or above the
+	// severity threshold, or secrets that were validated as valid.
+	ExitFindingsAboveThreshold ExitCode = 4
+)
+
+// Config for summarizing scan results.
+type Config struct {
+	// Findings with this or a higher severity result in
+	// ExitFindingsAboveThreshold.
+	SeverityThreshold detector.SeverityEnum
+}
+
+// DefaultConfig returns the default configuration for summarizing scan
+// results.
+func DefaultConfig() Config {
+	return Config{SeverityThreshold: detector.SeverityHigh}
+}
+
+var severityNames = map[string]detector.SeverityEnum{
+	"any":      detector.SeverityUnspecified,
+	"minimal":  detector.SeverityMinimal,
+	"low":      detector.SeverityLow,
+	"medium":   detector.SeverityMedium,
+	"high":     detector.SeverityHigh,
+	"critical": detector.SeverityCritical,
+}
+
+// ParseSeverity parses a severity threshold, one of "any", "minimal", "low",
+// "medium", "high" or "critical". "any" includes findings without a severity.
+func ParseSeverity(s string) (detector.SeverityEnum, error) {
+	sev, ok := severityNames[strings.ToLower(s)]
+	if !ok {
+		return detector.SeverityUnspecified, fmt.Errorf("invalid severity %q", s)
+	}
+	return sev, nil
+}
+
+// Summary is the compact outcome of a scan.
+type Summary struct {
+	// Status of the overall scan.
+	Status   *plugin.ScanStatus
+	Duration time.Duration
+	// Number of packages found.
+	Packages int
+	// Number of findings per severity.
+	Findings map[detector.SeverityEnum]int
+	// Number of findings at or above SeverityThreshold.
+	FindingsAboveThreshold int
+	SeverityThreshold      detector.SeverityEnum
+	// Number of secrets per validation status. Secrets that weren't validated
+	// are counted as veles.ValidationUnspecified.
+	Secrets map[veles.ValidationStatus]int
+	// Names of the plugins that failed, sorted.
+	FailedPlugins []string
+	ExitCode      ExitCode
+}
+
+// Collector counts the packages, findings and secrets of a scan while they're
+// passed to a scalibr.ResultSink, so that scans whose results are streamed
+// can be summarized as well. It implements scalibr.ResultSink.
+type Collector struct {
+	sink     scalibr.ResultSink
+	cfg      Config
+	packages int
+	findings map[detector.SeverityEnum]int
+	secrets  map[veles.ValidationStatus]int
+}
+
+// NewCollector returns a Collector that forwards the results to sink, which
+// can be nil.
+func NewCollector(cfg Config, sink scalibr.ResultSink) *Collector {
+	return &Collector{
+		sink:     sink,
+		cfg:      cfg,
+		findings: map[detector.SeverityEnum]int{},
+		secrets:  map[veles.ValidationStatus]int{},
+	}
+}
+
+// AddInventory counts a package and forwards it to the sink.
+func (c *Collector) AddInventory(i *extractor.Inventory) error {
+	c.packages++
+	if c.sink == nil {
+		return nil
+	}
+	return c.sink.AddInventory(i)
+}
+
+// AddFinding counts a finding and forwards it to the sink.
+func (c *Collector) AddFinding(f *detector.Finding) error {
+	c.findings[severity(f)]++
+	if c.sink == nil {
+		return nil
+	}
+	return c.sink.AddFinding(f)
+}
+
+// AddSecret counts a secret and forwards it to the sink.
+func (c *Collector) AddSecret(s *secrets.Secret) error {
+	status := s.Validation
+	if status == "" {
+		status = veles.ValidationUnspecified
+	}
+	c.secrets[status]++
+	if c.sink == nil {
+		return nil
+	}
+	return c.sink.AddSecret(s)
+}
+
+// Summarize returns the summary of the scan result r together with the
+// results that were passed to the Collector. The packages, findings and
+// secrets left in r are counted but not forwarded to the sink.
+func (c *Collector) Summarize(r *scalibr.ScanResult) *Summary {
+	s := &Summary{
+		Status:            r.Status,
+		Duration:          r.EndTime.Sub(r.StartTime),
+		Packages:          c.packages + len(r.Inventories),
+		Findings:          map[detector.SeverityEnum]int{},
+		SeverityThreshold: c.cfg.SeverityThreshold,
+		Secrets:           map[veles.ValidationStatus]int{},
+	}
+	for sev, n := range c.findings {
+		s.Findings[sev] += n
+	}
+	for _, f := range r.Findings {
+		s.Findings[severity(f)]++
+	}
+	for sev, n := range s.Findings {
+		if sev >= c.cfg.SeverityThreshold {
+			s.FindingsAboveThreshold += n
+		}
+	}
+	for status, n := range c.secrets {
+		s.Secrets[status] += n
+	}
+	for _, sec := range r.Secrets {
+		status := sec.Validation
+		if status == "" {
+			status = veles.ValidationUnspecified
+		}
+		s.Secrets[status]++
+	}
+	for _, p := range r.PluginStatus {
+		if p.Status != nil && p.Status.Status == plugin.ScanStatusFailed {
+			s.FailedPlugins = append(s.FailedPlugins, p.Name)
+		}
+	}
+	slices.Sort(s.FailedPlugins)
+	s.ExitCode = exitCode(s)
+	return s
+}
+
+// Summarize returns the summary of the scan result r, which wasn't streamed
+// to a ResultSink.
+func Summarize(r *scalibr.ScanResult, cfg Config) *Summary {
+	return NewCollector(cfg, nil).Summarize(r)
+}
+
+func exitCode(s *Summary) ExitCode {
+	if s.Status == nil || s.Status.Status == plugin.ScanStatusFailed || s.Status.Status == plugin.ScanStatusUnspecified {
+		return ExitFatal
+	}
+	if s.FindingsAboveThreshold > 0 || s.Secrets[veles.ValidationValid] > 0 {
+		return ExitFindingsAboveThreshold
+	}
+	if s.Status.Status == plugin.ScanStatusPartiallySucceeded || len(s.FailedPlugins) > 0 {
+		return ExitPartialFailure
+	}
+	if len(s.Findings) > 0 || len(s.Secrets) > 0 {
+		return ExitFindingsBelowThreshold
+	}
+	return ExitClean
+}
+
+// severity returns the severity of f, or SeverityUnspecified if it has none.
+func severity(f *detector.Finding) detector.SeverityEnum {
+	if f.Adv == nil || f.Adv.Sev == nil {
+		return detector.SeverityUnspecified
+	}
+	return f.Adv.Sev.Severity
+}
+
+var _ scalibr.ResultSink = &Collector{}
@@ -0,0 +1,240 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package summary_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/detector"
+	"github.com/google/osv-scalibr/extractor"
+	"github.com/google/osv-scalibr/extractor/filesystem/secrets"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/summary"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/tunnel"
+)
+
+var (
+	start     = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	succeeded = &plugin.ScanStatus{Status: plugin.ScanStatusSucceeded}
+	failed    = &plugin.ScanStatus{Status: plugin.ScanStatusFailed, FailureReason: "failed"}
+)
+
+func finding(sev detector.SeverityEnum) *detector.Finding {
+	return &detector.Finding{Adv: &detector.Advisory{Sev: &detector.Severity{Severity: sev}}}
+}
+
+func secret(status veles.ValidationStatus) *secrets.Secret {
+	return &secrets.Secret{Secret: tunnel.NgrokAuthtoken{Token: "token"}, Validation: status}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		desc   string
+		result *scalibr.ScanResult
+		cfg    summary.Config
+		want   *summary.Summary
+	}{
+		{
+			desc:   "clean",
+			result: &scalibr.ScanResult{StartTime: start, EndTime: start.Add(time.Minute), Status: succeeded, Inventories: []*extractor.Inventory{{Name: "pkg"}}},
+			cfg:    summary.DefaultConfig(),
+			want: &summary.Summary{
+				Status:            succeeded,
+				Duration:          time.Minute,
+				Packages:          1,
+				Findings:          map[detector.SeverityEnum]int{},
+				SeverityThreshold: detector.SeverityHigh,
+				Secrets:           map[veles.ValidationStatus]int{},
+				ExitCode:          summary.ExitClean,
+			},
+		},
+		{
+			desc: "findings below threshold",
+			result: &scalibr.ScanResult{
+				Status:   succeeded,
+				Findings: []*detector.Finding{finding(detector.SeverityMedium), finding(detector.SeverityLow), {}},
+				Secrets:  []*secrets.Secret{secret(""), secret(veles.ValidationInvalid)},
+			},
+			cfg: summary.DefaultConfig(),
+			want: &summary.Summary{
+				Status: succeeded,
+				Findings: map[detector.SeverityEnum]int{
+					detector.SeverityMedium:      1,
+					detector.SeverityLow:         1,
+					detector.SeverityUnspecified: 1,
+				},
+				SeverityThreshold: detector.SeverityHigh,
+				Secrets:           map[veles.ValidationStatus]int{veles.ValidationUnspecified: 1, veles.ValidationInvalid: 1},
+				ExitCode:          summary.ExitFindingsBelowThreshold,
+			},
+		},
+		{
+			desc:   "findings above threshold",
+			result: &scalibr.ScanResult{Status: succeeded, Findings: []*detector.Finding{finding(detector.SeverityCritical), finding(detector.SeverityLow)}},
+			cfg:    summary.DefaultConfig(),
+			want: &summary.Summary{
+				Status:                 succeeded,
+				Findings:               map[detector.SeverityEnum]int{detector.SeverityCritical: 1, detector.SeverityLow: 1},
+				FindingsAboveThreshold: 1,
+				SeverityThreshold:      detector.SeverityHigh,
+				Secrets:                map[veles.ValidationStatus]int{},
+				ExitCode:               summary.ExitFindingsAboveThreshold,
+			},
+		},
+		{
+			desc:   "lower threshold",
+			result: &scalibr.ScanResult{Status: succeeded, Findings: []*detector.Finding{{}}},
+			cfg:    summary.Config{SeverityThreshold: detector.SeverityUnspecified},
+			want: &summary.Summary{
+				Status:                 succeeded,
+				Findings:               map[detector.SeverityEnum]int{detector.SeverityUnspecified: 1},
+				FindingsAboveThreshold: 1,
+				SeverityThreshold:      detector.SeverityUnspecified,
+				Secrets:                map[veles.ValidationStatus]int{},
+				ExitCode:               summary.ExitFindingsAboveThreshold,
+			},
+		},
+		{
+			desc:   "valid secret",
+			result: &scalibr.ScanResult{Status: succeeded, Secrets: []*secrets.Secret{secret(veles.ValidationValid)}},
+			cfg:    summary.DefaultConfig(),
+			want: &summary.Summary{
+				Status:            succeeded,
+				Findings:          map[detector.SeverityEnum]int{},
+				SeverityThreshold: detector.SeverityHigh,
+				Secrets:           map[veles.ValidationStatus]int{veles.ValidationValid: 1},
+				ExitCode:          summary.ExitFindingsAboveThreshold,
+			},
+		},
+		{
+			desc: "partial failure",
+			result: &scalibr.ScanResult{
+				Status: succeeded,
+				PluginStatus: []*plugin.Status{
+					{Name: "python/wheelegg", Status: succeeded},
+					{Name: "os/dpkg", Status: failed},
+					{Name: "cis/generic_linux/etcpasswdpermissions", Status: failed},
+				},
+				Findings: []*detector.Finding{finding(detector.SeverityLow)},
+			},
+			cfg: summary.DefaultConfig(),
+			want: &summary.Summary{
+				Status:            succeeded,
+				Findings:          map[detector.SeverityEnum]int{detector.SeverityLow: 1},
+				SeverityThreshold: detector.SeverityHigh,
+				Secrets:           map[veles.ValidationStatus]int{},
+				FailedPlugins:     []string{"cis/generic_linux/etcpasswdpermissions", "os/dpkg"},
+				ExitCode:          summary.ExitPartialFailure,
+			},
+		},
+		{
+			desc:   "fatal",
+			result: &scalibr.ScanResult{Status: failed, Findings: []*detector.Finding{finding(detector.SeverityCritical)}},
+			cfg:    summary.DefaultConfig(),
+			want: &summary.Summary{
+				Status:                 failed,
+				Findings:               map[detector.SeverityEnum]int{detector.SeverityCritical: 1},
+				FindingsAboveThreshold: 1,
+				SeverityThreshold:      detector.SeverityHigh,
+				Secrets:                map[veles.ValidationStatus]int{},
+				ExitCode:               summary.ExitFatal,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got := summary.Summarize(tc.result, tc.cfg)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Summarize() returned unexpected summary (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeSink struct {
+	inventories int
+	findings    int
+	secrets     int
+}
+
+func (s *fakeSink) AddInventory(*extractor.Inventory) error { s.inventories++; return nil }
+func (s *fakeSink) AddFinding(*detector.Finding) error      { s.findings++; return nil }
+func (s *fakeSink) AddSecret(*secrets.Secret) error         { s.secrets++; return nil }
+
+func TestCollector(t *testing.T) {
+	sink := &fakeSink{}
+	c := summary.NewCollector(summary.DefaultConfig(), sink)
+	for _, err := range []error{
+		c.AddInventory(&extractor.Inventory{Name: "a"}),
+		c.AddInventory(&extractor.Inventory{Name: "b"}),
+		c.AddFinding(finding(detector.SeverityHigh)),
+		c.AddSecret(secret(veles.ValidationInvalid)),
+	} {
+		if err != nil {
+			t.Fatalf("Collector returned error: %v", err)
+		}
+	}
+	// Results left in the scan result are counted as well.
+	r := &scalibr.ScanResult{
+		Status:      succeeded,
+		Inventories: []*extractor.Inventory{{Name: "c"}},
+		Findings:    []*detector.Finding{finding(detector.SeverityLow)},
+	}
+	got := c.Summarize(r)
+
+	want := &summary.Summary{
+		Status:                 succeeded,
+		Packages:               3,
+		Findings:               map[detector.SeverityEnum]int{detector.SeverityHigh: 1, detector.SeverityLow: 1},
+		FindingsAboveThreshold: 1,
+		SeverityThreshold:      detector.SeverityHigh,
+		Secrets:                map[veles.ValidationStatus]int{veles.ValidationInvalid: 1},
+		ExitCode:               summary.ExitFindingsAboveThreshold,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Summarize() returned unexpected summary (-want +got):\n%s", diff)
+	}
+	if want := (fakeSink{inventories: 2, findings: 1, secrets: 1}); *sink != want {
+		t.Errorf("Collector forwarded %+v, want %+v", *sink, want)
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    detector.SeverityEnum
+		wantErr bool
+	}{
+		{in: "any", want: detector.SeverityUnspecified},
+		{in: "medium", want: detector.SeverityMedium},
+		{in: "CRITICAL", want: detector.SeverityCritical},
+		{in: "severe", wantErr: true},
+	}
+	for _, tc := range tests {
+		got, err := summary.ParseSeverity(tc.in)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ParseSeverity(%q) returned error %v, want error: %t", tc.in, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("ParseSeverity(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
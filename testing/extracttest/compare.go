@@ -18,9 +18,15 @@ import (
 	"cmp"
 	"fmt"
 
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/osv-scalibr/extractor"
 )
 
+// IgnoreDependencies is a cmp option that ignores the dependency graph of
+// Inventories, for tests of lockfile extractors that only check the packages
+// found.
+var IgnoreDependencies = cmpopts.IgnoreFields(extractor.Inventory{}, "Dependencies", "DirectDependency")
+
 // InventoryCmpLess is a comparator function for Inventories, to be used in
 // tests with cmp.Diff to disregard the order in which the Inventories
 // are reported.
@@ -0,0 +1,174 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import "strings"
+
+// PlantedGitHubToken is a GitHub fine-grained personal access token planted
+// in the Debian reference image. It ends in the checksum of its body.
+var PlantedGitHubToken = "github_pat_" + strings.Repeat("A1b2", 5) + "C3_" + strings.Repeat("d4E5f6", 8) + "d4E5f" + "4SdhR6"
+
+// ReferenceImages returns the reference images the integration tests run on.
+func ReferenceImages() []Image {
+	return []Image{Debian(), Alpine(), Distroless(), Wolfi()}
+}
+
+// Debian is a Debian image that installs a package and a Python library on
+// top of the base image and ships with a GitHub CLI config with a token.
+func Debian() Image {
+	return Image{
+		Name: "debian",
+		Layers: []Layer{
+			{
+				Command: "ADD rootfs.tar.xz / # buildkit",
+				Files: map[string]string{
+					"etc/os-release": osRelease("debian", "12", "bookworm", "Debian GNU/Linux 12 (bookworm)"),
+					"var/lib/dpkg/status": dpkgStatus(
+						dpkgPackage{name: "base-files", version: "12.4+deb12u5", arch: "amd64"},
+						dpkgPackage{name: "libc6", version: "2.36-9+deb12u4", source: "glibc", arch: "amd64"},
+					),
+				},
+			},
+			{
+				Command: "RUN apt-get update && apt-get install -y python3-requests # buildkit",
+				Files: map[string]string{
+					"var/lib/dpkg/status": dpkgStatus(
+						dpkgPackage{name: "base-files", version: "12.4+deb12u5", arch: "amd64"},
+						dpkgPackage{name: "libc6", version: "2.36-9+deb12u4", source: "glibc", arch: "amd64"},
+						dpkgPackage{name: "python3-requests", version: "2.28.1+dfsg-1", source: "requests", arch: "all"},
+					),
+					"usr/lib/python3/dist-packages/requests-2.28.1.egg-info/PKG-INFO": "Metadata-Version: 2.1\nName: requests\nVersion: 2.28.1\n",
+				},
+			},
+			{
+				Command: "COPY hosts.yml /root/.config/gh/ # buildkit",
+				Files: map[string]string{
+					"root/.config/gh/hosts.yml": "github.com:\n    user: octocat\n    oauth_token: " + PlantedGitHubToken + "\n    git_protocol: https\n",
+				},
+			},
+		},
+	}
+}
+
+// Alpine is an Alpine image that installs git and bash, then removes git.
+func Alpine() Image {
+	base := []string{apkPackage("alpine-baselayout", "3.6.5-r0", "alpine-baselayout"), apkPackage("musl", "1.2.5-r0", "musl")}
+	withGit := append(append([]string{}, base...), apkPackage("git", "2.45.2-r0", "git"))
+	withBash := append(append([]string{}, base...), apkPackage("bash", "5.2.26-r0", "bash"))
+	return Image{
+		Name: "alpine",
+		Layers: []Layer{
+			{
+				Command: "ADD alpine-minirootfs-3.20.3-x86_64.tar.gz / # buildkit",
+				Files: map[string]string{
+					"etc/os-release":       osRelease("alpine", "3.20.3", "", "Alpine Linux v3.20"),
+					"lib/apk/db/installed": strings.Join(base, "\n"),
+				},
+			},
+			{
+				Command: "RUN apk add git # buildkit",
+				Files:   map[string]string{"lib/apk/db/installed": strings.Join(withGit, "\n")},
+			},
+			{
+				Command: "RUN apk add bash && apk del git # buildkit",
+				Files:   map[string]string{"lib/apk/db/installed": strings.Join(withBash, "\n")},
+			},
+		},
+	}
+}
+
+// Distroless is a distroless image, which keeps one dpkg status file per
+// package in status.d.
+func Distroless() Image {
+	return Image{
+		Name: "distroless",
+		Layers: []Layer{
+			{
+				Command: "bazel build //base:static_debian12",
+				Files: map[string]string{
+					"etc/os-release": osRelease("debian", "12", "bookworm", "Distroless"),
+					"var/lib/dpkg/status.d/base-files": dpkgStatus(
+						dpkgPackage{name: "base-files", version: "12.4+deb12u5", arch: "amd64", distroless: true},
+					),
+					"var/lib/dpkg/status.d/tzdata": dpkgStatus(
+						dpkgPackage{name: "tzdata", version: "2024a-0+deb12u1", arch: "all", distroless: true},
+					),
+				},
+			},
+			{
+				Command: "bazel build //base:base_debian12",
+				Files: map[string]string{
+					"var/lib/dpkg/status.d/libssl3": dpkgStatus(
+						dpkgPackage{name: "libssl3", version: "3.0.13-1~deb12u1", source: "openssl", arch: "amd64", distroless: true},
+					),
+				},
+			},
+		},
+	}
+}
+
+// Wolfi is a Wolfi image, which uses the apk package manager.
+func Wolfi() Image {
+	return Image{
+		Name: "wolfi",
+		Layers: []Layer{
+			{
+				Command: "apko build wolfi-base.yaml",
+				Files: map[string]string{
+					"etc/os-release": osRelease("wolfi", "20230201", "", "Wolfi"),
+					"lib/apk/db/installed": strings.Join([]string{
+						apkPackage("wolfi-baselayout", "20230201-r15", "wolfi-baselayout"),
+						apkPackage("glibc", "2.40-r3", "glibc"),
+						apkPackage("ca-certificates-bundle", "20240705-r1", "ca-certificates"),
+					}, "\n"),
+				},
+			},
+		},
+	}
+}
+
+func osRelease(id, versionID, codename, prettyName string) string {
+	s := "PRETTY_NAME=\"" + prettyName + "\"\nID=" + id + "\nVERSION_ID=\"" + versionID + "\"\n"
+	if codename != "" {
+		s += "VERSION_CODENAME=" + codename + "\n"
+	}
+	return s
+}
+
+type dpkgPackage struct {
+	name, version, source, arch string
+	// distroless status files have no Status field.
+	distroless bool
+}
+
+func dpkgStatus(pkgs ...dpkgPackage) string {
+	var entries []string
+	for _, p := range pkgs {
+		s := "Package: " + p.name + "\n"
+		if !p.distroless {
+			s += "Status: install ok installed\n"
+		}
+		if p.source != "" {
+			s += "Source: " + p.source + "\n"
+		}
+		s += "Version: " + p.version + "\nArchitecture: " + p.arch + "\nMaintainer: Debian Developers\n"
+		entries = append(entries, s)
+	}
+	return strings.Join(entries, "\n")
+}
+
+func apkPackage(name, version, origin string) string {
+	return "P:" + name + "\nV:" + version + "\nA:x86_64\no:" + origin + "\nm:Maintainer <maintainer@example.com>\n"
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package integration provides a harness for end-to-end tests that scan
+// reference container images with the full set of extractors and detectors.
+// Unlike the unit tests of individual plugins, these tests cover the
+// interplay of image unpacking, the filesystem walk, extraction, PURL
+// conversion, layer tracing and secret detection.
+//
+// The reference images are built from their layer contents as docker image
+// tarballs, so the tests don't need a container runtime or network access.
+package integration
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	scalibr "github.com/google/osv-scalibr"
+	"github.com/google/osv-scalibr/extractor"
+)
+
+// Layer is a layer of a reference image.
+type Layer struct {
+	// Command is the command that created the layer, e.g. "RUN apk add git".
+	Command string
+	// Files maps the paths of the files added or overwritten by the layer to
+	// their content. Files are deleted with whiteout files, e.g. "etc/.wh.foo".
+	Files map[string]string
+}
+
+// Image is a reference image.
+type Image struct {
+	// Name is used as the repository of the image's tag.
+	Name   string
+	Layers []Layer
+}
+
+// Build writes img as a docker image tarball into dir and returns its path.
+func Build(img Image, dir string) (string, error) {
+	v1Img := empty.Image
+	for i, l := range img.Layers {
+		content, err := layerTar(l.Files)
+		if err != nil {
+			return "", fmt.Errorf("layer %d of %s: %w", i, img.Name, err)
+		}
+		layer, err := tarball.LayerFromOpener(func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(content)), nil
+		})
+		if err != nil {
+			return "", fmt.Errorf("layer %d of %s: %w", i, img.Name, err)
+		}
+		v1Img, err = mutate.Append(v1Img, mutate.Addendum{
+			Layer:   layer,
+			History: v1.History{CreatedBy: l.Command},
+		})
+		if err != nil {
+			return "", fmt.Errorf("layer %d of %s: %w", i, img.Name, err)
+		}
+	}
+
+	tag, err := name.NewTag("scalibr-integration/" + img.Name + ":latest")
+	if err != nil {
+		return "", err
+	}
+	tarPath := filepath.Join(dir, img.Name+".tar")
+	if err := tarball.WriteToFile(tarPath, tag, v1Img); err != nil {
+		return "", fmt.Errorf("tarball.WriteToFile(%s): %w", tarPath, err)
+	}
+	return tarPath, nil
+}
+
+// layerTar returns a layer tarball with the given files and their parent
+// directories.
+func layerTar(files map[string]string) ([]byte, error) {
+	dirs := map[string]bool{}
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+		for d := path.Dir(p); d != "." && d != "/"; d = path.Dir(d) {
+			dirs[d] = true
+		}
+	}
+	for d := range dirs {
+		paths = append(paths, d+"/")
+	}
+	// Parent directories sort before their contents.
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, p := range paths {
+		hdr := &tar.Header{Name: p, Mode: 0755, Typeflag: tar.TypeDir}
+		content, isFile := files[p]
+		if isFile {
+			hdr = &tar.Header{Name: p, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return nil, err
+		}
+		if isFile {
+			if _, err := tw.Write([]byte(content)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Scan builds img and scans it with config.
+func Scan(ctx context.Context, img Image, config *scalibr.ScanConfig) (*scalibr.ScanResult, error) {
+	dir, err := os.MkdirTemp("", "scalibr-integration-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tarPath, err := Build(img, dir)
+	if err != nil {
+		return nil, err
+	}
+	return scalibr.New().ScanImageTarball(ctx, tarPath, config)
+}
+
+// Package is the summary of an Inventory that the expected inventories of the
+// reference images are written in.
+type Package struct {
+	Name      string
+	Version   string
+	Extractor string
+	PURL      string
+	// Layer is the index of the layer that introduced the package, or -1 if
+	// the layer is unknown.
+	Layer int
+}
+
+// Packages returns the summaries of invs, sorted by PURL and name.
+func Packages(invs []*extractor.Inventory) []Package {
+	pkgs := make([]Package, 0, len(invs))
+	for _, inv := range invs {
+		p := Package{Name: inv.Name, Version: inv.Version, Layer: -1}
+		if inv.Extractor != nil {
+			p.Extractor = inv.Extractor.Name()
+			if purl := inv.Extractor.ToPURL(inv); purl != nil {
+				p.PURL = purl.String()
+			}
+		}
+		if inv.LayerDetails != nil {
+			p.Layer = inv.LayerDetails.Index
+		}
+		pkgs = append(pkgs, p)
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].PURL != pkgs[j].PURL {
+			return pkgs[i].PURL < pkgs[j].PURL
+		}
+		return pkgs[i].Name < pkgs[j].Name
+	})
+	return pkgs
+}
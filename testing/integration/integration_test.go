@@ -0,0 +1,101 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scalibr "github.com/google/osv-scalibr"
+	el "github.com/google/osv-scalibr/extractor/filesystem/list"
+	"github.com/google/osv-scalibr/plugin"
+	"github.com/google/osv-scalibr/testing/integration"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/github"
+)
+
+func TestReferenceImages(t *testing.T) {
+	tests := []struct {
+		img         integration.Image
+		want        []integration.Package
+		wantSecrets []veles.Secret
+	}{
+		{
+			img: integration.Debian(),
+			want: []integration.Package{
+				{Name: "base-files", Version: "12.4+deb12u5", Extractor: "os/dpkg", PURL: "pkg:deb/debian/base-files@12.4%2Bdeb12u5?arch=amd64&distro=bookworm"},
+				{Name: "libc6", Version: "2.36-9+deb12u4", Extractor: "os/dpkg", PURL: "pkg:deb/debian/libc6@2.36-9%2Bdeb12u4?arch=amd64&distro=bookworm&source=glibc"},
+				{Name: "python3-requests", Version: "2.28.1+dfsg-1", Extractor: "os/dpkg", PURL: "pkg:deb/debian/python3-requests@2.28.1%2Bdfsg-1?arch=all&distro=bookworm&source=requests", Layer: 1},
+				{Name: "requests", Version: "2.28.1", Extractor: "python/wheelegg", PURL: "pkg:pypi/requests@2.28.1", Layer: 1},
+			},
+			wantSecrets: []veles.Secret{github.FineGrainedPAT{Token: integration.PlantedGitHubToken}},
+		},
+		{
+			// git is added in layer 1 and removed in layer 2.
+			img: integration.Alpine(),
+			want: []integration.Package{
+				{Name: "alpine-baselayout", Version: "3.6.5-r0", Extractor: "os/apk", PURL: "pkg:apk/alpine/alpine-baselayout@3.6.5-r0?arch=x86_64&distro=3.20.3&origin=alpine-baselayout"},
+				{Name: "bash", Version: "5.2.26-r0", Extractor: "os/apk", PURL: "pkg:apk/alpine/bash@5.2.26-r0?arch=x86_64&distro=3.20.3&origin=bash", Layer: 2},
+				{Name: "musl", Version: "1.2.5-r0", Extractor: "os/apk", PURL: "pkg:apk/alpine/musl@1.2.5-r0?arch=x86_64&distro=3.20.3&origin=musl"},
+			},
+		},
+		{
+			img: integration.Distroless(),
+			want: []integration.Package{
+				{Name: "base-files", Version: "12.4+deb12u5", Extractor: "os/dpkg", PURL: "pkg:deb/debian/base-files@12.4%2Bdeb12u5?arch=amd64&distro=bookworm"},
+				{Name: "libssl3", Version: "3.0.13-1~deb12u1", Extractor: "os/dpkg", PURL: "pkg:deb/debian/libssl3@3.0.13-1~deb12u1?arch=amd64&distro=bookworm&source=openssl", Layer: 1},
+				{Name: "tzdata", Version: "2024a-0+deb12u1", Extractor: "os/dpkg", PURL: "pkg:deb/debian/tzdata@2024a-0%2Bdeb12u1?arch=all&distro=bookworm"},
+			},
+		},
+		{
+			img: integration.Wolfi(),
+			want: []integration.Package{
+				{Name: "ca-certificates-bundle", Version: "20240705-r1", Extractor: "os/apk", PURL: "pkg:apk/wolfi/ca-certificates-bundle@20240705-r1?arch=x86_64&distro=20230201&origin=ca-certificates"},
+				{Name: "glibc", Version: "2.40-r3", Extractor: "os/apk", PURL: "pkg:apk/wolfi/glibc@2.40-r3?arch=x86_64&distro=20230201&origin=glibc"},
+				{Name: "wolfi-baselayout", Version: "20230201-r15", Extractor: "os/apk", PURL: "pkg:apk/wolfi/wolfi-baselayout@20230201-r15?arch=x86_64&distro=20230201&origin=wolfi-baselayout"},
+			},
+		},
+	}
+	// The images are scanned like remote Linux images, with every extractor
+	// that supports it.
+	capabs := &plugin.Capabilities{OS: plugin.OSLinux}
+	for _, tc := range tests {
+		t.Run(tc.img.Name, func(t *testing.T) {
+			cfg := &scalibr.ScanConfig{
+				FilesystemExtractors: el.FilterByCapabilities(el.All, capabs),
+				SecretDetectors:      []veles.Detector{github.NewFineGrainedPATDetector()},
+				Capabilities:         capabs,
+			}
+			got, err := integration.Scan(context.Background(), tc.img, cfg)
+			if err != nil {
+				t.Fatalf("Scan(%s): %v", tc.img.Name, err)
+			}
+			if got.Status.Status != plugin.ScanStatusSucceeded {
+				t.Fatalf("Scan(%s): status %v: %s", tc.img.Name, got.Status.Status, got.Status.FailureReason)
+			}
+			if diff := cmp.Diff(tc.want, integration.Packages(got.Inventories)); diff != "" {
+				t.Errorf("Scan(%s): unexpected packages (-want +got):\n%s", tc.img.Name, diff)
+			}
+			var gotSecrets []veles.Secret
+			for _, s := range got.Secrets {
+				gotSecrets = append(gotSecrets, s.Secret)
+			}
+			if diff := cmp.Diff(tc.wantSecrets, gotSecrets); diff != "" {
+				t.Errorf("Scan(%s): unexpected secrets (-want +got):\n%s", tc.img.Name, diff)
+			}
+		})
+	}
+}
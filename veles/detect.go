@@ -0,0 +1,120 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// KiB is a Kibibyte.
+	KiB = 1024
+	// MiB is a Mebibyte.
+	MiB = 1024 * KiB
+
+	// DefaultReadLen is the default number of bytes the DetectionEngine reads
+	// from the input in one go.
+	DefaultReadLen = 64 * KiB
+)
+
+// DetectionEngine runs a set of Detectors on data read from an io.Reader.
+//
+// The input is processed in chunks so arbitrarily large inputs can be handled
+// with bounded memory. Consecutive chunks overlap by the largest MaxSecretLen
+// of all Detectors so secrets crossing chunk boundaries are still found
+// exactly once.
+type DetectionEngine struct {
+	detectors []Detector
+	readLen   int
+	retainLen int
+}
+
+// NewDetectionEngine creates a DetectionEngine for the given Detectors that
+// reads DefaultReadLen bytes at a time.
+func NewDetectionEngine(ds []Detector) (*DetectionEngine, error) {
+	return NewDetectionEngineWithReadLen(ds, DefaultReadLen)
+}
+
+// NewDetectionEngineWithReadLen creates a DetectionEngine for the given
+// Detectors that reads readLen bytes at a time.
+func NewDetectionEngineWithReadLen(ds []Detector, readLen int) (*DetectionEngine, error) {
+	if len(ds) == 0 {
+		return nil, errors.New("no detectors provided")
+	}
+	if readLen <= 0 {
+		return nil, fmt.Errorf("readLen must be positive, got %d", readLen)
+	}
+	retainLen := 0
+	for _, d := range ds {
+		if l := int(d.MaxSecretLen()); l > retainLen {
+			retainLen = l
+		}
+	}
+	return &DetectionEngine{
+		detectors: ds,
+		readLen:   readLen,
+		retainLen: retainLen,
+	}, nil
+}
+
+// Detect reads all data from r and returns the secrets found in it by any of
+// the engine's Detectors. UTF-16, UTF-32 and gzip compressed input is decoded
+// first, see Decode.
+func (e *DetectionEngine) Detect(ctx context.Context, r io.Reader) ([]Secret, error) {
+	r, err := Decode(r)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, e.retainLen+e.readLen)
+	var secrets []Secret
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := io.ReadFull(r, buf[len(buf):cap(buf)])
+		buf = buf[:len(buf)+n]
+		eof := errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+		if err != nil && !eof {
+			return nil, err
+		}
+		if eof {
+			// Last chunk: Everything remaining needs to be processed.
+			return append(secrets, e.DetectChunk(buf, len(buf))...), nil
+		}
+		// Only report secrets starting before the tail that's retained for the
+		// next chunk, otherwise they would be reported twice.
+		limit := len(buf) - e.retainLen
+		secrets = append(secrets, e.DetectChunk(buf, limit)...)
+		buf = buf[:copy(buf, buf[limit:])]
+	}
+}
+
+// DetectChunk runs all Detectors on data and returns the secrets that start
+// before the byte offset limit.
+func (e *DetectionEngine) DetectChunk(data []byte, limit int) []Secret {
+	var secrets []Secret
+	for _, d := range e.detectors {
+		found, positions := d.Detect(data)
+		for i, s := range found {
+			if positions[i] < limit {
+				secrets = append(secrets, s)
+			}
+		}
+	}
+	return secrets
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+)
+
+// fakeDetector finds all occurrences of a fixed string.
+type fakeDetector struct {
+	token string
+}
+
+func (d fakeDetector) MaxSecretLen() uint32 { return uint32(len(d.token)) }
+
+func (d fakeDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	offset := 0
+	for {
+		i := bytes.Index(data[offset:], []byte(d.token))
+		if i < 0 {
+			return secrets, positions
+		}
+		secrets = append(secrets, d.token)
+		positions = append(positions, offset+i)
+		offset += i + len(d.token)
+	}
+}
+
+func TestNewDetectionEngine(t *testing.T) {
+	if _, err := veles.NewDetectionEngine(nil); err == nil {
+		t.Errorf("NewDetectionEngine(nil): got nil error, want error")
+	}
+	if _, err := veles.NewDetectionEngineWithReadLen([]veles.Detector{fakeDetector{"a"}}, 0); err == nil {
+		t.Errorf("NewDetectionEngineWithReadLen(0): got nil error, want error")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		readLen int
+		ds      []veles.Detector
+		want    []veles.Secret
+	}{
+		{
+			name:    "empty input",
+			input:   "",
+			readLen: 8,
+			ds:      []veles.Detector{fakeDetector{"SECRET"}},
+			want:    nil,
+		},
+		{
+			name:    "single chunk",
+			input:   "foo SECRET bar",
+			readLen: 1024,
+			ds:      []veles.Detector{fakeDetector{"SECRET"}},
+			want:    []veles.Secret{"SECRET"},
+		},
+		{
+			name:    "secret across chunk boundary",
+			input:   "0123SECRET456789",
+			readLen: 6,
+			ds:      []veles.Detector{fakeDetector{"SECRET"}},
+			want:    []veles.Secret{"SECRET"},
+		},
+		{
+			name:    "secret at start of retained tail is reported once",
+			input:   strings.Repeat("x", 8) + "SECRET" + strings.Repeat("y", 20),
+			readLen: 8,
+			ds:      []veles.Detector{fakeDetector{"SECRET"}},
+			want:    []veles.Secret{"SECRET"},
+		},
+		{
+			name:    "many secrets with small reads",
+			input:   strings.Repeat("SECRET-", 10),
+			readLen: 3,
+			ds:      []veles.Detector{fakeDetector{"SECRET"}},
+			want: []veles.Secret{
+				"SECRET", "SECRET", "SECRET", "SECRET", "SECRET",
+				"SECRET", "SECRET", "SECRET", "SECRET", "SECRET",
+			},
+		},
+		{
+			name:    "multiple detectors",
+			input:   "aaa FOO bbb BARBAZ ccc",
+			readLen: 4,
+			ds:      []veles.Detector{fakeDetector{"FOO"}, fakeDetector{"BARBAZ"}},
+			want:    []veles.Secret{"FOO", "BARBAZ"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := veles.NewDetectionEngineWithReadLen(tt.ds, tt.readLen)
+			if err != nil {
+				t.Fatalf("NewDetectionEngineWithReadLen(): %v", err)
+			}
+			got, err := e.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(%q): %v", tt.input, err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect(%q) diff (-want +got):\n%s", tt.input, diff)
+			}
+		})
+	}
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("read error") }
+
+func TestDetectReadError(t *testing.T) {
+	e, err := veles.NewDetectionEngine([]veles.Detector{fakeDetector{"SECRET"}})
+	if err != nil {
+		t.Fatalf("NewDetectionEngine(): %v", err)
+	}
+	if _, err := e.Detect(context.Background(), errReader{}); err == nil {
+		t.Errorf("Detect(errReader): got nil error, want error")
+	}
+}
+
+func TestDetectCancelledContext(t *testing.T) {
+	e, err := veles.NewDetectionEngine([]veles.Detector{fakeDetector{"SECRET"}})
+	if err != nil {
+		t.Fatalf("NewDetectionEngine(): %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := e.Detect(ctx, strings.NewReader("SECRET")); !errors.Is(err, context.Canceled) {
+		t.Errorf("Detect(cancelled ctx): got error %v, want %v", err, context.Canceled)
+	}
+}
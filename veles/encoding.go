@@ -0,0 +1,203 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+const (
+	// sniffLen is the number of bytes at the start of the input that are used
+	// to detect its encoding.
+	sniffLen = 512
+	// transcodeLen is the number of bytes of UTF-16 or UTF-32 input that are
+	// transcoded at a time.
+	transcodeLen = 4 * KiB
+	// maxDecompressedLen is the maximum number of bytes read from gzip
+	// compressed input so compression bombs can't keep the engine busy
+	// indefinitely.
+	maxDecompressedLen = 1024 * MiB
+	// minUTF16Ratio is the share of code units that need to be ASCII
+	// characters for input without a byte order mark to be treated as UTF-16.
+	minUTF16Ratio = 0.9
+)
+
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+	bomUTF32LE = []byte{0xFF, 0xFE, 0x00, 0x00}
+	bomUTF32BE = []byte{0x00, 0x00, 0xFE, 0xFF}
+	gzipMagic  = []byte{0x1F, 0x8B}
+)
+
+// Decode returns a reader of the contents of r as UTF-8 so that Detectors
+// only need to match UTF-8:
+//
+//   - Byte order marks are stripped.
+//   - UTF-16 and UTF-32 are transcoded to UTF-8. UTF-16 is also recognized
+//     without a byte order mark if it's mostly ASCII, as is common for files
+//     written by Windows tools.
+//   - gzip compressed data is decompressed, e.g. rotated logs.
+//
+// Other input is returned unchanged. The input is transcoded while it's read,
+// so memory usage doesn't depend on its size.
+func Decode(r io.Reader) (io.Reader, error) {
+	return decode(r, true)
+}
+
+func decode(r io.Reader, allowGzip bool) (io.Reader, error) {
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	switch {
+	case allowGzip && bytes.HasPrefix(head, gzipMagic):
+		// Check the header without consuming the input first so that input
+		// that only starts like gzip is returned unchanged.
+		if _, err := gzip.NewReader(bytes.NewReader(head)); err != nil {
+			return br, nil
+		}
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		// Compressed data is only decompressed once, so nested archives stay
+		// binary.
+		return decode(io.LimitReader(zr, maxDecompressedLen), false)
+	// UTF-32LE needs to be checked first since its BOM starts with the
+	// UTF-16LE BOM.
+	case bytes.HasPrefix(head, bomUTF32LE):
+		return newTranscoder(br, len(bomUTF32LE), 4, binary.LittleEndian), nil
+	case bytes.HasPrefix(head, bomUTF32BE):
+		return newTranscoder(br, len(bomUTF32BE), 4, binary.BigEndian), nil
+	case bytes.HasPrefix(head, bomUTF16LE):
+		return newTranscoder(br, len(bomUTF16LE), 2, binary.LittleEndian), nil
+	case bytes.HasPrefix(head, bomUTF16BE):
+		return newTranscoder(br, len(bomUTF16BE), 2, binary.BigEndian), nil
+	case bytes.HasPrefix(head, bomUTF8):
+		if _, err := br.Discard(len(bomUTF8)); err != nil {
+			return nil, err
+		}
+		return br, nil
+	case looksLikeUTF16(head, binary.LittleEndian):
+		return newTranscoder(br, 0, 2, binary.LittleEndian), nil
+	case looksLikeUTF16(head, binary.BigEndian):
+		return newTranscoder(br, 0, 2, binary.BigEndian), nil
+	}
+	return br, nil
+}
+
+// looksLikeUTF16 returns true if most code units at the start of the input
+// are ASCII characters in UTF-16 with the given byte order.
+func looksLikeUTF16(head []byte, order binary.ByteOrder) bool {
+	units := len(head) / 2
+	// Too short to tell, e.g. "a\x00" could be anything.
+	if units < 4 {
+		return false
+	}
+	ascii := 0
+	for i := 0; i+1 < len(head); i += 2 {
+		u := order.Uint16(head[i:])
+		if u != 0 && u < utf8.RuneSelf {
+			ascii++
+		}
+	}
+	return float64(ascii) >= minUTF16Ratio*float64(units)
+}
+
+// transcoder transcodes UTF-16 or UTF-32 input to UTF-8 while it's read.
+type transcoder struct {
+	r     *bufio.Reader
+	width int
+	order binary.ByteOrder
+	buf   []byte
+	// A high surrogate at the end of the previous UTF-16 input whose low
+	// surrogate hasn't been read yet.
+	carry   []uint16
+	pending []byte
+	err     error
+}
+
+func newTranscoder(r *bufio.Reader, skip, width int, order binary.ByteOrder) io.Reader {
+	t := &transcoder{r: r, width: width, order: order, buf: make([]byte, transcodeLen)}
+	if _, err := r.Discard(skip); err != nil {
+		t.err = err
+	}
+	return t
+}
+
+// Read reads UTF-8 encoded data into p.
+func (t *transcoder) Read(p []byte) (int, error) {
+	for len(t.pending) == 0 {
+		if t.err != nil {
+			return 0, t.err
+		}
+		t.fill()
+	}
+	n := copy(p, t.pending)
+	t.pending = t.pending[n:]
+	return n, nil
+}
+
+// fill transcodes the next part of the input into pending.
+func (t *transcoder) fill() {
+	n, err := io.ReadFull(t.r, t.buf)
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = io.EOF
+	}
+	t.err = err
+	// A trailing partial code unit is dropped.
+	in := t.buf[:n-n%t.width]
+	out := t.pending[:0]
+	if t.width == 4 {
+		for i := 0; i < len(in); i += 4 {
+			r := rune(t.order.Uint32(in[i:]))
+			if !utf8.ValidRune(r) {
+				r = utf8.RuneError
+			}
+			out = utf8.AppendRune(out, r)
+		}
+		t.pending = out
+		return
+	}
+	units := t.carry
+	for i := 0; i < len(in); i += 2 {
+		units = append(units, t.order.Uint16(in[i:]))
+	}
+	t.carry = nil
+	if last := len(units) - 1; t.err == nil && last >= 0 && isHighSurrogate(units[last]) {
+		t.carry = []uint16{units[last]}
+		units = units[:last]
+	}
+	for _, r := range utf16.Decode(units) {
+		out = utf8.AppendRune(out, r)
+	}
+	t.pending = out
+}
+
+// isHighSurrogate returns true if u is the first code unit of a UTF-16
+// surrogate pair.
+func isHighSurrogate(u uint16) bool {
+	return u >= 0xD800 && u < 0xDC00
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+)
+
+func utf16Bytes(t *testing.T, s string, order binary.AppendByteOrder, bom bool) []byte {
+	t.Helper()
+	var b []byte
+	if bom {
+		b = order.AppendUint16(b, 0xFEFF)
+	}
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = order.AppendUint16(b, u)
+	}
+	return b
+}
+
+func utf32Bytes(t *testing.T, s string, order binary.AppendByteOrder) []byte {
+	t.Helper()
+	b := order.AppendUint32(nil, 0xFEFF)
+	for _, r := range s {
+		b = order.AppendUint32(b, uint32(r))
+	}
+	return b
+}
+
+func gzipBytes(t *testing.T, b []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		t.Fatalf("gzip Write(): %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("gzip Close(): %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestDecode(t *testing.T) {
+	const text = "password: SECRET\r\nemoji: \U0001F511 ümlaut"
+	// A surrogate pair that's split between two reads of the transcoder.
+	longText := strings.Repeat("x", 2047) + "\U0001F511" + strings.Repeat("y", 5000)
+	tests := []struct {
+		name  string
+		input []byte
+		want  string
+	}{
+		{
+			name:  "utf-8",
+			input: []byte(text),
+			want:  text,
+		},
+		{
+			name:  "utf-8 with bom",
+			input: append([]byte{0xEF, 0xBB, 0xBF}, text...),
+			want:  text,
+		},
+		{
+			name:  "utf-16le with bom",
+			input: utf16Bytes(t, text, binary.LittleEndian, true),
+			want:  text,
+		},
+		{
+			name:  "utf-16be with bom",
+			input: utf16Bytes(t, text, binary.BigEndian, true),
+			want:  text,
+		},
+		{
+			name:  "utf-16le without bom",
+			input: utf16Bytes(t, text, binary.LittleEndian, false),
+			want:  text,
+		},
+		{
+			name:  "utf-16be without bom",
+			input: utf16Bytes(t, text, binary.BigEndian, false),
+			want:  text,
+		},
+		{
+			name:  "utf-16 surrogate pair across reads",
+			input: utf16Bytes(t, longText, binary.LittleEndian, true),
+			want:  longText,
+		},
+		{
+			name:  "utf-16 with trailing partial code unit",
+			input: append(utf16Bytes(t, "abc", binary.LittleEndian, true), 'd'),
+			want:  "abc",
+		},
+		{
+			name:  "utf-32le",
+			input: utf32Bytes(t, text, binary.LittleEndian),
+			want:  text,
+		},
+		{
+			name:  "utf-32be",
+			input: utf32Bytes(t, text, binary.BigEndian),
+			want:  text,
+		},
+		{
+			name:  "gzip",
+			input: gzipBytes(t, []byte(text)),
+			want:  text,
+		},
+		{
+			name:  "gzip compressed utf-16",
+			input: gzipBytes(t, utf16Bytes(t, text, binary.LittleEndian, true)),
+			want:  text,
+		},
+		{
+			name:  "gzip magic without gzip data",
+			input: []byte("\x1f\x8bnot gzip"),
+			want:  "\x1f\x8bnot gzip",
+		},
+		{
+			name:  "binary with nul bytes",
+			input: []byte("\x7fELF\x02\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x3e\x00"),
+			want:  "\x7fELF\x02\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x3e\x00",
+		},
+		{
+			name:  "empty",
+			input: []byte{},
+			want:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := veles.Decode(bytes.NewReader(tc.input))
+			if err != nil {
+				t.Fatalf("Decode(): %v", err)
+			}
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("io.ReadAll(): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("Decode() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDetectEncoded(t *testing.T) {
+	const text = "token = SECRET\n"
+	nested := gzipBytes(t, gzipBytes(t, []byte(text)))
+	tests := []struct {
+		name  string
+		input []byte
+		want  []veles.Secret
+	}{
+		{
+			name:  "utf-16le",
+			input: utf16Bytes(t, text, binary.LittleEndian, true),
+			want:  []veles.Secret{"SECRET"},
+		},
+		{
+			name:  "utf-32be",
+			input: utf32Bytes(t, text, binary.BigEndian),
+			want:  []veles.Secret{"SECRET"},
+		},
+		{
+			name:  "gzip",
+			input: gzipBytes(t, []byte(text)),
+			want:  []veles.Secret{"SECRET"},
+		},
+		{
+			name:  "nested gzip is only decompressed once",
+			input: nested,
+			want:  nil,
+		},
+		{
+			name:  "secret in the middle of a long line",
+			input: []byte(strings.Repeat("a", veles.MiB) + "SECRET" + strings.Repeat("b", veles.MiB)),
+			want:  []veles.Secret{"SECRET"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := veles.NewDetectionEngineWithReadLen([]veles.Detector{fakeDetector{"SECRET"}}, 1024)
+			if err != nil {
+				t.Fatalf("NewDetectionEngineWithReadLen(): %v", err)
+			}
+			got, err := e.Detect(context.Background(), bytes.NewReader(tc.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
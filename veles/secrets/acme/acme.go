@@ -0,0 +1,59 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package acme contains Veles Secret types and Detectors for the credentials
+// of ACME clients like certbot and acme.sh: ACME account keys and the DNS
+// provider API tokens used to solve DNS-01 challenges. Both allow issuing
+// certificates for the domains of the account's owner, and DNS provider
+// tokens usually also allow changing the domains' DNS records.
+package acme
+
+// AccountKey is the private key of an ACME account, e.g. a Let's Encrypt
+// account, in the JWK format certbot stores in the private_key.json of the
+// account.
+type AccountKey struct {
+	// The JWK key type, "RSA" or "EC".
+	KeyType string
+	// The JWK as found in the file.
+	JWK string
+}
+
+// CertbotDNSCredentials is a certbot renewal config that references the
+// credentials file of a DNS plugin. The credentials file holds the DNS
+// provider token certbot renews the certificate with.
+type CertbotDNSCredentials struct {
+	// The certbot authenticator, e.g. "dns-cloudflare".
+	Authenticator string
+	// Path of the credentials file, e.g. "/root/.secrets/cloudflare.ini".
+	CredentialsFile string
+	// ID of the ACME account the certificate is renewed with. Empty if the
+	// config doesn't contain it.
+	Account string
+	// The ACME directory URL, e.g.
+	// "https://acme-v02.api.letsencrypt.org/directory".
+	Server string
+}
+
+// DNSProviderToken is an API token, key or secret of a DNS provider from a
+// certbot DNS plugin credentials file or an acme.sh account config.
+type DNSProviderToken struct {
+	// The ACME client that stores the token, "certbot" or "acme.sh".
+	Tool string
+	// The DNS provider, e.g. "cloudflare" or "route53".
+	Provider string
+	// The variable the token is stored in, e.g. "dns_cloudflare_api_token" or
+	// "CF_Token".
+	Variable string
+	Token    string
+}
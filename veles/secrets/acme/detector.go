@@ -0,0 +1,209 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package acme
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxContextLen bounds the config lines matched by the detection
+	// regexes.
+	maxContextLen = 1 * veles.KiB
+	// maxJWKLen is the maximum length of a JWK private key. The JWK of a
+	// 4096 bit RSA key is about 3.2 KiB.
+	maxJWKLen = 8 * veles.KiB
+	// maxRenewalConfigLen is how far from the credentials file reference the
+	// other settings of a certbot renewal config are searched.
+	maxRenewalConfigLen = 2 * veles.KiB
+)
+
+var (
+	// certbot stores account keys as a single JSON object, e.g.
+	// {"n": "...", "e": "AQAB", "d": "...", ..., "kty": "RSA"}.
+	jwkRe = regexp.MustCompile(`\{[^{}]*"kty"\s*:\s*"(?:RSA|EC)"[^{}]*\}`)
+
+	// certbot renewal configs reference the credentials file of DNS plugins
+	// in the [renewalparams] section, next to the authenticator, the account
+	// and the ACME server:
+	//
+	//   [renewalparams]
+	//   account = 0123456789abcdef0123456789abcdef
+	//   authenticator = dns-cloudflare
+	//   dns_cloudflare_credentials = /root/.secrets/cloudflare.ini
+	//   server = https://acme-v02.api.letsencrypt.org/directory
+	certbotCredentialsRe   = regexp.MustCompile(`(?m)^[ \t]*dns_([a-z0-9_]+?)_credentials[ \t]*=[ \t]*(\S+)`)
+	certbotAuthenticatorRe = regexp.MustCompile(`(?m)^[ \t]*authenticator[ \t]*=[ \t]*(\S+)`)
+	certbotAccountRe       = regexp.MustCompile(`(?m)^[ \t]*account[ \t]*=[ \t]*([0-9a-f]{32})\b`)
+	certbotServerRe        = regexp.MustCompile(`(?m)^[ \t]*server[ \t]*=[ \t]*(https?://\S+)`)
+
+	// certbot DNS plugin credentials files are INI files with
+	// dns_<provider>_<setting> keys, e.g. dns_cloudflare_api_token or
+	// dns_rfc2136_secret.
+	certbotTokenRe = regexp.MustCompile(`(?m)^[ \t]*(dns_([a-z0-9]+)_[a-z0-9_]*?(?:token|key|secret|password))[ \t]*=[ \t]*["']?([^\s"']{1,512})`)
+
+	// acme.sh saves the DNS API settings of the providers used to issue
+	// certificates in account.conf as SAVED_<provider>_<setting>='<value>',
+	// and older versions without the SAVED_ prefix.
+	acmeShTokenRe = regexp.MustCompile(`(?m)^[ \t]*(?:export[ \t]+)?(SAVED_)?(([A-Za-z0-9]+)_[A-Za-z0-9_]*?(?i:token|key|secret|password|pass))=["']([^"'\n]{1,512})["']`)
+)
+
+// acmeShProviders maps the variable prefixes of acme.sh DNS APIs to the DNS
+// provider.
+var acmeShProviders = map[string]string{
+	"ACMEDNS":   "acme-dns",
+	"AWS":       "route53",
+	"AZUREDNS":  "azure",
+	"Ali":       "alibaba",
+	"CF":        "cloudflare",
+	"DNSimple":  "dnsimple",
+	"DO":        "digitalocean",
+	"DP":        "dnspod",
+	"GANDI":     "gandi",
+	"GD":        "godaddy",
+	"HETZNER":   "hetzner",
+	"INWX":      "inwx",
+	"LINODE":    "linode",
+	"NAMECHEAP": "namecheap",
+	"NS1":       "ns1",
+	"OVH":       "ovh",
+	"PDNS":      "powerdns",
+	"PORKBUN":   "porkbun",
+	"VULTR":     "vultr",
+}
+
+// accountKeyDetector finds ACME account keys in certbot's JWK format.
+type accountKeyDetector struct{}
+
+// NewAccountKeyDetector returns a Detector that finds ACME account keys.
+func NewAccountKeyDetector() veles.Detector { return accountKeyDetector{} }
+
+// MaxSecretLen returns the maximum length of a JWK private key.
+func (accountKeyDetector) MaxSecretLen() uint32 { return maxJWKLen }
+
+// Detect finds ACME account keys in data.
+func (accountKeyDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range jwkRe.FindAllIndex(data, -1) {
+		jwk := data[m[0]:m[1]]
+		var key struct {
+			KTY string `json:"kty"`
+			D   string `json:"d"`
+			N   string `json:"n"`
+			X   string `json:"x"`
+		}
+		if err := json.Unmarshal(jwk, &key); err != nil {
+			continue
+		}
+		// Public keys don't have the private exponent or scalar "d".
+		if key.D == "" || (key.KTY == "RSA" && key.N == "") || (key.KTY == "EC" && key.X == "") {
+			continue
+		}
+		secrets = append(secrets, AccountKey{KeyType: key.KTY, JWK: string(jwk)})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// certbotDNSCredentialsDetector finds certbot renewal configs that reference
+// DNS plugin credentials files.
+type certbotDNSCredentialsDetector struct{}
+
+// NewCertbotDNSCredentialsDetector returns a Detector that finds certbot
+// renewal configs referencing DNS plugin credentials files.
+func NewCertbotDNSCredentialsDetector() veles.Detector {
+	return certbotDNSCredentialsDetector{}
+}
+
+// MaxSecretLen returns the maximum length of a credentials file reference.
+func (certbotDNSCredentialsDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds certbot renewal configs referencing DNS plugin credentials
+// files in data.
+func (certbotDNSCredentialsDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range certbotCredentialsRe.FindAllSubmatchIndex(data, -1) {
+		context := data[max(0, m[0]-maxRenewalConfigLen):min(len(data), m[1]+maxRenewalConfigLen)]
+		s := CertbotDNSCredentials{
+			Authenticator:   "dns-" + strings.ReplaceAll(string(data[m[2]:m[3]]), "_", "-"),
+			CredentialsFile: string(data[m[4]:m[5]]),
+		}
+		if a := certbotAuthenticatorRe.FindSubmatch(context); a != nil {
+			s.Authenticator = string(a[1])
+		}
+		if a := certbotAccountRe.FindSubmatch(context); a != nil {
+			s.Account = string(a[1])
+		}
+		if a := certbotServerRe.FindSubmatch(context); a != nil {
+			s.Server = string(a[1])
+		}
+		secrets = append(secrets, s)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// dnsProviderTokenDetector finds DNS provider tokens in certbot DNS plugin
+// credentials files and acme.sh account configs.
+type dnsProviderTokenDetector struct{}
+
+// NewDNSProviderTokenDetector returns a Detector that finds DNS provider
+// tokens of ACME clients.
+func NewDNSProviderTokenDetector() veles.Detector { return dnsProviderTokenDetector{} }
+
+// MaxSecretLen returns the maximum length of a DNS provider token setting.
+func (dnsProviderTokenDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds DNS provider tokens of ACME clients in data.
+func (dnsProviderTokenDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range certbotTokenRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, DNSProviderToken{
+			Tool:     "certbot",
+			Provider: string(data[m[4]:m[5]]),
+			Variable: string(data[m[2]:m[3]]),
+			Token:    string(data[m[6]:m[7]]),
+		})
+		positions = append(positions, m[0])
+	}
+	for _, m := range acmeShTokenRe.FindAllSubmatchIndex(data, -1) {
+		saved := m[2] >= 0
+		prefix := string(data[m[6]:m[7]])
+		provider, ok := acmeShProviders[prefix]
+		if !ok {
+			// Without the SAVED_ prefix only the settings of known DNS APIs
+			// are told apart from other shell variables.
+			if !saved {
+				continue
+			}
+			provider = strings.ToLower(prefix)
+		}
+		secrets = append(secrets, DNSProviderToken{
+			Tool:     "acme.sh",
+			Provider: provider,
+			Variable: string(data[m[4]:m[5]]),
+			Token:    string(data[m[8]:m[9]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ambientcreds contains Veles Secret types and Detectors for ambient
+// credentials persisted to files: tokens and credentials served by the
+// instance metadata services of AWS, Google Cloud and Azure, and the sockets
+// of SSH agents, including agents forwarded into SSH sessions.
+//
+// These credentials are handed to any process on a host, so they usually
+// only end up in files when they were copied out during debugging or by an
+// attacker, e.g. in saved curl output or dumped process environments. The
+// metadata credentials are short-lived, but finding them indicates that
+// credentials of the machine's identity may have been exfiltrated.
+package ambientcreds
+
+// IMDSv2Token is a session token of the AWS EC2 instance metadata service
+// (IMDSv2), which authorizes requests to the metadata service including
+// those for the credentials of the instance's role.
+type IMDSv2Token struct {
+	Token string
+}
+
+// AWSMetadataCredentials are the temporary credentials of an EC2 instance
+// role as served by the instance metadata service at
+// /latest/meta-data/iam/security-credentials/<role>.
+type AWSMetadataCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// The expiration time as served by the metadata service, e.g.
+	// "2024-05-01T12:00:00Z".
+	Expiration string
+}
+
+// Metadata token providers.
+const (
+	ProviderGCP   = "gcp"
+	ProviderAzure = "azure"
+)
+
+// MetadataAccessToken is an OAuth access token of a service account or a
+// managed identity as served by the Google Cloud metadata server or the
+// Azure instance metadata service.
+type MetadataAccessToken struct {
+	Provider string
+	Token    string
+	// The resource the token is valid for. Only served by Azure.
+	Resource string
+}
+
+// SSHAgentSocket is the socket of an SSH agent from an SSH_AUTH_SOCK
+// variable, e.g. in a file sourced from the output of "ssh-agent -s" or in a
+// dumped process environment. Anyone with access to the socket can
+// authenticate with the keys loaded into the agent.
+type SSHAgentSocket struct {
+	Path string
+	// The process ID of the agent, 0 if unknown.
+	PID int
+	// Forwarded is true if the socket was forwarded into an SSH session, in
+	// which case the keys are on the client that connected to the host.
+	Forwarded bool
+}
@@ -0,0 +1,226 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ambientcreds
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxContextLen bounds the variable names, separators and values matched
+	// by the detection regexes.
+	maxContextLen = 512
+	// maxObjectLen is how far apart the fields of a metadata service response
+	// can be. AWS session tokens alone can be over 1 KiB long.
+	maxObjectLen = 4 * veles.KiB
+	// maxSSHEnvLen is how far apart the SSH variables of an environment can
+	// be.
+	maxSSHEnvLen = 4 * veles.KiB
+)
+
+var (
+	// IMDSv2 tokens are sent in the X-aws-ec2-metadata-token header and are
+	// usually stored in a variable after being requested with
+	// `TOKEN=$(curl -X PUT http://169.254.169.254/latest/api/token ...)`.
+	imdsHeaderRe = regexp.MustCompile(`(?i:x-aws-ec2-metadata-token)["']?\s{0,5}[:=]\s{0,5}["']?([A-Za-z0-9+/_\-]{40,100}={0,2})`)
+	imdsEnvRe    = regexp.MustCompile(`(?i:[a-z0-9_]{0,40}token)["']?\s{0,5}[:=]\s{0,5}["']?(AQAEA[A-Za-z0-9+/_\-]{35,95}={0,2})`)
+
+	// The fields of the instance role credentials served by IMDS.
+	awsAccessKeyIDRe = regexp.MustCompile(`"AccessKeyId"\s{0,5}:\s{0,5}"(ASIA[A-Z0-9]{16})"`)
+	awsSecretKeyRe   = regexp.MustCompile(`"SecretAccessKey"\s{0,5}:\s{0,5}"([A-Za-z0-9/+]{40})"`)
+	awsTokenRe       = regexp.MustCompile(`"Token"\s{0,5}:\s{0,5}"([A-Za-z0-9/+=]{100,})"`)
+	awsExpirationRe  = regexp.MustCompile(`"Expiration"\s{0,5}:\s{0,5}"([0-9T:.\-+Z]{1,40})"`)
+
+	// The fields of the access tokens served by the Google Cloud metadata
+	// server and Azure IMDS. Google's user OAuth tokens have the same format
+	// but come with a refresh token.
+	gcpAccessTokenRe   = regexp.MustCompile(`"access_token"\s{0,5}:\s{0,5}"(ya29\.[A-Za-z0-9_\-.]{20,})"`)
+	azureAccessTokenRe = regexp.MustCompile(`"access_token"\s{0,5}:\s{0,5}"(eyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]*)"`)
+	azureResourceRe    = regexp.MustCompile(`"resource"\s{0,5}:\s{0,5}"(https://[^"\s]{1,200})"`)
+	expiresInRe        = regexp.MustCompile(`"expires_in"\s{0,5}:`)
+	refreshTokenRe     = regexp.MustCompile(`"refresh_token"\s{0,5}:`)
+
+	// SSH agent variables as printed by "ssh-agent -s", set by sshd for
+	// forwarded agents or dumped from /proc/<pid>/environ.
+	sshAuthSockRe = regexp.MustCompile(`SSH_AUTH_SOCK["']?\s{0,5}[:=]\s{0,5}["']?(/[^\s;'"\x00]{1,255})`)
+	sshAgentPIDRe = regexp.MustCompile(`SSH_AGENT_PID["']?\s{0,5}[:=]\s{0,5}["']?([0-9]{1,10})\b`)
+	sshConnRe     = regexp.MustCompile(`SSH_(?:CONNECTION|CLIENT)["']?\s{0,5}[:=]`)
+)
+
+// imdsTokenDetector finds IMDSv2 session tokens.
+type imdsTokenDetector struct{}
+
+// NewIMDSv2TokenDetector returns a Detector that finds AWS IMDSv2 session
+// tokens.
+func NewIMDSv2TokenDetector() veles.Detector { return imdsTokenDetector{} }
+
+// MaxSecretLen returns the maximum length of a token with its context.
+func (imdsTokenDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds IMDSv2 session tokens in data.
+func (imdsTokenDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	seen := map[int]bool{}
+	for _, re := range []*regexp.Regexp{imdsHeaderRe, imdsEnvRe} {
+		for _, m := range re.FindAllSubmatchIndex(data, -1) {
+			// A header like "X-aws-ec2-metadata-token: AQAEA..." matches both
+			// regexes.
+			if seen[m[2]] {
+				continue
+			}
+			seen[m[2]] = true
+			secrets = append(secrets, IMDSv2Token{Token: string(data[m[2]:m[3]])})
+			positions = append(positions, m[0])
+		}
+	}
+	return secrets, positions
+}
+
+// awsCredentialsDetector finds instance role credentials served by IMDS.
+type awsCredentialsDetector struct{}
+
+// NewAWSMetadataCredentialsDetector returns a Detector that finds the
+// credentials of EC2 instance roles as served by the instance metadata
+// service.
+func NewAWSMetadataCredentialsDetector() veles.Detector { return awsCredentialsDetector{} }
+
+// MaxSecretLen returns the maximum length of a credentials response.
+func (awsCredentialsDetector) MaxSecretLen() uint32 { return maxObjectLen }
+
+// Detect finds instance role credentials in data. Credentials are only
+// reported with their secret key and session token since temporary access
+// keys can't be used without them.
+func (awsCredentialsDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range awsAccessKeyIDRe.FindAllSubmatchIndex(data, -1) {
+		secret := nearest(data, awsSecretKeyRe, m[0], maxObjectLen)
+		token := nearest(data, awsTokenRe, m[0], maxObjectLen)
+		if secret == "" || token == "" {
+			continue
+		}
+		secrets = append(secrets, AWSMetadataCredentials{
+			AccessKeyID:     string(data[m[2]:m[3]]),
+			SecretAccessKey: secret,
+			SessionToken:    token,
+			Expiration:      nearest(data, awsExpirationRe, m[0], maxObjectLen),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// metadataTokenDetector finds access tokens served by the Google Cloud
+// metadata server and Azure IMDS.
+type metadataTokenDetector struct{}
+
+// NewMetadataAccessTokenDetector returns a Detector that finds access tokens
+// served by the Google Cloud metadata server and the Azure instance metadata
+// service.
+func NewMetadataAccessTokenDetector() veles.Detector { return metadataTokenDetector{} }
+
+// MaxSecretLen returns the maximum length of a token response.
+func (metadataTokenDetector) MaxSecretLen() uint32 { return maxObjectLen }
+
+// Detect finds metadata service access tokens in data.
+func (metadataTokenDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range gcpAccessTokenRe.FindAllSubmatchIndex(data, -1) {
+		obj := window(data, m[0], maxContextLen)
+		if !expiresInRe.Match(obj) || refreshTokenRe.Match(obj) {
+			continue
+		}
+		secrets = append(secrets, MetadataAccessToken{
+			Provider: ProviderGCP,
+			Token:    string(data[m[2]:m[3]]),
+		})
+		positions = append(positions, m[0])
+	}
+	for _, m := range azureAccessTokenRe.FindAllSubmatchIndex(data, -1) {
+		// Azure IMDS responses list the resource after the token.
+		resource := nearest(data, azureResourceRe, m[0], maxObjectLen)
+		if resource == "" || refreshTokenRe.Match(window(data, m[0], maxObjectLen)) {
+			continue
+		}
+		secrets = append(secrets, MetadataAccessToken{
+			Provider: ProviderAzure,
+			Token:    string(data[m[2]:m[3]]),
+			Resource: resource,
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// sshAgentDetector finds SSH agent sockets.
+type sshAgentDetector struct{}
+
+// NewSSHAgentSocketDetector returns a Detector that finds SSH agent sockets
+// in SSH agent environment files and dumped process environments.
+func NewSSHAgentSocketDetector() veles.Detector { return sshAgentDetector{} }
+
+// MaxSecretLen returns the maximum length of an environment with an SSH
+// agent socket.
+func (sshAgentDetector) MaxSecretLen() uint32 { return maxSSHEnvLen }
+
+// Detect finds SSH agent sockets in data. Agents started with ssh-agent set
+// SSH_AGENT_PID, while forwarded agents are set up by sshd together with
+// SSH_CONNECTION.
+func (sshAgentDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range sshAuthSockRe.FindAllSubmatchIndex(data, -1) {
+		s := SSHAgentSocket{Path: string(data[m[2]:m[3]])}
+		if pid := nearest(data, sshAgentPIDRe, m[0], maxSSHEnvLen); pid != "" {
+			s.PID, _ = strconv.Atoi(pid)
+		} else {
+			s.Forwarded = sshConnRe.Match(window(data, m[0], maxSSHEnvLen))
+		}
+		secrets = append(secrets, s)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// nearest returns the first submatch of the match of re closest to pos
+// within maxDist, or "" if there is none.
+func nearest(data []byte, re *regexp.Regexp, pos, maxDist int) string {
+	start := max(0, pos-maxDist)
+	var best []int
+	bestDist := maxDist + 1
+	for _, m := range re.FindAllSubmatchIndex(window(data, pos, maxDist), -1) {
+		dist := start + m[0] - pos
+		if dist < 0 {
+			dist = -dist
+		}
+		if dist < bestDist {
+			best, bestDist = m, dist
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	return string(data[start+best[2] : start+best[3]])
+}
+
+// window returns the bytes of data within maxDist of pos.
+func window(data []byte, pos, maxDist int) []byte {
+	return data[max(0, pos-maxDist):min(len(data), pos+maxDist)]
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apispec contains Veles Secret types and a Detector for API keys in
+// API descriptions and HTTP fixtures: example values of OpenAPI and Swagger
+// specs, Postman collections and environments, and hardcoded x-api-key
+// headers in HAR files, WireMock and Mockoon mappings and raw HTTP requests.
+//
+// Example values in these files are frequently copied from a working request
+// against a real API gateway instead of being made up, so they're reported
+// unless they look like a placeholder.
+package apispec
+
+// Kinds of files the keys are found in.
+const (
+	SourceOpenAPI     = "openapi"
+	SourceSwagger     = "swagger"
+	SourcePostman     = "postman"
+	SourceHTTPFixture = "http-fixture"
+)
+
+// APIKey is a key of a private HTTP API or API gateway, e.g. an AWS API
+// Gateway or Azure API Management key.
+//
+// The API is identified by the file the key is found in, so there's no
+// Validator.
+type APIKey struct {
+	// Source is the kind of file the key was found in, e.g. SourceOpenAPI.
+	Source string
+	// Name of the header, query parameter or cookie that carries the key,
+	// e.g. "X-API-Key".
+	Name string
+	// URL of the API or of the request that the key is sent with, if the file
+	// declares it.
+	URL string
+	Key string
+}
+
+// PostmanAPIKey is a Postman API key ("PMAK-..."). Private Postman mock
+// servers require one in the x-api-key header, so they end up in the
+// collections and fixtures that call the mock. The key grants access to the
+// workspaces of its user.
+type PostmanAPIKey struct {
+	// Source is the kind of file the key was found in, e.g. SourcePostman.
+	Source string
+	Key    string
+}
@@ -0,0 +1,361 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apispec
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"gopkg.in/yaml.v3"
+)
+
+// maxSpecLen is the maximum size of a spec, collection or fixture. Larger
+// files can't be parsed as a whole, but their x-api-key header lines are
+// still matched.
+const maxSpecLen = 256 * veles.KiB
+
+var (
+	// keyNameRe matches the names of headers, parameters and variables that
+	// usually carry an API key, e.g. "X-API-Key", "apiKey",
+	// "postman_api_key" or "Ocp-Apim-Subscription-Key".
+	keyNameRe = regexp.MustCompile(`(?i)(^|[-_.])(api[-_]?key|api[-_]?token|subscription[-_]?key)$`)
+	// headerLineRe matches hardcoded x-api-key headers in raw HTTP requests,
+	// e.g. in .http files of REST clients, curl commands and test code.
+	headerLineRe = regexp.MustCompile(`(?i)\b(x-api-key)["']?[ \t]{0,5}:[ \t]{0,5}["']?([A-Za-z0-9_\-.~+/=]{16,256})`)
+	keyRe        = regexp.MustCompile(`^[A-Za-z0-9_\-.~+/=:]{16,256}$`)
+	postmanKeyRe = regexp.MustCompile(`^PMAK-[0-9a-f]{24}-[0-9a-f]{34}$`)
+
+	placeholderWords = []string{"xxxx", "your", "example", "sample", "placeholder", "changeme", "redacted", "dummy", "apikey", "api_key", "api-key"}
+)
+
+// detector finds API keys in API descriptions and HTTP fixtures.
+type detector struct{}
+
+// NewDetector returns a Detector that finds API keys in OpenAPI and Swagger
+// specs, Postman collections and environments, and HTTP fixtures.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum size of a spec.
+func (detector) MaxSecretLen() uint32 { return maxSpecLen }
+
+// Detect finds API keys in the spec, collection or fixture in data.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	var keys []APIKey
+	doc, ok := parse(data)
+	switch {
+	case ok && doc["openapi"] != nil:
+		keys = openAPIKeys(SourceOpenAPI, doc)
+	case ok && doc["swagger"] != nil:
+		keys = openAPIKeys(SourceSwagger, doc)
+	case ok && isPostman(data):
+		keys = postmanKeys(doc)
+	default:
+		if ok {
+			keys = fixtureKeys(doc)
+		}
+		for _, m := range headerLineRe.FindAllSubmatch(data, -1) {
+			keys = append(keys, APIKey{Source: SourceHTTPFixture, Name: string(m[1]), Key: string(m[2])})
+		}
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	seen := map[string]bool{}
+	for _, k := range keys {
+		if seen[k.Key] || !keyRe.MatchString(k.Key) || isPlaceholder(k.Key) {
+			continue
+		}
+		seen[k.Key] = true
+		pos := bytes.Index(data, []byte(k.Key))
+		if pos < 0 {
+			pos = 0
+		}
+		if postmanKeyRe.MatchString(k.Key) {
+			secrets = append(secrets, PostmanAPIKey{Source: k.Source, Key: k.Key})
+		} else {
+			secrets = append(secrets, k)
+		}
+		positions = append(positions, pos)
+	}
+	return secrets, positions
+}
+
+// parse parses data as a JSON or YAML object. Only YAML that looks like a spec
+// is parsed, other fixtures are matched line by line.
+func parse(data []byte) (map[string]any, bool) {
+	var doc map[string]any
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		if err := json.Unmarshal(trimmed, &doc); err != nil {
+			return nil, false
+		}
+	case bytes.Contains(data, []byte("openapi:")) || bytes.Contains(data, []byte("swagger:")):
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+	return doc, doc != nil
+}
+
+func isPostman(data []byte) bool {
+	return bytes.Contains(data, []byte(`"_postman_id"`)) ||
+		bytes.Contains(data, []byte(`"_postman_variable_scope"`)) ||
+		bytes.Contains(data, []byte("schema.getpostman.com"))
+}
+
+// openAPIKeys returns the example values of the API key security schemes and
+// parameters of an OpenAPI 3 or Swagger 2 spec.
+func openAPIKeys(source string, doc map[string]any) []APIKey {
+	url := specURL(doc)
+
+	// The names of the headers, parameters and cookies that the spec's
+	// security schemes send keys in.
+	names := map[string]bool{}
+	schemes := map[string]any{}
+	if components, ok := doc["components"].(map[string]any); ok {
+		schemes, _ = components["securitySchemes"].(map[string]any)
+	}
+	if defs, ok := doc["securityDefinitions"].(map[string]any); ok {
+		schemes = defs
+	}
+	var keys []APIKey
+	for _, k := range sortedKeys(schemes) {
+		scheme, _ := schemes[k].(map[string]any)
+		if scheme["type"] != "apiKey" {
+			continue
+		}
+		name, _ := scheme["name"].(string)
+		names[strings.ToLower(name)] = true
+		for _, v := range exampleValues(scheme) {
+			keys = append(keys, APIKey{Source: source, Name: name, URL: url, Key: v})
+		}
+	}
+
+	walk(doc, func(obj map[string]any) {
+		name, _ := obj["name"].(string)
+		in, _ := obj["in"].(string)
+		if in != "header" && in != "query" && in != "cookie" {
+			return
+		}
+		if !names[strings.ToLower(name)] && !keyNameRe.MatchString(name) {
+			return
+		}
+		if obj["type"] == "apiKey" {
+			// Security schemes are handled above.
+			return
+		}
+		for _, v := range exampleValues(obj) {
+			keys = append(keys, APIKey{Source: source, Name: name, URL: url, Key: v})
+		}
+	})
+	return keys
+}
+
+// specURL returns the URL of the first server of an OpenAPI 3 spec or the
+// URL made of the host and base path of a Swagger 2 spec.
+func specURL(doc map[string]any) string {
+	if servers, ok := doc["servers"].([]any); ok && len(servers) > 0 {
+		server, _ := servers[0].(map[string]any)
+		url, _ := server["url"].(string)
+		return url
+	}
+	host, _ := doc["host"].(string)
+	if host == "" {
+		return ""
+	}
+	scheme := "https"
+	if schemes, ok := doc["schemes"].([]any); ok && len(schemes) > 0 {
+		if s, ok := schemes[0].(string); ok {
+			scheme = s
+		}
+	}
+	basePath, _ := doc["basePath"].(string)
+	return scheme + "://" + host + basePath
+}
+
+// exampleValues returns the string example and default values of a parameter
+// or security scheme, including those of its schema and of its named
+// examples.
+func exampleValues(obj map[string]any) []string {
+	var values []string
+	add := func(o map[string]any) {
+		for _, k := range []string{"example", "x-example", "default"} {
+			if v, ok := o[k].(string); ok {
+				values = append(values, v)
+			}
+		}
+	}
+	add(obj)
+	if schema, ok := obj["schema"].(map[string]any); ok {
+		add(schema)
+	}
+	if examples, ok := obj["examples"].(map[string]any); ok {
+		for _, k := range sortedKeys(examples) {
+			if e, ok := examples[k].(map[string]any); ok {
+				if v, ok := e["value"].(string); ok {
+					values = append(values, v)
+				}
+			}
+		}
+	}
+	return values
+}
+
+// postmanKeys returns the API keys in the headers, API key auth settings and
+// variables of a Postman collection or environment.
+func postmanKeys(doc map[string]any) []APIKey {
+	var keys []APIKey
+	walk(doc, func(obj map[string]any) {
+		url := postmanURL(obj["url"])
+		if headers, ok := obj["header"].([]any); ok {
+			for _, h := range headers {
+				name, value := keyValue(h, "key")
+				if keyNameRe.MatchString(name) {
+					keys = append(keys, APIKey{Source: SourcePostman, Name: name, URL: url, Key: value})
+				}
+			}
+		}
+		if obj["type"] == "apikey" {
+			// The settings of API key auth are a list of key-value pairs, e.g.
+			// [{"key": "key", "value": "x-api-key"}, {"key": "value", "value": "..."}].
+			settings := map[string]string{}
+			entries, _ := obj["apikey"].([]any)
+			for _, e := range entries {
+				k, v := keyValue(e, "key")
+				settings[k] = v
+			}
+			if settings["value"] != "" {
+				keys = append(keys, APIKey{Source: SourcePostman, Name: settings["key"], Key: settings["value"]})
+			}
+		}
+		for _, field := range []string{"values", "variable"} {
+			vars, _ := obj[field].([]any)
+			for _, v := range vars {
+				name, value := keyValue(v, "key")
+				if keyNameRe.MatchString(name) {
+					keys = append(keys, APIKey{Source: SourcePostman, Name: name, Key: value})
+				}
+			}
+		}
+	})
+	return keys
+}
+
+// postmanURL returns the URL of a Postman request, which is either a string
+// or an object with the URL in its "raw" field.
+func postmanURL(v any) string {
+	switch u := v.(type) {
+	case string:
+		return u
+	case map[string]any:
+		raw, _ := u["raw"].(string)
+		return raw
+	default:
+		return ""
+	}
+}
+
+// fixtureKeys returns the API keys in the request headers of JSON fixtures:
+// HAR files ({"name": ..., "value": ...} header lists), WireMock mappings
+// (header maps with plain or {"equalTo": ...} values) and Mockoon
+// environments (header rules of routes).
+func fixtureKeys(doc map[string]any) []APIKey {
+	var keys []APIKey
+	add := func(name, value string) {
+		if keyNameRe.MatchString(name) {
+			keys = append(keys, APIKey{Source: SourceHTTPFixture, Name: name, Key: value})
+		}
+	}
+	walk(doc, func(obj map[string]any) {
+		switch headers := obj["headers"].(type) {
+		case []any:
+			for _, h := range headers {
+				add(keyValue(h, "name"))
+			}
+		case map[string]any:
+			for _, name := range sortedKeys(headers) {
+				switch v := headers[name].(type) {
+				case string:
+					add(name, v)
+				case map[string]any:
+					value, _ := v["equalTo"].(string)
+					add(name, value)
+				}
+			}
+		}
+		if obj["target"] == "header" {
+			name, _ := obj["modifier"].(string)
+			value, _ := obj["value"].(string)
+			add(name, value)
+		}
+	})
+	return keys
+}
+
+// keyValue returns the name and the value of an object such as
+// {"key": "x-api-key", "value": "..."}, where nameField is the field that
+// holds the name.
+func keyValue(v any, nameField string) (string, string) {
+	obj, _ := v.(map[string]any)
+	if disabled, _ := obj["disabled"].(bool); disabled {
+		return "", ""
+	}
+	name, _ := obj[nameField].(string)
+	value, _ := obj["value"].(string)
+	return name, value
+}
+
+// isPlaceholder returns whether a value looks like a placeholder instead of a
+// real key. Real keys are random and contain both letters and digits.
+func isPlaceholder(v string) bool {
+	lower := strings.ToLower(v)
+	for _, w := range placeholderWords {
+		if strings.Contains(lower, w) {
+			return true
+		}
+	}
+	return !strings.ContainsAny(v, "0123456789") || !strings.ContainsAny(lower, "abcdefghijklmnopqrstuvwxyz")
+}
+
+// walk calls fn for every object in v, in a deterministic order.
+func walk(v any, fn func(map[string]any)) {
+	switch t := v.(type) {
+	case map[string]any:
+		fn(t)
+		for _, k := range sortedKeys(t) {
+			walk(t[k], fn)
+		}
+	case []any:
+		for _, e := range t {
+			walk(e, fn)
+		}
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
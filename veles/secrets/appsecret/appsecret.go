@@ -0,0 +1,179 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package appsecret contains a Veles Secret type and Detector for the secret
+// keys of web frameworks and the keys and passphrases encrypting app databases
+// and backups.
+package appsecret
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxContextLen bounds the key name, separator and value matched by the
+// detection regexes.
+const maxContextLen = 400
+
+// Framework is the framework or tool a Key belongs to.
+type Framework string
+
+// Supported frameworks and tools.
+const (
+	Django        Framework = "django"
+	Flask         Framework = "flask"
+	Rails         Framework = "rails"
+	Laravel       Framework = "laravel"
+	SQLCipher     Framework = "sqlcipher"
+	SignalDesktop Framework = "signal-desktop"
+	Restic        Framework = "restic"
+	Borg          Framework = "borg"
+)
+
+// Protection is something a Key protects.
+type Protection string
+
+// Protection values.
+const (
+	// ProtectsSessions means the key signs session cookies or other tokens.
+	// Knowing it allows forging sessions for arbitrary users.
+	ProtectsSessions Protection = "sessions"
+	// ProtectsEncryptedData means the key encrypts stored data such as
+	// database fields, whole databases or backups.
+	ProtectsEncryptedData Protection = "encrypted-data"
+)
+
+// Severity of a leaked Key.
+type Severity string
+
+// Severity values.
+const (
+	SeverityHigh Severity = "HIGH"
+	// SeverityCritical is used for keys that protect both sessions and
+	// encrypted data. Rails and Laravel also deserialize data they decrypt
+	// with their keys, which has repeatedly led to remote code execution.
+	SeverityCritical Severity = "CRITICAL"
+)
+
+// Key is a secret key of a framework or an encryption key or passphrase.
+//
+// The keys are either arbitrary strings or only usable together with the
+// data they protect, so there's no Validator.
+type Key struct {
+	Framework Framework
+	Key       string
+	Protects  []Protection
+	Severity  Severity
+}
+
+// frameworkProtections lists what the keys of each framework protect.
+var frameworkProtections = map[Framework][]Protection{
+	Django:        {ProtectsSessions},
+	Flask:         {ProtectsSessions},
+	Rails:         {ProtectsSessions, ProtectsEncryptedData},
+	Laravel:       {ProtectsSessions, ProtectsEncryptedData},
+	SQLCipher:     {ProtectsEncryptedData},
+	SignalDesktop: {ProtectsEncryptedData},
+	Restic:        {ProtectsEncryptedData},
+	Borg:          {ProtectsEncryptedData},
+}
+
+// newKey returns the Key for a value found for framework f.
+func newKey(f Framework, value string) Key {
+	protects := frameworkProtections[f]
+	severity := SeverityHigh
+	if len(protects) > 1 {
+		severity = SeverityCritical
+	}
+	return Key{Framework: f, Key: value, Protects: protects, Severity: severity}
+}
+
+// rule finds the keys of a framework. The first non-empty capturing group of
+// re is the key.
+type rule struct {
+	framework Framework
+	re        *regexp.Regexp
+}
+
+// envValue matches an unquoted or quoted value in env files and YAML. Template
+// placeholders such as ${VAR} or {{ .Values.x }} aren't matched.
+const envValue = `["']?\s{0,5}[:=]\s{0,5}["']?([^\s"'\{\}<>]{8,256})`
+
+var rules = []rule{
+	// Django's settings.py defines the key at module level, e.g.
+	// SECRET_KEY = 'django-insecure-...'. Other module level SECRET_KEY
+	// assignments in Python are attributed to Django as well.
+	{Django, regexp.MustCompile(`(?m:^SECRET_KEY\s{0,5}=\s{0,5}(?:'([^'\s]{8,256})'|"([^"\s]{8,256})"))`)},
+	{Django, regexp.MustCompile(`DJANGO_SECRET_KEY` + envValue)},
+	// app.secret_key = '...' or app.config['SECRET_KEY'] = '...'.
+	{Flask, regexp.MustCompile(`(?:\.secret_key|\.config\[\s{0,3}['"]SECRET_KEY['"]\s{0,3}\])\s{0,5}=\s{0,5}(?:'([^'\s]{8,256})'|"([^"\s]{8,256})")`)},
+	{Flask, regexp.MustCompile(`FLASK_SECRET_KEY` + envValue)},
+	// secret_key_base is a hex string in secrets.yml, credentials and env files.
+	{Rails, regexp.MustCompile(`(?i:secret_key_base)["']?\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{64,256})\b`)},
+	// APP_KEY=base64:... in .env or 'key' => env('APP_KEY', 'base64:...') in
+	// config/app.php. Laravel 5.0 used 32 character keys without encoding.
+	{Laravel, regexp.MustCompile(`\bAPP_KEY["']?\s{0,5}(?:=>?|:|,)\s{0,5}["']?(base64:[A-Za-z0-9+/]{22,88}={0,2}|[A-Za-z0-9]{32}\b)`)},
+	// PRAGMA key = 'passphrase' or PRAGMA key = "x'<hex>'" for raw keys.
+	{SQLCipher, regexp.MustCompile(`(?i:PRAGMA\s{1,5}key\s{0,5}=\s{0,5})(?:'([^'\n]{1,256})'|"([^"\n]{1,256})")`)},
+	// Signal Desktop keeps the key of its SQLCipher database in a config.json
+	// that only contains the key.
+	{SignalDesktop, regexp.MustCompile(`\{\s{0,10}"key"\s{0,5}:\s{0,5}"([0-9a-f]{64})"\s{0,10}\}`)},
+	{Restic, regexp.MustCompile(`RESTIC_PASSWORD` + envValue)},
+	{Borg, regexp.MustCompile(`BORG_PASSPHRASE` + envValue)},
+}
+
+// detector finds framework secret keys and encryption keys.
+type detector struct{}
+
+// NewDetector returns a Detector that finds framework secret keys and
+// database and backup encryption keys.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum length of a key including its context.
+func (detector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds framework secret keys and encryption keys in data.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, r := range rules {
+		for _, m := range r.re.FindAllSubmatchIndex(data, -1) {
+			value := firstGroup(data, m)
+			if value == "" || isPlaceholder(value) {
+				continue
+			}
+			secrets = append(secrets, newKey(r.framework, value))
+			positions = append(positions, m[0])
+		}
+	}
+	return secrets, positions
+}
+
+// firstGroup returns the text of the first capturing group that matched.
+func firstGroup(data []byte, m []int) string {
+	for i := 2; i+1 < len(m); i += 2 {
+		if m[i] >= 0 && m[i+1] > m[i] {
+			return string(data[m[i]:m[i+1]])
+		}
+	}
+	return ""
+}
+
+// isPlaceholder returns true for values that are variable references or
+// format strings rather than keys, e.g. $PASSPHRASE or PRAGMA key = '%s'.
+func isPlaceholder(value string) bool {
+	return strings.HasPrefix(value, "$") || strings.Contains(value, "${") || strings.Contains(value, "{{") || strings.Contains(value, "%s") || strings.Contains(value, "%(")
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appsecret_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/appsecret"
+)
+
+const (
+	djangoKey  = "django-insecure-k3$x9!q2@w7#e5^r8&t1*y4(u6)i0_o-p=a+s"
+	flaskKey   = "192b9bdd22ab9ed4d12e236c78afcb9a393ec15f71bbf5dc987d54727823bcbf"
+	railsKey   = "9c1a0d4b5e7f2a3c6d8e0f1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f"
+	laravelKey = "base64:2fl+Ktvkfl+Fuz4Qp/A75G2RTiWVA/ZoKZvp6fiiM10="
+	signalKey  = "4f1c0a6b2d8e9f3a5c7b1d0e2f4a6c8b9d1e3f5a7c9b0d2e4f6a8c1b3d5e7f9a"
+)
+
+func key(f appsecret.Framework, value string) appsecret.Key {
+	k := appsecret.Key{Framework: f, Key: value, Severity: appsecret.SeverityHigh}
+	switch f {
+	case appsecret.Django, appsecret.Flask:
+		k.Protects = []appsecret.Protection{appsecret.ProtectsSessions}
+	case appsecret.Rails, appsecret.Laravel:
+		k.Protects = []appsecret.Protection{appsecret.ProtectsSessions, appsecret.ProtectsEncryptedData}
+		k.Severity = appsecret.SeverityCritical
+	default:
+		k.Protects = []appsecret.Protection{appsecret.ProtectsEncryptedData}
+	}
+	return k
+}
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{appsecret.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "django settings.py",
+			input: "DEBUG = True\nSECRET_KEY = '" + djangoKey + "'\nALLOWED_HOSTS = []\n",
+			want:  []veles.Secret{key(appsecret.Django, djangoKey)},
+		},
+		{
+			name:  "django env file",
+			input: "DJANGO_SECRET_KEY=" + djangoKey + "\n",
+			want:  []veles.Secret{key(appsecret.Django, djangoKey)},
+		},
+		{
+			name:  "django key from environment",
+			input: "SECRET_KEY = os.environ['DJANGO_SECRET_KEY']\n",
+			want:  nil,
+		},
+		{
+			name:  "flask app config",
+			input: "app = Flask(__name__)\napp.config['SECRET_KEY'] = \"" + flaskKey + "\"\n",
+			want:  []veles.Secret{key(appsecret.Flask, flaskKey)},
+		},
+		{
+			name:  "flask secret_key attribute",
+			input: "app.secret_key = '" + flaskKey + "'",
+			want:  []veles.Secret{key(appsecret.Flask, flaskKey)},
+		},
+		{
+			name:  "flask env file",
+			input: "FLASK_SECRET_KEY=\"" + flaskKey + "\"",
+			want:  []veles.Secret{key(appsecret.Flask, flaskKey)},
+		},
+		{
+			name:  "rails secrets.yml",
+			input: "production:\n  secret_key_base: " + railsKey + "\n",
+			want:  []veles.Secret{key(appsecret.Rails, railsKey)},
+		},
+		{
+			name:  "rails env file",
+			input: "SECRET_KEY_BASE=" + railsKey,
+			want:  []veles.Secret{key(appsecret.Rails, railsKey)},
+		},
+		{
+			name:  "rails erb reference",
+			input: "secret_key_base: <%= ENV[\"SECRET_KEY_BASE\"] %>",
+			want:  nil,
+		},
+		{
+			name:  "laravel env file",
+			input: "APP_NAME=Laravel\nAPP_KEY=" + laravelKey + "\nAPP_DEBUG=false\n",
+			want:  []veles.Secret{key(appsecret.Laravel, laravelKey)},
+		},
+		{
+			name:  "laravel config default",
+			input: "'key' => env('APP_KEY', '" + laravelKey + "'),",
+			want:  []veles.Secret{key(appsecret.Laravel, laravelKey)},
+		},
+		{
+			name:  "legacy laravel key",
+			input: "APP_KEY=SomeRandomString0123456789abcdef",
+			want:  []veles.Secret{key(appsecret.Laravel, "SomeRandomString0123456789abcdef")},
+		},
+		{
+			name:  "other app key",
+			input: "PUSHER_APP_KEY=SomeRandomString0123456789abcdef",
+			want:  nil,
+		},
+		{
+			name:  "sqlcipher passphrase",
+			input: `db.execute("PRAGMA key = 'correct horse battery staple'")`,
+			want:  []veles.Secret{key(appsecret.SQLCipher, "correct horse battery staple")},
+		},
+		{
+			name:  "sqlcipher raw key",
+			input: `PRAGMA key = "x'2DD29CA851E7B56E4697B0E1F08507293D761A05CE4D1B628663F411A8086D99'";`,
+			want:  []veles.Secret{key(appsecret.SQLCipher, "x'2DD29CA851E7B56E4697B0E1F08507293D761A05CE4D1B628663F411A8086D99'")},
+		},
+		{
+			name:  "sqlcipher format string",
+			input: `query := fmt.Sprintf("PRAGMA key = '%s'", key)`,
+			want:  nil,
+		},
+		{
+			name:  "signal desktop config.json",
+			input: "{\n  \"key\": \"" + signalKey + "\"\n}",
+			want:  []veles.Secret{key(appsecret.SignalDesktop, signalKey)},
+		},
+		{
+			name:  "hex key in larger json object",
+			input: `{"name": "x", "key": "` + signalKey + `"}`,
+			want:  nil,
+		},
+		{
+			name:  "restic env file",
+			input: "export RESTIC_REPOSITORY=s3:s3.amazonaws.com/bucket\nexport RESTIC_PASSWORD=hunter2hunter2\n",
+			want:  []veles.Secret{key(appsecret.Restic, "hunter2hunter2")},
+		},
+		{
+			name:  "restic password file",
+			input: "RESTIC_PASSWORD_FILE=/etc/restic/password",
+			want:  nil,
+		},
+		{
+			name:  "borg passphrase",
+			input: "BORG_PASSPHRASE='correct-horse-battery'",
+			want:  []veles.Secret{key(appsecret.Borg, "correct-horse-battery")},
+		},
+		{
+			name:  "borg passphrase from variable",
+			input: "BORG_PASSPHRASE=${PASSPHRASE}",
+			want:  nil,
+		},
+		{
+			name:  "restic password from unbraced variable",
+			input: "RESTIC_PASSWORD=$RESTIC_PASS_VALUE",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
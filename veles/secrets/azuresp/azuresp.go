@@ -0,0 +1,50 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package azuresp contains Veles Secret types, Detectors and a Validator for
+// the credentials of Azure service principals (app registrations): client
+// secrets and client certificates. They're found in SDK auth files
+// (azureauth.json from "az ad sp create-for-rbac --sdk-auth"), the saved
+// output of "az ad sp create-for-rbac", the accessTokens.json of Azure CLI
+// versions before 2.30, and in env files that set the AZURE_CLIENT_* variables
+// of the Azure SDKs or the ARM_CLIENT_* variables of Terraform.
+//
+// The azureProfile.json of the Azure CLI only names the service principal of
+// a subscription, so the profile itself isn't reported.
+package azuresp
+
+// ClientSecret is a client secret of a service principal. Together with the
+// tenant and client ID it can be exchanged for access tokens with the
+// permissions of the service principal.
+type ClientSecret struct {
+	// The tenant (directory) ID or domain, e.g.
+	// "72f988bf-86f1-41af-91ab-2d7cd011db47" or "contoso.onmicrosoft.com".
+	// Empty if not found next to the secret.
+	TenantID string
+	// The application (client) ID. Empty if not found next to the secret.
+	ClientID string
+	Secret   string
+}
+
+// ClientCertificate is the certificate a service principal authenticates
+// with. The certificate and its private key are stored in a separate file;
+// the path and password of that file are reported.
+type ClientCertificate struct {
+	TenantID string
+	ClientID string
+	// Path of the PEM or PKCS#12 file with the certificate and private key.
+	Path string
+	// Password of the PKCS#12 file, if any.
+	Password string
+}
@@ -0,0 +1,214 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuresp
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxContextLen is the maximum length of a JSON object with service
+// principal credentials and how far apart the variables of a service
+// principal can be in an env file. SDK auth files list a few endpoint URLs
+// next to the credentials.
+const maxContextLen = 4 * veles.KiB
+
+var (
+	// JSON objects with a client ID: SDK auth files, e.g.
+	//
+	//   {"clientId": "...", "clientSecret": "...", "subscriptionId": "...",
+	//    "tenantId": "...", "activeDirectoryEndpointUrl": "...", ...}
+	//
+	// the output of "az ad sp create-for-rbac", e.g.
+	//
+	//   {"appId": "...", "displayName": "...", "password": "...", "tenant": "..."}
+	//
+	// and the service principal entries of accessTokens.json, e.g.
+	//
+	//   {"servicePrincipalId": "...", "servicePrincipalTenant": "...", "accessToken": "..."}
+	credentialsObjectRe = regexp.MustCompile(`\{[^{}]*"(?:clientId|appId|servicePrincipalId)"\s{0,5}:[^{}]*\}`)
+
+	// Variables of the Azure SDKs (AZURE_*) and of Terraform's azurerm
+	// provider (ARM_*) in env files, shell scripts and docker-compose files.
+	envSecretRe = regexp.MustCompile(`(?m)^[ \t]*(?:-[ \t]+)?(?:export[ \t]+)?(AZURE|ARM)_CLIENT_SECRET[ \t]*[=:][ \t]*["']?([A-Za-z0-9_~.\-+/=]{10,256})`)
+	envCertRe   = regexp.MustCompile(`(?m)^[ \t]*(?:-[ \t]+)?(?:export[ \t]+)?(AZURE|ARM)_CLIENT_CERTIFICATE_PATH[ \t]*[=:][ \t]*["']?([^\s"'$]{1,512})`)
+	// The other variables of the same service principal.
+	envOtherRe = regexp.MustCompile(`(?m)^[ \t]*(?:-[ \t]+)?(?:export[ \t]+)?(AZURE|ARM)_(CLIENT_ID|TENANT_ID|CLIENT_CERTIFICATE_PASSWORD)[ \t]*[=:][ \t]*["']?([^\s"'$#]{1,512})`)
+)
+
+// credentialsObject holds the fields of all the JSON formats matched by
+// credentialsObjectRe.
+type credentialsObject struct {
+	// SDK auth files.
+	ClientID                  string `json:"clientId"`
+	ClientSecret              string `json:"clientSecret"`
+	TenantID                  string `json:"tenantId"`
+	ClientCertificate         string `json:"clientCertificate"`
+	ClientCertificatePassword string `json:"clientCertificatePassword"`
+	// "az ad sp create-for-rbac" output. The certificate file is only set
+	// with --create-cert.
+	AppID                     string `json:"appId"`
+	Password                  string `json:"password"`
+	Tenant                    string `json:"tenant"`
+	FileWithCertAndPrivateKey string `json:"fileWithCertAndPrivateKey"`
+	// accessTokens.json, which stored the client secrets of service
+	// principals as their access token.
+	ServicePrincipalID     string `json:"servicePrincipalId"`
+	ServicePrincipalTenant string `json:"servicePrincipalTenant"`
+	AccessToken            string `json:"accessToken"`
+}
+
+// findObjects returns the credentials objects in data and their positions.
+func findObjects(data []byte) ([]credentialsObject, []int) {
+	var objects []credentialsObject
+	var positions []int
+	for _, m := range credentialsObjectRe.FindAllIndex(data, -1) {
+		var o credentialsObject
+		if err := json.Unmarshal(data[m[0]:m[1]], &o); err != nil {
+			continue
+		}
+		objects = append(objects, o)
+		positions = append(positions, m[0])
+	}
+	return objects, positions
+}
+
+// clientSecret returns the client secret of o, if it has one. The appId and
+// password of create-for-rbac output are only used with the tenant, since
+// they're common names in other JSON files.
+func (o credentialsObject) clientSecret() (ClientSecret, bool) {
+	switch {
+	case o.ClientID != "" && o.ClientSecret != "":
+		return ClientSecret{TenantID: o.TenantID, ClientID: o.ClientID, Secret: o.ClientSecret}, true
+	case o.AppID != "" && o.Password != "" && o.Tenant != "":
+		return ClientSecret{TenantID: o.Tenant, ClientID: o.AppID, Secret: o.Password}, true
+	case o.ServicePrincipalID != "" && o.AccessToken != "":
+		return ClientSecret{TenantID: o.ServicePrincipalTenant, ClientID: o.ServicePrincipalID, Secret: o.AccessToken}, true
+	}
+	return ClientSecret{}, false
+}
+
+// clientCertificate returns the client certificate of o, if it has one.
+func (o credentialsObject) clientCertificate() (ClientCertificate, bool) {
+	switch {
+	case o.ClientID != "" && o.ClientCertificate != "":
+		return ClientCertificate{
+			TenantID: o.TenantID,
+			ClientID: o.ClientID,
+			Path:     o.ClientCertificate,
+			Password: o.ClientCertificatePassword,
+		}, true
+	case o.AppID != "" && o.FileWithCertAndPrivateKey != "" && o.Tenant != "":
+		return ClientCertificate{TenantID: o.Tenant, ClientID: o.AppID, Path: o.FileWithCertAndPrivateKey}, true
+	}
+	return ClientCertificate{}, false
+}
+
+// envVariables returns the values of the other variables with the given
+// prefix closest to pos, keyed by their name without the prefix, e.g.
+// "CLIENT_ID".
+func envVariables(data []byte, pos int, prefix string) map[string]string {
+	start := max(0, pos-maxContextLen)
+	window := data[start:min(len(data), pos+maxContextLen)]
+	values := map[string]string{}
+	dists := map[string]int{}
+	for _, m := range envOtherRe.FindAllSubmatchIndex(window, -1) {
+		if string(window[m[2]:m[3]]) != prefix {
+			continue
+		}
+		name := string(window[m[4]:m[5]])
+		dist := start + m[0] - pos
+		if dist < 0 {
+			dist = -dist
+		}
+		if d, ok := dists[name]; ok && d <= dist {
+			continue
+		}
+		values[name] = string(window[m[6]:m[7]])
+		dists[name] = dist
+	}
+	return values
+}
+
+// clientSecretDetector finds service principal client secrets.
+type clientSecretDetector struct{}
+
+// NewClientSecretDetector returns a Detector that finds the client secrets of
+// Azure service principals.
+func NewClientSecretDetector() veles.Detector { return clientSecretDetector{} }
+
+// MaxSecretLen returns the maximum length of a client secret with its
+// context.
+func (clientSecretDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds service principal client secrets in data.
+func (clientSecretDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	objects, objectPositions := findObjects(data)
+	for i, o := range objects {
+		if s, ok := o.clientSecret(); ok {
+			secrets = append(secrets, s)
+			positions = append(positions, objectPositions[i])
+		}
+	}
+	for _, m := range envSecretRe.FindAllSubmatchIndex(data, -1) {
+		vars := envVariables(data, m[0], string(data[m[2]:m[3]]))
+		secrets = append(secrets, ClientSecret{
+			TenantID: vars["TENANT_ID"],
+			ClientID: vars["CLIENT_ID"],
+			Secret:   string(data[m[4]:m[5]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// clientCertificateDetector finds service principal client certificates.
+type clientCertificateDetector struct{}
+
+// NewClientCertificateDetector returns a Detector that finds references to
+// the client certificates of Azure service principals.
+func NewClientCertificateDetector() veles.Detector { return clientCertificateDetector{} }
+
+// MaxSecretLen returns the maximum length of a client certificate reference
+// with its context.
+func (clientCertificateDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds service principal client certificates in data.
+func (clientCertificateDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	objects, objectPositions := findObjects(data)
+	for i, o := range objects {
+		if c, ok := o.clientCertificate(); ok {
+			secrets = append(secrets, c)
+			positions = append(positions, objectPositions[i])
+		}
+	}
+	for _, m := range envCertRe.FindAllSubmatchIndex(data, -1) {
+		vars := envVariables(data, m[0], string(data[m[2]:m[3]]))
+		secrets = append(secrets, ClientCertificate{
+			TenantID: vars["TENANT_ID"],
+			ClientID: vars["CLIENT_ID"],
+			Path:     string(data[m[4]:m[5]]),
+			Password: vars["CLIENT_CERTIFICATE_PASSWORD"],
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
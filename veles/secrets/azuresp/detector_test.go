@@ -0,0 +1,166 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuresp_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/azuresp"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const (
+	tenantID = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	clientID = "3f1b8d2e-6c4a-4e8b-9d1f-2a7c5e9b0d4f"
+	secret   = "Xy8Q~abcdefghijklmnopqrstuvwxyz01234567"
+)
+
+func TestClientSecretDetector(t *testing.T) {
+	want := []veles.Secret{azuresp.ClientSecret{TenantID: tenantID, ClientID: clientID, Secret: secret}}
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name: "SDK auth file",
+			input: `{
+  "clientId": "` + clientID + `",
+  "clientSecret": "` + secret + `",
+  "subscriptionId": "a1b2c3d4-0000-1111-2222-333344445555",
+  "tenantId": "` + tenantID + `",
+  "activeDirectoryEndpointUrl": "https://login.microsoftonline.com",
+  "resourceManagerEndpointUrl": "https://management.azure.com/",
+  "activeDirectoryGraphResourceId": "https://graph.windows.net/",
+  "sqlManagementEndpointUrl": "https://management.core.windows.net:8443/",
+  "galleryEndpointUrl": "https://gallery.azure.com/",
+  "managementEndpointUrl": "https://management.core.windows.net/"
+}`,
+			want: want,
+		},
+		{
+			name:  "create-for-rbac output",
+			input: `{"appId": "` + clientID + `", "displayName": "ci-deploy", "password": "` + secret + `", "tenant": "` + tenantID + `"}`,
+			want:  want,
+		},
+		{
+			name: "legacy accessTokens.json",
+			input: `[{"servicePrincipalId": "` + clientID + `", "servicePrincipalTenant": "` + tenantID + `", "accessToken": "` + secret + `"},
+ {"tokenType": "Bearer", "userId": "user@contoso.com", "accessToken": "eyJ0eXAi", "_clientId": "04b07795-8ddb-461a-bbee-02f9e1bf7b46"}]`,
+			want: want,
+		},
+		{
+			name: "env file",
+			input: `AZURE_TENANT_ID=` + tenantID + `
+AZURE_CLIENT_ID=` + clientID + `
+AZURE_CLIENT_SECRET="` + secret + `"
+`,
+			want: want,
+		},
+		{
+			name: "Terraform variables",
+			input: `export ARM_SUBSCRIPTION_ID=a1b2c3d4-0000-1111-2222-333344445555
+export ARM_CLIENT_ID=` + clientID + `
+export ARM_CLIENT_SECRET='` + secret + `'
+export ARM_TENANT_ID=` + tenantID + `
+`,
+			want: want,
+		},
+		{
+			name: "variables of another prefix",
+			input: `AZURE_CLIENT_ID=` + clientID + `
+ARM_CLIENT_SECRET=` + secret + `
+`,
+			want: []veles.Secret{azuresp.ClientSecret{Secret: secret}},
+		},
+		{
+			name:  "placeholder",
+			input: "AZURE_CLIENT_SECRET=${AZURE_CLIENT_SECRET}\n",
+			want:  nil,
+		},
+		{
+			name:  "appId without tenant",
+			input: `{"appId": "com.example.app", "password": "hunter2hunter2"}`,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, azuresp.NewClientSecretDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClientCertificateDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "SDK auth file",
+			input: `{"clientId": "` + clientID + `", "clientCertificate": "/etc/azure/sp.pfx", "clientCertificatePassword": "pfx-password", "tenantId": "` + tenantID + `"}`,
+			want: []veles.Secret{azuresp.ClientCertificate{
+				TenantID: tenantID,
+				ClientID: clientID,
+				Path:     "/etc/azure/sp.pfx",
+				Password: "pfx-password",
+			}},
+		},
+		{
+			name:  "create-for-rbac output",
+			input: `{"appId": "` + clientID + `", "displayName": "ci-deploy", "fileWithCertAndPrivateKey": "/home/user/tmpz2lg0c2w.pem", "password": null, "tenant": "` + tenantID + `"}`,
+			want: []veles.Secret{azuresp.ClientCertificate{
+				TenantID: tenantID,
+				ClientID: clientID,
+				Path:     "/home/user/tmpz2lg0c2w.pem",
+			}},
+		},
+		{
+			name: "env file",
+			input: `- AZURE_CLIENT_ID=` + clientID + `
+- AZURE_TENANT_ID=` + tenantID + `
+- AZURE_CLIENT_CERTIFICATE_PATH=/run/secrets/sp.pem
+- AZURE_CLIENT_CERTIFICATE_PASSWORD=pem-password
+`,
+			want: []veles.Secret{azuresp.ClientCertificate{
+				TenantID: tenantID,
+				ClientID: clientID,
+				Path:     "/run/secrets/sp.pem",
+				Password: "pem-password",
+			}},
+		},
+		{
+			name:  "client secret",
+			input: `{"clientId": "` + clientID + `", "clientSecret": "` + secret + `", "tenantId": "` + tenantID + `"}`,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, azuresp.NewClientCertificateDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuresp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// DefaultEndpoint is the Microsoft identity platform of the Azure public
+	// cloud. The authority of SDK auth files isn't used as it comes from
+	// untrusted scanned data.
+	DefaultEndpoint = "https://login.microsoftonline.com"
+	// validationScope is requested in the token request. Every service
+	// principal can request a token for Azure Resource Manager, even
+	// without role assignments.
+	validationScope = "https://management.azure.com/.default"
+
+	// defaultTimeout is used if no HTTP client is configured.
+	defaultTimeout = 10 * time.Second
+	// maxResponseBytes is the maximum number of bytes read from a response.
+	maxResponseBytes = 64 * 1024
+)
+
+// Error codes of the Microsoft identity platform that mean the credentials
+// are invalid, see
+// https://learn.microsoft.com/en-us/entra/identity-platform/reference-error-codes.
+var invalidErrorCodes = []int{
+	7000215, // Invalid client secret.
+	7000222, // Expired client secret.
+	700016,  // Application not found in the tenant.
+	90002,   // Tenant not found.
+}
+
+// tokenError is the body of error responses of the token endpoint.
+type tokenError struct {
+	Error      string `json:"error"`
+	ErrorCodes []int  `json:"error_codes"`
+}
+
+// clientSecretValidator requests a token with the client credentials grant.
+type clientSecretValidator struct {
+	endpoint string
+	httpc    *http.Client
+}
+
+// NewClientSecretValidator returns a Validator for ClientSecrets that
+// requests an OAuth2 token with the client credentials grant from the
+// Microsoft identity platform at endpoint using the given client (nil for a
+// default client).
+func NewClientSecretValidator(endpoint string, client *http.Client) veles.Validator[ClientSecret] {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &clientSecretValidator{endpoint: endpoint, httpc: client}
+}
+
+// Validate checks whether s can be exchanged for an access token. Secrets
+// found without their tenant or client ID can't be validated.
+func (v *clientSecretValidator) Validate(ctx context.Context, s ClientSecret) (veles.ValidationStatus, error) {
+	if s.TenantID == "" || s.ClientID == "" {
+		return veles.ValidationFailed, errors.New("tenant or client ID unknown")
+	}
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {s.ClientID},
+		"client_secret": {s.Secret},
+		"scope":         {validationScope},
+	}
+	endpoint := fmt.Sprintf("%s/%s/oauth2/v2.0/token", strings.TrimSuffix(v.endpoint, "/"), url.PathEscape(s.TenantID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("http.NewRequestWithContext(POST): %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err := v.httpc.Do(req)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("HTTP POST failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return veles.ValidationValid, nil
+	}
+	var e tokenError
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseBytes)).Decode(&e); err == nil {
+		for _, code := range e.ErrorCodes {
+			if slices.Contains(invalidErrorCodes, code) {
+				return veles.ValidationInvalid, nil
+			}
+		}
+	}
+	return veles.ValidationFailed, fmt.Errorf("unexpected HTTP status %d with error %q and codes %v", res.StatusCode, e.Error, e.ErrorCodes)
+}
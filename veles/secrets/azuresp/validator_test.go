@@ -0,0 +1,110 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azuresp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/azuresp"
+)
+
+// newServer returns a token endpoint that issues tokens for the client
+// credentials of clientID in tenantID and otherwise responds with status and
+// body.
+func newServer(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/"+tenantID+"/oauth2/v2.0/token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("grant_type") == "client_credentials" && r.PostForm.Get("client_id") == clientID &&
+			r.PostForm.Get("client_secret") == secret {
+			w.Write([]byte(`{"token_type": "Bearer", "expires_in": 3599, "access_token": "eyJ0eXAi"}`))
+			return
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestClientSecretValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  azuresp.ClientSecret
+		status  int
+		body    string
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			secret: azuresp.ClientSecret{TenantID: tenantID, ClientID: clientID, Secret: secret},
+			want:   veles.ValidationValid,
+		},
+		{
+			name:   "invalid secret",
+			secret: azuresp.ClientSecret{TenantID: tenantID, ClientID: clientID, Secret: "wrong"},
+			status: http.StatusUnauthorized,
+			body:   `{"error": "invalid_client", "error_codes": [7000215]}`,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:   "unknown application",
+			secret: azuresp.ClientSecret{TenantID: tenantID, ClientID: "00000000-0000-0000-0000-000000000000", Secret: secret},
+			status: http.StatusBadRequest,
+			body:   `{"error": "unauthorized_client", "error_codes": [700016]}`,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "throttled",
+			secret:  azuresp.ClientSecret{TenantID: tenantID, ClientID: clientID, Secret: "wrong"},
+			status:  http.StatusTooManyRequests,
+			body:    `{"error": "temporarily_unavailable", "error_codes": [50196]}`,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+		{
+			name:    "unknown client ID",
+			secret:  azuresp.ClientSecret{TenantID: tenantID, Secret: secret},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newServer(t, tt.status, tt.body)
+			v := azuresp.NewClientSecretValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bugsnag contains a Veles Secret type and Detector for Bugsnag
+// project API keys.
+package bugsnag
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+// maxContextLen bounds the key name, separator and API key matched by the
+// detection regex.
+const maxContextLen = 128
+
+// Bugsnag API keys are 32 hex characters without a distinctive prefix, so
+// they're only reported when assigned to a Bugsnag-related key, e.g.
+// BUGSNAG_API_KEY=... or "bugsnag": {"apiKey": "..."} style configs.
+var keyRe = regexp.MustCompile(`(?i:bugsnag[a-z0-9_.\-]{0,40})["']?\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{32})\b`)
+
+// APIKey is a Bugsnag project notifier API key. It allows sending events to
+// the project; Bugsnag offers no endpoint to validate it without creating
+// data, so there's no Validator.
+type APIKey struct {
+	Key string
+}
+
+// NewDetector returns a Detector that finds Bugsnag API keys.
+func NewDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxContextLen,
+		Re:     keyRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return APIKey{Key: string(b)}
+		},
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bugsnag_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/bugsnag"
+)
+
+const key = "fedcba9876543210fedcba9876543210"
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{bugsnag.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "env variable",
+			input: "BUGSNAG_API_KEY=" + key + "\n",
+			want:  []veles.Secret{bugsnag.APIKey{Key: key}},
+		},
+		{
+			name:  "yaml config",
+			input: "bugsnag_api_key: '" + key + "'\n",
+			want:  []veles.Secret{bugsnag.APIKey{Key: key}},
+		},
+		{
+			name:  "uppercase hex is not a key",
+			input: "BUGSNAG_API_KEY=" + strings.ToUpper(key),
+			want:  nil,
+		},
+		{
+			name:  "hex string without bugsnag context",
+			input: "checksum=" + key,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cakey contains a Veles Secret type and Detector for private keys of
+// certificate authorities. A key is only reported if a CA certificate for it
+// is stored nearby, e.g. in the same PEM bundle or Kubernetes Secret, which
+// distinguishes it from the keys of ordinary TLS certificates.
+package cakey
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxContextLen is how far from a key a matching CA certificate is searched.
+// It also bounds the length of keys so that a key and the certificates next
+// to it usually end up in the same chunk of the DetectionEngine.
+const maxContextLen = 16 * veles.KiB
+
+var (
+	// PEM blocks of private keys and certificates. Encrypted keys have headers,
+	// which aren't matched as they can't be compared to a certificate anyway.
+	pemRe = regexp.MustCompile(`-----BEGIN ((?:RSA |EC )?PRIVATE KEY|CERTIFICATE)-----([A-Za-z0-9+/=\s]+)-----END ((?:RSA |EC )?PRIVATE KEY|CERTIFICATE)-----`)
+	// Base64 encoded PEM, e.g. in Kubernetes Secrets and kubeconfig files. The
+	// prefix is the encoding of "-----BEGIN".
+	base64PEMRe = regexp.MustCompile(`LS0tLS1CRUdJTi[A-Za-z0-9+/]+={0,2}`)
+)
+
+// Key is the private key of a CA. Anyone holding it can issue certificates
+// that are trusted wherever the CA is, and every certificate the CA has ever
+// signed is compromised, so leaks should be treated as critical.
+type Key struct {
+	// The PEM encoded private key. Keys found base64 encoded are decoded.
+	PEM string
+	// Subject of the CA certificate of the key, e.g. "CN=Example Root CA".
+	Subject string
+	// Root is true for self-signed root CAs and false for intermediate CAs.
+	Root bool
+}
+
+// block is a PEM block and its location in the input. For blocks found in
+// base64 encoded PEM, the location is that of the encoded data.
+type block struct {
+	pem        *pem.Block
+	start, end int
+}
+
+// detector finds CA keys together with their certificates.
+type detector struct{}
+
+// NewDetector returns a Detector that finds private keys of CAs.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum length of a key including the context
+// searched for its certificate.
+func (detector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds private keys in data that belong to a CA certificate within
+// maxContextLen bytes of them.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	var keys []block
+	var certs []*x509.Certificate
+	var certBlocks []block
+	for _, b := range findBlocks(data) {
+		if b.pem.Type != "CERTIFICATE" {
+			keys = append(keys, b)
+			continue
+		}
+		cert, err := x509.ParseCertificate(b.pem.Bytes)
+		if err != nil || !cert.BasicConstraintsValid || !cert.IsCA {
+			continue
+		}
+		certs = append(certs, cert)
+		certBlocks = append(certBlocks, b)
+	}
+	if len(certs) == 0 {
+		return nil, nil
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, k := range keys {
+		pub := publicKey(k.pem)
+		if pub == nil {
+			continue
+		}
+		for i, cert := range certs {
+			if certBlocks[i].start < k.start-maxContextLen || certBlocks[i].end > k.start+maxContextLen {
+				continue
+			}
+			if !pub.Equal(cert.PublicKey) {
+				continue
+			}
+			secrets = append(secrets, Key{
+				PEM:     string(pem.EncodeToMemory(k.pem)),
+				Subject: cert.Subject.String(),
+				Root:    isSelfSigned(cert),
+			})
+			positions = append(positions, k.start)
+			break
+		}
+	}
+	return secrets, positions
+}
+
+// findBlocks returns the PEM encoded keys and certificates in data, both
+// plain and base64 encoded.
+func findBlocks(data []byte) []block {
+	var blocks []block
+	for _, m := range pemRe.FindAllSubmatchIndex(data, -1) {
+		typ := string(data[m[2]:m[3]])
+		if typ != string(data[m[6]:m[7]]) {
+			continue
+		}
+		// Indentation, e.g. from YAML block scalars, is ignored.
+		der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(data[m[4]:m[5]])), ""))
+		if err != nil {
+			continue
+		}
+		blocks = append(blocks, block{pem: &pem.Block{Type: typ, Bytes: der}, start: m[0], end: m[1]})
+	}
+	for _, m := range base64PEMRe.FindAllIndex(data, -1) {
+		decoded, err := base64.StdEncoding.DecodeString(string(data[m[0]:m[1]]))
+		if err != nil {
+			continue
+		}
+		for _, inner := range findBlocks(decoded) {
+			inner.start, inner.end = m[0], m[1]
+			blocks = append(blocks, inner)
+		}
+	}
+	return blocks
+}
+
+// publicKey returns the public key of a PEM encoded private key or nil if it
+// can't be parsed.
+func publicKey(b *pem.Block) interface{ Equal(crypto.PublicKey) bool } {
+	var key any
+	var err error
+	switch b.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(b.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(b.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(b.Bytes)
+	}
+	if err != nil {
+		return nil
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil
+	}
+	pub, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return nil
+	}
+	return pub
+}
+
+// isSelfSigned returns true if cert is a root certificate that's signed by
+// its own key.
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawSubject, cert.RawIssuer) && cert.CheckSignatureFrom(cert) == nil
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cakey_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/cakey"
+)
+
+// testCert is a certificate with its PEM encoded certificate and key.
+type testCert struct {
+	cert    *x509.Certificate
+	signer  crypto.Signer
+	certPEM string
+	keyPEM  string
+}
+
+func newCert(t *testing.T, cn string, isCA bool, signer crypto.Signer, keyPEM string, parent *testCert) *testCert {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	parentCert, parentSigner := tmpl, signer
+	if parent != nil {
+		parentCert, parentSigner = parent.cert, parent.signer
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parentCert, signer.Public(), parentSigner)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate(): %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate(): %v", err)
+	}
+	return &testCert{
+		cert:    cert,
+		signer:  signer,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  keyPEM,
+	}
+}
+
+func ecKey(t *testing.T) (crypto.Signer, string) {
+	t.Helper()
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey(): %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(k)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKCS8PrivateKey(): %v", err)
+	}
+	return k, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func rsaKey(t *testing.T) (crypto.Signer, string) {
+	t.Helper()
+	k, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey(): %v", err)
+	}
+	return k, string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}))
+}
+
+func ed25519Key(t *testing.T) (crypto.Signer, string) {
+	t.Helper()
+	_, k, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey(): %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(k)
+	if err != nil {
+		t.Fatalf("x509.MarshalPKCS8PrivateKey(): %v", err)
+	}
+	return k, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func indent(s, prefix string) string {
+	return prefix + strings.ReplaceAll(strings.TrimSuffix(s, "\n"), "\n", "\n"+prefix)
+}
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{cakey.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	rootSigner, rootKey := ecKey(t)
+	root := newCert(t, "Example Root CA", true, rootSigner, rootKey, nil)
+	interSigner, interKey := rsaKey(t)
+	inter := newCert(t, "Example Issuing CA", true, interSigner, interKey, root)
+	edSigner, edKey := ed25519Key(t)
+	edRoot := newCert(t, "Example Ed25519 CA", true, edSigner, edKey, nil)
+	leafSigner, leafKey := ecKey(t)
+	leaf := newCert(t, "www.example.com", false, leafSigner, leafKey, inter)
+	_, otherKey := ecKey(t)
+
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "root CA bundle",
+			input: root.certPEM + root.keyPEM,
+			want:  []veles.Secret{cakey.Key{PEM: root.keyPEM, Subject: "CN=Example Root CA", Root: true}},
+		},
+		{
+			name:  "intermediate CA with chain",
+			input: inter.keyPEM + "\n" + inter.certPEM + root.certPEM,
+			want:  []veles.Secret{cakey.Key{PEM: inter.keyPEM, Subject: "CN=Example Issuing CA", Root: false}},
+		},
+		{
+			name:  "ed25519 CA",
+			input: edRoot.certPEM + edRoot.keyPEM,
+			want:  []veles.Secret{cakey.Key{PEM: edRoot.keyPEM, Subject: "CN=Example Ed25519 CA", Root: true}},
+		},
+		{
+			name:  "YAML block scalars",
+			input: "ca:\n  cert: |\n" + indent(root.certPEM, "    ") + "\n  key: |\n" + indent(root.keyPEM, "    ") + "\n",
+			want:  []veles.Secret{cakey.Key{PEM: root.keyPEM, Subject: "CN=Example Root CA", Root: true}},
+		},
+		{
+			name: "Kubernetes Secret",
+			input: "apiVersion: v1\nkind: Secret\ntype: kubernetes.io/tls\ndata:\n" +
+				"  ca.crt: " + b64(root.certPEM) + "\n" +
+				"  tls.crt: " + b64(inter.certPEM) + "\n" +
+				"  tls.key: " + b64(inter.keyPEM) + "\n",
+			want: []veles.Secret{cakey.Key{PEM: inter.keyPEM, Subject: "CN=Example Issuing CA", Root: false}},
+		},
+		{
+			name:  "TLS server key",
+			input: leaf.certPEM + leaf.keyPEM + inter.certPEM,
+			want:  nil,
+		},
+		{
+			name:  "key without certificate",
+			input: root.keyPEM,
+			want:  nil,
+		},
+		{
+			name:  "key that doesn't match the CA certificate",
+			input: root.certPEM + otherKey,
+			want:  nil,
+		},
+		{
+			name:  "certificate too far from the key",
+			input: root.certPEM + strings.Repeat("x", 20*1024) + root.keyPEM,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
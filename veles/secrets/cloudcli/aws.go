@@ -0,0 +1,125 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcli
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxCacheEntryLen is the maximum length of a cached AWS credentials or
+// token file. Session tokens alone can be over 1 KiB long.
+const maxCacheEntryLen = 8 * veles.KiB
+
+var (
+	// The AWS CLI caches the response of sts:AssumeRole and
+	// sso:GetRoleCredentials, e.g.
+	//
+	//   {"Credentials": {"AccessKeyId": "ASIA...", "SecretAccessKey": "...",
+	//    "SessionToken": "...", "Expiration": "2024-05-01T12:00:00Z"},
+	//    "AssumedRoleUser": {"AssumedRoleId": "AROA...:session",
+	//    "Arn": "arn:aws:sts::123456789012:assumed-role/Admin/session"}, ...}
+	awsCredentialsRe = regexp.MustCompile(`"Credentials"\s{0,5}:\s{0,5}(\{[^{}]*\})`)
+	awsAccessKeyIDRe = regexp.MustCompile(`^(?:ASIA|AKIA)[A-Z0-9]{16}$`)
+	awsARNRe         = regexp.MustCompile(`"Arn"\s{0,5}:\s{0,5}"(arn:aws[a-z\-]*:(?:sts|iam)::[0-9]{12}:[^"\s]{1,512})"`)
+
+	// "aws sso login" caches the access token together with the start URL
+	// of the access portal. The client registrations in the same directory
+	// don't have a start URL.
+	awsSSOTokenRe = regexp.MustCompile(`\{[^{}]*"startUrl"\s{0,5}:[^{}]*\}`)
+)
+
+// awsCredentialsDetector finds credentials cached by the AWS CLI.
+type awsCredentialsDetector struct{}
+
+// NewAWSCachedCredentialsDetector returns a Detector that finds role
+// credentials cached in ~/.aws/cli/cache.
+func NewAWSCachedCredentialsDetector() veles.Detector { return awsCredentialsDetector{} }
+
+// MaxSecretLen returns the maximum length of a cached credentials file.
+func (awsCredentialsDetector) MaxSecretLen() uint32 { return maxCacheEntryLen }
+
+// Detect finds cached AWS credentials in data.
+func (awsCredentialsDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range awsCredentialsRe.FindAllSubmatchIndex(data, -1) {
+		var c struct {
+			AccessKeyID     string `json:"AccessKeyId"`
+			SecretAccessKey string `json:"SecretAccessKey"`
+			SessionToken    string `json:"SessionToken"`
+			Expiration      string `json:"Expiration"`
+		}
+		if err := json.Unmarshal(data[m[2]:m[3]], &c); err != nil {
+			continue
+		}
+		if !awsAccessKeyIDRe.MatchString(c.AccessKeyID) || c.SecretAccessKey == "" {
+			continue
+		}
+		s := AWSCachedCredentials{
+			AccessKeyID:     c.AccessKeyID,
+			SecretAccessKey: c.SecretAccessKey,
+			SessionToken:    c.SessionToken,
+			Expiry:          parseTime(c.Expiration),
+		}
+		// The assumed role user is listed before or after the credentials.
+		window := data[max(0, m[0]-maxCacheEntryLen):min(len(data), m[1]+maxCacheEntryLen)]
+		if a := awsARNRe.FindSubmatch(window); a != nil {
+			s.Principal = string(a[1])
+		}
+		secrets = append(secrets, s)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// awsSSOTokenDetector finds SSO access tokens cached by the AWS CLI.
+type awsSSOTokenDetector struct{}
+
+// NewAWSSSOTokenDetector returns a Detector that finds IAM Identity Center
+// access tokens cached in ~/.aws/sso/cache.
+func NewAWSSSOTokenDetector() veles.Detector { return awsSSOTokenDetector{} }
+
+// MaxSecretLen returns the maximum length of a cached token file.
+func (awsSSOTokenDetector) MaxSecretLen() uint32 { return maxCacheEntryLen }
+
+// Detect finds cached AWS SSO tokens in data.
+func (awsSSOTokenDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range awsSSOTokenRe.FindAllIndex(data, -1) {
+		var t struct {
+			StartURL     string `json:"startUrl"`
+			Region       string `json:"region"`
+			AccessToken  string `json:"accessToken"`
+			RefreshToken string `json:"refreshToken"`
+			ExpiresAt    string `json:"expiresAt"`
+		}
+		if err := json.Unmarshal(data[m[0]:m[1]], &t); err != nil || t.AccessToken == "" {
+			continue
+		}
+		secrets = append(secrets, AWSSSOToken{
+			StartURL:     t.StartURL,
+			Region:       t.Region,
+			AccessToken:  t.AccessToken,
+			RefreshToken: t.RefreshToken,
+			Expiry:       parseTime(t.ExpiresAt),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
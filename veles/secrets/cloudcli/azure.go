@@ -0,0 +1,144 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcli
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxMSALCacheLen is the maximum length of an MSAL token cache searched for
+// the accounts of the cached tokens. Caches with a few accounts and many
+// access tokens are a few dozen KiB large.
+const maxMSALCacheLen = 64 * veles.KiB
+
+var (
+	// MSAL caches tokens as flat JSON objects keyed by their cache key, e.g.
+	//
+	//   "AccessToken": {"<key>": {"credential_type": "AccessToken",
+	//    "secret": "eyJ...", "home_account_id": "<oid>.<tid>",
+	//    "client_id": "04b07795-...", "realm": "<tid>", "target": "...",
+	//    "expires_on": "1714564800", ...}}
+	msalTokenRe = regexp.MustCompile(`\{[^{}]*"credential_type"\s{0,5}:\s{0,5}"(?:AccessToken|RefreshToken)"[^{}]*\}`)
+	// The accounts of the cache, which map home account IDs to usernames.
+	msalAccountRe = regexp.MustCompile(`\{[^{}]*"authority_type"\s{0,5}:[^{}]*\}`)
+
+	// Service principal entries, e.g.
+	//
+	//   [{"client_id": "...", "tenant": "...", "client_secret": "..."}]
+	//
+	// Entries of service principals logged in with a certificate have a
+	// "client_certificate" path instead of a secret.
+	azureSPEntryRe = regexp.MustCompile(`\{[^{}]*"client_secret"\s{0,5}:[^{}]*\}`)
+)
+
+// msalTokenDetector finds tokens in the MSAL token cache of the Azure CLI.
+type msalTokenDetector struct{}
+
+// NewAzureMSALTokenDetector returns a Detector that finds access and refresh
+// tokens in the MSAL token cache of the Azure CLI.
+func NewAzureMSALTokenDetector() veles.Detector { return msalTokenDetector{} }
+
+// MaxSecretLen returns the maximum length of a token cache.
+func (msalTokenDetector) MaxSecretLen() uint32 { return maxMSALCacheLen }
+
+// Detect finds cached Azure CLI tokens in data.
+func (msalTokenDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	matches := msalTokenRe.FindAllIndex(data, -1)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	usernames := map[string]string{}
+	for _, m := range msalAccountRe.FindAllIndex(data, -1) {
+		var a struct {
+			HomeAccountID string `json:"home_account_id"`
+			Username      string `json:"username"`
+		}
+		if err := json.Unmarshal(data[m[0]:m[1]], &a); err == nil && a.HomeAccountID != "" {
+			usernames[a.HomeAccountID] = a.Username
+		}
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range matches {
+		var t struct {
+			CredentialType string `json:"credential_type"`
+			Secret         string `json:"secret"`
+			HomeAccountID  string `json:"home_account_id"`
+			ClientID       string `json:"client_id"`
+			Realm          string `json:"realm"`
+			Target         string `json:"target"`
+			// Written as a string by MSAL for Python and as a number by
+			// some other MSAL libraries.
+			ExpiresOn json.Number `json:"expires_on"`
+		}
+		if err := json.Unmarshal(data[m[0]:m[1]], &t); err != nil || t.Secret == "" {
+			continue
+		}
+		secrets = append(secrets, AzureMSALToken{
+			CredentialType: t.CredentialType,
+			Secret:         t.Secret,
+			Username:       usernames[t.HomeAccountID],
+			HomeAccountID:  t.HomeAccountID,
+			ClientID:       t.ClientID,
+			Tenant:         t.Realm,
+			Target:         t.Target,
+			Expiry:         parseUnix(t.ExpiresOn.String()),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// azureSPDetector finds service principal secrets of the Azure CLI.
+type azureSPDetector struct{}
+
+// NewAzureServicePrincipalDetector returns a Detector that finds the client
+// secrets in the service principal entries of the Azure CLI.
+func NewAzureServicePrincipalDetector() veles.Detector { return azureSPDetector{} }
+
+// MaxSecretLen returns the maximum length of a service principal entry.
+func (azureSPDetector) MaxSecretLen() uint32 { return maxCacheEntryLen }
+
+// Detect finds Azure CLI service principal secrets in data.
+func (azureSPDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range azureSPEntryRe.FindAllIndex(data, -1) {
+		var e struct {
+			ClientID     string  `json:"client_id"`
+			Tenant       *string `json:"tenant"`
+			ClientSecret string  `json:"client_secret"`
+		}
+		// The tenant tells the entries apart from other OAuth client
+		// configs.
+		if err := json.Unmarshal(data[m[0]:m[1]], &e); err != nil || e.Tenant == nil {
+			continue
+		}
+		if e.ClientID == "" || e.ClientSecret == "" {
+			continue
+		}
+		secrets = append(secrets, AzureServicePrincipalSecret{
+			ClientID: e.ClientID,
+			Tenant:   *e.Tenant,
+			Secret:   e.ClientSecret,
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
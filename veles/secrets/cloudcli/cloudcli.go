@@ -0,0 +1,141 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cloudcli contains Veles Secret types and Detectors for credentials
+// that cloud provider CLIs cache in home directories: the ~/.aws/cli/cache
+// and ~/.aws/sso/cache of the AWS CLI, the MSAL token cache and service
+// principal entries of the Azure CLI in ~/.azure, doctl's config in
+// ~/.config/doctl and OCI CLI session tokens in ~/.oci/sessions.
+//
+// Most of them are short-lived, but home directories in container images and
+// backups are full of them and refresh tokens and long-lived tokens are
+// cached next to them. The Secrets carry the principal and, where the cache
+// records one, the expiry so that expired credentials can be told apart.
+package cloudcli
+
+import (
+	"strconv"
+	"time"
+)
+
+// AWSCachedCredentials are the temporary credentials the AWS CLI caches in
+// ~/.aws/cli/cache after assuming a role or fetching SSO role credentials.
+type AWSCachedCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// The ARN of the assumed role session, e.g.
+	// "arn:aws:sts::123456789012:assumed-role/Admin/session". Empty for SSO
+	// role credentials.
+	Principal string
+	// When the credentials expire, zero if unknown.
+	Expiry time.Time
+}
+
+// AWSSSOToken is an IAM Identity Center (SSO) access token the AWS CLI caches
+// in ~/.aws/sso/cache after "aws sso login". It can be exchanged for the
+// credentials of all roles the user has access to.
+type AWSSSOToken struct {
+	// The URL of the AWS access portal, e.g.
+	// "https://my-sso-portal.awsapps.com/start".
+	StartURL    string
+	Region      string
+	AccessToken string
+	// Only cached for sso-session profiles, which refresh the access token.
+	RefreshToken string
+	Expiry       time.Time
+}
+
+// Azure MSAL credential types.
+const (
+	CredentialTypeAccessToken  = "AccessToken"
+	CredentialTypeRefreshToken = "RefreshToken"
+)
+
+// AzureMSALToken is an access or refresh token from the MSAL token cache of
+// the Azure CLI, ~/.azure/msal_token_cache.json. Refresh tokens are valid
+// for up to 90 days and are renewed on use.
+type AzureMSALToken struct {
+	// CredentialTypeAccessToken or CredentialTypeRefreshToken.
+	CredentialType string
+	Secret         string
+	// The user principal name of the account, e.g. "user@contoso.com", if
+	// the account is in the cache.
+	Username      string
+	HomeAccountID string
+	ClientID      string
+	// The tenant ID. Only set for access tokens.
+	Tenant string
+	// The scopes of the token, e.g.
+	// "https://management.core.windows.net//user_impersonation".
+	Target string
+	// When the token expires, zero for refresh tokens.
+	Expiry time.Time
+}
+
+// AzureServicePrincipalSecret is the client secret of a service principal
+// logged in with "az login --service-principal", which the Azure CLI keeps
+// in ~/.azure/service_principal_entries.json. It doesn't expire until the
+// secret is rotated.
+type AzureServicePrincipalSecret struct {
+	ClientID string
+	Tenant   string
+	Secret   string
+}
+
+// DoctlToken is a DigitalOcean API token from doctl's config.yaml.
+type DoctlToken struct {
+	// The auth context of the token, "default" for the top-level
+	// access-token.
+	Context string
+	Token   string
+}
+
+// OCISessionToken is an OCI CLI session token from
+// ~/.oci/sessions/<profile>/token, created by "oci session authenticate".
+// Session tokens are valid for an hour and can be refreshed for up to 24
+// hours.
+type OCISessionToken struct {
+	Token string
+	// The OCID of the user, e.g. "ocid1.user.oc1..aaaa".
+	Principal string
+	// The OCID of the tenancy.
+	Tenancy string
+	Expiry  time.Time
+}
+
+// timeLayouts are the formats of the expiry times in the caches. AWS SSO
+// role credentials use "UTC" instead of a zone offset.
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05UTC"}
+
+// parseTime parses a cached expiry time, returning the zero time if it's
+// missing or malformed.
+func parseTime(s string) time.Time {
+	for _, l := range timeLayouts {
+		if t, err := time.Parse(l, s); err == nil {
+			return t.UTC()
+		}
+	}
+	return time.Time{}
+}
+
+// parseUnix parses a Unix timestamp in seconds, returning the zero time if
+// it's missing or malformed.
+func parseUnix(s string) time.Time {
+	sec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || sec <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0).UTC()
+}
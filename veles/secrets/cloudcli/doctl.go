@@ -0,0 +1,94 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcli
+
+import (
+	"bytes"
+	"regexp"
+	"slices"
+
+	"github.com/google/osv-scalibr/veles"
+	"gopkg.in/yaml.v3"
+)
+
+// maxDoctlConfigLen is the maximum size of a doctl config file.
+const maxDoctlConfigLen = 16 * veles.KiB
+
+var (
+	// doctl's config.yaml has the token of the default context at the top
+	// level and those of named contexts in auth-contexts:
+	//
+	//   access-token: dop_v1_...
+	//   auth-contexts:
+	//     staging: dop_v1_...
+	//   context: default
+	doctlKeyRe = regexp.MustCompile(`(?m)^(?:access-token|auth-contexts):`)
+	// Personal access tokens, which were 64 hex characters before the
+	// dop_v1_ prefix was introduced, and OAuth tokens from "doctl auth init"
+	// with a browser login.
+	doctlTokenRe = regexp.MustCompile(`^(?:do[op]_v1_)?[a-f0-9]{64}$`)
+)
+
+// doctlDetector finds DigitalOcean API tokens in doctl configs.
+type doctlDetector struct{}
+
+// NewDoctlDetector returns a Detector that finds the DigitalOcean API tokens
+// of the auth contexts in doctl's config.yaml.
+func NewDoctlDetector() veles.Detector { return doctlDetector{} }
+
+// MaxSecretLen returns the maximum size of a doctl config.
+func (doctlDetector) MaxSecretLen() uint32 { return maxDoctlConfigLen }
+
+// Detect finds doctl tokens in data.
+func (doctlDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	if !doctlKeyRe.Match(data) {
+		return nil, nil
+	}
+	var cfg struct {
+		AccessToken  string            `yaml:"access-token"`
+		AuthContexts map[string]string `yaml:"auth-contexts"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, nil
+	}
+	tokens := map[string]string{}
+	for name, token := range cfg.AuthContexts {
+		tokens[name] = token
+	}
+	if cfg.AccessToken != "" {
+		tokens["default"] = cfg.AccessToken
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	names := make([]string, 0, len(tokens))
+	for name := range tokens {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		token := tokens[name]
+		if !doctlTokenRe.MatchString(token) {
+			continue
+		}
+		pos := bytes.Index(data, []byte(token))
+		if pos < 0 {
+			pos = 0
+		}
+		secrets = append(secrets, DoctlToken{Context: name, Token: token})
+		positions = append(positions, pos)
+	}
+	return secrets, positions
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudcli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+// maxOCITokenLen is an upper bound for OCI session tokens, which embed the
+// public key of the session in their claims.
+const maxOCITokenLen = 8 * veles.KiB
+
+var ociTokenRe = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]{10,500}\.eyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}`)
+
+// ociClaims are the claims of an OCI session token that identify it.
+type ociClaims struct {
+	Subject string `json:"sub"`
+	Tenant  string `json:"tenant"`
+	Expiry  int64  `json:"exp"`
+}
+
+// NewOCISessionTokenDetector returns a Detector that finds OCI CLI session
+// tokens.
+func NewOCISessionTokenDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxOCITokenLen,
+		Re:     ociTokenRe,
+		FromMatch: func(b []byte) veles.Secret {
+			token := string(b)
+			payload := strings.Split(token, ".")[1]
+			decoded, err := base64.RawURLEncoding.DecodeString(payload)
+			if err != nil {
+				return nil
+			}
+			var c ociClaims
+			if err := json.Unmarshal(decoded, &c); err != nil {
+				return nil
+			}
+			// Session tokens are issued for a user in a tenancy, both
+			// identified by their OCIDs.
+			if !strings.HasPrefix(c.Subject, "ocid1.") || !strings.HasPrefix(c.Tenant, "ocid1.tenancy.") {
+				return nil
+			}
+			s := OCISessionToken{Token: token, Principal: c.Subject, Tenancy: c.Tenant}
+			if c.Expiry > 0 {
+				s.Expiry = time.Unix(c.Expiry, 0).UTC()
+			}
+			return s
+		},
+	}
+}
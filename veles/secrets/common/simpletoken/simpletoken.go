@@ -0,0 +1,65 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simpletoken implements a generic Detector for secrets that can be
+// found with a single regular expression.
+package simpletoken
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// Detector finds all non-overlapping matches of Re in the input data.
+//
+// If Re contains a capturing group, only the text matched by the first group
+// is passed to FromMatch. This allows using surrounding context (e.g. a
+// variable name) to reduce false positives for tokens without a distinctive
+// prefix. The reported position is always the start of the full match so that
+// the DetectionEngine never splits a match across chunks.
+type Detector struct {
+	// MaxLen is the maximum length of a full match of Re.
+	MaxLen uint32
+	// Re is the regular expression matching the token.
+	Re *regexp.Regexp
+	// FromMatch converts a match into a Secret. It may return nil to discard
+	// the match, e.g. if a checksum doesn't match.
+	FromMatch func([]byte) veles.Secret
+}
+
+// MaxSecretLen returns the maximum length a secret from this Detector can have.
+func (d Detector) MaxSecretLen() uint32 { return d.MaxLen }
+
+// Detect finds candidate tokens that match Detector.Re and returns them
+// alongside their starting positions.
+func (d Detector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range d.Re.FindAllSubmatchIndex(data, -1) {
+		start, end := m[0], m[1]
+		if len(m) >= 4 && m[2] >= 0 {
+			start, end = m[2], m[3]
+		}
+		s := d.FromMatch(data[start:end])
+		if s == nil {
+			continue
+		}
+		secrets = append(secrets, s)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+var _ veles.Detector = Detector{}
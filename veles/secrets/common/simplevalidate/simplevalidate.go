@@ -0,0 +1,124 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simplevalidate implements a generic Validator for secrets that can
+// be checked with a single HTTP request.
+package simplevalidate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// defaultTimeout is used if no HTTP client is configured.
+const defaultTimeout = 10 * time.Second
+
+// Validator validates a Secret of type S by sending an HTTP request and
+// mapping the response status code to a ValidationStatus.
+type Validator[S veles.Secret] struct {
+	// Endpoint is the URL the request is sent to.
+	// Ignored if EndpointFunc is set.
+	Endpoint string
+	// EndpointFunc optionally computes the URL from the secret.
+	EndpointFunc func(S) (string, error)
+	// HTTPMethod of the request. Defaults to GET.
+	HTTPMethod string
+	// HTTPHeaders returns the headers to send, usually including the secret.
+	HTTPHeaders func(S) map[string]string
+	// Body optionally returns the request body.
+	Body func(S) (string, error)
+	// ValidResponseCodes are the status codes that indicate a valid secret.
+	ValidResponseCodes []int
+	// InvalidResponseCodes are the status codes that indicate an invalid secret.
+	InvalidResponseCodes []int
+	// HTTPC is the client used for the request. A client with a default timeout
+	// is used if nil.
+	HTTPC *http.Client
+}
+
+// Validate sends the validation request for s and interprets the response.
+// Any status code not listed in ValidResponseCodes or InvalidResponseCodes
+// results in ValidationFailed. HTTP 429 is reported as a veles.RateLimitError
+// so that a throttled ValidationEngine retries it.
+func (v *Validator[S]) Validate(ctx context.Context, s S) (veles.ValidationStatus, error) {
+	endpoint := v.Endpoint
+	if v.EndpointFunc != nil {
+		var err error
+		if endpoint, err = v.EndpointFunc(s); err != nil {
+			return veles.ValidationFailed, fmt.Errorf("building endpoint: %w", err)
+		}
+	}
+	method := v.HTTPMethod
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if v.Body != nil {
+		b, err := v.Body(s)
+		if err != nil {
+			return veles.ValidationFailed, fmt.Errorf("building request body: %w", err)
+		}
+		body = strings.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("http.NewRequestWithContext(%s): %w", method, err)
+	}
+	if v.HTTPHeaders != nil {
+		for k, val := range v.HTTPHeaders(s) {
+			req.Header.Set(k, val)
+		}
+	}
+	client := v.HTTPC
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("HTTP %s failed: %w", method, err)
+	}
+	defer res.Body.Close()
+
+	switch {
+	case slices.Contains(v.ValidResponseCodes, res.StatusCode):
+		return veles.ValidationValid, nil
+	case slices.Contains(v.InvalidResponseCodes, res.StatusCode):
+		return veles.ValidationInvalid, nil
+	case res.StatusCode == http.StatusTooManyRequests:
+		return veles.ValidationFailed, &veles.RateLimitError{RetryAfter: retryAfter(res.Header.Get("Retry-After"))}
+	default:
+		return veles.ValidationFailed, fmt.Errorf("unexpected HTTP status: %d", res.StatusCode)
+	}
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date. It returns zero if the value is missing
+// or malformed.
+func retryAfter(v string) time.Duration {
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return 0
+}
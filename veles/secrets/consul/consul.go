@@ -0,0 +1,55 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package consul contains a Veles Secret type and Detector for HashiCorp
+// Consul ACL tokens.
+package consul
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+// maxContextLen bounds the key name, separator and token matched by the
+// detection regex.
+const maxContextLen = 200
+
+// ACL tokens are plain UUIDs, so they're only reported when assigned to a
+// Consul token key: CONSUL_HTTP_TOKEN and similar env variables, the
+// X-Consul-Token header, the legacy acl_*token agent options and the
+// initial_management/agent_recovery tokens of the acl.tokens config block.
+// Kubernetes env entries where the value follows on the next line are
+// matched too.
+var tokenRe = regexp.MustCompile(`(?i:consul[a-z0-9_.\-]{0,30}token|acl_(?:master_|agent_master_|agent_|replication_)?token|initial_management|agent_recovery)["']?(?:\s{0,5}[:=]|\s{0,40}value:)\s{0,5}["']?([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\b`)
+
+// ACLToken is a Consul ACL token secret ID. Depending on its policies it can
+// grant read or write access to the service catalog, KV store and ACL system.
+//
+// Consul clusters are self-hosted, so there's no Validator.
+type ACLToken struct {
+	Token string
+}
+
+// NewDetector returns a Detector that finds Consul ACL tokens.
+func NewDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxContextLen,
+		Re:     tokenRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return ACLToken{Token: string(b)}
+		},
+	}
+}
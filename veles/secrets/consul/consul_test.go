@@ -0,0 +1,85 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consul_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/consul"
+)
+
+const token = "b1gs33cr-3t00-4a1b-9c2d-0123456789ab"
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{consul.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+	uuid := "5c4f9e2a-8b1d-4e7f-a3c6-0d9b2e1f7a48"
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "env variable",
+			input: "export CONSUL_HTTP_TOKEN=" + uuid + "\n",
+			want:  []veles.Secret{consul.ACLToken{Token: uuid}},
+		},
+		{
+			name:  "hcl acl tokens block",
+			input: "acl {\n  enabled = true\n  tokens {\n    initial_management = \"" + uuid + "\"\n  }\n}\n",
+			want:  []veles.Secret{consul.ACLToken{Token: uuid}},
+		},
+		{
+			name:  "legacy json config",
+			input: `{"acl_master_token": "` + uuid + `"}`,
+			want:  []veles.Secret{consul.ACLToken{Token: uuid}},
+		},
+		{
+			name: "kubernetes env entry",
+			input: `- name: CONSUL_HTTP_TOKEN
+  value: "` + uuid + `"`,
+			want: []veles.Secret{consul.ACLToken{Token: uuid}},
+		},
+		{
+			name:  "uuid without consul context",
+			input: "request_id = \"" + uuid + "\"",
+			want:  nil,
+		},
+		{
+			name:  "not a uuid",
+			input: "CONSUL_HTTP_TOKEN=" + token,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cryptocurrency contains Veles Secret types and Detectors for
+// cryptocurrency credentials: Ethereum keystore files, BIP39 mnemonic phrases
+// and API key pairs of cryptocurrency exchanges.
+//
+// Leaked cryptocurrency credentials can be turned into money immediately and
+// transfers can't be reversed, so each Secret carries a Severity that ranks
+// how directly it gives access to funds. The Detectors aren't enabled by
+// default and need to be passed to the secret scanner explicitly.
+package cryptocurrency
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxContextLen is how far from an API key its secret is searched.
+const maxContextLen = 1 * veles.KiB
+
+// Severity of a leaked credential.
+type Severity string
+
+// Severity values.
+const (
+	// SeverityMedium is used for credentials that first need to be cracked,
+	// e.g. password encrypted keystores.
+	SeverityMedium Severity = "MEDIUM"
+	// SeverityHigh is used for credentials that give access to an account
+	// holding funds, e.g. exchange API keys that can trade or withdraw.
+	SeverityHigh Severity = "HIGH"
+	// SeverityCritical is used for credentials that directly control the
+	// funds, e.g. wallet seed phrases.
+	SeverityCritical Severity = "CRITICAL"
+)
+
+// EthereumKeystore is an encrypted Ethereum private key in the Web3 Secret
+// Storage format, e.g. the UTC--<date>--<address> files of geth. The key is
+// encrypted with a password that can be brute-forced offline.
+type EthereumKeystore struct {
+	// The address of the account as lowercase hex with a 0x prefix, if the
+	// keystore has one.
+	Address string
+	// The key derivation function, "scrypt" or "pbkdf2".
+	KDF string
+	// The keystore JSON.
+	Keystore string
+	Severity Severity
+}
+
+// Mnemonic is a BIP39 mnemonic phrase that encodes the seed of a wallet.
+type Mnemonic struct {
+	// The words of the phrase separated by single spaces.
+	Phrase   string
+	Severity Severity
+}
+
+// Exchange is a cryptocurrency exchange.
+type Exchange string
+
+// Supported exchanges.
+const (
+	Binance  Exchange = "binance"
+	Coinbase Exchange = "coinbase"
+)
+
+// ExchangeAPIKey is an API key of a cryptocurrency exchange together with its
+// secret.
+type ExchangeAPIKey struct {
+	Exchange Exchange
+	// The API key, or the key name for Coinbase Developer Platform keys, e.g.
+	// "organizations/<org>/apiKeys/<id>".
+	Key string
+	// The API secret, or the PEM encoded private key for Coinbase Developer
+	// Platform keys.
+	Secret   string
+	Severity Severity
+}
+
+// window returns the context of a match at [start, end).
+func window(data []byte, start, end int) []byte {
+	return data[max(0, start-maxContextLen):min(len(data), end+maxContextLen)]
+}
+
+// firstSubmatch returns the first submatch of re in data.
+func firstSubmatch(re *regexp.Regexp, data []byte) string {
+	m := re.FindSubmatch(data)
+	if m == nil {
+		return ""
+	}
+	return string(m[1])
+}
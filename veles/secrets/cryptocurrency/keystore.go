@@ -0,0 +1,130 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptocurrency
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxKeystoreLen is the maximum length of a keystore JSON. Keystores are
+	// usually below 1 KiB.
+	maxKeystoreLen = 4 * veles.KiB
+	// maxKeystoreStarts is the number of opening braces before the "crypto"
+	// key that are tried as the start of the keystore.
+	maxKeystoreStarts = 4
+)
+
+var (
+	// Older versions of geth write the key as "Crypto".
+	keystoreCryptoRe = regexp.MustCompile(`"(?:crypto|Crypto)"\s{0,10}:\s{0,10}\{`)
+	hexRe            = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+)
+
+type keystoreJSON struct {
+	Address string          `json:"address"`
+	Crypto  *keystoreCrypto `json:"crypto"`
+	Version int             `json:"version"`
+}
+
+type keystoreCrypto struct {
+	Cipher     string `json:"cipher"`
+	CipherText string `json:"ciphertext"`
+	KDF        string `json:"kdf"`
+	MAC        string `json:"mac"`
+}
+
+// keystoreDetector finds Ethereum keystores.
+type keystoreDetector struct{}
+
+// NewKeystoreDetector returns a Detector that finds Ethereum keystores in the
+// Web3 Secret Storage format.
+func NewKeystoreDetector() veles.Detector { return keystoreDetector{} }
+
+// MaxSecretLen returns the maximum length of a keystore JSON.
+func (keystoreDetector) MaxSecretLen() uint32 { return maxKeystoreLen }
+
+// Detect finds Ethereum keystores in data.
+func (keystoreDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	end := 0
+	for _, m := range keystoreCryptoRe.FindAllIndex(data, -1) {
+		if m[0] < end {
+			// Part of the previous keystore.
+			continue
+		}
+		start, length, ks := findKeystore(data, m[0])
+		if ks == nil {
+			continue
+		}
+		secrets = append(secrets, EthereumKeystore{
+			Address:  normalizeAddress(ks.Address),
+			KDF:      ks.Crypto.KDF,
+			Keystore: string(data[start : start+length]),
+			Severity: SeverityMedium,
+		})
+		positions = append(positions, start)
+		end = start + length
+	}
+	return secrets, positions
+}
+
+// findKeystore returns the start, length and contents of the keystore whose
+// "crypto" key is at cryptoPos, or nil if there's no valid keystore.
+func findKeystore(data []byte, cryptoPos int) (int, int, *keystoreJSON) {
+	lower := max(0, cryptoPos-maxKeystoreLen)
+	pos := cryptoPos
+	for range maxKeystoreStarts {
+		start := bytes.LastIndexByte(data[lower:pos], '{')
+		if start < 0 {
+			return 0, 0, nil
+		}
+		start += lower
+		dec := json.NewDecoder(bytes.NewReader(data[start:min(len(data), start+maxKeystoreLen)]))
+		ks := &keystoreJSON{}
+		if err := dec.Decode(ks); err == nil && isKeystore(ks) {
+			return start, int(dec.InputOffset()), ks
+		}
+		pos = start
+	}
+	return 0, 0, nil
+}
+
+// isKeystore returns true if ks is a version 3 keystore with an encrypted
+// key.
+func isKeystore(ks *keystoreJSON) bool {
+	if ks.Version != 3 || ks.Crypto == nil {
+		return false
+	}
+	c := ks.Crypto
+	if c.KDF != "scrypt" && c.KDF != "pbkdf2" {
+		return false
+	}
+	return hexRe.MatchString(c.CipherText) && hexRe.MatchString(c.MAC)
+}
+
+// normalizeAddress returns the address with a 0x prefix.
+func normalizeAddress(addr string) string {
+	if addr == "" {
+		return ""
+	}
+	return "0x" + strings.TrimPrefix(strings.ToLower(addr), "0x")
+}
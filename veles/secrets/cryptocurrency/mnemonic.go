@@ -0,0 +1,129 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptocurrency
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxMnemonicLen is the maximum length of a 24 word mnemonic including
+// whitespace between the words.
+const maxMnemonicLen = 512
+
+var (
+	//go:embed data/bip39_english.txt
+	bip39English string
+	// bip39Index maps the words of the BIP39 English wordlist to their index.
+	bip39Index = func() map[string]int {
+		m := map[string]int{}
+		for i, w := range strings.Fields(bip39English) {
+			m[w] = i
+		}
+		return m
+	}()
+
+	// Runs of at least 12 lowercase words that could be BIP39 words.
+	wordRunRe = regexp.MustCompile(`\b[a-z]{3,8}(?:\s{1,4}[a-z]{3,8}){11,}\b`)
+	wordRe    = regexp.MustCompile(`[a-z]+`)
+	// Valid mnemonic lengths, longest first.
+	mnemonicLengths = []int{24, 21, 18, 15, 12}
+)
+
+// mnemonicDetector finds BIP39 mnemonics.
+type mnemonicDetector struct{}
+
+// NewMnemonicDetector returns a Detector that finds BIP39 mnemonic phrases in
+// English. Only phrases with a valid checksum are reported.
+func NewMnemonicDetector() veles.Detector { return mnemonicDetector{} }
+
+// MaxSecretLen returns the maximum length of a mnemonic.
+func (mnemonicDetector) MaxSecretLen() uint32 { return maxMnemonicLen }
+
+// Detect finds BIP39 mnemonics in data.
+func (mnemonicDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, run := range wordRunRe.FindAllIndex(data, -1) {
+		words := wordRe.FindAllIndex(data[run[0]:run[1]], -1)
+		for i := 0; i < len(words); {
+			n := mnemonicAt(data[run[0]:run[1]], words[i:])
+			if n == 0 {
+				i++
+				continue
+			}
+			phrase := make([]string, 0, n)
+			for _, w := range words[i : i+n] {
+				phrase = append(phrase, string(data[run[0]+w[0]:run[0]+w[1]]))
+			}
+			secrets = append(secrets, Mnemonic{Phrase: strings.Join(phrase, " "), Severity: SeverityCritical})
+			positions = append(positions, run[0]+words[i][0])
+			i += n
+		}
+	}
+	return secrets, positions
+}
+
+// mnemonicAt returns the number of words of the longest valid mnemonic that
+// starts with the first of words, or 0 if there's none.
+func mnemonicAt(data []byte, words [][]int) int {
+	indices := make([]int, 0, mnemonicLengths[0])
+	for _, w := range words {
+		i, ok := bip39Index[string(data[w[0]:w[1]])]
+		if !ok || len(indices) == mnemonicLengths[0] {
+			break
+		}
+		indices = append(indices, i)
+	}
+	for _, n := range mnemonicLengths {
+		if len(indices) >= n && validChecksum(indices[:n]) && !isTestVector(indices[:n]) {
+			return n
+		}
+	}
+	return 0
+}
+
+// validChecksum returns true if the last bits of the mnemonic with the given
+// word indices are the checksum of the entropy encoded by the rest.
+func validChecksum(indices []int) bool {
+	v := new(big.Int)
+	for _, i := range indices {
+		v.Lsh(v, 11)
+		v.Or(v, big.NewInt(int64(i)))
+	}
+	// One checksum bit per 32 bits of entropy, i.e. per 3 words.
+	csBits := uint(len(indices) / 3)
+	entropyBytes := (len(indices)*11 - int(csBits)) / 8
+	checksum := new(big.Int).And(v, big.NewInt(1<<csBits-1)).Int64()
+	entropy := new(big.Int).Rsh(v, csBits).FillBytes(make([]byte, entropyBytes))
+	hash := sha256.Sum256(entropy)
+	return int64(hash[0]>>(8-csBits)) == checksum
+}
+
+// isTestVector returns true for well-known mnemonics from test vectors and
+// documentation that repeat the same word, e.g. "abandon abandon ... about".
+func isTestVector(indices []int) bool {
+	for _, i := range indices[1 : len(indices)-1] {
+		if i != indices[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datasource contains a Veles Secret type and a Detector for the
+// database credentials of Java application datasources: Tomcat <Resource>
+// elements of context.xml and server.xml, WildFly and JBoss <datasource>
+// elements of standalone.xml and *-ds.xml files and JDBC settings of
+// properties files, e.g. the spring.datasource.* properties of Spring Boot's
+// application.properties.
+//
+// Usernames and passwords are paired with the JDBC URL of their datasource,
+// so that each datasource is reported once with the database it grants
+// access to.
+package datasource
+
+// Formats the datasources are defined in.
+const (
+	FormatTomcatResource    = "tomcat-resource"
+	FormatWildFlyDatasource = "wildfly-datasource"
+	FormatProperties        = "properties"
+)
+
+// Credentials are the credentials a datasource connects to its database
+// with.
+type Credentials struct {
+	// Format is the config format the datasource is defined in, e.g.
+	// FormatTomcatResource.
+	Format string
+	// Name of the datasource: the JNDI or pool name in XML configs and the
+	// property prefix in properties files, e.g. "spring.datasource".
+	Name string
+	// URL is the JDBC URL of the database.
+	URL string
+	// JDBC holds the components of URL.
+	JDBC     JDBCURL
+	Username string
+	Password string
+}
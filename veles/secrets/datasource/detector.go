@@ -0,0 +1,268 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxConfigLen is the maximum size of a config file. Application server
+// configs like WildFly's standalone.xml are a few dozen KiB large.
+const maxConfigLen = 64 * veles.KiB
+
+var (
+	// Properties that hold the JDBC URL, username and password of a
+	// datasource, e.g. spring.datasource.url or hibernate.connection.username.
+	// The prefix before them is the datasource name.
+	urlKeyRe       = regexp.MustCompile(`(?i)^(.*?)[.-]?(?:jdbc[.-]?)?url$`)
+	usernameKeys   = []string{"username", "user", "user-name", "user.name", "jdbc.username", "jdbc.user"}
+	passwordKeys   = []string{"password", "pass", "jdbc.password"}
+	propertyLineRe = regexp.MustCompile(`^\s*([^#!\s=:][^=:\s]*)\s*[=:]\s*(.*?)\s*$`)
+
+	// Placeholders for values resolved at runtime, e.g. ${DB_PASSWORD} or
+	// Jasypt's ENC(...), aren't secrets.
+	placeholderRe = regexp.MustCompile(`^(\$\{.*\}|%\{.*\}|#\{.*\}|ENC\(.*\)|\{[A-Za-z0-9]+\}.*)$`)
+)
+
+// detector finds the credentials of datasources in application server
+// configs and properties files.
+type detector struct{}
+
+// NewDetector returns a Detector that finds the database credentials of
+// Tomcat resources, WildFly datasources and JDBC properties.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum size of a config file.
+func (detector) MaxSecretLen() uint32 { return maxConfigLen }
+
+// Detect finds datasource credentials in data.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	if !bytes.Contains(data, []byte("jdbc:")) {
+		return nil, nil
+	}
+	var found []Credentials
+	if bytes.Contains(data, []byte("<Resource")) || bytes.Contains(data, []byte("datasource")) {
+		found = xmlCredentials(data)
+	}
+	if len(found) == 0 {
+		found = propertiesCredentials(data)
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, c := range found {
+		if c.Password == "" || isPlaceholder(c.Password) {
+			continue
+		}
+		if slices.ContainsFunc(secrets, func(s veles.Secret) bool { return s == veles.Secret(c) }) {
+			continue
+		}
+		pos := bytes.Index(data, []byte(c.Password))
+		if pos < 0 {
+			pos = 0
+		}
+		secrets = append(secrets, c)
+		positions = append(positions, pos)
+	}
+	return secrets, positions
+}
+
+// newCredentials returns the credentials of a datasource with the given JDBC
+// URL. Credentials embedded in the URL are used if the datasource doesn't
+// set them separately.
+func newCredentials(format, name, jdbcURL, username, password string) (Credentials, bool) {
+	u, ok := ParseJDBCURL(jdbcURL)
+	if !ok {
+		return Credentials{}, false
+	}
+	if username == "" || isPlaceholder(username) {
+		username = u.Username
+	}
+	if password == "" || isPlaceholder(password) {
+		password = u.Password
+	}
+	return Credentials{
+		Format:   format,
+		Name:     name,
+		URL:      strings.TrimSpace(jdbcURL),
+		JDBC:     u,
+		Username: username,
+		Password: password,
+	}, true
+}
+
+func isPlaceholder(s string) bool { return placeholderRe.MatchString(strings.TrimSpace(s)) }
+
+// wildflyDatasource collects the settings of a WildFly <datasource> or
+// <xa-datasource> element while its children are decoded.
+type wildflyDatasource struct {
+	name, url, username, password string
+}
+
+// xmlCredentials returns the credentials of the Tomcat <Resource> and
+// WildFly <datasource> elements in data.
+func xmlCredentials(data []byte) []Credentials {
+	var result []Credentials
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Strict = false
+	var ds *wildflyDatasource
+	// Local names of the open elements inside the current datasource.
+	var path []string
+	// The name attribute of the open <xa-datasource-property> element.
+	var xaProperty string
+	for {
+		// Decoding stops at the end of the data and at chunks of larger files,
+		// which can't be parsed.
+		tok, err := dec.Token()
+		if err != nil {
+			break
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+			switch {
+			case name == "Resource":
+				attrs := attrMap(t.Attr)
+				if c, ok := newCredentials(FormatTomcatResource, attrs["name"], attrs["url"], attrs["username"], attrs["password"]); ok {
+					result = append(result, c)
+				}
+			case name == "datasource" || name == "xa-datasource":
+				attrs := attrMap(t.Attr)
+				ds = &wildflyDatasource{name: attrs["jndi-name"]}
+				if ds.name == "" {
+					ds.name = attrs["pool-name"]
+				}
+				path = nil
+			case ds != nil:
+				path = append(path, name)
+				attrs := attrMap(t.Attr)
+				switch name {
+				case "security":
+					// WildFly 27+ sets the credentials as attributes.
+					setIfEmpty(&ds.username, attrs["user-name"])
+					setIfEmpty(&ds.password, attrs["password"])
+				case "xa-datasource-property":
+					xaProperty = strings.ToLower(attrs["name"])
+				}
+			}
+		case xml.CharData:
+			if ds == nil || len(path) == 0 {
+				continue
+			}
+			text := strings.TrimSpace(string(t))
+			switch path[len(path)-1] {
+			case "connection-url":
+				setIfEmpty(&ds.url, text)
+			case "user-name":
+				setIfEmpty(&ds.username, text)
+			case "password":
+				setIfEmpty(&ds.password, text)
+			case "xa-datasource-property":
+				if xaProperty == "url" {
+					setIfEmpty(&ds.url, text)
+				}
+			}
+		case xml.EndElement:
+			switch {
+			case t.Name.Local == "datasource" || t.Name.Local == "xa-datasource":
+				if ds != nil {
+					if c, ok := newCredentials(FormatWildFlyDatasource, ds.name, ds.url, ds.username, ds.password); ok {
+						result = append(result, c)
+					}
+				}
+				ds = nil
+			case ds != nil && len(path) > 0:
+				path = path[:len(path)-1]
+			}
+		}
+	}
+	return result
+}
+
+func attrMap(attrs []xml.Attr) map[string]string {
+	m := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		m[a.Name.Local] = a.Value
+	}
+	return m
+}
+
+func setIfEmpty(dst *string, v string) {
+	if *dst == "" {
+		*dst = v
+	}
+}
+
+// propertiesCredentials returns the credentials of the datasources in a
+// properties file. A datasource is a group of properties with a common
+// prefix, a JDBC URL property and optional username and password properties,
+// e.g. spring.datasource.url, spring.datasource.username and
+// spring.datasource.password.
+func propertiesCredentials(data []byte) []Credentials {
+	var keys []string
+	props := map[string]string{}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(nil, maxConfigLen)
+	for s.Scan() {
+		m := propertyLineRe.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		key := strings.ToLower(m[1])
+		if _, ok := props[key]; !ok {
+			keys = append(keys, key)
+		}
+		props[key] = unquote(m[2])
+	}
+
+	var result []Credentials
+	for _, key := range keys {
+		m := urlKeyRe.FindStringSubmatch(key)
+		if m == nil || !strings.HasPrefix(strings.ToLower(props[key]), "jdbc:") {
+			continue
+		}
+		prefix := m[1]
+		lookup := func(names []string) string {
+			for _, n := range names {
+				k := n
+				if prefix != "" {
+					k = prefix + "." + n
+				}
+				if v := props[k]; v != "" {
+					return v
+				}
+			}
+			return ""
+		}
+		if c, ok := newCredentials(FormatProperties, prefix, props[key], lookup(usernameKeys), lookup(passwordKeys)); ok {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
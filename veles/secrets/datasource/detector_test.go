@@ -0,0 +1,219 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datasource_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/datasource"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const contextXML = `<?xml version="1.0" encoding="UTF-8"?>
+<Context>
+  <WatchedResource>WEB-INF/web.xml</WatchedResource>
+  <Resource name="jdbc/AppDB" auth="Container" type="javax.sql.DataSource"
+            maxTotal="20" maxIdle="10"
+            username="app" password="Tomcat-S3cret!"
+            driverClassName="org.postgresql.Driver"
+            url="jdbc:postgresql://db.internal:5432/shop?ssl=true&amp;sslmode=require"/>
+  <Resource name="jdbc/Reports" auth="Container" type="javax.sql.DataSource"
+            username="reports" password="${REPORTS_PASSWORD}"
+            url="jdbc:mysql://reports.internal:3306/reports"/>
+  <Resource name="mail/Session" auth="Container" type="jakarta.mail.Session"
+            mail.smtp.host="localhost" password="not-a-datasource"/>
+</Context>
+`
+
+const standaloneXML = `<?xml version='1.0' encoding='UTF-8'?>
+<server xmlns="urn:jboss:domain:20.0">
+  <profile>
+    <subsystem xmlns="urn:jboss:domain:datasources:7.0">
+      <datasources>
+        <datasource jndi-name="java:jboss/datasources/ExampleDS" pool-name="ExampleDS" enabled="true">
+          <connection-url>jdbc:h2:mem:test;DB_CLOSE_DELAY=-1</connection-url>
+          <driver>h2</driver>
+          <security user-name="sa" password="sa"/>
+        </datasource>
+        <datasource jndi-name="java:jboss/datasources/OrdersDS" pool-name="OrdersDS">
+          <connection-url>jdbc:oracle:thin:@//orders-db.internal:1521/ORDERS</connection-url>
+          <driver>oracle</driver>
+          <security>
+            <user-name>orders</user-name>
+            <password>WildFly-S3cret</password>
+          </security>
+        </datasource>
+        <xa-datasource jndi-name="java:jboss/datasources/BillingXADS" pool-name="BillingXADS">
+          <xa-datasource-property name="URL">jdbc:sqlserver://billing.internal:1433;databaseName=billing</xa-datasource-property>
+          <security>
+            <user-name>billing</user-name>
+            <password>Xa-S3cret</password>
+          </security>
+        </xa-datasource>
+      </datasources>
+    </subsystem>
+  </profile>
+</server>
+`
+
+const applicationProperties = `# Primary datasource
+spring.datasource.url=jdbc:mysql://mysql.internal:3306/orders?useSSL=true
+spring.datasource.username=orders
+spring.datasource.password=Spring-S3cret
+
+# Secondary datasource of a custom prefix
+app.datasource.reporting.jdbc-url = jdbc:postgresql://reporting.internal/reporting
+app.datasource.reporting.username = reporting
+app.datasource.reporting.password = ENC(G6N718UuyPE5bHyWKyuLQSm02auQPUtm)
+
+hibernate.connection.url=jdbc:postgresql://legacy.internal/legacy?user=legacy&password=Url-S3cret
+`
+
+func TestDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "tomcat context.xml",
+			input: contextXML,
+			want: []veles.Secret{
+				datasource.Credentials{
+					Format: datasource.FormatTomcatResource,
+					Name:   "jdbc/AppDB",
+					URL:    "jdbc:postgresql://db.internal:5432/shop?ssl=true&sslmode=require",
+					JDBC: datasource.JDBCURL{
+						Subprotocol: "postgresql",
+						Host:        "db.internal",
+						Port:        "5432",
+						Database:    "shop",
+					},
+					Username: "app",
+					Password: "Tomcat-S3cret!",
+				},
+			},
+		},
+		{
+			name:  "wildfly standalone.xml",
+			input: standaloneXML,
+			want: []veles.Secret{
+				datasource.Credentials{
+					Format:   datasource.FormatWildFlyDatasource,
+					Name:     "java:jboss/datasources/ExampleDS",
+					URL:      "jdbc:h2:mem:test;DB_CLOSE_DELAY=-1",
+					JDBC:     datasource.JDBCURL{Subprotocol: "h2"},
+					Username: "sa",
+					Password: "sa",
+				},
+				datasource.Credentials{
+					Format: datasource.FormatWildFlyDatasource,
+					Name:   "java:jboss/datasources/OrdersDS",
+					URL:    "jdbc:oracle:thin:@//orders-db.internal:1521/ORDERS",
+					JDBC: datasource.JDBCURL{
+						Subprotocol: "oracle",
+						Host:        "orders-db.internal",
+						Port:        "1521",
+						Database:    "ORDERS",
+					},
+					Username: "orders",
+					Password: "WildFly-S3cret",
+				},
+				datasource.Credentials{
+					Format: datasource.FormatWildFlyDatasource,
+					Name:   "java:jboss/datasources/BillingXADS",
+					URL:    "jdbc:sqlserver://billing.internal:1433;databaseName=billing",
+					JDBC: datasource.JDBCURL{
+						Subprotocol: "sqlserver",
+						Host:        "billing.internal",
+						Port:        "1433",
+						Database:    "billing",
+					},
+					Username: "billing",
+					Password: "Xa-S3cret",
+				},
+			},
+		},
+		{
+			name:  "spring application.properties",
+			input: applicationProperties,
+			want: []veles.Secret{
+				datasource.Credentials{
+					Format: datasource.FormatProperties,
+					Name:   "spring.datasource",
+					URL:    "jdbc:mysql://mysql.internal:3306/orders?useSSL=true",
+					JDBC: datasource.JDBCURL{
+						Subprotocol: "mysql",
+						Host:        "mysql.internal",
+						Port:        "3306",
+						Database:    "orders",
+					},
+					Username: "orders",
+					Password: "Spring-S3cret",
+				},
+				datasource.Credentials{
+					Format: datasource.FormatProperties,
+					Name:   "hibernate.connection",
+					URL:    "jdbc:postgresql://legacy.internal/legacy?user=legacy&password=Url-S3cret",
+					JDBC: datasource.JDBCURL{
+						Subprotocol: "postgresql",
+						Host:        "legacy.internal",
+						Database:    "legacy",
+						Username:    "legacy",
+						Password:    "Url-S3cret",
+					},
+					Username: "legacy",
+					Password: "Url-S3cret",
+				},
+			},
+		},
+		{
+			name:  "URL without credentials",
+			input: "spring.datasource.url=jdbc:postgresql://db/app\nspring.datasource.username=app\n",
+			want:  nil,
+		},
+		{
+			name:  "password without JDBC URL",
+			input: "db.password=s3cret\n",
+			want:  nil,
+		},
+		{
+			name:  "truncated XML",
+			input: standaloneXML[:strings.Index(standaloneXML, "<password>")],
+			want: []veles.Secret{
+				datasource.Credentials{
+					Format:   datasource.FormatWildFlyDatasource,
+					Name:     "java:jboss/datasources/ExampleDS",
+					URL:      "jdbc:h2:mem:test;DB_CLOSE_DELAY=-1",
+					JDBC:     datasource.JDBCURL{Subprotocol: "h2"},
+					Username: "sa",
+					Password: "sa",
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := velestest.Detect(t, datasource.NewDetector(), tc.input)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
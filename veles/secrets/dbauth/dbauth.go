@@ -0,0 +1,59 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dbauth contains Veles Secret types and Detectors for the
+// credentials database servers and clusters authenticate with: Redis ACL
+// users and passwords, MongoDB replica set keyfiles and PostgreSQL password
+// files. Cluster-internal secrets like Redis masterauth passwords and MongoDB
+// keyfiles allow joining rogue nodes to a cluster and replicating all of its
+// data.
+package dbauth
+
+// RedisACLUser is a user with passwords from a Redis ACL file (users.acl) or
+// a user directive of redis.conf.
+type RedisACLUser struct {
+	Username string
+	// Plaintext passwords, set with the ">password" rule.
+	Passwords []string
+	// Hex-encoded SHA-256 password hashes, set with the "#hash" rule.
+	PasswordHashes []string
+}
+
+// RedisPassword is a password from a redis.conf directive.
+type RedisPassword struct {
+	// The directive, "requirepass" for the password of the default user or
+	// "masterauth" for the password replicas authenticate to their primary
+	// with.
+	Directive string
+	Password  string
+}
+
+// MongoDBKeyFile is the shared key the members of a MongoDB replica set or
+// sharded cluster authenticate to each other with.
+type MongoDBKeyFile struct {
+	// The variable the key is assigned to, e.g. "MONGODB_REPLICA_SET_KEY".
+	// Empty if the key was found as the contents of a keyfile.
+	Variable string
+	Key      string
+}
+
+// PgpassEntry is an entry of a PostgreSQL password file (.pgpass).
+type PgpassEntry struct {
+	// The host, port and database the entry applies to. Each can be "*".
+	Host     string
+	Port     string
+	Database string
+	Username string
+	Password string
+}
@@ -0,0 +1,252 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbauth
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxLineLen bounds the config lines matched by the detection regexes.
+	maxLineLen = 4 * veles.KiB
+	// MongoDB keys are 6 to 1024 base64 characters long. Keyfile contents are
+	// only reported from minKeyFileLen on since shorter base64 blobs are too
+	// common in other files.
+	maxMongoDBKeyLen = 1024
+	minKeyFileLen    = 512
+	// maxKeyFileLen is the maximum size of a keyfile including the line
+	// breaks of base64 tools.
+	maxKeyFileLen = 2 * maxMongoDBKeyLen
+)
+
+var (
+	// Redis ACL rules are stored one user per line, e.g.
+	//
+	//   user default on #5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8 ~* &* +@all
+	//   user replica on >s3cret +psync +replconf +ping
+	redisUserRe = regexp.MustCompile(`(?m)^[ \t]*user[ \t]+(\S+)[ \t]+([^\n]+)`)
+	// The SHA-256 hash of a password set with a "#" rule.
+	redisHashRe = regexp.MustCompile(`^#[0-9a-fA-F]{64}$`)
+
+	// redis.conf sets the password of the default user with requirepass and
+	// the password of the primary that replicas connect to with masterauth.
+	redisPasswordRe = regexp.MustCompile(`(?m)^[ \t]*(requirepass|masterauth)[ \t]+("[^"\n]*"|'[^'\n]*'|[^\s"']+)[ \t]*\r?$`)
+
+	// The Bitnami images and Helm charts pass the replica set key in the
+	// MONGODB_REPLICA_SET_KEY env variable and the mongodb-replica-set-key
+	// Kubernetes secret key.
+	mongoDBKeyRe = regexp.MustCompile(`(?i)\b(mongodb[_-]replica[_-]set[_-]key)["']?[ \t]*[:=][ \t]*["']?([A-Za-z0-9+/=]{6,})`)
+
+	// .pgpass entries are hostname:port:database:username:password lines.
+	// Colons and backslashes in the fields are escaped with a backslash.
+	pgpassRe = regexp.MustCompile(`(?m)^((?:[^:\\\s#]|\\.)(?:[^:\\\s]|\\.)*):([1-9][0-9]{0,4}|\*):((?:[^:\\\r\n]|\\.)+):((?:[^:\\\r\n]|\\.)+):((?:[^:\\\r\n]|\\.)+)\r?$`)
+)
+
+// redisACLDetector finds users with passwords in Redis ACL rules.
+type redisACLDetector struct{}
+
+// NewRedisACLDetector returns a Detector that finds users with passwords in
+// Redis ACL files and user directives of redis.conf.
+func NewRedisACLDetector() veles.Detector { return redisACLDetector{} }
+
+// MaxSecretLen returns the maximum length of an ACL rule line.
+func (redisACLDetector) MaxSecretLen() uint32 { return maxLineLen }
+
+// Detect finds users with passwords in Redis ACL rules in data.
+func (redisACLDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range redisUserRe.FindAllSubmatchIndex(data, -1) {
+		user := RedisACLUser{Username: string(data[m[2]:m[3]])}
+		isACL := false
+		for _, rule := range strings.Fields(string(data[m[4]:m[5]])) {
+			switch {
+			case strings.HasPrefix(rule, ">") && len(rule) > 1:
+				user.Passwords = append(user.Passwords, rule[1:])
+			case redisHashRe.MatchString(rule):
+				user.PasswordHashes = append(user.PasswordHashes, strings.ToLower(rule[1:]))
+			case isACLRule(rule):
+				isACL = true
+			}
+		}
+		// Only lines with other ACL rules are reported so that prose
+		// starting with "user" isn't mistaken for a rule.
+		if !isACL || (len(user.Passwords) == 0 && len(user.PasswordHashes) == 0) {
+			continue
+		}
+		secrets = append(secrets, user)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// isACLRule returns whether rule is a Redis ACL rule other than a password.
+func isACLRule(rule string) bool {
+	switch rule {
+	case "on", "off", "allkeys", "allchannels", "allcommands", "nocommands",
+		"nopass", "resetpass", "resetkeys", "resetchannels", "reset":
+		return true
+	}
+	return len(rule) > 1 && strings.ContainsAny(rule[:1], "~%&+-")
+}
+
+// redisPasswordDetector finds requirepass and masterauth passwords in
+// redis.conf.
+type redisPasswordDetector struct{}
+
+// NewRedisPasswordDetector returns a Detector that finds requirepass and
+// masterauth passwords in redis.conf.
+func NewRedisPasswordDetector() veles.Detector { return redisPasswordDetector{} }
+
+// MaxSecretLen returns the maximum length of a password directive.
+func (redisPasswordDetector) MaxSecretLen() uint32 { return maxLineLen }
+
+// Detect finds requirepass and masterauth passwords in data.
+func (redisPasswordDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range redisPasswordRe.FindAllSubmatchIndex(data, -1) {
+		password := string(data[m[4]:m[5]])
+		if len(password) >= 2 && (password[0] == '"' || password[0] == '\'') {
+			password = password[1 : len(password)-1]
+		}
+		if password == "" {
+			continue
+		}
+		secrets = append(secrets, RedisPassword{
+			Directive: string(data[m[2]:m[3]]),
+			Password:  password,
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// mongoDBKeyFileDetector finds MongoDB replica set keys.
+type mongoDBKeyFileDetector struct{}
+
+// NewMongoDBKeyFileDetector returns a Detector that finds MongoDB replica set
+// keys, both as keyfile contents and in env variables and Kubernetes secrets.
+func NewMongoDBKeyFileDetector() veles.Detector { return mongoDBKeyFileDetector{} }
+
+// MaxSecretLen returns the maximum size of a keyfile.
+func (mongoDBKeyFileDetector) MaxSecretLen() uint32 { return maxKeyFileLen }
+
+// Detect finds MongoDB replica set keys in data.
+func (mongoDBKeyFileDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	if key, ok := keyFileContents(data); ok {
+		return []veles.Secret{MongoDBKeyFile{Key: key}}, []int{0}
+	}
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range mongoDBKeyRe.FindAllSubmatchIndex(data, -1) {
+		if m[5]-m[4] > maxMongoDBKeyLen {
+			continue
+		}
+		secrets = append(secrets, MongoDBKeyFile{
+			Variable: string(data[m[2]:m[3]]),
+			Key:      string(data[m[4]:m[5]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// keyFileContents returns the key if data is a MongoDB keyfile. Keyfiles are
+// usually generated with "openssl rand -base64 756", which wraps the key
+// into lines of 64 characters. MongoDB ignores the whitespace in the file.
+func keyFileContents(data []byte) (string, bool) {
+	if len(data) > maxKeyFileLen {
+		return "", false
+	}
+	lines := bytes.Fields(data)
+	if len(lines) < 2 {
+		return "", false
+	}
+	var key strings.Builder
+	for _, l := range lines {
+		if len(l) > 76 || !isBase64(l) {
+			return "", false
+		}
+		key.Write(l)
+	}
+	if key.Len() < minKeyFileLen || key.Len() > maxMongoDBKeyLen {
+		return "", false
+	}
+	return key.String(), true
+}
+
+func isBase64(b []byte) bool {
+	for _, c := range b {
+		if !('A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '+' || c == '/' || c == '=') {
+			return false
+		}
+	}
+	return true
+}
+
+// pgpassDetector finds entries of PostgreSQL password files.
+type pgpassDetector struct{}
+
+// NewPgpassDetector returns a Detector that finds entries of PostgreSQL
+// password files.
+func NewPgpassDetector() veles.Detector { return pgpassDetector{} }
+
+// MaxSecretLen returns the maximum length of a .pgpass line.
+func (pgpassDetector) MaxSecretLen() uint32 { return maxLineLen }
+
+// Detect finds .pgpass entries in data.
+func (pgpassDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range pgpassRe.FindAllSubmatchIndex(data, -1) {
+		field := func(i int) string { return unescapePgpass(string(data[m[2*i]:m[2*i+1]])) }
+		port := string(data[m[4]:m[5]])
+		if port != "*" {
+			if n, err := strconv.Atoi(port); err != nil || n > 65535 {
+				continue
+			}
+		}
+		secrets = append(secrets, PgpassEntry{
+			Host:     field(1),
+			Port:     port,
+			Database: field(3),
+			Username: field(4),
+			Password: field(5),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// unescapePgpass removes the backslash escapes of a .pgpass field.
+func unescapePgpass(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
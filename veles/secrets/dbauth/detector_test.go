@@ -0,0 +1,258 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbauth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/dbauth"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const (
+	passwordHash = "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"
+	replicaKey   = "Qm9vdHN0cmFwUmVwbGljYVNldEtleTEyMzQ1Njc4OTA="
+)
+
+func TestRedisACLDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name: "users.acl",
+			input: "user default on nopass sanitize-payload ~* &* +@all\n" +
+				"user admin on #" + passwordHash + " ~* &* +@all\n" +
+				"user replica on >s3cret >0ld-s3cret resetchannels -@all +psync +replconf +ping\n",
+			want: []veles.Secret{
+				dbauth.RedisACLUser{Username: "admin", PasswordHashes: []string{passwordHash}},
+				dbauth.RedisACLUser{Username: "replica", Passwords: []string{"s3cret", "0ld-s3cret"}},
+			},
+		},
+		{
+			name:  "redis.conf user directive",
+			input: "bind 127.0.0.1\n  user worker on >w0rker-pass ~jobs:* +@list\n",
+			want: []veles.Secret{
+				dbauth.RedisACLUser{Username: "worker", Passwords: []string{"w0rker-pass"}},
+			},
+		},
+		{
+			name:  "uppercase hash",
+			input: "user admin on #" + strings.ToUpper(passwordHash) + " ~*\r\n",
+			want: []veles.Secret{
+				dbauth.RedisACLUser{Username: "admin", PasswordHashes: []string{passwordHash}},
+			},
+		},
+		{
+			name:  "no password",
+			input: "user default on nopass ~* &* +@all\n",
+			want:  nil,
+		},
+		{
+			name:  "prose",
+			input: "user alice >bob in the ranking\n",
+			want:  nil,
+		},
+		{
+			name:  "short hash",
+			input: "user admin on #5e884898 ~*\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, dbauth.NewRedisACLDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedisPasswordDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "requirepass",
+			input: "port 6379\nrequirepass Sup3r-Secr3t!\n",
+			want:  []veles.Secret{dbauth.RedisPassword{Directive: "requirepass", Password: "Sup3r-Secr3t!"}},
+		},
+		{
+			name:  "quoted masterauth",
+			input: "replicaof 10.0.0.1 6379\nmasterauth \"repl pass\"\r\n",
+			want:  []veles.Secret{dbauth.RedisPassword{Directive: "masterauth", Password: "repl pass"}},
+		},
+		{
+			name:  "both",
+			input: "requirepass 'a1b2c3'\nmasterauth a1b2c3\n",
+			want: []veles.Secret{
+				dbauth.RedisPassword{Directive: "requirepass", Password: "a1b2c3"},
+				dbauth.RedisPassword{Directive: "masterauth", Password: "a1b2c3"},
+			},
+		},
+		{
+			name:  "commented out",
+			input: "# requirepass foobared\n",
+			want:  nil,
+		},
+		{
+			name:  "empty",
+			input: "requirepass \"\"\n",
+			want:  nil,
+		},
+		{
+			name:  "prose",
+			input: "requirepass sets the password of the default user\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, dbauth.NewRedisPasswordDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMongoDBKeyFileDetector(t *testing.T) {
+	keyFile := `
+UvImZaYMEtKJGF2VDuiBNgkWb2sRPReNbA/TkB/yOaGglfIPk5VlDPk4C47bIkpr
+JIoekk6P0K4uGpSSozBfGIy2EJAPnjR/rohtxlB3lex0XEw/yy6yxz4Uk0yGfuBX
+unJJm/oSHoNrKsFXJu59awr2qxPDjpLK4NFQV7FZmH+UzHQR1xfxRXmyqhAPu7NP
+pZP+rtJySLdi46tYBfB2WiucHX4PN8RJIb0/ZWTq338UKnJmjEfiI9Fu3YxHtGr8
+W67iYfU7JhUtJjuoOwN81JYuQ0gBJWuIXpyQUfMgsNuD856nrb0NdObex/PfrsyP
+ZGVmZBp7omYPMBH8NXApHFeZDRoAkSaJGfJdnQYS3zWdYCaiQPRYml15Hx3ZfP76
+d3p7TxUkGr9XvUN61LEphAU08/OHXCWwi+oGwodM+qTdF7LYQoRd6CpbxTmIiseA
+VKI5nM/J/MLaMc490Wa9zTozhH5buwf9B8pHeEIxsZr0WHLO77n8WfT5XRQ4Gjp4
+MlY0e5/85pzXAHrop1jMpBXVqR7oY8i2wDN64y1vyqJVFs3y+Lhldma+8hW5KCv+
+IAcml+d3zqclnNOY+nmo71knjIwhBQPM+LmmGoa/7yNv/N8x0982B0A2SoA9w5ZT
+Qotr1SEP6L1a5XWpldDnhGvT6uCAIYgmhoIE33DGLpsBxswmLCR5nrkejg9TroSH
+jnvIxhvijw4/MEYKxRmBc48HwuTpEHFTnPmBm4MzsUZzgojOeoHxP7KF4ODx7ULs
+j+TxM9dyI2ofZHFQEqs9bRI2q03IH+XGJ/C3pKldJEDiI/d3OL/zGGXifCn9qtU5
+KbRu/oNnVmsyW1EXuF0EVo11cLQEYlSEn0uD9RAc/OvJOvjgGhVDRQrnxy5FwSHR
+bNnprdHyQmcmieuDkn6zUxZHDsywLmzlEkTwBKIWzUIVm9s4EUPcH3QCVv6Nau3q
+RJ8hC4a1PfAc+ClDDC4z7k+gTofCNEpygKwtRVjNBP5ACQME
+`
+	key := strings.Join(strings.Fields(keyFile), "")
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "openssl keyfile",
+			input: strings.TrimPrefix(keyFile, "\n"),
+			want:  []veles.Secret{dbauth.MongoDBKeyFile{Key: key}},
+		},
+		{
+			name:  "single line keyfile",
+			input: key[:600] + "\n" + key[600:],
+			want:  nil,
+		},
+		{
+			name:  "env variable",
+			input: "MONGODB_REPLICA_SET_MODE=primary\nMONGODB_REPLICA_SET_KEY=" + replicaKey + "\n",
+			want:  []veles.Secret{dbauth.MongoDBKeyFile{Variable: "MONGODB_REPLICA_SET_KEY", Key: replicaKey}},
+		},
+		{
+			name:  "kubernetes secret",
+			input: "apiVersion: v1\nkind: Secret\ndata:\n  mongodb-replica-set-key: \"" + replicaKey + "\"\n",
+			want:  []veles.Secret{dbauth.MongoDBKeyFile{Variable: "mongodb-replica-set-key", Key: replicaKey}},
+		},
+		{
+			name:  "short base64 file",
+			input: "aGVsbG8gd29ybGQ=\naGVsbG8gd29ybGQ=\n",
+			want:  nil,
+		},
+		{
+			name:  "not base64",
+			input: strings.Replace(keyFile, "\n", "\n# comment\n", 1),
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, dbauth.NewMongoDBKeyFileDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPgpassDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "pgpass",
+			input: "# hostname:port:database:username:password\ndb.example.com:5432:app:app_user:Pa55w0rd\n*:*:*:replicator:r3pl\n",
+			want: []veles.Secret{
+				dbauth.PgpassEntry{Host: "db.example.com", Port: "5432", Database: "app", Username: "app_user", Password: "Pa55w0rd"},
+				dbauth.PgpassEntry{Host: "*", Port: "*", Database: "*", Username: "replicator", Password: "r3pl"},
+			},
+		},
+		{
+			name:  "escaped colon",
+			input: `localhost:5432:*:postgres:pa\:ss\\word` + "\r\n",
+			want: []veles.Secret{
+				dbauth.PgpassEntry{Host: "localhost", Port: "5432", Database: "*", Username: "postgres", Password: `pa:ss\word`},
+			},
+		},
+		{
+			name:  "passwd file",
+			input: "root:x:0:0:root:/root:/bin/bash\n",
+			want:  nil,
+		},
+		{
+			name:  "ipv6 address",
+			input: "fe80:0:0:0:1\n",
+			want:  nil,
+		},
+		{
+			name:  "port out of range",
+			input: "localhost:99999:app:user:pass\n",
+			want:  nil,
+		},
+		{
+			name:  "too many fields",
+			input: "localhost:5432:app:user:pass:extra\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, dbauth.NewPgpassDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
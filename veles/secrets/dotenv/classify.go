@@ -0,0 +1,75 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dotenv
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// KeyClass is the kind of credential a dotenv key holds according to its
+// name.
+type KeyClass string
+
+// Key classes.
+const (
+	ClassNone             KeyClass = ""
+	ClassPassword         KeyClass = "password"
+	ClassToken            KeyClass = "token"
+	ClassAPIKey           KeyClass = "api-key"
+	ClassPrivateKey       KeyClass = "private-key"
+	ClassSecret           KeyClass = "secret"
+	ClassConnectionString KeyClass = "connection-string"
+)
+
+// keySplitRe splits keys into words, e.g. "DB_PASSWORD" or "db.password".
+var keySplitRe = regexp.MustCompile(`[_.\-]+`)
+
+// referenceWords are the last words of keys that refer to a credential
+// instead of holding it, e.g. PASSWORD_FILE, or describe it, e.g. TOKEN_TTL.
+var referenceWords = []string{"FILE", "PATH", "DIR", "NAME", "ENABLED", "LENGTH", "TTL", "EXPIRY", "HEADER", "TYPE"}
+
+// Classify returns the kind of credential the key is named after.
+func Classify(key string) KeyClass {
+	words := keySplitRe.Split(strings.ToUpper(key), -1)
+	if len(words) == 0 || slices.Contains(referenceWords, words[len(words)-1]) {
+		return ClassNone
+	}
+	has := func(w ...string) bool {
+		for _, x := range w {
+			if slices.Contains(words, x) {
+				return true
+			}
+		}
+		return false
+	}
+	last := words[len(words)-1]
+	switch {
+	case has("PRIVATE") && has("KEY", "PEM"):
+		return ClassPrivateKey
+	case has("PASSWORD", "PASSWD", "PWD", "PASS", "PASSPHRASE"):
+		return ClassPassword
+	case has("APIKEY") || (has("API") && has("KEY")) || (has("ACCESS") && last == "KEY"):
+		return ClassAPIKey
+	case has("TOKEN", "BEARER"):
+		return ClassToken
+	case has("SECRET"):
+		return ClassSecret
+	case last == "URL" || last == "URI" || last == "DSN" || has("CONNECTION", "CONNSTR"):
+		return ClassConnectionString
+	}
+	return ClassNone
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dotenv parses dotenv files (.env, .env.local, .env.production,
+// direnv's .envrc, ...) and finds the secrets in them. The values are run
+// through the Veles detectors together with their key, and values of keys
+// whose name suggests a credential are reported even if no detector
+// recognizes them.
+package dotenv
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// templateSuffixes are suffixes of dotenv files that document the variables
+// with placeholder values, e.g. .env.example.
+var templateSuffixes = []string{".example", ".sample", ".template", ".dist", ".defaults"}
+
+// keyRe matches the key of an entry, optionally preceded by "export".
+var keyRe = regexp.MustCompile(`^(?:export\s+)?([A-Za-z_][A-Za-z0-9_.\-]*)\s*=\s*`)
+
+// Entry is a key-value pair from a dotenv file.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// Credential is the value of a dotenv entry whose key indicates a secret but
+// that isn't recognized by any detector, e.g. DB_PASSWORD=....
+type Credential struct {
+	Key   string
+	Value string
+	Class KeyClass
+}
+
+// IsDotenvFile returns true if the file at p is a dotenv file. Templates like
+// .env.example are excluded as they contain placeholders.
+func IsDotenvFile(p string) bool {
+	base := path.Base(filepath.ToSlash(p))
+	if base == ".envrc" || base == ".env" {
+		return true
+	}
+	if !strings.HasPrefix(base, ".env.") {
+		return false
+	}
+	for _, s := range templateSuffixes {
+		if strings.HasSuffix(base, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse returns the entries of the dotenv file read from r. Values can be
+// unquoted, single quoted (literal) or double quoted (with escape sequences),
+// and quoted values can span multiple lines. Lines that aren't assignments,
+// e.g. direnv commands, are ignored.
+func Parse(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1024*1024)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m := keyRe.FindStringSubmatchIndex(line)
+		if m == nil {
+			continue
+		}
+		key := line[m[2]:m[3]]
+		rest := line[m[1]:]
+		if rest == "" || (rest[0] != '"' && rest[0] != '\'') {
+			entries = append(entries, Entry{Key: key, Value: unquotedValue(rest)})
+			continue
+		}
+
+		// Quoted values continue until the closing quote, possibly on a later line.
+		quote := rest[0]
+		raw := rest[1:]
+		end := closingQuote(raw, quote)
+		for end < 0 && s.Scan() {
+			raw += "\n" + s.Text()
+			end = closingQuote(raw, quote)
+		}
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated quoted value for %s", key)
+		}
+		value := raw[:end]
+		if quote == '"' {
+			value = unescape(value)
+		}
+		entries = append(entries, Entry{Key: key, Value: value})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// unquotedValue returns an unquoted value without its trailing comment.
+func unquotedValue(s string) string {
+	for i := 1; i < len(s); i++ {
+		if s[i] == '#' && (s[i-1] == ' ' || s[i-1] == '\t') {
+			s = s[:i]
+			break
+		}
+	}
+	return strings.TrimSpace(s)
+}
+
+// closingQuote returns the index of the first unescaped quote in s, or -1.
+func closingQuote(s string, quote byte) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote == '"':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// unescape replaces the escape sequences supported in double quoted values.
+func unescape(s string) string {
+	return strings.NewReplacer(`\n`, "\n", `\r`, "\r", `\t`, "\t", `\"`, `"`, `\\`, `\`, `\$`, "$").Replace(s)
+}
+
+// Detect parses the dotenv file read from r and returns the secrets in it.
+// Each entry is passed to engine as "KEY=value" with quoting and escaping
+// removed, so detectors that rely on the key name as context still match.
+// Entries no detector recognizes are returned as a Credential if their key is
+// classified as a credential and their value isn't a placeholder.
+func Detect(ctx context.Context, engine *veles.DetectionEngine, r io.Reader) ([]veles.Secret, error) {
+	entries, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	var secrets []veles.Secret
+	for _, e := range entries {
+		found, err := engine.Detect(ctx, strings.NewReader(e.Key+"="+e.Value))
+		if err != nil {
+			return nil, err
+		}
+		if len(found) > 0 {
+			secrets = append(secrets, found...)
+			continue
+		}
+		if c := credential(e); c != nil {
+			secrets = append(secrets, *c)
+		}
+	}
+	return secrets, nil
+}
+
+// credential returns the untyped Credential for e, or nil if it doesn't hold
+// one.
+func credential(e Entry) *Credential {
+	class := Classify(e.Key)
+	if class == ClassNone || isPlaceholder(e.Value) {
+		return nil
+	}
+	if class == ClassConnectionString {
+		u, err := url.Parse(e.Value)
+		if err != nil || u.User == nil {
+			return nil
+		}
+		if p, ok := u.User.Password(); !ok || isPlaceholder(p) {
+			return nil
+		}
+	}
+	return &Credential{Key: e.Key, Value: e.Value, Class: class}
+}
+
+// isPlaceholder returns true if value refers to a secret stored elsewhere or
+// is an obvious placeholder.
+func isPlaceholder(value string) bool {
+	switch {
+	case value == "":
+		return true
+	case strings.HasPrefix(value, "$"):
+		// Variable reference or command substitution.
+		return true
+	case strings.HasPrefix(value, "<") && strings.HasSuffix(value, ">"):
+		return true
+	case strings.Trim(value, value[:1]) == "":
+		// A single repeated character, e.g. "xxxx" or "****".
+		return true
+	}
+	lower := strings.ToLower(value)
+	for _, p := range []string{"changeme", "change_me", "your_", "your-", "replace_me", "todo"} {
+		if strings.HasPrefix(lower, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emailprovider
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+const (
+	// maxContextLen bounds the variable names, separators and values matched
+	// by the detection regexes.
+	maxContextLen = 512
+	// maxSESPairLen is how far apart an SES SMTP user name, its password and
+	// the SMTP endpoint can be.
+	maxSESPairLen = 1 * veles.KiB
+	// sesPasswordVersion is the first byte of SES SMTP passwords, followed by
+	// a 32 byte HMAC-SHA256 signature. See
+	// https://docs.aws.amazon.com/ses/latest/dg/smtp-credentials.html.
+	sesPasswordVersion = 0x04
+	// sesPasswordLen is the length of a decoded SES SMTP password.
+	sesPasswordLen = 33
+	// maxMailchimpKeyLen is the length of a Mailchimp API key with a two digit
+	// data center.
+	maxMailchimpKeyLen = 37
+)
+
+var (
+	sesUsernameRe = regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`)
+	// SES SMTP passwords are 33 bytes in base64, which always start with "B"
+	// because of the version byte.
+	sesPasswordRe = regexp.MustCompile(`(?:^|[^A-Za-z0-9+/])(B[A-Za-z0-9+/]{43})(?:$|[^A-Za-z0-9+/=])`)
+	sesEndpointRe = regexp.MustCompile(`email-smtp(?:-fips)?\.([a-z]{2}(?:-gov)?-[a-z]+-[0-9])\.amazonaws\.com`)
+
+	// Postmark tokens are UUIDs, so they're only reported in the API header or
+	// assigned to a Postmark server token variable.
+	postmarkRe = regexp.MustCompile(`(?i:(x-postmark-server-token|postmark[a-z0-9_.\-]{0,30}(?:token|key)))["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\b`)
+
+	mailchimpRe = regexp.MustCompile(`\b[0-9a-f]{32}-us[0-9]{1,2}\b`)
+
+	// Mandrill keys have no distinctive format, newer ones are prefixed with
+	// "md-".
+	mandrillRe = regexp.MustCompile(`(?i:mandrill[a-z0-9_.\-]{0,30}key)["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?((?:md-)?[A-Za-z0-9_\-]{22})(?:$|[^A-Za-z0-9_\-])`)
+)
+
+// sesDetector finds SES SMTP user names and pairs them with the closest
+// password.
+type sesDetector struct{}
+
+// NewSESSMTPDetector returns a Detector that finds Amazon SES SMTP
+// credentials. Passwords are recognized by their derived format, so access
+// key IDs next to regular AWS secret keys aren't reported.
+func NewSESSMTPDetector() veles.Detector { return sesDetector{} }
+
+// MaxSecretLen returns the maximum distance between a user name, its
+// password and the SMTP endpoint.
+func (sesDetector) MaxSecretLen() uint32 { return maxSESPairLen }
+
+// Detect finds SES SMTP credentials in data.
+func (sesDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	usernames := sesUsernameRe.FindAllIndex(data, -1)
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+	var passwords [][]int
+	for _, m := range sesPasswordRe.FindAllSubmatchIndex(data, -1) {
+		if isSESPassword(data[m[2]:m[3]]) {
+			passwords = append(passwords, m[2:4])
+		}
+	}
+	endpoints := sesEndpointRe.FindAllSubmatchIndex(data, -1)
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, u := range usernames {
+		p := nearest(passwords, u[0])
+		if p == nil {
+			continue
+		}
+		c := SESSMTPCredentials{
+			Username: string(data[u[0]:u[1]]),
+			Password: string(data[p[0]:p[1]]),
+		}
+		if e := nearest(endpoints, u[0]); e != nil {
+			c.Region = string(data[e[2]:e[3]])
+		}
+		secrets = append(secrets, c)
+		positions = append(positions, min(u[0], p[0]))
+	}
+	return secrets, positions
+}
+
+// isSESPassword returns true if b is the base64 encoding of a versioned SES
+// SMTP password.
+func isSESPassword(b []byte) bool {
+	raw, err := base64.StdEncoding.DecodeString(string(b))
+	return err == nil && len(raw) == sesPasswordLen && raw[0] == sesPasswordVersion
+}
+
+// nearest returns the match closest to pos within maxSESPairLen, or nil if
+// there is none.
+func nearest(matches [][]int, pos int) []int {
+	var best []int
+	bestDist := maxSESPairLen + 1
+	for _, m := range matches {
+		dist := m[0] - pos
+		if dist < 0 {
+			dist = -dist
+		}
+		if dist < bestDist {
+			best, bestDist = m, dist
+		}
+	}
+	return best
+}
+
+// postmarkDetector finds Postmark server tokens.
+type postmarkDetector struct{}
+
+// NewPostmarkDetector returns a Detector that finds Postmark server tokens.
+func NewPostmarkDetector() veles.Detector { return postmarkDetector{} }
+
+// MaxSecretLen returns the maximum length of a Postmark token with its
+// context.
+func (postmarkDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds Postmark server tokens in data. Account tokens, which manage
+// servers and domains but can't send email, are skipped.
+func (postmarkDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range postmarkRe.FindAllSubmatchIndex(data, -1) {
+		if strings.Contains(strings.ToLower(string(data[m[2]:m[3]])), "account") {
+			continue
+		}
+		secrets = append(secrets, PostmarkServerToken{Token: string(data[m[4]:m[5]])})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// NewMailchimpDetector returns a Detector that finds Mailchimp Marketing API
+// keys.
+func NewMailchimpDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxMailchimpKeyLen,
+		Re:     mailchimpRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return MailchimpAPIKey{Key: string(b)}
+		},
+	}
+}
+
+// NewMandrillDetector returns a Detector that finds Mandrill API keys
+// assigned to Mandrill key variables.
+func NewMandrillDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxContextLen,
+		Re:     mandrillRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return MandrillAPIKey{Key: string(b)}
+		},
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package emailprovider contains Veles Secret types, Detectors and
+// Validators for credentials of transactional and marketing email
+// providers: Amazon SES SMTP credentials, Postmark server tokens and
+// Mailchimp and Mandrill (Mailchimp Transactional) API keys.
+//
+// Leaked email credentials are abused to send phishing and spam from the
+// owner's verified domains, which passes SPF and DKIM checks.
+package emailprovider
+
+// SESSMTPCredentials is an Amazon SES SMTP user name and password. The user
+// name is the access key ID of an IAM user and the password is derived from
+// its secret access key for a specific region.
+type SESSMTPCredentials struct {
+	Username string
+	Password string
+	// Region of the SES SMTP endpoint found next to the credentials, e.g.
+	// "eu-west-1". Empty if no endpoint was found.
+	Region string
+}
+
+// PostmarkServerToken is a Postmark server API token, which allows sending
+// email from the server's sender signatures.
+type PostmarkServerToken struct {
+	Token string
+}
+
+// MailchimpAPIKey is a Mailchimp Marketing API key. The key ends with the
+// data center of the account, e.g. "-us21".
+type MailchimpAPIKey struct {
+	Key string
+}
+
+// MandrillAPIKey is a Mandrill (Mailchimp Transactional) API key.
+type MandrillAPIKey struct {
+	Key string
+}
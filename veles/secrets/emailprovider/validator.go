@@ -0,0 +1,217 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emailprovider
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+const (
+	// DefaultPostmarkEndpoint returns the server a token belongs to without
+	// side effects.
+	DefaultPostmarkEndpoint = "https://api.postmarkapp.com/server"
+	// DefaultMailchimpEndpoint is the health check of the Marketing API.
+	// "{dc}" is replaced with the data center of the key.
+	DefaultMailchimpEndpoint = "https://{dc}.api.mailchimp.com/3.0/ping"
+	// DefaultMandrillEndpoint is the ping call of the Mandrill API.
+	DefaultMandrillEndpoint = "https://mandrillapp.com/api/1.0/users/ping2"
+
+	// defaultSESRegion is used to validate SES SMTP credentials found without
+	// an SMTP endpoint.
+	defaultSESRegion = "us-east-1"
+	// defaultTimeout is used if no HTTP client is configured and for SMTP
+	// connections.
+	defaultTimeout = 10 * time.Second
+	// smtpAuthFailed is the SMTP reply code for rejected credentials.
+	smtpAuthFailed = 535
+	// maxResponseBytes is the maximum number of bytes read from a response.
+	maxResponseBytes = 64 * 1024
+)
+
+// sesSMTPValidator logs in to the SES SMTP endpoint without sending email.
+type sesSMTPValidator struct {
+	addr    string
+	timeout time.Duration
+}
+
+// NewSESSMTPValidator returns a Validator for SES SMTP credentials that logs
+// in to the SMTP server at addr (host:port). If addr is empty, the endpoint
+// of the credentials' region (or us-east-1 if unknown) is used.
+//
+// SES SMTP passwords are only valid in the region they were derived for, so
+// credentials without a region that are rejected in us-east-1 are reported
+// as ValidationFailed rather than ValidationInvalid.
+func NewSESSMTPValidator(addr string) veles.Validator[SESSMTPCredentials] {
+	return &sesSMTPValidator{addr: addr, timeout: defaultTimeout}
+}
+
+// Validate checks whether c can authenticate to SES.
+func (v *sesSMTPValidator) Validate(ctx context.Context, c SESSMTPCredentials) (veles.ValidationStatus, error) {
+	addr := v.addr
+	if addr == "" {
+		region := c.Region
+		if region == "" {
+			region = defaultSESRegion
+		}
+		addr = fmt.Sprintf("email-smtp.%s.amazonaws.com:587", region)
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("invalid SMTP address %q: %w", addr, err)
+	}
+
+	dialer := &net.Dialer{Timeout: v.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+	defer conn.Close()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(v.timeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return veles.ValidationFailed, fmt.Errorf("conn.SetDeadline(): %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("smtp.NewClient(%s): %w", addr, err)
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return veles.ValidationFailed, fmt.Errorf("STARTTLS failed: %w", err)
+		}
+	}
+
+	err = client.Auth(smtp.PlainAuth("", c.Username, c.Password, host))
+	if err == nil {
+		_ = client.Quit()
+		return veles.ValidationValid, nil
+	}
+	var tpErr *textproto.Error
+	if !errors.As(err, &tpErr) || tpErr.Code != smtpAuthFailed {
+		return veles.ValidationFailed, fmt.Errorf("SMTP AUTH failed: %w", err)
+	}
+	if v.addr == "" && c.Region == "" {
+		return veles.ValidationFailed, fmt.Errorf("credentials rejected by %s, they might belong to another region", addr)
+	}
+	return veles.ValidationInvalid, nil
+}
+
+// NewPostmarkValidator returns a Validator for Postmark server tokens that
+// sends requests to endpoint using the given client (nil for a default
+// client).
+func NewPostmarkValidator(endpoint string, client *http.Client) veles.Validator[PostmarkServerToken] {
+	return &simplevalidate.Validator[PostmarkServerToken]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(t PostmarkServerToken) map[string]string {
+			return map[string]string{
+				"Accept":                  "application/json",
+				"X-Postmark-Server-Token": t.Token,
+			}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
+
+// NewMailchimpValidator returns a Validator for Mailchimp API keys that sends
+// requests to endpoint, in which "{dc}" is replaced with the key's data
+// center, using the given client (nil for a default client).
+func NewMailchimpValidator(endpoint string, client *http.Client) veles.Validator[MailchimpAPIKey] {
+	return &simplevalidate.Validator[MailchimpAPIKey]{
+		EndpointFunc: func(k MailchimpAPIKey) (string, error) {
+			i := strings.LastIndex(k.Key, "-")
+			if i < 0 {
+				return "", fmt.Errorf("key without data center")
+			}
+			return strings.ReplaceAll(endpoint, "{dc}", k.Key[i+1:]), nil
+		},
+		HTTPHeaders: func(k MailchimpAPIKey) map[string]string {
+			// Any user name is accepted.
+			return map[string]string{"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte("scalibr:"+k.Key))}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
+
+// mandrillError is the body of Mandrill API error responses.
+type mandrillError struct {
+	Name string `json:"name"`
+}
+
+// mandrillValidator calls the ping method of the Mandrill API.
+type mandrillValidator struct {
+	endpoint string
+	httpc    *http.Client
+}
+
+// NewMandrillValidator returns a Validator for Mandrill API keys that sends
+// requests to endpoint using the given client (nil for a default client).
+func NewMandrillValidator(endpoint string, client *http.Client) veles.Validator[MandrillAPIKey] {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &mandrillValidator{endpoint: endpoint, httpc: client}
+}
+
+// Validate checks whether k is a valid Mandrill API key. The Mandrill API
+// reports all errors, including invalid keys, with HTTP status 500, so the
+// error name in the body is checked.
+func (v *mandrillValidator) Validate(ctx context.Context, k MandrillAPIKey) (veles.ValidationStatus, error) {
+	body, err := json.Marshal(map[string]string{"key": k.Key})
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("json.Marshal(): %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint, strings.NewReader(string(body)))
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("http.NewRequestWithContext(POST): %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := v.httpc.Do(req)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("HTTP POST failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return veles.ValidationValid, nil
+	}
+	var e mandrillError
+	if err := json.NewDecoder(io.LimitReader(res.Body, maxResponseBytes)).Decode(&e); err == nil && e.Name == "Invalid_Key" {
+		return veles.ValidationInvalid, nil
+	}
+	return veles.ValidationFailed, fmt.Errorf("unexpected HTTP status %d with error %q", res.StatusCode, e.Name)
+}
@@ -0,0 +1,248 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emailprovider_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/emailprovider"
+)
+
+// startSMTPServer starts an SMTP server that accepts AUTH PLAIN with the
+// given credentials and returns its address.
+func startSMTPServer(t *testing.T, username, password string) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(): %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	want := base64.StdEncoding.EncodeToString([]byte("\x00" + username + "\x00" + password))
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				fmt.Fprint(conn, "220 localhost ESMTP\r\n")
+				s := bufio.NewScanner(conn)
+				for s.Scan() {
+					cmd := s.Text()
+					switch {
+					case strings.HasPrefix(cmd, "EHLO"):
+						fmt.Fprint(conn, "250-localhost\r\n250 AUTH PLAIN LOGIN\r\n")
+					case cmd == "AUTH PLAIN "+want:
+						fmt.Fprint(conn, "235 Authentication successful.\r\n")
+					case strings.HasPrefix(cmd, "AUTH"):
+						fmt.Fprint(conn, "535 Authentication Credentials Invalid\r\n")
+					case cmd == "QUIT":
+						fmt.Fprint(conn, "221 Bye\r\n")
+						return
+					default:
+						fmt.Fprint(conn, "501 Syntax error\r\n")
+					}
+				}
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestSESSMTPValidator(t *testing.T) {
+	addr := startSMTPServer(t, sesUsername, sesPassword)
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen(): %v", err)
+	}
+	closedAddr := closed.Addr().String()
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		addr    string
+		creds   emailprovider.SESSMTPCredentials
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			addr:  addr,
+			creds: emailprovider.SESSMTPCredentials{Username: sesUsername, Password: sesPassword},
+			want:  veles.ValidationValid,
+		},
+		{
+			name:  "invalid",
+			addr:  addr,
+			creds: emailprovider.SESSMTPCredentials{Username: sesUsername, Password: awsSecretKey},
+			want:  veles.ValidationInvalid,
+		},
+		{
+			name:    "connection refused",
+			addr:    closedAddr,
+			creds:   emailprovider.SESSMTPCredentials{Username: sesUsername, Password: sesPassword},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := emailprovider.NewSESSMTPValidator(tt.addr)
+			got, err := v.Validate(context.Background(), tt.creds)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostmarkValidator(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("X-Postmark-Server-Token") {
+		case postmarkToken:
+			w.WriteHeader(http.StatusOK)
+		case "":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		token   string
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{name: "valid", token: postmarkToken, want: veles.ValidationValid},
+		{name: "invalid", token: "00000000-0000-0000-0000-000000000000", want: veles.ValidationInvalid},
+		{name: "server error", token: "", want: veles.ValidationFailed, wantErr: true},
+	}
+
+	v := emailprovider.NewPostmarkValidator(s.URL, s.Client())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Validate(context.Background(), emailprovider.PostmarkServerToken{Token: tt.token})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMailchimpValidator(t *testing.T) {
+	wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte("scalibr:"+mailchimpKey))
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path != "/us21/3.0/ping":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Header.Get("Authorization") == wantAuth:
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		key     string
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{name: "valid", key: mailchimpKey, want: veles.ValidationValid},
+		{name: "invalid", key: "fedcba9876543210fedcba9876543210-us21", want: veles.ValidationInvalid},
+		{name: "other data center", key: "0123456789abcdef0123456789abcdef-us6", want: veles.ValidationFailed, wantErr: true},
+	}
+
+	v := emailprovider.NewMailchimpValidator(s.URL+"/{dc}/3.0/ping", s.Client())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Validate(context.Background(), emailprovider.MailchimpAPIKey{Key: tt.key})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMandrillValidator(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Key string `json:"key"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch req.Key {
+		case mandrillKey:
+			fmt.Fprint(w, `{"PING":"PONG!"}`)
+		case mandrillOld:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"status":"error","code":-99,"name":"GeneralError","message":"Oops"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"status":"error","code":-1,"name":"Invalid_Key","message":"Invalid API key"}`)
+		}
+	}))
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		key     string
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{name: "valid", key: mandrillKey, want: veles.ValidationValid},
+		{name: "invalid", key: "md-0000000000000000000000", want: veles.ValidationInvalid},
+		{name: "server error", key: mandrillOld, want: veles.ValidationFailed, wantErr: true},
+	}
+
+	v := emailprovider.NewMandrillValidator(s.URL, s.Client())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Validate(context.Background(), emailprovider.MandrillAPIKey{Key: tt.key})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+const (
+	// maxPairDistance is the maximum number of bytes between an access ID and
+	// its secret, enough for the two lines of a .boto file or a CSV export.
+	maxPairDistance = 500
+	// maxPairLen bounds an access ID, the secret and the bytes between them.
+	maxPairLen = maxPairDistance + 61 + 40
+	// maxURLLen is an upper bound for a signed URL.
+	maxURLLen = 4096
+	// v4TimeFormat is the format of X-Goog-Date.
+	v4TimeFormat = "20060102T150405Z"
+)
+
+var (
+	// Access IDs are "GOOG" followed by 20 (user account) or 57 (service
+	// account) upper case alphanumeric characters.
+	accessIDRe = regexp.MustCompile(`\bGOOG[0-9A-Z]{20}(?:[0-9A-Z]{37})?\b`)
+	// Secrets are 40 base64 characters.
+	hmacSecretRe = regexp.MustCompile(`(?:^|[^A-Za-z0-9+/])([A-Za-z0-9+/]{40})(?:$|[^A-Za-z0-9+/=])`)
+	hasLowerRe   = regexp.MustCompile(`[a-z]`)
+	hasUpperRe   = regexp.MustCompile(`[A-Z]`)
+	signedURLRe  = regexp.MustCompile(`https://(?:[a-z0-9._\-]{1,222}\.)?storage\.(?:googleapis|cloud\.google)\.com/[^\s"'<>\\]+`)
+)
+
+// hmacKeyDetector finds HMAC access IDs and pairs them with the closest
+// secret.
+type hmacKeyDetector struct{}
+
+// NewHMACKeyDetector returns a Detector that finds Cloud Storage HMAC keys.
+// Access IDs without a secret nearby aren't reported.
+func NewHMACKeyDetector() veles.Detector { return hmacKeyDetector{} }
+
+// MaxSecretLen returns the maximum length of an access ID and secret pair.
+func (hmacKeyDetector) MaxSecretLen() uint32 { return maxPairLen }
+
+// Detect finds HMAC keys in data.
+func (hmacKeyDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	ids := accessIDRe.FindAllIndex(data, -1)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var candidates [][]int
+	for _, m := range hmacSecretRe.FindAllSubmatchIndex(data, -1) {
+		s := data[m[2]:m[3]]
+		// Hex encoded hashes (e.g. git commit IDs) have the same length, real
+		// secrets always mix upper and lower case letters.
+		if hasLowerRe.Match(s) && hasUpperRe.Match(s) {
+			candidates = append(candidates, m[2:4])
+		}
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, id := range ids {
+		best, bestDistance := -1, maxPairDistance+1
+		for i, c := range candidates {
+			distance := c[0] - id[1]
+			if c[1] <= id[0] {
+				distance = id[0] - c[1]
+			}
+			if distance >= 0 && distance < bestDistance {
+				best, bestDistance = i, distance
+			}
+		}
+		if best < 0 {
+			continue
+		}
+		c := candidates[best]
+		secrets = append(secrets, HMACKey{
+			AccessID: string(data[id[0]:id[1]]),
+			Secret:   string(data[c[0]:c[1]]),
+		})
+		positions = append(positions, min(id[0], c[0]))
+	}
+	return secrets, positions
+}
+
+// NewSignedURLDetector returns a Detector that finds Cloud Storage signed
+// URLs.
+func NewSignedURLDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen:    maxURLLen,
+		Re:        signedURLRe,
+		FromMatch: signedURLFromMatch,
+	}
+}
+
+func signedURLFromMatch(b []byte) veles.Secret {
+	// Signed URLs in HTML and XML documents have their query escaped.
+	raw := strings.ReplaceAll(string(b), "&amp;", "&")
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil
+	}
+	q := u.Query()
+	switch {
+	case q.Get("Signature") != "" && q.Get("GoogleAccessId") != "":
+		expires, err := strconv.ParseInt(q.Get("Expires"), 10, 64)
+		if err != nil {
+			return nil
+		}
+		return SignedURL{URL: raw, Credential: q.Get("GoogleAccessId"), Expires: time.Unix(expires, 0).UTC()}
+	case q.Get("X-Goog-Signature") != "" && q.Get("X-Goog-Credential") != "":
+		date, err := time.Parse(v4TimeFormat, q.Get("X-Goog-Date"))
+		if err != nil {
+			return nil
+		}
+		seconds, err := strconv.ParseInt(q.Get("X-Goog-Expires"), 10, 64)
+		if err != nil {
+			return nil
+		}
+		credential, _, _ := strings.Cut(q.Get("X-Goog-Credential"), "/")
+		return SignedURL{URL: raw, Credential: credential, Expires: date.Add(time.Duration(seconds) * time.Second)}
+	}
+	return nil
+}
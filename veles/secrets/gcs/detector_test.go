@@ -0,0 +1,142 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/gcs"
+)
+
+const (
+	serviceAccountID = "GOOG1EXZ5F3V7NQ4OPVRWUQ7V3SAZHGMXZ5I7K2WD4MVUPNMLRVEQXHTT7ZTA"
+	userAccountID    = "GOOGTS7C7FUP3AIRVJTE2BCD"
+	hmacSecret       = "bGoa+V7g/yqDXvKRqq+JTFn4uQZbPiQJo4pf9RzJ"
+	otherSecret      = "Xq1/9mYbRkHvP2sLc8dTnW0aZ+eUj4fGiK7oB3hV"
+	v2URL            = "https://storage.googleapis.com/acme-backups/db.sql.gz?GoogleAccessId=backup%40acme.iam.gserviceaccount.com&Expires=4102444800&Signature=cGFyc2VkIGJ1dCBub3QgY2hlY2tlZA%3D%3D"
+	v4URL            = "https://acme-backups.storage.googleapis.com/db.sql.gz?X-Goog-Algorithm=GOOG4-RSA-SHA256&X-Goog-Credential=backup%40acme.iam.gserviceaccount.com%2F20240301%2Fauto%2Fstorage%2Fgoog4_request&X-Goog-Date=20240301T120000Z&X-Goog-Expires=604800&X-Goog-SignedHeaders=host&X-Goog-Signature=0123abcd"
+)
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{
+		gcs.NewHMACKeyDetector(),
+		gcs.NewSignedURLDetector(),
+	})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "boto config",
+			input: "[Credentials]\ngs_access_key_id = " + serviceAccountID + "\ngs_secret_access_key = " + hmacSecret + "\n",
+			want: []veles.Secret{
+				gcs.HMACKey{AccessID: serviceAccountID, Secret: hmacSecret},
+			},
+		},
+		{
+			name:  "secret before user account access ID",
+			input: "export SECRET=" + hmacSecret + "\nexport ACCESS_ID=" + userAccountID + "\n",
+			want: []veles.Secret{
+				gcs.HMACKey{AccessID: userAccountID, Secret: hmacSecret},
+			},
+		},
+		{
+			name:  "closest secret is used",
+			input: otherSecret + "\n\n\n\n" + userAccountID + "," + hmacSecret,
+			want: []veles.Secret{
+				gcs.HMACKey{AccessID: userAccountID, Secret: hmacSecret},
+			},
+		},
+		{
+			name:  "access ID without secret",
+			input: "gs_access_key_id = " + serviceAccountID + "\n",
+			want:  nil,
+		},
+		{
+			name:  "secret too far away",
+			input: userAccountID + strings.Repeat(" ", 600) + hmacSecret,
+			want:  nil,
+		},
+		{
+			name:  "hex hash is not a secret",
+			input: userAccountID + " da39a3ee5e6b4b0d3255bfef95601890afd80709",
+			want:  nil,
+		},
+		{
+			name:  "access ID with wrong length",
+			input: "GOOG1EXZ5F3V7NQ4OPVRWUQ7V3SAZ " + hmacSecret,
+			want:  nil,
+		},
+		{
+			name:  "V2 signed URL",
+			input: `curl -o db.sql.gz "` + v2URL + `"`,
+			want: []veles.Secret{gcs.SignedURL{
+				URL:        v2URL,
+				Credential: "backup@acme.iam.gserviceaccount.com",
+				Expires:    time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name:  "V4 signed URL",
+			input: "wget '" + v4URL + "'",
+			want: []veles.Secret{gcs.SignedURL{
+				URL:        v4URL,
+				Credential: "backup@acme.iam.gserviceaccount.com",
+				Expires:    time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name:  "HTML escaped signed URL",
+			input: `<a href="` + strings.ReplaceAll(v2URL, "&", "&amp;") + `">download</a>`,
+			want: []veles.Secret{gcs.SignedURL{
+				URL:        v2URL,
+				Credential: "backup@acme.iam.gserviceaccount.com",
+				Expires:    time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			}},
+		},
+		{
+			name:  "unsigned URL",
+			input: "https://storage.googleapis.com/acme-public/logo.png",
+			want:  nil,
+		},
+		{
+			name:  "signed URL of another service",
+			input: "https://example.com/db.sql.gz?GoogleAccessId=a&Expires=4102444800&Signature=abc",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
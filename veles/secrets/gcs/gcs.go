@@ -0,0 +1,41 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gcs contains Veles Secret types, Detectors and Validators for
+// Google Cloud Storage credentials that work outside of IAM: HMAC keys used
+// through the XML (interoperability) API and signed URLs.
+package gcs
+
+import "time"
+
+// HMACKey is a Cloud Storage HMAC key. Keys with a 61 character access ID
+// belong to a service account, 24 character ones are legacy interoperability
+// keys of a user account. HMAC keys don't show up in IAM policies and are
+// rarely rotated.
+type HMACKey struct {
+	AccessID string
+	Secret   string
+}
+
+// SignedURL is a Cloud Storage URL that grants access to an object to anyone
+// holding it until it expires.
+type SignedURL struct {
+	URL string
+	// Credential is the service account email (V2 signatures) or access ID
+	// (V4 signatures) the URL was signed with.
+	Credential string
+	// Expires is when the URL stops being valid. V2 signed URLs can expire
+	// arbitrarily far in the future, V4 ones after at most 7 days.
+	Expires time.Time
+}
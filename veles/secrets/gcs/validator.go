@@ -0,0 +1,200 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// DefaultEndpoint is the Cloud Storage XML API.
+	DefaultEndpoint = "https://storage.googleapis.com"
+
+	// defaultTimeout is used if no HTTP client is configured.
+	defaultTimeout = 10 * time.Second
+	// maxErrorBytes is the maximum number of bytes read from an error response.
+	maxErrorBytes = 64 * 1024
+	// unsignedPayload is the payload hash of requests without a body.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+)
+
+// xmlError is the body of XML API error responses.
+type xmlError struct {
+	Code string `xml:"Code"`
+}
+
+// hmacKeyValidator lists the buckets of the key's project with a request
+// signed by the key.
+type hmacKeyValidator struct {
+	endpoint string
+	httpc    *http.Client
+	now      func() time.Time
+}
+
+// NewHMACKeyValidator returns a Validator for HMACKeys that sends requests to
+// the XML API at endpoint using the given client (nil for a default client).
+// Keys that are valid but lack permission to list buckets are reported as
+// valid.
+func NewHMACKeyValidator(endpoint string, client *http.Client) veles.Validator[HMACKey] {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &hmacKeyValidator{endpoint: endpoint, httpc: client, now: time.Now}
+}
+
+// Validate checks whether k can authenticate against the XML API.
+func (v *hmacKeyValidator) Validate(ctx context.Context, k HMACKey) (veles.ValidationStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(v.endpoint, "/")+"/", nil)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("http.NewRequestWithContext(GET): %w", err)
+	}
+	signV4(req, k, v.now().UTC())
+	res, err := v.httpc.Do(req)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("HTTP GET failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return veles.ValidationValid, nil
+	}
+	code := errorCode(res.Body)
+	switch {
+	case res.StatusCode == http.StatusForbidden && (code == "InvalidAccessKeyId" || code == "SignatureDoesNotMatch"):
+		return veles.ValidationInvalid, nil
+	case res.StatusCode == http.StatusForbidden && code == "AccessDenied":
+		// The request was authenticated, the key just isn't allowed to list buckets.
+		return veles.ValidationValid, nil
+	default:
+		return veles.ValidationFailed, fmt.Errorf("unexpected HTTP status %d with error code %q", res.StatusCode, code)
+	}
+}
+
+// signV4 adds a V4 signature using k to the headers of req, which mustn't
+// have a body. See
+// https://cloud.google.com/storage/docs/authentication/signatures.
+func signV4(req *http.Request, k HMACKey, now time.Time) {
+	date := now.Format("20060102")
+	timestamp := now.Format(v4TimeFormat)
+	scope := date + "/auto/storage/goog4_request"
+	req.Header.Set("X-Goog-Date", timestamp)
+	req.Header.Set("X-Goog-Content-Sha256", unsignedPayload)
+
+	const signedHeaders = "host;x-goog-content-sha256;x-goog-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host,
+		"x-goog-content-sha256:" + unsignedPayload,
+		"x-goog-date:" + timestamp,
+		"",
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"GOOG4-HMAC-SHA256",
+		timestamp,
+		scope,
+		hex.EncodeToString(requestHash[:]),
+	}, "\n")
+
+	key := []byte("GOOG4" + k.Secret)
+	for _, s := range []string{date, "auto", "storage", "goog4_request"} {
+		key = hmacSHA256(key, s)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("GOOG4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", k.AccessID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// errorCode returns the error code of an XML API error response, or an
+// empty string if it can't be parsed.
+func errorCode(r io.Reader) string {
+	var e xmlError
+	if err := xml.NewDecoder(io.LimitReader(r, maxErrorBytes)).Decode(&e); err != nil {
+		return ""
+	}
+	return e.Code
+}
+
+// signedURLValidator fetches the first byte of the object a signed URL points
+// to.
+type signedURLValidator struct {
+	endpoint string
+	httpc    *http.Client
+}
+
+// NewSignedURLValidator returns a Validator for SignedURLs using the given
+// client (nil for a default client). The request is always sent to endpoint
+// instead of the host in the URL, which comes from untrusted scanned data;
+// the original host is kept in the Host header as it's part of the
+// signature. Only URLs signed for GET requests can be validated, others are
+// reported as invalid.
+func NewSignedURLValidator(endpoint string, client *http.Client) veles.Validator[SignedURL] {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &signedURLValidator{endpoint: endpoint, httpc: client}
+}
+
+// Validate checks whether u still grants access to its object.
+func (v *signedURLValidator) Validate(ctx context.Context, u SignedURL) (veles.ValidationStatus, error) {
+	parsed, err := url.Parse(u.URL)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("url.Parse(): %w", err)
+	}
+	target := strings.TrimSuffix(v.endpoint, "/") + parsed.EscapedPath() + "?" + parsed.RawQuery
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("http.NewRequestWithContext(GET): %w", err)
+	}
+	req.Host = parsed.Host
+	req.Header.Set("Range", "bytes=0-0")
+	res, err := v.httpc.Do(req)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("HTTP GET failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable:
+		return veles.ValidationValid, nil
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+		// Expired URLs are rejected with 400 and ExpiredToken, revoked or
+		// tampered ones with 403.
+		return veles.ValidationInvalid, nil
+	default:
+		return veles.ValidationFailed, fmt.Errorf("unexpected HTTP status: %d", res.StatusCode)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/gcs"
+)
+
+func xmlErrorResponse(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<?xml version='1.0' encoding='UTF-8'?><Error><Code>%s</Code><Message>...</Message></Error>", code)
+}
+
+func TestHMACKeyValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     gcs.HMACKey
+		status  int
+		code    string
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			key:    gcs.HMACKey{AccessID: serviceAccountID, Secret: hmacSecret},
+			status: http.StatusOK,
+			want:   veles.ValidationValid,
+		},
+		{
+			name:   "valid without permission to list buckets",
+			key:    gcs.HMACKey{AccessID: serviceAccountID, Secret: hmacSecret},
+			status: http.StatusForbidden,
+			code:   "AccessDenied",
+			want:   veles.ValidationValid,
+		},
+		{
+			name:   "unknown access ID",
+			key:    gcs.HMACKey{AccessID: userAccountID, Secret: hmacSecret},
+			status: http.StatusForbidden,
+			code:   "InvalidAccessKeyId",
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:   "wrong secret",
+			key:    gcs.HMACKey{AccessID: serviceAccountID, Secret: otherSecret},
+			status: http.StatusForbidden,
+			code:   "SignatureDoesNotMatch",
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "server error",
+			key:     gcs.HMACKey{AccessID: serviceAccountID, Secret: hmacSecret},
+			status:  http.StatusInternalServerError,
+			code:    "InternalError",
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				auth := r.Header.Get("Authorization")
+				if !strings.HasPrefix(auth, "GOOG4-HMAC-SHA256 Credential="+tt.key.AccessID+"/") || !strings.Contains(auth, "Signature=") {
+					t.Errorf("Authorization header = %q, want V4 signature for %s", auth, tt.key.AccessID)
+				}
+				if r.Header.Get("X-Goog-Date") == "" {
+					t.Errorf("X-Goog-Date header not set")
+				}
+				if tt.status == http.StatusOK {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				xmlErrorResponse(w, tt.status, tt.code)
+			}))
+			defer s.Close()
+
+			v := gcs.NewHMACKeyValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignedURLValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			status: http.StatusPartialContent,
+			want:   veles.ValidationValid,
+		},
+		{
+			name:   "expired",
+			status: http.StatusBadRequest,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:   "revoked",
+			status: http.StatusForbidden,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Host != "acme-backups.storage.googleapis.com" {
+					t.Errorf("Host = %q, want the host of the signed URL", r.Host)
+				}
+				if r.URL.Path != "/db.sql.gz" || r.URL.Query().Get("X-Goog-Signature") != "0123abcd" {
+					t.Errorf("request URL = %q, want the path and query of the signed URL", r.URL)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer s.Close()
+
+			v := gcs.NewSignedURLValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), gcs.SignedURL{URL: v4URL})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
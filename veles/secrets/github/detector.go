@@ -0,0 +1,80 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"hash/crc32"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+// maxTokenLen is the length of a fine-grained token including its prefix,
+// plus the character that terminates it.
+const maxTokenLen = 94
+
+// fineGrainedPATRe matches the fixed structure of fine-grained tokens: a 22
+// character ID and a 59 character secret that ends in a checksum. A token
+// must not be followed by further token characters.
+var fineGrainedPATRe = regexp.MustCompile(`(github_pat_[0-9A-Za-z]{22}_[0-9A-Za-z]{59})(?:[^0-9A-Za-z_]|$)`)
+
+const (
+	fineGrainedPATPrefix = "github_pat_"
+	// checksumLen is the length of the base62 encoded CRC32 checksum at the
+	// end of GitHub tokens.
+	checksumLen = 6
+	base62      = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// NewFineGrainedPATDetector returns a Detector that finds GitHub fine-grained
+// personal access tokens. Matches whose checksum doesn't match are
+// discarded.
+func NewFineGrainedPATDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxTokenLen,
+		Re:     fineGrainedPATRe,
+		FromMatch: func(b []byte) veles.Secret {
+			token := string(b)
+			if !validChecksum(token) {
+				return nil
+			}
+			return FineGrainedPAT{Token: token}
+		},
+	}
+}
+
+// validChecksum returns true if the last 6 characters of token are the
+// CRC32 checksum of the token body between the prefix and the checksum,
+// base62 encoded and left-padded with zeros like in classic tokens.
+func validChecksum(token string) bool {
+	body := strings.TrimPrefix(token, fineGrainedPATPrefix)
+	if len(body) <= checksumLen {
+		return false
+	}
+	payload, checksum := body[:len(body)-checksumLen], body[len(body)-checksumLen:]
+	return encodeBase62(crc32.ChecksumIEEE([]byte(payload)), checksumLen) == checksum
+}
+
+// encodeBase62 encodes n in base62, left-padded with zeros to width.
+func encodeBase62(n uint32, width int) string {
+	b := []byte(strings.Repeat("0", width))
+	for i := width - 1; i >= 0 && n > 0; i-- {
+		b[i] = base62[n%62]
+		n /= 62
+	}
+	return string(b)
+}
@@ -12,18 +12,12 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build !windows
+// Package github contains a Veles Secret type, Detector and Validator for
+// GitHub fine-grained personal access tokens.
+package github
 
-package regosversion
-
-import (
-	"context"
-	"testing"
-)
-
-func TestScan(t *testing.T) {
-	e := Extractor{}
-	if _, err := e.Extract(context.Background(), nil); err == nil {
-		t.Errorf("Extract() returned nil error, should not be supported on non-Windows")
-	}
+// FineGrainedPAT is a GitHub fine-grained personal access token (prefix
+// "github_pat_").
+type FineGrainedPAT struct {
+	Token string
 }
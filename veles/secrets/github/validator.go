@@ -0,0 +1,47 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"net/http"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// DefaultEndpoint returns the user a token belongs to on github.com. Any
+// valid fine-grained token can read it, regardless of its permissions. GitHub
+// Enterprise Server instances can be validated by setting their
+// https://<host>/api/v3/user endpoint instead.
+const DefaultEndpoint = "https://api.github.com/user"
+
+// NewFineGrainedPATValidator returns a Validator for FineGrainedPATs that
+// sends requests to endpoint using the given client (nil for a default
+// client).
+func NewFineGrainedPATValidator(endpoint string, client *http.Client) veles.Validator[FineGrainedPAT] {
+	return &simplevalidate.Validator[FineGrainedPAT]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(t FineGrainedPAT) map[string]string {
+			return map[string]string{
+				"Authorization":        "Bearer " + t.Token,
+				"Accept":               "application/vnd.github+json",
+				"X-GitHub-Api-Version": "2022-11-28",
+			}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
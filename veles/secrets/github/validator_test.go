@@ -0,0 +1,80 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/github"
+)
+
+func TestFineGrainedPATValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			token: token,
+			want:  veles.ValidationValid,
+		},
+		{
+			name:   "revoked",
+			token:  "github_pat_revoked",
+			status: http.StatusUnauthorized,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "rate limited",
+			token:   "github_pat_other",
+			status:  http.StatusForbidden,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/user" || r.Header.Get("Accept") != "application/vnd.github+json" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if r.Header.Get("Authorization") == "Bearer "+token {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer s.Close()
+
+			v := github.NewFineGrainedPATValidator(s.URL+"/user", s.Client())
+			got, err := v.Validate(context.Background(), github.FineGrainedPAT{Token: tt.token})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
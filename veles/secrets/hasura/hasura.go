@@ -0,0 +1,57 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hasura contains a Veles Secret type and Detector for Hasura GraphQL
+// Engine admin secrets.
+package hasura
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+// maxContextLen bounds the key name, separator and secret matched by the
+// detection regex.
+const maxContextLen = 400
+
+// Admin secrets are arbitrary strings, so they're only reported when assigned
+// to a Hasura admin secret key. This covers env files
+// (HASURA_GRAPHQL_ADMIN_SECRET=...), HTTP headers (x-hasura-admin-secret: ...),
+// Helm values (hasuraAdminSecret: ...) and Kubernetes env entries where the
+// value follows on the next line (- name: HASURA_GRAPHQL_ADMIN_SECRET
+// value: ...). Template placeholders such as ${VAR} or {{ .Values.x }} aren't
+// matched.
+var secretRe = regexp.MustCompile(`(?i:hasura[a-z0-9_\-]{0,20}admin[_\-]?secret)["']?(?:\s{0,5}[:=]|\s{0,40}value:)\s{0,5}["']?([^\s"'$\{\}<>]{8,256})`)
+
+// AdminSecret is a Hasura GraphQL Engine admin secret. It grants full access to
+// the GraphQL API, bypassing all permission rules.
+//
+// Hasura is self-hosted or runs on a per-project domain, so there's no
+// Validator.
+type AdminSecret struct {
+	Secret string
+}
+
+// NewDetector returns a Detector that finds Hasura admin secrets.
+func NewDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxContextLen,
+		Re:     secretRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return AdminSecret{Secret: string(b)}
+		},
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hasura_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/hasura"
+)
+
+const secret = "myAdm1nSecretKey!"
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{hasura.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "env file",
+			input: "HASURA_GRAPHQL_ADMIN_SECRET=" + secret + "\n",
+			want:  []veles.Secret{hasura.AdminSecret{Secret: secret}},
+		},
+		{
+			name:  "http header",
+			input: "curl -H 'x-hasura-admin-secret: " + secret + "' https://example.com/v1/graphql",
+			want:  []veles.Secret{hasura.AdminSecret{Secret: secret}},
+		},
+		{
+			name:  "helm values",
+			input: "hasura:\n  hasuraAdminSecret: \"" + secret + "\"\n",
+			want:  []veles.Secret{hasura.AdminSecret{Secret: secret}},
+		},
+		{
+			name: "kubernetes env entry",
+			input: `        env:
+        - name: HASURA_GRAPHQL_ADMIN_SECRET
+          value: ` + secret + "\n",
+			want: []veles.Secret{hasura.AdminSecret{Secret: secret}},
+		},
+		{
+			name:  "template placeholder",
+			input: "HASURA_GRAPHQL_ADMIN_SECRET: ${HASURA_SECRET}\nhasuraAdminSecret: {{ .Values.secret }}",
+			want:  nil,
+		},
+		{
+			name:  "too short",
+			input: "HASURA_GRAPHQL_ADMIN_SECRET=abc",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
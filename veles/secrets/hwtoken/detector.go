@@ -0,0 +1,209 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwtoken
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxContextLen bounds the command lines, variable names and separators
+	// matched by the detection regexes.
+	maxContextLen = 512
+	// maxSDTIDTokenLen is how far apart the serial number and the seed of a
+	// token in a .sdtid file can be.
+	maxSDTIDTokenLen = 1 * veles.KiB
+)
+
+var (
+	// Tokens of .sdtid files are <TKN> elements with the serial number
+	// followed by the seed, which is prefixed with "=" by RSA's tools, e.g.
+	// <TKN><SN>000123456789</SN><Seed>=nRBL+ObP3NsUDvPyjuEXyw==</Seed>.
+	sdtidRe = regexp.MustCompile(`<SN>\s{0,5}([0-9]{12})\s{0,5}</SN>(?s:.{0,512}?)<Seed>\s{0,5}=?([A-Za-z0-9+/]{22}==)\s{0,5}</Seed>`)
+
+	// Both the YubiKey Personalization Tool log and the output of ykman list
+	// the modhex public ID, the private ID and the AES key of a slot as
+	// consecutive CSV fields.
+	yubicoOTPRe = regexp.MustCompile(`\b([cbdefghijklnrtuv]{12}),([0-9a-fA-F]{12}),([0-9a-fA-F]{32})\b`)
+
+	// yubihsm-shell takes the password of the authentication key on the
+	// command line or in "session open <key id> <password>" commands, and
+	// the PKCS#11 module as a PIN made of the hex key ID and the password.
+	yubiHSMShellRe   = regexp.MustCompile(`yubihsm-shell\b[^\n]{0,200}?(?:\s-p\s{0,5}|\s--password[= ])["']?([^\s"']{1,64})`)
+	yubiHSMAuthKeyRe = regexp.MustCompile(`\s--authkey[= ]([0-9]{1,5})\b`)
+	yubiHSMSessionRe = regexp.MustCompile(`\bsession\s{1,5}open\s{1,5}([0-9]{1,5})\s{1,5}([^\s"']{1,64})`)
+	yubiHSMPINRe     = regexp.MustCompile(`yubihsm_pkcs11\.(?:so|dll|dylib)\b[^\n]{0,200}?\s(?:--pin|-p)[= ]["']?([0-9a-fA-F]{4})([^\s"']{1,64})`)
+	yubiHSMEnvRe     = regexp.MustCompile(`(?i:yubihsm[a-z0-9_.\-]{0,20}(?:password|passwd|pin))["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([^\s"']{1,64})`)
+
+	// PIV management keys are 3DES or AES keys, passed to ykman and
+	// yubico-piv-tool on the command line or assigned to a management key
+	// variable.
+	pivCLIRe = regexp.MustCompile(`(?:ykman\b[^\n]{0,100}?\bpiv\b[^\n]{0,200}?\s(?:-m|--management-key)|yubico-piv-tool\b[^\n]{0,200}?\s(?:-k|--key))[= ]?\s{0,5}["']?([0-9a-fA-F]{64}|[0-9a-fA-F]{48}|[0-9a-fA-F]{32})\b`)
+	pivEnvRe = regexp.MustCompile(`(?i:management[_\-]?key|mgm[_\-]?key)["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([0-9a-fA-F]{64}|[0-9a-fA-F]{48}|[0-9a-fA-F]{32})\b`)
+)
+
+const (
+	// defaultYubiHSMPassword is the password of the factory default
+	// authentication key 1 of a YubiHSM 2.
+	defaultYubiHSMPassword = "password"
+	// defaultPIVManagementKey is the factory default management key of
+	// YubiKeys and most other PIV cards.
+	defaultPIVManagementKey = "010203040506070801020304050607080102030405060708"
+)
+
+// sdtidDetector finds token seeds in RSA SecurID .sdtid files.
+type sdtidDetector struct{}
+
+// NewSecurIDSeedDetector returns a Detector that finds RSA SecurID token
+// seeds.
+func NewSecurIDSeedDetector() veles.Detector { return sdtidDetector{} }
+
+// MaxSecretLen returns the maximum length of a token element.
+func (sdtidDetector) MaxSecretLen() uint32 { return maxSDTIDTokenLen }
+
+// Detect finds RSA SecurID token seeds in data.
+func (sdtidDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range sdtidRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, SecurIDSeed{
+			SerialNumber: string(data[m[2]:m[3]]),
+			Seed:         string(data[m[4]:m[5]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// yubicoOTPDetector finds Yubico OTP secrets.
+type yubicoOTPDetector struct{}
+
+// NewYubicoOTPDetector returns a Detector that finds Yubico OTP secrets.
+func NewYubicoOTPDetector() veles.Detector { return yubicoOTPDetector{} }
+
+// MaxSecretLen returns the maximum length of a Yubico OTP secret.
+func (yubicoOTPDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds Yubico OTP secrets in data.
+func (yubicoOTPDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range yubicoOTPRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, YubicoOTPSecret{
+			PublicID:  string(data[m[2]:m[3]]),
+			PrivateID: strings.ToLower(string(data[m[4]:m[5]])),
+			AESKey:    strings.ToLower(string(data[m[6]:m[7]])),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// yubiHSMDetector finds passwords of YubiHSM authentication keys.
+type yubiHSMDetector struct{}
+
+// NewYubiHSMAuthKeyDetector returns a Detector that finds passwords of
+// YubiHSM 2 authentication keys.
+func NewYubiHSMAuthKeyDetector() veles.Detector { return yubiHSMDetector{} }
+
+// MaxSecretLen returns the maximum length of a password with its context.
+func (yubiHSMDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds passwords of YubiHSM authentication keys in data. The factory
+// default password is not reported.
+func (yubiHSMDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	add := func(keyID uint16, password string, pos int) {
+		if password == defaultYubiHSMPassword {
+			return
+		}
+		secrets = append(secrets, YubiHSMAuthKey{KeyID: keyID, Password: password})
+		positions = append(positions, pos)
+	}
+	for _, m := range yubiHSMShellRe.FindAllSubmatchIndex(data, -1) {
+		// The key ID can come before or after the password and defaults to
+		// 1 like in yubihsm-shell.
+		keyID := uint16(1)
+		line := data[m[0]:lineEnd(data, m[0])]
+		if k := yubiHSMAuthKeyRe.FindSubmatch(line); k != nil {
+			keyID = parseKeyID(string(k[1]), 10)
+		}
+		add(keyID, string(data[m[2]:m[3]]), m[0])
+	}
+	for _, m := range yubiHSMSessionRe.FindAllSubmatchIndex(data, -1) {
+		add(parseKeyID(string(data[m[2]:m[3]]), 10), string(data[m[4]:m[5]]), m[0])
+	}
+	for _, m := range yubiHSMPINRe.FindAllSubmatchIndex(data, -1) {
+		add(parseKeyID(string(data[m[2]:m[3]]), 16), string(data[m[4]:m[5]]), m[0])
+	}
+	for _, m := range yubiHSMEnvRe.FindAllSubmatchIndex(data, -1) {
+		add(0, string(data[m[2]:m[3]]), m[0])
+	}
+	return secrets, positions
+}
+
+// lineEnd returns the end of the line starting at or containing pos.
+func lineEnd(data []byte, pos int) int {
+	for i := pos; i < len(data); i++ {
+		if data[i] == '\n' {
+			return i
+		}
+	}
+	return len(data)
+}
+
+// parseKeyID parses an object ID in the given base, returning 0 if it's
+// invalid.
+func parseKeyID(s string, base int) uint16 {
+	id, err := strconv.ParseUint(s, base, 16)
+	if err != nil {
+		return 0
+	}
+	return uint16(id)
+}
+
+// pivDetector finds PIV management keys.
+type pivDetector struct{}
+
+// NewPIVManagementKeyDetector returns a Detector that finds PIV management
+// keys.
+func NewPIVManagementKeyDetector() veles.Detector { return pivDetector{} }
+
+// MaxSecretLen returns the maximum length of a management key with its
+// context.
+func (pivDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds PIV management keys in data. The factory default key is not
+// reported.
+func (pivDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, re := range []*regexp.Regexp{pivCLIRe, pivEnvRe} {
+		for _, m := range re.FindAllSubmatchIndex(data, -1) {
+			key := strings.ToLower(string(data[m[2]:m[3]]))
+			if key == defaultPIVManagementKey {
+				continue
+			}
+			secrets = append(secrets, PIVManagementKey{Key: key})
+			positions = append(positions, m[0])
+		}
+	}
+	return secrets, positions
+}
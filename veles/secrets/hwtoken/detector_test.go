@@ -0,0 +1,208 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwtoken_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/hwtoken"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const (
+	pivKey    = "a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718"
+	otpKey    = "d5ec2d8dcb0c1e9fe7cba7d97bb4bff8"
+	privateID = "267e04a6f6b0"
+)
+
+func TestSecurIDSeedDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name: "sdtid file",
+			input: `<?xml version="1.0"?>
+<TKNBatch>
+  <TKNHeader>
+    <Version>0</Version>
+    <Origin>N/A</Origin>
+    <Secret>/pb5r3W0Lfw5KXKlF6rHvw==</Secret>
+  </TKNHeader>
+  <TKN>
+    <SN>000123456789</SN>
+    <Seed>=nRBL+ObP3NsUDvPyjuEXyw==</Seed>
+    <UserFirstName/>
+  </TKN>
+  <TKN>
+    <SN>000123456790</SN>
+    <Seed>=X/cY4DsRVsZUU9fOgkv3Tw==</Seed>
+  </TKN>
+</TKNBatch>
+`,
+			want: []veles.Secret{
+				hwtoken.SecurIDSeed{SerialNumber: "000123456789", Seed: "nRBL+ObP3NsUDvPyjuEXyw=="},
+				hwtoken.SecurIDSeed{SerialNumber: "000123456790", Seed: "X/cY4DsRVsZUU9fOgkv3Tw=="},
+			},
+		},
+		{
+			name:  "serial number without seed",
+			input: "<TKN><SN>000123456789</SN><Birth>2020/01/01</Birth></TKN>",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, hwtoken.NewSecurIDSeedDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestYubicoOTPDetector(t *testing.T) {
+	want := []veles.Secret{hwtoken.YubicoOTPSecret{
+		PublicID:  "vvccccfiluij",
+		PrivateID: privateID,
+		AESKey:    otpKey,
+	}}
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "personalization tool log",
+			input: "Yubico OTP,11/23/2021 14:35,1,vvccccfiluij," + privateID + "," + otpKey + ",,,0,0,0,0,0,0,0,0,0,0\n",
+			want:  want,
+		},
+		{
+			name:  "ykman config output",
+			input: "12345678,vvccccfiluij," + strings.ToUpper(privateID) + "," + strings.ToUpper(otpKey) + ",,2021-11-23T14:35:00,\n",
+			want:  want,
+		},
+		{
+			name:  "public ID not modhex",
+			input: "abcdef012345," + privateID + "," + otpKey,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, hwtoken.NewYubicoOTPDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestYubiHSMAuthKeyDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "yubihsm-shell with default key",
+			input: "yubihsm-shell -p s3cr3t-hsm-pw -a get-pseudo-random\n",
+			want:  []veles.Secret{hwtoken.YubiHSMAuthKey{KeyID: 1, Password: "s3cr3t-hsm-pw"}},
+		},
+		{
+			name:  "yubihsm-shell with authkey",
+			input: "yubihsm-shell --password=s3cr3t-hsm-pw --authkey 2 -a list-objects\n",
+			want:  []veles.Secret{hwtoken.YubiHSMAuthKey{KeyID: 2, Password: "s3cr3t-hsm-pw"}},
+		},
+		{
+			name:  "session open command",
+			input: "connect\nsession open 3 s3cr3t-hsm-pw\n",
+			want:  []veles.Secret{hwtoken.YubiHSMAuthKey{KeyID: 3, Password: "s3cr3t-hsm-pw"}},
+		},
+		{
+			name:  "PKCS#11 PIN",
+			input: "pkcs11-tool --module /usr/lib/yubihsm_pkcs11.so --login --pin 000as3cr3t-hsm-pw -O\n",
+			want:  []veles.Secret{hwtoken.YubiHSMAuthKey{KeyID: 10, Password: "s3cr3t-hsm-pw"}},
+		},
+		{
+			name:  "environment variable",
+			input: "YUBIHSM_AUTHKEY_PASSWORD=s3cr3t-hsm-pw\n",
+			want:  []veles.Secret{hwtoken.YubiHSMAuthKey{Password: "s3cr3t-hsm-pw"}},
+		},
+		{
+			name:  "factory default password",
+			input: "yubihsm-shell -p password -a get-device-info\nsession open 1 password\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, hwtoken.NewYubiHSMAuthKeyDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPIVManagementKeyDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "ykman",
+			input: "ykman piv keys generate -m " + strings.ToUpper(pivKey) + " 9a pub.pem\n",
+			want:  []veles.Secret{hwtoken.PIVManagementKey{Key: pivKey}},
+		},
+		{
+			name:  "yubico-piv-tool",
+			input: "yubico-piv-tool -a import-certificate -s 9c --key=" + pivKey + " -i cert.pem\n",
+			want:  []veles.Secret{hwtoken.PIVManagementKey{Key: pivKey}},
+		},
+		{
+			name:  "AES-256 key in config",
+			input: `{"piv_management_key": "` + pivKey + "0011223344556677" + `"}`,
+			want:  []veles.Secret{hwtoken.PIVManagementKey{Key: pivKey + "0011223344556677"}},
+		},
+		{
+			name:  "factory default key",
+			input: "ykman piv access change-management-key -m 010203040506070801020304050607080102030405060708 -g\n",
+			want:  nil,
+		},
+		{
+			name:  "hex without context",
+			input: "checksum=" + pivKey,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, hwtoken.NewPIVManagementKeyDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
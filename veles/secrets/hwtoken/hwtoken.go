@@ -0,0 +1,62 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hwtoken contains Veles Secret types and Detectors for the secrets
+// of hardware authentication tokens: RSA SecurID token seed files (.sdtid),
+// Yubico OTP secrets as exported when programming YubiKeys, YubiHSM
+// authentication key passwords and PIV management keys.
+//
+// With the seed or key of a token, anyone can compute its one-time passwords
+// or manage its keys without holding the token, which silently defeats the
+// second factor it provides. Leaks should be treated as critical.
+package hwtoken
+
+// SecurIDSeed is the seed of an RSA SecurID software token from a token seed
+// file (.sdtid).
+type SecurIDSeed struct {
+	// SerialNumber is the 12 digit serial number of the token.
+	SerialNumber string
+	// Seed is the base64 encoded seed, encrypted with a key derived from the
+	// serial number and the batch secret of the file.
+	Seed string
+}
+
+// YubicoOTPSecret is the secret of a YubiKey slot programmed with a Yubico
+// OTP credential, as logged by the YubiKey Personalization Tool or written
+// by "ykman otp yubiotp --config-output" for uploading to a validation
+// server.
+type YubicoOTPSecret struct {
+	// PublicID is the modhex encoded public identity prepended to each OTP.
+	PublicID string
+	// PrivateID is the hex encoded private identity.
+	PrivateID string
+	// AESKey is the hex encoded AES-128 key that encrypts the OTPs.
+	AESKey string
+}
+
+// YubiHSMAuthKey is the password of an authentication key of a YubiHSM 2.
+// The session keys of the HSM are derived from it.
+type YubiHSMAuthKey struct {
+	// KeyID is the object ID of the authentication key, or 0 if unknown.
+	KeyID uint16
+	// Password is the password the authentication key was derived from.
+	Password string
+}
+
+// PIVManagementKey is the hex encoded management key of a PIV smart card,
+// e.g. a YubiKey. It authorizes generating and importing keys and
+// certificates on the card.
+type PIVManagementKey struct {
+	Key string
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package iacstate parses the state files of infrastructure-as-code tools,
+// Terraform's terraform.tfstate and Pulumi's stack checkpoints and exports,
+// and finds the secrets in them. Both tools store the attributes of the
+// resources they manage in plaintext, including database passwords, access
+// keys and private keys they generated or were configured with.
+//
+// The resource attributes known to hold secrets and the attributes Terraform
+// marks as sensitive are passed to the Veles detectors together with their
+// name. Values no detector recognizes are reported as an Attribute with the
+// address of the resource they belong to.
+package iacstate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// Tool is the infrastructure-as-code tool that wrote a state file.
+type Tool string
+
+// Tool values.
+const (
+	ToolTerraform Tool = "terraform"
+	ToolPulumi    Tool = "pulumi"
+)
+
+// pulumiSecretSig is the value of the signature key that marks secret values
+// in Pulumi state, see
+// https://github.com/pulumi/pulumi/blob/master/sdk/go/common/resource/sig/sig.go.
+const pulumiSecretSig = "1b47061264138c4ac30d75fd1eb44270"
+
+// pulumiSigKey is the key of the signature of special values in Pulumi state.
+const pulumiSigKey = "4dabf18193072939515e22adb298388d"
+
+// Attribute is a secret resource attribute from a state file, e.g. the
+// password of a database instance.
+type Attribute struct {
+	Tool Tool
+	// Address of the resource, e.g. "module.db.aws_db_instance.main" for
+	// Terraform or the URN of the resource for Pulumi.
+	Address string
+	// Path of the attribute in the resource, e.g. "password" or
+	// `data["api_key"]`.
+	Attribute string
+	Value     string
+}
+
+// IsStateFile returns true if the file at p is a Terraform state file or a
+// Pulumi stack checkpoint in a filesystem backend.
+func IsStateFile(p string) bool {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	if strings.HasSuffix(base, ".tfstate") || strings.HasSuffix(base, ".tfstate.backup") {
+		return true
+	}
+	return strings.Contains("/"+p, "/.pulumi/stacks/") &&
+		(strings.HasSuffix(base, ".json") || strings.HasSuffix(base, ".json.bak"))
+}
+
+// Parse returns the secret attributes of the resources in the state file read
+// from r. The format of the file is determined from its contents, so state
+// exported with "pulumi stack export" is supported as well.
+func Parse(r io.Reader) ([]Attribute, error) {
+	var s stateFile
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to parse state: %w", err)
+	}
+	switch {
+	case s.Checkpoint != nil && s.Checkpoint.Latest != nil:
+		return pulumiAttributes(s.Checkpoint.Latest), nil
+	case s.Deployment != nil:
+		return pulumiAttributes(s.Deployment), nil
+	default:
+		return terraformAttributes(s.Resources), nil
+	}
+}
+
+// Detect parses the state file read from r and returns the secrets in it.
+// Each secret attribute is passed to engine as "attribute=value" so detectors
+// that rely on the name as context still match. Attributes no detector
+// recognizes are returned as an Attribute.
+func Detect(ctx context.Context, engine *veles.DetectionEngine, r io.Reader) ([]veles.Secret, error) {
+	attrs, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	var secrets []veles.Secret
+	for _, a := range attrs {
+		found, err := engine.Detect(ctx, strings.NewReader(a.Attribute+"="+a.Value))
+		if err != nil {
+			return nil, err
+		}
+		if len(found) > 0 {
+			secrets = append(secrets, found...)
+			continue
+		}
+		secrets = append(secrets, a)
+	}
+	return secrets, nil
+}
+
+// stateFile holds the fields of both Terraform and Pulumi state files.
+type stateFile struct {
+	// Terraform state (format version 4, Terraform 0.12 and later).
+	Resources []tfResource `json:"resources"`
+	// Pulumi checkpoint in a filesystem or cloud storage backend.
+	Checkpoint *struct {
+		Latest *pulumiDeployment `json:"latest"`
+	} `json:"checkpoint"`
+	// Pulumi state exported with "pulumi stack export".
+	Deployment *pulumiDeployment `json:"deployment"`
+}
+
+// addAttribute appends the string values in v to attrs with the attribute
+// path p. Maps and lists are flattened, e.g. into `data["password"]`.
+func addAttribute(attrs []Attribute, a Attribute, v any) []Attribute {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return attrs
+		}
+		a.Value = v
+		for _, o := range attrs {
+			if o == a {
+				return attrs
+			}
+		}
+		return append(attrs, a)
+	case map[string]any:
+		for _, k := range sortedKeys(v) {
+			sub := a
+			sub.Attribute = fmt.Sprintf("%s[%q]", a.Attribute, k)
+			attrs = addAttribute(attrs, sub, v[k])
+		}
+	case []any:
+		for i, e := range v {
+			sub := a
+			sub.Attribute = fmt.Sprintf("%s[%d]", a.Attribute, i)
+			attrs = addAttribute(attrs, sub, e)
+		}
+	}
+	return attrs
+}
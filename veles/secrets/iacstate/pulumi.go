@@ -0,0 +1,146 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacstate
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// pulumiSecretAttributes are the properties known to hold secrets by Pulumi
+// resource type.
+var pulumiSecretAttributes = map[string][]string{
+	// AWS
+	"aws:rds/instance:Instance":                      {"password"},
+	"aws:rds/cluster:Cluster":                        {"masterPassword"},
+	"aws:redshift/cluster:Cluster":                   {"masterPassword"},
+	"aws:iam/accessKey:AccessKey":                    {"secret", "sesSmtpPasswordV4"},
+	"aws:iam/userLoginProfile:UserLoginProfile":      {"password"},
+	"aws:secretsmanager/secretVersion:SecretVersion": {"secretString"},
+	"aws:ssm/parameter:Parameter":                    {"value"},
+	// Google Cloud
+	"gcp:sql/user:User":           {"password"},
+	"gcp:serviceaccount/key:Key":  {"privateKey"},
+	"gcp:storage/hmacKey:HmacKey": {"secret"},
+	// Azure
+	"azure-native:sql:Server":                               {"administratorLoginPassword"},
+	"azure-native:dbforpostgresql:Server":                   {"administratorLoginPassword"},
+	"azuread:index/applicationPassword:ApplicationPassword": {"value"},
+	// Kubernetes
+	"kubernetes:core/v1:Secret": {"data", "stringData"},
+	// Generated credentials
+	"tls:index/privateKey:PrivateKey":            {"privateKeyPem", "privateKeyPemPkcs8", "privateKeyOpenssh"},
+	"random:index/randomPassword:RandomPassword": {"result"},
+}
+
+// pulumiDeployment is the state of a Pulumi stack.
+type pulumiDeployment struct {
+	Resources []pulumiResource `json:"resources"`
+}
+
+// pulumiResource is a resource in a Pulumi deployment.
+type pulumiResource struct {
+	URN     string         `json:"urn"`
+	Type    string         `json:"type"`
+	Inputs  map[string]any `json:"inputs"`
+	Outputs map[string]any `json:"outputs"`
+}
+
+// pulumiAttributes returns the secret properties of the resources. These are
+// the properties known to hold secrets and any value Pulumi marks as secret
+// whose plaintext is stored in the state, which is the case for exports with
+// --show-secrets. Encrypted secret values are skipped.
+func pulumiAttributes(d *pulumiDeployment) []Attribute {
+	var attrs []Attribute
+	for _, r := range d.Resources {
+		for _, props := range []map[string]any{r.Outputs, r.Inputs} {
+			for _, name := range pulumiSecretAttributes[r.Type] {
+				a := Attribute{Tool: ToolPulumi, Address: r.URN, Attribute: name}
+				attrs = addAttribute(attrs, a, revealSecrets(props[name]))
+			}
+			for _, name := range sortedKeys(props) {
+				a := Attribute{Tool: ToolPulumi, Address: r.URN, Attribute: name}
+				attrs = addPlaintextSecrets(attrs, a, props[name])
+			}
+		}
+	}
+	return attrs
+}
+
+// addPlaintextSecrets appends the plaintext values of the secrets in v to
+// attrs.
+func addPlaintextSecrets(attrs []Attribute, a Attribute, v any) []Attribute {
+	switch v := v.(type) {
+	case map[string]any:
+		if v[pulumiSigKey] == pulumiSecretSig {
+			if p, ok := secretPlaintext(v); ok {
+				attrs = addAttribute(attrs, a, p)
+			}
+			return attrs
+		}
+		for _, k := range sortedKeys(v) {
+			sub := a
+			sub.Attribute = fmt.Sprintf("%s[%q]", a.Attribute, k)
+			attrs = addPlaintextSecrets(attrs, sub, v[k])
+		}
+	case []any:
+		for i, e := range v {
+			sub := a
+			sub.Attribute = fmt.Sprintf("%s[%d]", a.Attribute, i)
+			attrs = addPlaintextSecrets(attrs, sub, e)
+		}
+	}
+	return attrs
+}
+
+// revealSecrets replaces the secret values in v with their plaintext, or with
+// nil if they're encrypted.
+func revealSecrets(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		if v[pulumiSigKey] == pulumiSecretSig {
+			if p, ok := secretPlaintext(v); ok {
+				return p
+			}
+			return nil
+		}
+		res := make(map[string]any, len(v))
+		for k, e := range v {
+			res[k] = revealSecrets(e)
+		}
+		return res
+	case []any:
+		res := make([]any, len(v))
+		for i, e := range v {
+			res[i] = revealSecrets(e)
+		}
+		return res
+	}
+	return v
+}
+
+// secretPlaintext returns the plaintext value of a Pulumi secret, which is
+// stored JSON encoded.
+func secretPlaintext(secret map[string]any) (any, bool) {
+	p, ok := secret["plaintext"].(string)
+	if !ok {
+		return nil, false
+	}
+	var v any
+	if err := json.Unmarshal([]byte(p), &v); err != nil {
+		return p, true
+	}
+	return revealSecrets(v), true
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iacstate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// tfSecretAttributes are the attributes known to hold secrets by Terraform
+// resource type. Most providers also mark them as sensitive, but the
+// sensitive_attributes of a resource are only recorded since Terraform 0.15.
+var tfSecretAttributes = map[string][]string{
+	// AWS
+	"aws_db_instance":                   {"password"},
+	"aws_rds_cluster":                   {"master_password"},
+	"aws_docdb_cluster":                 {"master_password"},
+	"aws_neptune_cluster":               {"master_password"},
+	"aws_redshift_cluster":              {"master_password"},
+	"aws_elasticache_replication_group": {"auth_token"},
+	"aws_iam_access_key":                {"secret", "ses_smtp_password_v4"},
+	"aws_iam_user_login_profile":        {"password"},
+	"aws_secretsmanager_secret_version": {"secret_string"},
+	"aws_ssm_parameter":                 {"value"},
+	"aws_key_pair":                      {"private_key"},
+	// Google Cloud
+	"google_sql_user":              {"password"},
+	"google_sql_database_instance": {"root_password"},
+	"google_service_account_key":   {"private_key"},
+	"google_storage_hmac_key":      {"secret"},
+	// Azure
+	"azurerm_mssql_server":               {"administrator_login_password"},
+	"azurerm_postgresql_flexible_server": {"administrator_password"},
+	"azurerm_mysql_flexible_server":      {"administrator_password"},
+	"azurerm_storage_account": {
+		"primary_access_key", "secondary_access_key",
+		"primary_connection_string", "secondary_connection_string",
+	},
+	"azuread_application_password":       {"value"},
+	"azuread_service_principal_password": {"value"},
+	// Kubernetes and Vault
+	"kubernetes_secret":    {"data", "binary_data"},
+	"kubernetes_secret_v1": {"data", "binary_data"},
+	"vault_generic_secret": {"data_json"},
+	// Generated credentials
+	"tls_private_key":       {"private_key_pem", "private_key_pem_pkcs8", "private_key_openssh"},
+	"random_password":       {"result"},
+	"databricks_token":      {"token_value"},
+	"github_actions_secret": {"plaintext_value"},
+}
+
+// tfResource is a resource in a Terraform state file.
+type tfResource struct {
+	Module    string       `json:"module"`
+	Mode      string       `json:"mode"`
+	Type      string       `json:"type"`
+	Name      string       `json:"name"`
+	Instances []tfInstance `json:"instances"`
+}
+
+// tfInstance is an instance of a resource, e.g. one of its count instances.
+type tfInstance struct {
+	IndexKey            any            `json:"index_key"`
+	Attributes          map[string]any `json:"attributes"`
+	SensitiveAttributes [][]tfPathStep `json:"sensitive_attributes"`
+}
+
+// tfPathStep is a step of the path of a sensitive attribute, e.g.
+// {"type": "get_attr", "value": "password"}.
+type tfPathStep struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// terraformAttributes returns the secret attributes of the resources.
+func terraformAttributes(resources []tfResource) []Attribute {
+	var attrs []Attribute
+	for _, r := range resources {
+		for _, inst := range r.Instances {
+			address := tfAddress(r, inst.IndexKey)
+			for _, name := range tfSecretAttributes[r.Type] {
+				a := Attribute{Tool: ToolTerraform, Address: address, Attribute: name}
+				attrs = addAttribute(attrs, a, inst.Attributes[name])
+			}
+			for _, p := range inst.SensitiveAttributes {
+				name, v, ok := tfLookup(inst.Attributes, p)
+				if !ok {
+					continue
+				}
+				a := Attribute{Tool: ToolTerraform, Address: address, Attribute: name}
+				attrs = addAttribute(attrs, a, v)
+			}
+		}
+	}
+	return attrs
+}
+
+// tfAddress returns the address of a resource instance, e.g.
+// `module.db.aws_db_instance.main["primary"]`.
+func tfAddress(r tfResource, indexKey any) string {
+	parts := []string{}
+	if r.Module != "" {
+		parts = append(parts, r.Module)
+	}
+	if r.Mode == "data" {
+		parts = append(parts, "data")
+	}
+	parts = append(parts, r.Type, r.Name)
+	address := strings.Join(parts, ".")
+	switch k := indexKey.(type) {
+	case string:
+		address += fmt.Sprintf("[%q]", k)
+	case float64:
+		address += fmt.Sprintf("[%d]", int(k))
+	}
+	return address
+}
+
+// tfLookup returns the attribute path and the value at the path of a
+// sensitive attribute.
+func tfLookup(attrs map[string]any, p []tfPathStep) (string, any, bool) {
+	if len(p) == 0 || p[0].Type != "get_attr" {
+		return "", nil, false
+	}
+	name, ok := p[0].Value.(string)
+	if !ok {
+		return "", nil, false
+	}
+	v := attrs[name]
+	for _, step := range p[1:] {
+		key := step.Value
+		// Index keys are encoded with their type, e.g.
+		// {"value": 0, "type": "number"}.
+		if m, ok := key.(map[string]any); ok && step.Type == "index" {
+			key = m["value"]
+		}
+		switch k := key.(type) {
+		case string:
+			m, ok := v.(map[string]any)
+			if !ok {
+				return "", nil, false
+			}
+			if step.Type == "get_attr" {
+				name += "." + k
+			} else {
+				name += fmt.Sprintf("[%q]", k)
+			}
+			v = m[k]
+		case float64:
+			l, ok := v.([]any)
+			if !ok || int(k) < 0 || int(k) >= len(l) {
+				return "", nil, false
+			}
+			name += fmt.Sprintf("[%d]", int(k))
+			v = l[int(k)]
+		default:
+			return "", nil, false
+		}
+	}
+	return name, v, true
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
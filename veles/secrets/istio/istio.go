@@ -0,0 +1,95 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package istio contains a Veles Secret type and Detector for the Kubernetes
+// service account tokens that Istio workloads use to bootstrap their mesh
+// identity.
+package istio
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+const (
+	// maxTokenLen is an upper bound for projected service account tokens.
+	maxTokenLen = 4096
+	// istioCAAudience is the audience of tokens accepted by istiod to issue
+	// workload certificates.
+	istioCAAudience = "istio-ca"
+)
+
+var jwtRe = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]{10,500}\.eyJ[A-Za-z0-9_\-]{10,1000}\.[A-Za-z0-9_\-]{10,1000}`)
+
+// BootstrapToken is a service account token with the istio-ca audience, e.g.
+// the istio-token file used to onboard VMs into the mesh. It can be exchanged
+// at istiod for a workload certificate of the service account, which allows
+// impersonating the workload inside the mesh.
+type BootstrapToken struct {
+	Token string
+	// The Kubernetes subject, e.g. system:serviceaccount:<namespace>:<name>.
+	Subject string
+}
+
+type claims struct {
+	Subject  string   `json:"sub"`
+	Audience audience `json:"aud"`
+}
+
+// audience is the JWT "aud" claim which can be a string or a list of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = []string{s}
+		return nil
+	}
+	var l []string
+	if err := json.Unmarshal(b, &l); err != nil {
+		return err
+	}
+	*a = l
+	return nil
+}
+
+// NewDetector returns a Detector that finds Istio bootstrap tokens.
+func NewDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxTokenLen,
+		Re:     jwtRe,
+		FromMatch: func(b []byte) veles.Secret {
+			token := string(b)
+			payload := strings.Split(token, ".")[1]
+			decoded, err := base64.RawURLEncoding.DecodeString(payload)
+			if err != nil {
+				return nil
+			}
+			var c claims
+			if err := json.Unmarshal(decoded, &c); err != nil {
+				return nil
+			}
+			if !slices.Contains(c.Audience, istioCAAudience) {
+				return nil
+			}
+			return BootstrapToken{Token: token, Subject: c.Subject}
+		},
+	}
+}
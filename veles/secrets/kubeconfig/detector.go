@@ -0,0 +1,187 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeconfig
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"gopkg.in/yaml.v3"
+)
+
+// maxConfigLen is the maximum size of a kubeconfig file. Files with many
+// clusters and embedded certificates are a few dozen KiB large.
+const maxConfigLen = 64 * veles.KiB
+
+var (
+	// kubeconfig files are YAML documents of kind Config with a users list.
+	kindRe  = regexp.MustCompile(`(?m)^kind:[ \t]*["']?Config["']?[ \t]*\r?$`)
+	usersRe = regexp.MustCompile(`(?m)^users:`)
+
+	// Flags of exec plugins that take secrets, e.g. --token or
+	// --oidc-client-secret.
+	execFlagRe = regexp.MustCompile(`^--?([a-z0-9-]*(?:token|secret|password|key))(?:=(.*))?$`)
+	// Env variables that hold secrets, e.g. AWS_SECRET_ACCESS_KEY.
+	execEnvRe = regexp.MustCompile(`(?i)token|secret|password|passwd|credential|key`)
+)
+
+// config is the part of a kubeconfig file the detector reads.
+type config struct {
+	Kind     string `yaml:"kind"`
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string `yaml:"token"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Exec                  *struct {
+				Command string   `yaml:"command"`
+				Args    []string `yaml:"args"`
+				Env     []struct {
+					Name  string `yaml:"name"`
+					Value string `yaml:"value"`
+				} `yaml:"env"`
+			} `yaml:"exec"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// detector finds user credentials in kubeconfig files.
+type detector struct{}
+
+// NewDetector returns a Detector that finds the bearer tokens, client
+// certificates and exec plugin secrets of kubeconfig users.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum size of a kubeconfig file.
+func (detector) MaxSecretLen() uint32 { return maxConfigLen }
+
+// Detect finds kubeconfig user credentials in data.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	if !kindRe.Match(data) || !usersRe.Match(data) {
+		return nil, nil
+	}
+	var secrets []veles.Secret
+	var positions []int
+	add := func(s veles.Secret, value string) {
+		pos := bytes.Index(data, []byte(value))
+		if pos < 0 {
+			pos = 0
+		}
+		secrets = append(secrets, s)
+		positions = append(positions, pos)
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var cfg config
+		// Decoding stops at the end of the data and at chunks of larger
+		// files, which can't be parsed.
+		if err := dec.Decode(&cfg); err != nil {
+			break
+		}
+		if cfg.Kind != "Config" {
+			continue
+		}
+		for _, u := range cfg.Users {
+			cluster := cfg.clusterOf(u.Name)
+			if u.User.Token != "" {
+				add(BearerToken{Cluster: cluster, User: u.Name, Token: u.User.Token}, u.User.Token)
+			}
+			if u.User.ClientCertificateData != "" && u.User.ClientKeyData != "" {
+				add(ClientCertificate{
+					Cluster:         cluster,
+					User:            u.Name,
+					CertificateData: u.User.ClientCertificateData,
+					KeyData:         u.User.ClientKeyData,
+				}, u.User.ClientKeyData)
+			}
+			if u.User.Exec == nil {
+				continue
+			}
+			for _, c := range execSecrets(u.User.Exec.Args) {
+				add(ExecCredential{Cluster: cluster, User: u.Name, Command: u.User.Exec.Command, Name: c[0], Value: c[1]}, c[1])
+			}
+			for _, e := range u.User.Exec.Env {
+				if e.Value != "" && execEnvRe.MatchString(e.Name) {
+					add(ExecCredential{Cluster: cluster, User: u.Name, Command: u.User.Exec.Command, Name: e.Name, Value: e.Value}, e.Value)
+				}
+			}
+		}
+	}
+	return secrets, positions
+}
+
+// clusterOf returns the cluster of the first context that references user.
+func (cfg *config) clusterOf(user string) Cluster {
+	for _, ctx := range cfg.Contexts {
+		if ctx.Context.User != user {
+			continue
+		}
+		for _, c := range cfg.Clusters {
+			if c.Name == ctx.Context.Cluster {
+				return Cluster{
+					Name:                     c.Name,
+					Server:                   c.Cluster.Server,
+					CertificateAuthorityData: c.Cluster.CertificateAuthorityData,
+					InsecureSkipTLSVerify:    c.Cluster.InsecureSkipTLSVerify,
+				}
+			}
+		}
+		return Cluster{Name: ctx.Context.Cluster}
+	}
+	return Cluster{}
+}
+
+// execSecrets returns the flags with secret values in the args of an exec
+// plugin as flag and value pairs. Both "--flag=value" and "--flag value" are
+// supported.
+func execSecrets(args []string) [][2]string {
+	var secrets [][2]string
+	for i := 0; i < len(args); i++ {
+		m := execFlagRe.FindStringSubmatch(args[i])
+		if m == nil {
+			continue
+		}
+		flag, value := strings.SplitN(args[i], "=", 2)[0], m[2]
+		if !strings.Contains(args[i], "=") {
+			if i+1 >= len(args) || strings.HasPrefix(args[i+1], "-") {
+				continue
+			}
+			i++
+			value = args[i]
+		}
+		if value != "" {
+			secrets = append(secrets, [2]string{flag, value})
+		}
+	}
+	return secrets
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubeconfig contains Veles Secret types, Detectors and Validators
+// for the user credentials of kubeconfig files, e.g. ~/.kube/config or the
+// admin.conf and kubelet.conf of /etc/kubernetes: bearer tokens, client
+// certificates and secrets passed to exec credential plugins. They usually
+// grant access to the Kubernetes API server of the cluster, often with
+// cluster-admin permissions.
+package kubeconfig
+
+// Cluster is the cluster a kubeconfig user is used for, taken from the
+// first context that references the user. All fields are empty if no
+// context references it.
+type Cluster struct {
+	// Name of the clusters entry.
+	Name string
+	// URL of the API server, e.g. "https://10.0.0.1:6443".
+	Server string
+	// Base64-encoded PEM of the CA that signed the server certificate.
+	CertificateAuthorityData string
+	// Whether the server certificate isn't verified.
+	InsecureSkipTLSVerify bool
+}
+
+// BearerToken is the token of a kubeconfig user, e.g. a service account
+// token.
+type BearerToken struct {
+	Cluster Cluster
+	// Name of the users entry.
+	User  string
+	Token string
+}
+
+// ClientCertificate is the client certificate and private key of a
+// kubeconfig user.
+type ClientCertificate struct {
+	Cluster Cluster
+	// Name of the users entry.
+	User string
+	// Base64-encoded PEM of the certificate and the private key.
+	CertificateData string
+	KeyData         string
+}
+
+// ExecCredential is a secret passed to the exec credential plugin of a
+// kubeconfig user in an env variable or a command line flag, e.g. the
+// client secret of an OIDC login plugin.
+type ExecCredential struct {
+	Cluster Cluster
+	// Name of the users entry.
+	User string
+	// The plugin command, e.g. "kubelogin".
+	Command string
+	// The env variable or flag the secret is passed in, e.g.
+	// "AWS_SECRET_ACCESS_KEY" or "--oidc-client-secret".
+	Name  string
+	Value string
+}
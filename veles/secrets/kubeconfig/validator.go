@@ -0,0 +1,161 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// defaultTimeout is the timeout of validation requests.
+const defaultTimeout = 10 * time.Second
+
+// The validators request the API discovery document, which every
+// authenticated user may read. Anonymous and unknown users are rejected with
+// 401, users without the discovery permission with 403.
+const discoveryPath = "/api"
+
+// bearerTokenValidator authenticates to the cluster's API server with a
+// bearer token.
+type bearerTokenValidator struct {
+	timeout time.Duration
+}
+
+// NewBearerTokenValidator returns a Validator for BearerTokens. The token is
+// sent to the API server of the kubeconfig, so only enable validation for
+// files from trusted sources.
+func NewBearerTokenValidator() veles.Validator[BearerToken] {
+	return &bearerTokenValidator{timeout: defaultTimeout}
+}
+
+// Validate checks whether t can authenticate against its API server.
+func (v *bearerTokenValidator) Validate(ctx context.Context, t BearerToken) (veles.ValidationStatus, error) {
+	tlsConfig, err := clusterTLSConfig(t.Cluster)
+	if err != nil {
+		return veles.ValidationFailed, err
+	}
+	return validate(ctx, t.Cluster, tlsConfig, v.timeout, func(req *http.Request) {
+		req.Header.Set("Authorization", "Bearer "+t.Token)
+	})
+}
+
+// clientCertificateValidator authenticates to the cluster's API server with
+// a client certificate.
+type clientCertificateValidator struct {
+	timeout time.Duration
+}
+
+// NewClientCertificateValidator returns a Validator for ClientCertificates.
+// The certificate is presented to the API server of the kubeconfig, so only
+// enable validation for files from trusted sources.
+func NewClientCertificateValidator() veles.Validator[ClientCertificate] {
+	return &clientCertificateValidator{timeout: defaultTimeout}
+}
+
+// Validate checks whether c can authenticate against its API server.
+func (v *clientCertificateValidator) Validate(ctx context.Context, c ClientCertificate) (veles.ValidationStatus, error) {
+	tlsConfig, err := clusterTLSConfig(c.Cluster)
+	if err != nil {
+		return veles.ValidationFailed, err
+	}
+	certPEM, err := base64.StdEncoding.DecodeString(c.CertificateData)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("decoding client-certificate-data: %w", err)
+	}
+	keyPEM, err := base64.StdEncoding.DecodeString(c.KeyData)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("decoding client-key-data: %w", err)
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("tls.X509KeyPair(): %w", err)
+	}
+	tlsConfig.Certificates = []tls.Certificate{cert}
+	return validate(ctx, c.Cluster, tlsConfig, v.timeout, func(*http.Request) {})
+}
+
+// clusterTLSConfig returns the TLS config to connect to the API server of c
+// with.
+func clusterTLSConfig(c Cluster) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.InsecureSkipTLSVerify {
+		// Mirrors what kubectl does for this kubeconfig.
+		cfg.InsecureSkipVerify = true
+		return cfg, nil
+	}
+	if c.CertificateAuthorityData == "" {
+		return cfg, nil
+	}
+	caPEM, err := base64.StdEncoding.DecodeString(c.CertificateAuthorityData)
+	if err != nil {
+		return nil, fmt.Errorf("decoding certificate-authority-data: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("certificate-authority-data contains no certificates")
+	}
+	cfg.RootCAs = pool
+	return cfg, nil
+}
+
+// validate requests the discovery document of the API server of c with the
+// credentials set by auth.
+func validate(ctx context.Context, c Cluster, tlsConfig *tls.Config, timeout time.Duration, auth func(*http.Request)) (veles.ValidationStatus, error) {
+	server, err := url.Parse(c.Server)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("url.Parse(%q): %w", c.Server, err)
+	}
+	// Credentials are never sent in plaintext.
+	if server.Scheme != "https" || server.Host == "" {
+		return veles.ValidationFailed, fmt.Errorf("API server %q is not an HTTPS URL", c.Server)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.Server, "/")+discoveryPath, nil)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("http.NewRequestWithContext(GET): %w", err)
+	}
+	auth(req)
+	client := &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		// The API server doesn't redirect discovery requests, and following
+		// redirects could send the credentials elsewhere.
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	defer client.CloseIdleConnections()
+	res, err := client.Do(req)
+	if err != nil {
+		return veles.ValidationFailed, fmt.Errorf("HTTP GET failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK, http.StatusForbidden:
+		return veles.ValidationValid, nil
+	case http.StatusUnauthorized:
+		return veles.ValidationInvalid, nil
+	default:
+		return veles.ValidationFailed, fmt.Errorf("unexpected HTTP status: %d", res.StatusCode)
+	}
+}
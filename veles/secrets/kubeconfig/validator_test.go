@@ -0,0 +1,253 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeconfig_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/kubeconfig"
+)
+
+// startAPIServer starts a TLS server with the given handler and returns its
+// cluster config. Like the API server, it requests client certificates but
+// leaves their verification to the handler.
+func startAPIServer(t *testing.T, handler http.HandlerFunc) kubeconfig.Cluster {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(handler)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	return kubeconfig.Cluster{
+		Name:                     "test",
+		Server:                   srv.URL,
+		CertificateAuthorityData: base64.StdEncoding.EncodeToString(ca),
+	}
+}
+
+// newClientCertificate returns a CA and a client certificate signed by it as
+// base64-encoded PEM.
+func newClientCertificate(t *testing.T) (*x509.CertPool, string, string) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey(): %v", err)
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kubernetes"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate(): %v", err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate(): %v", err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey(): %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "kubernetes-admin", Organization: []string{"system:masters"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate(): %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("x509.MarshalECPrivateKey(): %v", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return pool, base64.StdEncoding.EncodeToString(certPEM), base64.StdEncoding.EncodeToString(keyPEM)
+}
+
+func TestBearerTokenValidator(t *testing.T) {
+	cluster := startAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path != "/api":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Header.Get("Authorization") == "Bearer "+saToken:
+			w.WriteHeader(http.StatusOK)
+		case r.Header.Get("Authorization") == "Bearer restricted":
+			w.WriteHeader(http.StatusForbidden)
+		case r.Header.Get("Authorization") == "Bearer broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+	untrusted := cluster
+	untrusted.CertificateAuthorityData = caData
+	insecure := cluster
+	insecure.CertificateAuthorityData = ""
+	insecure.InsecureSkipTLSVerify = true
+	plaintext := cluster
+	plaintext.Server = "http://10.0.0.1:8080"
+
+	tests := []struct {
+		name    string
+		token   kubeconfig.BearerToken
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			token: kubeconfig.BearerToken{Cluster: cluster, Token: saToken},
+			want:  veles.ValidationValid,
+		},
+		{
+			name:  "valid without discovery permission",
+			token: kubeconfig.BearerToken{Cluster: cluster, Token: "restricted"},
+			want:  veles.ValidationValid,
+		},
+		{
+			name:  "invalid",
+			token: kubeconfig.BearerToken{Cluster: cluster, Token: "revoked"},
+			want:  veles.ValidationInvalid,
+		},
+		{
+			name:  "insecure-skip-tls-verify",
+			token: kubeconfig.BearerToken{Cluster: insecure, Token: saToken},
+			want:  veles.ValidationValid,
+		},
+		{
+			name:    "server error",
+			token:   kubeconfig.BearerToken{Cluster: cluster, Token: "broken"},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+		{
+			name:    "untrusted server certificate",
+			token:   kubeconfig.BearerToken{Cluster: untrusted, Token: saToken},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+		{
+			name:    "plaintext server",
+			token:   kubeconfig.BearerToken{Cluster: plaintext, Token: saToken},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+		{
+			name:    "no cluster",
+			token:   kubeconfig.BearerToken{Token: saToken},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	v := kubeconfig.NewBearerTokenValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Validate(context.Background(), tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientCertificateValidator(t *testing.T) {
+	clientCAs, cert, key := newClientCertificate(t)
+	_, otherCert, otherKey := newClientCertificate(t)
+	cluster := startAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		opts := x509.VerifyOptions{Roots: clientCAs, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}
+		if _, err := r.TLS.PeerCertificates[0].Verify(opts); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name    string
+		cert    kubeconfig.ClientCertificate
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cert: kubeconfig.ClientCertificate{Cluster: cluster, CertificateData: cert, KeyData: key},
+			want: veles.ValidationValid,
+		},
+		{
+			name: "certificate of another CA",
+			cert: kubeconfig.ClientCertificate{Cluster: cluster, CertificateData: otherCert, KeyData: otherKey},
+			want: veles.ValidationInvalid,
+		},
+		{
+			name:    "mismatched key",
+			cert:    kubeconfig.ClientCertificate{Cluster: cluster, CertificateData: cert, KeyData: otherKey},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+		{
+			name:    "invalid base64",
+			cert:    kubeconfig.ClientCertificate{Cluster: cluster, CertificateData: "!!", KeyData: key},
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	v := kubeconfig.NewClientCertificateValidator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := v.Validate(context.Background(), tt.cert)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package list provides a public list of the Veles secret detectors and of
+// the validators for the secrets they find.
+package list
+
+import (
+	"net/http"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/acme"
+	"github.com/google/osv-scalibr/veles/secrets/ambientcreds"
+	"github.com/google/osv-scalibr/veles/secrets/apispec"
+	"github.com/google/osv-scalibr/veles/secrets/appsecret"
+	"github.com/google/osv-scalibr/veles/secrets/azuresp"
+	"github.com/google/osv-scalibr/veles/secrets/bugsnag"
+	"github.com/google/osv-scalibr/veles/secrets/cakey"
+	"github.com/google/osv-scalibr/veles/secrets/cloudcli"
+	"github.com/google/osv-scalibr/veles/secrets/consul"
+	"github.com/google/osv-scalibr/veles/secrets/cryptocurrency"
+	"github.com/google/osv-scalibr/veles/secrets/datasource"
+	"github.com/google/osv-scalibr/veles/secrets/dbauth"
+	"github.com/google/osv-scalibr/veles/secrets/emailprovider"
+	"github.com/google/osv-scalibr/veles/secrets/gcs"
+	"github.com/google/osv-scalibr/veles/secrets/github"
+	"github.com/google/osv-scalibr/veles/secrets/hasura"
+	"github.com/google/osv-scalibr/veles/secrets/hwtoken"
+	"github.com/google/osv-scalibr/veles/secrets/istio"
+	"github.com/google/osv-scalibr/veles/secrets/kubeconfig"
+	"github.com/google/osv-scalibr/veles/secrets/linkerd"
+	"github.com/google/osv-scalibr/veles/secrets/mdm"
+	"github.com/google/osv-scalibr/veles/secrets/meetingplatform"
+	"github.com/google/osv-scalibr/veles/secrets/mlplatform"
+	"github.com/google/osv-scalibr/veles/secrets/mobileapp"
+	"github.com/google/osv-scalibr/veles/secrets/nebula"
+	"github.com/google/osv-scalibr/veles/secrets/presignedurl"
+	"github.com/google/osv-scalibr/veles/secrets/proxyauth"
+	"github.com/google/osv-scalibr/veles/secrets/rollbar"
+	"github.com/google/osv-scalibr/veles/secrets/sectools"
+	"github.com/google/osv-scalibr/veles/secrets/sentry"
+	"github.com/google/osv-scalibr/veles/secrets/tailscale"
+	"github.com/google/osv-scalibr/veles/secrets/tunnel"
+	"github.com/google/osv-scalibr/veles/secrets/vault"
+	"github.com/google/osv-scalibr/veles/secrets/voip"
+	"github.com/google/osv-scalibr/veles/secrets/zerotier"
+)
+
+// Detectors returns all Veles secret detectors. Dotenv, infrastructure state
+// and source map files are additionally parsed by the secrets scanner of the
+// filesystem walk, so their detectors aren't listed here.
+func Detectors() []veles.Detector {
+	return []veles.Detector{
+		acme.NewAccountKeyDetector(),
+		acme.NewCertbotDNSCredentialsDetector(),
+		acme.NewDNSProviderTokenDetector(),
+		ambientcreds.NewIMDSv2TokenDetector(),
+		ambientcreds.NewAWSMetadataCredentialsDetector(),
+		ambientcreds.NewMetadataAccessTokenDetector(),
+		ambientcreds.NewSSHAgentSocketDetector(),
+		apispec.NewDetector(),
+		appsecret.NewDetector(),
+		azuresp.NewClientSecretDetector(),
+		azuresp.NewClientCertificateDetector(),
+		bugsnag.NewDetector(),
+		cakey.NewDetector(),
+		cloudcli.NewAWSCachedCredentialsDetector(),
+		cloudcli.NewAWSSSOTokenDetector(),
+		cloudcli.NewAzureMSALTokenDetector(),
+		cloudcli.NewAzureServicePrincipalDetector(),
+		cloudcli.NewDoctlDetector(),
+		cloudcli.NewOCISessionTokenDetector(),
+		consul.NewDetector(),
+		cryptocurrency.NewExchangeDetector(),
+		cryptocurrency.NewKeystoreDetector(),
+		cryptocurrency.NewMnemonicDetector(),
+		datasource.NewDetector(),
+		dbauth.NewMongoDBKeyFileDetector(),
+		dbauth.NewPgpassDetector(),
+		dbauth.NewRedisACLDetector(),
+		dbauth.NewRedisPasswordDetector(),
+		emailprovider.NewMailchimpDetector(),
+		emailprovider.NewMandrillDetector(),
+		emailprovider.NewPostmarkDetector(),
+		emailprovider.NewSESSMTPDetector(),
+		gcs.NewHMACKeyDetector(),
+		gcs.NewSignedURLDetector(),
+		github.NewFineGrainedPATDetector(),
+		hasura.NewDetector(),
+		hwtoken.NewPIVManagementKeyDetector(),
+		hwtoken.NewSecurIDSeedDetector(),
+		hwtoken.NewYubiHSMAuthKeyDetector(),
+		hwtoken.NewYubicoOTPDetector(),
+		istio.NewDetector(),
+		kubeconfig.NewDetector(),
+		linkerd.NewDetector(),
+		mdm.NewAndroidEnrollmentTokenDetector(),
+		mdm.NewProfileDetector(),
+		mdm.NewProvisioningDetector(),
+		mdm.NewPushCertificateDetector(),
+		meetingplatform.NewWebexBotTokenDetector(),
+		meetingplatform.NewZoomJWTDetector(),
+		meetingplatform.NewZoomS2SDetector(),
+		mlplatform.NewHuggingFaceDetector(),
+		mlplatform.NewKaggleDetector(),
+		mlplatform.NewMLflowDetector(),
+		mlplatform.NewWandBDetector(),
+		mobileapp.NewDetector(),
+		nebula.NewDetector(),
+		presignedurl.NewDetector(),
+		proxyauth.NewDetector(),
+		rollbar.NewDetector(),
+		sectools.NewAcunetixDetector(),
+		sectools.NewBurpEnterpriseDetector(),
+		sectools.NewNessusActivationCodeDetector(),
+		sectools.NewTenableDetector(),
+		sentry.NewDSNDetector(),
+		sentry.NewOrgAuthTokenDetector(),
+		sentry.NewUserAuthTokenDetector(),
+		tailscale.NewAPIKeyDetector(),
+		tailscale.NewAuthKeyDetector(),
+		tunnel.NewChiselDetector(),
+		tunnel.NewCloudflaredDetector(),
+		tunnel.NewFRPDetector(),
+		tunnel.NewNgrokDetector(),
+		vault.NewTokenDetector(),
+		vault.NewUnsealKeysDetector(),
+		voip.NewAsteriskDetector(),
+		voip.NewFreeSWITCHDetector(),
+		voip.NewXMPPComponentDetector(),
+		zerotier.NewDetector(),
+	}
+}
+
+// NewValidationEngine returns a throttled ValidationEngine with the
+// validators of all secrets that can be validated against the public API of
+// their service. The validators send their requests using the given client
+// (nil for a default client).
+//
+// Secrets of self-hosted services, e.g. Vault and MLflow tokens, and
+// kubeconfig credentials are left out since validating them would require
+// sending them to a server found in the scanned data or configured by the
+// caller.
+func NewValidationEngine(client *http.Client) *veles.ValidationEngine {
+	e := veles.NewThrottledValidationEngine(veles.DefaultThrottleConfig())
+	veles.AddValidator(e, azuresp.NewClientSecretValidator(azuresp.DefaultEndpoint, client))
+	veles.AddValidator(e, emailprovider.NewMailchimpValidator(emailprovider.DefaultMailchimpEndpoint, client))
+	veles.AddValidator(e, emailprovider.NewMandrillValidator(emailprovider.DefaultMandrillEndpoint, client))
+	veles.AddValidator(e, emailprovider.NewPostmarkValidator(emailprovider.DefaultPostmarkEndpoint, client))
+	veles.AddValidator(e, emailprovider.NewSESSMTPValidator(""))
+	veles.AddValidator(e, gcs.NewHMACKeyValidator(gcs.DefaultEndpoint, client))
+	veles.AddValidator(e, gcs.NewSignedURLValidator(gcs.DefaultEndpoint, client))
+	veles.AddValidator(e, github.NewFineGrainedPATValidator(github.DefaultEndpoint, client))
+	veles.AddValidator(e, meetingplatform.NewWebexBotTokenValidator(meetingplatform.DefaultWebexEndpoint, client))
+	veles.AddValidator(e, meetingplatform.NewZoomS2SValidator(meetingplatform.DefaultZoomTokenEndpoint, client))
+	veles.AddValidator(e, mlplatform.NewHuggingFaceValidator(mlplatform.DefaultHuggingFaceEndpoint, client))
+	veles.AddValidator(e, mlplatform.NewKaggleValidator(mlplatform.DefaultKaggleEndpoint, client))
+	veles.AddValidator(e, mlplatform.NewWandBValidator(mlplatform.DefaultWandBEndpoint, client))
+	veles.AddValidator(e, rollbar.NewValidator(rollbar.DefaultEndpoint, client))
+	veles.AddValidator(e, sectools.NewTenableValidator(sectools.DefaultTenableEndpoint, client))
+	veles.AddValidator(e, sentry.NewOrgAuthTokenValidator(sentry.DefaultEndpoint, client))
+	veles.AddValidator(e, sentry.NewUserAuthTokenValidator(sentry.DefaultEndpoint, client))
+	veles.AddValidator(e, tailscale.NewAPIKeyValidator(tailscale.DefaultEndpoint, client))
+	veles.AddValidator(e, zerotier.NewValidator(zerotier.DefaultEndpoint, client))
+	return e
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mdm
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxAndroidTokenLen is the maximum length of the admin extra that holds an
+// enrollment token.
+const maxAndroidTokenLen = 256
+
+// The enrollment token in the admin extras bundle of a provisioning QR code.
+// The quotes may be escaped if the QR code JSON is embedded in a string.
+var androidTokenRe = regexp.MustCompile(`com\.google\.android\.apps\.work\.clouddpc\.EXTRA_ENROLLMENT_TOKEN\\?"\s*:\s*\\?"([A-Za-z0-9]{16,64})\\?"`)
+
+// androidDetector finds Android Enterprise enrollment tokens.
+type androidDetector struct{}
+
+// NewAndroidEnrollmentTokenDetector returns a Detector that finds Android
+// Enterprise enrollment tokens in provisioning QR code contents.
+func NewAndroidEnrollmentTokenDetector() veles.Detector { return androidDetector{} }
+
+// MaxSecretLen returns the maximum length of the enrollment token extra.
+func (androidDetector) MaxSecretLen() uint32 { return maxAndroidTokenLen }
+
+// Detect finds Android Enterprise enrollment tokens in data.
+func (androidDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range androidTokenRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, AndroidEnrollmentToken{Token: string(data[m[2]:m[3]])})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
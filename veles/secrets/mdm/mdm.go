@@ -0,0 +1,109 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mdm contains Veles Secret types and Detectors for the credentials of
+// mobile device management (MDM) deployments: credentials embedded in Apple
+// configuration profiles, Apple MDM push certificates with their private keys,
+// secrets in Windows provisioning packages and Android Enterprise enrollment
+// tokens as used by Intune and other MDM services.
+//
+// MDM credentials allow enrolling devices into a fleet or pushing
+// configuration to all of its devices, so leaks should be treated as
+// critical.
+package mdm
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxContextLen is how far from a credential the surrounding payload or the
+// private key of a certificate is searched. Configuration profiles embed
+// certificates as base64, so payloads can be several KiB long.
+const maxContextLen = 16 * veles.KiB
+
+// ProfileCredential is a credential embedded in a payload of an Apple
+// configuration profile (.mobileconfig), e.g. of an MDM enrollment profile.
+type ProfileCredential struct {
+	// The type of the payload, "com.apple.security.scep" for SCEP challenges
+	// or "com.apple.security.pkcs12" for passwords of PKCS#12 identities.
+	PayloadType string
+	// The challenge or password.
+	Value string
+}
+
+// PushCertificate is an Apple MDM push certificate together with its private
+// key. It allows sending push notifications to all devices enrolled in the
+// MDM server, which makes them fetch commands from it.
+type PushCertificate struct {
+	// The push topic from the certificate's UID, e.g.
+	// "com.apple.mgmt.External.<uuid>".
+	Topic string
+	// The PEM encoded certificate and private key.
+	Certificate string
+	PrivateKey  string
+}
+
+// ProvisioningSecretType is the kind of a secret in a Windows provisioning
+// package.
+type ProvisioningSecretType string
+
+// ProvisioningSecretType values.
+const (
+	// BulkEnrollmentToken is a bulk primary refresh token (BPRT) that joins
+	// devices to Microsoft Entra ID and enrolls them in Intune.
+	BulkEnrollmentToken ProvisioningSecretType = "bulk-enrollment-token"
+	// DomainJoinPassword is the password of the account that joins devices
+	// to an Active Directory domain.
+	DomainJoinPassword ProvisioningSecretType = "domain-join-password"
+	// LocalAccountPassword is the password of a local account created on the
+	// devices.
+	LocalAccountPassword ProvisioningSecretType = "local-account-password"
+)
+
+// ProvisioningSecret is a secret from the customizations of a Windows
+// provisioning package, e.g. a customizations.xml of Windows Configuration
+// Designer.
+type ProvisioningSecret struct {
+	Type ProvisioningSecretType
+	// The account the secret belongs to, e.g. "contoso\joiner". Empty for bulk
+	// enrollment tokens.
+	Account string
+	Value   string
+}
+
+// AndroidEnrollmentToken is an Android Enterprise enrollment token, e.g. from
+// the provisioning QR code of an Intune or Android Management API enrollment
+// profile. It allows enrolling devices as managed by the organization.
+type AndroidEnrollmentToken struct {
+	Token string
+}
+
+// firstSubmatch returns the first submatch of re in data, XML unescaped.
+func firstSubmatch(re *regexp.Regexp, data []byte) string {
+	m := re.FindSubmatch(data)
+	if m == nil {
+		return ""
+	}
+	return html.UnescapeString(strings.TrimSpace(string(m[1])))
+}
+
+// isPlaceholder returns true for values that are filled in by an MDM server
+// or a template engine, e.g. "$CHALLENGE" or "{{ password }}".
+func isPlaceholder(v string) bool {
+	return v == "" || strings.HasPrefix(v, "$") || strings.HasPrefix(v, "%") || strings.Contains(v, "{{")
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mdm
+
+import (
+	"html"
+	"regexp"
+	"slices"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// Payload types of credentials in configuration profiles and the plist key
+// of the credential.
+const (
+	payloadSCEP   = "com.apple.security.scep"
+	payloadPKCS12 = "com.apple.security.pkcs12"
+)
+
+var (
+	// A challenge or password string in a plist.
+	profileCredentialRe = regexp.MustCompile(`<key>(Challenge|Password)</key>\s*<string>([^<]{1,1000})</string>`)
+	// The tokens that determine which payload a key belongs to.
+	profileTokenRe = regexp.MustCompile(`<dict>|</dict>|<key>PayloadType</key>\s*<string>([^<]*)</string>`)
+)
+
+// profileDetector finds credentials in Apple configuration profiles.
+type profileDetector struct{}
+
+// NewProfileDetector returns a Detector that finds SCEP challenges and
+// PKCS#12 passwords in Apple configuration profiles.
+func NewProfileDetector() veles.Detector { return profileDetector{} }
+
+// MaxSecretLen returns the maximum length of a payload.
+func (profileDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds credentials in configuration profiles in data.
+func (profileDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range profileCredentialRe.FindAllSubmatchIndex(data, -1) {
+		value := html.UnescapeString(string(data[m[4]:m[5]]))
+		if isPlaceholder(value) {
+			continue
+		}
+		// The password of a PKCS#12 payload is a key of the payload itself,
+		// while the challenge of a SCEP payload is a key of its
+		// PayloadContent dict.
+		wantType, level := payloadPKCS12, 0
+		if string(data[m[2]:m[3]]) == "Challenge" {
+			wantType, level = payloadSCEP, -1
+		}
+		if payloadType(data, m[0], m[1], level) != wantType {
+			continue
+		}
+		secrets = append(secrets, ProfileCredential{PayloadType: wantType, Value: value})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// payloadType returns the PayloadType of the dict that's level dicts above
+// the dict containing the plist key at [start, end), or an empty string if
+// it's not found within maxContextLen.
+func payloadType(data []byte, start, end, level int) string {
+	// Keys after the credential, e.g. in profiles with sorted keys.
+	after := data[end:min(len(data), end+maxContextLen)]
+	depth := 0
+	for _, t := range profileTokenRe.FindAllSubmatch(after, -1) {
+		switch string(t[0]) {
+		case "<dict>":
+			depth++
+		case "</dict>":
+			depth--
+		default:
+			if depth == level {
+				return string(t[1])
+			}
+		}
+		if depth < level {
+			break
+		}
+	}
+
+	// Keys before the credential.
+	before := profileTokenRe.FindAllSubmatch(data[max(0, start-maxContextLen):start], -1)
+	slices.Reverse(before)
+	depth = 0
+	for _, t := range before {
+		switch string(t[0]) {
+		case "</dict>":
+			depth++
+		case "<dict>":
+			depth--
+		default:
+			if depth == level {
+				return string(t[1])
+			}
+		}
+		if depth < level {
+			return ""
+		}
+	}
+	return ""
+}
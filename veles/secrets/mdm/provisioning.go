@@ -0,0 +1,92 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mdm
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxProvisioningLen is the maximum length of a provisioning setting. Bulk
+// enrollment tokens are JWTs of a few KiB.
+const maxProvisioningLen = 8 * veles.KiB
+
+var (
+	bprtRe = regexp.MustCompile(`<BPRT>\s*(eyJ[A-Za-z0-9_\-.]{20,})\s*</BPRT>`)
+	// The settings of the account that joins devices to a domain.
+	computerAccountRe = regexp.MustCompile(`(?s)<ComputerAccount>(.{0,1000}?)</ComputerAccount>`)
+	// Local accounts created on the devices. Windows Configuration Designer
+	// always writes the group of the user.
+	userRe     = regexp.MustCompile(`(?s)<User\s+UserName="([^"]{1,256})"\s*>(.{0,1000}?)</User>`)
+	accountRe  = regexp.MustCompile(`<Account>([^<]{1,256})</Account>`)
+	passwordRe = regexp.MustCompile(`<Password>([^<]{1,256})</Password>`)
+)
+
+// provisioningDetector finds secrets in Windows provisioning packages.
+type provisioningDetector struct{}
+
+// NewProvisioningDetector returns a Detector that finds bulk enrollment
+// tokens and account passwords in the customizations of Windows provisioning
+// packages.
+func NewProvisioningDetector() veles.Detector { return provisioningDetector{} }
+
+// MaxSecretLen returns the maximum length of a provisioning setting.
+func (provisioningDetector) MaxSecretLen() uint32 { return maxProvisioningLen }
+
+// Detect finds provisioning package secrets in data.
+func (provisioningDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range bprtRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, ProvisioningSecret{
+			Type:  BulkEnrollmentToken,
+			Value: string(data[m[2]:m[3]]),
+		})
+		positions = append(positions, m[0])
+	}
+	for _, m := range computerAccountRe.FindAllSubmatchIndex(data, -1) {
+		settings := data[m[2]:m[3]]
+		password := firstSubmatch(passwordRe, settings)
+		if isPlaceholder(password) {
+			continue
+		}
+		secrets = append(secrets, ProvisioningSecret{
+			Type:    DomainJoinPassword,
+			Account: firstSubmatch(accountRe, settings),
+			Value:   password,
+		})
+		positions = append(positions, m[0])
+	}
+	for _, m := range userRe.FindAllSubmatchIndex(data, -1) {
+		settings := data[m[4]:m[5]]
+		if !bytes.Contains(settings, []byte("<UserGroup>")) {
+			continue
+		}
+		password := firstSubmatch(passwordRe, settings)
+		if isPlaceholder(password) {
+			continue
+		}
+		secrets = append(secrets, ProvisioningSecret{
+			Type:    LocalAccountPassword,
+			Account: html.UnescapeString(string(data[m[2]:m[3]])),
+			Value:   password,
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
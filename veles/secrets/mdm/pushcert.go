@@ -0,0 +1,160 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mdm
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// mdmTopicPrefix is the prefix of the push topic of MDM push certificates.
+const mdmTopicPrefix = "com.apple.mgmt."
+
+var (
+	// PEM blocks of private keys and certificates. Encrypted keys have
+	// headers, which aren't matched as they can't be compared to a
+	// certificate anyway.
+	pemRe = regexp.MustCompile(`-----BEGIN ((?:RSA |EC )?PRIVATE KEY|CERTIFICATE)-----([A-Za-z0-9+/=\s]+)-----END ((?:RSA |EC )?PRIVATE KEY|CERTIFICATE)-----`)
+	// The userID attribute that holds the push topic of a certificate.
+	oidUserID = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 1}
+)
+
+// block is a PEM block and its location in the input.
+type block struct {
+	pem        *pem.Block
+	start, end int
+}
+
+// pushCertDetector finds Apple MDM push certificates with their private keys.
+type pushCertDetector struct{}
+
+// NewPushCertificateDetector returns a Detector that finds Apple MDM push
+// certificates that are stored together with their private key, e.g. in the
+// config of an MDM server.
+func NewPushCertificateDetector() veles.Detector { return pushCertDetector{} }
+
+// MaxSecretLen returns the maximum length of a certificate including the
+// context searched for its private key.
+func (pushCertDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds MDM push certificates in data whose private key is within
+// maxContextLen bytes of them.
+func (pushCertDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var certs, keys []block
+	for _, b := range findBlocks(data) {
+		if b.pem.Type == "CERTIFICATE" {
+			certs = append(certs, b)
+		} else {
+			keys = append(keys, b)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, c := range certs {
+		cert, err := x509.ParseCertificate(c.pem.Bytes)
+		if err != nil {
+			continue
+		}
+		topic := pushTopic(cert)
+		if topic == "" {
+			continue
+		}
+		for _, k := range keys {
+			if k.start < c.start-maxContextLen || k.end > c.end+maxContextLen {
+				continue
+			}
+			if pub := publicKey(k.pem); pub == nil || !pub.Equal(cert.PublicKey) {
+				continue
+			}
+			secrets = append(secrets, PushCertificate{
+				Topic:       topic,
+				Certificate: string(pem.EncodeToMemory(c.pem)),
+				PrivateKey:  string(pem.EncodeToMemory(k.pem)),
+			})
+			positions = append(positions, c.start)
+			break
+		}
+	}
+	return secrets, positions
+}
+
+// pushTopic returns the MDM push topic of cert or an empty string if it isn't
+// an MDM push certificate.
+func pushTopic(cert *x509.Certificate) string {
+	for _, n := range cert.Subject.Names {
+		if !n.Type.Equal(oidUserID) {
+			continue
+		}
+		if v, ok := n.Value.(string); ok && strings.HasPrefix(v, mdmTopicPrefix) {
+			return v
+		}
+	}
+	return ""
+}
+
+// findBlocks returns the PEM encoded keys and certificates in data.
+func findBlocks(data []byte) []block {
+	var blocks []block
+	for _, m := range pemRe.FindAllSubmatchIndex(data, -1) {
+		typ := string(data[m[2]:m[3]])
+		if typ != string(data[m[6]:m[7]]) {
+			continue
+		}
+		der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(data[m[4]:m[5]])), ""))
+		if err != nil {
+			continue
+		}
+		blocks = append(blocks, block{pem: &pem.Block{Type: typ, Bytes: der}, start: m[0], end: m[1]})
+	}
+	return blocks
+}
+
+// publicKey returns the public key of a PEM encoded private key or nil if it
+// can't be parsed.
+func publicKey(b *pem.Block) interface{ Equal(crypto.PublicKey) bool } {
+	var key any
+	var err error
+	switch b.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(b.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(b.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(b.Bytes)
+	}
+	if err != nil {
+		return nil
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil
+	}
+	pub, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return nil
+	}
+	return pub
+}
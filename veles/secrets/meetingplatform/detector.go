@@ -0,0 +1,187 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meetingplatform
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+const (
+	// maxZoomPairLen is how far apart the fields of Zoom app credentials can
+	// be.
+	maxZoomPairLen = 1 * veles.KiB
+	// webexTokenLen is the length of a Webex access token: 64 characters, the
+	// region and the ID of the organization.
+	webexTokenLen = 64 + 1 + 4 + 1 + 36
+)
+
+// zoomField is a field of Zoom app credentials.
+type zoomField int
+
+const (
+	zoomAccountID zoomField = iota
+	zoomClientID
+	zoomClientSecret
+	zoomAPIKey
+	zoomAPISecret
+)
+
+var (
+	// Zoom credentials have no distinctive format, so they're only reported
+	// when assigned to Zoom variables, e.g. ZOOM_CLIENT_SECRET or
+	// zoom.api_key.
+	zoomRe = regexp.MustCompile(`(?i:zoom[a-z0-9_.\-]{0,20}?(account[_.\-]?id|client[_.\-]?id|client[_.\-]?secret|api[_.\-]?key|api[_.\-]?secret))["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([A-Za-z0-9_\-]{16,64})(?:$|[^A-Za-z0-9_\-])`)
+
+	// zoomValueRes are the formats of the values of each field.
+	zoomValueRes = map[zoomField]*regexp.Regexp{
+		zoomAccountID:    regexp.MustCompile(`^[A-Za-z0-9_\-]{20,24}$`),
+		zoomClientID:     regexp.MustCompile(`^[A-Za-z0-9_\-]{18,24}$`),
+		zoomClientSecret: regexp.MustCompile(`^[A-Za-z0-9]{32}$`),
+		zoomAPIKey:       regexp.MustCompile(`^[A-Za-z0-9_\-]{18,24}$`),
+		zoomAPISecret:    regexp.MustCompile(`^[A-Za-z0-9]{36}$`),
+	}
+
+	webexRe = regexp.MustCompile(`\b[A-Za-z0-9]{64}_[A-Z0-9]{4}_[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+)
+
+// zoomMatch is a value assigned to a Zoom credential field.
+type zoomMatch struct {
+	pos   int
+	value string
+}
+
+// findZoomFields returns the values assigned to Zoom credential fields in
+// data, keyed by field.
+func findZoomFields(data []byte) map[zoomField][]zoomMatch {
+	fields := make(map[zoomField][]zoomMatch)
+	for _, m := range zoomRe.FindAllSubmatchIndex(data, -1) {
+		name := strings.ToLower(string(data[m[2]:m[3]]))
+		name = strings.NewReplacer("_", "", ".", "", "-", "").Replace(name)
+		var f zoomField
+		switch name {
+		case "accountid":
+			f = zoomAccountID
+		case "clientid":
+			f = zoomClientID
+		case "clientsecret":
+			f = zoomClientSecret
+		case "apikey":
+			f = zoomAPIKey
+		case "apisecret":
+			f = zoomAPISecret
+		default:
+			continue
+		}
+		value := string(data[m[4]:m[5]])
+		if !zoomValueRes[f].MatchString(value) {
+			continue
+		}
+		fields[f] = append(fields[f], zoomMatch{pos: m[0], value: value})
+	}
+	return fields
+}
+
+// nearest returns the match closest to pos within maxZoomPairLen, or nil if
+// there is none.
+func nearest(matches []zoomMatch, pos int) *zoomMatch {
+	var best *zoomMatch
+	bestDist := maxZoomPairLen + 1
+	for i, m := range matches {
+		dist := m.pos - pos
+		if dist < 0 {
+			dist = -dist
+		}
+		if dist < bestDist {
+			best, bestDist = &matches[i], dist
+		}
+	}
+	return best
+}
+
+// zoomS2SDetector finds Zoom Server-to-Server OAuth client secrets and pairs
+// them with the closest client ID and account ID.
+type zoomS2SDetector struct{}
+
+// NewZoomS2SDetector returns a Detector that finds Zoom Server-to-Server
+// OAuth app credentials. Client secrets without a client ID next to them
+// can't be used and aren't reported.
+func NewZoomS2SDetector() veles.Detector { return zoomS2SDetector{} }
+
+// MaxSecretLen returns the maximum distance between the fields of the
+// credentials.
+func (zoomS2SDetector) MaxSecretLen() uint32 { return maxZoomPairLen }
+
+// Detect finds Zoom Server-to-Server OAuth credentials in data.
+func (zoomS2SDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	fields := findZoomFields(data)
+	var secrets []veles.Secret
+	var positions []int
+	for _, s := range fields[zoomClientSecret] {
+		id := nearest(fields[zoomClientID], s.pos)
+		if id == nil {
+			continue
+		}
+		c := ZoomS2SCredentials{ClientID: id.value, ClientSecret: s.value}
+		if a := nearest(fields[zoomAccountID], s.pos); a != nil {
+			c.AccountID = a.value
+		}
+		secrets = append(secrets, c)
+		positions = append(positions, min(s.pos, id.pos))
+	}
+	return secrets, positions
+}
+
+// zoomJWTDetector finds Zoom JWT app API secrets and pairs them with the
+// closest API key.
+type zoomJWTDetector struct{}
+
+// NewZoomJWTDetector returns a Detector that finds the API key and secret of
+// legacy Zoom JWT apps.
+func NewZoomJWTDetector() veles.Detector { return zoomJWTDetector{} }
+
+// MaxSecretLen returns the maximum distance between the API key and secret.
+func (zoomJWTDetector) MaxSecretLen() uint32 { return maxZoomPairLen }
+
+// Detect finds Zoom JWT app credentials in data.
+func (zoomJWTDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	fields := findZoomFields(data)
+	var secrets []veles.Secret
+	var positions []int
+	for _, s := range fields[zoomAPISecret] {
+		k := nearest(fields[zoomAPIKey], s.pos)
+		if k == nil {
+			continue
+		}
+		secrets = append(secrets, ZoomJWTCredentials{APIKey: k.value, APISecret: s.value})
+		positions = append(positions, min(s.pos, k.pos))
+	}
+	return secrets, positions
+}
+
+// NewWebexBotTokenDetector returns a Detector that finds Webex bot and
+// personal access tokens.
+func NewWebexBotTokenDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: webexTokenLen,
+		Re:     webexRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return WebexBotToken{Token: string(b)}
+		},
+	}
+}
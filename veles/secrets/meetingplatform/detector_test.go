@@ -0,0 +1,173 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meetingplatform_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/meetingplatform"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const (
+	zoomAccountID    = "Ab3dEf5gHi7jKl9mNo1pQr"
+	zoomClientID     = "Xy1zAb2cDe3fGh4iJk5L"
+	zoomClientSecret = "k8Jd2mQx9Lp4Rt7Wv1Zb3Nc6Hf0Ys5Ga"
+	zoomAPIKey       = "aB1cD2eF3gH4iJ5kL6mN7o"
+	zoomAPISecret    = "Qw3Er5Ty7Ui9Op1As3Df5Gh7Jk9Lz1Xc3Vb5"
+	webexToken       = "ZDI3MGEyYzQtNmFlNS00NDNhLWFlNzAtZGVjNjE0MGU1OGZmZWNmZDEwN2ItYTU3" +
+		"_PF84_1eb65fdf-9643-417f-9974-ad72cae0e10f"
+)
+
+func TestZoomS2SDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name: "environment variables",
+			input: "ZOOM_ACCOUNT_ID=" + zoomAccountID + "\n" +
+				"ZOOM_CLIENT_ID=" + zoomClientID + "\n" +
+				"ZOOM_CLIENT_SECRET=" + zoomClientSecret + "\n",
+			want: []veles.Secret{meetingplatform.ZoomS2SCredentials{
+				AccountID:    zoomAccountID,
+				ClientID:     zoomClientID,
+				ClientSecret: zoomClientSecret,
+			}},
+		},
+		{
+			name: "yaml without account ID",
+			input: "zoom:\n" +
+				"zoom_client_id: \"" + zoomClientID + "\"\n" +
+				"zoom_client_secret: \"" + zoomClientSecret + "\"\n",
+			want: []veles.Secret{meetingplatform.ZoomS2SCredentials{
+				ClientID:     zoomClientID,
+				ClientSecret: zoomClientSecret,
+			}},
+		},
+		{
+			name:  "secret without client ID",
+			input: "ZOOM_CLIENT_SECRET=" + zoomClientSecret + "\n",
+			want:  nil,
+		},
+		{
+			name: "placeholder secret",
+			input: "ZOOM_CLIENT_ID=" + zoomClientID + "\n" +
+				"ZOOM_CLIENT_SECRET=your-zoom-client-secret-here\n",
+			want: nil,
+		},
+		{
+			name: "not zoom variables",
+			input: "CLIENT_ID=" + zoomClientID + "\n" +
+				"CLIENT_SECRET=" + zoomClientSecret + "\n",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, meetingplatform.NewZoomS2SDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestZoomJWTDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "environment variables",
+			input: "ZOOM_API_KEY=" + zoomAPIKey + "\nZOOM_API_SECRET=" + zoomAPISecret + "\n",
+			want: []veles.Secret{meetingplatform.ZoomJWTCredentials{
+				APIKey:    zoomAPIKey,
+				APISecret: zoomAPISecret,
+			}},
+		},
+		{
+			name:  "json config",
+			input: `{"zoomApiKey": "` + zoomAPIKey + `", "zoomApiSecret": "` + zoomAPISecret + `"}`,
+			want: []veles.Secret{meetingplatform.ZoomJWTCredentials{
+				APIKey:    zoomAPIKey,
+				APISecret: zoomAPISecret,
+			}},
+		},
+		{
+			name:  "secret without key",
+			input: "ZOOM_API_SECRET=" + zoomAPISecret + "\n",
+			want:  nil,
+		},
+		{
+			name: "server-to-server credentials",
+			input: "ZOOM_CLIENT_ID=" + zoomClientID + "\n" +
+				"ZOOM_CLIENT_SECRET=" + zoomClientSecret + "\n",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, meetingplatform.NewZoomJWTDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWebexBotTokenDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "bot config",
+			input: "WEBEX_BOT_TOKEN=" + webexToken + "\n",
+			want:  []veles.Secret{meetingplatform.WebexBotToken{Token: webexToken}},
+		},
+		{
+			name:  "authorization header",
+			input: "curl -H 'Authorization: Bearer " + webexToken + "' https://webexapis.com/v1/messages",
+			want:  []veles.Secret{meetingplatform.WebexBotToken{Token: webexToken}},
+		},
+		{
+			name:  "truncated token",
+			input: "WEBEX_BOT_TOKEN=" + webexToken[:64] + "\n",
+			want:  nil,
+		},
+		{
+			name:  "organization ID only",
+			input: "orgId: 1eb65fdf-9643-417f-9974-ad72cae0e10f\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, meetingplatform.NewWebexBotTokenDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
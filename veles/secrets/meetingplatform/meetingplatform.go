@@ -0,0 +1,45 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package meetingplatform contains Veles Secret types, Detectors and
+// Validators for credentials of video meeting platforms: Zoom
+// Server-to-Server OAuth apps, legacy Zoom JWT apps and Webex bot tokens.
+//
+// These credentials give access to meeting recordings and transcripts and
+// allow scheduling meetings and sending messages as members of the
+// organization.
+package meetingplatform
+
+// ZoomS2SCredentials are the credentials of a Zoom Server-to-Server OAuth
+// app, which are exchanged for access tokens of the account.
+type ZoomS2SCredentials struct {
+	// AccountID of the Zoom account the app belongs to. Empty if it wasn't
+	// found next to the client credentials.
+	AccountID    string
+	ClientID     string
+	ClientSecret string
+}
+
+// ZoomJWTCredentials are the API key and secret of a legacy Zoom JWT app,
+// which are used to sign API access tokens.
+type ZoomJWTCredentials struct {
+	APIKey    string
+	APISecret string
+}
+
+// WebexBotToken is a Webex bot access token. Personal access tokens of Webex
+// users have the same format.
+type WebexBotToken struct {
+	Token string
+}
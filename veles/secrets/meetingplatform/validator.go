@@ -0,0 +1,78 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meetingplatform
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+const (
+	// DefaultZoomTokenEndpoint issues access tokens for Server-to-Server
+	// OAuth apps.
+	DefaultZoomTokenEndpoint = "https://zoom.us/oauth/token"
+	// DefaultWebexEndpoint returns the details of the token's owner.
+	DefaultWebexEndpoint = "https://webexapis.com/v1/people/me"
+)
+
+// NewZoomS2SValidator returns a Validator for Zoom Server-to-Server OAuth
+// credentials that requests an access token from endpoint using the given
+// client (nil for a default client). Credentials without an account ID can't
+// be validated and are reported as ValidationFailed.
+//
+// Legacy Zoom JWT apps were deactivated by Zoom, so there is no Validator
+// for ZoomJWTCredentials.
+func NewZoomS2SValidator(endpoint string, client *http.Client) veles.Validator[ZoomS2SCredentials] {
+	return &simplevalidate.Validator[ZoomS2SCredentials]{
+		EndpointFunc: func(c ZoomS2SCredentials) (string, error) {
+			if c.AccountID == "" {
+				return "", errors.New("no account ID found for the client credentials")
+			}
+			q := url.Values{"grant_type": {"account_credentials"}, "account_id": {c.AccountID}}
+			return endpoint + "?" + q.Encode(), nil
+		},
+		HTTPMethod: http.MethodPost,
+		HTTPHeaders: func(c ZoomS2SCredentials) map[string]string {
+			return map[string]string{
+				"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(c.ClientID+":"+c.ClientSecret)),
+			}
+		},
+		ValidResponseCodes: []int{http.StatusOK},
+		// Zoom answers 400 for unknown accounts and 401 for wrong client
+		// credentials.
+		InvalidResponseCodes: []int{http.StatusBadRequest, http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
+
+// NewWebexBotTokenValidator returns a Validator for Webex bot tokens that
+// sends requests to endpoint using the given client (nil for a default
+// client).
+func NewWebexBotTokenValidator(endpoint string, client *http.Client) veles.Validator[WebexBotToken] {
+	return &simplevalidate.Validator[WebexBotToken]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(t WebexBotToken) map[string]string {
+			return map[string]string{"Authorization": "Bearer " + t.Token}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
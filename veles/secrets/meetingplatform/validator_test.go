@@ -0,0 +1,129 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meetingplatform_test
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/meetingplatform"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+func TestZoomS2SValidator(t *testing.T) {
+	valid := meetingplatform.ZoomS2SCredentials{
+		AccountID:    zoomAccountID,
+		ClientID:     zoomClientID,
+		ClientSecret: zoomClientSecret,
+	}
+	wrongSecret := valid
+	wrongSecret.ClientSecret = "wrong"
+	noAccount := valid
+	noAccount.AccountID = ""
+
+	tests := []struct {
+		name    string
+		creds   meetingplatform.ZoomS2SCredentials
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			creds: valid,
+			want:  veles.ValidationValid,
+		},
+		{
+			name:   "invalid",
+			creds:  wrongSecret,
+			status: http.StatusUnauthorized,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "no account ID",
+			creds:   noAccount,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+		{
+			name:    "server error",
+			creds:   wrongSecret,
+			status:  http.StatusInternalServerError,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte(zoomClientID+":"+zoomClientSecret))
+			s := velestest.NewAuthServer(t, http.MethodPost, "/oauth/token?account_id="+zoomAccountID+"&grant_type=account_credentials", wantAuth, tt.status)
+			v := meetingplatform.NewZoomS2SValidator(s.URL+"/oauth/token", s.Client())
+			got, err := v.Validate(context.Background(), tt.creds)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebexBotTokenValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			token: webexToken,
+			want:  veles.ValidationValid,
+		},
+		{
+			name:   "invalid",
+			token:  "revoked",
+			status: http.StatusUnauthorized,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "rate limited",
+			token:   "revoked",
+			status:  http.StatusTooManyRequests,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := velestest.NewAuthServer(t, http.MethodGet, "/v1/people/me", "Bearer "+webexToken, tt.status)
+			v := meetingplatform.NewWebexBotTokenValidator(s.URL+"/v1/people/me", s.Client())
+			got, err := v.Validate(context.Background(), meetingplatform.WebexBotToken{Token: tt.token})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
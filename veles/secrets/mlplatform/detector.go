@@ -0,0 +1,157 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mlplatform
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+const (
+	// huggingFaceTokenLen is the length of a token including its prefix.
+	huggingFaceTokenLen = 37
+	// maxContextLen bounds the variable names, separators and values matched
+	// by the context based detection regexes.
+	maxContextLen = 256
+	// maxMLflowContextLen is how far from a token its tracking URI is searched.
+	maxMLflowContextLen = 1 * veles.KiB
+)
+
+var (
+	huggingFaceTokenRe = regexp.MustCompile(`\bhf_[A-Za-z0-9]{34}\b`)
+
+	// W&B API keys are 40 hex characters without a distinctive prefix, so
+	// they're only reported when assigned to a W&B related key or stored in
+	// the .netrc entry written by `wandb login`.
+	wandbEnvRe   = regexp.MustCompile(`(?i:wandb[a-z0-9_.\-]{0,40})(?:\(key)?["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{40})\b`)
+	wandbNetrcRe = regexp.MustCompile(`machine\s{1,10}api\.wandb\.ai\s{1,10}login\s{1,10}\S{1,64}\s{1,10}password\s{1,10}([0-9a-f]{40})\b`)
+
+	// kaggle.json contains the username and a 32 hex character key.
+	kaggleUserFirstRe = regexp.MustCompile(`"username"\s{0,10}:\s{0,10}"([A-Za-z0-9_.\-]{1,64})"\s{0,10},\s{0,10}"key"\s{0,10}:\s{0,10}"([0-9a-f]{32})"`)
+	kaggleKeyFirstRe  = regexp.MustCompile(`"key"\s{0,10}:\s{0,10}"([0-9a-f]{32})"\s{0,10},\s{0,10}"username"\s{0,10}:\s{0,10}"([A-Za-z0-9_.\-]{1,64})"`)
+
+	mlflowTokenRe = regexp.MustCompile(`MLFLOW_TRACKING_TOKEN["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([A-Za-z0-9._~+/=\-]{16,200})`)
+	mlflowURIRe   = regexp.MustCompile(`MLFLOW_TRACKING_URI["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?(https?://[^\s"']{1,200})`)
+)
+
+// NewHuggingFaceDetector returns a Detector that finds HuggingFace user access
+// tokens.
+func NewHuggingFaceDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: huggingFaceTokenLen,
+		Re:     huggingFaceTokenRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return HuggingFaceToken{Token: string(b)}
+		},
+	}
+}
+
+// wandbDetector finds W&B API keys in environment variables, configs and
+// .netrc files.
+type wandbDetector struct{}
+
+// NewWandBDetector returns a Detector that finds Weights & Biases API keys.
+func NewWandBDetector() veles.Detector { return wandbDetector{} }
+
+// MaxSecretLen returns the maximum length of a W&B API key with its context.
+func (wandbDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds W&B API keys in data.
+func (wandbDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, re := range []*regexp.Regexp{wandbEnvRe, wandbNetrcRe} {
+		for _, m := range re.FindAllSubmatchIndex(data, -1) {
+			secrets = append(secrets, WandBAPIKey{Key: string(data[m[2]:m[3]])})
+			positions = append(positions, m[0])
+		}
+	}
+	return secrets, positions
+}
+
+// kaggleDetector finds the credentials stored in kaggle.json.
+type kaggleDetector struct{}
+
+// NewKaggleDetector returns a Detector that finds Kaggle API credentials.
+func NewKaggleDetector() veles.Detector { return kaggleDetector{} }
+
+// MaxSecretLen returns the maximum length of a kaggle.json credential pair.
+func (kaggleDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds Kaggle credentials in data.
+func (kaggleDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range kaggleUserFirstRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, KaggleCredentials{
+			Username: string(data[m[2]:m[3]]),
+			Key:      string(data[m[4]:m[5]]),
+		})
+		positions = append(positions, m[0])
+	}
+	for _, m := range kaggleKeyFirstRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, KaggleCredentials{
+			Username: string(data[m[4]:m[5]]),
+			Key:      string(data[m[2]:m[3]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// mlflowDetector finds MLflow tracking tokens and the tracking URI configured
+// next to them.
+type mlflowDetector struct{}
+
+// NewMLflowDetector returns a Detector that finds MLflow tracking server
+// tokens.
+func NewMLflowDetector() veles.Detector { return mlflowDetector{} }
+
+// MaxSecretLen returns the maximum distance between a token and its tracking
+// URI.
+func (mlflowDetector) MaxSecretLen() uint32 { return maxMLflowContextLen }
+
+// Detect finds MLflow tracking tokens in data.
+func (mlflowDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	uris := mlflowURIRe.FindAllSubmatchIndex(data, -1)
+	for _, m := range mlflowTokenRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, MLflowToken{
+			Token:       string(data[m[2]:m[3]]),
+			TrackingURI: nearestURI(data, uris, m[0]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// nearestURI returns the tracking URI closest to pos within
+// maxMLflowContextLen, or "" if there is none.
+func nearestURI(data []byte, uris [][]int, pos int) string {
+	best, bestDist := "", maxMLflowContextLen+1
+	for _, u := range uris {
+		dist := u[0] - pos
+		if dist < 0 {
+			dist = -dist
+		}
+		if dist < bestDist {
+			best, bestDist = string(data[u[2]:u[3]]), dist
+		}
+	}
+	return best
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mlplatform contains Veles Secret types, Detectors and Validators for
+// credentials of machine learning platforms: HuggingFace, Weights & Biases,
+// Kaggle and MLflow tracking servers.
+//
+// These credentials give access to proprietary models, datasets and
+// experiment data and are commonly left in notebooks and their outputs.
+package mlplatform
+
+// HuggingFaceToken is a HuggingFace user access token (prefix "hf_").
+type HuggingFaceToken struct {
+	Token string
+}
+
+// WandBAPIKey is a Weights & Biases API key.
+type WandBAPIKey struct {
+	Key string
+}
+
+// KaggleCredentials is the API key pair stored in kaggle.json.
+type KaggleCredentials struct {
+	Username string
+	Key      string
+}
+
+// MLflowToken is a bearer token used to authenticate to an MLflow tracking
+// server.
+type MLflowToken struct {
+	Token string
+	// TrackingURI is the tracking server the token was configured with, if
+	// found next to it.
+	TrackingURI string
+}
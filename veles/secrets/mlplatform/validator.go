@@ -0,0 +1,111 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mlplatform
+
+import (
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// Default validation endpoints. Each returns information about the account
+// the credential belongs to without side effects.
+const (
+	DefaultHuggingFaceEndpoint = "https://huggingface.co/api/whoami-v2"
+	DefaultWandBEndpoint       = "https://api.wandb.ai/graphql"
+	DefaultKaggleEndpoint      = "https://www.kaggle.com/api/v1/competitions/list"
+)
+
+// mlflowSearchPath lists at most one experiment of a tracking server.
+const mlflowSearchPath = "/api/2.0/mlflow/experiments/search?max_results=1"
+
+func basicAuth(user, password string) map[string]string {
+	return map[string]string{
+		"Authorization": "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password)),
+	}
+}
+
+// NewHuggingFaceValidator returns a Validator for HuggingFace tokens that sends
+// requests to endpoint using the given client (nil for a default client).
+func NewHuggingFaceValidator(endpoint string, client *http.Client) veles.Validator[HuggingFaceToken] {
+	return &simplevalidate.Validator[HuggingFaceToken]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(t HuggingFaceToken) map[string]string {
+			return map[string]string{"Authorization": "Bearer " + t.Token}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
+
+// NewWandBValidator returns a Validator for W&B API keys that sends requests to
+// endpoint using the given client (nil for a default client).
+//
+// W&B authenticates API requests with the key as the password of the "api"
+// user.
+func NewWandBValidator(endpoint string, client *http.Client) veles.Validator[WandBAPIKey] {
+	return &simplevalidate.Validator[WandBAPIKey]{
+		Endpoint:   endpoint,
+		HTTPMethod: http.MethodPost,
+		HTTPHeaders: func(k WandBAPIKey) map[string]string {
+			h := basicAuth("api", k.Key)
+			h["Content-Type"] = "application/json"
+			return h
+		},
+		Body: func(WandBAPIKey) (string, error) {
+			return `{"query":"query { viewer { id } }"}`, nil
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
+
+// NewKaggleValidator returns a Validator for Kaggle credentials that sends
+// requests to endpoint using the given client (nil for a default client).
+func NewKaggleValidator(endpoint string, client *http.Client) veles.Validator[KaggleCredentials] {
+	return &simplevalidate.Validator[KaggleCredentials]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(c KaggleCredentials) map[string]string {
+			return basicAuth(c.Username, c.Key)
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
+
+// NewMLflowValidator returns a Validator for MLflow tokens that sends requests
+// to the tracking server at trackingURI using the given client (nil for a
+// default client).
+//
+// MLflow servers are self-hosted so there is no default endpoint. The
+// TrackingURI found next to a token is deliberately not used as it comes from
+// untrusted scanned data.
+func NewMLflowValidator(trackingURI string, client *http.Client) veles.Validator[MLflowToken] {
+	return &simplevalidate.Validator[MLflowToken]{
+		Endpoint: strings.TrimSuffix(trackingURI, "/") + mlflowSearchPath,
+		HTTPHeaders: func(t MLflowToken) map[string]string {
+			return map[string]string{"Authorization": "Bearer " + t.Token}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized, http.StatusForbidden},
+		HTTPC:                client,
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mlplatform_test
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/mlplatform"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+func basic(user, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+}
+
+func TestHuggingFaceValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			token: hfToken,
+			want:  veles.ValidationValid,
+		},
+		{
+			name:   "invalid",
+			token:  "hf_revoked",
+			status: http.StatusUnauthorized,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "server error",
+			token:   "hf_revoked",
+			status:  http.StatusInternalServerError,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := velestest.NewAuthServer(t, http.MethodGet, "/", "Bearer "+hfToken, tt.status)
+			v := mlplatform.NewHuggingFaceValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), mlplatform.HuggingFaceToken{Token: tt.token})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWandBValidator(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %q, want POST", r.Method)
+		}
+		if body, _ := io.ReadAll(r.Body); len(body) == 0 {
+			t.Error("request has no GraphQL query")
+		}
+		if r.Header.Get("Authorization") != basic("api", wandbKey) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer s.Close()
+	v := mlplatform.NewWandBValidator(s.URL, s.Client())
+
+	got, err := v.Validate(context.Background(), mlplatform.WandBAPIKey{Key: wandbKey})
+	if err != nil {
+		t.Fatalf("Validate(valid key): %v", err)
+	}
+	if got != veles.ValidationValid {
+		t.Errorf("Validate(valid key) = %q, want %q", got, veles.ValidationValid)
+	}
+
+	got, err = v.Validate(context.Background(), mlplatform.WandBAPIKey{Key: "revoked"})
+	if err != nil {
+		t.Fatalf("Validate(revoked key): %v", err)
+	}
+	if got != veles.ValidationInvalid {
+		t.Errorf("Validate(revoked key) = %q, want %q", got, veles.ValidationInvalid)
+	}
+}
+
+func TestKaggleValidator(t *testing.T) {
+	s := velestest.NewAuthServer(t, http.MethodGet, "/", basic("alice", kaggleKey), http.StatusUnauthorized)
+	v := mlplatform.NewKaggleValidator(s.URL, s.Client())
+
+	got, err := v.Validate(context.Background(), mlplatform.KaggleCredentials{Username: "alice", Key: kaggleKey})
+	if err != nil {
+		t.Fatalf("Validate(valid credentials): %v", err)
+	}
+	if got != veles.ValidationValid {
+		t.Errorf("Validate(valid credentials) = %q, want %q", got, veles.ValidationValid)
+	}
+
+	got, err = v.Validate(context.Background(), mlplatform.KaggleCredentials{Username: "bob", Key: kaggleKey})
+	if err != nil {
+		t.Fatalf("Validate(wrong user): %v", err)
+	}
+	if got != veles.ValidationInvalid {
+		t.Errorf("Validate(wrong user) = %q, want %q", got, veles.ValidationInvalid)
+	}
+}
+
+func TestMLflowValidator(t *testing.T) {
+	s := velestest.NewAuthServer(t, http.MethodGet, "/api/2.0/mlflow/experiments/search?max_results=1", "Bearer "+mlflowToken, http.StatusForbidden)
+	v := mlplatform.NewMLflowValidator(s.URL+"/", s.Client())
+
+	got, err := v.Validate(context.Background(), mlplatform.MLflowToken{Token: mlflowToken})
+	if err != nil {
+		t.Fatalf("Validate(valid token): %v", err)
+	}
+	if got != veles.ValidationValid {
+		t.Errorf("Validate(valid token) = %q, want %q", got, veles.ValidationValid)
+	}
+
+	got, err = v.Validate(context.Background(), mlplatform.MLflowToken{Token: "revoked"})
+	if err != nil {
+		t.Fatalf("Validate(revoked token): %v", err)
+	}
+	if got != veles.ValidationInvalid {
+		t.Errorf("Validate(revoked token) = %q, want %q", got, veles.ValidationInvalid)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mobileapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/groob/plist"
+)
+
+// maxConfigLen is the maximum size of a config file. Firebase and Amplify
+// configs of apps with many clients and services are a few KiB large.
+const maxConfigLen = 64 * veles.KiB
+
+var (
+	// fcmServerKeyRe matches legacy Cloud Messaging server keys, e.g.
+	// "AAAAabc1234:APA91b...".
+	fcmServerKeyRe = regexp.MustCompile(`^AAAA[A-Za-z0-9_-]{7}:APA91b[A-Za-z0-9_-]{134}$`)
+
+	accessKeyIDNameRe     = regexp.MustCompile(`(?i)^(aws_?)?access_?key_?id$`)
+	secretAccessKeyNameRe = regexp.MustCompile(`(?i)^(aws_?)?secret_?access_?key$`)
+	accessKeyIDRe         = regexp.MustCompile(`^(AKIA|ASIA)[A-Z0-9]{16}$`)
+	secretAccessKeyRe     = regexp.MustCompile(`^[A-Za-z0-9/+]{40}$`)
+)
+
+// detector finds backend credentials in mobile app config files.
+type detector struct{}
+
+// NewDetector returns a Detector that finds OAuth client secrets and legacy
+// Cloud Messaging server keys in Firebase configs, and Cognito app client
+// secrets and IAM access keys in AWS Amplify configs.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum size of a config file.
+func (detector) MaxSecretLen() uint32 { return maxConfigLen }
+
+// Detect finds backend credentials in the mobile app config file in data.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	var found []veles.Secret
+	switch {
+	case bytes.Contains(data, []byte("GOOGLE_APP_ID")):
+		var cfg map[string]any
+		if err := plist.Unmarshal(data, &cfg); err != nil {
+			return nil, nil
+		}
+		projectID, _ := cfg["PROJECT_ID"].(string)
+		found = firebaseSecrets(ConfigGoogleServiceInfoPlist, projectID, cfg, "CLIENT_ID", "CLIENT_SECRET")
+	case bytes.Contains(data, []byte(`"project_info"`)):
+		var cfg map[string]any
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, nil
+		}
+		info, _ := cfg["project_info"].(map[string]any)
+		projectID, _ := info["project_id"].(string)
+		found = firebaseSecrets(ConfigGoogleServicesJSON, projectID, cfg, "client_id", "client_secret")
+	case bytes.Contains(data, []byte(`"CognitoUserPool"`)) || bytes.Contains(data, []byte(`"awsCognitoAuthPlugin"`)) || bytes.Contains(data, []byte(`"CredentialsProvider"`)):
+		var cfg map[string]any
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, nil
+		}
+		found = amplifySecrets(cfg)
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, s := range found {
+		if slices.Contains(secrets, s) {
+			continue
+		}
+		pos := bytes.Index(data, []byte(secretValue(s)))
+		if pos < 0 {
+			pos = 0
+		}
+		secrets = append(secrets, s)
+		positions = append(positions, pos)
+	}
+	return secrets, positions
+}
+
+// firebaseSecrets returns the OAuth client secrets, stored under the
+// secretKey of an object whose client ID is stored under idKey, and the
+// legacy server keys in a Firebase config.
+func firebaseSecrets(config string, projectID string, cfg map[string]any, idKey string, secretKey string) []veles.Secret {
+	var secrets []veles.Secret
+	walk(cfg, func(obj map[string]any) {
+		if secret, _ := obj[secretKey].(string); secret != "" {
+			clientID, _ := obj[idKey].(string)
+			secrets = append(secrets, OAuthClientSecret{Config: config, ProjectID: projectID, ClientID: clientID, Secret: secret})
+		}
+		for _, k := range sortedKeys(obj) {
+			if v, ok := obj[k].(string); ok && fcmServerKeyRe.MatchString(v) {
+				secrets = append(secrets, FCMServerKey{Config: config, ProjectID: projectID, Key: v})
+			}
+		}
+	})
+	return secrets
+}
+
+// amplifySecrets returns the Cognito app client secrets and IAM access keys
+// in an Amplify config. amplifyconfiguration.json nests the contents of
+// awsconfiguration.json in the plugins of its categories, e.g. in
+// auth.plugins.awsCognitoAuthPlugin.
+func amplifySecrets(cfg map[string]any) []veles.Secret {
+	config := ConfigAWSConfigurationJSON
+	if auth, ok := cfg["auth"].(map[string]any); ok && auth["plugins"] != nil {
+		config = ConfigAmplifyConfigurationJSON
+	}
+
+	var secrets []veles.Secret
+	walk(cfg, func(obj map[string]any) {
+		if secret, _ := obj["AppClientSecret"].(string); secret != "" {
+			poolID, _ := obj["PoolId"].(string)
+			clientID, _ := obj["AppClientId"].(string)
+			region, _ := obj["Region"].(string)
+			secrets = append(secrets, CognitoAppClientSecret{Config: config, Region: region, UserPoolID: poolID, AppClientID: clientID, Secret: secret})
+		}
+		var keyID, secretKey string
+		for _, k := range sortedKeys(obj) {
+			v, ok := obj[k].(string)
+			if !ok {
+				continue
+			}
+			switch {
+			case accessKeyIDNameRe.MatchString(k) && accessKeyIDRe.MatchString(v):
+				keyID = v
+			case secretAccessKeyNameRe.MatchString(k) && secretAccessKeyRe.MatchString(v):
+				secretKey = v
+			}
+		}
+		if keyID != "" && secretKey != "" {
+			secrets = append(secrets, AWSAccessKey{Config: config, AccessKeyID: keyID, SecretAccessKey: secretKey})
+		}
+	})
+	return secrets
+}
+
+// walk calls visit for every object in v, in the order of their keys.
+func walk(v any, visit func(map[string]any)) {
+	switch v := v.(type) {
+	case map[string]any:
+		visit(v)
+		for _, k := range sortedKeys(v) {
+			walk(v[k], visit)
+		}
+	case []any:
+		for _, e := range v {
+			walk(e, visit)
+		}
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// secretValue returns the secret value of s, used to report its position.
+func secretValue(s veles.Secret) string {
+	switch s := s.(type) {
+	case OAuthClientSecret:
+		return s.Secret
+	case FCMServerKey:
+		return s.Key
+	case CognitoAppClientSecret:
+		return s.Secret
+	case AWSAccessKey:
+		return s.SecretAccessKey
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mobileapp contains Veles Secret types and a Detector for backend
+// credentials in the config files bundled with iOS and Android apps:
+// Firebase's google-services.json and GoogleService-Info.plist and the AWS
+// Amplify configs (amplifyconfiguration.json and awsconfiguration.json).
+//
+// Most values of these files are public identifiers that every copy of the
+// app ships with, e.g. Firebase API keys and app IDs, OAuth client IDs,
+// Cognito user and identity pool IDs and AppSync API keys. Access through
+// them is governed by security rules and backend policies, so they aren't
+// reported. Only values that grant access beyond what the app's users have
+// are reported.
+package mobileapp
+
+// Config files the secrets are found in.
+const (
+	ConfigGoogleServicesJSON       = "google-services.json"
+	ConfigGoogleServiceInfoPlist   = "GoogleService-Info.plist"
+	ConfigAmplifyConfigurationJSON = "amplifyconfiguration.json"
+	ConfigAWSConfigurationJSON     = "awsconfiguration.json"
+)
+
+// OAuthClientSecret is the secret of an OAuth client in a Firebase config.
+// The configs generated by Firebase only contain client IDs, so a secret
+// means that credentials of a web or server client were added to the app.
+type OAuthClientSecret struct {
+	// Config is the kind of config file, e.g. ConfigGoogleServicesJSON.
+	Config    string
+	ProjectID string
+	ClientID  string
+	Secret    string
+}
+
+// FCMServerKey is a legacy Firebase Cloud Messaging server key, which can
+// send push messages to all users of the project's apps.
+type FCMServerKey struct {
+	// Config is the kind of config file, e.g. ConfigGoogleServicesJSON.
+	Config    string
+	ProjectID string
+	Key       string
+}
+
+// CognitoAppClientSecret is the secret of a Cognito user pool app client in
+// an Amplify config. Clients with a secret are meant for servers; with the
+// secret, anyone can act as the app client, e.g. to sign up users or to use
+// admin-enabled authentication flows.
+type CognitoAppClientSecret struct {
+	// Config is the kind of config file, e.g. ConfigAWSConfigurationJSON.
+	Config      string
+	Region      string
+	UserPoolID  string
+	AppClientID string
+	Secret      string
+}
+
+// AWSAccessKey is a static IAM access key in an Amplify config, e.g. added
+// to call AWS services directly instead of through Cognito identities.
+type AWSAccessKey struct {
+	// Config is the kind of config file, e.g. ConfigAmplifyConfigurationJSON.
+	Config          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nebula contains Veles Secret types and a Detector for Nebula overlay
+// network CA and host private keys.
+package nebula
+
+import (
+	"encoding/base64"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxPEMLen bounds a PEM encoded key, allowing for indentation in YAML block
+// scalars.
+const maxPEMLen = 500
+
+// Nebula keys are PEM blocks with custom types, e.g.
+// "NEBULA ED25519 PRIVATE KEY". The PEM body can be indented if the key is
+// inlined into the pki section of a config.
+var keyRe = regexp.MustCompile(`-----BEGIN NEBULA ((?:ECDSA )?[A-Z0-9]{4,8}) PRIVATE KEY-----([A-Za-z0-9+/=\s]{40,400}?)-----END NEBULA ((?:ECDSA )?[A-Z0-9]{4,8}) PRIVATE KEY-----`)
+
+// keyAlgorithm describes a Nebula private key type.
+type keyAlgorithm struct {
+	name string
+	ca   bool
+	// Length of the decoded key.
+	length int
+}
+
+var algorithms = map[string]keyAlgorithm{
+	"ED25519":    {name: "ed25519", ca: true, length: 64},
+	"ECDSA P256": {name: "ecdsa-p256", ca: true, length: 32},
+	"X25519":     {name: "x25519", ca: false, length: 32},
+	"P256":       {name: "p256", ca: false, length: 32},
+}
+
+// CAKey is the private key of a Nebula certificate authority. It signs host
+// certificates, so it allows adding arbitrary hosts to the network.
+type CAKey struct {
+	// Algorithm of the key, "ed25519" or "ecdsa-p256".
+	Algorithm string
+	PEM       string
+}
+
+// HostKey is the private key of a Nebula host. Together with the host's
+// certificate it allows joining the network as that host.
+type HostKey struct {
+	// Algorithm of the key, "x25519" or "p256".
+	Algorithm string
+	PEM       string
+}
+
+// detector finds Nebula CA and host private keys.
+//
+// Nebula keys are only usable with the network's certificates and can't be
+// checked remotely, so there's no Validator. Instead, keys are only reported
+// if they decode to the length expected for their algorithm.
+type detector struct{}
+
+// NewDetector returns a Detector that finds Nebula CA and host private keys.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum length of a PEM encoded key.
+func (detector) MaxSecretLen() uint32 { return maxPEMLen }
+
+// Detect finds Nebula private keys in data.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range keyRe.FindAllSubmatchIndex(data, -1) {
+		typ := string(data[m[2]:m[3]])
+		if typ != string(data[m[6]:m[7]]) {
+			continue
+		}
+		alg, ok := algorithms[typ]
+		if !ok || !validBody(data[m[4]:m[5]], alg.length) {
+			continue
+		}
+		pem := string(data[m[0]:m[1]])
+		if alg.ca {
+			secrets = append(secrets, CAKey{Algorithm: alg.name, PEM: pem})
+		} else {
+			secrets = append(secrets, HostKey{Algorithm: alg.name, PEM: pem})
+		}
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// validBody returns true if the PEM body decodes to a key of the given length.
+func validBody(body []byte, length int) bool {
+	b64 := strings.Join(strings.Fields(string(body)), "")
+	key, err := base64.StdEncoding.DecodeString(b64)
+	return err == nil && len(key) == length
+}
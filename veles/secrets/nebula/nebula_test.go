@@ -0,0 +1,102 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nebula_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/nebula"
+)
+
+const (
+	caKey = `-----BEGIN NEBULA ED25519 PRIVATE KEY-----
+AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKissLS4vMDEyMzQ1Njc4OTo7PD0+Pw==
+-----END NEBULA ED25519 PRIVATE KEY-----`
+	hostKey = `-----BEGIN NEBULA X25519 PRIVATE KEY-----
+ZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXp7fH1+f4CBgoM=
+-----END NEBULA X25519 PRIVATE KEY-----`
+	// The same key inlined into a config file.
+	indentedHostKey = `-----BEGIN NEBULA X25519 PRIVATE KEY-----
+    ZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXp7fH1+f4CBgoM=
+    -----END NEBULA X25519 PRIVATE KEY-----`
+	p256CAKey = `-----BEGIN NEBULA ECDSA P256 PRIVATE KEY-----
+ZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXp7fH1+f4CBgoM=
+-----END NEBULA ECDSA P256 PRIVATE KEY-----`
+)
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{nebula.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "ca.key file",
+			input: caKey + "\n",
+			want:  []veles.Secret{nebula.CAKey{Algorithm: "ed25519", PEM: caKey}},
+		},
+		{
+			name:  "host.key file",
+			input: hostKey + "\n",
+			want:  []veles.Secret{nebula.HostKey{Algorithm: "x25519", PEM: hostKey}},
+		},
+		{
+			name:  "P-256 CA key",
+			input: p256CAKey,
+			want:  []veles.Secret{nebula.CAKey{Algorithm: "ecdsa-p256", PEM: p256CAKey}},
+		},
+		{
+			name:  "key inlined in config",
+			input: "pki:\n  ca: /etc/nebula/ca.crt\n  key: |\n    " + indentedHostKey + "\n",
+			want:  []veles.Secret{nebula.HostKey{Algorithm: "x25519", PEM: indentedHostKey}},
+		},
+		{
+			name:  "public key",
+			input: strings.ReplaceAll(hostKey, "PRIVATE", "PUBLIC"),
+			want:  nil,
+		},
+		{
+			name:  "wrong key length for algorithm",
+			input: strings.ReplaceAll(hostKey, "X25519", "ED25519"),
+			want:  nil,
+		},
+		{
+			name:  "mismatched begin and end",
+			input: strings.Replace(hostKey, "BEGIN NEBULA X25519", "BEGIN NEBULA P256", 1),
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presignedurl
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+const (
+	// maxURLLen is an upper bound for a pre-signed URL.
+	maxURLLen = 4096
+	// v4TimeFormat is the format of X-Amz-Date.
+	v4TimeFormat = "20060102T150405Z"
+)
+
+var (
+	// urlRe matches URLs on the object store endpoints of all providers. The
+	// query is checked for signature parameters after parsing.
+	urlRe = regexp.MustCompile(`https://(?i:[a-z0-9.\-]{1,253}\.amazonaws\.com|[a-z0-9]{3,24}\.(?:blob|file|queue|table|dfs)\.core\.windows\.net)(?::[0-9]{1,5})?/[^\s"'<>\\]+`)
+
+	// azureTimeFormats are the ISO 8601 formats accepted for the se parameter
+	// of SAS tokens.
+	azureTimeFormats = []string{time.RFC3339, "2006-01-02T15:04Z", "2006-01-02"}
+)
+
+// NewDetector returns a Detector that finds pre-signed URLs and evaluates
+// their expiry against the current time.
+func NewDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxURLLen,
+		Re:     urlRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return fromMatch(b, time.Now())
+		},
+	}
+}
+
+func fromMatch(b []byte, now time.Time) veles.Secret {
+	// URLs in HTML and XML documents have their query escaped.
+	raw := strings.ReplaceAll(string(b), "&amp;", "&")
+	u, err := url.Parse(raw)
+	if err != nil || u.RawQuery == "" {
+		return nil
+	}
+	host := strings.ToLower(u.Hostname())
+	q := u.Query()
+
+	var p Provider
+	var identity string
+	var expires time.Time
+	var ok bool
+	switch {
+	case strings.HasSuffix(host, ".amazonaws.com"):
+		p = ProviderS3
+		identity, expires, ok = parseS3(q)
+	case strings.HasSuffix(host, ".core.windows.net"):
+		p = ProviderAzure
+		identity, _, _ = strings.Cut(host, ".")
+		expires, ok = parseAzure(q)
+	}
+	if !ok {
+		return nil
+	}
+
+	expired := !expires.IsZero() && !expires.After(now)
+	severity := SeverityHigh
+	if expired {
+		severity = SeverityInfo
+	}
+	return URL{
+		Provider:        p,
+		URL:             raw,
+		SigningIdentity: identity,
+		Expires:         expires,
+		Expired:         expired,
+		Severity:        severity,
+	}
+}
+
+// parseS3 returns the access key ID and expiry of an S3 pre-signed URL. See
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html.
+func parseS3(q url.Values) (string, time.Time, bool) {
+	switch {
+	case q.Get("X-Amz-Signature") != "" && q.Get("X-Amz-Credential") != "":
+		expires, ok := v4Expiry(q.Get("X-Amz-Date"), q.Get("X-Amz-Expires"))
+		credential, _, _ := strings.Cut(q.Get("X-Amz-Credential"), "/")
+		return credential, expires, ok
+	case q.Get("Signature") != "" && q.Get("AWSAccessKeyId") != "":
+		expires, ok := unixExpiry(q.Get("Expires"))
+		return q.Get("AWSAccessKeyId"), expires, ok
+	}
+	return "", time.Time{}, false
+}
+
+// which case a zero time is returned.
+func parseAzure(q url.Values) (time.Time, bool) {
+	if q.Get("sig") == "" || q.Get("sv") == "" {
+		return time.Time{}, false
+	}
+	se := q.Get("se")
+	if se == "" {
+		return time.Time{}, q.Get("si") != ""
+	}
+	for _, f := range azureTimeFormats {
+		if t, err := time.Parse(f, se); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// v4Expiry returns the expiry of a V4 signature from its signing time and
+// lifetime in seconds.
+func v4Expiry(date, seconds string) (time.Time, bool) {
+	t, err := time.Parse(v4TimeFormat, date)
+	if err != nil {
+		return time.Time{}, false
+	}
+	s, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t.Add(time.Duration(s) * time.Second), true
+}
+
+// unixExpiry parses the Unix timestamp of a V2 signature.
+func unixExpiry(s string) (time.Time, bool) {
+	expires, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(expires, 0).UTC(), true
+}
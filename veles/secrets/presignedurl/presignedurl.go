@@ -0,0 +1,64 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package presignedurl contains a Veles Secret type and Detector for
+// time-limited pre-signed URLs of cloud object stores: Amazon S3 (SigV2 and
+// SigV4) and Azure Storage shared access signatures. Google Cloud Storage
+// signed URLs are found by gcs.NewSignedURLDetector, which also parses their
+// expiry and can validate them.
+//
+// Pre-signed URLs end up in logs, tickets and build output all the time and
+// most of them have long expired when they're found. The Detector parses the
+// expiry of each URL so that only the ones still granting access are
+// reported with an elevated severity.
+package presignedurl
+
+import "time"
+
+// Provider is the cloud provider that signed a URL.
+type Provider string
+
+// Supported providers.
+const (
+	ProviderS3    Provider = "s3"
+	ProviderAzure Provider = "azure"
+)
+
+// Severity of a leaked URL.
+type Severity string
+
+// Severity values.
+const (
+	// SeverityHigh is used for URLs that haven't expired yet (or whose expiry
+	// is unknown): anyone holding them can access the object.
+	SeverityHigh Severity = "HIGH"
+	// SeverityInfo is used for expired URLs. They no longer grant access but
+	// still reveal bucket names, object paths and signing identities.
+	SeverityInfo Severity = "INFO"
+)
+
+// URL is a pre-signed URL.
+type URL struct {
+	Provider Provider
+	URL      string
+	// SigningIdentity is the access key ID (S3) or storage account name
+	// (Azure) the URL was signed with.
+	SigningIdentity string
+	// Expires is when the URL stops being valid. It's zero for Azure SAS
+	// tokens that take their expiry from a stored access policy.
+	Expires time.Time
+	// Expired is whether Expires was in the past when the URL was found.
+	Expired  bool
+	Severity Severity
+}
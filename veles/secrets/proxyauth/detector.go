@@ -0,0 +1,152 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyauth
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxConfigLen is the maximum size of a proxy config.
+	maxConfigLen = 64 * veles.KiB
+	// minKeyLen is the minimum length of a key. oauth2-proxy requires cookie
+	// secrets of 16, 24 or 32 bytes and shorter HMAC keys are rejected by
+	// most JWT libraries.
+	minKeyLen = 16
+)
+
+// keyRule matches the settings of one proxy that hold a signing key.
+type keyRule struct {
+	proxy string
+	// markers gate the rule: at least one of them has to be in the config
+	// since settings like "secret" are too generic on their own.
+	markers []string
+	re      *regexp.Regexp
+}
+
+var (
+	rules = []keyRule{
+		{
+			// oauth2-proxy.cfg, environment variables, command line flags and the
+			// values of the oauth2-proxy Helm chart.
+			proxy:   ProxyOAuth2Proxy,
+			markers: []string{"oauth2-proxy", "oauth2_proxy", "OAUTH2_PROXY_", "cookie_secret"},
+			re:      regexp.MustCompile(`(?m)(cookie_secret|--cookie-secret|OAUTH2_PROXY_COOKIE_SECRET|cookieSecret)["']?\s*[=:\s]\s*["']?([^"'\s,#]+)`),
+		},
+		{
+			// traefik-forward-auth's SECRET and the secrets of JWT middleware
+			// plugins in Traefik's dynamic config.
+			proxy:   ProxyTraefik,
+			markers: []string{"traefik-forward-auth", "forwardAuth", "forwardauth", "traefik"},
+			re:      regexp.MustCompile(`(?mi)^[\s-]*(--secret|secret|signingSecret|jwtSecret|sharedSecret)["']?\s*[=:]\s*["']?([^"'\s,#]+)`),
+		},
+		{
+			// The auth_jwt_key directive of the ngx-http-auth-jwt modules.
+			proxy:   ProxyNginx,
+			markers: []string{"auth_jwt"},
+			re:      regexp.MustCompile(`(?m)\b(auth_jwt_key)\s+["']?([^"'\s;]+)["']?(?:\s+(?:hex|base64|utf8))?\s*;`),
+		},
+	}
+
+	// Placeholders for values resolved at deploy time, e.g. ${SECRET},
+	// {{ .Values.secret }} or <cookie-secret>, aren't keys.
+	placeholderRe = regexp.MustCompile(`^(\$\{?[A-Za-z_]|\{\{|<.*>|%\(|/)`)
+)
+
+// detector finds shared signing keys in reverse proxy configs.
+type detector struct{}
+
+// NewDetector returns a Detector that finds the signing keys of
+// oauth2-proxy, Traefik forward-auth and JWT middlewares and nginx auth_jwt.
+func NewDetector() veles.Detector { return detector{} }
+
+// MaxSecretLen returns the maximum size of a proxy config.
+func (detector) MaxSecretLen() uint32 { return maxConfigLen }
+
+// Detect finds signing keys in data.
+func (detector) Detect(data []byte) ([]veles.Secret, []int) {
+	var found []SigningKey
+	for _, r := range rules {
+		if !slices.ContainsFunc(r.markers, func(m string) bool { return bytes.Contains(data, []byte(m)) }) {
+			continue
+		}
+		for _, m := range r.re.FindAllSubmatch(data, -1) {
+			found = append(found, SigningKey{
+				Proxy:   r.proxy,
+				Setting: strings.TrimLeft(string(m[1]), "-"),
+				Key:     string(m[2]),
+			})
+		}
+	}
+	if bytes.Contains(data, []byte(`"oct"`)) {
+		found = append(found, jwkKeys(data)...)
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for _, k := range found {
+		if len(k.Key) < minKeyLen || placeholderRe.MatchString(k.Key) {
+			continue
+		}
+		if slices.ContainsFunc(secrets, func(s veles.Secret) bool { return s.(SigningKey).Key == k.Key }) {
+			continue
+		}
+		pos := bytes.Index(data, []byte(k.Key))
+		if pos < 0 {
+			pos = 0
+		}
+		secrets = append(secrets, k)
+		positions = append(positions, pos)
+	}
+	return secrets, positions
+}
+
+type jwk struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	K       string `json:"k"`
+}
+
+// jwkKeys returns the symmetric keys of a JSON Web Key or Key Set, the
+// format of nginx's auth_jwt_key_file.
+func jwkKeys(data []byte) []SigningKey {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil
+	}
+	if len(set.Keys) == 0 {
+		var single jwk
+		if err := json.Unmarshal(data, &single); err != nil {
+			return nil
+		}
+		set.Keys = []jwk{single}
+	}
+	var keys []SigningKey
+	for _, k := range set.Keys {
+		if k.KeyType != "oct" || k.K == "" {
+			continue
+		}
+		keys = append(keys, SigningKey{Proxy: ProxyNginx, Setting: "auth_jwt_key_file", Key: k.K, KeyID: k.KeyID})
+	}
+	return keys
+}
@@ -0,0 +1,208 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyauth_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/proxyauth"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const oauth2ProxyCfg = `## oauth2-proxy.cfg
+http_address = "0.0.0.0:4180"
+upstreams = ["http://127.0.0.1:8080/"]
+client_id = "app"
+cookie_secret = "OQINaROshtE9TcZkNAm-5Zs2Pv3xaWytBmc5W7sPX7w="
+cookie_secure = true
+`
+
+const oauth2ProxyDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: oauth2-proxy
+spec:
+  template:
+    spec:
+      containers:
+      - name: oauth2-proxy
+        image: quay.io/oauth2-proxy/oauth2-proxy:v7.6.0
+        args:
+        - --provider=oidc
+        - --cookie-secret=b2F1dGgyLXByb3h5LWNvb2tpZQ
+        env:
+        - name: OAUTH2_PROXY_CLIENT_SECRET
+          valueFrom:
+            secretKeyRef: {name: oauth2-proxy, key: client-secret}
+`
+
+const oauth2ProxyValues = `# Values for the oauth2-proxy chart
+config:
+  clientID: app
+  cookieSecret: "{{ .Values.cookieSecret }}"
+`
+
+const forwardAuthCompose = `services:
+  traefik-forward-auth:
+    image: thomseddon/traefik-forward-auth:2
+    environment:
+      - PROVIDERS_GOOGLE_CLIENT_ID=app.apps.googleusercontent.com
+      - SECRET=fwd-auth-shared-signing-secret
+      - LOG_LEVEL=info
+    labels:
+      - "traefik.http.middlewares.sso.forwardauth.address=http://traefik-forward-auth:4181"
+`
+
+const traefikJWTMiddleware = `http:
+  middlewares:
+    jwt-auth:
+      plugin:
+        jwt:
+          signingSecret: "traefik-jwt-hmac-signing-key"
+          alg: HS256
+    short:
+      plugin:
+        jwt:
+          secret: tooshort
+`
+
+const nginxConf = `server {
+    listen 443 ssl;
+    location /api/ {
+        auth_jwt_enabled on;
+        auth_jwt_key "6e67696e782d6a77742d68657832353621" hex;
+        auth_jwt_location HEADER=Authorization;
+        proxy_pass http://api;
+    }
+    location /admin/ {
+        auth_jwt_key "${JWT_SECRET}";
+    }
+}
+`
+
+const jwks = `{
+  "keys": [
+    {"kty": "oct", "kid": "0001", "k": "bmdpbngtcGx1cy1zeW1tZXRyaWMta2V5"},
+    {"kty": "RSA", "kid": "0002", "n": "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw", "e": "AQAB"}
+  ]
+}
+`
+
+func TestDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "oauth2-proxy config file",
+			input: oauth2ProxyCfg,
+			want: []veles.Secret{
+				proxyauth.SigningKey{
+					Proxy:   proxyauth.ProxyOAuth2Proxy,
+					Setting: "cookie_secret",
+					Key:     "OQINaROshtE9TcZkNAm-5Zs2Pv3xaWytBmc5W7sPX7w=",
+				},
+			},
+		},
+		{
+			name:  "oauth2-proxy command line flag",
+			input: oauth2ProxyDeployment,
+			want: []veles.Secret{
+				proxyauth.SigningKey{
+					Proxy:   proxyauth.ProxyOAuth2Proxy,
+					Setting: "cookie-secret",
+					Key:     "b2F1dGgyLXByb3h5LWNvb2tpZQ",
+				},
+			},
+		},
+		{
+			name:  "oauth2-proxy environment variable",
+			input: "OAUTH2_PROXY_COOKIE_SECRET=ZW52LWNvb2tpZS1zZWNyZXQ\n",
+			want: []veles.Secret{
+				proxyauth.SigningKey{
+					Proxy:   proxyauth.ProxyOAuth2Proxy,
+					Setting: "OAUTH2_PROXY_COOKIE_SECRET",
+					Key:     "ZW52LWNvb2tpZS1zZWNyZXQ",
+				},
+			},
+		},
+		{
+			name:  "templated oauth2-proxy values",
+			input: oauth2ProxyValues,
+		},
+		{
+			name:  "traefik-forward-auth compose file",
+			input: forwardAuthCompose,
+			want: []veles.Secret{
+				proxyauth.SigningKey{
+					Proxy:   proxyauth.ProxyTraefik,
+					Setting: "SECRET",
+					Key:     "fwd-auth-shared-signing-secret",
+				},
+			},
+		},
+		{
+			name:  "traefik JWT middleware",
+			input: traefikJWTMiddleware,
+			want: []veles.Secret{
+				proxyauth.SigningKey{
+					Proxy:   proxyauth.ProxyTraefik,
+					Setting: "signingSecret",
+					Key:     "traefik-jwt-hmac-signing-key",
+				},
+			},
+		},
+		{
+			name:  "nginx auth_jwt_key",
+			input: nginxConf,
+			want: []veles.Secret{
+				proxyauth.SigningKey{
+					Proxy:   proxyauth.ProxyNginx,
+					Setting: "auth_jwt_key",
+					Key:     "6e67696e782d6a77742d68657832353621",
+				},
+			},
+		},
+		{
+			name:  "nginx auth_jwt_key_file JWKS",
+			input: jwks,
+			want: []veles.Secret{
+				proxyauth.SigningKey{
+					Proxy:   proxyauth.ProxyNginx,
+					Setting: "auth_jwt_key_file",
+					Key:     "bmdpbngtcGx1cy1zeW1tZXRyaWMta2V5",
+					KeyID:   "0001",
+				},
+			},
+		},
+		{
+			name:  "generic secret without proxy context",
+			input: "database:\n  secret: not-a-proxy-signing-key\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := velestest.Detect(t, proxyauth.NewDetector(), tc.input)
+			if diff := cmp.Diff(tc.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package proxyauth contains a Veles Secret type and a Detector for the
+// shared signing keys of authenticating reverse proxies: the cookie secret
+// of oauth2-proxy, the secret of Traefik forward-auth services and JWT
+// middlewares and the keys of nginx auth_jwt configs.
+//
+// The proxies sign or verify the sessions and tokens of every application
+// behind them with these keys, so a leaked key allows minting valid
+// credentials for all of them.
+package proxyauth
+
+// Proxies the signing keys belong to.
+const (
+	ProxyOAuth2Proxy = "oauth2-proxy"
+	ProxyTraefik     = "traefik"
+	ProxyNginx       = "nginx"
+)
+
+// SigningKey is a shared key a reverse proxy signs or verifies sessions or
+// tokens with.
+type SigningKey struct {
+	// Proxy is the proxy or auth service the key is configured for, e.g.
+	// ProxyOAuth2Proxy.
+	Proxy string
+	// Setting is the option, directive or environment variable the key is set
+	// with, e.g. "cookie_secret".
+	Setting string
+	Key     string
+	// KeyID is the "kid" of a key from a JSON Web Key Set.
+	KeyID string
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rollbar contains a Veles Secret type, Detector and Validator for
+// Rollbar access tokens.
+package rollbar
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// maxContextLen bounds the variable name, separator and token matched by the
+// detection regex.
+const maxContextLen = 128
+
+// Rollbar access tokens are 32 hex characters without a distinctive prefix, so
+// they're only reported when assigned to a Rollbar-related key, e.g.
+// ROLLBAR_ACCESS_TOKEN=... or "rollbar_token": "...".
+var tokenRe = regexp.MustCompile(`(?i:rollbar[a-z0-9_.\-]{0,40})["']?\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{32})\b`)
+
+// AccessToken is a Rollbar project or account access token.
+type AccessToken struct {
+	Token string
+}
+
+// NewDetector returns a Detector that finds Rollbar access tokens.
+func NewDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxContextLen,
+		Re:     tokenRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return AccessToken{Token: string(b)}
+		},
+	}
+}
+
+// DefaultEndpoint is a read endpoint of the Rollbar API.
+const DefaultEndpoint = "https://api.rollbar.com/api/1/items"
+
+// NewValidator returns a Validator for Rollbar access tokens that sends
+// requests to endpoint using the given client (nil for a default client).
+//
+// Tokens with only the post_server_item/post_client_item scope are rejected
+// from read endpoints with 403, which still proves the token is active.
+func NewValidator(endpoint string, client *http.Client) veles.Validator[AccessToken] {
+	return &simplevalidate.Validator[AccessToken]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(t AccessToken) map[string]string {
+			return map[string]string{"X-Rollbar-Access-Token": t.Token}
+		},
+		ValidResponseCodes:   []int{http.StatusOK, http.StatusForbidden},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
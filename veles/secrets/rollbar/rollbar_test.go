@@ -0,0 +1,110 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollbar_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/rollbar"
+)
+
+const token = "0123456789abcdef0123456789abcdef"
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{rollbar.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "env variable",
+			input: "ROLLBAR_ACCESS_TOKEN=" + token + "\n",
+			want:  []veles.Secret{rollbar.AccessToken{Token: token}},
+		},
+		{
+			name:  "json config",
+			input: `{"rollbarToken": "` + token + `"}`,
+			want:  []veles.Secret{rollbar.AccessToken{Token: token}},
+		},
+		{
+			name:  "hex string without rollbar context",
+			input: "API_TOKEN=" + token,
+			want:  nil,
+		},
+		{
+			name:  "token too long",
+			input: "ROLLBAR_TOKEN=" + token + "ff",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{name: "read scope", status: http.StatusOK, want: veles.ValidationValid},
+		{name: "write-only scope", status: http.StatusForbidden, want: veles.ValidationValid},
+		{name: "invalid", status: http.StatusUnauthorized, want: veles.ValidationInvalid},
+		{name: "unexpected status", status: http.StatusTeapot, want: veles.ValidationFailed, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("X-Rollbar-Access-Token"); got != token {
+					t.Errorf("X-Rollbar-Access-Token = %q, want %q", got, token)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer s.Close()
+
+			v := rollbar.NewValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), rollbar.AccessToken{Token: token})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
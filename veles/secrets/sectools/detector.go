@@ -0,0 +1,182 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sectools
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxContextLen bounds the variable names, separators and values matched
+	// by the detection regexes.
+	maxContextLen = 512
+	// maxTenablePairLen is how far apart a Tenable access and secret key can
+	// be when configured separately.
+	maxTenablePairLen = 1 * veles.KiB
+)
+
+var (
+	// The REST API of Burp Suite Enterprise takes the key as a path segment,
+	// e.g. https://burp.example.com/api/<key>/v0.1/scan.
+	burpURLRe = regexp.MustCompile(`(https?://[A-Za-z0-9.\-]{1,253}(?::[0-9]{1,5})?)/api/([A-Za-z0-9]{32})/v0\.1\b`)
+	burpEnvRe = regexp.MustCompile(`(?i:burp[a-z0-9_.\-]{0,40}api[_\-]?key)["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([A-Za-z0-9]{32})\b`)
+
+	// Tenable APIs authenticate with the header
+	// "X-ApiKeys: accessKey=<key>; secretKey=<key>".
+	tenableHeaderRe    = regexp.MustCompile(`accessKey=([0-9a-f]{64})\s{0,5};\s{0,5}secretKey=([0-9a-f]{64})\b`)
+	tenableAccessKeyRe = regexp.MustCompile(`(?i:(?:tenable|tio|tsc|nessus)[a-z0-9_.\-]{0,20}access[_\-]?key)["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{64})\b`)
+	tenableSecretKeyRe = regexp.MustCompile(`(?i:(?:tenable|tio|tsc|nessus)[a-z0-9_.\-]{0,20}secret[_\-]?key)["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{64})\b`)
+
+	// Nessus activation codes are groups of 4 characters without a
+	// distinctive prefix, so they're only reported when passed to nessuscli
+	// or assigned to an activation code variable, e.g. the ACTIVATION_CODE
+	// environment variable of the Nessus container image.
+	nessusCLIRe = regexp.MustCompile(`nessuscli\s{1,10}fetch\s{1,10}--register(?:-only)?\s{1,10}([A-Z0-9]{4}(?:-[A-Z0-9]{4}){3,4})\b`)
+	nessusEnvRe = regexp.MustCompile(`(?i:nessus[a-z0-9_.\-]{0,40}(?:activation|license)[a-z_\-]{0,10}|activation[_\-]?code)["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([A-Z0-9]{4}(?:-[A-Z0-9]{4}){3,4})\b`)
+
+	// Acunetix API keys are 65 hex characters sent in the X-Auth header.
+	acunetixRe = regexp.MustCompile(`(?i:acunetix[a-z0-9_.\-]{0,40}|x-auth)["'\]]{0,2}\s{0,5}[:=]\s{0,5}["']?([0-9a-f]{65})\b`)
+)
+
+// burpDetector finds Burp Suite Enterprise API keys in REST API URLs and
+// environment variables.
+type burpDetector struct{}
+
+// NewBurpEnterpriseDetector returns a Detector that finds Burp Suite
+// Enterprise Edition API keys.
+func NewBurpEnterpriseDetector() veles.Detector { return burpDetector{} }
+
+// MaxSecretLen returns the maximum length of a Burp API key with its context.
+func (burpDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds Burp Suite Enterprise API keys in data.
+func (burpDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range burpURLRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, BurpEnterpriseAPIKey{
+			Key: string(data[m[4]:m[5]]),
+			URL: string(data[m[2]:m[3]]),
+		})
+		positions = append(positions, m[0])
+	}
+	for _, m := range burpEnvRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, BurpEnterpriseAPIKey{Key: string(data[m[2]:m[3]])})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// tenableDetector finds Tenable API key pairs in X-ApiKeys headers and
+// configuration files.
+type tenableDetector struct{}
+
+// NewTenableDetector returns a Detector that finds Tenable API key pairs.
+func NewTenableDetector() veles.Detector { return tenableDetector{} }
+
+// MaxSecretLen returns the maximum distance between a Tenable access and
+// secret key.
+func (tenableDetector) MaxSecretLen() uint32 { return maxTenablePairLen }
+
+// Detect finds Tenable API key pairs in data. Keys without their counterpart
+// are not reported since neither authenticates on its own.
+func (tenableDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range tenableHeaderRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, TenableAPIKeys{
+			AccessKey: string(data[m[2]:m[3]]),
+			SecretKey: string(data[m[4]:m[5]]),
+		})
+		positions = append(positions, m[0])
+	}
+	secretKeys := tenableSecretKeyRe.FindAllSubmatchIndex(data, -1)
+	for _, m := range tenableAccessKeyRe.FindAllSubmatchIndex(data, -1) {
+		s := nearest(secretKeys, m[0])
+		if s == nil {
+			continue
+		}
+		secrets = append(secrets, TenableAPIKeys{
+			AccessKey: string(data[m[2]:m[3]]),
+			SecretKey: string(data[s[2]:s[3]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// nearest returns the match closest to pos within maxTenablePairLen, or nil if
+// there is none.
+func nearest(matches [][]int, pos int) []int {
+	var best []int
+	bestDist := maxTenablePairLen + 1
+	for _, m := range matches {
+		dist := m[0] - pos
+		if dist < 0 {
+			dist = -dist
+		}
+		if dist < bestDist {
+			best, bestDist = m, dist
+		}
+	}
+	return best
+}
+
+// nessusDetector finds Nessus activation codes.
+type nessusDetector struct{}
+
+// NewNessusActivationCodeDetector returns a Detector that finds Nessus
+// activation codes.
+func NewNessusActivationCodeDetector() veles.Detector { return nessusDetector{} }
+
+// MaxSecretLen returns the maximum length of an activation code with its
+// context.
+func (nessusDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds Nessus activation codes in data.
+func (nessusDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, re := range []*regexp.Regexp{nessusCLIRe, nessusEnvRe} {
+		for _, m := range re.FindAllSubmatchIndex(data, -1) {
+			secrets = append(secrets, NessusActivationCode{Code: string(data[m[2]:m[3]])})
+			positions = append(positions, m[0])
+		}
+	}
+	return secrets, positions
+}
+
+// acunetixDetector finds Acunetix API keys.
+type acunetixDetector struct{}
+
+// NewAcunetixDetector returns a Detector that finds Acunetix API keys.
+func NewAcunetixDetector() veles.Detector { return acunetixDetector{} }
+
+// MaxSecretLen returns the maximum length of an Acunetix API key with its
+// context.
+func (acunetixDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds Acunetix API keys in data.
+func (acunetixDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range acunetixRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, AcunetixAPIKey{Key: string(data[m[2]:m[3]])})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sectools_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/sectools"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const (
+	burpKey          = "Xk3pQ9vLm2Rt7WbN4cJh8ZsY1dFg6AeU"
+	tenableAccessKey = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	tenableSecretKey = "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	nessusCode       = "ABCD-1234-EFGH-5678"
+	acunetixKey      = "1986ad8c0a5b3df4d7028d5f3c06e936c0123456789abcdef0123456789abcdef"
+)
+
+func TestBurpEnterpriseDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "REST API URL",
+			input: "curl https://burp.example.com:8443/api/" + burpKey + "/v0.1/scan -d @scan.json",
+			want: []veles.Secret{sectools.BurpEnterpriseAPIKey{
+				Key: burpKey,
+				URL: "https://burp.example.com:8443",
+			}},
+		},
+		{
+			name:  "CI variable",
+			input: "BURP_ENTERPRISE_API_KEY: " + burpKey + "\n",
+			want:  []veles.Secret{sectools.BurpEnterpriseAPIKey{Key: burpKey}},
+		},
+		{
+			name:  "key without burp context",
+			input: "API_KEY=" + burpKey,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, sectools.NewBurpEnterpriseDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTenableDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "X-ApiKeys header",
+			input: `curl -H "X-ApiKeys: accessKey=` + tenableAccessKey + `; secretKey=` + tenableSecretKey + `" https://cloud.tenable.com/scans`,
+			want: []veles.Secret{sectools.TenableAPIKeys{
+				AccessKey: tenableAccessKey,
+				SecretKey: tenableSecretKey,
+			}},
+		},
+		{
+			name:  "environment variables",
+			input: "TIO_ACCESS_KEY=" + tenableAccessKey + "\nTIO_SECRET_KEY=" + tenableSecretKey + "\n",
+			want: []veles.Secret{sectools.TenableAPIKeys{
+				AccessKey: tenableAccessKey,
+				SecretKey: tenableSecretKey,
+			}},
+		},
+		{
+			name:  "YAML config with secret key first",
+			input: "tenable:\n  tenable_secret_key: " + tenableSecretKey + "\n  tenable_access_key: " + tenableAccessKey + "\n",
+			want: []veles.Secret{sectools.TenableAPIKeys{
+				AccessKey: tenableAccessKey,
+				SecretKey: tenableSecretKey,
+			}},
+		},
+		{
+			name:  "access key without secret key",
+			input: "TIO_ACCESS_KEY=" + tenableAccessKey + "\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, sectools.NewTenableDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNessusActivationCodeDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "nessuscli",
+			input: "RUN /opt/nessus/sbin/nessuscli fetch --register " + nessusCode + "\n",
+			want:  []veles.Secret{sectools.NessusActivationCode{Code: nessusCode}},
+		},
+		{
+			name:  "container environment",
+			input: "docker run -e ACTIVATION_CODE=ABCD-1234-EFGH-5678-IJKL tenable/nessus:latest-ubuntu",
+			want:  []veles.Secret{sectools.NessusActivationCode{Code: "ABCD-1234-EFGH-5678-IJKL"}},
+		},
+		{
+			name:  "ansible variable",
+			input: `nessus_license_key: "` + nessusCode + `"`,
+			want:  []veles.Secret{sectools.NessusActivationCode{Code: nessusCode}},
+		},
+		{
+			name:  "code without nessus context",
+			input: "SERIAL=" + nessusCode,
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, sectools.NewNessusActivationCodeDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAcunetixDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "X-Auth header",
+			input: `requests.get(url, headers={"X-Auth": "` + acunetixKey + `"})`,
+			want:  []veles.Secret{sectools.AcunetixAPIKey{Key: acunetixKey}},
+		},
+		{
+			name:  "environment variable",
+			input: "ACUNETIX_API_KEY=" + acunetixKey + "\n",
+			want:  []veles.Secret{sectools.AcunetixAPIKey{Key: acunetixKey}},
+		},
+		{
+			name:  "64 hex characters",
+			input: "ACUNETIX_API_KEY=" + acunetixKey[:64] + "\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, sectools.NewAcunetixDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sectools contains Veles Secret types, Detectors and Validators for
+// license keys and API keys of commercial security tools: Burp Suite
+// Enterprise, Tenable and Nessus, and Acunetix.
+//
+// These keys authenticate to the vendor's cloud or to self-hosted scanners
+// and let anyone who finds them run the licensed offensive tooling.
+package sectools
+
+// BurpEnterpriseAPIKey is an API key of a Burp Suite Enterprise Edition
+// server's REST API.
+type BurpEnterpriseAPIKey struct {
+	Key string
+	// URL is the server the key was used with, if found in a REST API URL.
+	URL string
+}
+
+// TenableAPIKeys is the access and secret key pair of the Tenable
+// Vulnerability Management, Tenable Security Center or Nessus API.
+type TenableAPIKeys struct {
+	AccessKey string
+	SecretKey string
+}
+
+// NessusActivationCode is a code that registers a Nessus scanner with a
+// license.
+type NessusActivationCode struct {
+	Code string
+}
+
+// AcunetixAPIKey is an API key of an Acunetix scanner.
+type AcunetixAPIKey struct {
+	Key string
+}
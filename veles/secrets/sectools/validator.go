@@ -0,0 +1,44 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sectools
+
+import (
+	"net/http"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// DefaultTenableEndpoint returns the user the keys belong to in Tenable
+// Vulnerability Management without side effects.
+const DefaultTenableEndpoint = "https://cloud.tenable.com/session"
+
+// NewTenableValidator returns a Validator for Tenable API keys that sends
+// requests to endpoint using the given client (nil for a default client).
+//
+// Keys of self-hosted Tenable Security Center and Nessus instances can be
+// validated against the instance's session endpoint, e.g.
+// https://nessus.example.com:8834/session.
+func NewTenableValidator(endpoint string, client *http.Client) veles.Validator[TenableAPIKeys] {
+	return &simplevalidate.Validator[TenableAPIKeys]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(k TenableAPIKeys) map[string]string {
+			return map[string]string{"X-ApiKeys": "accessKey=" + k.AccessKey + "; secretKey=" + k.SecretKey}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
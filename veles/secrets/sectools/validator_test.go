@@ -0,0 +1,77 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sectools_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/sectools"
+)
+
+func TestTenableValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    sectools.TenableAPIKeys
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			keys: sectools.TenableAPIKeys{AccessKey: tenableAccessKey, SecretKey: tenableSecretKey},
+			want: veles.ValidationValid,
+		},
+		{
+			name:   "invalid",
+			keys:   sectools.TenableAPIKeys{AccessKey: tenableAccessKey, SecretKey: tenableAccessKey},
+			status: http.StatusUnauthorized,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "server error",
+			keys:    sectools.TenableAPIKeys{AccessKey: tenableAccessKey, SecretKey: tenableAccessKey},
+			status:  http.StatusInternalServerError,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	wantHeader := "accessKey=" + tenableAccessKey + "; secretKey=" + tenableSecretKey
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-ApiKeys") == wantHeader {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer s.Close()
+
+			v := sectools.NewTenableValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), tt.keys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
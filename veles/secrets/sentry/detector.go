@@ -0,0 +1,112 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+)
+
+const (
+	// maxOrgTokenLen is a generous upper bound for org auth tokens: prefix,
+	// JSON payload of up to 400 base64 characters and the 43 character secret.
+	maxOrgTokenLen = 460
+	// userTokenLen is the length of a user auth token including its prefix.
+	userTokenLen = 71
+	// maxDSNLen is an upper bound for a DSN with both keys and a long host.
+	maxDSNLen = 350
+)
+
+var (
+	orgTokenRe  = regexp.MustCompile(`sntrys_[A-Za-z0-9+/]{20,400}={0,2}_[A-Za-z0-9+/]{43}`)
+	userTokenRe = regexp.MustCompile(`sntryu_[0-9a-f]{64}`)
+	// Legacy DSN format: https://<public>:<secret>@<host>/<path>/<project_id>
+	dsnRe = regexp.MustCompile(`https?://([0-9a-f]{32}):([0-9a-f]{32})@([A-Za-z0-9.\-]{1,200}(?::[0-9]{1,5})?)/(?:[A-Za-z0-9_.\-]{1,40}/){0,2}([0-9]{1,20})`)
+)
+
+type orgTokenPayload struct {
+	URL string `json:"url"`
+	Org string `json:"org"`
+}
+
+// NewOrgAuthTokenDetector returns a Detector that finds Sentry organization
+// auth tokens.
+func NewOrgAuthTokenDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxOrgTokenLen,
+		Re:     orgTokenRe,
+		FromMatch: func(b []byte) veles.Secret {
+			token := string(b)
+			payload := strings.TrimPrefix(token, "sntrys_")
+			payload = payload[:strings.LastIndex(payload, "_")]
+			decoded, err := base64.StdEncoding.DecodeString(payload)
+			if err != nil {
+				// Some tokens are stored without base64 padding.
+				if decoded, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(payload, "=")); err != nil {
+					return nil
+				}
+			}
+			var p orgTokenPayload
+			if err := json.Unmarshal(decoded, &p); err != nil {
+				return nil
+			}
+			return OrgAuthToken{Token: token, Org: p.Org, URL: p.URL}
+		},
+	}
+}
+
+// NewUserAuthTokenDetector returns a Detector that finds Sentry user auth
+// tokens.
+func NewUserAuthTokenDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: userTokenLen,
+		Re:     userTokenRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return UserAuthToken{Token: string(b)}
+		},
+	}
+}
+
+// dsnDetector finds legacy DSNs that contain a secret key.
+type dsnDetector struct{}
+
+// NewDSNDetector returns a Detector that finds Sentry DSNs containing a
+// project secret key.
+func NewDSNDetector() veles.Detector { return dsnDetector{} }
+
+// MaxSecretLen returns the maximum length of a DSN.
+func (dsnDetector) MaxSecretLen() uint32 { return maxDSNLen }
+
+// Detect finds legacy DSNs in data.
+func (dsnDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range dsnRe.FindAllSubmatchIndex(data, -1) {
+		secrets = append(secrets, DSN{
+			DSN:       string(data[m[0]:m[1]]),
+			PublicKey: string(data[m[2]:m[3]]),
+			SecretKey: string(data[m[4]:m[5]]),
+			Host:      string(data[m[6]:m[7]]),
+			ProjectID: string(data[m[8]:m[9]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sentry contains Veles Secret types, Detectors and Validators for
+// Sentry error tracking credentials.
+package sentry
+
+// OrgAuthToken is a Sentry organization auth token (prefix "sntrys_").
+// The token embeds a base64 encoded JSON payload identifying the organization
+// and the Sentry instance it belongs to.
+type OrgAuthToken struct {
+	Token string
+	// Org is the slug of the organization the token belongs to.
+	Org string
+	// URL is the Sentry instance the token was issued by.
+	URL string
+}
+
+// UserAuthToken is a Sentry user auth token (prefix "sntryu_").
+type UserAuthToken struct {
+	Token string
+}
+
+// DSN is a legacy Sentry DSN that still contains the project's secret key.
+// Modern DSNs only contain a public key and are meant to be embedded in client
+// applications, so they're not reported.
+type DSN struct {
+	DSN       string
+	PublicKey string
+	SecretKey string
+	// Host is the Sentry ingestion host, e.g. "o123.ingest.sentry.io".
+	Host string
+	// ProjectID is the numeric ID of the Sentry project the DSN reports to.
+	ProjectID string
+}
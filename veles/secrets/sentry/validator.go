@@ -0,0 +1,56 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentry
+
+import (
+	"net/http"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// DefaultEndpoint lists the organizations visible to a token on sentry.io.
+// Self-hosted instances can be validated by setting a different endpoint; the
+// URL embedded in org tokens is deliberately not used as it comes from
+// untrusted scanned data.
+const DefaultEndpoint = "https://sentry.io/api/0/organizations/"
+
+func bearer(token string) map[string]string {
+	return map[string]string{"Authorization": "Bearer " + token}
+}
+
+// NewOrgAuthTokenValidator returns a Validator for OrgAuthTokens that sends
+// requests to endpoint using the given client (nil for a default client).
+func NewOrgAuthTokenValidator(endpoint string, client *http.Client) veles.Validator[OrgAuthToken] {
+	return &simplevalidate.Validator[OrgAuthToken]{
+		Endpoint:             endpoint,
+		HTTPHeaders:          func(t OrgAuthToken) map[string]string { return bearer(t.Token) },
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
+
+// NewUserAuthTokenValidator returns a Validator for UserAuthTokens that sends
+// requests to endpoint using the given client (nil for a default client).
+func NewUserAuthTokenValidator(endpoint string, client *http.Client) veles.Validator[UserAuthToken] {
+	return &simplevalidate.Validator[UserAuthToken]{
+		Endpoint:             endpoint,
+		HTTPHeaders:          func(t UserAuthToken) map[string]string { return bearer(t.Token) },
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
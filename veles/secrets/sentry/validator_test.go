@@ -0,0 +1,102 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/sentry"
+)
+
+func newServer(t *testing.T, validToken string, status int) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "Bearer "+validToken {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestOrgAuthTokenValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			token: orgToken,
+			want:  veles.ValidationValid,
+		},
+		{
+			name:   "invalid",
+			token:  "sntrys_other",
+			status: http.StatusUnauthorized,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "server error",
+			token:   "sntrys_other",
+			status:  http.StatusInternalServerError,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newServer(t, orgToken, tt.status)
+			v := sentry.NewOrgAuthTokenValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), sentry.OrgAuthToken{Token: tt.token})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserAuthTokenValidator(t *testing.T) {
+	s := newServer(t, userToken, http.StatusUnauthorized)
+	v := sentry.NewUserAuthTokenValidator(s.URL, s.Client())
+
+	got, err := v.Validate(context.Background(), sentry.UserAuthToken{Token: userToken})
+	if err != nil {
+		t.Fatalf("Validate(valid token): %v", err)
+	}
+	if got != veles.ValidationValid {
+		t.Errorf("Validate(valid token) = %q, want %q", got, veles.ValidationValid)
+	}
+
+	got, err = v.Validate(context.Background(), sentry.UserAuthToken{Token: "sntryu_revoked"})
+	if err != nil {
+		t.Fatalf("Validate(revoked token): %v", err)
+	}
+	if got != veles.ValidationInvalid {
+		t.Errorf("Validate(revoked token) = %q, want %q", got, veles.ValidationInvalid)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sourcemap parses the source maps of JavaScript and CSS bundles
+// deployed to web roots and finds the secrets and internal paths they expose.
+//
+// Bundlers embed the original sources in the sourcesContent of source maps,
+// so a map deployed next to a minified bundle publishes code that was never
+// meant to leave the build, including hardcoded credentials that minification
+// would otherwise have mangled. The embedded sources are passed to the Veles
+// detectors one by one. The absolute paths of the sources on the build
+// machine are reported as a PathDisclosure with a lower severity.
+package sourcemap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxPaths is the maximum number of paths reported in a PathDisclosure.
+// Source maps of large bundles list thousands of sources.
+const maxPaths = 10
+
+// Severity of a PathDisclosure.
+type Severity string
+
+// Severity values.
+const (
+	// SeverityLow is used for source maps that only reveal the directories the
+	// application was built in.
+	SeverityLow Severity = "LOW"
+	// SeverityMedium is used for source maps that reveal home directories and
+	// with them the user names of developers or build accounts.
+	SeverityMedium Severity = "MEDIUM"
+)
+
+// PathDisclosure is a deployed source map that reveals absolute paths of the
+// machine the application was built on.
+type PathDisclosure struct {
+	// Up to 10 of the disclosed paths, sorted.
+	Paths []string
+	// Count is the total number of disclosed paths.
+	Count    int
+	Severity Severity
+}
+
+// SourceMap is a parsed source map, see https://tc39.es/source-map/.
+type SourceMap struct {
+	Version        int       `json:"version"`
+	File           string    `json:"file"`
+	SourceRoot     string    `json:"sourceRoot"`
+	Sources        []string  `json:"sources"`
+	SourcesContent []*string `json:"sourcesContent"`
+	// The sections of an index map, each with a map of its own.
+	Sections []struct {
+		Map *SourceMap `json:"map"`
+	} `json:"sections"`
+}
+
+// mapSuffixes are the file name suffixes of source maps of JavaScript and CSS
+// bundles.
+var mapSuffixes = []string{".js.map", ".mjs.map", ".cjs.map", ".css.map"}
+
+// webRootDirs are the names of directories that web servers and frameworks
+// serve static files from.
+var webRootDirs = map[string]bool{
+	"www":         true,
+	"html":        true,
+	"htdocs":      true,
+	"public_html": true,
+	"wwwroot":     true,
+	"webapps":     true,
+	"public":      true,
+	"static":      true,
+	"assets":      true,
+	"dist":        true,
+	"build":       true,
+}
+
+var (
+	// windowsPathRe matches absolute Windows paths, e.g. "C:\agent\_work".
+	windowsPathRe = regexp.MustCompile(`^[A-Za-z]:[\\/]`)
+	// homeDirRe matches paths in home directories.
+	homeDirRe = regexp.MustCompile(`^(?:/home/[^/]+|/Users/[^/]+|/root|[A-Za-z]:[\\/](?i:Users)[\\/][^\\/]+)(?:[\\/]|$)`)
+)
+
+// IsSourceMapFile returns true if the file at p is the source map of a
+// JavaScript or CSS bundle in a web root. Source maps of installed libraries
+// in node_modules aren't deployed and are skipped.
+func IsSourceMapFile(p string) bool {
+	p = filepath.ToSlash(p)
+	base := path.Base(p)
+	if !slices.ContainsFunc(mapSuffixes, func(s string) bool { return strings.HasSuffix(base, s) }) {
+		return false
+	}
+	dirs := strings.Split(path.Dir(p), "/")
+	if slices.Contains(dirs, "node_modules") {
+		return false
+	}
+	return slices.ContainsFunc(dirs, func(d string) bool { return webRootDirs[d] })
+}
+
+// Parse parses the source map read from r.
+func Parse(r io.Reader) (*SourceMap, error) {
+	var m SourceMap
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to parse source map: %w", err)
+	}
+	if len(m.Sources) == 0 && len(m.Sections) == 0 {
+		return nil, fmt.Errorf("not a source map: no sources or sections")
+	}
+	return &m, nil
+}
+
+// Contents returns the embedded sources of the map and the maps of its
+// sections. Sources without embedded content are skipped.
+func (m *SourceMap) Contents() []string {
+	var result []string
+	for _, c := range m.SourcesContent {
+		if c != nil && *c != "" {
+			result = append(result, *c)
+		}
+	}
+	for _, s := range m.Sections {
+		if s.Map != nil {
+			result = append(result, s.Map.Contents()...)
+		}
+	}
+	return result
+}
+
+// InternalPaths returns the distinct absolute paths of the sources of the map
+// and the maps of its sections on the machine they were built on, sorted.
+// Relative sources, e.g. "webpack://app/./src/index.js", don't disclose
+// anything and are skipped.
+func (m *SourceMap) InternalPaths() []string {
+	var result []string
+	for _, s := range m.Sources {
+		if p, ok := internalPath(m.SourceRoot, s); ok && !slices.Contains(result, p) {
+			result = append(result, p)
+		}
+	}
+	for _, s := range m.Sections {
+		if s.Map == nil {
+			continue
+		}
+		for _, p := range s.Map.InternalPaths() {
+			if !slices.Contains(result, p) {
+				result = append(result, p)
+			}
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// internalPath returns the absolute filesystem path a source refers to, if
+// any.
+func internalPath(sourceRoot, source string) (string, bool) {
+	if sourceRoot != "" && !strings.Contains(source, "://") && !strings.HasPrefix(source, "/") {
+		source = strings.TrimSuffix(sourceRoot, "/") + "/" + source
+	}
+	p := source
+	if rest, ok := strings.CutPrefix(p, "file://"); ok {
+		p = rest
+	} else if rest, ok := strings.CutPrefix(p, "webpack://"); ok {
+		// webpack://[namespace]/[resource path], the path is relative to the
+		// project unless the namespace is empty and the path isn't.
+		ns, rest, _ := strings.Cut(rest, "/")
+		if ns == "" && !strings.HasPrefix(rest, ".") && !strings.HasPrefix(rest, "/") {
+			rest = "/" + rest
+		}
+		p = rest
+	} else if strings.Contains(p, "://") {
+		return "", false
+	}
+
+	switch {
+	case windowsPathRe.MatchString(p):
+		return p, true
+	case strings.HasPrefix(p, "/") && !strings.HasPrefix(p, "/./") && !strings.HasPrefix(p, "/../"):
+		return path.Clean(p), true
+	default:
+		return "", false
+	}
+}
+
+// Detect parses the source map read from r and returns the secrets in it.
+// Each embedded source is passed to engine on its own, so all detectors run
+// on the original code rather than the JSON escaped map. If the map reveals
+// internal paths, a PathDisclosure is returned as well.
+func Detect(ctx context.Context, engine *veles.DetectionEngine, r io.Reader) ([]veles.Secret, error) {
+	m, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	var secrets []veles.Secret
+	for _, c := range m.Contents() {
+		found, err := engine.Detect(ctx, strings.NewReader(c))
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, found...)
+	}
+	if d, ok := pathDisclosure(m.InternalPaths()); ok {
+		secrets = append(secrets, d)
+	}
+	return secrets, nil
+}
+
+func pathDisclosure(paths []string) (PathDisclosure, bool) {
+	if len(paths) == 0 {
+		return PathDisclosure{}, false
+	}
+	d := PathDisclosure{Count: len(paths), Severity: SeverityLow}
+	if slices.ContainsFunc(paths, homeDirRe.MatchString) {
+		d.Severity = SeverityMedium
+	}
+	d.Paths = paths[:min(len(paths), maxPaths)]
+	return d, true
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tailscale contains Veles Secret types, Detectors and Validators for
+// Tailscale auth keys and API access tokens.
+package tailscale
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// maxKeyLen is a generous upper bound for the length of a key including its
+// prefix, key ID and secret.
+const maxKeyLen = 128
+
+// DefaultEndpoint lists the auth keys of the tailnet the API token belongs to.
+const DefaultEndpoint = "https://api.tailscale.com/api/v2/tailnet/-/keys"
+
+// Keys have the form tskey-<type>-<key ID>-<secret>.
+var (
+	authKeyRe = regexp.MustCompile(`tskey-auth-[A-Za-z0-9]{6,40}-[A-Za-z0-9]{16,64}`)
+	apiKeyRe  = regexp.MustCompile(`tskey-api-[A-Za-z0-9]{6,40}-[A-Za-z0-9]{16,64}`)
+)
+
+// AuthKey is a Tailscale auth key. It registers new devices in the tailnet
+// without any user interaction.
+//
+// There's no Validator since the only way to check an auth key is to register
+// a device with it.
+type AuthKey struct {
+	Key string
+}
+
+// APIKey is a Tailscale API access token.
+type APIKey struct {
+	Key string
+}
+
+// NewAuthKeyDetector returns a Detector that finds Tailscale auth keys.
+func NewAuthKeyDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxKeyLen,
+		Re:     authKeyRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return AuthKey{Key: string(b)}
+		},
+	}
+}
+
+// NewAPIKeyDetector returns a Detector that finds Tailscale API access tokens.
+func NewAPIKeyDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxKeyLen,
+		Re:     apiKeyRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return APIKey{Key: string(b)}
+		},
+	}
+}
+
+// NewAPIKeyValidator returns a Validator for APIKeys that sends requests to
+// endpoint using the given client (nil for a default client). A 403 response
+// means the token is valid but lacks the permission to list keys.
+func NewAPIKeyValidator(endpoint string, client *http.Client) veles.Validator[APIKey] {
+	return &simplevalidate.Validator[APIKey]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(k APIKey) map[string]string {
+			return map[string]string{"Authorization": "Bearer " + k.Key}
+		},
+		ValidResponseCodes:   []int{http.StatusOK, http.StatusForbidden},
+		InvalidResponseCodes: []int{http.StatusUnauthorized},
+		HTTPC:                client,
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxChiselCommandLen bounds the arguments of a chisel command line.
+const maxChiselCommandLen = 1000
+
+// chisel command lines, e.g. in shell scripts, systemd units or shell history.
+var chiselCommandRe = regexp.MustCompile(`chisel\s+(client|server)\b([^\n]{0,1000})`)
+
+// chiselValueFlags are the chisel flags that take a value. All other flags are
+// booleans, e.g. --reverse.
+var chiselValueFlags = map[string]bool{
+	"auth": true, "authfile": true, "backend": true, "fingerprint": true,
+	"header": true, "host": true, "hostname": true, "keepalive": true,
+	"key": true, "keyfile": true, "keygen": true, "max-retry-count": true,
+	"max-retry-interval": true, "p": true, "port": true, "proxy": true,
+	"sni": true, "tls-ca": true, "tls-cert": true, "tls-domain": true,
+	"tls-key": true,
+}
+
+// chiselDetector finds chisel credentials.
+type chiselDetector struct{}
+
+// NewChiselDetector returns a Detector that finds the credentials passed to
+// chisel servers and clients with --auth.
+func NewChiselDetector() veles.Detector { return chiselDetector{} }
+
+// MaxSecretLen returns the maximum length of a chisel command line.
+func (chiselDetector) MaxSecretLen() uint32 { return maxChiselCommandLen + 20 }
+
+// Detect finds chisel command lines with credentials in data.
+func (chiselDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range chiselCommandRe.FindAllSubmatchIndex(data, -1) {
+		client := string(data[m[2]:m[3]]) == "client"
+		a, ok := parseChiselArgs(string(data[m[4]:m[5]]), client)
+		if !ok {
+			continue
+		}
+		secrets = append(secrets, a)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// parseChiselArgs returns the credentials and, for clients, the server and
+// remotes from the arguments of a chisel command.
+func parseChiselArgs(args string, client bool) (ChiselAuth, bool) {
+	var a ChiselAuth
+	var positional []string
+	fields := strings.Fields(args)
+	for i := 0; i < len(fields); i++ {
+		f := strings.Trim(fields[i], `"'`)
+		if f == "&&" || f == "||" || f == "|" || f == ";" || strings.HasPrefix(f, ">") {
+			break
+		}
+		if !strings.HasPrefix(f, "-") {
+			positional = append(positional, f)
+			continue
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeft(f, "-"), "=")
+		value = strings.Trim(value, `"'`)
+		if !chiselValueFlags[name] {
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(fields) {
+				break
+			}
+			i++
+			value = strings.Trim(fields[i], `"'`)
+		}
+		if name == "auth" {
+			a.User, a.Password, _ = strings.Cut(value, ":")
+		}
+	}
+	if a.User == "" || a.Password == "" {
+		return ChiselAuth{}, false
+	}
+	if client && len(positional) > 0 {
+		a.Server = positional[0]
+	}
+	if client && len(positional) > 1 {
+		a.Targets = positional[1:]
+	}
+	return a, true
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+var (
+	// The credentials JSON file written by "cloudflared tunnel create", e.g.
+	// {"AccountTag":"...","TunnelSecret":"...","TunnelID":"..."}.
+	cloudflaredCredentialsRe = regexp.MustCompile(`\{[^{}]{0,500}"TunnelSecret"[^{}]{0,500}\}`)
+	// Tunnel tokens are base64 encoded JSON starting with {"a":" for the
+	// account tag, e.g. passed to "cloudflared tunnel run --token".
+	cloudflaredTokenRe = regexp.MustCompile(`eyJhIjoi[A-Za-z0-9+/_-]{40,600}={0,2}`)
+	// Ingress services in config.yml, e.g. "service: http://localhost:8000",
+	// and the origin of quick tunnels, e.g. "--url http://localhost:8000".
+	cloudflaredServiceRe = regexp.MustCompile(`(?m)(?:^\s*-?\s*service:\s*["']?|--url[=\s]+["']?)((?:https?|tcp|ssh|rdp|smb|unix|unix\+tls)://[^\s"']+)`)
+)
+
+type cloudflaredCredentialsFile struct {
+	AccountTag   string `json:"AccountTag"`
+	TunnelSecret string `json:"TunnelSecret"`
+	TunnelID     string `json:"TunnelID"`
+}
+
+type cloudflaredToken struct {
+	AccountTag   string `json:"a"`
+	TunnelSecret string `json:"s"`
+	TunnelID     string `json:"t"`
+}
+
+// cloudflaredDetector finds Cloudflare Tunnel credentials.
+type cloudflaredDetector struct{}
+
+// NewCloudflaredDetector returns a Detector that finds Cloudflare Tunnel
+// credentials files and tunnel tokens.
+func NewCloudflaredDetector() veles.Detector { return cloudflaredDetector{} }
+
+// MaxSecretLen returns the maximum length of the credentials including the
+// context searched for the tunnel targets.
+func (cloudflaredDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds Cloudflare Tunnel credentials in data.
+func (cloudflaredDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	add := func(c CloudflaredCredentials, start, end int) {
+		if c.AccountTag == "" || c.TunnelID == "" || !validTunnelSecret(c.TunnelSecret) {
+			return
+		}
+		c.Targets = findAll(window(data, start, end), cloudflaredServiceRe)
+		secrets = append(secrets, c)
+		positions = append(positions, start)
+	}
+	for _, m := range cloudflaredCredentialsRe.FindAllIndex(data, -1) {
+		var f cloudflaredCredentialsFile
+		if err := json.Unmarshal(data[m[0]:m[1]], &f); err != nil {
+			continue
+		}
+		add(CloudflaredCredentials{AccountTag: f.AccountTag, TunnelID: f.TunnelID, TunnelSecret: f.TunnelSecret}, m[0], m[1])
+	}
+	for _, m := range cloudflaredTokenRe.FindAllIndex(data, -1) {
+		b, err := decodeBase64(string(data[m[0]:m[1]]))
+		if err != nil {
+			continue
+		}
+		var t cloudflaredToken
+		if err := json.Unmarshal(b, &t); err != nil {
+			continue
+		}
+		add(CloudflaredCredentials{AccountTag: t.AccountTag, TunnelID: t.TunnelID, TunnelSecret: t.TunnelSecret}, m[0], m[1])
+	}
+	return secrets, positions
+}
+
+// validTunnelSecret returns true if s is a base64 encoded secret of at least
+// 32 bytes.
+func validTunnelSecret(s string) bool {
+	b, err := decodeBase64(s)
+	return err == nil && len(b) >= 32
+}
+
+// decodeBase64 decodes standard or URL-safe base64, with or without padding.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
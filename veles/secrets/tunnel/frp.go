@@ -0,0 +1,95 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+var (
+	// The token in frpc and frps configs: "token = ..." in the [common]
+	// section of the INI format, "auth.token = ..." or "token = ..." in the
+	// [auth] table of the TOML format.
+	frpTokenRe = regexp.MustCompile(`(?m)^[ \t]*(?:auth\.)?token[ \t]*=[ \t]*["']?([^\s"'#;]{1,200})`)
+	// frp specific settings, one of which is required near the token to tell
+	// frp configs apart from other INI and TOML files.
+	frpSettingRe   = regexp.MustCompile(`(?m)^[ \t]*(?:server_addr|serverAddr|bind_port|bindPort)[ \t]*=`)
+	frpServerRe    = regexp.MustCompile(`(?m)^[ \t]*(?:server_addr|serverAddr)[ \t]*=[ \t]*["']?([^\s"'#;]+)`)
+	frpPortRe      = regexp.MustCompile(`(?m)^[ \t]*(?:server_port|serverPort)[ \t]*=[ \t]*["']?([0-9]{1,5})`)
+	frpLocalIPRe   = regexp.MustCompile(`(?m)^[ \t]*(?:local_ip|localIP)[ \t]*=[ \t]*["']?([^\s"'#;]+)`)
+	frpLocalPortRe = regexp.MustCompile(`(?m)^[ \t]*(?:local_port|localPort)[ \t]*=[ \t]*["']?([0-9]{1,5})`)
+	// Section headers, e.g. "[ssh]" or "[[proxies]]".
+	frpSectionRe = regexp.MustCompile(`(?m)^[ \t]*\[`)
+)
+
+// frpDetector finds frp auth tokens.
+type frpDetector struct{}
+
+// NewFRPDetector returns a Detector that finds the auth tokens in frp server
+// and client configs.
+func NewFRPDetector() veles.Detector { return frpDetector{} }
+
+// MaxSecretLen returns the maximum length of a token including the context
+// searched for the server and proxies.
+func (frpDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds frp auth tokens in data.
+func (frpDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range frpTokenRe.FindAllSubmatchIndex(data, -1) {
+		ctx := window(data, m[0], m[1])
+		if !frpSettingRe.Match(ctx) {
+			continue
+		}
+		t := FRPToken{Token: string(data[m[2]:m[3]]), Targets: frpTargets(ctx)}
+		if server := frpServerRe.FindSubmatch(ctx); server != nil {
+			t.Server = string(server[1])
+			if port := frpPortRe.FindSubmatch(ctx); port != nil {
+				t.Server += ":" + string(port[1])
+			}
+		}
+		secrets = append(secrets, t)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// frpTargets returns the local address of each proxy section that sets a
+// local port.
+func frpTargets(ctx []byte) []string {
+	var targets []string
+	sections := frpSectionRe.FindAllIndex(ctx, -1)
+	for i, s := range sections {
+		end := len(ctx)
+		if i+1 < len(sections) {
+			end = sections[i+1][0]
+		}
+		section := ctx[s[0]:end]
+		port := frpLocalPortRe.FindSubmatch(section)
+		if port == nil {
+			continue
+		}
+		ip := []byte("127.0.0.1")
+		if m := frpLocalIPRe.FindSubmatch(section); m != nil {
+			ip = m[1]
+		}
+		targets = append(targets, string(bytes.Join([][]byte{ip, port[1]}, []byte(":"))))
+	}
+	return targets
+}
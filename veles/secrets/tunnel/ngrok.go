@@ -0,0 +1,61 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+var (
+	// Authtokens are two alphanumeric parts joined by an underscore, e.g.
+	// "2Lsn8vRYoQbKpzK1sFg9YaWgF1k_7tR2nbXZXDzYtJxRbb1Ez". As that's not
+	// distinctive, they're only matched as the authtoken of an ngrok.yml, the
+	// NGROK_AUTHTOKEN variable or an argument of the ngrok CLI.
+	ngrokTokenRe = regexp.MustCompile(`(?i:ngrok[a-z_]{0,20}auth_?token|authtoken)["']?(?:\s{0,5}[:=]\s{0,5}|\s{1,5})["']?([0-9A-Za-z]{16,30}_[0-9A-Za-z]{15,30})\b`)
+	// Tunnel addresses in ngrok.yml (v2) and command lines, e.g. "addr: 8080"
+	// or "ngrok http 8080".
+	ngrokAddrRe    = regexp.MustCompile(`(?m)^\s*addr:\s*["']?([^\s"'#]+)`)
+	ngrokCommandRe = regexp.MustCompile(`ngrok\s+(?:http|tcp|tls)\s+([^\s"'-][^\s"']*)`)
+	// Upstream URLs in ngrok.yml (v3), e.g. "upstream:\n  url: 8080".
+	ngrokUpstreamRe = regexp.MustCompile(`upstream:\s*\n\s*url:\s*["']?([^\s"'#]+)`)
+)
+
+// ngrokDetector finds ngrok authtokens.
+type ngrokDetector struct{}
+
+// NewNgrokDetector returns a Detector that finds ngrok authtokens.
+func NewNgrokDetector() veles.Detector { return ngrokDetector{} }
+
+// MaxSecretLen returns the maximum length of an authtoken including the
+// context searched for the tunnel targets.
+func (ngrokDetector) MaxSecretLen() uint32 { return maxContextLen }
+
+// Detect finds ngrok authtokens in data.
+func (ngrokDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range ngrokTokenRe.FindAllSubmatchIndex(data, -1) {
+		ctx := window(data, m[0], m[1])
+		var targets []string
+		for _, re := range []*regexp.Regexp{ngrokAddrRe, ngrokUpstreamRe, ngrokCommandRe} {
+			targets = append(targets, findAll(ctx, re)...)
+		}
+		secrets = append(secrets, NgrokAuthtoken{Token: string(data[m[2]:m[3]]), Targets: targets})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tunnel contains Veles Secret types and Detectors for the credentials
+// of tunneling tools that expose internal services to the internet: ngrok
+// authtokens, Cloudflare Tunnel credentials and frp and chisel auth tokens.
+//
+// Besides granting access to the tunnel provider, these credentials indicate
+// that a host may expose internal services without authorization, so the
+// Detectors also report the local services the tunnels forward to where the
+// surrounding config or command line makes that possible.
+package tunnel
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxContextLen is how far from a credential the tunnel targets and server
+// address are searched, e.g. the rest of an ngrok.yml or frpc.toml.
+const maxContextLen = 4 * veles.KiB
+
+// NgrokAuthtoken is an ngrok agent authtoken. It allows starting tunnels on
+// the ngrok account it belongs to.
+type NgrokAuthtoken struct {
+	Token string
+	// Local addresses the tunnels forward to, e.g. "8080" or
+	// "localhost:3000".
+	Targets []string
+}
+
+// CloudflaredCredentials are the credentials of a Cloudflare Tunnel, from a
+// credentials JSON file or a tunnel token. They allow running a connector
+// for the tunnel, i.e. receiving all traffic routed to it.
+type CloudflaredCredentials struct {
+	AccountTag   string
+	TunnelID     string
+	TunnelSecret string
+	// Services the tunnel's ingress rules forward to, e.g.
+	// "http://localhost:8000".
+	Targets []string
+}
+
+// FRPToken is the auth token of an frp (fast reverse proxy) server or client.
+// It allows registering proxies with the server.
+type FRPToken struct {
+	Token string
+	// Address of the frp server, e.g. "203.0.113.10:7000". Empty for the
+	// server's own config.
+	Server string
+	// Local addresses the client's proxies forward to, e.g. "127.0.0.1:22".
+	Targets []string
+}
+
+// ChiselAuth are the credentials of a chisel server or client.
+type ChiselAuth struct {
+	User     string
+	Password string
+	// URL of the chisel server the client connects to. Empty for servers.
+	Server string
+	// The client's remotes, e.g. "R:2222:localhost:22".
+	Targets []string
+}
+
+// window returns the context of a match at [start, end).
+func window(data []byte, start, end int) []byte {
+	return data[max(0, start-maxContextLen):min(len(data), end+maxContextLen)]
+}
+
+// findAll returns the text matched by the first group of re in data, without
+// duplicates.
+func findAll(data []byte, re *regexp.Regexp) []string {
+	var res []string
+	for _, m := range re.FindAllSubmatch(data, -1) {
+		if s := string(m[1]); !slices.Contains(res, s) {
+			res = append(res, s)
+		}
+	}
+	return res
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tunnel_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/tunnel"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const (
+	ngrokToken    = "2Lsn8vRYoQbKpzK1sFg9YaWgF1k_7tR2nbXZXDzYtJxRbb1Ez"
+	accountTag    = "6f2b1c0e9d8a7b6c5d4e3f2a1b0c9d8e"
+	tunnelID      = "0d7a9c2e-3b4f-4e5a-9c1d-2e3f4a5b6c7d"
+	tunnelSecret  = "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+	tunnelToken   = "eyJhIjoiNmYyYjFjMGU5ZDhhN2I2YzVkNGUzZjJhMWIwYzlkOGUiLCJ0IjoiMGQ3YTljMmUtM2I0Zi00ZTVhLTljMWQtMmUzZjRhNWI2YzdkIiwicyI6IkFBRUNBd1FGQmdjSUNRb0xEQTBPRHhBUkVoTVVGUllYR0JrYUd4d2RIaDg9In0="
+	frpToken      = "Xk29fLq0vB7zP3mN"
+	chiselCommand = "chisel client --auth tunnel:Pa55w0rd --keepalive 25s https://tunnel.example.com:443 R:2222:localhost:22 R:socks"
+)
+
+func TestNgrokDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "ngrok.yml v2",
+			input: "version: \"2\"\nauthtoken: " + ngrokToken + "\ntunnels:\n  web:\n    proto: http\n    addr: 8080\n  db:\n    proto: tcp\n    addr: localhost:5432\n",
+			want: []veles.Secret{tunnel.NgrokAuthtoken{
+				Token:   ngrokToken,
+				Targets: []string{"8080", "localhost:5432"},
+			}},
+		},
+		{
+			name:  "ngrok.yml v3",
+			input: "version: 3\nagent:\n  authtoken: " + ngrokToken + "\nendpoints:\n  - name: api\n    upstream:\n      url: 3000\n",
+			want:  []veles.Secret{tunnel.NgrokAuthtoken{Token: ngrokToken, Targets: []string{"3000"}}},
+		},
+		{
+			name:  "environment variable and command line",
+			input: "export NGROK_AUTHTOKEN=" + ngrokToken + "\nngrok http 127.0.0.1:9000 --domain example.ngrok.app\n",
+			want:  []veles.Secret{tunnel.NgrokAuthtoken{Token: ngrokToken, Targets: []string{"127.0.0.1:9000"}}},
+		},
+		{
+			name:  "add-authtoken command",
+			input: "ngrok config add-authtoken " + ngrokToken,
+			want:  []veles.Secret{tunnel.NgrokAuthtoken{Token: ngrokToken}},
+		},
+		{
+			name:  "token without context",
+			input: "id: " + ngrokToken,
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := velestest.Detect(t, tunnel.NewNgrokDetector(), tc.input)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudflaredDetector(t *testing.T) {
+	creds := tunnel.CloudflaredCredentials{AccountTag: accountTag, TunnelID: tunnelID, TunnelSecret: tunnelSecret}
+	withTargets := creds
+	withTargets.Targets = []string{"http://localhost:8000", "ssh://localhost:22"}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "credentials file",
+			input: `{"AccountTag":"` + accountTag + `","TunnelSecret":"` + tunnelSecret + `","TunnelID":"` + tunnelID + `","Endpoint":""}`,
+			want:  []veles.Secret{creds},
+		},
+		{
+			name:  "tunnel token",
+			input: "cloudflared tunnel --no-autoupdate run --token " + tunnelToken,
+			want:  []veles.Secret{creds},
+		},
+		{
+			name: "tunnel token with ingress rules",
+			input: "tunnel: " + tunnelID + "\ntoken: " + tunnelToken + "\ningress:\n" +
+				"  - hostname: app.example.com\n    service: http://localhost:8000\n" +
+				"  - hostname: ssh.example.com\n    service: ssh://localhost:22\n" +
+				"  - service: http_status:404\n",
+			want: []veles.Secret{withTargets},
+		},
+		{
+			name:  "secret too short",
+			input: `{"AccountTag":"` + accountTag + `","TunnelSecret":"c2hvcnQ=","TunnelID":"` + tunnelID + `"}`,
+			want:  nil,
+		},
+		{
+			name:  "missing tunnel ID",
+			input: `{"AccountTag":"` + accountTag + `","TunnelSecret":"` + tunnelSecret + `"}`,
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := velestest.Detect(t, tunnel.NewCloudflaredDetector(), tc.input)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFRPDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name: "frpc.ini",
+			input: "[common]\nserver_addr = 203.0.113.10\nserver_port = 7000\ntoken = " + frpToken + "\n\n" +
+				"[ssh]\ntype = tcp\nlocal_ip = 10.0.0.5\nlocal_port = 22\nremote_port = 6000\n\n" +
+				"[web]\ntype = http\nlocal_port = 80\ncustom_domains = www.example.com\n",
+			want: []veles.Secret{tunnel.FRPToken{
+				Token:   frpToken,
+				Server:  "203.0.113.10:7000",
+				Targets: []string{"10.0.0.5:22", "127.0.0.1:80"},
+			}},
+		},
+		{
+			name: "frpc.toml",
+			input: "serverAddr = \"frp.example.com\"\nserverPort = 7000\nauth.method = \"token\"\nauth.token = \"" + frpToken + "\"\n\n" +
+				"[[proxies]]\nname = \"rdp\"\ntype = \"tcp\"\nlocalIP = \"127.0.0.1\"\nlocalPort = 3389\nremotePort = 6001\n",
+			want: []veles.Secret{tunnel.FRPToken{
+				Token:   frpToken,
+				Server:  "frp.example.com:7000",
+				Targets: []string{"127.0.0.1:3389"},
+			}},
+		},
+		{
+			name:  "frps.toml",
+			input: "bindPort = 7000\n[auth]\nmethod = \"token\"\ntoken = \"" + frpToken + "\"\n",
+			want:  []veles.Secret{tunnel.FRPToken{Token: frpToken}},
+		},
+		{
+			name:  "token in unrelated config",
+			input: "[github]\nuser = octocat\ntoken = " + frpToken + "\n",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := velestest.Detect(t, tunnel.NewFRPDetector(), tc.input)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChiselDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "client",
+			input: chiselCommand + "\n",
+			want: []veles.Secret{tunnel.ChiselAuth{
+				User:     "tunnel",
+				Password: "Pa55w0rd",
+				Server:   "https://tunnel.example.com:443",
+				Targets:  []string{"R:2222:localhost:22", "R:socks"},
+			}},
+		},
+		{
+			name:  "server in systemd unit",
+			input: "[Service]\nExecStart=/usr/local/bin/chisel server --port 8443 --reverse --auth=\"admin:s3cr3t\"\n",
+			want:  []veles.Secret{tunnel.ChiselAuth{User: "admin", Password: "s3cr3t"}},
+		},
+		{
+			name:  "client in shell pipeline",
+			input: "./chisel client --auth u:p 10.1.1.1:8000 R:8080:127.0.0.1:80 && echo done",
+			want: []veles.Secret{tunnel.ChiselAuth{
+				User:     "u",
+				Password: "p",
+				Server:   "10.1.1.1:8000",
+				Targets:  []string{"R:8080:127.0.0.1:80"},
+			}},
+		},
+		{
+			name:  "no credentials",
+			input: "chisel server --port 8080 --reverse --authfile /etc/chisel/users.json",
+			want:  nil,
+		},
+		{
+			name:  "user without password",
+			input: "chisel client --auth admin https://tunnel.example.com",
+			want:  nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := velestest.Detect(t, tunnel.NewChiselDetector(), tc.input)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+const (
+	// maxTokenLen bounds batch tokens, which grow with their policies, and the
+	// key name matched in front of legacy tokens.
+	maxTokenLen = 600
+	// maxInitOutputLen bounds the output of "vault operator init" with up to
+	// 10 key shares.
+	maxInitOutputLen = 4 * veles.KiB
+)
+
+var (
+	tokenRe = regexp.MustCompile(`\bhv[sb]\.[A-Za-z0-9_\-]{24,500}`)
+	// Legacy tokens have no distinctive prefix, so they're only reported when
+	// assigned to a Vault token key, e.g. VAULT_TOKEN, the X-Vault-Token header
+	// or the root token printed by "vault operator init".
+	legacyTokenRe = regexp.MustCompile(`(?i:vault[_\-]?(?:dev[_\-]?root[_\-]?)?token(?:[_\-]?id)?|initial root token|root_token)["']?\s{0,5}[:=]?\s{0,5}["']?\b(s\.[A-Za-z0-9]{24}|b\.[A-Za-z0-9_\-]{60,500})\b`)
+
+	// The text output of "vault operator init".
+	keyLineRe   = regexp.MustCompile(`(Unseal|Recovery) Key \d{1,2}: ([A-Za-z0-9+/]{43}=)`)
+	thresholdRe = regexp.MustCompile(`key threshold of (\d{1,2})`)
+	// The JSON output of "vault operator init -format=json".
+	keyListRe       = regexp.MustCompile(`"(unseal|recovery)_keys_b64"\s*:\s*\[([^\]]*)\]`)
+	keyRe           = regexp.MustCompile(`"([A-Za-z0-9+/]{43}=)"`)
+	jsonThresholdRe = regexp.MustCompile(`"(unseal|recovery)(?:_keys)?_threshold"\s*:\s*(\d{1,2})`)
+)
+
+func tokenType(token string) TokenType {
+	if strings.HasPrefix(token, "hvb.") || strings.HasPrefix(token, "b.") {
+		return TokenTypeBatch
+	}
+	return TokenTypeService
+}
+
+// tokenDetector finds Vault tokens.
+type tokenDetector struct{}
+
+// NewTokenDetector returns a Detector that finds Vault service and batch
+// tokens.
+func NewTokenDetector() veles.Detector { return tokenDetector{} }
+
+// MaxSecretLen returns the maximum length of a token and its key name.
+func (tokenDetector) MaxSecretLen() uint32 { return maxTokenLen }
+
+// Detect finds Vault tokens in data.
+func (tokenDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range tokenRe.FindAllIndex(data, -1) {
+		token := string(data[m[0]:m[1]])
+		secrets = append(secrets, Token{Token: token, Type: tokenType(token)})
+		positions = append(positions, m[0])
+	}
+	for _, m := range legacyTokenRe.FindAllSubmatchIndex(data, -1) {
+		token := string(data[m[2]:m[3]])
+		secrets = append(secrets, Token{Token: token, Type: tokenType(token)})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// unsealKeysDetector finds the output of "vault operator init".
+type unsealKeysDetector struct{}
+
+// NewUnsealKeysDetector returns a Detector that finds the unseal and recovery
+// keys printed by "vault operator init", in text or JSON format.
+func NewUnsealKeysDetector() veles.Detector { return unsealKeysDetector{} }
+
+// MaxSecretLen returns the maximum length of the init output.
+func (unsealKeysDetector) MaxSecretLen() uint32 { return maxInitOutputLen }
+
+// Detect finds unseal and recovery keys in data.
+func (unsealKeysDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	// Unseal and recovery keys, indexed by whether they're recovery keys.
+	var keys [2]*UnsealKeys
+	var pos [2]int
+	add := func(kind string, key string, at int) {
+		i := 0
+		if strings.EqualFold(kind, "recovery") {
+			i = 1
+		}
+		if keys[i] == nil {
+			keys[i] = &UnsealKeys{Recovery: i == 1}
+			pos[i] = at
+		}
+		keys[i].Keys = append(keys[i].Keys, key)
+	}
+
+	for _, m := range keyLineRe.FindAllSubmatchIndex(data, -1) {
+		add(string(data[m[2]:m[3]]), string(data[m[4]:m[5]]), m[0])
+	}
+	for _, m := range keyListRe.FindAllSubmatchIndex(data, -1) {
+		for _, k := range keyRe.FindAllSubmatch(data[m[4]:m[5]], -1) {
+			add(string(data[m[2]:m[3]]), string(k[1]), m[0])
+		}
+	}
+
+	var secrets []veles.Secret
+	var positions []int
+	for i, k := range keys {
+		if k == nil {
+			continue
+		}
+		if m := thresholdRe.FindSubmatch(data); m != nil {
+			k.Threshold, _ = strconv.Atoi(string(m[1]))
+		}
+		for _, m := range jsonThresholdRe.FindAllSubmatch(data, -1) {
+			if (string(m[1]) == "recovery") == k.Recovery {
+				k.Threshold, _ = strconv.Atoi(string(m[2]))
+			}
+		}
+		secrets = append(secrets, *k)
+		positions = append(positions, pos[i])
+	}
+	return secrets, positions
+}
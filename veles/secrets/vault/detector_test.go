@@ -0,0 +1,166 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/vault"
+)
+
+const (
+	serviceToken = "hvs.CAESIJlWGLZ8mT3xB0tSE7YhK0q4hZ2bNvXr9aPpQyWc1FfTGh4KHGh2cy5zRWpYc2NlVUd6V0hRbEp2a2VvRHZ6Rmo"
+	batchToken   = "hvb.AAAAAQKbT0c7sP2pZ8x_yX3a9q2Jt6M4vL1wN8rE5uH0cQ-kFgA7bD2eR9sT1uV3wX5yZ7aB9cD1eF3gH5iJ7kL9mN1oP3qR5"
+	legacyToken  = "s.8fJ2kP9qLx3VbN7mT1wR4yZ6"
+	unsealKey1   = "q5sN0Ok8CcbP1m9LXwz3u2aE0tfR7yHgVYkUjT4iB+M="
+	unsealKey2   = "Zr0aKm3d9Wc2X7vQpLh1N4sT6yJeBfGuI8oR5nMtYxk="
+	unsealKey3   = "7pH2sLk0QwE9rT3yU6iO1aSdF4gJ8zXcVbNm5/KlPoI="
+)
+
+const initOutput = `Unseal Key 1: q5sN0Ok8CcbP1m9LXwz3u2aE0tfR7yHgVYkUjT4iB+M=
+Unseal Key 2: Zr0aKm3d9Wc2X7vQpLh1N4sT6yJeBfGuI8oR5nMtYxk=
+Unseal Key 3: 7pH2sLk0QwE9rT3yU6iO1aSdF4gJ8zXcVbNm5/KlPoI=
+
+Initial Root Token: s.8fJ2kP9qLx3VbN7mT1wR4yZ6
+
+Vault initialized with 3 key shares and a key threshold of 2. Please securely
+distribute the key shares printed above.
+`
+
+const initOutputJSON = `{
+  "unseal_keys_b64": [],
+  "unseal_keys_hex": [],
+  "unseal_shares": 1,
+  "unseal_threshold": 1,
+  "recovery_keys_b64": [
+    "q5sN0Ok8CcbP1m9LXwz3u2aE0tfR7yHgVYkUjT4iB+M=",
+    "Zr0aKm3d9Wc2X7vQpLh1N4sT6yJeBfGuI8oR5nMtYxk="
+  ],
+  "recovery_keys_hex": [
+    "ab9b0d3a493c09c6cfd66f4b5f0cf7bb6684d2d7d1ef2c87e0558914e8e2207e3"
+  ],
+  "recovery_keys_shares": 2,
+  "recovery_keys_threshold": 2,
+  "root_token": "hvs.CAESIJlWGLZ8mT3xB0tSE7YhK0q4hZ2bNvXr9aPpQyWc1FfTGh4KHGh2cy5zRWpYc2NlVUd6V0hRbEp2a2VvRHZ6Rmo"
+}
+`
+
+func TestTokenDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{vault.NewTokenDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "service token in CI log",
+			input: "+ vault login " + serviceToken + "\nSuccess! You are now authenticated.",
+			want:  []veles.Secret{vault.Token{Token: serviceToken, Type: vault.TokenTypeService}},
+		},
+		{
+			name:  "batch token header",
+			input: "curl -H 'X-Vault-Token: " + batchToken + "' https://vault:8200/v1/secret/data/app",
+			want:  []veles.Secret{vault.Token{Token: batchToken, Type: vault.TokenTypeBatch}},
+		},
+		{
+			name:  "legacy token env variable",
+			input: "export VAULT_TOKEN=" + legacyToken + "\n",
+			want:  []veles.Secret{vault.Token{Token: legacyToken, Type: vault.TokenTypeService}},
+		},
+		{
+			name:  "legacy root token of init output",
+			input: initOutput,
+			want:  []veles.Secret{vault.Token{Token: legacyToken, Type: vault.TokenTypeService}},
+		},
+		{
+			name:  "legacy token without vault context",
+			input: "version = \"" + legacyToken + "\"",
+			want:  nil,
+		},
+		{
+			name:  "prefix too short",
+			input: "hvs.abc",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnsealKeysDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{vault.NewUnsealKeysDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "text output",
+			input: initOutput,
+			want: []veles.Secret{vault.UnsealKeys{
+				Keys:      []string{unsealKey1, unsealKey2, unsealKey3},
+				Threshold: 2,
+			}},
+		},
+		{
+			name:  "json output with recovery keys",
+			input: initOutputJSON,
+			want: []veles.Secret{vault.UnsealKeys{
+				Keys:      []string{unsealKey1, unsealKey2},
+				Threshold: 2,
+				Recovery:  true,
+			}},
+		},
+		{
+			name:  "no keys",
+			input: "Unseal Key 1: <redacted>\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
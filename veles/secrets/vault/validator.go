@@ -0,0 +1,43 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// lookupSelfPath returns the properties of the token the request is sent with.
+// The default policy allows all tokens to look themselves up.
+const lookupSelfPath = "/v1/auth/token/lookup-self"
+
+// NewTokenValidator returns a Validator for Tokens that looks them up on the
+// Vault server at address, e.g. "https://vault.example.com:8200", using the
+// given client (nil for a default client). Vault is self-hosted, so the
+// address has to be configured; tokens are never sent to addresses found in
+// the scanned data.
+func NewTokenValidator(address string, client *http.Client) veles.Validator[Token] {
+	return &simplevalidate.Validator[Token]{
+		Endpoint:    strings.TrimSuffix(address, "/") + lookupSelfPath,
+		HTTPHeaders: func(t Token) map[string]string { return map[string]string{"X-Vault-Token": t.Token} },
+		// Vault answers unknown, expired and revoked tokens with 403.
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusForbidden},
+		HTTPC:                client,
+	}
+}
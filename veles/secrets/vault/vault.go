@@ -0,0 +1,50 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vault contains Veles Secret types, Detectors and a Validator for
+// HashiCorp Vault credentials.
+package vault
+
+// TokenType is the type of a Vault token.
+type TokenType string
+
+// Types of Vault tokens.
+const (
+	// TokenTypeService is a persisted, renewable token (prefix "hvs." or the
+	// legacy "s.").
+	TokenTypeService TokenType = "service"
+	// TokenTypeBatch is an encrypted blob that isn't persisted by Vault
+	// (prefix "hvb." or the legacy "b.").
+	TokenTypeBatch TokenType = "batch"
+)
+
+// Token is a Vault token. Depending on its policies it can read secrets, issue
+// credentials from secrets engines or, for root tokens, control all of Vault.
+type Token struct {
+	Token string
+	Type  TokenType
+}
+
+// UnsealKeys are the key shares printed by "vault operator init". Any
+// Threshold of them reconstruct the root key that decrypts all of Vault's
+// storage.
+type UnsealKeys struct {
+	// Keys are the base64 encoded key shares.
+	Keys []string
+	// Threshold is the number of shares needed to unseal Vault, 0 if unknown.
+	Threshold int
+	// Recovery is true for the recovery keys of a Vault that auto-unseals with
+	// a KMS. They can't unseal Vault but can generate new root tokens.
+	Recovery bool
+}
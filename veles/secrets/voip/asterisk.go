@@ -0,0 +1,129 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package voip
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxSectionLen is the maximum length of a config section that's inspected.
+const maxSectionLen = 4 * veles.KiB
+
+var (
+	// Section headers, optionally followed by templates, e.g. "[6001](!)".
+	sectionRe = regexp.MustCompile(`(?m)^\[([^\]\r\n]{1,80})\]`)
+	// Outbound registrations of chan_sip: register => user[:secret[:authuser]]@host[:port][/extension]
+	registerRe = regexp.MustCompile(`(?m)^[ \t]*register[ \t]*=>[ \t]*([^:@\s]{1,64}):([^:@\s]{1,128})(?::[^@\s]{1,64})?@([A-Za-z0-9.\-]{1,253})`)
+)
+
+// asteriskDetector finds SIP credentials in Asterisk configs.
+type asteriskDetector struct{}
+
+// NewAsteriskDetector returns a Detector that finds the secrets of SIP peers
+// in sip.conf, auth objects in pjsip.conf and outbound registrations.
+func NewAsteriskDetector() veles.Detector { return asteriskDetector{} }
+
+// MaxSecretLen returns the maximum length of a config section.
+func (asteriskDetector) MaxSecretLen() uint32 { return maxSectionLen }
+
+// Detect finds Asterisk SIP credentials in data.
+func (asteriskDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	headers := sectionRe.FindAllSubmatchIndex(data, -1)
+	for i, h := range headers {
+		end := len(data)
+		if i+1 < len(headers) {
+			end = headers[i+1][0]
+		}
+		end = min(end, h[1]+maxSectionLen)
+		if c := parseAsteriskSection(string(data[h[2]:h[3]]), data[h[1]:end]); c != nil {
+			secrets = append(secrets, *c)
+			positions = append(positions, h[0])
+		}
+	}
+	for _, m := range registerRe.FindAllSubmatchIndex(data, -1) {
+		secret := string(data[m[4]:m[5]])
+		if isPlaceholder(secret) {
+			continue
+		}
+		secrets = append(secrets, AsteriskCredential{
+			Section:  "register",
+			Username: string(data[m[2]:m[3]]),
+			Secret:   secret,
+			Host:     string(data[m[6]:m[7]]),
+		})
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// parseAsteriskSection returns the credential configured in the section
+// name, or nil if it isn't a SIP peer or pjsip auth object with a secret.
+func parseAsteriskSection(name string, body []byte) *AsteriskCredential {
+	opts := make(map[string]string)
+	s := bufio.NewScanner(bytes.NewReader(body))
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), ";")
+		// Both "key=value" and "key => value" are valid.
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		k = strings.ToLower(strings.TrimSpace(k))
+		v = strings.TrimSpace(strings.TrimPrefix(v, ">"))
+		if _, exists := opts[k]; !exists {
+			opts[k] = v
+		}
+	}
+
+	c := &AsteriskCredential{Section: name}
+	switch opts["type"] {
+	case "peer", "friend", "user":
+		// chan_sip authenticates peers with their section name unless a
+		// different user is configured.
+		c.Username = firstNonEmpty(opts["defaultuser"], opts["username"], name)
+		c.Secret = opts["secret"]
+		if c.Secret == "" {
+			c.Secret, c.Hashed = opts["md5secret"], true
+		}
+	case "auth":
+		c.Username = opts["username"]
+		c.Secret = opts["password"]
+		if opts["auth_type"] == "md5" || c.Secret == "" {
+			c.Secret, c.Hashed = opts["md5_cred"], true
+		}
+	default:
+		return nil
+	}
+	if isPlaceholder(c.Secret) {
+		return nil
+	}
+	return c
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package voip
+
+import (
+	"html"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxFreeSWITCHLen is the maximum length of a user or gateway definition.
+const maxFreeSWITCHLen = 2 * veles.KiB
+
+var (
+	fsUserRe    = regexp.MustCompile(`(?s)<user\s+id="([^"]{1,64})"[^>]{0,200}>(.{0,1000}?)</user>`)
+	fsGatewayRe = regexp.MustCompile(`(?s)<gateway\s+name="([^"]{1,64})"\s*>(.{0,1000}?)</gateway>`)
+	// The default password preprocessor variable of vars.xml.
+	fsDefaultPasswordRe = regexp.MustCompile(`<X-PRE-PROCESS\s+cmd="set"\s+data="default_password=([^"]{1,128})"`)
+	fsParamRe           = regexp.MustCompile(`<param\s+name="([a-z0-9\-]{1,32})"\s+value="([^"]{0,256})"`)
+)
+
+// freeSWITCHDetector finds SIP credentials in FreeSWITCH XML configs.
+type freeSWITCHDetector struct{}
+
+// NewFreeSWITCHDetector returns a Detector that finds the passwords of
+// directory users and SIP gateways and the default password in FreeSWITCH
+// configs.
+func NewFreeSWITCHDetector() veles.Detector { return freeSWITCHDetector{} }
+
+// MaxSecretLen returns the maximum length of a user or gateway definition.
+func (freeSWITCHDetector) MaxSecretLen() uint32 { return maxFreeSWITCHLen }
+
+// Detect finds FreeSWITCH credentials in data.
+func (freeSWITCHDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	for _, m := range fsDefaultPasswordRe.FindAllSubmatchIndex(data, -1) {
+		secret := html.UnescapeString(string(data[m[2]:m[3]]))
+		if isPlaceholder(secret) {
+			continue
+		}
+		secrets = append(secrets, FreeSWITCHCredential{Type: FreeSWITCHDefaultPassword, Secret: secret})
+		positions = append(positions, m[0])
+	}
+	for _, block := range []struct {
+		re  *regexp.Regexp
+		typ FreeSWITCHCredentialType
+	}{
+		{fsUserRe, FreeSWITCHUser},
+		{fsGatewayRe, FreeSWITCHGateway},
+	} {
+		for _, m := range block.re.FindAllSubmatchIndex(data, -1) {
+			c := FreeSWITCHCredential{Type: block.typ, Name: string(data[m[2]:m[3]])}
+			params := make(map[string]string)
+			for _, p := range fsParamRe.FindAllSubmatch(data[m[4]:m[5]], -1) {
+				params[string(p[1])] = html.UnescapeString(string(p[2]))
+			}
+			c.Username = params["username"]
+			c.Secret = params["password"]
+			if c.Secret == "" {
+				c.Secret, c.Hashed = params["a1-hash"], true
+			}
+			if isPlaceholder(c.Secret) {
+				continue
+			}
+			secrets = append(secrets, c)
+			positions = append(positions, m[0])
+		}
+	}
+	return secrets, positions
+}
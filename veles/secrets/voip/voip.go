@@ -0,0 +1,79 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package voip contains Veles Secret types and Detectors for the credentials
+// of VoIP systems: SIP accounts in Asterisk and FreeSWITCH configs and XMPP
+// component secrets.
+//
+// Leaked SIP credentials are used for toll fraud, i.e. placing expensive
+// calls at the expense of the account owner, and are frequently left in the
+// configs of PBX appliance images.
+package voip
+
+import "strings"
+
+// AsteriskCredential is a SIP credential configured in Asterisk's sip.conf or
+// pjsip.conf.
+type AsteriskCredential struct {
+	// The config section of the peer or auth object, or "register" for the
+	// outbound registrations of sip.conf.
+	Section  string
+	Username string
+	Secret   string
+	// Whether Secret is an MD5 digest of username:realm:password.
+	Hashed bool
+	// The SIP provider, only set for outbound registrations.
+	Host string
+}
+
+// FreeSWITCHCredentialType is the kind of a FreeSWITCH credential.
+type FreeSWITCHCredentialType string
+
+// FreeSWITCHCredentialType values.
+const (
+	// FreeSWITCHUser is the password of a user in the directory.
+	FreeSWITCHUser FreeSWITCHCredentialType = "user"
+	// FreeSWITCHGateway is the password of an account at a SIP provider.
+	FreeSWITCHGateway FreeSWITCHCredentialType = "gateway"
+	// FreeSWITCHDefaultPassword is the default_password variable of vars.xml
+	// that directory users inherit.
+	FreeSWITCHDefaultPassword FreeSWITCHCredentialType = "default-password"
+)
+
+// FreeSWITCHCredential is a SIP credential configured in FreeSWITCH's XML
+// configuration.
+type FreeSWITCHCredential struct {
+	Type FreeSWITCHCredentialType
+	// The user ID or gateway name. Empty for the default password.
+	Name     string
+	Username string
+	Secret   string
+	// Whether Secret is an a1-hash instead of a password.
+	Hashed bool
+}
+
+// XMPPComponentSecret is the shared secret an external XMPP component, e.g. a
+// gateway or Jitsi Videobridge, uses to authenticate to the XMPP server.
+type XMPPComponentSecret struct {
+	// The domain of the component, if configured next to the secret.
+	Component string
+	Secret    string
+}
+
+// isPlaceholder returns true for values that are filled in by a
+// preprocessor or template engine, e.g. "$${default_password}" or
+// "{{ sip_secret }}".
+func isPlaceholder(v string) bool {
+	return v == "" || strings.Contains(v, "${") || strings.Contains(v, "{{")
+}
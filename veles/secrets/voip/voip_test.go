@@ -0,0 +1,245 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package voip_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/voip"
+	"github.com/google/osv-scalibr/veles/velestest"
+)
+
+const sipConf = `[general]
+context=default
+register => 1234:Pr0viderPass@sip.provider.example/1234
+
+[6001]
+type=friend
+host=dynamic
+secret=Ext6001Secret ; desk phone
+context=internal
+
+[6002]
+type=peer
+defaultuser=alice
+md5secret=5f4dcc3b5aa765d61d8327deb882cf99
+
+[6003]
+type=friend
+secret=${SIP_SECRET}
+
+[internal]
+exten => 100,1,Dial(SIP/6001)
+`
+
+const pjsipConf = `[transport-udp]
+type=transport
+protocol=udp
+bind=0.0.0.0
+
+[6001-auth]
+type=auth
+auth_type=userpass
+username=6001
+password=PjsipPass!
+
+[trunk-auth]
+type = auth
+auth_type = md5
+username = trunk
+md5_cred = 0123456789abcdef0123456789abcdef
+`
+
+func TestAsteriskDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "sip.conf",
+			input: sipConf,
+			want: []veles.Secret{
+				voip.AsteriskCredential{Section: "6001", Username: "6001", Secret: "Ext6001Secret"},
+				voip.AsteriskCredential{Section: "6002", Username: "alice", Secret: "5f4dcc3b5aa765d61d8327deb882cf99", Hashed: true},
+				voip.AsteriskCredential{Section: "register", Username: "1234", Secret: "Pr0viderPass", Host: "sip.provider.example"},
+			},
+		},
+		{
+			name:  "pjsip.conf",
+			input: pjsipConf,
+			want: []veles.Secret{
+				voip.AsteriskCredential{Section: "6001-auth", Username: "6001", Secret: "PjsipPass!"},
+				voip.AsteriskCredential{Section: "trunk-auth", Username: "trunk", Secret: "0123456789abcdef0123456789abcdef", Hashed: true},
+			},
+		},
+		{
+			name:  "ini file of another application",
+			input: "[database]\nuser=app\npassword=hunter2\n",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, voip.NewAsteriskDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFreeSWITCHDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name: "directory user",
+			input: `<include>
+  <user id="1000">
+    <params>
+      <param name="password" value="Us3r&amp;Pass"/>
+      <param name="vm-password" value="1000"/>
+    </params>
+  </user>
+</include>`,
+			want: []veles.Secret{voip.FreeSWITCHCredential{
+				Type:   voip.FreeSWITCHUser,
+				Name:   "1000",
+				Secret: "Us3r&Pass",
+			}},
+		},
+		{
+			name: "directory user with a1-hash",
+			input: `<user id="1001" cidr="10.0.0.0/8">
+  <params>
+    <param name="a1-hash" value="c6440e5de50b403206989679159de89a"/>
+  </params>
+</user>`,
+			want: []veles.Secret{voip.FreeSWITCHCredential{
+				Type:   voip.FreeSWITCHUser,
+				Name:   "1001",
+				Secret: "c6440e5de50b403206989679159de89a",
+				Hashed: true,
+			}},
+		},
+		{
+			name: "user inheriting the default password",
+			input: `<user id="1002">
+  <params>
+    <param name="password" value="$${default_password}"/>
+  </params>
+</user>`,
+			want: nil,
+		},
+		{
+			name: "gateway",
+			input: `<include>
+  <gateway name="provider">
+    <param name="username" value="trunk01"/>
+    <param name="password" value="Tr4nkPass"/>
+    <param name="proxy" value="sip.provider.example"/>
+  </gateway>
+</include>`,
+			want: []veles.Secret{voip.FreeSWITCHCredential{
+				Type:     voip.FreeSWITCHGateway,
+				Name:     "provider",
+				Username: "trunk01",
+				Secret:   "Tr4nkPass",
+			}},
+		},
+		{
+			name:  "default password",
+			input: `<X-PRE-PROCESS cmd="set" data="default_password=1234"/>`,
+			want: []veles.Secret{voip.FreeSWITCHCredential{
+				Type:   voip.FreeSWITCHDefaultPassword,
+				Secret: "1234",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, voip.NewFreeSWITCHDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestXMPPComponentDetector(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name: "prosody",
+			input: `VirtualHost "meet.example.com"
+    authentication = "anonymous"
+
+Component "focus.meet.example.com" "client_proxy"
+
+Component "gateway.example.com"
+    component_secret = "Gw-S3cret"
+`,
+			want: []veles.Secret{voip.XMPPComponentSecret{
+				Component: "gateway.example.com",
+				Secret:    "Gw-S3cret",
+			}},
+		},
+		{
+			name:  "prosody secret from environment",
+			input: "Component \"jvb.example.com\"\n    component_secret = os.getenv(\"JVB_SECRET\")\n",
+			want:  nil,
+		},
+		{
+			name: "ejabberd",
+			input: `listen:
+  -
+    port: 5222
+    module: ejabberd_c2s
+  -
+    port: 5275
+    module: ejabberd_service
+    hosts:
+      "icq.example.org":
+        password: "Ej4bberdSecret"
+  -
+    port: 5280
+    module: ejabberd_http
+`,
+			want: []veles.Secret{voip.XMPPComponentSecret{
+				Component: "icq.example.org",
+				Secret:    "Ej4bberdSecret",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := velestest.Detect(t, voip.NewXMPPComponentDetector(), tt.input)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
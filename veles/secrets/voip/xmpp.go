@@ -0,0 +1,99 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package voip
+
+import (
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// maxComponentLen is how far from a component declaration its secret is
+// searched.
+const maxComponentLen = 1 * veles.KiB
+
+var (
+	// Prosody: Component "gateway.example.com" followed by its options.
+	prosodyComponentRe = regexp.MustCompile(`(?m)^[ \t]*Component[ \t]+["']([^"'\s]{1,253})["']`)
+	prosodySecretRe    = regexp.MustCompile(`(?m)^[ \t]*component_secret[ \t]*=[ \t]*["']([^"'\r\n]{1,256})["']`)
+	// ejabberd: a listener of the ejabberd_service module with its password.
+	ejabberdServiceRe = regexp.MustCompile(`module:\s{0,10}ejabberd_service\b`)
+	// The start of the next listener in the listen list.
+	ejabberdNextListenerRe = regexp.MustCompile(`\n\s{0,20}-\s`)
+	ejabberdHostRe         = regexp.MustCompile(`["']?([A-Za-z0-9.\-]{1,253}\.[A-Za-z]{2,63})["']?:\s{0,10}\n\s{0,20}password:`)
+	ejabberdSecretRe       = regexp.MustCompile(`password:\s{0,10}["']?([^"'\s]{1,256})["']?`)
+)
+
+// xmppComponentDetector finds the secrets of external XMPP components.
+type xmppComponentDetector struct{}
+
+// NewXMPPComponentDetector returns a Detector that finds external component
+// secrets in Prosody and ejabberd configs.
+func NewXMPPComponentDetector() veles.Detector { return xmppComponentDetector{} }
+
+// MaxSecretLen returns the maximum distance between a component declaration
+// and its secret.
+func (xmppComponentDetector) MaxSecretLen() uint32 { return maxComponentLen }
+
+// Detect finds XMPP component secrets in data.
+func (xmppComponentDetector) Detect(data []byte) ([]veles.Secret, []int) {
+	var secrets []veles.Secret
+	var positions []int
+	components := prosodyComponentRe.FindAllSubmatchIndex(data, -1)
+	for _, m := range prosodySecretRe.FindAllSubmatchIndex(data, -1) {
+		secret := string(data[m[2]:m[3]])
+		if isPlaceholder(secret) {
+			continue
+		}
+		secrets = append(secrets, XMPPComponentSecret{
+			Component: precedingComponent(data, components, m[0]),
+			Secret:    secret,
+		})
+		positions = append(positions, m[0])
+	}
+	for _, m := range ejabberdServiceRe.FindAllIndex(data, -1) {
+		listener := data[m[1]:min(len(data), m[1]+maxComponentLen)]
+		if next := ejabberdNextListenerRe.FindIndex(listener); next != nil {
+			listener = listener[:next[0]]
+		}
+		s := ejabberdSecretRe.FindSubmatch(listener)
+		if s == nil || isPlaceholder(string(s[1])) {
+			continue
+		}
+		c := XMPPComponentSecret{Secret: string(s[1])}
+		if h := ejabberdHostRe.FindSubmatch(listener); h != nil {
+			c.Component = string(h[1])
+		}
+		secrets = append(secrets, c)
+		positions = append(positions, m[0])
+	}
+	return secrets, positions
+}
+
+// precedingComponent returns the closest Prosody component declared before
+// pos within maxComponentLen, or "" if there is none. Options belong to the
+// last declared component.
+func precedingComponent(data []byte, components [][]int, pos int) string {
+	name := ""
+	for _, c := range components {
+		if c[0] > pos {
+			break
+		}
+		if pos-c[0] <= maxComponentLen {
+			name = string(data[c[2]:c[3]])
+		}
+	}
+	return name
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zerotier contains a Veles Secret type, Detector and Validator for
+// ZeroTier Central API tokens.
+package zerotier
+
+import (
+	"net/http"
+	"regexp"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simpletoken"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// maxContextLen bounds the key name, separator and token matched by the
+// detection regex.
+const maxContextLen = 100
+
+// DefaultEndpoint lists the networks the token has access to on ZeroTier
+// Central.
+const DefaultEndpoint = "https://api.zerotier.com/api/v1/network"
+
+// API tokens are 32 random alphanumeric characters without a prefix, so
+// they're only reported when assigned to a ZeroTier token variable, e.g.
+// ZEROTIER_CENTRAL_TOKEN=... or zt_api_token: "...".
+var tokenRe = regexp.MustCompile(`(?i:(?:zerotier|zt)[a-z0-9_\-]{0,30}token)["']?\s{0,5}[:=]\s{0,5}["']?([A-Za-z0-9]{32})\b`)
+
+// APIToken is a ZeroTier Central API token. It allows managing networks,
+// including authorizing new members.
+type APIToken struct {
+	Token string
+}
+
+// NewDetector returns a Detector that finds ZeroTier Central API tokens.
+func NewDetector() veles.Detector {
+	return simpletoken.Detector{
+		MaxLen: maxContextLen,
+		Re:     tokenRe,
+		FromMatch: func(b []byte) veles.Secret {
+			return APIToken{Token: string(b)}
+		},
+	}
+}
+
+// NewValidator returns a Validator for APITokens that sends requests to
+// endpoint using the given client (nil for a default client).
+func NewValidator(endpoint string, client *http.Client) veles.Validator[APIToken] {
+	return &simplevalidate.Validator[APIToken]{
+		Endpoint: endpoint,
+		HTTPHeaders: func(t APIToken) map[string]string {
+			return map[string]string{"Authorization": "token " + t.Token}
+		},
+		ValidResponseCodes:   []int{http.StatusOK},
+		InvalidResponseCodes: []int{http.StatusUnauthorized, http.StatusForbidden},
+		HTTPC:                client,
+	}
+}
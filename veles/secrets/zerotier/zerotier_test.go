@@ -0,0 +1,132 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zerotier_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/zerotier"
+)
+
+const token = "aB3dE5fG7hJ9kL1mN3pQ5rS7tU9vW1xY"
+
+func TestDetector(t *testing.T) {
+	engine, err := veles.NewDetectionEngine([]veles.Detector{zerotier.NewDetector()})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []veles.Secret
+	}{
+		{
+			name:  "env file",
+			input: "ZEROTIER_CENTRAL_TOKEN=" + token + "\n",
+			want:  []veles.Secret{zerotier.APIToken{Token: token}},
+		},
+		{
+			name:  "yaml",
+			input: `zt_api_token: "` + token + `"`,
+			want:  []veles.Secret{zerotier.APIToken{Token: token}},
+		},
+		{
+			name:  "terraform provider",
+			input: `provider "zerotier" { zerotier_central_token = "` + token + `" }`,
+			want:  []veles.Secret{zerotier.APIToken{Token: token}},
+		},
+		{
+			name:  "token without context",
+			input: "token=" + token,
+			want:  nil,
+		},
+		{
+			name:  "longer value",
+			input: "ZEROTIER_CENTRAL_TOKEN=" + token + "abc",
+			want:  nil,
+		},
+		{
+			name:  "environment variable reference",
+			input: "ZEROTIER_CENTRAL_TOKEN=${TOKEN}",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Detect(context.Background(), strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Detect(): %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Detect() diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		want    veles.ValidationStatus
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			status: http.StatusOK,
+			want:   veles.ValidationValid,
+		},
+		{
+			name:   "invalid",
+			status: http.StatusUnauthorized,
+			want:   veles.ValidationInvalid,
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			want:    veles.ValidationFailed,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "token "+token {
+					t.Errorf("Authorization header = %q, want token", r.Header.Get("Authorization"))
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer s.Close()
+
+			v := zerotier.NewValidator(s.URL, s.Client())
+			got, err := v.Validate(context.Background(), zerotier.APIToken{Token: token})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error: %v, want error: %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
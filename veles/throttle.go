@@ -0,0 +1,152 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is matched by the errors Validators return when the service
+// they validate against rejects the request because of rate limiting, e.g.
+// with HTTP 429.
+var ErrRateLimited = errors.New("rate limited")
+
+// RateLimitError is returned by Validators if the service rejected the
+// validation request because of rate limiting.
+type RateLimitError struct {
+	// RetryAfter is how long the service asked to wait before retrying, e.g.
+	// from an HTTP Retry-After header. Zero if unknown.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited, retry after %v", e.RetryAfter)
+	}
+	return "rate limited"
+}
+
+// Is makes errors.Is(err, ErrRateLimited) true for a RateLimitError.
+func (e *RateLimitError) Is(target error) bool { return target == ErrRateLimited }
+
+// ThrottleConfig configures how a ValidationEngine limits the calls to its
+// Validators so that large scans don't overload the services they validate
+// against.
+type ThrottleConfig struct {
+	// QPS is the maximum number of validation calls per second to the
+	// Validator of each Secret type. Use SetQPS to override it for a type. If
+	// zero or negative, calls aren't limited.
+	QPS float64
+	// MaxRetries is how often a validation that failed with ErrRateLimited is
+	// retried.
+	MaxRetries int
+	// Backoff is the wait before the first retry. It doubles with every retry
+	// and a random jitter of up to the same duration is added. A longer wait
+	// requested by the service through RateLimitError.RetryAfter is honored.
+	Backoff time.Duration
+	// MaxBackoff caps the wait before a retry, including RetryAfter.
+	MaxBackoff time.Duration
+}
+
+// DefaultThrottleConfig returns the default throttling of validation calls.
+func DefaultThrottleConfig() ThrottleConfig {
+	return ThrottleConfig{
+		QPS:        5,
+		MaxRetries: 3,
+		Backoff:    time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
+// NewThrottledValidationEngine creates a ValidationEngine without any
+// Validators that limits and retries validation calls as configured.
+func NewThrottledValidationEngine(cfg ThrottleConfig) *ValidationEngine {
+	e := NewValidationEngine()
+	e.throttle = cfg
+	return e
+}
+
+// backoff returns the wait before the retry after the given number of
+// attempts.
+func (c ThrottleConfig) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	d := c.Backoff << attempt
+	if d > 0 {
+		d += time.Duration(rand.Int63n(int64(d)))
+	}
+	d = max(d, retryAfter)
+	if c.MaxBackoff > 0 {
+		d = min(d, c.MaxBackoff)
+	}
+	return d
+}
+
+// limiter spaces calls at least interval apart. Calls are scheduled in the
+// order they arrive, so concurrent callers wait in line.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(qps float64) *limiter {
+	if qps <= 0 {
+		return &limiter{}
+	}
+	return &limiter{interval: time.Duration(float64(time.Second) / qps)}
+}
+
+// wait blocks until the next call is allowed or ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return ctx.Err()
+	}
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+	return sleep(ctx, at.Sub(now))
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// call is a validation that's in progress or done. Identical Secrets share
+// one call.
+type call struct {
+	done   chan struct{}
+	status ValidationStatus
+	err    error
+}
@@ -0,0 +1,216 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/osv-scalibr/veles"
+	"github.com/google/osv-scalibr/veles/secrets/common/simplevalidate"
+)
+
+// countingValidator returns ValidationValid after failing the first
+// rateLimited calls with a RateLimitError.
+type countingValidator struct {
+	calls       atomic.Int32
+	rateLimited int32
+}
+
+func (v *countingValidator) Validate(_ context.Context, _ fakeSecret) (veles.ValidationStatus, error) {
+	if v.calls.Add(1) <= v.rateLimited {
+		return veles.ValidationFailed, &veles.RateLimitError{}
+	}
+	return veles.ValidationValid, nil
+}
+
+func TestValidate_GroupsIdenticalSecrets(t *testing.T) {
+	v := &countingValidator{}
+	e := veles.NewValidationEngine()
+	veles.AddValidator[fakeSecret](e, v)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, err := e.Validate(context.Background(), fakeSecret{Value: "foo"}); got != veles.ValidationValid || err != nil {
+				t.Errorf("Validate(foo) = %q, %v, want %q", got, err, veles.ValidationValid)
+			}
+		}()
+	}
+	wg.Wait()
+	if _, err := e.Validate(context.Background(), fakeSecret{Value: "bar"}); err != nil {
+		t.Errorf("Validate(bar): %v", err)
+	}
+	if got := v.calls.Load(); got != 2 {
+		t.Errorf("Validator called %d times, want 2", got)
+	}
+}
+
+func TestValidate_CachesResults(t *testing.T) {
+	v := &countingValidator{}
+	e := veles.NewValidationEngine()
+	veles.AddValidator[fakeSecret](e, v)
+
+	validate := func(value string) {
+		t.Helper()
+		if got, err := e.Validate(context.Background(), fakeSecret{Value: value}); got != veles.ValidationValid || err != nil {
+			t.Fatalf("Validate(%s) = %q, %v, want %q", value, got, err, veles.ValidationValid)
+		}
+	}
+	validate("foo")
+	validate("foo")
+	if got := v.calls.Load(); got != 1 {
+		t.Errorf("validator called %d times for a repeated secret, want 1", got)
+	}
+
+	// More distinct secrets than the engine keeps results for.
+	const n = 5000
+	for i := range n {
+		validate(fmt.Sprint("bar", i))
+	}
+	validate("foo")
+	if got := v.calls.Load(); got != n+2 {
+		t.Errorf("validator called %d times after the result of foo was evicted, want %d", got, n+2)
+	}
+}
+
+func TestValidate_RetriesRateLimited(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxRetries  int
+		rateLimited int32
+		want        veles.ValidationStatus
+		wantErr     error
+		wantCalls   int32
+	}{
+		{
+			name:        "succeeds after retries",
+			maxRetries:  3,
+			rateLimited: 2,
+			want:        veles.ValidationValid,
+			wantCalls:   3,
+		},
+		{
+			name:        "retries exhausted",
+			maxRetries:  1,
+			rateLimited: 5,
+			want:        veles.ValidationFailed,
+			wantErr:     veles.ErrRateLimited,
+			wantCalls:   2,
+		},
+		{
+			name:        "no retries",
+			maxRetries:  0,
+			rateLimited: 1,
+			want:        veles.ValidationFailed,
+			wantErr:     veles.ErrRateLimited,
+			wantCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &countingValidator{rateLimited: tt.rateLimited}
+			e := veles.NewThrottledValidationEngine(veles.ThrottleConfig{
+				MaxRetries: tt.maxRetries,
+				Backoff:    time.Millisecond,
+				MaxBackoff: 5 * time.Millisecond,
+			})
+			veles.AddValidator[fakeSecret](e, v)
+
+			got, err := e.Validate(context.Background(), fakeSecret{Value: "foo"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error: %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %q, want %q", got, tt.want)
+			}
+			if got := v.calls.Load(); got != tt.wantCalls {
+				t.Errorf("Validator called %d times, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestValidate_QPS(t *testing.T) {
+	cfg := veles.DefaultThrottleConfig()
+	cfg.QPS = 50
+	e := veles.NewThrottledValidationEngine(cfg)
+	veles.AddValidator[fakeSecret](e, &countingValidator{})
+
+	start := time.Now()
+	for _, s := range []string{"a", "b", "c", "d"} {
+		if _, err := e.Validate(context.Background(), fakeSecret{Value: s}); err != nil {
+			t.Fatalf("Validate(%s): %v", s, err)
+		}
+	}
+	// The first call isn't delayed, the others are spaced 20ms apart.
+	if elapsed, want := time.Since(start), 60*time.Millisecond; elapsed < want {
+		t.Errorf("4 validations at 50 QPS took %v, want at least %v", elapsed, want)
+	}
+
+	veles.SetQPS[fakeSecret](e, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := e.Validate(ctx, fakeSecret{Value: "e"}); err != nil {
+		t.Fatalf("Validate(e): %v", err)
+	}
+	// The second call at 1 QPS has to wait longer than the deadline.
+	if got, err := e.Validate(ctx, fakeSecret{Value: "f"}); got != veles.ValidationFailed || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Validate(f) = %q, %v, want %q, %v", got, err, veles.ValidationFailed, context.DeadlineExceeded)
+	}
+}
+
+func TestValidate_HTTPTooManyRequests(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	e := veles.NewThrottledValidationEngine(veles.ThrottleConfig{
+		MaxRetries: 1,
+		Backoff:    time.Millisecond,
+		// Caps the two minutes requested by the server.
+		MaxBackoff: 5 * time.Millisecond,
+	})
+	veles.AddValidator[fakeSecret](e, &simplevalidate.Validator[fakeSecret]{
+		Endpoint:           srv.URL,
+		ValidResponseCodes: []int{http.StatusOK},
+		HTTPC:              srv.Client(),
+	})
+
+	got, err := e.Validate(context.Background(), fakeSecret{Value: "foo"})
+	if err != nil || got != veles.ValidationValid {
+		t.Errorf("Validate() = %q, %v, want %q", got, err, veles.ValidationValid)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("server got %d requests, want 2", got)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// ValidationStatus is the result of validating a Secret.
+type ValidationStatus string
+
+// ValidationStatus values.
+const (
+	// ValidationUnspecified is the default value. It should not be returned by
+	// Validators.
+	ValidationUnspecified ValidationStatus = "VALIDATION_UNSPECIFIED"
+	// ValidationUnsupported means no Validator is available for the Secret type.
+	ValidationUnsupported ValidationStatus = "VALIDATION_UNSUPPORTED"
+	// ValidationFailed means the validation couldn't be completed, e.g. because
+	// of a network error.
+	ValidationFailed ValidationStatus = "VALIDATION_FAILED"
+	// ValidationInvalid means the Secret is not (or no longer) valid.
+	ValidationInvalid ValidationStatus = "VALIDATION_INVALID"
+	// ValidationValid means the Secret is valid and can be used.
+	ValidationValid ValidationStatus = "VALIDATION_VALID"
+)
+
+// Validator checks whether a Secret of type S is valid, usually by
+// authenticating against the service it belongs to.
+type Validator[S Secret] interface {
+	Validate(ctx context.Context, secret S) (ValidationStatus, error)
+}
+
+// maxCachedResults is the number of validation results a ValidationEngine
+// keeps for Secrets that are found again. The least recently used result is
+// dropped first.
+const maxCachedResults = 4096
+
+// secretKey identifies a Secret by a hash of its type and value so that the
+// engine doesn't keep the Secrets themselves around.
+type secretKey [sha256.Size]byte
+
+func keyOf(s Secret) secretKey {
+	return sha256.Sum256(fmt.Appendf(nil, "%T\x00%#v", s, s))
+}
+
+// result is a completed validation in the result cache.
+type result struct {
+	key    secretKey
+	status ValidationStatus
+	err    error
+}
+
+// ValidationEngine dispatches Secrets to the Validator registered for their
+// concrete type. Identical Secrets, e.g. the same key found in several files,
+// are only validated once and share the result, as long as it's among the
+// most recently used results. It's safe for concurrent use.
+type ValidationEngine struct {
+	validators map[reflect.Type]func(context.Context, Secret) (ValidationStatus, error)
+	throttle   ThrottleConfig
+
+	mu sync.Mutex
+	// QPS overrides of ThrottleConfig.QPS by Secret type.
+	qps      map[reflect.Type]float64
+	limiters map[reflect.Type]*limiter
+	// Validations in progress.
+	calls map[secretKey]*call
+	// Completed validations, the most recently used first.
+	results      map[secretKey]*list.Element
+	resultsOrder *list.List
+}
+
+// NewValidationEngine creates a ValidationEngine without any Validators.
+// Use AddValidator to register them. Validation calls aren't throttled, use
+// NewThrottledValidationEngine for that.
+func NewValidationEngine() *ValidationEngine {
+	return &ValidationEngine{
+		validators:   make(map[reflect.Type]func(context.Context, Secret) (ValidationStatus, error)),
+		qps:          make(map[reflect.Type]float64),
+		limiters:     make(map[reflect.Type]*limiter),
+		calls:        make(map[secretKey]*call),
+		results:      make(map[secretKey]*list.Element),
+		resultsOrder: list.New(),
+	}
+}
+
+// AddValidator registers v for Secrets of type S. It returns false if a
+// Validator for S was already registered, in which case it's replaced.
+func AddValidator[S Secret](e *ValidationEngine, v Validator[S]) bool {
+	t := reflect.TypeFor[S]()
+	_, exists := e.validators[t]
+	e.validators[t] = func(ctx context.Context, s Secret) (ValidationStatus, error) {
+		return v.Validate(ctx, s.(S))
+	}
+	return !exists
+}
+
+// SetQPS sets the maximum number of validation calls per second for Secrets
+// of type S, overriding ThrottleConfig.QPS. If qps is zero or negative, the
+// calls aren't limited.
+func SetQPS[S Secret](e *ValidationEngine, qps float64) {
+	t := reflect.TypeFor[S]()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.qps[t] = qps
+	delete(e.limiters, t)
+}
+
+// Validate validates s using the Validator registered for its type.
+// ValidationUnsupported is returned if there's no such Validator. If s was
+// validated recently, or is being validated concurrently, the result of that
+// validation is returned.
+func (e *ValidationEngine) Validate(ctx context.Context, s Secret) (ValidationStatus, error) {
+	if s == nil {
+		return ValidationFailed, fmt.Errorf("secret is nil")
+	}
+	t := reflect.TypeOf(s)
+	v, ok := e.validators[t]
+	if !ok {
+		return ValidationUnsupported, nil
+	}
+
+	key := keyOf(s)
+	e.mu.Lock()
+	if el, ok := e.results[key]; ok {
+		e.resultsOrder.MoveToFront(el)
+		r := el.Value.(*result)
+		e.mu.Unlock()
+		return r.status, r.err
+	}
+	c, ok := e.calls[key]
+	if !ok {
+		c = &call{done: make(chan struct{})}
+		e.calls[key] = c
+	}
+	e.mu.Unlock()
+	if ok {
+		select {
+		case <-c.done:
+			return c.status, c.err
+		case <-ctx.Done():
+			return ValidationFailed, ctx.Err()
+		}
+	}
+
+	c.status, c.err = e.validate(ctx, t, v, s)
+	if c.err != nil {
+		c.status = ValidationFailed
+	}
+	e.mu.Lock()
+	delete(e.calls, key)
+	if ctx.Err() == nil {
+		// Results of canceled validations aren't kept so that later callers
+		// with a live context validate again.
+		e.addResult(&result{key: key, status: c.status, err: c.err})
+	}
+	e.mu.Unlock()
+	close(c.done)
+	return c.status, c.err
+}
+
+// addResult adds r to the result cache and drops the least recently used
+// result if the cache is full. e.mu must be held.
+func (e *ValidationEngine) addResult(r *result) {
+	e.results[r.key] = e.resultsOrder.PushFront(r)
+	if e.resultsOrder.Len() > maxCachedResults {
+		oldest := e.resultsOrder.Back()
+		e.resultsOrder.Remove(oldest)
+		delete(e.results, oldest.Value.(*result).key)
+	}
+}
+
+// validate calls v once the rate limit for Secrets of type t allows it and
+// retries calls that fail with ErrRateLimited.
+func (e *ValidationEngine) validate(ctx context.Context, t reflect.Type, v func(context.Context, Secret) (ValidationStatus, error), s Secret) (ValidationStatus, error) {
+	l := e.limiter(t)
+	for attempt := 0; ; attempt++ {
+		if err := l.wait(ctx); err != nil {
+			return ValidationFailed, err
+		}
+		status, err := v(ctx, s)
+		if !errors.Is(err, ErrRateLimited) || attempt >= e.throttle.MaxRetries {
+			return status, err
+		}
+		var retryAfter time.Duration
+		var rle *RateLimitError
+		if errors.As(err, &rle) {
+			retryAfter = rle.RetryAfter
+		}
+		if err := sleep(ctx, e.throttle.backoff(attempt, retryAfter)); err != nil {
+			return ValidationFailed, err
+		}
+	}
+}
+
+// limiter returns the rate limiter of the Validator for Secrets of type t.
+func (e *ValidationEngine) limiter(t reflect.Type) *limiter {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	l, ok := e.limiters[t]
+	if !ok {
+		qps, ok := e.qps[t]
+		if !ok {
+			qps = e.throttle.QPS
+		}
+		l = newLimiter(qps)
+		e.limiters[t] = l
+	}
+	return l
+}
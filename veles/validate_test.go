@@ -0,0 +1,112 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package veles_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+type fakeSecret struct {
+	Value string
+}
+
+type otherSecret struct{}
+
+type fakeValidator struct {
+	status veles.ValidationStatus
+	err    error
+}
+
+func (v fakeValidator) Validate(_ context.Context, _ fakeSecret) (veles.ValidationStatus, error) {
+	return v.status, v.err
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator fakeValidator
+		secret    veles.Secret
+		want      veles.ValidationStatus
+		wantErr   bool
+	}{
+		{
+			name:      "valid",
+			validator: fakeValidator{status: veles.ValidationValid},
+			secret:    fakeSecret{Value: "foo"},
+			want:      veles.ValidationValid,
+		},
+		{
+			name:      "invalid",
+			validator: fakeValidator{status: veles.ValidationInvalid},
+			secret:    fakeSecret{Value: "foo"},
+			want:      veles.ValidationInvalid,
+		},
+		{
+			name:      "validator error",
+			validator: fakeValidator{status: veles.ValidationValid, err: errors.New("network down")},
+			secret:    fakeSecret{Value: "foo"},
+			want:      veles.ValidationFailed,
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported secret type",
+			validator: fakeValidator{status: veles.ValidationValid},
+			secret:    otherSecret{},
+			want:      veles.ValidationUnsupported,
+		},
+		{
+			name:      "nil secret",
+			validator: fakeValidator{status: veles.ValidationValid},
+			secret:    nil,
+			want:      veles.ValidationFailed,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := veles.NewValidationEngine()
+			veles.AddValidator[fakeSecret](e, tt.validator)
+			got, err := e.Validate(context.Background(), tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate(%v) error: %v, want error: %t", tt.secret, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Validate(%v) = %q, want %q", tt.secret, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddValidatorReplaces(t *testing.T) {
+	e := veles.NewValidationEngine()
+	if !veles.AddValidator[fakeSecret](e, fakeValidator{status: veles.ValidationInvalid}) {
+		t.Errorf("AddValidator() first call: got false, want true")
+	}
+	if veles.AddValidator[fakeSecret](e, fakeValidator{status: veles.ValidationValid}) {
+		t.Errorf("AddValidator() second call: got true, want false")
+	}
+	got, err := e.Validate(context.Background(), fakeSecret{})
+	if err != nil {
+		t.Fatalf("Validate(): %v", err)
+	}
+	if got != veles.ValidationValid {
+		t.Errorf("Validate() = %q, want %q", got, veles.ValidationValid)
+	}
+}
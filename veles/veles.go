@@ -0,0 +1,37 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package veles is a library for detecting and validating secrets such as API
+// keys and credentials in arbitrary data.
+//
+// Detection is done by Detectors which are run on chunks of data by the
+// DetectionEngine. Each type of secret is represented by its own Go type that
+// is defined next to the Detector that finds it (see veles/secrets/...).
+// Validators can optionally check whether a found secret is still active.
+package veles
+
+// Secret is a secret found by a Detector. Concrete secret types are plain Go
+// structs defined alongside their Detectors.
+type Secret any
+
+// Detector finds instances of one or more types of secrets in a chunk of data.
+type Detector interface {
+	// MaxSecretLen is the maximum length in bytes a secret found by this
+	// Detector can have. It's used by the DetectionEngine to make sure secrets
+	// spanning chunk boundaries are still found.
+	MaxSecretLen() uint32
+	// Detect finds secrets in data and returns them together with the byte
+	// offsets at which they start. Both slices need to have the same length.
+	Detect(data []byte) ([]Secret, []int)
+}
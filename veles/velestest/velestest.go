@@ -0,0 +1,65 @@
+// Copyright 2024 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package velestest contains helpers for testing Veles detectors.
+package velestest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/osv-scalibr/veles"
+)
+
+// Detect runs d on input through a DetectionEngine, so that matches spanning
+// chunk boundaries are handled like in a scan, and returns the secrets found.
+// The test fails if the engine can't be created or detection fails.
+func Detect(t *testing.T, d veles.Detector, input string) []veles.Secret {
+	t.Helper()
+	engine, err := veles.NewDetectionEngine([]veles.Detector{d})
+	if err != nil {
+		t.Fatalf("veles.NewDetectionEngine(): %v", err)
+	}
+	got, err := engine.Detect(context.Background(), strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Detect() error: %v", err)
+	}
+	return got
+}
+
+// NewAuthServer returns a server for testing validators that responds with 200
+// if the request carries wantAuth in its Authorization header and with status
+// otherwise. The test fails if a request doesn't use wantMethod or wantURI.
+// The server is closed when the test ends.
+func NewAuthServer(t *testing.T, wantMethod, wantURI, wantAuth string, status int) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != wantMethod {
+			t.Errorf("request method = %q, want %q", r.Method, wantMethod)
+		}
+		if r.URL.RequestURI() != wantURI {
+			t.Errorf("request URI = %q, want %q", r.URL.RequestURI(), wantURI)
+		}
+		if r.Header.Get("Authorization") == wantAuth {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}